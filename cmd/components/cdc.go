@@ -0,0 +1,59 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package components
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/cdc"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/dependency"
+)
+
+// CDC implements the server replicating the changes to another cluster
+type CDC struct {
+	svr *cdc.Server
+}
+
+// NewCDC creates a new CDC
+func NewCDC(ctx context.Context, factory dependency.Factory) (*CDC, error) {
+	svr, err := cdc.NewServer(ctx, factory)
+	if err != nil {
+		return nil, err
+	}
+	return &CDC{svr: svr}, nil
+}
+
+// Run starts service
+func (c *CDC) Run() error {
+	if err := c.svr.Run(); err != nil {
+		return err
+	}
+	log.Debug("CDC successfully started")
+	return nil
+}
+
+// Stop terminates service
+func (c *CDC) Stop() error {
+	return c.svr.Stop()
+}
+
+// GetComponentStates returns CDC's states
+func (c *CDC) GetComponentStates(ctx context.Context, request *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error) {
+	return c.svr.GetComponentStates(ctx, request)
+}
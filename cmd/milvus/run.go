@@ -62,6 +62,8 @@ func (c *run) execute(args []string, flags *flag.FlagSet) {
 		role.EnableIndexCoord = true
 	case typeutil.IndexNodeRole:
 		role.EnableIndexNode = true
+	case typeutil.CDCRole:
+		role.EnableCDC = true
 	case typeutil.StandaloneRole, typeutil.EmbeddedRole:
 		role.HasMultipleRoles = true
 		role.EnableRootCoord = true
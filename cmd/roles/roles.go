@@ -35,6 +35,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/milvus-io/milvus/cmd/components"
+	"github.com/milvus-io/milvus/internal/cdc"
 	"github.com/milvus-io/milvus/internal/datacoord"
 	"github.com/milvus-io/milvus/internal/datanode"
 	"github.com/milvus-io/milvus/internal/indexcoord"
@@ -81,6 +82,7 @@ type MilvusRoles struct {
 	EnableDataNode   bool `env:"ENABLE_DATA_NODE"`
 	EnableIndexCoord bool `env:"ENABLE_INDEX_COORD"`
 	EnableIndexNode  bool `env:"ENABLE_INDEX_NODE"`
+	EnableCDC        bool `env:"ENABLE_CDC"`
 }
 
 // EnvValue not used now.
@@ -353,6 +355,34 @@ func (mr *MilvusRoles) runIndexNode(ctx context.Context, localMsg bool, alias st
 	return in
 }
 
+func (mr *MilvusRoles) runCDC(ctx context.Context, localMsg bool) *components.CDC {
+	var c *components.CDC
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		cdc.Params.InitOnce()
+		cdc.Params.SetLogConfig(typeutil.CDCRole)
+
+		factory := dependency.NewFactory(localMsg)
+
+		var err error
+		c, err = components.NewCDC(ctx, factory)
+		if err != nil {
+			panic(err)
+		}
+		if !mr.HasMultipleRoles {
+			http.Handle(healthz.HealthzRouterPath, &componentsHealthzHandler{component: c})
+		}
+		wg.Done()
+		_ = c.Run()
+	}()
+	wg.Wait()
+
+	metrics.RegisterCDC(Registry)
+	return c
+}
+
 // Run Milvus components.
 func (mr *MilvusRoles) Run(local bool, alias string) {
 	log.Info("starting running Milvus components")
@@ -461,6 +491,14 @@ func (mr *MilvusRoles) Run(local bool, alias string) {
 		}
 	}
 
+	var c *components.CDC
+	if mr.EnableCDC {
+		c = mr.runCDC(ctx, local)
+		if c != nil {
+			defer c.Stop()
+		}
+	}
+
 	if mr.HasMultipleRoles {
 		multiRoleHealthzHandler := func(w http.ResponseWriter, r *http.Request) {
 			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
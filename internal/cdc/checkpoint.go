@@ -0,0 +1,78 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"path"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+const checkpointPrefix = "cdc/checkpoint"
+
+// checkpointStore persists the positions of the dml channels replayed, the replication resumes from them after restart.
+type checkpointStore struct {
+	kv   kv.BaseKV
+	name string
+}
+
+func newCheckpointStore(kv kv.BaseKV, name string) *checkpointStore {
+	return &checkpointStore{
+		kv:   kv,
+		name: name,
+	}
+}
+
+func (s *checkpointStore) key(channel string) string {
+	return path.Join(checkpointPrefix, s.name, channel)
+}
+
+// load returns the checkpoints of the channels, empty if the replication has never started.
+func (s *checkpointStore) load() ([]*internalpb.MsgPosition, error) {
+	_, values, err := s.kv.LoadWithPrefix(path.Join(checkpointPrefix, s.name) + "/")
+	if err != nil {
+		return nil, err
+	}
+	positions := make([]*internalpb.MsgPosition, 0, len(values))
+	for _, value := range values {
+		position := &internalpb.MsgPosition{}
+		if err = proto.Unmarshal([]byte(value), position); err != nil {
+			log.Error("unmarshal cdc checkpoint failed", zap.String("name", s.name), zap.Error(err))
+			return nil, err
+		}
+		positions = append(positions, position)
+	}
+	return positions, nil
+}
+
+// save saves the checkpoints of the channels at once.
+func (s *checkpointStore) save(positions []*internalpb.MsgPosition) error {
+	kvs := make(map[string]string, len(positions))
+	for _, position := range positions {
+		value, err := proto.Marshal(position)
+		if err != nil {
+			return err
+		}
+		kvs[s.key(position.GetChannelName())] = string(value)
+	}
+	return s.kv.MultiSave(kvs)
+}
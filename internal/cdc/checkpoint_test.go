@@ -0,0 +1,72 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+func TestCheckpointStore(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	s1 := newCheckpointStore(kv, "r1")
+	s2 := newCheckpointStore(kv, "r10")
+
+	positions, err := s1.load()
+	assert.NoError(t, err)
+	assert.Empty(t, positions)
+
+	err = s1.save([]*internalpb.MsgPosition{
+		{ChannelName: "ch_0", MsgID: []byte{1}, Timestamp: 10},
+		{ChannelName: "ch_1", MsgID: []byte{2}, Timestamp: 10},
+	})
+	assert.NoError(t, err)
+	err = s2.save([]*internalpb.MsgPosition{{ChannelName: "ch_0", MsgID: []byte{3}, Timestamp: 5}})
+	assert.NoError(t, err)
+
+	err = s1.save([]*internalpb.MsgPosition{{ChannelName: "ch_0", MsgID: []byte{4}, Timestamp: 20}})
+	assert.NoError(t, err)
+
+	positions, err = s1.load()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(positions))
+	for _, position := range positions {
+		switch position.GetChannelName() {
+		case "ch_0":
+			assert.Equal(t, []byte{4}, position.GetMsgID())
+			assert.Equal(t, uint64(20), position.GetTimestamp())
+		case "ch_1":
+			assert.Equal(t, []byte{2}, position.GetMsgID())
+		default:
+			t.Fatalf("unexpected channel %s", position.GetChannelName())
+		}
+	}
+
+	positions, err = s2.load()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(positions))
+	assert.Equal(t, []byte{3}, positions[0].GetMsgID())
+
+	err = kv.Save(s1.key("ch_2"), "invalid")
+	assert.NoError(t, err)
+	_, err = s1.load()
+	assert.Error(t, err)
+}
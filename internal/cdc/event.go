@@ -0,0 +1,115 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+// ChangeEventVersion is the format version of the change events produced,
+// the events of a newer version are rejected since their meaning is unknown.
+const ChangeEventVersion int32 = 1
+
+// NewChangeEvent converts a message consumed from the dml channels into a change event,
+// false is returned if the message doesn't change the data or the schema, like the time tick.
+func NewChangeEvent(msg msgstream.TsMsg) (*internalpb.ChangeEvent, bool) {
+	event := &internalpb.ChangeEvent{
+		Version:   ChangeEventVersion,
+		MsgType:   msg.Type(),
+		Timestamp: msg.BeginTs(),
+	}
+	switch m := msg.(type) {
+	case *msgstream.InsertMsg:
+		event.Vchannel = m.GetShardName()
+		event.Payload = &internalpb.ChangeEvent_Insert{Insert: &m.InsertRequest}
+	case *msgstream.DeleteMsg:
+		event.Vchannel = m.GetShardName()
+		event.Payload = &internalpb.ChangeEvent_Delete{Delete: &m.DeleteRequest}
+	case *msgstream.CreateCollectionMsg:
+		event.Payload = &internalpb.ChangeEvent_CreateCollection{CreateCollection: &m.CreateCollectionRequest}
+	case *msgstream.DropCollectionMsg:
+		event.Payload = &internalpb.ChangeEvent_DropCollection{DropCollection: &m.DropCollectionRequest}
+	case *msgstream.CreatePartitionMsg:
+		event.Payload = &internalpb.ChangeEvent_CreatePartition{CreatePartition: &m.CreatePartitionRequest}
+	case *msgstream.DropPartitionMsg:
+		event.Payload = &internalpb.ChangeEvent_DropPartition{DropPartition: &m.DropPartitionRequest}
+	case *msgstream.TruncateMsg:
+		event.Payload = &internalpb.ChangeEvent_Truncate{Truncate: &m.TruncateRequest}
+	case *msgstream.AlterSchemaMsg:
+		event.Payload = &internalpb.ChangeEvent_AlterSchema{AlterSchema: &m.AlterSchemaRequest}
+	default:
+		return nil, false
+	}
+	return event, true
+}
+
+// isBroadcast tells if the event is broadcast to all the channels of the collection,
+// only one of the copies is replayed.
+func isBroadcast(event *internalpb.ChangeEvent) bool {
+	switch event.GetPayload().(type) {
+	case *internalpb.ChangeEvent_Insert, *internalpb.ChangeEvent_Delete:
+		return false
+	default:
+		return true
+	}
+}
+
+// getCollectionName returns the name of the collection changed by the event.
+func getCollectionName(event *internalpb.ChangeEvent) string {
+	switch payload := event.GetPayload().(type) {
+	case *internalpb.ChangeEvent_Insert:
+		return payload.Insert.GetCollectionName()
+	case *internalpb.ChangeEvent_Delete:
+		return payload.Delete.GetCollectionName()
+	case *internalpb.ChangeEvent_CreateCollection:
+		return payload.CreateCollection.GetCollectionName()
+	case *internalpb.ChangeEvent_DropCollection:
+		return payload.DropCollection.GetCollectionName()
+	case *internalpb.ChangeEvent_CreatePartition:
+		return payload.CreatePartition.GetCollectionName()
+	case *internalpb.ChangeEvent_DropPartition:
+		return payload.DropPartition.GetCollectionName()
+	case *internalpb.ChangeEvent_Truncate:
+		return payload.Truncate.GetCollectionName()
+	case *internalpb.ChangeEvent_AlterSchema:
+		return payload.AlterSchema.GetCollectionName()
+	default:
+		return ""
+	}
+}
+
+// MarshalChangeEvent serializes the change event.
+func MarshalChangeEvent(event *internalpb.ChangeEvent) ([]byte, error) {
+	return proto.Marshal(event)
+}
+
+// UnmarshalChangeEvent deserializes the change event, the events of a newer version are rejected.
+func UnmarshalChangeEvent(data []byte) (*internalpb.ChangeEvent, error) {
+	event := &internalpb.ChangeEvent{}
+	if err := proto.Unmarshal(data, event); err != nil {
+		return nil, err
+	}
+	if event.GetVersion() > ChangeEventVersion {
+		return nil, fmt.Errorf("unsupported change event version %d, the latest supported is %d", event.GetVersion(), ChangeEventVersion)
+	}
+	return event, nil
+}
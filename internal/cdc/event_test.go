@@ -0,0 +1,167 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+const testCollection = "test_cdc"
+
+func newTestSchema(t *testing.T) []byte {
+	schema := &schemapb.CollectionSchema{
+		Name: testCollection,
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, AutoID: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+		},
+	}
+	data, err := proto.Marshal(schema)
+	assert.NoError(t, err)
+	return data
+}
+
+func newCreateCollectionMsg(t *testing.T, ts uint64, vchannels ...string) *msgstream.CreateCollectionMsg {
+	return &msgstream.CreateCollectionMsg{
+		BaseMsg: msgstream.BaseMsg{BeginTimestamp: ts, EndTimestamp: ts},
+		CreateCollectionRequest: internalpb.CreateCollectionRequest{
+			Base:                &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection, Timestamp: ts},
+			CollectionName:      testCollection,
+			Schema:              newTestSchema(t),
+			VirtualChannelNames: vchannels,
+		},
+	}
+}
+
+func newInsertMsg(ts uint64, vchannel string, pks ...int64) *msgstream.InsertMsg {
+	vectors := make([]float32, 0, 2*len(pks))
+	for range pks {
+		vectors = append(vectors, 1, 1)
+	}
+	return &msgstream.InsertMsg{
+		BaseMsg: msgstream.BaseMsg{BeginTimestamp: ts, EndTimestamp: ts},
+		InsertRequest: internalpb.InsertRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert, Timestamp: ts},
+			ShardName:      vchannel,
+			CollectionName: testCollection,
+			PartitionName:  localDefaultPartitionName,
+			NumRows:        uint64(len(pks)),
+			Version:        internalpb.InsertDataVersion_ColumnBased,
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_Int64,
+					FieldName: "pk",
+					FieldId:   100,
+					Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+					}},
+				},
+				{
+					Type:      schemapb.DataType_FloatVector,
+					FieldName: "vec",
+					FieldId:   101,
+					Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+						Dim:  2,
+						Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: vectors}},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func newDeleteMsg(ts uint64, vchannel string, pks ...int64) *msgstream.DeleteMsg {
+	return &msgstream.DeleteMsg{
+		BaseMsg: msgstream.BaseMsg{BeginTimestamp: ts, EndTimestamp: ts},
+		DeleteRequest: internalpb.DeleteRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_Delete, Timestamp: ts},
+			ShardName:      vchannel,
+			CollectionName: testCollection,
+			NumRows:        int64(len(pks)),
+			PrimaryKeys: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}},
+			},
+		},
+	}
+}
+
+func newDropCollectionMsg(ts uint64) *msgstream.DropCollectionMsg {
+	return &msgstream.DropCollectionMsg{
+		BaseMsg: msgstream.BaseMsg{BeginTimestamp: ts, EndTimestamp: ts},
+		DropCollectionRequest: internalpb.DropCollectionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DropCollection, Timestamp: ts},
+			CollectionName: testCollection,
+		},
+	}
+}
+
+func TestNewChangeEvent(t *testing.T) {
+	event, ok := NewChangeEvent(newInsertMsg(10, "ch_0v0", 1, 2))
+	assert.True(t, ok)
+	assert.Equal(t, ChangeEventVersion, event.GetVersion())
+	assert.Equal(t, commonpb.MsgType_Insert, event.GetMsgType())
+	assert.Equal(t, "ch_0v0", event.GetVchannel())
+	assert.Equal(t, uint64(10), event.GetTimestamp())
+	assert.Equal(t, uint64(2), event.GetInsert().GetNumRows())
+	assert.False(t, isBroadcast(event))
+	assert.Equal(t, testCollection, getCollectionName(event))
+
+	event, ok = NewChangeEvent(newDeleteMsg(11, "ch_0v0", 1))
+	assert.True(t, ok)
+	assert.Equal(t, commonpb.MsgType_Delete, event.GetMsgType())
+	assert.False(t, isBroadcast(event))
+
+	event, ok = NewChangeEvent(newCreateCollectionMsg(t, 9, "ch_0v0"))
+	assert.True(t, ok)
+	assert.Equal(t, commonpb.MsgType_CreateCollection, event.GetMsgType())
+	assert.Equal(t, "", event.GetVchannel())
+	assert.True(t, isBroadcast(event))
+	assert.Equal(t, testCollection, getCollectionName(event))
+
+	_, ok = NewChangeEvent(&msgstream.TimeTickMsg{
+		TimeTickMsg: internalpb.TimeTickMsg{Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_TimeTick}},
+	})
+	assert.False(t, ok)
+}
+
+func TestMarshalChangeEvent(t *testing.T) {
+	event, ok := NewChangeEvent(newInsertMsg(10, "ch_0v0", 1, 2))
+	assert.True(t, ok)
+	data, err := MarshalChangeEvent(event)
+	assert.NoError(t, err)
+	got, err := UnmarshalChangeEvent(data)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(event, got))
+
+	event.Version = ChangeEventVersion + 1
+	data, err = MarshalChangeEvent(event)
+	assert.NoError(t, err)
+	_, err = UnmarshalChangeEvent(data)
+	assert.Error(t, err)
+
+	_, err = UnmarshalChangeEvent([]byte("invalid"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,477 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// localDefaultPartitionName is the default of common.defaultPartitionName
+const localDefaultPartitionName = "_default"
+
+type localCollection struct {
+	schema     *schemapb.CollectionSchema
+	shardsNum  int32
+	properties []*commonpb.KeyValuePair
+	// partition name -> primary keys of the entities
+	partitions map[string]map[interface{}]struct{}
+	// index name -> index
+	indexes map[string]*milvuspb.IndexDescription
+}
+
+// LocalTarget is an in-process target keeping the collections, partitions, indexes, aliases and primary keys
+// of the entities in memory, it's used to verify the replication without a target cluster.
+// Only `pk in [...]` is supported by Delete.
+type LocalTarget struct {
+	mu          sync.RWMutex
+	collections map[string]*localCollection
+	// alias -> collection name
+	aliases map[string]string
+}
+
+var _ Target = (*LocalTarget)(nil)
+
+// NewLocalTarget returns an empty in-process target.
+func NewLocalTarget() *LocalTarget {
+	return &LocalTarget{
+		collections: make(map[string]*localCollection),
+		aliases:     make(map[string]string),
+	}
+}
+
+func successStatus() *commonpb.Status {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+}
+
+func failStatus(code commonpb.ErrorCode, format string, args ...interface{}) *commonpb.Status {
+	return &commonpb.Status{ErrorCode: code, Reason: fmt.Sprintf(format, args...)}
+}
+
+func collectionNotExists(collectionName string) *commonpb.Status {
+	return failStatus(commonpb.ErrorCode_CollectionNotExists, "collection %s does not exist", collectionName)
+}
+
+// NumEntities returns the number of the entities in the collection.
+func (t *LocalTarget) NumEntities(collectionName string) int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	num := 0
+	if coll, ok := t.collections[collectionName]; ok {
+		for _, pks := range coll.partitions {
+			num += len(pks)
+		}
+	}
+	return num
+}
+
+// HasEntity tells if the entity of the primary key is in the collection.
+func (t *LocalTarget) HasEntity(collectionName string, pk interface{}) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if coll, ok := t.collections[collectionName]; ok {
+		for _, pks := range coll.partitions {
+			if _, ok = pks[pk]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (t *LocalTarget) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.collections[request.GetCollectionName()]; ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "collection %s already exists", request.GetCollectionName()), nil
+	}
+	schema := &schemapb.CollectionSchema{}
+	if err := proto.Unmarshal(request.GetSchema(), schema); err != nil {
+		return failStatus(commonpb.ErrorCode_IllegalArgument, "unmarshal schema failed, %s", err), nil
+	}
+	if _, err := typeutil.GetPrimaryFieldSchema(schema); err != nil {
+		return failStatus(commonpb.ErrorCode_IllegalArgument, err.Error()), nil
+	}
+	t.collections[request.GetCollectionName()] = &localCollection{
+		schema:     schema,
+		shardsNum:  request.GetShardsNum(),
+		properties: request.GetProperties(),
+		partitions: map[string]map[interface{}]struct{}{
+			localDefaultPartitionName: {},
+		},
+		indexes: make(map[string]*milvuspb.IndexDescription),
+	}
+	return successStatus(), nil
+}
+
+func (t *LocalTarget) DropCollection(ctx context.Context, request *milvuspb.DropCollectionRequest) (*commonpb.Status, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.collections[request.GetCollectionName()]; !ok {
+		return collectionNotExists(request.GetCollectionName()), nil
+	}
+	delete(t.collections, request.GetCollectionName())
+	for alias, collectionName := range t.aliases {
+		if collectionName == request.GetCollectionName() {
+			delete(t.aliases, alias)
+		}
+	}
+	return successStatus(), nil
+}
+
+func (t *LocalTarget) HasCollection(ctx context.Context, request *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	_, ok := t.collections[request.GetCollectionName()]
+	return &milvuspb.BoolResponse{Status: successStatus(), Value: ok}, nil
+}
+
+func (t *LocalTarget) DescribeCollection(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	coll, ok := t.collections[request.GetCollectionName()]
+	if !ok {
+		return &milvuspb.DescribeCollectionResponse{Status: collectionNotExists(request.GetCollectionName())}, nil
+	}
+	var aliases []string
+	for alias, collectionName := range t.aliases {
+		if collectionName == request.GetCollectionName() {
+			aliases = append(aliases, alias)
+		}
+	}
+	sort.Strings(aliases)
+	return &milvuspb.DescribeCollectionResponse{
+		Status:         successStatus(),
+		Schema:         proto.Clone(coll.schema).(*schemapb.CollectionSchema),
+		CollectionName: request.GetCollectionName(),
+		ShardsNum:      coll.shardsNum,
+		Aliases:        aliases,
+		Properties:     coll.properties,
+	}, nil
+}
+
+func (t *LocalTarget) TruncateCollection(ctx context.Context, request *milvuspb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	coll, ok := t.collections[request.GetCollectionName()]
+	if !ok {
+		return collectionNotExists(request.GetCollectionName()), nil
+	}
+	for name := range coll.partitions {
+		coll.partitions[name] = make(map[interface{}]struct{})
+	}
+	return successStatus(), nil
+}
+
+func (t *LocalTarget) AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	coll, ok := t.collections[request.GetCollectionName()]
+	if !ok {
+		return collectionNotExists(request.GetCollectionName()), nil
+	}
+	field := proto.Clone(request.GetField()).(*schemapb.FieldSchema)
+	for _, f := range coll.schema.GetFields() {
+		if f.GetName() == field.GetName() {
+			return failStatus(commonpb.ErrorCode_IllegalArgument, "field %s already exists", field.GetName()), nil
+		}
+		if f.GetFieldID() >= field.GetFieldID() {
+			field.FieldID = f.GetFieldID() + 1
+		}
+	}
+	coll.schema.Fields = append(coll.schema.Fields, field)
+	return successStatus(), nil
+}
+
+func (t *LocalTarget) DropField(ctx context.Context, request *milvuspb.DropFieldRequest) (*commonpb.Status, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	coll, ok := t.collections[request.GetCollectionName()]
+	if !ok {
+		return collectionNotExists(request.GetCollectionName()), nil
+	}
+	for i, f := range coll.schema.GetFields() {
+		if f.GetName() == request.GetFieldName() {
+			coll.schema.Fields = append(coll.schema.Fields[:i], coll.schema.Fields[i+1:]...)
+			return successStatus(), nil
+		}
+	}
+	return failStatus(commonpb.ErrorCode_IllegalArgument, "field %s does not exist", request.GetFieldName()), nil
+}
+
+func (t *LocalTarget) CreatePartition(ctx context.Context, request *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	coll, ok := t.collections[request.GetCollectionName()]
+	if !ok {
+		return collectionNotExists(request.GetCollectionName()), nil
+	}
+	if _, ok = coll.partitions[request.GetPartitionName()]; ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "partition %s already exists", request.GetPartitionName()), nil
+	}
+	coll.partitions[request.GetPartitionName()] = make(map[interface{}]struct{})
+	return successStatus(), nil
+}
+
+func (t *LocalTarget) DropPartition(ctx context.Context, request *milvuspb.DropPartitionRequest) (*commonpb.Status, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	coll, ok := t.collections[request.GetCollectionName()]
+	if !ok {
+		return collectionNotExists(request.GetCollectionName()), nil
+	}
+	if _, ok = coll.partitions[request.GetPartitionName()]; !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "partition %s does not exist", request.GetPartitionName()), nil
+	}
+	delete(coll.partitions, request.GetPartitionName())
+	return successStatus(), nil
+}
+
+func (t *LocalTarget) HasPartition(ctx context.Context, request *milvuspb.HasPartitionRequest) (*milvuspb.BoolResponse, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	coll, ok := t.collections[request.GetCollectionName()]
+	if !ok {
+		return &milvuspb.BoolResponse{Status: collectionNotExists(request.GetCollectionName())}, nil
+	}
+	_, ok = coll.partitions[request.GetPartitionName()]
+	return &milvuspb.BoolResponse{Status: successStatus(), Value: ok}, nil
+}
+
+func (t *LocalTarget) TruncatePartition(ctx context.Context, request *milvuspb.TruncatePartitionRequest) (*commonpb.Status, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	coll, ok := t.collections[request.GetCollectionName()]
+	if !ok {
+		return collectionNotExists(request.GetCollectionName()), nil
+	}
+	if _, ok = coll.partitions[request.GetPartitionName()]; !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "partition %s does not exist", request.GetPartitionName()), nil
+	}
+	coll.partitions[request.GetPartitionName()] = make(map[interface{}]struct{})
+	return successStatus(), nil
+}
+
+func (t *LocalTarget) CreateIndex(ctx context.Context, request *milvuspb.CreateIndexRequest) (*commonpb.Status, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	coll, ok := t.collections[request.GetCollectionName()]
+	if !ok {
+		return collectionNotExists(request.GetCollectionName()), nil
+	}
+	if getField(coll.schema, request.GetFieldName()) == nil {
+		return failStatus(commonpb.ErrorCode_IllegalArgument, "field %s does not exist", request.GetFieldName()), nil
+	}
+	for _, index := range coll.indexes {
+		if index.GetIndexName() == request.GetIndexName() || index.GetFieldName() == request.GetFieldName() {
+			return failStatus(commonpb.ErrorCode_UnexpectedError, "index %s already exists", index.GetIndexName()), nil
+		}
+	}
+	coll.indexes[request.GetIndexName()] = &milvuspb.IndexDescription{
+		IndexName: request.GetIndexName(),
+		Params:    request.GetExtraParams(),
+		FieldName: request.GetFieldName(),
+	}
+	return successStatus(), nil
+}
+
+func (t *LocalTarget) DescribeIndex(ctx context.Context, request *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	coll, ok := t.collections[request.GetCollectionName()]
+	if !ok {
+		return &milvuspb.DescribeIndexResponse{Status: collectionNotExists(request.GetCollectionName())}, nil
+	}
+	var indexes []*milvuspb.IndexDescription
+	for _, index := range coll.indexes {
+		if request.GetIndexName() == "" || request.GetIndexName() == index.GetIndexName() {
+			indexes = append(indexes, proto.Clone(index).(*milvuspb.IndexDescription))
+		}
+	}
+	if len(indexes) == 0 {
+		return &milvuspb.DescribeIndexResponse{Status: failStatus(commonpb.ErrorCode_IndexNotExist, "index not exist")}, nil
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i].GetIndexName() < indexes[j].GetIndexName()
+	})
+	return &milvuspb.DescribeIndexResponse{Status: successStatus(), IndexDescriptions: indexes}, nil
+}
+
+func (t *LocalTarget) DropIndex(ctx context.Context, request *milvuspb.DropIndexRequest) (*commonpb.Status, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	coll, ok := t.collections[request.GetCollectionName()]
+	if !ok {
+		return collectionNotExists(request.GetCollectionName()), nil
+	}
+	if _, ok = coll.indexes[request.GetIndexName()]; !ok {
+		return failStatus(commonpb.ErrorCode_IndexNotExist, "index %s does not exist", request.GetIndexName()), nil
+	}
+	delete(coll.indexes, request.GetIndexName())
+	return successStatus(), nil
+}
+
+func (t *LocalTarget) CreateAlias(ctx context.Context, request *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.collections[request.GetCollectionName()]; !ok {
+		return collectionNotExists(request.GetCollectionName()), nil
+	}
+	if _, ok := t.aliases[request.GetAlias()]; ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "alias %s already exists", request.GetAlias()), nil
+	}
+	if _, ok := t.collections[request.GetAlias()]; ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "alias %s collides with an existing collection", request.GetAlias()), nil
+	}
+	t.aliases[request.GetAlias()] = request.GetCollectionName()
+	return successStatus(), nil
+}
+
+func (t *LocalTarget) DropAlias(ctx context.Context, request *milvuspb.DropAliasRequest) (*commonpb.Status, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.aliases[request.GetAlias()]; !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "alias %s does not exist", request.GetAlias()), nil
+	}
+	delete(t.aliases, request.GetAlias())
+	return successStatus(), nil
+}
+
+func (t *LocalTarget) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasRequest) (*commonpb.Status, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.collections[request.GetCollectionName()]; !ok {
+		return collectionNotExists(request.GetCollectionName()), nil
+	}
+	if _, ok := t.aliases[request.GetAlias()]; !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "alias %s does not exist", request.GetAlias()), nil
+	}
+	t.aliases[request.GetAlias()] = request.GetCollectionName()
+	return successStatus(), nil
+}
+
+// getPrimaryKeys returns the primary keys in the field data.
+func getPrimaryKeys(fieldData *schemapb.FieldData) ([]interface{}, error) {
+	var pks []interface{}
+	switch fieldData.GetType() {
+	case schemapb.DataType_Int64:
+		for _, pk := range fieldData.GetScalars().GetLongData().GetData() {
+			pks = append(pks, pk)
+		}
+	case schemapb.DataType_VarChar:
+		for _, pk := range fieldData.GetScalars().GetStringData().GetData() {
+			pks = append(pks, pk)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported primary key type %s", fieldData.GetType())
+	}
+	return pks, nil
+}
+
+func (t *LocalTarget) Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	coll, ok := t.collections[request.GetCollectionName()]
+	if !ok {
+		return &milvuspb.MutationResult{Status: collectionNotExists(request.GetCollectionName())}, nil
+	}
+	partitionName := request.GetPartitionName()
+	if partitionName == "" {
+		partitionName = localDefaultPartitionName
+	}
+	pks, ok := coll.partitions[partitionName]
+	if !ok {
+		return &milvuspb.MutationResult{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "partition %s does not exist", partitionName),
+		}, nil
+	}
+	pkField, _ := typeutil.GetPrimaryFieldSchema(coll.schema)
+	if pkField.GetAutoID() {
+		return &milvuspb.MutationResult{
+			Status: failStatus(commonpb.ErrorCode_IllegalArgument, "the primary key of collection %s is auto generated", request.GetCollectionName()),
+		}, nil
+	}
+	var insertedPKs []interface{}
+	for _, fieldData := range request.GetFieldsData() {
+		if fieldData.GetFieldName() == pkField.GetName() {
+			var err error
+			if insertedPKs, err = getPrimaryKeys(fieldData); err != nil {
+				return &milvuspb.MutationResult{Status: failStatus(commonpb.ErrorCode_IllegalArgument, err.Error())}, nil
+			}
+		}
+	}
+	if uint32(len(insertedPKs)) != request.GetNumRows() {
+		return &milvuspb.MutationResult{
+			Status: failStatus(commonpb.ErrorCode_IllegalArgument, "the number of primary keys %d doesn't match the number of rows %d",
+				len(insertedPKs), request.GetNumRows()),
+		}, nil
+	}
+	for _, pk := range insertedPKs {
+		pks[pk] = struct{}{}
+	}
+	return &milvuspb.MutationResult{Status: successStatus(), InsertCnt: int64(len(insertedPKs))}, nil
+}
+
+func (t *LocalTarget) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	coll, ok := t.collections[request.GetCollectionName()]
+	if !ok {
+		return &milvuspb.MutationResult{Status: collectionNotExists(request.GetCollectionName())}, nil
+	}
+	plan, err := planparserv2.CreateRetrievePlan(coll.schema, request.GetExpr())
+	if err != nil {
+		return &milvuspb.MutationResult{Status: failStatus(commonpb.ErrorCode_IllegalArgument, err.Error())}, nil
+	}
+	termExpr := plan.GetPredicates().GetTermExpr()
+	if termExpr == nil || !termExpr.GetColumnInfo().GetIsPrimaryKey() {
+		return &milvuspb.MutationResult{
+			Status: failStatus(commonpb.ErrorCode_IllegalArgument, "only `pk in [...]` is supported, expr: %s", request.GetExpr()),
+		}, nil
+	}
+	var deleted int64
+	for name, pks := range coll.partitions {
+		if request.GetPartitionName() != "" && request.GetPartitionName() != name {
+			continue
+		}
+		for _, value := range termExpr.GetValues() {
+			var pk interface{}
+			switch v := value.GetVal().(type) {
+			case *planpb.GenericValue_Int64Val:
+				pk = v.Int64Val
+			case *planpb.GenericValue_StringVal:
+				pk = v.StringVal
+			}
+			if _, ok = pks[pk]; ok {
+				delete(pks, pk)
+				deleted++
+			}
+		}
+	}
+	return &milvuspb.MutationResult{Status: successStatus(), DeleteCnt: deleted}, nil
+}
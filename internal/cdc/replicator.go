@@ -0,0 +1,343 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+const (
+	defaultRetryAttempts    = 10
+	defaultRestartInterval  = 10 * time.Second
+	defaultMetaSyncInterval = time.Minute
+)
+
+// Config is the config of a replication.
+type Config struct {
+	// Name identifies the replication, the checkpoints and the subscription of each replication are separated
+	Name string
+	// PChannels are the dml channels of the source cluster, see DmlPChannels
+	PChannels []string
+	// Collections are the names of the collections replicated, all the collections are replicated if it's empty
+	Collections []string
+	// InitialPosition is where the replication starts if there is no checkpoint
+	InitialPosition mqwrapper.SubscriptionInitialPosition
+	// RetryAttempts is the times a change event is retried before the replication restarts, 10 by default
+	RetryAttempts uint
+	// RestartInterval is how long the replication waits before it restarts from the checkpoints after failures,
+	// 10 seconds by default
+	RestartInterval time.Duration
+	// MetaSyncInterval is how often the indexes and the aliases are synchronized from the source, 1 minute by default
+	MetaSyncInterval time.Duration
+}
+
+// DmlPChannels returns the names of the dml channels created by rootcoord, the ddl is broadcast to them as well.
+func DmlPChannels(prefix string, num int64) []string {
+	channels := make([]string, 0, num)
+	for i := int64(0); i < num; i++ {
+		channels = append(channels, fmt.Sprintf("%s_%d", prefix, i))
+	}
+	return channels
+}
+
+// Replicator captures the changes of the source cluster from the dml channels and replays them into the target.
+//
+// The dml channels are consumed by a single time tick stream, so that the change events of all the channels
+// are replayed in the order of their timestamps, the checkpoints are saved after each time tick is replayed.
+// The replication restarts from the checkpoints if a change event can't be replayed, until it's stopped.
+//
+// The source is optional. Without it, the replication starts from the initial position and the collections
+// created before are not replicated, neither are the indexes and the aliases, which are not carried by the change events.
+type Replicator struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	cfg         Config
+	factory     msgstream.Factory
+	stream      msgstream.MsgStream
+	checkpoints *checkpointStore
+	source      Source
+	target      Target
+	sink        *Sink
+	collections map[string]struct{}
+	// the collections of the source are copied before replaying if there is no checkpoint
+	initialSync bool
+
+	errMu sync.RWMutex
+	err   error
+
+	// vchannel -> collection name, the lag is reported for each vchannel replayed
+	vchannels map[string]string
+}
+
+// NewReplicator returns the replicator from the dml channels of the source cluster to the target,
+// the checkpoints are saved in kv. The source can be nil.
+func NewReplicator(ctx context.Context, factory msgstream.Factory, kv kv.BaseKV, source Source, target Target, cfg Config) (*Replicator, error) {
+	if cfg.Name == "" {
+		return nil, errors.New("the name of the replication is empty")
+	}
+	if len(cfg.PChannels) == 0 {
+		return nil, errors.New("no dml channel to replicate")
+	}
+	if cfg.RetryAttempts == 0 {
+		cfg.RetryAttempts = defaultRetryAttempts
+	}
+	if cfg.RestartInterval <= 0 {
+		cfg.RestartInterval = defaultRestartInterval
+	}
+	if cfg.MetaSyncInterval <= 0 {
+		cfg.MetaSyncInterval = defaultMetaSyncInterval
+	}
+	ctx1, cancel := context.WithCancel(ctx)
+	r := &Replicator{
+		ctx:         ctx1,
+		cancel:      cancel,
+		cfg:         cfg,
+		factory:     factory,
+		checkpoints: newCheckpointStore(kv, cfg.Name),
+		source:      source,
+		target:      target,
+		sink:        NewSink(target),
+		collections: make(map[string]struct{}),
+		vchannels:   make(map[string]string),
+	}
+	for _, name := range cfg.Collections {
+		r.collections[name] = struct{}{}
+	}
+	return r, nil
+}
+
+// Start subscribes the dml channels and starts replaying, the replication resumes from the checkpoints if any.
+func (r *Replicator) Start() error {
+	if err := r.subscribe(); err != nil {
+		return err
+	}
+	r.wg.Add(1)
+	go r.work()
+	if r.source != nil {
+		r.wg.Add(1)
+		go r.syncMetaLoop()
+	}
+	return nil
+}
+
+// subscribe subscribes the dml channels from the checkpoints, or from the initial position if there is no checkpoint,
+// in which case the collections of the source are copied before replaying.
+func (r *Replicator) subscribe() error {
+	stream, err := r.factory.NewTtMsgStream(r.ctx)
+	if err != nil {
+		return err
+	}
+	positions, err := r.checkpoints.load()
+	if err != nil {
+		stream.Close()
+		return err
+	}
+	subName := fmt.Sprintf("cdc-%s", r.cfg.Name)
+	stream.AsConsumerWithPosition(r.cfg.PChannels, subName, r.cfg.InitialPosition)
+	if len(positions) > 0 {
+		if err = stream.Seek(positions); err != nil {
+			log.Error("cdc seek to checkpoints failed", zap.String("name", r.cfg.Name), zap.Error(err))
+			stream.Close()
+			return err
+		}
+	}
+	stream.Start()
+	r.stream = stream
+	r.initialSync = len(positions) == 0 && r.source != nil
+	log.Info("cdc replication started", zap.String("name", r.cfg.Name), zap.Strings("pchannels", r.cfg.PChannels),
+		zap.Int("checkpoints", len(positions)), zap.Bool("initialSync", r.initialSync))
+	return nil
+}
+
+// Stop stops replaying, the changes after the last checkpoint are replayed again once it restarts.
+func (r *Replicator) Stop() {
+	r.cancel()
+	r.wg.Wait()
+	if r.stream != nil {
+		r.stream.Close()
+	}
+	for vchannel := range r.vchannels {
+		metrics.CDCReplicationLag.DeleteLabelValues(r.cfg.Name, vchannel)
+	}
+	log.Info("cdc replication stopped", zap.String("name", r.cfg.Name))
+}
+
+// Err returns why the replication failed if it's restarting, or nil once a time tick is replayed again.
+func (r *Replicator) Err() error {
+	r.errMu.RLock()
+	defer r.errMu.RUnlock()
+	return r.err
+}
+
+func (r *Replicator) setErr(err error) {
+	r.errMu.Lock()
+	defer r.errMu.Unlock()
+	r.err = err
+}
+
+func (r *Replicator) work() {
+	defer r.wg.Done()
+	for {
+		err := r.run()
+		if err == nil || r.ctx.Err() != nil {
+			return
+		}
+		// the lag keeps growing until the replication catches up after restart
+		r.setErr(err)
+		metrics.CDCReplicationFailureCounter.WithLabelValues(r.cfg.Name).Inc()
+		log.Error("cdc replication failed, restart from the checkpoints", zap.String("name", r.cfg.Name),
+			zap.Duration("interval", r.cfg.RestartInterval), zap.Error(err))
+		if !r.restart() {
+			return
+		}
+	}
+}
+
+// run replays the packs until the replication is stopped or fails.
+func (r *Replicator) run() error {
+	if r.initialSync {
+		if err := r.copyCollections(); err != nil {
+			return fmt.Errorf("initial sync failed, %w", err)
+		}
+		r.initialSync = false
+	}
+	for {
+		select {
+		case <-r.ctx.Done():
+			return nil
+		case pack, ok := <-r.stream.Chan():
+			if !ok {
+				return errors.New("cdc stream closed")
+			}
+			if err := r.replay(pack); err != nil {
+				return err
+			}
+			r.setErr(nil)
+		}
+	}
+}
+
+// restart subscribes the dml channels again after the restart interval, until it succeeds or the replication is stopped.
+// The sink is renewed, since the target may be changed by others in the meantime.
+func (r *Replicator) restart() bool {
+	r.stream.Close()
+	r.stream = nil
+	r.sink = NewSink(r.target)
+	for {
+		select {
+		case <-r.ctx.Done():
+			return false
+		case <-time.After(r.cfg.RestartInterval):
+		}
+		err := r.subscribe()
+		if err == nil {
+			return true
+		}
+		r.setErr(err)
+		log.Warn("cdc subscribe failed", zap.String("name", r.cfg.Name), zap.Error(err))
+	}
+}
+
+// convert converts the messages of the pack into the change events in the order of their timestamps,
+// the broadcast ddl is kept once.
+func (r *Replicator) convert(pack *msgstream.MsgPack) []*internalpb.ChangeEvent {
+	events := make([]*internalpb.ChangeEvent, 0, len(pack.Msgs))
+	broadcast := make(map[string]struct{})
+	for _, msg := range pack.Msgs {
+		event, ok := NewChangeEvent(msg)
+		if !ok {
+			continue
+		}
+		collectionName := getCollectionName(event)
+		if _, ok = r.collections[collectionName]; len(r.collections) > 0 && !ok {
+			continue
+		}
+		if isBroadcast(event) {
+			key := fmt.Sprintf("%s-%s-%d", event.GetMsgType(), collectionName, event.GetTimestamp())
+			if _, ok = broadcast[key]; ok {
+				continue
+			}
+			broadcast[key] = struct{}{}
+		}
+		events = append(events, event)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].GetTimestamp() < events[j].GetTimestamp()
+	})
+	return events
+}
+
+// replay replays the change events of the pack and saves the end positions as the checkpoints.
+func (r *Replicator) replay(pack *msgstream.MsgPack) error {
+	for _, event := range r.convert(pack) {
+		err := retry.Do(r.ctx, func() error {
+			return r.sink.Apply(r.ctx, event)
+		}, retry.Attempts(r.cfg.RetryAttempts))
+		if err != nil {
+			return fmt.Errorf("replay %s event of collection %s at %d failed, %w",
+				event.GetMsgType(), getCollectionName(event), event.GetTimestamp(), err)
+		}
+		metrics.CDCReplicatedEventCounter.WithLabelValues(r.cfg.Name, event.GetMsgType().String()).Inc()
+		r.trackVChannels(event)
+	}
+	if err := r.checkpoints.save(pack.EndPositions); err != nil {
+		return err
+	}
+
+	physical, _ := tsoutil.ParseTS(pack.EndTs)
+	lag := float64(time.Since(physical).Milliseconds())
+	for vchannel := range r.vchannels {
+		metrics.CDCReplicationLag.WithLabelValues(r.cfg.Name, vchannel).Set(lag)
+	}
+	return nil
+}
+
+func (r *Replicator) trackVChannels(event *internalpb.ChangeEvent) {
+	switch payload := event.GetPayload().(type) {
+	case *internalpb.ChangeEvent_Insert, *internalpb.ChangeEvent_Delete:
+		r.vchannels[event.GetVchannel()] = getCollectionName(event)
+	case *internalpb.ChangeEvent_CreateCollection:
+		for _, vchannel := range payload.CreateCollection.GetVirtualChannelNames() {
+			r.vchannels[vchannel] = payload.CreateCollection.GetCollectionName()
+		}
+	case *internalpb.ChangeEvent_DropCollection:
+		for vchannel, collectionName := range r.vchannels {
+			if collectionName == payload.DropCollection.GetCollectionName() {
+				delete(r.vchannels, vchannel)
+				metrics.CDCReplicationLag.DeleteLabelValues(r.cfg.Name, vchannel)
+			}
+		}
+	}
+}
@@ -0,0 +1,335 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/common"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+type mockMsgStream struct {
+	msgstream.MsgStream
+	ch       chan *msgstream.MsgPack
+	channels []string
+	seeked   []*internalpb.MsgPosition
+}
+
+func (m *mockMsgStream) AsConsumerWithPosition(channels []string, subName string, position mqwrapper.SubscriptionInitialPosition) {
+	m.channels = channels
+}
+
+func (m *mockMsgStream) Seek(positions []*internalpb.MsgPosition) error {
+	m.seeked = positions
+	return nil
+}
+
+func (m *mockMsgStream) Chan() <-chan *msgstream.MsgPack {
+	return m.ch
+}
+
+func (m *mockMsgStream) Start() {}
+
+func (m *mockMsgStream) Close() {}
+
+type mockMsgStreamFactory struct {
+	msgstream.Factory
+	stream *mockMsgStream
+	err    error
+}
+
+func (f *mockMsgStreamFactory) NewTtMsgStream(ctx context.Context) (msgstream.MsgStream, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.stream, nil
+}
+
+func newMsgPack(ts uint64, msgs ...msgstream.TsMsg) *msgstream.MsgPack {
+	return &msgstream.MsgPack{
+		EndTs: ts,
+		Msgs:  msgs,
+		EndPositions: []*internalpb.MsgPosition{
+			{ChannelName: "ch_0", MsgID: []byte{byte(ts)}, Timestamp: ts},
+			{ChannelName: "ch_1", MsgID: []byte{byte(ts)}, Timestamp: ts},
+		},
+	}
+}
+
+func TestDmlPChannels(t *testing.T) {
+	assert.Equal(t, []string{"dml_0", "dml_1"}, DmlPChannels("dml", 2))
+}
+
+func TestNewReplicator(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewReplicator(ctx, &mockMsgStreamFactory{}, memkv.NewMemoryKV(), nil, NewLocalTarget(), Config{PChannels: []string{"ch_0"}})
+	assert.Error(t, err)
+	_, err = NewReplicator(ctx, &mockMsgStreamFactory{}, memkv.NewMemoryKV(), nil, NewLocalTarget(), Config{Name: "r1"})
+	assert.Error(t, err)
+
+	r, err := NewReplicator(ctx, &mockMsgStreamFactory{err: errors.New("mock")}, memkv.NewMemoryKV(), nil, NewLocalTarget(),
+		Config{Name: "r1", PChannels: []string{"ch_0"}})
+	assert.NoError(t, err)
+	assert.Error(t, r.Start())
+}
+
+func TestReplicator(t *testing.T) {
+	ctx := context.Background()
+	kv := memkv.NewMemoryKV()
+	target := NewLocalTarget()
+	stream := &mockMsgStream{ch: make(chan *msgstream.MsgPack, 10)}
+	cfg := Config{
+		Name:          "r1",
+		PChannels:     []string{"ch_0", "ch_1"},
+		Collections:   []string{testCollection},
+		RetryAttempts: 1,
+	}
+	r, err := NewReplicator(ctx, &mockMsgStreamFactory{stream: stream}, kv, nil, target, cfg)
+	assert.NoError(t, err)
+	assert.NoError(t, r.Start())
+	assert.Equal(t, cfg.PChannels, stream.channels)
+	assert.Empty(t, stream.seeked)
+
+	now := tsoutil.ComposeTSByTime(time.Now(), 0)
+	other := newInsertMsg(now+3, "ch_1v1", 100)
+	other.CollectionName = "other"
+	// the ddl is broadcast to both channels, the messages of different channels are out of order in the pack
+	stream.ch <- newMsgPack(now+3,
+		newInsertMsg(now+2, "ch_0v0", 1, 2),
+		newCreateCollectionMsg(t, now+1, "ch_0v0", "ch_1v0"),
+		newCreateCollectionMsg(t, now+1, "ch_0v0", "ch_1v0"),
+		newInsertMsg(now+3, "ch_1v0", 3),
+		other)
+	stream.ch <- newMsgPack(now+5, newDeleteMsg(now+4, "ch_0v0", 1))
+
+	assert.Eventually(t, func() bool {
+		positions, err := newCheckpointStore(kv, "r1").load()
+		return err == nil && len(positions) == 2 && positions[0].GetTimestamp() == now+5
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 2, target.NumEntities(testCollection))
+	assert.True(t, target.HasEntity(testCollection, int64(3)))
+	assert.False(t, target.HasEntity(testCollection, int64(1)))
+	assert.ElementsMatch(t, []string{"ch_0v0", "ch_1v0"}, func() []string {
+		var vchannels []string
+		for vchannel := range r.vchannels {
+			vchannels = append(vchannels, vchannel)
+		}
+		return vchannels
+	}())
+
+	stream.ch <- newMsgPack(now+7, newInsertMsg(now+6, "ch_0v0", 4), newDropCollectionMsg(now+6))
+	// the replication fails since the collection is dropped, the checkpoints are kept to restart from
+	stream.ch <- newMsgPack(now+8, newDeleteMsg(now+8, "ch_0v0", 2))
+	assert.Eventually(t, func() bool {
+		return r.Err() != nil
+	}, 10*time.Second, 10*time.Millisecond)
+	positions, err := newCheckpointStore(kv, "r1").load()
+	assert.NoError(t, err)
+	assert.Equal(t, now+7, positions[0].GetTimestamp())
+	assert.Empty(t, r.vchannels)
+	r.Stop()
+
+	// resumes from the checkpoints
+	stream = &mockMsgStream{ch: make(chan *msgstream.MsgPack, 10)}
+	r, err = NewReplicator(ctx, &mockMsgStreamFactory{stream: stream}, kv, nil, target, cfg)
+	assert.NoError(t, err)
+	assert.NoError(t, r.Start())
+	assert.Equal(t, 2, len(stream.seeked))
+	r.Stop()
+}
+
+// failingTarget fails the first inserts
+type failingTarget struct {
+	*LocalTarget
+	failures int32
+}
+
+func (t *failingTarget) Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	if atomic.AddInt32(&t.failures, -1) >= 0 {
+		return nil, errors.New("mock")
+	}
+	return t.LocalTarget.Insert(ctx, request)
+}
+
+func TestReplicator_restart(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	target := &failingTarget{LocalTarget: NewLocalTarget(), failures: 1}
+	stream := &mockMsgStream{ch: make(chan *msgstream.MsgPack, 10)}
+	r, err := NewReplicator(context.Background(), &mockMsgStreamFactory{stream: stream}, kv, nil, target, Config{
+		Name:            "r1",
+		PChannels:       []string{"ch_0", "ch_1"},
+		RetryAttempts:   1,
+		RestartInterval: 10 * time.Millisecond,
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, r.Start())
+	defer r.Stop()
+
+	now := tsoutil.ComposeTSByTime(time.Now(), 0)
+	stream.ch <- newMsgPack(now+1, newCreateCollectionMsg(t, now+1, "ch_0v0", "ch_1v0"))
+	insertPack := newMsgPack(now+2, newInsertMsg(now+2, "ch_0v0", 1))
+	stream.ch <- insertPack
+	assert.Eventually(t, func() bool {
+		return r.Err() != nil
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// the stream redelivers the messages after the checkpoints
+	stream.ch <- insertPack
+	assert.Eventually(t, func() bool {
+		return r.Err() == nil
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 2, len(stream.seeked))
+	assert.Equal(t, now+1, stream.seeked[0].GetTimestamp())
+	assert.True(t, target.HasEntity(testCollection, int64(1)))
+}
+
+// mockSource is a source cluster with the test collection
+type mockSource struct {
+	mu         sync.Mutex
+	schema     *schemapb.CollectionSchema
+	aliases    []string
+	partitions []string
+	indexes    []*milvuspb.IndexDescription
+	// partition name -> primary keys
+	entities map[string][]int64
+}
+
+func newMockSource(t *testing.T) *mockSource {
+	schema := &schemapb.CollectionSchema{}
+	assert.NoError(t, proto.Unmarshal(newTestSchema(t), schema))
+	return &mockSource{
+		schema:     schema,
+		aliases:    []string{"a1"},
+		partitions: []string{localDefaultPartitionName, "p1"},
+		indexes: []*milvuspb.IndexDescription{
+			{IndexName: "idx", FieldName: "vec", Params: []*commonpb.KeyValuePair{{Key: "index_type", Value: "FLAT"}}},
+		},
+		entities: map[string][]int64{localDefaultPartitionName: {1, 2}, "p1": {3}},
+	}
+}
+
+func (s *mockSource) ShowCollections(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
+	return &milvuspb.ShowCollectionsResponse{Status: successStatus(), CollectionNames: []string{testCollection}}, nil
+}
+
+func (s *mockSource) DescribeCollection(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &milvuspb.DescribeCollectionResponse{
+		Status:         successStatus(),
+		Schema:         s.schema,
+		CollectionName: testCollection,
+		ShardsNum:      2,
+		Aliases:        s.aliases,
+	}, nil
+}
+
+func (s *mockSource) ShowPartitions(ctx context.Context, request *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error) {
+	return &milvuspb.ShowPartitionsResponse{Status: successStatus(), PartitionNames: s.partitions}, nil
+}
+
+func (s *mockSource) DescribeIndex(ctx context.Context, request *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.indexes) == 0 {
+		return &milvuspb.DescribeIndexResponse{Status: failStatus(commonpb.ErrorCode_IndexNotExist, "index not exist")}, nil
+	}
+	return &milvuspb.DescribeIndexResponse{Status: successStatus(), IndexDescriptions: s.indexes}, nil
+}
+
+func (s *mockSource) QueryStream(ctx context.Context, request *milvuspb.QueryRequest, send func(*milvuspb.QueryResults) error) error {
+	pks := s.entities[request.GetPartitionNames()[0]]
+	if len(pks) == 0 {
+		return send(&milvuspb.QueryResults{Status: failStatus(commonpb.ErrorCode_EmptyCollection, "empty collection")})
+	}
+	// the rows of the same primary key are merged
+	msg := newInsertMsg(0, "", append(pks, pks[0])...)
+	if err := send(&milvuspb.QueryResults{FieldsData: msg.GetFieldsData()}); err != nil {
+		return err
+	}
+	return send(&milvuspb.QueryResults{Status: successStatus()})
+}
+
+func TestReplicator_initialSync(t *testing.T) {
+	ctx := context.Background()
+	kv := memkv.NewMemoryKV()
+	source := newMockSource(t)
+	target := NewLocalTarget()
+	stream := &mockMsgStream{ch: make(chan *msgstream.MsgPack, 10)}
+	r, err := NewReplicator(ctx, &mockMsgStreamFactory{stream: stream}, kv, source, target, Config{
+		Name:             "r1",
+		PChannels:        []string{"ch_0", "ch_1"},
+		MetaSyncInterval: 10 * time.Millisecond,
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, r.Start())
+	defer r.Stop()
+
+	// the entities copied are replayed again
+	now := tsoutil.ComposeTSByTime(time.Now(), 0)
+	stream.ch <- newMsgPack(now+1, newInsertMsg(now+1, "ch_0v0", 1, 4))
+	assert.Eventually(t, func() bool {
+		positions, err := newCheckpointStore(kv, "r1").load()
+		return err == nil && len(positions) == 2
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 4, target.NumEntities(testCollection))
+	assert.True(t, target.HasEntity(testCollection, int64(3)))
+
+	resp, err := target.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{CollectionName: testCollection})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), resp.GetShardsNum())
+	assert.Equal(t, []string{"a1"}, resp.GetAliases())
+	assert.ElementsMatch(t, []*commonpb.KeyValuePair{
+		{Key: common.EnforceUniquePKKey, Value: "true"},
+		{Key: common.UniquePKConflictPolicyKey, Value: common.UniquePKConflictUpsert},
+	}, resp.GetProperties())
+	has, err := target.HasPartition(ctx, &milvuspb.HasPartitionRequest{CollectionName: testCollection, PartitionName: "p1"})
+	assert.NoError(t, err)
+	assert.True(t, has.GetValue())
+	indexes, err := describeIndexes(ctx, target, testCollection)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(indexes))
+	assert.Equal(t, "idx", indexes[0].GetIndexName())
+
+	// the indexes and the aliases are synchronized periodically
+	source.mu.Lock()
+	source.aliases = []string{"a2"}
+	source.indexes = nil
+	source.mu.Unlock()
+	assert.Eventually(t, func() bool {
+		resp, err := target.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{CollectionName: testCollection})
+		indexes, err2 := describeIndexes(ctx, target, testCollection)
+		return err == nil && err2 == nil && len(resp.GetAliases()) == 1 && resp.GetAliases()[0] == "a2" && len(indexes) == 0
+	}, 10*time.Second, 10*time.Millisecond)
+}
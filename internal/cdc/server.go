@@ -0,0 +1,171 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/milvus-io/milvus/internal/common"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// Params is the param table of the cdc server
+var Params paramtable.ComponentParam
+
+const dialTimeout = 30 * time.Second
+
+// Server runs the replication configured by the cdc section of milvus.yaml, from the cluster it's deployed in
+// to the target cluster. The dml channels and the etcd keeping the checkpoints are the ones of the source cluster.
+type Server struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	factory    dependency.Factory
+	etcdCli    *clientv3.Client
+	sourceConn *grpc.ClientConn
+	targetConn *grpc.ClientConn
+	replicator *Replicator
+
+	stateCode atomic.Value
+}
+
+// NewServer returns the cdc server.
+func NewServer(ctx context.Context, factory dependency.Factory) (*Server, error) {
+	ctx1, cancel := context.WithCancel(ctx)
+	s := &Server{
+		ctx:     ctx1,
+		cancel:  cancel,
+		factory: factory,
+	}
+	s.stateCode.Store(internalpb.StateCode_Abnormal)
+	return s, nil
+}
+
+func dial(ctx context.Context, address string) (*grpc.ClientConn, error) {
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+	return grpc.DialContext(ctx, address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock(),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(paramtable.DefaultClientMaxRecvSize),
+			grpc.MaxCallSendMsgSize(paramtable.DefaultClientMaxSendSize)))
+}
+
+func (s *Server) init() error {
+	cfg := Params.CDCCfg
+	if cfg.TargetAddress == "" {
+		return errors.New("cdc.target.address is empty")
+	}
+	s.factory.Init(&Params)
+
+	etcdCli, err := etcd.GetEtcdClient(&Params.EtcdCfg)
+	if err != nil {
+		return err
+	}
+	s.etcdCli = etcdCli
+
+	var source Source
+	if cfg.SourceAddress != "" {
+		if s.sourceConn, err = dial(s.ctx, cfg.SourceAddress); err != nil {
+			return err
+		}
+		source = NewClientSource(milvuspb.NewMilvusServiceClient(s.sourceConn))
+	}
+	if s.targetConn, err = dial(s.ctx, cfg.TargetAddress); err != nil {
+		return err
+	}
+	target := NewClientTarget(milvuspb.NewMilvusServiceClient(s.targetConn))
+
+	s.replicator, err = NewReplicator(s.ctx, s.factory, etcdkv.NewEtcdKV(etcdCli, Params.EtcdCfg.MetaRootPath), source, target, Config{
+		Name:             cfg.Name,
+		PChannels:        DmlPChannels(Params.CommonCfg.RootCoordDml, Params.RootCoordCfg.DmlChannelNum),
+		Collections:      cfg.Collections,
+		InitialPosition:  mqwrapper.SubscriptionPositionLatest,
+		RetryAttempts:    cfg.RetryAttempts,
+		RestartInterval:  cfg.RestartInterval,
+		MetaSyncInterval: cfg.MetaSyncInterval,
+	})
+	return err
+}
+
+// Run connects to the clusters and starts the replication.
+func (s *Server) Run() error {
+	if err := s.init(); err != nil {
+		log.Error("cdc init failed", zap.Error(err))
+		return err
+	}
+	if err := s.replicator.Start(); err != nil {
+		log.Error("cdc start failed", zap.Error(err))
+		return err
+	}
+	s.stateCode.Store(internalpb.StateCode_Healthy)
+	log.Info("cdc server started", zap.String("name", Params.CDCCfg.Name),
+		zap.String("source", Params.CDCCfg.SourceAddress), zap.String("target", Params.CDCCfg.TargetAddress))
+	return nil
+}
+
+// Stop stops the replication and closes the connections.
+func (s *Server) Stop() error {
+	s.stateCode.Store(internalpb.StateCode_Abnormal)
+	s.cancel()
+	if s.replicator != nil {
+		s.replicator.Stop()
+	}
+	for _, conn := range []*grpc.ClientConn{s.sourceConn, s.targetConn} {
+		if conn != nil {
+			_ = conn.Close()
+		}
+	}
+	if s.etcdCli != nil {
+		_ = s.etcdCli.Close()
+	}
+	return nil
+}
+
+// GetComponentStates returns the state of the cdc server, which is abnormal while the replication is restarting.
+func (s *Server) GetComponentStates(ctx context.Context, req *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error) {
+	stateCode := s.stateCode.Load().(internalpb.StateCode)
+	status := &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	if stateCode == internalpb.StateCode_Healthy {
+		if err := s.replicator.Err(); err != nil {
+			stateCode = internalpb.StateCode_Abnormal
+			status.Reason = err.Error()
+		}
+	}
+	return &internalpb.ComponentStates{
+		State: &internalpb.ComponentInfo{
+			NodeID:    common.NotRegisteredID,
+			Role:      "CDC",
+			StateCode: stateCode,
+		},
+		Status: status,
+	}, nil
+}
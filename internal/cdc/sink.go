@@ -0,0 +1,487 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// Sink replays the change events into the target through the proxy API.
+//
+// The ddl events are idempotent, they are skipped if the target has already applied them,
+// while the entities after the last checkpoint may be inserted again after restart.
+// The primary keys are replicated as they are, so the primary key of the target collection is never auto generated,
+// and the target collections enforce unique primary keys with the upsert policy, so that the entities inserted again
+// replace the existing ones instead of duplicating them.
+// The consistency level and the other properties of the collections are not carried by the change events,
+// the target collections take the default ones.
+type Sink struct {
+	target Target
+	// collection name -> primary key field in the target
+	pkFields map[string]*schemapb.FieldSchema
+}
+
+// NewSink returns the sink replaying the change events into the target.
+func NewSink(target Target) *Sink {
+	return &Sink{
+		target:   target,
+		pkFields: make(map[string]*schemapb.FieldSchema),
+	}
+}
+
+// Apply replays the change event into the target.
+func (s *Sink) Apply(ctx context.Context, event *internalpb.ChangeEvent) error {
+	if event.GetVersion() > ChangeEventVersion {
+		return fmt.Errorf("unsupported change event version %d, the latest supported is %d", event.GetVersion(), ChangeEventVersion)
+	}
+	switch payload := event.GetPayload().(type) {
+	case *internalpb.ChangeEvent_Insert:
+		return s.insert(ctx, payload.Insert)
+	case *internalpb.ChangeEvent_Delete:
+		return s.delete(ctx, payload.Delete)
+	case *internalpb.ChangeEvent_CreateCollection:
+		return s.createCollection(ctx, payload.CreateCollection)
+	case *internalpb.ChangeEvent_DropCollection:
+		return s.dropCollection(ctx, payload.DropCollection)
+	case *internalpb.ChangeEvent_CreatePartition:
+		return s.createPartition(ctx, payload.CreatePartition)
+	case *internalpb.ChangeEvent_DropPartition:
+		return s.dropPartition(ctx, payload.DropPartition)
+	case *internalpb.ChangeEvent_Truncate:
+		return s.truncate(ctx, payload.Truncate)
+	case *internalpb.ChangeEvent_AlterSchema:
+		return s.alterSchema(ctx, payload.AlterSchema)
+	default:
+		return fmt.Errorf("unknown payload of change event, msg type %s", event.GetMsgType())
+	}
+}
+
+func checkStatus(status *commonpb.Status, err error) error {
+	if err != nil {
+		return err
+	}
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(status.GetReason())
+	}
+	return nil
+}
+
+func (s *Sink) insert(ctx context.Context, req *internalpb.InsertRequest) error {
+	return s.insertRows(ctx, req.GetCollectionName(), req.GetPartitionName(), req.GetFieldsData())
+}
+
+// insertRows inserts the rows into the target, the rows of the same primary key are merged into the last one,
+// since the target rejects the insert requests with duplicated primary keys.
+func (s *Sink) insertRows(ctx context.Context, collectionName, partitionName string, fieldsData []*schemapb.FieldData) error {
+	pkField, err := s.getPrimaryField(ctx, collectionName)
+	if err != nil {
+		return err
+	}
+	fieldsData, numRows, err := dedupRows(fieldsData, pkField)
+	if err != nil || numRows == 0 {
+		return err
+	}
+	resp, err := s.target.Insert(ctx, &milvuspb.InsertRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert},
+		CollectionName: collectionName,
+		PartitionName:  partitionName,
+		FieldsData:     fieldsData,
+		NumRows:        uint32(numRows),
+	})
+	return checkStatus(resp.GetStatus(), err)
+}
+
+// dedupRows keeps the last row of each primary key, and returns the number of the rows kept.
+func dedupRows(fieldsData []*schemapb.FieldData, pkField *schemapb.FieldSchema) ([]*schemapb.FieldData, int, error) {
+	pkData, err := typeutil.GetPrimaryFieldData(fieldsData, pkField)
+	if err != nil {
+		return nil, 0, err
+	}
+	pks, err := getPrimaryKeys(pkData)
+	if err != nil {
+		return nil, 0, err
+	}
+	last := make(map[interface{}]int, len(pks))
+	for i, pk := range pks {
+		last[pk] = i
+	}
+	if len(last) == len(pks) {
+		return fieldsData, len(pks), nil
+	}
+	deduped := make([]*schemapb.FieldData, len(fieldsData))
+	for i, pk := range pks {
+		if last[pk] == i {
+			typeutil.AppendFieldData(deduped, fieldsData, int64(i))
+		}
+	}
+	return deduped, len(last), nil
+}
+
+func (s *Sink) delete(ctx context.Context, req *internalpb.DeleteRequest) error {
+	pkField, err := s.getPrimaryField(ctx, req.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	ids := req.GetPrimaryKeys()
+	if ids == nil {
+		ids = &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: req.GetInt64PrimaryKeys()}},
+		}
+	}
+	if typeutil.GetSizeOfIDs(ids) == 0 {
+		return nil
+	}
+	resp, err := s.target.Delete(ctx, &milvuspb.DeleteRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_Delete},
+		CollectionName: req.GetCollectionName(),
+		PartitionName:  req.GetPartitionName(),
+		Expr:           ids2Expr(pkField.GetName(), ids),
+	})
+	return checkStatus(resp.GetStatus(), err)
+}
+
+// ids2Expr builds the expression matching the primary keys.
+func ids2Expr(fieldName string, ids *schemapb.IDs) string {
+	var values []string
+	switch ids.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		for _, id := range ids.GetIntId().GetData() {
+			values = append(values, strconv.FormatInt(id, 10))
+		}
+	case *schemapb.IDs_StrId:
+		for _, id := range ids.GetStrId().GetData() {
+			values = append(values, strconv.Quote(id))
+		}
+	}
+	return fieldName + " in [" + strings.Join(values, ", ") + "]"
+}
+
+func (s *Sink) describeCollection(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+	resp, err := describeCollection(ctx, s.target, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetSchema(), nil
+}
+
+func (s *Sink) getPrimaryField(ctx context.Context, collectionName string) (*schemapb.FieldSchema, error) {
+	if field, ok := s.pkFields[collectionName]; ok {
+		return field, nil
+	}
+	schema, err := s.describeCollection(ctx, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	field, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	s.pkFields[collectionName] = field
+	return field, nil
+}
+
+func (s *Sink) hasCollection(ctx context.Context, collectionName string) (bool, error) {
+	resp, err := s.target.HasCollection(ctx, &milvuspb.HasCollectionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_HasCollection},
+		CollectionName: collectionName,
+	})
+	if err = checkStatus(resp.GetStatus(), err); err != nil {
+		return false, err
+	}
+	return resp.GetValue(), nil
+}
+
+func (s *Sink) hasPartition(ctx context.Context, collectionName, partitionName string) (bool, error) {
+	resp, err := s.target.HasPartition(ctx, &milvuspb.HasPartitionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_HasPartition},
+		CollectionName: collectionName,
+		PartitionName:  partitionName,
+	})
+	if err = checkStatus(resp.GetStatus(), err); err != nil {
+		return false, err
+	}
+	return resp.GetValue(), nil
+}
+
+func (s *Sink) createCollection(ctx context.Context, req *internalpb.CreateCollectionRequest) error {
+	schema := &schemapb.CollectionSchema{}
+	if err := proto.Unmarshal(req.GetSchema(), schema); err != nil {
+		return err
+	}
+	return s.createTargetCollection(ctx, req.GetCollectionName(), schema, int32(len(req.GetVirtualChannelNames())))
+}
+
+// createTargetCollection creates the collection in the target if it doesn't exist.
+func (s *Sink) createTargetCollection(ctx context.Context, collectionName string, schema *schemapb.CollectionSchema, shardsNum int32) error {
+	delete(s.pkFields, collectionName)
+	has, err := s.hasCollection(ctx, collectionName)
+	if err != nil || has {
+		return err
+	}
+	schema = proto.Clone(schema).(*schemapb.CollectionSchema)
+	// the primary keys generated by the source are kept
+	for _, field := range schema.GetFields() {
+		field.AutoID = false
+	}
+	schema.AutoID = false
+	schemaBytes, err := proto.Marshal(schema)
+	if err != nil {
+		return err
+	}
+	return checkStatus(s.target.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+		CollectionName: collectionName,
+		Schema:         schemaBytes,
+		ShardsNum:      shardsNum,
+		Properties: []*commonpb.KeyValuePair{
+			{Key: common.EnforceUniquePKKey, Value: "true"},
+			{Key: common.UniquePKConflictPolicyKey, Value: common.UniquePKConflictUpsert},
+		},
+	}))
+}
+
+func (s *Sink) dropCollection(ctx context.Context, req *internalpb.DropCollectionRequest) error {
+	delete(s.pkFields, req.GetCollectionName())
+	has, err := s.hasCollection(ctx, req.GetCollectionName())
+	if err != nil || !has {
+		return err
+	}
+	return checkStatus(s.target.DropCollection(ctx, &milvuspb.DropCollectionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DropCollection},
+		CollectionName: req.GetCollectionName(),
+	}))
+}
+
+func (s *Sink) createPartition(ctx context.Context, req *internalpb.CreatePartitionRequest) error {
+	has, err := s.hasPartition(ctx, req.GetCollectionName(), req.GetPartitionName())
+	if err != nil || has {
+		return err
+	}
+	return checkStatus(s.target.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreatePartition},
+		CollectionName: req.GetCollectionName(),
+		PartitionName:  req.GetPartitionName(),
+	}))
+}
+
+func (s *Sink) dropPartition(ctx context.Context, req *internalpb.DropPartitionRequest) error {
+	has, err := s.hasPartition(ctx, req.GetCollectionName(), req.GetPartitionName())
+	if err != nil || !has {
+		return err
+	}
+	return checkStatus(s.target.DropPartition(ctx, &milvuspb.DropPartitionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DropPartition},
+		CollectionName: req.GetCollectionName(),
+		PartitionName:  req.GetPartitionName(),
+	}))
+}
+
+func (s *Sink) truncate(ctx context.Context, req *internalpb.TruncateRequest) error {
+	if req.GetPartitionName() == "" {
+		return checkStatus(s.target.TruncateCollection(ctx, &milvuspb.TruncateCollectionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_TruncateCollection},
+			CollectionName: req.GetCollectionName(),
+		}))
+	}
+	return checkStatus(s.target.TruncatePartition(ctx, &milvuspb.TruncatePartitionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_TruncatePartition},
+		CollectionName: req.GetCollectionName(),
+		PartitionName:  req.GetPartitionName(),
+	}))
+}
+
+func getField(schema *schemapb.CollectionSchema, fieldName string) *schemapb.FieldSchema {
+	for _, field := range schema.GetFields() {
+		if field.GetName() == fieldName {
+			return field
+		}
+	}
+	return nil
+}
+
+// alterSchema adds the field if it's in the new schema, otherwise drops it.
+func (s *Sink) alterSchema(ctx context.Context, req *internalpb.AlterSchemaRequest) error {
+	current, err := s.describeCollection(ctx, req.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	exist := getField(current, req.GetFieldName()) != nil
+	field := getField(req.GetSchema(), req.GetFieldName())
+	if field != nil {
+		if exist {
+			return nil
+		}
+		field = proto.Clone(field).(*schemapb.FieldSchema)
+		// the field ID is allocated by the target
+		field.FieldID = 0
+		return checkStatus(s.target.AddField(ctx, &milvuspb.AddFieldRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AddField},
+			CollectionName: req.GetCollectionName(),
+			Field:          field,
+		}))
+	}
+	if !exist {
+		return nil
+	}
+	return checkStatus(s.target.DropField(ctx, &milvuspb.DropFieldRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DropField},
+		CollectionName: req.GetCollectionName(),
+		FieldName:      req.GetFieldName(),
+	}))
+}
+
+type collectionDescriber interface {
+	DescribeCollection(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error)
+}
+
+func describeCollection(ctx context.Context, d collectionDescriber, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
+	resp, err := d.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DescribeCollection},
+		CollectionName: collectionName,
+	})
+	if err = checkStatus(resp.GetStatus(), err); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type indexDescriber interface {
+	DescribeIndex(ctx context.Context, request *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error)
+}
+
+// describeIndexes returns all the indexes of the collection.
+func describeIndexes(ctx context.Context, d indexDescriber, collectionName string) ([]*milvuspb.IndexDescription, error) {
+	resp, err := d.DescribeIndex(ctx, &milvuspb.DescribeIndexRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DescribeIndex},
+		CollectionName: collectionName,
+	})
+	if err == nil && resp.GetStatus().GetErrorCode() == commonpb.ErrorCode_IndexNotExist {
+		return nil, nil
+	}
+	if err = checkStatus(resp.GetStatus(), err); err != nil {
+		return nil, err
+	}
+	return resp.GetIndexDescriptions(), nil
+}
+
+// syncIndexes makes the indexes of the target collection the same as the ones of the source collection,
+// the indexes are matched by their names and fields, the parameters of the existing indexes are not compared.
+func (s *Sink) syncIndexes(ctx context.Context, collectionName string, indexes []*milvuspb.IndexDescription) error {
+	current, err := describeIndexes(ctx, s.target, collectionName)
+	if err != nil {
+		return err
+	}
+	wanted := make(map[string]string, len(indexes))
+	for _, index := range indexes {
+		wanted[index.GetIndexName()] = index.GetFieldName()
+	}
+	existing := make(map[string]string, len(current))
+	for _, index := range current {
+		if fieldName, ok := wanted[index.GetIndexName()]; ok && fieldName == index.GetFieldName() {
+			existing[index.GetIndexName()] = index.GetFieldName()
+			continue
+		}
+		err = checkStatus(s.target.DropIndex(ctx, &milvuspb.DropIndexRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DropIndex},
+			CollectionName: collectionName,
+			FieldName:      index.GetFieldName(),
+			IndexName:      index.GetIndexName(),
+		}))
+		if err != nil {
+			return fmt.Errorf("drop index %s of collection %s failed, %w", index.GetIndexName(), collectionName, err)
+		}
+	}
+	for _, index := range indexes {
+		if _, ok := existing[index.GetIndexName()]; ok {
+			continue
+		}
+		err = checkStatus(s.target.CreateIndex(ctx, &milvuspb.CreateIndexRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateIndex},
+			CollectionName: collectionName,
+			FieldName:      index.GetFieldName(),
+			ExtraParams:    index.GetParams(),
+			IndexName:      index.GetIndexName(),
+		}))
+		if err != nil {
+			return fmt.Errorf("create index %s of collection %s failed, %w", index.GetIndexName(), collectionName, err)
+		}
+	}
+	return nil
+}
+
+// syncAliases makes the aliases of the target collection the same as the ones of the source collection,
+// the aliases pointing to another collection in the target are altered.
+func (s *Sink) syncAliases(ctx context.Context, collectionName string, aliases []string) error {
+	resp, err := describeCollection(ctx, s.target, collectionName)
+	if err != nil {
+		return err
+	}
+	wanted := make(map[string]struct{}, len(aliases))
+	for _, alias := range aliases {
+		wanted[alias] = struct{}{}
+	}
+	existing := make(map[string]struct{}, len(resp.GetAliases()))
+	for _, alias := range resp.GetAliases() {
+		if _, ok := wanted[alias]; ok {
+			existing[alias] = struct{}{}
+			continue
+		}
+		err = checkStatus(s.target.DropAlias(ctx, &milvuspb.DropAliasRequest{
+			Base:  &commonpb.MsgBase{MsgType: commonpb.MsgType_DropAlias},
+			Alias: alias,
+		}))
+		if err != nil {
+			return fmt.Errorf("drop alias %s of collection %s failed, %w", alias, collectionName, err)
+		}
+	}
+	for _, alias := range aliases {
+		if _, ok := existing[alias]; ok {
+			continue
+		}
+		err = checkStatus(s.target.CreateAlias(ctx, &milvuspb.CreateAliasRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateAlias},
+			CollectionName: collectionName,
+			Alias:          alias,
+		}))
+		if err == nil {
+			continue
+		}
+		// the alias may still point to another collection
+		err = checkStatus(s.target.AlterAlias(ctx, &milvuspb.AlterAliasRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterAlias},
+			CollectionName: collectionName,
+			Alias:          alias,
+		}))
+		if err != nil {
+			return fmt.Errorf("create alias %s of collection %s failed, %w", alias, collectionName, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func applyMsg(t *testing.T, sink *Sink, msg msgstream.TsMsg) error {
+	event, ok := NewChangeEvent(msg)
+	assert.True(t, ok)
+	return sink.Apply(context.Background(), event)
+}
+
+func TestSink(t *testing.T) {
+	ctx := context.Background()
+	target := NewLocalTarget()
+	sink := NewSink(target)
+
+	assert.NoError(t, applyMsg(t, sink, newCreateCollectionMsg(t, 1, "ch_0v0", "ch_1v0")))
+	// the broadcast ddl is replayed again after restart
+	assert.NoError(t, applyMsg(t, sink, newCreateCollectionMsg(t, 1, "ch_0v0", "ch_1v0")))
+	resp, err := target.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{CollectionName: testCollection})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), resp.GetShardsNum())
+	assert.False(t, resp.GetSchema().GetFields()[0].GetAutoID())
+	// the inserts replayed again after restart are upserted
+	assert.ElementsMatch(t, []*commonpb.KeyValuePair{
+		{Key: common.EnforceUniquePKKey, Value: "true"},
+		{Key: common.UniquePKConflictPolicyKey, Value: common.UniquePKConflictUpsert},
+	}, resp.GetProperties())
+
+	assert.NoError(t, applyMsg(t, sink, newInsertMsg(2, "ch_0v0", 1, 2, 3)))
+	assert.NoError(t, applyMsg(t, sink, newInsertMsg(3, "ch_1v0", 4, 5)))
+	assert.Equal(t, 5, target.NumEntities(testCollection))
+	assert.NoError(t, applyMsg(t, sink, newDeleteMsg(4, "ch_0v0", 1, 4, 6)))
+	assert.Equal(t, 3, target.NumEntities(testCollection))
+	assert.False(t, target.HasEntity(testCollection, int64(1)))
+	assert.True(t, target.HasEntity(testCollection, int64(2)))
+	assert.NoError(t, applyMsg(t, sink, newDeleteMsg(4, "ch_0v0")))
+
+	t.Run("partition", func(t *testing.T) {
+		createMsg := &msgstream.CreatePartitionMsg{
+			CreatePartitionRequest: internalpb.CreatePartitionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreatePartition},
+				CollectionName: testCollection,
+				PartitionName:  "p1",
+			},
+		}
+		assert.NoError(t, applyMsg(t, sink, createMsg))
+		assert.NoError(t, applyMsg(t, sink, createMsg))
+
+		insertMsg := newInsertMsg(5, "ch_0v0", 10)
+		insertMsg.PartitionName = "p1"
+		assert.NoError(t, applyMsg(t, sink, insertMsg))
+		assert.True(t, target.HasEntity(testCollection, int64(10)))
+
+		truncateMsg := &msgstream.TruncateMsg{
+			TruncateRequest: internalpb.TruncateRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_TruncatePartition},
+				CollectionName: testCollection,
+				PartitionName:  "p1",
+			},
+		}
+		assert.NoError(t, applyMsg(t, sink, truncateMsg))
+		assert.False(t, target.HasEntity(testCollection, int64(10)))
+		assert.Equal(t, 3, target.NumEntities(testCollection))
+
+		dropMsg := &msgstream.DropPartitionMsg{
+			DropPartitionRequest: internalpb.DropPartitionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DropPartition},
+				CollectionName: testCollection,
+				PartitionName:  "p1",
+			},
+		}
+		assert.NoError(t, applyMsg(t, sink, dropMsg))
+		assert.NoError(t, applyMsg(t, sink, dropMsg))
+		has, err := target.HasPartition(ctx, &milvuspb.HasPartitionRequest{CollectionName: testCollection, PartitionName: "p1"})
+		assert.NoError(t, err)
+		assert.False(t, has.GetValue())
+	})
+
+	t.Run("alter schema", func(t *testing.T) {
+		resp, err := target.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{CollectionName: testCollection})
+		assert.NoError(t, err)
+		schema := resp.GetSchema()
+		schema.Fields = append(schema.Fields, &schemapb.FieldSchema{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int64, Nullable: true})
+		addMsg := &msgstream.AlterSchemaMsg{
+			AlterSchemaRequest: internalpb.AlterSchemaRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AddField},
+				CollectionName: testCollection,
+				FieldName:      "age",
+				Schema:         schema,
+			},
+		}
+		assert.NoError(t, applyMsg(t, sink, addMsg))
+		assert.NoError(t, applyMsg(t, sink, addMsg))
+		resp, err = target.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{CollectionName: testCollection})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(resp.GetSchema().GetFields()))
+		assert.Equal(t, "age", resp.GetSchema().GetFields()[2].GetName())
+
+		schema.Fields = schema.Fields[:2]
+		dropMsg := &msgstream.AlterSchemaMsg{
+			AlterSchemaRequest: internalpb.AlterSchemaRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DropField},
+				CollectionName: testCollection,
+				FieldName:      "age",
+				Schema:         schema,
+			},
+		}
+		assert.NoError(t, applyMsg(t, sink, dropMsg))
+		assert.NoError(t, applyMsg(t, sink, dropMsg))
+		resp, err = target.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{CollectionName: testCollection})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(resp.GetSchema().GetFields()))
+	})
+
+	t.Run("truncate collection", func(t *testing.T) {
+		truncateMsg := &msgstream.TruncateMsg{
+			TruncateRequest: internalpb.TruncateRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_TruncateCollection},
+				CollectionName: testCollection,
+			},
+		}
+		assert.NoError(t, applyMsg(t, sink, truncateMsg))
+		assert.Equal(t, 0, target.NumEntities(testCollection))
+		assert.NoError(t, applyMsg(t, sink, newInsertMsg(6, "ch_0v0", 1)))
+		assert.Equal(t, 1, target.NumEntities(testCollection))
+	})
+
+	assert.NoError(t, applyMsg(t, sink, newDropCollectionMsg(7)))
+	assert.NoError(t, applyMsg(t, sink, newDropCollectionMsg(7)))
+	has, err := target.HasCollection(ctx, &milvuspb.HasCollectionRequest{CollectionName: testCollection})
+	assert.NoError(t, err)
+	assert.False(t, has.GetValue())
+
+	// the collection doesn't exist
+	assert.Error(t, applyMsg(t, sink, newInsertMsg(8, "ch_0v0", 1)))
+	assert.Error(t, applyMsg(t, sink, newDeleteMsg(8, "ch_0v0", 1)))
+
+	event, ok := NewChangeEvent(newInsertMsg(8, "ch_0v0", 1))
+	assert.True(t, ok)
+	event.Version = ChangeEventVersion + 1
+	assert.Error(t, sink.Apply(ctx, event))
+}
+
+func TestIDs2Expr(t *testing.T) {
+	assert.Equal(t, "pk in [1, 2]", ids2Expr("pk", &schemapb.IDs{
+		IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2}}},
+	}))
+	assert.Equal(t, `pk in ["a", "b\"c"]`, ids2Expr("pk", &schemapb.IDs{
+		IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a", `b"c`}}},
+	}))
+}
+
+func TestDedupRows(t *testing.T) {
+	pkField := &schemapb.FieldSchema{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64}
+	fieldsData := newInsertMsg(1, "ch_0v0", 1, 2).GetFieldsData()
+	deduped, numRows, err := dedupRows(fieldsData, pkField)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, numRows)
+	assert.Equal(t, fieldsData, deduped)
+
+	fieldsData = newInsertMsg(1, "ch_0v0", 1, 2, 1).GetFieldsData()
+	fieldsData[1].GetVectors().GetFloatVector().Data = []float32{1, 1, 2, 2, 3, 3}
+	deduped, numRows, err = dedupRows(fieldsData, pkField)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, numRows)
+	assert.Equal(t, []int64{2, 1}, deduped[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []float32{2, 2, 3, 3}, deduped[1].GetVectors().GetFloatVector().GetData())
+
+	_, _, err = dedupRows(fieldsData[1:], pkField)
+	assert.Error(t, err)
+}
+
+func TestSink_syncMeta(t *testing.T) {
+	ctx := context.Background()
+	target := NewLocalTarget()
+	sink := NewSink(target)
+	assert.NoError(t, applyMsg(t, sink, newCreateCollectionMsg(t, 1, "ch_0v0", "ch_1v0")))
+	createMsg := newCreateCollectionMsg(t, 1, "ch_0v0", "ch_1v0")
+	createMsg.CollectionName = "other"
+	assert.NoError(t, applyMsg(t, sink, createMsg))
+
+	indexes := []*milvuspb.IndexDescription{
+		{IndexName: "idx", FieldName: "vec", Params: []*commonpb.KeyValuePair{{Key: "index_type", Value: "FLAT"}}},
+	}
+	assert.NoError(t, sink.syncIndexes(ctx, testCollection, indexes))
+	assert.NoError(t, sink.syncIndexes(ctx, testCollection, indexes))
+	current, err := describeIndexes(ctx, target, testCollection)
+	assert.NoError(t, err)
+	assert.Equal(t, indexes, current)
+	// the index is rebuilt on another field
+	indexes[0].FieldName = "pk"
+	assert.NoError(t, sink.syncIndexes(ctx, testCollection, indexes))
+	current, err = describeIndexes(ctx, target, testCollection)
+	assert.NoError(t, err)
+	assert.Equal(t, indexes, current)
+	assert.NoError(t, sink.syncIndexes(ctx, testCollection, nil))
+	current, err = describeIndexes(ctx, target, testCollection)
+	assert.NoError(t, err)
+	assert.Empty(t, current)
+	assert.Error(t, sink.syncIndexes(ctx, testCollection, []*milvuspb.IndexDescription{{IndexName: "idx", FieldName: "age"}}))
+
+	getAliases := func(collectionName string) []string {
+		resp, err := describeCollection(ctx, target, collectionName)
+		assert.NoError(t, err)
+		return resp.GetAliases()
+	}
+	assert.NoError(t, sink.syncAliases(ctx, testCollection, []string{"a1", "a2"}))
+	assert.NoError(t, sink.syncAliases(ctx, testCollection, []string{"a1", "a2"}))
+	assert.Equal(t, []string{"a1", "a2"}, getAliases(testCollection))
+	// the alias is moved to another collection
+	assert.NoError(t, sink.syncAliases(ctx, "other", []string{"a2"}))
+	assert.Equal(t, []string{"a1"}, getAliases(testCollection))
+	assert.Equal(t, []string{"a2"}, getAliases("other"))
+	assert.NoError(t, sink.syncAliases(ctx, testCollection, nil))
+	assert.Empty(t, getAliases(testCollection))
+	assert.Error(t, sink.syncAliases(ctx, testCollection, []string{"other"}))
+}
@@ -0,0 +1,84 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+// Source is the part of the proxy API the metadata and the existing entities of the source cluster are read through,
+// the indexes and the aliases are not carried by the change events so they are synchronized from the source.
+// Both types.ProxyComponent and the client of a remote proxy wrapped by NewClientSource implement it.
+type Source interface {
+	ShowCollections(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error)
+	DescribeCollection(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error)
+	ShowPartitions(ctx context.Context, request *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error)
+	DescribeIndex(ctx context.Context, request *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error)
+	// QueryStream sends the results in chunks, the last chunk carries the status only
+	QueryStream(ctx context.Context, request *milvuspb.QueryRequest, send func(*milvuspb.QueryResults) error) error
+}
+
+// clientSource reads the source cluster through its proxy.
+type clientSource struct {
+	client milvuspb.MilvusServiceClient
+}
+
+var _ Source = (*clientSource)(nil)
+
+// NewClientSource returns the source reading a remote Milvus through the client of its proxy.
+func NewClientSource(client milvuspb.MilvusServiceClient) Source {
+	return &clientSource{client: client}
+}
+
+func (s *clientSource) ShowCollections(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
+	return s.client.ShowCollections(ctx, request)
+}
+
+func (s *clientSource) DescribeCollection(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	return s.client.DescribeCollection(ctx, request)
+}
+
+func (s *clientSource) ShowPartitions(ctx context.Context, request *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error) {
+	return s.client.ShowPartitions(ctx, request)
+}
+
+func (s *clientSource) DescribeIndex(ctx context.Context, request *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
+	return s.client.DescribeIndex(ctx, request)
+}
+
+func (s *clientSource) QueryStream(ctx context.Context, request *milvuspb.QueryRequest, send func(*milvuspb.QueryResults) error) error {
+	stream, err := s.client.QueryStream(ctx, request)
+	if err != nil {
+		return err
+	}
+	for {
+		result, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = send(result); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,202 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// listCollections returns the names of the collections of the source which are replicated.
+func (r *Replicator) listCollections(ctx context.Context) ([]string, error) {
+	resp, err := r.source.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
+		Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_ShowCollections},
+	})
+	if err = checkStatus(resp.GetStatus(), err); err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, name := range resp.GetCollectionNames() {
+		if _, ok := r.collections[name]; len(r.collections) > 0 && !ok {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// copyCollections creates the collections of the source in the target with their partitions, indexes and aliases,
+// then copies their entities with strong consistency, so the collections must be loaded in the source.
+// The changes consumed from the initial position are replayed after the copy, they converge with the copied entities
+// since the inserts are upserted and the deletes are idempotent in the target.
+func (r *Replicator) copyCollections() error {
+	names, err := r.listCollections(r.ctx)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err = r.copyCollection(r.ctx, name); err != nil {
+			return fmt.Errorf("copy collection %s failed, %w", name, err)
+		}
+		log.Info("cdc collection copied", zap.String("name", r.cfg.Name), zap.String("collection", name))
+	}
+	return nil
+}
+
+func (r *Replicator) copyCollection(ctx context.Context, collectionName string) error {
+	desc, err := describeCollection(ctx, r.source, collectionName)
+	if err != nil {
+		return err
+	}
+	if err = r.sink.createTargetCollection(ctx, collectionName, desc.GetSchema(), desc.GetShardsNum()); err != nil {
+		return err
+	}
+	partitions, err := r.source.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_ShowPartitions},
+		CollectionName: collectionName,
+	})
+	if err = checkStatus(partitions.GetStatus(), err); err != nil {
+		return err
+	}
+	for _, partitionName := range partitions.GetPartitionNames() {
+		err = r.sink.createPartition(ctx, &internalpb.CreatePartitionRequest{
+			CollectionName: collectionName,
+			PartitionName:  partitionName,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if err = r.syncCollectionMeta(ctx, r.sink, desc); err != nil {
+		return err
+	}
+	for _, partitionName := range partitions.GetPartitionNames() {
+		if err = r.copyEntities(ctx, desc.GetSchema(), collectionName, partitionName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchAllExpr returns the expression matching all the entities, since the query expression can't be empty.
+func matchAllExpr(pkField *schemapb.FieldSchema) string {
+	if pkField.GetDataType() == schemapb.DataType_VarChar {
+		return fmt.Sprintf(`%s >= ""`, pkField.GetName())
+	}
+	return fmt.Sprintf("%s <= 0 || %s > 0", pkField.GetName(), pkField.GetName())
+}
+
+func (r *Replicator) copyEntities(ctx context.Context, schema *schemapb.CollectionSchema, collectionName, partitionName string) error {
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return err
+	}
+	var outputFields []string
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() >= common.StartOfUserFieldID {
+			outputFields = append(outputFields, field.GetName())
+		}
+	}
+	err = r.source.QueryStream(ctx, &milvuspb.QueryRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_Retrieve},
+		CollectionName: collectionName,
+		Expr:           matchAllExpr(pkField),
+		OutputFields:   outputFields,
+		PartitionNames: []string{partitionName},
+		// strong consistency, the entities inserted before the subscription are all copied
+		GuaranteeTimestamp: 0,
+	}, func(result *milvuspb.QueryResults) error {
+		if result.GetStatus().GetErrorCode() == commonpb.ErrorCode_EmptyCollection {
+			return nil
+		}
+		if err := checkStatus(result.GetStatus(), nil); err != nil {
+			return err
+		}
+		if len(result.GetFieldsData()) == 0 {
+			return nil
+		}
+		return r.sink.insertRows(ctx, collectionName, partitionName, result.GetFieldsData())
+	})
+	if err != nil {
+		return fmt.Errorf("copy entities of partition %s failed, the collection must be loaded in the source, %w", partitionName, err)
+	}
+	return nil
+}
+
+// syncCollectionMeta synchronizes the indexes and the aliases of the collection from the source.
+func (r *Replicator) syncCollectionMeta(ctx context.Context, sink *Sink, desc *milvuspb.DescribeCollectionResponse) error {
+	collectionName := desc.GetCollectionName()
+	indexes, err := describeIndexes(ctx, r.source, collectionName)
+	if err != nil {
+		return err
+	}
+	if err = sink.syncIndexes(ctx, collectionName, indexes); err != nil {
+		return err
+	}
+	return sink.syncAliases(ctx, collectionName, desc.GetAliases())
+}
+
+// syncMetaLoop synchronizes the indexes and the aliases from the source periodically, since they are not carried
+// by the change events. A sink of its own is used, so that it doesn't race with replaying.
+func (r *Replicator) syncMetaLoop() {
+	defer r.wg.Done()
+	sink := NewSink(r.target)
+	ticker := time.NewTicker(r.cfg.MetaSyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+			r.syncMeta(r.ctx, sink)
+		}
+	}
+}
+
+// syncMeta synchronizes the indexes and the aliases of the collections existing in the target,
+// the collections not replayed yet are synchronized next time.
+func (r *Replicator) syncMeta(ctx context.Context, sink *Sink) {
+	names, err := r.listCollections(ctx)
+	if err != nil {
+		log.Warn("cdc list collections failed", zap.String("name", r.cfg.Name), zap.Error(err))
+		return
+	}
+	for _, name := range names {
+		has, err := sink.hasCollection(ctx, name)
+		if err != nil || !has {
+			continue
+		}
+		desc, err := describeCollection(ctx, r.source, name)
+		if err == nil {
+			err = r.syncCollectionMeta(ctx, sink, desc)
+		}
+		if err != nil {
+			log.Warn("cdc sync indexes and aliases failed", zap.String("name", r.cfg.Name), zap.String("collection", name), zap.Error(err))
+		}
+	}
+}
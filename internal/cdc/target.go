@@ -0,0 +1,140 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+// Target is the part of the proxy API the change events are replayed through,
+// both types.ProxyComponent and the client of a remote proxy wrapped by NewClientTarget implement it.
+type Target interface {
+	CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error)
+	DropCollection(ctx context.Context, request *milvuspb.DropCollectionRequest) (*commonpb.Status, error)
+	HasCollection(ctx context.Context, request *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error)
+	DescribeCollection(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error)
+	TruncateCollection(ctx context.Context, request *milvuspb.TruncateCollectionRequest) (*commonpb.Status, error)
+	AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error)
+	DropField(ctx context.Context, request *milvuspb.DropFieldRequest) (*commonpb.Status, error)
+
+	CreatePartition(ctx context.Context, request *milvuspb.CreatePartitionRequest) (*commonpb.Status, error)
+	DropPartition(ctx context.Context, request *milvuspb.DropPartitionRequest) (*commonpb.Status, error)
+	HasPartition(ctx context.Context, request *milvuspb.HasPartitionRequest) (*milvuspb.BoolResponse, error)
+	TruncatePartition(ctx context.Context, request *milvuspb.TruncatePartitionRequest) (*commonpb.Status, error)
+
+	CreateIndex(ctx context.Context, request *milvuspb.CreateIndexRequest) (*commonpb.Status, error)
+	DescribeIndex(ctx context.Context, request *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error)
+	DropIndex(ctx context.Context, request *milvuspb.DropIndexRequest) (*commonpb.Status, error)
+
+	CreateAlias(ctx context.Context, request *milvuspb.CreateAliasRequest) (*commonpb.Status, error)
+	DropAlias(ctx context.Context, request *milvuspb.DropAliasRequest) (*commonpb.Status, error)
+	AlterAlias(ctx context.Context, request *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
+
+	Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error)
+	Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error)
+}
+
+// clientTarget replays the change events into a remote Milvus through its proxy.
+type clientTarget struct {
+	client milvuspb.MilvusServiceClient
+}
+
+var _ Target = (*clientTarget)(nil)
+
+// NewClientTarget returns the target replaying the change events through the client of a remote proxy.
+func NewClientTarget(client milvuspb.MilvusServiceClient) Target {
+	return &clientTarget{client: client}
+}
+
+func (t *clientTarget) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
+	return t.client.CreateCollection(ctx, request)
+}
+
+func (t *clientTarget) DropCollection(ctx context.Context, request *milvuspb.DropCollectionRequest) (*commonpb.Status, error) {
+	return t.client.DropCollection(ctx, request)
+}
+
+func (t *clientTarget) HasCollection(ctx context.Context, request *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error) {
+	return t.client.HasCollection(ctx, request)
+}
+
+func (t *clientTarget) DescribeCollection(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	return t.client.DescribeCollection(ctx, request)
+}
+
+func (t *clientTarget) TruncateCollection(ctx context.Context, request *milvuspb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	return t.client.TruncateCollection(ctx, request)
+}
+
+func (t *clientTarget) AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	return t.client.AddField(ctx, request)
+}
+
+func (t *clientTarget) DropField(ctx context.Context, request *milvuspb.DropFieldRequest) (*commonpb.Status, error) {
+	return t.client.DropField(ctx, request)
+}
+
+func (t *clientTarget) CreatePartition(ctx context.Context, request *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
+	return t.client.CreatePartition(ctx, request)
+}
+
+func (t *clientTarget) DropPartition(ctx context.Context, request *milvuspb.DropPartitionRequest) (*commonpb.Status, error) {
+	return t.client.DropPartition(ctx, request)
+}
+
+func (t *clientTarget) HasPartition(ctx context.Context, request *milvuspb.HasPartitionRequest) (*milvuspb.BoolResponse, error) {
+	return t.client.HasPartition(ctx, request)
+}
+
+func (t *clientTarget) TruncatePartition(ctx context.Context, request *milvuspb.TruncatePartitionRequest) (*commonpb.Status, error) {
+	return t.client.TruncatePartition(ctx, request)
+}
+
+func (t *clientTarget) CreateIndex(ctx context.Context, request *milvuspb.CreateIndexRequest) (*commonpb.Status, error) {
+	return t.client.CreateIndex(ctx, request)
+}
+
+func (t *clientTarget) DescribeIndex(ctx context.Context, request *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
+	return t.client.DescribeIndex(ctx, request)
+}
+
+func (t *clientTarget) DropIndex(ctx context.Context, request *milvuspb.DropIndexRequest) (*commonpb.Status, error) {
+	return t.client.DropIndex(ctx, request)
+}
+
+func (t *clientTarget) CreateAlias(ctx context.Context, request *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
+	return t.client.CreateAlias(ctx, request)
+}
+
+func (t *clientTarget) DropAlias(ctx context.Context, request *milvuspb.DropAliasRequest) (*commonpb.Status, error) {
+	return t.client.DropAlias(ctx, request)
+}
+
+func (t *clientTarget) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasRequest) (*commonpb.Status, error) {
+	return t.client.AlterAlias(ctx, request)
+}
+
+func (t *clientTarget) Insert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	return t.client.Insert(ctx, request)
+}
+
+func (t *clientTarget) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
+	return t.client.Delete(ctx, request)
+}
@@ -16,7 +16,13 @@
 
 package common
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+)
 
 // system filed id:
 // 0: unique row id
@@ -52,8 +58,36 @@ const (
 
 	// NotRegisteredID means node is not registered into etcd.
 	NotRegisteredID = int64(-1)
+
+	// CollectionTimeTravelRetentionKey is the collection property overriding common.retentionDuration, in seconds
+	CollectionTimeTravelRetentionKey = "timetravel.retention"
+
+	// EnforceUniquePKKey is the collection property checking the uniqueness of the inserted primary keys
+	EnforceUniquePKKey = "enforce_unique_pk"
+
+	// UniquePKConflictPolicyKey is the collection property overriding proxy.uniquePKConflictPolicy
+	UniquePKConflictPolicyKey = "unique_pk.conflict_policy"
+
+	// UniquePKConflictUpsert is the policy replacing the existing entities with the inserted ones
+	UniquePKConflictUpsert = "upsert"
 )
 
 // Endian is type alias of binary.LittleEndian.
 // Milvus uses little endian by default.
 var Endian = binary.LittleEndian
+
+// GetTimeTravelRetention returns the time travel retention in seconds set in the collection properties,
+// defaultRetention is returned if it is not set.
+func GetTimeTravelRetention(properties []*commonpb.KeyValuePair, defaultRetention int64) (int64, error) {
+	for _, kv := range properties {
+		if kv.GetKey() != CollectionTimeTravelRetentionKey {
+			continue
+		}
+		retention, err := strconv.ParseInt(kv.GetValue(), 10, 64)
+		if err != nil || retention < 0 {
+			return 0, fmt.Errorf("invalid value of %s: %s", CollectionTimeTravelRetentionKey, kv.GetValue())
+		}
+		return retention, nil
+	}
+	return defaultRetention, nil
+}
@@ -0,0 +1,39 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTimeTravelRetention(t *testing.T) {
+	retention, err := GetTimeTravelRetention(nil, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), retention)
+
+	retention, err = GetTimeTravelRetention([]*commonpb.KeyValuePair{{Key: CollectionTimeTravelRetentionKey, Value: "3600"}}, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3600), retention)
+
+	_, err = GetTimeTravelRetention([]*commonpb.KeyValuePair{{Key: CollectionTimeTravelRetentionKey, Value: "-1"}}, 100)
+	assert.Error(t, err)
+	_, err = GetTimeTravelRetention([]*commonpb.KeyValuePair{{Key: CollectionTimeTravelRetentionKey, Value: "1h"}}, 100)
+	assert.Error(t, err)
+}
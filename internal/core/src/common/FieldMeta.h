@@ -200,6 +200,16 @@ class FieldMeta {
         return type_;
     }
 
+    bool
+    is_nullable() const {
+        return nullable_;
+    }
+
+    void
+    set_nullable(bool nullable) {
+        nullable_ = nullable;
+    }
+
     int64_t
     get_sizeof() const {
         if (is_vector()) {
@@ -224,6 +234,7 @@ class FieldMeta {
     DataType type_ = DataType::NONE;
     std::optional<VectorInfo> vector_info_;
     std::optional<StringInfo> string_info_;
+    bool nullable_ = false;
 };
 
 }  // namespace milvus
@@ -75,6 +75,12 @@ struct SearchResult {
 
     // used for reduce, filter invalid pk, get real topks count
     std::vector<int64_t> real_topK_per_nq_;
+
+    // profile of executing the plan on the segment, used to explain the search
+    int64_t scanned_rows_ = 0;
+    int64_t filtered_rows_ = 0;
+    int64_t filter_cost_us_ = 0;
+    int64_t search_cost_us_ = 0;
 };
 
 using SearchResultPtr = std::shared_ptr<SearchResult>;
@@ -72,9 +72,13 @@ Schema::ParseFrom(const milvus::proto::schema::CollectionSchema& schema_proto) {
             auto type_map = RepeatedKeyValToMap(child.type_params());
             AssertInfo(type_map.count(MAX_LENGTH), "max_length not found");
             auto max_len = boost::lexical_cast<int64_t>(type_map.at(MAX_LENGTH));
-            schema->AddField(name, field_id, data_type, max_len);
+            auto field_meta = FieldMeta(name, field_id, data_type, max_len);
+            field_meta.set_nullable(child.nullable());
+            schema->AddField(std::move(field_meta));
         } else {
-            schema->AddField(name, field_id, data_type);
+            auto field_meta = FieldMeta(name, field_id, data_type);
+            field_meta.set_nullable(child.nullable());
+            schema->AddField(std::move(field_meta));
         }
 
         if (child.is_primary_key()) {
@@ -0,0 +1,306 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#include <algorithm>
+#include <memory>
+#include <string>
+#include <vector>
+#include <pb/schema.pb.h>
+#include "index/Meta.h"
+#include "common/Utils.h"
+#include "knowhere/index/vector_index/adapter/VectorAdapter.h"
+
+namespace milvus::scalar {
+
+template <typename T>
+inline void
+BitmapIndex<T>::BuildWithDataset(const DatasetPtr& dataset) {
+    auto size = knowhere::GetDatasetRows(dataset);
+    auto data = knowhere::GetDatasetTensor(dataset);
+    Build(size, reinterpret_cast<const T*>(data));
+}
+
+template <>
+inline void
+BitmapIndex<bool>::BuildWithDataset(const DatasetPtr& dataset) {
+    auto size = knowhere::GetDatasetRows(dataset);
+    auto data = knowhere::GetDatasetTensor(dataset);
+    proto::schema::BoolArray arr;
+    arr.ParseFromArray(data, size);
+    Build(arr.data().size(), arr.data().data());
+}
+
+template <>
+inline void
+BitmapIndex<std::string>::BuildWithDataset(const DatasetPtr& dataset) {
+    auto size = knowhere::GetDatasetRows(dataset);
+    auto data = knowhere::GetDatasetTensor(dataset);
+    proto::schema::StringArray arr;
+    arr.ParseFromArray(data, size);
+    // TODO: optimize here. avoid memory copy.
+    std::vector<std::string> vecs{arr.data().begin(), arr.data().end()};
+    Build(vecs.size(), vecs.data());
+}
+
+template <typename T>
+inline void
+BitmapIndex<T>::Build(size_t n, const T* values) {
+    if (is_built_) {
+        return;
+    }
+    if (n == 0) {
+        throw std::invalid_argument("BitmapIndex cannot build null values!");
+    }
+    dict_.assign(values, values + n);
+    std::sort(dict_.begin(), dict_.end());
+    dict_.erase(std::unique(dict_.begin(), dict_.end()), dict_.end());
+
+    codes_.resize(n);
+    for (size_t i = 0; i < n; ++i) {
+        auto it = std::lower_bound(dict_.begin(), dict_.end(), values[i]);
+        codes_[i] = static_cast<uint32_t>(it - dict_.begin());
+    }
+    build_bitmaps();
+}
+
+template <typename T>
+inline void
+BitmapIndex<T>::build_bitmaps() {
+    bitmaps_.assign(dict_.size(), TargetBitmap(codes_.size()));
+    for (size_t i = 0; i < codes_.size(); ++i) {
+        bitmaps_[codes_[i]].set(i);
+    }
+    is_built_ = true;
+}
+
+template <typename T>
+inline TargetBitmapPtr
+BitmapIndex<T>::union_bitmaps(size_t begin, size_t end) {
+    TargetBitmapPtr bitset = std::make_unique<TargetBitmap>(codes_.size());
+    for (auto i = begin; i < end; ++i) {
+        *bitset |= bitmaps_[i];
+    }
+    return bitset;
+}
+
+template <typename T>
+inline BinarySet
+BitmapIndex<T>::Serialize(const Config& config) {
+    AssertInfo(is_built_, "index has not been built");
+
+    auto dict_size = dict_.size() * sizeof(T);
+    std::shared_ptr<uint8_t[]> dict_data(new uint8_t[dict_size]);
+    memcpy(dict_data.get(), dict_.data(), dict_size);
+
+    auto codes_size = codes_.size() * sizeof(uint32_t);
+    std::shared_ptr<uint8_t[]> codes_data(new uint8_t[codes_size]);
+    memcpy(codes_data.get(), codes_.data(), codes_size);
+
+    BinarySet res_set;
+    res_set.Append(BITMAP_INDEX_DICT, dict_data, dict_size);
+    res_set.Append(BITMAP_INDEX_CODES, codes_data, codes_size);
+    knowhere::Disassemble(res_set, config);
+    return res_set;
+}
+
+template <>
+inline BinarySet
+BitmapIndex<bool>::Serialize(const Config& config) {
+    AssertInfo(is_built_, "index has not been built");
+
+    // std::vector<bool> is bit-packed, store every value as a single byte instead.
+    auto dict_size = dict_.size() * sizeof(uint8_t);
+    std::shared_ptr<uint8_t[]> dict_data(new uint8_t[dict_size]);
+    for (size_t i = 0; i < dict_.size(); ++i) {
+        dict_data[i] = dict_[i] ? 1 : 0;
+    }
+
+    auto codes_size = codes_.size() * sizeof(uint32_t);
+    std::shared_ptr<uint8_t[]> codes_data(new uint8_t[codes_size]);
+    memcpy(codes_data.get(), codes_.data(), codes_size);
+
+    BinarySet res_set;
+    res_set.Append(BITMAP_INDEX_DICT, dict_data, dict_size);
+    res_set.Append(BITMAP_INDEX_CODES, codes_data, codes_size);
+    knowhere::Disassemble(res_set, config);
+    return res_set;
+}
+
+template <>
+inline BinarySet
+BitmapIndex<std::string>::Serialize(const Config& config) {
+    AssertInfo(is_built_, "index has not been built");
+
+    proto::schema::StringArray arr;
+    for (const auto& str : dict_) {
+        arr.add_data(str);
+    }
+    auto dict_size = arr.ByteSizeLong();
+    std::shared_ptr<uint8_t[]> dict_data(new uint8_t[dict_size]);
+    arr.SerializeToArray(dict_data.get(), dict_size);
+
+    auto codes_size = codes_.size() * sizeof(uint32_t);
+    std::shared_ptr<uint8_t[]> codes_data(new uint8_t[codes_size]);
+    memcpy(codes_data.get(), codes_.data(), codes_size);
+
+    BinarySet res_set;
+    res_set.Append(BITMAP_INDEX_DICT, dict_data, dict_size);
+    res_set.Append(BITMAP_INDEX_CODES, codes_data, codes_size);
+    knowhere::Disassemble(res_set, config);
+    return res_set;
+}
+
+template <typename T>
+inline void
+BitmapIndex<T>::Load(const BinarySet& index_binary) {
+    knowhere::Assemble(const_cast<BinarySet&>(index_binary));
+
+    auto dict = index_binary.GetByName(BITMAP_INDEX_DICT);
+    dict_.resize(dict->size / sizeof(T));
+    memcpy(dict_.data(), dict->data.get(), (size_t)dict->size);
+
+    auto codes = index_binary.GetByName(BITMAP_INDEX_CODES);
+    codes_.resize(codes->size / sizeof(uint32_t));
+    memcpy(codes_.data(), codes->data.get(), (size_t)codes->size);
+
+    build_bitmaps();
+}
+
+template <>
+inline void
+BitmapIndex<bool>::Load(const BinarySet& index_binary) {
+    knowhere::Assemble(const_cast<BinarySet&>(index_binary));
+
+    auto dict = index_binary.GetByName(BITMAP_INDEX_DICT);
+    dict_.resize(dict->size);
+    for (size_t i = 0; i < (size_t)dict->size; ++i) {
+        dict_[i] = dict->data[i] != 0;
+    }
+
+    auto codes = index_binary.GetByName(BITMAP_INDEX_CODES);
+    codes_.resize(codes->size / sizeof(uint32_t));
+    memcpy(codes_.data(), codes->data.get(), (size_t)codes->size);
+
+    build_bitmaps();
+}
+
+template <>
+inline void
+BitmapIndex<std::string>::Load(const BinarySet& index_binary) {
+    knowhere::Assemble(const_cast<BinarySet&>(index_binary));
+
+    auto dict = index_binary.GetByName(BITMAP_INDEX_DICT);
+    proto::schema::StringArray arr;
+    arr.ParseFromArray(dict->data.get(), dict->size);
+    dict_.assign(arr.data().begin(), arr.data().end());
+
+    auto codes = index_binary.GetByName(BITMAP_INDEX_CODES);
+    codes_.resize(codes->size / sizeof(uint32_t));
+    memcpy(codes_.data(), codes->data.get(), (size_t)codes->size);
+
+    build_bitmaps();
+}
+
+template <typename T>
+inline const TargetBitmapPtr
+BitmapIndex<T>::In(size_t n, const T* values) {
+    AssertInfo(is_built_, "index has not been built");
+    TargetBitmapPtr bitset = std::make_unique<TargetBitmap>(codes_.size());
+    for (size_t i = 0; i < n; ++i) {
+        auto it = std::lower_bound(dict_.begin(), dict_.end(), values[i]);
+        if (it != dict_.end() && *it == values[i]) {
+            *bitset |= bitmaps_[it - dict_.begin()];
+        }
+    }
+    return bitset;
+}
+
+template <typename T>
+inline const TargetBitmapPtr
+BitmapIndex<T>::NotIn(size_t n, const T* values) {
+    auto bitset = In(n, values);
+    bitset->flip();
+    return bitset;
+}
+
+template <typename T>
+inline const TargetBitmapPtr
+BitmapIndex<T>::Range(T value, OpType op) {
+    AssertInfo(is_built_, "index has not been built");
+    size_t begin = 0;
+    size_t end = dict_.size();
+    switch (op) {
+        case OpType::LessThan:
+            end = std::lower_bound(dict_.begin(), dict_.end(), value) - dict_.begin();
+            break;
+        case OpType::LessEqual:
+            end = std::upper_bound(dict_.begin(), dict_.end(), value) - dict_.begin();
+            break;
+        case OpType::GreaterThan:
+            begin = std::upper_bound(dict_.begin(), dict_.end(), value) - dict_.begin();
+            break;
+        case OpType::GreaterEqual:
+            begin = std::lower_bound(dict_.begin(), dict_.end(), value) - dict_.begin();
+            break;
+        default:
+            throw std::invalid_argument(std::string("Invalid OperatorType: ") + std::to_string((int)op) + "!");
+    }
+    return union_bitmaps(begin, end);
+}
+
+template <typename T>
+inline const TargetBitmapPtr
+BitmapIndex<T>::Range(T lower_bound_value, bool lb_inclusive, T upper_bound_value, bool ub_inclusive) {
+    AssertInfo(is_built_, "index has not been built");
+    if (lower_bound_value > upper_bound_value ||
+        (lower_bound_value == upper_bound_value && !(lb_inclusive && ub_inclusive))) {
+        return std::make_unique<TargetBitmap>(codes_.size());
+    }
+    size_t begin = lb_inclusive ? std::lower_bound(dict_.begin(), dict_.end(), lower_bound_value) - dict_.begin()
+                                : std::upper_bound(dict_.begin(), dict_.end(), lower_bound_value) - dict_.begin();
+    size_t end = ub_inclusive ? std::upper_bound(dict_.begin(), dict_.end(), upper_bound_value) - dict_.begin()
+                              : std::lower_bound(dict_.begin(), dict_.end(), upper_bound_value) - dict_.begin();
+    return union_bitmaps(begin, std::max(begin, end));
+}
+
+template <typename T>
+inline T
+BitmapIndex<T>::Reverse_Lookup(size_t offset) const {
+    AssertInfo(offset < codes_.size(), "out of range of total count");
+    AssertInfo(is_built_, "index has not been built");
+    return dict_[codes_[offset]];
+}
+
+template <typename T>
+inline const TargetBitmapPtr
+BitmapIndex<T>::Query(const DatasetPtr& dataset) {
+    return ScalarIndex<T>::Query(dataset);
+}
+
+template <>
+inline const TargetBitmapPtr
+BitmapIndex<std::string>::Query(const DatasetPtr& dataset) {
+    auto op = dataset->Get<OpType>(OPERATOR_TYPE);
+    if (op == OpType::PrefixMatch) {
+        AssertInfo(is_built_, "index has not been built");
+        auto prefix = dataset->Get<std::string>(PREFIX_VALUE);
+        // values sharing the prefix are adjacent in the sorted dictionary.
+        size_t begin = std::lower_bound(dict_.begin(), dict_.end(), prefix) - dict_.begin();
+        size_t end = begin;
+        while (end < dict_.size() && milvus::PrefixMatch(dict_[end], prefix)) {
+            ++end;
+        }
+        return union_bitmaps(begin, end);
+    }
+    return ScalarIndex<std::string>::Query(dataset);
+}
+
+}  // namespace milvus::scalar
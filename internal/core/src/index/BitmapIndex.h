@@ -0,0 +1,102 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#pragma once
+
+#include <memory>
+#include <string>
+#include <vector>
+#include "index/ScalarIndex.h"
+
+namespace milvus::scalar {
+
+// BitmapIndex keeps a bitmap of matched rows for every distinct value, it's designed for low-cardinality fields.
+// Rows are dictionary encoded, so only the dictionary and the codes are persisted.
+template <typename T>
+class BitmapIndex : public ScalarIndex<T> {
+ public:
+    BitmapIndex() = default;
+
+    BinarySet
+    Serialize(const Config& config) override;
+
+    void
+    Load(const BinarySet& index_binary) override;
+
+    void
+    BuildWithDataset(const DatasetPtr& dataset) override;
+
+    size_t
+    Count() override {
+        return codes_.size();
+    }
+
+    int64_t
+    Size() override {
+        return (int64_t)codes_.size();
+    }
+
+    void
+    Build(size_t n, const T* values) override;
+
+    const TargetBitmapPtr
+    In(size_t n, const T* values) override;
+
+    const TargetBitmapPtr
+    NotIn(size_t n, const T* values) override;
+
+    const TargetBitmapPtr
+    Range(T value, OpType op) override;
+
+    const TargetBitmapPtr
+    Range(T lower_bound_value, bool lb_inclusive, T upper_bound_value, bool ub_inclusive) override;
+
+    T
+    Reverse_Lookup(size_t offset) const override;
+
+    const TargetBitmapPtr
+    Query(const DatasetPtr& dataset) override;
+
+ public:
+    size_t
+    Cardinality() const {
+        return dict_.size();
+    }
+
+ private:
+    void
+    build_bitmaps();
+
+    // union the bitmaps of dictionary entries in [begin, end).
+    TargetBitmapPtr
+    union_bitmaps(size_t begin, size_t end);
+
+ private:
+    bool is_built_ = false;
+    std::vector<T> dict_;          // sorted distinct values.
+    std::vector<uint32_t> codes_;  // offset -> position in dict_.
+    std::vector<TargetBitmap> bitmaps_;
+};
+
+template <typename T>
+using BitmapIndexPtr = std::unique_ptr<BitmapIndex<T>>;
+
+}  // namespace milvus::scalar
+
+#include "index/BitmapIndex-inl.h"
+
+namespace milvus::scalar {
+template <typename T>
+inline BitmapIndexPtr<T>
+CreateBitmapIndex() {
+    return std::make_unique<BitmapIndex<T>>();
+}
+}  // namespace milvus::scalar
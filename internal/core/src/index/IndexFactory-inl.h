@@ -14,24 +14,35 @@
 #include "index/StringIndexMarisa.h"
 #include "index/IndexType.h"
 #include "index/BoolIndex.h"
+#include "index/BitmapIndex.h"
 
 namespace milvus::scalar {
 
 template <typename T>
 inline ScalarIndexPtr<T>
 IndexFactory::CreateIndex(const std::string& index_type) {
+    if (index_type == INDEX_TYPE_BITMAP) {
+        return CreateBitmapIndex<T>();
+    }
+    // sorted (value, offset) pairs also serve as the posting lists of an inverted index.
     return CreateScalarIndexSort<T>();
 }
 
 template <>
 inline ScalarIndexPtr<bool>
 IndexFactory::CreateIndex(const std::string& index_type) {
+    if (index_type == INDEX_TYPE_BITMAP) {
+        return CreateBitmapIndex<bool>();
+    }
     return CreateBoolIndex();
 }
 
 template <>
 inline ScalarIndexPtr<std::string>
 IndexFactory::CreateIndex(const std::string& index_type) {
+    if (index_type == INDEX_TYPE_BITMAP) {
+        return CreateBitmapIndex<std::string>();
+    }
 #if defined(__linux__) || defined(__APPLE__)
     return CreateStringIndexMarisa();
 #else
@@ -13,4 +13,7 @@
 
 namespace milvus::scalar {
 constexpr const char* INDEX_TYPE_MARISA = "marisa";
+constexpr const char* INDEX_TYPE_SORT = "STL_SORT";
+constexpr const char* INDEX_TYPE_INVERTED = "INVERTED";
+constexpr const char* INDEX_TYPE_BITMAP = "BITMAP";
 }
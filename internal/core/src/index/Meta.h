@@ -24,4 +24,6 @@ constexpr const char* MARISA_TRIE = "marisa_trie";
 constexpr const char* MARISA_TRIE_INDEX = "marisa_trie_index";
 constexpr const char* MARISA_STR_IDS = "marisa_trie_str_ids";
 constexpr const char* FLAT_STR_INDEX = "flat_str_index";
+constexpr const char* BITMAP_INDEX_DICT = "bitmap_index_dict";
+constexpr const char* BITMAP_INDEX_CODES = "bitmap_index_codes";
 }  // namespace milvus::scalar
@@ -22,7 +22,6 @@ ScalarIndexCreator::ScalarIndexCreator(CDataType dtype, const char* type_params,
     // TODO: move parse-related logic to a common interface.
     Helper::ParseFromString(type_params_, std::string(type_params));
     Helper::ParseFromString(index_params_, std::string(index_params));
-    // TODO: create index according to the params.
     index_ = scalar::IndexFactory::GetInstance().CreateIndex(dtype_, index_type());
 }
 
@@ -43,7 +42,13 @@ ScalarIndexCreator::Load(const knowhere::BinarySet& binary_set) {
 
 std::string
 ScalarIndexCreator::index_type() {
-    // TODO
+    for (auto i = 0; i < index_params_.params_size(); ++i) {
+        const auto& param = index_params_.params(i);
+        if (param.key() == "index_type") {
+            return param.value();
+        }
+    }
+    // keep compatible with the index built before index type was specified.
     return "sort";
 }
 
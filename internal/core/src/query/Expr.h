@@ -188,6 +188,18 @@ struct BinaryRangeExpr : Expr {
     accept(ExprVisitor&) override;
 };
 
+struct NullExpr : Expr {
+    enum class OpType { Invalid = 0, IsNull = 1, IsNotNull = 2 };
+
+    FieldId field_id_;
+    DataType data_type_;
+    OpType op_type_;
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
 struct CompareExpr : Expr {
     FieldId left_field_id_;
     FieldId right_field_id_;
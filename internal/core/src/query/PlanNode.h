@@ -66,6 +66,8 @@ struct RetrievePlanNode : PlanNode {
     accept(PlanNodeVisitor&) override;
 
     ExprPtr predicate_;
+    // only the entities with the smallest primary keys are retrieved if positive
+    int64_t limit_ = 0;
 };
 
 }  // namespace milvus::query
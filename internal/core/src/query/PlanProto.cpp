@@ -171,6 +171,7 @@ ProtoParser::RetrievePlanNodeFromProto(const planpb::PlanNode& plan_node_proto)
 
     auto plan_node = [&]() -> std::unique_ptr<RetrievePlanNode> { return std::make_unique<RetrievePlanNode>(); }();
     plan_node->predicate_ = std::move(expr_opt);
+    plan_node->limit_ = plan_node_proto.limit();
     return plan_node;
 }
 
@@ -318,6 +319,21 @@ ProtoParser::ParseCompareExpr(const proto::plan::CompareExpr& expr_pb) {
     }();
 }
 
+ExprPtr
+ProtoParser::ParseNullExpr(const proto::plan::NullExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto& field_meta = schema[field_id];
+    Assert(field_meta.get_data_type() == static_cast<DataType>(column_info.data_type()));
+    AssertInfo(field_meta.is_nullable(), "null check on non-nullable field");
+
+    auto result = std::make_unique<NullExpr>();
+    result->field_id_ = field_id;
+    result->data_type_ = field_meta.get_data_type();
+    result->op_type_ = static_cast<NullExpr::OpType>(expr_pb.op());
+    return result;
+}
+
 ExprPtr
 ProtoParser::ParseTermExpr(const proto::plan::TermExpr& expr_pb) {
     auto& columnInfo = expr_pb.column_info();
@@ -436,6 +452,9 @@ ProtoParser::ParseExpr(const proto::plan::Expr& expr_pb) {
         case ppe::kBinaryArithOpEvalRangeExpr: {
             return ParseBinaryArithOpEvalRangeExpr(expr_pb.binary_arith_op_eval_range_expr());
         }
+        case ppe::kNullExpr: {
+            return ParseNullExpr(expr_pb.null_expr());
+        }
         default:
             PanicInfo("unsupported expr proto node");
     }
@@ -41,6 +41,9 @@ class ProtoParser {
     ExprPtr
     ParseCompareExpr(const proto::plan::CompareExpr& expr_pb);
 
+    ExprPtr
+    ParseNullExpr(const proto::plan::NullExpr& expr_pb);
+
     ExprPtr
     ParseTermExpr(const proto::plan::TermExpr& expr_pb);
 
@@ -44,6 +44,9 @@ class ExecExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(NullExpr& expr) override;
+
  public:
     ExecExprVisitor(const segcore::SegmentInternalInterface& segment, int64_t row_count, Timestamp timestamp)
         : segment_(segment), row_count_(row_count), timestamp_(timestamp) {
@@ -88,6 +91,10 @@ class ExecExprVisitor : public ExprVisitor {
     auto
     ExecCompareExprDispatcher(CompareExpr& expr, CmpFunc cmp_func) -> BitsetType;
 
+    // clear the rows where the field is null, null never satisfies a predicate
+    void
+    MaskNullRows(FieldId field_id, BitsetType& res);
+
  private:
     const segcore::SegmentInternalInterface& segment_;
     Timestamp timestamp_;
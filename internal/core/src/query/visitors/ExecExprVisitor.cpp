@@ -66,6 +66,10 @@ class ExecExprVisitor : ExprVisitor {
     auto
     ExecCompareExprDispatcher(CompareExpr& expr, CmpFunc cmp_func) -> BitsetType;
 
+    // clear the rows where the field is null, null never satisfies a predicate
+    void
+    MaskNullRows(FieldId field_id, BitsetType& res);
+
  private:
     const segcore::SegmentInternalInterface& segment_;
     int64_t row_count_;
@@ -432,6 +436,18 @@ ExecExprVisitor::ExecBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) ->
 }
 #pragma clang diagnostic pop
 
+void
+ExecExprVisitor::MaskNullRows(FieldId field_id, BitsetType& res) {
+    if (!segment_.get_schema()[field_id].is_nullable()) {
+        return;
+    }
+    for (int64_t offset = 0; offset < row_count_; ++offset) {
+        if (res[offset] && !segment_.is_valid(field_id, offset)) {
+            res[offset] = false;
+        }
+    }
+}
+
 void
 ExecExprVisitor::visit(UnaryRangeExpr& expr) {
     auto& field_meta = segment_.get_schema()[expr.field_id_];
@@ -475,6 +491,7 @@ ExecExprVisitor::visit(UnaryRangeExpr& expr) {
             PanicInfo("unsupported");
     }
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    MaskNullRows(expr.field_id_, res);
     bitset_opt_ = std::move(res);
 }
 
@@ -513,6 +530,7 @@ ExecExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
             PanicInfo("unsupported");
     }
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    MaskNullRows(expr.field_id_, res);
     bitset_opt_ = std::move(res);
 }
 
@@ -559,6 +577,7 @@ ExecExprVisitor::visit(BinaryRangeExpr& expr) {
             PanicInfo("unsupported");
     }
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    MaskNullRows(expr.field_id_, res);
     bitset_opt_ = std::move(res);
 }
 
@@ -746,6 +765,25 @@ ExecExprVisitor::visit(CompareExpr& expr) {
         }
     }
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    MaskNullRows(expr.left_field_id_, res);
+    MaskNullRows(expr.right_field_id_, res);
+    bitset_opt_ = std::move(res);
+}
+
+void
+ExecExprVisitor::visit(NullExpr& expr) {
+    using OpType = NullExpr::OpType;
+    auto& field_meta = segment_.get_schema()[expr.field_id_];
+    AssertInfo(expr.data_type_ == field_meta.get_data_type(),
+               "[ExecExprVisitor]DataType of expr isn't field_meta data type");
+    AssertInfo(expr.op_type_ == OpType::IsNull || expr.op_type_ == OpType::IsNotNull,
+               "[ExecExprVisitor]Invalid null op");
+
+    auto is_null = expr.op_type_ == OpType::IsNull;
+    BitsetType res(row_count_);
+    for (int64_t offset = 0; offset < row_count_; ++offset) {
+        res[offset] = segment_.is_valid(expr.field_id_, offset) != is_null;
+    }
     bitset_opt_ = std::move(res);
 }
 
@@ -882,6 +920,7 @@ ExecExprVisitor::visit(TermExpr& expr) {
             PanicInfo("unsupported");
     }
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    MaskNullRows(expr.field_id_, res);
     bitset_opt_ = std::move(res);
 }
 }  // namespace milvus::query
@@ -9,6 +9,7 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <chrono>
 #include <utility>
 
 #include "query/PlanImpl.h"
@@ -88,6 +89,7 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
         return;
     }
 
+    auto filter_start = std::chrono::steady_clock::now();
     BitsetType bitset_holder;
     if (node.predicate_.has_value()) {
         bitset_holder = ExecExprVisitor(*segment, active_count, timestamp_).call_child(*node.predicate_.value());
@@ -98,15 +100,26 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
     segment->mask_with_timestamps(bitset_holder, timestamp_);
 
     segment->mask_with_delete(bitset_holder, active_count, timestamp_);
+    auto filter_end = std::chrono::steady_clock::now();
+    auto filtered_rows = static_cast<int64_t>(bitset_holder.size() - bitset_holder.count());
+    auto filter_cost_us = std::chrono::duration_cast<std::chrono::microseconds>(filter_end - filter_start).count();
     // if bitset_holder is all 1's, we got empty result
     if (bitset_holder.count() == bitset_holder.size()) {
         search_result_opt_ = empty_search_result(num_queries, node.search_info_);
+        search_result_opt_->scanned_rows_ = active_count;
+        search_result_opt_->filter_cost_us_ = filter_cost_us;
         return;
     }
     BitsetView final_view = bitset_holder;
     segment->vector_search(active_count, node.search_info_, src_data, num_queries, timestamp_, final_view,
                            search_result);
+    auto search_end = std::chrono::steady_clock::now();
 
+    search_result.scanned_rows_ = active_count;
+    search_result.filtered_rows_ = filtered_rows;
+    search_result.filter_cost_us_ = filter_cost_us;
+    search_result.search_cost_us_ =
+        std::chrono::duration_cast<std::chrono::microseconds>(search_end - filter_end).count();
     search_result_opt_ = std::move(search_result);
 }
 
@@ -248,6 +248,19 @@ ShowExprVisitor::visit(CompareExpr& expr) {
     json_opt_ = res;
 }
 
+void
+ShowExprVisitor::visit(NullExpr& expr) {
+    AssertInfo(!json_opt_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
+    using OpType = NullExpr::OpType;
+
+    auto op_name = expr.op_type_ == OpType::IsNull ? "IsNull" : "IsNotNull";
+    Json res{{"expr_type", "Null"},
+             {"field_id", expr.field_id_.get()},
+             {"data_type", datatype_name(expr.data_type_)},
+             {"op", op_name}};
+    json_opt_ = res;
+}
+
 template <typename T>
 static Json
 BinaryArithOpEvalRangeExtract(const BinaryArithOpEvalRangeExpr& expr_raw) {
@@ -47,4 +47,9 @@ VerifyExprVisitor::visit(CompareExpr& expr) {
     // TODO
 }
 
+void
+VerifyExprVisitor::visit(NullExpr& expr) {
+    // TODO
+}
+
 }  // namespace milvus::query
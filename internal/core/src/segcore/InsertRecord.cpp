@@ -16,57 +16,62 @@ namespace milvus::segcore {
 InsertRecord::InsertRecord(const Schema& schema, int64_t size_per_chunk)
     : row_ids_(size_per_chunk), timestamps_(size_per_chunk) {
     for (auto& field : schema) {
-        auto field_id = field.first;
-        auto& field_meta = field.second;
+        append_field_meta(field.first, field.second, size_per_chunk);
+    }
+}
 
-        if (field_meta.is_vector()) {
-            if (field_meta.get_data_type() == DataType::VECTOR_FLOAT) {
-                this->append_field_data<FloatVector>(field_id, field_meta.get_dim(), size_per_chunk);
-                continue;
-            } else if (field_meta.get_data_type() == DataType::VECTOR_BINARY) {
-                this->append_field_data<BinaryVector>(field_id, field_meta.get_dim(), size_per_chunk);
-                continue;
-            } else {
-                PanicInfo("unsupported");
-            }
+void
+InsertRecord::append_field_meta(FieldId field_id, const FieldMeta& field_meta, int64_t size_per_chunk) {
+    if (field_meta.is_vector()) {
+        if (field_meta.get_data_type() == DataType::VECTOR_FLOAT) {
+            this->append_field_data<FloatVector>(field_id, field_meta.get_dim(), size_per_chunk);
+            return;
+        } else if (field_meta.get_data_type() == DataType::VECTOR_BINARY) {
+            this->append_field_data<BinaryVector>(field_id, field_meta.get_dim(), size_per_chunk);
+            return;
+        } else {
+            PanicInfo("unsupported");
+        }
+    }
+    switch (field_meta.get_data_type()) {
+        case DataType::BOOL: {
+            this->append_field_data<bool>(field_id, size_per_chunk);
+            break;
+        }
+        case DataType::INT8: {
+            this->append_field_data<int8_t>(field_id, size_per_chunk);
+            break;
+        }
+        case DataType::INT16: {
+            this->append_field_data<int16_t>(field_id, size_per_chunk);
+            break;
         }
-        switch (field_meta.get_data_type()) {
-            case DataType::BOOL: {
-                this->append_field_data<bool>(field_id, size_per_chunk);
-                break;
-            }
-            case DataType::INT8: {
-                this->append_field_data<int8_t>(field_id, size_per_chunk);
-                break;
-            }
-            case DataType::INT16: {
-                this->append_field_data<int16_t>(field_id, size_per_chunk);
-                break;
-            }
-            case DataType::INT32: {
-                this->append_field_data<int32_t>(field_id, size_per_chunk);
-                break;
-            }
-            case DataType::INT64: {
-                this->append_field_data<int64_t>(field_id, size_per_chunk);
-                break;
-            }
-            case DataType::FLOAT: {
-                this->append_field_data<float>(field_id, size_per_chunk);
-                break;
-            }
-            case DataType::DOUBLE: {
-                this->append_field_data<double>(field_id, size_per_chunk);
-                break;
-            }
-            case DataType::VARCHAR: {
-                this->append_field_data<std::string>(field_id, size_per_chunk);
-                break;
-            }
-            default: {
-                PanicInfo("unsupported");
-            }
+        case DataType::INT32: {
+            this->append_field_data<int32_t>(field_id, size_per_chunk);
+            break;
         }
+        case DataType::INT64: {
+            this->append_field_data<int64_t>(field_id, size_per_chunk);
+            break;
+        }
+        case DataType::FLOAT: {
+            this->append_field_data<float>(field_id, size_per_chunk);
+            break;
+        }
+        case DataType::DOUBLE: {
+            this->append_field_data<double>(field_id, size_per_chunk);
+            break;
+        }
+        case DataType::VARCHAR: {
+            this->append_field_data<std::string>(field_id, size_per_chunk);
+            break;
+        }
+        default: {
+            PanicInfo("unsupported");
+        }
+    }
+    if (field_meta.is_nullable()) {
+        this->append_valid_data(field_id, size_per_chunk);
     }
 }
 
@@ -40,6 +40,10 @@ struct InsertRecord {
 
     explicit InsertRecord(const Schema& schema, int64_t size_per_chunk);
 
+    // add the columns of a field, used when a field is added to the schema
+    void
+    append_field_meta(FieldId field_id, const FieldMeta& field_meta, int64_t size_per_chunk);
+
     std::vector<SegOffset>
     search_pk(const PkType pk, Timestamp timestamp) const {
         std::vector<SegOffset> res_offsets;
@@ -128,11 +132,40 @@ struct InsertRecord {
     void
     drop_field_data(FieldId field_id) {
         fields_data_.erase(field_id);
+        if (auto valid_data = get_valid_data(field_id)) {
+            valid_data->clear();
+        }
+    }
+
+    // get validity of a nullable field, nullptr for non-nullable fields
+    ConcurrentVector<bool>*
+    get_valid_data(FieldId field_id) const {
+        auto iter = valid_data_.find(field_id);
+        if (iter == valid_data_.end()) {
+            return nullptr;
+        }
+        return iter->second.get();
+    }
+
+    // rows of non-nullable fields are always valid, so are the rows whose raw data is not loaded
+    bool
+    is_valid(FieldId field_id, int64_t offset) const {
+        auto valid_data = get_valid_data(field_id);
+        if (valid_data == nullptr || valid_data->num_chunk() == 0) {
+            return true;
+        }
+        return (*valid_data)[offset];
+    }
+
+    void
+    append_valid_data(FieldId field_id, int64_t size_per_chunk) {
+        valid_data_.emplace(field_id, std::make_unique<ConcurrentVector<bool>>(size_per_chunk));
     }
 
  private:
     //    std::vector<std::unique_ptr<VectorBase>> fields_data_;
     std::unordered_map<FieldId, std::unique_ptr<VectorBase>> fields_data_;
+    std::unordered_map<FieldId, std::unique_ptr<ConcurrentVector<bool>>> valid_data_;
 };
 
 }  // namespace milvus::segcore
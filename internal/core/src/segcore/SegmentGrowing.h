@@ -38,6 +38,10 @@ class SegmentGrowing : public SegmentInternalInterface {
            const Timestamp* timestamps,
            const InsertData* insert_data) = 0;
 
+    // add the fields of schema missing from the segment, filling the existing rows from default_data
+    virtual void
+    AddFields(SchemaPtr schema, const InsertData* default_data) = 0;
+
     // virtual int64_t
     // PreDelete(int64_t size) = 0;
 
@@ -82,6 +82,9 @@ SegmentGrowingImpl::Insert(int64_t reserved_offset,
         auto data_offset = field_id_to_offset[field_id];
         insert_record_.get_field_data_base(field_id)->set_data_raw(reserved_offset, size,
                                                                    &insert_data->fields_data(data_offset), field_meta);
+        if (field_meta.is_nullable()) {
+            set_valid_data(field_id, reserved_offset, size, insert_data->fields_data(data_offset));
+        }
     }
 
     // step 4: set pks to offset
@@ -101,6 +104,58 @@ SegmentGrowingImpl::Insert(int64_t reserved_offset,
     }
 }
 
+void
+SegmentGrowingImpl::set_valid_data(FieldId field_id,
+                                   int64_t reserved_offset,
+                                   int64_t size,
+                                   const DataArray& field_data) {
+    // empty valid data means all rows are valid
+    auto& valid_data = field_data.valid_data();
+    auto valid_vec = insert_record_.get_valid_data(field_id);
+    if (valid_data.empty()) {
+        auto all_valid = std::make_unique<bool[]>(size);
+        std::fill_n(all_valid.get(), size, true);
+        valid_vec->set_data_raw(reserved_offset, all_valid.get(), size);
+    } else {
+        AssertInfo(valid_data.size() == size, "length of valid data not equal to insert size");
+        valid_vec->set_data_raw(reserved_offset, valid_data.data(), size);
+    }
+}
+
+void
+SegmentGrowingImpl::AddFields(SchemaPtr schema, const InsertData* default_data) {
+    // the caller blocks inserts, so every reserved row has been inserted
+    auto size = insert_record_.ack_responder_.GetAck();
+    AssertInfo(insert_record_.reserved == size, "rows are being inserted while adding fields");
+    AssertInfo(default_data->num_rows() == size, "default data count not equal to row count");
+    std::unordered_map<FieldId, int64_t> field_id_to_offset;
+    int64_t field_offset = 0;
+    for (auto& field : default_data->fields_data()) {
+        auto field_id = FieldId(field.field_id());
+        AssertInfo(!field_id_to_offset.count(field_id), "duplicate field data");
+        field_id_to_offset.emplace(field_id, field_offset++);
+    }
+
+    for (auto& [field_id, field_meta] : schema->get_fields()) {
+        if (schema_->get_fields().count(field_id)) {
+            continue;
+        }
+        AssertInfo(field_id_to_offset.count(field_id), "Cannot find default data of field_id");
+        insert_record_.append_field_meta(field_id, field_meta, segcore_config_.get_chunk_rows());
+        if (size == 0) {
+            continue;
+        }
+        auto& field_data = default_data->fields_data(field_id_to_offset[field_id]);
+        insert_record_.get_field_data_base(field_id)->set_data_raw(0, size, &field_data, field_meta);
+        if (field_meta.is_nullable()) {
+            set_valid_data(field_id, 0, size, field_data);
+        }
+    }
+
+    retired_schemas_.push_back(std::move(schema_));
+    schema_ = std::move(schema);
+}
+
 Status
 SegmentGrowingImpl::Delete(int64_t reserved_begin, int64_t size, const IdArray* ids, const Timestamp* timestamps_raw) {
     auto field_id = schema_->get_primary_field_id().value_or(FieldId(-1));
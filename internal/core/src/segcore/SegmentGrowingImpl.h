@@ -48,6 +48,9 @@ class SegmentGrowingImpl : public SegmentGrowing {
            const Timestamp* timestamps,
            const InsertData* insert_data) override;
 
+    void
+    AddFields(SchemaPtr schema, const InsertData* default_data) override;
+
     int64_t
     PreDelete(int64_t size) override;
 
@@ -119,6 +122,11 @@ class SegmentGrowingImpl : public SegmentGrowing {
         return segcore_config_.get_chunk_rows();
     }
 
+    bool
+    is_valid(FieldId field_id, int64_t seg_offset) const final {
+        return insert_record_.is_valid(field_id, seg_offset);
+    }
+
  public:
     // only for debug
     void
@@ -217,9 +225,15 @@ class SegmentGrowingImpl : public SegmentGrowing {
         Assert(plan);
     }
 
+ private:
+    void
+    set_valid_data(FieldId field_id, int64_t reserved_offset, int64_t size, const DataArray& field_data);
+
  private:
     SegcoreConfig segcore_config_;
     SchemaPtr schema_;
+    // schemas replaced by AddFields, the indexing record still refers to them
+    std::vector<SchemaPtr> retired_schemas_;
 
     // small indexes for every chunk
     IndexingRecord indexing_record_;
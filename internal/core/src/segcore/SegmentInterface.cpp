@@ -9,6 +9,9 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <algorithm>
+#include <numeric>
+
 #include "SegmentInterface.h"
 #include "query/generated/ExecPlanNodeVisitor.h"
 #include "Utils.h"
@@ -45,10 +48,50 @@ SegmentInternalInterface::FillTargetEntry(const query::Plan* plan, SearchResult&
     // fill other entries except primary key by result_offset
     for (auto field_id : plan->target_entries_) {
         auto field_data = bulk_subscript(field_id, results.seg_offsets_.data(), size);
+        fill_valid_data(field_id, results.seg_offsets_.data(), size, field_data.get());
         results.output_fields_data_[field_id] = std::move(field_data);
     }
 }
 
+void
+SegmentInternalInterface::fill_valid_data(FieldId field_id,
+                                          const int64_t* seg_offsets,
+                                          int64_t count,
+                                          DataArray* output) const {
+    if (!get_schema()[field_id].is_nullable()) {
+        return;
+    }
+    auto valid_data = output->mutable_valid_data();
+    valid_data->Reserve(count);
+    for (int64_t i = 0; i < count; ++i) {
+        auto offset = seg_offsets[i];
+        valid_data->Add(offset != INVALID_SEG_OFFSET && is_valid(field_id, offset));
+    }
+}
+
+void
+SegmentInternalInterface::limit_by_pk(std::vector<int64_t>& seg_offsets, int64_t limit) const {
+    if (limit <= 0 || seg_offsets.size() <= static_cast<size_t>(limit)) {
+        return;
+    }
+    auto pk_field_id_opt = get_schema().get_primary_field_id();
+    AssertInfo(pk_field_id_opt.has_value(), "Cannot get primary key offset from schema");
+    auto field_data = bulk_subscript(pk_field_id_opt.value(), seg_offsets.data(), seg_offsets.size());
+    std::vector<PkType> pks(seg_offsets.size());
+    ParsePksFromFieldData(pks, *field_data.get());
+
+    // only the primary keys are read for all the matched rows, the other fields are read for the limited ones
+    std::vector<size_t> order(pks.size());
+    std::iota(order.begin(), order.end(), 0);
+    std::partial_sort(order.begin(), order.begin() + limit, order.end(),
+                      [&pks](size_t lhs, size_t rhs) { return pks[lhs] < pks[rhs]; });
+    std::vector<int64_t> limited(limit);
+    for (int64_t i = 0; i < limit; ++i) {
+        limited[i] = seg_offsets[order[i]];
+    }
+    seg_offsets = std::move(limited);
+}
+
 std::unique_ptr<SearchResult>
 SegmentInternalInterface::Search(const query::Plan* plan,
                                  const query::PlaceholderGroup* placeholder_group,
@@ -69,6 +112,7 @@ SegmentInternalInterface::Retrieve(const query::RetrievePlan* plan, Timestamp ti
     query::ExecPlanNodeVisitor visitor(*this, timestamp);
     auto retrieve_results = visitor.get_retrieve_result(*plan->plan_node_);
     retrieve_results.segment_ = (void*)this;
+    limit_by_pk(retrieve_results.result_offsets_, plan->plan_node_->limit_);
 
     results->mutable_offset()->Add(retrieve_results.result_offsets_.begin(), retrieve_results.result_offsets_.end());
 
@@ -80,6 +124,8 @@ SegmentInternalInterface::Retrieve(const query::RetrievePlan* plan, Timestamp ti
 
         auto col =
             bulk_subscript(field_id, retrieve_results.result_offsets_.data(), retrieve_results.result_offsets_.size());
+        fill_valid_data(field_id, retrieve_results.result_offsets_.data(), retrieve_results.result_offsets_.size(),
+                        col.get());
         auto col_data = col.release();
         fields_data->AddAllocated(col_data);
         if (pk_field_id.has_value() && pk_field_id.value() == field_id) {
@@ -162,6 +162,10 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual int64_t
     get_active_count(Timestamp ts) const = 0;
 
+    // validity of the row at seg_offset, rows of non-nullable fields are always valid
+    virtual bool
+    is_valid(FieldId field_id, int64_t seg_offset) const = 0;
+
     virtual std::vector<SegOffset>
     search_ids(const BitsetType& view, Timestamp timestamp) const = 0;
 
@@ -192,6 +196,14 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual void
     check_search(const query::Plan* plan) const = 0;
 
+    // fill the validity of the rows of a nullable field into output
+    void
+    fill_valid_data(FieldId field_id, const int64_t* seg_offsets, int64_t count, DataArray* output) const;
+
+    // keep the offsets of the limit rows with the smallest primary keys in order, all are kept if limit isn't positive
+    void
+    limit_by_pk(std::vector<int64_t>& seg_offsets, int64_t limit) const;
+
  protected:
     mutable std::shared_mutex mutex_;
 };
@@ -223,6 +223,20 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
         field_data->fill_chunk_data(size, info.field_data, field_meta);
         AssertInfo(field_data->num_chunk() == 1, "num chunk not equal to 1 for sealed segment");
 
+        // empty valid data means all rows are valid
+        if (field_meta.is_nullable()) {
+            auto& valid_data = info.field_data->valid_data();
+            auto valid_vec = insert_record_.get_valid_data(field_id);
+            if (valid_data.empty()) {
+                auto all_valid = std::make_unique<bool[]>(size);
+                std::fill_n(all_valid.get(), size, true);
+                valid_vec->fill_chunk_data(all_valid.get(), size);
+            } else {
+                AssertInfo(valid_data.size() == size, "length of valid data not equal to row count");
+                valid_vec->fill_chunk_data(valid_data.data(), size);
+            }
+        }
+
         // set pks to offset
         if (schema_->get_primary_field_id() == field_id) {
             AssertInfo(field_id.get() != -1, "Primary key is -1");
@@ -278,6 +292,11 @@ SegmentSealedImpl::num_chunk_data(FieldId field_id) const {
     return field_data->num_chunk();
 }
 
+bool
+SegmentSealedImpl::is_valid(FieldId field_id, int64_t seg_offset) const {
+    return insert_record_.is_valid(field_id, seg_offset);
+}
+
 int64_t
 SegmentSealedImpl::num_chunk() const {
     return 1;
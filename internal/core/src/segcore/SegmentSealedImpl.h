@@ -83,6 +83,9 @@ class SegmentSealedImpl : public SegmentSealed {
     int64_t
     size_per_chunk() const override;
 
+    bool
+    is_valid(FieldId field_id, int64_t seg_offset) const override;
+
     std::string
     debug() const override;
 
@@ -208,6 +208,10 @@ MergeDataArray(std::vector<std::pair<milvus::SearchResult*, int64_t>>& result_of
             continue;
         }
 
+        if (field_meta.is_nullable()) {
+            data_array->add_valid_data(src_field_data->valid_data(src_offset));
+        }
+
         auto scalar_array = data_array->mutable_scalars();
         switch (data_type) {
             case DataType::BOOL: {
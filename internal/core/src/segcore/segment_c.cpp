@@ -60,6 +60,17 @@ DeleteSearchResult(CSearchResult search_result) {
     delete res;
 }
 
+CSearchProfile
+GetSearchProfile(CSearchResult search_result) {
+    auto res = (milvus::SearchResult*)search_result;
+    CSearchProfile profile;
+    profile.scanned_rows = res->scanned_rows_;
+    profile.filtered_rows = res->filtered_rows_;
+    profile.filter_cost_us = res->filter_cost_us_;
+    profile.search_cost_us = res->search_cost_us_;
+    return profile;
+}
+
 CStatus
 Search(CSegmentInterface c_segment,
        CSearchPlan c_plan,
@@ -171,6 +182,25 @@ PreInsert(CSegmentInterface c_segment, int64_t size, int64_t* offset) {
     }
 }
 
+CStatus
+AddGrowingFields(CSegmentInterface c_segment,
+                 CCollection collection,
+                 const uint8_t* data_info,
+                 const uint64_t data_info_len) {
+    try {
+        auto segment = (milvus::segcore::SegmentGrowing*)c_segment;
+        auto col = (milvus::segcore::Collection*)collection;
+        auto default_data = std::make_unique<milvus::InsertData>();
+        auto suc = default_data->ParseFromArray(data_info, data_info_len);
+        AssertInfo(suc, "failed to parse default data from records");
+
+        segment->AddFields(col->get_schema(), default_data.get());
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
 CStatus
 Delete(CSegmentInterface c_segment,
        int64_t reserved_offset,
@@ -37,6 +37,16 @@ DeleteSegment(CSegmentInterface c_segment);
 void
 DeleteSearchResult(CSearchResult search_result);
 
+typedef struct CSearchProfile {
+    int64_t scanned_rows;
+    int64_t filtered_rows;
+    int64_t filter_cost_us;
+    int64_t search_cost_us;
+} CSearchProfile;
+
+CSearchProfile
+GetSearchProfile(CSearchResult search_result);
+
 CStatus
 Search(CSegmentInterface c_segment,
        CSearchPlan c_plan,
@@ -76,6 +86,12 @@ Insert(CSegmentInterface c_segment,
 CStatus
 PreInsert(CSegmentInterface c_segment, int64_t size, int64_t* offset);
 
+CStatus
+AddGrowingFields(CSegmentInterface c_segment,
+                 CCollection collection,
+                 const uint8_t* data_info,
+                 const uint64_t data_info_len);
+
 //////////////////////////////    interfaces for sealed segment    //////////////////////////////
 CStatus
 LoadFieldData(CSegmentInterface c_segment, CLoadFieldDataInfo load_field_data_info);
@@ -27,7 +27,10 @@ enum ColumnType : int {
     STRING = 20,
     VARCHAR = 21,
     VECTOR_BINARY = 100,
-    VECTOR_FLOAT = 101
+    VECTOR_FLOAT = 101,
+    VECTOR_SPARSE_FLOAT = 102,
+    VECTOR_FLOAT16 = 103,
+    VECTOR_BFLOAT16 = 104
 };
 
 enum ErrorCode : int {
@@ -35,6 +35,7 @@ struct PayloadWriter {
     ColumnType columnType;
     int dimension;  // binary vector, float vector
     std::shared_ptr<arrow::ArrayBuilder> builder;
+    std::shared_ptr<arrow::BooleanBuilder> valid_builder;  // validity of nullable field, written as column "valid"
     std::shared_ptr<arrow::Schema> schema;
     std::shared_ptr<PayloadOutputStream> output;
     int rows;
@@ -47,7 +48,9 @@ struct PayloadReader {
     std::shared_ptr<arrow::Table> table;
     std::shared_ptr<arrow::ChunkedArray> column;
     std::shared_ptr<arrow::Array> array;
+    std::shared_ptr<arrow::Array> valid_array;
     bool* bValues;
+    bool* validValues;
 };
 
 class PayloadOutputStream : public arrow::io::OutputStream {
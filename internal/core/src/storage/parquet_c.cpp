@@ -31,6 +31,7 @@ extern "C" CPayloadWriter
 NewPayloadWriter(int columnType) {
     auto p = new wrapper::PayloadWriter;
     p->builder = nullptr;
+    p->valid_builder = nullptr;
     p->schema = nullptr;
     p->output = nullptr;
     p->dimension = wrapper::EMPTY_DIMENSION;
@@ -90,11 +91,23 @@ NewPayloadWriter(int columnType) {
             p->dimension = wrapper::EMPTY_DIMENSION;
             break;
         }
+        case ColumnType::VECTOR_SPARSE_FLOAT: {
+            p->columnType = ColumnType::VECTOR_SPARSE_FLOAT;
+            p->builder = std::make_shared<arrow::BinaryBuilder>();
+            p->schema = arrow::schema({arrow::field("val", arrow::binary())});
+            break;
+        }
         case ColumnType::VECTOR_FLOAT: {
             p->columnType = ColumnType::VECTOR_FLOAT;
             p->dimension = wrapper::EMPTY_DIMENSION;
             break;
         }
+        case ColumnType::VECTOR_FLOAT16:
+        case ColumnType::VECTOR_BFLOAT16: {
+            p->columnType = static_cast<ColumnType>(columnType);
+            p->dimension = wrapper::EMPTY_DIMENSION;
+            break;
+        }
         default: {
             delete p;
             return nullptr;
@@ -204,6 +217,35 @@ AddOneStringToPayload(CPayloadWriter payloadWriter, char* cstr, int str_size) {
     return st;
 }
 
+extern "C" CStatus
+AddOneBinaryToPayload(CPayloadWriter payloadWriter, uint8_t* values, int length) {
+    CStatus st;
+    st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+    st.error_msg = nullptr;
+
+    auto p = reinterpret_cast<wrapper::PayloadWriter*>(payloadWriter);
+    auto builder = std::dynamic_pointer_cast<arrow::BinaryBuilder>(p->builder);
+    if (builder == nullptr || p->columnType != ColumnType::VECTOR_SPARSE_FLOAT) {
+        st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+        st.error_msg = ErrorMsg("incorrect data type");
+        return st;
+    }
+    if (p->output != nullptr) {
+        st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+        st.error_msg = ErrorMsg("payload has finished");
+        return st;
+    }
+    // an empty row is valid for sparse vectors, it means all elements are zero
+    auto ast = builder->Append(values, length);
+    if (!ast.ok()) {
+        st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+        st.error_msg = ErrorMsg(ast.message());
+        return st;
+    }
+    p->rows++;
+    return st;
+}
+
 extern "C" CStatus
 AddBinaryVectorToPayload(CPayloadWriter payloadWriter, uint8_t* values, int dimension, int length) {
     CStatus st;
@@ -298,6 +340,82 @@ AddFloatVectorToPayload(CPayloadWriter payloadWriter, float* values, int dimensi
     return st;
 }
 
+// Float16 and BFloat16 vectors share the same layout, 2 bytes per dimension.
+extern "C" CStatus
+AddFloat16VectorToPayload(CPayloadWriter payloadWriter, uint8_t* values, int dimension, int length) {
+    CStatus st;
+    st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+    st.error_msg = nullptr;
+    if (length <= 0)
+        return st;
+
+    auto p = reinterpret_cast<wrapper::PayloadWriter*>(payloadWriter);
+    if (p->columnType != ColumnType::VECTOR_FLOAT16 && p->columnType != ColumnType::VECTOR_BFLOAT16) {
+        st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+        st.error_msg = ErrorMsg("incorrect data type");
+        return st;
+    }
+    if (p->dimension == wrapper::EMPTY_DIMENSION) {
+        if (dimension <= 0) {
+            st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+            st.error_msg = ErrorMsg("incorrect dimension value");
+            return st;
+        }
+        p->builder = std::make_shared<arrow::FixedSizeBinaryBuilder>(arrow::fixed_size_binary(dimension * 2));
+        p->schema = arrow::schema({arrow::field("val", arrow::fixed_size_binary(dimension * 2))});
+        p->dimension = dimension;
+    } else if (p->dimension != dimension) {
+        st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+        st.error_msg = ErrorMsg("dimension changed");
+        return st;
+    }
+    auto builder = std::dynamic_pointer_cast<arrow::FixedSizeBinaryBuilder>(p->builder);
+    if (builder == nullptr) {
+        st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+        st.error_msg = ErrorMsg("incorrect data type");
+        return st;
+    }
+    if (p->output != nullptr) {
+        st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+        st.error_msg = ErrorMsg("payload has finished");
+        return st;
+    }
+    auto ast = builder->AppendValues(values, length);
+    if (!ast.ok()) {
+        st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+        st.error_msg = ErrorMsg(ast.message());
+        return st;
+    }
+    p->rows += length;
+    return st;
+}
+
+extern "C" CStatus
+AddValidDataToPayload(CPayloadWriter payloadWriter, bool* values, int length) {
+    CStatus st;
+    st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+    st.error_msg = nullptr;
+    if (length <= 0)
+        return st;
+
+    auto p = reinterpret_cast<wrapper::PayloadWriter*>(payloadWriter);
+    if (p->output != nullptr) {
+        st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+        st.error_msg = ErrorMsg("payload has finished");
+        return st;
+    }
+    if (p->valid_builder == nullptr) {
+        p->valid_builder = std::make_shared<arrow::BooleanBuilder>();
+    }
+    auto ast = p->valid_builder->AppendValues(values, values + length);
+    if (!ast.ok()) {
+        st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+        st.error_msg = ErrorMsg(ast.message());
+        return st;
+    }
+    return st;
+}
+
 extern "C" CStatus
 FinishPayloadWriter(CPayloadWriter payloadWriter) {
     CStatus st;
@@ -306,7 +424,7 @@ FinishPayloadWriter(CPayloadWriter payloadWriter) {
     auto p = reinterpret_cast<wrapper::PayloadWriter*>(payloadWriter);
     if (p->builder == nullptr) {
         if (p->dimension == wrapper::EMPTY_DIMENSION) {
-            // For FloatVector/BinaryVector datatype, the builder is lazily inited.
+            // For FloatVector/BinaryVector/Float16Vector/BFloat16Vector datatype, the builder is lazily inited.
             // Since wrapper::EMPTY_DIMENSION indicates the builder is not inited,
             // we simply return success here.
             return st;
@@ -325,7 +443,26 @@ FinishPayloadWriter(CPayloadWriter payloadWriter) {
             return st;
         }
 
-        auto table = arrow::Table::Make(p->schema, {array});
+        auto schema = p->schema;
+        std::vector<std::shared_ptr<arrow::Array>> columns{array};
+        if (p->valid_builder != nullptr) {
+            std::shared_ptr<arrow::Array> valid_array;
+            ast = p->valid_builder->Finish(&valid_array);
+            if (!ast.ok()) {
+                st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+                st.error_msg = ErrorMsg(ast.message());
+                return st;
+            }
+            if (valid_array->length() != array->length()) {
+                st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+                st.error_msg = ErrorMsg("length of valid data mismatch with payload");
+                return st;
+            }
+            schema = arrow::schema({p->schema->field(0), arrow::field("valid", arrow::boolean(), false)});
+            columns.push_back(valid_array);
+        }
+
+        auto table = arrow::Table::Make(schema, columns);
         p->output = std::make_shared<wrapper::PayloadOutputStream>();
         auto mem_pool = arrow::default_memory_pool();
         ast = parquet::arrow::WriteTable(
@@ -374,6 +511,7 @@ extern "C" CPayloadReader
 NewPayloadReader(int columnType, uint8_t* buffer, int64_t buf_size) {
     auto p = new wrapper::PayloadReader;
     p->bValues = nullptr;
+    p->validValues = nullptr;
     p->input = std::make_shared<wrapper::PayloadInputStream>(buffer, buf_size);
     auto mem_pool = arrow::default_memory_pool();
     auto st = parquet::arrow::OpenFile(p->input, mem_pool, &p->reader);
@@ -390,6 +528,9 @@ NewPayloadReader(int columnType, uint8_t* buffer, int64_t buf_size) {
     assert(p->column != nullptr);
     assert(p->column->chunks().size() == 1);
     p->array = p->column->chunk(0);
+    if (p->table->num_columns() > 1) {
+        p->valid_array = p->table->column(1)->chunk(0);
+    }
     switch (columnType) {
         case ColumnType::BOOL:
         case ColumnType::INT8:
@@ -401,7 +542,10 @@ NewPayloadReader(int columnType, uint8_t* buffer, int64_t buf_size) {
         case ColumnType::STRING:
         case ColumnType::VARCHAR:
         case ColumnType::VECTOR_BINARY:
-        case ColumnType::VECTOR_FLOAT: {
+        case ColumnType::VECTOR_FLOAT:
+        case ColumnType::VECTOR_SPARSE_FLOAT:
+        case ColumnType::VECTOR_FLOAT16:
+        case ColumnType::VECTOR_BFLOAT16: {
             break;
         }
         default: {
@@ -507,6 +651,29 @@ GetOneStringFromPayload(CPayloadReader payloadReader, int idx, char** cstr, int*
     return st;
 }
 
+extern "C" CStatus
+GetOneBinaryFromPayload(CPayloadReader payloadReader, int idx, uint8_t** values, int* length) {
+    CStatus st;
+    st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+    st.error_msg = nullptr;
+    auto p = reinterpret_cast<wrapper::PayloadReader*>(payloadReader);
+    auto array = std::dynamic_pointer_cast<arrow::BinaryArray>(p->array);
+    if (array == nullptr) {
+        st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+        st.error_msg = ErrorMsg("Incorrect data type");
+        return st;
+    }
+    if (idx >= array->length()) {
+        st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+        st.error_msg = ErrorMsg("memory overflow");
+        return st;
+    }
+    arrow::BinaryArray::offset_type value_length;
+    *values = (uint8_t*)array->GetValue(idx, &value_length);
+    *length = value_length;
+    return st;
+}
+
 extern "C" CStatus
 GetBinaryVectorFromPayload(CPayloadReader payloadReader, uint8_t** values, int* dimension, int* length) {
     CStatus st;
@@ -543,6 +710,54 @@ GetFloatVectorFromPayload(CPayloadReader payloadReader, float** values, int* dim
     return st;
 }
 
+extern "C" CStatus
+GetFloat16VectorFromPayload(CPayloadReader payloadReader, uint8_t** values, int* dimension, int* length) {
+    CStatus st;
+    st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+    st.error_msg = nullptr;
+    auto p = reinterpret_cast<wrapper::PayloadReader*>(payloadReader);
+    auto array = std::dynamic_pointer_cast<arrow::FixedSizeBinaryArray>(p->array);
+    if (array == nullptr) {
+        st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+        st.error_msg = ErrorMsg("Incorrect data type");
+        return st;
+    }
+    *dimension = array->byte_width() / 2;
+    *length = array->length();
+    *values = (uint8_t*)array->raw_values();
+    return st;
+}
+
+extern "C" CStatus
+GetValidDataFromPayload(CPayloadReader payloadReader, bool** values, int* length) {
+    CStatus st;
+    st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+    st.error_msg = nullptr;
+    auto p = reinterpret_cast<wrapper::PayloadReader*>(payloadReader);
+    if (p->valid_array == nullptr) {
+        // payload written without valid data, all rows are valid
+        *values = nullptr;
+        *length = 0;
+        return st;
+    }
+    if (p->validValues == nullptr) {
+        auto array = std::dynamic_pointer_cast<arrow::BooleanArray>(p->valid_array);
+        if (array == nullptr) {
+            st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+            st.error_msg = ErrorMsg("incorrect data type");
+            return st;
+        }
+        int len = array->length();
+        p->validValues = new bool[len];
+        for (int i = 0; i < len; i++) {
+            p->validValues[i] = array->Value(i);
+        }
+    }
+    *values = p->validValues;
+    *length = p->valid_array->length();
+    return st;
+}
+
 extern "C" int
 GetPayloadLengthFromReader(CPayloadReader payloadReader) {
     auto p = reinterpret_cast<wrapper::PayloadReader*>(payloadReader);
@@ -556,6 +771,7 @@ ReleasePayloadReader(CPayloadReader payloadReader) {
     auto p = reinterpret_cast<wrapper::PayloadReader*>(payloadReader);
     if (p != nullptr) {
         delete[] p->bValues;
+        delete[] p->validValues;
         delete p;
     }
     arrow::default_memory_pool()->ReleaseUnused();
@@ -54,9 +54,15 @@ AddDoubleToPayload(CPayloadWriter payloadWriter, double* values, int length);
 CStatus
 AddOneStringToPayload(CPayloadWriter payloadWriter, char* cstr, int str_size);
 CStatus
+AddOneBinaryToPayload(CPayloadWriter payloadWriter, uint8_t* values, int length);
+CStatus
 AddBinaryVectorToPayload(CPayloadWriter payloadWriter, uint8_t* values, int dimension, int length);
 CStatus
 AddFloatVectorToPayload(CPayloadWriter payloadWriter, float* values, int dimension, int length);
+CStatus
+AddFloat16VectorToPayload(CPayloadWriter payloadWriter, uint8_t* values, int dimension, int length);
+CStatus
+AddValidDataToPayload(CPayloadWriter payloadWriter, bool* values, int length);
 
 CStatus
 FinishPayloadWriter(CPayloadWriter payloadWriter);
@@ -88,9 +94,15 @@ GetDoubleFromPayload(CPayloadReader payloadReader, double** values, int* length)
 CStatus
 GetOneStringFromPayload(CPayloadReader payloadReader, int idx, char** cstr, int* str_size);
 CStatus
+GetOneBinaryFromPayload(CPayloadReader payloadReader, int idx, uint8_t** values, int* length);
+CStatus
 GetBinaryVectorFromPayload(CPayloadReader payloadReader, uint8_t** values, int* dimension, int* length);
 CStatus
 GetFloatVectorFromPayload(CPayloadReader payloadReader, float** values, int* dimension, int* length);
+CStatus
+GetFloat16VectorFromPayload(CPayloadReader payloadReader, uint8_t** values, int* dimension, int* length);
+CStatus
+GetValidDataFromPayload(CPayloadReader payloadReader, bool** values, int* length);
 
 int
 GetPayloadLengthFromReader(CPayloadReader payloadReader);
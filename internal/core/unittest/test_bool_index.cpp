@@ -13,6 +13,7 @@
 #include <knowhere/index/vector_index/helpers/IndexParameter.h>
 #include <pb/schema.pb.h>
 #include <index/BoolIndex.h>
+#include <index/BitmapIndex.h>
 #include "test_utils/indexbuilder_test_utils.h"
 
 class BoolIndexTest : public ::testing::Test {
@@ -207,3 +208,26 @@ TEST_F(BoolIndexTest, Codec) {
         }
     }
 }
+
+TEST_F(BoolIndexTest, Bitmap) {
+    auto true_test = std::make_unique<bool>(true);
+    auto false_test = std::make_unique<bool>(false);
+
+    auto index = milvus::scalar::CreateBitmapIndex<bool>();
+    index->BuildWithDataset(half_ds);
+    ASSERT_EQ(n, index->Count());
+    ASSERT_EQ(2, index->Cardinality());
+
+    auto binary_set = index->Serialize(nullptr);
+    auto copy_index = milvus::scalar::CreateBitmapIndex<bool>();
+    copy_index->Load(binary_set);
+    ASSERT_EQ(n, copy_index->Count());
+
+    auto bitset1 = copy_index->In(1, true_test.get());
+    auto bitset2 = copy_index->NotIn(1, true_test.get());
+    for (size_t i = 0; i < n; i++) {
+        ASSERT_EQ(bitset1->test(i), (i % 2) == 0);
+        ASSERT_EQ(bitset2->test(i), (i % 2) != 0);
+        ASSERT_EQ(copy_index->Reverse_Lookup(i), (i % 2) == 0);
+    }
+}
@@ -9,6 +9,7 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <algorithm>
 #include <gtest/gtest.h>
 
 #include "query/ExprImpl.h"
@@ -366,3 +367,41 @@ TEST(Retrieve, Delete) {
         ASSERT_EQ(field1_data.data_size(), DIM * size);
     }
 }
+
+TEST(Retrieve, Limit) {
+    auto schema = std::make_shared<Schema>();
+    auto fid_64 = schema->AddDebugField("i64", DataType::INT64);
+    auto DIM = 16;
+    auto fid_vec = schema->AddDebugField("vector_64", DataType::VECTOR_FLOAT, DIM, knowhere::metric::L2);
+    schema->set_primary_field_id(fid_64);
+
+    int64_t N = 100;
+    int64_t limit = 10;
+    auto dataset = DataGen(schema, N);
+    auto segment = CreateSealedSegment(schema);
+    SealedLoadFieldData(dataset, *segment);
+    auto i64_col = dataset.get_col<int64_t>(fid_64);
+
+    auto plan = std::make_unique<query::RetrievePlan>(*schema);
+    std::vector<int64_t> values(i64_col.begin(), i64_col.end());
+    auto term_expr = std::make_unique<query::TermExprImpl<int64_t>>(fid_64, DataType::INT64, values);
+    plan->plan_node_ = std::make_unique<query::RetrievePlanNode>();
+    plan->plan_node_->predicate_ = std::move(term_expr);
+    plan->plan_node_->limit_ = limit;
+    std::vector<FieldId> target_fields_id{fid_64, fid_vec};
+    plan->field_ids_ = target_fields_id;
+
+    auto retrieve_results = segment->Retrieve(plan.get(), 100);
+    Assert(retrieve_results->fields_data_size() == target_fields_id.size());
+    ASSERT_EQ(retrieve_results->offset_size(), limit);
+    auto field0_data = retrieve_results->fields_data(0).scalars().long_data();
+    ASSERT_EQ(field0_data.data_size(), limit);
+
+    // the smallest primary keys are retrieved in order
+    std::sort(values.begin(), values.end());
+    for (int i = 0; i < limit; ++i) {
+        ASSERT_EQ(field0_data.data(i), values[i]);
+    }
+    auto field1_data = retrieve_results->fields_data(1).vectors().float_vector();
+    ASSERT_EQ(field1_data.data_size(), DIM * limit);
+}
@@ -17,6 +17,7 @@
 package datacoord
 
 import (
+	"container/heap"
 	"context"
 	"errors"
 	"fmt"
@@ -33,6 +34,7 @@ import (
 // TODO we should split compaction into different priorities, small compaction helps to merge segment, large compaction helps to handle delta and expiration of large segments
 const (
 	maxParallelCompactionTaskNum      = 100
+	maxPendingCompactionTaskNum       = 1000
 	compactionTimeout                 = 10 * time.Second
 	compactionExpirationCheckInterval = 60 * time.Second
 )
@@ -48,10 +50,12 @@ type compactionPlanContext interface {
 	getCompaction(planID int64) *compactionTask
 	// expireCompaction set the compaction state to expired
 	expireCompaction(ts Timestamp) error
-	// isFull return true if the task pool is full
+	// isFull return true if the pending task queue is full
 	isFull() bool
 	// get compaction tasks by signal id
 	getCompactionTasksBySignalID(signalID int64) []*compactionTask
+	// cancelCompaction cancels the pending and executing tasks of the signal
+	cancelCompaction(signalID int64) error
 }
 
 type compactionTaskState int8
@@ -60,6 +64,8 @@ const (
 	executing compactionTaskState = iota + 1
 	completed
 	timeout
+	pending
+	cancelled
 )
 
 var (
@@ -90,8 +96,38 @@ func (t *compactionTask) shadowClone(opts ...compactionTaskOpt) *compactionTask
 
 var _ compactionPlanContext = (*compactionPlanHandler)(nil)
 
+// compactionQueue is a priority queue of pending compaction tasks, tasks with higher priority are popped first,
+// and tasks with the same priority are popped in the order of plan id
+type compactionQueue []*compactionTask
+
+func (q compactionQueue) Len() int { return len(q) }
+
+func (q compactionQueue) Less(i, j int) bool {
+	pi, pj := q[i].triggerInfo.getPriority(), q[j].triggerInfo.getPriority()
+	if pi != pj {
+		return pi > pj
+	}
+	return q[i].plan.GetPlanID() < q[j].plan.GetPlanID()
+}
+
+func (q compactionQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *compactionQueue) Push(x interface{}) {
+	*q = append(*q, x.(*compactionTask))
+}
+
+func (q *compactionQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	task := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return task
+}
+
 type compactionPlanHandler struct {
 	plans            map[int64]*compactionTask // planID -> task
+	pendingTasks     compactionQueue
 	sessions         *SessionManager
 	meta             *meta
 	chManager        *ChannelManager
@@ -151,6 +187,7 @@ func (c *compactionPlanHandler) stop() {
 }
 
 // execCompactionPlan start to execute plan and return immediately
+// the plan is queued if there are too many executing plans, and executed by priority later
 func (c *compactionPlanHandler) execCompactionPlan(signal *compactionSignal, plan *datapb.CompactionPlan) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -162,20 +199,42 @@ func (c *compactionPlanHandler) execCompactionPlan(signal *compactionSignal, pla
 
 	c.setSegmentsCompacting(plan, true)
 
-	// FIXME: check response of compaction call and restore segment state if failed
-	c.sessions.Compaction(nodeID, plan)
-
 	task := &compactionTask{
 		triggerInfo: signal,
 		plan:        plan,
-		state:       executing,
+		state:       pending,
 		dataNodeID:  nodeID,
 	}
 	c.plans[plan.PlanID] = task
-	c.executingTaskNum++
+	heap.Push(&c.pendingTasks, task)
+	c.schedule()
 	return nil
 }
 
+// schedule executes pending tasks by priority until the executing task pool is full, must be called with lock held
+func (c *compactionPlanHandler) schedule() {
+	for c.executingTaskNum < maxParallelCompactionTaskNum && c.pendingTasks.Len() > 0 {
+		task := heap.Pop(&c.pendingTasks).(*compactionTask)
+		plan := task.plan
+		// the channel may be moved to another node while the task is pending
+		nodeID, err := c.chManager.FindWatcher(plan.GetChannel())
+		if err != nil {
+			log.Warn("failed to execute pending compaction plan", zap.Int64("planID", plan.GetPlanID()), zap.Error(err))
+			c.setSegmentsCompacting(plan, false)
+			c.plans[plan.PlanID] = task.shadowClone(setState(timeout))
+			continue
+		}
+
+		// the timeout starts from the execution, rather than the time the plan is queued
+		plan.StartTime = tsoutil.ComposeTSByTime(time.Now(), 0)
+		// FIXME: check response of compaction call and restore segment state if failed
+		c.sessions.Compaction(nodeID, plan)
+
+		c.plans[plan.PlanID] = task.shadowClone(setState(executing), setDataNodeID(nodeID))
+		c.executingTaskNum++
+	}
+}
+
 func (c *compactionPlanHandler) setSegmentsCompacting(plan *datapb.CompactionPlan, compacting bool) {
 	for _, segmentBinlogs := range plan.GetSegmentBinlogs() {
 		c.meta.SetSegmentCompacting(segmentBinlogs.GetSegmentID(), compacting)
@@ -208,14 +267,25 @@ func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResu
 		}); err != nil {
 			return err
 		}
+	case datapb.CompactionType_ClusteringCompaction:
+		if err := c.meta.CompleteClusteringCompaction(plan.GetSegmentBinlogs(), result, func(segment *datapb.CompactionSegmentBinlogs) bool {
+			return !c.segRefer.HasSegmentLock(segment.SegmentID)
+		}); err != nil {
+			return err
+		}
 	default:
 		return errors.New("unknown compaction type")
 	}
 	c.plans[planID] = c.plans[planID].shadowClone(setState(completed), setResult(result))
 	c.executingTaskNum--
-	if c.plans[planID].plan.GetType() == datapb.CompactionType_MergeCompaction ||
-		c.plans[planID].plan.GetType() == datapb.CompactionType_MixCompaction {
+	c.schedule()
+	switch c.plans[planID].plan.GetType() {
+	case datapb.CompactionType_MergeCompaction, datapb.CompactionType_MixCompaction:
 		c.flushCh <- result.GetSegmentID()
+	case datapb.CompactionType_ClusteringCompaction:
+		for _, segment := range result.GetClusteredSegments() {
+			c.flushCh <- segment.GetSegmentID()
+		}
 	}
 	// TODO: when to clean task list
 
@@ -257,6 +327,7 @@ func (c *compactionPlanHandler) expireCompaction(ts Timestamp) error {
 		c.plans[planID] = c.plans[planID].shadowClone(setState(timeout))
 		c.executingTaskNum--
 	}
+	c.schedule()
 
 	return nil
 }
@@ -267,12 +338,12 @@ func (c *compactionPlanHandler) isTimeout(now Timestamp, start Timestamp, timeou
 	return int32(ts.Sub(startTime).Seconds()) >= timeout
 }
 
-// isFull return true if the task pool is full
+// isFull return true if the pending task queue is full
 func (c *compactionPlanHandler) isFull() bool {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	return c.executingTaskNum >= maxParallelCompactionTaskNum
+	return c.pendingTasks.Len() >= maxPendingCompactionTaskNum
 }
 
 func (c *compactionPlanHandler) getExecutingCompactions() []*compactionTask {
@@ -300,6 +371,51 @@ func (c *compactionPlanHandler) getCompactionTasksBySignalID(signalID int64) []*
 	return tasks
 }
 
+// cancelCompaction cancels the pending and executing tasks of the signal, and rolls back the compacting state of
+// their segments. The results of the cancelled tasks reported later are rejected.
+func (c *compactionPlanHandler) cancelCompaction(signalID int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var found bool
+	node2Plans := make(map[int64][]int64)
+	for planID, task := range c.plans {
+		if task.triggerInfo.id != signalID {
+			continue
+		}
+		found = true
+		switch task.state {
+		case executing:
+			node2Plans[task.dataNodeID] = append(node2Plans[task.dataNodeID], planID)
+			c.executingTaskNum--
+		case pending:
+		default:
+			continue
+		}
+		c.setSegmentsCompacting(task.plan, false)
+		c.plans[planID] = task.shadowClone(setState(cancelled))
+	}
+	if !found {
+		return fmt.Errorf("compaction %d is not found", signalID)
+	}
+
+	pendingTasks := c.pendingTasks[:0]
+	for _, task := range c.pendingTasks {
+		if task.triggerInfo.id != signalID {
+			pendingTasks = append(pendingTasks, task)
+		}
+	}
+	c.pendingTasks = pendingTasks
+	heap.Init(&c.pendingTasks)
+
+	for nodeID, planIDs := range node2Plans {
+		c.sessions.StopCompaction(nodeID, planIDs)
+	}
+	log.Info("compaction cancelled", zap.Int64("compactionID", signalID), zap.Any("stopped plans", node2Plans))
+	c.schedule()
+	return nil
+}
+
 type compactionTaskOpt func(task *compactionTask)
 
 func setState(state compactionTaskState) compactionTaskOpt {
@@ -308,6 +424,12 @@ func setState(state compactionTaskState) compactionTaskOpt {
 	}
 }
 
+func setDataNodeID(nodeID int64) compactionTaskOpt {
+	return func(task *compactionTask) {
+		task.dataNodeID = nodeID
+	}
+}
+
 func setResult(result *datapb.CompactionResult) compactionTaskOpt {
 	return func(task *compactionTask) {
 		task.result = result
@@ -0,0 +1,224 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"go.uber.org/zap"
+)
+
+// keys of collection properties overriding the single compaction policy of the collection
+const (
+	compactionDeleteRatioKey       = "compaction.delete.ratio"
+	compactionDeltalogMaxNumKey    = "compaction.deltalog.maxnum"
+	compactionDeltalogMaxSizeKey   = "compaction.deltalog.maxsize"
+	compactionExpiredLogMaxSizeKey = "compaction.expiredlog.maxsize"
+	compactionBinlogMaxNumKey      = "compaction.binlog.maxnum"
+	compactionSegmentMaxAgeKey     = "compaction.segment.maxage" // in seconds
+)
+
+// compactionPolicyConfig holds the thresholds of single compaction rules
+type compactionPolicyConfig struct {
+	deleteRatio       float64
+	deltalogMaxNum    int64
+	deltalogMaxSize   int64
+	expiredLogMaxSize int64
+	binlogMaxNum      int64
+	segmentMaxAge     time.Duration
+}
+
+// newCompactionPolicyConfig returns the thresholds configured in Params, overridden by the collection properties.
+// Invalid properties are ignored.
+func newCompactionPolicyConfig(properties []*commonpb.KeyValuePair) *compactionPolicyConfig {
+	config := &compactionPolicyConfig{
+		deleteRatio:       float64(Params.DataCoordCfg.SingleCompactionRatioThreshold),
+		deltalogMaxNum:    Params.DataCoordCfg.SingleCompactionDeltalogMaxNum,
+		deltalogMaxSize:   Params.DataCoordCfg.SingleCompactionDeltaLogMaxSize,
+		expiredLogMaxSize: Params.DataCoordCfg.SingleCompactionExpiredLogMaxSize,
+		binlogMaxNum:      Params.DataCoordCfg.SingleCompactionBinlogMaxNum,
+		segmentMaxAge:     Params.DataCoordCfg.SingleCompactionSegmentMaxAge,
+	}
+
+	for _, kv := range properties {
+		var err error
+		switch kv.GetKey() {
+		case compactionDeleteRatioKey:
+			err = parseFloatProperty(kv.GetValue(), &config.deleteRatio)
+		case compactionDeltalogMaxNumKey:
+			err = parseIntProperty(kv.GetValue(), &config.deltalogMaxNum)
+		case compactionDeltalogMaxSizeKey:
+			err = parseIntProperty(kv.GetValue(), &config.deltalogMaxSize)
+		case compactionExpiredLogMaxSizeKey:
+			err = parseIntProperty(kv.GetValue(), &config.expiredLogMaxSize)
+		case compactionBinlogMaxNumKey:
+			err = parseIntProperty(kv.GetValue(), &config.binlogMaxNum)
+		case compactionSegmentMaxAgeKey:
+			var seconds int64
+			if err = parseIntProperty(kv.GetValue(), &seconds); err == nil {
+				config.segmentMaxAge = time.Duration(seconds) * time.Second
+			}
+		}
+		if err != nil {
+			log.Warn("invalid compaction policy property, ignore it", zap.String("key", kv.GetKey()),
+				zap.String("value", kv.GetValue()), zap.Error(err))
+		}
+	}
+	return config
+}
+
+func parseFloatProperty(value string, dst *float64) error {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return err
+	}
+	*dst = v
+	return nil
+}
+
+func parseIntProperty(value string, dst *int64) error {
+	v, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return err
+	}
+	*dst = v
+	return nil
+}
+
+// segmentCompactionStats is the statistics of a segment the single compaction rules judge on
+type segmentCompactionStats struct {
+	logNum       int   // number of binlogs, deltalogs and statslogs
+	deltalogNum  int   // number of deltalogs
+	deletedRows  int64 // deleted rows before timetravel
+	deltalogSize int64 // size of deltalogs before timetravel
+	expiredRows  int64
+	expiredSize  int64
+	age          time.Duration
+
+	// single compaction only merge insert and delta log beyond the timetravel
+	// segment's insert binlogs dont have time range info, so we wait until the segment's last expire time is less than timetravel
+	// to ensure that all insert logs is beyond the timetravel.
+	// TODO: add meta in insert binlog
+	beyondTimetravel bool
+}
+
+func getSegmentCompactionStats(segment *SegmentInfo, compactTime *compactTime) *segmentCompactionStats {
+	stats := &segmentCompactionStats{
+		beyondTimetravel: segment.GetLastExpireTime() < compactTime.travelTime,
+	}
+
+	for _, binlogs := range segment.GetBinlogs() {
+		stats.logNum += len(binlogs.GetBinlogs())
+		for _, l := range binlogs.GetBinlogs() {
+			// TODO, we should probably estimate expired log entries by total rows in binlog and the ralationship of timeTo, timeFrom and expire time
+			if l.TimestampTo < compactTime.expireTime {
+				stats.expiredRows += l.GetEntriesNum()
+				stats.expiredSize += l.GetLogSize()
+			}
+		}
+	}
+
+	for _, deltaLogs := range segment.GetDeltalogs() {
+		stats.logNum += len(deltaLogs.GetBinlogs())
+		stats.deltalogNum += len(deltaLogs.GetBinlogs())
+		for _, l := range deltaLogs.GetBinlogs() {
+			if l.TimestampTo < compactTime.travelTime {
+				stats.deletedRows += l.GetEntriesNum()
+				stats.deltalogSize += l.GetLogSize()
+			}
+		}
+	}
+
+	for _, statsLogs := range segment.GetStatslogs() {
+		stats.logNum += len(statsLogs.GetBinlogs())
+	}
+
+	if startTs := segment.GetStartPosition().GetTimestamp(); startTs > 0 {
+		pts, _ := tsoutil.ParseTS(startTs)
+		stats.age = time.Since(pts)
+	}
+	return stats
+}
+
+// singleCompactionRule checks whether a segment should be compacted, and explains why if it should
+type singleCompactionRule func(segment *SegmentInfo, stats *segmentCompactionStats, config *compactionPolicyConfig) (bool, string)
+
+// singleCompactionRules are applied in order, a segment is compacted if any of the rules hits
+var singleCompactionRules = []singleCompactionRule{
+	binlogNumRule,
+	expiredRule,
+	deleteRatioRule,
+	deltalogSizeRule,
+	deltalogNumRule,
+	segmentAgeRule,
+}
+
+// binlogNumRule avoids segment has too many bin logs and the etcd meta is too large
+func binlogNumRule(segment *SegmentInfo, stats *segmentCompactionStats, config *compactionPolicyConfig) (bool, string) {
+	if int64(stats.logNum) > config.binlogMaxNum {
+		return true, fmt.Sprintf("binlog number %d exceeds %d", stats.logNum, config.binlogMaxNum)
+	}
+	return false, ""
+}
+
+// expiredRule compacts segment with too many expired entities if expire time is enabled
+func expiredRule(segment *SegmentInfo, stats *segmentCompactionStats, config *compactionPolicyConfig) (bool, string) {
+	if float64(stats.expiredRows)/float64(segment.GetNumOfRows()) >= config.deleteRatio {
+		return true, fmt.Sprintf("expired rows %d reach ratio %g of %d rows", stats.expiredRows, config.deleteRatio, segment.GetNumOfRows())
+	}
+	if stats.expiredSize > config.expiredLogMaxSize {
+		return true, fmt.Sprintf("expired log size %d exceeds %d", stats.expiredSize, config.expiredLogMaxSize)
+	}
+	return false, ""
+}
+
+// deleteRatioRule compacts segment whose deleted rows reach a ratio of total rows
+func deleteRatioRule(segment *SegmentInfo, stats *segmentCompactionStats, config *compactionPolicyConfig) (bool, string) {
+	if stats.beyondTimetravel && float64(stats.deletedRows)/float64(segment.GetNumOfRows()) >= config.deleteRatio {
+		return true, fmt.Sprintf("deleted rows %d reach ratio %g of %d rows", stats.deletedRows, config.deleteRatio, segment.GetNumOfRows())
+	}
+	return false, ""
+}
+
+// deltalogSizeRule compacts segment whose deltalogs are too large
+func deltalogSizeRule(segment *SegmentInfo, stats *segmentCompactionStats, config *compactionPolicyConfig) (bool, string) {
+	if stats.beyondTimetravel && stats.deltalogSize > config.deltalogMaxSize {
+		return true, fmt.Sprintf("deltalog size %d exceeds %d", stats.deltalogSize, config.deltalogMaxSize)
+	}
+	return false, ""
+}
+
+// deltalogNumRule merges the deltalogs of segment with too many deltalogs
+func deltalogNumRule(segment *SegmentInfo, stats *segmentCompactionStats, config *compactionPolicyConfig) (bool, string) {
+	if int64(stats.deltalogNum) > config.deltalogMaxNum {
+		return true, fmt.Sprintf("deltalog number %d exceeds %d", stats.deltalogNum, config.deltalogMaxNum)
+	}
+	return false, ""
+}
+
+// segmentAgeRule compacts old segment with deletions, even if the deletions are not heavy enough
+func segmentAgeRule(segment *SegmentInfo, stats *segmentCompactionStats, config *compactionPolicyConfig) (bool, string) {
+	if config.segmentMaxAge > 0 && stats.beyondTimetravel && stats.deletedRows > 0 && stats.age >= config.segmentMaxAge {
+		return true, fmt.Sprintf("segment with %d deleted rows is older than %v", stats.deletedRows, config.segmentMaxAge)
+	}
+	return false, ""
+}
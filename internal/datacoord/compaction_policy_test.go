@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_newCompactionPolicyConfig(t *testing.T) {
+	Params.Init()
+
+	config := newCompactionPolicyConfig(nil)
+	assert.Equal(t, float64(Params.DataCoordCfg.SingleCompactionRatioThreshold), config.deleteRatio)
+	assert.Equal(t, Params.DataCoordCfg.SingleCompactionDeltalogMaxNum, config.deltalogMaxNum)
+	assert.Equal(t, Params.DataCoordCfg.SingleCompactionBinlogMaxNum, config.binlogMaxNum)
+
+	config = newCompactionPolicyConfig([]*commonpb.KeyValuePair{
+		{Key: compactionDeleteRatioKey, Value: "0.5"},
+		{Key: compactionDeltalogMaxNumKey, Value: "10"},
+		{Key: compactionDeltalogMaxSizeKey, Value: "1024"},
+		{Key: compactionExpiredLogMaxSizeKey, Value: "2048"},
+		{Key: compactionBinlogMaxNumKey, Value: "invalid"},
+		{Key: compactionSegmentMaxAgeKey, Value: "3600"},
+		{Key: "unrelated", Value: "1"},
+	})
+	assert.Equal(t, 0.5, config.deleteRatio)
+	assert.Equal(t, int64(10), config.deltalogMaxNum)
+	assert.Equal(t, int64(1024), config.deltalogMaxSize)
+	assert.Equal(t, int64(2048), config.expiredLogMaxSize)
+	assert.Equal(t, Params.DataCoordCfg.SingleCompactionBinlogMaxNum, config.binlogMaxNum)
+	assert.Equal(t, time.Hour, config.segmentMaxAge)
+}
+
+func Test_singleCompactionRules(t *testing.T) {
+	Params.Init()
+
+	now := time.Now()
+	travelTime := tsoutil.ComposeTS(now.UnixNano()/int64(time.Millisecond), 0)
+	dayAgo := tsoutil.ComposeTS(now.Add(-24*time.Hour).UnixNano()/int64(time.Millisecond), 0)
+
+	var deltalogs []*datapb.Binlog
+	for i := 0; i < 20; i++ {
+		deltalogs = append(deltalogs, &datapb.Binlog{EntriesNum: 1, LogSize: 10, TimestampTo: dayAgo})
+	}
+	segment := &SegmentInfo{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID:             1,
+			NumOfRows:      1000,
+			LastExpireTime: dayAgo,
+			StartPosition:  &internalpb.MsgPosition{Timestamp: dayAgo},
+			Deltalogs:      []*datapb.FieldBinlog{{Binlogs: deltalogs}},
+		},
+	}
+	stats := getSegmentCompactionStats(segment, &compactTime{travelTime: travelTime})
+	assert.True(t, stats.beyondTimetravel)
+	assert.Equal(t, 20, stats.deltalogNum)
+	assert.Equal(t, int64(20), stats.deletedRows)
+	assert.Equal(t, int64(200), stats.deltalogSize)
+	assert.True(t, stats.age >= 24*time.Hour)
+
+	config := newCompactionPolicyConfig(nil)
+	for _, rule := range singleCompactionRules {
+		hit, _ := rule(segment, stats, config)
+		assert.False(t, hit)
+	}
+
+	config.deleteRatio = 0.01
+	hit, reason := deleteRatioRule(segment, stats, config)
+	assert.True(t, hit)
+	assert.NotEmpty(t, reason)
+
+	config.deltalogMaxNum = 10
+	hit, _ = deltalogNumRule(segment, stats, config)
+	assert.True(t, hit)
+
+	config.deltalogMaxSize = 100
+	hit, _ = deltalogSizeRule(segment, stats, config)
+	assert.True(t, hit)
+
+	config.segmentMaxAge = time.Hour
+	hit, _ = segmentAgeRule(segment, stats, config)
+	assert.True(t, hit)
+
+	// deletions within timetravel are not compactable
+	stats.beyondTimetravel = false
+	hit, _ = deleteRatioRule(segment, stats, config)
+	assert.False(t, hit)
+	hit, _ = segmentAgeRule(segment, stats, config)
+	assert.False(t, hit)
+}
@@ -561,6 +561,114 @@ func Test_getCompactionTasksBySignalID(t *testing.T) {
 	}
 }
 
+func newTestCompactionPlanHandler(ch chan interface{}) *compactionPlanHandler {
+	return &compactionPlanHandler{
+		plans: map[int64]*compactionTask{},
+		sessions: &SessionManager{
+			sessions: struct {
+				sync.RWMutex
+				data map[int64]*Session
+			}{
+				data: map[int64]*Session{
+					1: {client: &mockDataNodeClient{ch: ch}},
+				},
+			},
+		},
+		chManager: &ChannelManager{
+			store: &ChannelStore{
+				channelsInfo: map[int64]*NodeChannelInfo{
+					1: {NodeID: 1, Channels: []*channel{{Name: "ch1"}}},
+				},
+			},
+		},
+		meta: &meta{
+			segments: &SegmentsInfo{
+				map[int64]*SegmentInfo{
+					1: {SegmentInfo: &datapb.SegmentInfo{ID: 1}},
+					2: {SegmentInfo: &datapb.SegmentInfo{ID: 2}},
+					3: {SegmentInfo: &datapb.SegmentInfo{ID: 3}},
+				},
+			},
+		},
+	}
+}
+
+func Test_compactionPlanHandler_priority(t *testing.T) {
+	ch := make(chan interface{}, 10)
+	c := newTestCompactionPlanHandler(ch)
+	c.executingTaskNum = maxParallelCompactionTaskNum
+
+	for i, priority := range []int32{0, 10, 0} {
+		planID := int64(i + 1)
+		err := c.execCompactionPlan(&compactionSignal{id: 100, priority: priority}, &datapb.CompactionPlan{
+			PlanID:         planID,
+			Channel:        "ch1",
+			Type:           datapb.CompactionType_MixCompaction,
+			SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{SegmentID: planID}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, pending, c.getCompaction(planID).state)
+		assert.True(t, c.meta.GetSegment(planID).isCompacting)
+	}
+
+	// plans are executed by priority, and then by plan id
+	for _, planID := range []int64{2, 1, 3} {
+		c.mu.Lock()
+		c.executingTaskNum--
+		c.schedule()
+		c.mu.Unlock()
+		<-ch
+		assert.Equal(t, executing, c.getCompaction(planID).state)
+		assert.Equal(t, int64(1), c.getCompaction(planID).dataNodeID)
+	}
+	assert.Equal(t, 0, c.pendingTasks.Len())
+	assert.Equal(t, maxParallelCompactionTaskNum, c.executingTaskNum)
+}
+
+func Test_compactionPlanHandler_cancelCompaction(t *testing.T) {
+	ch := make(chan interface{}, 10)
+	c := newTestCompactionPlanHandler(ch)
+	c.executingTaskNum = maxParallelCompactionTaskNum - 1
+
+	for i := 1; i <= 3; i++ {
+		planID := int64(i)
+		signalID := int64(100)
+		if i == 3 {
+			signalID = 200
+		}
+		err := c.execCompactionPlan(&compactionSignal{id: signalID}, &datapb.CompactionPlan{
+			PlanID:         planID,
+			Channel:        "ch1",
+			Type:           datapb.CompactionType_MixCompaction,
+			SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{SegmentID: planID}},
+		})
+		assert.Nil(t, err)
+	}
+	<-ch
+	assert.Equal(t, executing, c.getCompaction(1).state)
+	assert.Equal(t, pending, c.getCompaction(2).state)
+	assert.Equal(t, pending, c.getCompaction(3).state)
+
+	err := c.cancelCompaction(100)
+	assert.Nil(t, err)
+	for _, planID := range []int64{1, 2} {
+		assert.Equal(t, cancelled, c.getCompaction(planID).state)
+		assert.False(t, c.meta.GetSegment(planID).isCompacting)
+	}
+	// the slot released by the cancelled plan is taken by the pending plan of another compaction
+	<-ch
+	assert.Equal(t, executing, c.getCompaction(3).state)
+	assert.True(t, c.meta.GetSegment(3).isCompacting)
+	assert.Equal(t, 0, c.pendingTasks.Len())
+
+	// result of the cancelled plan is rejected
+	err = c.completeCompaction(&datapb.CompactionResult{PlanID: 1})
+	assert.Error(t, err)
+
+	err = c.cancelCompaction(300)
+	assert.Error(t, err)
+}
+
 func getFieldBinlogPaths(id int64, paths ...string) *datapb.FieldBinlog {
 	l := &datapb.FieldBinlog{
 		FieldID: id,
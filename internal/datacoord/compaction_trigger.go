@@ -18,13 +18,20 @@ package datacoord
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sort"
 	"sync"
 	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/logutil"
 	"go.uber.org/zap"
 )
@@ -42,7 +49,9 @@ type trigger interface {
 	// triggerSingleCompaction triggers a compaction bundled with collection-partition-channel-segment
 	triggerSingleCompaction(collectionID, partitionID, segmentID int64, channel string, compactTime *compactTime) error
 	// forceTriggerCompaction force to start a compaction
-	forceTriggerCompaction(collectionID int64, compactTime *compactTime) (UniqueID, error)
+	forceTriggerCompaction(collectionID int64, compactTime *compactTime, opts ...compactionSignalOpt) (UniqueID, error)
+	// getCompactionPolicyStats lists the segments of a collection and why they are selected by compaction policy or not
+	getCompactionPolicyStats(collectionID int64, compactTime *compactTime) []*milvuspb.SegmentCompactionStats
 }
 
 type compactionSignal struct {
@@ -54,6 +63,61 @@ type compactionSignal struct {
 	segmentID    UniqueID
 	channel      string
 	compactTime  *compactTime
+
+	// scope and preference of manual compaction
+	partitionIDs []UniqueID
+	segmentIDs   []UniqueID
+	targetSize   int64 // in MB
+	priority     int32
+}
+
+func (s *compactionSignal) getPriority() int32 {
+	if s == nil {
+		return 0
+	}
+	return s.priority
+}
+
+// inScope returns whether the segment is in the scope of the signal
+func (s *compactionSignal) inScope(segment *SegmentInfo) bool {
+	switch {
+	case len(s.segmentIDs) > 0:
+		return funcutil.SliceContain(s.segmentIDs, segment.GetID())
+	case len(s.partitionIDs) > 0:
+		return funcutil.SliceContain(s.partitionIDs, segment.GetPartitionID())
+	default:
+		return true
+	}
+}
+
+type compactionSignalOpt func(signal *compactionSignal)
+
+// withPartitions limits manual compaction to the given partitions
+func withPartitions(partitionIDs []UniqueID) compactionSignalOpt {
+	return func(signal *compactionSignal) {
+		signal.partitionIDs = partitionIDs
+	}
+}
+
+// withSegments limits manual compaction to the given segments
+func withSegments(segmentIDs []UniqueID) compactionSignalOpt {
+	return func(signal *compactionSignal) {
+		signal.segmentIDs = segmentIDs
+	}
+}
+
+// withTargetSize sets the target segment size in MB of manual compaction
+func withTargetSize(size int64) compactionSignalOpt {
+	return func(signal *compactionSignal) {
+		signal.targetSize = size
+	}
+}
+
+// withPriority sets the priority of the plans of manual compaction
+func withPriority(priority int32) compactionSignalOpt {
+	return func(signal *compactionSignal) {
+		signal.priority = priority
+	}
 }
 
 var _ trigger = (*compactionTrigger)(nil)
@@ -190,22 +254,53 @@ func (t *compactionTrigger) triggerSingleCompaction(collectionID, partitionID, s
 
 // forceTriggerCompaction force to start a compaction
 // invoked by user `ManualCompaction` operation
-func (t *compactionTrigger) forceTriggerCompaction(collectionID int64, compactTime *compactTime) (UniqueID, error) {
-	id, err := t.allocSignalID()
-	if err != nil {
-		return -1, err
-	}
+func (t *compactionTrigger) forceTriggerCompaction(collectionID int64, compactTime *compactTime, opts ...compactionSignalOpt) (UniqueID, error) {
 	signal := &compactionSignal{
-		id:           id,
 		isForce:      true,
 		isGlobal:     true,
 		collectionID: collectionID,
 		compactTime:  compactTime,
 	}
+	for _, opt := range opts {
+		opt(signal)
+	}
+	if err := t.validateManualSignal(signal); err != nil {
+		return -1, err
+	}
+
+	id, err := t.allocSignalID()
+	if err != nil {
+		return -1, err
+	}
+	signal.id = id
 	t.handleGlobalSignal(signal)
 	return id, nil
 }
 
+// validateManualSignal checks the segments specified by manual compaction could be compacted
+func (t *compactionTrigger) validateManualSignal(signal *compactionSignal) error {
+	if len(signal.segmentIDs) > 0 && len(signal.partitionIDs) > 0 {
+		return errors.New("partitionIDs and segmentIDs can not be specified at the same time")
+	}
+	if signal.targetSize < 0 {
+		return fmt.Errorf("invalid target segment size %d", signal.targetSize)
+	}
+	for _, segmentID := range signal.segmentIDs {
+		segment := t.meta.GetSegment(segmentID)
+		switch {
+		case segment == nil || segment.GetCollectionID() != signal.collectionID:
+			return fmt.Errorf("segment %d is not found in collection %d", segmentID, signal.collectionID)
+		case !isSegmentHealthy(segment) || !isFlush(segment):
+			return fmt.Errorf("segment %d is not flushed", segmentID)
+		case segment.isCompacting:
+			return fmt.Errorf("segment %d is compacting", segmentID)
+		case t.segRefer.HasSegmentLock(segmentID):
+			return fmt.Errorf("segment %d is referenced", segmentID)
+		}
+	}
+	return nil
+}
+
 func (t *compactionTrigger) allocSignalID() (UniqueID, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -226,17 +321,34 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) {
 
 	m := t.meta.GetSegmentsChanPart(func(segment *SegmentInfo) bool {
 		return (signal.collectionID == 0 || segment.CollectionID == signal.collectionID) &&
+			signal.inScope(segment) &&
 			isSegmentHealthy(segment) &&
 			isFlush(segment) &&
 			!segment.isCompacting && // not compacting now
 			!t.segRefer.HasSegmentLock(segment.ID) // not reference
 	}) // m is list of chanPartSegments, which is channel-partition organized segments
+
+	// manual compaction of a collection with clustering key co-locates rows by the key
+	var clusteringKey *schemapb.FieldSchema
+	if signal.isForce && signal.collectionID != 0 {
+		clusteringKey = t.getClusteringKey(signal.collectionID)
+	}
 	for _, group := range m {
 		if !signal.isForce && t.compactionHandler.isFull() {
 			break
 		}
 
-		plans := t.generatePlans(group.segments, signal.isForce, signal.compactTime)
+		compactTime := t.getCollectionCompactTime(group.collecionID, signal.compactTime)
+		segments := group.segments
+		if signal.targetSize > 0 {
+			segments = withTargetSegmentSize(segments, signal.targetSize)
+		}
+		var plans []*datapb.CompactionPlan
+		if clusteringKey != nil {
+			plans = t.generateClusteringPlans(segments, clusteringKey.GetFieldID(), compactTime)
+		} else {
+			plans = t.generatePlans(segments, signal.isForce, compactTime)
+		}
 		for _, plan := range plans {
 			if !signal.isForce && t.compactionHandler.isFull() {
 				log.Warn("compaction plan skipped due to handler full", zap.Int64("collection", signal.collectionID), zap.Int64("planID", plan.PlanID))
@@ -264,6 +376,12 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) {
 	}
 }
 
+// getCollectionCompactTime returns the compact time honouring the time travel retention of the collection.
+func (t *compactionTrigger) getCollectionCompactTime(collectionID UniqueID, compactTime *compactTime) *compactTime {
+	retention := getCollectionRetention(collectionID, t.meta.GetCollection(collectionID).GetProperties())
+	return compactTime.withRetention(retention)
+}
+
 // handleSignal processes segment flush caused partition-chan level compaction signal
 func (t *compactionTrigger) handleSignal(signal *compactionSignal) {
 	t.forceMu.Lock()
@@ -283,7 +401,8 @@ func (t *compactionTrigger) handleSignal(signal *compactionSignal) {
 	channel := segment.GetInsertChannel()
 	partitionID := segment.GetPartitionID()
 	segments := t.getCandidateSegments(channel, partitionID)
-	plans := t.generatePlans(segments, signal.isForce, signal.compactTime)
+	compactTime := t.getCollectionCompactTime(segment.GetCollectionID(), signal.compactTime)
+	plans := t.generatePlans(segments, signal.isForce, compactTime)
 	for _, plan := range plans {
 		if t.compactionHandler.isFull() {
 			log.Warn("compaction plan skipped due to handler full", zap.Int64("collection", signal.collectionID), zap.Int64("planID", plan.PlanID))
@@ -405,6 +524,89 @@ func (t *compactionTrigger) generatePlans(segments []*SegmentInfo, force bool, c
 	return plans
 }
 
+// getClusteringKey returns the clustering key field of the collection, nil if not specified
+func (t *compactionTrigger) getClusteringKey(collectionID UniqueID) *schemapb.FieldSchema {
+	collection := t.meta.GetCollection(collectionID)
+	if collection == nil {
+		return nil
+	}
+	for _, field := range collection.GetSchema().GetFields() {
+		if field.GetIsClusteringKey() {
+			return field
+		}
+	}
+	return nil
+}
+
+// generateClusteringPlans generates plans to cluster the segments not clustered yet.
+// The first clustering of a channel partition puts all the segments into one plan, so the clustered segments cover
+// disjoint key ranges. The min keys of the clustered segments bound the later plans, the segments clustered by
+// different plans overlap only if they fall into the same bound range.
+func (t *compactionTrigger) generateClusteringPlans(segments []*SegmentInfo, clusteringKeyID UniqueID, compactTime *compactTime) []*datapb.CompactionPlan {
+	var candidates []*SegmentInfo
+	var bounds []*planpb.GenericValue
+	for _, segment := range segments {
+		keyRange := segment.GetClusteringKeyRange()
+		if keyRange.GetFieldID() != clusteringKeyID {
+			candidates = append(candidates, segment.ShadowClone())
+		} else if keyRange.GetMin() != nil {
+			bounds = append(bounds, keyRange.GetMin())
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].GetID() < candidates[j].GetID()
+	})
+	bounds = sortClusteringKeyBounds(bounds)
+
+	var plans []*datapb.CompactionPlan
+	for len(candidates) > 0 {
+		size := len(candidates)
+		if len(bounds) > 0 && size > Params.DataCoordCfg.MaxSegmentToMerge {
+			size = Params.DataCoordCfg.MaxSegmentToMerge
+		}
+		bucket := candidates[:size]
+		candidates = candidates[size:]
+
+		plan := segmentsToPlan(bucket, compactTime)
+		plan.Type = datapb.CompactionType_ClusteringCompaction
+		plan.ClusteringKeyFieldID = clusteringKeyID
+		plan.MaxSegmentRows = bucket[0].GetMaxRowNum()
+		plan.ClusteringKeyBounds = bounds
+		log.Info("generate a clustering plan", zap.Any("plan", plan), zap.Int64("clustering key", clusteringKeyID))
+		plans = append(plans, plan)
+	}
+	return plans
+}
+
+// withTargetSegmentSize returns the clones of segments, whose max row number is scaled to the target size in MB,
+// so that the plans generated merge segments up to the target size instead of dataCoord.segment.maxSize
+func withTargetSegmentSize(segments []*SegmentInfo, size int64) []*SegmentInfo {
+	ratio := float64(size) / Params.DataCoordCfg.SegmentMaxSize
+	cloned := make([]*SegmentInfo, 0, len(segments))
+	for _, segment := range segments {
+		cloned = append(cloned, segment.Clone(SetMaxRowNum(int64(float64(segment.GetMaxRowNum())*ratio))))
+	}
+	return cloned
+}
+
+// sortClusteringKeyBounds sorts the bounds in ascending order and removes the duplicated ones
+func sortClusteringKeyBounds(bounds []*planpb.GenericValue) []*planpb.GenericValue {
+	sort.SliceStable(bounds, func(i, j int) bool {
+		ret, _ := planparserv2.CompareValue(bounds[i], bounds[j])
+		return ret < 0
+	})
+	var sorted []*planpb.GenericValue
+	for _, bound := range bounds {
+		if len(sorted) > 0 {
+			if ret, ok := planparserv2.CompareValue(sorted[len(sorted)-1], bound); ok && ret == 0 {
+				continue
+			}
+		}
+		sorted = append(sorted, bound)
+	}
+	return sorted
+}
+
 func segmentsToPlan(segments []*SegmentInfo, compactTime *compactTime) *datapb.CompactionPlan {
 	plan := &datapb.CompactionPlan{
 		Timetravel: compactTime.travelTime,
@@ -490,72 +692,66 @@ func (t *compactionTrigger) fillOriginPlan(plan *datapb.CompactionPlan) error {
 }
 
 func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, compactTime *compactTime) bool {
-	// count all the binlog file count
-	var totalLogNum int
-	for _, binlogs := range segment.GetBinlogs() {
-		totalLogNum += len(binlogs.GetBinlogs())
-	}
-
-	for _, deltaLogs := range segment.GetDeltalogs() {
-		totalLogNum += len(deltaLogs.GetBinlogs())
+	hit, reasons := t.applySingleCompactionRules(segment, compactTime)
+	if hit {
+		log.Info("segment hits single compaction rules, trigger compaction", zap.Int64("segment", segment.ID),
+			zap.Strings("reasons", reasons))
 	}
+	return hit
+}
 
-	for _, statsLogs := range segment.GetStatslogs() {
-		totalLogNum += len(statsLogs.GetBinlogs())
-	}
-	// avoid segment has too many bin logs and the etcd meta is too large, force trigger compaction
-	if totalLogNum > int(Params.DataCoordCfg.SingleCompactionBinlogMaxNum) {
-		log.Info("total binlog number is too much, trigger compaction", zap.Int64("segment", segment.ID),
-			zap.Int("Delta logs", len(segment.GetDeltalogs())), zap.Int("Bin Logs", len(segment.GetBinlogs())), zap.Int("Stat logs", len(segment.GetStatslogs())))
-		return true
-	}
+// applySingleCompactionRules applies the rules with the policy config of the collection, returns reasons of the hit rules
+func (t *compactionTrigger) applySingleCompactionRules(segment *SegmentInfo, compactTime *compactTime) (bool, []string) {
+	config := newCompactionPolicyConfig(t.meta.GetCollection(segment.GetCollectionID()).GetProperties())
+	stats := getSegmentCompactionStats(segment, compactTime)
 
-	// if expire time is enabled, put segment into compaction candidate
-	totalExpiredSize := int64(0)
-	totalExpiredRows := 0
-	for _, binlogs := range segment.GetBinlogs() {
-		for _, l := range binlogs.GetBinlogs() {
-			// TODO, we should probably estimate expired log entries by total rows in binlog and the ralationship of timeTo, timeFrom and expire time
-			if l.TimestampTo < compactTime.expireTime {
-				totalExpiredRows += int(l.GetEntriesNum())
-				totalExpiredSize += l.GetLogSize()
-			}
+	var reasons []string
+	for _, rule := range singleCompactionRules {
+		if hit, reason := rule(segment, stats, config); hit {
+			reasons = append(reasons, reason)
 		}
 	}
+	return len(reasons) > 0, reasons
+}
 
-	if float32(totalExpiredRows)/float32(segment.GetNumOfRows()) >= Params.DataCoordCfg.SingleCompactionRatioThreshold || totalExpiredSize > Params.DataCoordCfg.SingleCompactionExpiredLogMaxSize {
-		log.Info("total expired entities is too much, trigger compation", zap.Int64("segment", segment.ID),
-			zap.Int("expired rows", totalExpiredRows), zap.Int64("expired log size", totalExpiredSize))
-		return true
-	}
+// getCompactionPolicyStats lists the flushed segments of the collection, and why they are selected by single compaction or not
+func (t *compactionTrigger) getCompactionPolicyStats(collectionID int64, compactTime *compactTime) []*milvuspb.SegmentCompactionStats {
+	segments := t.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.CollectionID == collectionID && isSegmentHealthy(segment) && isFlush(segment)
+	})
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].GetID() < segments[j].GetID()
+	})
 
-	// single compaction only merge insert and delta log beyond the timetravel
-	// segment's insert binlogs dont have time range info, so we wait until the segment's last expire time is less than timetravel
-	// to ensure that all insert logs is beyond the timetravel.
-	// TODO: add meta in insert binlog
-	if segment.LastExpireTime >= compactTime.travelTime {
-		return false
-	}
+	compactTime = t.getCollectionCompactTime(collectionID, compactTime)
+	result := make([]*milvuspb.SegmentCompactionStats, 0, len(segments))
+	for _, segment := range segments {
+		stats := getSegmentCompactionStats(segment, compactTime)
+		segmentStats := &milvuspb.SegmentCompactionStats{
+			SegmentID:    segment.GetID(),
+			PartitionID:  segment.GetPartitionID(),
+			Channel:      segment.GetInsertChannel(),
+			NumOfRows:    segment.GetNumOfRows(),
+			DeletedRows:  stats.deletedRows,
+			DeltalogNum:  int64(stats.deltalogNum),
+			DeltalogSize: stats.deltalogSize,
+			BinlogNum:    int64(stats.logNum),
+		}
 
-	totalDeletedRows := 0
-	totalDeleteLogSize := int64(0)
-	for _, deltaLogs := range segment.GetDeltalogs() {
-		for _, l := range deltaLogs.GetBinlogs() {
-			if l.TimestampTo < compactTime.travelTime {
-				totalDeletedRows += int(l.GetEntriesNum())
-				totalDeleteLogSize += l.GetLogSize()
+		switch {
+		case segment.isCompacting:
+			segmentStats.Reasons = []string{"segment is compacting"}
+		case t.segRefer.HasSegmentLock(segment.ID):
+			segmentStats.Reasons = []string{"segment is referenced"}
+		default:
+			segmentStats.Selected, segmentStats.Reasons = t.applySingleCompactionRules(segment, compactTime)
+			if !segmentStats.Selected {
+				segmentStats.Reasons = []string{"no rule hits"}
 			}
 		}
+		result = append(result, segmentStats)
 	}
-
-	// currently delta log size and delete ratio policy is applied
-	if float32(totalDeletedRows)/float32(segment.GetNumOfRows()) >= Params.DataCoordCfg.SingleCompactionRatioThreshold || totalDeleteLogSize > Params.DataCoordCfg.SingleCompactionDeltaLogMaxSize {
-		log.Info("total delete entities is too much, trigger compation", zap.Int64("segment", segment.ID),
-			zap.Int("deleted rows", totalDeletedRows), zap.Int64("delete log size", totalDeleteLogSize))
-		return true
-	}
-
-	return false
+	return result
 }
 
 func isFlush(segment *SegmentInfo) bool {
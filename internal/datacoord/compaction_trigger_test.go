@@ -24,6 +24,8 @@ import (
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -63,6 +65,11 @@ func (h *spyCompactionHandler) getCompactionTasksBySignalID(signalID int64) []*c
 	panic("not implemented") // TODO: Implement
 }
 
+// cancelCompaction cancels the pending and executing tasks of the signal
+func (h *spyCompactionHandler) cancelCompaction(signalID int64) error {
+	panic("not implemented") // TODO: Implement
+}
+
 func (h *spyCompactionHandler) start() {}
 
 func (h *spyCompactionHandler) stop() {}
@@ -992,3 +999,211 @@ func Test_handleSignal(t *testing.T) {
 		got.handleSignal(signal)
 	})
 }
+
+func Test_compactionTrigger_generateClusteringPlans(t *testing.T) {
+	Params.Init()
+	newSegment := func(id int64, keyRange *datapb.ClusteringKeyRange) *SegmentInfo {
+		return &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+			ID:                 id,
+			CollectionID:       1,
+			PartitionID:        1,
+			InsertChannel:      "ch1",
+			NumOfRows:          10,
+			MaxRowNum:          300,
+			State:              commonpb.SegmentState_Flushed,
+			ClusteringKeyRange: keyRange,
+		}}
+	}
+	trigger := newCompactionTrigger(&meta{
+		collections: map[UniqueID]*datapb.CollectionInfo{
+			1: {ID: 1, Schema: &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, DataType: schemapb.DataType_Int64, IsClusteringKey: true},
+			}}},
+			2: {ID: 2, Schema: &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			}}},
+		},
+	}, &compactionPlanHandler{}, newMockAllocator(), &SegmentReferenceManager{segmentsLock: map[UniqueID]map[UniqueID]*datapb.SegmentReferenceLock{}})
+
+	assert.Equal(t, int64(101), trigger.getClusteringKey(1).GetFieldID())
+	assert.Nil(t, trigger.getClusteringKey(2))
+	assert.Nil(t, trigger.getClusteringKey(3))
+
+	keyRange := func(min, max int64) *datapb.ClusteringKeyRange {
+		return &datapb.ClusteringKeyRange{
+			FieldID: 101,
+			Min:     &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: min}},
+			Max:     &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: max}},
+		}
+	}
+	segments := []*SegmentInfo{
+		newSegment(3, nil),
+		newSegment(1, nil),
+		newSegment(2, keyRange(10, 19)),
+		newSegment(4, keyRange(0, 9)),
+		newSegment(5, keyRange(10, 15)),
+	}
+	plans := trigger.generateClusteringPlans(segments, 101, &compactTime{travelTime: 200})
+	assert.Equal(t, 1, len(plans))
+	assert.Equal(t, datapb.CompactionType_ClusteringCompaction, plans[0].GetType())
+	assert.Equal(t, int64(101), plans[0].GetClusteringKeyFieldID())
+	assert.Equal(t, int64(300), plans[0].GetMaxSegmentRows())
+	assert.Equal(t, uint64(200), plans[0].GetTimetravel())
+	// segment clustered before is not compacted again
+	assert.Equal(t, 2, len(plans[0].GetSegmentBinlogs()))
+	assert.Equal(t, int64(1), plans[0].GetSegmentBinlogs()[0].GetSegmentID())
+	assert.Equal(t, int64(3), plans[0].GetSegmentBinlogs()[1].GetSegmentID())
+	// the min keys of the clustered segments bound the plan
+	assert.Equal(t, 2, len(plans[0].GetClusteringKeyBounds()))
+	assert.Equal(t, int64(0), plans[0].GetClusteringKeyBounds()[0].GetInt64Val())
+	assert.Equal(t, int64(10), plans[0].GetClusteringKeyBounds()[1].GetInt64Val())
+
+	plans = trigger.generateClusteringPlans(segments[2:], 101, &compactTime{travelTime: 200})
+	assert.Empty(t, plans)
+
+	// the first clustering puts all segments into one plan
+	var unclustered []*SegmentInfo
+	for i := int64(0); i < int64(Params.DataCoordCfg.MaxSegmentToMerge)+1; i++ {
+		unclustered = append(unclustered, newSegment(i+10, nil))
+	}
+	plans = trigger.generateClusteringPlans(unclustered, 101, &compactTime{travelTime: 200})
+	assert.Equal(t, 1, len(plans))
+	assert.Equal(t, len(unclustered), len(plans[0].GetSegmentBinlogs()))
+	assert.Empty(t, plans[0].GetClusteringKeyBounds())
+
+	// later plans are cut by the max number of segments to merge
+	plans = trigger.generateClusteringPlans(append(unclustered, newSegment(2, keyRange(10, 19))), 101, &compactTime{travelTime: 200})
+	assert.Equal(t, 2, len(plans))
+	assert.Equal(t, Params.DataCoordCfg.MaxSegmentToMerge, len(plans[0].GetSegmentBinlogs()))
+	assert.Equal(t, 1, len(plans[1].GetSegmentBinlogs()))
+}
+
+func Test_compactionTrigger_manualScope(t *testing.T) {
+	Params.Init()
+	newSegment := func(id, partitionID int64) *SegmentInfo {
+		return &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+			ID:            id,
+			CollectionID:  1,
+			PartitionID:   partitionID,
+			InsertChannel: "ch1",
+			NumOfRows:     50,
+			MaxRowNum:     300,
+			State:         commonpb.SegmentState_Flushed,
+		}}
+	}
+	newTrigger := func() (*compactionTrigger, chan *datapb.CompactionPlan) {
+		m := &meta{segments: &SegmentsInfo{map[int64]*SegmentInfo{
+			1: newSegment(1, 10),
+			2: newSegment(2, 10),
+			3: newSegment(3, 20),
+			4: newSegment(4, 20),
+		}}}
+		m.segments.SetIsCompacting(4, true)
+		spy := &spyCompactionHandler{spyChan: make(chan *datapb.CompactionPlan, 10)}
+		tr := newCompactionTrigger(m, spy, newMockAllocator(),
+			&SegmentReferenceManager{segmentsLock: map[UniqueID]map[UniqueID]*datapb.SegmentReferenceLock{}})
+		return tr, spy.spyChan
+	}
+	getSegmentIDs := func(plan *datapb.CompactionPlan) []int64 {
+		var ids []int64
+		for _, segment := range plan.GetSegmentBinlogs() {
+			ids = append(ids, segment.GetSegmentID())
+		}
+		return ids
+	}
+	ct := &compactTime{travelTime: 200}
+
+	t.Run("invalid scope", func(t *testing.T) {
+		tr, _ := newTrigger()
+		_, err := tr.forceTriggerCompaction(1, ct, withSegments([]int64{1}), withPartitions([]int64{10}))
+		assert.Error(t, err)
+		_, err = tr.forceTriggerCompaction(1, ct, withSegments([]int64{1, 5}))
+		assert.Error(t, err)
+		_, err = tr.forceTriggerCompaction(2, ct, withSegments([]int64{1}))
+		assert.Error(t, err)
+		_, err = tr.forceTriggerCompaction(1, ct, withSegments([]int64{4}))
+		assert.Error(t, err)
+		_, err = tr.forceTriggerCompaction(1, ct, withTargetSize(-1))
+		assert.Error(t, err)
+	})
+
+	t.Run("compact segments", func(t *testing.T) {
+		tr, plans := newTrigger()
+		_, err := tr.forceTriggerCompaction(1, ct, withSegments([]int64{1, 3}))
+		assert.Nil(t, err)
+		var ids []int64
+		for len(plans) > 0 {
+			ids = append(ids, getSegmentIDs(<-plans)...)
+		}
+		assert.ElementsMatch(t, []int64{1, 3}, ids)
+	})
+
+	t.Run("compact partitions", func(t *testing.T) {
+		tr, plans := newTrigger()
+		_, err := tr.forceTriggerCompaction(1, ct, withPartitions([]int64{10}))
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(plans))
+		assert.ElementsMatch(t, []int64{1, 2}, getSegmentIDs(<-plans))
+	})
+
+	t.Run("compact with target size", func(t *testing.T) {
+		tr, plans := newTrigger()
+		// segments of 1/4 max size could hold a single segment only
+		size := int64(Params.DataCoordCfg.SegmentMaxSize / 4)
+		_, err := tr.forceTriggerCompaction(1, ct, withPartitions([]int64{10}), withTargetSize(size))
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(plans))
+	})
+}
+
+func Test_compactionTrigger_getCompactionPolicyStats(t *testing.T) {
+	Params.Init()
+
+	newSegment := func(id UniqueID, collectionID UniqueID, deletedRows int64) *SegmentInfo {
+		return &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+			ID:             id,
+			CollectionID:   collectionID,
+			PartitionID:    1,
+			InsertChannel:  "ch1",
+			NumOfRows:      100,
+			MaxRowNum:      300,
+			LastExpireTime: 100,
+			State:          commonpb.SegmentState_Flushed,
+			Deltalogs: []*datapb.FieldBinlog{{
+				Binlogs: []*datapb.Binlog{{EntriesNum: deletedRows, LogSize: 10, TimestampTo: 100}},
+			}},
+		}}
+	}
+	compacting := newSegment(3, 1, 50)
+	compacting.isCompacting = true
+	trigger := newCompactionTrigger(&meta{
+		collections: map[UniqueID]*datapb.CollectionInfo{
+			1: {ID: 1, Properties: []*commonpb.KeyValuePair{{Key: compactionDeleteRatioKey, Value: "0.05"}}},
+		},
+		segments: &SegmentsInfo{map[UniqueID]*SegmentInfo{
+			1: newSegment(1, 1, 10),
+			2: newSegment(2, 1, 1),
+			3: compacting,
+			4: newSegment(4, 2, 10),
+		}},
+	}, &compactionPlanHandler{}, newMockAllocator(), &SegmentReferenceManager{segmentsLock: map[UniqueID]map[UniqueID]*datapb.SegmentReferenceLock{}})
+
+	stats := trigger.getCompactionPolicyStats(1, &compactTime{travelTime: 200})
+	assert.Equal(t, 3, len(stats))
+	// the delete ratio of collection 1 is overridden by collection properties
+	assert.Equal(t, int64(1), stats[0].GetSegmentID())
+	assert.True(t, stats[0].GetSelected())
+	assert.Equal(t, int64(10), stats[0].GetDeletedRows())
+	assert.Equal(t, 1, len(stats[0].GetReasons()))
+	assert.False(t, stats[1].GetSelected())
+	assert.False(t, stats[2].GetSelected())
+	assert.Equal(t, []string{"segment is compacting"}, stats[2].GetReasons())
+
+	// the default delete ratio 0.2 applies to collection 2
+	stats = trigger.getCompactionPolicyStats(2, &compactTime{travelTime: 200})
+	assert.Equal(t, 1, len(stats))
+	assert.False(t, stats[0].GetSelected())
+	assert.False(t, trigger.ShouldDoSingleCompaction(newSegment(4, 2, 10), &compactTime{travelTime: 200}))
+	assert.True(t, trigger.ShouldDoSingleCompaction(newSegment(1, 1, 10), &compactTime{travelTime: 200}))
+}
@@ -20,6 +20,15 @@ package datacoord
 const (
 	// segmentReferPrefix is the prefix of the segment reference lock path
 	segmentReferPrefix = "segmentRefer"
+
+	// snapshotReferNodeID is the node holding the segment reference locks of snapshots,
+	// the locks are released only when the snapshots are dropped
+	snapshotReferNodeID = UniqueID(-1)
+)
+
+const (
+	// snapshotPrefix is the prefix of the snapshot path
+	snapshotPrefix = "snapshot"
 )
 
 const (
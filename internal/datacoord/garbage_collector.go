@@ -21,6 +21,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -173,7 +174,7 @@ func (gc *garbageCollector) clearEtcd() {
 	})
 
 	for _, sinfo := range drops {
-		if !gc.isExpire(sinfo.GetDroppedAt()) {
+		if !gc.isExpire(sinfo.GetDroppedAt(), gc.getRetention(sinfo.GetCollectionID())) {
 			continue
 		}
 		logs := getLogs(sinfo)
@@ -183,9 +184,26 @@ func (gc *garbageCollector) clearEtcd() {
 	}
 }
 
-func (gc *garbageCollector) isExpire(dropts Timestamp) bool {
+// isExpire checks whether the segment dropped at dropts exceeds both drop tolerance and the time travel retention.
+func (gc *garbageCollector) isExpire(dropts Timestamp, retention time.Duration) bool {
 	droptime := time.Unix(0, int64(dropts))
-	return time.Since(droptime) > gc.option.dropTolerance
+	tolerance := gc.option.dropTolerance
+	if retention > tolerance {
+		tolerance = retention
+	}
+	return time.Since(droptime) > tolerance
+}
+
+// getRetention returns the time travel retention set in the collection properties,
+// the binlogs of dropped segments are kept within the retained window.
+// Collections without the property only keep them within the drop tolerance.
+func (gc *garbageCollector) getRetention(collectionID UniqueID) time.Duration {
+	retention, err := common.GetTimeTravelRetention(gc.meta.GetCollection(collectionID).GetProperties(), 0)
+	if err != nil {
+		log.Warn("invalid time travel retention of collection", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return 0
+	}
+	return time.Duration(retention) * time.Second
 }
 
 func getLogs(sinfo *SegmentInfo) []*datapb.Binlog {
@@ -25,6 +25,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/common"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -251,6 +252,34 @@ func Test_garbageCollector_scan(t *testing.T) {
 	cleanupOSS(cli.Client, bucketName, rootPath)
 }
 
+func Test_garbageCollector_getRetention(t *testing.T) {
+	Params.Init()
+	meta, err := newMemoryMeta(newMockAllocator())
+	require.NoError(t, err)
+	gc := newGarbageCollector(meta, nil, GcOption{})
+
+	meta.AddCollection(&datapb.CollectionInfo{ID: 1})
+	meta.AddCollection(&datapb.CollectionInfo{
+		ID:         2,
+		Properties: []*commonpb.KeyValuePair{{Key: common.CollectionTimeTravelRetentionKey, Value: "60"}},
+	})
+	meta.AddCollection(&datapb.CollectionInfo{
+		ID:         3,
+		Properties: []*commonpb.KeyValuePair{{Key: common.CollectionTimeTravelRetentionKey, Value: "-1"}},
+	})
+
+	// only the collections setting the retention extend the drop tolerance
+	assert.Equal(t, time.Duration(0), gc.getRetention(1))
+	assert.Equal(t, time.Minute, gc.getRetention(2))
+	assert.Equal(t, time.Duration(0), gc.getRetention(3))
+	assert.Equal(t, time.Duration(0), gc.getRetention(4))
+
+	gc.option.dropTolerance = time.Second
+	droppedAt := uint64(time.Now().Add(-10 * time.Second).UnixNano())
+	assert.True(t, gc.isExpire(droppedAt, gc.getRetention(1)))
+	assert.False(t, gc.isExpire(droppedAt, gc.getRetention(2)))
+}
+
 // initialize unit test sso env
 func initUtOSSEnv(bucket, root string, n int) (mcm *storage.MinioChunkManager, inserts []string, stats []string, delta []string, other []string, err error) {
 	Params.Init()
@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"go.uber.org/zap"
 )
 
@@ -38,6 +39,7 @@ const (
 	metaPrefix          = "datacoord-meta"
 	segmentPrefix       = metaPrefix + "/s"
 	channelRemovePrefix = metaPrefix + "/channel-removal"
+	truncatePrefix      = metaPrefix + "/truncate"
 
 	removeFlagTomestone = "removed"
 )
@@ -413,6 +415,58 @@ func (m *meta) UpdateFlushSegmentsInfo(
 	return nil
 }
 
+// AddClusteredDeltalogs adds the deltalogs flushed to a segment dropped by clustering compaction to the segments
+// clustered from it, returns false if the segment is not clustered.
+// They are deletes the datanode was flushing when the clustering started, which are routed by the bloom filter of the
+// dropped segment, so the deleted primary keys may be in any of the clustered segments.
+func (m *meta) AddClusteredDeltalogs(segmentID UniqueID, deltalogs []*datapb.FieldBinlog) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	var clustered []*SegmentInfo
+	for _, segment := range m.segments.GetSegments() {
+		if isSegmentHealthy(segment) && segment.GetClusteringKeyRange() != nil && funcutil.SliceContain(segment.GetCompactionFrom(), segmentID) {
+			clustered = append(clustered, segment)
+		}
+	}
+	if len(clustered) == 0 {
+		return false, nil
+	}
+
+	var binlogs []*datapb.FieldBinlog
+	for _, fbl := range deltalogs {
+		if len(fbl.GetBinlogs()) > 0 {
+			binlogs = append(binlogs, fbl)
+		}
+	}
+	if len(binlogs) == 0 {
+		return true, nil
+	}
+
+	kv := make(map[string]string)
+	modSegments := make(map[UniqueID]*SegmentInfo)
+	for _, segment := range clustered {
+		cloned := segment.Clone()
+		cloned.Deltalogs = append(cloned.Deltalogs, binlogs...)
+		segBytes, err := proto.Marshal(cloned.SegmentInfo)
+		if err != nil {
+			return true, fmt.Errorf("dataCoord AddClusteredDeltalogs segmentID:%d, marshal failed:%w", cloned.GetID(), err)
+		}
+		kv[buildSegmentPath(cloned.GetCollectionID(), cloned.GetPartitionID(), cloned.GetID())] = string(segBytes)
+		modSegments[cloned.GetID()] = cloned
+	}
+	if err := m.saveKvTxn(kv); err != nil {
+		log.Error("failed to store clustered segment deltalogs into Etcd", zap.Int64("segmentID", segmentID), zap.Error(err))
+		return true, err
+	}
+	for id, s := range modSegments {
+		m.segments.SetSegment(id, s)
+	}
+	log.Info("add deltalogs of the dropped segment to clustered segments", zap.Int64("segmentID", segmentID),
+		zap.Int("clustered segments", len(modSegments)))
+	return true, nil
+}
+
 // UpdateDropChannelSegmentInfo updates segment checkpoints and binlogs before drop
 // reusing segment info to pass segment id, binlogs, statslog, deltalog, start position and checkpoint
 func (m *meta) UpdateDropChannelSegmentInfo(channel string, segments []*SegmentInfo) error {
@@ -580,6 +634,109 @@ func (m *meta) saveDropSegmentAndRemove(channel string, modSegments map[int64]*S
 	return nil
 }
 
+// TruncateSegments marks the provided segments dropped, so that their files are reclaimed by the garbage collector
+// the segments are saved in batches limited by the etcd transaction, and the memory is updated per saved batch
+// so that it stays consistent with the meta store, the truncation shall be retried if it fails halfway
+func (m *meta) TruncateSegments(segmentIDs []UniqueID) error {
+	m.Lock()
+	defer m.Unlock()
+
+	var origins, dropped []*SegmentInfo
+	kvs := make(map[string]string)
+	removals := make([]string, 0)
+	commit := func() error {
+		if err := m.client.MultiSaveAndRemove(kvs, removals); err != nil {
+			log.Warn("failed to save truncated segments", zap.Error(err))
+			return err
+		}
+		for i, segment := range dropped {
+			oldState := origins[i].GetState()
+			metrics.DataCoordNumSegments.WithLabelValues(oldState.String()).Dec()
+			metrics.DataCoordNumSegments.WithLabelValues(metrics.DropedSegmentLabel).Inc()
+			if oldState == commonpb.SegmentState_Flushed {
+				metrics.DataCoordNumStoredRows.WithLabelValues().Sub(float64(segment.GetNumOfRows()))
+			}
+			m.segments.SetSegment(segment.GetID(), segment)
+		}
+		origins, dropped = nil, nil
+		kvs = make(map[string]string)
+		removals = make([]string, 0)
+		return nil
+	}
+
+	now := uint64(time.Now().UnixNano())
+	for _, segmentID := range segmentIDs {
+		segment := m.segments.GetSegment(segmentID)
+		if !isSegmentHealthy(segment) {
+			continue
+		}
+		cloned := segment.Clone()
+		cloned.State = commonpb.SegmentState_Dropped
+		cloned.DroppedAt = now
+		key, value, err := m.marshal(cloned)
+		if err != nil {
+			return err
+		}
+		kvs[key] = value
+		// the segment shall not be handed off to the query nodes any more
+		removals = append(removals, buildQuerySegmentPath(cloned.GetCollectionID(), cloned.GetPartitionID(), cloned.GetID()))
+		origins = append(origins, segment)
+		dropped = append(dropped, cloned)
+
+		if len(kvs)+len(removals) >= maxOperationsPerTxn {
+			if err := commit(); err != nil {
+				return err
+			}
+		}
+	}
+	if len(kvs) > 0 {
+		return commit()
+	}
+	return nil
+}
+
+// GetTruncateInfo returns the unfinished truncate of the collection or partition, nil if there is none
+func (m *meta) GetTruncateInfo(collectionID UniqueID, partitionID UniqueID) (*datapb.TruncateInfo, error) {
+	_, values, err := m.client.LoadWithPrefix(buildTruncatePath(collectionID, partitionID))
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		info := &datapb.TruncateInfo{}
+		if err := proto.Unmarshal([]byte(value), info); err != nil {
+			return nil, err
+		}
+		// the prefix of partition 1 matches partition 10 as well
+		if info.GetCollectionID() == collectionID && info.GetPartitionID() == partitionID {
+			return info, nil
+		}
+	}
+	return nil, nil
+}
+
+// IsTruncating returns whether the collection or any of its partitions is being truncated
+func (m *meta) IsTruncating(collectionID UniqueID) (bool, error) {
+	keys, _, err := m.client.LoadWithPrefix(fmt.Sprintf("%s/%d/", truncatePrefix, collectionID))
+	if err != nil {
+		return false, err
+	}
+	return len(keys) > 0, nil
+}
+
+// SaveTruncateInfo persists the segments to truncate
+func (m *meta) SaveTruncateInfo(info *datapb.TruncateInfo) error {
+	value, err := proto.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return m.client.Save(buildTruncatePath(info.GetCollectionID(), info.GetPartitionID()), string(value))
+}
+
+// RemoveTruncateInfo removes the truncate info after the truncation is finished
+func (m *meta) RemoveTruncateInfo(collectionID UniqueID, partitionID UniqueID) error {
+	return m.client.Remove(buildTruncatePath(collectionID, partitionID))
+}
+
 // FinishRemoveChannel removes channel remove flag after whole procedure is finished
 func (m *meta) FinishRemoveChannel(channel string) error {
 	key := buildChannelRemovePath(channel)
@@ -784,11 +941,83 @@ func (m *meta) CompleteMergeCompaction(compactionLogs []*datapb.CompactionSegmen
 	m.Lock()
 	defer m.Unlock()
 
+	segments, startPosition, dmlPosition, newAddedDeltalogs, err := m.dropCompactedSegments(compactionLogs, canCompaction)
+	if err != nil {
+		return err
+	}
+	deltalogs := append(result.GetDeltalogs(), newAddedDeltalogs...)
+
+	compactionFrom := make([]UniqueID, 0, len(segments))
+	for _, s := range segments {
+		compactionFrom = append(compactionFrom, s.GetID())
+	}
+
+	segmentInfo := &datapb.SegmentInfo{
+		ID:                  result.GetSegmentID(),
+		CollectionID:        segments[0].CollectionID,
+		PartitionID:         segments[0].PartitionID,
+		InsertChannel:       segments[0].InsertChannel,
+		NumOfRows:           result.NumOfRows,
+		State:               commonpb.SegmentState_Flushing,
+		MaxRowNum:           segments[0].MaxRowNum,
+		Binlogs:             result.GetInsertLogs(),
+		Statslogs:           result.GetField2StatslogPaths(),
+		Deltalogs:           deltalogs,
+		StartPosition:       startPosition,
+		DmlPosition:         dmlPosition,
+		CreatedByCompaction: true,
+		CompactionFrom:      compactionFrom,
+	}
+	segment := NewSegmentInfo(segmentInfo)
+
+	log.Info("CompleteMergeCompaction", zap.Int64("segmentID", segmentInfo.ID),
+		zap.Int64("collectionID", segmentInfo.CollectionID),
+		zap.Int64("partitionID", segmentInfo.PartitionID),
+		zap.Int64("NumOfRows", segmentInfo.NumOfRows),
+		zap.Any("compactionFrom", segmentInfo.CompactionFrom))
+
+	data := make(map[string]string)
+
+	for _, s := range segments {
+		k, v, err := m.marshal(s)
+		if err != nil {
+			return err
+		}
+		data[k] = v
+	}
+
+	if segment.NumOfRows > 0 {
+		k, v, err := m.marshal(segment)
+		if err != nil {
+			return err
+		}
+		data[k] = v
+	}
+
+	if err := m.saveKvTxn(data); err != nil {
+		return err
+	}
+
+	for _, s := range segments {
+		m.segments.SetSegment(s.GetID(), s)
+	}
+
+	// Handle empty segment generated by merge-compaction
+	if segment.NumOfRows > 0 {
+		m.segments.SetSegment(segment.GetID(), segment)
+	}
+	return nil
+}
+
+// dropCompactedSegments returns the cloned compacted segments marked as dropped, the earliest start and dml positions of them,
+// and the deltalogs added to them while executing the compaction
+func (m *meta) dropCompactedSegments(compactionLogs []*datapb.CompactionSegmentBinlogs,
+	canCompaction func(segment *datapb.CompactionSegmentBinlogs) bool) ([]*SegmentInfo, *internalpb.MsgPosition, *internalpb.MsgPosition, []*datapb.FieldBinlog, error) {
 	segments := make([]*SegmentInfo, 0, len(compactionLogs))
 	for _, cl := range compactionLogs {
 		if !canCompaction(cl) {
 			log.Warn("can not be compacted, segment has reference lock", zap.Int64("segmentID", cl.SegmentID))
-			return fmt.Errorf("can not be compacted, segment with ID %d has reference lock", cl.SegmentID)
+			return nil, nil, nil, nil, fmt.Errorf("can not be compacted, segment with ID %d has reference lock", cl.SegmentID)
 		}
 		if segment := m.segments.GetSegment(cl.GetSegmentID()); segment != nil {
 			cloned := segment.Clone()
@@ -823,40 +1052,77 @@ func (m *meta) CompleteMergeCompaction(compactionLogs []*datapb.CompactionSegmen
 	}
 
 	newAddedDeltalogs := m.updateDeltalogs(originDeltalogs, deletedDeltalogs, nil)
-	deltalogs := append(result.GetDeltalogs(), newAddedDeltalogs...)
+	return segments, startPosition, dmlPosition, newAddedDeltalogs, nil
+}
+
+// CompleteClusteringCompaction drops the compacted segments and adds the clustered segments with their clustering key range
+func (m *meta) CompleteClusteringCompaction(compactionLogs []*datapb.CompactionSegmentBinlogs, result *datapb.CompactionResult,
+	canCompaction func(segment *datapb.CompactionSegmentBinlogs) bool) error {
+	m.Lock()
+	defer m.Unlock()
+
+	// the datanode refreshes the deltalogs after it stops the flushes of the compacted segments
+	if len(result.GetSegmentBinlogs()) > 0 {
+		compactionLogs = result.GetSegmentBinlogs()
+	}
+	segments, startPosition, dmlPosition, newAddedDeltalogs, err := m.dropCompactedSegments(compactionLogs, canCompaction)
+	if err != nil {
+		return err
+	}
+	if len(segments) == 0 {
+		return fmt.Errorf("compacted segments of plan %d not found", result.GetPlanID())
+	}
+	// deletes not split by the clustering can't be attributed to the clustered segments
+	if len(newAddedDeltalogs) > 0 {
+		log.Warn("deltalogs added to the compacted segments during clustering compaction", zap.Int64("planID", result.GetPlanID()))
+		return fmt.Errorf("deltalogs added to the compacted segments of plan %d during clustering compaction", result.GetPlanID())
+	}
 
 	compactionFrom := make([]UniqueID, 0, len(segments))
 	for _, s := range segments {
 		compactionFrom = append(compactionFrom, s.GetID())
 	}
 
-	segmentInfo := &datapb.SegmentInfo{
-		ID:                  result.GetSegmentID(),
-		CollectionID:        segments[0].CollectionID,
-		PartitionID:         segments[0].PartitionID,
-		InsertChannel:       segments[0].InsertChannel,
-		NumOfRows:           result.NumOfRows,
-		State:               commonpb.SegmentState_Flushing,
-		MaxRowNum:           segments[0].MaxRowNum,
-		Binlogs:             result.GetInsertLogs(),
-		Statslogs:           result.GetField2StatslogPaths(),
-		Deltalogs:           deltalogs,
-		StartPosition:       startPosition,
-		DmlPosition:         dmlPosition,
-		CreatedByCompaction: true,
-		CompactionFrom:      compactionFrom,
+	var siblings []UniqueID
+	for _, cs := range result.GetClusteredSegments() {
+		if cs.GetNumOfRows() > 0 {
+			siblings = append(siblings, cs.GetSegmentID())
+		}
 	}
-	segment := NewSegmentInfo(segmentInfo)
 
-	log.Info("CompleteMergeCompaction", zap.Int64("segmentID", segmentInfo.ID),
-		zap.Int64("collectionID", segmentInfo.CollectionID),
-		zap.Int64("partitionID", segmentInfo.PartitionID),
-		zap.Int64("NumOfRows", segmentInfo.NumOfRows),
-		zap.Any("compactionFrom", segmentInfo.CompactionFrom))
+	clustered := make([]*SegmentInfo, 0, len(siblings))
+	for _, cs := range result.GetClusteredSegments() {
+		if cs.GetNumOfRows() <= 0 {
+			continue
+		}
+		clustered = append(clustered, NewSegmentInfo(&datapb.SegmentInfo{
+			ID:                  cs.GetSegmentID(),
+			CollectionID:        segments[0].CollectionID,
+			PartitionID:         segments[0].PartitionID,
+			InsertChannel:       segments[0].InsertChannel,
+			NumOfRows:           cs.GetNumOfRows(),
+			State:               commonpb.SegmentState_Flushing,
+			MaxRowNum:           segments[0].MaxRowNum,
+			Binlogs:             cs.GetInsertLogs(),
+			Statslogs:           cs.GetField2StatslogPaths(),
+			Deltalogs:           cs.GetDeltalogs(),
+			StartPosition:       startPosition,
+			DmlPosition:         dmlPosition,
+			CreatedByCompaction: true,
+			CompactionFrom:      compactionFrom,
+			ClusteringKeyRange:  cs.GetClusteringKeyRange(),
+			CompactionSiblings:  siblings,
+		}))
+	}
+
+	log.Info("CompleteClusteringCompaction", zap.Int64("planID", result.GetPlanID()),
+		zap.Int64("collectionID", segments[0].CollectionID),
+		zap.Int64("partitionID", segments[0].PartitionID),
+		zap.Int("clustered segments", len(clustered)),
+		zap.Int64s("compactionFrom", compactionFrom))
 
 	data := make(map[string]string)
-
-	for _, s := range segments {
+	for _, s := range append(segments, clustered...) {
 		k, v, err := m.marshal(s)
 		if err != nil {
 			return err
@@ -864,14 +1130,6 @@ func (m *meta) CompleteMergeCompaction(compactionLogs []*datapb.CompactionSegmen
 		data[k] = v
 	}
 
-	if segment.NumOfRows > 0 {
-		k, v, err := m.marshal(segment)
-		if err != nil {
-			return err
-		}
-		data[k] = v
-	}
-
 	if err := m.saveKvTxn(data); err != nil {
 		return err
 	}
@@ -879,10 +1137,8 @@ func (m *meta) CompleteMergeCompaction(compactionLogs []*datapb.CompactionSegmen
 	for _, s := range segments {
 		m.segments.SetSegment(s.GetID(), s)
 	}
-
-	// Handle empty segment generated by merge-compaction
-	if segment.NumOfRows > 0 {
-		m.segments.SetSegment(segment.GetID(), segment)
+	for _, s := range clustered {
+		m.segments.SetSegment(s.GetID(), s)
 	}
 	return nil
 }
@@ -1024,6 +1280,7 @@ func (m *meta) saveSegmentInfo(segment *SegmentInfo) error {
 			SegmentState:        commonpb.SegmentState_Sealed,
 			CreatedByCompaction: segment.GetCreatedByCompaction(),
 			CompactionFrom:      segment.GetCompactionFrom(),
+			CompactionSiblings:  segment.GetCompactionSiblings(),
 		}
 		handoffSegBytes, err := proto.Marshal(handoffSegmentInfo)
 		if err != nil {
@@ -1064,6 +1321,11 @@ func buildChannelRemovePath(channel string) string {
 	return fmt.Sprintf("%s/%s", channelRemovePrefix, channel)
 }
 
+// buildTruncatePath builds the path of the truncate info of the collection or partition
+func buildTruncatePath(collectionID UniqueID, partitionID UniqueID) string {
+	return fmt.Sprintf("%s/%d/%d", truncatePrefix, collectionID, partitionID)
+}
+
 // buildSegment utility function for compose datapb.SegmentInfo struct with provided info
 func buildSegment(collectionID UniqueID, partitionID UniqueID, segmentID UniqueID, channelName string) *SegmentInfo {
 	info := &datapb.SegmentInfo{
@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/util"
 	"github.com/stretchr/testify/assert"
 )
@@ -470,6 +471,123 @@ func Test_meta_CompleteMergeCompaction(t *testing.T) {
 	}
 }
 
+func Test_meta_CompleteClusteringCompaction(t *testing.T) {
+	m := &meta{
+		client: memkv.NewMemoryKV(),
+		segments: &SegmentsInfo{map[int64]*SegmentInfo{
+			1: {SegmentInfo: &datapb.SegmentInfo{
+				ID:        1,
+				Binlogs:   []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log1", "log2")},
+				Deltalogs: []*datapb.FieldBinlog{getFieldBinlogPaths(0, "deltalog1")},
+			}},
+			2: {SegmentInfo: &datapb.SegmentInfo{
+				ID:      2,
+				Binlogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log3", "log4")},
+			}},
+		}},
+	}
+	compactionLogs := []*datapb.CompactionSegmentBinlogs{
+		{SegmentID: 1, FieldBinlogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log1", "log2")}, Deltalogs: []*datapb.FieldBinlog{getFieldBinlogPaths(0, "deltalog1")}},
+		{SegmentID: 2, FieldBinlogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log3", "log4")}},
+	}
+	keyRange := func(min, max int64) *datapb.ClusteringKeyRange {
+		return &datapb.ClusteringKeyRange{
+			FieldID: 100,
+			Min:     &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: min}},
+			Max:     &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: max}},
+		}
+	}
+	result := &datapb.CompactionResult{
+		PlanID: 1,
+		ClusteredSegments: []*datapb.ClusteredSegment{
+			{SegmentID: 3, NumOfRows: 2, InsertLogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log5")}, ClusteringKeyRange: keyRange(0, 10)},
+			{SegmentID: 4, NumOfRows: 2, InsertLogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log6")}, ClusteringKeyRange: keyRange(11, 20)},
+			{SegmentID: 5, NumOfRows: 0},
+		},
+	}
+	err := m.CompleteClusteringCompaction(compactionLogs, result, func(segment *datapb.CompactionSegmentBinlogs) bool {
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, m.GetSegment(1))
+	assert.Nil(t, m.GetSegment(2))
+	assert.Nil(t, m.GetSegment(5))
+	for _, cs := range result.GetClusteredSegments()[:2] {
+		segment := m.GetSegment(cs.GetSegmentID())
+		assert.NotNil(t, segment)
+		assert.EqualValues(t, cs.GetInsertLogs(), segment.GetBinlogs())
+		assert.Equal(t, cs.GetClusteringKeyRange(), segment.GetClusteringKeyRange())
+		assert.ElementsMatch(t, []UniqueID{1, 2}, segment.GetCompactionFrom())
+		assert.Equal(t, []UniqueID{3, 4}, segment.GetCompactionSiblings())
+		assert.True(t, segment.GetCreatedByCompaction())
+	}
+
+	// deltalogs of a clustered segment are its own
+	err = m.UpdateFlushSegmentsInfo(3, true, false, false, nil, nil, []*datapb.FieldBinlog{getFieldBinlogPaths(0, "deltalog2")}, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "deltalog2", m.GetSegment(3).GetDeltalogs()[0].GetBinlogs()[0].GetLogPath())
+	assert.Empty(t, m.GetSegment(4).GetDeltalogs())
+
+	// deltalogs flushed to a compacted segment after clustering go to all the segments clustered from it
+	clustered, err := m.AddClusteredDeltalogs(1, []*datapb.FieldBinlog{getFieldBinlogPaths(0, "deltalog3")})
+	assert.NoError(t, err)
+	assert.True(t, clustered)
+	assert.Equal(t, 2, len(m.GetSegment(3).GetDeltalogs()))
+	assert.Equal(t, "deltalog3", m.GetSegment(4).GetDeltalogs()[0].GetBinlogs()[0].GetLogPath())
+	clustered, err = m.AddClusteredDeltalogs(3, []*datapb.FieldBinlog{getFieldBinlogPaths(0, "deltalog4")})
+	assert.NoError(t, err)
+	assert.False(t, clustered)
+
+	t.Run("deltalogs added during compaction", func(t *testing.T) {
+		m := &meta{
+			client: memkv.NewMemoryKV(),
+			segments: &SegmentsInfo{map[int64]*SegmentInfo{
+				1: {SegmentInfo: &datapb.SegmentInfo{
+					ID:        1,
+					Binlogs:   []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log1")},
+					Deltalogs: []*datapb.FieldBinlog{getFieldBinlogPaths(0, "deltalog1", "deltalog2")},
+				}},
+			}},
+		}
+		compactionLogs := []*datapb.CompactionSegmentBinlogs{
+			{SegmentID: 1, FieldBinlogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log1")}, Deltalogs: []*datapb.FieldBinlog{getFieldBinlogPaths(0, "deltalog1")}},
+		}
+		result := &datapb.CompactionResult{
+			PlanID:            2,
+			ClusteredSegments: []*datapb.ClusteredSegment{{SegmentID: 3, NumOfRows: 2, ClusteringKeyRange: keyRange(0, 10)}},
+		}
+		canCompaction := func(segment *datapb.CompactionSegmentBinlogs) bool {
+			return true
+		}
+		err := m.CompleteClusteringCompaction(compactionLogs, result, canCompaction)
+		assert.Error(t, err)
+		assert.NotNil(t, m.GetSegment(1))
+
+		// the deltalogs refreshed by datanode cover the added ones
+		result.SegmentBinlogs = []*datapb.CompactionSegmentBinlogs{
+			{SegmentID: 1, FieldBinlogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log1")}, Deltalogs: []*datapb.FieldBinlog{getFieldBinlogPaths(0, "deltalog1", "deltalog2")}},
+		}
+		err = m.CompleteClusteringCompaction(compactionLogs, result, canCompaction)
+		assert.NoError(t, err)
+		assert.Nil(t, m.GetSegment(1))
+		assert.NotNil(t, m.GetSegment(3))
+	})
+
+	t.Run("compacted segments not found", func(t *testing.T) {
+		err := m.CompleteClusteringCompaction([]*datapb.CompactionSegmentBinlogs{{SegmentID: 10}}, result, func(segment *datapb.CompactionSegmentBinlogs) bool {
+			return true
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("segment has reference lock", func(t *testing.T) {
+		err := m.CompleteClusteringCompaction([]*datapb.CompactionSegmentBinlogs{{SegmentID: 3}}, result, func(segment *datapb.CompactionSegmentBinlogs) bool {
+			return false
+		})
+		assert.Error(t, err)
+	})
+}
+
 func Test_meta_CompleteInnerCompaction(t *testing.T) {
 	type fields struct {
 		client      kv.TxnKV
@@ -762,3 +880,116 @@ func TestMeta_isSegmentHealthy_issue17823_panic(t *testing.T) {
 
 	assert.False(t, isSegmentHealthy(seg))
 }
+
+func TestMeta_TruncateSegments(t *testing.T) {
+	meta, err := newMeta(memkv.NewMemoryKV())
+	assert.Nil(t, err)
+
+	segments := []*datapb.SegmentInfo{
+		{ID: 1, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Flushed, NumOfRows: 10},
+		{ID: 2, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Growing},
+		{ID: 3, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Dropped},
+	}
+	for _, segment := range segments {
+		err = meta.AddSegment(NewSegmentInfo(segment))
+		assert.Nil(t, err)
+	}
+	err = meta.saveSegmentInfo(meta.GetSegment(1))
+	assert.Nil(t, err)
+
+	err = meta.TruncateSegments([]UniqueID{1, 2, 3, 4})
+	assert.Nil(t, err)
+	for _, segmentID := range []UniqueID{1, 2, 3} {
+		assert.Nil(t, meta.GetSegment(segmentID))
+		segment := meta.GetAllSegment(segmentID)
+		assert.Equal(t, commonpb.SegmentState_Dropped, segment.GetState())
+	}
+	assert.NotZero(t, meta.GetAllSegment(1).GetDroppedAt())
+	assert.Zero(t, meta.GetAllSegment(3).GetDroppedAt())
+
+	// the truncated segments are not handed off any more
+	keys, _, err := meta.client.LoadWithPrefix(util.HandoffSegmentPrefix)
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+
+	// the dropped state is persisted
+	reloaded, err := newMeta(meta.client)
+	assert.Nil(t, err)
+	assert.Nil(t, reloaded.GetSegment(1))
+	assert.Nil(t, reloaded.GetSegment(2))
+
+	t.Run("fail in the second batch", func(t *testing.T) {
+		store := memkv.NewMemoryKV()
+		meta, err := newMeta(store)
+		assert.Nil(t, err)
+		// each segment takes a save and a removal in the transaction
+		batchSize := maxOperationsPerTxn / 2
+		segmentIDs := make([]UniqueID, 0, batchSize+10)
+		for i := 0; i < batchSize+10; i++ {
+			segmentID := UniqueID(i + 1)
+			err = meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: segmentID, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Flushed}))
+			assert.Nil(t, err)
+			segmentIDs = append(segmentIDs, segmentID)
+		}
+
+		meta.client = &multiSaveAndRemoveFailKV{TxnKV: store, succeeds: 1}
+		err = meta.TruncateSegments(segmentIDs)
+		assert.NotNil(t, err)
+
+		// the memory is consistent with the meta store
+		reloaded, err := newMeta(store)
+		assert.Nil(t, err)
+		for i, segmentID := range segmentIDs {
+			if i < batchSize {
+				assert.Nil(t, meta.GetSegment(segmentID))
+				assert.Nil(t, reloaded.GetSegment(segmentID))
+			} else {
+				assert.NotNil(t, meta.GetSegment(segmentID))
+				assert.NotNil(t, reloaded.GetSegment(segmentID))
+			}
+		}
+
+		// the retry drops the rest
+		meta.client = store
+		err = meta.TruncateSegments(segmentIDs)
+		assert.Nil(t, err)
+		for _, segmentID := range segmentIDs {
+			assert.Nil(t, meta.GetSegment(segmentID))
+		}
+	})
+}
+
+func TestMeta_TruncateInfo(t *testing.T) {
+	meta, err := newMeta(memkv.NewMemoryKV())
+	assert.Nil(t, err)
+
+	info, err := meta.GetTruncateInfo(100, 1)
+	assert.Nil(t, err)
+	assert.Nil(t, info)
+
+	err = meta.SaveTruncateInfo(&datapb.TruncateInfo{CollectionID: 100, PartitionID: 10, SegmentIDs: []int64{1, 2}})
+	assert.Nil(t, err)
+	// partition 1 shares the prefix of partition 10
+	info, err = meta.GetTruncateInfo(100, 1)
+	assert.Nil(t, err)
+	assert.Nil(t, info)
+	info, err = meta.GetTruncateInfo(100, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 2}, info.GetSegmentIDs())
+	truncating, err := meta.IsTruncating(100)
+	assert.Nil(t, err)
+	assert.True(t, truncating)
+	// collection 10 shares the prefix of collection 100
+	truncating, err = meta.IsTruncating(10)
+	assert.Nil(t, err)
+	assert.False(t, truncating)
+
+	err = meta.RemoveTruncateInfo(100, 10)
+	assert.Nil(t, err)
+	info, err = meta.GetTruncateInfo(100, 10)
+	assert.Nil(t, err)
+	assert.Nil(t, info)
+	truncating, err = meta.IsTruncating(100)
+	assert.Nil(t, err)
+	assert.False(t, truncating)
+}
@@ -101,6 +101,21 @@ func (kv *removeFailKV) Remove(key string) error {
 	return errors.New("mocked fail")
 }
 
+// a mock kv that fails the `MultiSaveAndRemove` after the first `succeeds` ones
+type multiSaveAndRemoveFailKV struct {
+	kv.TxnKV
+	succeeds int
+}
+
+// MultiSaveAndRemove override behavior, inject error
+func (kv *multiSaveAndRemoveFailKV) MultiSaveAndRemove(saves map[string]string, removals []string) error {
+	if kv.succeeds <= 0 {
+		return errors.New("mocked fail")
+	}
+	kv.succeeds--
+	return kv.TxnKV.MultiSaveAndRemove(saves, removals)
+}
+
 func newMockAllocator() *MockAllocator {
 	return &MockAllocator{}
 }
@@ -216,6 +231,10 @@ func (c *mockDataNodeClient) Compaction(ctx context.Context, req *datapb.Compact
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "not implemented"}, nil
 }
 
+func (c *mockDataNodeClient) StopCompaction(ctx context.Context, req *datapb.StopCompactionRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
 func (c *mockDataNodeClient) Import(ctx context.Context, in *datapb.ImportTaskRequest) (*commonpb.Status, error) {
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
@@ -224,6 +243,14 @@ func (c *mockDataNodeClient) AddSegment(ctx context.Context, req *datapb.AddSegm
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
 
+func (c *mockDataNodeClient) CheckPrimaryKeys(ctx context.Context, req *datapb.CheckPrimaryKeysRequest) (*datapb.CheckPrimaryKeysResponse, error) {
+	// all the primary keys may exist
+	return &datapb.CheckPrimaryKeysResponse{
+		Status:       &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		ExistingKeys: req.GetPrimaryKeys(),
+	}, nil
+}
+
 func (c *mockDataNodeClient) Stop() error {
 	c.state = internalpb.StateCode_Abnormal
 	return nil
@@ -246,6 +273,26 @@ func (m *mockRootCoordService) AlterAlias(ctx context.Context, req *milvuspb.Alt
 	panic("implement me")
 }
 
+func (m *mockRootCoordService) AlterShards(ctx context.Context, req *milvuspb.AlterShardsRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) TruncateCollection(ctx context.Context, req *milvuspb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) TruncatePartition(ctx context.Context, req *milvuspb.TruncatePartitionRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) AddField(ctx context.Context, req *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) DropField(ctx context.Context, req *milvuspb.DropFieldRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
 func newMockRootCoordService() *mockRootCoordService {
 	return &mockRootCoordService{state: internalpb.StateCode_Healthy}
 }
@@ -588,6 +635,16 @@ func (h *mockCompactionHandler) getCompactionTasksBySignalID(signalID int64) []*
 	panic("not implemented")
 }
 
+// cancelCompaction cancels the pending and executing tasks of the signal
+func (h *mockCompactionHandler) cancelCompaction(signalID int64) error {
+	if f, ok := h.methods["cancelCompaction"]; ok {
+		if ff, ok := f.(func(signalID int64) error); ok {
+			return ff(signalID)
+		}
+	}
+	panic("not implemented")
+}
+
 type mockCompactionTrigger struct {
 	methods map[string]interface{}
 }
@@ -613,7 +670,7 @@ func (t *mockCompactionTrigger) triggerSingleCompaction(collectionID int64, part
 }
 
 // forceTriggerCompaction force to start a compaction
-func (t *mockCompactionTrigger) forceTriggerCompaction(collectionID int64, ct *compactTime) (UniqueID, error) {
+func (t *mockCompactionTrigger) forceTriggerCompaction(collectionID int64, ct *compactTime, opts ...compactionSignalOpt) (UniqueID, error) {
 	if f, ok := t.methods["forceTriggerCompaction"]; ok {
 		if ff, ok := f.(func(collectionID int64, ct *compactTime) (UniqueID, error)); ok {
 			return ff(collectionID, ct)
@@ -622,6 +679,16 @@ func (t *mockCompactionTrigger) forceTriggerCompaction(collectionID int64, ct *c
 	panic("not implemented")
 }
 
+// getCompactionPolicyStats lists the segments of a collection and why they are selected by compaction policy or not
+func (t *mockCompactionTrigger) getCompactionPolicyStats(collectionID int64, ct *compactTime) []*milvuspb.SegmentCompactionStats {
+	if f, ok := t.methods["getCompactionPolicyStats"]; ok {
+		if ff, ok := f.(func(collectionID int64, ct *compactTime) []*milvuspb.SegmentCompactionStats); ok {
+			return ff(collectionID, ct)
+		}
+	}
+	panic("not implemented")
+}
+
 func (t *mockCompactionTrigger) start() {
 	if f, ok := t.methods["start"]; ok {
 		if ff, ok := f.(func()); ok {
@@ -694,6 +761,18 @@ func (m *mockRootCoordService) ListPolicy(ctx context.Context, in *internalpb.Li
 	return &internalpb.ListPolicyResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
 }
 
+func (m *mockRootCoordService) CreateRowPolicy(ctx context.Context, req *milvuspb.CreateRowPolicyRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) DropRowPolicy(ctx context.Context, req *milvuspb.DropRowPolicyRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) ListRowPolicies(ctx context.Context, req *milvuspb.ListRowPoliciesRequest) (*milvuspb.ListRowPoliciesResponse, error) {
+	panic("implement me")
+}
+
 type mockHandler struct {
 }
 
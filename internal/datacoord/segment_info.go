@@ -291,6 +291,13 @@ func SetFlushTime(t time.Time) SegmentInfoOption {
 	}
 }
 
+// SetMaxRowNum is the option to set max row number for segment info
+func SetMaxRowNum(maxRowNum int64) SegmentInfoOption {
+	return func(segment *SegmentInfo) {
+		segment.MaxRowNum = maxRowNum
+	}
+}
+
 // SetIsCompacting is the option to set compaction state for segment info
 func SetIsCompacting(isCompacting bool) SegmentInfoOption {
 	return func(segment *SegmentInfo) {
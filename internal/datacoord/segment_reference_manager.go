@@ -189,6 +189,9 @@ func (srm *SegmentReferenceManager) recoverySegReferManager(nodeIDs []UniqueID)
 	offlineIDs := make(map[UniqueID]struct{})
 	for _, segLock := range srm.segmentsLock {
 		for nodeID := range segLock {
+			if nodeID == snapshotReferNodeID {
+				continue
+			}
 			if _, ok := onlineIDs[nodeID]; !ok {
 				offlineIDs[nodeID] = struct{}{}
 			}
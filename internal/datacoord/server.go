@@ -138,6 +138,7 @@ type Server struct {
 	rootCoordClientCreator rootCoordCreatorFunc
 
 	segReferManager *SegmentReferenceManager
+	snapshotManager *snapshotManager
 }
 
 // ServerHelper datacoord server injection helper
@@ -288,6 +289,10 @@ func (s *Server) Start() error {
 		return err
 	}
 
+	if s.snapshotManager, err = newSnapshotManager(s.kvClient, s.segReferManager); err != nil {
+		return err
+	}
+
 	if Params.DataCoordCfg.EnableCompaction {
 		s.createCompactionHandler()
 		s.createCompactionTrigger()
@@ -948,6 +953,7 @@ func (s *Server) loadCollectionFromRootCoord(ctx context.Context, collectionID i
 		Schema:         resp.Schema,
 		Partitions:     presp.PartitionIDs,
 		StartPositions: resp.GetStartPositions(),
+		Properties:     resp.GetProperties(),
 	}
 	s.meta.AddCollection(collInfo)
 	return nil
@@ -249,6 +249,134 @@ func TestFlush(t *testing.T) {
 	})
 }
 
+func TestTruncateSegments(t *testing.T) {
+	t.Run("normal case", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		schema := newTestSchema()
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 0, Schema: schema, Partitions: []int64{1, 2}})
+		allocations, err := svr.segmentManager.AllocSegment(context.TODO(), 0, 1, "channel-1", 1)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 1, len(allocations))
+		expireTs := allocations[0].ExpireTime
+		segID := allocations[0].SegmentID
+		err = svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            segID + 1,
+			CollectionID:  0,
+			PartitionID:   2,
+			InsertChannel: "channel-1",
+			State:         commonpb.SegmentState_Flushed,
+		}))
+		assert.Nil(t, err)
+
+		// the growing segment may still receive the inserts
+		resp, err := svr.TruncateSegments(context.TODO(), &datapb.TruncateSegmentsRequest{
+			CollectionID: 0,
+			PartitionID:  1,
+			TruncateTs:   expireTs,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, expireTs, resp.GetAllocatedUntil())
+		assert.Empty(t, resp.GetSegmentIDs())
+		assert.Equal(t, commonpb.SegmentState_Sealed, svr.meta.GetSegment(segID).GetState())
+		// the segments to truncate are fixed before any of them is dropped
+		info, err := svr.meta.GetTruncateInfo(0, 1)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []int64{segID}, info.GetSegmentIDs())
+
+		// the segment allocated for the inserts since then is not truncated
+		allocations, err = svr.segmentManager.AllocSegment(context.TODO(), 0, 1, "channel-1", 1)
+		assert.Nil(t, err)
+		assert.EqualValues(t, 1, len(allocations))
+		newSegID := allocations[0].SegmentID
+		newExpireTs := allocations[0].ExpireTime
+		assert.NotEqual(t, segID, newSegID)
+
+		resp, err = svr.TruncateSegments(context.TODO(), &datapb.TruncateSegmentsRequest{
+			CollectionID: 0,
+			PartitionID:  1,
+			TruncateTs:   expireTs + 1,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Zero(t, resp.GetAllocatedUntil())
+		assert.ElementsMatch(t, []int64{segID}, resp.GetSegmentIDs())
+		assert.Nil(t, svr.meta.GetSegment(segID))
+		assert.Equal(t, commonpb.SegmentState_Dropped, svr.meta.GetAllSegment(segID).GetState())
+		assert.Equal(t, commonpb.SegmentState_Growing, svr.meta.GetSegment(newSegID).GetState())
+		// the other partition is kept
+		assert.NotNil(t, svr.meta.GetSegment(segID+1))
+
+		// the interrupted truncate is resumed with the same segments
+		resp, err = svr.TruncateSegments(context.TODO(), &datapb.TruncateSegmentsRequest{
+			CollectionID: 0,
+			PartitionID:  1,
+			TruncateTs:   expireTs + 2,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.ElementsMatch(t, []int64{segID}, resp.GetSegmentIDs())
+
+		resp, err = svr.TruncateSegments(context.TODO(), &datapb.TruncateSegmentsRequest{
+			CollectionID: 0,
+			PartitionID:  1,
+			Finished:     true,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		info, err = svr.meta.GetTruncateInfo(0, 1)
+		assert.Nil(t, err)
+		assert.Nil(t, info)
+
+		resp, err = svr.TruncateSegments(context.TODO(), &datapb.TruncateSegmentsRequest{
+			CollectionID: 0,
+			TruncateTs:   newExpireTs + 1,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.ElementsMatch(t, []int64{segID + 1, newSegID}, resp.GetSegmentIDs())
+		assert.Empty(t, svr.meta.GetSegmentsOfCollection(0))
+	})
+
+	t.Run("collection with snapshots", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.meta.AddCollection(&datapb.CollectionInfo{ID: 0, Schema: newTestSchema(), Partitions: []int64{1}})
+		err := svr.snapshotManager.create(&datapb.SnapshotInfo{SnapshotID: 1, Name: "s1", CollectionID: 0}, nil)
+		assert.Nil(t, err)
+
+		resp, err := svr.TruncateSegments(context.TODO(), &datapb.TruncateSegmentsRequest{CollectionID: 0, PartitionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		info, err := svr.meta.GetTruncateInfo(0, 1)
+		assert.Nil(t, err)
+		assert.Nil(t, info)
+
+		// all the snapshots are dropped with the collection
+		status, err := svr.DropSnapshot(context.TODO(), &datapb.DropSnapshotRequest{CollectionID: 0})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		resp, err = svr.TruncateSegments(context.TODO(), &datapb.TruncateSegmentsRequest{CollectionID: 0, PartitionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+		// no snapshot is created until the truncation finishes
+		snapshot, err := svr.CreateSnapshot(context.TODO(), &datapb.CreateSnapshotRequest{CollectionID: 0, Name: "s2"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, snapshot.GetStatus().GetErrorCode())
+	})
+
+	t.Run("closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+		resp, err := svr.TruncateSegments(context.Background(), &datapb.TruncateSegmentsRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, serverNotServingErrMsg, resp.GetStatus().GetReason())
+	})
+}
+
 //func TestGetComponentStates(t *testing.T) {
 //svr := newTestServer(t)
 //defer closeTestServer(t, svr)
@@ -2244,6 +2372,85 @@ func TestGetCompactionStateWithPlans(t *testing.T) {
 	})
 }
 
+func TestGetCompactionPolicyStats(t *testing.T) {
+	Params.DataCoordCfg.EnableCompaction = true
+	t.Run("test get compaction policy stats successfully", func(t *testing.T) {
+		svr := &Server{allocator: &MockAllocator{}}
+		svr.isServing = ServerStateHealthy
+		svr.compactionTrigger = &mockCompactionTrigger{
+			methods: map[string]interface{}{
+				"getCompactionPolicyStats": func(collectionID int64, ct *compactTime) []*milvuspb.SegmentCompactionStats {
+					return []*milvuspb.SegmentCompactionStats{{SegmentID: 1, Selected: true}}
+				},
+			},
+		}
+
+		resp, err := svr.GetCompactionPolicyStats(context.TODO(), &milvuspb.GetCompactionPolicyStatsRequest{
+			CollectionID: 1,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, 1, len(resp.GetSegments()))
+	})
+
+	t.Run("test get compaction policy stats with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateStopped
+
+		resp, err := svr.GetCompactionPolicyStats(context.TODO(), &milvuspb.GetCompactionPolicyStatsRequest{
+			CollectionID: 1,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.DataCoordCfg.GetNodeID()), resp.Status.Reason)
+	})
+}
+
+func TestCancelCompaction(t *testing.T) {
+	Params.DataCoordCfg.EnableCompaction = true
+	t.Run("test cancel compaction successfully", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateHealthy
+		svr.compactionHandler = &mockCompactionHandler{
+			methods: map[string]interface{}{
+				"cancelCompaction": func(signalID int64) error {
+					return nil
+				},
+			},
+		}
+
+		resp, err := svr.CancelCompaction(context.TODO(), &milvuspb.CancelCompactionRequest{CompactionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("test cancel compaction failure", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateHealthy
+		svr.compactionHandler = &mockCompactionHandler{
+			methods: map[string]interface{}{
+				"cancelCompaction": func(signalID int64) error {
+					return errors.New("mock error")
+				},
+			},
+		}
+
+		resp, err := svr.CancelCompaction(context.TODO(), &milvuspb.CancelCompactionRequest{CompactionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+	})
+
+	t.Run("test cancel compaction with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateStopped
+
+		resp, err := svr.CancelCompaction(context.TODO(), &milvuspb.CancelCompactionRequest{CompactionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(Params.DataCoordCfg.GetNodeID()), resp.GetReason())
+	})
+}
+
 func TestOptions(t *testing.T) {
 	kv := getMetaKv(t)
 	defer func() {
@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/logutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/retry"
@@ -359,6 +360,17 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 	segment := s.meta.GetSegment(segmentID)
 
 	if segment == nil {
+		// the deletes being flushed when the segment was clustered arrive after it's dropped
+		clustered, err := s.meta.AddClusteredDeltalogs(segmentID, req.GetDeltalogs())
+		if err != nil {
+			log.Error("failed to add deltalogs to clustered segments", zap.Int64("segmentID", segmentID), zap.Error(err))
+			resp.Reason = err.Error()
+			return resp, nil
+		}
+		if clustered {
+			resp.ErrorCode = commonpb.ErrorCode_Success
+			return resp, nil
+		}
 		log.Error("failed to get segment", zap.Int64("segmentID", segmentID))
 		failResponseWithCode(resp, commonpb.ErrorCode_SegmentNotFound, fmt.Sprintf("failed to get segment %d", segmentID))
 		return resp, nil
@@ -571,6 +583,7 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 	segment2DeltaBinlogs := make(map[UniqueID][]*datapb.FieldBinlog)
 	segment2InsertChannel := make(map[UniqueID]string)
 	segmentsNumOfRows := make(map[UniqueID]int64)
+	segment2KeyRange := make(map[UniqueID]*datapb.ClusteringKeyRange)
 
 	flushedIDs := make(map[int64]struct{})
 	for _, id := range segmentIDs {
@@ -610,6 +623,7 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 		}
 
 		segmentsNumOfRows[id] = segment.NumOfRows
+		segment2KeyRange[id] = segment.GetClusteringKeyRange()
 
 		statsBinlogs := segment.GetStatslogs()
 		field2StatsBinlog := make(map[UniqueID][]*datapb.Binlog)
@@ -633,12 +647,13 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 	binlogs := make([]*datapb.SegmentBinlogs, 0, len(segment2Binlogs))
 	for segmentID := range flushedIDs {
 		sbl := &datapb.SegmentBinlogs{
-			SegmentID:     segmentID,
-			NumOfRows:     segmentsNumOfRows[segmentID],
-			FieldBinlogs:  segment2Binlogs[segmentID],
-			Statslogs:     segment2StatsBinlogs[segmentID],
-			Deltalogs:     segment2DeltaBinlogs[segmentID],
-			InsertChannel: segment2InsertChannel[segmentID],
+			SegmentID:          segmentID,
+			NumOfRows:          segmentsNumOfRows[segmentID],
+			FieldBinlogs:       segment2Binlogs[segmentID],
+			Statslogs:          segment2StatsBinlogs[segmentID],
+			Deltalogs:          segment2DeltaBinlogs[segmentID],
+			InsertChannel:      segment2InsertChannel[segmentID],
+			ClusteringKeyRange: segment2KeyRange[segmentID],
 		}
 		binlogs = append(binlogs, sbl)
 	}
@@ -829,7 +844,9 @@ func (s *Server) CompleteCompaction(ctx context.Context, req *datapb.CompactionR
 
 // ManualCompaction triggers a compaction for a collection
 func (s *Server) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
-	log.Info("received manual compaction", zap.Int64("collectionID", req.GetCollectionID()))
+	log.Info("received manual compaction", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("partitionIDs", req.GetPartitionIDs()), zap.Int64s("segmentIDs", req.GetSegmentIDs()),
+		zap.Int64("targetSize", req.GetTargetSize()), zap.Int32("priority", req.GetPriority()))
 
 	resp := &milvuspb.ManualCompactionResponse{
 		Status: &commonpb.Status{
@@ -856,7 +873,11 @@ func (s *Server) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompa
 		return resp, nil
 	}
 
-	id, err := s.compactionTrigger.forceTriggerCompaction(req.CollectionID, ct)
+	id, err := s.compactionTrigger.forceTriggerCompaction(req.CollectionID, ct,
+		withPartitions(req.GetPartitionIDs()),
+		withSegments(req.GetSegmentIDs()),
+		withTargetSize(req.GetTargetSize()),
+		withPriority(req.GetPriority()))
 	if err != nil {
 		log.Error("failed to trigger manual compaction", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
 		resp.Status.Reason = err.Error()
@@ -891,15 +912,16 @@ func (s *Server) GetCompactionState(ctx context.Context, req *milvuspb.GetCompac
 	}
 
 	tasks := s.compactionHandler.getCompactionTasksBySignalID(req.GetCompactionID())
-	state, executingCnt, completedCnt, timeoutCnt := getCompactionState(tasks)
+	state, executingCnt, completedCnt, timeoutCnt, cancelledCnt := getCompactionState(tasks)
 
 	resp.State = state
 	resp.ExecutingPlanNo = int64(executingCnt)
 	resp.CompletedPlanNo = int64(completedCnt)
 	resp.TimeoutPlanNo = int64(timeoutCnt)
+	resp.CancelledPlanNo = int64(cancelledCnt)
 	resp.Status.ErrorCode = commonpb.ErrorCode_Success
 	log.Info("success to get compaction state", zap.Any("state", state), zap.Int("executing", executingCnt),
-		zap.Int("completed", completedCnt), zap.Int("timeout", timeoutCnt))
+		zap.Int("completed", completedCnt), zap.Int("timeout", timeoutCnt), zap.Int("cancelled", cancelledCnt))
 	return resp, nil
 }
 
@@ -927,7 +949,7 @@ func (s *Server) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.
 		resp.MergeInfos = append(resp.MergeInfos, getCompactionMergeInfo(task))
 	}
 
-	state, _, _, _ := getCompactionState(tasks)
+	state, _, _, _, _ := getCompactionState(tasks)
 
 	resp.Status.ErrorCode = commonpb.ErrorCode_Success
 	resp.State = state
@@ -935,6 +957,65 @@ func (s *Server) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.
 	return resp, nil
 }
 
+// GetCompactionPolicyStats lists the flushed segments of a collection and why they are selected by compaction policy or not
+func (s *Server) GetCompactionPolicyStats(ctx context.Context, req *milvuspb.GetCompactionPolicyStatsRequest) (*milvuspb.GetCompactionPolicyStatsResponse, error) {
+	log.Info("received the request to get compaction policy stats", zap.Int64("collectionID", req.GetCollectionID()))
+
+	resp := &milvuspb.GetCompactionPolicyStatsResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to get compaction policy stats", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(errDataCoordIsUnhealthy(Params.DataCoordCfg.GetNodeID())))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.DataCoordCfg.GetNodeID())
+		return resp, nil
+	}
+
+	if !Params.DataCoordCfg.EnableCompaction {
+		resp.Status.Reason = "compaction disabled"
+		return resp, nil
+	}
+
+	ct, err := getCompactTime(ctx, s.allocator)
+	if err != nil {
+		log.Warn("failed to get compact time", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	resp.Segments = s.compactionTrigger.getCompactionPolicyStats(req.GetCollectionID(), ct)
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// CancelCompaction cancels the pending and executing plans of a manual compaction
+func (s *Server) CancelCompaction(ctx context.Context, req *milvuspb.CancelCompactionRequest) (*commonpb.Status, error) {
+	log.Info("received the request to cancel compaction", zap.Int64("compactionID", req.GetCompactionID()))
+
+	resp := &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}
+
+	if s.isClosed() {
+		log.Warn("failed to cancel compaction", zap.Int64("compactionID", req.GetCompactionID()), zap.Error(errDataCoordIsUnhealthy(Params.DataCoordCfg.GetNodeID())))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.DataCoordCfg.GetNodeID())
+		return resp, nil
+	}
+
+	if !Params.DataCoordCfg.EnableCompaction {
+		resp.Reason = "compaction disabled"
+		return resp, nil
+	}
+
+	if err := s.compactionHandler.cancelCompaction(req.GetCompactionID()); err != nil {
+		log.Warn("failed to cancel compaction", zap.Int64("compactionID", req.GetCompactionID()), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+
+	log.Info("success to cancel compaction", zap.Int64("compactionID", req.GetCompactionID()))
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
 func getCompactionMergeInfo(task *compactionTask) *milvuspb.CompactionMergeInfo {
 	segments := task.plan.GetSegmentBinlogs()
 	var sources []int64
@@ -953,20 +1034,25 @@ func getCompactionMergeInfo(task *compactionTask) *milvuspb.CompactionMergeInfo
 	}
 }
 
-func getCompactionState(tasks []*compactionTask) (state commonpb.CompactionState, executingCnt, completedCnt, timeoutCnt int) {
+func getCompactionState(tasks []*compactionTask) (state commonpb.CompactionState, executingCnt, completedCnt, timeoutCnt, cancelledCnt int) {
 	for _, t := range tasks {
 		switch t.state {
-		case executing:
+		case executing, pending:
 			executingCnt++
 		case completed:
 			completedCnt++
 		case timeout:
 			timeoutCnt++
+		case cancelled:
+			cancelledCnt++
 		}
 	}
-	if executingCnt != 0 {
+	switch {
+	case executingCnt != 0:
 		state = commonpb.CompactionState_Executing
-	} else {
+	case cancelledCnt != 0:
+		state = commonpb.CompactionState_Cancelled
+	default:
 		state = commonpb.CompactionState_Completed
 	}
 	return
@@ -987,6 +1073,14 @@ func (s *Server) WatchChannels(ctx context.Context, req *datapb.WatchChannelsReq
 		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.DataCoordCfg.GetNodeID())
 		return resp, nil
 	}
+	// channels added by AlterShards may bring new start positions, refresh the cached collection info
+	if s.meta.GetCollection(req.GetCollectionID()) != nil {
+		if err := s.loadCollectionFromRootCoord(ctx, req.GetCollectionID()); err != nil {
+			log.Warn("fail to refresh collection info", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+			resp.Status.Reason = err.Error()
+			return resp, nil
+		}
+	}
 	for _, channelName := range req.GetChannelNames() {
 		ch := &channel{
 			Name:         channelName,
@@ -1204,3 +1298,295 @@ func (s *Server) AddSegment(ctx context.Context, req *datapb.AddSegmentRequest)
 		ErrorCode: commonpb.ErrorCode_Success,
 	}, nil
 }
+
+// TruncateSegments marks all the segments of the collection or partition dropped, the segments are kept
+// if any of them may still receive the inserts no later than the truncate timestamp.
+// The segments to truncate are fixed and persisted by the first call, the later calls wait for the same segments,
+// and an interrupted truncate is resumed with them until the truncate msg is broadcast and the call is finished.
+func (s *Server) TruncateSegments(ctx context.Context, req *datapb.TruncateSegmentsRequest) (*datapb.TruncateSegmentsResponse, error) {
+	log.Info("receive truncate segments request",
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("partitionID", req.GetPartitionID()),
+		zap.Uint64("truncateTs", req.GetTruncateTs()),
+		zap.Bool("finished", req.GetFinished()))
+	resp := &datapb.TruncateSegmentsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if s.isClosed() {
+		resp.Status.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+
+	info, err := s.meta.GetTruncateInfo(req.GetCollectionID(), req.GetPartitionID())
+	if err != nil {
+		resp.Status.Reason = fmt.Sprintf("failed to get truncate info of collection %d, %s", req.GetCollectionID(), err)
+		return resp, nil
+	}
+	if req.GetFinished() {
+		if info != nil {
+			if err := s.meta.RemoveTruncateInfo(req.GetCollectionID(), req.GetPartitionID()); err != nil {
+				resp.Status.Reason = fmt.Sprintf("failed to remove truncate info of collection %d, %s", req.GetCollectionID(), err)
+				return resp, nil
+			}
+		}
+		log.Info("truncate segments finished",
+			zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Int64("partitionID", req.GetPartitionID()))
+		resp.Status.ErrorCode = commonpb.ErrorCode_Success
+		return resp, nil
+	}
+
+	// the segments created later, for the inserts after the truncation starts, are not truncated,
+	// otherwise the truncation never ends under steady inserts
+	if info == nil {
+		// the segments pinned by snapshots must stay served, so the collection with snapshots is not truncated
+		err := s.snapshotManager.withoutSnapshots(req.GetCollectionID(), func() error {
+			segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+				return isSegmentHealthy(segment) &&
+					segment.GetCollectionID() == req.GetCollectionID() &&
+					(req.GetPartitionID() == 0 || segment.GetPartitionID() == req.GetPartitionID())
+			})
+			info = &datapb.TruncateInfo{
+				CollectionID: req.GetCollectionID(),
+				PartitionID:  req.GetPartitionID(),
+				SegmentIDs:   make([]UniqueID, 0, len(segments)),
+			}
+			for _, segment := range segments {
+				info.SegmentIDs = append(info.SegmentIDs, segment.GetID())
+			}
+			if err := s.meta.SaveTruncateInfo(info); err != nil {
+				return fmt.Errorf("failed to save truncate info of collection %d, %w", req.GetCollectionID(), err)
+			}
+			return nil
+		})
+		if err != nil {
+			resp.Status.Reason = err.Error()
+			return resp, nil
+		}
+	}
+	segmentIDs := info.GetSegmentIDs()
+
+	var growingIDs []UniqueID
+	for _, segmentID := range segmentIDs {
+		if segment := s.meta.GetSegment(segmentID); segment != nil && segment.GetState() == commonpb.SegmentState_Growing {
+			growingIDs = append(growingIDs, segmentID)
+		}
+	}
+	// seal the growing segments first, so that no more inserts are assigned to them
+	if len(growingIDs) > 0 {
+		if _, err := s.segmentManager.SealAllSegments(ctx, req.GetCollectionID(), growingIDs); err != nil {
+			resp.Status.Reason = fmt.Sprintf("failed to seal segments of collection %d, %s", req.GetCollectionID(), err)
+			return resp, nil
+		}
+	}
+
+	var allocatedUntil Timestamp
+	for _, segmentID := range segmentIDs {
+		segment := s.meta.GetSegment(segmentID)
+		if segment == nil {
+			continue
+		}
+		for _, allocation := range segment.allocations {
+			if allocation.ExpireTime > allocatedUntil {
+				allocatedUntil = allocation.ExpireTime
+			}
+		}
+	}
+	if allocatedUntil >= req.GetTruncateTs() {
+		log.Info("segments are allocated after the truncate timestamp, retry later",
+			zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Uint64("allocatedUntil", allocatedUntil))
+		resp.Status.ErrorCode = commonpb.ErrorCode_Success
+		resp.AllocatedUntil = allocatedUntil
+		return resp, nil
+	}
+
+	// the segments dropped already by an interrupted truncate are skipped
+	for _, segmentID := range segmentIDs {
+		s.segmentManager.DropSegment(ctx, segmentID)
+	}
+	if err := s.meta.TruncateSegments(segmentIDs); err != nil {
+		resp.Status.Reason = fmt.Sprintf("failed to truncate segments of collection %d, %s", req.GetCollectionID(), err)
+		return resp, nil
+	}
+	log.Info("truncate segments done",
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("partitionID", req.GetPartitionID()),
+		zap.Int64s("segmentIDs", segmentIDs))
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.SegmentIDs = segmentIDs
+	return resp, nil
+}
+
+// CreateSnapshot pins the segments holding the data of the collection by now as a named snapshot.
+func (s *Server) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) (*datapb.CreateSnapshotResponse, error) {
+	log.Info("receive create snapshot request",
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("name", req.GetName()))
+	resp := &datapb.CreateSnapshotResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if s.isClosed() {
+		resp.Status.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+	if len(s.snapshotManager.list(req.GetCollectionID(), req.GetName())) > 0 {
+		resp.Status.Reason = fmt.Sprintf("snapshot %s already exists in collection %d", req.GetName(), req.GetCollectionID())
+		return resp, nil
+	}
+
+	snapshotTs, err := s.allocator.allocTimestamp(ctx)
+	if err != nil {
+		resp.Status.Reason = fmt.Sprintf("failed to allocate snapshot timestamp, %s", err)
+		return resp, nil
+	}
+	// seal the growing segments, so that the entities inserted later go to the segments out of the snapshot
+	if _, err = s.segmentManager.SealAllSegments(ctx, req.GetCollectionID(), nil); err != nil {
+		resp.Status.Reason = fmt.Sprintf("failed to seal segments of collection %d, %s", req.GetCollectionID(), err)
+		return resp, nil
+	}
+
+	segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return isSegmentHealthy(segment) && segment.GetCollectionID() == req.GetCollectionID()
+	})
+	segmentIDs := make([]UniqueID, 0, len(segments))
+	for _, segment := range segments {
+		// the result segment of the compaction is not pinned
+		if segment.isCompacting {
+			resp.Status.Reason = fmt.Sprintf("segment %d of collection %d is compacting, retry later", segment.GetID(), req.GetCollectionID())
+			return resp, nil
+		}
+		segmentIDs = append(segmentIDs, segment.GetID())
+	}
+
+	snapshotID, err := s.allocator.allocID(ctx)
+	if err != nil {
+		resp.Status.Reason = fmt.Sprintf("failed to allocate snapshot id, %s", err)
+		return resp, nil
+	}
+	snapshot := &datapb.SnapshotInfo{
+		SnapshotID:   snapshotID,
+		Name:         req.GetName(),
+		CollectionID: req.GetCollectionID(),
+		SnapshotTs:   snapshotTs,
+		SegmentIDs:   segmentIDs,
+	}
+	// the segments being truncated can't be pinned
+	checkTruncating := func() error {
+		truncating, err := s.meta.IsTruncating(req.GetCollectionID())
+		if err != nil {
+			return fmt.Errorf("failed to get truncate info of collection %d, %w", req.GetCollectionID(), err)
+		}
+		if truncating {
+			return fmt.Errorf("collection %d is being truncated, retry later", req.GetCollectionID())
+		}
+		return nil
+	}
+	if err = s.snapshotManager.create(snapshot, checkTruncating); err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.Snapshot = snapshot
+	return resp, nil
+}
+
+// DropSnapshot drops the snapshot and releases its segments to compaction and garbage collection,
+// all the snapshots of the collection are dropped if the name is empty.
+func (s *Server) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	log.Info("receive drop snapshot request",
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("name", req.GetName()))
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+	if s.isClosed() {
+		resp.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+	var err error
+	if req.GetName() == "" {
+		err = s.snapshotManager.dropCollection(req.GetCollectionID())
+	} else {
+		err = s.snapshotManager.drop(req.GetCollectionID(), req.GetName())
+	}
+	if err != nil {
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// ListSnapshots returns the snapshots of the collection.
+func (s *Server) ListSnapshots(ctx context.Context, req *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error) {
+	resp := &datapb.ListSnapshotsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if s.isClosed() {
+		resp.Status.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.Snapshots = s.snapshotManager.list(req.GetCollectionID(), req.GetName())
+	return resp, nil
+}
+
+// CheckPrimaryKeys returns the primary keys which may exist in the collection.
+// The DataNodes serving the channels of the collection check the keys against the bloom filters of their segments,
+// the keys inserted but not consumed by the DataNodes yet are not reported.
+func (s *Server) CheckPrimaryKeys(ctx context.Context, req *datapb.CheckPrimaryKeysRequest) (*datapb.CheckPrimaryKeysResponse, error) {
+	resp := &datapb.CheckPrimaryKeysResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if s.isClosed() {
+		resp.Status.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+
+	// entities inserted before the shards were altered live in the old channels, so all the channels are checked
+	var nodeIDs []int64
+	for _, info := range s.channelManager.GetChannels() {
+		for _, ch := range info.Channels {
+			if ch.CollectionID == req.GetCollectionID() {
+				nodeIDs = append(nodeIDs, info.NodeID)
+				break
+			}
+		}
+	}
+	if len(nodeIDs) == 0 {
+		resp.Status.Reason = fmt.Sprintf("no data node serves collection %d", req.GetCollectionID())
+		return resp, nil
+	}
+
+	existing := &schemapb.IDs{}
+	seen := make(map[interface{}]struct{})
+	for _, nodeID := range nodeIDs {
+		ret, err := s.sessionManager.CheckPrimaryKeys(ctx, nodeID, req)
+		if err != nil {
+			log.Warn("failed to check primary keys", zap.Int64("collectionID", req.GetCollectionID()),
+				zap.Int64("nodeID", nodeID), zap.Error(err))
+			resp.Status.Reason = err.Error()
+			return resp, nil
+		}
+		keys := ret.GetExistingKeys()
+		for i := 0; i < typeutil.GetSizeOfIDs(keys); i++ {
+			pk := typeutil.GetPK(keys, int64(i))
+			if _, ok := seen[pk]; !ok {
+				seen[pk] = struct{}{}
+				typeutil.AppendIDs(existing, keys, i)
+			}
+		}
+	}
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.ExistingKeys = existing
+	return resp, nil
+}
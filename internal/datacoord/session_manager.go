@@ -18,6 +18,7 @@ package datacoord
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -36,6 +37,8 @@ const (
 	importTimeout     = 3 * time.Hour
 	reCollectTimeout  = 5 * time.Second
 	addSegmentTimeout = 30 * time.Second
+	// checkPrimaryKeysTimeout limits the check of primary keys on each DataNode, the inserts are blocked meanwhile
+	checkPrimaryKeysTimeout = 5 * time.Second
 )
 
 // SessionManager provides the grpc interfaces of cluster
@@ -152,6 +155,34 @@ func (c *SessionManager) execCompaction(nodeID int64, plan *datapb.CompactionPla
 	log.Info("success to execute compaction", zap.Int64("node", nodeID), zap.Any("planID", plan.GetPlanID()))
 }
 
+// StopCompaction is a grpc interface. It will send request to DataNode with provided `nodeID` asynchronously.
+func (c *SessionManager) StopCompaction(nodeID int64, planIDs []int64) {
+	go c.execStopCompaction(nodeID, planIDs)
+}
+
+func (c *SessionManager) execStopCompaction(nodeID int64, planIDs []int64) {
+	ctx, cancel := context.WithTimeout(context.Background(), compactionTimeout)
+	defer cancel()
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		log.Warn("failed to get client", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return
+	}
+
+	resp, err := cli.StopCompaction(ctx, &datapb.StopCompactionRequest{
+		Base: &commonpb.MsgBase{
+			SourceID: Params.DataCoordCfg.GetNodeID(),
+		},
+		PlanIDs: planIDs,
+	})
+	if err := VerifyResponse(resp, err); err != nil {
+		log.Warn("failed to stop compaction", zap.Int64("node", nodeID), zap.Error(err), zap.Int64s("planIDs", planIDs))
+		return
+	}
+
+	log.Info("success to stop compaction", zap.Int64("node", nodeID), zap.Int64s("planIDs", planIDs))
+}
+
 // Import is a grpc interface. It will send request to DataNode with provided `nodeID` asynchronously.
 func (c *SessionManager) Import(ctx context.Context, nodeID int64, itr *datapb.ImportTaskRequest) {
 	go c.execImport(ctx, nodeID, itr)
@@ -227,6 +258,24 @@ func (c *SessionManager) execAddSegment(ctx context.Context, nodeID int64, req *
 	log.Info("success to add segment", zap.Int64("DataNode ID", nodeID), zap.Any("add segment req", req))
 }
 
+// CheckPrimaryKeys returns the primary keys which may exist in the segments served by the DataNode with provided `nodeID`.
+func (c *SessionManager) CheckPrimaryKeys(ctx context.Context, nodeID int64, req *datapb.CheckPrimaryKeysRequest) (*datapb.CheckPrimaryKeysResponse, error) {
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, checkPrimaryKeysTimeout)
+	defer cancel()
+	resp, err := cli.CheckPrimaryKeys(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+	return resp, nil
+}
+
 func (c *SessionManager) getClient(ctx context.Context, nodeID int64) (types.DataNode, error) {
 	c.sessions.RLock()
 	session, ok := c.sessions.data[nodeID]
@@ -0,0 +1,180 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"go.uber.org/zap"
+)
+
+// snapshotManager maintains the snapshots of collections.
+// The segments of a snapshot are pinned by a segment reference lock held by snapshotReferNodeID,
+// so that compaction and garbage collection leave their binlogs alone until the snapshot is dropped.
+type snapshotManager struct {
+	etcdKV   kv.BaseKV
+	segRefer *SegmentReferenceManager
+
+	// collectionID -> (name -> snapshot)
+	snapshots map[UniqueID]map[string]*datapb.SnapshotInfo
+	lock      sync.RWMutex
+}
+
+func newSnapshotManager(etcdKV kv.BaseKV, segRefer *SegmentReferenceManager) (*snapshotManager, error) {
+	m := &snapshotManager{
+		etcdKV:    etcdKV,
+		segRefer:  segRefer,
+		snapshots: make(map[UniqueID]map[string]*datapb.SnapshotInfo),
+	}
+	_, values, err := etcdKV.LoadWithPrefix(snapshotPrefix)
+	if err != nil {
+		log.Error("load snapshots from etcd failed", zap.Error(err))
+		return nil, err
+	}
+	for _, value := range values {
+		snapshot := &datapb.SnapshotInfo{}
+		if err = proto.Unmarshal([]byte(value), snapshot); err != nil {
+			log.Error("unmarshal snapshot failed", zap.Error(err))
+			return nil, err
+		}
+		m.put(snapshot)
+	}
+	log.Info("load snapshots successfully", zap.Int("num", len(values)))
+	return m, nil
+}
+
+func generateSnapshotKey(collectionID UniqueID, name string) string {
+	return path.Join(snapshotPrefix, strconv.FormatInt(collectionID, 10), name)
+}
+
+func (m *snapshotManager) put(snapshot *datapb.SnapshotInfo) {
+	if _, ok := m.snapshots[snapshot.GetCollectionID()]; !ok {
+		m.snapshots[snapshot.GetCollectionID()] = make(map[string]*datapb.SnapshotInfo)
+	}
+	m.snapshots[snapshot.GetCollectionID()][snapshot.GetName()] = snapshot
+}
+
+// create pins the segments of the snapshot and saves it, check is called before with the snapshots locked if it's not nil.
+func (m *snapshotManager) create(snapshot *datapb.SnapshotInfo, check func() error) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.snapshots[snapshot.GetCollectionID()][snapshot.GetName()]; ok {
+		return fmt.Errorf("snapshot %s already exists in collection %d", snapshot.GetName(), snapshot.GetCollectionID())
+	}
+	if check != nil {
+		if err := check(); err != nil {
+			return err
+		}
+	}
+	value, err := proto.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	if err = m.segRefer.AddSegmentsLock(snapshot.GetSnapshotID(), snapshot.GetSegmentIDs(), snapshotReferNodeID); err != nil {
+		return err
+	}
+	if err = m.etcdKV.Save(generateSnapshotKey(snapshot.GetCollectionID(), snapshot.GetName()), string(value)); err != nil {
+		log.Error("save snapshot to etcd failed", zap.Int64("collectionID", snapshot.GetCollectionID()),
+			zap.String("name", snapshot.GetName()), zap.Error(err))
+		if releaseErr := m.segRefer.ReleaseSegmentsLock(snapshot.GetSnapshotID(), snapshotReferNodeID); releaseErr != nil {
+			log.Warn("release segments lock of snapshot failed", zap.Int64("snapshotID", snapshot.GetSnapshotID()), zap.Error(releaseErr))
+		}
+		return err
+	}
+	m.put(snapshot)
+	log.Info("create snapshot successfully", zap.Int64("collectionID", snapshot.GetCollectionID()), zap.String("name", snapshot.GetName()),
+		zap.Uint64("snapshotTs", snapshot.GetSnapshotTs()), zap.Int64s("segmentIDs", snapshot.GetSegmentIDs()))
+	return nil
+}
+
+// withoutSnapshots calls fn if the collection has no snapshots, no snapshot of the collection is created until fn returns.
+func (m *snapshotManager) withoutSnapshots(collectionID UniqueID, fn func() error) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if len(m.snapshots[collectionID]) > 0 {
+		return fmt.Errorf("collection %d has %d snapshots, drop them first", collectionID, len(m.snapshots[collectionID]))
+	}
+	return fn()
+}
+
+// drop removes the snapshot and unpins its segments.
+func (m *snapshotManager) drop(collectionID UniqueID, name string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.dropLocked(collectionID, name)
+}
+
+// dropCollection drops all the snapshots of the collection, it's called when the collection is dropped.
+func (m *snapshotManager) dropCollection(collectionID UniqueID) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for name := range m.snapshots[collectionID] {
+		if err := m.dropLocked(collectionID, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *snapshotManager) dropLocked(collectionID UniqueID, name string) error {
+	snapshot, ok := m.snapshots[collectionID][name]
+	if !ok {
+		return fmt.Errorf("snapshot %s does not exist in collection %d", name, collectionID)
+	}
+	// the snapshot is removed first, a leaked lock is safer than a snapshot whose segments are not pinned
+	if err := m.etcdKV.Remove(generateSnapshotKey(collectionID, name)); err != nil {
+		log.Error("remove snapshot from etcd failed", zap.Int64("collectionID", collectionID), zap.String("name", name), zap.Error(err))
+		return err
+	}
+	delete(m.snapshots[collectionID], name)
+	if len(m.snapshots[collectionID]) == 0 {
+		delete(m.snapshots, collectionID)
+	}
+	if err := m.segRefer.ReleaseSegmentsLock(snapshot.GetSnapshotID(), snapshotReferNodeID); err != nil {
+		return err
+	}
+	log.Info("drop snapshot successfully", zap.Int64("collectionID", collectionID), zap.String("name", name))
+	return nil
+}
+
+// list returns the snapshots of the collection sorted by name, only the one named name is returned if name is not empty.
+func (m *snapshotManager) list(collectionID UniqueID, name string) []*datapb.SnapshotInfo {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	ret := make([]*datapb.SnapshotInfo, 0, len(m.snapshots[collectionID]))
+	for _, snapshot := range m.snapshots[collectionID] {
+		if name == "" || snapshot.GetName() == name {
+			ret = append(ret, snapshot)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].GetName() < ret[j].GetName()
+	})
+	return ret
+}
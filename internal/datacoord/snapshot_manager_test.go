@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func Test_snapshotManager(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	segRefer, err := NewSegmentReferenceManager(kv, nil)
+	assert.NoError(t, err)
+	m, err := newSnapshotManager(kv, segRefer)
+	assert.NoError(t, err)
+
+	snapshot1 := &datapb.SnapshotInfo{SnapshotID: 1, Name: "s1", CollectionID: 100, SnapshotTs: 1000, SegmentIDs: []UniqueID{1, 2}}
+	snapshot2 := &datapb.SnapshotInfo{SnapshotID: 2, Name: "s0", CollectionID: 100, SnapshotTs: 2000, SegmentIDs: []UniqueID{2, 3}}
+	assert.NoError(t, m.create(snapshot1, nil))
+	assert.NoError(t, m.create(snapshot2, nil))
+	assert.Error(t, m.create(snapshot1, nil))
+
+	for _, segID := range []UniqueID{1, 2, 3} {
+		assert.True(t, segRefer.HasSegmentLock(segID))
+	}
+	assert.False(t, segRefer.HasSegmentLock(4))
+
+	snapshots := m.list(100, "")
+	assert.Equal(t, 2, len(snapshots))
+	assert.Equal(t, "s0", snapshots[0].GetName())
+	assert.Equal(t, "s1", snapshots[1].GetName())
+	assert.Equal(t, 1, len(m.list(100, "s1")))
+	assert.Empty(t, m.list(100, "s2"))
+	assert.Empty(t, m.list(101, ""))
+
+	t.Run("survive restart", func(t *testing.T) {
+		// the segments locks of snapshots are kept though snapshotReferNodeID is never online
+		segRefer, err := NewSegmentReferenceManager(kv, nil)
+		assert.NoError(t, err)
+		restarted, err := newSnapshotManager(kv, segRefer)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(restarted.list(100, "")))
+		for _, segID := range []UniqueID{1, 2, 3} {
+			assert.True(t, segRefer.HasSegmentLock(segID))
+		}
+	})
+
+	assert.NoError(t, m.drop(100, "s1"))
+	assert.Error(t, m.drop(100, "s1"))
+	assert.False(t, segRefer.HasSegmentLock(1))
+	assert.True(t, segRefer.HasSegmentLock(2))
+	assert.True(t, segRefer.HasSegmentLock(3))
+
+	assert.NoError(t, m.drop(100, "s0"))
+	assert.Empty(t, m.list(100, ""))
+	assert.False(t, segRefer.HasSegmentLock(2))
+}
+
+func Test_snapshotManager_collection(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	segRefer, err := NewSegmentReferenceManager(kv, nil)
+	assert.NoError(t, err)
+	m, err := newSnapshotManager(kv, segRefer)
+	assert.NoError(t, err)
+
+	called := false
+	fn := func() error {
+		called = true
+		return nil
+	}
+	assert.NoError(t, m.withoutSnapshots(100, fn))
+	assert.True(t, called)
+
+	snapshot := &datapb.SnapshotInfo{SnapshotID: 1, Name: "s1", CollectionID: 100, SegmentIDs: []UniqueID{1}}
+	assert.Error(t, m.create(snapshot, func() error { return errors.New("mock") }))
+	assert.Empty(t, m.list(100, ""))
+	assert.NoError(t, m.create(snapshot, nil))
+	assert.NoError(t, m.create(&datapb.SnapshotInfo{SnapshotID: 2, Name: "s2", CollectionID: 100, SegmentIDs: []UniqueID{2}}, nil))
+	assert.NoError(t, m.create(&datapb.SnapshotInfo{SnapshotID: 3, Name: "s1", CollectionID: 101, SegmentIDs: []UniqueID{3}}, nil))
+
+	called = false
+	assert.Error(t, m.withoutSnapshots(100, fn))
+	assert.False(t, called)
+
+	assert.NoError(t, m.dropCollection(100))
+	assert.Empty(t, m.list(100, ""))
+	assert.False(t, segRefer.HasSegmentLock(1))
+	assert.False(t, segRefer.HasSegmentLock(2))
+	assert.Equal(t, 1, len(m.list(101, "")))
+	assert.True(t, segRefer.HasSegmentLock(3))
+	assert.NoError(t, m.withoutSnapshots(100, fn))
+}
@@ -21,8 +21,11 @@ import (
 	"errors"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"go.uber.org/zap"
 )
 
 // Response response interface for verification
@@ -91,3 +94,29 @@ func getCompactTime(ctx context.Context, allocator allocator) (*compactTime, err
 	// no expiration time
 	return &compactTime{ttRetentionLogic, 0}, nil
 }
+
+// getCollectionRetention returns the time travel retention of a collection in seconds,
+// the default retention is used if the collection doesn't set a valid one.
+func getCollectionRetention(collectionID UniqueID, properties []*commonpb.KeyValuePair) int64 {
+	retention, err := common.GetTimeTravelRetention(properties, Params.CommonCfg.RetentionDuration)
+	if err != nil {
+		log.Warn("invalid time travel retention of collection, use the default one", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return Params.CommonCfg.RetentionDuration
+	}
+	return retention
+}
+
+// withRetention returns the compact time honouring the time travel retention of a collection in seconds,
+// entities are not expired before the travel time so that they stay visible within the retained window.
+func (ct *compactTime) withRetention(retention int64) *compactTime {
+	if ct == nil || ct.travelTime == 0 || retention == Params.CommonCfg.RetentionDuration {
+		return ct
+	}
+	shift := time.Duration(Params.CommonCfg.RetentionDuration-retention) * time.Second
+	travelTime := tsoutil.AddPhysicalDurationOnTs(ct.travelTime, shift)
+	expireTime := ct.expireTime
+	if expireTime > travelTime {
+		expireTime = travelTime
+	}
+	return &compactTime{travelTime, expireTime}
+}
@@ -143,6 +143,24 @@ func Test_getCompactTime(t *testing.T) {
 	}
 }
 
+func Test_compactTimeWithRetention(t *testing.T) {
+	Params.Init()
+	Params.CommonCfg.RetentionDuration = 3600
+
+	now := time.Date(2021, 11, 15, 0, 0, 0, 0, time.Local)
+	travelTime := tsoutil.ComposeTSByTime(now.Add(-time.Hour), 0)
+	expireTime := tsoutil.ComposeTSByTime(now.Add(-2*time.Hour), 0)
+	ct := &compactTime{travelTime, expireTime}
+
+	assert.Same(t, ct, ct.withRetention(3600))
+	assert.EqualValues(t, &compactTime{tsoutil.ComposeTSByTime(now.Add(-time.Minute), 0), expireTime}, ct.withRetention(60))
+	// entities are kept within the longer retention
+	assert.EqualValues(t, &compactTime{tsoutil.ComposeTSByTime(now.Add(-3*time.Hour), 0), tsoutil.ComposeTSByTime(now.Add(-3*time.Hour), 0)}, ct.withRetention(3*3600))
+
+	var nilTime *compactTime
+	assert.Nil(t, nilTime.withRetention(60))
+}
+
 type fixedTSOAllocator struct {
 	fixedTime time.Time
 }
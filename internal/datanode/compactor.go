@@ -21,15 +21,19 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
@@ -191,19 +195,255 @@ func nano2Milli(nano time.Duration) float64 {
 	return float64(nano) / float64(time.Millisecond)
 }
 
-func (t *compactionTask) merge(mergeItr iterator, delta map[interface{}]Timestamp, schema *schemapb.CollectionSchema, currentTs Timestamp) ([]*InsertData, int64, error) {
-	mergeStart := time.Now()
+// mergedRows holds the remaining rows of the compacted segments, column by column.
+type mergedRows struct {
+	dim          int // dimension of float/binary vector field
+	fID2Type     map[UniqueID]schemapb.DataType
+	fID2Content  map[UniqueID][]interface{}
+	fID2Nullable map[UniqueID]bool // the value of a null row is nil
+}
+
+// numRows calculates numRows from rowID field, fieldID 0
+func (r *mergedRows) numRows() int64 {
+	return int64(len(r.fID2Content[0]))
+}
+
+// toInsertDatas splits the rows into InsertDatas, each of which populates one binlog.
+func (r *mergedRows) toInsertDatas() ([]*InsertData, error) {
+	var (
+		numRows          = int(r.numRows())
+		maxRowsPerBinlog = int(Params.DataNodeCfg.FlushInsertBufferSize / (int64(r.dim) * 4))
+		numBinlogs       = numRows / maxRowsPerBinlog
+		iDatas           = make([]*InsertData, 0)
+	)
+	if numRows%maxRowsPerBinlog != 0 {
+		numBinlogs++
+	}
+
+	for i := 0; i < numBinlogs; i++ {
+		iDatas = append(iDatas, &InsertData{Data: make(map[storage.FieldID]storage.FieldData)})
+	}
+
+	for fID, content := range r.fID2Content {
+		tp, ok := r.fID2Type[fID]
+		if !ok {
+			log.Warn("no field ID in this schema", zap.Int64("fieldID", fID))
+			return nil, errors.New("Unexpected error")
+		}
+
+		for i := 0; i < numBinlogs; i++ {
+			var c []interface{}
+
+			if i == numBinlogs-1 {
+				c = content[i*maxRowsPerBinlog:]
+			} else {
+				c = content[i*maxRowsPerBinlog : i*maxRowsPerBinlog+maxRowsPerBinlog]
+			}
+
+			var validData []bool
+			if r.fID2Nullable[fID] {
+				var err error
+				if c, validData, err = splitNullRows(tp, c); err != nil {
+					return nil, err
+				}
+			}
+
+			fData, err := interface2FieldData(tp, c, int64(len(c)))
+
+			if err != nil {
+				log.Warn("transfer interface to FieldData wrong", zap.Error(err))
+				return nil, err
+			}
+			if validData != nil {
+				storage.SetValidData(fData, validData)
+			}
+			iDatas[i].Data[fID] = fData
+		}
+
+	}
+	return iDatas, nil
+}
+
+// clusterBy sorts the rows by the values of the field, and splits them into chunks holding no more than maxRows rows.
+// A chunk never crosses a bound, and rows with the same value are kept in one chunk even if it exceeds maxRows,
+// so the value ranges of the chunks are disjoint.
+func (r *mergedRows) clusterBy(fieldID UniqueID, maxRows int64, bounds []*planpb.GenericValue) ([]*mergedRows, error) {
+	keys, ok := r.fID2Content[fieldID]
+	if !ok {
+		return nil, fmt.Errorf("clustering key field %d not found", fieldID)
+	}
+	if maxRows <= 0 {
+		return nil, fmt.Errorf("illegal max rows %d of clustered segment", maxRows)
+	}
+
+	orders := make([]int, len(keys))
+	for i := range orders {
+		orders[i] = i
+	}
+	var sortErr error
+	sort.SliceStable(orders, func(i, j int) bool {
+		less, err := lessValue(keys[orders[i]], keys[orders[j]])
+		if err != nil {
+			sortErr = err
+		}
+		return less
+	})
+	if sortErr != nil {
+		return nil, sortErr
+	}
+
+	// rangeOf returns the number of bounds no greater than the value
+	rangeOf := func(v interface{}) (int, error) {
+		value, err := toGenericValue(v)
+		if err != nil {
+			return 0, err
+		}
+		return sort.Search(len(bounds), func(i int) bool {
+			ret, _ := planparserv2.CompareValue(bounds[i], value)
+			return ret > 0
+		}), nil
+	}
+
+	chunks := make([]*mergedRows, 0)
+	for start := 0; start < len(orders); {
+		startRange, err := rangeOf(keys[orders[start]])
+		if err != nil {
+			return nil, err
+		}
+		end := start + 1
+		for ; end < len(orders); end++ {
+			less, err := lessValue(keys[orders[end-1]], keys[orders[end]])
+			if err != nil {
+				return nil, err
+			}
+			if !less {
+				continue
+			}
+			if end-start >= int(maxRows) {
+				break
+			}
+			endRange, err := rangeOf(keys[orders[end]])
+			if err != nil {
+				return nil, err
+			}
+			if endRange != startRange {
+				break
+			}
+		}
+		chunk := &mergedRows{
+			dim:          r.dim,
+			fID2Type:     r.fID2Type,
+			fID2Content:  make(map[UniqueID][]interface{}),
+			fID2Nullable: r.fID2Nullable,
+		}
+		for fID, content := range r.fID2Content {
+			c := make([]interface{}, 0, end-start)
+			for _, idx := range orders[start:end] {
+				c = append(c, content[idx])
+			}
+			chunk.fID2Content[fID] = c
+		}
+		chunks = append(chunks, chunk)
+		start = end
+	}
+	return chunks, nil
+}
+
+// splitDeltaBuf splits the deletions by the primary keys of the chunks, a deletion only goes to the chunks holding
+// its primary key.
+func splitDeltaBuf(deltaBuf *DelDataBuf, chunks []*mergedRows, pkID UniqueID) []*DelDataBuf {
+	bufs := make([]*DelDataBuf, len(chunks))
+	pk2Chunks := make(map[interface{}][]int)
+	for i, chunk := range chunks {
+		bufs[i] = newDelDataBuf()
+		for _, pk := range chunk.fID2Content[pkID] {
+			if idx := pk2Chunks[pk]; len(idx) == 0 || idx[len(idx)-1] != i {
+				pk2Chunks[pk] = append(idx, i)
+			}
+		}
+	}
+	for i, pk := range deltaBuf.delData.Pks {
+		ts := deltaBuf.delData.Tss[i]
+		for _, idx := range pk2Chunks[pk.GetValue()] {
+			bufs[idx].delData.Append(pk, ts)
+			bufs[idx].updateSize(1)
+			bufs[idx].updateTimeRange(TimeRange{timestampMin: ts, timestampMax: ts})
+		}
+	}
+	return bufs
+}
+
+// keyRange returns the min and max values of the field, rows must be sorted by the field.
+func (r *mergedRows) keyRange(fieldID UniqueID) (*datapb.ClusteringKeyRange, error) {
+	keys := r.fID2Content[fieldID]
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no value of clustering key field %d", fieldID)
+	}
+	min, err := toGenericValue(keys[0])
+	if err != nil {
+		return nil, err
+	}
+	max, err := toGenericValue(keys[len(keys)-1])
+	if err != nil {
+		return nil, err
+	}
+	return &datapb.ClusteringKeyRange{
+		FieldID: fieldID,
+		Min:     min,
+		Max:     max,
+	}, nil
+}
+
+func lessValue(a, b interface{}) (bool, error) {
+	switch v := a.(type) {
+	case int8:
+		return v < b.(int8), nil
+	case int16:
+		return v < b.(int16), nil
+	case int32:
+		return v < b.(int32), nil
+	case int64:
+		return v < b.(int64), nil
+	case float32:
+		return v < b.(float32), nil
+	case float64:
+		return v < b.(float64), nil
+	case string:
+		return v < b.(string), nil
+	}
+	return false, errTransferType
+}
+
+func toGenericValue(v interface{}) (*planpb.GenericValue, error) {
+	switch value := v.(type) {
+	case int8:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: int64(value)}}, nil
+	case int16:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: int64(value)}}, nil
+	case int32:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: int64(value)}}, nil
+	case int64:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: value}}, nil
+	case float32:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: float64(value)}}, nil
+	case float64:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: value}}, nil
+	case string:
+		return &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: value}}, nil
+	}
+	return nil, errTransferType
+}
 
+func (t *compactionTask) collectRows(mergeItr iterator, delta map[interface{}]Timestamp, schema *schemapb.CollectionSchema, currentTs Timestamp) (*mergedRows, int64, error) {
 	var (
-		dim              int   // dimension of float/binary vector field
-		maxRowsPerBinlog int   // maximum rows populating one binlog
-		numBinlogs       int   // binlog number
-		expired          int64 // the number of expired entity
-		err              error
-
-		iDatas      = make([]*InsertData, 0)
-		fID2Type    = make(map[UniqueID]schemapb.DataType)
-		fID2Content = make(map[UniqueID][]interface{})
+		expired int64 // the number of expired entity
+		err     error
+
+		rows = &mergedRows{
+			fID2Type:     make(map[UniqueID]schemapb.DataType),
+			fID2Content:  make(map[UniqueID][]interface{}),
+			fID2Nullable: make(map[UniqueID]bool),
+		}
 	)
 
 	isDeletedValue := func(v *storage.Value) bool {
@@ -214,14 +454,32 @@ func (t *compactionTask) merge(mergeItr iterator, delta map[interface{}]Timestam
 		return false
 	}
 
+	// the rows written before fields were added get the default values of them, nil for null
+	defaults := make(map[UniqueID]interface{})
+
 	// get dim
 	for _, fs := range schema.GetFields() {
-		fID2Type[fs.GetFieldID()] = fs.GetDataType()
+		rows.fID2Type[fs.GetFieldID()] = fs.GetDataType()
+		rows.fID2Nullable[fs.GetFieldID()] = fs.GetNullable()
+		if fs.GetFieldID() >= common.StartOfUserFieldID && !typeutil.IsVectorType(fs.GetDataType()) {
+			fData, err := storage.GenDefaultFieldData(fs, 1)
+			if err != nil {
+				log.Warn("failed to generate default value", zap.Int64("fieldID", fs.GetFieldID()), zap.Error(err))
+				return nil, 0, err
+			}
+			if storage.IsRowValid(fData, 0) {
+				defaults[fs.GetFieldID()] = fData.GetRow(0)
+			} else {
+				defaults[fs.GetFieldID()] = nil
+			}
+		}
 		if fs.GetDataType() == schemapb.DataType_FloatVector ||
-			fs.GetDataType() == schemapb.DataType_BinaryVector {
+			fs.GetDataType() == schemapb.DataType_BinaryVector ||
+			fs.GetDataType() == schemapb.DataType_Float16Vector ||
+			fs.GetDataType() == schemapb.DataType_BFloat16Vector {
 			for _, t := range fs.GetTypeParams() {
 				if t.Key == "dim" {
-					if dim, err = strconv.Atoi(t.Value); err != nil {
+					if rows.dim, err = strconv.Atoi(t.Value); err != nil {
 						log.Warn("strconv wrong on get dim", zap.Error(err))
 						return nil, 0, err
 					}
@@ -231,7 +489,6 @@ func (t *compactionTask) merge(mergeItr iterator, delta map[interface{}]Timestam
 		}
 	}
 
-	expired = 0
 	for mergeItr.HasNext() {
 		//  no error if HasNext() returns true
 		vInter, _ := mergeItr.Next()
@@ -260,52 +517,35 @@ func (t *compactionTask) merge(mergeItr iterator, delta map[interface{}]Timestam
 		}
 
 		for fID, vInter := range row {
-			if _, ok := fID2Content[fID]; !ok {
-				fID2Content[fID] = make([]interface{}, 0)
+			// the binlogs of dropped fields are discarded
+			if _, ok := rows.fID2Type[fID]; !ok {
+				continue
+			}
+			rows.fID2Content[fID] = append(rows.fID2Content[fID], vInter)
+		}
+		for fID, value := range defaults {
+			if _, ok := row[fID]; !ok {
+				rows.fID2Content[fID] = append(rows.fID2Content[fID], value)
 			}
-			fID2Content[fID] = append(fID2Content[fID], vInter)
 		}
 	}
+	return rows, expired, nil
+}
 
-	// calculate numRows from rowID field, fieldID 0
-	numRows := int64(len(fID2Content[0]))
-	maxRowsPerBinlog = int(Params.DataNodeCfg.FlushInsertBufferSize / (int64(dim) * 4))
-	numBinlogs = int(numRows) / maxRowsPerBinlog
-	if int(numRows)%maxRowsPerBinlog != 0 {
-		numBinlogs++
-	}
+func (t *compactionTask) merge(mergeItr iterator, delta map[interface{}]Timestamp, schema *schemapb.CollectionSchema, currentTs Timestamp) ([]*InsertData, int64, error) {
+	mergeStart := time.Now()
 
-	for i := 0; i < numBinlogs; i++ {
-		iDatas = append(iDatas, &InsertData{Data: make(map[storage.FieldID]storage.FieldData)})
+	rows, expired, err := t.collectRows(mergeItr, delta, schema, currentTs)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	for fID, content := range fID2Content {
-		tp, ok := fID2Type[fID]
-		if !ok {
-			log.Warn("no field ID in this schema", zap.Int64("fieldID", fID))
-			return nil, 0, errors.New("Unexpected error")
-		}
-
-		for i := 0; i < numBinlogs; i++ {
-			var c []interface{}
-
-			if i == numBinlogs-1 {
-				c = content[i*maxRowsPerBinlog:]
-			} else {
-				c = content[i*maxRowsPerBinlog : i*maxRowsPerBinlog+maxRowsPerBinlog]
-			}
-
-			fData, err := interface2FieldData(tp, c, int64(len(c)))
-
-			if err != nil {
-				log.Warn("transfer interface to FieldData wrong", zap.Error(err))
-				return nil, 0, err
-			}
-			iDatas[i].Data[fID] = fData
-		}
-
+	iDatas, err := rows.toInsertDatas()
+	if err != nil {
+		return nil, 0, err
 	}
 
+	numRows := rows.numRows()
 	log.Debug("merge end", zap.Int64("planID", t.getPlanID()), zap.Int64("remaining insert numRows", numRows),
 		zap.Int64("expired entities", expired),
 		zap.Float64("elapse in ms", nano2Milli(time.Since(mergeStart))))
@@ -334,7 +574,8 @@ func (t *compactionTask) compact() error {
 		log.Error("compact wrong, there's no segments in segment binlogs")
 		return errIllegalCompactionPlan
 
-	case t.plan.GetType() == datapb.CompactionType_MergeCompaction || t.plan.GetType() == datapb.CompactionType_MixCompaction:
+	case t.plan.GetType() == datapb.CompactionType_MergeCompaction || t.plan.GetType() == datapb.CompactionType_MixCompaction ||
+		t.plan.GetType() == datapb.CompactionType_ClusteringCompaction:
 		targetSegID, err = t.allocID()
 		if err != nil {
 			log.Error("compact wrong", zap.Error(err))
@@ -359,9 +600,15 @@ func (t *compactionTask) compact() error {
 
 	// Inject to stop flush
 	injectStart := time.Now()
-	ti := newTaskInjection(len(segIDs), func(pack *segmentFlushPack) {
+	postInjection := func(pack *segmentFlushPack) {
 		pack.segmentID = targetSegID
-	})
+	}
+	if t.plan.GetType() == datapb.CompactionType_ClusteringCompaction {
+		// the deletes being flushed can't be split by the clustered segments, datacoord adds them to all the
+		// segments clustered from the compacted segment
+		postInjection = nil
+	}
+	ti := newTaskInjection(len(segIDs), postInjection)
 	defer close(ti.injectOver)
 
 	t.injectFlush(ti, segIDs...)
@@ -371,6 +618,13 @@ func (t *compactionTask) compact() error {
 		log.Debug("inject elapse in ms", zap.Int64("planID", t.plan.GetPlanID()), zap.Float64("elapse", nano2Milli(injectEnd.Sub(injectStart))))
 	}()
 
+	if t.plan.GetType() == datapb.CompactionType_ClusteringCompaction {
+		if err := t.refreshDeltalogs(ctxTimeout, segIDs); err != nil {
+			log.Error("compact wrong, failed to refresh deltalogs", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
+			return err
+		}
+	}
+
 	var (
 		iItr = make([]iterator, 0)
 		imu  sync.Mutex
@@ -396,12 +650,12 @@ func (t *compactionTask) compact() error {
 	g, gCtx := errgroup.WithContext(ctxTimeout)
 	for _, s := range t.plan.GetSegmentBinlogs() {
 
-		// Get the number of field binlog files from non-empty segment
+		// Get the number of field binlog files from non-empty segment, the fields added
+		// after some binlogs were flushed have fewer binlog files
 		var binlogNum int
 		for _, b := range s.GetFieldBinlogs() {
-			if b != nil {
+			if b != nil && len(b.GetBinlogs()) > binlogNum {
 				binlogNum = len(b.GetBinlogs())
-				break
 			}
 		}
 		// Unable to deal with all empty segments cases, so return error
@@ -413,7 +667,12 @@ func (t *compactionTask) compact() error {
 		for idx := 0; idx < binlogNum; idx++ {
 			var ps []string
 			for _, f := range s.GetFieldBinlogs() {
-				ps = append(ps, f.GetBinlogs()[idx].GetLogPath())
+				// binlogs of the added field are aligned to the latest flushes
+				fIdx := idx - (binlogNum - len(f.GetBinlogs()))
+				if fIdx < 0 {
+					continue
+				}
+				ps = append(ps, f.GetBinlogs()[fIdx].GetLogPath())
 			}
 
 			g.Go(func() error {
@@ -476,12 +735,39 @@ func (t *compactionTask) compact() error {
 		return err
 	}
 
+	// the task may be stopped while downloading, give up before the time consuming merge
+	if !funcutil.CheckCtxValid(ctxTimeout) {
+		log.Warn("compaction stopped before merge", zap.Int64("planID", t.plan.GetPlanID()))
+		return errContext
+	}
+
+	if t.plan.GetType() == datapb.CompactionType_ClusteringCompaction {
+		rows, _, err := t.collectRows(mergeItr, deltaPk2Ts, meta.GetSchema(), t.GetCurrentTime())
+		if err == nil {
+			err = t.compactClustering(ctxTimeout, targetSegID, collID, partID, segIDs, rows, deltaBuf, meta, PKfieldID, PkType)
+		}
+		if err != nil {
+			log.Error("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
+			return err
+		}
+
+		ti.injectDone(true)
+		log.Info("overall elapse in ms", zap.Int64("planID", t.plan.GetPlanID()), zap.Float64("elapse", nano2Milli(time.Since(compactStart))))
+		metrics.DataNodeCompactionLatency.WithLabelValues(fmt.Sprint(Params.DataNodeCfg.GetNodeID())).Observe(float64(t.tr.ElapseSpan().Milliseconds()))
+		return nil
+	}
+
 	iDatas, numRows, err := t.merge(mergeItr, deltaPk2Ts, meta.GetSchema(), t.GetCurrentTime())
 	if err != nil {
 		log.Error("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
 		return err
 	}
 
+	if !funcutil.CheckCtxValid(ctxTimeout) {
+		log.Warn("compaction stopped before upload", zap.Int64("planID", t.plan.GetPlanID()))
+		return errContext
+	}
+
 	uploadStart := time.Now()
 	segPaths, err := t.upload(ctxTimeout, targetSegID, partID, iDatas, deltaBuf.delData, meta)
 	if err != nil {
@@ -565,7 +851,139 @@ func (t *compactionTask) compact() error {
 	return nil
 }
 
+// compactClustering sorts the remaining rows of the segments by the clustering key, and splits them into several
+// segments, each of which covers a range of the clustering key.
+func (t *compactionTask) compactClustering(ctx context.Context, targetSegID, collID, partID UniqueID, segIDs []UniqueID,
+	rows *mergedRows, deltaBuf *DelDataBuf, meta *etcdpb.CollectionMeta, pkID UniqueID, pkType schemapb.DataType) error {
+
+	keyID := t.plan.GetClusteringKeyFieldID()
+	chunks, err := rows.clusterBy(keyID, t.plan.GetMaxSegmentRows(), t.plan.GetClusteringKeyBounds())
+	if err != nil {
+		return err
+	}
+	deltaBufs := splitDeltaBuf(deltaBuf, chunks, pkID)
+
+	// the first chunk goes to the target segment, to which the injected flushes are redirected
+	segIDsTo := []UniqueID{targetSegID}
+	if len(chunks) > 1 {
+		start, _, err := t.allocIDBatch(uint32(len(chunks) - 1))
+		if err != nil {
+			return err
+		}
+		for i := 1; i < len(chunks); i++ {
+			segIDsTo = append(segIDsTo, start+UniqueID(i-1))
+		}
+	}
+
+	uploadStart := time.Now()
+	clustered := make([]*datapb.ClusteredSegment, 0, len(chunks))
+	for i, chunk := range chunks {
+		iDatas, err := chunk.toInsertDatas()
+		if err != nil {
+			return err
+		}
+		keyRange, err := chunk.keyRange(keyID)
+		if err != nil {
+			return err
+		}
+
+		segPaths, err := t.upload(ctx, segIDsTo[i], partID, iDatas, deltaBufs[i].delData, meta)
+		if err != nil {
+			return err
+		}
+		for _, fbl := range segPaths.deltaInfo {
+			for _, deltaLogInfo := range fbl.GetBinlogs() {
+				deltaLogInfo.LogSize = deltaBufs[i].GetLogSize()
+				deltaLogInfo.TimestampFrom = deltaBufs[i].GetTimestampFrom()
+				deltaLogInfo.TimestampTo = deltaBufs[i].GetTimestampTo()
+				deltaLogInfo.EntriesNum = deltaBufs[i].GetEntriesNum()
+			}
+		}
+
+		clustered = append(clustered, &datapb.ClusteredSegment{
+			SegmentID:           segIDsTo[i],
+			NumOfRows:           chunk.numRows(),
+			InsertLogs:          segPaths.inPaths,
+			Field2StatslogPaths: segPaths.statsPaths,
+			Deltalogs:           segPaths.deltaInfo,
+			ClusteringKeyRange:  keyRange,
+		})
+	}
+	log.Debug("upload elapse in ms", zap.Int64("planID", t.plan.GetPlanID()), zap.Float64("elapse", nano2Milli(time.Since(uploadStart))))
+
+	pack := &datapb.CompactionResult{
+		PlanID:            t.plan.GetPlanID(),
+		ClusteredSegments: clustered,
+		SegmentBinlogs:    t.plan.GetSegmentBinlogs(),
+	}
+	status, err := t.dc.CompleteCompaction(ctx, pack)
+	if err != nil {
+		log.Error("complete compaction rpc wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
+		return err
+	}
+	if status.ErrorCode != commonpb.ErrorCode_Success {
+		log.Error("complete compaction wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.String("reason", status.GetReason()))
+		return fmt.Errorf("complete comapction wrong: %s", status.GetReason())
+	}
+
+	// The clustered segments only hold the pk ranges of their own, the deletes buffered for the compacted
+	// segments are split by them.
+	if len(chunks) == 0 {
+		if err := t.mergeFlushedSegments(targetSegID, collID, partID, t.plan.GetPlanID(), segIDs, t.plan.GetChannel(), 0); err != nil {
+			return err
+		}
+		t.removeSegments(targetSegID)
+	} else {
+		numRows := make([]int64, 0, len(chunks))
+		pks := make([]storage.FieldData, 0, len(chunks))
+		for _, chunk := range chunks {
+			pk, err := interface2FieldData(pkType, chunk.fID2Content[pkID], chunk.numRows())
+			if err != nil {
+				return err
+			}
+			numRows = append(numRows, chunk.numRows())
+			pks = append(pks, pk)
+		}
+		err := t.splitFlushedSegments(segIDsTo, collID, partID, t.plan.GetPlanID(), segIDs, t.plan.GetChannel(), numRows, pks)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Info("clustering compaction done",
+		zap.Int64("planID", t.plan.GetPlanID()),
+		zap.Int64s("compacted From segmentIDs", segIDs),
+		zap.Int64s("compacted To segmentIDs", segIDsTo[:len(chunks)]),
+	)
+	return nil
+}
+
 // TODO copy maybe expensive, but this seems to be the only convinent way.
+// splitNullRows replaces the nil values of null rows of a nullable field with placeholders, and returns
+// the validity of rows, the validity is nil if there's no null row.
+func splitNullRows(schemaDataType schemapb.DataType, content []interface{}) ([]interface{}, []bool, error) {
+	var validData []bool
+	for i, c := range content {
+		if c != nil {
+			continue
+		}
+		if validData == nil {
+			validData = make([]bool, len(content))
+			for j := range validData {
+				validData[j] = true
+			}
+			content = append([]interface{}{}, content...)
+		}
+		placeholder, err := storage.GenDefaultFieldData(&schemapb.FieldSchema{DataType: schemaDataType}, 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		content[i] = placeholder.GetRow(0)
+		validData[i] = false
+	}
+	return content, validData, nil
+}
+
 func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}, numRows int64) (storage.FieldData, error) {
 	var rst storage.FieldData
 	numOfRows := []int64{numRows}
@@ -724,6 +1142,55 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 		data.Dim = len(data.Data) * 8 / int(numRows)
 		rst = data
 
+	case schemapb.DataType_Float16Vector:
+		var data = &storage.Float16VectorFieldData{
+			NumRows: numOfRows,
+			Data:    []byte{},
+		}
+
+		for _, c := range content {
+			r, ok := c.([]byte)
+			if !ok {
+				return nil, errTransferType
+			}
+			data.Data = append(data.Data, r...)
+		}
+
+		data.Dim = len(data.Data) / 2 / int(numRows)
+		rst = data
+
+	case schemapb.DataType_BFloat16Vector:
+		var data = &storage.BFloat16VectorFieldData{
+			NumRows: numOfRows,
+			Data:    []byte{},
+		}
+
+		for _, c := range content {
+			r, ok := c.([]byte)
+			if !ok {
+				return nil, errTransferType
+			}
+			data.Data = append(data.Data, r...)
+		}
+
+		data.Dim = len(data.Data) / 2 / int(numRows)
+		rst = data
+
+	case schemapb.DataType_SparseFloatVector:
+		var data = &storage.SparseFloatVectorFieldData{
+			NumRows:  numOfRows,
+			Contents: make([][]byte, 0, len(content)),
+		}
+
+		for _, c := range content {
+			r, ok := c.([]byte)
+			if !ok {
+				return nil, errTransferType
+			}
+			data.AppendRow(r)
+		}
+		rst = data
+
 	default:
 		return nil, errUnknownDataType
 	}
@@ -731,6 +1198,55 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 	return rst, nil
 }
 
+// refreshDeltalogs adds the deltalogs flushed to the compacted segments after the plan was generated, no more
+// deltalogs are flushed to them once the flushes are injected.
+func (t *compactionTask) refreshDeltalogs(ctx context.Context, segIDs []UniqueID) error {
+	resp, err := t.dc.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_SegmentInfo,
+			SourceID: Params.DataNodeCfg.GetNodeID(),
+		},
+		SegmentIDs: segIDs,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(resp.GetStatus().GetReason())
+	}
+
+	infos := make(map[UniqueID]*datapb.SegmentInfo)
+	for _, info := range resp.GetInfos() {
+		infos[info.GetID()] = info
+	}
+	for _, s := range t.plan.GetSegmentBinlogs() {
+		info, ok := infos[s.GetSegmentID()]
+		if !ok {
+			continue
+		}
+		known := make(map[string]struct{})
+		for _, fbl := range s.GetDeltalogs() {
+			for _, l := range fbl.GetBinlogs() {
+				known[l.GetLogPath()] = struct{}{}
+			}
+		}
+		for _, fbl := range info.GetDeltalogs() {
+			var added []*datapb.Binlog
+			for _, l := range fbl.GetBinlogs() {
+				if _, ok := known[l.GetLogPath()]; !ok {
+					added = append(added, l)
+				}
+			}
+			if len(added) > 0 {
+				log.Info("refresh deltalogs of compacted segment", zap.Int64("planID", t.plan.GetPlanID()),
+					zap.Int64("segmentID", s.GetSegmentID()), zap.Int("added", len(added)))
+				s.Deltalogs = append(s.Deltalogs, &datapb.FieldBinlog{FieldID: fbl.GetFieldID(), Binlogs: added})
+			}
+		}
+	}
+	return nil
+}
+
 func (t *compactionTask) getSegmentMeta(segID UniqueID) (UniqueID, UniqueID, *etcdpb.CollectionMeta, error) {
 	collID, partID, err := t.getCollectionAndPartitionID(segID)
 	if err != nil {
@@ -767,6 +1283,10 @@ func (t *compactionTask) isExpiredEntity(ts, now Timestamp) bool {
 
 	pts, _ := tsoutil.ParseTS(ts)
 	pnow, _ := tsoutil.ParseTS(now)
+	// entities still visible within the time travel retention window of the collection are kept
+	if travelTs := t.plan.GetTimetravel(); travelTs != 0 && travelTs < now {
+		pnow, _ = tsoutil.ParseTS(travelTs)
+	}
 	expireTime := pts.Add(Params.CommonCfg.EntityExpirationTTL)
 	return expireTime.Before(pnow)
 }
@@ -27,8 +27,10 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -298,6 +300,48 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 			assert.NotEmpty(t, idata[0].Data)
 		})
 
+		t.Run("Merge with altered schema", func(t *testing.T) {
+			Params.CommonCfg.EntityExpirationTTL = 0
+			iData := genInsertDataWithExpiredTS()
+			meta := NewMetaFactory().GetCollectionMeta(1, "test", schemapb.DataType_Int64)
+
+			iblobs, err := getInsertBlobs(100, iData, meta)
+			require.NoError(t, err)
+
+			iitr, err := storage.NewInsertBinlogIterator(iblobs, 106, schemapb.DataType_Int64)
+			require.NoError(t, err)
+
+			mitr := storage.NewMergeIterator([]iterator{iitr})
+
+			// field 102 is dropped and field 200 is added after the rows were written
+			schema := &schemapb.CollectionSchema{
+				Name:    meta.GetSchema().GetName(),
+				Version: 2,
+			}
+			for _, field := range meta.GetSchema().GetFields() {
+				if field.GetFieldID() != 102 {
+					schema.Fields = append(schema.Fields, field)
+				}
+			}
+			schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+				FieldID:  200,
+				Name:     "added",
+				DataType: schemapb.DataType_Int64,
+				DefaultValue: &schemapb.ValueField{
+					Data: &schemapb.ValueField_LongData{LongData: 3},
+				},
+			})
+
+			ct := &compactionTask{}
+			idata, numOfRow, err := ct.merge(mitr, map[interface{}]Timestamp{}, schema, ct.GetCurrentTime())
+			assert.NoError(t, err)
+			assert.Equal(t, int64(2), numOfRow)
+			require.Equal(t, 1, len(idata))
+			_, ok := idata[0].Data[102]
+			assert.False(t, ok)
+			assert.Equal(t, []int64{3, 3}, idata[0].Data[200].(*storage.Int64FieldData).Data)
+		})
+
 		t.Run("Merge with expiration", func(t *testing.T) {
 			Params.CommonCfg.EntityExpirationTTL = 864000 // 10 days in seconds
 			iData := genInsertDataWithExpiredTS()
@@ -322,6 +366,106 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 		})
 	})
 
+	t.Run("Test clusterBy", func(t *testing.T) {
+		rows := &mergedRows{
+			dim: 1,
+			fID2Type: map[UniqueID]schemapb.DataType{
+				0:   schemapb.DataType_Int64,
+				100: schemapb.DataType_Int64,
+				101: schemapb.DataType_VarChar,
+			},
+			fID2Content: map[UniqueID][]interface{}{
+				0:   {int64(1), int64(2), int64(3), int64(4), int64(5)},
+				100: {int64(5), int64(1), int64(4), int64(2), int64(3)},
+				101: {"e", "a", "d", "b", "c"},
+			},
+		}
+
+		chunks, err := rows.clusterBy(100, 2, nil)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(chunks))
+		assert.Equal(t, []interface{}{int64(2), int64(4)}, chunks[0].fID2Content[0])
+		assert.Equal(t, []interface{}{"a", "b"}, chunks[0].fID2Content[101])
+		assert.Equal(t, []interface{}{int64(1)}, chunks[2].fID2Content[0])
+		assert.Equal(t, int64(1), chunks[2].numRows())
+
+		keyRange, err := chunks[1].keyRange(101)
+		require.NoError(t, err)
+		assert.Equal(t, "c", keyRange.GetMin().GetStringVal())
+		assert.Equal(t, "d", keyRange.GetMax().GetStringVal())
+
+		_, err = rows.clusterBy(102, 2, nil)
+		assert.Error(t, err)
+		_, err = rows.clusterBy(100, 0, nil)
+		assert.Error(t, err)
+
+		// chunks never cross a bound
+		bounds := []*planpb.GenericValue{{Val: &planpb.GenericValue_Int64Val{Int64Val: 2}}, {Val: &planpb.GenericValue_Int64Val{Int64Val: 5}}}
+		chunks, err = rows.clusterBy(100, 3, bounds)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(chunks))
+		assert.Equal(t, []interface{}{int64(1)}, chunks[0].fID2Content[100])
+		assert.Equal(t, []interface{}{int64(2), int64(3), int64(4)}, chunks[1].fID2Content[100])
+		assert.Equal(t, []interface{}{int64(5)}, chunks[2].fID2Content[100])
+
+		// rows with the same key are kept in one chunk
+		rows.fID2Content[100] = []interface{}{int64(1), int64(2), int64(2), int64(2), int64(3)}
+		chunks, err = rows.clusterBy(100, 2, nil)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(chunks))
+		assert.Equal(t, []interface{}{int64(1), int64(2), int64(2), int64(2)}, chunks[0].fID2Content[100])
+		assert.Equal(t, []interface{}{int64(3)}, chunks[1].fID2Content[100])
+	})
+
+	t.Run("Test splitDeltaBuf", func(t *testing.T) {
+		chunks := []*mergedRows{
+			{fID2Content: map[UniqueID][]interface{}{100: {int64(1), int64(2)}}},
+			{fID2Content: map[UniqueID][]interface{}{100: {int64(3), int64(3)}}},
+		}
+		deltaBuf := newDelDataBuf()
+		deltaBuf.delData.Append(newInt64PrimaryKey(1), 10)
+		deltaBuf.delData.Append(newInt64PrimaryKey(3), 20)
+		deltaBuf.delData.Append(newInt64PrimaryKey(4), 30)
+
+		bufs := splitDeltaBuf(deltaBuf, chunks, 100)
+		require.Equal(t, 2, len(bufs))
+		assert.Equal(t, []primaryKey{newInt64PrimaryKey(1)}, bufs[0].delData.Pks)
+		assert.Equal(t, []Timestamp{10}, bufs[0].delData.Tss)
+		assert.Equal(t, int64(1), bufs[0].GetEntriesNum())
+		assert.Equal(t, []primaryKey{newInt64PrimaryKey(3)}, bufs[1].delData.Pks)
+		assert.Equal(t, uint64(20), bufs[1].GetTimestampFrom())
+		assert.Equal(t, uint64(20), bufs[1].GetTimestampTo())
+	})
+
+	t.Run("Test toInsertDatas with null rows", func(t *testing.T) {
+		rows := &mergedRows{
+			dim: 1,
+			fID2Type: map[UniqueID]schemapb.DataType{
+				0:   schemapb.DataType_Int64,
+				100: schemapb.DataType_Int64,
+				101: schemapb.DataType_VarChar,
+			},
+			fID2Content: map[UniqueID][]interface{}{
+				0:   {int64(1), int64(2), int64(3)},
+				100: {int64(5), nil, int64(4)},
+				101: {"a", "b", "c"},
+			},
+			fID2Nullable: map[UniqueID]bool{100: true, 101: true},
+		}
+
+		iDatas, err := rows.toInsertDatas()
+		require.NoError(t, err)
+		require.Equal(t, 1, len(iDatas))
+		assert.Equal(t, []int64{5, 0, 4}, iDatas[0].Data[100].(*storage.Int64FieldData).Data)
+		assert.Equal(t, []bool{true, false, true}, storage.GetValidData(iDatas[0].Data[100]))
+		assert.Empty(t, storage.GetValidData(iDatas[0].Data[101]))
+
+		// null rows of not nullable field are rejected
+		rows.fID2Nullable[100] = false
+		_, err = rows.toInsertDatas()
+		assert.Error(t, err)
+	})
+
 	t.Run("Test isExpiredEntity", func(t *testing.T) {
 		t.Run("When CompactionEntityExpiration is set math.MaxInt64", func(t *testing.T) {
 			Params.CommonCfg.EntityExpirationTTL = math.MaxInt64
@@ -380,6 +524,20 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 			res = ct.isExpiredEntity(math.MaxInt64, 0)
 			assert.Equal(t, false, res)
 		})
+		t.Run("When entities are visible within the time travel retention", func(t *testing.T) {
+			Params.CommonCfg.EntityExpirationTTL = time.Hour
+			now := time.Now()
+			ts := tsoutil.ComposeTSByTime(now.Add(-2*time.Hour), 0)
+
+			ct := &compactionTask{plan: &datapb.CompactionPlan{}}
+			assert.True(t, ct.isExpiredEntity(ts, tsoutil.ComposeTSByTime(now, 0)))
+
+			ct.plan.Timetravel = tsoutil.ComposeTSByTime(now.Add(-90*time.Minute), 0)
+			assert.False(t, ct.isExpiredEntity(ts, tsoutil.ComposeTSByTime(now, 0)))
+
+			ct.plan.Timetravel = tsoutil.ComposeTSByTime(now.Add(-30*time.Minute), 0)
+			assert.True(t, ct.isExpiredEntity(ts, tsoutil.ComposeTSByTime(now, 0)))
+		})
 	})
 }
 
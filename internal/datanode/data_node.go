@@ -799,6 +799,19 @@ func (node *DataNode) Compaction(ctx context.Context, req *datapb.CompactionPlan
 	}, nil
 }
 
+// StopCompaction stops the executing compaction tasks of the given plans, the flushes injected by the tasks are
+// rolled back and the results are never reported to DataCoord
+func (node *DataNode) StopCompaction(ctx context.Context, req *datapb.StopCompactionRequest) (*commonpb.Status, error) {
+	log.Info("DataNode receive stop compaction request", zap.Int64s("planIDs", req.GetPlanIDs()))
+	for _, planID := range req.GetPlanIDs() {
+		// stopTask waits for the task to quit, which may be waiting for an idle worker
+		go node.compactionExecutor.stopTask(planID)
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 // Import data files(json, numpy, etc.) on MinIO/S3 storage, read and parse them into sealed segments
 func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*commonpb.Status, error) {
 	log.Info("DataNode receive import request",
@@ -954,6 +967,41 @@ func (node *DataNode) AddSegment(ctx context.Context, req *datapb.AddSegmentRequ
 	}, nil
 }
 
+// CheckPrimaryKeys returns the primary keys which may exist in the segments of the collection served by the DataNode
+func (node *DataNode) CheckPrimaryKeys(ctx context.Context, req *datapb.CheckPrimaryKeysRequest) (*datapb.CheckPrimaryKeysResponse, error) {
+	resp := &datapb.CheckPrimaryKeysResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if !node.isHealthy() {
+		resp.Status.Reason = msgDataNodeIsUnhealthy(Params.DataNodeCfg.GetNodeID())
+		return resp, nil
+	}
+
+	var replicas []Replica
+	node.flowgraphManager.flowgraphs.Range(func(key, value interface{}) bool {
+		ds := value.(*dataSyncService)
+		if ds.collectionID == req.GetCollectionID() {
+			replicas = append(replicas, ds.replica)
+		}
+		return true
+	})
+
+	var existing []primaryKey
+	for _, pk := range storage.ParseIDs2PrimaryKeys(req.GetPrimaryKeys()) {
+		for _, replica := range replicas {
+			if replica.mayContainPK(pk) {
+				existing = append(existing, pk)
+				break
+			}
+		}
+	}
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.ExistingKeys = storage.ParsePrimaryKeys2IDs(existing)
+	return resp, nil
+}
+
 func importFlushReqFunc(node *DataNode, req *datapb.ImportTaskRequest, res *rootcoordpb.ImportResult, schema *schemapb.CollectionSchema, ts Timestamp) importutil.ImportFlushFunc {
 	return func(fields map[storage.FieldID]storage.FieldData, shardNum int) error {
 		if shardNum >= len(req.GetImportTask().GetChannelNames()) {
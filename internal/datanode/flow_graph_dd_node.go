@@ -144,6 +144,37 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 				fgMsg.dropPartitions = append(fgMsg.dropPartitions, dpMsg.PartitionID)
 			}
 
+		case commonpb.MsgType_TruncateCollection, commonpb.MsgType_TruncatePartition:
+			tMsg := msg.(*msgstream.TruncateMsg)
+			if tMsg.GetCollectionID() == ddn.collectionID {
+				log.Info("truncate msg received",
+					zap.Int64("collectionID", tMsg.GetCollectionID()),
+					zap.Int64("partitionID", tMsg.GetPartitionID()),
+					zap.Int64s("segmentIDs", tMsg.GetSegmentIDs()),
+					zap.String("vChanneName", ddn.vChannelName))
+				ddn.droppedSegmentIDs = append(ddn.droppedSegmentIDs, tMsg.GetSegmentIDs()...)
+				// the inserts before the truncate msg in the same pack are filtered as well
+				insertMessages := fgMsg.insertMessages[:0]
+				for _, imsg := range fgMsg.insertMessages {
+					if !ddn.isDropped(imsg.GetSegmentID()) {
+						insertMessages = append(insertMessages, imsg)
+					}
+				}
+				fgMsg.insertMessages = insertMessages
+				fgMsg.truncatedSegments = append(fgMsg.truncatedSegments, tMsg.GetSegmentIDs()...)
+			}
+
+		case commonpb.MsgType_AddField, commonpb.MsgType_DropField:
+			asMsg := msg.(*msgstream.AlterSchemaMsg)
+			if asMsg.GetCollectionID() == ddn.collectionID {
+				log.Info("alter schema msg received",
+					zap.Int64("collectionID", asMsg.GetCollectionID()),
+					zap.String("fieldName", asMsg.GetFieldName()),
+					zap.Int32("schemaVersion", asMsg.GetSchema().GetVersion()),
+					zap.String("vChanneName", ddn.vChannelName))
+				fgMsg.schema = asMsg.GetSchema()
+			}
+
 		case commonpb.MsgType_Insert:
 			imsg := msg.(*msgstream.InsertMsg)
 			if imsg.CollectionID != ddn.collectionID {
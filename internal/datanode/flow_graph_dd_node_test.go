@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/util/dependency"
@@ -239,6 +240,90 @@ func TestFlowGraph_DDNode_Operate(t *testing.T) {
 		assert.Equal(t, 1, len(rt[0].(*flowGraphMsg).insertMessages))
 	})
 
+	t.Run("Test DDNode Operate Truncate Msg", func(t *testing.T) {
+		factory := dependency.NewDefaultFactory(true)
+		deltaStream, err := factory.NewMsgStream(context.Background())
+		require.Nil(t, err)
+		deltaStream.SetRepackFunc(msgstream.DefaultRepackFunc)
+		deltaStream.AsProducer([]string{"DataNode-test-delta-channel-0"})
+
+		ddn := ddNode{
+			ctx:            context.Background(),
+			collectionID:   1,
+			deltaMsgStream: deltaStream,
+		}
+
+		truncateMsg := &msgstream.TruncateMsg{
+			TruncateRequest: internalpb.TruncateRequest{
+				Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_TruncateCollection},
+				CollectionID: 1,
+				SegmentIDs:   []UniqueID{100},
+			},
+		}
+		otherMsg := &msgstream.TruncateMsg{
+			TruncateRequest: internalpb.TruncateRequest{
+				Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_TruncatePartition},
+				CollectionID: 2,
+				SegmentIDs:   []UniqueID{200},
+			},
+		}
+		tsMessages := []msgstream.TsMsg{getInsertMsg(100, 10000), getInsertMsg(200, 10000), truncateMsg, otherMsg}
+		var msgStreamMsg Msg = flowgraph.GenerateMsgStreamMsg(tsMessages, 0, 0, nil, nil)
+
+		rt := ddn.Operate([]Msg{msgStreamMsg})
+		fgMsg := rt[0].(*flowGraphMsg)
+		assert.ElementsMatch(t, []UniqueID{100}, fgMsg.truncatedSegments)
+		assert.Equal(t, 1, len(fgMsg.insertMessages))
+		assert.Equal(t, UniqueID(200), fgMsg.insertMessages[0].GetSegmentID())
+
+		// the later inserts of the truncated segments are filtered
+		tsMessages = []msgstream.TsMsg{getInsertMsg(100, 20000), getInsertMsg(300, 20000)}
+		msgStreamMsg = flowgraph.GenerateMsgStreamMsg(tsMessages, 0, 0, nil, nil)
+		rt = ddn.Operate([]Msg{msgStreamMsg})
+		fgMsg = rt[0].(*flowGraphMsg)
+		assert.Empty(t, fgMsg.truncatedSegments)
+		assert.Equal(t, 1, len(fgMsg.insertMessages))
+		assert.Equal(t, UniqueID(300), fgMsg.insertMessages[0].GetSegmentID())
+	})
+
+	t.Run("Test DDNode Operate AlterSchema Msg", func(t *testing.T) {
+		factory := dependency.NewDefaultFactory(true)
+		deltaStream, err := factory.NewMsgStream(context.Background())
+		require.Nil(t, err)
+		deltaStream.SetRepackFunc(msgstream.DefaultRepackFunc)
+		deltaStream.AsProducer([]string{"DataNode-test-delta-channel-0"})
+
+		ddn := ddNode{
+			ctx:            context.Background(),
+			collectionID:   1,
+			deltaMsgStream: deltaStream,
+		}
+
+		alterMsg := &msgstream.AlterSchemaMsg{
+			AlterSchemaRequest: internalpb.AlterSchemaRequest{
+				Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_AddField},
+				CollectionID: 1,
+				FieldName:    "added",
+				Schema:       &schemapb.CollectionSchema{Version: 1},
+			},
+		}
+		otherMsg := &msgstream.AlterSchemaMsg{
+			AlterSchemaRequest: internalpb.AlterSchemaRequest{
+				Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_DropField},
+				CollectionID: 2,
+				FieldName:    "dropped",
+				Schema:       &schemapb.CollectionSchema{Version: 2},
+			},
+		}
+		tsMessages := []msgstream.TsMsg{alterMsg, otherMsg}
+		var msgStreamMsg Msg = flowgraph.GenerateMsgStreamMsg(tsMessages, 0, 0, nil, nil)
+
+		rt := ddn.Operate([]Msg{msgStreamMsg})
+		fgMsg := rt[0].(*flowGraphMsg)
+		require.NotNil(t, fgMsg.schema)
+		assert.Equal(t, int32(1), fgMsg.schema.GetVersion())
+	})
+
 	t.Run("Test DDNode Operate Delete Msg", func(t *testing.T) {
 		tests := []struct {
 			ddnCollID   UniqueID
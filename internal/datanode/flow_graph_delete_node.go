@@ -118,7 +118,12 @@ func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg, tr TimeRange) er
 				compactToDelBuff.updateFromBuf(value.(*DelDataBuf))
 			}
 		}
-		dn.delBuf.Store(compactedTo, compactToDelBuff)
+		// the deletes of the segments clustered into several segments are split by their pk filters
+		if clusteredTo := dn.replica.listClusteredSegmentIDs(compactedFrom[0]); len(clusteredTo) > 0 {
+			dn.splitDelBuf(compactToDelBuff, clusteredTo)
+		} else {
+			dn.delBuf.Store(compactedTo, compactToDelBuff)
+		}
 		dn.replica.removeSegments(compactedFrom...)
 		log.Debug("update delBuf for merged segments",
 			zap.Int64("compactedTo segmentID", compactedTo),
@@ -167,6 +172,28 @@ func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg, tr TimeRange) er
 	return nil
 }
 
+// splitDelBuf buffers the deletes for the segments whose pk filters may hold the deleted primary keys
+func (dn *deleteNode) splitDelBuf(buf *DelDataBuf, segIDs []UniqueID) {
+	segIDToPks, segIDToTss := dn.filterSegmentByPK(common.InvalidPartitionID, buf.delData.Pks, buf.delData.Tss)
+	for _, segID := range segIDs {
+		pks := segIDToPks[segID]
+		if len(pks) == 0 {
+			continue
+		}
+		delDataBuf := newDelDataBuf()
+		if value, ok := dn.delBuf.Load(segID); ok {
+			delDataBuf = value.(*DelDataBuf)
+		}
+		for i, pk := range pks {
+			ts := segIDToTss[segID][i]
+			delDataBuf.delData.Append(pk, ts)
+			delDataBuf.updateTimeRange(TimeRange{timestampMin: ts, timestampMax: ts})
+		}
+		delDataBuf.updateSize(int64(len(pks)))
+		dn.delBuf.Store(segID, delDataBuf)
+	}
+}
+
 func (dn *deleteNode) showDelBuf() {
 	segments := dn.replica.filterSegments(dn.channelName, common.InvalidPartitionID)
 	for _, seg := range segments {
@@ -211,6 +238,11 @@ func (dn *deleteNode) Operate(in []Msg) []Msg {
 		msg.SetTraceCtx(ctx)
 	}
 
+	// the truncated segments are removed from replica already, discard their delete buffers
+	for _, segID := range fgMsg.truncatedSegments {
+		dn.delBuf.Delete(segID)
+	}
+
 	for i, msg := range fgMsg.deleteMessages {
 		traceID, _, _ := trace.InfoFromSpan(spans[i])
 		log.Info("Buffer delete request in DataNode", zap.String("traceID", traceID))
@@ -86,6 +86,10 @@ func (replica *mockReplica) getCollectionSchema(collectionID UniqueID, ts Timest
 	return &schemapb.CollectionSchema{}, nil
 }
 
+func (replica *mockReplica) updateCollectionSchema(schema *schemapb.CollectionSchema) bool {
+	return false
+}
+
 func (replica *mockReplica) getCollectionAndPartitionID(segID UniqueID) (collID, partitionID UniqueID, err error) {
 	if segID == -1 {
 		return -1, -1, errors.New("mocked error")
@@ -40,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type (
@@ -209,6 +210,30 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 
 	ibNode.lastTimestamp = endPositions[0].Timestamp
 
+	// The truncated segments are dropped by DataCoord already, discard their buffers without flushing
+	if len(fgMsg.truncatedSegments) > 0 {
+		log.Info("(Truncate) discard the truncated segments",
+			zap.Int64s("segmentIDs", fgMsg.truncatedSegments),
+			zap.String("vchannel name", ibNode.channelName),
+		)
+		for _, segID := range fgMsg.truncatedSegments {
+			ibNode.insertBuffer.Delete(segID)
+		}
+		ibNode.replica.removeSegments(fgMsg.truncatedSegments...)
+	}
+
+	// Fields are added or dropped, align the buffered data to the new schema before buffering new inserts
+	if fgMsg.schema != nil && ibNode.replica.updateCollectionSchema(fgMsg.schema) {
+		log.Info("(AlterSchema) align the buffered data to the new schema",
+			zap.Int32("schema version", fgMsg.schema.GetVersion()),
+			zap.String("vchannel name", ibNode.channelName),
+		)
+		if err := ibNode.alignBufferSchema(fgMsg.schema); err != nil {
+			log.Error("failed to align the buffered data to the new schema", zap.Error(err))
+			panic(err)
+		}
+	}
+
 	// Updating segment statistics in replica
 	seg2Upload, err := ibNode.updateSegStatesInReplica(fgMsg.insertMessages, startPositions[0], endPositions[0])
 	if err != nil {
@@ -428,6 +453,8 @@ func (ibNode *insertBufferNode) Operate(in []Msg) []Msg {
 		endPositions:    fgMsg.endPositions,
 		segmentsToFlush: segmentsToFlush,
 		dropCollection:  fgMsg.dropCollection,
+
+		truncatedSegments: fgMsg.truncatedSegments,
 	}
 
 	for _, sp := range spans {
@@ -499,6 +526,11 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 	// TODO GOOSE: under assumption that there's only 1 Vector field in one collection schema
 	var dimension int
 	for _, field := range collSchema.Fields {
+		if field.DataType == schemapb.DataType_SparseFloatVector {
+			// sparse vector has no fixed dimension, estimate it by the average size of a row
+			dimension = typeutil.SparseFloatVectorEstimatedElements * typeutil.SparseFloatRowElementSize / 4
+			break
+		}
 		if field.DataType == schemapb.DataType_FloatVector ||
 			field.DataType == schemapb.DataType_BinaryVector {
 
@@ -509,6 +541,18 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 			}
 			break
 		}
+		if field.DataType == schemapb.DataType_Float16Vector ||
+			field.DataType == schemapb.DataType_BFloat16Vector {
+
+			dimension, err = storage.GetDimFromParams(field.TypeParams)
+			if err != nil {
+				log.Error("failed to get dim from field", zap.Error(err))
+				return err
+			}
+			// half precision vectors take 2 bytes per dimension, buffer limit is computed by 4 bytes
+			dimension = (dimension + 1) / 2
+			break
+		}
 	}
 
 	newbd, err := newBufferData(int64(dimension))
@@ -549,6 +593,33 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 	return nil
 }
 
+// alignBufferSchema fills the default values of the added fields into the buffered data
+// and removes the dropped fields from it.
+func (ibNode *insertBufferNode) alignBufferSchema(schema *schemapb.CollectionSchema) error {
+	fieldIDs := make(map[UniqueID]struct{}, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		fieldIDs[field.GetFieldID()] = struct{}{}
+	}
+
+	var err error
+	ibNode.insertBuffer.Range(func(k, v interface{}) bool {
+		buffer := v.(*BufferData)
+		if buffer.buffer == nil {
+			return true
+		}
+		for fieldID := range buffer.buffer.Data {
+			if _, ok := fieldIDs[fieldID]; !ok {
+				delete(buffer.buffer.Data, fieldID)
+			}
+		}
+		if err = storage.FillDefaultFieldData(schema, buffer.buffer, uint64(buffer.size)); err != nil {
+			return false
+		}
+		return true
+	})
+	return err
+}
+
 // writeHardTimeTick writes timetick once insertBufferNode operates.
 func (ibNode *insertBufferNode) writeHardTimeTick(ts Timestamp, segmentIDs []int64) {
 	ibNode.ttLogger.LogTs(ts)
@@ -30,6 +30,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 
@@ -1033,3 +1034,43 @@ func TestInsertBufferNode_BufferData(te *testing.T) {
 
 	}
 }
+
+func TestInsertBufferNode_alignBufferSchema(t *testing.T) {
+	ibNode := &insertBufferNode{}
+	ibNode.insertBuffer.Store(UniqueID(1), &BufferData{
+		buffer: &InsertData{
+			Data: map[storage.FieldID]storage.FieldData{
+				common.RowIDField:     &storage.Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+				common.TimeStampField: &storage.Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+				100:                   &storage.Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+				101:                   &storage.Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+			},
+		},
+		size: 2,
+	})
+
+	schema := &schemapb.CollectionSchema{
+		Version: 1,
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{
+				FieldID:  102,
+				DataType: schemapb.DataType_Int64,
+				DefaultValue: &schemapb.ValueField{
+					Data: &schemapb.ValueField_LongData{LongData: 7},
+				},
+			},
+		},
+	}
+	require.NoError(t, ibNode.alignBufferSchema(schema))
+
+	v, ok := ibNode.insertBuffer.Load(UniqueID(1))
+	require.True(t, ok)
+	data := v.(*BufferData).buffer.Data
+	assert.Equal(t, 4, len(data))
+	_, ok = data[101]
+	assert.False(t, ok)
+	assert.Equal(t, []int64{7, 7}, data[102].(*storage.Int64FieldData).Data)
+}
@@ -19,6 +19,7 @@ package datanode
 import (
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 )
 
@@ -40,6 +41,10 @@ type flowGraphMsg struct {
 	segmentsToFlush []UniqueID
 	dropCollection  bool
 	dropPartitions  []UniqueID
+	//truncatedSegments are dropped by DataCoord, their buffers are discarded without flushing
+	truncatedSegments []UniqueID
+	//schema is the new collection schema after fields are added or dropped
+	schema *schemapb.CollectionSchema
 }
 
 func (fgMsg *flowGraphMsg) TimeTick() Timestamp {
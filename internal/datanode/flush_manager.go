@@ -800,9 +800,10 @@ func flushNotifyFunc(dsService *dataSyncService, opts ...retry.Option) notifyMet
 				return fmt.Errorf(err.Error())
 			}
 
-			// Segment not found during stale segment flush. Segment might get compacted already.
+			// Segment not found during stale segment flush. Segment might get compacted or truncated already.
 			// Stop retry and still proceed to the end, ignoring this error.
-			if !pack.flushed && rsp.GetErrorCode() == commonpb.ErrorCode_SegmentNotFound {
+			if rsp.GetErrorCode() == commonpb.ErrorCode_SegmentNotFound &&
+				(!pack.flushed || !dsService.replica.hasSegment(pack.segmentID, true)) {
 				log.Warn("stale segment not found, could be compacted",
 					zap.Int64("segment ID", pack.segmentID))
 				log.Warn("failed to SaveBinlogPaths",
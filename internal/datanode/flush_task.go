@@ -26,6 +26,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/trace"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -136,9 +138,11 @@ func (t *flushTaskRunner) runFlushInsert(task flushInsertTask,
 			zap.Any("position", pos),
 		)
 		go func() {
-			err := retry.Do(context.Background(), func() error {
+			ctx, span := trace.StartOtelSpan(context.Background(), "DataNode-FlushInsert", attribute.Int64("segmentID", t.segmentID))
+			err := retry.Do(ctx, func() error {
 				return task.flushInsertData()
 			}, opts...)
+			trace.EndOtelSpan(span, err)
 			if err != nil {
 				t.insertErr = err
 			}
@@ -164,9 +168,11 @@ func (t *flushTaskRunner) runFlushDel(task flushDeleteTask, deltaLogs *DelDataBu
 			}
 		}
 		go func() {
-			err := retry.Do(context.Background(), func() error {
+			ctx, span := trace.StartOtelSpan(context.Background(), "DataNode-FlushDelete", attribute.Int64("segmentID", t.segmentID))
+			err := retry.Do(ctx, func() error {
 				return task.flushDeleteData()
 			}, opts...)
+			trace.EndOtelSpan(span, err)
 			if err != nil {
 				t.deleteErr = err
 			}
@@ -52,6 +52,7 @@ var newVarCharPrimaryKey = storage.NewVarCharPrimaryKey
 type Replica interface {
 	getCollectionID() UniqueID
 	getCollectionSchema(collectionID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error)
+	updateCollectionSchema(schema *schemapb.CollectionSchema) bool
 	getCollectionAndPartitionID(segID UniqueID) (collID, partitionID UniqueID, err error)
 
 	listAllSegmentIDs() []UniqueID
@@ -67,9 +68,12 @@ type Replica interface {
 	updateSegmentCheckPoint(segID UniqueID)
 	updateSegmentPKRange(segID UniqueID, ids storage.FieldData)
 	mergeFlushedSegments(segID, collID, partID, planID UniqueID, compactedFrom []UniqueID, channelName string, numOfRows int64) error
+	splitFlushedSegments(segIDs []UniqueID, collID, partID, planID UniqueID, compactedFrom []UniqueID, channelName string, numOfRows []int64, pks []storage.FieldData) error
 	hasSegment(segID UniqueID, countFlushed bool) bool
 	removeSegments(segID ...UniqueID)
 	listCompactedSegmentIDs() map[UniqueID][]UniqueID
+	listClusteredSegmentIDs(compactedFrom UniqueID) []UniqueID
+	mayContainPK(pk primaryKey) bool
 
 	updateStatistics(segID UniqueID, numRows int64)
 	refreshFlushedSegStatistics(segID UniqueID, numRows int64)
@@ -88,6 +92,7 @@ type Segment struct {
 	isFlushed    atomic.Value // bool
 	channelName  string
 	compactedTo  UniqueID
+	clusteredTo  []UniqueID // segments clustered from the compacted segment
 
 	checkPoint segmentCheckPoint
 	startPos   *internalpb.MsgPosition // TODO readonly
@@ -102,6 +107,7 @@ type Segment struct {
 // It implements `Replica` interface.
 type SegmentReplica struct {
 	collectionID UniqueID
+	schemaMu     sync.Mutex
 	collSchema   *schemapb.CollectionSchema
 
 	segMu             sync.RWMutex
@@ -130,6 +136,23 @@ func (s *Segment) updatePk(pk primaryKey) error {
 	return nil
 }
 
+// isPKExist returns true if the pk may exist in the segment, false positive is possible
+func (s *Segment) isPKExist(pk primaryKey) bool {
+	if s.minPK != nil && s.maxPK != nil && (pk.LT(s.minPK) || pk.GT(s.maxPK)) {
+		return false
+	}
+	switch pk.Type() {
+	case schemapb.DataType_Int64:
+		buf := make([]byte, 8)
+		common.Endian.PutUint64(buf, uint64(pk.(*int64PrimaryKey).Value))
+		return s.pkFilter.Test(buf)
+	case schemapb.DataType_VarChar:
+		return s.pkFilter.TestString(pk.(*varCharPrimaryKey).Value)
+	default:
+		return false
+	}
+}
+
 func (s *Segment) updatePKRange(ids storage.FieldData) error {
 	switch pks := ids.(type) {
 	case *storage.Int64FieldData:
@@ -304,6 +327,32 @@ func (replica *SegmentReplica) listCompactedSegmentIDs() map[UniqueID][]UniqueID
 	return compactedTo2From
 }
 
+// listClusteredSegmentIDs returns the segments clustered from the compacted segment
+func (replica *SegmentReplica) listClusteredSegmentIDs(compactedFrom UniqueID) []UniqueID {
+	replica.segMu.RLock()
+	defer replica.segMu.RUnlock()
+
+	if seg, ok := replica.compactedSegments[compactedFrom]; ok {
+		return seg.clusteredTo
+	}
+	return nil
+}
+
+// mayContainPK returns true if the pk may exist in the segments of the replica, the compacted segments are skipped
+func (replica *SegmentReplica) mayContainPK(pk primaryKey) bool {
+	replica.segMu.RLock()
+	defer replica.segMu.RUnlock()
+
+	for _, segments := range []map[UniqueID]*Segment{replica.newSegments, replica.normalSegments, replica.flushedSegments} {
+		for _, seg := range segments {
+			if seg.isPKExist(pk) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // filterSegments return segments with same channelName and partition ID
 // get all segments
 func (replica *SegmentReplica) filterSegments(channelName string, partitionID UniqueID) []*Segment {
@@ -671,6 +720,8 @@ func (replica *SegmentReplica) getCollectionSchema(collID UniqueID, ts Timestamp
 		return nil, fmt.Errorf("not supported collection %v", collID)
 	}
 
+	replica.schemaMu.Lock()
+	defer replica.schemaMu.Unlock()
 	if replica.collSchema == nil {
 		sch, err := replica.metaService.getCollectionSchema(context.Background(), collID, ts)
 		if err != nil {
@@ -683,6 +734,18 @@ func (replica *SegmentReplica) getCollectionSchema(collID UniqueID, ts Timestamp
 	return replica.collSchema, nil
 }
 
+// updateCollectionSchema replaces the cached collection schema if the schema version is newer,
+// returns whether the schema is replaced.
+func (replica *SegmentReplica) updateCollectionSchema(schema *schemapb.CollectionSchema) bool {
+	replica.schemaMu.Lock()
+	defer replica.schemaMu.Unlock()
+	if replica.collSchema != nil && replica.collSchema.GetVersion() >= schema.GetVersion() {
+		return false
+	}
+	replica.collSchema = schema
+	return true
+}
+
 func (replica *SegmentReplica) validCollection(collID UniqueID) bool {
 	return collID == replica.collectionID
 }
@@ -758,6 +821,68 @@ func (replica *SegmentReplica) mergeFlushedSegments(segID, collID, partID, planI
 	return nil
 }
 
+// splitFlushedSegments adds the segments clustered from the compacted segments, each of which only holds the pk range
+// of its own rows. The first segment is the one the compacted segments are compacted to.
+func (replica *SegmentReplica) splitFlushedSegments(segIDs []UniqueID, collID, partID, planID UniqueID, compactedFrom []UniqueID,
+	channelName string, numOfRows []int64, pks []storage.FieldData) error {
+	if collID != replica.collectionID {
+		log.Warn("Mismatch collection",
+			zap.Int64("input ID", collID),
+			zap.Int64("expected ID", replica.collectionID))
+		return fmt.Errorf("mismatch collection, ID=%d", collID)
+	}
+	if len(segIDs) == 0 || len(segIDs) != len(numOfRows) || len(segIDs) != len(pks) {
+		return fmt.Errorf("mismatch number of clustered segments, plan ID=%d", planID)
+	}
+
+	log.Info("split flushed segments",
+		zap.Int64("planID", planID),
+		zap.Int64s("compacted To segmentIDs", segIDs),
+		zap.Int64s("compacted From segmentIDs", compactedFrom),
+		zap.Int64("partition ID", partID),
+		zap.String("channel name", channelName),
+	)
+
+	segments := make([]*Segment, 0, len(segIDs))
+	for i, segID := range segIDs {
+		seg := &Segment{
+			collectionID: collID,
+			partitionID:  partID,
+			segmentID:    segID,
+			channelName:  channelName,
+			numRows:      numOfRows[i],
+
+			pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
+		}
+		if err := seg.updatePKRange(pks[i]); err != nil {
+			return err
+		}
+		seg.isNew.Store(false)
+		seg.isFlushed.Store(true)
+		segments = append(segments, seg)
+	}
+
+	replica.segMu.Lock()
+	defer replica.segMu.Unlock()
+	for _, ID := range compactedFrom {
+		s, ok := replica.flushedSegments[ID]
+		if !ok {
+			log.Warn("no match flushed segment to split from", zap.Int64("segmentID", ID))
+			continue
+		}
+
+		s.compactedTo = segIDs[0]
+		s.clusteredTo = segIDs
+		replica.compactedSegments[ID] = s
+		delete(replica.flushedSegments, ID)
+	}
+	for _, seg := range segments {
+		replica.flushedSegments[seg.segmentID] = seg
+	}
+
+	return nil
+}
+
 // for tests only
 func (replica *SegmentReplica) addFlushedSegmentWithPKs(segID, collID, partID UniqueID, channelName string, numOfRows int64, ids storage.FieldData) error {
 	if collID != replica.collectionID {
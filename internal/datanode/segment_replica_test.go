@@ -582,6 +582,27 @@ func TestSegmentReplica_InterfaceMethod(t *testing.T) {
 		rc.setCollectionID(1)
 	})
 
+	t.Run("Test_updateCollectionSchema", func(t *testing.T) {
+		sr, err := newReplica(context.Background(), rc, cm, 1)
+		assert.Nil(t, err)
+
+		s, err := sr.getCollectionSchema(1, Timestamp(0))
+		assert.NoError(t, err)
+		newSchema := &schemapb.CollectionSchema{
+			Name:    s.GetName(),
+			Fields:  s.GetFields(),
+			Version: s.GetVersion() + 1,
+		}
+
+		assert.True(t, sr.updateCollectionSchema(newSchema))
+		s, err = sr.getCollectionSchema(1, Timestamp(0))
+		assert.NoError(t, err)
+		assert.Equal(t, newSchema.GetVersion(), s.GetVersion())
+
+		// outdated schema is ignored
+		assert.False(t, sr.updateCollectionSchema(newSchema))
+	})
+
 	t.Run("Test listAllSegmentIDs", func(t *testing.T) {
 		sr := &SegmentReplica{
 			newSegments:     map[UniqueID]*Segment{1: {segmentID: 1}},
@@ -666,6 +687,36 @@ func TestSegmentReplica_InterfaceMethod(t *testing.T) {
 		from, ok := to2from[3]
 		assert.True(t, ok)
 		assert.ElementsMatch(t, []UniqueID{1, 2}, from)
+		assert.Empty(t, sr.listClusteredSegmentIDs(1))
+	})
+
+	t.Run("Test_splitFlushedSegments", func(t *testing.T) {
+		sr, err := newReplica(context.Background(), rc, cm, 1)
+		assert.Nil(t, err)
+
+		sr.addFlushedSegmentWithPKs(1, 1, 0, "channel", 10, &storage.Int64FieldData{Data: []int64{1, 3}})
+		sr.addFlushedSegmentWithPKs(2, 1, 0, "channel", 10, &storage.Int64FieldData{Data: []int64{2, 4}})
+
+		err = sr.splitFlushedSegments([]UniqueID{3, 4}, 1, 0, 100, []UniqueID{1, 2}, "channel", []int64{2, 2},
+			[]storage.FieldData{&storage.Int64FieldData{Data: []int64{1, 2}}, &storage.Int64FieldData{Data: []int64{3, 4}}})
+		assert.NoError(t, err)
+		assert.True(t, sr.hasSegment(3, true))
+		assert.True(t, sr.hasSegment(4, true))
+		assert.False(t, sr.hasSegment(1, true))
+		assert.False(t, sr.hasSegment(2, true))
+
+		assert.ElementsMatch(t, []UniqueID{1, 2}, sr.listCompactedSegmentIDs()[3])
+		assert.Equal(t, []UniqueID{3, 4}, sr.listClusteredSegmentIDs(1))
+
+		// each clustered segment only holds its own pk range
+		seg := sr.flushedSegments[3]
+		assert.Equal(t, newInt64PrimaryKey(1), seg.minPK)
+		assert.Equal(t, newInt64PrimaryKey(2), seg.maxPK)
+
+		err = sr.splitFlushedSegments([]UniqueID{5}, 1, 0, 100, nil, "channel", nil, nil)
+		assert.Error(t, err)
+		err = sr.splitFlushedSegments([]UniqueID{5}, 2, 0, 100, nil, "channel", []int64{1}, []storage.FieldData{&storage.Int64FieldData{Data: []int64{1}}})
+		assert.Error(t, err)
 	})
 
 }
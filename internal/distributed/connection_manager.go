@@ -32,8 +32,10 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"google.golang.org/grpc"
@@ -70,11 +72,18 @@ type ConnectionManager struct {
 	connMu      sync.RWMutex
 	connections map[int64]*grpc.ClientConn
 
+	internalTLS paramtable.InternalTLSConfig
+
 	closeCh chan struct{}
 }
 
+// ClientParams is the parameters of the connections to the other components
+var ClientParams paramtable.GrpcClientConfig
+
 // NewConnectionManager creates a new connection manager.
+// The connections are secured by mutual tls if the internal tls is enabled.
 func NewConnectionManager(session *sessionutil.Session) *ConnectionManager {
+	ClientParams.InitOnce(session.ServerName)
 	return &ConnectionManager{
 		session: session,
 
@@ -88,6 +97,8 @@ func NewConnectionManager(session *sessionutil.Session) *ConnectionManager {
 		notify:     make(chan int64),
 
 		connections: make(map[int64]*grpc.ClientConn),
+
+		internalTLS: ClientParams.InternalTLS,
 	}
 }
 
@@ -315,7 +326,7 @@ func (cm *ConnectionManager) buildClients(session *sessionutil.Session, connecti
 }
 
 func (cm *ConnectionManager) buildConnections(session *sessionutil.Session) {
-	task := newBuildClientTask(session, cm.notify)
+	task := newBuildClientTask(session, cm.notify, cm.internalTLS)
 	cm.addTask(session.ServerID, task)
 	task.Run()
 }
@@ -368,12 +379,13 @@ type buildClientTask struct {
 	sess         *sessionutil.Session
 	state        buildConnectionstate
 	retryOptions []retry.Option
+	internalTLS  paramtable.InternalTLSConfig
 
 	result *grpc.ClientConn
 	notify chan int64
 }
 
-func newBuildClientTask(session *sessionutil.Session, notify chan int64, retryOptions ...retry.Option) *buildClientTask {
+func newBuildClientTask(session *sessionutil.Session, notify chan int64, internalTLS paramtable.InternalTLSConfig, retryOptions ...retry.Option) *buildClientTask {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &buildClientTask{
 		ctx:    ctx,
@@ -381,6 +393,7 @@ func newBuildClientTask(session *sessionutil.Session, notify chan int64, retryOp
 
 		sess:         session,
 		retryOptions: retryOptions,
+		internalTLS:  internalTLS,
 
 		notify: notify,
 	}
@@ -392,10 +405,14 @@ func (bct *buildClientTask) Run() {
 	go func() {
 		defer bct.finish()
 		connectGrpcFunc := func() error {
+			creds, err := tlsutil.InternalClientCredentials(bct.internalTLS)
+			if err != nil {
+				return err
+			}
 			opts := trace.GetInterceptorOpts()
 			log.Debug("Grpc connect ", zap.String("Address", bct.sess.Address))
 			conn, err := grpc.DialContext(bct.ctx, bct.sess.Address,
-				grpc.WithInsecure(), grpc.WithBlock(), grpc.WithTimeout(30*time.Second),
+				grpc.WithTransportCredentials(creds), grpc.WithBlock(), grpc.WithTimeout(30*time.Second),
 				grpc.WithDisableRetry(),
 				grpc.WithUnaryInterceptor(
 					grpc_middleware.ChainUnaryClient(
@@ -404,6 +421,7 @@ func (bct *buildClientTask) Run() {
 							grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
 						),
 						grpc_opentracing.UnaryClientInterceptor(opts...),
+						trace.OtelUnaryClientInterceptor(),
 					)),
 				grpc.WithStreamInterceptor(
 					grpc_middleware.ChainStreamClient(
@@ -412,6 +430,7 @@ func (bct *buildClientTask) Run() {
 							grpc_retry.WithCodes(codes.Aborted, codes.Unavailable),
 						),
 						grpc_opentracing.StreamClientInterceptor(opts...),
+						trace.OtelStreamClientInterceptor(),
 					)),
 			)
 			if err != nil {
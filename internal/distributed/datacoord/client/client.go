@@ -65,6 +65,7 @@ func NewClient(ctx context.Context, metaRoot string, etcdCli *clientv3.Client) (
 			InitialBackoff:         ClientParams.InitialBackoff,
 			MaxBackoff:             ClientParams.MaxBackoff,
 			BackoffMultiplier:      ClientParams.BackoffMultiplier,
+			InternalTLS:            ClientParams.InternalTLS,
 		},
 		sess: sess,
 	}
@@ -453,6 +454,34 @@ func (c *Client) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.
 	return ret.(*milvuspb.GetCompactionPlansResponse), err
 }
 
+// GetCompactionPolicyStats gets the compaction policy statistics of segments of a collection
+func (c *Client) GetCompactionPolicyStats(ctx context.Context, req *milvuspb.GetCompactionPolicyStatsRequest) (*milvuspb.GetCompactionPolicyStatsResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(datapb.DataCoordClient).GetCompactionPolicyStats(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*milvuspb.GetCompactionPolicyStatsResponse), err
+}
+
+// CancelCompaction cancels the pending and executing plans of a manual compaction
+func (c *Client) CancelCompaction(ctx context.Context, req *milvuspb.CancelCompactionRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(datapb.DataCoordClient).CancelCompaction(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
 // WatchChannels notifies DataCoord to watch vchannels of a collection
 func (c *Client) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error) {
 	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
@@ -578,3 +607,73 @@ func (c *Client) AddSegment(ctx context.Context, req *datapb.AddSegmentRequest)
 	}
 	return ret.(*commonpb.Status), err
 }
+
+// TruncateSegments is the DataCoord client side code for TruncateSegments call.
+func (c *Client) TruncateSegments(ctx context.Context, req *datapb.TruncateSegmentsRequest) (*datapb.TruncateSegmentsResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(datapb.DataCoordClient).TruncateSegments(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.TruncateSegmentsResponse), err
+}
+
+// CreateSnapshot is the DataCoord client side code for CreateSnapshot call.
+func (c *Client) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) (*datapb.CreateSnapshotResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(datapb.DataCoordClient).CreateSnapshot(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.CreateSnapshotResponse), err
+}
+
+// DropSnapshot is the DataCoord client side code for DropSnapshot call.
+func (c *Client) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(datapb.DataCoordClient).DropSnapshot(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// ListSnapshots is the DataCoord client side code for ListSnapshots call.
+func (c *Client) ListSnapshots(ctx context.Context, req *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(datapb.DataCoordClient).ListSnapshots(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.ListSnapshotsResponse), err
+}
+
+// CheckPrimaryKeys is the DataCoord client side code for CheckPrimaryKeys call.
+func (c *Client) CheckPrimaryKeys(ctx context.Context, req *datapb.CheckPrimaryKeysRequest) (*datapb.CheckPrimaryKeysResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(datapb.DataCoordClient).CheckPrimaryKeys(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.CheckPrimaryKeysResponse), err
+}
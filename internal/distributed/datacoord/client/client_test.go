@@ -138,6 +138,18 @@ func Test_NewClient(t *testing.T) {
 
 		r27, err := client.AddSegment(ctx, nil)
 		retCheck(retNotNil, r27, err)
+
+		r28, err := client.TruncateSegments(ctx, nil)
+		retCheck(retNotNil, r28, err)
+
+		r29, err := client.CreateSnapshot(ctx, nil)
+		retCheck(retNotNil, r29, err)
+
+		r30, err := client.DropSnapshot(ctx, nil)
+		retCheck(retNotNil, r30, err)
+
+		r31, err := client.ListSnapshots(ctx, nil)
+		retCheck(retNotNil, r31, err)
 	}
 
 	client.grpcClient = &mock.GRPCClientBase{
@@ -43,6 +43,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/logutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -63,6 +64,7 @@ type Server struct {
 	grpcErrChan chan error
 	grpcServer  *grpc.Server
 	closer      io.Closer
+	otelCloser  io.Closer
 }
 
 // NewServer new data service grpc server
@@ -85,6 +87,13 @@ func (s *Server) init() error {
 	s.closer = closer
 
 	datacoord.Params.InitOnce()
+
+	otelCloser, err := trace.InitOtelTracing(typeutil.DataCoordRole, datacoord.Params.CommonCfg.OtelConfig())
+	if err != nil {
+		log.Warn("DataCoord init OpenTelemetry tracing failed", zap.Error(err))
+		return err
+	}
+	s.otelCloser = otelCloser
 	datacoord.Params.DataCoordCfg.IP = Params.IP
 	datacoord.Params.DataCoordCfg.Port = Params.Port
 	datacoord.Params.DataCoordCfg.Address = Params.GetAddress()
@@ -143,14 +152,24 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 		Timeout: 10 * time.Second, // Wait 10 second for the ping ack before assuming the connection is dead
 	}
 
+	creds, err := tlsutil.InternalServerCredentials(Params.InternalTLS)
+	if err != nil {
+		log.Warn("failed to load internal tls credentials", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+
 	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
+		grpc.Creds(creds),
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(ot.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)))
+		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)),
+		grpc.ChainUnaryInterceptor(trace.OtelUnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(trace.OtelStreamServerInterceptor()))
 	datapb.RegisterDataCoordServer(s.grpcServer, s)
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
 	if err := s.grpcServer.Serve(lis); err != nil {
@@ -182,6 +201,11 @@ func (s *Server) Stop() error {
 			return err
 		}
 	}
+	if s.otelCloser != nil {
+		if err := s.otelCloser.Close(); err != nil {
+			return err
+		}
+	}
 	s.cancel()
 
 	if s.etcdCli != nil {
@@ -311,6 +335,16 @@ func (s *Server) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.
 	return s.dataCoord.GetCompactionStateWithPlans(ctx, req)
 }
 
+// GetCompactionPolicyStats gets the compaction policy statistics of segments of a collection
+func (s *Server) GetCompactionPolicyStats(ctx context.Context, req *milvuspb.GetCompactionPolicyStatsRequest) (*milvuspb.GetCompactionPolicyStatsResponse, error) {
+	return s.dataCoord.GetCompactionPolicyStats(ctx, req)
+}
+
+// CancelCompaction cancels the pending and executing plans of a manual compaction
+func (s *Server) CancelCompaction(ctx context.Context, req *milvuspb.CancelCompactionRequest) (*commonpb.Status, error) {
+	return s.dataCoord.CancelCompaction(ctx, req)
+}
+
 // WatchChannels starts watch channels by give request
 func (s *Server) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error) {
 	return s.dataCoord.WatchChannels(ctx, req)
@@ -354,3 +388,28 @@ func (s *Server) ReleaseSegmentLock(ctx context.Context, req *datapb.ReleaseSegm
 func (s *Server) AddSegment(ctx context.Context, request *datapb.AddSegmentRequest) (*commonpb.Status, error) {
 	return s.dataCoord.AddSegment(ctx, request)
 }
+
+// TruncateSegments marks all the segments of a collection or partition dropped.
+func (s *Server) TruncateSegments(ctx context.Context, req *datapb.TruncateSegmentsRequest) (*datapb.TruncateSegmentsResponse, error) {
+	return s.dataCoord.TruncateSegments(ctx, req)
+}
+
+// CreateSnapshot pins the segments holding the data of a collection by now as a named snapshot.
+func (s *Server) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) (*datapb.CreateSnapshotResponse, error) {
+	return s.dataCoord.CreateSnapshot(ctx, req)
+}
+
+// DropSnapshot drops a snapshot and unpins its segments.
+func (s *Server) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	return s.dataCoord.DropSnapshot(ctx, req)
+}
+
+// ListSnapshots returns the snapshots of a collection.
+func (s *Server) ListSnapshots(ctx context.Context, req *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error) {
+	return s.dataCoord.ListSnapshots(ctx, req)
+}
+
+// CheckPrimaryKeys returns the primary keys which may exist in the collection.
+func (s *Server) CheckPrimaryKeys(ctx context.Context, req *datapb.CheckPrimaryKeysRequest) (*datapb.CheckPrimaryKeysResponse, error) {
+	return s.dataCoord.CheckPrimaryKeys(ctx, req)
+}
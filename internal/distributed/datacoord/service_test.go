@@ -52,6 +52,7 @@ type MockDataCoord struct {
 	compactionStateResp  *milvuspb.GetCompactionStateResponse
 	manualCompactionResp *milvuspb.ManualCompactionResponse
 	compactionPlansResp  *milvuspb.GetCompactionPlansResponse
+	policyStatsResp      *milvuspb.GetCompactionPolicyStatsResponse
 	watchChannelsResp    *datapb.WatchChannelsResponse
 	getFlushStateResp    *milvuspb.GetFlushStateResponse
 	dropVChanResp        *datapb.DropVirtualChannelResponse
@@ -61,6 +62,11 @@ type MockDataCoord struct {
 	acquireSegLockResp   *commonpb.Status
 	releaseSegLockResp   *commonpb.Status
 	addSegmentResp       *commonpb.Status
+	truncateSegmentsResp *datapb.TruncateSegmentsResponse
+	createSnapshotResp   *datapb.CreateSnapshotResponse
+	dropSnapshotResp     *commonpb.Status
+	listSnapshotsResp    *datapb.ListSnapshotsResponse
+	checkPKsResp         *datapb.CheckPrimaryKeysResponse
 }
 
 func (m *MockDataCoord) Init() error {
@@ -158,6 +164,14 @@ func (m *MockDataCoord) GetCompactionStateWithPlans(ctx context.Context, req *mi
 	return m.compactionPlansResp, m.err
 }
 
+func (m *MockDataCoord) GetCompactionPolicyStats(ctx context.Context, req *milvuspb.GetCompactionPolicyStatsRequest) (*milvuspb.GetCompactionPolicyStatsResponse, error) {
+	return m.policyStatsResp, m.err
+}
+
+func (m *MockDataCoord) CancelCompaction(ctx context.Context, req *milvuspb.CancelCompactionRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
 func (m *MockDataCoord) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error) {
 	return m.watchChannelsResp, m.err
 }
@@ -194,6 +208,26 @@ func (m *MockDataCoord) AddSegment(ctx context.Context, req *datapb.AddSegmentRe
 	return m.addSegmentResp, m.err
 }
 
+func (m *MockDataCoord) TruncateSegments(ctx context.Context, req *datapb.TruncateSegmentsRequest) (*datapb.TruncateSegmentsResponse, error) {
+	return m.truncateSegmentsResp, m.err
+}
+
+func (m *MockDataCoord) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) (*datapb.CreateSnapshotResponse, error) {
+	return m.createSnapshotResp, m.err
+}
+
+func (m *MockDataCoord) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	return m.dropSnapshotResp, m.err
+}
+
+func (m *MockDataCoord) ListSnapshots(ctx context.Context, req *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error) {
+	return m.listSnapshotsResp, m.err
+}
+
+func (m *MockDataCoord) CheckPrimaryKeys(ctx context.Context, req *datapb.CheckPrimaryKeysRequest) (*datapb.CheckPrimaryKeysResponse, error) {
+	return m.checkPKsResp, m.err
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 func Test_NewServer(t *testing.T) {
 	ctx := context.Background()
@@ -404,6 +438,24 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("GetCompactionPolicyStats", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			policyStatsResp: &milvuspb.GetCompactionPolicyStatsResponse{},
+		}
+		resp, err := server.GetCompactionPolicyStats(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("CancelCompaction", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			status: &commonpb.Status{},
+		}
+		resp, err := server.CancelCompaction(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
 	t.Run("set segment state", func(t *testing.T) {
 		server.dataCoord = &MockDataCoord{
 			setSegmentStateResp: &datapb.SetSegmentStateResponse{},
@@ -468,6 +520,69 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("truncate segments", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			truncateSegmentsResp: &datapb.TruncateSegmentsResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+			},
+		}
+		resp, err := server.TruncateSegments(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("create snapshot", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			createSnapshotResp: &datapb.CreateSnapshotResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+			},
+		}
+		resp, err := server.CreateSnapshot(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("drop snapshot", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			dropSnapshotResp: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+		}
+		resp, err := server.DropSnapshot(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("list snapshots", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			listSnapshotsResp: &datapb.ListSnapshotsResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+			},
+		}
+		resp, err := server.ListSnapshots(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("check primary keys", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			checkPKsResp: &datapb.CheckPrimaryKeysResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+			},
+		}
+		resp, err := server.CheckPrimaryKeys(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
 	err := server.Stop()
 	assert.Nil(t, err)
 }
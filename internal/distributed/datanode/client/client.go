@@ -58,6 +58,7 @@ func NewClient(ctx context.Context, addr string) (*Client, error) {
 			InitialBackoff:         ClientParams.InitialBackoff,
 			MaxBackoff:             ClientParams.MaxBackoff,
 			BackoffMultiplier:      ClientParams.BackoffMultiplier,
+			InternalTLS:            ClientParams.InternalTLS,
 		},
 	}
 	client.grpcClient.SetRole(typeutil.DataNodeRole)
@@ -190,6 +191,20 @@ func (c *Client) Compaction(ctx context.Context, req *datapb.CompactionPlan) (*c
 	return ret.(*commonpb.Status), err
 }
 
+// StopCompaction stops the executing compaction tasks of the given plans
+func (c *Client) StopCompaction(ctx context.Context, req *datapb.StopCompactionRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(datapb.DataNodeClient).StopCompaction(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
 // Import data files(json, numpy, etc.) on MinIO/S3 storage, read and parse them into sealed segments
 func (c *Client) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*commonpb.Status, error) {
 	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
@@ -230,3 +245,17 @@ func (c *Client) AddSegment(ctx context.Context, req *datapb.AddSegmentRequest)
 	}
 	return ret.(*commonpb.Status), err
 }
+
+// CheckPrimaryKeys is the DataNode client side code for CheckPrimaryKeys call.
+func (c *Client) CheckPrimaryKeys(ctx context.Context, req *datapb.CheckPrimaryKeysRequest) (*datapb.CheckPrimaryKeysResponse, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(datapb.DataNodeClient).CheckPrimaryKeys(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*datapb.CheckPrimaryKeysResponse), err
+}
@@ -46,6 +46,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -69,7 +70,8 @@ type Server struct {
 	newRootCoordClient func(string, *clientv3.Client) (types.RootCoord, error)
 	newDataCoordClient func(string, *clientv3.Client) (types.DataCoord, error)
 
-	closer io.Closer
+	closer     io.Closer
+	otelCloser io.Closer
 }
 
 // NewServer new DataNode grpc server
@@ -128,14 +130,24 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 		return
 	}
 
+	creds, err := tlsutil.InternalServerCredentials(Params.InternalTLS)
+	if err != nil {
+		log.Warn("failed to load internal tls credentials", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+
 	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
+		grpc.Creds(creds),
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(ot.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)))
+		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)),
+		grpc.ChainUnaryInterceptor(trace.OtelUnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(trace.OtelStreamServerInterceptor()))
 	datapb.RegisterDataNodeServer(s.grpcServer, s)
 
 	ctx, cancel := context.WithCancel(s.ctx)
@@ -183,6 +195,11 @@ func (s *Server) Stop() error {
 			return err
 		}
 	}
+	if s.otelCloser != nil {
+		if err := s.otelCloser.Close(); err != nil {
+			return err
+		}
+	}
 	s.cancel()
 	if s.etcdCli != nil {
 		defer s.etcdCli.Close()
@@ -235,6 +252,13 @@ func (s *Server) init() error {
 	s.SetEtcdClient(s.etcdCli)
 	closer := trace.InitTracing(fmt.Sprintf("data_node ip: %s, port: %d", Params.IP, Params.Port))
 	s.closer = closer
+
+	otelCloser, err := trace.InitOtelTracing(typeutil.DataNodeRole, dn.Params.CommonCfg.OtelConfig())
+	if err != nil {
+		log.Warn("DataNode init OpenTelemetry tracing failed", zap.Error(err))
+		return err
+	}
+	s.otelCloser = otelCloser
 	addr := Params.IP + ":" + strconv.Itoa(Params.Port)
 	log.Debug("DataNode address", zap.String("address", addr))
 
@@ -354,6 +378,11 @@ func (s *Server) Compaction(ctx context.Context, request *datapb.CompactionPlan)
 	return s.datanode.Compaction(ctx, request)
 }
 
+// StopCompaction stops the executing compaction tasks of the given plans
+func (s *Server) StopCompaction(ctx context.Context, request *datapb.StopCompactionRequest) (*commonpb.Status, error) {
+	return s.datanode.StopCompaction(ctx, request)
+}
+
 func (s *Server) Import(ctx context.Context, request *datapb.ImportTaskRequest) (*commonpb.Status, error) {
 	return s.datanode.Import(ctx, request)
 }
@@ -365,3 +394,8 @@ func (s *Server) ResendSegmentStats(ctx context.Context, request *datapb.ResendS
 func (s *Server) AddSegment(ctx context.Context, request *datapb.AddSegmentRequest) (*commonpb.Status, error) {
 	return s.datanode.AddSegment(ctx, request)
 }
+
+// CheckPrimaryKeys returns the primary keys which may exist in the segments served by the DataNode
+func (s *Server) CheckPrimaryKeys(ctx context.Context, request *datapb.CheckPrimaryKeysRequest) (*datapb.CheckPrimaryKeysResponse, error) {
+	return s.datanode.CheckPrimaryKeys(ctx, request)
+}
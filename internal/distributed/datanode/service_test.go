@@ -47,6 +47,7 @@ type MockDataNode struct {
 	strResp    *milvuspb.StringResponse
 	metricResp *milvuspb.GetMetricsResponse
 	resendResp *datapb.ResendSegmentStatsResponse
+	checkResp  *datapb.CheckPrimaryKeysResponse
 }
 
 func (m *MockDataNode) Init() error {
@@ -109,6 +110,10 @@ func (m *MockDataNode) Compaction(ctx context.Context, req *datapb.CompactionPla
 	return m.status, m.err
 }
 
+func (m *MockDataNode) StopCompaction(ctx context.Context, req *datapb.StopCompactionRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
 func (m *MockDataNode) SetEtcdClient(client *clientv3.Client) {
 }
 
@@ -124,6 +129,10 @@ func (m *MockDataNode) AddSegment(ctx context.Context, req *datapb.AddSegmentReq
 	return m.status, m.err
 }
 
+func (m *MockDataNode) CheckPrimaryKeys(ctx context.Context, req *datapb.CheckPrimaryKeysRequest) (*datapb.CheckPrimaryKeysResponse, error) {
+	return m.checkResp, m.err
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type mockDataCoord struct {
 	types.DataCoord
@@ -259,6 +268,15 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("StopCompaction", func(t *testing.T) {
+		server.datanode = &MockDataNode{
+			status: &commonpb.Status{},
+		}
+		resp, err := server.StopCompaction(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
 	t.Run("Import", func(t *testing.T) {
 		server.datanode = &MockDataNode{
 			status: &commonpb.Status{},
@@ -286,6 +304,15 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("check primary keys", func(t *testing.T) {
+		server.datanode = &MockDataNode{
+			checkResp: &datapb.CheckPrimaryKeysResponse{},
+		}
+		resp, err := server.CheckPrimaryKeys(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
 	err = server.Stop()
 	assert.Nil(t, err)
 }
@@ -65,6 +65,7 @@ func NewClient(ctx context.Context, metaRoot string, etcdCli *clientv3.Client) (
 			InitialBackoff:         ClientParams.InitialBackoff,
 			MaxBackoff:             ClientParams.MaxBackoff,
 			BackoffMultiplier:      ClientParams.BackoffMultiplier,
+			InternalTLS:            ClientParams.InternalTLS,
 		},
 		sess: sess,
 	}
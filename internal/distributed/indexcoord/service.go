@@ -43,6 +43,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -68,7 +69,8 @@ type Server struct {
 
 	dataCoord types.DataCoord
 
-	closer io.Closer
+	closer     io.Closer
+	otelCloser io.Closer
 }
 
 // Run initializes and starts IndexCoord's grpc service.
@@ -96,6 +98,13 @@ func (s *Server) init() error {
 	closer := trace.InitTracing("IndexCoord")
 	s.closer = closer
 
+	otelCloser, err := trace.InitOtelTracing(typeutil.IndexCoordRole, indexcoord.Params.CommonCfg.OtelConfig())
+	if err != nil {
+		log.Warn("IndexCoord init OpenTelemetry tracing failed", zap.Error(err))
+		return err
+	}
+	s.otelCloser = otelCloser
+
 	etcdCli, err := etcd.GetEtcdClient(&indexcoord.Params.EtcdCfg)
 	if err != nil {
 		log.Debug("IndexCoord connect to etcd failed", zap.Error(err))
@@ -169,6 +178,11 @@ func (s *Server) Stop() error {
 			return err
 		}
 	}
+	if s.otelCloser != nil {
+		if err := s.otelCloser.Close(); err != nil {
+			return err
+		}
+	}
 	if s.indexcoord != nil {
 		s.indexcoord.Stop()
 	}
@@ -266,14 +280,24 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	ctx, cancel := context.WithCancel(s.loopCtx)
 	defer cancel()
 
+	creds, err := tlsutil.InternalServerCredentials(Params.InternalTLS)
+	if err != nil {
+		log.Warn("failed to load internal tls credentials", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+
 	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
+		grpc.Creds(creds),
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(ot.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)))
+		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)),
+		grpc.ChainUnaryInterceptor(trace.OtelUnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(trace.OtelStreamServerInterceptor()))
 	indexpb.RegisterIndexCoordServer(s.grpcServer, s)
 
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
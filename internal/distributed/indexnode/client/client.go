@@ -58,6 +58,7 @@ func NewClient(ctx context.Context, addr string) (*Client, error) {
 			InitialBackoff:         ClientParams.InitialBackoff,
 			MaxBackoff:             ClientParams.MaxBackoff,
 			BackoffMultiplier:      ClientParams.BackoffMultiplier,
+			InternalTLS:            ClientParams.InternalTLS,
 		},
 	}
 	client.grpcClient.SetRole(typeutil.IndexNodeRole)
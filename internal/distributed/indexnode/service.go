@@ -43,6 +43,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -60,8 +61,9 @@ type Server struct {
 	loopCancel func()
 	loopWg     sync.WaitGroup
 
-	etcdCli *clientv3.Client
-	closer  io.Closer
+	etcdCli    *clientv3.Client
+	closer     io.Closer
+	otelCloser io.Closer
 }
 
 // Run initializes and starts IndexNode's grpc service.
@@ -102,14 +104,24 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 		Timeout: 10 * time.Second, // Wait 10 second for the ping ack before assuming the connection is dead
 	}
 
+	creds, err := tlsutil.InternalServerCredentials(Params.InternalTLS)
+	if err != nil {
+		log.Warn("failed to load internal tls credentials", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+
 	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
+		grpc.Creds(creds),
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(ot.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)))
+		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)),
+		grpc.ChainUnaryInterceptor(trace.OtelUnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(trace.OtelStreamServerInterceptor()))
 	indexpb.RegisterIndexNodeServer(s.grpcServer, s)
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
 	if err := s.grpcServer.Serve(lis); err != nil {
@@ -133,6 +145,13 @@ func (s *Server) init() error {
 	closer := trace.InitTracing(fmt.Sprintf("IndexNode-%d", indexnode.Params.IndexNodeCfg.GetNodeID()))
 	s.closer = closer
 
+	otelCloser, err := trace.InitOtelTracing(typeutil.IndexNodeRole, indexnode.Params.CommonCfg.OtelConfig())
+	if err != nil {
+		log.Warn("IndexNode init OpenTelemetry tracing failed", zap.Error(err))
+		return err
+	}
+	s.otelCloser = otelCloser
+
 	defer func() {
 		if err != nil {
 			err = s.Stop()
@@ -190,6 +209,11 @@ func (s *Server) Stop() error {
 			return err
 		}
 	}
+	if s.otelCloser != nil {
+		if err := s.otelCloser.Close(); err != nil {
+			return err
+		}
+	}
 	s.loopCancel()
 	if s.indexnode != nil {
 		s.indexnode.Stop()
@@ -0,0 +1,32 @@
+package httpserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/authutil"
+)
+
+// AuthenticateFunc verifies the Authorization header, returns nil AuthInfo if the authentication is not required
+type AuthenticateFunc func(ctx context.Context, authorization string) (*authutil.AuthInfo, error)
+
+// AuthMiddleware authenticates the requests with authenticate,
+// the AuthInfo of the request is set to the gin context for the handlers
+func AuthMiddleware(authenticate AuthenticateFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		info, err := authenticate(c, c.GetHeader("Authorization"))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, ErrResponse{
+				ErrorCode: commonpb.ErrorCode_PermissionDenied,
+				Reason:    "auth check failure, please check username, password or credential",
+			})
+			return
+		}
+		if info != nil {
+			c.Set(authutil.AuthInfoKey, info)
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,59 @@
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/milvus-io/milvus/internal/util/authutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthMiddleware(t *testing.T) {
+	authenticate := func(ctx context.Context, authorization string) (*authutil.AuthInfo, error) {
+		switch authorization {
+		case "Bearer valid":
+			return &authutil.AuthInfo{User: "alice", Roles: []string{"reader"}, Method: "apikey"}, nil
+		case "":
+			return nil, nil
+		}
+		return nil, errors.New("invalid credential")
+	}
+	testEngine := gin.New()
+	testEngine.Use(AuthMiddleware(authenticate))
+	testEngine.GET("/test", func(c *gin.Context) {
+		user := ""
+		if info, ok := authutil.AuthInfoFromContext(c); ok {
+			user = info.User
+		}
+		c.String(http.StatusOK, user)
+	})
+
+	t.Run("authenticated", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/test", nil)
+		req.Header.Set("Authorization", "Bearer valid")
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "alice", w.Body.String())
+	})
+
+	t.Run("not required", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/test", nil)
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "", w.Body.String())
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/test", nil)
+		req.Header.Set("Authorization", "Bearer invalid")
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
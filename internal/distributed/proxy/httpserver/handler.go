@@ -34,9 +34,17 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.DELETE("/collection/load", wrapHandler(h.handleReleaseCollection))
 	router.GET("/collection/statistics", wrapHandler(h.handleGetCollectionStatistics))
 	router.GET("/collections", wrapHandler(h.handleShowCollections))
+	router.PATCH("/collection/shards", wrapHandler(h.handleAlterShards))
+	router.POST("/collection/truncate", wrapHandler(h.handleTruncateCollection))
+	router.POST("/collection/field", wrapHandler(h.handleAddField))
+	router.DELETE("/collection/field", wrapHandler(h.handleDropField))
+	router.POST("/collection/snapshot", wrapHandler(h.handleCreateSnapshot))
+	router.DELETE("/collection/snapshot", wrapHandler(h.handleDropSnapshot))
+	router.GET("/collection/snapshots", wrapHandler(h.handleListSnapshots))
 
 	router.POST("/partition", wrapHandler(h.handleCreatePartition))
 	router.DELETE("/partition", wrapHandler(h.handleDropPartition))
+	router.POST("/partition/truncate", wrapHandler(h.handleTruncatePartition))
 	router.GET("/partition/existence", wrapHandler(h.handleHasPartition))
 	router.POST("/partitions/load", wrapHandler(h.handleLoadPartitions))
 	router.DELETE("/partitions/load", wrapHandler(h.handleReleasePartitions))
@@ -69,7 +77,9 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.POST("/load-balance", wrapHandler(h.handleLoadBalance))
 	router.GET("/compaction/state", wrapHandler(h.handleGetCompactionState))
 	router.GET("/compaction/plans", wrapHandler(h.handleGetCompactionStateWithPlans))
+	router.GET("/compaction/policy-stats", wrapHandler(h.handleGetCompactionPolicyStats))
 	router.POST("/compaction", wrapHandler(h.handleManualCompaction))
+	router.POST("/compaction/cancel", wrapHandler(h.handleCancelCompaction))
 
 	router.POST("/import", wrapHandler(h.handleImport))
 	router.GET("/import/state", wrapHandler(h.handleGetImportState))
@@ -180,6 +190,69 @@ func (h *Handlers) handleShowCollections(c *gin.Context) (interface{}, error) {
 	return h.proxy.ShowCollections(c, &req)
 }
 
+func (h *Handlers) handleAlterShards(c *gin.Context) (interface{}, error) {
+	req := milvuspb.AlterShardsRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.AlterShards(c, &req)
+}
+
+func (h *Handlers) handleTruncateCollection(c *gin.Context) (interface{}, error) {
+	req := milvuspb.TruncateCollectionRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.TruncateCollection(c, &req)
+}
+
+func (h *Handlers) handleAddField(c *gin.Context) (interface{}, error) {
+	req := milvuspb.AddFieldRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.AddField(c, &req)
+}
+
+func (h *Handlers) handleDropField(c *gin.Context) (interface{}, error) {
+	req := milvuspb.DropFieldRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.DropField(c, &req)
+}
+
+func (h *Handlers) handleCreateSnapshot(c *gin.Context) (interface{}, error) {
+	req := milvuspb.CreateSnapshotRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.CreateSnapshot(c, &req)
+}
+
+func (h *Handlers) handleDropSnapshot(c *gin.Context) (interface{}, error) {
+	req := milvuspb.DropSnapshotRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.DropSnapshot(c, &req)
+}
+
+func (h *Handlers) handleListSnapshots(c *gin.Context) (interface{}, error) {
+	req := milvuspb.ListSnapshotsRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.ListSnapshots(c, &req)
+}
+
 func (h *Handlers) handleCreatePartition(c *gin.Context) (interface{}, error) {
 	req := milvuspb.CreatePartitionRequest{}
 	err := shouldBind(c, &req)
@@ -198,6 +271,15 @@ func (h *Handlers) handleDropPartition(c *gin.Context) (interface{}, error) {
 	return h.proxy.DropPartition(c, &req)
 }
 
+func (h *Handlers) handleTruncatePartition(c *gin.Context) (interface{}, error) {
+	req := milvuspb.TruncatePartitionRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.TruncatePartition(c, &req)
+}
+
 func (h *Handlers) handleHasPartition(c *gin.Context) (interface{}, error) {
 	req := milvuspb.HasPartitionRequest{}
 	err := shouldBind(c, &req)
@@ -364,6 +446,7 @@ func (h *Handlers) handleSearch(c *gin.Context) (interface{}, error) {
 		TravelTimestamp:    wrappedReq.TravelTimestamp,
 		GuaranteeTimestamp: wrappedReq.GuaranteeTimestamp,
 		Nq:                 wrappedReq.Nq,
+		TravelTime:         wrappedReq.TravelTime,
 	}
 	if len(wrappedReq.BinaryVectors) > 0 {
 		req.PlaceholderGroup = binaryVector2Bytes(wrappedReq.BinaryVectors)
@@ -479,6 +562,15 @@ func (h *Handlers) handleGetCompactionStateWithPlans(c *gin.Context) (interface{
 	return h.proxy.GetCompactionStateWithPlans(c, &req)
 }
 
+func (h *Handlers) handleGetCompactionPolicyStats(c *gin.Context) (interface{}, error) {
+	req := milvuspb.GetCompactionPolicyStatsRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.GetCompactionPolicyStats(c, &req)
+}
+
 func (h *Handlers) handleManualCompaction(c *gin.Context) (interface{}, error) {
 	req := milvuspb.ManualCompactionRequest{}
 	err := shouldBind(c, &req)
@@ -488,6 +580,15 @@ func (h *Handlers) handleManualCompaction(c *gin.Context) (interface{}, error) {
 	return h.proxy.ManualCompaction(c, &req)
 }
 
+func (h *Handlers) handleCancelCompaction(c *gin.Context) (interface{}, error) {
+	req := milvuspb.CancelCompactionRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.CancelCompaction(c, &req)
+}
+
 func (h *Handlers) handleImport(c *gin.Context) (interface{}, error) {
 	req := milvuspb.ImportRequest{}
 	err := shouldBind(c, &req)
@@ -103,6 +103,38 @@ func (mockProxyComponent) AlterAlias(ctx context.Context, request *milvuspb.Alte
 	return testStatus, nil
 }
 
+func (mockProxyComponent) AlterShards(ctx context.Context, request *milvuspb.AlterShardsRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (mockProxyComponent) TruncateCollection(ctx context.Context, request *milvuspb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (mockProxyComponent) TruncatePartition(ctx context.Context, request *milvuspb.TruncatePartitionRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (mockProxyComponent) AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (mockProxyComponent) DropField(ctx context.Context, request *milvuspb.DropFieldRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (mockProxyComponent) CreateSnapshot(ctx context.Context, request *milvuspb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (mockProxyComponent) DropSnapshot(ctx context.Context, request *milvuspb.DropSnapshotRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (mockProxyComponent) ListSnapshots(ctx context.Context, request *milvuspb.ListSnapshotsRequest) (*milvuspb.ListSnapshotsResponse, error) {
+	return &milvuspb.ListSnapshotsResponse{Status: testStatus}, nil
+}
+
 func (mockProxyComponent) CreateIndex(ctx context.Context, request *milvuspb.CreateIndexRequest) (*commonpb.Status, error) {
 	return testStatus, nil
 }
@@ -219,6 +251,14 @@ func (mockProxyComponent) GetCompactionStateWithPlans(ctx context.Context, reque
 	return &milvuspb.GetCompactionPlansResponse{Status: testStatus}, nil
 }
 
+func (mockProxyComponent) GetCompactionPolicyStats(ctx context.Context, request *milvuspb.GetCompactionPolicyStatsRequest) (*milvuspb.GetCompactionPolicyStatsResponse, error) {
+	return &milvuspb.GetCompactionPolicyStatsResponse{Status: testStatus}, nil
+}
+
+func (mockProxyComponent) CancelCompaction(ctx context.Context, request *milvuspb.CancelCompactionRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
 func (mockProxyComponent) ManualCompaction(ctx context.Context, request *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
 	return &milvuspb.ManualCompactionResponse{Status: testStatus}, nil
 }
@@ -355,6 +395,34 @@ func TestHandlers(t *testing.T) {
 			http.MethodGet, "/collections", emptyBody,
 			http.StatusOK, &milvuspb.ShowCollectionsResponse{Status: testStatus},
 		},
+		{
+			http.MethodPatch, "/collection/shards", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodPost, "/collection/truncate", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodPost, "/collection/field", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodDelete, "/collection/field", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodPost, "/collection/snapshot", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodDelete, "/collection/snapshot", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodGet, "/collection/snapshots", emptyBody,
+			http.StatusOK, &milvuspb.ListSnapshotsResponse{Status: testStatus},
+		},
 		{
 			http.MethodPost, "/partition", emptyBody,
 			http.StatusOK, testStatus,
@@ -363,6 +431,10 @@ func TestHandlers(t *testing.T) {
 			http.MethodDelete, "/partition", emptyBody,
 			http.StatusOK, testStatus,
 		},
+		{
+			http.MethodPost, "/partition/truncate", emptyBody,
+			http.StatusOK, testStatus,
+		},
 		{
 			http.MethodGet, "/partition/existence", emptyBody,
 			http.StatusOK, &milvuspb.BoolResponse{Status: testStatus},
@@ -474,10 +546,18 @@ func TestHandlers(t *testing.T) {
 			http.MethodGet, "/compaction/plans", emptyBody,
 			http.StatusOK, &milvuspb.GetCompactionPlansResponse{Status: testStatus},
 		},
+		{
+			http.MethodGet, "/compaction/policy-stats", emptyBody,
+			http.StatusOK, &milvuspb.GetCompactionPolicyStatsResponse{Status: testStatus},
+		},
 		{
 			http.MethodPost, "/compaction", emptyBody,
 			http.StatusOK, &milvuspb.ManualCompactionResponse{Status: testStatus},
 		},
+		{
+			http.MethodPost, "/compaction/cancel", emptyBody,
+			http.StatusOK, testStatus,
+		},
 		{
 			http.MethodPost, "/import", emptyBody,
 			http.StatusOK, &milvuspb.ImportResponse{Status: testStatus},
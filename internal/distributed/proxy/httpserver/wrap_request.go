@@ -264,6 +264,7 @@ type SearchRequest struct {
 	TravelTimestamp    uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
 	GuaranteeTimestamp uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
 	Nq                 int64                    `protobuf:"varint,12,opt,name=nq,proto3" json:"nq,omitempty"`
+	TravelTime         string                   `protobuf:"bytes,15,opt,name=travel_time,json=travelTime,proto3" json:"travel_time,omitempty"`
 }
 
 func binaryVector2Bytes(vectors [][]byte) []byte {
@@ -63,6 +63,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -93,8 +94,9 @@ type Server struct {
 	queryCoordClient types.QueryCoord
 	indexCoordClient types.IndexCoord
 
-	tracer opentracing.Tracer
-	closer io.Closer
+	tracer     opentracing.Tracer
+	closer     io.Closer
+	otelCloser io.Closer
 }
 
 // NewServer create a Proxy server.
@@ -126,6 +128,7 @@ func (s *Server) registerHTTPServer() {
 	}
 	ginHandler := gin.Default()
 	apiv1 := ginHandler.Group(apiPathPrefix)
+	apiv1.Use(httpserver.AuthMiddleware(proxy.AuthenticateHTTPRequest))
 	httpserver.NewHandlers(s.proxy).RegisterRoutesTo(apiv1)
 	http.Handle("/", ginHandler)
 }
@@ -169,12 +172,18 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			ot.UnaryServerInterceptor(opts...),
+			trace.OtelUnaryServerInterceptor(),
+			proxy.AuditInterceptor(),
 			grpc_auth.UnaryServerInterceptor(proxy.AuthenticationInterceptor),
 			proxy.UnaryServerInterceptor(proxy.PrivilegeInterceptor),
 		)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 			ot.StreamServerInterceptor(opts...),
-			grpc_auth.StreamServerInterceptor(proxy.AuthenticationInterceptor))),
+			trace.OtelStreamServerInterceptor(),
+			proxy.AuditStreamInterceptor(),
+			grpc_auth.StreamServerInterceptor(proxy.AuthenticationInterceptor),
+			proxy.StreamServerInterceptor(proxy.PrivilegeInterceptor),
+		)),
 	}
 
 	if Params.TLSMode == 1 {
@@ -252,18 +261,28 @@ func (s *Server) startInternalGrpc(grpcPort int, errChan chan error) {
 	}
 	log.Debug("Proxy internal server already listen on tcp", zap.Int("port", grpcPort))
 
+	creds, err := tlsutil.InternalServerCredentials(Params.InternalTLS)
+	if err != nil {
+		log.Warn("failed to load internal tls credentials", zap.Error(err))
+		errChan <- err
+		return
+	}
+
 	opts := trace.GetInterceptorOpts()
 	s.grpcInternalServer = grpc.NewServer(
+		grpc.Creds(creds),
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			ot.UnaryServerInterceptor(opts...),
+			trace.OtelUnaryServerInterceptor(),
 			grpc_auth.UnaryServerInterceptor(proxy.AuthenticationInterceptor),
 		)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 			ot.StreamServerInterceptor(opts...),
+			trace.OtelStreamServerInterceptor(),
 			grpc_auth.StreamServerInterceptor(proxy.AuthenticationInterceptor),
 		)),
 	)
@@ -320,6 +339,13 @@ func (s *Server) init() error {
 	serviceName := fmt.Sprintf("Proxy ip: %s, port: %d", Params.IP, Params.Port)
 	closer := trace.InitTracing(serviceName)
 	s.closer = closer
+
+	otelCloser, err := trace.InitOtelTracing(typeutil.ProxyRole, proxy.Params.CommonCfg.OtelConfig())
+	if err != nil {
+		log.Warn("Proxy init OpenTelemetry tracing failed", zap.Error(err))
+		return err
+	}
+	s.otelCloser = otelCloser
 	log.Debug("init Proxy's tracer done", zap.String("service name", serviceName))
 
 	etcdCli, err := etcd.GetEtcdClient(&proxy.Params.EtcdCfg)
@@ -507,6 +533,11 @@ func (s *Server) Stop() error {
 			return err
 		}
 	}
+	if s.otelCloser != nil {
+		if err := s.otelCloser.Close(); err != nil {
+			return err
+		}
+	}
 
 	if s.etcdCli != nil {
 		defer s.etcdCli.Close()
@@ -666,6 +697,16 @@ func (s *Server) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*
 	return s.proxy.Delete(ctx, request)
 }
 
+// DeleteByExpr submits a job deleting the entities matching the expression
+func (s *Server) DeleteByExpr(ctx context.Context, request *milvuspb.DeleteByExprRequest) (*milvuspb.DeleteByExprResponse, error) {
+	return s.proxy.DeleteByExpr(ctx, request)
+}
+
+// GetDeleteJobState returns the progress of the delete job
+func (s *Server) GetDeleteJobState(ctx context.Context, request *milvuspb.GetDeleteJobStateRequest) (*milvuspb.GetDeleteJobStateResponse, error) {
+	return s.proxy.GetDeleteJobState(ctx, request)
+}
+
 func (s *Server) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	return s.proxy.Search(ctx, request)
 }
@@ -678,6 +719,16 @@ func (s *Server) Query(ctx context.Context, request *milvuspb.QueryRequest) (*mi
 	return s.proxy.Query(ctx, request)
 }
 
+// SearchStream searches the vectors and sends the results in chunks
+func (s *Server) SearchStream(request *milvuspb.SearchRequest, stream milvuspb.MilvusService_SearchStreamServer) error {
+	return s.proxy.SearchStream(stream.Context(), request, stream.Send)
+}
+
+// QueryStream queries the entities and sends the results in chunks
+func (s *Server) QueryStream(request *milvuspb.QueryRequest, stream milvuspb.MilvusService_QueryStreamServer) error {
+	return s.proxy.QueryStream(stream.Context(), request, stream.Send)
+}
+
 func (s *Server) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	return s.proxy.CalcDistance(ctx, request)
 }
@@ -729,6 +780,46 @@ func (s *Server) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasReq
 	return s.proxy.AlterAlias(ctx, request)
 }
 
+// AlterShards increases the shards number of the specified collection.
+func (s *Server) AlterShards(ctx context.Context, request *milvuspb.AlterShardsRequest) (*commonpb.Status, error) {
+	return s.proxy.AlterShards(ctx, request)
+}
+
+// TruncateCollection removes all the entities of the specified collection.
+func (s *Server) TruncateCollection(ctx context.Context, request *milvuspb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.TruncateCollection(ctx, request)
+}
+
+// TruncatePartition removes all the entities of the specified partition.
+func (s *Server) TruncatePartition(ctx context.Context, request *milvuspb.TruncatePartitionRequest) (*commonpb.Status, error) {
+	return s.proxy.TruncatePartition(ctx, request)
+}
+
+// AddField adds a field to the specified collection.
+func (s *Server) AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	return s.proxy.AddField(ctx, request)
+}
+
+// DropField drops a field from the specified collection.
+func (s *Server) DropField(ctx context.Context, request *milvuspb.DropFieldRequest) (*commonpb.Status, error) {
+	return s.proxy.DropField(ctx, request)
+}
+
+// CreateSnapshot pins the current state of the specified collection as a named snapshot.
+func (s *Server) CreateSnapshot(ctx context.Context, request *milvuspb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateSnapshot(ctx, request)
+}
+
+// DropSnapshot drops a snapshot of the specified collection.
+func (s *Server) DropSnapshot(ctx context.Context, request *milvuspb.DropSnapshotRequest) (*commonpb.Status, error) {
+	return s.proxy.DropSnapshot(ctx, request)
+}
+
+// ListSnapshots lists the snapshots of the specified collection.
+func (s *Server) ListSnapshots(ctx context.Context, request *milvuspb.ListSnapshotsRequest) (*milvuspb.ListSnapshotsResponse, error) {
+	return s.proxy.ListSnapshots(ctx, request)
+}
+
 // GetCompactionState gets the state of a compaction
 func (s *Server) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
 	return s.proxy.GetCompactionState(ctx, req)
@@ -743,6 +834,16 @@ func (s *Server) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.
 	return s.proxy.GetCompactionStateWithPlans(ctx, req)
 }
 
+// GetCompactionPolicyStats gets the compaction policy statistics of segments of a collection
+func (s *Server) GetCompactionPolicyStats(ctx context.Context, req *milvuspb.GetCompactionPolicyStatsRequest) (*milvuspb.GetCompactionPolicyStatsResponse, error) {
+	return s.proxy.GetCompactionPolicyStats(ctx, req)
+}
+
+// CancelCompaction cancels a compaction
+func (s *Server) CancelCompaction(ctx context.Context, req *milvuspb.CancelCompactionRequest) (*commonpb.Status, error) {
+	return s.proxy.CancelCompaction(ctx, req)
+}
+
 // GetFlushState gets the flush state of multiple segments
 func (s *Server) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
 	return s.proxy.GetFlushState(ctx, req)
@@ -862,6 +963,18 @@ func (s *Server) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantReque
 	return s.proxy.SelectGrant(ctx, req)
 }
 
+func (s *Server) CreateRowPolicy(ctx context.Context, req *milvuspb.CreateRowPolicyRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateRowPolicy(ctx, req)
+}
+
+func (s *Server) DropRowPolicy(ctx context.Context, req *milvuspb.DropRowPolicyRequest) (*commonpb.Status, error) {
+	return s.proxy.DropRowPolicy(ctx, req)
+}
+
+func (s *Server) ListRowPolicies(ctx context.Context, req *milvuspb.ListRowPoliciesRequest) (*milvuspb.ListRowPoliciesResponse, error) {
+	return s.proxy.ListRowPolicies(ctx, req)
+}
+
 func (s *Server) RefreshPolicyInfoCache(ctx context.Context, req *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
 	return s.proxy.RefreshPolicyInfoCache(ctx, req)
 }
@@ -182,6 +182,26 @@ func (m *MockRootCoord) AlterAlias(ctx context.Context, req *milvuspb.AlterAlias
 	return nil, nil
 }
 
+func (m *MockRootCoord) AlterShards(ctx context.Context, req *milvuspb.AlterShardsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) TruncateCollection(ctx context.Context, req *milvuspb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) TruncatePartition(ctx context.Context, req *milvuspb.TruncatePartitionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) AddField(ctx context.Context, req *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) DropField(ctx context.Context, req *milvuspb.DropFieldRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockRootCoord) AllocTimestamp(ctx context.Context, req *rootcoordpb.AllocTimestampRequest) (*rootcoordpb.AllocTimestampResponse, error) {
 	return nil, nil
 }
@@ -290,6 +310,18 @@ func (m *MockRootCoord) ListPolicy(ctx context.Context, in *internalpb.ListPolic
 	return nil, nil
 }
 
+func (m *MockRootCoord) CreateRowPolicy(ctx context.Context, in *milvuspb.CreateRowPolicyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) DropRowPolicy(ctx context.Context, in *milvuspb.DropRowPolicyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListRowPolicies(ctx context.Context, in *milvuspb.ListRowPoliciesRequest) (*milvuspb.ListRowPoliciesResponse, error) {
+	return nil, nil
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type MockIndexCoord struct {
 	MockBase
@@ -432,6 +464,14 @@ func (m *MockQueryCoord) LoadBalance(ctx context.Context, req *querypb.LoadBalan
 	return nil, nil
 }
 
+func (m *MockQueryCoord) AlterShards(ctx context.Context, req *querypb.AlterShardsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockQueryCoord) TruncateSegments(ctx context.Context, req *querypb.TruncateSegmentsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockQueryCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, nil
 }
@@ -538,6 +578,14 @@ func (m *MockDataCoord) GetCompactionStateWithPlans(ctx context.Context, req *mi
 	return nil, nil
 }
 
+func (m *MockDataCoord) GetCompactionPolicyStats(ctx context.Context, req *milvuspb.GetCompactionPolicyStatsRequest) (*milvuspb.GetCompactionPolicyStatsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) CancelCompaction(ctx context.Context, req *milvuspb.CancelCompactionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockDataCoord) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error) {
 	return nil, nil
 }
@@ -570,6 +618,26 @@ func (m *MockDataCoord) ReleaseSegmentLock(ctx context.Context, req *datapb.Rele
 	return nil, nil
 }
 
+func (m *MockDataCoord) TruncateSegments(ctx context.Context, req *datapb.TruncateSegmentsRequest) (*datapb.TruncateSegmentsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) (*datapb.CreateSnapshotResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) ListSnapshots(ctx context.Context, req *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) CheckPrimaryKeys(ctx context.Context, req *datapb.CheckPrimaryKeysRequest) (*datapb.CheckPrimaryKeysResponse, error) {
+	return nil, nil
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type MockProxy struct {
 	MockBase
@@ -693,6 +761,14 @@ func (m *MockProxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 	return nil, nil
 }
 
+func (m *MockProxy) DeleteByExpr(ctx context.Context, request *milvuspb.DeleteByExprRequest) (*milvuspb.DeleteByExprResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetDeleteJobState(ctx context.Context, request *milvuspb.GetDeleteJobStateRequest) (*milvuspb.GetDeleteJobStateResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	return nil, nil
 }
@@ -705,6 +781,14 @@ func (m *MockProxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (
 	return nil, nil
 }
 
+func (m *MockProxy) SearchStream(ctx context.Context, request *milvuspb.SearchRequest, send func(*milvuspb.SearchResults) error) error {
+	return nil
+}
+
+func (m *MockProxy) QueryStream(ctx context.Context, request *milvuspb.QueryRequest, send func(*milvuspb.QueryResults) error) error {
+	return nil
+}
+
 func (m *MockProxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	return nil, nil
 }
@@ -749,6 +833,38 @@ func (m *MockProxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAlias
 	return nil, nil
 }
 
+func (m *MockProxy) AlterShards(ctx context.Context, request *milvuspb.AlterShardsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) TruncateCollection(ctx context.Context, request *milvuspb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) TruncatePartition(ctx context.Context, request *milvuspb.TruncatePartitionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DropField(ctx context.Context, request *milvuspb.DropFieldRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CreateSnapshot(ctx context.Context, request *milvuspb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DropSnapshot(ctx context.Context, request *milvuspb.DropSnapshotRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListSnapshots(ctx context.Context, request *milvuspb.ListSnapshotsRequest) (*milvuspb.ListSnapshotsResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) SetRootCoordClient(rootCoord types.RootCoord) {
 
 }
@@ -784,6 +900,14 @@ func (m *MockProxy) GetCompactionStateWithPlans(ctx context.Context, req *milvus
 	return nil, nil
 }
 
+func (m *MockProxy) GetCompactionPolicyStats(ctx context.Context, req *milvuspb.GetCompactionPolicyStatsRequest) (*milvuspb.GetCompactionPolicyStatsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CancelCompaction(ctx context.Context, req *milvuspb.CancelCompactionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
 	return nil, nil
 }
@@ -864,6 +988,18 @@ func (m *MockProxy) SelectGrant(ctx context.Context, in *milvuspb.SelectGrantReq
 	return nil, nil
 }
 
+func (m *MockProxy) CreateRowPolicy(ctx context.Context, req *milvuspb.CreateRowPolicyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DropRowPolicy(ctx context.Context, req *milvuspb.DropRowPolicyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListRowPolicies(ctx context.Context, req *milvuspb.ListRowPoliciesRequest) (*milvuspb.ListRowPoliciesResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) RefreshPolicyInfoCache(ctx context.Context, req *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -1133,6 +1269,16 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("DeleteByExpr", func(t *testing.T) {
+		_, err := server.DeleteByExpr(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetDeleteJobState", func(t *testing.T) {
+		_, err := server.GetDeleteJobState(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("Search", func(t *testing.T) {
 		_, err := server.Search(ctx, nil)
 		assert.Nil(t, err)
@@ -1203,6 +1349,46 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("AlterShards", func(t *testing.T) {
+		_, err := server.AlterShards(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("TruncateCollection", func(t *testing.T) {
+		_, err := server.TruncateCollection(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("TruncatePartition", func(t *testing.T) {
+		_, err := server.TruncatePartition(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("AddField", func(t *testing.T) {
+		_, err := server.AddField(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DropField", func(t *testing.T) {
+		_, err := server.DropField(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CreateSnapshot", func(t *testing.T) {
+		_, err := server.CreateSnapshot(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DropSnapshot", func(t *testing.T) {
+		_, err := server.DropSnapshot(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("ListSnapshots", func(t *testing.T) {
+		_, err := server.ListSnapshots(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("GetCompactionState", func(t *testing.T) {
 		_, err := server.GetCompactionState(ctx, nil)
 		assert.Nil(t, err)
@@ -1218,6 +1404,16 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("GetCompactionPolicyStats", func(t *testing.T) {
+		_, err := server.GetCompactionPolicyStats(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CancelCompaction", func(t *testing.T) {
+		_, err := server.CancelCompaction(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("CreateCredential", func(t *testing.T) {
 		_, err := server.CreateCredential(ctx, nil)
 		assert.Nil(t, err)
@@ -1283,6 +1479,21 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("CreateRowPolicy", func(t *testing.T) {
+		_, err := server.CreateRowPolicy(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DropRowPolicy", func(t *testing.T) {
+		_, err := server.DropRowPolicy(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("ListRowPolicies", func(t *testing.T) {
+		_, err := server.ListRowPolicies(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("RefreshPrivilegeInfoCache", func(t *testing.T) {
 		_, err := server.RefreshPolicyInfoCache(ctx, nil)
 		assert.Nil(t, err)
@@ -110,6 +110,12 @@ func Test_NewClient(t *testing.T) {
 
 		r18, err := client.GetShardLeaders(ctx, nil)
 		retCheck(retNotNil, r18, err)
+
+		r19, err := client.AlterShards(ctx, nil)
+		retCheck(retNotNil, r19, err)
+
+		r20, err := client.TruncateSegments(ctx, nil)
+		retCheck(retNotNil, r20, err)
 	}
 
 	client.grpcClient = &mock.GRPCClientBase{
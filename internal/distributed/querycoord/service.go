@@ -44,6 +44,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -69,7 +70,8 @@ type Server struct {
 	rootCoord  types.RootCoord
 	indexCoord types.IndexCoord
 
-	closer io.Closer
+	closer     io.Closer
+	otelCloser io.Closer
 }
 
 // NewServer create a new QueryCoord grpc server.
@@ -116,6 +118,13 @@ func (s *Server) init() error {
 	closer := trace.InitTracing("querycoord")
 	s.closer = closer
 
+	otelCloser, err := trace.InitOtelTracing(typeutil.QueryCoordRole, qc.Params.CommonCfg.OtelConfig())
+	if err != nil {
+		log.Warn("QueryCoord init OpenTelemetry tracing failed", zap.Error(err))
+		return err
+	}
+	s.otelCloser = otelCloser
+
 	etcdCli, err := etcd.GetEtcdClient(&Params.EtcdCfg)
 	if err != nil {
 		log.Debug("QueryCoord connect to etcd failed", zap.Error(err))
@@ -253,14 +262,24 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	ctx, cancel := context.WithCancel(s.loopCtx)
 	defer cancel()
 
+	creds, err := tlsutil.InternalServerCredentials(Params.InternalTLS)
+	if err != nil {
+		log.Warn("failed to load internal tls credentials", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+
 	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
+		grpc.Creds(creds),
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(ot.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)))
+		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)),
+		grpc.ChainUnaryInterceptor(trace.OtelUnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(trace.OtelStreamServerInterceptor()))
 	querypb.RegisterQueryCoordServer(s.grpcServer, s)
 
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
@@ -286,6 +305,11 @@ func (s *Server) Stop() error {
 			return err
 		}
 	}
+	if s.otelCloser != nil {
+		if err := s.otelCloser.Close(); err != nil {
+			return err
+		}
+	}
 	if s.etcdCli != nil {
 		defer s.etcdCli.Close()
 	}
@@ -381,6 +405,16 @@ func (s *Server) LoadBalance(ctx context.Context, req *querypb.LoadBalanceReques
 	return s.queryCoord.LoadBalance(ctx, req)
 }
 
+// AlterShards makes the query nodes serving the collection watch its newly added dm channels
+func (s *Server) AlterShards(ctx context.Context, req *querypb.AlterShardsRequest) (*commonpb.Status, error) {
+	return s.queryCoord.AlterShards(ctx, req)
+}
+
+// TruncateSegments releases the truncated segments from the query nodes
+func (s *Server) TruncateSegments(ctx context.Context, req *querypb.TruncateSegmentsRequest) (*commonpb.Status, error) {
+	return s.queryCoord.TruncateSegments(ctx, req)
+}
+
 // GetMetrics gets the metrics information of QueryCoord.
 func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.queryCoord.GetMetrics(ctx, req)
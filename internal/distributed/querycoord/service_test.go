@@ -135,6 +135,14 @@ func (m *MockQueryCoord) LoadBalance(ctx context.Context, req *querypb.LoadBalan
 	return m.status, m.err
 }
 
+func (m *MockQueryCoord) AlterShards(ctx context.Context, req *querypb.AlterShardsRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
+func (m *MockQueryCoord) TruncateSegments(ctx context.Context, req *querypb.TruncateSegmentsRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
 func (m *MockQueryCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return m.metricResp, m.err
 }
@@ -372,6 +380,20 @@ func Test_NewServer(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	t.Run("AlterShards", func(t *testing.T) {
+		req := &querypb.AlterShardsRequest{}
+		resp, err := server.AlterShards(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	t.Run("TruncateSegments", func(t *testing.T) {
+		req := &querypb.TruncateSegmentsRequest{}
+		resp, err := server.TruncateSegments(ctx, req)
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	t.Run("GetMetrics", func(t *testing.T) {
 		req := &milvuspb.GetMetricsRequest{
 			Request: "",
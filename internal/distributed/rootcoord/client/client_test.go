@@ -100,6 +100,10 @@ func Test_NewClient(t *testing.T) {
 			r, err := client.DropPartition(ctx, nil)
 			retCheck(retNotNil, r, err)
 		}
+		{
+			r, err := client.TruncatePartition(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
 		{
 			r, err := client.HasPartition(ctx, nil)
 			retCheck(retNotNil, r, err)
@@ -164,6 +168,22 @@ func Test_NewClient(t *testing.T) {
 			r, err := client.AlterAlias(ctx, nil)
 			retCheck(retNotNil, r, err)
 		}
+		{
+			r, err := client.AlterShards(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.TruncateCollection(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.AddField(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.DropField(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
 		{
 			r, err := client.Import(ctx, nil)
 			retCheck(retNotNil, r, err)
@@ -232,6 +252,18 @@ func Test_NewClient(t *testing.T) {
 			r, err := client.ListPolicy(ctx, nil)
 			retCheck(retNotNil, r, err)
 		}
+		{
+			r, err := client.CreateRowPolicy(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.DropRowPolicy(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.ListRowPolicies(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
 	}
 
 	client.grpcClient = &mock.GRPCClientBase{
@@ -318,6 +350,10 @@ func Test_NewClient(t *testing.T) {
 		rTimeout, err := client.DropPartition(shortCtx, nil)
 		retCheck(rTimeout, err)
 	}
+	{
+		rTimeout, err := client.TruncatePartition(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
 	{
 		rTimeout, err := client.HasPartition(shortCtx, nil)
 		retCheck(rTimeout, err)
@@ -382,6 +418,22 @@ func Test_NewClient(t *testing.T) {
 		rTimeout, err := client.AlterAlias(shortCtx, nil)
 		retCheck(rTimeout, err)
 	}
+	{
+		rTimeout, err := client.AlterShards(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.TruncateCollection(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.AddField(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.DropField(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
 	{
 		rTimeout, err := client.Import(shortCtx, nil)
 		retCheck(rTimeout, err)
@@ -454,6 +506,18 @@ func Test_NewClient(t *testing.T) {
 		rTimeout, err := client.ListPolicy(shortCtx, nil)
 		retCheck(rTimeout, err)
 	}
+	{
+		rTimeout, err := client.CreateRowPolicy(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.DropRowPolicy(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.ListRowPolicies(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
 
 	// clean up
 	err = client.Stop()
@@ -47,6 +47,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
@@ -77,7 +78,8 @@ type Server struct {
 	newDataCoordClient  func(string, *clientv3.Client) types.DataCoord
 	newQueryCoordClient func(string, *clientv3.Client) types.QueryCoord
 
-	closer io.Closer
+	closer     io.Closer
+	otelCloser io.Closer
 }
 
 // CreateAlias creates an alias for specified collection.
@@ -95,6 +97,26 @@ func (s *Server) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasReq
 	return s.rootCoord.AlterAlias(ctx, request)
 }
 
+// AlterShards increases the shards number of the specified collection.
+func (s *Server) AlterShards(ctx context.Context, request *milvuspb.AlterShardsRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AlterShards(ctx, request)
+}
+
+// TruncateCollection removes all the entities of the specified collection.
+func (s *Server) TruncateCollection(ctx context.Context, request *milvuspb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.TruncateCollection(ctx, request)
+}
+
+// AddField adds a field to the specified collection.
+func (s *Server) AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AddField(ctx, request)
+}
+
+// DropField drops a field from the specified collection.
+func (s *Server) DropField(ctx context.Context, request *milvuspb.DropFieldRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropField(ctx, request)
+}
+
 // NewServer create a new RootCoord grpc server.
 func NewServer(ctx context.Context, factory dependency.Factory) (*Server, error) {
 	ctx1, cancel := context.WithCancel(ctx)
@@ -161,6 +183,13 @@ func (s *Server) init() error {
 	closer := trace.InitTracing("root_coord")
 	s.closer = closer
 
+	otelCloser, err := trace.InitOtelTracing(typeutil.RootCoordRole, rootcoord.Params.CommonCfg.OtelConfig())
+	if err != nil {
+		log.Warn("RootCoord init OpenTelemetry tracing failed", zap.Error(err))
+		return err
+	}
+	s.otelCloser = otelCloser
+
 	etcdCli, err := etcd.GetEtcdClient(&Params.EtcdCfg)
 	if err != nil {
 		log.Debug("RootCoord connect to etcd failed", zap.Error(err))
@@ -252,14 +281,24 @@ func (s *Server) startGrpcLoop(port int) {
 	ctx, cancel := context.WithCancel(s.ctx)
 	defer cancel()
 
+	creds, err := tlsutil.InternalServerCredentials(Params.InternalTLS)
+	if err != nil {
+		log.Warn("failed to load internal tls credentials", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+
 	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
+		grpc.Creds(creds),
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(ot.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)))
+		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)),
+		grpc.ChainUnaryInterceptor(trace.OtelUnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(trace.OtelStreamServerInterceptor()))
 	rootcoordpb.RegisterRootCoordServer(s.grpcServer, s)
 
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
@@ -289,6 +328,11 @@ func (s *Server) Stop() error {
 			log.Error("Failed to close opentracing", zap.Error(err))
 		}
 	}
+	if s.otelCloser != nil {
+		if err := s.otelCloser.Close(); err != nil {
+			log.Error("Failed to close OpenTelemetry tracing", zap.Error(err))
+		}
+	}
 	if s.etcdCli != nil {
 		defer s.etcdCli.Close()
 	}
@@ -372,6 +416,11 @@ func (s *Server) DropPartition(ctx context.Context, in *milvuspb.DropPartitionRe
 	return s.rootCoord.DropPartition(ctx, in)
 }
 
+// TruncatePartition removes all the entities of the specified partition.
+func (s *Server) TruncatePartition(ctx context.Context, in *milvuspb.TruncatePartitionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.TruncatePartition(ctx, in)
+}
+
 // HasPartition checks whether a partition is created.
 func (s *Server) HasPartition(ctx context.Context, in *milvuspb.HasPartitionRequest) (*milvuspb.BoolResponse, error) {
 	return s.rootCoord.HasPartition(ctx, in)
@@ -521,3 +570,15 @@ func (s *Server) SelectGrant(ctx context.Context, request *milvuspb.SelectGrantR
 func (s *Server) ListPolicy(ctx context.Context, request *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
 	return s.rootCoord.ListPolicy(ctx, request)
 }
+
+func (s *Server) CreateRowPolicy(ctx context.Context, request *milvuspb.CreateRowPolicyRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CreateRowPolicy(ctx, request)
+}
+
+func (s *Server) DropRowPolicy(ctx context.Context, request *milvuspb.DropRowPolicyRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropRowPolicy(ctx, request)
+}
+
+func (s *Server) ListRowPolicies(ctx context.Context, request *milvuspb.ListRowPoliciesRequest) (*milvuspb.ListRowPoliciesResponse, error) {
+	return s.rootCoord.ListRowPolicies(ctx, request)
+}
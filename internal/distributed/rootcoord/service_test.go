@@ -181,6 +181,14 @@ func TestGrpcService(t *testing.T) {
 		return nil
 	}
 
+	core.CallTruncateSegmentsService = func(ctx context.Context, ts typeutil.Timestamp, collID, partID typeutil.UniqueID, finished bool) (*datapb.TruncateSegmentsResponse, error) {
+		return &datapb.TruncateSegmentsResponse{}, nil
+	}
+
+	core.CallDropSnapshotsService = func(ctx context.Context, collID typeutil.UniqueID) error {
+		return nil
+	}
+
 	var segs []typeutil.UniqueID
 	segLock := sync.Mutex{}
 	core.CallGetFlushedSegmentsService = func(ctx context.Context, collID, partID typeutil.UniqueID) ([]typeutil.UniqueID, error) {
@@ -231,6 +239,12 @@ func TestGrpcService(t *testing.T) {
 	core.CallReleasePartitionService = func(ctx context.Context, ts typeutil.Timestamp, dbID, collectionID typeutil.UniqueID, partitionIDs []typeutil.UniqueID) error {
 		return nil
 	}
+	core.CallAlterShardsService = func(ctx context.Context, ts typeutil.Timestamp, collectionID typeutil.UniqueID, channelNames []string) error {
+		return nil
+	}
+	core.CallTruncateQuerySegmentsService = func(ctx context.Context, ts typeutil.Timestamp, collectionID typeutil.UniqueID, segmentIDs []typeutil.UniqueID) error {
+		return nil
+	}
 	core.CallImportService = func(ctx context.Context, req *datapb.ImportTaskRequest) *datapb.ImportTaskResponse {
 		return nil
 	}
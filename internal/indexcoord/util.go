@@ -26,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // getDimension gets the dimension of data from building index request.
@@ -57,6 +58,10 @@ func estimateIndexSize(dim int64, numRows int64, dataType schemapb.DataType) (ui
 		return uint64(dim) / 8 * uint64(numRows), nil
 	}
 
+	if dataType == schemapb.DataType_Float16Vector || dataType == schemapb.DataType_BFloat16Vector {
+		return uint64(dim) * uint64(numRows) * 2, nil
+	}
+
 	// TODO: optimize here.
 	return 0, nil
 
@@ -71,9 +76,15 @@ func estimateScalarIndexSize(req *indexpb.BuildIndexRequest) (uint64, error) {
 }
 
 func estimateIndexSizeByReq(req *indexpb.BuildIndexRequest) (uint64, error) {
+	if req.GetFieldSchema().GetDataType() == schemapb.DataType_SparseFloatVector {
+		// sparse float vector has no fixed dimension, estimate by the average size of a row
+		return uint64(req.GetNumRows()) * typeutil.SparseFloatVectorEstimatedElements * typeutil.SparseFloatRowElementSize, nil
+	}
 	vecDTypes := []schemapb.DataType{
 		schemapb.DataType_FloatVector,
 		schemapb.DataType_BinaryVector,
+		schemapb.DataType_Float16Vector,
+		schemapb.DataType_BFloat16Vector,
 	}
 	if funcutil.SliceContain(vecDTypes, req.GetFieldSchema().GetDataType()) {
 		dim, err := getDimension(req)
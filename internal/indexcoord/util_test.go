@@ -78,6 +78,10 @@ func Test_estimateIndexSize(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, uint64(200), memorySize)
 
+	memorySize, err = estimateIndexSize(10, 100, schemapb.DataType_Float16Vector)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2000), memorySize)
+
 	memorySize, err = estimateIndexSize(10, 100, schemapb.DataType_Float)
 	assert.Nil(t, err)
 	assert.Equal(t, uint64(0), memorySize)
@@ -16,6 +16,7 @@ type Catalog interface {
 	ListCollections(ctx context.Context, ts typeutil.Timestamp) (map[string]*model.Collection, error)
 	CollectionExists(ctx context.Context, collectionID typeutil.UniqueID, ts typeutil.Timestamp) bool
 	DropCollection(ctx context.Context, collectionInfo *model.Collection, ts typeutil.Timestamp) error
+	AlterCollection(ctx context.Context, collectionInfo *model.Collection, ts typeutil.Timestamp) error
 
 	CreatePartition(ctx context.Context, coll *model.Collection, ts typeutil.Timestamp) error
 	DropPartition(ctx context.Context, collectionInfo *model.Collection, partitionID typeutil.UniqueID, ts typeutil.Timestamp) error
@@ -45,6 +46,9 @@ type Catalog interface {
 	SelectGrant(ctx context.Context, tenant string, entity *milvuspb.GrantEntity) ([]*milvuspb.GrantEntity, error)
 	ListPolicy(ctx context.Context, tenant string) ([]string, error)
 	ListUserRole(ctx context.Context, tenant string) ([]string, error)
+	SaveRowPolicy(ctx context.Context, tenant string, entity *milvuspb.RowPolicyEntity) error
+	DropRowPolicy(ctx context.Context, tenant string, roleName string, collectionName string, policyName string) error
+	ListRowPolicies(ctx context.Context, tenant string, roleName string, collectionName string) ([]*milvuspb.RowPolicyEntity, error)
 
 	Close()
 }
@@ -57,6 +57,24 @@ func (kc *Catalog) CreateCollection(ctx context.Context, coll *model.Collection,
 	return nil
 }
 
+func (kc *Catalog) AlterCollection(ctx context.Context, coll *model.Collection, ts typeutil.Timestamp) error {
+	k := fmt.Sprintf("%s/%d", CollectionMetaPrefix, coll.CollectionID)
+	collInfo := model.MarshalCollectionModel(coll)
+	v, err := proto.Marshal(collInfo)
+	if err != nil {
+		log.Error("alter collection marshal fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+
+	err = kc.Snapshot.Save(k, string(v), ts)
+	if err != nil {
+		log.Error("alter collection persist meta fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
 func (kc *Catalog) CreatePartition(ctx context.Context, coll *model.Collection, ts typeutil.Timestamp) error {
 	k1 := fmt.Sprintf("%s/%d", CollectionMetaPrefix, coll.CollectionID)
 	collInfo := model.MarshalCollectionModel(coll)
@@ -944,6 +962,58 @@ func (kc *Catalog) ListUserRole(ctx context.Context, tenant string) ([]string, e
 	return userRoles, nil
 }
 
+func (kc *Catalog) SaveRowPolicy(ctx context.Context, tenant string, entity *milvuspb.RowPolicyEntity) error {
+	k := funcutil.HandleTenantForEtcdKey(RowPolicyPrefix, tenant, fmt.Sprintf("%s/%s/%s", entity.Role.Name, entity.CollectionName, entity.PolicyName))
+	v, err := proto.Marshal(entity)
+	if err != nil {
+		log.Error("fail to marshal the row policy entity", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("fail to marshal row policy, key:%s, err:%w", k, err)
+	}
+	if err = kc.Txn.Save(k, string(v)); err != nil {
+		log.Error("fail to save the row policy", zap.String("key", k), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) DropRowPolicy(ctx context.Context, tenant string, roleName string, collectionName string, policyName string) error {
+	k := funcutil.HandleTenantForEtcdKey(RowPolicyPrefix, tenant, fmt.Sprintf("%s/%s/%s", roleName, collectionName, policyName))
+	if _, err := kc.Txn.Load(k); err != nil {
+		log.Error("fail to load the row policy", zap.String("key", k), zap.Error(err))
+		return err
+	}
+	if err := kc.Txn.Remove(k); err != nil {
+		log.Error("fail to remove the row policy", zap.String("key", k), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) ListRowPolicies(ctx context.Context, tenant string, roleName string, collectionName string) ([]*milvuspb.RowPolicyEntity, error) {
+	var entities []*milvuspb.RowPolicyEntity
+	k := funcutil.HandleTenantForEtcdKey(RowPolicyPrefix, tenant, roleName)
+	if !funcutil.IsEmptyString(roleName) && !funcutil.IsEmptyString(collectionName) {
+		k = funcutil.HandleTenantForEtcdKey(RowPolicyPrefix, tenant, fmt.Sprintf("%s/%s", roleName, collectionName))
+	}
+	_, values, err := kc.Txn.LoadWithPrefix(k + "/")
+	if err != nil {
+		log.Error("fail to load row policies", zap.String("key", k), zap.Error(err))
+		return entities, err
+	}
+	for _, v := range values {
+		entity := &milvuspb.RowPolicyEntity{}
+		if err = proto.Unmarshal([]byte(v), entity); err != nil {
+			log.Warn("fail to unmarshal the row policy entity", zap.String("key", k), zap.Error(err))
+			continue
+		}
+		if !funcutil.IsEmptyString(collectionName) && entity.CollectionName != collectionName {
+			continue
+		}
+		entities = append(entities, entity)
+	}
+	return entities, nil
+}
+
 func (kc *Catalog) Close() {
 	// do nothing
 }
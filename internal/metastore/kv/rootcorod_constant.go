@@ -34,4 +34,7 @@ const (
 
 	// GranteePrefix prefix for mapping among user or role, resource type, resource name
 	GranteePrefix = ComponentPrefix + CommonCredentialPrefix + "/grantee-privileges"
+
+	// RowPolicyPrefix prefix for row policies of role and collection
+	RowPolicyPrefix = ComponentPrefix + CommonCredentialPrefix + "/row-policies"
 )
@@ -19,10 +19,14 @@ type Collection struct {
 	VirtualChannelNames  []string
 	PhysicalChannelNames []string
 	ShardsNum            int32
+	ShardsNumHistory     []int32
+	SchemaVersion        int32
+	MaxFieldID           int64
 	StartPositions       []*commonpb.KeyDataPair
 	CreateTime           uint64
 	ConsistencyLevel     commonpb.ConsistencyLevel
 	Aliases              []string
+	Properties           []*commonpb.KeyValuePair
 	Extra                map[string]string // extra kvs
 }
 
@@ -39,10 +43,14 @@ func (c Collection) Clone() *Collection {
 		VirtualChannelNames:  c.VirtualChannelNames,
 		PhysicalChannelNames: c.PhysicalChannelNames,
 		ShardsNum:            c.ShardsNum,
+		ShardsNumHistory:     c.ShardsNumHistory,
+		SchemaVersion:        c.SchemaVersion,
+		MaxFieldID:           c.MaxFieldID,
 		ConsistencyLevel:     c.ConsistencyLevel,
 		CreateTime:           c.CreateTime,
 		StartPositions:       c.StartPositions,
 		Aliases:              c.Aliases,
+		Properties:           c.Properties,
 		Extra:                c.Extra,
 	}
 }
@@ -93,9 +101,13 @@ func UnmarshalCollectionModel(coll *pb.CollectionInfo) *Collection {
 		VirtualChannelNames:  coll.VirtualChannelNames,
 		PhysicalChannelNames: coll.PhysicalChannelNames,
 		ShardsNum:            coll.ShardsNum,
+		ShardsNumHistory:     coll.ShardsNumHistory,
+		SchemaVersion:        coll.Schema.Version,
+		MaxFieldID:           coll.MaxFieldId,
 		ConsistencyLevel:     coll.ConsistencyLevel,
 		CreateTime:           coll.CreateTime,
 		StartPositions:       coll.StartPositions,
+		Properties:           coll.Properties,
 	}
 }
 
@@ -107,14 +119,17 @@ func MarshalCollectionModel(coll *Collection) *pb.CollectionInfo {
 	fields := make([]*schemapb.FieldSchema, len(coll.Fields))
 	for idx, field := range coll.Fields {
 		fields[idx] = &schemapb.FieldSchema{
-			FieldID:      field.FieldID,
-			Name:         field.Name,
-			IsPrimaryKey: field.IsPrimaryKey,
-			Description:  field.Description,
-			DataType:     field.DataType,
-			TypeParams:   field.TypeParams,
-			IndexParams:  field.IndexParams,
-			AutoID:       field.AutoID,
+			FieldID:         field.FieldID,
+			Name:            field.Name,
+			IsPrimaryKey:    field.IsPrimaryKey,
+			Description:     field.Description,
+			DataType:        field.DataType,
+			TypeParams:      field.TypeParams,
+			IndexParams:     field.IndexParams,
+			AutoID:          field.AutoID,
+			IsClusteringKey: field.IsClusteringKey,
+			Nullable:        field.Nullable,
+			DefaultValue:    field.DefaultValue,
 		}
 	}
 	collSchema := &schemapb.CollectionSchema{
@@ -122,6 +137,7 @@ func MarshalCollectionModel(coll *Collection) *pb.CollectionInfo {
 		Description: coll.Description,
 		AutoID:      coll.AutoID,
 		Fields:      fields,
+		Version:     coll.SchemaVersion,
 	}
 
 	partitions := make([]*pb.PartitionInfo, len(coll.Partitions))
@@ -149,7 +165,10 @@ func MarshalCollectionModel(coll *Collection) *pb.CollectionInfo {
 		VirtualChannelNames:  coll.VirtualChannelNames,
 		PhysicalChannelNames: coll.PhysicalChannelNames,
 		ShardsNum:            coll.ShardsNum,
+		ShardsNumHistory:     coll.ShardsNumHistory,
+		MaxFieldId:           coll.MaxFieldID,
 		ConsistencyLevel:     coll.ConsistencyLevel,
 		StartPositions:       coll.StartPositions,
+		Properties:           coll.Properties,
 	}
 }
@@ -28,6 +28,12 @@ var (
 			Value: "field110-v1",
 		},
 	}
+	properties = []*commonpb.KeyValuePair{
+		{
+			Key:   "compaction.delete.ratio",
+			Value: "0.1",
+		},
+	}
 	startPositions = []*commonpb.KeyDataPair{
 		{
 			Key:  "k1",
@@ -54,6 +60,7 @@ var (
 		CreateTime:           1,
 		StartPositions:       startPositions,
 		ConsistencyLevel:     commonpb.ConsistencyLevel_Strong,
+		Properties:           properties,
 		Partitions: []*Partition{
 			{
 				PartitionID:               partID,
@@ -86,6 +93,7 @@ var (
 		ShardsNum:            1,
 		StartPositions:       startPositions,
 		ConsistencyLevel:     commonpb.ConsistencyLevel_Strong,
+		Properties:           properties,
 	}
 
 	newColPb = &pb.CollectionInfo{
@@ -115,6 +123,7 @@ var (
 		ShardsNum:            1,
 		StartPositions:       startPositions,
 		ConsistencyLevel:     commonpb.ConsistencyLevel_Strong,
+		Properties:           properties,
 	}
 )
 
@@ -136,3 +145,26 @@ func TestMarshalCollectionModel(t *testing.T) {
 
 	assert.Nil(t, MarshalCollectionModel(nil))
 }
+
+func TestCollectionModel_SchemaVersion(t *testing.T) {
+	coll := colModel.Clone()
+	coll.SchemaVersion = 2
+	coll.MaxFieldID = fieldID + 1
+	coll.Fields = []*Field{fieldModel, {
+		FieldID:      fieldID + 1,
+		Name:         "added",
+		DataType:     schemapb.DataType_Int64,
+		Nullable:     true,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 10}},
+	}}
+
+	collPb := MarshalCollectionModel(coll)
+	assert.Equal(t, int32(2), collPb.GetSchema().GetVersion())
+	assert.Equal(t, fieldID+1, collPb.GetMaxFieldId())
+	assert.True(t, collPb.GetSchema().GetFields()[1].GetNullable())
+	assert.Equal(t, int64(10), collPb.GetSchema().GetFields()[1].GetDefaultValue().GetLongData())
+
+	ret := UnmarshalCollectionModel(collPb)
+	ret.TenantID = tenantID
+	assert.Equal(t, coll, ret)
+}
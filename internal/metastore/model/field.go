@@ -6,14 +6,17 @@ import (
 )
 
 type Field struct {
-	FieldID      int64
-	Name         string
-	IsPrimaryKey bool
-	Description  string
-	DataType     schemapb.DataType
-	TypeParams   []*commonpb.KeyValuePair
-	IndexParams  []*commonpb.KeyValuePair
-	AutoID       bool
+	FieldID         int64
+	Name            string
+	IsPrimaryKey    bool
+	Description     string
+	DataType        schemapb.DataType
+	TypeParams      []*commonpb.KeyValuePair
+	IndexParams     []*commonpb.KeyValuePair
+	AutoID          bool
+	IsClusteringKey bool
+	Nullable        bool
+	DefaultValue    *schemapb.ValueField
 }
 
 func MarshalFieldModel(field *Field) *schemapb.FieldSchema {
@@ -22,14 +25,17 @@ func MarshalFieldModel(field *Field) *schemapb.FieldSchema {
 	}
 
 	return &schemapb.FieldSchema{
-		FieldID:      field.FieldID,
-		Name:         field.Name,
-		IsPrimaryKey: field.IsPrimaryKey,
-		Description:  field.Description,
-		DataType:     field.DataType,
-		TypeParams:   field.TypeParams,
-		IndexParams:  field.IndexParams,
-		AutoID:       field.AutoID,
+		FieldID:         field.FieldID,
+		Name:            field.Name,
+		IsPrimaryKey:    field.IsPrimaryKey,
+		Description:     field.Description,
+		DataType:        field.DataType,
+		TypeParams:      field.TypeParams,
+		IndexParams:     field.IndexParams,
+		AutoID:          field.AutoID,
+		IsClusteringKey: field.IsClusteringKey,
+		Nullable:        field.Nullable,
+		DefaultValue:    field.DefaultValue,
 	}
 }
 
@@ -51,14 +57,17 @@ func UnmarshalFieldModel(fieldSchema *schemapb.FieldSchema) *Field {
 	}
 
 	return &Field{
-		FieldID:      fieldSchema.FieldID,
-		Name:         fieldSchema.Name,
-		IsPrimaryKey: fieldSchema.IsPrimaryKey,
-		Description:  fieldSchema.Description,
-		DataType:     fieldSchema.DataType,
-		TypeParams:   fieldSchema.TypeParams,
-		IndexParams:  fieldSchema.IndexParams,
-		AutoID:       fieldSchema.AutoID,
+		FieldID:         fieldSchema.FieldID,
+		Name:            fieldSchema.Name,
+		IsPrimaryKey:    fieldSchema.IsPrimaryKey,
+		Description:     fieldSchema.Description,
+		DataType:        fieldSchema.DataType,
+		TypeParams:      fieldSchema.TypeParams,
+		IndexParams:     fieldSchema.IndexParams,
+		AutoID:          fieldSchema.AutoID,
+		IsClusteringKey: fieldSchema.IsClusteringKey,
+		Nullable:        fieldSchema.Nullable,
+		DefaultValue:    fieldSchema.DefaultValue,
 	}
 }
 
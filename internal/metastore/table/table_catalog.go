@@ -34,6 +34,10 @@ func (tc *Catalog) DropCollection(ctx context.Context, collectionInfo *model.Col
 	return nil
 }
 
+func (tc *Catalog) AlterCollection(ctx context.Context, collectionInfo *model.Collection, ts typeutil.Timestamp) error {
+	return nil
+}
+
 func (tc *Catalog) CreatePartition(ctx context.Context, coll *model.Collection, ts typeutil.Timestamp) error {
 	return nil
 }
@@ -0,0 +1,63 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	cdcSubsystem = "cdc"
+
+	cdcTaskLabelName = "cdc_task"
+)
+
+var (
+	// CDCReplicationLag records how far the target falls behind the source on each vchannel, in milliseconds.
+	CDCReplicationLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: cdcSubsystem,
+			Name:      "replication_lag",
+			Help:      "time between now and the latest source time tick replayed into the target, in milliseconds",
+		}, []string{cdcTaskLabelName, channelNameLabelName})
+
+	// CDCReplicatedEventCounter records the number of the change events replayed into the target.
+	CDCReplicatedEventCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: cdcSubsystem,
+			Name:      "replicated_event_count",
+			Help:      "number of change events replayed into the target",
+		}, []string{cdcTaskLabelName, msgTypeLabelName})
+
+	// CDCReplicationFailureCounter records the times the replication fails and restarts from the checkpoints.
+	CDCReplicationFailureCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: cdcSubsystem,
+			Name:      "replication_failure_count",
+			Help:      "number of times the replication fails and restarts from the checkpoints",
+		}, []string{cdcTaskLabelName})
+)
+
+// RegisterCDC registers CDC metrics
+func RegisterCDC(registry *prometheus.Registry) {
+	registry.MustRegister(CDCReplicationLag)
+	registry.MustRegister(CDCReplicatedEventCounter)
+	registry.MustRegister(CDCReplicationFailureCounter)
+}
@@ -75,6 +75,7 @@ const (
 	rolenameLabelName        = "role_name"
 	cacheNameLabelName       = "cache_name"
 	cacheStateLabelName      = "cache_state"
+	scheduleGroupLabelName   = "schedule_group"
 )
 
 var (
@@ -231,6 +231,24 @@ var (
 			Name:      "send_bytes_count",
 			Help:      "count of bytes sent back to sdk",
 		}, []string{nodeIDLabelName})
+
+	// ProxyRejectedTaskCount record the tasks rejected by Proxy as the task queues are full
+	ProxyRejectedTaskCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "rejected_task_count",
+			Help:      "count of tasks rejected as the task queues are full",
+		}, []string{nodeIDLabelName, functionLabelName})
+
+	// ProxyDroppedTaskCount record the tasks dropped by Proxy as their contexts are done before execution
+	ProxyDroppedTaskCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "dropped_task_count",
+			Help:      "count of tasks dropped as their deadlines passed before execution",
+		}, []string{nodeIDLabelName, functionLabelName})
 )
 
 //RegisterProxy registers Proxy metrics
@@ -263,4 +281,6 @@ func RegisterProxy(registry *prometheus.Registry) {
 	registry.MustRegister(ProxyDQLReqLatency)
 	registry.MustRegister(ProxyMutationReceiveBytes)
 	registry.MustRegister(ProxyReadReqSendBytes)
+	registry.MustRegister(ProxyRejectedTaskCount)
+	registry.MustRegister(ProxyDroppedTaskCount)
 }
@@ -261,6 +261,29 @@ var (
 			nodeIDLabelName,
 		})
 
+	QueryNodeReadTaskGroupReadyLen = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "read_task_group_ready_len",
+			Help:      "number of ready read tasks in readyQueue of each schedule group",
+		}, []string{
+			nodeIDLabelName,
+			scheduleGroupLabelName,
+		})
+
+	QueryNodeReadTaskGroupWaitLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "read_task_group_wait_latency",
+			Help:      "latency of ready read tasks waiting to be scheduled of each schedule group",
+			Buckets:   buckets,
+		}, []string{
+			nodeIDLabelName,
+			scheduleGroupLabelName,
+		})
+
 	QueryNodeEvictedReadReqCount = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: milvusNamespace,
@@ -315,6 +338,8 @@ func RegisterQueryNode(registry *prometheus.Registry) {
 	registry.MustRegister(QueryNodeSearchNQ)
 	registry.MustRegister(QueryNodeSearchGroupSize)
 	registry.MustRegister(QueryNodeEvictedReadReqCount)
+	registry.MustRegister(QueryNodeReadTaskGroupReadyLen)
+	registry.MustRegister(QueryNodeReadTaskGroupWaitLatency)
 	registry.MustRegister(QueryNodeSearchGroupTopK)
 	registry.MustRegister(QueryNodeSearchTopK)
 	registry.MustRegister(QueryNodeNumFlowGraphs)
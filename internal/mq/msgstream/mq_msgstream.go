@@ -278,6 +278,7 @@ func (ms *mqMsgStream) Produce(msgPack *MsgPack) error {
 			msg := &mqwrapper.ProducerMessage{Payload: m, Properties: map[string]string{}}
 
 			trace.InjectContextToPulsarMsgProperties(sp.Context(), msg.Properties)
+			injectOtelContext(spanCtx, v.Msgs[i], msg.Properties)
 
 			ms.producerLock.Lock()
 			if _, err := ms.producers[channel].Send(
@@ -344,6 +345,7 @@ func (ms *mqMsgStream) ProduceMark(msgPack *MsgPack) (map[string][]MessageID, er
 			msg := &mqwrapper.ProducerMessage{Payload: m, Properties: map[string]string{}}
 
 			trace.InjectContextToPulsarMsgProperties(sp.Context(), msg.Properties)
+			injectOtelContext(spanCtx, tsMsg, msg.Properties)
 
 			ms.producerLock.Lock()
 			id, err := ms.producers[channel].Send(
@@ -387,6 +389,7 @@ func (ms *mqMsgStream) Broadcast(msgPack *MsgPack) error {
 		msg := &mqwrapper.ProducerMessage{Payload: m, Properties: map[string]string{}}
 
 		trace.InjectContextToPulsarMsgProperties(sp.Context(), msg.Properties)
+		injectOtelContext(spanCtx, v, msg.Properties)
 
 		ms.producerLock.Lock()
 		for _, producer := range ms.producers {
@@ -429,6 +432,7 @@ func (ms *mqMsgStream) BroadcastMark(msgPack *MsgPack) (map[string][]MessageID,
 		msg := &mqwrapper.ProducerMessage{Payload: m, Properties: map[string]string{}}
 
 		trace.InjectContextToPulsarMsgProperties(sp.Context(), msg.Properties)
+		injectOtelContext(spanCtx, v, msg.Properties)
 
 		ms.producerLock.Lock()
 		for channel, producer := range ms.producers {
@@ -506,9 +510,13 @@ func (ms *mqMsgStream) receiveMsg(consumer mqwrapper.Consumer) {
 				Timestamp:   tsMsg.BeginTs(),
 			})
 
+			var traceCtx context.Context
 			sp, ok := ExtractFromPulsarMsgProperties(tsMsg, msg.Properties())
 			if ok {
-				tsMsg.SetTraceCtx(opentracing.ContextWithSpan(context.Background(), sp))
+				traceCtx = opentracing.ContextWithSpan(context.Background(), sp)
+			}
+			if traceCtx = extractOtelContext(traceCtx, tsMsg, msg.Properties()); traceCtx != nil {
+				tsMsg.SetTraceCtx(traceCtx)
 			}
 
 			msgPack := MsgPack{
@@ -813,9 +821,13 @@ func (ms *MqTtMsgStream) consumeToTtMsg(consumer mqwrapper.Consumer) {
 				continue
 			}
 
+			var traceCtx context.Context
 			sp, ok := ExtractFromPulsarMsgProperties(tsMsg, msg.Properties())
 			if ok {
-				tsMsg.SetTraceCtx(opentracing.ContextWithSpan(context.Background(), sp))
+				traceCtx = opentracing.ContextWithSpan(context.Background(), sp)
+			}
+			if traceCtx = extractOtelContext(traceCtx, tsMsg, msg.Properties()); traceCtx != nil {
+				tsMsg.SetTraceCtx(traceCtx)
 			}
 
 			ms.chanMsgBufMutex.Lock()
@@ -943,6 +943,116 @@ func (dp *DropPartitionMsg) Unmarshal(input MarshalType) (TsMsg, error) {
 	return dropPartitionMsg, nil
 }
 
+/////////////////////////////////////////Truncate//////////////////////////////////////////
+
+// TruncateMsg is a message pack that contains truncate collection or partition request
+type TruncateMsg struct {
+	BaseMsg
+	internalpb.TruncateRequest
+}
+
+// interface implementation validation
+var _ TsMsg = &TruncateMsg{}
+
+// ID returns the ID of this message pack
+func (tm *TruncateMsg) ID() UniqueID {
+	return tm.Base.MsgID
+}
+
+// Type returns the type of this message pack
+func (tm *TruncateMsg) Type() MsgType {
+	return tm.Base.MsgType
+}
+
+// SourceID indicates which component generated this message
+func (tm *TruncateMsg) SourceID() int64 {
+	return tm.Base.SourceID
+}
+
+// Marshal is used to serializing a message pack to byte array
+func (tm *TruncateMsg) Marshal(input TsMsg) (MarshalType, error) {
+	truncateMsg := input.(*TruncateMsg)
+	truncateRequest := &truncateMsg.TruncateRequest
+	mb, err := proto.Marshal(truncateRequest)
+	if err != nil {
+		return nil, err
+	}
+	return mb, nil
+}
+
+// Unmarshal is used to deserializing a message pack from byte array
+func (tm *TruncateMsg) Unmarshal(input MarshalType) (TsMsg, error) {
+	truncateRequest := internalpb.TruncateRequest{}
+	in, err := convertToByteArray(input)
+	if err != nil {
+		return nil, err
+	}
+	err = proto.Unmarshal(in, &truncateRequest)
+	if err != nil {
+		return nil, err
+	}
+	truncateMsg := &TruncateMsg{TruncateRequest: truncateRequest}
+	truncateMsg.BeginTimestamp = truncateMsg.Base.Timestamp
+	truncateMsg.EndTimestamp = truncateMsg.Base.Timestamp
+
+	return truncateMsg, nil
+}
+
+/////////////////////////////////////////AlterSchema//////////////////////////////////////////
+
+// AlterSchemaMsg is a message pack that contains add field or drop field request
+type AlterSchemaMsg struct {
+	BaseMsg
+	internalpb.AlterSchemaRequest
+}
+
+// interface implementation validation
+var _ TsMsg = &AlterSchemaMsg{}
+
+// ID returns the ID of this message pack
+func (am *AlterSchemaMsg) ID() UniqueID {
+	return am.Base.MsgID
+}
+
+// Type returns the type of this message pack
+func (am *AlterSchemaMsg) Type() MsgType {
+	return am.Base.MsgType
+}
+
+// SourceID indicates which component generated this message
+func (am *AlterSchemaMsg) SourceID() int64 {
+	return am.Base.SourceID
+}
+
+// Marshal is used to serializing a message pack to byte array
+func (am *AlterSchemaMsg) Marshal(input TsMsg) (MarshalType, error) {
+	alterSchemaMsg := input.(*AlterSchemaMsg)
+	alterSchemaRequest := &alterSchemaMsg.AlterSchemaRequest
+	mb, err := proto.Marshal(alterSchemaRequest)
+	if err != nil {
+		return nil, err
+	}
+	return mb, nil
+}
+
+// Unmarshal is used to deserializing a message pack from byte array
+func (am *AlterSchemaMsg) Unmarshal(input MarshalType) (TsMsg, error) {
+	alterSchemaRequest := internalpb.AlterSchemaRequest{}
+	in, err := convertToByteArray(input)
+	if err != nil {
+		return nil, err
+	}
+	err = proto.Unmarshal(in, &alterSchemaRequest)
+	if err != nil {
+		return nil, err
+	}
+	alterSchemaMsg := &AlterSchemaMsg{AlterSchemaRequest: alterSchemaRequest}
+	alterSchemaMsg.BeginTimestamp = alterSchemaMsg.Base.Timestamp
+	alterSchemaMsg.EndTimestamp = alterSchemaMsg.Base.Timestamp
+
+	return alterSchemaMsg, nil
+}
+
 /////////////////////////////////////////LoadIndex//////////////////////////////////////////
 // FIXME(wxyu): comment it until really needed
 /*
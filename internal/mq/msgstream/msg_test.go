@@ -794,6 +794,90 @@ func TestDropPartitionMsg_Unmarshal_IllegalParameter(t *testing.T) {
 	assert.Nil(t, tsMsg)
 }
 
+func TestTruncateMsg(t *testing.T) {
+	truncateMsg := &TruncateMsg{
+		BaseMsg: generateBaseMsg(),
+		TruncateRequest: internalpb.TruncateRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_TruncatePartition,
+				MsgID:     1,
+				Timestamp: 2,
+				SourceID:  3,
+			},
+			DbName:         "test_db",
+			CollectionName: "test_collection",
+			PartitionName:  "test_partition",
+			CollectionID:   4,
+			PartitionID:    5,
+			SegmentIDs:     []int64{6, 7},
+		},
+	}
+
+	assert.Equal(t, int64(1), truncateMsg.ID())
+	assert.Equal(t, commonpb.MsgType_TruncatePartition, truncateMsg.Type())
+	assert.Equal(t, int64(3), truncateMsg.SourceID())
+
+	bytes, err := truncateMsg.Marshal(truncateMsg)
+	assert.Nil(t, err)
+
+	tsMsg, err := truncateMsg.Unmarshal(bytes)
+	assert.Nil(t, err)
+
+	truncateMsg2, ok := tsMsg.(*TruncateMsg)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), truncateMsg2.ID())
+	assert.Equal(t, commonpb.MsgType_TruncatePartition, truncateMsg2.Type())
+	assert.Equal(t, uint64(2), truncateMsg2.BeginTs())
+	assert.Equal(t, []int64{6, 7}, truncateMsg2.GetSegmentIDs())
+
+	tsMsg, err = truncateMsg.Unmarshal(10)
+	assert.NotNil(t, err)
+	assert.Nil(t, tsMsg)
+}
+
+func TestAlterSchemaMsg(t *testing.T) {
+	alterSchemaMsg := &AlterSchemaMsg{
+		BaseMsg: generateBaseMsg(),
+		AlterSchemaRequest: internalpb.AlterSchemaRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_AddField,
+				MsgID:     1,
+				Timestamp: 2,
+				SourceID:  3,
+			},
+			DbName:         "test_db",
+			CollectionName: "test_collection",
+			CollectionID:   4,
+			FieldName:      "test_field",
+			Schema: &schemapb.CollectionSchema{
+				Name:    "test_collection",
+				Version: 1,
+			},
+		},
+	}
+
+	assert.Equal(t, int64(1), alterSchemaMsg.ID())
+	assert.Equal(t, commonpb.MsgType_AddField, alterSchemaMsg.Type())
+	assert.Equal(t, int64(3), alterSchemaMsg.SourceID())
+
+	bytes, err := alterSchemaMsg.Marshal(alterSchemaMsg)
+	assert.Nil(t, err)
+
+	tsMsg, err := alterSchemaMsg.Unmarshal(bytes)
+	assert.Nil(t, err)
+
+	alterSchemaMsg2, ok := tsMsg.(*AlterSchemaMsg)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), alterSchemaMsg2.ID())
+	assert.Equal(t, commonpb.MsgType_AddField, alterSchemaMsg2.Type())
+	assert.Equal(t, uint64(2), alterSchemaMsg2.BeginTs())
+	assert.Equal(t, int32(1), alterSchemaMsg2.GetSchema().GetVersion())
+
+	tsMsg, err = alterSchemaMsg.Unmarshal(10)
+	assert.NotNil(t, err)
+	assert.Nil(t, tsMsg)
+}
+
 func TestDataNodeTtMsg(t *testing.T) {
 	dataNodeTtMsg := &DataNodeTtMsg{
 		BaseMsg: generateBaseMsg(),
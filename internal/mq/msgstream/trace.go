@@ -86,6 +86,31 @@ func MsgSpanFromCtx(ctx context.Context, msg TsMsg, opts ...opentracing.StartSpa
 	return span, ctx
 }
 
+// injectOtelContext injects the OpenTelemetry span context of ctx to the properties of msg
+func injectOtelContext(ctx context.Context, msg TsMsg, properties map[string]string) {
+	if ctx == nil || !allowTrace(msg) {
+		return
+	}
+	trace.InjectOtelContextToMsgProperties(ctx, properties)
+}
+
+// extractOtelContext returns ctx with the OpenTelemetry span context carried by the properties of msg,
+// ctx is returned as is if no span context is carried.
+func extractOtelContext(ctx context.Context, msg TsMsg, properties map[string]string) context.Context {
+	if !allowTrace(msg) {
+		return ctx
+	}
+	parent := ctx
+	if parent == nil {
+		parent = context.Background()
+	}
+	otelCtx, ok := trace.ExtractOtelContextFromMsgProperties(parent, properties)
+	if !ok {
+		return ctx
+	}
+	return otelCtx
+}
+
 func allowTrace(in interface{}) bool {
 	if in == nil {
 		return false
@@ -65,6 +65,8 @@ func (pudf *ProtoUDFactory) NewUnmarshalDispatcher() *ProtoUnmarshalDispatcher {
 	dropCollectionMsg := DropCollectionMsg{}
 	createPartitionMsg := CreatePartitionMsg{}
 	dropPartitionMsg := DropPartitionMsg{}
+	truncateMsg := TruncateMsg{}
+	alterSchemaMsg := AlterSchemaMsg{}
 	dataNodeTtMsg := DataNodeTtMsg{}
 	sealedSegmentsChangeInfoMsg := SealedSegmentsChangeInfoMsg{}
 
@@ -81,6 +83,10 @@ func (pudf *ProtoUDFactory) NewUnmarshalDispatcher() *ProtoUnmarshalDispatcher {
 	p.TempMap[commonpb.MsgType_DropCollection] = dropCollectionMsg.Unmarshal
 	p.TempMap[commonpb.MsgType_CreatePartition] = createPartitionMsg.Unmarshal
 	p.TempMap[commonpb.MsgType_DropPartition] = dropPartitionMsg.Unmarshal
+	p.TempMap[commonpb.MsgType_TruncateCollection] = truncateMsg.Unmarshal
+	p.TempMap[commonpb.MsgType_TruncatePartition] = truncateMsg.Unmarshal
+	p.TempMap[commonpb.MsgType_AddField] = alterSchemaMsg.Unmarshal
+	p.TempMap[commonpb.MsgType_DropField] = alterSchemaMsg.Unmarshal
 	p.TempMap[commonpb.MsgType_DataNodeTt] = dataNodeTtMsg.Unmarshal
 	p.TempMap[commonpb.MsgType_SealedSegmentsChangeInfo] = sealedSegmentsChangeInfoMsg.Unmarshal
 
@@ -6,6 +6,7 @@ expr:
 	| BooleanConstant										                # Boolean
 	| StringLiteral											                # String
 	| Identifier											                # Identifier
+	| Identifier op = (ISNULL | ISNOTNULL)                                  # IsNull
 	| '(' expr ')'											                # Parens
 	| expr LIKE StringLiteral                                               # Like
 	| expr POW expr											                # Power
@@ -65,6 +66,8 @@ NOT: '!' | 'not';
 
 IN: 'in';
 NIN: 'not in';
+ISNULL: 'is null' | 'IS NULL';
+ISNOTNULL: 'is not null' | 'IS NOT NULL';
 EmptyTerm: '[' (Whitespace | Newline)* ']';
 
 BooleanConstant: 'true' | 'True' | 'TRUE' | 'false' | 'False' | 'FALSE';
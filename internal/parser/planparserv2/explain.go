@@ -0,0 +1,83 @@
+package planparserv2
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+)
+
+// PredicateIndexUsage describes how a leaf predicate of the filter expression is evaluated.
+type PredicateIndexUsage struct {
+	Predicate string  // kind of the predicate, such as "term", "unary_range" and "compare".
+	FieldIDs  []int64 // fields referenced by the predicate.
+	IndexType string  // scalar index used to filter, empty means the predicate is evaluated by scanning.
+}
+
+// UseIndex returns true if the predicate is filtered by a scalar index.
+func (u *PredicateIndexUsage) UseIndex() bool {
+	return u.IndexType != ""
+}
+
+func (u *PredicateIndexUsage) String() string {
+	if u.UseIndex() {
+		return fmt.Sprintf("%s%v: index(%s)", u.Predicate, u.FieldIDs, u.IndexType)
+	}
+	return fmt.Sprintf("%s%v: scan", u.Predicate, u.FieldIDs)
+}
+
+// ExplainIndexUsage reports which predicates of the plan can be filtered by scalar index.
+// indexTypes maps the id of each indexed field to its index type.
+// Note that only sealed segments with loaded index use them, growing segments are always scanned.
+func ExplainIndexUsage(plan *planpb.PlanNode, indexTypes map[int64]string) []*PredicateIndexUsage {
+	var expr *planpb.Expr
+	switch node := plan.GetNode().(type) {
+	case *planpb.PlanNode_VectorAnns:
+		expr = node.VectorAnns.GetPredicates()
+	case *planpb.PlanNode_Predicates:
+		expr = node.Predicates
+	}
+	ret := make([]*PredicateIndexUsage, 0)
+	explainExpr(expr, indexTypes, &ret)
+	return ret
+}
+
+func explainExpr(expr *planpb.Expr, indexTypes map[int64]string, ret *[]*PredicateIndexUsage) {
+	if expr == nil {
+		return
+	}
+	// term, unary range and binary range are the predicates segcore can answer by ScalarIndex directly.
+	indexed := func(predicate string, fieldID int64) *PredicateIndexUsage {
+		return &PredicateIndexUsage{Predicate: predicate, FieldIDs: []int64{fieldID}, IndexType: indexTypes[fieldID]}
+	}
+	switch realExpr := expr.GetExpr().(type) {
+	case *planpb.Expr_UnaryExpr:
+		explainExpr(realExpr.UnaryExpr.GetChild(), indexTypes, ret)
+	case *planpb.Expr_BinaryExpr:
+		explainExpr(realExpr.BinaryExpr.GetLeft(), indexTypes, ret)
+		explainExpr(realExpr.BinaryExpr.GetRight(), indexTypes, ret)
+	case *planpb.Expr_TermExpr:
+		*ret = append(*ret, indexed("term", realExpr.TermExpr.GetColumnInfo().GetFieldId()))
+	case *planpb.Expr_UnaryRangeExpr:
+		*ret = append(*ret, indexed("unary_range", realExpr.UnaryRangeExpr.GetColumnInfo().GetFieldId()))
+	case *planpb.Expr_BinaryRangeExpr:
+		*ret = append(*ret, indexed("binary_range", realExpr.BinaryRangeExpr.GetColumnInfo().GetFieldId()))
+	case *planpb.Expr_BinaryArithOpEvalRangeExpr:
+		*ret = append(*ret, &PredicateIndexUsage{
+			Predicate: "binary_arith_op_eval_range",
+			FieldIDs:  []int64{realExpr.BinaryArithOpEvalRangeExpr.GetColumnInfo().GetFieldId()},
+		})
+	case *planpb.Expr_NullExpr:
+		*ret = append(*ret, &PredicateIndexUsage{
+			Predicate: "null",
+			FieldIDs:  []int64{realExpr.NullExpr.GetColumnInfo().GetFieldId()},
+		})
+	case *planpb.Expr_CompareExpr:
+		*ret = append(*ret, &PredicateIndexUsage{
+			Predicate: "compare",
+			FieldIDs: []int64{
+				realExpr.CompareExpr.GetLeftColumnInfo().GetFieldId(),
+				realExpr.CompareExpr.GetRightColumnInfo().GetFieldId(),
+			},
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package planparserv2
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplainIndexUsage(t *testing.T) {
+	schema := newTestSchema()
+	int64FieldID := int64(100 + schemapb.DataType_Int64)
+	varCharFieldID := int64(100 + schemapb.DataType_VarChar)
+	int8FieldID := int64(100 + schemapb.DataType_Int8)
+	indexTypes := map[int64]string{
+		int64FieldID:   indexparamcheck.IndexSTLSORT,
+		varCharFieldID: indexparamcheck.IndexINVERTED,
+	}
+
+	plan, err := CreateRetrievePlan(schema, `Int64Field > 10 and (VarCharField in ["a", "b"] or not (Int8Field < Int64Field))`)
+	assert.NoError(t, err)
+	usages := ExplainIndexUsage(plan, indexTypes)
+	assert.Equal(t, 3, len(usages))
+
+	assert.Equal(t, "unary_range", usages[0].Predicate)
+	assert.Equal(t, []int64{int64FieldID}, usages[0].FieldIDs)
+	assert.True(t, usages[0].UseIndex())
+	assert.Equal(t, indexparamcheck.IndexSTLSORT, usages[0].IndexType)
+
+	assert.Equal(t, "term", usages[1].Predicate)
+	assert.Equal(t, indexparamcheck.IndexINVERTED, usages[1].IndexType)
+
+	assert.Equal(t, "compare", usages[2].Predicate)
+	assert.Equal(t, []int64{int8FieldID, int64FieldID}, usages[2].FieldIDs)
+	assert.False(t, usages[2].UseIndex())
+	assert.Equal(t, "compare[102 105]: scan", usages[2].String())
+
+	t.Run("search plan", func(t *testing.T) {
+		plan, err := CreateSearchPlan(schema, `10 <= Int8Field < 20 and Int64Field + 1 == 3`, "FloatVectorField", &planpb.QueryInfo{
+			Topk:         10,
+			MetricType:   "L2",
+			SearchParams: "{\"nprobe\": 10}",
+		})
+		assert.NoError(t, err)
+		usages := ExplainIndexUsage(plan, indexTypes)
+		assert.Equal(t, 2, len(usages))
+		assert.Equal(t, "binary_range", usages[0].Predicate)
+		assert.False(t, usages[0].UseIndex())
+		assert.Equal(t, "binary_arith_op_eval_range", usages[1].Predicate)
+		assert.False(t, usages[1].UseIndex())
+	})
+
+	t.Run("no predicates", func(t *testing.T) {
+		assert.Empty(t, ExplainIndexUsage(&planpb.PlanNode{}, indexTypes))
+	})
+}
+
+func TestShowPlan(t *testing.T) {
+	schema := newTestSchema()
+
+	plan, err := CreateSearchPlan(schema, `Int64Field > 10`, "FloatVectorField", &planpb.QueryInfo{
+		Topk:         10,
+		MetricType:   "L2",
+		SearchParams: "{\"nprobe\": 10}",
+	})
+	assert.NoError(t, err)
+	js := make(map[string]interface{})
+	assert.NoError(t, json.Unmarshal([]byte(ShowPlan(plan)), &js))
+	anns := js["vector_anns"].(map[string]interface{})
+	assert.Equal(t, float64(10), anns["topk"])
+	assert.Equal(t, "L2", anns["metric_type"])
+	assert.Contains(t, anns, "predicates")
+
+	plan, err = CreateRetrievePlan(schema, `Int64Field in [1, 2]`)
+	assert.NoError(t, err)
+	js = make(map[string]interface{})
+	assert.NoError(t, json.Unmarshal([]byte(ShowPlan(plan)), &js))
+	assert.Contains(t, js, "predicates")
+	assert.NotContains(t, js, "vector_anns")
+}
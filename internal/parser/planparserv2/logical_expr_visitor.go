@@ -14,4 +14,5 @@ type LogicalExprVisitor interface {
 	VisitBinaryArithExpr(expr *planpb.BinaryArithExpr) interface{}
 	VisitValueExpr(expr *planpb.ValueExpr) interface{}
 	VisitColumnExpr(expr *planpb.ColumnExpr) interface{}
+	VisitNullExpr(expr *planpb.NullExpr) interface{}
 }
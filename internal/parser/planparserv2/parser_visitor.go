@@ -428,6 +428,41 @@ func (v *ParserVisitor) VisitLike(ctx *parser.LikeContext) interface{} {
 	}
 }
 
+// VisitIsNull translates expr to null plan.
+func (v *ParserVisitor) VisitIsNull(ctx *parser.IsNullContext) interface{} {
+	identifier := ctx.Identifier().GetText()
+	field, err := v.schema.GetFieldFromName(identifier)
+	if err != nil {
+		return err
+	}
+
+	if !field.GetNullable() {
+		return fmt.Errorf("null check on non-nullable field is unsupported, field name = %s", identifier)
+	}
+
+	op := planpb.NullExpr_IsNull
+	if ctx.GetOp().GetTokenType() == parser.PlanParserISNOTNULL {
+		op = planpb.NullExpr_IsNotNull
+	}
+
+	return &ExprWithType{
+		expr: &planpb.Expr{
+			Expr: &planpb.Expr_NullExpr{
+				NullExpr: &planpb.NullExpr{
+					ColumnInfo: &planpb.ColumnInfo{
+						FieldId:      field.FieldID,
+						DataType:     field.DataType,
+						IsPrimaryKey: field.IsPrimaryKey,
+						IsAutoID:     field.AutoID,
+					},
+					Op: op,
+				},
+			},
+		},
+		dataType: schemapb.DataType_Bool,
+	}
+}
+
 // VisitTerm translates expr to term plan.
 func (v *ParserVisitor) VisitTerm(ctx *parser.TermContext) interface{} {
 	child := ctx.Expr(0).Accept(v)
@@ -133,6 +133,42 @@ func TestExpr_Like(t *testing.T) {
 	}
 }
 
+func TestExpr_IsNull(t *testing.T) {
+	schema := newTestSchema()
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID: 1000, Name: "NullableField", DataType: schemapb.DataType_Int64, Nullable: true,
+	})
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	exprStrs := []string{
+		`NullableField is null`,
+		`NullableField IS NULL`,
+		`NullableField is not null`,
+		`NullableField IS NOT NULL`,
+		`NullableField is null || NullableField > 1`,
+		`not (NullableField is not null) && Int64Field in [1, 2]`,
+	}
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+
+	expr, err := ParseExpr(helper, `NullableField is not null`)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.NullExpr_IsNotNull, expr.GetNullExpr().GetOp())
+	assert.Equal(t, int64(1000), expr.GetNullExpr().GetColumnInfo().GetFieldId())
+
+	invalidExprs := []string{
+		`Int64Field is null`,
+		`NotExistField is null`,
+		`NullableField is`,
+		`1 is null`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, helper, exprStr)
+	}
+}
+
 func TestExpr_BinaryRange(t *testing.T) {
 	schema := newTestSchema()
 	helper, err := typeutil.CreateSchemaHelper(schema)
@@ -0,0 +1,139 @@
+package planparserv2
+
+import (
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+)
+
+// CanPruneByRange returns true if no row whose value of the field lies in [min, max] satisfies the filter of the plan,
+// segments with such key range could be skipped when executing the plan.
+// It's conservative, predicates which could not be reasoned about are treated as possibly matched.
+func CanPruneByRange(plan *planpb.PlanNode, fieldID int64, min, max *planpb.GenericValue) bool {
+	if min == nil || max == nil {
+		return false
+	}
+	var expr *planpb.Expr
+	switch node := plan.GetNode().(type) {
+	case *planpb.PlanNode_VectorAnns:
+		expr = node.VectorAnns.GetPredicates()
+	case *planpb.PlanNode_Predicates:
+		expr = node.Predicates
+	}
+	if expr == nil {
+		return false
+	}
+	return !mayMatchRange(expr, fieldID, min, max)
+}
+
+// CompareValue compares two generic values, ok is false if they are not comparable.
+func CompareValue(a, b *planpb.GenericValue) (ret int, ok bool) {
+	switch {
+	case IsString(a) && IsString(b):
+		return strings.Compare(a.GetStringVal(), b.GetStringVal()), true
+	case IsInteger(a) && IsInteger(b):
+		switch {
+		case a.GetInt64Val() < b.GetInt64Val():
+			return -1, true
+		case a.GetInt64Val() > b.GetInt64Val():
+			return 1, true
+		}
+		return 0, true
+	case IsNumber(a) && IsNumber(b):
+		toFloat := func(n *planpb.GenericValue) float64 {
+			if IsInteger(n) {
+				return float64(n.GetInt64Val())
+			}
+			return n.GetFloatVal()
+		}
+		fa, fb := toFloat(a), toFloat(b)
+		switch {
+		case fa < fb:
+			return -1, true
+		case fa > fb:
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// inRange checks whether value lies in [min, max], unknown is treated as true.
+func inRange(value, min, max *planpb.GenericValue) bool {
+	lower, ok1 := CompareValue(value, min)
+	upper, ok2 := CompareValue(value, max)
+	if !ok1 || !ok2 {
+		return true
+	}
+	return lower >= 0 && upper <= 0
+}
+
+func mayMatchRange(expr *planpb.Expr, fieldID int64, min, max *planpb.GenericValue) bool {
+	switch realExpr := expr.GetExpr().(type) {
+	case *planpb.Expr_BinaryExpr:
+		left := mayMatchRange(realExpr.BinaryExpr.GetLeft(), fieldID, min, max)
+		right := mayMatchRange(realExpr.BinaryExpr.GetRight(), fieldID, min, max)
+		if realExpr.BinaryExpr.GetOp() == planpb.BinaryExpr_LogicalAnd {
+			return left && right
+		}
+		return left || right
+
+	case *planpb.Expr_TermExpr:
+		if realExpr.TermExpr.GetColumnInfo().GetFieldId() != fieldID {
+			return true
+		}
+		for _, value := range realExpr.TermExpr.GetValues() {
+			if inRange(value, min, max) {
+				return true
+			}
+		}
+		return false
+
+	case *planpb.Expr_UnaryRangeExpr:
+		if realExpr.UnaryRangeExpr.GetColumnInfo().GetFieldId() != fieldID {
+			return true
+		}
+		value := realExpr.UnaryRangeExpr.GetValue()
+		switch realExpr.UnaryRangeExpr.GetOp() {
+		case planpb.OpType_GreaterThan:
+			ret, ok := CompareValue(max, value)
+			return !ok || ret > 0
+		case planpb.OpType_GreaterEqual:
+			ret, ok := CompareValue(max, value)
+			return !ok || ret >= 0
+		case planpb.OpType_LessThan:
+			ret, ok := CompareValue(min, value)
+			return !ok || ret < 0
+		case planpb.OpType_LessEqual:
+			ret, ok := CompareValue(min, value)
+			return !ok || ret <= 0
+		case planpb.OpType_Equal:
+			return inRange(value, min, max)
+		case planpb.OpType_PrefixMatch:
+			if !IsString(value) || !IsString(min) || !IsString(max) {
+				return true
+			}
+			// strings with the prefix are no less than the prefix itself
+			prefix := value.GetStringVal()
+			return max.GetStringVal() >= prefix &&
+				(min.GetStringVal() <= prefix || strings.HasPrefix(min.GetStringVal(), prefix))
+		}
+		return true
+
+	case *planpb.Expr_BinaryRangeExpr:
+		rangeExpr := realExpr.BinaryRangeExpr
+		if rangeExpr.GetColumnInfo().GetFieldId() != fieldID {
+			return true
+		}
+		if ret, ok := CompareValue(max, rangeExpr.GetLowerValue()); ok && (ret < 0 || ret == 0 && !rangeExpr.GetLowerInclusive()) {
+			return false
+		}
+		if ret, ok := CompareValue(min, rangeExpr.GetUpperValue()); ok && (ret > 0 || ret == 0 && !rangeExpr.GetUpperInclusive()) {
+			return false
+		}
+		return true
+	}
+
+	// not, compare and arithmetic expressions are not reasoned about
+	return true
+}
@@ -0,0 +1,78 @@
+package planparserv2
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanPruneByRange(t *testing.T) {
+	schema := newTestSchema()
+	int64FieldID := int64(100 + schemapb.DataType_Int64)
+	varCharFieldID := int64(100 + schemapb.DataType_VarChar)
+
+	int64Cases := []struct {
+		expr  string
+		prune bool
+	}{
+		{`Int64Field > 20`, true},
+		{`Int64Field >= 20`, false},
+		{`Int64Field < 10`, true},
+		{`Int64Field <= 10`, false},
+		{`Int64Field == 15`, false},
+		{`Int64Field == 21`, true},
+		{`Int64Field != 21`, false},
+		{`Int64Field in [1, 2, 30]`, true},
+		{`Int64Field in [1, 12]`, false},
+		{`1 <= Int64Field < 10`, true},
+		{`1 <= Int64Field <= 10`, false},
+		{`20 < Int64Field < 30`, true},
+		{`Int64Field > 20 and Int8Field > 0`, true},
+		{`Int64Field > 20 or Int8Field > 0`, false},
+		{`Int64Field > 20 or Int64Field < 5`, true},
+		{`not (Int64Field > 20)`, false},
+		{`Int8Field > 100`, false},
+		{`Int64Field + 1 == 100`, false},
+	}
+	for _, c := range int64Cases {
+		plan, err := CreateRetrievePlan(schema, c.expr)
+		assert.NoError(t, err, c.expr)
+		assert.Equal(t, c.prune, CanPruneByRange(plan, int64FieldID, NewInt(10), NewInt(20)), c.expr)
+	}
+
+	varCharCases := []struct {
+		expr  string
+		prune bool
+	}{
+		{`VarCharField == "a"`, true},
+		{`VarCharField == "bb"`, false},
+		{`VarCharField like "bc%"`, false},
+		{`VarCharField like "d%"`, true},
+		{`VarCharField like "a%"`, true},
+		{`VarCharField > "c"`, true},
+	}
+	for _, c := range varCharCases {
+		plan, err := CreateRetrievePlan(schema, c.expr)
+		assert.NoError(t, err, c.expr)
+		assert.Equal(t, c.prune, CanPruneByRange(plan, varCharFieldID, NewString("b"), NewString("c")), c.expr)
+	}
+
+	t.Run("search plan", func(t *testing.T) {
+		plan, err := CreateSearchPlan(schema, `Int64Field > 20`, "FloatVectorField", &planpb.QueryInfo{
+			Topk:         10,
+			MetricType:   "L2",
+			SearchParams: "{\"nprobe\": 10}",
+		})
+		assert.NoError(t, err)
+		assert.True(t, CanPruneByRange(plan, int64FieldID, NewInt(10), NewInt(20)))
+	})
+
+	t.Run("no range or predicates", func(t *testing.T) {
+		plan, err := CreateRetrievePlan(schema, `Int64Field > 20`)
+		assert.NoError(t, err)
+		assert.False(t, CanPruneByRange(plan, int64FieldID, nil, nil))
+		assert.False(t, CanPruneByRange(&planpb.PlanNode{}, int64FieldID, NewInt(10), NewInt(20)))
+	})
+}
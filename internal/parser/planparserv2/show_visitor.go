@@ -59,6 +59,8 @@ func (v *ShowExprVisitor) VisitExpr(expr *planpb.Expr) interface{} {
 		js["expr"] = v.VisitValueExpr(realExpr.ValueExpr)
 	case *planpb.Expr_ColumnExpr:
 		js["expr"] = v.VisitColumnExpr(realExpr.ColumnExpr)
+	case *planpb.Expr_NullExpr:
+		js["expr"] = v.VisitNullExpr(realExpr.NullExpr)
 	default:
 		js["expr"] = ""
 	}
@@ -155,6 +157,14 @@ func (v *ShowExprVisitor) VisitColumnExpr(expr *planpb.ColumnExpr) interface{} {
 	return js
 }
 
+func (v *ShowExprVisitor) VisitNullExpr(expr *planpb.NullExpr) interface{} {
+	js := make(map[string]interface{})
+	js["expr_type"] = "null"
+	js["op"] = expr.Op.String()
+	js["column_info"] = extractColumnInfo(expr.GetColumnInfo())
+	return js
+}
+
 func NewShowExprVisitor() LogicalExprVisitor {
 	return &ShowExprVisitor{}
 }
@@ -166,3 +176,28 @@ func ShowExpr(expr *planpb.Expr) {
 	b, _ := json.MarshalIndent(js, "", "  ")
 	fmt.Println(string(b))
 }
+
+// ShowPlan returns the plan in json format, the predicates are shown as ShowExpr does.
+func ShowPlan(plan *planpb.PlanNode) string {
+	v := NewShowExprVisitor()
+	js := make(map[string]interface{})
+	switch node := plan.GetNode().(type) {
+	case *planpb.PlanNode_VectorAnns:
+		anns := make(map[string]interface{})
+		anns["field_id"] = node.VectorAnns.GetFieldId()
+		anns["is_binary"] = node.VectorAnns.GetIsBinary()
+		anns["topk"] = node.VectorAnns.GetQueryInfo().GetTopk()
+		anns["metric_type"] = node.VectorAnns.GetQueryInfo().GetMetricType()
+		anns["search_params"] = node.VectorAnns.GetQueryInfo().GetSearchParams()
+		anns["round_decimal"] = node.VectorAnns.GetQueryInfo().GetRoundDecimal()
+		if node.VectorAnns.GetPredicates() != nil {
+			anns["predicates"] = v.VisitExpr(node.VectorAnns.GetPredicates())
+		}
+		js["vector_anns"] = anns
+	case *planpb.PlanNode_Predicates:
+		js["predicates"] = v.VisitExpr(node.Predicates)
+	}
+	js["output_field_ids"] = plan.GetOutputFieldIds()
+	b, _ := json.MarshalIndent(js, "", "  ")
+	return string(b)
+}
@@ -71,6 +71,9 @@ const (
 	ErrorCode_NotShardLeader                ErrorCode = 45
 	ErrorCode_NoReplicaAvailable            ErrorCode = 46
 	ErrorCode_SegmentNotFound               ErrorCode = 47
+	ErrorCode_OperateRowPolicyFailure       ErrorCode = 48
+	ErrorCode_ListRowPoliciesFailure        ErrorCode = 49
+	ErrorCode_ServerBusy                    ErrorCode = 50
 	// internal error code.
 	ErrorCode_DDRequestRace ErrorCode = 1000
 )
@@ -123,6 +126,9 @@ var ErrorCode_name = map[int32]string{
 	45:   "NotShardLeader",
 	46:   "NoReplicaAvailable",
 	47:   "SegmentNotFound",
+	48:   "OperateRowPolicyFailure",
+	49:   "ListRowPoliciesFailure",
+	50:   "ServerBusy",
 	1000: "DDRequestRace",
 }
 
@@ -174,6 +180,9 @@ var ErrorCode_value = map[string]int32{
 	"NotShardLeader":                45,
 	"NoReplicaAvailable":            46,
 	"SegmentNotFound":               47,
+	"OperateRowPolicyFailure":       48,
+	"ListRowPoliciesFailure":        49,
+	"ServerBusy":                    50,
 	"DDRequestRace":                 1000,
 }
 
@@ -306,6 +315,13 @@ const (
 	MsgType_CreateAlias        MsgType = 108
 	MsgType_DropAlias          MsgType = 109
 	MsgType_AlterAlias         MsgType = 110
+	MsgType_AlterShards        MsgType = 111
+	MsgType_TruncateCollection MsgType = 112
+	MsgType_AddField           MsgType = 113
+	MsgType_DropField          MsgType = 114
+	MsgType_CreateSnapshot     MsgType = 115
+	MsgType_DropSnapshot       MsgType = 116
+	MsgType_ListSnapshots      MsgType = 117
 	// DEFINITION REQUESTS: PARTITION
 	MsgType_CreatePartition   MsgType = 200
 	MsgType_DropPartition     MsgType = 201
@@ -314,6 +330,7 @@ const (
 	MsgType_ShowPartitions    MsgType = 204
 	MsgType_LoadPartitions    MsgType = 205
 	MsgType_ReleasePartitions MsgType = 206
+	MsgType_TruncatePartition MsgType = 207
 	// DEFINE REQUESTS: SEGMENT
 	MsgType_ShowSegments        MsgType = 250
 	MsgType_DescribeSegment     MsgType = 251
@@ -349,10 +366,11 @@ const (
 	MsgType_GetShardLeaders          MsgType = 514
 	MsgType_GetReplicas              MsgType = 515
 	// DATA SERVICE
-	MsgType_SegmentInfo     MsgType = 600
-	MsgType_SystemInfo      MsgType = 601
-	MsgType_GetRecoveryInfo MsgType = 602
-	MsgType_GetSegmentState MsgType = 603
+	MsgType_SegmentInfo      MsgType = 600
+	MsgType_SystemInfo       MsgType = 601
+	MsgType_GetRecoveryInfo  MsgType = 602
+	MsgType_GetSegmentState  MsgType = 603
+	MsgType_CheckPrimaryKeys MsgType = 604
 	// SYSTEM CONTROL
 	MsgType_TimeTick          MsgType = 1200
 	MsgType_QueryNodeStats    MsgType = 1201
@@ -380,6 +398,9 @@ const (
 	MsgType_SelectGrant            MsgType = 1607
 	MsgType_RefreshPolicyInfoCache MsgType = 1608
 	MsgType_ListPolicy             MsgType = 1609
+	MsgType_CreateRowPolicy        MsgType = 1610
+	MsgType_DropRowPolicy          MsgType = 1611
+	MsgType_ListRowPolicies        MsgType = 1612
 )
 
 var MsgType_name = map[int32]string{
@@ -395,6 +416,13 @@ var MsgType_name = map[int32]string{
 	108:  "CreateAlias",
 	109:  "DropAlias",
 	110:  "AlterAlias",
+	111:  "AlterShards",
+	112:  "TruncateCollection",
+	113:  "AddField",
+	114:  "DropField",
+	115:  "CreateSnapshot",
+	116:  "DropSnapshot",
+	117:  "ListSnapshots",
 	200:  "CreatePartition",
 	201:  "DropPartition",
 	202:  "HasPartition",
@@ -402,6 +430,7 @@ var MsgType_name = map[int32]string{
 	204:  "ShowPartitions",
 	205:  "LoadPartitions",
 	206:  "ReleasePartitions",
+	207:  "TruncatePartition",
 	250:  "ShowSegments",
 	251:  "DescribeSegment",
 	252:  "LoadSegments",
@@ -436,6 +465,7 @@ var MsgType_name = map[int32]string{
 	601:  "SystemInfo",
 	602:  "GetRecoveryInfo",
 	603:  "GetSegmentState",
+	604:  "CheckPrimaryKeys",
 	1200: "TimeTick",
 	1201: "QueryNodeStats",
 	1202: "LoadIndex",
@@ -460,6 +490,9 @@ var MsgType_name = map[int32]string{
 	1607: "SelectGrant",
 	1608: "RefreshPolicyInfoCache",
 	1609: "ListPolicy",
+	1610: "CreateRowPolicy",
+	1611: "DropRowPolicy",
+	1612: "ListRowPolicies",
 }
 
 var MsgType_value = map[string]int32{
@@ -475,6 +508,13 @@ var MsgType_value = map[string]int32{
 	"CreateAlias":              108,
 	"DropAlias":                109,
 	"AlterAlias":               110,
+	"AlterShards":              111,
+	"TruncateCollection":       112,
+	"AddField":                 113,
+	"DropField":                114,
+	"CreateSnapshot":           115,
+	"DropSnapshot":             116,
+	"ListSnapshots":            117,
 	"CreatePartition":          200,
 	"DropPartition":            201,
 	"HasPartition":             202,
@@ -482,6 +522,7 @@ var MsgType_value = map[string]int32{
 	"ShowPartitions":           204,
 	"LoadPartitions":           205,
 	"ReleasePartitions":        206,
+	"TruncatePartition":        207,
 	"ShowSegments":             250,
 	"DescribeSegment":          251,
 	"LoadSegments":             252,
@@ -516,6 +557,7 @@ var MsgType_value = map[string]int32{
 	"SystemInfo":               601,
 	"GetRecoveryInfo":          602,
 	"GetSegmentState":          603,
+	"CheckPrimaryKeys":         604,
 	"TimeTick":                 1200,
 	"QueryNodeStats":           1201,
 	"LoadIndex":                1202,
@@ -540,6 +582,9 @@ var MsgType_value = map[string]int32{
 	"SelectGrant":              1607,
 	"RefreshPolicyInfoCache":   1608,
 	"ListPolicy":               1609,
+	"CreateRowPolicy":          1610,
+	"DropRowPolicy":            1611,
+	"ListRowPolicies":          1612,
 }
 
 func (x MsgType) String() string {
@@ -581,18 +626,21 @@ const (
 	CompactionState_UndefiedState CompactionState = 0
 	CompactionState_Executing     CompactionState = 1
 	CompactionState_Completed     CompactionState = 2
+	CompactionState_Cancelled     CompactionState = 3
 )
 
 var CompactionState_name = map[int32]string{
 	0: "UndefiedState",
 	1: "Executing",
 	2: "Completed",
+	3: "Cancelled",
 }
 
 var CompactionState_value = map[string]int32{
 	"UndefiedState": 0,
 	"Executing":     1,
 	"Completed":     2,
+	"Cancelled":     3,
 }
 
 func (x CompactionState) String() string {
@@ -805,6 +853,7 @@ func (ObjectPrivilege) EnumDescriptor() ([]byte, []int) {
 type Status struct {
 	ErrorCode            ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=milvus.proto.common.ErrorCode" json:"error_code,omitempty"`
 	Reason               string    `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	RetryAfterMs         int64     `protobuf:"varint,3,opt,name=retry_after_ms,json=retryAfterMs,proto3" json:"retry_after_ms,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
 	XXX_unrecognized     []byte    `json:"-"`
 	XXX_sizecache        int32     `json:"-"`
@@ -849,6 +898,13 @@ func (m *Status) GetReason() string {
 	return ""
 }
 
+func (m *Status) GetRetryAfterMs() int64 {
+	if m != nil {
+		return m.RetryAfterMs
+	}
+	return 0
+}
+
 type KeyValuePair struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -1376,160 +1432,170 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 2473 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x49, 0x73, 0x24, 0x47,
-	0xf5, 0x57, 0x75, 0xb7, 0x96, 0xce, 0x6e, 0x49, 0x4f, 0x29, 0x8d, 0xa6, 0x3d, 0x8b, 0x47, 0xd6,
-	0xdf, 0xfe, 0x33, 0x08, 0x5b, 0x03, 0xe3, 0x08, 0x20, 0x88, 0x30, 0x81, 0xd4, 0x2d, 0x69, 0x14,
-	0x1e, 0x2d, 0x94, 0x34, 0xb6, 0x83, 0x08, 0x98, 0x48, 0x55, 0x3d, 0x75, 0xd7, 0x4c, 0x75, 0x65,
-	0x51, 0x99, 0xad, 0x51, 0x73, 0x32, 0x26, 0x82, 0x33, 0x98, 0x2f, 0xc0, 0x07, 0x60, 0x5f, 0x0c,
-	0x47, 0x76, 0x6c, 0xb6, 0x0b, 0x17, 0x76, 0x38, 0xc2, 0x9d, 0xd5, 0x2b, 0xf1, 0x32, 0x6b, 0x6b,
-	0xcd, 0x18, 0x0e, 0xdc, 0x3a, 0x7f, 0xef, 0xe5, 0xdb, 0x97, 0xac, 0x66, 0x4d, 0x4f, 0xf6, 0xfb,
-	0x32, 0x5a, 0x8d, 0x13, 0xa9, 0x25, 0x9f, 0xef, 0x07, 0xe1, 0xc9, 0x40, 0xd9, 0xd3, 0xaa, 0x25,
-	0x5d, 0x58, 0xea, 0x4a, 0xd9, 0x0d, 0xf1, 0x9a, 0x01, 0x8f, 0x06, 0xc7, 0xd7, 0x7c, 0x54, 0x5e,
-	0x12, 0xc4, 0x5a, 0x26, 0x96, 0x71, 0xf9, 0x36, 0x9b, 0x38, 0xd0, 0x42, 0x0f, 0x14, 0x7f, 0x8a,
-	0x31, 0x4c, 0x12, 0x99, 0xdc, 0xf6, 0xa4, 0x8f, 0x2d, 0x67, 0xc9, 0xb9, 0x3a, 0x73, 0xfd, 0xe1,
-	0xd5, 0x07, 0x48, 0x5d, 0xdd, 0x20, 0xb6, 0xb6, 0xf4, 0xd1, 0xad, 0x63, 0xf6, 0x93, 0x2f, 0xb2,
-	0x89, 0x04, 0x85, 0x92, 0x51, 0xab, 0xb2, 0xe4, 0x5c, 0xad, 0xbb, 0xe9, 0x69, 0xf9, 0xbd, 0xac,
-	0xf9, 0x34, 0x0e, 0x9f, 0x11, 0xe1, 0x00, 0xf7, 0x45, 0x90, 0x70, 0x60, 0xd5, 0xbb, 0x38, 0x34,
-	0xf2, 0xeb, 0x2e, 0xfd, 0xe4, 0x0b, 0x6c, 0xfc, 0x84, 0xc8, 0xe9, 0x45, 0x7b, 0x58, 0x7e, 0x92,
-	0x35, 0x9e, 0xc6, 0x61, 0x47, 0x68, 0xf1, 0x36, 0xd7, 0x38, 0xab, 0xf9, 0x42, 0x0b, 0x73, 0xab,
-	0xe9, 0x9a, 0xdf, 0xcb, 0x97, 0x58, 0x6d, 0x3d, 0x94, 0x47, 0x85, 0x48, 0xc7, 0x10, 0x53, 0x91,
-	0x27, 0x0c, 0xf6, 0x43, 0xe1, 0x61, 0x4f, 0x86, 0x3e, 0x26, 0xc6, 0x24, 0x92, 0xab, 0x45, 0x37,
-	0x93, 0xab, 0x45, 0x97, 0xbf, 0x9f, 0xd5, 0xf4, 0x30, 0xb6, 0xd6, 0xcc, 0x5c, 0x7f, 0xf4, 0x81,
-	0x11, 0x28, 0x89, 0x39, 0x1c, 0xc6, 0xe8, 0x9a, 0x1b, 0x14, 0x02, 0xa3, 0x48, 0xb5, 0xaa, 0x4b,
-	0xd5, 0xab, 0x4d, 0x37, 0x3d, 0x2d, 0x7f, 0x74, 0x44, 0xef, 0x56, 0x22, 0x07, 0x31, 0xdf, 0x66,
-	0xcd, 0xb8, 0xc0, 0x54, 0xcb, 0x59, 0xaa, 0x5e, 0x6d, 0x5c, 0x7f, 0xec, 0xbf, 0x69, 0x33, 0x46,
-	0xbb, 0x23, 0x57, 0x97, 0x9f, 0x60, 0x93, 0x6b, 0xbe, 0x9f, 0xa0, 0x52, 0x7c, 0x86, 0x55, 0x82,
-	0x38, 0x75, 0xa6, 0x12, 0xc4, 0x14, 0xa3, 0x58, 0x26, 0xda, 0xf8, 0x52, 0x75, 0xcd, 0xef, 0xe5,
-	0x17, 0x1d, 0x36, 0xb9, 0xa3, 0xba, 0xeb, 0x42, 0x21, 0x7f, 0x1f, 0x9b, 0xea, 0xab, 0xee, 0x6d,
-	0xe3, 0xaf, 0xcd, 0xf8, 0xa5, 0x07, 0x5a, 0xb0, 0xa3, 0xba, 0xc6, 0xcf, 0xc9, 0xbe, 0xfd, 0x41,
-	0x01, 0xee, 0xab, 0xee, 0x76, 0x27, 0x95, 0x6c, 0x0f, 0xfc, 0x12, 0xab, 0xeb, 0xa0, 0x8f, 0x4a,
-	0x8b, 0x7e, 0xdc, 0xaa, 0x2e, 0x39, 0x57, 0x6b, 0x6e, 0x01, 0xf0, 0x0b, 0x6c, 0x4a, 0xc9, 0x41,
-	0xe2, 0xe1, 0x76, 0xa7, 0x55, 0x33, 0xd7, 0xf2, 0xf3, 0xf2, 0x53, 0xac, 0xbe, 0xa3, 0xba, 0x37,
-	0x50, 0xf8, 0x98, 0xf0, 0x77, 0xb3, 0xda, 0x91, 0x50, 0xd6, 0xa2, 0xc6, 0xdb, 0x5b, 0x44, 0x1e,
-	0xb8, 0x86, 0x73, 0xf9, 0x63, 0xac, 0xd9, 0xd9, 0xb9, 0xf9, 0x3f, 0x48, 0x20, 0xd3, 0x55, 0x4f,
-	0x24, 0xfe, 0xae, 0xe8, 0x67, 0x85, 0x58, 0x00, 0xcb, 0xaf, 0x39, 0xac, 0xb9, 0x9f, 0x04, 0x27,
-	0x41, 0x88, 0x5d, 0xdc, 0x38, 0xd5, 0xfc, 0x43, 0xac, 0x21, 0x8f, 0xee, 0xa0, 0xa7, 0xcb, 0xb1,
-	0xbb, 0xf2, 0x40, 0x3d, 0x7b, 0x86, 0xcf, 0x84, 0x8f, 0xc9, 0xfc, 0x37, 0xdf, 0x63, 0x90, 0x4a,
-	0x88, 0x33, 0xc1, 0xff, 0xb1, 0xe4, 0xac, 0x98, 0xdc, 0x08, 0x77, 0x56, 0x8e, 0x02, 0x7c, 0x85,
-	0xcd, 0xa5, 0x02, 0x23, 0xd1, 0xc7, 0xdb, 0x41, 0xe4, 0xe3, 0xa9, 0x49, 0xc2, 0x78, 0xc6, 0x4b,
-	0xae, 0x6c, 0x13, 0xcc, 0x1f, 0x67, 0xfc, 0x3e, 0x5e, 0x65, 0x92, 0x32, 0xee, 0xc2, 0x19, 0x66,
-	0xb5, 0xf2, 0xcb, 0x29, 0x56, 0xcf, 0x7b, 0x9e, 0x37, 0xd8, 0xe4, 0xc1, 0xc0, 0xf3, 0x50, 0x29,
-	0x18, 0xe3, 0xf3, 0x6c, 0xf6, 0x56, 0x84, 0xa7, 0x31, 0x7a, 0x1a, 0x7d, 0xc3, 0x03, 0x0e, 0x9f,
-	0x63, 0xd3, 0x6d, 0x19, 0x45, 0xe8, 0xe9, 0x4d, 0x11, 0x84, 0xe8, 0x43, 0x85, 0x2f, 0x30, 0xd8,
-	0xc7, 0xa4, 0x1f, 0x28, 0x15, 0xc8, 0xa8, 0x83, 0x51, 0x80, 0x3e, 0x54, 0xf9, 0x79, 0x36, 0xdf,
-	0x96, 0x61, 0x88, 0x9e, 0x0e, 0x64, 0xb4, 0x2b, 0xf5, 0xc6, 0x69, 0xa0, 0xb4, 0x82, 0x1a, 0x89,
-	0xdd, 0x0e, 0x43, 0xec, 0x8a, 0x70, 0x2d, 0xe9, 0x0e, 0xfa, 0x18, 0x69, 0x18, 0x27, 0x19, 0x29,
-	0xd8, 0x09, 0xfa, 0x18, 0x91, 0x24, 0x98, 0x2c, 0xa1, 0xc6, 0x5a, 0x8a, 0x2d, 0x4c, 0xf1, 0x87,
-	0xd8, 0xb9, 0x14, 0x2d, 0x29, 0x10, 0x7d, 0x84, 0x3a, 0x9f, 0x65, 0x8d, 0x94, 0x74, 0xb8, 0xb7,
-	0xff, 0x34, 0xb0, 0x92, 0x04, 0x57, 0xde, 0x73, 0xd1, 0x93, 0x89, 0x0f, 0x8d, 0x92, 0x09, 0xcf,
-	0xa0, 0xa7, 0x65, 0xb2, 0xdd, 0x81, 0x26, 0x19, 0x9c, 0x82, 0x07, 0x28, 0x12, 0xaf, 0xe7, 0xa2,
-	0x1a, 0x84, 0x1a, 0xa6, 0x39, 0xb0, 0xe6, 0x66, 0x10, 0xe2, 0xae, 0xd4, 0x9b, 0x72, 0x10, 0xf9,
-	0x30, 0xc3, 0x67, 0x18, 0xdb, 0x41, 0x2d, 0xd2, 0x08, 0xcc, 0x92, 0xda, 0xb6, 0xf0, 0x7a, 0x98,
-	0x02, 0xc0, 0x17, 0x19, 0x6f, 0x8b, 0x28, 0x92, 0xba, 0x9d, 0xa0, 0xd0, 0xb8, 0x69, 0xba, 0x19,
-	0xe6, 0xc8, 0x9c, 0x11, 0x3c, 0x08, 0x11, 0x78, 0xc1, 0xdd, 0xc1, 0x10, 0x73, 0xee, 0xf9, 0x82,
-	0x3b, 0xc5, 0x89, 0x7b, 0x81, 0x8c, 0x5f, 0x1f, 0x04, 0xa1, 0x6f, 0x42, 0x62, 0xd3, 0x72, 0x8e,
-	0x6c, 0x4c, 0x8d, 0xdf, 0xbd, 0xb9, 0x7d, 0x70, 0x08, 0x8b, 0xfc, 0x1c, 0x9b, 0x4b, 0x91, 0x1d,
-	0xd4, 0x49, 0xe0, 0x99, 0xe0, 0x9d, 0x27, 0x53, 0xf7, 0x06, 0x7a, 0xef, 0x78, 0x07, 0xfb, 0x32,
-	0x19, 0x42, 0x8b, 0x12, 0x6a, 0x24, 0x65, 0x29, 0x82, 0x87, 0x48, 0xc3, 0x46, 0x3f, 0xd6, 0xc3,
-	0x22, 0xbc, 0x70, 0x81, 0x5f, 0x64, 0xe7, 0x6f, 0xc5, 0xbe, 0xd0, 0xb8, 0xdd, 0xa7, 0x51, 0x73,
-	0x28, 0xd4, 0x5d, 0x72, 0x77, 0x90, 0x20, 0x5c, 0xe4, 0x17, 0xd8, 0xe2, 0x68, 0x2e, 0xf2, 0x60,
-	0x5d, 0xa2, 0x8b, 0xd6, 0xdb, 0x76, 0x82, 0x3e, 0x46, 0x3a, 0x10, 0x61, 0x76, 0xf1, 0x72, 0x21,
-	0xf5, 0x7e, 0xe2, 0xc3, 0x44, 0xb4, 0x9e, 0xdf, 0x4f, 0xbc, 0xc2, 0x5b, 0x6c, 0x61, 0x0b, 0xf5,
-	0xfd, 0x94, 0x25, 0xa2, 0xdc, 0x0c, 0x94, 0x21, 0xdd, 0x52, 0x98, 0xa8, 0x8c, 0xf2, 0x08, 0xe7,
-	0x6c, 0x66, 0x0b, 0x35, 0x81, 0x19, 0xb6, 0x4c, 0x71, 0xb2, 0xe6, 0xb9, 0x32, 0xc4, 0x0c, 0xfe,
-	0x3f, 0x8a, 0x41, 0x27, 0x91, 0x71, 0x19, 0x7c, 0x94, 0xdc, 0xdc, 0x8b, 0x31, 0x11, 0x1a, 0x49,
-	0x46, 0x99, 0xf6, 0x18, 0xc9, 0x39, 0x40, 0x8a, 0x40, 0x19, 0xfe, 0xff, 0x02, 0x2e, 0x6b, 0x7d,
-	0x07, 0xd5, 0x70, 0xca, 0x8d, 0x76, 0x4e, 0x66, 0xa4, 0xab, 0xe4, 0x75, 0xaa, 0x24, 0xef, 0xff,
-	0x8c, 0xf8, 0x4e, 0x2a, 0x15, 0x7b, 0x6f, 0x2b, 0x11, 0x91, 0xce, 0xf0, 0x15, 0xfe, 0x08, 0xbb,
-	0xec, 0xe2, 0x71, 0x82, 0xaa, 0xb7, 0x2f, 0xc3, 0xc0, 0x1b, 0x6e, 0x47, 0xc7, 0x32, 0x2f, 0x49,
-	0x62, 0x79, 0x17, 0x59, 0x42, 0x61, 0xb1, 0xf4, 0x0c, 0x7e, 0x9c, 0x62, 0xb2, 0x2b, 0xf5, 0x01,
-	0x8d, 0xc3, 0x9b, 0x66, 0xc0, 0xc2, 0x13, 0xa4, 0x65, 0x57, 0xba, 0x18, 0x87, 0x81, 0x27, 0xd6,
-	0x4e, 0x44, 0x10, 0x8a, 0xa3, 0x10, 0x61, 0x95, 0x82, 0x72, 0x80, 0x5d, 0x6a, 0xd9, 0x3c, 0xbf,
-	0xd7, 0x38, 0x67, 0xd3, 0x9d, 0x8e, 0x8b, 0x1f, 0x1f, 0xa0, 0xd2, 0xae, 0xf0, 0x10, 0xfe, 0x3c,
-	0xb9, 0xf2, 0x1c, 0x63, 0xa6, 0xa8, 0xe8, 0xf9, 0x81, 0xa4, 0xa2, 0x38, 0xed, 0xca, 0x08, 0x61,
-	0x8c, 0x37, 0xd9, 0xd4, 0xad, 0x28, 0x50, 0x6a, 0x80, 0x3e, 0x38, 0xd4, 0x50, 0xdb, 0xd1, 0x7e,
-	0x22, 0xbb, 0xb4, 0xe9, 0xa0, 0x42, 0xd4, 0xcd, 0x20, 0x0a, 0x54, 0xcf, 0x8c, 0x12, 0xc6, 0x26,
-	0xd2, 0xce, 0xaa, 0xad, 0xbc, 0xe0, 0xb0, 0x66, 0x6a, 0x83, 0x15, 0xbe, 0xc0, 0xa0, 0x7c, 0x2e,
-	0xc4, 0xe7, 0x05, 0xed, 0xd0, 0x58, 0xdb, 0x4a, 0xe4, 0xbd, 0x20, 0xea, 0x42, 0x85, 0xa4, 0x1d,
-	0xa0, 0x08, 0x8d, 0xe4, 0x06, 0x9b, 0xdc, 0x0c, 0x07, 0x46, 0x4d, 0xcd, 0x28, 0xa5, 0x03, 0xb1,
-	0x8d, 0x13, 0x89, 0x0a, 0x20, 0x46, 0x1f, 0x26, 0xf8, 0x34, 0xab, 0xdb, 0xb2, 0x27, 0xda, 0xe4,
-	0xca, 0x07, 0xd9, 0xec, 0x99, 0x57, 0x02, 0x9f, 0x62, 0xb5, 0x54, 0x35, 0xb0, 0xe6, 0x7a, 0x10,
-	0x89, 0x64, 0x68, 0x67, 0x0b, 0xf8, 0xd4, 0x73, 0x9b, 0xa1, 0x14, 0x3a, 0x05, 0x70, 0xe5, 0xa5,
-	0xa6, 0x59, 0xd3, 0xe6, 0xe2, 0x34, 0xab, 0xdf, 0x8a, 0x7c, 0x3c, 0x0e, 0x22, 0xf4, 0x61, 0xcc,
-	0xf4, 0xbc, 0xed, 0x96, 0xa2, 0xf9, 0x7c, 0x8a, 0x20, 0x19, 0x53, 0xc2, 0x90, 0x1a, 0xf7, 0x86,
-	0x50, 0x25, 0xe8, 0x98, 0xf2, 0xd6, 0x31, 0x8f, 0xc0, 0xa3, 0xf2, 0xf5, 0xae, 0xc9, 0x5b, 0x4f,
-	0xde, 0x2b, 0x30, 0x05, 0x3d, 0xd2, 0xb4, 0x85, 0xfa, 0x60, 0xa8, 0x34, 0xf6, 0xdb, 0x32, 0x3a,
-	0x0e, 0xba, 0x0a, 0x02, 0xd2, 0x74, 0x53, 0x0a, 0xbf, 0x74, 0xfd, 0x0e, 0x55, 0x8e, 0x8b, 0x21,
-	0x0a, 0x55, 0x96, 0x7a, 0xd7, 0x4c, 0x3d, 0x63, 0xea, 0x5a, 0x18, 0x08, 0x05, 0x21, 0xb9, 0x42,
-	0x56, 0xda, 0x63, 0x9f, 0x92, 0xba, 0x16, 0x6a, 0x4c, 0xec, 0x39, 0xe2, 0x0b, 0x6c, 0xd6, 0xf2,
-	0xef, 0x8b, 0x44, 0x07, 0x46, 0xc8, 0xcb, 0x8e, 0x29, 0x9f, 0x44, 0xc6, 0x05, 0xf6, 0x0a, 0x2d,
-	0x99, 0xe6, 0x0d, 0xa1, 0x0a, 0xe8, 0x27, 0x0e, 0x5f, 0x64, 0x73, 0x99, 0x6b, 0x05, 0xfe, 0x53,
-	0x87, 0xcf, 0xb3, 0x19, 0x72, 0x2d, 0xc7, 0x14, 0xfc, 0xcc, 0x80, 0xe4, 0x44, 0x09, 0xfc, 0xb9,
-	0x91, 0x90, 0x7a, 0x51, 0xc2, 0x7f, 0x61, 0x94, 0x91, 0x84, 0xb4, 0x88, 0x14, 0xbc, 0xea, 0x90,
-	0xa5, 0x99, 0xb2, 0x14, 0x86, 0xd7, 0x0c, 0x23, 0x49, 0xcd, 0x19, 0x5f, 0x37, 0x8c, 0xa9, 0xcc,
-	0x1c, 0x7d, 0xc3, 0xa0, 0x37, 0x44, 0xe4, 0xcb, 0xe3, 0xe3, 0x1c, 0x7d, 0xd3, 0xe1, 0x2d, 0x36,
-	0x4f, 0xd7, 0xd7, 0x45, 0x28, 0x22, 0xaf, 0xe0, 0x7f, 0xcb, 0xe1, 0xe7, 0x18, 0x9c, 0x51, 0xa7,
-	0xe0, 0xf9, 0x0a, 0x87, 0x2c, 0xbe, 0xa6, 0x79, 0xe0, 0x0b, 0x15, 0x13, 0xab, 0x94, 0xd1, 0x62,
-	0x5f, 0xac, 0xf0, 0x19, 0x1b, 0x74, 0x7b, 0xfe, 0x52, 0x85, 0x37, 0xd8, 0xc4, 0x76, 0xa4, 0x30,
-	0xd1, 0xf0, 0x19, 0xaa, 0xef, 0x09, 0x3b, 0x41, 0xe1, 0xb3, 0xd4, 0x46, 0xe3, 0xa6, 0xbe, 0xe1,
-	0x45, 0xda, 0xce, 0xdc, 0x45, 0x85, 0x91, 0x5f, 0xea, 0x1d, 0x05, 0x9f, 0x33, 0x37, 0xec, 0xfa,
-	0x83, 0xbf, 0x56, 0x4d, 0x68, 0xca, 0xbb, 0xf0, 0x6f, 0x55, 0x32, 0x61, 0x0b, 0x75, 0xd1, 0xce,
-	0xf0, 0xf7, 0x2a, 0xbf, 0xc0, 0xce, 0x65, 0x98, 0xd9, 0x4c, 0x79, 0x23, 0xff, 0xa3, 0xca, 0x2f,
-	0xb1, 0xf3, 0x34, 0xa6, 0xf3, 0xba, 0xa1, 0x4b, 0x81, 0xd2, 0x81, 0xa7, 0xe0, 0x9f, 0x55, 0x7e,
-	0x91, 0x2d, 0x6e, 0xa1, 0xce, 0xf3, 0x51, 0x22, 0xfe, 0xab, 0xca, 0xa7, 0xd9, 0x94, 0x4b, 0xab,
-	0x0b, 0x4f, 0x10, 0x5e, 0xad, 0x52, 0x52, 0xb3, 0x63, 0x6a, 0xce, 0x6b, 0x55, 0x0a, 0xf5, 0xb3,
-	0x42, 0x7b, 0xbd, 0x4e, 0xbf, 0xdd, 0x13, 0x51, 0x84, 0xa1, 0x82, 0xd7, 0xab, 0x14, 0x50, 0x17,
-	0xfb, 0xf2, 0x04, 0x4b, 0xf0, 0x1b, 0xc6, 0x69, 0xc3, 0xfc, 0xe1, 0x01, 0x26, 0xc3, 0x9c, 0xf0,
-	0x66, 0x95, 0x52, 0x63, 0xf9, 0x47, 0x29, 0x6f, 0x55, 0xf9, 0x65, 0xd6, 0xb2, 0xc3, 0x22, 0x4b,
-	0x0c, 0x11, 0xbb, 0x48, 0xe3, 0x15, 0x9e, 0xaf, 0xe5, 0x12, 0x3b, 0x18, 0x6a, 0x91, 0xdf, 0xfb,
-	0x64, 0x8d, 0xec, 0xa2, 0xe6, 0x2a, 0xa6, 0xaa, 0x82, 0x17, 0x6a, 0x94, 0xd1, 0x2d, 0xd4, 0xe9,
-	0x60, 0x55, 0xf0, 0x29, 0x83, 0xa4, 0x92, 0x8d, 0xc8, 0x5f, 0xd5, 0xf8, 0x2c, 0x63, 0xb6, 0x27,
-	0x0d, 0xf0, 0xeb, 0x4c, 0x14, 0xbd, 0x5d, 0x4e, 0x30, 0x31, 0x83, 0x1d, 0x7e, 0x93, 0x2b, 0x28,
-	0x4d, 0x3e, 0xf8, 0x6d, 0x8d, 0x42, 0x76, 0x18, 0xf4, 0xf1, 0x30, 0xf0, 0xee, 0xc2, 0x57, 0xea,
-	0x14, 0x32, 0xe3, 0xd1, 0xae, 0xf4, 0xd1, 0x66, 0xf8, 0xab, 0x75, 0x2a, 0x18, 0xaa, 0x43, 0x5b,
-	0x30, 0x5f, 0x33, 0xe7, 0x74, 0x7a, 0x6f, 0x77, 0xe0, 0xeb, 0xf4, 0x86, 0x62, 0xe9, 0xf9, 0xf0,
-	0x60, 0x0f, 0xbe, 0x51, 0x27, 0x55, 0x6b, 0x61, 0x28, 0x3d, 0xa1, 0xf3, 0x6e, 0xf8, 0x66, 0x9d,
-	0xda, 0xa9, 0xa4, 0x3d, 0xcd, 0xda, 0x4b, 0x75, 0x8a, 0x7d, 0x8a, 0x9b, 0x62, 0xeb, 0xd0, 0x50,
-	0xfc, 0x96, 0x91, 0x4a, 0xdf, 0x7b, 0x64, 0xc9, 0xa1, 0x86, 0x6f, 0x1b, 0xbe, 0xb3, 0xcf, 0x02,
-	0xf8, 0x5d, 0x23, 0xad, 0xaf, 0x12, 0xf6, 0xfb, 0x86, 0xed, 0x8f, 0xd1, 0x77, 0x00, 0xfc, 0xc1,
-	0xc0, 0x67, 0xdf, 0x0e, 0xf0, 0xc7, 0x06, 0x19, 0x56, 0x5e, 0xff, 0xf4, 0x08, 0x56, 0xf0, 0xa7,
-	0x06, 0x59, 0x50, 0x2c, 0x7a, 0xf8, 0x4e, 0x93, 0x82, 0x95, 0xad, 0x78, 0xf8, 0x6e, 0x93, 0xdc,
-	0x3c, 0xb3, 0xdc, 0xe1, 0x7b, 0x4d, 0x93, 0x8e, 0x7c, 0xad, 0xc3, 0xf7, 0x4b, 0x00, 0x71, 0xc1,
-	0x0f, 0x9a, 0x66, 0x02, 0x8d, 0xac, 0x72, 0xf8, 0x61, 0x93, 0x6c, 0x3b, 0xbb, 0xc4, 0xe1, 0x47,
-	0x4d, 0x9b, 0xee, 0x7c, 0x7d, 0xc3, 0x8f, 0x9b, 0xd4, 0x01, 0x0f, 0x5e, 0xdc, 0xf0, 0xb2, 0xd1,
-	0x55, 0xac, 0x6c, 0x78, 0xa5, 0xb9, 0xb2, 0xcc, 0x26, 0x3b, 0x2a, 0x34, 0x7b, 0x63, 0x92, 0x55,
-	0x3b, 0x2a, 0x84, 0x31, 0x1a, 0xb3, 0xeb, 0x52, 0x86, 0x1b, 0xa7, 0x71, 0xf2, 0xcc, 0x7b, 0xc0,
-	0x59, 0x59, 0x67, 0xb3, 0x6d, 0xd9, 0x8f, 0x45, 0xde, 0x6e, 0x66, 0x55, 0xd8, 0x1d, 0x83, 0xbe,
-	0x2d, 0x95, 0x31, 0x9a, 0xd5, 0x1b, 0xa7, 0xe8, 0x0d, 0xcc, 0x46, 0x73, 0xe8, 0x48, 0x97, 0x28,
-	0xc8, 0x3e, 0x54, 0x56, 0x9e, 0x63, 0xd0, 0x96, 0x91, 0x0a, 0x94, 0xc6, 0xc8, 0x1b, 0xde, 0xc4,
-	0x13, 0x0c, 0xcd, 0xde, 0xd4, 0x89, 0x8c, 0xba, 0x30, 0x66, 0xbe, 0x13, 0xd0, 0xbc, 0xf7, 0xed,
-	0x76, 0x5d, 0xa7, 0xb7, 0x80, 0xf9, 0x18, 0x98, 0x61, 0x6c, 0xe3, 0x04, 0x23, 0x3d, 0x10, 0x61,
-	0x38, 0x84, 0x2a, 0x9d, 0xdb, 0x03, 0xa5, 0x65, 0x3f, 0xf8, 0x84, 0xd9, 0xdf, 0x5f, 0x76, 0x58,
-	0xc3, 0xae, 0xd2, 0xdc, 0x34, 0x7b, 0xdc, 0xc7, 0xc8, 0x0f, 0x8c, 0x70, 0x7a, 0xcb, 0x1a, 0x28,
-	0x5d, 0xfa, 0x4e, 0xc1, 0x74, 0xa0, 0x45, 0xa2, 0xb3, 0x8f, 0x0e, 0x0b, 0x75, 0xe4, 0xbd, 0x28,
-	0x94, 0xc2, 0x37, 0xfb, 0x3c, 0xbf, 0xba, 0x2f, 0x12, 0x65, 0x96, 0x3a, 0x3d, 0xf5, 0x53, 0xf9,
-	0x89, 0xf1, 0xc7, 0x87, 0xf1, 0x02, 0x2c, 0x7c, 0x9e, 0xa0, 0xe5, 0x69, 0x41, 0x53, 0xec, 0x59,
-	0xa5, 0xb3, 0x95, 0xeb, 0x8c, 0x15, 0x9f, 0x79, 0xc6, 0x9f, 0x62, 0x09, 0x8e, 0x51, 0x54, 0xb6,
-	0x42, 0x79, 0x24, 0x42, 0x70, 0xe8, 0x0d, 0x60, 0x8a, 0xa2, 0xb2, 0xf2, 0xe9, 0x71, 0x36, 0x7b,
-	0xe6, 0xa3, 0x8e, 0x6c, 0xcb, 0x0f, 0x6b, 0x21, 0x65, 0xee, 0x32, 0x7b, 0x28, 0x47, 0xee, 0x5b,
-	0xfa, 0x0e, 0x3d, 0x04, 0x73, 0xf2, 0x99, 0xed, 0x5f, 0xe1, 0x57, 0xd8, 0xc5, 0x82, 0x78, 0xff,
-	0xce, 0xa7, 0xc1, 0xdb, 0xca, 0x19, 0xce, 0x2e, 0xff, 0x1a, 0x45, 0x34, 0xa7, 0xd2, 0x34, 0xb0,
-	0x9f, 0x60, 0xc5, 0x17, 0xa8, 0x5d, 0x6a, 0x30, 0x41, 0x5f, 0x45, 0x85, 0x8d, 0x79, 0x59, 0xc1,
-	0x24, 0xc5, 0x30, 0x27, 0xa4, 0x0b, 0x67, 0x6a, 0x04, 0x4c, 0x17, 0x4f, 0x9d, 0x5e, 0xcd, 0x39,
-	0x48, 0x33, 0xab, 0x18, 0x17, 0x8c, 0xde, 0xea, 0x67, 0x42, 0x60, 0xe7, 0x52, 0x63, 0x84, 0x62,
-	0xb0, 0x0e, 0x6a, 0x11, 0x84, 0xd0, 0xa4, 0x44, 0x8d, 0xc4, 0xc5, 0xde, 0x98, 0x1e, 0x51, 0x9e,
-	0xee, 0xb0, 0x19, 0x7a, 0xcf, 0x14, 0xcf, 0x68, 0xb3, 0xfd, 0x66, 0x47, 0x30, 0x33, 0x1f, 0x01,
-	0x46, 0xd4, 0x95, 0xd6, 0x34, 0xcc, 0x8d, 0x3a, 0x6a, 0x0a, 0x04, 0xf8, 0x48, 0x74, 0xad, 0xdd,
-	0x7b, 0xf7, 0x22, 0x4c, 0x54, 0x2f, 0x88, 0x61, 0x7e, 0x24, 0x68, 0x76, 0x44, 0x99, 0xba, 0x58,
-	0x18, 0x09, 0x05, 0x99, 0x5e, 0x5c, 0x3a, 0x37, 0x9a, 0x30, 0x33, 0x24, 0x0a, 0xea, 0xe2, 0x08,
-	0x75, 0x47, 0x44, 0xa2, 0x5b, 0x52, 0x78, 0x7e, 0x44, 0x61, 0x69, 0x3a, 0xb5, 0x3e, 0x20, 0xd9,
-	0x5c, 0xfe, 0x17, 0xc4, 0x6d, 0x3c, 0xd5, 0xb7, 0xe5, 0xd1, 0x1d, 0x7e, 0x65, 0xd5, 0xfe, 0x75,
-	0xb8, 0x9a, 0xfd, 0x75, 0xb8, 0xba, 0x83, 0x4a, 0x91, 0xc8, 0xd8, 0xd4, 0x47, 0xeb, 0x2f, 0x93,
-	0xe6, 0xbf, 0x95, 0x47, 0x1e, 0xfc, 0x8f, 0x55, 0xe9, 0xbf, 0x12, 0x77, 0x36, 0x2e, 0x9d, 0xf6,
-	0x8e, 0xee, 0xac, 0x3f, 0xcb, 0x66, 0x02, 0x99, 0xdd, 0xeb, 0x26, 0xb1, 0xb7, 0xde, 0x68, 0x9b,
-	0x7b, 0xfb, 0x24, 0x63, 0xdf, 0xf9, 0xc8, 0x93, 0xdd, 0x40, 0xf7, 0x06, 0x47, 0x24, 0xed, 0x9a,
-	0x65, 0x7b, 0x22, 0x90, 0xe9, 0xaf, 0x6b, 0x41, 0xa4, 0x69, 0x62, 0x87, 0xf6, 0x4f, 0xcd, 0x6b,
-	0x56, 0x63, 0x7c, 0xf4, 0x79, 0xc7, 0x39, 0x9a, 0x30, 0xd0, 0x93, 0xff, 0x0e, 0x00, 0x00, 0xff,
-	0xff, 0xa4, 0x96, 0x88, 0xfc, 0x1a, 0x15, 0x00, 0x00,
+	// 2632 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x49, 0x70, 0x24, 0x47,
+	0xd5, 0x9e, 0x52, 0xb7, 0xa4, 0xe9, 0xec, 0x1e, 0xe9, 0x29, 0xa5, 0xd1, 0xc8, 0xb3, 0x78, 0xc6,
+	0xfa, 0xed, 0x9f, 0x41, 0xd8, 0x1a, 0x7b, 0x1c, 0x01, 0x04, 0x11, 0x26, 0x90, 0xba, 0x25, 0x8d,
+	0x62, 0x46, 0x8b, 0x5b, 0x1a, 0xdb, 0x41, 0x04, 0x28, 0x52, 0x55, 0x4f, 0xdd, 0x35, 0x53, 0x5d,
+	0x59, 0xce, 0xcc, 0xd6, 0xa8, 0x39, 0x19, 0x13, 0x70, 0x06, 0x73, 0xe5, 0xc0, 0x81, 0x23, 0xfb,
+	0x7e, 0x64, 0xc7, 0x1b, 0x70, 0x65, 0x31, 0xcb, 0x11, 0xee, 0xac, 0x5e, 0x89, 0x97, 0x59, 0x5b,
+	0x6b, 0xc6, 0x70, 0xe0, 0x56, 0xf9, 0xbd, 0x97, 0xef, 0xbd, 0x7c, 0xf9, 0xb6, 0x2c, 0xd6, 0xf0,
+	0x65, 0xaf, 0x27, 0xe3, 0xc5, 0x44, 0x49, 0x23, 0xf9, 0x74, 0x2f, 0x8c, 0x0e, 0xfb, 0xda, 0xad,
+	0x16, 0x1d, 0xe9, 0xec, 0xa5, 0x8e, 0x94, 0x9d, 0x08, 0xaf, 0x58, 0x70, 0xbf, 0x7f, 0x70, 0x25,
+	0x40, 0xed, 0xab, 0x30, 0x31, 0x52, 0x39, 0xc6, 0xf9, 0x4f, 0x7b, 0x6c, 0x6c, 0xc7, 0x08, 0xd3,
+	0xd7, 0xfc, 0x09, 0xc6, 0x50, 0x29, 0xa9, 0xf6, 0x7c, 0x19, 0xe0, 0x9c, 0x77, 0xc9, 0xbb, 0x3c,
+	0x71, 0xf5, 0xfe, 0xc5, 0x7b, 0x88, 0x5d, 0x5c, 0x21, 0xb6, 0xa6, 0x0c, 0xb0, 0x5d, 0xc3, 0xec,
+	0x93, 0xcf, 0xb2, 0x31, 0x85, 0x42, 0xcb, 0x78, 0x6e, 0xe4, 0x92, 0x77, 0xb9, 0xd6, 0x4e, 0x57,
+	0xfc, 0x41, 0x36, 0xa1, 0xd0, 0xa8, 0xc1, 0x9e, 0x38, 0x30, 0xa8, 0xf6, 0x7a, 0x7a, 0xae, 0x72,
+	0xc9, 0xbb, 0x5c, 0x69, 0x37, 0x2c, 0xba, 0x44, 0xe0, 0x86, 0x9e, 0x7f, 0x3f, 0x6b, 0x5c, 0xc7,
+	0xc1, 0x53, 0x22, 0xea, 0xe3, 0xb6, 0x08, 0x15, 0x07, 0x56, 0xb9, 0x8d, 0x03, 0x6b, 0x45, 0xad,
+	0x4d, 0x9f, 0x7c, 0x86, 0x8d, 0x1e, 0x12, 0x39, 0x15, 0xef, 0x16, 0xf3, 0x8f, 0xb3, 0xfa, 0x75,
+	0x1c, 0xb4, 0x84, 0x11, 0xef, 0xb2, 0x8d, 0xb3, 0x6a, 0x20, 0x8c, 0xb0, 0xbb, 0x1a, 0x6d, 0xfb,
+	0x3d, 0x7f, 0x9e, 0x55, 0x97, 0x23, 0xb9, 0x5f, 0x88, 0xf4, 0x2c, 0x31, 0x15, 0x79, 0xc8, 0x60,
+	0x3b, 0x12, 0x3e, 0x76, 0x65, 0x14, 0xa0, 0xb2, 0x26, 0x91, 0x5c, 0x23, 0x3a, 0x99, 0x5c, 0x23,
+	0x3a, 0xfc, 0x83, 0xac, 0x6a, 0x06, 0x89, 0xb3, 0x66, 0xe2, 0xea, 0x83, 0xf7, 0xf4, 0x53, 0x49,
+	0xcc, 0xee, 0x20, 0xc1, 0xb6, 0xdd, 0x41, 0x8e, 0xb2, 0x8a, 0xc8, 0x11, 0x95, 0xcb, 0x8d, 0x76,
+	0xba, 0x9a, 0xff, 0xd8, 0x90, 0xde, 0x35, 0x25, 0xfb, 0x09, 0x5f, 0x67, 0x8d, 0xa4, 0xc0, 0xf4,
+	0x9c, 0x77, 0xa9, 0x72, 0xb9, 0x7e, 0xf5, 0xa1, 0xff, 0xa6, 0xcd, 0x1a, 0xdd, 0x1e, 0xda, 0x3a,
+	0xff, 0x08, 0x1b, 0x5f, 0x0a, 0x02, 0x85, 0x5a, 0xf3, 0x09, 0x36, 0x12, 0x26, 0xe9, 0x61, 0x46,
+	0xc2, 0x84, 0x7c, 0x94, 0x48, 0x65, 0xec, 0x59, 0x2a, 0x6d, 0xfb, 0x3d, 0xff, 0x82, 0xc7, 0xc6,
+	0x37, 0x74, 0x67, 0x59, 0x68, 0xe4, 0x1f, 0x60, 0x27, 0x7b, 0xba, 0xb3, 0x67, 0xcf, 0xeb, 0xe2,
+	0xe2, 0xfc, 0x3d, 0x2d, 0xd8, 0xd0, 0x1d, 0x7b, 0xce, 0xf1, 0x9e, 0xfb, 0x20, 0x07, 0xf7, 0x74,
+	0x67, 0xbd, 0x95, 0x4a, 0x76, 0x0b, 0x7e, 0x9e, 0xd5, 0x4c, 0xd8, 0x43, 0x6d, 0x44, 0x2f, 0xb1,
+	0xc1, 0x50, 0x6d, 0x17, 0x00, 0x3f, 0xcb, 0x4e, 0x6a, 0xd9, 0x57, 0x3e, 0xae, 0xb7, 0xe6, 0xaa,
+	0x76, 0x5b, 0xbe, 0x9e, 0x7f, 0x82, 0xd5, 0x36, 0x74, 0xe7, 0x1a, 0x8a, 0x00, 0x15, 0x7f, 0x94,
+	0x55, 0xf7, 0x85, 0x76, 0x16, 0xd5, 0xdf, 0xdd, 0x22, 0x3a, 0x41, 0xdb, 0x72, 0xce, 0x7f, 0x9c,
+	0x35, 0x5a, 0x1b, 0x37, 0xfe, 0x07, 0x09, 0x64, 0xba, 0xee, 0x0a, 0x15, 0x6c, 0x8a, 0x5e, 0x16,
+	0x88, 0x05, 0x30, 0xff, 0x86, 0xc7, 0x1a, 0xdb, 0x2a, 0x3c, 0x0c, 0x23, 0xec, 0xe0, 0xca, 0x91,
+	0xe1, 0x1f, 0x61, 0x75, 0xb9, 0x7f, 0x0b, 0x7d, 0x53, 0xf6, 0xdd, 0xc5, 0x7b, 0xea, 0xd9, 0xb2,
+	0x7c, 0xd6, 0x7d, 0x4c, 0xe6, 0xdf, 0x7c, 0x8b, 0x41, 0x2a, 0x21, 0xc9, 0x04, 0xff, 0xc7, 0x90,
+	0x73, 0x62, 0x72, 0x23, 0xda, 0x93, 0x72, 0x18, 0xe0, 0x0b, 0x6c, 0x2a, 0x15, 0x18, 0x8b, 0x1e,
+	0xee, 0x85, 0x71, 0x80, 0x47, 0xf6, 0x12, 0x46, 0x33, 0x5e, 0x3a, 0xca, 0x3a, 0xc1, 0xfc, 0x61,
+	0xc6, 0xef, 0xe2, 0xd5, 0xf6, 0x52, 0x46, 0xdb, 0x70, 0x8c, 0x59, 0x2f, 0x7c, 0xa1, 0xc6, 0x6a,
+	0x79, 0x65, 0xe0, 0x75, 0x36, 0xbe, 0xd3, 0xf7, 0x7d, 0xd4, 0x1a, 0x4e, 0xf0, 0x69, 0x36, 0x79,
+	0x33, 0xc6, 0xa3, 0x04, 0x7d, 0x83, 0x81, 0xe5, 0x01, 0x8f, 0x4f, 0xb1, 0x53, 0x4d, 0x19, 0xc7,
+	0xe8, 0x9b, 0x55, 0x11, 0x46, 0x18, 0xc0, 0x08, 0x9f, 0x61, 0xb0, 0x8d, 0xaa, 0x17, 0x6a, 0x1d,
+	0xca, 0xb8, 0x85, 0x71, 0x88, 0x01, 0x54, 0xf8, 0x19, 0x36, 0xdd, 0x94, 0x51, 0x84, 0xbe, 0x09,
+	0x65, 0xbc, 0x29, 0xcd, 0xca, 0x51, 0xa8, 0x8d, 0x86, 0x2a, 0x89, 0x5d, 0x8f, 0x22, 0xec, 0x88,
+	0x68, 0x49, 0x75, 0xfa, 0x3d, 0x8c, 0x0d, 0x8c, 0x92, 0x8c, 0x14, 0x6c, 0x85, 0x3d, 0x8c, 0x49,
+	0x12, 0x8c, 0x97, 0x50, 0x6b, 0x2d, 0xf9, 0x16, 0x4e, 0xf2, 0xfb, 0xd8, 0xe9, 0x14, 0x2d, 0x29,
+	0x10, 0x3d, 0x84, 0x1a, 0x9f, 0x64, 0xf5, 0x94, 0xb4, 0xbb, 0xb5, 0x7d, 0x1d, 0x58, 0x49, 0x42,
+	0x5b, 0xde, 0x69, 0xa3, 0x2f, 0x55, 0x00, 0xf5, 0x92, 0x09, 0x4f, 0xa1, 0x6f, 0xa4, 0x5a, 0x6f,
+	0x41, 0x83, 0x0c, 0x4e, 0xc1, 0x1d, 0x14, 0xca, 0xef, 0xb6, 0x51, 0xf7, 0x23, 0x03, 0xa7, 0x38,
+	0xb0, 0xc6, 0x6a, 0x18, 0xe1, 0xa6, 0x34, 0xab, 0xb2, 0x1f, 0x07, 0x30, 0xc1, 0x27, 0x18, 0xdb,
+	0x40, 0x23, 0x52, 0x0f, 0x4c, 0x92, 0xda, 0xa6, 0xf0, 0xbb, 0x98, 0x02, 0xc0, 0x67, 0x19, 0x6f,
+	0x8a, 0x38, 0x96, 0xa6, 0xa9, 0x50, 0x18, 0x5c, 0xb5, 0xd9, 0x0c, 0x53, 0x64, 0xce, 0x10, 0x1e,
+	0x46, 0x08, 0xbc, 0xe0, 0x6e, 0x61, 0x84, 0x39, 0xf7, 0x74, 0xc1, 0x9d, 0xe2, 0xc4, 0x3d, 0x43,
+	0xc6, 0x2f, 0xf7, 0xc3, 0x28, 0xb0, 0x2e, 0x71, 0xd7, 0x72, 0x9a, 0x6c, 0x4c, 0x8d, 0xdf, 0xbc,
+	0xb1, 0xbe, 0xb3, 0x0b, 0xb3, 0xfc, 0x34, 0x9b, 0x4a, 0x91, 0x0d, 0x34, 0x2a, 0xf4, 0xad, 0xf3,
+	0xce, 0x90, 0xa9, 0x5b, 0x7d, 0xb3, 0x75, 0xb0, 0x81, 0x3d, 0xa9, 0x06, 0x30, 0x47, 0x17, 0x6a,
+	0x25, 0x65, 0x57, 0x04, 0xf7, 0x91, 0x86, 0x95, 0x5e, 0x62, 0x06, 0x85, 0x7b, 0xe1, 0x2c, 0x3f,
+	0xc7, 0xce, 0xdc, 0x4c, 0x02, 0x61, 0x70, 0xbd, 0x47, 0xa5, 0x66, 0x57, 0xe8, 0xdb, 0x74, 0xdc,
+	0xbe, 0x42, 0x38, 0xc7, 0xcf, 0xb2, 0xd9, 0xe1, 0xbb, 0xc8, 0x9d, 0x75, 0x9e, 0x36, 0xba, 0xd3,
+	0x36, 0x15, 0x06, 0x18, 0x9b, 0x50, 0x44, 0xd9, 0xc6, 0x0b, 0x85, 0xd4, 0xbb, 0x89, 0xf7, 0x13,
+	0xd1, 0x9d, 0xfc, 0x6e, 0xe2, 0x45, 0x3e, 0xc7, 0x66, 0xd6, 0xd0, 0xdc, 0x4d, 0xb9, 0x44, 0x94,
+	0x1b, 0xa1, 0xb6, 0xa4, 0x9b, 0x1a, 0x95, 0xce, 0x28, 0x0f, 0x70, 0xce, 0x26, 0xd6, 0xd0, 0x10,
+	0x98, 0x61, 0xf3, 0xe4, 0x27, 0x67, 0x5e, 0x5b, 0x46, 0x98, 0xc1, 0xff, 0x47, 0x3e, 0x68, 0x29,
+	0x99, 0x94, 0xc1, 0x07, 0xe9, 0x98, 0x5b, 0x09, 0x2a, 0x61, 0x90, 0x64, 0x94, 0x69, 0x0f, 0x91,
+	0x9c, 0x1d, 0x24, 0x0f, 0x94, 0xe1, 0xff, 0x2f, 0xe0, 0xb2, 0xd6, 0xf7, 0x50, 0x0c, 0xa7, 0xdc,
+	0xe8, 0xea, 0x64, 0x46, 0xba, 0x4c, 0xa7, 0x4e, 0x95, 0xe4, 0xf9, 0x9f, 0x11, 0xdf, 0x4b, 0xa1,
+	0xe2, 0xf6, 0xad, 0x29, 0x11, 0x9b, 0x0c, 0x5f, 0xe0, 0x0f, 0xb0, 0x0b, 0x6d, 0x3c, 0x50, 0xa8,
+	0xbb, 0xdb, 0x32, 0x0a, 0xfd, 0xc1, 0x7a, 0x7c, 0x20, 0xf3, 0x90, 0x24, 0x96, 0xf7, 0x91, 0x25,
+	0xe4, 0x16, 0x47, 0xcf, 0xe0, 0x87, 0xc9, 0x27, 0x9b, 0xd2, 0xec, 0x50, 0x39, 0xbc, 0x61, 0x0b,
+	0x2c, 0x3c, 0x42, 0x5a, 0x36, 0x65, 0x1b, 0x93, 0x28, 0xf4, 0xc5, 0xd2, 0xa1, 0x08, 0x23, 0xb1,
+	0x1f, 0x21, 0x2c, 0x92, 0x53, 0x76, 0xb0, 0x43, 0x29, 0x9b, 0xdf, 0xef, 0x95, 0x92, 0xbd, 0x6d,
+	0x79, 0x67, 0x58, 0xfa, 0xa3, 0xe4, 0x31, 0x52, 0x9a, 0x51, 0x42, 0xcc, 0x6f, 0xe3, 0x31, 0xca,
+	0xa2, 0x1d, 0x54, 0x87, 0xa8, 0x96, 0xfb, 0x7a, 0x00, 0x57, 0x39, 0x67, 0xa7, 0x5a, 0xad, 0x36,
+	0x3e, 0xdb, 0x47, 0x6d, 0xda, 0xc2, 0x47, 0xf8, 0xf3, 0xf8, 0xc2, 0x33, 0x8c, 0xd9, 0xe8, 0xa4,
+	0x69, 0x07, 0xc9, 0xd6, 0x62, 0xb5, 0x29, 0x63, 0x84, 0x13, 0xbc, 0xc1, 0x4e, 0xde, 0x8c, 0x43,
+	0xad, 0xfb, 0x18, 0x80, 0x47, 0x32, 0xd7, 0xe3, 0x6d, 0x25, 0x3b, 0xd4, 0x32, 0x61, 0x84, 0xa8,
+	0xab, 0x61, 0x1c, 0xea, 0xae, 0xad, 0x49, 0x8c, 0x8d, 0xa5, 0x29, 0x5a, 0x5d, 0x78, 0xde, 0x63,
+	0x8d, 0xf4, 0x30, 0x4e, 0xf8, 0x0c, 0x83, 0xf2, 0xba, 0x10, 0x9f, 0x67, 0x86, 0x47, 0xf5, 0x71,
+	0x4d, 0xc9, 0x3b, 0x61, 0xdc, 0x81, 0x11, 0x92, 0xb6, 0x83, 0x22, 0xb2, 0x92, 0xeb, 0x6c, 0x7c,
+	0x35, 0xea, 0x5b, 0x35, 0x55, 0xab, 0x94, 0x16, 0xc4, 0x36, 0x4a, 0x24, 0x8a, 0xa4, 0x04, 0x03,
+	0x18, 0xe3, 0xa7, 0x58, 0xcd, 0xe5, 0x0f, 0xd1, 0xc6, 0x17, 0x3e, 0xcc, 0x26, 0x8f, 0x8d, 0x1b,
+	0xfc, 0x24, 0xab, 0xa6, 0xaa, 0x81, 0x35, 0x96, 0xc3, 0x58, 0xa8, 0x81, 0x2b, 0x52, 0x10, 0x50,
+	0xf2, 0xae, 0x46, 0x52, 0x98, 0x14, 0xc0, 0x85, 0x2f, 0x4d, 0xd8, 0x7e, 0x6f, 0x37, 0x9e, 0x62,
+	0xb5, 0x9b, 0x71, 0x80, 0x07, 0x61, 0x8c, 0x01, 0x9c, 0xb0, 0xc5, 0xc3, 0xa5, 0x5d, 0x91, 0xc5,
+	0x01, 0x79, 0x90, 0x8c, 0x29, 0x61, 0x48, 0x15, 0xe0, 0x9a, 0xd0, 0x25, 0xe8, 0x80, 0x02, 0xa0,
+	0x65, 0x87, 0xce, 0xfd, 0xf2, 0xf6, 0x8e, 0x0d, 0x80, 0xae, 0xbc, 0x53, 0x60, 0x1a, 0xba, 0xa4,
+	0x69, 0x0d, 0xcd, 0xce, 0x40, 0x1b, 0xec, 0x35, 0x65, 0x7c, 0x10, 0x76, 0x34, 0x84, 0xa4, 0xe9,
+	0x86, 0x14, 0x41, 0x69, 0xfb, 0x2d, 0x0a, 0xc1, 0x36, 0x46, 0x28, 0x74, 0x59, 0xea, 0x6d, 0x5b,
+	0x3e, 0xad, 0xa9, 0x4b, 0x51, 0x28, 0x34, 0x44, 0x74, 0x14, 0xb2, 0xd2, 0x2d, 0x7b, 0x74, 0xa9,
+	0x4b, 0x91, 0x41, 0xe5, 0xd6, 0x31, 0xf1, 0xdb, 0xb5, 0x0d, 0x5a, 0x0d, 0x92, 0xcc, 0xdd, 0x55,
+	0xfd, 0xd8, 0x1f, 0x3e, 0x6d, 0x42, 0x17, 0xb1, 0x14, 0x04, 0xab, 0x21, 0x46, 0x01, 0x3c, 0x9b,
+	0x49, 0x75, 0x4b, 0x45, 0x06, 0x3a, 0xad, 0x3b, 0xb1, 0x48, 0x74, 0x57, 0x1a, 0xd0, 0xe4, 0x72,
+	0x62, 0xc9, 0x11, 0x43, 0xce, 0xa1, 0x00, 0xce, 0x10, 0x0d, 0x7d, 0x3e, 0xc3, 0x26, 0xdd, 0xc6,
+	0x6d, 0xa1, 0x4c, 0x68, 0x55, 0xbd, 0xe8, 0xd9, 0xe8, 0x55, 0x32, 0x29, 0xb0, 0x97, 0xa8, 0x59,
+	0x36, 0xae, 0x09, 0x5d, 0x40, 0x2f, 0x7b, 0x7c, 0x96, 0x4d, 0x65, 0x9e, 0x2d, 0xf0, 0x57, 0x3c,
+	0x3e, 0xcd, 0x26, 0xc8, 0xb3, 0x39, 0xa6, 0xe1, 0x55, 0x0b, 0x92, 0x0f, 0x4b, 0xe0, 0x2f, 0xac,
+	0x84, 0xd4, 0x89, 0x25, 0xfc, 0x97, 0x16, 0xcf, 0x9c, 0x50, 0x48, 0xfe, 0x95, 0x35, 0x82, 0x24,
+	0xa7, 0xb1, 0xad, 0xe1, 0x75, 0x8f, 0x4e, 0x90, 0x19, 0x91, 0xc2, 0xf0, 0x86, 0x65, 0x24, 0x6d,
+	0x39, 0xe3, 0x9b, 0x96, 0x31, 0xd5, 0x95, 0xa3, 0x6f, 0x59, 0xf4, 0x9a, 0x88, 0x03, 0x79, 0x70,
+	0x90, 0xa3, 0x6f, 0x7b, 0x7c, 0x8e, 0x4d, 0xd3, 0xf6, 0x65, 0x11, 0x89, 0xd8, 0x2f, 0xf8, 0xdf,
+	0xf1, 0xf8, 0x69, 0x06, 0xc7, 0xd4, 0x69, 0x78, 0x6e, 0x84, 0x43, 0x76, 0xed, 0x36, 0xa7, 0xe1,
+	0xcb, 0x23, 0xd6, 0x87, 0x29, 0xa3, 0xc3, 0xbe, 0x32, 0xc2, 0x27, 0xdc, 0xad, 0xb9, 0xf5, 0x57,
+	0x47, 0x78, 0x9d, 0x8d, 0xad, 0xc7, 0x1a, 0x95, 0x81, 0xcf, 0x52, 0xda, 0x8d, 0xb9, 0x0e, 0x01,
+	0x9f, 0xa3, 0xec, 0x1e, 0xb5, 0x69, 0x07, 0x2f, 0xd0, 0xf4, 0xc1, 0xdb, 0xa8, 0x31, 0x0e, 0x4a,
+	0x29, 0xad, 0xe1, 0xf3, 0x76, 0x87, 0x6b, 0xef, 0xf0, 0xd7, 0x8a, 0x75, 0x4d, 0xb9, 0xd7, 0xff,
+	0xad, 0x42, 0x26, 0xac, 0xa1, 0x29, 0xaa, 0x0c, 0xfc, 0xbd, 0xc2, 0xcf, 0xb2, 0xd3, 0x19, 0x66,
+	0x3b, 0x6f, 0x5e, 0x5f, 0xfe, 0x51, 0xe1, 0xe7, 0xd9, 0x19, 0x6a, 0x43, 0x79, 0xd4, 0xd1, 0xa6,
+	0x50, 0x9b, 0xd0, 0xd7, 0xf0, 0xcf, 0x0a, 0x3f, 0xc7, 0x66, 0xd7, 0xd0, 0xe4, 0xd7, 0x51, 0x22,
+	0xfe, 0xab, 0xc2, 0x4f, 0xb1, 0x93, 0x6d, 0x6a, 0xcd, 0x78, 0x88, 0xf0, 0x7a, 0x85, 0x2e, 0x3b,
+	0x5b, 0xa6, 0xe6, 0xbc, 0x51, 0x21, 0x57, 0x3f, 0x2d, 0x8c, 0xdf, 0x6d, 0xf5, 0x9a, 0x5d, 0x11,
+	0xc7, 0x18, 0x69, 0x78, 0xb3, 0x42, 0x0e, 0x6d, 0x63, 0x4f, 0x1e, 0x62, 0x09, 0x7e, 0xcb, 0x1e,
+	0xda, 0x32, 0x3f, 0xd9, 0x47, 0x35, 0xc8, 0x09, 0x6f, 0x57, 0xe8, 0x6a, 0x1c, 0xff, 0x30, 0xe5,
+	0x9d, 0x0a, 0xbf, 0xc0, 0xe6, 0x5c, 0x0d, 0xcb, 0x2e, 0x86, 0x88, 0x1d, 0xa4, 0xf6, 0x01, 0xcf,
+	0x55, 0x73, 0x89, 0x2d, 0x8c, 0x8c, 0xc8, 0xf7, 0x7d, 0xb2, 0x4a, 0x76, 0x51, 0xce, 0x17, 0x5d,
+	0x43, 0xc3, 0xf3, 0x55, 0xba, 0xd1, 0x35, 0x34, 0x69, 0xe3, 0xd0, 0xf0, 0x29, 0x8b, 0xa4, 0x92,
+	0xad, 0xc8, 0x5f, 0x57, 0xf9, 0x24, 0x63, 0xae, 0x54, 0x58, 0xe0, 0x37, 0x99, 0x28, 0x9a, 0xcd,
+	0x0e, 0x51, 0xd9, 0xc6, 0x05, 0xbf, 0xcd, 0x15, 0x14, 0xb7, 0x87, 0xf0, 0xbb, 0x2a, 0x1d, 0xbc,
+	0xd9, 0x45, 0xff, 0xf6, 0xb6, 0x0a, 0x7b, 0x42, 0x0d, 0xae, 0xe3, 0x40, 0xc3, 0x6b, 0x55, 0xf2,
+	0xe4, 0x6e, 0xd8, 0xc3, 0xdd, 0xd0, 0xbf, 0x0d, 0x5f, 0xaf, 0x91, 0x27, 0xed, 0x41, 0x37, 0x65,
+	0x80, 0xee, 0xe2, 0xbf, 0x51, 0xa3, 0x38, 0xa2, 0xf0, 0x74, 0x71, 0xf4, 0x4d, 0xbb, 0x4e, 0x7b,
+	0xcd, 0x7a, 0x0b, 0xbe, 0x45, 0xa3, 0x23, 0x4b, 0xd7, 0xbb, 0x3b, 0x5b, 0xf0, 0xed, 0x1a, 0x59,
+	0xb0, 0x14, 0x45, 0x92, 0xf2, 0x29, 0x4b, 0x92, 0xef, 0xd4, 0x28, 0xcb, 0x4a, 0x46, 0xa5, 0x97,
+	0xf9, 0xdd, 0x1a, 0x59, 0x96, 0xe2, 0x36, 0x06, 0x5b, 0x54, 0xc2, 0xbf, 0x67, 0xa5, 0xd2, 0x33,
+	0x97, 0x2c, 0xd9, 0x35, 0xf0, 0x7d, 0xcb, 0x77, 0x7c, 0x1a, 0x82, 0xd7, 0xea, 0x69, 0xd8, 0x95,
+	0xb0, 0xdf, 0xd7, 0x5d, 0xda, 0x0c, 0x8f, 0x3f, 0xf0, 0x07, 0x0b, 0x1f, 0x1f, 0x99, 0xe0, 0x8f,
+	0x75, 0x32, 0xac, 0x3c, 0xf5, 0xd0, 0xec, 0xaf, 0xe1, 0x4f, 0x75, 0xb2, 0xa0, 0x98, 0x6f, 0xe0,
+	0x07, 0x0d, 0x72, 0x56, 0x36, 0xd9, 0xc0, 0x0f, 0x1b, 0x74, 0xcc, 0x63, 0x33, 0x0d, 0xfc, 0xa8,
+	0x61, 0x6f, 0x29, 0x9f, 0x66, 0xe0, 0xc7, 0x25, 0x80, 0xb8, 0xe0, 0x27, 0x0d, 0x5b, 0xb0, 0x86,
+	0x26, 0x18, 0xf8, 0x69, 0x83, 0x6c, 0x3b, 0x3e, 0xbb, 0xc0, 0xcf, 0x1a, 0x2e, 0x0a, 0xf2, 0xa9,
+	0x05, 0x7e, 0xde, 0xa0, 0xc4, 0xb8, 0xf7, 0xbc, 0x02, 0x2f, 0x5a, 0x5d, 0xc5, 0xa4, 0x02, 0x2f,
+	0x35, 0x8a, 0x8a, 0x9b, 0x4f, 0x18, 0xf0, 0x72, 0x23, 0xab, 0xb8, 0x05, 0xf6, 0x8a, 0xe5, 0x3c,
+	0x36, 0x6f, 0xc0, 0xab, 0x8d, 0x85, 0x79, 0x36, 0xde, 0xd2, 0x91, 0xed, 0x92, 0xe3, 0xac, 0xd2,
+	0xd2, 0x11, 0x9c, 0xa0, 0xa6, 0xb2, 0x2c, 0x65, 0xb4, 0x72, 0x94, 0xa8, 0xa7, 0x1e, 0x03, 0x6f,
+	0xe1, 0x49, 0x36, 0xd9, 0x94, 0xbd, 0x44, 0xe4, 0x59, 0x6c, 0x1b, 0xa3, 0xeb, 0xa8, 0x18, 0x58,
+	0x00, 0x4e, 0x50, 0x0f, 0x59, 0x39, 0x42, 0xbf, 0x6f, 0xfb, 0xb7, 0x47, 0x4b, 0xda, 0x14, 0xa1,
+	0xb1, 0x2f, 0x21, 0x5a, 0x52, 0xf1, 0x8b, 0xec, 0x50, 0xb0, 0xf0, 0x0c, 0x83, 0xa6, 0x8c, 0x75,
+	0xa8, 0x0d, 0xc6, 0xfe, 0xe0, 0x06, 0x1e, 0x62, 0x64, 0x87, 0x06, 0xa3, 0x64, 0xdc, 0x81, 0x13,
+	0xf6, 0xb5, 0x85, 0xf6, 0xd5, 0xe4, 0x46, 0x8b, 0x65, 0x9a, 0xa8, 0xac, 0xa0, 0x09, 0xc6, 0x56,
+	0x0e, 0x31, 0x36, 0x7d, 0x11, 0x45, 0x03, 0xa8, 0xd0, 0xba, 0xd9, 0xd7, 0x46, 0xf6, 0xc2, 0x4f,
+	0xd8, 0xe1, 0xe5, 0x6b, 0x1e, 0xab, 0xbb, 0x39, 0x22, 0xb7, 0xd4, 0x2d, 0xb7, 0x31, 0x0e, 0x42,
+	0x2b, 0x9c, 0x5e, 0x04, 0x16, 0x4a, 0x27, 0x1e, 0xaf, 0x60, 0xda, 0x31, 0x42, 0x99, 0xec, 0xe9,
+	0xe6, 0xa0, 0x96, 0xbc, 0x13, 0x47, 0x52, 0x04, 0x76, 0x98, 0xc9, 0xb7, 0x6e, 0x0b, 0xa5, 0x49,
+	0x9f, 0x7d, 0x30, 0xa5, 0xf2, 0x95, 0x3d, 0x4f, 0x00, 0xa3, 0x05, 0x58, 0xb8, 0x60, 0x8c, 0x5a,
+	0xb1, 0x03, 0x6d, 0xee, 0x64, 0x89, 0xc3, 0x16, 0xae, 0x32, 0x56, 0x3c, 0x96, 0xed, 0x79, 0x8a,
+	0x46, 0x7d, 0x82, 0xbc, 0xb2, 0x16, 0xc9, 0x7d, 0x11, 0x81, 0x47, 0x03, 0x90, 0x8d, 0xb1, 0x91,
+	0x85, 0xcf, 0x8c, 0xb2, 0xc9, 0x63, 0x4f, 0x63, 0xb2, 0x2d, 0x5f, 0x2c, 0x45, 0x74, 0x91, 0x17,
+	0xd8, 0x7d, 0x39, 0x72, 0xd7, 0xc4, 0xe3, 0xd1, 0x78, 0x9a, 0x93, 0x8f, 0x8d, 0x3e, 0x23, 0xfc,
+	0x22, 0x3b, 0x57, 0x10, 0xef, 0x1e, 0x78, 0xa8, 0xbc, 0xcf, 0xe5, 0x0c, 0xc7, 0x27, 0x9f, 0x2a,
+	0x79, 0x34, 0xa7, 0x52, 0x71, 0x71, 0x0f, 0xd9, 0x1c, 0x4a, 0x5b, 0x27, 0x8c, 0xd1, 0xdb, 0xb2,
+	0xb0, 0x31, 0x8f, 0x32, 0x18, 0x27, 0x1f, 0xe6, 0x84, 0xb4, 0xad, 0x9d, 0x1c, 0x02, 0xd3, 0xf6,
+	0x56, 0xa3, 0x49, 0x3a, 0x07, 0xd7, 0xb0, 0x5c, 0x7d, 0x18, 0xbd, 0x78, 0x8e, 0xb9, 0xc0, 0x95,
+	0xb9, 0xfa, 0x10, 0xc5, 0x62, 0x2d, 0x34, 0x22, 0x8c, 0xa0, 0x41, 0x17, 0x35, 0xe4, 0x17, 0xb7,
+	0xe3, 0xd4, 0x90, 0xf2, 0xb4, 0x53, 0x4e, 0xd0, 0xac, 0x94, 0x83, 0xae, 0xc7, 0x4e, 0x0e, 0x61,
+	0xb6, 0xdc, 0x02, 0x0c, 0xa9, 0x2b, 0x0d, 0x03, 0x30, 0x35, 0x7c, 0x50, 0x1b, 0x20, 0xc0, 0x87,
+	0xbc, 0xeb, 0xec, 0xde, 0xba, 0x13, 0xa3, 0xd2, 0xdd, 0x30, 0x81, 0xe9, 0x21, 0xa7, 0xb9, 0x8a,
+	0x67, 0xe3, 0x62, 0x66, 0xc8, 0x15, 0x64, 0x7a, 0xb1, 0xe9, 0xf4, 0xf0, 0x85, 0xd9, 0x9a, 0x53,
+	0x50, 0x67, 0x87, 0xa8, 0x1b, 0x22, 0x16, 0x9d, 0x92, 0xc2, 0x33, 0x43, 0x0a, 0x4b, 0xc5, 0x6e,
+	0xee, 0x43, 0x92, 0x4d, 0xe5, 0x3f, 0x72, 0xf6, 0xf0, 0xc8, 0xec, 0xc9, 0xfd, 0x5b, 0xfc, 0xe2,
+	0xa2, 0xfb, 0x4f, 0xbb, 0x98, 0xfd, 0xa7, 0x5d, 0xdc, 0x40, 0xad, 0x49, 0x64, 0x62, 0xe3, 0x63,
+	0xee, 0x2f, 0xe3, 0xf6, 0x0f, 0xd5, 0x03, 0xf7, 0xfe, 0xef, 0x57, 0xfa, 0xe3, 0xd4, 0x9e, 0x4c,
+	0x4a, 0xab, 0xad, 0xfd, 0x5b, 0xcb, 0x4f, 0xb3, 0x89, 0x50, 0x66, 0xfb, 0x3a, 0x2a, 0xf1, 0x97,
+	0xeb, 0x4d, 0xbb, 0x6f, 0x9b, 0x64, 0x6c, 0x7b, 0x1f, 0x7d, 0xbc, 0x13, 0x9a, 0x6e, 0x7f, 0x9f,
+	0xa4, 0x5d, 0x71, 0x6c, 0x8f, 0x84, 0x32, 0xfd, 0xba, 0x12, 0xc6, 0x86, 0x1a, 0x40, 0xe4, 0xfe,
+	0x20, 0x5f, 0x71, 0x1a, 0x93, 0xfd, 0x2f, 0x7a, 0xde, 0xfe, 0x98, 0x85, 0x1e, 0xff, 0xf7, 0x00,
+	0x3e, 0xa8, 0xc7, 0x9d, 0x87, 0x16, 0x00, 0x00,
 }
@@ -10,6 +10,7 @@ import (
 	commonpb "github.com/milvus-io/milvus/internal/proto/commonpb"
 	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
 	milvuspb "github.com/milvus-io/milvus/internal/proto/milvuspb"
+	planpb "github.com/milvus-io/milvus/internal/proto/planpb"
 	schemapb "github.com/milvus-io/milvus/internal/proto/schemapb"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -74,10 +75,11 @@ func (ChannelWatchState) EnumDescriptor() ([]byte, []int) {
 type CompactionType int32
 
 const (
-	CompactionType_UndefinedCompaction CompactionType = 0
-	CompactionType_InnerCompaction     CompactionType = 1
-	CompactionType_MergeCompaction     CompactionType = 2
-	CompactionType_MixCompaction       CompactionType = 3
+	CompactionType_UndefinedCompaction  CompactionType = 0
+	CompactionType_InnerCompaction      CompactionType = 1
+	CompactionType_MergeCompaction      CompactionType = 2
+	CompactionType_MixCompaction        CompactionType = 3
+	CompactionType_ClusteringCompaction CompactionType = 4
 )
 
 var CompactionType_name = map[int32]string{
@@ -85,13 +87,15 @@ var CompactionType_name = map[int32]string{
 	1: "InnerCompaction",
 	2: "MergeCompaction",
 	3: "MixCompaction",
+	4: "ClusteringCompaction",
 }
 
 var CompactionType_value = map[string]int32{
-	"UndefinedCompaction": 0,
-	"InnerCompaction":     1,
-	"MergeCompaction":     2,
-	"MixCompaction":       3,
+	"UndefinedCompaction":  0,
+	"InnerCompaction":      1,
+	"MergeCompaction":      2,
+	"MixCompaction":        3,
+	"ClusteringCompaction": 4,
 }
 
 func (x CompactionType) String() string {
@@ -1539,6 +1543,7 @@ type CollectionInfo struct {
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
 	Partitions           []int64                    `protobuf:"varint,3,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
 	StartPositions       []*commonpb.KeyDataPair    `protobuf:"bytes,4,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
+	Properties           []*commonpb.KeyValuePair   `protobuf:"bytes,5,rep,name=properties,proto3" json:"properties,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -1597,6 +1602,13 @@ func (m *CollectionInfo) GetStartPositions() []*commonpb.KeyDataPair {
 	return nil
 }
 
+func (m *CollectionInfo) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
 type SegmentInfo struct {
 	ID             int64                   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	CollectionID   int64                   `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
@@ -1612,13 +1624,15 @@ type SegmentInfo struct {
 	Binlogs   []*FieldBinlog `protobuf:"bytes,11,rep,name=binlogs,proto3" json:"binlogs,omitempty"`
 	Statslogs []*FieldBinlog `protobuf:"bytes,12,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
 	// deltalogs consists of delete binlogs. FieldID is not used yet since delete is always applied on primary key
-	Deltalogs            []*FieldBinlog `protobuf:"bytes,13,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	CreatedByCompaction  bool           `protobuf:"varint,14,opt,name=createdByCompaction,proto3" json:"createdByCompaction,omitempty"`
-	CompactionFrom       []int64        `protobuf:"varint,15,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
-	DroppedAt            uint64         `protobuf:"varint,16,opt,name=dropped_at,json=droppedAt,proto3" json:"dropped_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Deltalogs            []*FieldBinlog      `protobuf:"bytes,13,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	CreatedByCompaction  bool                `protobuf:"varint,14,opt,name=createdByCompaction,proto3" json:"createdByCompaction,omitempty"`
+	CompactionFrom       []int64             `protobuf:"varint,15,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
+	DroppedAt            uint64              `protobuf:"varint,16,opt,name=dropped_at,json=droppedAt,proto3" json:"dropped_at,omitempty"`
+	ClusteringKeyRange   *ClusteringKeyRange `protobuf:"bytes,17,opt,name=clustering_key_range,json=clusteringKeyRange,proto3" json:"clustering_key_range,omitempty"`
+	CompactionSiblings   []int64             `protobuf:"varint,18,rep,packed,name=compaction_siblings,json=compactionSiblings,proto3" json:"compaction_siblings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *SegmentInfo) Reset()         { *m = SegmentInfo{} }
@@ -1758,6 +1772,76 @@ func (m *SegmentInfo) GetDroppedAt() uint64 {
 	return 0
 }
 
+func (m *SegmentInfo) GetClusteringKeyRange() *ClusteringKeyRange {
+	if m != nil {
+		return m.ClusteringKeyRange
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetCompactionSiblings() []int64 {
+	if m != nil {
+		return m.CompactionSiblings
+	}
+	return nil
+}
+
+// ClusteringKeyRange is the min and max value of clustering key in a segment
+type ClusteringKeyRange struct {
+	FieldID              int64                `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	Min                  *planpb.GenericValue `protobuf:"bytes,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  *planpb.GenericValue `protobuf:"bytes,3,opt,name=max,proto3" json:"max,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ClusteringKeyRange) Reset()         { *m = ClusteringKeyRange{} }
+func (m *ClusteringKeyRange) String() string { return proto.CompactTextString(m) }
+func (*ClusteringKeyRange) ProtoMessage()    {}
+func (*ClusteringKeyRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{27}
+}
+
+func (m *ClusteringKeyRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusteringKeyRange.Unmarshal(m, b)
+}
+func (m *ClusteringKeyRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusteringKeyRange.Marshal(b, m, deterministic)
+}
+func (m *ClusteringKeyRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusteringKeyRange.Merge(m, src)
+}
+func (m *ClusteringKeyRange) XXX_Size() int {
+	return xxx_messageInfo_ClusteringKeyRange.Size(m)
+}
+func (m *ClusteringKeyRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusteringKeyRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusteringKeyRange proto.InternalMessageInfo
+
+func (m *ClusteringKeyRange) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *ClusteringKeyRange) GetMin() *planpb.GenericValue {
+	if m != nil {
+		return m.Min
+	}
+	return nil
+}
+
+func (m *ClusteringKeyRange) GetMax() *planpb.GenericValue {
+	if m != nil {
+		return m.Max
+	}
+	return nil
+}
+
 type SegmentStartPosition struct {
 	StartPosition        *internalpb.MsgPosition `protobuf:"bytes,1,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	SegmentID            int64                   `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -1770,7 +1854,7 @@ func (m *SegmentStartPosition) Reset()         { *m = SegmentStartPosition{} }
 func (m *SegmentStartPosition) String() string { return proto.CompactTextString(m) }
 func (*SegmentStartPosition) ProtoMessage()    {}
 func (*SegmentStartPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{27}
+	return fileDescriptor_82cd95f524594f49, []int{28}
 }
 
 func (m *SegmentStartPosition) XXX_Unmarshal(b []byte) error {
@@ -1826,7 +1910,7 @@ func (m *SaveBinlogPathsRequest) Reset()         { *m = SaveBinlogPathsRequest{}
 func (m *SaveBinlogPathsRequest) String() string { return proto.CompactTextString(m) }
 func (*SaveBinlogPathsRequest) ProtoMessage()    {}
 func (*SaveBinlogPathsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{28}
+	return fileDescriptor_82cd95f524594f49, []int{29}
 }
 
 func (m *SaveBinlogPathsRequest) XXX_Unmarshal(b []byte) error {
@@ -1937,7 +2021,7 @@ func (m *CheckPoint) Reset()         { *m = CheckPoint{} }
 func (m *CheckPoint) String() string { return proto.CompactTextString(m) }
 func (*CheckPoint) ProtoMessage()    {}
 func (*CheckPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{29}
+	return fileDescriptor_82cd95f524594f49, []int{30}
 }
 
 func (m *CheckPoint) XXX_Unmarshal(b []byte) error {
@@ -1994,7 +2078,7 @@ func (m *DeltaLogInfo) Reset()         { *m = DeltaLogInfo{} }
 func (m *DeltaLogInfo) String() string { return proto.CompactTextString(m) }
 func (*DeltaLogInfo) ProtoMessage()    {}
 func (*DeltaLogInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{30}
+	return fileDescriptor_82cd95f524594f49, []int{31}
 }
 
 func (m *DeltaLogInfo) XXX_Unmarshal(b []byte) error {
@@ -2064,7 +2148,7 @@ func (m *DataNodeTtMsg) Reset()         { *m = DataNodeTtMsg{} }
 func (m *DataNodeTtMsg) String() string { return proto.CompactTextString(m) }
 func (*DataNodeTtMsg) ProtoMessage()    {}
 func (*DataNodeTtMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{31}
+	return fileDescriptor_82cd95f524594f49, []int{32}
 }
 
 func (m *DataNodeTtMsg) XXX_Unmarshal(b []byte) error {
@@ -2125,7 +2209,7 @@ func (m *SegmentStats) Reset()         { *m = SegmentStats{} }
 func (m *SegmentStats) String() string { return proto.CompactTextString(m) }
 func (*SegmentStats) ProtoMessage()    {}
 func (*SegmentStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{32}
+	return fileDescriptor_82cd95f524594f49, []int{33}
 }
 
 func (m *SegmentStats) XXX_Unmarshal(b []byte) error {
@@ -2173,7 +2257,7 @@ func (m *ChannelStatus) Reset()         { *m = ChannelStatus{} }
 func (m *ChannelStatus) String() string { return proto.CompactTextString(m) }
 func (*ChannelStatus) ProtoMessage()    {}
 func (*ChannelStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{33}
+	return fileDescriptor_82cd95f524594f49, []int{34}
 }
 
 func (m *ChannelStatus) XXX_Unmarshal(b []byte) error {
@@ -2228,7 +2312,7 @@ func (m *DataNodeInfo) Reset()         { *m = DataNodeInfo{} }
 func (m *DataNodeInfo) String() string { return proto.CompactTextString(m) }
 func (*DataNodeInfo) ProtoMessage()    {}
 func (*DataNodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{34}
+	return fileDescriptor_82cd95f524594f49, []int{35}
 }
 
 func (m *DataNodeInfo) XXX_Unmarshal(b []byte) error {
@@ -2271,22 +2355,23 @@ func (m *DataNodeInfo) GetChannels() []*ChannelStatus {
 }
 
 type SegmentBinlogs struct {
-	SegmentID            int64          `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	FieldBinlogs         []*FieldBinlog `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
-	NumOfRows            int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	Statslogs            []*FieldBinlog `protobuf:"bytes,4,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
-	Deltalogs            []*FieldBinlog `protobuf:"bytes,5,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	InsertChannel        string         `protobuf:"bytes,6,opt,name=insert_channel,json=insertChannel,proto3" json:"insert_channel,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	SegmentID            int64               `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	FieldBinlogs         []*FieldBinlog      `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
+	NumOfRows            int64               `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	Statslogs            []*FieldBinlog      `protobuf:"bytes,4,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
+	Deltalogs            []*FieldBinlog      `protobuf:"bytes,5,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	InsertChannel        string              `protobuf:"bytes,6,opt,name=insert_channel,json=insertChannel,proto3" json:"insert_channel,omitempty"`
+	ClusteringKeyRange   *ClusteringKeyRange `protobuf:"bytes,7,opt,name=clustering_key_range,json=clusteringKeyRange,proto3" json:"clustering_key_range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *SegmentBinlogs) Reset()         { *m = SegmentBinlogs{} }
 func (m *SegmentBinlogs) String() string { return proto.CompactTextString(m) }
 func (*SegmentBinlogs) ProtoMessage()    {}
 func (*SegmentBinlogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{35}
+	return fileDescriptor_82cd95f524594f49, []int{36}
 }
 
 func (m *SegmentBinlogs) XXX_Unmarshal(b []byte) error {
@@ -2349,6 +2434,13 @@ func (m *SegmentBinlogs) GetInsertChannel() string {
 	return ""
 }
 
+func (m *SegmentBinlogs) GetClusteringKeyRange() *ClusteringKeyRange {
+	if m != nil {
+		return m.ClusteringKeyRange
+	}
+	return nil
+}
+
 type FieldBinlog struct {
 	FieldID              int64     `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	Binlogs              []*Binlog `protobuf:"bytes,2,rep,name=binlogs,proto3" json:"binlogs,omitempty"`
@@ -2361,7 +2453,7 @@ func (m *FieldBinlog) Reset()         { *m = FieldBinlog{} }
 func (m *FieldBinlog) String() string { return proto.CompactTextString(m) }
 func (*FieldBinlog) ProtoMessage()    {}
 func (*FieldBinlog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{36}
+	return fileDescriptor_82cd95f524594f49, []int{37}
 }
 
 func (m *FieldBinlog) XXX_Unmarshal(b []byte) error {
@@ -2411,7 +2503,7 @@ func (m *Binlog) Reset()         { *m = Binlog{} }
 func (m *Binlog) String() string { return proto.CompactTextString(m) }
 func (*Binlog) ProtoMessage()    {}
 func (*Binlog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{37}
+	return fileDescriptor_82cd95f524594f49, []int{38}
 }
 
 func (m *Binlog) XXX_Unmarshal(b []byte) error {
@@ -2480,7 +2572,7 @@ func (m *GetRecoveryInfoResponse) Reset()         { *m = GetRecoveryInfoResponse
 func (m *GetRecoveryInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoResponse) ProtoMessage()    {}
 func (*GetRecoveryInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{38}
+	return fileDescriptor_82cd95f524594f49, []int{39}
 }
 
 func (m *GetRecoveryInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -2535,7 +2627,7 @@ func (m *GetRecoveryInfoRequest) Reset()         { *m = GetRecoveryInfoRequest{}
 func (m *GetRecoveryInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoRequest) ProtoMessage()    {}
 func (*GetRecoveryInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{39}
+	return fileDescriptor_82cd95f524594f49, []int{40}
 }
 
 func (m *GetRecoveryInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -2590,7 +2682,7 @@ func (m *GetFlushedSegmentsRequest) Reset()         { *m = GetFlushedSegmentsReq
 func (m *GetFlushedSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFlushedSegmentsRequest) ProtoMessage()    {}
 func (*GetFlushedSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{40}
+	return fileDescriptor_82cd95f524594f49, []int{41}
 }
 
 func (m *GetFlushedSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2644,7 +2736,7 @@ func (m *GetFlushedSegmentsResponse) Reset()         { *m = GetFlushedSegmentsRe
 func (m *GetFlushedSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFlushedSegmentsResponse) ProtoMessage()    {}
 func (*GetFlushedSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{41}
+	return fileDescriptor_82cd95f524594f49, []int{42}
 }
 
 func (m *GetFlushedSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -2691,7 +2783,7 @@ func (m *SegmentFlushCompletedMsg) Reset()         { *m = SegmentFlushCompletedM
 func (m *SegmentFlushCompletedMsg) String() string { return proto.CompactTextString(m) }
 func (*SegmentFlushCompletedMsg) ProtoMessage()    {}
 func (*SegmentFlushCompletedMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{42}
+	return fileDescriptor_82cd95f524594f49, []int{43}
 }
 
 func (m *SegmentFlushCompletedMsg) XXX_Unmarshal(b []byte) error {
@@ -2742,7 +2834,7 @@ func (m *ChannelWatchInfo) Reset()         { *m = ChannelWatchInfo{} }
 func (m *ChannelWatchInfo) String() string { return proto.CompactTextString(m) }
 func (*ChannelWatchInfo) ProtoMessage()    {}
 func (*ChannelWatchInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{43}
+	return fileDescriptor_82cd95f524594f49, []int{44}
 }
 
 func (m *ChannelWatchInfo) XXX_Unmarshal(b []byte) error {
@@ -2806,7 +2898,7 @@ func (m *CompactionSegmentBinlogs) Reset()         { *m = CompactionSegmentBinlo
 func (m *CompactionSegmentBinlogs) String() string { return proto.CompactTextString(m) }
 func (*CompactionSegmentBinlogs) ProtoMessage()    {}
 func (*CompactionSegmentBinlogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{44}
+	return fileDescriptor_82cd95f524594f49, []int{45}
 }
 
 func (m *CompactionSegmentBinlogs) XXX_Unmarshal(b []byte) error {
@@ -2870,16 +2962,20 @@ type CompactionPlan struct {
 	Type                 CompactionType              `protobuf:"varint,5,opt,name=type,proto3,enum=milvus.proto.data.CompactionType" json:"type,omitempty"`
 	Timetravel           uint64                      `protobuf:"varint,6,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
 	Channel              string                      `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	ClusteringKeyFieldID int64                       `protobuf:"varint,8,opt,name=clustering_key_fieldID,json=clusteringKeyFieldID,proto3" json:"clustering_key_fieldID,omitempty"`
+	MaxSegmentRows       int64                       `protobuf:"varint,9,opt,name=max_segment_rows,json=maxSegmentRows,proto3" json:"max_segment_rows,omitempty"`
+	// the clustering key values splitting the rows into ranges, clustered segments never cross a bound, only used by clustering compaction
+	ClusteringKeyBounds  []*planpb.GenericValue `protobuf:"bytes,10,rep,name=clustering_key_bounds,json=clusteringKeyBounds,proto3" json:"clustering_key_bounds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *CompactionPlan) Reset()         { *m = CompactionPlan{} }
 func (m *CompactionPlan) String() string { return proto.CompactTextString(m) }
 func (*CompactionPlan) ProtoMessage()    {}
 func (*CompactionPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{45}
+	return fileDescriptor_82cd95f524594f49, []int{46}
 }
 
 func (m *CompactionPlan) XXX_Unmarshal(b []byte) error {
@@ -2949,23 +3045,95 @@ func (m *CompactionPlan) GetChannel() string {
 	return ""
 }
 
+func (m *CompactionPlan) GetClusteringKeyFieldID() int64 {
+	if m != nil {
+		return m.ClusteringKeyFieldID
+	}
+	return 0
+}
+
+func (m *CompactionPlan) GetMaxSegmentRows() int64 {
+	if m != nil {
+		return m.MaxSegmentRows
+	}
+	return 0
+}
+
+func (m *CompactionPlan) GetClusteringKeyBounds() []*planpb.GenericValue {
+	if m != nil {
+		return m.ClusteringKeyBounds
+	}
+	return nil
+}
+
+type StopCompactionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	PlanIDs              []int64           `protobuf:"varint,2,rep,packed,name=planIDs,proto3" json:"planIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *StopCompactionRequest) Reset()         { *m = StopCompactionRequest{} }
+func (m *StopCompactionRequest) String() string { return proto.CompactTextString(m) }
+func (*StopCompactionRequest) ProtoMessage()    {}
+func (*StopCompactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{47}
+}
+
+func (m *StopCompactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StopCompactionRequest.Unmarshal(m, b)
+}
+func (m *StopCompactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StopCompactionRequest.Marshal(b, m, deterministic)
+}
+func (m *StopCompactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopCompactionRequest.Merge(m, src)
+}
+func (m *StopCompactionRequest) XXX_Size() int {
+	return xxx_messageInfo_StopCompactionRequest.Size(m)
+}
+func (m *StopCompactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopCompactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopCompactionRequest proto.InternalMessageInfo
+
+func (m *StopCompactionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *StopCompactionRequest) GetPlanIDs() []int64 {
+	if m != nil {
+		return m.PlanIDs
+	}
+	return nil
+}
+
 type CompactionResult struct {
-	PlanID               int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	SegmentID            int64          `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	NumOfRows            int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	InsertLogs           []*FieldBinlog `protobuf:"bytes,4,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
-	Field2StatslogPaths  []*FieldBinlog `protobuf:"bytes,5,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
-	Deltalogs            []*FieldBinlog `protobuf:"bytes,6,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	PlanID              int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	SegmentID           int64          `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumOfRows           int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	InsertLogs          []*FieldBinlog `protobuf:"bytes,4,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
+	Field2StatslogPaths []*FieldBinlog `protobuf:"bytes,5,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs           []*FieldBinlog `protobuf:"bytes,6,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	// segments produced by clustering compaction, the single segment fields above are not used then
+	ClusteredSegments []*ClusteredSegment `protobuf:"bytes,7,rep,name=clustered_segments,json=clusteredSegments,proto3" json:"clustered_segments,omitempty"`
+	// binlogs of the compacted segments refreshed after the plan was generated, only set by clustering compaction
+	SegmentBinlogs       []*CompactionSegmentBinlogs `protobuf:"bytes,8,rep,name=segmentBinlogs,proto3" json:"segmentBinlogs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
 func (m *CompactionResult) Reset()         { *m = CompactionResult{} }
 func (m *CompactionResult) String() string { return proto.CompactTextString(m) }
 func (*CompactionResult) ProtoMessage()    {}
 func (*CompactionResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{46}
+	return fileDescriptor_82cd95f524594f49, []int{48}
 }
 
 func (m *CompactionResult) XXX_Unmarshal(b []byte) error {
@@ -3028,6 +3196,99 @@ func (m *CompactionResult) GetDeltalogs() []*FieldBinlog {
 	return nil
 }
 
+func (m *CompactionResult) GetClusteredSegments() []*ClusteredSegment {
+	if m != nil {
+		return m.ClusteredSegments
+	}
+	return nil
+}
+
+func (m *CompactionResult) GetSegmentBinlogs() []*CompactionSegmentBinlogs {
+	if m != nil {
+		return m.SegmentBinlogs
+	}
+	return nil
+}
+
+type ClusteredSegment struct {
+	SegmentID            int64               `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumOfRows            int64               `protobuf:"varint,2,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	InsertLogs           []*FieldBinlog      `protobuf:"bytes,3,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
+	Field2StatslogPaths  []*FieldBinlog      `protobuf:"bytes,4,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs            []*FieldBinlog      `protobuf:"bytes,5,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	ClusteringKeyRange   *ClusteringKeyRange `protobuf:"bytes,6,opt,name=clustering_key_range,json=clusteringKeyRange,proto3" json:"clustering_key_range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ClusteredSegment) Reset()         { *m = ClusteredSegment{} }
+func (m *ClusteredSegment) String() string { return proto.CompactTextString(m) }
+func (*ClusteredSegment) ProtoMessage()    {}
+func (*ClusteredSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{49}
+}
+
+func (m *ClusteredSegment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusteredSegment.Unmarshal(m, b)
+}
+func (m *ClusteredSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusteredSegment.Marshal(b, m, deterministic)
+}
+func (m *ClusteredSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusteredSegment.Merge(m, src)
+}
+func (m *ClusteredSegment) XXX_Size() int {
+	return xxx_messageInfo_ClusteredSegment.Size(m)
+}
+func (m *ClusteredSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusteredSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusteredSegment proto.InternalMessageInfo
+
+func (m *ClusteredSegment) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *ClusteredSegment) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
+func (m *ClusteredSegment) GetInsertLogs() []*FieldBinlog {
+	if m != nil {
+		return m.InsertLogs
+	}
+	return nil
+}
+
+func (m *ClusteredSegment) GetField2StatslogPaths() []*FieldBinlog {
+	if m != nil {
+		return m.Field2StatslogPaths
+	}
+	return nil
+}
+
+func (m *ClusteredSegment) GetDeltalogs() []*FieldBinlog {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
+func (m *ClusteredSegment) GetClusteringKeyRange() *ClusteringKeyRange {
+	if m != nil {
+		return m.ClusteringKeyRange
+	}
+	return nil
+}
+
 // Deprecated
 type SegmentFieldBinlogMeta struct {
 	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
@@ -3041,7 +3302,7 @@ func (m *SegmentFieldBinlogMeta) Reset()         { *m = SegmentFieldBinlogMeta{}
 func (m *SegmentFieldBinlogMeta) String() string { return proto.CompactTextString(m) }
 func (*SegmentFieldBinlogMeta) ProtoMessage()    {}
 func (*SegmentFieldBinlogMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{47}
+	return fileDescriptor_82cd95f524594f49, []int{50}
 }
 
 func (m *SegmentFieldBinlogMeta) XXX_Unmarshal(b []byte) error {
@@ -3088,7 +3349,7 @@ func (m *WatchChannelsRequest) Reset()         { *m = WatchChannelsRequest{} }
 func (m *WatchChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchChannelsRequest) ProtoMessage()    {}
 func (*WatchChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{48}
+	return fileDescriptor_82cd95f524594f49, []int{51}
 }
 
 func (m *WatchChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -3134,7 +3395,7 @@ func (m *WatchChannelsResponse) Reset()         { *m = WatchChannelsResponse{} }
 func (m *WatchChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*WatchChannelsResponse) ProtoMessage()    {}
 func (*WatchChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{49}
+	return fileDescriptor_82cd95f524594f49, []int{52}
 }
 
 func (m *WatchChannelsResponse) XXX_Unmarshal(b []byte) error {
@@ -3175,7 +3436,7 @@ func (m *SetSegmentStateRequest) Reset()         { *m = SetSegmentStateRequest{}
 func (m *SetSegmentStateRequest) String() string { return proto.CompactTextString(m) }
 func (*SetSegmentStateRequest) ProtoMessage()    {}
 func (*SetSegmentStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{50}
+	return fileDescriptor_82cd95f524594f49, []int{53}
 }
 
 func (m *SetSegmentStateRequest) XXX_Unmarshal(b []byte) error {
@@ -3228,7 +3489,7 @@ func (m *SetSegmentStateResponse) Reset()         { *m = SetSegmentStateResponse
 func (m *SetSegmentStateResponse) String() string { return proto.CompactTextString(m) }
 func (*SetSegmentStateResponse) ProtoMessage()    {}
 func (*SetSegmentStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{51}
+	return fileDescriptor_82cd95f524594f49, []int{54}
 }
 
 func (m *SetSegmentStateResponse) XXX_Unmarshal(b []byte) error {
@@ -3269,7 +3530,7 @@ func (m *DropVirtualChannelRequest) Reset()         { *m = DropVirtualChannelReq
 func (m *DropVirtualChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelRequest) ProtoMessage()    {}
 func (*DropVirtualChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{52}
+	return fileDescriptor_82cd95f524594f49, []int{55}
 }
 
 func (m *DropVirtualChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -3329,7 +3590,7 @@ func (m *DropVirtualChannelSegment) Reset()         { *m = DropVirtualChannelSeg
 func (m *DropVirtualChannelSegment) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelSegment) ProtoMessage()    {}
 func (*DropVirtualChannelSegment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{53}
+	return fileDescriptor_82cd95f524594f49, []int{56}
 }
 
 func (m *DropVirtualChannelSegment) XXX_Unmarshal(b []byte) error {
@@ -3417,7 +3678,7 @@ func (m *DropVirtualChannelResponse) Reset()         { *m = DropVirtualChannelRe
 func (m *DropVirtualChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*DropVirtualChannelResponse) ProtoMessage()    {}
 func (*DropVirtualChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{54}
+	return fileDescriptor_82cd95f524594f49, []int{57}
 }
 
 func (m *DropVirtualChannelResponse) XXX_Unmarshal(b []byte) error {
@@ -3463,7 +3724,7 @@ func (m *ImportTask) Reset()         { *m = ImportTask{} }
 func (m *ImportTask) String() string { return proto.CompactTextString(m) }
 func (*ImportTask) ProtoMessage()    {}
 func (*ImportTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{55}
+	return fileDescriptor_82cd95f524594f49, []int{58}
 }
 
 func (m *ImportTask) XXX_Unmarshal(b []byte) error {
@@ -3555,7 +3816,7 @@ func (m *ImportTaskState) Reset()         { *m = ImportTaskState{} }
 func (m *ImportTaskState) String() string { return proto.CompactTextString(m) }
 func (*ImportTaskState) ProtoMessage()    {}
 func (*ImportTaskState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{56}
+	return fileDescriptor_82cd95f524594f49, []int{59}
 }
 
 func (m *ImportTaskState) XXX_Unmarshal(b []byte) error {
@@ -3634,7 +3895,7 @@ func (m *ImportTaskInfo) Reset()         { *m = ImportTaskInfo{} }
 func (m *ImportTaskInfo) String() string { return proto.CompactTextString(m) }
 func (*ImportTaskInfo) ProtoMessage()    {}
 func (*ImportTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{57}
+	return fileDescriptor_82cd95f524594f49, []int{60}
 }
 
 func (m *ImportTaskInfo) XXX_Unmarshal(b []byte) error {
@@ -3759,7 +4020,7 @@ func (m *ImportTaskResponse) Reset()         { *m = ImportTaskResponse{} }
 func (m *ImportTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*ImportTaskResponse) ProtoMessage()    {}
 func (*ImportTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{58}
+	return fileDescriptor_82cd95f524594f49, []int{61}
 }
 
 func (m *ImportTaskResponse) XXX_Unmarshal(b []byte) error {
@@ -3807,7 +4068,7 @@ func (m *ImportTaskRequest) Reset()         { *m = ImportTaskRequest{} }
 func (m *ImportTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*ImportTaskRequest) ProtoMessage()    {}
 func (*ImportTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{59}
+	return fileDescriptor_82cd95f524594f49, []int{62}
 }
 
 func (m *ImportTaskRequest) XXX_Unmarshal(b []byte) error {
@@ -3861,7 +4122,7 @@ func (m *UpdateSegmentStatisticsRequest) Reset()         { *m = UpdateSegmentSta
 func (m *UpdateSegmentStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateSegmentStatisticsRequest) ProtoMessage()    {}
 func (*UpdateSegmentStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{60}
+	return fileDescriptor_82cd95f524594f49, []int{63}
 }
 
 func (m *UpdateSegmentStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -3907,7 +4168,7 @@ func (m *ResendSegmentStatsRequest) Reset()         { *m = ResendSegmentStatsReq
 func (m *ResendSegmentStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*ResendSegmentStatsRequest) ProtoMessage()    {}
 func (*ResendSegmentStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{61}
+	return fileDescriptor_82cd95f524594f49, []int{64}
 }
 
 func (m *ResendSegmentStatsRequest) XXX_Unmarshal(b []byte) error {
@@ -3947,7 +4208,7 @@ func (m *ResendSegmentStatsResponse) Reset()         { *m = ResendSegmentStatsRe
 func (m *ResendSegmentStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*ResendSegmentStatsResponse) ProtoMessage()    {}
 func (*ResendSegmentStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{62}
+	return fileDescriptor_82cd95f524594f49, []int{65}
 }
 
 func (m *ResendSegmentStatsResponse) XXX_Unmarshal(b []byte) error {
@@ -3998,7 +4259,7 @@ func (m *AddSegmentRequest) Reset()         { *m = AddSegmentRequest{} }
 func (m *AddSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*AddSegmentRequest) ProtoMessage()    {}
 func (*AddSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{63}
+	return fileDescriptor_82cd95f524594f49, []int{66}
 }
 
 func (m *AddSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -4061,10 +4322,199 @@ func (m *AddSegmentRequest) GetRowNum() int64 {
 	return 0
 }
 
-type SegmentReferenceLock struct {
-	TaskID               int64    `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
-	NodeID               int64    `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
-	SegmentIDs           []int64  `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+type TruncateSegmentsRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// 0 means all the partitions
+	PartitionID int64 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	// the segments are kept if any of them may receive the inserts after the timestamp
+	TruncateTs uint64 `protobuf:"varint,4,opt,name=truncate_ts,json=truncateTs,proto3" json:"truncate_ts,omitempty"`
+	// the truncate msg of the segments is broadcast, the truncate info is removed
+	Finished             bool     `protobuf:"varint,5,opt,name=finished,proto3" json:"finished,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TruncateSegmentsRequest) Reset()         { *m = TruncateSegmentsRequest{} }
+func (m *TruncateSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*TruncateSegmentsRequest) ProtoMessage()    {}
+func (*TruncateSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{67}
+}
+
+func (m *TruncateSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TruncateSegmentsRequest.Unmarshal(m, b)
+}
+func (m *TruncateSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TruncateSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *TruncateSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncateSegmentsRequest.Merge(m, src)
+}
+func (m *TruncateSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_TruncateSegmentsRequest.Size(m)
+}
+func (m *TruncateSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncateSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TruncateSegmentsRequest proto.InternalMessageInfo
+
+func (m *TruncateSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *TruncateSegmentsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *TruncateSegmentsRequest) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *TruncateSegmentsRequest) GetTruncateTs() uint64 {
+	if m != nil {
+		return m.TruncateTs
+	}
+	return 0
+}
+
+func (m *TruncateSegmentsRequest) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
+// TruncateInfo fixes the segments to truncate before any of them is dropped,
+// an interrupted truncate is resumed by the next one with the same segments
+type TruncateInfo struct {
+	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	SegmentIDs           []int64  `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TruncateInfo) Reset()         { *m = TruncateInfo{} }
+func (m *TruncateInfo) String() string { return proto.CompactTextString(m) }
+func (*TruncateInfo) ProtoMessage()    {}
+func (*TruncateInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{68}
+}
+
+func (m *TruncateInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TruncateInfo.Unmarshal(m, b)
+}
+func (m *TruncateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TruncateInfo.Marshal(b, m, deterministic)
+}
+func (m *TruncateInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncateInfo.Merge(m, src)
+}
+func (m *TruncateInfo) XXX_Size() int {
+	return xxx_messageInfo_TruncateInfo.Size(m)
+}
+func (m *TruncateInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncateInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TruncateInfo proto.InternalMessageInfo
+
+func (m *TruncateInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *TruncateInfo) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *TruncateInfo) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type TruncateSegmentsResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the segments marked dropped
+	SegmentIDs []int64 `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	// nonzero if nothing is truncated as the segments are allocated until the timestamp,
+	// it should be retried with a later timestamp
+	AllocatedUntil       uint64   `protobuf:"varint,3,opt,name=allocated_until,json=allocatedUntil,proto3" json:"allocated_until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TruncateSegmentsResponse) Reset()         { *m = TruncateSegmentsResponse{} }
+func (m *TruncateSegmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*TruncateSegmentsResponse) ProtoMessage()    {}
+func (*TruncateSegmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{69}
+}
+
+func (m *TruncateSegmentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TruncateSegmentsResponse.Unmarshal(m, b)
+}
+func (m *TruncateSegmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TruncateSegmentsResponse.Marshal(b, m, deterministic)
+}
+func (m *TruncateSegmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncateSegmentsResponse.Merge(m, src)
+}
+func (m *TruncateSegmentsResponse) XXX_Size() int {
+	return xxx_messageInfo_TruncateSegmentsResponse.Size(m)
+}
+func (m *TruncateSegmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncateSegmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TruncateSegmentsResponse proto.InternalMessageInfo
+
+func (m *TruncateSegmentsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *TruncateSegmentsResponse) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *TruncateSegmentsResponse) GetAllocatedUntil() uint64 {
+	if m != nil {
+		return m.AllocatedUntil
+	}
+	return 0
+}
+
+type SegmentReferenceLock struct {
+	TaskID               int64    `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	NodeID               int64    `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	SegmentIDs           []int64  `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4074,44 +4524,480 @@ func (m *SegmentReferenceLock) Reset()         { *m = SegmentReferenceLock{} }
 func (m *SegmentReferenceLock) String() string { return proto.CompactTextString(m) }
 func (*SegmentReferenceLock) ProtoMessage()    {}
 func (*SegmentReferenceLock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{64}
+	return fileDescriptor_82cd95f524594f49, []int{70}
+}
+
+func (m *SegmentReferenceLock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentReferenceLock.Unmarshal(m, b)
+}
+func (m *SegmentReferenceLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentReferenceLock.Marshal(b, m, deterministic)
+}
+func (m *SegmentReferenceLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentReferenceLock.Merge(m, src)
+}
+func (m *SegmentReferenceLock) XXX_Size() int {
+	return xxx_messageInfo_SegmentReferenceLock.Size(m)
+}
+func (m *SegmentReferenceLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentReferenceLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentReferenceLock proto.InternalMessageInfo
+
+func (m *SegmentReferenceLock) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+func (m *SegmentReferenceLock) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *SegmentReferenceLock) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+// SnapshotInfo is a named, read-only view of a collection at snapshot_ts, its segments are pinned until it's dropped
+type SnapshotInfo struct {
+	SnapshotID           int64    `protobuf:"varint,1,opt,name=snapshotID,proto3" json:"snapshotID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CollectionID         int64    `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	SnapshotTs           uint64   `protobuf:"varint,4,opt,name=snapshot_ts,json=snapshotTs,proto3" json:"snapshot_ts,omitempty"`
+	SegmentIDs           []int64  `protobuf:"varint,5,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SnapshotInfo) Reset()         { *m = SnapshotInfo{} }
+func (m *SnapshotInfo) String() string { return proto.CompactTextString(m) }
+func (*SnapshotInfo) ProtoMessage()    {}
+func (*SnapshotInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{71}
+}
+
+func (m *SnapshotInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SnapshotInfo.Unmarshal(m, b)
+}
+func (m *SnapshotInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SnapshotInfo.Marshal(b, m, deterministic)
+}
+func (m *SnapshotInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotInfo.Merge(m, src)
+}
+func (m *SnapshotInfo) XXX_Size() int {
+	return xxx_messageInfo_SnapshotInfo.Size(m)
+}
+func (m *SnapshotInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotInfo proto.InternalMessageInfo
+
+func (m *SnapshotInfo) GetSnapshotID() int64 {
+	if m != nil {
+		return m.SnapshotID
+	}
+	return 0
+}
+
+func (m *SnapshotInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SnapshotInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *SnapshotInfo) GetSnapshotTs() uint64 {
+	if m != nil {
+		return m.SnapshotTs
+	}
+	return 0
+}
+
+func (m *SnapshotInfo) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type CreateSnapshotRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Name                 string            `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateSnapshotRequest) Reset()         { *m = CreateSnapshotRequest{} }
+func (m *CreateSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateSnapshotRequest) ProtoMessage()    {}
+func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{72}
+}
+
+func (m *CreateSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSnapshotRequest.Unmarshal(m, b)
+}
+func (m *CreateSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSnapshotRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSnapshotRequest.Merge(m, src)
+}
+func (m *CreateSnapshotRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateSnapshotRequest.Size(m)
+}
+func (m *CreateSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSnapshotRequest proto.InternalMessageInfo
+
+func (m *CreateSnapshotRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateSnapshotRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CreateSnapshotRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type CreateSnapshotResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Snapshot             *SnapshotInfo    `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CreateSnapshotResponse) Reset()         { *m = CreateSnapshotResponse{} }
+func (m *CreateSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateSnapshotResponse) ProtoMessage()    {}
+func (*CreateSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{73}
+}
+
+func (m *CreateSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSnapshotResponse.Unmarshal(m, b)
+}
+func (m *CreateSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSnapshotResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSnapshotResponse.Merge(m, src)
+}
+func (m *CreateSnapshotResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateSnapshotResponse.Size(m)
+}
+func (m *CreateSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSnapshotResponse proto.InternalMessageInfo
+
+func (m *CreateSnapshotResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *CreateSnapshotResponse) GetSnapshot() *SnapshotInfo {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
+}
+
+type DropSnapshotRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// all the snapshots of the collection are dropped if it's empty
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropSnapshotRequest) Reset()         { *m = DropSnapshotRequest{} }
+func (m *DropSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*DropSnapshotRequest) ProtoMessage()    {}
+func (*DropSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{74}
+}
+
+func (m *DropSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropSnapshotRequest.Unmarshal(m, b)
+}
+func (m *DropSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropSnapshotRequest.Marshal(b, m, deterministic)
+}
+func (m *DropSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropSnapshotRequest.Merge(m, src)
+}
+func (m *DropSnapshotRequest) XXX_Size() int {
+	return xxx_messageInfo_DropSnapshotRequest.Size(m)
+}
+func (m *DropSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropSnapshotRequest proto.InternalMessageInfo
+
+func (m *DropSnapshotRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropSnapshotRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *DropSnapshotRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ListSnapshotsRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// only the snapshot with the name is returned if it's not empty
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSnapshotsRequest) Reset()         { *m = ListSnapshotsRequest{} }
+func (m *ListSnapshotsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSnapshotsRequest) ProtoMessage()    {}
+func (*ListSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{75}
+}
+
+func (m *ListSnapshotsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSnapshotsRequest.Unmarshal(m, b)
+}
+func (m *ListSnapshotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSnapshotsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListSnapshotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSnapshotsRequest.Merge(m, src)
+}
+func (m *ListSnapshotsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSnapshotsRequest.Size(m)
+}
+func (m *ListSnapshotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSnapshotsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSnapshotsRequest proto.InternalMessageInfo
+
+func (m *ListSnapshotsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ListSnapshotsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ListSnapshotsRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ListSnapshotsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Snapshots            []*SnapshotInfo  `protobuf:"bytes,2,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListSnapshotsResponse) Reset()         { *m = ListSnapshotsResponse{} }
+func (m *ListSnapshotsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSnapshotsResponse) ProtoMessage()    {}
+func (*ListSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{76}
+}
+
+func (m *ListSnapshotsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSnapshotsResponse.Unmarshal(m, b)
+}
+func (m *ListSnapshotsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSnapshotsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListSnapshotsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSnapshotsResponse.Merge(m, src)
+}
+func (m *ListSnapshotsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSnapshotsResponse.Size(m)
+}
+func (m *ListSnapshotsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSnapshotsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSnapshotsResponse proto.InternalMessageInfo
+
+func (m *ListSnapshotsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListSnapshotsResponse) GetSnapshots() []*SnapshotInfo {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
 }
 
-func (m *SegmentReferenceLock) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SegmentReferenceLock.Unmarshal(m, b)
+type CheckPrimaryKeysRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PrimaryKeys          *schemapb.IDs     `protobuf:"bytes,3,opt,name=primary_keys,json=primaryKeys,proto3" json:"primary_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (m *SegmentReferenceLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SegmentReferenceLock.Marshal(b, m, deterministic)
+
+func (m *CheckPrimaryKeysRequest) Reset()         { *m = CheckPrimaryKeysRequest{} }
+func (m *CheckPrimaryKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckPrimaryKeysRequest) ProtoMessage()    {}
+func (*CheckPrimaryKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{77}
 }
-func (m *SegmentReferenceLock) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SegmentReferenceLock.Merge(m, src)
+
+func (m *CheckPrimaryKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckPrimaryKeysRequest.Unmarshal(m, b)
 }
-func (m *SegmentReferenceLock) XXX_Size() int {
-	return xxx_messageInfo_SegmentReferenceLock.Size(m)
+func (m *CheckPrimaryKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckPrimaryKeysRequest.Marshal(b, m, deterministic)
 }
-func (m *SegmentReferenceLock) XXX_DiscardUnknown() {
-	xxx_messageInfo_SegmentReferenceLock.DiscardUnknown(m)
+func (m *CheckPrimaryKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckPrimaryKeysRequest.Merge(m, src)
+}
+func (m *CheckPrimaryKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckPrimaryKeysRequest.Size(m)
+}
+func (m *CheckPrimaryKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckPrimaryKeysRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SegmentReferenceLock proto.InternalMessageInfo
+var xxx_messageInfo_CheckPrimaryKeysRequest proto.InternalMessageInfo
 
-func (m *SegmentReferenceLock) GetTaskID() int64 {
+func (m *CheckPrimaryKeysRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.TaskID
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
-func (m *SegmentReferenceLock) GetNodeID() int64 {
+func (m *CheckPrimaryKeysRequest) GetCollectionID() int64 {
 	if m != nil {
-		return m.NodeID
+		return m.CollectionID
 	}
 	return 0
 }
 
-func (m *SegmentReferenceLock) GetSegmentIDs() []int64 {
+func (m *CheckPrimaryKeysRequest) GetPrimaryKeys() *schemapb.IDs {
 	if m != nil {
-		return m.SegmentIDs
+		return m.PrimaryKeys
+	}
+	return nil
+}
+
+type CheckPrimaryKeysResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the primary keys which may exist, checked by the bloom filters and the ranges of the segments
+	ExistingKeys         *schemapb.IDs `protobuf:"bytes,2,opt,name=existing_keys,json=existingKeys,proto3" json:"existing_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CheckPrimaryKeysResponse) Reset()         { *m = CheckPrimaryKeysResponse{} }
+func (m *CheckPrimaryKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckPrimaryKeysResponse) ProtoMessage()    {}
+func (*CheckPrimaryKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{78}
+}
+
+func (m *CheckPrimaryKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckPrimaryKeysResponse.Unmarshal(m, b)
+}
+func (m *CheckPrimaryKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckPrimaryKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckPrimaryKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckPrimaryKeysResponse.Merge(m, src)
+}
+func (m *CheckPrimaryKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckPrimaryKeysResponse.Size(m)
+}
+func (m *CheckPrimaryKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckPrimaryKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckPrimaryKeysResponse proto.InternalMessageInfo
+
+func (m *CheckPrimaryKeysResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *CheckPrimaryKeysResponse) GetExistingKeys() *schemapb.IDs {
+	if m != nil {
+		return m.ExistingKeys
 	}
 	return nil
 }
@@ -4146,6 +5032,7 @@ func init() {
 	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.data.CollectionInfo")
 	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.data.SegmentInfo")
+	proto.RegisterType((*ClusteringKeyRange)(nil), "milvus.proto.data.ClusteringKeyRange")
 	proto.RegisterType((*SegmentStartPosition)(nil), "milvus.proto.data.SegmentStartPosition")
 	proto.RegisterType((*SaveBinlogPathsRequest)(nil), "milvus.proto.data.SaveBinlogPathsRequest")
 	proto.RegisterType((*CheckPoint)(nil), "milvus.proto.data.CheckPoint")
@@ -4165,7 +5052,9 @@ func init() {
 	proto.RegisterType((*ChannelWatchInfo)(nil), "milvus.proto.data.ChannelWatchInfo")
 	proto.RegisterType((*CompactionSegmentBinlogs)(nil), "milvus.proto.data.CompactionSegmentBinlogs")
 	proto.RegisterType((*CompactionPlan)(nil), "milvus.proto.data.CompactionPlan")
+	proto.RegisterType((*StopCompactionRequest)(nil), "milvus.proto.data.StopCompactionRequest")
 	proto.RegisterType((*CompactionResult)(nil), "milvus.proto.data.CompactionResult")
+	proto.RegisterType((*ClusteredSegment)(nil), "milvus.proto.data.ClusteredSegment")
 	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
 	proto.RegisterType((*WatchChannelsRequest)(nil), "milvus.proto.data.WatchChannelsRequest")
 	proto.RegisterType((*WatchChannelsResponse)(nil), "milvus.proto.data.WatchChannelsResponse")
@@ -4183,247 +5072,301 @@ func init() {
 	proto.RegisterType((*ResendSegmentStatsRequest)(nil), "milvus.proto.data.ResendSegmentStatsRequest")
 	proto.RegisterType((*ResendSegmentStatsResponse)(nil), "milvus.proto.data.ResendSegmentStatsResponse")
 	proto.RegisterType((*AddSegmentRequest)(nil), "milvus.proto.data.AddSegmentRequest")
+	proto.RegisterType((*TruncateSegmentsRequest)(nil), "milvus.proto.data.TruncateSegmentsRequest")
+	proto.RegisterType((*TruncateInfo)(nil), "milvus.proto.data.TruncateInfo")
+	proto.RegisterType((*TruncateSegmentsResponse)(nil), "milvus.proto.data.TruncateSegmentsResponse")
 	proto.RegisterType((*SegmentReferenceLock)(nil), "milvus.proto.data.SegmentReferenceLock")
+	proto.RegisterType((*SnapshotInfo)(nil), "milvus.proto.data.SnapshotInfo")
+	proto.RegisterType((*CreateSnapshotRequest)(nil), "milvus.proto.data.CreateSnapshotRequest")
+	proto.RegisterType((*CreateSnapshotResponse)(nil), "milvus.proto.data.CreateSnapshotResponse")
+	proto.RegisterType((*DropSnapshotRequest)(nil), "milvus.proto.data.DropSnapshotRequest")
+	proto.RegisterType((*ListSnapshotsRequest)(nil), "milvus.proto.data.ListSnapshotsRequest")
+	proto.RegisterType((*ListSnapshotsResponse)(nil), "milvus.proto.data.ListSnapshotsResponse")
+	proto.RegisterType((*CheckPrimaryKeysRequest)(nil), "milvus.proto.data.CheckPrimaryKeysRequest")
+	proto.RegisterType((*CheckPrimaryKeysResponse)(nil), "milvus.proto.data.CheckPrimaryKeysResponse")
 }
 
 func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
 
 var fileDescriptor_82cd95f524594f49 = []byte{
-	// 3740 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x1b, 0x5d, 0x6f, 0x1c, 0x57,
-	0x35, 0xb3, 0x5f, 0xde, 0x3d, 0xfb, 0xe1, 0xf5, 0x4d, 0xea, 0x6c, 0x36, 0x89, 0x93, 0x4c, 0x9a,
-	0x34, 0x4d, 0xd3, 0xa4, 0x75, 0xa8, 0x5a, 0xd1, 0x2f, 0xc5, 0x71, 0xe2, 0xac, 0xb0, 0x83, 0x33,
-	0x76, 0x1a, 0x44, 0x11, 0xab, 0xf1, 0xce, 0xf5, 0x7a, 0xea, 0x9d, 0x99, 0xcd, 0xcc, 0x6c, 0x1c,
-	0xf7, 0xa5, 0x15, 0x95, 0x90, 0x8a, 0x10, 0x45, 0x42, 0x48, 0x20, 0x81, 0x84, 0x78, 0x02, 0x24,
-	0x24, 0xa4, 0x8a, 0x07, 0x40, 0x7d, 0xaf, 0xe0, 0x01, 0xf1, 0xcc, 0x0f, 0x80, 0x17, 0xf8, 0x0d,
-	0xe8, 0x7e, 0xcc, 0x9d, 0xef, 0xdd, 0xf1, 0x3a, 0x69, 0x78, 0xdb, 0x7b, 0xe6, 0xdc, 0x73, 0xcf,
-	0x3d, 0xf7, 0x7c, 0xdf, 0xbb, 0xd0, 0xd4, 0x54, 0x57, 0xed, 0xf6, 0x2c, 0xcb, 0xd6, 0xae, 0x0e,
-	0x6d, 0xcb, 0xb5, 0xd0, 0x9c, 0xa1, 0x0f, 0x1e, 0x8d, 0x1c, 0x36, 0xba, 0x4a, 0x3e, 0xb7, 0x6b,
-	0x3d, 0xcb, 0x30, 0x2c, 0x93, 0x81, 0xda, 0x0d, 0xdd, 0x74, 0xb1, 0x6d, 0xaa, 0x03, 0x3e, 0xae,
-	0x05, 0x27, 0xb4, 0x6b, 0x4e, 0x6f, 0x07, 0x1b, 0x2a, 0x1b, 0xc9, 0x33, 0x50, 0xbc, 0x65, 0x0c,
-	0xdd, 0x7d, 0xf9, 0x67, 0x12, 0xd4, 0x6e, 0x0f, 0x46, 0xce, 0x8e, 0x82, 0x1f, 0x8e, 0xb0, 0xe3,
-	0xa2, 0x57, 0xa0, 0xb0, 0xa5, 0x3a, 0xb8, 0x25, 0x9d, 0x95, 0x2e, 0x55, 0x17, 0x4f, 0x5d, 0x0d,
-	0xad, 0xca, 0xd7, 0x5b, 0x73, 0xfa, 0x4b, 0xaa, 0x83, 0x15, 0x8a, 0x89, 0x10, 0x14, 0xb4, 0xad,
-	0xce, 0x72, 0x2b, 0x77, 0x56, 0xba, 0x94, 0x57, 0xe8, 0x6f, 0xb4, 0x00, 0xe0, 0xe0, 0xbe, 0x81,
-	0x4d, 0xb7, 0xb3, 0xec, 0xb4, 0xf2, 0x67, 0xf3, 0x97, 0xf2, 0x4a, 0x00, 0x82, 0x64, 0xa8, 0xf5,
-	0xac, 0xc1, 0x00, 0xf7, 0x5c, 0xdd, 0x32, 0x3b, 0xcb, 0xad, 0x02, 0x9d, 0x1b, 0x82, 0xc9, 0xbf,
-	0x90, 0xa0, 0xce, 0x59, 0x73, 0x86, 0x96, 0xe9, 0x60, 0x74, 0x1d, 0x4a, 0x8e, 0xab, 0xba, 0x23,
-	0x87, 0x73, 0x77, 0x32, 0x91, 0xbb, 0x0d, 0x8a, 0xa2, 0x70, 0xd4, 0x44, 0xf6, 0xa2, 0xcb, 0xe7,
-	0xe3, 0xcb, 0x47, 0xb6, 0x50, 0x88, 0x6e, 0x41, 0xfe, 0x87, 0x04, 0xcd, 0x0d, 0x6f, 0xe8, 0x49,
-	0xef, 0x18, 0x14, 0x7b, 0xd6, 0xc8, 0x74, 0x29, 0x83, 0x75, 0x85, 0x0d, 0xd0, 0x39, 0xa8, 0xf5,
-	0x76, 0x54, 0xd3, 0xc4, 0x83, 0xae, 0xa9, 0x1a, 0x98, 0xb2, 0x52, 0x51, 0xaa, 0x1c, 0x76, 0x57,
-	0x35, 0x70, 0x26, 0x8e, 0xce, 0x42, 0x75, 0xa8, 0xda, 0xae, 0x1e, 0x92, 0x59, 0x10, 0x84, 0xda,
-	0x50, 0xd6, 0x9d, 0x8e, 0x31, 0xb4, 0x6c, 0xb7, 0x55, 0x3c, 0x2b, 0x5d, 0x2a, 0x2b, 0x62, 0x4c,
-	0x56, 0xd0, 0xe9, 0xaf, 0x4d, 0xd5, 0xd9, 0xed, 0x2c, 0xb7, 0x4a, 0x6c, 0x85, 0x20, 0x4c, 0xfe,
-	0x95, 0x04, 0xf3, 0x37, 0x1c, 0x47, 0xef, 0x9b, 0xb1, 0x9d, 0xcd, 0x43, 0xc9, 0xb4, 0x34, 0xdc,
-	0x59, 0xa6, 0x5b, 0xcb, 0x2b, 0x7c, 0x84, 0x4e, 0x42, 0x65, 0x88, 0xb1, 0xdd, 0xb5, 0xad, 0x81,
-	0xb7, 0xb1, 0x32, 0x01, 0x28, 0xd6, 0x00, 0xa3, 0x7b, 0x30, 0xe7, 0x44, 0x08, 0x31, 0x6d, 0xa8,
-	0x2e, 0x9e, 0xbf, 0x1a, 0xd3, 0xe7, 0xab, 0xd1, 0x45, 0x95, 0xf8, 0x6c, 0xf9, 0xe3, 0x1c, 0x1c,
-	0x15, 0x78, 0x8c, 0x57, 0xf2, 0x9b, 0x48, 0xde, 0xc1, 0x7d, 0xc1, 0x1e, 0x1b, 0x64, 0x91, 0xbc,
-	0x38, 0xb2, 0x7c, 0xf0, 0xc8, 0x32, 0x28, 0x68, 0xf4, 0x3c, 0x8a, 0xf1, 0xf3, 0x38, 0x03, 0x55,
-	0xfc, 0x78, 0xa8, 0xdb, 0xb8, 0xeb, 0xea, 0x06, 0xa6, 0x22, 0x2f, 0x28, 0xc0, 0x40, 0x9b, 0xba,
-	0x11, 0xd4, 0xe8, 0x99, 0xcc, 0x1a, 0x2d, 0xff, 0x5a, 0x82, 0xe3, 0xb1, 0x53, 0xe2, 0x26, 0xa2,
-	0x40, 0x93, 0xee, 0xdc, 0x97, 0x0c, 0x31, 0x16, 0x22, 0xf0, 0x8b, 0xe3, 0x04, 0xee, 0xa3, 0x2b,
-	0xb1, 0xf9, 0x01, 0x26, 0x73, 0xd9, 0x99, 0xdc, 0x85, 0xe3, 0x2b, 0xd8, 0xe5, 0x0b, 0x90, 0x6f,
-	0xd8, 0x99, 0xde, 0xc5, 0x84, 0x6d, 0x31, 0x17, 0xb3, 0xc5, 0x3f, 0xe4, 0x84, 0x2d, 0xd2, 0xa5,
-	0x3a, 0xe6, 0xb6, 0x85, 0x4e, 0x41, 0x45, 0xa0, 0x70, 0xad, 0xf0, 0x01, 0xe8, 0x75, 0x28, 0x12,
-	0x4e, 0x99, 0x4a, 0x34, 0x16, 0xcf, 0x25, 0xef, 0x29, 0x40, 0x53, 0x61, 0xf8, 0xa8, 0x03, 0x0d,
-	0xc7, 0x55, 0x6d, 0xb7, 0x3b, 0xb4, 0x1c, 0x7a, 0xce, 0x54, 0x71, 0xaa, 0x8b, 0x72, 0x98, 0x82,
-	0x70, 0xc6, 0x6b, 0x4e, 0x7f, 0x9d, 0x63, 0x2a, 0x75, 0x3a, 0xd3, 0x1b, 0xa2, 0x5b, 0x50, 0xc3,
-	0xa6, 0xe6, 0x13, 0x2a, 0x64, 0x26, 0x54, 0xc5, 0xa6, 0x26, 0xc8, 0xf8, 0xe7, 0x53, 0xcc, 0x7e,
-	0x3e, 0x3f, 0x94, 0xa0, 0x15, 0x3f, 0xa0, 0xc3, 0x38, 0xda, 0x37, 0xd9, 0x24, 0xcc, 0x0e, 0x68,
-	0xac, 0x85, 0x8b, 0x43, 0x52, 0xf8, 0x14, 0xf9, 0xa7, 0x12, 0x3c, 0xe7, 0xb3, 0x43, 0x3f, 0x3d,
-	0x2d, 0x6d, 0x41, 0x97, 0xa1, 0xa9, 0x9b, 0xbd, 0xc1, 0x48, 0xc3, 0xf7, 0xcd, 0x3b, 0x58, 0x1d,
-	0xb8, 0x3b, 0xfb, 0xf4, 0x0c, 0xcb, 0x4a, 0x0c, 0x2e, 0x7f, 0x22, 0xc1, 0x7c, 0x94, 0xaf, 0xc3,
-	0x08, 0xe9, 0x6b, 0x50, 0xd4, 0xcd, 0x6d, 0xcb, 0x93, 0xd1, 0xc2, 0x18, 0xa3, 0x24, 0x6b, 0x31,
-	0x64, 0xd9, 0x80, 0x93, 0x2b, 0xd8, 0xed, 0x98, 0x0e, 0xb6, 0xdd, 0x25, 0xdd, 0x1c, 0x58, 0xfd,
-	0x75, 0xd5, 0xdd, 0x39, 0x84, 0x41, 0x85, 0x6c, 0x23, 0x17, 0xb1, 0x0d, 0xf9, 0x37, 0x12, 0x9c,
-	0x4a, 0x5e, 0x8f, 0x6f, 0xbd, 0x0d, 0xe5, 0x6d, 0x1d, 0x0f, 0x34, 0x22, 0x5f, 0x89, 0xca, 0x57,
-	0x8c, 0x89, 0x61, 0x0d, 0x09, 0x32, 0xdf, 0xe1, 0xb9, 0x14, 0x6d, 0xde, 0x70, 0x6d, 0xdd, 0xec,
-	0xaf, 0xea, 0x8e, 0xab, 0x30, 0xfc, 0x80, 0x3c, 0xf3, 0xd9, 0xd5, 0xf8, 0x07, 0x12, 0x2c, 0xac,
-	0x60, 0xf7, 0xa6, 0xf0, 0xcb, 0xe4, 0xbb, 0xee, 0xb8, 0x7a, 0xcf, 0x79, 0xb2, 0x19, 0x4d, 0x86,
-	0x00, 0x2d, 0x7f, 0x26, 0xc1, 0x99, 0x54, 0x66, 0xb8, 0xe8, 0xb8, 0xdf, 0xf1, 0xbc, 0x72, 0xb2,
-	0xdf, 0xf9, 0x06, 0xde, 0x7f, 0x4f, 0x1d, 0x8c, 0xf0, 0xba, 0xaa, 0xdb, 0xcc, 0xef, 0x4c, 0xe9,
-	0x85, 0x7f, 0x2f, 0xc1, 0xe9, 0x15, 0xec, 0xae, 0x7b, 0x31, 0xe9, 0x19, 0x4a, 0x87, 0xe0, 0x04,
-	0x62, 0xa3, 0x97, 0x52, 0x85, 0x60, 0xf2, 0x8f, 0xd8, 0x71, 0x26, 0xf2, 0xfb, 0x4c, 0x04, 0xb8,
-	0x40, 0x2d, 0x21, 0x60, 0x92, 0x37, 0x59, 0xea, 0xc0, 0xc5, 0x27, 0xff, 0x52, 0x82, 0x13, 0x37,
-	0x7a, 0x0f, 0x47, 0xba, 0x8d, 0x39, 0xd2, 0xaa, 0xd5, 0xdb, 0x9d, 0x5e, 0xb8, 0x7e, 0x9a, 0x95,
-	0x0b, 0xa5, 0x59, 0x93, 0x12, 0xea, 0x79, 0x28, 0xb9, 0x2c, 0xaf, 0x63, 0x99, 0x0a, 0x1f, 0x51,
-	0xfe, 0x14, 0x3c, 0xc0, 0xaa, 0xf3, 0xff, 0xc9, 0xdf, 0x67, 0x05, 0xa8, 0xbd, 0xc7, 0xd3, 0x31,
-	0x1a, 0xb5, 0xa3, 0x9a, 0x24, 0x25, 0x27, 0x5e, 0x81, 0x0c, 0x2e, 0x29, 0xa9, 0x5b, 0x81, 0xba,
-	0x83, 0xf1, 0xee, 0x34, 0x31, 0xba, 0x46, 0x26, 0x8a, 0xd8, 0xba, 0x0a, 0x73, 0x23, 0x73, 0x9b,
-	0x54, 0x21, 0x58, 0xe3, 0x02, 0x64, 0x9a, 0x3b, 0xd9, 0x77, 0xc7, 0x27, 0xa2, 0x3b, 0x30, 0x1b,
-	0xa5, 0x55, 0xcc, 0x44, 0x2b, 0x3a, 0x0d, 0x75, 0xa0, 0xa9, 0xd9, 0xd6, 0x70, 0x88, 0xb5, 0xae,
-	0xe3, 0x91, 0x2a, 0x65, 0x23, 0xc5, 0xe7, 0x09, 0x52, 0xaf, 0xc0, 0xd1, 0x28, 0xa7, 0x1d, 0x8d,
-	0x24, 0xa4, 0xe4, 0x0c, 0x93, 0x3e, 0xa1, 0x2b, 0x30, 0x17, 0xc7, 0x2f, 0x53, 0xfc, 0xf8, 0x07,
-	0xf4, 0x32, 0xa0, 0x08, 0xab, 0x04, 0xbd, 0xc2, 0xd0, 0xc3, 0xcc, 0x74, 0x34, 0x47, 0xfe, 0x54,
-	0x82, 0xf9, 0x07, 0xaa, 0xdb, 0xdb, 0x59, 0x36, 0xb8, 0xad, 0x1d, 0xc2, 0x57, 0xbd, 0x0d, 0x95,
-	0x47, 0x5c, 0x2f, 0xbc, 0x80, 0x74, 0x26, 0x41, 0x3e, 0x41, 0x0d, 0x54, 0xfc, 0x19, 0xf2, 0x97,
-	0x12, 0x1c, 0xa3, 0x25, 0xa8, 0x27, 0xac, 0xaf, 0xde, 0x6b, 0x4e, 0x28, 0x43, 0xd1, 0x45, 0x68,
-	0x18, 0xaa, 0xbd, 0xbb, 0xe1, 0xe3, 0x14, 0x29, 0x4e, 0x04, 0x2a, 0x3f, 0x06, 0xe0, 0xa3, 0x35,
-	0xa7, 0x3f, 0x05, 0xff, 0x6f, 0xc0, 0x0c, 0x5f, 0x95, 0xbb, 0xcf, 0x49, 0x7a, 0xe6, 0xa1, 0xcb,
-	0x7f, 0x95, 0xa0, 0xe1, 0x87, 0x44, 0x6a, 0xe4, 0x0d, 0xc8, 0x09, 0xd3, 0xce, 0x75, 0x96, 0xd1,
-	0xdb, 0x50, 0x62, 0xed, 0x09, 0x4e, 0xfb, 0x42, 0x98, 0x36, 0x6f, 0x5d, 0x04, 0xe2, 0x2a, 0x05,
-	0x28, 0x7c, 0x12, 0x91, 0x91, 0x88, 0x22, 0xc2, 0xf9, 0xf8, 0x10, 0xd4, 0x81, 0xd9, 0x70, 0xca,
-	0xee, 0x99, 0xf0, 0xd9, 0xb4, 0xe0, 0xb1, 0xac, 0xba, 0x2a, 0x8d, 0x1d, 0x8d, 0x50, 0xc6, 0xee,
-	0xc8, 0xff, 0x2d, 0x42, 0x35, 0xb0, 0xcb, 0xd8, 0x4e, 0xa2, 0x47, 0x9a, 0x9b, 0x5c, 0x37, 0xe6,
-	0xe3, 0x75, 0xe3, 0x05, 0x68, 0xe8, 0x34, 0xf9, 0xea, 0x72, 0x55, 0xa4, 0x5e, 0xb3, 0xa2, 0xd4,
-	0x19, 0x94, 0xdb, 0x05, 0x5a, 0x80, 0xaa, 0x39, 0x32, 0xba, 0xd6, 0x76, 0xd7, 0xb6, 0xf6, 0x1c,
-	0x5e, 0x80, 0x56, 0xcc, 0x91, 0xf1, 0xcd, 0x6d, 0xc5, 0xda, 0x73, 0xfc, 0x1a, 0xa7, 0x74, 0xc0,
-	0x1a, 0x67, 0x01, 0xaa, 0x86, 0xfa, 0x98, 0x50, 0xed, 0x9a, 0x23, 0x83, 0xd6, 0xa6, 0x79, 0xa5,
-	0x62, 0xa8, 0x8f, 0x15, 0x6b, 0xef, 0xee, 0xc8, 0x40, 0x97, 0xa0, 0x39, 0x50, 0x1d, 0xb7, 0x1b,
-	0x2c, 0x6e, 0xcb, 0xb4, 0xb8, 0x6d, 0x10, 0xf8, 0x2d, 0xbf, 0xc0, 0x8d, 0x57, 0x4b, 0x95, 0x43,
-	0x54, 0x4b, 0x9a, 0x31, 0xf0, 0x09, 0x41, 0xf6, 0x6a, 0x49, 0x33, 0x06, 0x82, 0xcc, 0x1b, 0x30,
-	0xb3, 0x45, 0x53, 0x5a, 0xa7, 0x55, 0x4d, 0x75, 0x98, 0xb7, 0x49, 0x36, 0xcb, 0x32, 0x5f, 0xc5,
-	0x43, 0x47, 0x6f, 0x41, 0x85, 0x66, 0x12, 0x74, 0x6e, 0x2d, 0xd3, 0x5c, 0x7f, 0x02, 0x99, 0xad,
-	0xe1, 0x81, 0xab, 0xd2, 0xd9, 0xf5, 0x6c, 0xb3, 0xc5, 0x04, 0xe2, 0xa4, 0x7b, 0x36, 0x56, 0x5d,
-	0xac, 0x2d, 0xed, 0xdf, 0xb4, 0x8c, 0xa1, 0x4a, 0x95, 0xa9, 0xd5, 0xa0, 0x65, 0x4b, 0xd2, 0x27,
-	0xe2, 0x18, 0x7a, 0x62, 0x74, 0xdb, 0xb6, 0x8c, 0xd6, 0x2c, 0x73, 0x0c, 0x61, 0x28, 0x3a, 0x0d,
-	0xe0, 0xb9, 0x67, 0xd5, 0x6d, 0x35, 0xe9, 0x29, 0x56, 0x38, 0xe4, 0x86, 0x2b, 0x7f, 0x04, 0xc7,
-	0x7c, 0x0d, 0x09, 0x9c, 0x46, 0xfc, 0x60, 0xa5, 0x69, 0x0f, 0x76, 0x7c, 0x31, 0xf2, 0xf7, 0x02,
-	0xcc, 0x6f, 0xa8, 0x8f, 0xf0, 0xd3, 0xaf, 0x7b, 0x32, 0xf9, 0xe3, 0x55, 0x98, 0xa3, 0xa5, 0xce,
-	0x62, 0x80, 0x9f, 0x31, 0x09, 0x41, 0xf0, 0x38, 0xe3, 0x13, 0xd1, 0xbb, 0x24, 0x93, 0xc1, 0xbd,
-	0xdd, 0x75, 0x4b, 0xf7, 0x93, 0x81, 0xd3, 0x09, 0x74, 0x6e, 0x0a, 0x2c, 0x25, 0x38, 0x03, 0xad,
-	0xc7, 0x5d, 0x1b, 0x4b, 0x03, 0x5e, 0x18, 0x5b, 0x7d, 0xfb, 0xd2, 0x8f, 0x7a, 0x38, 0xd4, 0x82,
-	0x19, 0x1e, 0xc3, 0xa9, 0xdd, 0x97, 0x15, 0x6f, 0x88, 0xd6, 0xe1, 0x28, 0xdb, 0xc1, 0x06, 0x57,
-	0x6a, 0xb6, 0xf9, 0x72, 0xa6, 0xcd, 0x27, 0x4d, 0x0d, 0xdb, 0x44, 0xe5, 0xa0, 0x36, 0xd1, 0x82,
-	0x19, 0xae, 0xa7, 0xd4, 0x17, 0x94, 0x15, 0x6f, 0x48, 0x8e, 0x99, 0xf5, 0x35, 0x75, 0xb3, 0xdf,
-	0xaa, 0xd2, 0x6f, 0x3e, 0x80, 0xd4, 0x8c, 0xe0, 0xcb, 0x73, 0x42, 0x9f, 0xe8, 0x1d, 0x28, 0x0b,
-	0x0d, 0xcf, 0x65, 0xd6, 0x70, 0x31, 0x27, 0xea, 0xa3, 0xf3, 0x11, 0x1f, 0x2d, 0xff, 0x4d, 0x82,
-	0xda, 0x32, 0xd9, 0xd2, 0xaa, 0xd5, 0xa7, 0x11, 0xe5, 0x02, 0x34, 0x6c, 0xdc, 0xb3, 0x6c, 0xad,
-	0x8b, 0x4d, 0xd7, 0xd6, 0x31, 0x6b, 0x2f, 0x14, 0x94, 0x3a, 0x83, 0xde, 0x62, 0x40, 0x82, 0x46,
-	0xdc, 0xae, 0xe3, 0xaa, 0xc6, 0xb0, 0xbb, 0x4d, 0xcc, 0x3b, 0xc7, 0xd0, 0x04, 0x94, 0x5a, 0xf7,
-	0x39, 0xa8, 0xf9, 0x68, 0xae, 0x45, 0xd7, 0x2f, 0x28, 0x55, 0x01, 0xdb, 0xb4, 0xd0, 0xf3, 0xd0,
-	0xa0, 0x32, 0xed, 0x0e, 0xac, 0x7e, 0x97, 0x94, 0xe2, 0x3c, 0xd8, 0xd4, 0x34, 0xce, 0x16, 0x39,
-	0xab, 0x30, 0x96, 0xa3, 0x7f, 0x88, 0x79, 0xb8, 0x11, 0x58, 0x1b, 0xfa, 0x87, 0x98, 0xc4, 0xfa,
-	0x3a, 0x89, 0x9d, 0x77, 0x2d, 0x0d, 0x6f, 0x4e, 0x99, 0x69, 0x64, 0xe8, 0xd9, 0x9e, 0x82, 0x8a,
-	0xd8, 0x01, 0xdf, 0x92, 0x0f, 0x40, 0xb7, 0xa1, 0xe1, 0xe5, 0xc4, 0x5d, 0x56, 0x2a, 0x16, 0x52,
-	0x33, 0xbf, 0x40, 0xf4, 0x73, 0x94, 0xba, 0x37, 0x8d, 0x0e, 0xe5, 0xdb, 0x50, 0x0b, 0x7e, 0x26,
-	0xab, 0x6e, 0x44, 0x15, 0x45, 0x00, 0x88, 0x36, 0xde, 0x1d, 0x19, 0xe4, 0x4c, 0xb9, 0x63, 0xf1,
-	0x86, 0xf2, 0x27, 0x12, 0xd4, 0x79, 0xc8, 0xde, 0x10, 0x77, 0x12, 0x74, 0x6b, 0x12, 0xdd, 0x1a,
-	0xfd, 0x8d, 0xbe, 0x1e, 0x6e, 0x48, 0x3e, 0x9f, 0xe8, 0x04, 0x28, 0x11, 0x9a, 0x1d, 0x87, 0xe2,
-	0x75, 0x96, 0xe6, 0xc4, 0xc7, 0x44, 0xd1, 0xf8, 0xd1, 0x50, 0x45, 0x6b, 0xc1, 0x8c, 0xaa, 0x69,
-	0x36, 0x76, 0x1c, 0xce, 0x87, 0x37, 0x24, 0x5f, 0x1e, 0x61, 0xdb, 0xf1, 0x54, 0x3e, 0xaf, 0x78,
-	0x43, 0xf4, 0x16, 0x94, 0x45, 0x3a, 0x9d, 0x4f, 0x4a, 0xa1, 0x82, 0x7c, 0xf2, 0x52, 0x5a, 0xcc,
-	0x90, 0xff, 0x98, 0x83, 0x06, 0x17, 0xd8, 0x12, 0x8f, 0xa9, 0xe3, 0x8d, 0x6f, 0x09, 0x6a, 0xdb,
-	0xbe, 0xed, 0x8f, 0x6b, 0x9a, 0x05, 0x5d, 0x44, 0x68, 0xce, 0x24, 0x03, 0x0c, 0x47, 0xf5, 0xc2,
-	0xa1, 0xa2, 0x7a, 0xf1, 0xa0, 0x1e, 0x2c, 0x9e, 0xe7, 0x95, 0x12, 0xf2, 0x3c, 0xf9, 0x3b, 0x50,
-	0x0d, 0x10, 0xa0, 0x1e, 0x9a, 0x75, 0xdb, 0xb8, 0xc4, 0xbc, 0x21, 0xba, 0xee, 0xe7, 0x36, 0x4c,
-	0x54, 0x27, 0x12, 0x78, 0x89, 0xa4, 0x35, 0xf2, 0x6f, 0x25, 0x28, 0x71, 0xca, 0x67, 0xa0, 0xca,
-	0x9d, 0x0e, 0xcd, 0xfb, 0x18, 0x75, 0xe0, 0x20, 0x92, 0xf8, 0x3d, 0x39, 0xaf, 0x73, 0x02, 0xca,
-	0x11, 0x7f, 0x33, 0xc3, 0xc3, 0x82, 0xf7, 0x29, 0xe0, 0x64, 0xc8, 0x27, 0xea, 0x5f, 0xbe, 0x94,
-	0xe8, 0xb5, 0x82, 0x82, 0x7b, 0xd6, 0x23, 0x6c, 0xef, 0x1f, 0xbe, 0x1f, 0xfb, 0x66, 0x40, 0xa1,
-	0x33, 0xd6, 0x87, 0x62, 0x02, 0x7a, 0xd3, 0x17, 0x77, 0x3e, 0xa9, 0x19, 0x15, 0xf4, 0x30, 0x5c,
-	0x1d, 0x7d, 0xb1, 0xff, 0x98, 0x75, 0x96, 0xc3, 0x5b, 0x99, 0x36, 0xaf, 0x79, 0x22, 0x65, 0x87,
-	0xfc, 0x13, 0x09, 0x4e, 0xac, 0x60, 0xf7, 0x76, 0xb8, 0xd7, 0xf0, 0xac, 0xb9, 0x32, 0xa0, 0x9d,
-	0xc4, 0xd4, 0x61, 0x4e, 0xbd, 0x0d, 0x65, 0xd1, 0x35, 0x61, 0xf7, 0x03, 0x62, 0x2c, 0x7f, 0x5f,
-	0x82, 0x16, 0x5f, 0x85, 0xae, 0x49, 0x52, 0xea, 0x01, 0x76, 0xb1, 0xf6, 0x55, 0xd7, 0xcd, 0x5f,
-	0x48, 0xd0, 0x0c, 0x7a, 0x7c, 0xea, 0xb4, 0x5f, 0x83, 0x22, 0x6d, 0x4f, 0x70, 0x0e, 0x26, 0x2a,
-	0x2b, 0xc3, 0x26, 0x2e, 0x83, 0xa6, 0x79, 0x9b, 0x22, 0x38, 0xf1, 0xa1, 0x1f, 0x76, 0xf2, 0x07,
-	0x0f, 0x3b, 0x3c, 0x0c, 0x5b, 0x23, 0x42, 0x97, 0xf5, 0xf5, 0x7c, 0x80, 0xfc, 0x79, 0x0e, 0x5a,
-	0x7e, 0x3d, 0xf2, 0x95, 0xfb, 0xfd, 0x94, 0x6c, 0x35, 0xff, 0x84, 0xb2, 0xd5, 0xc2, 0xe1, 0x7d,
-	0x7d, 0x31, 0xc9, 0xd7, 0xff, 0x25, 0x07, 0x0d, 0x5f, 0x6a, 0xeb, 0x03, 0xd5, 0x44, 0xf3, 0x50,
-	0x1a, 0x0e, 0x54, 0xbf, 0x19, 0xca, 0x47, 0x68, 0x43, 0xe4, 0x39, 0x61, 0x39, 0xbd, 0x94, 0x74,
-	0x86, 0x29, 0x07, 0xa1, 0x44, 0x48, 0x90, 0x72, 0x90, 0x15, 0x14, 0xb4, 0xa8, 0xe7, 0xb9, 0x15,
-	0x53, 0x16, 0x52, 0xcf, 0x5f, 0x01, 0xc4, 0x4f, 0xb8, 0xab, 0x9b, 0x5d, 0x07, 0xf7, 0x2c, 0x53,
-	0x63, 0x67, 0x5f, 0x54, 0x9a, 0xfc, 0x4b, 0xc7, 0xdc, 0x60, 0x70, 0xf4, 0x1a, 0x14, 0xdc, 0xfd,
-	0x21, 0xf3, 0xe2, 0x8d, 0x44, 0xef, 0xe8, 0xf3, 0xb5, 0xb9, 0x3f, 0xc4, 0x0a, 0x45, 0x47, 0x0b,
-	0x00, 0x84, 0x94, 0x6b, 0xab, 0x8f, 0x78, 0x48, 0x2c, 0x28, 0x01, 0x08, 0xd1, 0x66, 0x4f, 0x86,
-	0x33, 0x2c, 0x74, 0xf0, 0xa1, 0xfc, 0xa7, 0x1c, 0x34, 0x7d, 0x92, 0x0a, 0x76, 0x46, 0x03, 0x37,
-	0x55, 0x7e, 0xe3, 0x8b, 0xc1, 0x49, 0x79, 0xc3, 0xbb, 0x50, 0xe5, 0xe7, 0x79, 0x00, 0x7d, 0x00,
-	0x36, 0x65, 0x75, 0x8c, 0x82, 0x16, 0x9f, 0x90, 0x82, 0x96, 0x0e, 0xa8, 0xa0, 0xf2, 0x06, 0xcc,
-	0x7b, 0x7e, 0xcf, 0x47, 0x58, 0xc3, 0xae, 0x3a, 0x26, 0xe1, 0x38, 0x03, 0x55, 0x16, 0xcf, 0x58,
-	0x20, 0x67, 0xa9, 0x3a, 0x6c, 0x89, 0x0a, 0x57, 0xfe, 0x2e, 0x1c, 0xa3, 0x7e, 0x23, 0xda, 0xca,
-	0xcd, 0xd2, 0xe6, 0x97, 0x45, 0x21, 0x40, 0x92, 0x7e, 0xa6, 0xdd, 0x15, 0x25, 0x04, 0x93, 0x57,
-	0xe1, 0xb9, 0x08, 0xfd, 0x43, 0xc4, 0x05, 0x92, 0x0a, 0xcd, 0x6f, 0x84, 0x2f, 0xc5, 0xa7, 0x8f,
-	0x7e, 0xa7, 0x45, 0xe7, 0xb6, 0xab, 0x6b, 0x51, 0xfd, 0xd2, 0xd0, 0x3b, 0x50, 0x31, 0xf1, 0x5e,
-	0x37, 0xe8, 0x7c, 0x33, 0x34, 0xe8, 0xca, 0x26, 0xde, 0xa3, 0xbf, 0xe4, 0xbb, 0x70, 0x3c, 0xc6,
-	0xea, 0x61, 0xf6, 0xfe, 0x67, 0x09, 0x4e, 0x2c, 0xdb, 0xd6, 0xf0, 0x3d, 0xdd, 0x76, 0x47, 0xea,
-	0x20, 0x7c, 0xcf, 0xf5, 0x74, 0xca, 0xb8, 0x3b, 0x81, 0x30, 0xcc, 0xfc, 0xf2, 0x95, 0x04, 0x75,
-	0x8d, 0x33, 0xc5, 0x37, 0x1d, 0x08, 0xda, 0xff, 0xca, 0x27, 0x31, 0xcf, 0xf1, 0x26, 0x04, 0x9b,
-	0x2c, 0x59, 0x4a, 0x62, 0xd7, 0x27, 0x3f, 0x6d, 0xd7, 0x27, 0xc5, 0xf2, 0x0b, 0x4f, 0xc8, 0xf2,
-	0x0f, 0x5c, 0x86, 0xdc, 0x81, 0x70, 0x47, 0x8e, 0xba, 0xdc, 0xa9, 0x5a, 0x79, 0x4b, 0x00, 0x7e,
-	0x77, 0x8a, 0xbf, 0x69, 0xca, 0x42, 0x26, 0x30, 0x8b, 0x9c, 0x96, 0xf0, 0xb2, 0xb4, 0xab, 0x1c,
-	0xea, 0x97, 0xdc, 0x83, 0x76, 0x92, 0x96, 0x1e, 0x46, 0xf3, 0x3f, 0xcf, 0x01, 0x74, 0xc4, 0x33,
-	0xb8, 0xe9, 0x32, 0xca, 0xf3, 0x50, 0xf7, 0x15, 0xc6, 0xb7, 0xf7, 0xa0, 0x16, 0x69, 0xc4, 0x24,
-	0x44, 0x62, 0x4b, 0x70, 0x62, 0xc9, 0xae, 0x46, 0xe9, 0x04, 0xac, 0x86, 0x29, 0x45, 0xc4, 0xe9,
-	0xa1, 0x93, 0x50, 0xb1, 0xad, 0xbd, 0x2e, 0x31, 0x33, 0xcd, 0x7b, 0xe7, 0x67, 0x5b, 0x7b, 0xc4,
-	0xf8, 0x34, 0x74, 0x1c, 0x66, 0x5c, 0xd5, 0xd9, 0x25, 0xf4, 0x4b, 0x81, 0xab, 0x56, 0x0d, 0x1d,
-	0x83, 0xe2, 0xb6, 0x3e, 0xc0, 0xec, 0x66, 0xaf, 0xa2, 0xb0, 0x01, 0x7a, 0xdd, 0x7b, 0x90, 0x52,
-	0xce, 0x7c, 0x9d, 0xce, 0xde, 0xa4, 0x7c, 0x29, 0xc1, 0xac, 0x2f, 0x35, 0xea, 0x80, 0x88, 0x4f,
-	0xa3, 0xfe, 0xec, 0xa6, 0xa5, 0x31, 0x57, 0xd1, 0x48, 0xb9, 0x62, 0x61, 0x13, 0x99, 0xd7, 0xf2,
-	0xa7, 0x8c, 0xcb, 0xcb, 0xc9, 0xbe, 0xc8, 0xa6, 0x75, 0xcd, 0xbb, 0xe1, 0x29, 0xd9, 0xd6, 0x5e,
-	0x47, 0x13, 0xd2, 0x60, 0x8f, 0xf8, 0x58, 0x16, 0x4a, 0xa4, 0x71, 0x93, 0xbe, 0xe3, 0x3b, 0x0f,
-	0x75, 0x6c, 0xdb, 0x96, 0xdd, 0x35, 0xb0, 0xe3, 0xa8, 0x7d, 0xcc, 0x93, 0xae, 0x1a, 0x05, 0xae,
-	0x31, 0x98, 0xfc, 0x45, 0x1e, 0x1a, 0xfe, 0x56, 0xbc, 0x7b, 0x1d, 0x5d, 0xf3, 0xee, 0x75, 0x74,
-	0x72, 0x74, 0x60, 0x33, 0x57, 0x28, 0x0e, 0x77, 0x29, 0xd7, 0x92, 0x94, 0x0a, 0x87, 0x76, 0x34,
-	0x12, 0x0b, 0x89, 0x91, 0x99, 0x96, 0x86, 0xfd, 0xc3, 0x05, 0x0f, 0xc4, 0xcf, 0x36, 0xa4, 0x23,
-	0x85, 0x0c, 0x3a, 0x52, 0xcc, 0xa0, 0x23, 0xa5, 0x04, 0x1d, 0x99, 0x87, 0xd2, 0xd6, 0xa8, 0xb7,
-	0x8b, 0x5d, 0x9e, 0x22, 0xf1, 0x51, 0x58, 0x77, 0xca, 0x11, 0xdd, 0x11, 0x2a, 0x52, 0x09, 0xaa,
-	0xc8, 0x49, 0xa8, 0xb0, 0x0b, 0x86, 0xae, 0xeb, 0xd0, 0x4e, 0x6b, 0x5e, 0x29, 0x33, 0xc0, 0xa6,
-	0x83, 0xde, 0xf0, 0xea, 0x87, 0x6a, 0x92, 0xb1, 0x53, 0xaf, 0x13, 0xd1, 0x12, 0xaf, 0x7a, 0xb8,
-	0x00, 0x0d, 0xfa, 0xc8, 0xf9, 0xe1, 0x08, 0xdb, 0xfb, 0xea, 0xd6, 0x00, 0xb7, 0x6a, 0x94, 0x9d,
-	0x3a, 0x81, 0xde, 0xf3, 0x80, 0x44, 0x20, 0x14, 0x4d, 0x37, 0x35, 0xfc, 0x18, 0x6b, 0xad, 0x3a,
-	0x45, 0xa2, 0xa2, 0xee, 0x30, 0x90, 0xfc, 0x01, 0x20, 0x7f, 0x8d, 0xc3, 0x55, 0x86, 0x91, 0x43,
-	0xcc, 0x45, 0x0f, 0x51, 0xfe, 0x9d, 0x04, 0x73, 0xc1, 0xc5, 0xa6, 0x0d, 0x8f, 0xef, 0x40, 0x95,
-	0x75, 0xa4, 0xbb, 0xc4, 0x3c, 0x79, 0x6d, 0x78, 0x7a, 0xac, 0xf4, 0x14, 0xf0, 0x1f, 0xeb, 0x12,
-	0x25, 0xd8, 0xb3, 0xec, 0x5d, 0xdd, 0xec, 0x77, 0x09, 0x67, 0x9e, 0x51, 0xd4, 0x38, 0xf0, 0x2e,
-	0x81, 0xc9, 0x9f, 0x4a, 0xb0, 0x70, 0x7f, 0xa8, 0xa9, 0x2e, 0x0e, 0xe4, 0x09, 0x87, 0x7d, 0xff,
-	0xf3, 0x9a, 0xf7, 0x00, 0x27, 0x97, 0xad, 0xab, 0xca, 0xb0, 0xe5, 0x35, 0x38, 0xa1, 0x60, 0x07,
-	0x9b, 0x5a, 0xe8, 0xe3, 0xb4, 0x5c, 0xc8, 0x43, 0x68, 0x27, 0x91, 0x3b, 0xcc, 0xd9, 0xb3, 0x84,
-	0xad, 0x6b, 0x13, 0xb2, 0x2e, 0xf7, 0x3f, 0x24, 0x4f, 0xa0, 0xeb, 0xb8, 0xf2, 0xbf, 0x25, 0x98,
-	0xbb, 0xa1, 0x79, 0xeb, 0x3d, 0xb5, 0xbc, 0x30, 0x9a, 0x37, 0xe5, 0xe3, 0x79, 0xd3, 0x93, 0x72,
-	0x24, 0xdc, 0xa5, 0x9a, 0x23, 0xc3, 0x0b, 0x15, 0x36, 0xbd, 0xdf, 0x95, 0xb7, 0xc5, 0xa5, 0x9f,
-	0x82, 0xb7, 0xb1, 0x8d, 0xcd, 0x1e, 0x5e, 0xb5, 0x7a, 0xbb, 0x81, 0x57, 0x3c, 0x52, 0xf0, 0x15,
-	0xcf, 0xb4, 0xaf, 0x82, 0x2e, 0xff, 0x5c, 0x82, 0xb9, 0x58, 0x77, 0x01, 0x35, 0x00, 0xee, 0x9b,
-	0x3d, 0xde, 0x76, 0x69, 0x1e, 0x41, 0x35, 0x28, 0x7b, 0x4d, 0x98, 0xa6, 0x84, 0xaa, 0x30, 0xb3,
-	0x69, 0x51, 0xec, 0x66, 0x0e, 0x35, 0xa1, 0xc6, 0x26, 0x8e, 0x7a, 0x3d, 0xec, 0x38, 0xcd, 0xbc,
-	0x80, 0xdc, 0x56, 0xf5, 0xc1, 0xc8, 0xc6, 0xcd, 0x02, 0xaa, 0x43, 0x65, 0xd3, 0xe2, 0x6f, 0xa0,
-	0x9a, 0x45, 0x84, 0xa0, 0xe1, 0x3d, 0x88, 0xe2, 0x93, 0x4a, 0x01, 0x98, 0x37, 0x6d, 0xe6, 0xf2,
-	0x76, 0xb0, 0x0e, 0x27, 0xc5, 0x29, 0x3a, 0x0e, 0x47, 0xef, 0x9b, 0x1a, 0xde, 0xd6, 0x4d, 0xac,
-	0xf9, 0x9f, 0x9a, 0x47, 0xd0, 0x51, 0x98, 0xed, 0x98, 0x26, 0xb6, 0x03, 0x40, 0x89, 0x00, 0xd7,
-	0xb0, 0xdd, 0xc7, 0x01, 0x60, 0x0e, 0xcd, 0x41, 0x7d, 0x4d, 0x7f, 0x1c, 0x00, 0xe5, 0x17, 0xff,
-	0x79, 0x1c, 0x2a, 0xcb, 0xaa, 0xab, 0xde, 0xb4, 0x2c, 0x5b, 0x43, 0x43, 0x40, 0xf4, 0x05, 0xa1,
-	0x31, 0xb4, 0x4c, 0xf1, 0x2e, 0x17, 0xbd, 0x92, 0x92, 0x42, 0xc5, 0x51, 0xb9, 0x5a, 0xb6, 0x2f,
-	0xa6, 0xcc, 0x88, 0xa0, 0xcb, 0x47, 0x90, 0x41, 0x57, 0x24, 0xc5, 0xfd, 0xa6, 0xde, 0xdb, 0xf5,
-	0x9e, 0x16, 0x8c, 0x59, 0x31, 0x82, 0xea, 0xad, 0x18, 0x79, 0xee, 0xcb, 0x07, 0xec, 0x99, 0xa7,
-	0x67, 0x97, 0xf2, 0x11, 0xf4, 0x10, 0x8e, 0xad, 0xe0, 0x80, 0x1f, 0xf2, 0x16, 0x5c, 0x4c, 0x5f,
-	0x30, 0x86, 0x7c, 0xc0, 0x25, 0x57, 0xa1, 0x48, 0x3b, 0x79, 0x28, 0xc9, 0x55, 0x05, 0xff, 0xfc,
-	0xd2, 0x3e, 0x9b, 0x8e, 0x20, 0xa8, 0x7d, 0x00, 0xb3, 0x91, 0xc7, 0xf7, 0xe8, 0xc5, 0x84, 0x69,
-	0xc9, 0x7f, 0xa3, 0x68, 0x5f, 0xce, 0x82, 0x2a, 0xd6, 0xea, 0x43, 0x23, 0xfc, 0xfa, 0x10, 0x5d,
-	0x4a, 0x98, 0x9f, 0xf8, 0x6e, 0xba, 0xfd, 0x62, 0x06, 0x4c, 0xb1, 0x90, 0x01, 0xcd, 0xe8, 0x63,
-	0x70, 0x74, 0x79, 0x2c, 0x81, 0xb0, 0xba, 0xbd, 0x94, 0x09, 0x57, 0x2c, 0xb7, 0x4f, 0x95, 0x20,
-	0xf6, 0xbe, 0x18, 0x5d, 0x4d, 0x26, 0x93, 0xf6, 0xf0, 0xb9, 0x7d, 0x2d, 0x33, 0xbe, 0x58, 0xfa,
-	0x7b, 0xec, 0x06, 0x21, 0xe9, 0x8d, 0x2e, 0x7a, 0x35, 0x99, 0xdc, 0x98, 0xc7, 0xc5, 0xed, 0xc5,
-	0x83, 0x4c, 0x11, 0x4c, 0x7c, 0x44, 0x5b, 0xff, 0x09, 0xaf, 0x5c, 0xa3, 0x76, 0xe7, 0xd1, 0x4b,
-	0x7f, 0xc0, 0xdb, 0x7e, 0xf5, 0x00, 0x33, 0x04, 0x03, 0x56, 0xf4, 0xb5, 0xbd, 0x67, 0x86, 0xd7,
-	0x26, 0x6a, 0xcd, 0x74, 0x36, 0xf8, 0x3e, 0xcc, 0x46, 0x1e, 0x71, 0x24, 0x5a, 0x4d, 0xf2, 0x43,
-	0x8f, 0xf6, 0xb8, 0xf0, 0xcd, 0x4c, 0x32, 0x72, 0x93, 0x82, 0x52, 0xb4, 0x3f, 0xe1, 0xb6, 0xa5,
-	0x7d, 0x39, 0x0b, 0xaa, 0xd8, 0x88, 0x43, 0xdd, 0x65, 0xe4, 0x36, 0x02, 0x5d, 0x49, 0xa6, 0x91,
-	0x7c, 0x93, 0xd2, 0x7e, 0x39, 0x23, 0xb6, 0x58, 0xb4, 0x0b, 0xb0, 0x82, 0xdd, 0x35, 0xec, 0xda,
-	0x44, 0x47, 0x2e, 0x26, 0x8a, 0xdc, 0x47, 0xf0, 0x96, 0x79, 0x61, 0x22, 0x9e, 0x58, 0xe0, 0x5b,
-	0x80, 0xbc, 0x10, 0x1b, 0x78, 0x42, 0x74, 0x7e, 0x6c, 0xc3, 0x96, 0x75, 0x57, 0x27, 0x9d, 0xcd,
-	0x43, 0x68, 0xae, 0xa9, 0x26, 0x29, 0xd5, 0x7d, 0xba, 0x57, 0x12, 0x19, 0x8b, 0xa2, 0xa5, 0x48,
-	0x2b, 0x15, 0x5b, 0x6c, 0x66, 0x4f, 0xc4, 0x50, 0x55, 0x98, 0x20, 0x8e, 0xfa, 0x16, 0x5f, 0x1a,
-	0x11, 0xc4, 0x14, 0xdf, 0x32, 0x06, 0x5f, 0x2c, 0xfc, 0xb1, 0x44, 0xff, 0xa7, 0x11, 0x41, 0x78,
-	0xa0, 0xbb, 0x3b, 0xeb, 0x03, 0xd5, 0x74, 0xb2, 0xb0, 0x40, 0x11, 0x0f, 0xc0, 0x02, 0xc7, 0x17,
-	0x2c, 0x68, 0x50, 0x0f, 0xf5, 0x43, 0x51, 0xd2, 0x3b, 0xa0, 0xa4, 0x8e, 0x6c, 0xfb, 0xd2, 0x64,
-	0x44, 0xb1, 0xca, 0x0e, 0xd4, 0x3d, 0x7d, 0x65, 0xc2, 0x7d, 0x31, 0x8d, 0x53, 0x1f, 0x27, 0xc5,
-	0xdc, 0x92, 0x51, 0x83, 0xe6, 0x16, 0x6f, 0xf7, 0xa0, 0x6c, 0x6d, 0xc2, 0x71, 0xe6, 0x96, 0xde,
-	0x43, 0x62, 0xfe, 0x24, 0xd2, 0x5a, 0x4d, 0x76, 0x56, 0x89, 0x9d, 0xe2, 0x44, 0x7f, 0x92, 0xd2,
-	0xa9, 0x95, 0x8f, 0xa0, 0x07, 0x50, 0xe2, 0x7f, 0xd0, 0x7c, 0x7e, 0x7c, 0xf1, 0xc7, 0xa9, 0x5f,
-	0x98, 0x80, 0x25, 0x08, 0xef, 0xc2, 0xf1, 0x94, 0xd2, 0x2f, 0x31, 0xce, 0x8d, 0x2f, 0x13, 0x27,
-	0x59, 0xb9, 0x0a, 0x28, 0xfe, 0x2f, 0x88, 0xc4, 0x63, 0x4a, 0xfd, 0xb3, 0x44, 0x86, 0x25, 0xe2,
-	0x7f, 0x64, 0x48, 0x5c, 0x22, 0xf5, 0xff, 0x0e, 0x93, 0x96, 0xb8, 0x07, 0xe0, 0x17, 0x78, 0x89,
-	0xe7, 0x11, 0xab, 0xff, 0x26, 0x90, 0x5c, 0xfc, 0x4f, 0x09, 0xca, 0xde, 0xab, 0x9b, 0x67, 0x90,
-	0xdc, 0x3f, 0x83, 0x6c, 0xfb, 0x7d, 0x98, 0x8d, 0x3c, 0xdf, 0x4f, 0x34, 0x9e, 0xe4, 0x27, 0xfe,
-	0x93, 0x4e, 0xe8, 0x01, 0xff, 0x4b, 0xb8, 0x08, 0xbc, 0x2f, 0xa4, 0x65, 0xec, 0xd1, 0x98, 0x3b,
-	0x81, 0xf0, 0x53, 0x8f, 0xb0, 0x77, 0x01, 0x02, 0x11, 0x70, 0xfc, 0x55, 0x28, 0x71, 0xea, 0x93,
-	0x18, 0x5e, 0x3b, 0xa0, 0xdf, 0x98, 0x40, 0xce, 0x21, 0xd6, 0x15, 0x6d, 0xa7, 0xa4, 0x58, 0x57,
-	0x4a, 0x13, 0x27, 0xd1, 0xcf, 0xa6, 0xf7, 0x68, 0x9e, 0x8a, 0xbd, 0x2d, 0x5d, 0xff, 0xf6, 0xab,
-	0x7d, 0xdd, 0xdd, 0x19, 0x6d, 0x91, 0x2f, 0xd7, 0x18, 0xea, 0xcb, 0xba, 0xc5, 0x7f, 0x5d, 0xf3,
-	0x14, 0xfd, 0x1a, 0x9d, 0x7d, 0x8d, 0xac, 0x31, 0xdc, 0xda, 0x2a, 0xd1, 0xd1, 0xf5, 0xff, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x64, 0x37, 0x8c, 0x0d, 0x75, 0x41, 0x00, 0x00,
+	// 4418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3c, 0x4b, 0x6f, 0x1c, 0x47,
+	0x7a, 0xee, 0x79, 0x71, 0xe6, 0x9b, 0x87, 0x86, 0x25, 0x8a, 0x1a, 0x8d, 0xad, 0x57, 0xdb, 0x92,
+	0x65, 0x59, 0x96, 0x6c, 0xda, 0x46, 0x8c, 0x78, 0xed, 0x85, 0x48, 0x5a, 0xf4, 0xc0, 0xa2, 0x22,
+	0x37, 0x29, 0x2b, 0xc9, 0x06, 0x19, 0x34, 0xa7, 0x8b, 0x64, 0x2f, 0x67, 0xba, 0x47, 0xdd, 0x3d,
+	0x22, 0xb9, 0x17, 0x2b, 0x09, 0x10, 0x60, 0xf3, 0xd8, 0x0d, 0x90, 0x04, 0x48, 0x80, 0x04, 0x08,
+	0x72, 0xc9, 0x03, 0x09, 0x10, 0x60, 0x0f, 0x01, 0x02, 0xec, 0x7d, 0x91, 0x04, 0x09, 0xf2, 0x17,
+	0x72, 0x49, 0x8e, 0x39, 0x06, 0x39, 0x05, 0xf5, 0xec, 0x57, 0xf5, 0x4c, 0x93, 0xa3, 0xc7, 0xde,
+	0xa6, 0xbe, 0xfe, 0xaa, 0xea, 0xab, 0xaf, 0xbe, 0x77, 0x55, 0x0d, 0xb4, 0x2d, 0x33, 0x30, 0xfb,
+	0x03, 0xd7, 0xf5, 0xac, 0xdb, 0x63, 0xcf, 0x0d, 0x5c, 0xb4, 0x38, 0xb2, 0x87, 0x4f, 0x27, 0x3e,
+	0x6b, 0xdd, 0x26, 0x9f, 0xbb, 0x8d, 0x81, 0x3b, 0x1a, 0xb9, 0x0e, 0x03, 0x75, 0x5b, 0xb6, 0x13,
+	0x60, 0xcf, 0x31, 0x87, 0xbc, 0xdd, 0x88, 0x76, 0xe8, 0x36, 0xfc, 0xc1, 0x3e, 0x1e, 0x99, 0xbc,
+	0x05, 0xe3, 0xa1, 0xc9, 0xfb, 0xe9, 0x0b, 0x50, 0xfe, 0x62, 0x34, 0x0e, 0x8e, 0xf5, 0x3f, 0xd1,
+	0xa0, 0x71, 0x6f, 0x38, 0xf1, 0xf7, 0x0d, 0xfc, 0x64, 0x82, 0xfd, 0x00, 0xbd, 0x0f, 0xa5, 0x1d,
+	0xd3, 0xc7, 0x1d, 0xed, 0x8a, 0x76, 0xa3, 0xbe, 0xf2, 0xc6, 0xed, 0x18, 0x05, 0x7c, 0xee, 0x4d,
+	0x7f, 0x6f, 0xd5, 0xf4, 0xb1, 0x41, 0x31, 0x11, 0x82, 0x92, 0xb5, 0xd3, 0x5b, 0xef, 0x14, 0xae,
+	0x68, 0x37, 0x8a, 0x06, 0xfd, 0x8d, 0x2e, 0x01, 0xf8, 0x78, 0x6f, 0x84, 0x9d, 0xa0, 0xb7, 0xee,
+	0x77, 0x8a, 0x57, 0x8a, 0x37, 0x8a, 0x46, 0x04, 0x82, 0x74, 0x68, 0x0c, 0xdc, 0xe1, 0x10, 0x0f,
+	0x02, 0xdb, 0x75, 0x7a, 0xeb, 0x9d, 0x12, 0xed, 0x1b, 0x83, 0xe9, 0x7f, 0xa6, 0x41, 0x93, 0x93,
+	0xe6, 0x8f, 0x5d, 0xc7, 0xc7, 0xe8, 0x43, 0xa8, 0xf8, 0x81, 0x19, 0x4c, 0x7c, 0x4e, 0xdd, 0xeb,
+	0x4a, 0xea, 0xb6, 0x28, 0x8a, 0xc1, 0x51, 0x95, 0xe4, 0x25, 0xa7, 0x2f, 0xa6, 0xa7, 0x4f, 0x2c,
+	0xa1, 0x94, 0x5c, 0x82, 0xfe, 0x1f, 0x1a, 0xb4, 0xb7, 0x44, 0x53, 0x70, 0x6f, 0x09, 0xca, 0x03,
+	0x77, 0xe2, 0x04, 0x94, 0xc0, 0xa6, 0xc1, 0x1a, 0xe8, 0x2a, 0x34, 0x06, 0xfb, 0xa6, 0xe3, 0xe0,
+	0x61, 0xdf, 0x31, 0x47, 0x98, 0x92, 0x52, 0x33, 0xea, 0x1c, 0xf6, 0xc0, 0x1c, 0xe1, 0x5c, 0x14,
+	0x5d, 0x81, 0xfa, 0xd8, 0xf4, 0x02, 0x3b, 0xc6, 0xb3, 0x28, 0x08, 0x75, 0xa1, 0x6a, 0xfb, 0xbd,
+	0xd1, 0xd8, 0xf5, 0x82, 0x4e, 0xf9, 0x8a, 0x76, 0xa3, 0x6a, 0xc8, 0x36, 0x99, 0xc1, 0xa6, 0xbf,
+	0xb6, 0x4d, 0xff, 0xa0, 0xb7, 0xde, 0xa9, 0xb0, 0x19, 0xa2, 0x30, 0xfd, 0x2f, 0x34, 0x58, 0xbe,
+	0xeb, 0xfb, 0xf6, 0x9e, 0x93, 0x5a, 0xd9, 0x32, 0x54, 0x1c, 0xd7, 0xc2, 0xbd, 0x75, 0xba, 0xb4,
+	0xa2, 0xc1, 0x5b, 0xe8, 0x75, 0xa8, 0x8d, 0x31, 0xf6, 0xfa, 0x9e, 0x3b, 0x14, 0x0b, 0xab, 0x12,
+	0x80, 0xe1, 0x0e, 0x31, 0xfa, 0x1a, 0x16, 0xfd, 0xc4, 0x40, 0x4c, 0x1a, 0xea, 0x2b, 0x6f, 0xde,
+	0x4e, 0xc9, 0xf6, 0xed, 0xe4, 0xa4, 0x46, 0xba, 0xb7, 0xfe, 0xac, 0x00, 0x67, 0x25, 0x1e, 0xa3,
+	0x95, 0xfc, 0x26, 0x9c, 0xf7, 0xf1, 0x9e, 0x24, 0x8f, 0x35, 0xf2, 0x70, 0x5e, 0x6e, 0x59, 0x31,
+	0xba, 0x65, 0x39, 0x04, 0x34, 0xb9, 0x1f, 0xe5, 0xf4, 0x7e, 0x5c, 0x86, 0x3a, 0x3e, 0x1a, 0xdb,
+	0x1e, 0xee, 0x07, 0xf6, 0x08, 0x53, 0x96, 0x97, 0x0c, 0x60, 0xa0, 0x6d, 0x7b, 0x14, 0x95, 0xe8,
+	0x85, 0xdc, 0x12, 0xad, 0xff, 0xa5, 0x06, 0xe7, 0x53, 0xbb, 0xc4, 0x55, 0xc4, 0x80, 0x36, 0x5d,
+	0x79, 0xc8, 0x19, 0xa2, 0x2c, 0x84, 0xe1, 0xd7, 0xa7, 0x31, 0x3c, 0x44, 0x37, 0x52, 0xfd, 0x23,
+	0x44, 0x16, 0xf2, 0x13, 0x79, 0x00, 0xe7, 0x37, 0x70, 0xc0, 0x27, 0x20, 0xdf, 0xb0, 0x7f, 0x7a,
+	0x13, 0x13, 0xd7, 0xc5, 0x42, 0x4a, 0x17, 0xff, 0xa1, 0x00, 0xed, 0xe8, 0x54, 0x3d, 0x67, 0xd7,
+	0x45, 0x6f, 0x40, 0x4d, 0xa2, 0x70, 0xa9, 0x08, 0x01, 0xe8, 0x17, 0xa0, 0x4c, 0x28, 0x65, 0x22,
+	0xd1, 0x5a, 0xb9, 0xaa, 0x5e, 0x53, 0x64, 0x4c, 0x83, 0xe1, 0xa3, 0x1e, 0xb4, 0xfc, 0xc0, 0xf4,
+	0x82, 0xfe, 0xd8, 0xf5, 0xe9, 0x3e, 0x53, 0xc1, 0xa9, 0xaf, 0xe8, 0xf1, 0x11, 0xa4, 0x61, 0xde,
+	0xf4, 0xf7, 0x1e, 0x72, 0x4c, 0xa3, 0x49, 0x7b, 0x8a, 0x26, 0xfa, 0x02, 0x1a, 0xd8, 0xb1, 0xc2,
+	0x81, 0x4a, 0xb9, 0x07, 0xaa, 0x63, 0xc7, 0x92, 0xc3, 0x84, 0xfb, 0x53, 0xce, 0xbf, 0x3f, 0xbf,
+	0xa7, 0x41, 0x27, 0xbd, 0x41, 0xf3, 0x18, 0xda, 0x4f, 0x59, 0x27, 0xcc, 0x36, 0x68, 0xaa, 0x86,
+	0xcb, 0x4d, 0x32, 0x78, 0x17, 0xfd, 0x8f, 0x35, 0x38, 0x17, 0x92, 0x43, 0x3f, 0xbd, 0x28, 0x69,
+	0x41, 0x37, 0xa1, 0x6d, 0x3b, 0x83, 0xe1, 0xc4, 0xc2, 0x8f, 0x9c, 0x2f, 0xb1, 0x39, 0x0c, 0xf6,
+	0x8f, 0xe9, 0x1e, 0x56, 0x8d, 0x14, 0x5c, 0xff, 0x2d, 0x0d, 0x96, 0x93, 0x74, 0xcd, 0xc3, 0xa4,
+	0x8f, 0xa0, 0x6c, 0x3b, 0xbb, 0xae, 0xe0, 0xd1, 0xa5, 0x29, 0x4a, 0x49, 0xe6, 0x62, 0xc8, 0xfa,
+	0x08, 0x5e, 0xdf, 0xc0, 0x41, 0xcf, 0xf1, 0xb1, 0x17, 0xac, 0xda, 0xce, 0xd0, 0xdd, 0x7b, 0x68,
+	0x06, 0xfb, 0x73, 0x28, 0x54, 0x4c, 0x37, 0x0a, 0x09, 0xdd, 0xd0, 0xff, 0x5a, 0x83, 0x37, 0xd4,
+	0xf3, 0xf1, 0xa5, 0x77, 0xa1, 0xba, 0x6b, 0xe3, 0xa1, 0xd5, 0x5b, 0x67, 0xd6, 0xa5, 0x68, 0xc8,
+	0x36, 0x51, 0xac, 0x31, 0x41, 0xe6, 0x2b, 0xbc, 0x9a, 0x21, 0xcd, 0x5b, 0x81, 0x67, 0x3b, 0x7b,
+	0xf7, 0x6d, 0x3f, 0x30, 0x18, 0x7e, 0x84, 0x9f, 0xc5, 0xfc, 0x62, 0xfc, 0x3b, 0x1a, 0x5c, 0xda,
+	0xc0, 0xc1, 0x9a, 0xb4, 0xcb, 0xe4, 0xbb, 0xed, 0x07, 0xf6, 0xc0, 0x7f, 0xbe, 0x11, 0x4d, 0x0e,
+	0x07, 0xad, 0xff, 0x58, 0x83, 0xcb, 0x99, 0xc4, 0x70, 0xd6, 0x71, 0xbb, 0x23, 0xac, 0xb2, 0xda,
+	0xee, 0x7c, 0x85, 0x8f, 0xbf, 0x31, 0x87, 0x13, 0xfc, 0xd0, 0xb4, 0x3d, 0x66, 0x77, 0x4e, 0x69,
+	0x85, 0xff, 0x5e, 0x83, 0x8b, 0x1b, 0x38, 0x78, 0x28, 0x7c, 0xd2, 0x2b, 0xe4, 0x0e, 0xc1, 0x89,
+	0xf8, 0x46, 0x11, 0x52, 0xc5, 0x60, 0xfa, 0x8f, 0xd8, 0x76, 0x2a, 0xe9, 0x7d, 0x25, 0x0c, 0xbc,
+	0x44, 0x35, 0x21, 0xa2, 0x92, 0x6b, 0x2c, 0x74, 0xe0, 0xec, 0xd3, 0xff, 0x5c, 0x83, 0x0b, 0x77,
+	0x07, 0x4f, 0x26, 0xb6, 0x87, 0x39, 0xd2, 0x7d, 0x77, 0x70, 0x70, 0x7a, 0xe6, 0x86, 0x61, 0x56,
+	0x21, 0x16, 0x66, 0xcd, 0x0a, 0xa8, 0x97, 0xa1, 0x12, 0xb0, 0xb8, 0x8e, 0x45, 0x2a, 0xbc, 0x45,
+	0xe9, 0x33, 0xf0, 0x10, 0x9b, 0xfe, 0xcf, 0x27, 0x7d, 0x3f, 0x2e, 0x41, 0xe3, 0x1b, 0x1e, 0x8e,
+	0x51, 0xaf, 0x9d, 0x94, 0x24, 0x4d, 0x1d, 0x78, 0x45, 0x22, 0x38, 0x55, 0x50, 0xb7, 0x01, 0x4d,
+	0x1f, 0xe3, 0x83, 0xd3, 0xf8, 0xe8, 0x06, 0xe9, 0x28, 0x5a, 0xe8, 0x3e, 0x2c, 0x4e, 0x9c, 0x5d,
+	0x92, 0x85, 0x60, 0x8b, 0x33, 0x90, 0x49, 0xee, 0x6c, 0xdb, 0x9d, 0xee, 0x88, 0xbe, 0x84, 0x33,
+	0xc9, 0xb1, 0xca, 0xb9, 0xc6, 0x4a, 0x76, 0x43, 0x3d, 0x68, 0x5b, 0x9e, 0x3b, 0x1e, 0x63, 0xab,
+	0xef, 0x8b, 0xa1, 0x2a, 0xf9, 0x86, 0xe2, 0xfd, 0xe4, 0x50, 0xef, 0xc3, 0xd9, 0x24, 0xa5, 0x3d,
+	0x8b, 0x04, 0xa4, 0x64, 0x0f, 0x55, 0x9f, 0xd0, 0x2d, 0x58, 0x4c, 0xe3, 0x57, 0x29, 0x7e, 0xfa,
+	0x03, 0x7a, 0x0f, 0x50, 0x82, 0x54, 0x82, 0x5e, 0x63, 0xe8, 0x71, 0x62, 0x7a, 0x96, 0xaf, 0xff,
+	0x50, 0x83, 0xe5, 0xc7, 0x66, 0x30, 0xd8, 0x5f, 0x1f, 0x71, 0x5d, 0x9b, 0xc3, 0x56, 0x7d, 0x06,
+	0xb5, 0xa7, 0x5c, 0x2e, 0x84, 0x43, 0xba, 0xac, 0xe0, 0x4f, 0x54, 0x02, 0x8d, 0xb0, 0x87, 0xfe,
+	0x33, 0x0d, 0x96, 0x68, 0x0a, 0x2a, 0x98, 0xf5, 0xf2, 0xad, 0xe6, 0x8c, 0x34, 0x14, 0x5d, 0x87,
+	0xd6, 0xc8, 0xf4, 0x0e, 0xb6, 0x42, 0x9c, 0x32, 0xc5, 0x49, 0x40, 0xf5, 0x23, 0x00, 0xde, 0xda,
+	0xf4, 0xf7, 0x4e, 0x41, 0xff, 0x27, 0xb0, 0xc0, 0x67, 0xe5, 0xe6, 0x73, 0x96, 0x9c, 0x09, 0x74,
+	0xfd, 0xf7, 0x0b, 0xd0, 0x0a, 0x5d, 0x22, 0x55, 0xf2, 0x16, 0x14, 0xa4, 0x6a, 0x17, 0x7a, 0xeb,
+	0xe8, 0x33, 0xa8, 0xb0, 0x52, 0x05, 0x1f, 0xfb, 0x5a, 0x7c, 0x6c, 0xf6, 0xed, 0x76, 0xc4, 0xaf,
+	0x52, 0x80, 0xc1, 0x3b, 0x11, 0x1e, 0x49, 0x2f, 0x22, 0x8d, 0x4f, 0x08, 0x41, 0x3d, 0x38, 0x13,
+	0x0f, 0xd9, 0x85, 0x0a, 0x5f, 0xc9, 0x72, 0x1e, 0xeb, 0x66, 0x60, 0x52, 0xdf, 0xd1, 0x8a, 0x45,
+	0xec, 0x3e, 0xba, 0x0b, 0x30, 0xf6, 0xdc, 0x31, 0xf6, 0x02, 0x1b, 0x0b, 0xe5, 0xcd, 0xe1, 0x82,
+	0x22, 0x9d, 0xf4, 0xff, 0xad, 0x40, 0x3d, 0xc2, 0xa8, 0x14, 0x33, 0x92, 0x52, 0x51, 0x98, 0x9d,
+	0x7a, 0x16, 0xd3, 0xa9, 0xe7, 0x35, 0x68, 0xd9, 0x34, 0x7e, 0xeb, 0x73, 0x69, 0xa6, 0x86, 0xb7,
+	0x66, 0x34, 0x19, 0x94, 0xab, 0x16, 0xba, 0x04, 0x75, 0x67, 0x32, 0xea, 0xbb, 0xbb, 0x7d, 0xcf,
+	0x3d, 0xf4, 0x79, 0x0e, 0x5b, 0x73, 0x26, 0xa3, 0x5f, 0xda, 0x35, 0xdc, 0x43, 0x3f, 0x4c, 0x93,
+	0x2a, 0x27, 0x4c, 0x93, 0x2e, 0x41, 0x7d, 0x64, 0x1e, 0x91, 0x51, 0xfb, 0xce, 0x64, 0x44, 0xd3,
+	0xdb, 0xa2, 0x51, 0x1b, 0x99, 0x47, 0x86, 0x7b, 0xf8, 0x60, 0x32, 0x42, 0x37, 0xa0, 0x3d, 0x34,
+	0xfd, 0xa0, 0x1f, 0xcd, 0x8f, 0xab, 0x34, 0x3f, 0x6e, 0x11, 0xf8, 0x17, 0x61, 0x8e, 0x9c, 0x4e,
+	0xb8, 0x6a, 0x73, 0x24, 0x5c, 0xd6, 0x68, 0x18, 0x0e, 0x04, 0xf9, 0x13, 0x2e, 0x6b, 0x34, 0x94,
+	0xc3, 0x7c, 0x02, 0x0b, 0x3b, 0x34, 0x2a, 0xf6, 0x3b, 0xf5, 0x4c, 0x9b, 0x7b, 0x8f, 0x04, 0xc4,
+	0x2c, 0x78, 0x36, 0x04, 0x3a, 0xfa, 0x0e, 0xd4, 0x68, 0x30, 0x42, 0xfb, 0x36, 0x72, 0xf5, 0x0d,
+	0x3b, 0x90, 0xde, 0x16, 0x1e, 0x06, 0x26, 0xed, 0xdd, 0xcc, 0xd7, 0x5b, 0x76, 0x20, 0x76, 0x7e,
+	0xe0, 0x61, 0x33, 0xc0, 0xd6, 0xea, 0xf1, 0x9a, 0x3b, 0x1a, 0x9b, 0x54, 0x98, 0x3a, 0x2d, 0x9a,
+	0xf9, 0xa8, 0x3e, 0x11, 0xdb, 0x32, 0x90, 0xad, 0x7b, 0x9e, 0x3b, 0xea, 0x9c, 0x61, 0xb6, 0x25,
+	0x0e, 0x45, 0x17, 0x01, 0x84, 0x85, 0x37, 0x83, 0x4e, 0x9b, 0xee, 0x62, 0x8d, 0x43, 0xee, 0x06,
+	0xe8, 0x31, 0x2c, 0x0d, 0x86, 0x13, 0x3f, 0xc0, 0x24, 0xe2, 0xef, 0x1f, 0xe0, 0xe3, 0xbe, 0x67,
+	0x3a, 0x7b, 0xb8, 0xb3, 0xa8, 0xd2, 0x75, 0xba, 0x82, 0x35, 0x89, 0xfe, 0x15, 0x3e, 0x36, 0x08,
+	0xb2, 0x81, 0x06, 0x29, 0x18, 0xba, 0x03, 0x67, 0x43, 0x4a, 0xfa, 0xbe, 0xbd, 0x33, 0xb4, 0x9d,
+	0x3d, 0xbf, 0x83, 0x28, 0x91, 0x28, 0xfc, 0xb4, 0xc5, 0xbf, 0xe8, 0x7f, 0xa4, 0x01, 0x4a, 0x8f,
+	0x8d, 0x3a, 0xb0, 0xc0, 0xd3, 0x17, 0xae, 0x86, 0xa2, 0x89, 0x3e, 0x80, 0xe2, 0xc8, 0x76, 0xb8,
+	0x55, 0x4a, 0x78, 0x0e, 0x5a, 0x4e, 0xdd, 0xc0, 0x0e, 0xf6, 0xec, 0x01, 0xd5, 0x74, 0x83, 0xe0,
+	0xd2, 0x2e, 0xe6, 0x51, 0xa7, 0x98, 0xb7, 0x8b, 0x79, 0xa4, 0x7f, 0x0b, 0x4b, 0xa1, 0x0a, 0x45,
+	0xc4, 0x35, 0x2d, 0xf9, 0xda, 0x69, 0x25, 0x7f, 0x7a, 0xc2, 0xf7, 0xef, 0x25, 0x58, 0xde, 0x32,
+	0x9f, 0xe2, 0x17, 0x9f, 0x5b, 0xe6, 0xf2, 0x79, 0xf7, 0x61, 0x91, 0x6e, 0xc0, 0x4a, 0x84, 0x9e,
+	0x4e, 0x29, 0x97, 0xbc, 0xa7, 0x3b, 0xa2, 0xef, 0x92, 0x68, 0x11, 0x0f, 0x0e, 0x1e, 0xba, 0x76,
+	0x18, 0x70, 0x5d, 0x54, 0x49, 0x9d, 0xc4, 0x32, 0xa2, 0x3d, 0xd0, 0xc3, 0xb4, 0xfb, 0x60, 0xa1,
+	0xd6, 0xdb, 0x53, 0x2b, 0x1c, 0x21, 0xf7, 0x53, 0x5e, 0x84, 0x08, 0x1c, 0x8b, 0x93, 0xa8, 0x61,
+	0xac, 0x1a, 0xa2, 0x89, 0x1e, 0xc2, 0x59, 0xb6, 0x82, 0x2d, 0xae, 0xf5, 0x6c, 0xf1, 0xd5, 0x5c,
+	0x8b, 0x57, 0x75, 0x8d, 0x1b, 0x8d, 0xda, 0x49, 0x8d, 0x46, 0x07, 0x16, 0xb8, 0x22, 0x53, 0x63,
+	0x59, 0x35, 0x44, 0x93, 0x6c, 0x33, 0xab, 0x1d, 0xdb, 0xce, 0x5e, 0xa7, 0x4e, 0xbf, 0x85, 0x00,
+	0x92, 0x97, 0x43, 0xc8, 0xcf, 0x19, 0xb5, 0xb8, 0xcf, 0xa1, 0x2a, 0x25, 0xbc, 0x90, 0x5b, 0xc2,
+	0x65, 0x9f, 0xa4, 0x13, 0x2b, 0x26, 0x9c, 0x98, 0xfe, 0x2f, 0x1a, 0x34, 0xd6, 0xc9, 0x92, 0xee,
+	0xbb, 0x7b, 0xd4, 0xe5, 0x5e, 0x83, 0x96, 0x87, 0x07, 0xae, 0x67, 0xf5, 0xb1, 0x13, 0x78, 0xc4,
+	0x93, 0x6b, 0xd4, 0x68, 0x35, 0x19, 0xf4, 0x0b, 0x06, 0x24, 0x68, 0xc4, 0x2f, 0xf9, 0x81, 0x39,
+	0x1a, 0xf7, 0x77, 0x89, 0xfd, 0x2b, 0x30, 0x34, 0x09, 0xa5, 0xe6, 0xef, 0x2a, 0x34, 0x42, 0xb4,
+	0xc0, 0xa5, 0xf3, 0x97, 0x8c, 0xba, 0x84, 0x6d, 0xbb, 0xe8, 0x2d, 0x68, 0x51, 0x9e, 0xf6, 0x87,
+	0xee, 0x5e, 0x9f, 0x94, 0x3b, 0xb8, 0x37, 0x6e, 0x58, 0x9c, 0x2c, 0xb2, 0x57, 0x71, 0x2c, 0xdf,
+	0xfe, 0x01, 0xe6, 0xfe, 0x58, 0x62, 0x6d, 0xd9, 0x3f, 0xc0, 0xfa, 0x3f, 0x6b, 0xd0, 0x24, 0xf1,
+	0xc9, 0x03, 0xd7, 0xc2, 0xdb, 0xa7, 0x8c, 0xe6, 0x72, 0xd4, 0xc5, 0xdf, 0x80, 0x9a, 0x5c, 0x01,
+	0x5f, 0x52, 0x08, 0x40, 0xf7, 0xa0, 0x25, 0xf2, 0x8e, 0x3e, 0x4b, 0xc7, 0x4b, 0x99, 0xd1, 0x75,
+	0x24, 0x3c, 0xf0, 0x8d, 0xa6, 0xe8, 0x46, 0x9b, 0xfa, 0x3d, 0x68, 0x44, 0x3f, 0x93, 0x59, 0xb7,
+	0x92, 0x82, 0x22, 0x01, 0x44, 0x1a, 0x1f, 0x4c, 0x46, 0x64, 0x4f, 0xb9, 0x61, 0x11, 0x4d, 0x52,
+	0xa7, 0x6b, 0xf2, 0x98, 0x66, 0x4b, 0x9e, 0xfb, 0xd0, 0xa5, 0x69, 0x74, 0x69, 0xf4, 0x37, 0xfa,
+	0xc5, 0x78, 0xd1, 0xf7, 0x2d, 0xa5, 0x11, 0xa0, 0x83, 0xd0, 0x0c, 0x24, 0x16, 0xd0, 0xe4, 0x29,
+	0x00, 0x3d, 0x23, 0x82, 0xc6, 0xb7, 0x86, 0x0a, 0x5a, 0x07, 0x16, 0x4c, 0xcb, 0xf2, 0xb0, 0xef,
+	0x73, 0x3a, 0x44, 0x93, 0x7c, 0x79, 0x8a, 0x3d, 0x5f, 0x88, 0x7c, 0xd1, 0x10, 0x4d, 0xf4, 0x1d,
+	0xa8, 0xca, 0x94, 0xa5, 0xa8, 0x0a, 0x53, 0xa3, 0x74, 0xf2, 0x72, 0x85, 0xec, 0xa1, 0xff, 0xa8,
+	0x08, 0x2d, 0xce, 0xb0, 0x55, 0x1e, 0x74, 0x4c, 0x57, 0xbe, 0x55, 0x68, 0xec, 0x86, 0xba, 0x3f,
+	0xad, 0x30, 0x19, 0x35, 0x11, 0xb1, 0x3e, 0xb3, 0x14, 0x30, 0x1e, 0xf6, 0x94, 0xe6, 0x0a, 0x7b,
+	0xca, 0x27, 0xb5, 0x60, 0xe9, 0x40, 0xb8, 0xa2, 0x0a, 0x84, 0xb3, 0x82, 0x94, 0x85, 0x39, 0x83,
+	0x14, 0xfd, 0xd7, 0xa0, 0x1e, 0xa1, 0x6c, 0x4a, 0xac, 0xf1, 0x61, 0x18, 0x55, 0xb2, 0x3d, 0xb8,
+	0xa0, 0x98, 0x34, 0x11, 0x50, 0xea, 0x7f, 0xa3, 0x41, 0x85, 0x8f, 0x4c, 0x0e, 0x9b, 0x98, 0xe1,
+	0xa2, 0x11, 0x37, 0x1b, 0x1d, 0x38, 0x88, 0x84, 0xdc, 0xcf, 0xcf, 0x9c, 0x5d, 0x80, 0x6a, 0xc2,
+	0x90, 0x2d, 0x70, 0x7f, 0x23, 0x3e, 0x45, 0xac, 0xd7, 0xc2, 0x90, 0x1b, 0xae, 0x9f, 0x69, 0xf4,
+	0x4c, 0xc8, 0xc0, 0x03, 0xf7, 0x29, 0xf6, 0x8e, 0xe7, 0x2f, 0xa6, 0x7f, 0x1a, 0xd1, 0x94, 0x9c,
+	0xc9, 0xbd, 0xec, 0x80, 0x3e, 0x0d, 0xd9, 0x5d, 0x54, 0xa5, 0x71, 0x51, 0xd3, 0xc5, 0xe5, 0x3c,
+	0x64, 0xfb, 0x1f, 0xb0, 0x63, 0x81, 0xf8, 0x52, 0x4e, 0x1b, 0x30, 0x3d, 0x97, 0x84, 0x4f, 0xff,
+	0x43, 0x0d, 0x2e, 0x6c, 0xe0, 0xe0, 0x5e, 0xbc, 0x50, 0xf4, 0xaa, 0xa9, 0x1a, 0x41, 0x57, 0x45,
+	0xd4, 0x3c, 0xbb, 0xde, 0x85, 0xaa, 0x2c, 0x79, 0xb1, 0xc3, 0x1d, 0xd9, 0xd6, 0x7f, 0x5b, 0x83,
+	0x0e, 0x9f, 0x85, 0xce, 0x49, 0x92, 0x99, 0x21, 0x0e, 0xb0, 0xf5, 0xb2, 0x8b, 0x1e, 0x3f, 0xd5,
+	0xa0, 0x1d, 0x75, 0x25, 0xe4, 0x2b, 0xfa, 0x18, 0xca, 0xb4, 0xb6, 0xc4, 0x29, 0x98, 0x29, 0xac,
+	0x0c, 0x9b, 0x98, 0x0c, 0x1a, 0x3f, 0x6e, 0x4b, 0xaf, 0xc7, 0x9b, 0xa1, 0x3f, 0x2b, 0x9e, 0xdc,
+	0x9f, 0x71, 0xff, 0xee, 0x4e, 0xc8, 0xb8, 0xac, 0x28, 0x1b, 0x02, 0xf4, 0x9f, 0x14, 0xa0, 0x13,
+	0x66, 0x82, 0x2f, 0xdd, 0xa1, 0x64, 0x84, 0xc1, 0xc5, 0xe7, 0x14, 0x06, 0x97, 0xe6, 0x77, 0x22,
+	0x65, 0x85, 0x13, 0xd1, 0xff, 0xa7, 0x08, 0xad, 0x90, 0x6b, 0x0f, 0x87, 0xa6, 0x43, 0x0a, 0xdf,
+	0x24, 0xeb, 0x0b, 0xef, 0x4d, 0xb0, 0x16, 0xda, 0x92, 0x01, 0x54, 0x9c, 0x4f, 0xef, 0xaa, 0xf6,
+	0x30, 0x63, 0x23, 0x8c, 0xc4, 0x10, 0x24, 0x11, 0x67, 0x99, 0x0a, 0x2d, 0xa7, 0xf0, 0xa0, 0x8d,
+	0x09, 0x0b, 0xa9, 0xa4, 0xdc, 0x02, 0xc4, 0x77, 0xb8, 0x6f, 0x3b, 0x7d, 0x1f, 0x0f, 0x5c, 0xc7,
+	0x62, 0x7b, 0x5f, 0x36, 0xda, 0xfc, 0x4b, 0xcf, 0xd9, 0x62, 0x70, 0xf4, 0x31, 0x94, 0x82, 0xe3,
+	0x31, 0xb3, 0xe2, 0xad, 0x95, 0xab, 0x53, 0xe9, 0xda, 0x3e, 0x1e, 0x63, 0x83, 0xa2, 0x93, 0x62,
+	0x1c, 0x19, 0x2a, 0xf0, 0xcc, 0xa7, 0xdc, 0xd7, 0x96, 0x8c, 0x08, 0x84, 0x48, 0xb3, 0xe0, 0xe1,
+	0x02, 0x73, 0x1d, 0xbc, 0x89, 0x3e, 0x82, 0xe5, 0x84, 0x0b, 0x16, 0x9e, 0xb2, 0x4a, 0x59, 0xb7,
+	0x14, 0xf3, 0xae, 0xf7, 0xd8, 0x37, 0x52, 0x48, 0x22, 0x85, 0x26, 0xce, 0x09, 0x16, 0x80, 0xd4,
+	0x28, 0x7e, 0x6b, 0x64, 0x1e, 0x71, 0x86, 0xd1, 0x28, 0x64, 0x0b, 0xce, 0x25, 0xc6, 0xdf, 0x71,
+	0x27, 0x84, 0x03, 0xa0, 0xf2, 0x1d, 0xe9, 0x5c, 0xfd, 0x6c, 0x6c, 0xfe, 0x55, 0xda, 0x57, 0x1f,
+	0xc0, 0xb9, 0xad, 0xc0, 0x1d, 0x87, 0xac, 0x38, 0xbd, 0xc5, 0xed, 0xc0, 0x02, 0x13, 0x0e, 0x61,
+	0xd7, 0x44, 0x53, 0xff, 0xcf, 0x22, 0xb4, 0xa3, 0x33, 0xf8, 0x93, 0x61, 0x90, 0x29, 0x59, 0xd3,
+	0xf3, 0xef, 0x59, 0xa1, 0xda, 0x77, 0xa1, 0xce, 0x25, 0xfd, 0x04, 0x9a, 0x02, 0xac, 0xcb, 0xfd,
+	0x29, 0xaa, 0x5b, 0x7e, 0x4e, 0xaa, 0x5b, 0x39, 0xa9, 0xea, 0x1a, 0x20, 0xa2, 0xb2, 0xe8, 0x59,
+	0xc9, 0x42, 0xe6, 0x15, 0x85, 0x35, 0x81, 0x2c, 0x44, 0x67, 0x71, 0x90, 0x80, 0xf8, 0x0a, 0xe5,
+	0xad, 0xce, 0xad, 0xbc, 0xfa, 0xff, 0x15, 0xa0, 0x9d, 0x9c, 0x7c, 0x86, 0xa9, 0x4d, 0x6c, 0x66,
+	0x61, 0xc6, 0x66, 0x16, 0x9f, 0xd7, 0x66, 0x96, 0x9e, 0xd3, 0x66, 0x9e, 0x38, 0x98, 0xcf, 0x8a,
+	0xd2, 0x2b, 0xf3, 0x46, 0xe9, 0x5b, 0xb0, 0x2c, 0xe2, 0x86, 0x70, 0xe6, 0x4d, 0x1c, 0x98, 0x53,
+	0x02, 0xf6, 0xcb, 0x50, 0x67, 0xf1, 0x20, 0x0b, 0x84, 0x59, 0x0e, 0x0d, 0x3b, 0xb2, 0xf4, 0xa4,
+	0xff, 0x3a, 0x2c, 0x51, 0xbf, 0x9b, 0x3c, 0xc7, 0xca, 0x73, 0xc6, 0xa9, 0x43, 0x23, 0x92, 0x8d,
+	0x33, 0x8b, 0x50, 0x33, 0x62, 0x30, 0xfd, 0x3e, 0x9c, 0x4b, 0x8c, 0x3f, 0x47, 0x5c, 0x45, 0x52,
+	0x89, 0xe5, 0xad, 0xf8, 0x8d, 0xa0, 0xd3, 0xdb, 0xb2, 0x8b, 0xf2, 0xd8, 0xaa, 0x6f, 0x5b, 0x49,
+	0x2b, 0x64, 0xa1, 0xcf, 0xa1, 0xe6, 0xe0, 0xc3, 0x7e, 0x34, 0x78, 0xc9, 0x71, 0xb4, 0x50, 0x75,
+	0xf0, 0x21, 0xfd, 0xa5, 0x3f, 0x80, 0xf3, 0x29, 0x52, 0xe7, 0x59, 0xfb, 0x3f, 0x69, 0x70, 0x61,
+	0xdd, 0x73, 0xc7, 0xdf, 0xd8, 0x5e, 0x30, 0x31, 0x87, 0xf1, 0x43, 0xfe, 0x17, 0x53, 0x5f, 0xf9,
+	0x32, 0x12, 0xc6, 0x32, 0xc5, 0xbc, 0xa5, 0x10, 0xdf, 0x34, 0x51, 0xc2, 0x2c, 0x85, 0x41, 0xef,
+	0x7f, 0x15, 0xe1, 0x42, 0x26, 0xde, 0x0c, 0x0b, 0x92, 0x27, 0xca, 0x57, 0x96, 0x63, 0x8b, 0xa7,
+	0x2d, 0xc7, 0xfe, 0xbc, 0x99, 0x94, 0x2f, 0x21, 0x5e, 0x2a, 0xef, 0x54, 0x72, 0x57, 0x20, 0xe3,
+	0x1d, 0xd1, 0x2a, 0x40, 0x58, 0x36, 0xee, 0x2c, 0xe4, 0x1e, 0x26, 0xd2, 0x8b, 0xec, 0x96, 0x34,
+	0xdf, 0x3c, 0xec, 0x09, 0x01, 0xfa, 0xd7, 0xd0, 0x55, 0x49, 0xe9, 0x3c, 0x92, 0xff, 0x93, 0x02,
+	0x40, 0x4f, 0xde, 0x01, 0x3e, 0x5d, 0x46, 0xf6, 0x26, 0x34, 0x43, 0x81, 0x09, 0xf5, 0x3d, 0x2a,
+	0x45, 0x16, 0x51, 0x09, 0x99, 0x18, 0x12, 0x9c, 0x54, 0xb2, 0x68, 0xd1, 0x71, 0x22, 0x5a, 0xc3,
+	0x84, 0x22, 0x61, 0xf4, 0xc8, 0x85, 0x63, 0x72, 0xa8, 0x48, 0xd4, 0xcc, 0x12, 0x97, 0x9c, 0x3d,
+	0xf7, 0x90, 0x28, 0x9f, 0x85, 0xce, 0xc3, 0x02, 0xb9, 0x58, 0x42, 0xc6, 0xaf, 0x44, 0xee, 0x99,
+	0x58, 0xe4, 0x96, 0xef, 0xae, 0x3d, 0xc4, 0xcc, 0xf1, 0xd7, 0x0c, 0xd6, 0x20, 0xa7, 0x9b, 0xec,
+	0x36, 0x5e, 0x35, 0xf7, 0x5d, 0x22, 0x8a, 0x4f, 0x4a, 0x19, 0x67, 0x42, 0xae, 0x51, 0x03, 0x44,
+	0x6c, 0x1a, 0xb5, 0x67, 0x6b, 0xae, 0xc5, 0x4c, 0x45, 0x2b, 0xe3, 0x7c, 0x99, 0x75, 0x64, 0x56,
+	0x2b, 0xec, 0x32, 0x2d, 0xaf, 0x25, 0xeb, 0x22, 0x8b, 0xb6, 0x2d, 0x71, 0xbc, 0x5d, 0xf1, 0xdc,
+	0xc3, 0x9e, 0x25, 0xb9, 0xc1, 0x6e, 0x30, 0xb3, 0x2c, 0x8e, 0x70, 0x63, 0x8d, 0xb4, 0x09, 0x3f,
+	0xb1, 0xe7, 0xb9, 0x5e, 0x7f, 0x84, 0x7d, 0xdf, 0xdc, 0xc3, 0x3c, 0x69, 0x69, 0x50, 0xe0, 0x26,
+	0x83, 0xe9, 0x3f, 0x2d, 0x42, 0x2b, 0x5c, 0x8a, 0x38, 0x91, 0xb6, 0x2d, 0x71, 0x22, 0x6d, 0x93,
+	0xad, 0x03, 0x8f, 0x99, 0x42, 0xb9, 0xb9, 0xab, 0x85, 0x8e, 0x66, 0xd4, 0x38, 0xb4, 0x67, 0x11,
+	0x5f, 0x48, 0x94, 0xcc, 0x71, 0x2d, 0x1c, 0x6e, 0x2e, 0x08, 0x10, 0xdf, 0xdb, 0x98, 0x8c, 0x94,
+	0x72, 0xc8, 0x48, 0x39, 0x87, 0x8c, 0x54, 0x14, 0x32, 0xb2, 0x0c, 0x95, 0x9d, 0xc9, 0xe0, 0x00,
+	0x07, 0x3c, 0xc5, 0xe0, 0xad, 0xb8, 0xec, 0x54, 0x13, 0xb2, 0x23, 0x45, 0xa4, 0x16, 0x15, 0x91,
+	0xd7, 0xa1, 0xc6, 0x8e, 0x46, 0xfb, 0x81, 0x4f, 0x8f, 0x40, 0x8a, 0x46, 0x95, 0x01, 0xb6, 0x7d,
+	0xf4, 0x89, 0xc8, 0xbf, 0xeb, 0x2a, 0x65, 0xa7, 0x56, 0x27, 0x21, 0x25, 0x22, 0xfb, 0xbe, 0x06,
+	0x2d, 0xf2, 0xb9, 0xff, 0x64, 0x82, 0xbd, 0x63, 0x73, 0x67, 0x88, 0x3b, 0x0d, 0x4a, 0x4e, 0x93,
+	0x40, 0xbf, 0x16, 0x40, 0xc2, 0x10, 0x8a, 0x66, 0x3b, 0x16, 0x3e, 0xc2, 0x56, 0xa7, 0x49, 0x91,
+	0x28, 0xab, 0x7b, 0x0c, 0xa4, 0x7f, 0x1f, 0x50, 0x38, 0xc7, 0x7c, 0x95, 0x95, 0xc4, 0x26, 0x16,
+	0x92, 0x9b, 0xa8, 0xff, 0xad, 0x06, 0x8b, 0xd1, 0xc9, 0x4e, 0xeb, 0x1e, 0x3f, 0x87, 0x3a, 0x3b,
+	0x2a, 0xea, 0x13, 0xf5, 0xe4, 0xb5, 0x95, 0x8b, 0x53, 0xb9, 0x67, 0x40, 0xf8, 0x52, 0x81, 0x08,
+	0xc1, 0xa1, 0xeb, 0x1d, 0x90, 0x18, 0x90, 0x50, 0x26, 0x94, 0xa2, 0xc1, 0x81, 0xa4, 0xfc, 0x4e,
+	0x2f, 0x12, 0x5d, 0x7a, 0x34, 0xb6, 0xcc, 0x00, 0x47, 0xe2, 0x84, 0x79, 0x2f, 0x3f, 0x7e, 0x2c,
+	0x6e, 0x1f, 0x16, 0xf2, 0x1d, 0x77, 0x30, 0x6c, 0x7d, 0x93, 0xdc, 0xc2, 0xf3, 0xb1, 0x63, 0xc5,
+	0x3e, 0x9e, 0x96, 0x0a, 0x7d, 0x0c, 0x5d, 0xd5, 0x70, 0xf3, 0xec, 0x3d, 0x0b, 0xd8, 0xfa, 0x1e,
+	0xf6, 0x59, 0xb5, 0xab, 0xc8, 0xe3, 0x04, 0x3a, 0x4f, 0xa0, 0xff, 0xb7, 0x06, 0x8b, 0x77, 0x2d,
+	0x99, 0x13, 0xbd, 0xa8, 0xb8, 0x30, 0x19, 0x37, 0x15, 0xd3, 0x71, 0xd3, 0xf3, 0x32, 0x24, 0xdc,
+	0xa4, 0x92, 0x52, 0x39, 0x77, 0x15, 0x1e, 0xbd, 0x99, 0xa2, 0xff, 0x9b, 0x06, 0xe7, 0xb7, 0xbd,
+	0x89, 0x33, 0x08, 0x25, 0xe7, 0x55, 0x97, 0x51, 0x89, 0x66, 0x06, 0x9c, 0xa4, 0x7e, 0xc0, 0x4a,
+	0x36, 0xa4, 0xaa, 0xc2, 0x41, 0xdb, 0x3e, 0xbb, 0x91, 0xed, 0xd8, 0xf4, 0x48, 0x99, 0x3b, 0x45,
+	0xd1, 0xd6, 0x03, 0x68, 0x88, 0xf5, 0x9c, 0xe4, 0x8a, 0x65, 0x94, 0xa4, 0x42, 0x9a, 0xa4, 0x19,
+	0x37, 0x3e, 0xc9, 0xcb, 0xb2, 0x4e, 0x9a, 0x8d, 0xf3, 0x88, 0xe8, 0xac, 0x7b, 0xfd, 0x6f, 0xc3,
+	0x19, 0x73, 0x38, 0x74, 0xc9, 0x84, 0x56, 0x7f, 0xe2, 0x04, 0xf6, 0x90, 0x97, 0xc0, 0x5a, 0x12,
+	0xfc, 0x88, 0x40, 0xf5, 0x5d, 0x79, 0xdf, 0xc2, 0xc0, 0xbb, 0xd8, 0xc3, 0xce, 0x00, 0x93, 0x5b,
+	0xb1, 0x91, 0x4b, 0xaa, 0x5a, 0xf4, 0x92, 0xea, 0x69, 0x2f, 0xbd, 0xea, 0x7f, 0xa5, 0x41, 0x63,
+	0xcb, 0x31, 0xc7, 0xfe, 0xbe, 0xcb, 0xae, 0x7a, 0x91, 0x0e, 0xa2, 0x2d, 0x26, 0x89, 0x40, 0xe4,
+	0x99, 0x65, 0x21, 0x72, 0x66, 0x99, 0xe7, 0xc2, 0xc4, 0x65, 0xa8, 0x8b, 0x51, 0x22, 0xe2, 0x21,
+	0x40, 0xdb, 0x49, 0xd6, 0x95, 0x53, 0x94, 0xfe, 0x86, 0x06, 0xe7, 0xd6, 0xa8, 0x57, 0x13, 0xf4,
+	0xbe, 0x58, 0x89, 0x17, 0x0b, 0x2d, 0x86, 0x0b, 0x25, 0x57, 0x06, 0x96, 0x93, 0x34, 0xcc, 0x79,
+	0x3a, 0x24, 0x38, 0xa0, 0xbe, 0xc0, 0xc3, 0xac, 0x75, 0x64, 0x7f, 0x0c, 0xd9, 0x41, 0xff, 0x16,
+	0xce, 0x92, 0x48, 0xfb, 0xd5, 0x71, 0xe3, 0x99, 0x06, 0x4b, 0xe4, 0x75, 0x84, 0xa0, 0xc0, 0x7f,
+	0xf9, 0x24, 0xfc, 0xae, 0x06, 0xe7, 0x12, 0x24, 0xcc, 0xb3, 0x1f, 0x9f, 0x41, 0x4d, 0xb0, 0x77,
+	0xaa, 0xfb, 0x8c, 0x6e, 0x48, 0xd8, 0x43, 0xff, 0x3b, 0x0d, 0xce, 0xb3, 0x1b, 0x25, 0x9e, 0x3d,
+	0x32, 0xbd, 0xe3, 0xaf, 0xf0, 0xf1, 0x0b, 0xe6, 0xc9, 0xa7, 0xd0, 0x18, 0xb3, 0xb9, 0x48, 0xa5,
+	0x49, 0x3c, 0x4b, 0xe9, 0x28, 0xef, 0xa6, 0xf6, 0xd6, 0x7d, 0xa3, 0x3e, 0x0e, 0x29, 0x23, 0x2f,
+	0x19, 0x3a, 0x69, 0x72, 0xe7, 0xe3, 0x5f, 0x13, 0x1f, 0x91, 0xf8, 0x85, 0x55, 0xbe, 0xc4, 0x33,
+	0x86, 0x6c, 0x7a, 0x1a, 0x02, 0x9d, 0xcc, 0x7d, 0xf3, 0x4f, 0x35, 0x58, 0x4c, 0x1d, 0x06, 0xa1,
+	0x16, 0xc0, 0x23, 0x67, 0xc0, 0x4f, 0xc9, 0xda, 0xaf, 0xa1, 0x06, 0x54, 0xc5, 0x99, 0x59, 0x5b,
+	0x43, 0x75, 0x58, 0xd8, 0x76, 0x29, 0x76, 0xbb, 0x80, 0xda, 0xd0, 0x60, 0x1d, 0x27, 0x83, 0x01,
+	0xf6, 0xfd, 0x76, 0x51, 0x42, 0xee, 0x99, 0xf6, 0x70, 0xe2, 0xe1, 0x76, 0x09, 0x35, 0xa1, 0xb6,
+	0xed, 0xf2, 0xf7, 0x06, 0xed, 0x32, 0x42, 0xd0, 0xe2, 0x0d, 0xd1, 0xa9, 0x12, 0x81, 0x89, 0x6e,
+	0x0b, 0x37, 0x9f, 0x69, 0xd0, 0x8a, 0x1f, 0x26, 0xa0, 0xf3, 0x70, 0xf6, 0x91, 0x63, 0xe1, 0x5d,
+	0xdb, 0xc1, 0x56, 0xf8, 0xa9, 0xfd, 0x1a, 0x3a, 0x0b, 0x67, 0x7a, 0x8e, 0x83, 0xbd, 0x08, 0x50,
+	0x23, 0xc0, 0x4d, 0xec, 0xed, 0xe1, 0x08, 0xb0, 0x80, 0x16, 0xa1, 0xb9, 0x69, 0x1f, 0x45, 0x40,
+	0x45, 0xd4, 0x81, 0xa5, 0xb0, 0x20, 0x18, 0xf9, 0x52, 0x5a, 0xf9, 0xd7, 0x8b, 0x50, 0x5b, 0x37,
+	0x03, 0x73, 0xcd, 0x75, 0x3d, 0x0b, 0x8d, 0x01, 0xd1, 0x87, 0x3c, 0xa3, 0xb1, 0xeb, 0xc8, 0xe7,
+	0x71, 0xe8, 0xfd, 0x8c, 0x64, 0x3e, 0x8d, 0xca, 0x05, 0xb3, 0x7b, 0x3d, 0xa3, 0x47, 0x02, 0x5d,
+	0x7f, 0x0d, 0x8d, 0xe8, 0x8c, 0xe4, 0x98, 0x66, 0xdb, 0x1e, 0x1c, 0x88, 0x5b, 0x09, 0x53, 0x66,
+	0x4c, 0xa0, 0x8a, 0x19, 0x13, 0x25, 0x6d, 0xde, 0x60, 0xaf, 0xad, 0x84, 0xfc, 0xe9, 0xaf, 0xa1,
+	0x27, 0xb0, 0xb4, 0x81, 0x23, 0x11, 0xb1, 0x98, 0x70, 0x25, 0x7b, 0xc2, 0x14, 0xf2, 0x09, 0xa7,
+	0xbc, 0x0f, 0x65, 0x7a, 0x26, 0x8b, 0x54, 0x5a, 0x1f, 0x7d, 0x83, 0xde, 0xbd, 0x92, 0x8d, 0x20,
+	0x47, 0xfb, 0x3e, 0x9c, 0x49, 0xbc, 0x81, 0x45, 0xef, 0x28, 0xba, 0xa9, 0x5f, 0x33, 0x77, 0x6f,
+	0xe6, 0x41, 0x95, 0x73, 0xed, 0x41, 0x2b, 0xfe, 0x08, 0x08, 0xdd, 0x50, 0xf4, 0x57, 0x3e, 0x5f,
+	0xec, 0xbe, 0x93, 0x03, 0x53, 0x4e, 0x34, 0x82, 0x76, 0xf2, 0x4d, 0x26, 0xba, 0x39, 0x75, 0x80,
+	0xb8, 0xb8, 0xbd, 0x9b, 0x0b, 0x57, 0x4e, 0x77, 0x0c, 0x4b, 0xaa, 0x67, 0x7e, 0xe8, 0xb6, 0x7a,
+	0x98, 0xac, 0xf7, 0x87, 0xdd, 0x3b, 0xb9, 0xf1, 0xe5, 0xd4, 0xbf, 0xc9, 0xee, 0x82, 0xa8, 0x9e,
+	0xca, 0xa1, 0x0f, 0xd4, 0xc3, 0x4d, 0x79, 0xe3, 0xd7, 0x5d, 0x39, 0x49, 0x17, 0x49, 0xc4, 0xb7,
+	0xb0, 0xac, 0x7e, 0x6c, 0x86, 0xde, 0x57, 0x8f, 0x97, 0xfd, 0x8e, 0xae, 0xfb, 0xc1, 0x09, 0x7a,
+	0x48, 0x02, 0xdc, 0xe4, 0xa3, 0x57, 0xa1, 0x86, 0x77, 0x66, 0x4a, 0xcd, 0xe9, 0x74, 0xf0, 0x7b,
+	0x70, 0x26, 0x71, 0xcf, 0x57, 0xa9, 0x35, 0xea, 0xbb, 0xc0, 0xdd, 0x69, 0x6e, 0x8a, 0xa9, 0x64,
+	0xe2, 0x4e, 0x0c, 0xca, 0x90, 0x7e, 0xc5, 0xbd, 0x99, 0xee, 0xcd, 0x3c, 0xa8, 0x72, 0x21, 0x3e,
+	0x35, 0x97, 0x89, 0x7b, 0x25, 0xe8, 0x96, 0x7a, 0x0c, 0xf5, 0x9d, 0x98, 0xee, 0x7b, 0x39, 0xb1,
+	0xe5, 0xa4, 0x7d, 0x80, 0x0d, 0x1c, 0x6c, 0xe2, 0xc0, 0x23, 0x32, 0x72, 0x5d, 0xc9, 0xf2, 0x10,
+	0x41, 0x4c, 0xf3, 0xf6, 0x4c, 0x3c, 0x39, 0xc1, 0x2f, 0x03, 0x12, 0xde, 0x37, 0x72, 0x0d, 0xff,
+	0xcd, 0xa9, 0xa7, 0x8a, 0xec, 0x34, 0x78, 0xd6, 0xde, 0x3c, 0x81, 0xf6, 0xa6, 0xe9, 0x90, 0xa2,
+	0x71, 0x38, 0xee, 0x2d, 0x25, 0x61, 0x49, 0xb4, 0x0c, 0x6e, 0x65, 0x62, 0xcb, 0xc5, 0x1c, 0x4a,
+	0x1f, 0x6a, 0x4a, 0x15, 0xc4, 0xe8, 0xb6, 0x72, 0x98, 0x34, 0x62, 0x86, 0x6d, 0x99, 0x82, 0x2f,
+	0x27, 0x7e, 0xa6, 0xc1, 0xeb, 0x69, 0x84, 0xc7, 0x76, 0xb0, 0x4f, 0x6e, 0x64, 0xf8, 0x79, 0x48,
+	0xa0, 0x88, 0x27, 0x20, 0x81, 0xe3, 0x4b, 0x12, 0x7e, 0xc8, 0x5e, 0xd7, 0x47, 0x10, 0xdc, 0xa1,
+	0x3d, 0x38, 0x66, 0x77, 0x5c, 0x3f, 0xca, 0x31, 0x5e, 0x88, 0x2e, 0xa8, 0xf8, 0xf8, 0x84, 0xbd,
+	0x22, 0x52, 0xdb, 0x5e, 0x33, 0x9d, 0x01, 0x9e, 0xbd, 0xf5, 0x49, 0xb4, 0x9c, 0x7a, 0x6f, 0x41,
+	0x33, 0x76, 0x0c, 0x89, 0x54, 0xf7, 0xe2, 0x55, 0x07, 0xa1, 0xdd, 0x1b, 0xb3, 0x11, 0xe5, 0x32,
+	0xf6, 0xa1, 0x29, 0x94, 0x93, 0x49, 0xd2, 0x3b, 0x59, 0x0c, 0x09, 0x71, 0x32, 0x6c, 0x8b, 0x1a,
+	0x35, 0x6a, 0x5b, 0xd2, 0xa7, 0x2c, 0x28, 0xdf, 0xe9, 0xdc, 0x34, 0xdb, 0x92, 0x7d, 0x74, 0xc3,
+	0x8c, 0x67, 0xe2, 0x44, 0x53, 0x6d, 0x99, 0x95, 0x07, 0xb4, 0xdd, 0x9b, 0x79, 0x50, 0xe5, 0x5c,
+	0x8f, 0xa1, 0xc2, 0xff, 0x14, 0xe6, 0xad, 0xe9, 0x35, 0x57, 0x3e, 0xfa, 0xb5, 0x19, 0x58, 0x72,
+	0xe0, 0x03, 0x38, 0x9f, 0x51, 0x71, 0x55, 0x3a, 0xf5, 0xe9, 0xd5, 0xd9, 0x59, 0x62, 0x67, 0x02,
+	0x4a, 0xbf, 0xbc, 0x56, 0x6e, 0x53, 0xe6, 0x03, 0xed, 0x1c, 0x53, 0xa4, 0x1f, 0x4f, 0x2b, 0xa7,
+	0xc8, 0x7c, 0x63, 0x3d, 0x6b, 0x8a, 0xaf, 0x01, 0xc2, 0xba, 0xaa, 0x72, 0x3f, 0x52, 0x65, 0xd7,
+	0x59, 0x43, 0x8e, 0xa0, 0x9d, 0x2c, 0xbc, 0x29, 0xa3, 0xc8, 0x8c, 0x22, 0x67, 0xf7, 0xdd, 0x5c,
+	0xb8, 0xd1, 0xe8, 0x38, 0x5e, 0xb6, 0x51, 0x46, 0xc7, 0xca, 0xea, 0x52, 0xf7, 0x9d, 0x1c, 0x98,
+	0x72, 0xa2, 0x47, 0xd0, 0x88, 0xd6, 0x64, 0xd0, 0xf5, 0x0c, 0x1d, 0x4b, 0x4e, 0x32, 0xdb, 0x7c,
+	0xc5, 0xaa, 0x1c, 0x4a, 0xf3, 0xa5, 0x2a, 0xc5, 0x74, 0x6f, 0xcc, 0x46, 0x8c, 0x86, 0xf6, 0xc9,
+	0x72, 0x80, 0x72, 0x53, 0x32, 0x4a, 0x1c, 0xdd, 0x77, 0x73, 0xe1, 0x8a, 0xe9, 0x56, 0xfe, 0xb1,
+	0x0a, 0x55, 0xf1, 0x12, 0xe1, 0x15, 0x64, 0xb3, 0xaf, 0x20, 0xbd, 0xfc, 0x1e, 0x9c, 0x49, 0x3c,
+	0x1b, 0x57, 0x1a, 0x50, 0xf5, 0xd3, 0xf2, 0x59, 0x32, 0xf2, 0x98, 0xff, 0x15, 0x99, 0xd4, 0xa7,
+	0xb7, 0xb3, 0x52, 0xd4, 0xa4, 0x32, 0xcd, 0x18, 0xf8, 0x85, 0x87, 0x94, 0x0f, 0x00, 0x22, 0x7e,
+	0x7f, 0xfa, 0x2d, 0x4e, 0x12, 0xc5, 0xcc, 0x22, 0xf8, 0x57, 0xa0, 0x15, 0xbf, 0xef, 0xa8, 0xd4,
+	0x76, 0xe5, 0x95, 0xc8, 0x59, 0x43, 0x6f, 0x9e, 0xd0, 0x2d, 0xcd, 0x18, 0xce, 0x07, 0x94, 0x3e,
+	0x24, 0xcb, 0x30, 0xde, 0x19, 0x47, 0x73, 0xdd, 0xf7, 0x72, 0x62, 0x4b, 0x76, 0xbf, 0x18, 0x73,
+	0xfe, 0x12, 0x2d, 0xc7, 0xea, 0x87, 0xbf, 0xfa, 0xc1, 0x9e, 0x1d, 0xec, 0x4f, 0x76, 0x08, 0x21,
+	0x77, 0x58, 0xd7, 0xf7, 0x6c, 0x97, 0xff, 0xba, 0x23, 0x54, 0xf6, 0x0e, 0x1d, 0xed, 0x0e, 0x19,
+	0x6d, 0xbc, 0xb3, 0x53, 0xa1, 0xad, 0x0f, 0xff, 0x7f, 0x00, 0x38, 0x83, 0x6b, 0x3d, 0xc3, 0x50,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4458,6 +5401,8 @@ type DataCoordClient interface {
 	ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)
 	GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error)
 	GetCompactionStateWithPlans(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error)
+	GetCompactionPolicyStats(ctx context.Context, in *milvuspb.GetCompactionPolicyStatsRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPolicyStatsResponse, error)
+	CancelCompaction(ctx context.Context, in *milvuspb.CancelCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error)
 	GetFlushState(ctx context.Context, in *milvuspb.GetFlushStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error)
 	DropVirtualChannel(ctx context.Context, in *DropVirtualChannelRequest, opts ...grpc.CallOption) (*DropVirtualChannelResponse, error)
@@ -4468,6 +5413,11 @@ type DataCoordClient interface {
 	AcquireSegmentLock(ctx context.Context, in *AcquireSegmentLockRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ReleaseSegmentLock(ctx context.Context, in *ReleaseSegmentLockRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AddSegment(ctx context.Context, in *AddSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	TruncateSegments(ctx context.Context, in *TruncateSegmentsRequest, opts ...grpc.CallOption) (*TruncateSegmentsResponse, error)
+	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error)
+	DropSnapshot(ctx context.Context, in *DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	CheckPrimaryKeys(ctx context.Context, in *CheckPrimaryKeysRequest, opts ...grpc.CallOption) (*CheckPrimaryKeysResponse, error)
 }
 
 type dataCoordClient struct {
@@ -4649,6 +5599,24 @@ func (c *dataCoordClient) GetCompactionStateWithPlans(ctx context.Context, in *m
 	return out, nil
 }
 
+func (c *dataCoordClient) GetCompactionPolicyStats(ctx context.Context, in *milvuspb.GetCompactionPolicyStatsRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPolicyStatsResponse, error) {
+	out := new(milvuspb.GetCompactionPolicyStatsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCompactionPolicyStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CancelCompaction(ctx context.Context, in *milvuspb.CancelCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CancelCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataCoordClient) WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error) {
 	out := new(WatchChannelsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/WatchChannels", in, out, opts...)
@@ -4730,6 +5698,51 @@ func (c *dataCoordClient) AddSegment(ctx context.Context, in *AddSegmentRequest,
 	return out, nil
 }
 
+func (c *dataCoordClient) TruncateSegments(ctx context.Context, in *TruncateSegmentsRequest, opts ...grpc.CallOption) (*TruncateSegmentsResponse, error) {
+	out := new(TruncateSegmentsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/TruncateSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error) {
+	out := new(CreateSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CreateSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) DropSnapshot(ctx context.Context, in *DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/DropSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ListSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CheckPrimaryKeys(ctx context.Context, in *CheckPrimaryKeysRequest, opts ...grpc.CallOption) (*CheckPrimaryKeysResponse, error) {
+	out := new(CheckPrimaryKeysResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CheckPrimaryKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataCoordServer is the server API for DataCoord service.
 type DataCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -4752,6 +5765,8 @@ type DataCoordServer interface {
 	ManualCompaction(context.Context, *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
 	GetCompactionState(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
 	GetCompactionStateWithPlans(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
+	GetCompactionPolicyStats(context.Context, *milvuspb.GetCompactionPolicyStatsRequest) (*milvuspb.GetCompactionPolicyStatsResponse, error)
+	CancelCompaction(context.Context, *milvuspb.CancelCompactionRequest) (*commonpb.Status, error)
 	WatchChannels(context.Context, *WatchChannelsRequest) (*WatchChannelsResponse, error)
 	GetFlushState(context.Context, *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)
 	DropVirtualChannel(context.Context, *DropVirtualChannelRequest) (*DropVirtualChannelResponse, error)
@@ -4762,6 +5777,11 @@ type DataCoordServer interface {
 	AcquireSegmentLock(context.Context, *AcquireSegmentLockRequest) (*commonpb.Status, error)
 	ReleaseSegmentLock(context.Context, *ReleaseSegmentLockRequest) (*commonpb.Status, error)
 	AddSegment(context.Context, *AddSegmentRequest) (*commonpb.Status, error)
+	TruncateSegments(context.Context, *TruncateSegmentsRequest) (*TruncateSegmentsResponse, error)
+	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error)
+	DropSnapshot(context.Context, *DropSnapshotRequest) (*commonpb.Status, error)
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	CheckPrimaryKeys(context.Context, *CheckPrimaryKeysRequest) (*CheckPrimaryKeysResponse, error)
 }
 
 // UnimplementedDataCoordServer can be embedded to have forward compatible implementations.
@@ -4825,6 +5845,12 @@ func (*UnimplementedDataCoordServer) GetCompactionState(ctx context.Context, req
 func (*UnimplementedDataCoordServer) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionStateWithPlans not implemented")
 }
+func (*UnimplementedDataCoordServer) GetCompactionPolicyStats(ctx context.Context, req *milvuspb.GetCompactionPolicyStatsRequest) (*milvuspb.GetCompactionPolicyStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionPolicyStats not implemented")
+}
+func (*UnimplementedDataCoordServer) CancelCompaction(ctx context.Context, req *milvuspb.CancelCompactionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCompaction not implemented")
+}
 func (*UnimplementedDataCoordServer) WatchChannels(ctx context.Context, req *WatchChannelsRequest) (*WatchChannelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WatchChannels not implemented")
 }
@@ -4852,6 +5878,21 @@ func (*UnimplementedDataCoordServer) ReleaseSegmentLock(ctx context.Context, req
 func (*UnimplementedDataCoordServer) AddSegment(ctx context.Context, req *AddSegmentRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddSegment not implemented")
 }
+func (*UnimplementedDataCoordServer) TruncateSegments(ctx context.Context, req *TruncateSegmentsRequest) (*TruncateSegmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TruncateSegments not implemented")
+}
+func (*UnimplementedDataCoordServer) CreateSnapshot(ctx context.Context, req *CreateSnapshotRequest) (*CreateSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
+}
+func (*UnimplementedDataCoordServer) DropSnapshot(ctx context.Context, req *DropSnapshotRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropSnapshot not implemented")
+}
+func (*UnimplementedDataCoordServer) ListSnapshots(ctx context.Context, req *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (*UnimplementedDataCoordServer) CheckPrimaryKeys(ctx context.Context, req *CheckPrimaryKeysRequest) (*CheckPrimaryKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPrimaryKeys not implemented")
+}
 
 func RegisterDataCoordServer(s *grpc.Server, srv DataCoordServer) {
 	s.RegisterService(&_DataCoord_serviceDesc, srv)
@@ -5199,6 +6240,42 @@ func _DataCoord_GetCompactionStateWithPlans_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_GetCompactionPolicyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetCompactionPolicyStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetCompactionPolicyStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetCompactionPolicyStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetCompactionPolicyStats(ctx, req.(*milvuspb.GetCompactionPolicyStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_CancelCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CancelCompactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).CancelCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/CancelCompaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).CancelCompaction(ctx, req.(*milvuspb.CancelCompactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataCoord_WatchChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WatchChannelsRequest)
 	if err := dec(in); err != nil {
@@ -5361,6 +6438,96 @@ func _DataCoord_AddSegment_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_TruncateSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TruncateSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).TruncateSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/TruncateSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).TruncateSegments(ctx, req.(*TruncateSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).CreateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/CreateSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).CreateSnapshot(ctx, req.(*CreateSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_DropSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).DropSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/DropSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).DropSnapshot(ctx, req.(*DropSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ListSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_CheckPrimaryKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPrimaryKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).CheckPrimaryKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/CheckPrimaryKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).CheckPrimaryKeys(ctx, req.(*CheckPrimaryKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataCoord",
 	HandlerType: (*DataCoordServer)(nil),
@@ -5441,6 +6608,14 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCompactionStateWithPlans",
 			Handler:    _DataCoord_GetCompactionStateWithPlans_Handler,
 		},
+		{
+			MethodName: "GetCompactionPolicyStats",
+			Handler:    _DataCoord_GetCompactionPolicyStats_Handler,
+		},
+		{
+			MethodName: "CancelCompaction",
+			Handler:    _DataCoord_CancelCompaction_Handler,
+		},
 		{
 			MethodName: "WatchChannels",
 			Handler:    _DataCoord_WatchChannels_Handler,
@@ -5477,6 +6652,26 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddSegment",
 			Handler:    _DataCoord_AddSegment_Handler,
 		},
+		{
+			MethodName: "TruncateSegments",
+			Handler:    _DataCoord_TruncateSegments_Handler,
+		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _DataCoord_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "DropSnapshot",
+			Handler:    _DataCoord_DropSnapshot_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _DataCoord_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "CheckPrimaryKeys",
+			Handler:    _DataCoord_CheckPrimaryKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
@@ -5493,10 +6688,12 @@ type DataNodeClient interface {
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
 	Compaction(ctx context.Context, in *CompactionPlan, opts ...grpc.CallOption) (*commonpb.Status, error)
+	StopCompaction(ctx context.Context, in *StopCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ResendSegmentStats(ctx context.Context, in *ResendSegmentStatsRequest, opts ...grpc.CallOption) (*ResendSegmentStatsResponse, error)
 	AddSegment(ctx context.Context, in *AddSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CheckPrimaryKeys(ctx context.Context, in *CheckPrimaryKeysRequest, opts ...grpc.CallOption) (*CheckPrimaryKeysResponse, error)
 }
 
 type dataNodeClient struct {
@@ -5561,6 +6758,15 @@ func (c *dataNodeClient) Compaction(ctx context.Context, in *CompactionPlan, opt
 	return out, nil
 }
 
+func (c *dataNodeClient) StopCompaction(ctx context.Context, in *StopCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/StopCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataNodeClient) Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/Import", in, out, opts...)
@@ -5588,6 +6794,15 @@ func (c *dataNodeClient) AddSegment(ctx context.Context, in *AddSegmentRequest,
 	return out, nil
 }
 
+func (c *dataNodeClient) CheckPrimaryKeys(ctx context.Context, in *CheckPrimaryKeysRequest, opts ...grpc.CallOption) (*CheckPrimaryKeysResponse, error) {
+	out := new(CheckPrimaryKeysResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/CheckPrimaryKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataNodeServer is the server API for DataNode service.
 type DataNodeServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -5597,10 +6812,12 @@ type DataNodeServer interface {
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 	Compaction(context.Context, *CompactionPlan) (*commonpb.Status, error)
+	StopCompaction(context.Context, *StopCompactionRequest) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(context.Context, *ImportTaskRequest) (*commonpb.Status, error)
 	ResendSegmentStats(context.Context, *ResendSegmentStatsRequest) (*ResendSegmentStatsResponse, error)
 	AddSegment(context.Context, *AddSegmentRequest) (*commonpb.Status, error)
+	CheckPrimaryKeys(context.Context, *CheckPrimaryKeysRequest) (*CheckPrimaryKeysResponse, error)
 }
 
 // UnimplementedDataNodeServer can be embedded to have forward compatible implementations.
@@ -5625,6 +6842,9 @@ func (*UnimplementedDataNodeServer) GetMetrics(ctx context.Context, req *milvusp
 func (*UnimplementedDataNodeServer) Compaction(ctx context.Context, req *CompactionPlan) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Compaction not implemented")
 }
+func (*UnimplementedDataNodeServer) StopCompaction(ctx context.Context, req *StopCompactionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopCompaction not implemented")
+}
 func (*UnimplementedDataNodeServer) Import(ctx context.Context, req *ImportTaskRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
 }
@@ -5634,6 +6854,9 @@ func (*UnimplementedDataNodeServer) ResendSegmentStats(ctx context.Context, req
 func (*UnimplementedDataNodeServer) AddSegment(ctx context.Context, req *AddSegmentRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddSegment not implemented")
 }
+func (*UnimplementedDataNodeServer) CheckPrimaryKeys(ctx context.Context, req *CheckPrimaryKeysRequest) (*CheckPrimaryKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPrimaryKeys not implemented")
+}
 
 func RegisterDataNodeServer(s *grpc.Server, srv DataNodeServer) {
 	s.RegisterService(&_DataNode_serviceDesc, srv)
@@ -5747,6 +6970,24 @@ func _DataNode_Compaction_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataNode_StopCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopCompactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).StopCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/StopCompaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).StopCompaction(ctx, req.(*StopCompactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataNode_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ImportTaskRequest)
 	if err := dec(in); err != nil {
@@ -5801,6 +7042,24 @@ func _DataNode_AddSegment_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataNode_CheckPrimaryKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPrimaryKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).CheckPrimaryKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/CheckPrimaryKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).CheckPrimaryKeys(ctx, req.(*CheckPrimaryKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataNode_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataNode",
 	HandlerType: (*DataNodeServer)(nil),
@@ -5829,6 +7088,10 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Compaction",
 			Handler:    _DataNode_Compaction_Handler,
 		},
+		{
+			MethodName: "StopCompaction",
+			Handler:    _DataNode_StopCompaction_Handler,
+		},
 		{
 			MethodName: "Import",
 			Handler:    _DataNode_Import_Handler,
@@ -5841,6 +7104,10 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddSegment",
 			Handler:    _DataNode_AddSegment_Handler,
 		},
+		{
+			MethodName: "CheckPrimaryKeys",
+			Handler:    _DataNode_CheckPrimaryKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
@@ -157,9 +157,13 @@ type CollectionInfo struct {
 	StartPositions             []*commonpb.KeyDataPair   `protobuf:"bytes,11,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
 	ConsistencyLevel           commonpb.ConsistencyLevel `protobuf:"varint,12,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
 	Partitions                 []*PartitionInfo          `protobuf:"bytes,13,rep,name=partitions,proto3" json:"partitions,omitempty"`
-	XXX_NoUnkeyedLiteral       struct{}                  `json:"-"`
-	XXX_unrecognized           []byte                    `json:"-"`
-	XXX_sizecache              int32                     `json:"-"`
+	Properties                 []*commonpb.KeyValuePair  `protobuf:"bytes,14,rep,name=properties,proto3" json:"properties,omitempty"`
+	ShardsNumHistory           []int32                   `protobuf:"varint,15,rep,packed,name=shards_num_history,json=shardsNumHistory,proto3" json:"shards_num_history,omitempty"`
+	// the largest field ID ever allocated, the IDs of dropped fields are never reused
+	MaxFieldId           int64    `protobuf:"varint,16,opt,name=max_field_id,json=maxFieldId,proto3" json:"max_field_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CollectionInfo) Reset()         { *m = CollectionInfo{} }
@@ -278,6 +282,27 @@ func (m *CollectionInfo) GetPartitions() []*PartitionInfo {
 	return nil
 }
 
+func (m *CollectionInfo) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *CollectionInfo) GetShardsNumHistory() []int32 {
+	if m != nil {
+		return m.ShardsNumHistory
+	}
+	return nil
+}
+
+func (m *CollectionInfo) GetMaxFieldId() int64 {
+	if m != nil {
+		return m.MaxFieldId
+	}
+	return 0
+}
+
 type PartitionInfo struct {
 	PartitionID               int64    `protobuf:"varint,1,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
 	PartitionName             string   `protobuf:"bytes,2,opt,name=partitionName,proto3" json:"partitionName,omitempty"`
@@ -594,58 +619,61 @@ func init() {
 func init() { proto.RegisterFile("etcd_meta.proto", fileDescriptor_975d306d62b73e88) }
 
 var fileDescriptor_975d306d62b73e88 = []byte{
-	// 837 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0x96, 0xe3, 0xe6, 0xc7, 0x27, 0x69, 0xba, 0x1d, 0x60, 0x35, 0x5b, 0x16, 0xf0, 0x46, 0x2c,
-	0xf8, 0x66, 0x5b, 0xd1, 0x05, 0xee, 0x40, 0x2b, 0x6a, 0xad, 0x14, 0x01, 0xab, 0x68, 0x5a, 0x71,
-	0xc1, 0x8d, 0x35, 0xb1, 0x4f, 0x9b, 0x91, 0xec, 0xb1, 0xe5, 0x19, 0x17, 0xf2, 0x06, 0xbc, 0x01,
-	0x8f, 0xc2, 0x2d, 0x37, 0x3c, 0x0d, 0x2f, 0x81, 0x3c, 0xfe, 0x89, 0x9d, 0xb4, 0x5c, 0xee, 0x5d,
-	0xce, 0x77, 0xe6, 0x9c, 0xcc, 0x77, 0xce, 0x37, 0x9f, 0xe1, 0x04, 0x75, 0x18, 0x05, 0x09, 0x6a,
-	0x7e, 0x9e, 0xe5, 0xa9, 0x4e, 0xc9, 0x69, 0x22, 0xe2, 0xfb, 0x42, 0x55, 0xd1, 0x79, 0x99, 0x3d,
-	0x9b, 0x85, 0x69, 0x92, 0xa4, 0xb2, 0x82, 0xce, 0x66, 0x2a, 0xdc, 0x60, 0x52, 0x1f, 0x5f, 0xfc,
-	0x63, 0x81, 0xb3, 0x94, 0x11, 0xfe, 0xbe, 0x94, 0xb7, 0x29, 0xf9, 0x04, 0x40, 0x94, 0x41, 0x20,
-	0x79, 0x82, 0xd4, 0x72, 0x2d, 0xcf, 0x61, 0x8e, 0x41, 0xde, 0xf1, 0x04, 0x09, 0x85, 0xb1, 0x09,
-	0x96, 0x3e, 0x1d, 0xb8, 0x96, 0x67, 0xb3, 0x26, 0x24, 0x3e, 0xcc, 0xaa, 0xc2, 0x8c, 0xe7, 0x3c,
-	0x51, 0xd4, 0x76, 0x6d, 0x6f, 0x7a, 0xf9, 0xe2, 0xbc, 0x77, 0x99, 0xfa, 0x1a, 0x3f, 0xe2, 0xf6,
-	0x17, 0x1e, 0x17, 0xb8, 0xe2, 0x22, 0x67, 0x53, 0x53, 0xb6, 0x32, 0x55, 0x65, 0xff, 0x08, 0x63,
-	0xd4, 0x18, 0xd1, 0x23, 0xd7, 0xf2, 0x26, 0xac, 0x09, 0xc9, 0x67, 0x30, 0x0d, 0x73, 0xe4, 0x1a,
-	0x03, 0x2d, 0x12, 0xa4, 0x43, 0xd7, 0xf2, 0x8e, 0x18, 0x54, 0xd0, 0x8d, 0x48, 0x70, 0xe1, 0xc3,
-	0xfc, 0xad, 0xc0, 0x38, 0xda, 0x71, 0xa1, 0x30, 0xbe, 0x15, 0x31, 0x46, 0x4b, 0xdf, 0x10, 0xb1,
-	0x59, 0x13, 0x3e, 0x4e, 0x63, 0xf1, 0xf7, 0x10, 0xe6, 0x57, 0x69, 0x1c, 0x63, 0xa8, 0x45, 0x2a,
-	0x4d, 0x9b, 0x39, 0x0c, 0xda, 0x0e, 0x83, 0xa5, 0x4f, 0xbe, 0x83, 0x51, 0x35, 0x40, 0x53, 0x3b,
-	0xbd, 0x7c, 0xd9, 0xe7, 0x58, 0x0f, 0x77, 0xd7, 0xe4, 0xda, 0x00, 0xac, 0x2e, 0xda, 0x27, 0x62,
-	0xef, 0x13, 0x21, 0x0b, 0x98, 0x65, 0x3c, 0xd7, 0xc2, 0x5c, 0xc0, 0x57, 0xf4, 0xc8, 0xb5, 0x3d,
-	0x9b, 0xf5, 0x30, 0xf2, 0x05, 0xcc, 0xdb, 0xb8, 0x5c, 0x8c, 0xa2, 0x43, 0xd7, 0xf6, 0x1c, 0xb6,
-	0x87, 0x92, 0xb7, 0x70, 0x7c, 0x5b, 0x0e, 0x25, 0x30, 0xfc, 0x50, 0xd1, 0xd1, 0x43, 0x6b, 0x29,
-	0x35, 0x72, 0xde, 0x1f, 0x1e, 0x9b, 0xdd, 0xb6, 0x31, 0x2a, 0x72, 0x09, 0x1f, 0xdd, 0x8b, 0x5c,
-	0x17, 0x3c, 0x0e, 0xc2, 0x0d, 0x97, 0x12, 0x63, 0x23, 0x10, 0x45, 0xc7, 0xe6, 0x6f, 0x3f, 0xa8,
-	0x93, 0x57, 0x55, 0xae, 0xfa, 0xef, 0xaf, 0xe1, 0x69, 0xb6, 0xd9, 0x2a, 0x11, 0x1e, 0x14, 0x4d,
-	0x4c, 0xd1, 0x87, 0x4d, 0xb6, 0x57, 0xf5, 0x06, 0x9e, 0xb7, 0x1c, 0x82, 0x6a, 0x2a, 0x91, 0x99,
-	0x94, 0xd2, 0x3c, 0xc9, 0x14, 0x75, 0x5c, 0xdb, 0x3b, 0x62, 0x67, 0xed, 0x99, 0xab, 0xea, 0xc8,
-	0x4d, 0x7b, 0xa2, 0x94, 0xb0, 0xda, 0xf0, 0x3c, 0x52, 0x81, 0x2c, 0x12, 0x0a, 0xae, 0xe5, 0x0d,
-	0x99, 0x53, 0x21, 0xef, 0x8a, 0x84, 0x2c, 0xe1, 0x44, 0x69, 0x9e, 0xeb, 0x20, 0x4b, 0x95, 0xe9,
-	0xa0, 0xe8, 0xd4, 0x0c, 0xc5, 0x7d, 0x4c, 0xab, 0x3e, 0xd7, 0xdc, 0x48, 0x75, 0x6e, 0x0a, 0x57,
-	0x4d, 0x1d, 0x61, 0x70, 0x1a, 0xa6, 0x52, 0x09, 0xa5, 0x51, 0x86, 0xdb, 0x20, 0xc6, 0x7b, 0x8c,
-	0xe9, 0xcc, 0xb5, 0xbc, 0xf9, 0xbe, 0x28, 0xea, 0x66, 0x57, 0xbb, 0xd3, 0x3f, 0x95, 0x87, 0xd9,
-	0x93, 0x70, 0x0f, 0x21, 0x6f, 0x00, 0x5a, 0x6e, 0x8a, 0x1e, 0x3f, 0x74, 0x33, 0xb3, 0xae, 0x55,
-	0x2b, 0x87, 0x72, 0x5b, 0x9d, 0x9a, 0xc5, 0x9f, 0x16, 0x1c, 0xf7, 0xb2, 0xc4, 0x85, 0x69, 0x47,
-	0x3d, 0xb5, 0x94, 0xbb, 0x10, 0xf9, 0x1c, 0x8e, 0x7b, 0xca, 0x31, 0xd2, 0x76, 0x58, 0x1f, 0x24,
-	0xdf, 0xc3, 0xc7, 0xff, 0xb3, 0x9b, 0x5a, 0xca, 0xcf, 0x1e, 0x5d, 0xcd, 0xe2, 0x8f, 0x01, 0x3c,
-	0xb9, 0xc6, 0xbb, 0x04, 0xa5, 0xde, 0xbd, 0xd2, 0x05, 0xcc, 0xc2, 0xdd, 0x83, 0x6b, 0x6e, 0xd7,
-	0xc3, 0xf6, 0x09, 0x0c, 0x0e, 0x09, 0x3c, 0x07, 0x47, 0xd5, 0x9d, 0x7d, 0x73, 0x11, 0x9b, 0xed,
-	0x80, 0xca, 0x09, 0x4a, 0x39, 0xfb, 0xc6, 0x56, 0x8c, 0x13, 0x98, 0xb0, 0xeb, 0x04, 0xc3, 0xbe,
-	0xa1, 0x51, 0x18, 0xaf, 0x0b, 0x61, 0x6a, 0x46, 0x55, 0xa6, 0x0e, 0xc9, 0x0b, 0x98, 0xa1, 0xe4,
-	0xeb, 0x18, 0xab, 0x57, 0x45, 0xc7, 0xc6, 0xa9, 0xa6, 0x15, 0x66, 0x88, 0xed, 0x3f, 0xf2, 0xc9,
-	0x81, 0x5b, 0xfd, 0x6b, 0x75, 0x7d, 0xe6, 0x67, 0xd4, 0xfc, 0xbd, 0xfb, 0xcc, 0xa7, 0x00, 0xed,
-	0x84, 0x1a, 0x97, 0xe9, 0x20, 0xe4, 0x65, 0xc7, 0x63, 0x02, 0xcd, 0xef, 0x1a, 0x8f, 0xd9, 0x89,
-	0xe2, 0x86, 0xdf, 0xa9, 0x03, 0xbb, 0x1a, 0x1d, 0xda, 0xd5, 0xe2, 0xaf, 0x92, 0x6d, 0x8e, 0x11,
-	0x4a, 0x2d, 0x78, 0x6c, 0xd6, 0x7e, 0x06, 0x93, 0x42, 0x61, 0xde, 0xf9, 0xcc, 0xb4, 0x31, 0x79,
-	0x05, 0x04, 0x65, 0x98, 0x6f, 0xb3, 0x52, 0x5f, 0x19, 0x57, 0xea, 0xb7, 0x34, 0x8f, 0x6a, 0x49,
-	0x9e, 0xb6, 0x99, 0x55, 0x9d, 0x20, 0x4f, 0x61, 0xa4, 0x51, 0x72, 0xa9, 0x0d, 0x49, 0x87, 0xd5,
-	0x11, 0x79, 0x06, 0x13, 0xa1, 0x02, 0x55, 0x64, 0x98, 0x37, 0x5f, 0x13, 0xa1, 0xae, 0xcb, 0x90,
-	0x7c, 0x09, 0x27, 0x6a, 0xc3, 0x2f, 0xbf, 0xf9, 0x76, 0xd7, 0x7e, 0x68, 0x6a, 0xe7, 0x15, 0xdc,
-	0xf4, 0xfe, 0xe1, 0xf5, 0xaf, 0x5f, 0xdd, 0x09, 0xbd, 0x29, 0xd6, 0xe5, 0x13, 0xbe, 0xa8, 0x16,
-	0xf0, 0x4a, 0xa4, 0xf5, 0xaf, 0x0b, 0x21, 0x75, 0x79, 0xe7, 0xf8, 0xc2, 0xec, 0xe4, 0xa2, 0x7c,
-	0x99, 0xd9, 0x7a, 0x3d, 0x32, 0xd1, 0xeb, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x26, 0x75, 0x94,
-	0x72, 0x9b, 0x07, 0x00, 0x00,
+	// 891 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x5d, 0x6e, 0x23, 0x45,
+	0x10, 0xd6, 0x78, 0xfc, 0x5b, 0x76, 0x9c, 0x6c, 0x03, 0xab, 0xde, 0xb0, 0xc0, 0xac, 0x45, 0x60,
+	0x1e, 0xd8, 0x44, 0x78, 0x81, 0x37, 0xd0, 0x42, 0xac, 0x15, 0x16, 0xb0, 0xb2, 0x3a, 0x11, 0x0f,
+	0xbc, 0x8c, 0xda, 0x33, 0x95, 0xb8, 0xa5, 0xf9, 0xd3, 0x74, 0x3b, 0xc4, 0x37, 0xe0, 0x06, 0x1c,
+	0x85, 0x13, 0x70, 0x02, 0x8e, 0xc1, 0x25, 0xd0, 0x74, 0xcf, 0xaf, 0x9d, 0x20, 0x9e, 0x78, 0x73,
+	0x7d, 0xdd, 0x55, 0xae, 0xaf, 0xfa, 0xab, 0x6f, 0xe0, 0x18, 0x95, 0x1f, 0x78, 0x11, 0x2a, 0x7e,
+	0x9e, 0x66, 0x89, 0x4a, 0xc8, 0x93, 0x48, 0x84, 0x77, 0x5b, 0x69, 0xa2, 0xf3, 0xfc, 0xf4, 0x74,
+	0xe2, 0x27, 0x51, 0x94, 0xc4, 0x06, 0x3a, 0x9d, 0x48, 0x7f, 0x83, 0x51, 0x71, 0x7d, 0xf6, 0xa7,
+	0x05, 0xa3, 0x65, 0x1c, 0xe0, 0xfd, 0x32, 0xbe, 0x49, 0xc8, 0x07, 0x00, 0x22, 0x0f, 0xbc, 0x98,
+	0x47, 0x48, 0x2d, 0xc7, 0x72, 0x47, 0x6c, 0xa4, 0x91, 0xb7, 0x3c, 0x42, 0x42, 0x61, 0xa0, 0x83,
+	0xe5, 0x82, 0x76, 0x1c, 0xcb, 0xb5, 0x59, 0x19, 0x92, 0x05, 0x4c, 0x4c, 0x62, 0xca, 0x33, 0x1e,
+	0x49, 0x6a, 0x3b, 0xb6, 0x3b, 0x9e, 0xbf, 0x38, 0x6f, 0x35, 0x53, 0xb4, 0xf1, 0x03, 0xee, 0x7e,
+	0xe6, 0xe1, 0x16, 0x57, 0x5c, 0x64, 0x6c, 0xac, 0xd3, 0x56, 0x3a, 0x2b, 0xaf, 0x1f, 0x60, 0x88,
+	0x0a, 0x03, 0xda, 0x75, 0x2c, 0x77, 0xc8, 0xca, 0x90, 0x7c, 0x04, 0x63, 0x3f, 0x43, 0xae, 0xd0,
+	0x53, 0x22, 0x42, 0xda, 0x73, 0x2c, 0xb7, 0xcb, 0xc0, 0x40, 0xd7, 0x22, 0xc2, 0xd9, 0x02, 0xa6,
+	0x6f, 0x04, 0x86, 0x41, 0xcd, 0x85, 0xc2, 0xe0, 0x46, 0x84, 0x18, 0x2c, 0x17, 0x9a, 0x88, 0xcd,
+	0xca, 0xf0, 0x71, 0x1a, 0xb3, 0xbf, 0xfa, 0x30, 0xbd, 0x4c, 0xc2, 0x10, 0x7d, 0x25, 0x92, 0x58,
+	0x97, 0x99, 0x42, 0xa7, 0xaa, 0xd0, 0x59, 0x2e, 0xc8, 0xd7, 0xd0, 0x37, 0x03, 0xd4, 0xb9, 0xe3,
+	0xf9, 0x59, 0x9b, 0x63, 0x31, 0xdc, 0xba, 0xc8, 0x95, 0x06, 0x58, 0x91, 0xb4, 0x4f, 0xc4, 0xde,
+	0x27, 0x42, 0x66, 0x30, 0x49, 0x79, 0xa6, 0x84, 0x6e, 0x60, 0x21, 0x69, 0xd7, 0xb1, 0x5d, 0x9b,
+	0xb5, 0x30, 0xf2, 0x09, 0x4c, 0xab, 0x38, 0x7f, 0x18, 0x49, 0x7b, 0x8e, 0xed, 0x8e, 0xd8, 0x1e,
+	0x4a, 0xde, 0xc0, 0xd1, 0x4d, 0x3e, 0x14, 0x4f, 0xf3, 0x43, 0x49, 0xfb, 0x0f, 0x3d, 0x4b, 0xae,
+	0x91, 0xf3, 0xf6, 0xf0, 0xd8, 0xe4, 0xa6, 0x8a, 0x51, 0x92, 0x39, 0xbc, 0x77, 0x27, 0x32, 0xb5,
+	0xe5, 0xa1, 0xe7, 0x6f, 0x78, 0x1c, 0x63, 0xa8, 0x05, 0x22, 0xe9, 0x40, 0xff, 0xed, 0x3b, 0xc5,
+	0xe1, 0xa5, 0x39, 0x33, 0xff, 0xfd, 0x05, 0x3c, 0x4d, 0x37, 0x3b, 0x29, 0xfc, 0x83, 0xa4, 0xa1,
+	0x4e, 0x7a, 0xb7, 0x3c, 0x6d, 0x65, 0xbd, 0x86, 0xe7, 0x15, 0x07, 0xcf, 0x4c, 0x25, 0xd0, 0x93,
+	0x92, 0x8a, 0x47, 0xa9, 0xa4, 0x23, 0xc7, 0x76, 0xbb, 0xec, 0xb4, 0xba, 0x73, 0x69, 0xae, 0x5c,
+	0x57, 0x37, 0x72, 0x09, 0xcb, 0x0d, 0xcf, 0x02, 0xe9, 0xc5, 0xdb, 0x88, 0x82, 0x63, 0xb9, 0x3d,
+	0x36, 0x32, 0xc8, 0xdb, 0x6d, 0x44, 0x96, 0x70, 0x2c, 0x15, 0xcf, 0x94, 0x97, 0x26, 0x52, 0x57,
+	0x90, 0x74, 0xac, 0x87, 0xe2, 0x3c, 0xa6, 0xd5, 0x05, 0x57, 0x5c, 0x4b, 0x75, 0xaa, 0x13, 0x57,
+	0x65, 0x1e, 0x61, 0xf0, 0xc4, 0x4f, 0x62, 0x29, 0xa4, 0xc2, 0xd8, 0xdf, 0x79, 0x21, 0xde, 0x61,
+	0x48, 0x27, 0x8e, 0xe5, 0x4e, 0xe7, 0x67, 0x0f, 0x16, 0xbb, 0xac, 0x6f, 0xff, 0x98, 0x5f, 0x66,
+	0x27, 0xfe, 0x1e, 0x42, 0x5e, 0x03, 0x54, 0xdc, 0x24, 0x3d, 0x7a, 0xa8, 0x33, 0xfd, 0x5c, 0xab,
+	0x4a, 0x0e, 0xf9, 0x6b, 0x35, 0x72, 0xc8, 0xb7, 0x00, 0x69, 0x96, 0xa4, 0x98, 0x29, 0x81, 0x92,
+	0x4e, 0xff, 0xeb, 0x1e, 0x36, 0x92, 0xc8, 0x67, 0x40, 0xea, 0x11, 0x7a, 0x1b, 0x21, 0x55, 0x92,
+	0xed, 0xe8, 0xb1, 0x63, 0xbb, 0x3d, 0x76, 0x52, 0x8d, 0xf2, 0x7b, 0x83, 0x13, 0x07, 0x26, 0x11,
+	0xbf, 0xf7, 0x0a, 0xa1, 0x05, 0xf4, 0x44, 0xaf, 0x0a, 0x44, 0xfc, 0xde, 0x68, 0x2a, 0x98, 0xfd,
+	0x6e, 0xc1, 0x51, 0xab, 0x61, 0xe2, 0xc0, 0xb8, 0x21, 0xe8, 0x62, 0xbb, 0x9a, 0x10, 0xf9, 0x18,
+	0x8e, 0x5a, 0x62, 0xd6, 0xdb, 0x36, 0x62, 0x6d, 0x90, 0x7c, 0x03, 0xef, 0xff, 0x8b, 0x5c, 0x8a,
+	0xed, 0x7a, 0xf6, 0xa8, 0x5a, 0x66, 0xbf, 0x75, 0xe0, 0xe4, 0x0a, 0x6f, 0x23, 0x8c, 0x55, 0x6d,
+	0x1c, 0x33, 0x98, 0xf8, 0xb5, 0x07, 0x94, 0xdd, 0xb5, 0xb0, 0x7d, 0x02, 0x9d, 0x43, 0x02, 0xcf,
+	0x61, 0x24, 0x8b, 0xca, 0x0b, 0xdd, 0x88, 0xcd, 0x6a, 0xc0, 0x98, 0x53, 0x3e, 0x9d, 0x05, 0xed,
+	0x96, 0xe6, 0xa4, 0xc3, 0xa6, 0x39, 0xf5, 0xda, 0x1e, 0x4b, 0x61, 0xb0, 0xde, 0x0a, 0x9d, 0xd3,
+	0x37, 0x27, 0x45, 0x48, 0x5e, 0xc0, 0x04, 0x63, 0xbe, 0x0e, 0xd1, 0x2c, 0x3a, 0x1d, 0x68, 0xf3,
+	0x1c, 0x1b, 0x4c, 0x13, 0xdb, 0xf7, 0x9d, 0xe1, 0x81, 0x81, 0xfe, 0x6d, 0x35, 0xad, 0xef, 0x27,
+	0x54, 0xfc, 0x7f, 0xb7, 0xbe, 0x0f, 0x01, 0xaa, 0x09, 0x95, 0xc6, 0xd7, 0x40, 0xc8, 0x59, 0xc3,
+	0xf6, 0x3c, 0xc5, 0x6f, 0x4b, 0xdb, 0xab, 0x45, 0x71, 0xcd, 0x6f, 0xe5, 0x81, 0x83, 0xf6, 0x0f,
+	0x1d, 0x74, 0xf6, 0x47, 0xce, 0x36, 0xc3, 0x00, 0x63, 0x25, 0x78, 0xa8, 0x9f, 0xfd, 0x14, 0x86,
+	0x5b, 0x89, 0x59, 0xe3, 0xcb, 0x57, 0xc5, 0xe4, 0x25, 0x10, 0x8c, 0xfd, 0x6c, 0x97, 0xe6, 0xfa,
+	0x4a, 0xb9, 0x94, 0xbf, 0x26, 0x59, 0x50, 0x48, 0xf2, 0x49, 0x75, 0xb2, 0x2a, 0x0e, 0xc8, 0x53,
+	0xe8, 0x2b, 0x8c, 0x79, 0xac, 0x34, 0xc9, 0x11, 0x2b, 0x22, 0xf2, 0x0c, 0x86, 0x42, 0x7a, 0x72,
+	0x9b, 0x62, 0x56, 0x7e, 0xe0, 0x84, 0xbc, 0xca, 0x43, 0xf2, 0x29, 0x1c, 0xcb, 0x0d, 0x9f, 0x7f,
+	0xf9, 0x55, 0x5d, 0xbe, 0xa7, 0x73, 0xa7, 0x06, 0x2e, 0x6b, 0x7f, 0xf7, 0xea, 0x97, 0xcf, 0x6f,
+	0x85, 0xda, 0x6c, 0xd7, 0xf9, 0x1a, 0x5f, 0x98, 0x07, 0x78, 0x29, 0x92, 0xe2, 0xd7, 0x85, 0x88,
+	0x55, 0xde, 0x73, 0x78, 0xa1, 0xdf, 0xe4, 0x22, 0x37, 0x8b, 0x74, 0xbd, 0xee, 0xeb, 0xe8, 0xd5,
+	0x3f, 0x03, 0x00, 0x07, 0x4e, 0x12, 0xe0, 0x2e, 0x08, 0x00, 0x00,
 }
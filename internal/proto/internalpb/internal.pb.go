@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	commonpb "github.com/milvus-io/milvus/internal/proto/commonpb"
+	milvuspb "github.com/milvus-io/milvus/internal/proto/milvuspb"
 	schemapb "github.com/milvus-io/milvus/internal/proto/schemapb"
 	math "math"
 )
@@ -1003,6 +1004,176 @@ func (m *DropPartitionRequest) GetPartitionID() int64 {
 	return 0
 }
 
+// TruncateRequest is broadcast to the dml channels, the listed segments are dropped at the timestamp of base
+type TruncateRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName  string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	CollectionID   int64             `protobuf:"varint,5,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// 0 means all the partitions
+	PartitionID          int64    `protobuf:"varint,6,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	SegmentIDs           []int64  `protobuf:"varint,7,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TruncateRequest) Reset()         { *m = TruncateRequest{} }
+func (m *TruncateRequest) String() string { return proto.CompactTextString(m) }
+func (*TruncateRequest) ProtoMessage()    {}
+func (*TruncateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{16}
+}
+
+func (m *TruncateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TruncateRequest.Unmarshal(m, b)
+}
+func (m *TruncateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TruncateRequest.Marshal(b, m, deterministic)
+}
+func (m *TruncateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncateRequest.Merge(m, src)
+}
+func (m *TruncateRequest) XXX_Size() int {
+	return xxx_messageInfo_TruncateRequest.Size(m)
+}
+func (m *TruncateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TruncateRequest proto.InternalMessageInfo
+
+func (m *TruncateRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *TruncateRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *TruncateRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *TruncateRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *TruncateRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *TruncateRequest) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *TruncateRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+// AlterSchemaRequest is broadcast to the dml channels when a field is added or dropped,
+// the entities after the timestamp of base follow the new schema
+type AlterSchemaRequest struct {
+	Base                 *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                     `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                     `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionID         int64                      `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	FieldName            string                     `protobuf:"bytes,5,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	Schema               *schemapb.CollectionSchema `protobuf:"bytes,6,opt,name=schema,proto3" json:"schema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *AlterSchemaRequest) Reset()         { *m = AlterSchemaRequest{} }
+func (m *AlterSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*AlterSchemaRequest) ProtoMessage()    {}
+func (*AlterSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{17}
+}
+
+func (m *AlterSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlterSchemaRequest.Unmarshal(m, b)
+}
+func (m *AlterSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlterSchemaRequest.Marshal(b, m, deterministic)
+}
+func (m *AlterSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterSchemaRequest.Merge(m, src)
+}
+func (m *AlterSchemaRequest) XXX_Size() int {
+	return xxx_messageInfo_AlterSchemaRequest.Size(m)
+}
+func (m *AlterSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterSchemaRequest proto.InternalMessageInfo
+
+func (m *AlterSchemaRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *AlterSchemaRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *AlterSchemaRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *AlterSchemaRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *AlterSchemaRequest) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
+}
+
+func (m *AlterSchemaRequest) GetSchema() *schemapb.CollectionSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
 type CreateAliasRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -1017,7 +1188,7 @@ func (m *CreateAliasRequest) Reset()         { *m = CreateAliasRequest{} }
 func (m *CreateAliasRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateAliasRequest) ProtoMessage()    {}
 func (*CreateAliasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{16}
+	return fileDescriptor_41f4a519b878ee3b, []int{18}
 }
 
 func (m *CreateAliasRequest) XXX_Unmarshal(b []byte) error {
@@ -1079,7 +1250,7 @@ func (m *DropAliasRequest) Reset()         { *m = DropAliasRequest{} }
 func (m *DropAliasRequest) String() string { return proto.CompactTextString(m) }
 func (*DropAliasRequest) ProtoMessage()    {}
 func (*DropAliasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{17}
+	return fileDescriptor_41f4a519b878ee3b, []int{19}
 }
 
 func (m *DropAliasRequest) XXX_Unmarshal(b []byte) error {
@@ -1135,7 +1306,7 @@ func (m *AlterAliasRequest) Reset()         { *m = AlterAliasRequest{} }
 func (m *AlterAliasRequest) String() string { return proto.CompactTextString(m) }
 func (*AlterAliasRequest) ProtoMessage()    {}
 func (*AlterAliasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{18}
+	return fileDescriptor_41f4a519b878ee3b, []int{20}
 }
 
 func (m *AlterAliasRequest) XXX_Unmarshal(b []byte) error {
@@ -1202,7 +1373,7 @@ func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
 func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateIndexRequest) ProtoMessage()    {}
 func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{19}
+	return fileDescriptor_41f4a519b878ee3b, []int{21}
 }
 
 func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -1305,7 +1476,7 @@ func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
 func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
 func (*InsertRequest) ProtoMessage()    {}
 func (*InsertRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{20}
+	return fileDescriptor_41f4a519b878ee3b, []int{22}
 }
 
 func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
@@ -1439,26 +1610,36 @@ type SearchRequest struct {
 	PartitionIDs []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
 	Dsl          string            `protobuf:"bytes,6,opt,name=dsl,proto3" json:"dsl,omitempty"`
 	// serialized `PlaceholderGroup`
-	PlaceholderGroup     []byte           `protobuf:"bytes,7,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
-	DslType              commonpb.DslType `protobuf:"varint,8,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
-	SerializedExprPlan   []byte           `protobuf:"bytes,9,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
-	OutputFieldsId       []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
-	TravelTimestamp      uint64           `protobuf:"varint,11,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	TimeoutTimestamp     uint64           `protobuf:"varint,13,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
-	Nq                   int64            `protobuf:"varint,14,opt,name=nq,proto3" json:"nq,omitempty"`
-	Topk                 int64            `protobuf:"varint,15,opt,name=topk,proto3" json:"topk,omitempty"`
-	MetricType           string           `protobuf:"bytes,16,opt,name=metricType,proto3" json:"metricType,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	PlaceholderGroup   []byte           `protobuf:"bytes,7,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
+	DslType            commonpb.DslType `protobuf:"varint,8,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
+	SerializedExprPlan []byte           `protobuf:"bytes,9,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
+	OutputFieldsId     []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
+	TravelTimestamp    uint64           `protobuf:"varint,11,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	TimeoutTimestamp   uint64           `protobuf:"varint,13,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	Nq                 int64            `protobuf:"varint,14,opt,name=nq,proto3" json:"nq,omitempty"`
+	Topk               int64            `protobuf:"varint,15,opt,name=topk,proto3" json:"topk,omitempty"`
+	MetricType         string           `protobuf:"bytes,16,opt,name=metricType,proto3" json:"metricType,omitempty"`
+	GroupByFieldId     int64            `protobuf:"varint,17,opt,name=group_by_field_id,json=groupByFieldId,proto3" json:"group_by_field_id,omitempty"`
+	GroupSize          int64            `protobuf:"varint,18,opt,name=group_size,json=groupSize,proto3" json:"group_size,omitempty"`
+	Explain            bool             `protobuf:"varint,19,opt,name=explain,proto3" json:"explain,omitempty"`
+	// used by the read task scheduling policies of query nodes
+	Username string `protobuf:"bytes,20,opt,name=username,proto3" json:"username,omitempty"`
+	Priority int32  `protobuf:"varint,21,opt,name=priority,proto3" json:"priority,omitempty"`
+	// the shard leaders push the partial results to the proxy by SendSearchResult instead of buffering them
+	StreamResults bool `protobuf:"varint,22,opt,name=stream_results,json=streamResults,proto3" json:"stream_results,omitempty"`
+	// the sealed segments pruned by the proxy, whose clustering key range can't match the expression
+	PrunedSegmentIDs     []int64  `protobuf:"varint,23,rep,packed,name=pruned_segmentIDs,json=prunedSegmentIDs,proto3" json:"pruned_segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
 func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()    {}
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{21}
+	return fileDescriptor_41f4a519b878ee3b, []int{23}
 }
 
 func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
@@ -1591,6 +1772,55 @@ func (m *SearchRequest) GetMetricType() string {
 	return ""
 }
 
+func (m *SearchRequest) GetGroupByFieldId() int64 {
+	if m != nil {
+		return m.GroupByFieldId
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetGroupSize() int64 {
+	if m != nil {
+		return m.GroupSize
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetExplain() bool {
+	if m != nil {
+		return m.Explain
+	}
+	return false
+}
+
+func (m *SearchRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetStreamResults() bool {
+	if m != nil {
+		return m.StreamResults
+	}
+	return false
+}
+
+func (m *SearchRequest) GetPrunedSegmentIDs() []int64 {
+	if m != nil {
+		return m.PrunedSegmentIDs
+	}
+	return nil
+}
+
 type SearchResults struct {
 	Base                     *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                   *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1602,19 +1832,20 @@ type SearchResults struct {
 	ChannelIDsSearched       []string          `protobuf:"bytes,8,rep,name=channelIDs_searched,json=channelIDsSearched,proto3" json:"channelIDs_searched,omitempty"`
 	GlobalSealedSegmentIDs   []int64           `protobuf:"varint,9,rep,packed,name=global_sealed_segmentIDs,json=globalSealedSegmentIDs,proto3" json:"global_sealed_segmentIDs,omitempty"`
 	// schema.SearchResultsData inside
-	SlicedBlob           []byte   `protobuf:"bytes,10,opt,name=sliced_blob,json=slicedBlob,proto3" json:"sliced_blob,omitempty"`
-	SlicedNumCount       int64    `protobuf:"varint,11,opt,name=sliced_num_count,json=slicedNumCount,proto3" json:"sliced_num_count,omitempty"`
-	SlicedOffset         int64    `protobuf:"varint,12,opt,name=sliced_offset,json=slicedOffset,proto3" json:"sliced_offset,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SlicedBlob           []byte                   `protobuf:"bytes,10,opt,name=sliced_blob,json=slicedBlob,proto3" json:"sliced_blob,omitempty"`
+	SlicedNumCount       int64                    `protobuf:"varint,11,opt,name=sliced_num_count,json=slicedNumCount,proto3" json:"sliced_num_count,omitempty"`
+	SlicedOffset         int64                    `protobuf:"varint,12,opt,name=sliced_offset,json=slicedOffset,proto3" json:"sliced_offset,omitempty"`
+	Profiles             []*milvuspb.ShardProfile `protobuf:"bytes,13,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *SearchResults) Reset()         { *m = SearchResults{} }
 func (m *SearchResults) String() string { return proto.CompactTextString(m) }
 func (*SearchResults) ProtoMessage()    {}
 func (*SearchResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{22}
+	return fileDescriptor_41f4a519b878ee3b, []int{24}
 }
 
 func (m *SearchResults) XXX_Unmarshal(b []byte) error {
@@ -1719,27 +1950,45 @@ func (m *SearchResults) GetSlicedOffset() int64 {
 	return 0
 }
 
+func (m *SearchResults) GetProfiles() []*milvuspb.ShardProfile {
+	if m != nil {
+		return m.Profiles
+	}
+	return nil
+}
+
 type RetrieveRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	ReqID                int64             `protobuf:"varint,2,opt,name=reqID,proto3" json:"reqID,omitempty"`
-	DbID                 int64             `protobuf:"varint,3,opt,name=dbID,proto3" json:"dbID,omitempty"`
-	CollectionID         int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionIDs         []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
-	SerializedExprPlan   []byte            `protobuf:"bytes,6,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
-	OutputFieldsId       []int64           `protobuf:"varint,7,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
-	TravelTimestamp      uint64            `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64            `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	TimeoutTimestamp     uint64            `protobuf:"varint,10,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base               *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ReqID              int64             `protobuf:"varint,2,opt,name=reqID,proto3" json:"reqID,omitempty"`
+	DbID               int64             `protobuf:"varint,3,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID       int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs       []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	SerializedExprPlan []byte            `protobuf:"bytes,6,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
+	OutputFieldsId     []int64           `protobuf:"varint,7,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
+	TravelTimestamp    uint64            `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64            `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	TimeoutTimestamp   uint64            `protobuf:"varint,10,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	Explain            bool              `protobuf:"varint,11,opt,name=explain,proto3" json:"explain,omitempty"`
+	// used by the read task scheduling policies of query nodes
+	Username string `protobuf:"bytes,12,opt,name=username,proto3" json:"username,omitempty"`
+	Priority int32  `protobuf:"varint,13,opt,name=priority,proto3" json:"priority,omitempty"`
+	// the shard leaders push the partial results to the proxy by SendRetrieveResult instead of buffering them
+	StreamResults bool `protobuf:"varint,14,opt,name=stream_results,json=streamResults,proto3" json:"stream_results,omitempty"`
+	// the sealed segments pruned by the proxy, whose clustering key range can't match the expression
+	PrunedSegmentIDs []int64 `protobuf:"varint,15,rep,packed,name=pruned_segmentIDs,json=prunedSegmentIDs,proto3" json:"pruned_segmentIDs,omitempty"`
+	// only the entities with the smallest primary keys are returned in order if it's positive,
+	// used to page through the entities by the primary keys
+	Limit                int64    `protobuf:"varint,16,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RetrieveRequest) Reset()         { *m = RetrieveRequest{} }
 func (m *RetrieveRequest) String() string { return proto.CompactTextString(m) }
 func (*RetrieveRequest) ProtoMessage()    {}
 func (*RetrieveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{23}
+	return fileDescriptor_41f4a519b878ee3b, []int{25}
 }
 
 func (m *RetrieveRequest) XXX_Unmarshal(b []byte) error {
@@ -1830,25 +2079,68 @@ func (m *RetrieveRequest) GetTimeoutTimestamp() uint64 {
 	return 0
 }
 
+func (m *RetrieveRequest) GetExplain() bool {
+	if m != nil {
+		return m.Explain
+	}
+	return false
+}
+
+func (m *RetrieveRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *RetrieveRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *RetrieveRequest) GetStreamResults() bool {
+	if m != nil {
+		return m.StreamResults
+	}
+	return false
+}
+
+func (m *RetrieveRequest) GetPrunedSegmentIDs() []int64 {
+	if m != nil {
+		return m.PrunedSegmentIDs
+	}
+	return nil
+}
+
+func (m *RetrieveRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 type RetrieveResults struct {
-	Base                      *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Status                    *commonpb.Status      `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	ReqID                     int64                 `protobuf:"varint,3,opt,name=reqID,proto3" json:"reqID,omitempty"`
-	Ids                       *schemapb.IDs         `protobuf:"bytes,4,opt,name=ids,proto3" json:"ids,omitempty"`
-	FieldsData                []*schemapb.FieldData `protobuf:"bytes,5,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
-	SealedSegmentIDsRetrieved []int64               `protobuf:"varint,6,rep,packed,name=sealed_segmentIDs_retrieved,json=sealedSegmentIDsRetrieved,proto3" json:"sealed_segmentIDs_retrieved,omitempty"`
-	ChannelIDsRetrieved       []string              `protobuf:"bytes,7,rep,name=channelIDs_retrieved,json=channelIDsRetrieved,proto3" json:"channelIDs_retrieved,omitempty"`
-	GlobalSealedSegmentIDs    []int64               `protobuf:"varint,8,rep,packed,name=global_sealed_segmentIDs,json=globalSealedSegmentIDs,proto3" json:"global_sealed_segmentIDs,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{}              `json:"-"`
-	XXX_unrecognized          []byte                `json:"-"`
-	XXX_sizecache             int32                 `json:"-"`
+	Base                      *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Status                    *commonpb.Status         `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ReqID                     int64                    `protobuf:"varint,3,opt,name=reqID,proto3" json:"reqID,omitempty"`
+	Ids                       *schemapb.IDs            `protobuf:"bytes,4,opt,name=ids,proto3" json:"ids,omitempty"`
+	FieldsData                []*schemapb.FieldData    `protobuf:"bytes,5,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	SealedSegmentIDsRetrieved []int64                  `protobuf:"varint,6,rep,packed,name=sealed_segmentIDs_retrieved,json=sealedSegmentIDsRetrieved,proto3" json:"sealed_segmentIDs_retrieved,omitempty"`
+	ChannelIDsRetrieved       []string                 `protobuf:"bytes,7,rep,name=channelIDs_retrieved,json=channelIDsRetrieved,proto3" json:"channelIDs_retrieved,omitempty"`
+	GlobalSealedSegmentIDs    []int64                  `protobuf:"varint,8,rep,packed,name=global_sealed_segmentIDs,json=globalSealedSegmentIDs,proto3" json:"global_sealed_segmentIDs,omitempty"`
+	Profiles                  []*milvuspb.ShardProfile `protobuf:"bytes,9,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}                 `json:"-"`
+	XXX_unrecognized          []byte                   `json:"-"`
+	XXX_sizecache             int32                    `json:"-"`
 }
 
 func (m *RetrieveResults) Reset()         { *m = RetrieveResults{} }
 func (m *RetrieveResults) String() string { return proto.CompactTextString(m) }
 func (*RetrieveResults) ProtoMessage()    {}
 func (*RetrieveResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{24}
+	return fileDescriptor_41f4a519b878ee3b, []int{26}
 }
 
 func (m *RetrieveResults) XXX_Unmarshal(b []byte) error {
@@ -1925,6 +2217,13 @@ func (m *RetrieveResults) GetGlobalSealedSegmentIDs() []int64 {
 	return nil
 }
 
+func (m *RetrieveResults) GetProfiles() []*milvuspb.ShardProfile {
+	if m != nil {
+		return m.Profiles
+	}
+	return nil
+}
+
 type DeleteRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	ShardName            string            `protobuf:"bytes,2,opt,name=shardName,proto3" json:"shardName,omitempty"`
@@ -1947,7 +2246,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{25}
+	return fileDescriptor_41f4a519b878ee3b, []int{27}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -2068,7 +2367,7 @@ func (m *LoadIndex) Reset()         { *m = LoadIndex{} }
 func (m *LoadIndex) String() string { return proto.CompactTextString(m) }
 func (*LoadIndex) ProtoMessage()    {}
 func (*LoadIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{26}
+	return fileDescriptor_41f4a519b878ee3b, []int{28}
 }
 
 func (m *LoadIndex) XXX_Unmarshal(b []byte) error {
@@ -2143,7 +2442,7 @@ func (m *IndexStats) Reset()         { *m = IndexStats{} }
 func (m *IndexStats) String() string { return proto.CompactTextString(m) }
 func (*IndexStats) ProtoMessage()    {}
 func (*IndexStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{27}
+	return fileDescriptor_41f4a519b878ee3b, []int{29}
 }
 
 func (m *IndexStats) XXX_Unmarshal(b []byte) error {
@@ -2191,7 +2490,7 @@ func (m *FieldStats) Reset()         { *m = FieldStats{} }
 func (m *FieldStats) String() string { return proto.CompactTextString(m) }
 func (*FieldStats) ProtoMessage()    {}
 func (*FieldStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{28}
+	return fileDescriptor_41f4a519b878ee3b, []int{30}
 }
 
 func (m *FieldStats) XXX_Unmarshal(b []byte) error {
@@ -2247,7 +2546,7 @@ func (m *SegmentStats) Reset()         { *m = SegmentStats{} }
 func (m *SegmentStats) String() string { return proto.CompactTextString(m) }
 func (*SegmentStats) ProtoMessage()    {}
 func (*SegmentStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{29}
+	return fileDescriptor_41f4a519b878ee3b, []int{31}
 }
 
 func (m *SegmentStats) XXX_Unmarshal(b []byte) error {
@@ -2310,7 +2609,7 @@ func (m *MsgPosition) Reset()         { *m = MsgPosition{} }
 func (m *MsgPosition) String() string { return proto.CompactTextString(m) }
 func (*MsgPosition) ProtoMessage()    {}
 func (*MsgPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{30}
+	return fileDescriptor_41f4a519b878ee3b, []int{32}
 }
 
 func (m *MsgPosition) XXX_Unmarshal(b []byte) error {
@@ -2373,7 +2672,7 @@ func (m *ChannelTimeTickMsg) Reset()         { *m = ChannelTimeTickMsg{} }
 func (m *ChannelTimeTickMsg) String() string { return proto.CompactTextString(m) }
 func (*ChannelTimeTickMsg) ProtoMessage()    {}
 func (*ChannelTimeTickMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{31}
+	return fileDescriptor_41f4a519b878ee3b, []int{33}
 }
 
 func (m *ChannelTimeTickMsg) XXX_Unmarshal(b []byte) error {
@@ -2439,7 +2738,7 @@ func (m *CredentialInfo) Reset()         { *m = CredentialInfo{} }
 func (m *CredentialInfo) String() string { return proto.CompactTextString(m) }
 func (*CredentialInfo) ProtoMessage()    {}
 func (*CredentialInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{32}
+	return fileDescriptor_41f4a519b878ee3b, []int{34}
 }
 
 func (m *CredentialInfo) XXX_Unmarshal(b []byte) error {
@@ -2507,7 +2806,7 @@ func (m *ListPolicyRequest) Reset()         { *m = ListPolicyRequest{} }
 func (m *ListPolicyRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPolicyRequest) ProtoMessage()    {}
 func (*ListPolicyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{33}
+	return fileDescriptor_41f4a519b878ee3b, []int{35}
 }
 
 func (m *ListPolicyRequest) XXX_Unmarshal(b []byte) error {
@@ -2537,19 +2836,20 @@ func (m *ListPolicyRequest) GetBase() *commonpb.MsgBase {
 
 type ListPolicyResponse struct {
 	// Contain error_code and reason
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	PolicyInfos          []string         `protobuf:"bytes,2,rep,name=policy_infos,json=policyInfos,proto3" json:"policy_infos,omitempty"`
-	UserRoles            []string         `protobuf:"bytes,3,rep,name=user_roles,json=userRoles,proto3" json:"user_roles,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Status               *commonpb.Status            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PolicyInfos          []string                    `protobuf:"bytes,2,rep,name=policy_infos,json=policyInfos,proto3" json:"policy_infos,omitempty"`
+	UserRoles            []string                    `protobuf:"bytes,3,rep,name=user_roles,json=userRoles,proto3" json:"user_roles,omitempty"`
+	RowPolicies          []*milvuspb.RowPolicyEntity `protobuf:"bytes,4,rep,name=row_policies,json=rowPolicies,proto3" json:"row_policies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
 func (m *ListPolicyResponse) Reset()         { *m = ListPolicyResponse{} }
 func (m *ListPolicyResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPolicyResponse) ProtoMessage()    {}
 func (*ListPolicyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{34}
+	return fileDescriptor_41f4a519b878ee3b, []int{36}
 }
 
 func (m *ListPolicyResponse) XXX_Unmarshal(b []byte) error {
@@ -2591,6 +2891,13 @@ func (m *ListPolicyResponse) GetUserRoles() []string {
 	return nil
 }
 
+func (m *ListPolicyResponse) GetRowPolicies() []*milvuspb.RowPolicyEntity {
+	if m != nil {
+		return m.RowPolicies
+	}
+	return nil
+}
+
 type ShowConfigurationsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Pattern              string            `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
@@ -2603,7 +2910,7 @@ func (m *ShowConfigurationsRequest) Reset()         { *m = ShowConfigurationsReq
 func (m *ShowConfigurationsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowConfigurationsRequest) ProtoMessage()    {}
 func (*ShowConfigurationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{35}
+	return fileDescriptor_41f4a519b878ee3b, []int{37}
 }
 
 func (m *ShowConfigurationsRequest) XXX_Unmarshal(b []byte) error {
@@ -2650,7 +2957,7 @@ func (m *ShowConfigurationsResponse) Reset()         { *m = ShowConfigurationsRe
 func (m *ShowConfigurationsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowConfigurationsResponse) ProtoMessage()    {}
 func (*ShowConfigurationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{36}
+	return fileDescriptor_41f4a519b878ee3b, []int{38}
 }
 
 func (m *ShowConfigurationsResponse) XXX_Unmarshal(b []byte) error {
@@ -2685,6 +2992,211 @@ func (m *ShowConfigurationsResponse) GetConfiguations() []*commonpb.KeyValuePair
 	return nil
 }
 
+// ChangeEvent is an entry of the change stream captured from the dml channels, it's replayed into another cluster
+type ChangeEvent struct {
+	// the format version of the event, bumped on incompatible changes
+	Version int32            `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	MsgType commonpb.MsgType `protobuf:"varint,2,opt,name=msg_type,json=msgType,proto3,enum=milvus.proto.common.MsgType" json:"msg_type,omitempty"`
+	// empty for the ddl events, which are broadcast to all the channels of the collection
+	Vchannel  string `protobuf:"bytes,3,opt,name=vchannel,proto3" json:"vchannel,omitempty"`
+	Timestamp uint64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//	*ChangeEvent_Insert
+	//	*ChangeEvent_Delete
+	//	*ChangeEvent_CreateCollection
+	//	*ChangeEvent_DropCollection
+	//	*ChangeEvent_CreatePartition
+	//	*ChangeEvent_DropPartition
+	//	*ChangeEvent_Truncate
+	//	*ChangeEvent_AlterSchema
+	Payload              isChangeEvent_Payload `protobuf_oneof:"payload"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ChangeEvent) Reset()         { *m = ChangeEvent{} }
+func (m *ChangeEvent) String() string { return proto.CompactTextString(m) }
+func (*ChangeEvent) ProtoMessage()    {}
+func (*ChangeEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{39}
+}
+
+func (m *ChangeEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeEvent.Unmarshal(m, b)
+}
+func (m *ChangeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeEvent.Marshal(b, m, deterministic)
+}
+func (m *ChangeEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeEvent.Merge(m, src)
+}
+func (m *ChangeEvent) XXX_Size() int {
+	return xxx_messageInfo_ChangeEvent.Size(m)
+}
+func (m *ChangeEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeEvent proto.InternalMessageInfo
+
+func (m *ChangeEvent) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ChangeEvent) GetMsgType() commonpb.MsgType {
+	if m != nil {
+		return m.MsgType
+	}
+	return commonpb.MsgType_Undefined
+}
+
+func (m *ChangeEvent) GetVchannel() string {
+	if m != nil {
+		return m.Vchannel
+	}
+	return ""
+}
+
+func (m *ChangeEvent) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type isChangeEvent_Payload interface {
+	isChangeEvent_Payload()
+}
+
+type ChangeEvent_Insert struct {
+	Insert *InsertRequest `protobuf:"bytes,5,opt,name=insert,proto3,oneof"`
+}
+
+type ChangeEvent_Delete struct {
+	Delete *DeleteRequest `protobuf:"bytes,6,opt,name=delete,proto3,oneof"`
+}
+
+type ChangeEvent_CreateCollection struct {
+	CreateCollection *CreateCollectionRequest `protobuf:"bytes,7,opt,name=create_collection,json=createCollection,proto3,oneof"`
+}
+
+type ChangeEvent_DropCollection struct {
+	DropCollection *DropCollectionRequest `protobuf:"bytes,8,opt,name=drop_collection,json=dropCollection,proto3,oneof"`
+}
+
+type ChangeEvent_CreatePartition struct {
+	CreatePartition *CreatePartitionRequest `protobuf:"bytes,9,opt,name=create_partition,json=createPartition,proto3,oneof"`
+}
+
+type ChangeEvent_DropPartition struct {
+	DropPartition *DropPartitionRequest `protobuf:"bytes,10,opt,name=drop_partition,json=dropPartition,proto3,oneof"`
+}
+
+type ChangeEvent_Truncate struct {
+	Truncate *TruncateRequest `protobuf:"bytes,11,opt,name=truncate,proto3,oneof"`
+}
+
+type ChangeEvent_AlterSchema struct {
+	AlterSchema *AlterSchemaRequest `protobuf:"bytes,12,opt,name=alter_schema,json=alterSchema,proto3,oneof"`
+}
+
+func (*ChangeEvent_Insert) isChangeEvent_Payload() {}
+
+func (*ChangeEvent_Delete) isChangeEvent_Payload() {}
+
+func (*ChangeEvent_CreateCollection) isChangeEvent_Payload() {}
+
+func (*ChangeEvent_DropCollection) isChangeEvent_Payload() {}
+
+func (*ChangeEvent_CreatePartition) isChangeEvent_Payload() {}
+
+func (*ChangeEvent_DropPartition) isChangeEvent_Payload() {}
+
+func (*ChangeEvent_Truncate) isChangeEvent_Payload() {}
+
+func (*ChangeEvent_AlterSchema) isChangeEvent_Payload() {}
+
+func (m *ChangeEvent) GetPayload() isChangeEvent_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *ChangeEvent) GetInsert() *InsertRequest {
+	if x, ok := m.GetPayload().(*ChangeEvent_Insert); ok {
+		return x.Insert
+	}
+	return nil
+}
+
+func (m *ChangeEvent) GetDelete() *DeleteRequest {
+	if x, ok := m.GetPayload().(*ChangeEvent_Delete); ok {
+		return x.Delete
+	}
+	return nil
+}
+
+func (m *ChangeEvent) GetCreateCollection() *CreateCollectionRequest {
+	if x, ok := m.GetPayload().(*ChangeEvent_CreateCollection); ok {
+		return x.CreateCollection
+	}
+	return nil
+}
+
+func (m *ChangeEvent) GetDropCollection() *DropCollectionRequest {
+	if x, ok := m.GetPayload().(*ChangeEvent_DropCollection); ok {
+		return x.DropCollection
+	}
+	return nil
+}
+
+func (m *ChangeEvent) GetCreatePartition() *CreatePartitionRequest {
+	if x, ok := m.GetPayload().(*ChangeEvent_CreatePartition); ok {
+		return x.CreatePartition
+	}
+	return nil
+}
+
+func (m *ChangeEvent) GetDropPartition() *DropPartitionRequest {
+	if x, ok := m.GetPayload().(*ChangeEvent_DropPartition); ok {
+		return x.DropPartition
+	}
+	return nil
+}
+
+func (m *ChangeEvent) GetTruncate() *TruncateRequest {
+	if x, ok := m.GetPayload().(*ChangeEvent_Truncate); ok {
+		return x.Truncate
+	}
+	return nil
+}
+
+func (m *ChangeEvent) GetAlterSchema() *AlterSchemaRequest {
+	if x, ok := m.GetPayload().(*ChangeEvent_AlterSchema); ok {
+		return x.AlterSchema
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ChangeEvent) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ChangeEvent_Insert)(nil),
+		(*ChangeEvent_Delete)(nil),
+		(*ChangeEvent_CreateCollection)(nil),
+		(*ChangeEvent_DropCollection)(nil),
+		(*ChangeEvent_CreatePartition)(nil),
+		(*ChangeEvent_DropPartition)(nil),
+		(*ChangeEvent_Truncate)(nil),
+		(*ChangeEvent_AlterSchema)(nil),
+	}
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.internal.StateCode", StateCode_name, StateCode_value)
 	proto.RegisterEnum("milvus.proto.internal.InsertDataVersion", InsertDataVersion_name, InsertDataVersion_value)
@@ -2704,6 +3216,8 @@ func init() {
 	proto.RegisterType((*DropCollectionRequest)(nil), "milvus.proto.internal.DropCollectionRequest")
 	proto.RegisterType((*CreatePartitionRequest)(nil), "milvus.proto.internal.CreatePartitionRequest")
 	proto.RegisterType((*DropPartitionRequest)(nil), "milvus.proto.internal.DropPartitionRequest")
+	proto.RegisterType((*TruncateRequest)(nil), "milvus.proto.internal.TruncateRequest")
+	proto.RegisterType((*AlterSchemaRequest)(nil), "milvus.proto.internal.AlterSchemaRequest")
 	proto.RegisterType((*CreateAliasRequest)(nil), "milvus.proto.internal.CreateAliasRequest")
 	proto.RegisterType((*DropAliasRequest)(nil), "milvus.proto.internal.DropAliasRequest")
 	proto.RegisterType((*AlterAliasRequest)(nil), "milvus.proto.internal.AlterAliasRequest")
@@ -2725,149 +3239,183 @@ func init() {
 	proto.RegisterType((*ListPolicyResponse)(nil), "milvus.proto.internal.ListPolicyResponse")
 	proto.RegisterType((*ShowConfigurationsRequest)(nil), "milvus.proto.internal.ShowConfigurationsRequest")
 	proto.RegisterType((*ShowConfigurationsResponse)(nil), "milvus.proto.internal.ShowConfigurationsResponse")
+	proto.RegisterType((*ChangeEvent)(nil), "milvus.proto.internal.ChangeEvent")
 }
 
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 2211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcf, 0x73, 0x1b, 0x49,
-	0xf5, 0xdf, 0xd1, 0xc8, 0x96, 0xf4, 0x24, 0xcb, 0x72, 0xc7, 0xc9, 0x2a, 0x4e, 0xb2, 0xeb, 0xcc,
-	0x77, 0xbf, 0x60, 0x12, 0x36, 0x09, 0xde, 0xdd, 0x64, 0x0b, 0x28, 0x42, 0x62, 0x2d, 0xc1, 0x95,
-	0x1f, 0x98, 0x71, 0x48, 0x15, 0x5c, 0xa6, 0x5a, 0x9a, 0xb6, 0xd4, 0x64, 0x66, 0x7a, 0xdc, 0xdd,
-	0x63, 0x47, 0x39, 0x71, 0xe0, 0xc4, 0x16, 0xdc, 0xb8, 0x6c, 0x15, 0x9c, 0xb9, 0x70, 0xde, 0x1b,
-	0x54, 0x71, 0xe2, 0xc4, 0x89, 0x0b, 0xff, 0x0a, 0xc5, 0x81, 0xea, 0x1f, 0x33, 0xfa, 0x61, 0xd9,
-	0x91, 0x9d, 0xda, 0xdd, 0x50, 0xb5, 0xb7, 0xe9, 0xf7, 0x5e, 0x77, 0xbf, 0x7e, 0xef, 0xf3, 0x5e,
-	0xbf, 0xd7, 0x03, 0x4d, 0x9a, 0x48, 0xc2, 0x13, 0x1c, 0xdd, 0x48, 0x39, 0x93, 0x0c, 0x9d, 0x8f,
-	0x69, 0x74, 0x90, 0x09, 0x33, 0xba, 0x91, 0x33, 0xd7, 0x1a, 0x3d, 0x16, 0xc7, 0x2c, 0x31, 0xe4,
-	0xb5, 0x86, 0xe8, 0x0d, 0x48, 0x8c, 0xcd, 0xc8, 0xfb, 0x8b, 0x03, 0x4b, 0x5b, 0x2c, 0x4e, 0x59,
-	0x42, 0x12, 0xb9, 0x9d, 0xec, 0x31, 0x74, 0x01, 0x16, 0x13, 0x16, 0x92, 0xed, 0x4e, 0xdb, 0x59,
-	0x77, 0x36, 0x5c, 0xdf, 0x8e, 0x10, 0x82, 0x32, 0x67, 0x11, 0x69, 0x97, 0xd6, 0x9d, 0x8d, 0x9a,
-	0xaf, 0xbf, 0xd1, 0x5d, 0x00, 0x21, 0xb1, 0x24, 0x41, 0x8f, 0x85, 0xa4, 0xed, 0xae, 0x3b, 0x1b,
-	0xcd, 0xcd, 0xf5, 0x1b, 0x33, 0xb5, 0xb8, 0xb1, 0xab, 0x04, 0xb7, 0x58, 0x48, 0xfc, 0x9a, 0xc8,
-	0x3f, 0xd1, 0x0f, 0x01, 0xc8, 0x0b, 0xc9, 0x71, 0x40, 0x93, 0x3d, 0xd6, 0x2e, 0xaf, 0xbb, 0x1b,
-	0xf5, 0xcd, 0xab, 0x93, 0x0b, 0x58, 0xe5, 0x1f, 0x92, 0xe1, 0x33, 0x1c, 0x65, 0x64, 0x07, 0x53,
-	0xee, 0xd7, 0xf4, 0x24, 0xa5, 0xae, 0xf7, 0x2f, 0x07, 0x96, 0x8b, 0x03, 0xe8, 0x3d, 0x04, 0xfa,
-	0x2e, 0x2c, 0xe8, 0x2d, 0xf4, 0x09, 0xea, 0x9b, 0xef, 0x1d, 0xa3, 0xd1, 0xc4, 0xb9, 0x7d, 0x33,
-	0x05, 0xfd, 0x0c, 0xce, 0x89, 0xac, 0xdb, 0xcb, 0x59, 0x81, 0xa6, 0x8a, 0x76, 0x49, 0xab, 0x36,
-	0xdf, 0x4a, 0x68, 0x7c, 0x01, 0xab, 0xd2, 0x07, 0xb0, 0xa8, 0x56, 0xca, 0x84, 0xb6, 0x52, 0x7d,
-	0xf3, 0xd2, 0xcc, 0x43, 0xee, 0x6a, 0x11, 0xdf, 0x8a, 0x7a, 0x97, 0xe0, 0xe2, 0x03, 0x22, 0xa7,
-	0x4e, 0xe7, 0x93, 0xfd, 0x8c, 0x08, 0x69, 0x99, 0x4f, 0x69, 0x4c, 0x9e, 0xd2, 0xde, 0xf3, 0xad,
-	0x01, 0x4e, 0x12, 0x12, 0xe5, 0xcc, 0x2b, 0x70, 0xe9, 0x01, 0xd1, 0x13, 0xa8, 0x90, 0xb4, 0x27,
-	0xa6, 0xd8, 0xe7, 0xe1, 0xdc, 0x03, 0x22, 0x3b, 0xe1, 0x14, 0xf9, 0x19, 0x54, 0x9f, 0x28, 0x67,
-	0x2b, 0x18, 0xdc, 0x86, 0x0a, 0x0e, 0x43, 0x4e, 0x84, 0xb0, 0x56, 0xbc, 0x3c, 0x53, 0xe3, 0x7b,
-	0x46, 0xc6, 0xcf, 0x85, 0x67, 0xc1, 0xc4, 0xfb, 0x25, 0xc0, 0x76, 0x42, 0xe5, 0x0e, 0xe6, 0x38,
-	0x16, 0xc7, 0x02, 0xac, 0x03, 0x0d, 0x21, 0x31, 0x97, 0x41, 0xaa, 0xe5, 0xac, 0xc9, 0xe7, 0x40,
-	0x43, 0x5d, 0x4f, 0x33, 0xab, 0x7b, 0x3f, 0x07, 0xd8, 0x95, 0x9c, 0x26, 0xfd, 0x47, 0x54, 0x48,
-	0xb5, 0xd7, 0x81, 0x92, 0x53, 0x87, 0x70, 0x37, 0x6a, 0xbe, 0x1d, 0x8d, 0xb9, 0xa3, 0x34, 0xbf,
-	0x3b, 0xee, 0x42, 0x3d, 0x37, 0xf7, 0x63, 0xd1, 0x47, 0xb7, 0xa0, 0xdc, 0xc5, 0x82, 0x9c, 0x68,
-	0x9e, 0xc7, 0xa2, 0x7f, 0x1f, 0x0b, 0xe2, 0x6b, 0x49, 0xef, 0xcf, 0x25, 0x58, 0x9d, 0x70, 0x8b,
-	0x35, 0xfc, 0xe9, 0x97, 0x52, 0x66, 0x0e, 0xbb, 0xdb, 0x1d, 0xad, 0xbe, 0xeb, 0xeb, 0x6f, 0xe4,
-	0x41, 0xa3, 0xc7, 0xa2, 0x88, 0xf4, 0x24, 0x65, 0xc9, 0x76, 0x47, 0x23, 0xcd, 0xf5, 0x27, 0x68,
-	0x4a, 0x26, 0xc5, 0x5c, 0x52, 0x33, 0x14, 0x3a, 0xe4, 0x5c, 0x7f, 0x82, 0x86, 0xbe, 0x05, 0x2d,
-	0xc9, 0xf1, 0x01, 0x89, 0x02, 0x49, 0x63, 0x22, 0x24, 0x8e, 0xd3, 0xf6, 0xc2, 0xba, 0xb3, 0x51,
-	0xf6, 0x97, 0x0d, 0xfd, 0x69, 0x4e, 0x46, 0x37, 0xe1, 0x5c, 0x3f, 0xc3, 0x1c, 0x27, 0x92, 0x90,
-	0x31, 0xe9, 0x45, 0x2d, 0x8d, 0x0a, 0xd6, 0x68, 0xc2, 0x75, 0x58, 0x51, 0x62, 0x2c, 0x93, 0x63,
-	0xe2, 0x15, 0x2d, 0xde, 0xb2, 0x8c, 0x42, 0xd8, 0xfb, 0xdc, 0x81, 0xf3, 0x53, 0xf6, 0x12, 0x29,
-	0x4b, 0x04, 0x39, 0x83, 0xc1, 0xce, 0xe2, 0x71, 0x74, 0xc7, 0x24, 0x12, 0x15, 0xb4, 0x73, 0x62,
-	0xd1, 0xc8, 0x7b, 0xbf, 0x71, 0xe1, 0xed, 0x2d, 0x4e, 0x74, 0x9a, 0xcb, 0xad, 0x7f, 0x76, 0x67,
-	0xbf, 0x0d, 0x95, 0xb0, 0x1b, 0x24, 0x38, 0xce, 0xc3, 0x6a, 0x31, 0xec, 0x3e, 0xc1, 0x31, 0x41,
-	0xdf, 0x80, 0xe6, 0xc8, 0xbb, 0x8a, 0xa2, 0x7d, 0x5e, 0xf3, 0xa7, 0xa8, 0xe8, 0x3d, 0x58, 0x2a,
-	0x3c, 0xac, 0xc5, 0xca, 0x5a, 0x6c, 0x92, 0x58, 0x60, 0x6a, 0xe1, 0x04, 0x4c, 0x2d, 0xce, 0xc0,
-	0xd4, 0x3a, 0xd4, 0xc7, 0xf0, 0xa3, 0xbd, 0xe9, 0xfa, 0xe3, 0x24, 0x15, 0x86, 0xe6, 0xd6, 0x69,
-	0x57, 0xd7, 0x9d, 0x8d, 0x86, 0x6f, 0x47, 0xe8, 0x16, 0x9c, 0x3b, 0xa0, 0x5c, 0x66, 0x38, 0xb2,
-	0x99, 0x48, 0xe9, 0x21, 0xda, 0x35, 0x1d, 0xab, 0xb3, 0x58, 0x68, 0x13, 0x56, 0xd3, 0xc1, 0x50,
-	0xd0, 0xde, 0xd4, 0x14, 0xd0, 0x53, 0x66, 0xf2, 0xbc, 0xbf, 0x39, 0x70, 0xbe, 0xc3, 0x59, 0xfa,
-	0x46, 0xb8, 0x22, 0x37, 0x72, 0xf9, 0x04, 0x23, 0x2f, 0x1c, 0x35, 0xb2, 0xf7, 0xdb, 0x12, 0x5c,
-	0x30, 0x88, 0xda, 0xc9, 0x0d, 0xfb, 0x05, 0x9c, 0xe2, 0x9b, 0xb0, 0x3c, 0xda, 0xd5, 0x08, 0xcc,
-	0x3e, 0xc6, 0xff, 0x43, 0xb3, 0x70, 0xb0, 0x91, 0xfb, 0x72, 0x21, 0xe5, 0x7d, 0x5a, 0x82, 0x55,
-	0xe5, 0xd4, 0xaf, 0xad, 0xa1, 0xac, 0xf1, 0x47, 0x07, 0x90, 0x41, 0xc7, 0xbd, 0x88, 0x62, 0xf1,
-	0x55, 0xda, 0x62, 0x15, 0x16, 0xb0, 0xd2, 0xc1, 0x9a, 0xc0, 0x0c, 0x3c, 0x01, 0x2d, 0xe5, 0xad,
-	0x2f, 0x4a, 0xbb, 0x62, 0x53, 0x77, 0x7c, 0xd3, 0x3f, 0x38, 0xb0, 0x72, 0x2f, 0x92, 0x84, 0xbf,
-	0xa1, 0x46, 0xf9, 0x6b, 0x29, 0xf7, 0xda, 0x76, 0x12, 0x92, 0x17, 0x5f, 0xa5, 0x82, 0x57, 0x00,
-	0xf6, 0x28, 0x89, 0xc2, 0x71, 0xf4, 0xd6, 0x34, 0xe5, 0xb5, 0x90, 0xdb, 0x86, 0x8a, 0x5e, 0xa4,
-	0x40, 0x6d, 0x3e, 0x54, 0xd5, 0x9e, 0xa9, 0xfc, 0x6d, 0xb5, 0x57, 0x9d, 0xbb, 0xda, 0xd3, 0xd3,
-	0x6c, 0xb5, 0xf7, 0x8f, 0x32, 0x2c, 0x6d, 0x27, 0x82, 0x70, 0x79, 0x76, 0xe3, 0x5d, 0x86, 0x9a,
-	0x18, 0x60, 0xae, 0x0f, 0x6a, 0xcd, 0x37, 0x22, 0x8c, 0x9b, 0xd6, 0x7d, 0x95, 0x69, 0xcb, 0x73,
-	0x26, 0x87, 0x85, 0x93, 0x92, 0xc3, 0xe2, 0x09, 0x26, 0xae, 0xbc, 0x3a, 0x39, 0x54, 0x8f, 0xde,
-	0xbe, 0xea, 0x80, 0xa4, 0x1f, 0xab, 0xf6, 0xa4, 0xd3, 0xae, 0x69, 0xfe, 0x88, 0x80, 0xde, 0x01,
-	0x28, 0x2a, 0x31, 0x73, 0x8f, 0x96, 0xfd, 0x31, 0x8a, 0xba, 0xbb, 0x39, 0x3b, 0x54, 0xb5, 0x62,
-	0x5d, 0xd7, 0x8a, 0x76, 0x84, 0x3e, 0x84, 0x2a, 0x67, 0x87, 0x41, 0x88, 0x25, 0x6e, 0x37, 0xb4,
-	0xf3, 0x2e, 0xce, 0x34, 0xf6, 0xfd, 0x88, 0x75, 0xfd, 0x0a, 0x67, 0x87, 0x1d, 0x2c, 0x31, 0xba,
-	0x0b, 0x75, 0x8d, 0x00, 0x61, 0x26, 0x2e, 0xe9, 0x89, 0xef, 0x4c, 0x4e, 0xb4, 0x0d, 0xea, 0x8f,
-	0x94, 0x9c, 0x9a, 0xe4, 0x1b, 0x68, 0x0a, 0xbd, 0xc0, 0x45, 0xa8, 0x26, 0x59, 0x1c, 0x70, 0x76,
-	0x28, 0xda, 0x4d, 0x5d, 0x37, 0x56, 0x92, 0x2c, 0xf6, 0xd9, 0xa1, 0x40, 0xf7, 0xa1, 0x72, 0x40,
-	0xb8, 0xa0, 0x2c, 0x69, 0x2f, 0xeb, 0x56, 0x74, 0xe3, 0x98, 0x76, 0xcd, 0x20, 0x46, 0x2d, 0xf7,
-	0xcc, 0xc8, 0xfb, 0xf9, 0x44, 0xef, 0x9f, 0x65, 0x58, 0xda, 0x25, 0x98, 0xf7, 0x06, 0x67, 0x07,
-	0xd4, 0x2a, 0x2c, 0x70, 0xb2, 0x5f, 0x14, 0xe7, 0x66, 0x50, 0xf8, 0xd7, 0x3d, 0xc1, 0xbf, 0xe5,
-	0x39, 0x2a, 0xf6, 0x85, 0x19, 0x15, 0x7b, 0x0b, 0xdc, 0x50, 0x44, 0x1a, 0x3a, 0x35, 0x5f, 0x7d,
-	0xaa, 0x3a, 0x3b, 0x8d, 0x70, 0x8f, 0x0c, 0x58, 0x14, 0x12, 0x1e, 0xf4, 0x39, 0xcb, 0x4c, 0x9d,
-	0xdd, 0xf0, 0x5b, 0x63, 0x8c, 0x07, 0x8a, 0x8e, 0xee, 0x40, 0x35, 0x14, 0x51, 0x20, 0x87, 0x29,
-	0xd1, 0xf8, 0x69, 0x1e, 0x73, 0xcc, 0x8e, 0x88, 0x9e, 0x0e, 0x53, 0xe2, 0x57, 0x42, 0xf3, 0x81,
-	0x6e, 0xc1, 0xaa, 0x20, 0x9c, 0xe2, 0x88, 0xbe, 0x24, 0x61, 0x40, 0x5e, 0xa4, 0x3c, 0x48, 0x23,
-	0x9c, 0x68, 0x90, 0x35, 0x7c, 0x34, 0xe2, 0x7d, 0xf2, 0x22, 0xe5, 0x3b, 0x11, 0x4e, 0xd0, 0x06,
-	0xb4, 0x58, 0x26, 0xd3, 0x4c, 0x06, 0x16, 0x06, 0x34, 0xd4, 0x98, 0x73, 0xfd, 0xa6, 0xa1, 0x6b,
-	0xaf, 0x8b, 0xed, 0x70, 0x66, 0x17, 0x52, 0x3f, 0x55, 0x17, 0xd2, 0x38, 0x5d, 0x17, 0xb2, 0x34,
-	0xbb, 0x0b, 0x41, 0x4d, 0x28, 0x25, 0xfb, 0x1a, 0x6b, 0xae, 0x5f, 0x4a, 0xf6, 0x95, 0x23, 0x25,
-	0x4b, 0x9f, 0x6b, 0x8c, 0xb9, 0xbe, 0xfe, 0x56, 0x41, 0x14, 0x13, 0xc9, 0x69, 0x4f, 0x99, 0xa5,
-	0xdd, 0xd2, 0x7e, 0x18, 0xa3, 0x78, 0xff, 0x71, 0x47, 0xb0, 0x12, 0x59, 0x24, 0xc5, 0x97, 0xd5,
-	0xc1, 0x14, 0x58, 0x74, 0xc7, 0xb1, 0xf8, 0x2e, 0xd4, 0x8d, 0x72, 0xc6, 0xe7, 0xe5, 0x69, 0x7d,
-	0x95, 0x80, 0x8a, 0xb2, 0xfd, 0x8c, 0x70, 0x4a, 0x84, 0x4d, 0xfb, 0x90, 0x64, 0xf1, 0x4f, 0x0d,
-	0x05, 0x9d, 0x83, 0x05, 0xc9, 0xd2, 0xe0, 0x79, 0x9e, 0xae, 0x24, 0x4b, 0x1f, 0xa2, 0xef, 0xc3,
-	0x9a, 0x20, 0x38, 0x22, 0x61, 0x50, 0xa4, 0x17, 0x11, 0x08, 0x7d, 0x6c, 0x12, 0xb6, 0x2b, 0xda,
-	0xcd, 0x6d, 0x23, 0xb1, 0x5b, 0x08, 0xec, 0x5a, 0xbe, 0xf2, 0x62, 0xcf, 0x94, 0xed, 0x13, 0xd3,
-	0xaa, 0xba, 0xb2, 0x47, 0x23, 0x56, 0x31, 0xe1, 0x63, 0x68, 0xf7, 0x23, 0xd6, 0xc5, 0x51, 0x70,
-	0x64, 0x57, 0xdd, 0x42, 0xb8, 0xfe, 0x05, 0xc3, 0xdf, 0x9d, 0xda, 0x52, 0x1d, 0x4f, 0x44, 0xb4,
-	0x47, 0xc2, 0xa0, 0x1b, 0xb1, 0x6e, 0x1b, 0x34, 0x5c, 0xc1, 0x90, 0x54, 0xbe, 0x52, 0x30, 0xb5,
-	0x02, 0xca, 0x0c, 0x3d, 0x96, 0x25, 0x52, 0x83, 0xcf, 0xf5, 0x9b, 0x86, 0xfe, 0x24, 0x8b, 0xb7,
-	0x14, 0x15, 0xfd, 0x1f, 0x2c, 0x59, 0x49, 0xb6, 0xb7, 0x27, 0x88, 0xd4, 0xa8, 0x73, 0xfd, 0x86,
-	0x21, 0xfe, 0x44, 0xd3, 0xbc, 0xcf, 0x5c, 0x58, 0xf6, 0x95, 0x75, 0xc9, 0x01, 0xf9, 0x5f, 0xca,
-	0x2b, 0xc7, 0xc5, 0xf7, 0xe2, 0xa9, 0xe2, 0xbb, 0x32, 0x77, 0x7c, 0x57, 0x4f, 0x15, 0xdf, 0xb5,
-	0xd3, 0xc5, 0x37, 0x1c, 0xf3, 0xca, 0xf0, 0xa7, 0x09, 0xe7, 0xbc, 0x01, 0xd1, 0x79, 0x0d, 0x5c,
-	0x1a, 0x9a, 0x52, 0xb1, 0xbe, 0xd9, 0x9e, 0x79, 0x37, 0x6e, 0x77, 0x84, 0xaf, 0x84, 0xa6, 0xef,
-	0xd3, 0x85, 0x53, 0xdf, 0xa7, 0x3f, 0x80, 0x4b, 0x47, 0x63, 0x96, 0x5b, 0x73, 0x84, 0xed, 0x45,
-	0xed, 0xbb, 0x8b, 0xd3, 0x41, 0x9b, 0xdb, 0x2b, 0x44, 0xdf, 0x81, 0xd5, 0xb1, 0xa8, 0x1d, 0x4d,
-	0xac, 0x98, 0x1e, 0x7e, 0xc4, 0x1b, 0x4d, 0x39, 0x29, 0x6e, 0xab, 0x27, 0xc5, 0xad, 0xf7, 0x77,
-	0x17, 0x96, 0x3a, 0x24, 0x22, 0x92, 0x7c, 0x5d, 0xee, 0x1d, 0x5b, 0xee, 0x7d, 0x1b, 0x10, 0x4d,
-	0xe4, 0xed, 0x0f, 0x83, 0x94, 0xd3, 0x18, 0xf3, 0x61, 0xf0, 0x9c, 0x0c, 0xf3, 0x84, 0xd8, 0xd2,
-	0x9c, 0x1d, 0xc3, 0x78, 0x48, 0x86, 0xe2, 0x95, 0xe5, 0xdf, 0x78, 0xbd, 0x65, 0x32, 0x60, 0x51,
-	0x6f, 0x7d, 0x0f, 0x1a, 0x13, 0x5b, 0x34, 0x5e, 0x01, 0xd8, 0x7a, 0x3a, 0xda, 0xd7, 0xfb, 0xb7,
-	0x03, 0xb5, 0x47, 0x0c, 0x87, 0xba, 0xf3, 0x39, 0xa3, 0x1b, 0x8b, 0xa2, 0xb6, 0x34, 0x5d, 0xd4,
-	0x5e, 0x86, 0x51, 0xf3, 0x62, 0x1d, 0x39, 0xd6, 0xcd, 0x8c, 0x75, 0x25, 0xe5, 0xc9, 0xae, 0xe4,
-	0x5d, 0xa8, 0x53, 0xa5, 0x50, 0x90, 0x62, 0x39, 0x30, 0x39, 0xb1, 0xe6, 0x83, 0x26, 0xed, 0x28,
-	0x8a, 0x6a, 0x5b, 0x72, 0x01, 0xdd, 0xb6, 0x2c, 0xce, 0xdd, 0xb6, 0xd8, 0x45, 0x74, 0xdb, 0xf2,
-	0x6b, 0x07, 0x40, 0x1f, 0x5c, 0xe5, 0x83, 0xa3, 0x8b, 0x3a, 0x67, 0x59, 0x54, 0x25, 0x6b, 0xed,
-	0x29, 0x12, 0x61, 0x39, 0x0a, 0x2a, 0x61, 0x8d, 0x83, 0x94, 0xd7, 0x0c, 0xcb, 0x06, 0x94, 0xf0,
-	0x7e, 0xe7, 0x00, 0xe8, 0xac, 0x60, 0xd4, 0x98, 0x86, 0x9f, 0x73, 0x72, 0x43, 0x57, 0x9a, 0x34,
-	0xdd, 0xfd, 0xdc, 0x74, 0x27, 0xbc, 0x98, 0x8e, 0x55, 0xe0, 0xf9, 0xe1, 0xad, 0x75, 0xf5, 0xb7,
-	0xf7, 0x7b, 0x07, 0x1a, 0x56, 0x3b, 0xa3, 0xd2, 0x84, 0x97, 0x9d, 0x69, 0x2f, 0xeb, 0x32, 0x26,
-	0x66, 0x7c, 0x18, 0x08, 0xfa, 0x92, 0x58, 0x85, 0xc0, 0x90, 0x76, 0xe9, 0x4b, 0x32, 0x01, 0x5e,
-	0x77, 0x12, 0xbc, 0xd7, 0x61, 0x85, 0x93, 0x1e, 0x49, 0x64, 0x34, 0x0c, 0x62, 0x16, 0xd2, 0x3d,
-	0x4a, 0x42, 0x8d, 0x86, 0xaa, 0xdf, 0xca, 0x19, 0x8f, 0x2d, 0xdd, 0xfb, 0x95, 0x03, 0xf5, 0xc7,
-	0xa2, 0xbf, 0xc3, 0x84, 0x0e, 0x32, 0x74, 0x15, 0x1a, 0x36, 0xb1, 0x99, 0x08, 0x77, 0x34, 0xc2,
-	0xea, 0xbd, 0xd1, 0xab, 0xa3, 0x4a, 0xed, 0xb1, 0xe8, 0x5b, 0x33, 0x35, 0x7c, 0x33, 0x40, 0x6b,
-	0x50, 0x8d, 0x45, 0x5f, 0x57, 0xdd, 0x16, 0x96, 0xc5, 0x58, 0x9d, 0x75, 0x74, 0x59, 0x95, 0xf5,
-	0x65, 0x35, 0x22, 0x78, 0x9f, 0x3b, 0x80, 0xec, 0xab, 0xe6, 0x6b, 0xfd, 0x84, 0xd0, 0x5e, 0x1e,
-	0x7f, 0x39, 0x2d, 0x69, 0x8c, 0x4f, 0xd0, 0xa6, 0x92, 0x82, 0x7b, 0x24, 0x29, 0x5c, 0x87, 0x95,
-	0x90, 0xec, 0xe1, 0x2c, 0x1a, 0xbf, 0x5f, 0x8d, 0xca, 0x2d, 0xcb, 0x98, 0x78, 0xc5, 0x6f, 0x6e,
-	0x71, 0x12, 0x92, 0x44, 0x52, 0x1c, 0xe9, 0x9f, 0x4b, 0x6b, 0x50, 0xcd, 0x84, 0x42, 0x42, 0x61,
-	0xbb, 0x62, 0x8c, 0xde, 0x07, 0x44, 0x92, 0x1e, 0x1f, 0xa6, 0x0a, 0xc4, 0x29, 0x16, 0xe2, 0x90,
-	0xf1, 0xd0, 0x26, 0xea, 0x95, 0x82, 0xb3, 0x63, 0x19, 0xaa, 0x3d, 0x95, 0x24, 0xc1, 0x89, 0xcc,
-	0xf3, 0xb5, 0x19, 0x29, 0xd7, 0x53, 0x11, 0x88, 0x2c, 0x25, 0xdc, 0xba, 0xb5, 0x42, 0xc5, 0xae,
-	0x1a, 0xaa, 0x54, 0x2e, 0x06, 0x78, 0xf3, 0xa3, 0xdb, 0xa3, 0xe5, 0x4d, 0x8a, 0x6e, 0x1a, 0x72,
-	0xbe, 0xb6, 0xf7, 0x09, 0xac, 0x3c, 0xa2, 0x42, 0xee, 0xb0, 0x88, 0xf6, 0x86, 0x67, 0xbe, 0x71,
-	0xbc, 0x4f, 0x1d, 0x40, 0xe3, 0xeb, 0xd8, 0x7f, 0x18, 0xa3, 0x8a, 0xc1, 0x99, 0xbf, 0x62, 0xb8,
-	0x0a, 0x8d, 0x54, 0x2f, 0xa3, 0xff, 0x98, 0xe6, 0xde, 0xab, 0x1b, 0x9a, 0xb2, 0xad, 0x40, 0x57,
-	0x00, 0x94, 0x31, 0x03, 0xce, 0x22, 0x62, 0x9c, 0x57, 0xf3, 0x6b, 0x8a, 0xe2, 0x2b, 0x82, 0xd7,
-	0x87, 0x8b, 0xbb, 0x03, 0x76, 0xb8, 0xc5, 0x92, 0x3d, 0xda, 0xcf, 0x38, 0x56, 0x80, 0x7e, 0x8d,
-	0xb7, 0xb1, 0x36, 0x54, 0x52, 0x2c, 0x55, 0x58, 0x5b, 0x1f, 0xe5, 0x43, 0xef, 0x33, 0x07, 0xd6,
-	0x66, 0xed, 0xf4, 0x3a, 0xc7, 0x7f, 0x00, 0x4b, 0x3d, 0xb3, 0x9c, 0x59, 0x6d, 0xfe, 0x9f, 0x84,
-	0x93, 0xf3, 0xae, 0x7d, 0x0c, 0xb5, 0xe2, 0x87, 0x34, 0x6a, 0x41, 0x63, 0x3b, 0xa1, 0x52, 0xd7,
-	0xb2, 0x34, 0xe9, 0xb7, 0xde, 0x42, 0x75, 0xa8, 0xfc, 0x98, 0xe0, 0x48, 0x0e, 0x86, 0x2d, 0x07,
-	0x35, 0xa0, 0x7a, 0xaf, 0x9b, 0x30, 0x1e, 0xe3, 0xa8, 0x55, 0xba, 0xb6, 0x09, 0x2b, 0x47, 0xde,
-	0x0f, 0x94, 0x88, 0xcf, 0x0e, 0x95, 0x59, 0xc2, 0xd6, 0x5b, 0x68, 0x19, 0xea, 0x5b, 0x2c, 0xca,
-	0xe2, 0xc4, 0x10, 0x9c, 0xfb, 0x77, 0x7e, 0xf1, 0x51, 0x9f, 0xca, 0x41, 0xd6, 0x55, 0xaa, 0xdd,
-	0x34, 0xba, 0xbe, 0x4f, 0x99, 0xfd, 0xba, 0x99, 0xa7, 0xc5, 0x9b, 0x5a, 0xfd, 0x62, 0x98, 0x76,
-	0xbb, 0x8b, 0x9a, 0xf2, 0xc1, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x10, 0x68, 0x86, 0x3f, 0xea,
-	0x1f, 0x00, 0x00,
+	// 2737 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcf, 0x6f, 0x24, 0x47,
+	0xf5, 0x77, 0x4f, 0x8f, 0xe7, 0xc7, 0x9b, 0xf1, 0x78, 0x5c, 0xeb, 0xdd, 0xf4, 0xee, 0xe6, 0x87,
+	0xd3, 0xdf, 0x24, 0x5f, 0x27, 0x9b, 0xec, 0x06, 0xe7, 0xa7, 0x80, 0x24, 0xac, 0xed, 0x65, 0x6d,
+	0x25, 0xbb, 0x98, 0xf2, 0x12, 0x44, 0x24, 0xd4, 0xaa, 0x99, 0x2e, 0x8f, 0x9b, 0xed, 0x5f, 0x5b,
+	0x55, 0x63, 0xef, 0xe4, 0xc4, 0x81, 0x53, 0x10, 0x1c, 0x90, 0xb8, 0x20, 0xc1, 0x8d, 0x23, 0x67,
+	0x0e, 0x48, 0x20, 0x21, 0x0e, 0x9c, 0x38, 0x21, 0x24, 0xfe, 0x02, 0xfe, 0x07, 0x4e, 0xa8, 0x5e,
+	0x75, 0xcf, 0xf4, 0x8c, 0xc7, 0xb3, 0x63, 0xaf, 0x92, 0x6c, 0x10, 0xb7, 0xae, 0xf7, 0xab, 0xaa,
+	0xde, 0x7b, 0xf5, 0xa9, 0x7a, 0x55, 0x0d, 0xad, 0x20, 0x56, 0x5c, 0xc4, 0x2c, 0xbc, 0x9e, 0x8a,
+	0x44, 0x25, 0xe4, 0x62, 0x14, 0x84, 0x47, 0x7d, 0x69, 0x5a, 0xd7, 0x73, 0xe6, 0x95, 0x66, 0x37,
+	0x89, 0xa2, 0x24, 0x36, 0xe4, 0x2b, 0x4d, 0xd9, 0x3d, 0xe4, 0x11, 0xcb, 0x5b, 0x45, 0x15, 0xf7,
+	0x8f, 0x16, 0x2c, 0x6d, 0x25, 0x51, 0x9a, 0xc4, 0x3c, 0x56, 0xbb, 0xf1, 0x41, 0x42, 0x2e, 0x41,
+	0x25, 0x4e, 0x7c, 0xbe, 0xbb, 0xed, 0x58, 0x6b, 0xd6, 0xba, 0x4d, 0xb3, 0x16, 0x21, 0x50, 0x16,
+	0x49, 0xc8, 0x9d, 0xd2, 0x9a, 0xb5, 0x5e, 0xa7, 0xf8, 0x4d, 0x3e, 0x00, 0x90, 0x8a, 0x29, 0xee,
+	0x75, 0x13, 0x9f, 0x3b, 0xf6, 0x9a, 0xb5, 0xde, 0xda, 0x58, 0xbb, 0x3e, 0x75, 0x4c, 0xd7, 0xf7,
+	0xb5, 0xe0, 0x56, 0xe2, 0x73, 0x5a, 0x97, 0xf9, 0x27, 0xf9, 0x16, 0x00, 0x7f, 0xa8, 0x04, 0xf3,
+	0x82, 0xf8, 0x20, 0x71, 0xca, 0x6b, 0xf6, 0x7a, 0x63, 0xe3, 0xf9, 0x71, 0x03, 0xd9, 0x54, 0x3e,
+	0xe4, 0x83, 0x8f, 0x59, 0xd8, 0xe7, 0x7b, 0x2c, 0x10, 0xb4, 0x8e, 0x4a, 0x7a, 0xb8, 0xee, 0x3f,
+	0x2d, 0x58, 0x1e, 0x4e, 0x00, 0xfb, 0x90, 0xe4, 0xeb, 0xb0, 0x88, 0x5d, 0xe0, 0x0c, 0x1a, 0x1b,
+	0x2f, 0x9c, 0x32, 0xa2, 0xb1, 0x79, 0x53, 0xa3, 0x42, 0xbe, 0x07, 0x17, 0x64, 0xbf, 0xd3, 0xcd,
+	0x59, 0x1e, 0x52, 0xa5, 0x53, 0x5a, 0xb3, 0xe7, 0xb6, 0x44, 0x8a, 0x06, 0xb2, 0x21, 0xbd, 0x01,
+	0x15, 0x6d, 0xa9, 0x2f, 0xd1, 0x4b, 0x8d, 0x8d, 0xab, 0x53, 0x27, 0xb9, 0x8f, 0x22, 0x34, 0x13,
+	0x75, 0xaf, 0xc2, 0xe5, 0xdb, 0x5c, 0x4d, 0xcc, 0x8e, 0xf2, 0x07, 0x7d, 0x2e, 0x55, 0xc6, 0xbc,
+	0x17, 0x44, 0xfc, 0x5e, 0xd0, 0xbd, 0xbf, 0x75, 0xc8, 0xe2, 0x98, 0x87, 0x39, 0xf3, 0x19, 0xb8,
+	0x7a, 0x9b, 0xa3, 0x42, 0x20, 0x55, 0xd0, 0x95, 0x13, 0xec, 0x8b, 0x70, 0xe1, 0x36, 0x57, 0xdb,
+	0xfe, 0x04, 0xf9, 0x63, 0xa8, 0xdd, 0xd5, 0xc1, 0xd6, 0x69, 0xf0, 0x36, 0x54, 0x99, 0xef, 0x0b,
+	0x2e, 0x65, 0xe6, 0xc5, 0xa7, 0xa7, 0x8e, 0xf8, 0xa6, 0x91, 0xa1, 0xb9, 0xf0, 0xb4, 0x34, 0x71,
+	0x7f, 0x04, 0xb0, 0x1b, 0x07, 0x6a, 0x8f, 0x09, 0x16, 0xc9, 0x53, 0x13, 0x6c, 0x1b, 0x9a, 0x52,
+	0x31, 0xa1, 0xbc, 0x14, 0xe5, 0x9c, 0xd2, 0xbc, 0xd9, 0xd0, 0x40, 0x35, 0x63, 0xdd, 0xfd, 0x01,
+	0xc0, 0xbe, 0x12, 0x41, 0xdc, 0xfb, 0x28, 0x90, 0x4a, 0xf7, 0x75, 0xa4, 0xe5, 0xf4, 0x24, 0xec,
+	0xf5, 0x3a, 0xcd, 0x5a, 0x85, 0x70, 0x94, 0xe6, 0x0f, 0xc7, 0x07, 0xd0, 0xc8, 0xdd, 0x7d, 0x47,
+	0xf6, 0xc8, 0xeb, 0x50, 0xee, 0x30, 0xc9, 0x67, 0xba, 0xe7, 0x8e, 0xec, 0x6d, 0x32, 0xc9, 0x29,
+	0x4a, 0xba, 0xbf, 0x2b, 0xc1, 0xea, 0x58, 0x58, 0x32, 0xc7, 0x9f, 0xdd, 0x94, 0x76, 0xb3, 0xdf,
+	0xd9, 0xdd, 0xc6, 0xe1, 0xdb, 0x14, 0xbf, 0x89, 0x0b, 0xcd, 0x6e, 0x12, 0x86, 0xbc, 0xab, 0x82,
+	0x24, 0xde, 0xdd, 0xc6, 0x4c, 0xb3, 0xe9, 0x18, 0x4d, 0xcb, 0xa4, 0x4c, 0xa8, 0xc0, 0x34, 0x25,
+	0x2e, 0x39, 0x9b, 0x8e, 0xd1, 0xc8, 0xcb, 0xd0, 0x56, 0x82, 0x1d, 0xf1, 0xd0, 0x53, 0x41, 0xc4,
+	0xa5, 0x62, 0x51, 0xea, 0x2c, 0xae, 0x59, 0xeb, 0x65, 0xba, 0x6c, 0xe8, 0xf7, 0x72, 0x32, 0xb9,
+	0x01, 0x17, 0x7a, 0x7d, 0x26, 0x58, 0xac, 0x38, 0x2f, 0x48, 0x57, 0x50, 0x9a, 0x0c, 0x59, 0x23,
+	0x85, 0x6b, 0xb0, 0xa2, 0xc5, 0x92, 0xbe, 0x2a, 0x88, 0x57, 0x51, 0xbc, 0x9d, 0x31, 0x86, 0xc2,
+	0xee, 0xef, 0x2d, 0xb8, 0x38, 0xe1, 0x2f, 0x99, 0x26, 0xb1, 0xe4, 0xe7, 0x70, 0xd8, 0x79, 0x22,
+	0x4e, 0xde, 0x31, 0x40, 0xa2, 0x17, 0xed, 0x9c, 0xb9, 0x68, 0xe4, 0xdd, 0xcf, 0x6c, 0x78, 0x6a,
+	0x4b, 0x70, 0x84, 0xb9, 0xdc, 0xfb, 0xe7, 0x0f, 0xf6, 0x53, 0x50, 0xf5, 0x3b, 0x5e, 0xcc, 0xa2,
+	0x7c, 0x59, 0x55, 0xfc, 0xce, 0x5d, 0x16, 0x71, 0xf2, 0x12, 0xb4, 0x46, 0xd1, 0xd5, 0x14, 0x8c,
+	0x79, 0x9d, 0x4e, 0x50, 0xc9, 0x0b, 0xb0, 0x34, 0x8c, 0x30, 0x8a, 0x95, 0x51, 0x6c, 0x9c, 0x38,
+	0xcc, 0xa9, 0xc5, 0x19, 0x39, 0x55, 0x99, 0x92, 0x53, 0x6b, 0xd0, 0x28, 0xe4, 0x0f, 0x46, 0xd3,
+	0xa6, 0x45, 0x92, 0x5e, 0x86, 0x66, 0x0f, 0x72, 0x6a, 0x6b, 0xd6, 0x7a, 0x93, 0x66, 0x2d, 0xf2,
+	0x3a, 0x5c, 0x38, 0x0a, 0x84, 0xea, 0xb3, 0x30, 0x43, 0x22, 0x3d, 0x0e, 0xe9, 0xd4, 0x71, 0xad,
+	0x4e, 0x63, 0x91, 0x0d, 0x58, 0x4d, 0x0f, 0x07, 0x32, 0xe8, 0x4e, 0xa8, 0x00, 0xaa, 0x4c, 0xe5,
+	0xb9, 0x7f, 0xb6, 0xe0, 0xe2, 0xb6, 0x48, 0xd2, 0x27, 0x22, 0x14, 0xb9, 0x93, 0xcb, 0x33, 0x9c,
+	0xbc, 0x78, 0xd2, 0xc9, 0xee, 0xcf, 0x4a, 0x70, 0xc9, 0x64, 0xd4, 0x5e, 0xee, 0xd8, 0xcf, 0x61,
+	0x16, 0xff, 0x0f, 0xcb, 0xa3, 0x5e, 0xbd, 0xf8, 0xf4, 0x69, 0xbc, 0x08, 0xad, 0x61, 0x80, 0x8d,
+	0xdc, 0x17, 0x9b, 0x52, 0xee, 0x4f, 0x4b, 0xb0, 0xaa, 0x83, 0xfa, 0x3f, 0x6f, 0x68, 0x6f, 0xfc,
+	0xa2, 0x04, 0xcb, 0xf7, 0x44, 0x3f, 0xee, 0x32, 0xc5, 0xbf, 0x02, 0x8e, 0x98, 0x23, 0xe1, 0x27,
+	0x27, 0x5d, 0x39, 0x89, 0x2a, 0xcf, 0x02, 0x48, 0xde, 0x8b, 0xf4, 0xb1, 0x6b, 0x5b, 0x3a, 0x55,
+	0xdc, 0xc9, 0x0a, 0x14, 0xf7, 0xb3, 0x12, 0x90, 0x9b, 0xa1, 0xe2, 0x62, 0x1f, 0xd1, 0xe6, 0xcb,
+	0xf4, 0xcb, 0xe4, 0x84, 0xcb, 0x53, 0x26, 0xfc, 0x0c, 0xc0, 0x41, 0xc0, 0x43, 0xdf, 0xd8, 0x59,
+	0x44, 0x3b, 0x75, 0xa4, 0xa0, 0x89, 0xf7, 0x86, 0x18, 0x5a, 0xc1, 0x81, 0xbf, 0x38, 0x3e, 0x70,
+	0xc3, 0xbb, 0x3e, 0xc2, 0xb8, 0x6c, 0xd2, 0x99, 0x92, 0xfb, 0x1b, 0x0b, 0x88, 0xc1, 0x8f, 0x9b,
+	0x61, 0xc0, 0xe4, 0x97, 0xe9, 0x8c, 0x55, 0x58, 0x64, 0x7a, 0x0c, 0x59, 0x6e, 0x98, 0x86, 0x2b,
+	0xa1, 0xad, 0xd7, 0xf3, 0xe7, 0x35, 0xba, 0x61, 0xa7, 0x76, 0xb1, 0xd3, 0x5f, 0x5b, 0xb0, 0x82,
+	0x29, 0xf2, 0x84, 0x3a, 0xe5, 0x4f, 0xa5, 0x3c, 0x6a, 0xbb, 0xb1, 0xcf, 0x1f, 0x7e, 0x99, 0x03,
+	0x1c, 0x4f, 0xcf, 0xf2, 0x64, 0x7a, 0x9e, 0x17, 0xdb, 0x1c, 0xa8, 0xa2, 0x91, 0x21, 0xae, 0xe5,
+	0x4d, 0x5d, 0x0f, 0x98, 0xda, 0x30, 0xab, 0x07, 0x6a, 0x73, 0xd7, 0x03, 0xa8, 0x96, 0xd5, 0x03,
+	0x7f, 0x2b, 0xc3, 0xd2, 0x6e, 0x2c, 0xb9, 0x50, 0xe7, 0x77, 0xde, 0xd3, 0x50, 0x97, 0x87, 0x4c,
+	0xf8, 0x77, 0x47, 0xee, 0x1b, 0x11, 0x8a, 0xae, 0xb5, 0x1f, 0xe5, 0xda, 0xf2, 0x9c, 0xa8, 0xb9,
+	0x38, 0x6b, 0xfb, 0xa8, 0xcc, 0x70, 0x71, 0xf5, 0xd1, 0x48, 0x5a, 0x3b, 0x89, 0xa4, 0x7a, 0x82,
+	0x39, 0x6e, 0x3a, 0x75, 0xe4, 0x8f, 0x08, 0x1a, 0x67, 0x87, 0x67, 0x75, 0x73, 0xd2, 0x2a, 0xd3,
+	0x02, 0x45, 0x9f, 0xee, 0x44, 0x72, 0xac, 0x31, 0xb8, 0x81, 0x18, 0x9c, 0xb5, 0xc8, 0x9b, 0x50,
+	0x13, 0xc9, 0xb1, 0xe7, 0x33, 0xc5, 0x9c, 0x26, 0x06, 0xef, 0xf2, 0x54, 0x67, 0x6f, 0x86, 0x49,
+	0x87, 0x56, 0x45, 0x72, 0xbc, 0xcd, 0x14, 0x23, 0x1f, 0x40, 0x03, 0x33, 0x40, 0x1a, 0xc5, 0x25,
+	0x54, 0x7c, 0x76, 0x2a, 0xd8, 0x7d, 0x5b, 0xcb, 0x69, 0x25, 0x6a, 0x52, 0x53, 0xa2, 0x81, 0xcb,
+	0x50, 0x8b, 0xfb, 0x91, 0x27, 0x92, 0x63, 0xe9, 0xb4, 0xb0, 0xb2, 0xa8, 0xc6, 0xfd, 0x88, 0x26,
+	0xc7, 0x92, 0x6c, 0x42, 0xf5, 0x88, 0x0b, 0x19, 0x24, 0xb1, 0xb3, 0x8c, 0x97, 0x15, 0xeb, 0xa7,
+	0x14, 0xf4, 0x26, 0x63, 0xb4, 0xb9, 0x8f, 0x8d, 0x3c, 0xcd, 0x15, 0xdd, 0xbf, 0x54, 0x60, 0x69,
+	0x9f, 0x33, 0xd1, 0x3d, 0x3c, 0x7f, 0x42, 0xad, 0xc2, 0xa2, 0xe0, 0x0f, 0x86, 0xe5, 0x9b, 0x69,
+	0x0c, 0xe3, 0x6b, 0xcf, 0x88, 0x6f, 0x79, 0x8e, 0x9a, 0x6e, 0x71, 0x4a, 0x4d, 0xd7, 0x06, 0xdb,
+	0x97, 0x21, 0xa6, 0x4e, 0x9d, 0xea, 0x4f, 0x5d, 0x89, 0xa5, 0x21, 0xeb, 0xf2, 0xc3, 0x24, 0xf4,
+	0xb9, 0xf0, 0x7a, 0x22, 0xe9, 0x9b, 0x4a, 0xac, 0x49, 0xdb, 0x05, 0xc6, 0x6d, 0x4d, 0x27, 0xef,
+	0x40, 0xcd, 0x97, 0xa1, 0xa7, 0x06, 0x29, 0xc7, 0xfc, 0x69, 0x9d, 0x32, 0xcd, 0x6d, 0x19, 0xde,
+	0x1b, 0xa4, 0x9c, 0x56, 0x7d, 0xf3, 0x41, 0x5e, 0x87, 0x55, 0xc9, 0x45, 0xc0, 0xc2, 0xe0, 0x53,
+	0xee, 0x7b, 0xfc, 0x61, 0x2a, 0xbc, 0x34, 0x64, 0x31, 0x26, 0x59, 0x93, 0x92, 0x11, 0xef, 0xd6,
+	0xc3, 0x54, 0xec, 0x85, 0x2c, 0x26, 0xeb, 0xd0, 0x4e, 0xfa, 0x2a, 0xed, 0x2b, 0x2f, 0x4b, 0x83,
+	0xc0, 0xc7, 0x9c, 0xb3, 0x69, 0xcb, 0xd0, 0x31, 0xea, 0x72, 0xd7, 0x9f, 0x5a, 0xa7, 0x36, 0xce,
+	0x54, 0xa7, 0x36, 0xcf, 0x56, 0xa7, 0x2e, 0x4d, 0xaf, 0x53, 0x49, 0x0b, 0x4a, 0xf1, 0x03, 0xcc,
+	0x35, 0x9b, 0x96, 0xe2, 0x07, 0x3a, 0x90, 0x2a, 0x49, 0xef, 0x63, 0x8e, 0xd9, 0x14, 0xbf, 0xf5,
+	0x22, 0x8a, 0xb8, 0x12, 0x41, 0x57, 0xbb, 0xc5, 0x69, 0x63, 0x1c, 0x0a, 0x14, 0xf2, 0x32, 0xac,
+	0x60, 0x08, 0xbc, 0xce, 0xc0, 0x4c, 0x5c, 0xcf, 0x7b, 0x05, 0x0d, 0xb4, 0x90, 0xb1, 0x39, 0xc0,
+	0x89, 0xef, 0xfa, 0x1a, 0x89, 0x8d, 0xa8, 0x0c, 0x3e, 0xe5, 0x0e, 0x31, 0xcb, 0x15, 0x29, 0xfb,
+	0xc1, 0xa7, 0x5c, 0x23, 0x2a, 0x7f, 0x98, 0x86, 0x2c, 0x88, 0x9d, 0x0b, 0x6b, 0xd6, 0x7a, 0x8d,
+	0xe6, 0x4d, 0x72, 0x05, 0x6a, 0x7d, 0xa9, 0x13, 0x3c, 0xe2, 0xce, 0x2a, 0x8e, 0x60, 0xd8, 0xd6,
+	0xbc, 0x54, 0x04, 0x89, 0x08, 0xd4, 0xc0, 0xb9, 0xb8, 0x66, 0xad, 0x2f, 0xd2, 0x61, 0x5b, 0xe3,
+	0x93, 0x54, 0x82, 0xb3, 0xc8, 0x13, 0x5c, 0xf6, 0x43, 0x25, 0x9d, 0x4b, 0x68, 0x78, 0xc9, 0x50,
+	0xa9, 0x21, 0x62, 0x46, 0x89, 0x7e, 0xcc, 0x7d, 0xaf, 0x70, 0x2c, 0x7b, 0x0a, 0x43, 0xd7, 0x36,
+	0x8c, 0xfd, 0xd1, 0xe1, 0xec, 0x0f, 0xe5, 0xd1, 0x32, 0x32, 0xea, 0x5f, 0x50, 0x4d, 0x3f, 0x5c,
+	0x7b, 0x76, 0x71, 0xed, 0x3d, 0x07, 0x0d, 0x13, 0x0c, 0x93, 0xe3, 0xe5, 0x13, 0xf1, 0x79, 0x0e,
+	0x1a, 0x1a, 0x55, 0x1e, 0xf4, 0xb9, 0x08, 0xb8, 0xcc, 0xb6, 0x39, 0x88, 0xfb, 0xd1, 0x77, 0x0d,
+	0x85, 0x5c, 0x80, 0x45, 0x95, 0xa4, 0xde, 0xfd, 0x1c, 0x9e, 0x55, 0x92, 0x7e, 0x48, 0xbe, 0x09,
+	0x57, 0x24, 0x67, 0xe1, 0x98, 0x4b, 0x3c, 0x89, 0xd3, 0xe6, 0x7e, 0x76, 0x64, 0x75, 0x8c, 0xc4,
+	0xc8, 0x37, 0xfb, 0x19, 0x5f, 0x67, 0x6d, 0xd7, 0x14, 0xb2, 0x63, 0x6a, 0x35, 0xac, 0x75, 0xc9,
+	0x88, 0x35, 0x54, 0x78, 0x17, 0x9c, 0x5e, 0x98, 0x74, 0x58, 0xe8, 0x9d, 0xe8, 0x15, 0x8b, 0x6a,
+	0x9b, 0x5e, 0x32, 0xfc, 0xfd, 0x89, 0x2e, 0xf5, 0xf4, 0x64, 0x18, 0x74, 0xb9, 0xef, 0x75, 0xc2,
+	0xa4, 0xe3, 0x00, 0x2e, 0x4f, 0x30, 0x24, 0x8d, 0xcf, 0x7a, 0x59, 0x66, 0x02, 0xda, 0x0d, 0xdd,
+	0xa4, 0x1f, 0x2b, 0x5c, 0x6c, 0x36, 0x6d, 0x19, 0xfa, 0xdd, 0x7e, 0xb4, 0xa5, 0xa9, 0xe4, 0xff,
+	0x60, 0x29, 0x93, 0x4c, 0x0e, 0x0e, 0x24, 0x57, 0xb8, 0xca, 0x6c, 0xda, 0x34, 0xc4, 0xef, 0x20,
+	0x8d, 0xbc, 0xa7, 0xd3, 0x2d, 0x39, 0x08, 0x42, 0x2e, 0x9d, 0xa5, 0x69, 0x1b, 0x7b, 0xd6, 0xd8,
+	0xd7, 0xdb, 0xec, 0x9e, 0x91, 0xa4, 0x43, 0x15, 0xf7, 0x1f, 0x65, 0x58, 0xa6, 0x3a, 0x38, 0xfc,
+	0x88, 0x7f, 0x95, 0x60, 0xf8, 0x34, 0x38, 0xac, 0x9c, 0x09, 0x0e, 0xab, 0x73, 0xc3, 0x61, 0xed,
+	0x4c, 0x70, 0x58, 0x3f, 0x1b, 0x1c, 0xc2, 0x29, 0x70, 0x58, 0x00, 0xa0, 0xc6, 0xe9, 0x00, 0xd4,
+	0x9c, 0x01, 0x40, 0x4b, 0x8f, 0x04, 0xa0, 0xd6, 0xdc, 0x00, 0xb4, 0x3c, 0x1d, 0x80, 0x74, 0xf0,
+	0xc3, 0x20, 0x0a, 0x14, 0x62, 0xb1, 0x4d, 0x4d, 0xc3, 0xfd, 0x97, 0x5d, 0x4c, 0xac, 0x27, 0x00,
+	0x98, 0x5e, 0x01, 0x3b, 0xf0, 0x4d, 0x55, 0xd0, 0xd8, 0x70, 0xa6, 0x1e, 0x83, 0x76, 0xb7, 0x25,
+	0xd5, 0x42, 0x93, 0x47, 0xa7, 0xc5, 0x33, 0x1f, 0x9d, 0xde, 0x87, 0xab, 0x27, 0xe1, 0x4a, 0x64,
+	0xee, 0xf0, 0x9d, 0x0a, 0xba, 0xf2, 0xf2, 0x24, 0x5e, 0xe5, 0xfe, 0xf2, 0xc9, 0xd7, 0x60, 0xb5,
+	0x00, 0x58, 0x23, 0xc5, 0xaa, 0xb9, 0xd0, 0x1b, 0xf1, 0x46, 0x2a, 0xb3, 0x20, 0xab, 0x36, 0x13,
+	0xb2, 0x8a, 0x10, 0x52, 0x3f, 0x3b, 0x84, 0xfc, 0xd5, 0x86, 0xa5, 0x6d, 0x1e, 0xf2, 0xc7, 0xb9,
+	0x30, 0xf9, 0xaf, 0x2f, 0x0c, 0x5e, 0x05, 0x12, 0xc4, 0xea, 0xed, 0x37, 0xbd, 0x54, 0x04, 0x11,
+	0x13, 0x03, 0xef, 0x3e, 0x1f, 0xe4, 0x5b, 0x49, 0x1b, 0x39, 0x7b, 0x86, 0xf1, 0x21, 0x1f, 0xc8,
+	0x47, 0x16, 0x0a, 0xc5, 0x93, 0xb9, 0xd9, 0x3b, 0x86, 0x27, 0xf3, 0x6f, 0x40, 0x73, 0xac, 0x8b,
+	0xe6, 0x23, 0xf2, 0xbd, 0x91, 0x8e, 0xfa, 0x75, 0xff, 0x6d, 0x41, 0xfd, 0xa3, 0x84, 0xf9, 0x58,
+	0x23, 0x9f, 0x33, 0x8c, 0xc3, 0xf2, 0xa7, 0x34, 0x59, 0xfe, 0x3c, 0x0d, 0xa3, 0x32, 0x37, 0x0b,
+	0xe4, 0x88, 0x50, 0xac, 0x5f, 0xcb, 0xe3, 0xf5, 0xeb, 0x73, 0xd0, 0x08, 0xf4, 0x80, 0xbc, 0x94,
+	0xa9, 0x43, 0xb3, 0x1d, 0xd4, 0x29, 0x20, 0x69, 0x4f, 0x53, 0x74, 0x81, 0x9b, 0x0b, 0x60, 0x81,
+	0x5b, 0x99, 0xbb, 0xc0, 0xcd, 0x8c, 0x60, 0x81, 0xfb, 0x13, 0x4b, 0xbf, 0xae, 0xf9, 0xfc, 0xa1,
+	0x86, 0x93, 0x93, 0x46, 0xad, 0xf3, 0x18, 0xd5, 0xfb, 0x14, 0x46, 0x8a, 0x87, 0x4c, 0x8d, 0xd6,
+	0xa4, 0xcc, 0x9c, 0x43, 0x74, 0xd4, 0x0c, 0x2b, 0x5b, 0x8f, 0xd2, 0xfd, 0xb9, 0x05, 0x80, 0xa0,
+	0x62, 0x86, 0x31, 0x99, 0x7e, 0xd6, 0xec, 0xd2, 0xbf, 0x34, 0xee, 0xba, 0xcd, 0xdc, 0x75, 0x33,
+	0x5e, 0x5f, 0x0a, 0xb5, 0x5a, 0x3e, 0xf9, 0xcc, 0xbb, 0xf8, 0xed, 0xfe, 0xd2, 0x82, 0x66, 0x36,
+	0x3a, 0x33, 0xa4, 0xb1, 0x28, 0x5b, 0x93, 0x51, 0xc6, 0x03, 0x60, 0x94, 0x88, 0x81, 0x39, 0x55,
+	0x9b, 0x01, 0x81, 0x21, 0xe1, 0xb1, 0xba, 0x98, 0xbc, 0xf6, 0x78, 0xf2, 0x5e, 0x83, 0x15, 0xc1,
+	0xbb, 0x3c, 0x56, 0xe1, 0xc0, 0x8b, 0x12, 0x3f, 0x38, 0x08, 0xb8, 0x8f, 0xd9, 0x50, 0xa3, 0xed,
+	0x9c, 0x71, 0x27, 0xa3, 0xbb, 0x3f, 0xb6, 0xa0, 0x71, 0x47, 0xf6, 0xf6, 0x12, 0x89, 0x8b, 0x8c,
+	0x3c, 0x0f, 0xcd, 0x0c, 0x17, 0xcd, 0x0a, 0xb7, 0x30, 0xc3, 0x1a, 0xdd, 0xd1, 0x0b, 0x86, 0xde,
+	0x19, 0x22, 0xd9, 0xcb, 0xdc, 0xd4, 0xa4, 0xa6, 0xa1, 0x37, 0xcc, 0x48, 0xf6, 0xb0, 0x3e, 0xcb,
+	0xd2, 0x72, 0xd8, 0xd6, 0x73, 0x1d, 0xed, 0xd3, 0x65, 0xdc, 0xa7, 0xeb, 0xaa, 0xf8, 0xae, 0x46,
+	0xb2, 0x17, 0x92, 0xc7, 0x7a, 0xd0, 0xc4, 0x28, 0x17, 0x5f, 0x61, 0x4a, 0x98, 0xe3, 0x63, 0xb4,
+	0x09, 0x50, 0xb0, 0x4f, 0x80, 0xc2, 0x35, 0x58, 0xf1, 0xf9, 0x01, 0xeb, 0x87, 0xca, 0x9b, 0x1c,
+	0x72, 0x3b, 0x63, 0x8c, 0xbd, 0x08, 0xb6, 0xb6, 0x04, 0xf7, 0x79, 0xac, 0x02, 0x16, 0xe2, 0x43,
+	0x75, 0xf1, 0x4c, 0x61, 0x4d, 0x9c, 0x29, 0x5e, 0x03, 0xc2, 0xe3, 0xae, 0x18, 0xa4, 0x3a, 0x89,
+	0x53, 0x26, 0xe5, 0x71, 0x22, 0xfc, 0x0c, 0xa8, 0x57, 0x86, 0x9c, 0xbd, 0x8c, 0xa1, 0x2f, 0x32,
+	0x14, 0x8f, 0x59, 0xac, 0x72, 0xbc, 0x36, 0x2d, 0x1d, 0xfa, 0x40, 0x7a, 0xb2, 0x9f, 0x72, 0x91,
+	0x85, 0xb5, 0x1a, 0xc8, 0x7d, 0xdd, 0xd4, 0x50, 0x2e, 0x0f, 0xd9, 0xc6, 0x5b, 0x6f, 0x8f, 0xcc,
+	0x1b, 0x88, 0x6e, 0x19, 0x72, 0x6e, 0xdb, 0xbd, 0x05, 0x2b, 0xfa, 0x45, 0x7a, 0x2f, 0x09, 0x83,
+	0xee, 0xe0, 0xdc, 0x3b, 0x8e, 0xfb, 0x77, 0x0b, 0x48, 0xd1, 0x4e, 0xf6, 0x1e, 0x3a, 0x3a, 0x70,
+	0x58, 0xf3, 0x1f, 0x38, 0x9e, 0x87, 0x66, 0x8a, 0x66, 0xf0, 0xef, 0x8b, 0x3c, 0x7a, 0x0d, 0x43,
+	0xd3, 0xbe, 0x95, 0xba, 0xd4, 0xd4, 0xce, 0xf4, 0x44, 0x12, 0x72, 0x13, 0xbc, 0x3a, 0xad, 0x6b,
+	0x0a, 0xd5, 0x04, 0x72, 0x1b, 0x9a, 0xfa, 0x86, 0x07, 0x35, 0x02, 0x6e, 0x5e, 0x93, 0x4f, 0xfc,
+	0x25, 0x91, 0x35, 0x68, 0x72, 0x6c, 0x06, 0x7d, 0x2b, 0x56, 0x81, 0x1a, 0xd0, 0x86, 0xc8, 0x08,
+	0x01, 0x97, 0x6e, 0x0f, 0x2e, 0xef, 0x1f, 0x26, 0xc7, 0x5b, 0x49, 0x7c, 0x10, 0xf4, 0xfa, 0x82,
+	0xe9, 0x95, 0xf1, 0x18, 0xd7, 0xb1, 0x0e, 0x54, 0x53, 0xa6, 0x34, 0x3e, 0x64, 0xc1, 0xce, 0x9b,
+	0xee, 0xaf, 0x2c, 0xb8, 0x32, 0xad, 0xa7, 0xc7, 0xf1, 0xe3, 0x6d, 0x58, 0xea, 0x1a, 0x73, 0xc6,
+	0xda, 0xfc, 0x7f, 0x2e, 0x8c, 0xeb, 0xb9, 0xbf, 0xad, 0x40, 0x43, 0xaf, 0xcb, 0x1e, 0xbf, 0x75,
+	0xc4, 0x63, 0xa5, 0xa7, 0x91, 0x5f, 0x57, 0x59, 0x78, 0x22, 0xce, 0x9b, 0xfa, 0x3e, 0x26, 0x92,
+	0x3d, 0x53, 0xab, 0x96, 0x66, 0xdc, 0xc7, 0xdc, 0x91, 0x3d, 0x73, 0x1f, 0x13, 0x99, 0x0f, 0xbd,
+	0x5a, 0x8e, 0xb2, 0xe5, 0x99, 0x83, 0x46, 0xde, 0x9e, 0x0d, 0x1a, 0xe4, 0x7d, 0xa8, 0x04, 0x78,
+	0x2b, 0x86, 0x09, 0x7e, 0xfa, 0xbf, 0x30, 0x63, 0x97, 0xad, 0x3b, 0x0b, 0x34, 0xd3, 0xd2, 0xfa,
+	0x3e, 0x1e, 0xb7, 0x9c, 0xca, 0x4c, 0xfd, 0xb1, 0x33, 0x99, 0xd6, 0x37, 0x5a, 0xe4, 0x87, 0xb0,
+	0xd2, 0xc5, 0x9b, 0x70, 0x6f, 0xb4, 0x89, 0xe0, 0xa9, 0xa6, 0xb1, 0x71, 0xfd, 0xb4, 0xdf, 0x72,
+	0xa6, 0xbf, 0xc0, 0xef, 0x2c, 0xd0, 0x76, 0x77, 0x82, 0x45, 0xbe, 0x0f, 0xcb, 0xbe, 0x48, 0xd2,
+	0xa2, 0xf1, 0x1a, 0x1a, 0x7f, 0xf5, 0xb4, 0x71, 0x4e, 0x7b, 0x51, 0xde, 0x59, 0xa0, 0x2d, 0x7f,
+	0x8c, 0x41, 0x3e, 0x81, 0xac, 0x33, 0x6f, 0x78, 0xb0, 0xc2, 0x42, 0xab, 0xb1, 0xf1, 0xda, 0xcc,
+	0x61, 0x4f, 0x3e, 0x6c, 0xee, 0x2c, 0xd0, 0xe5, 0xee, 0x38, 0x87, 0xdc, 0x03, 0xec, 0xad, 0x60,
+	0x19, 0xd0, 0xf2, 0xb5, 0x19, 0x63, 0x9e, 0x62, 0x77, 0xc9, 0x2f, 0xd2, 0xc9, 0x36, 0xd4, 0x54,
+	0xf6, 0x96, 0x88, 0xc7, 0xb4, 0xc6, 0xc6, 0x4b, 0xa7, 0xd8, 0x9b, 0x78, 0x72, 0xdc, 0x59, 0xa0,
+	0x43, 0x4d, 0x72, 0x17, 0x9a, 0x2c, 0x54, 0x5c, 0x78, 0xd9, 0xab, 0x95, 0x39, 0xd1, 0xbd, 0x7c,
+	0x8a, 0xa5, 0x93, 0xef, 0x74, 0x3b, 0x0b, 0xb4, 0xc1, 0x46, 0xd4, 0xcd, 0xba, 0x5e, 0xd3, 0x83,
+	0x30, 0x61, 0xfe, 0x2b, 0xef, 0x42, 0x7d, 0xf8, 0x37, 0x19, 0x69, 0x43, 0x53, 0xff, 0x5c, 0x84,
+	0x75, 0x73, 0x10, 0xf7, 0xda, 0x0b, 0xa4, 0x01, 0xd5, 0x1d, 0xce, 0x42, 0x75, 0x38, 0x68, 0x5b,
+	0xa4, 0x09, 0xb5, 0x9b, 0x9d, 0x38, 0x11, 0x11, 0x0b, 0xdb, 0xa5, 0x57, 0x36, 0x60, 0xe5, 0xc4,
+	0xd5, 0xae, 0x16, 0xa1, 0xc9, 0xb1, 0x86, 0x0f, 0xbf, 0xbd, 0x40, 0x96, 0xa1, 0xb1, 0x95, 0x84,
+	0xfd, 0x28, 0x36, 0x04, 0x6b, 0xf3, 0x9d, 0x4f, 0xde, 0xea, 0x05, 0xea, 0xb0, 0xdf, 0xd1, 0x8b,
+	0xea, 0x86, 0x19, 0xfe, 0x6b, 0x41, 0x92, 0x7d, 0xdd, 0xc8, 0xa7, 0x70, 0x03, 0x67, 0x34, 0x6c,
+	0xa6, 0x9d, 0x4e, 0x05, 0x29, 0x6f, 0xfc, 0x67, 0x00, 0xae, 0x4c, 0x3e, 0x89, 0xb5, 0x27, 0x00,
+	0x00,
 }
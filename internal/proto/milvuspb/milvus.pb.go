@@ -56,6 +56,37 @@ func (ShowType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{0}
 }
 
+type DeleteJobState int32
+
+const (
+	DeleteJobState_DeleteJobPending   DeleteJobState = 0
+	DeleteJobState_DeleteJobRunning   DeleteJobState = 1
+	DeleteJobState_DeleteJobCompleted DeleteJobState = 2
+	DeleteJobState_DeleteJobFailed    DeleteJobState = 3
+)
+
+var DeleteJobState_name = map[int32]string{
+	0: "DeleteJobPending",
+	1: "DeleteJobRunning",
+	2: "DeleteJobCompleted",
+	3: "DeleteJobFailed",
+}
+
+var DeleteJobState_value = map[string]int32{
+	"DeleteJobPending":   0,
+	"DeleteJobRunning":   1,
+	"DeleteJobCompleted": 2,
+	"DeleteJobFailed":    3,
+}
+
+func (x DeleteJobState) String() string {
+	return proto.EnumName(DeleteJobState_name, int32(x))
+}
+
+func (DeleteJobState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{1}
+}
+
 type OperateUserRoleType int32
 
 const (
@@ -78,7 +109,7 @@ func (x OperateUserRoleType) String() string {
 }
 
 func (OperateUserRoleType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{1}
+	return fileDescriptor_02345ba45cc0e303, []int{2}
 }
 
 type OperatePrivilegeType int32
@@ -103,7 +134,7 @@ func (x OperatePrivilegeType) String() string {
 }
 
 func (OperatePrivilegeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{2}
+	return fileDescriptor_02345ba45cc0e303, []int{3}
 }
 
 type CreateAliasRequest struct {
@@ -287,7 +318,576 @@ func (m *AlterAliasRequest) GetAlias() string {
 	return ""
 }
 
-//*
+// Increase the shards number of an existing collection.
+// Entities inserted afterwards are distributed over both the old and the new shards.
+type AlterShardsRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The unique collection name in milvus.(Required)
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The new shards number, must be larger than the current one.(Required)
+	ShardsNum            int32    `protobuf:"varint,4,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterShardsRequest) Reset()         { *m = AlterShardsRequest{} }
+func (m *AlterShardsRequest) String() string { return proto.CompactTextString(m) }
+func (*AlterShardsRequest) ProtoMessage()    {}
+func (*AlterShardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{3}
+}
+
+func (m *AlterShardsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlterShardsRequest.Unmarshal(m, b)
+}
+func (m *AlterShardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlterShardsRequest.Marshal(b, m, deterministic)
+}
+func (m *AlterShardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterShardsRequest.Merge(m, src)
+}
+func (m *AlterShardsRequest) XXX_Size() int {
+	return xxx_messageInfo_AlterShardsRequest.Size(m)
+}
+func (m *AlterShardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterShardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterShardsRequest proto.InternalMessageInfo
+
+func (m *AlterShardsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *AlterShardsRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *AlterShardsRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *AlterShardsRequest) GetShardsNum() int32 {
+	if m != nil {
+		return m.ShardsNum
+	}
+	return 0
+}
+
+// Add a scalar field to an existing collection.
+// The rows written before are read with the default value of the field.
+type AddFieldRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The unique collection name in milvus.(Required)
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The new field, must be nullable or have a default value.(Required)
+	Field                *schemapb.FieldSchema `protobuf:"bytes,4,opt,name=field,proto3" json:"field,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *AddFieldRequest) Reset()         { *m = AddFieldRequest{} }
+func (m *AddFieldRequest) String() string { return proto.CompactTextString(m) }
+func (*AddFieldRequest) ProtoMessage()    {}
+func (*AddFieldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{4}
+}
+
+func (m *AddFieldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddFieldRequest.Unmarshal(m, b)
+}
+func (m *AddFieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddFieldRequest.Marshal(b, m, deterministic)
+}
+func (m *AddFieldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddFieldRequest.Merge(m, src)
+}
+func (m *AddFieldRequest) XXX_Size() int {
+	return xxx_messageInfo_AddFieldRequest.Size(m)
+}
+func (m *AddFieldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddFieldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddFieldRequest proto.InternalMessageInfo
+
+func (m *AddFieldRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *AddFieldRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *AddFieldRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *AddFieldRequest) GetField() *schemapb.FieldSchema {
+	if m != nil {
+		return m.Field
+	}
+	return nil
+}
+
+// Drop a field from an existing collection, the primary key and vector fields can't be dropped.
+type DropFieldRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The unique collection name in milvus.(Required)
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The field to drop.(Required)
+	FieldName            string   `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropFieldRequest) Reset()         { *m = DropFieldRequest{} }
+func (m *DropFieldRequest) String() string { return proto.CompactTextString(m) }
+func (*DropFieldRequest) ProtoMessage()    {}
+func (*DropFieldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{5}
+}
+
+func (m *DropFieldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropFieldRequest.Unmarshal(m, b)
+}
+func (m *DropFieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropFieldRequest.Marshal(b, m, deterministic)
+}
+func (m *DropFieldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropFieldRequest.Merge(m, src)
+}
+func (m *DropFieldRequest) XXX_Size() int {
+	return xxx_messageInfo_DropFieldRequest.Size(m)
+}
+func (m *DropFieldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropFieldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropFieldRequest proto.InternalMessageInfo
+
+func (m *DropFieldRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropFieldRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *DropFieldRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *DropFieldRequest) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
+}
+
+// Pin the current state of a collection as a named snapshot, the entities inserted or deleted later are invisible in it.
+type CreateSnapshotRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The unique collection name in milvus.(Required)
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The snapshot name, unique in the collection.(Required)
+	SnapshotName         string   `protobuf:"bytes,4,opt,name=snapshot_name,json=snapshotName,proto3" json:"snapshot_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateSnapshotRequest) Reset()         { *m = CreateSnapshotRequest{} }
+func (m *CreateSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateSnapshotRequest) ProtoMessage()    {}
+func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{6}
+}
+
+func (m *CreateSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSnapshotRequest.Unmarshal(m, b)
+}
+func (m *CreateSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSnapshotRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSnapshotRequest.Merge(m, src)
+}
+func (m *CreateSnapshotRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateSnapshotRequest.Size(m)
+}
+func (m *CreateSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSnapshotRequest proto.InternalMessageInfo
+
+func (m *CreateSnapshotRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateSnapshotRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *CreateSnapshotRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *CreateSnapshotRequest) GetSnapshotName() string {
+	if m != nil {
+		return m.SnapshotName
+	}
+	return ""
+}
+
+// Drop a snapshot, its data is released to compaction and garbage collection.
+type DropSnapshotRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The unique collection name in milvus.(Required)
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The snapshot name.(Required)
+	SnapshotName         string   `protobuf:"bytes,4,opt,name=snapshot_name,json=snapshotName,proto3" json:"snapshot_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropSnapshotRequest) Reset()         { *m = DropSnapshotRequest{} }
+func (m *DropSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*DropSnapshotRequest) ProtoMessage()    {}
+func (*DropSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{7}
+}
+
+func (m *DropSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropSnapshotRequest.Unmarshal(m, b)
+}
+func (m *DropSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropSnapshotRequest.Marshal(b, m, deterministic)
+}
+func (m *DropSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropSnapshotRequest.Merge(m, src)
+}
+func (m *DropSnapshotRequest) XXX_Size() int {
+	return xxx_messageInfo_DropSnapshotRequest.Size(m)
+}
+func (m *DropSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropSnapshotRequest proto.InternalMessageInfo
+
+func (m *DropSnapshotRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropSnapshotRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *DropSnapshotRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *DropSnapshotRequest) GetSnapshotName() string {
+	if m != nil {
+		return m.SnapshotName
+	}
+	return ""
+}
+
+type ListSnapshotsRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The unique collection name in milvus.(Required)
+	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSnapshotsRequest) Reset()         { *m = ListSnapshotsRequest{} }
+func (m *ListSnapshotsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSnapshotsRequest) ProtoMessage()    {}
+func (*ListSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{8}
+}
+
+func (m *ListSnapshotsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSnapshotsRequest.Unmarshal(m, b)
+}
+func (m *ListSnapshotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSnapshotsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListSnapshotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSnapshotsRequest.Merge(m, src)
+}
+func (m *ListSnapshotsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSnapshotsRequest.Size(m)
+}
+func (m *ListSnapshotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSnapshotsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSnapshotsRequest proto.InternalMessageInfo
+
+func (m *ListSnapshotsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ListSnapshotsRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *ListSnapshotsRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type SnapshotInfo struct {
+	SnapshotName   string `protobuf:"bytes,1,opt,name=snapshot_name,json=snapshotName,proto3" json:"snapshot_name,omitempty"`
+	CollectionName string `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// the hybrid timestamp the snapshot is taken at
+	SnapshotTimestamp    uint64   `protobuf:"varint,3,opt,name=snapshot_timestamp,json=snapshotTimestamp,proto3" json:"snapshot_timestamp,omitempty"`
+	SegmentIDs           []int64  `protobuf:"varint,4,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SnapshotInfo) Reset()         { *m = SnapshotInfo{} }
+func (m *SnapshotInfo) String() string { return proto.CompactTextString(m) }
+func (*SnapshotInfo) ProtoMessage()    {}
+func (*SnapshotInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{9}
+}
+
+func (m *SnapshotInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SnapshotInfo.Unmarshal(m, b)
+}
+func (m *SnapshotInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SnapshotInfo.Marshal(b, m, deterministic)
+}
+func (m *SnapshotInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotInfo.Merge(m, src)
+}
+func (m *SnapshotInfo) XXX_Size() int {
+	return xxx_messageInfo_SnapshotInfo.Size(m)
+}
+func (m *SnapshotInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotInfo proto.InternalMessageInfo
+
+func (m *SnapshotInfo) GetSnapshotName() string {
+	if m != nil {
+		return m.SnapshotName
+	}
+	return ""
+}
+
+func (m *SnapshotInfo) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *SnapshotInfo) GetSnapshotTimestamp() uint64 {
+	if m != nil {
+		return m.SnapshotTimestamp
+	}
+	return 0
+}
+
+func (m *SnapshotInfo) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type ListSnapshotsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Snapshots            []*SnapshotInfo  `protobuf:"bytes,2,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListSnapshotsResponse) Reset()         { *m = ListSnapshotsResponse{} }
+func (m *ListSnapshotsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSnapshotsResponse) ProtoMessage()    {}
+func (*ListSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{10}
+}
+
+func (m *ListSnapshotsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSnapshotsResponse.Unmarshal(m, b)
+}
+func (m *ListSnapshotsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSnapshotsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListSnapshotsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSnapshotsResponse.Merge(m, src)
+}
+func (m *ListSnapshotsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSnapshotsResponse.Size(m)
+}
+func (m *ListSnapshotsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSnapshotsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSnapshotsResponse proto.InternalMessageInfo
+
+func (m *ListSnapshotsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListSnapshotsResponse) GetSnapshots() []*SnapshotInfo {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+// Remove all the entities of a collection, the collection ID, schema, indexes, aliases and load state are kept.
+type TruncateCollectionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The unique collection name in milvus.(Required)
+	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TruncateCollectionRequest) Reset()         { *m = TruncateCollectionRequest{} }
+func (m *TruncateCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*TruncateCollectionRequest) ProtoMessage()    {}
+func (*TruncateCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{11}
+}
+
+func (m *TruncateCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TruncateCollectionRequest.Unmarshal(m, b)
+}
+func (m *TruncateCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TruncateCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *TruncateCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncateCollectionRequest.Merge(m, src)
+}
+func (m *TruncateCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_TruncateCollectionRequest.Size(m)
+}
+func (m *TruncateCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncateCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TruncateCollectionRequest proto.InternalMessageInfo
+
+func (m *TruncateCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *TruncateCollectionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *TruncateCollectionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
 // Create collection in milvus
 type CreateCollectionRequest struct {
 	// Not useful for now
@@ -302,17 +902,19 @@ type CreateCollectionRequest struct {
 	// https://github.com/milvus-io/milvus/issues/6690
 	ShardsNum int32 `protobuf:"varint,5,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
 	// The consistency level that the collection used, modification is not supported now.
-	ConsistencyLevel     commonpb.ConsistencyLevel `protobuf:"varint,6,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	ConsistencyLevel commonpb.ConsistencyLevel `protobuf:"varint,6,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	// The collection level properties, such as the overrides of compaction policy (Optional)
+	Properties           []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=properties,proto3" json:"properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *CreateCollectionRequest) Reset()         { *m = CreateCollectionRequest{} }
 func (m *CreateCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateCollectionRequest) ProtoMessage()    {}
 func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{3}
+	return fileDescriptor_02345ba45cc0e303, []int{12}
 }
 
 func (m *CreateCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -375,7 +977,13 @@ func (m *CreateCollectionRequest) GetConsistencyLevel() commonpb.ConsistencyLeve
 	return commonpb.ConsistencyLevel_Strong
 }
 
-//*
+func (m *CreateCollectionRequest) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
 // Drop collection in milvus, also will drop data in collection.
 type DropCollectionRequest struct {
 	// Not useful for now
@@ -393,7 +1001,7 @@ func (m *DropCollectionRequest) Reset()         { *m = DropCollectionRequest{} }
 func (m *DropCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*DropCollectionRequest) ProtoMessage()    {}
 func (*DropCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{4}
+	return fileDescriptor_02345ba45cc0e303, []int{13}
 }
 
 func (m *DropCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -455,7 +1063,7 @@ func (m *HasCollectionRequest) Reset()         { *m = HasCollectionRequest{} }
 func (m *HasCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*HasCollectionRequest) ProtoMessage()    {}
 func (*HasCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{5}
+	return fileDescriptor_02345ba45cc0e303, []int{14}
 }
 
 func (m *HasCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -516,7 +1124,7 @@ func (m *BoolResponse) Reset()         { *m = BoolResponse{} }
 func (m *BoolResponse) String() string { return proto.CompactTextString(m) }
 func (*BoolResponse) ProtoMessage()    {}
 func (*BoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{6}
+	return fileDescriptor_02345ba45cc0e303, []int{15}
 }
 
 func (m *BoolResponse) XXX_Unmarshal(b []byte) error {
@@ -563,7 +1171,7 @@ func (m *StringResponse) Reset()         { *m = StringResponse{} }
 func (m *StringResponse) String() string { return proto.CompactTextString(m) }
 func (*StringResponse) ProtoMessage()    {}
 func (*StringResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{7}
+	return fileDescriptor_02345ba45cc0e303, []int{16}
 }
 
 func (m *StringResponse) XXX_Unmarshal(b []byte) error {
@@ -620,7 +1228,7 @@ func (m *DescribeCollectionRequest) Reset()         { *m = DescribeCollectionReq
 func (m *DescribeCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeCollectionRequest) ProtoMessage()    {}
 func (*DescribeCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{8}
+	return fileDescriptor_02345ba45cc0e303, []int{17}
 }
 
 func (m *DescribeCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -702,7 +1310,12 @@ type DescribeCollectionResponse struct {
 	// The consistency level that the collection used, modification is not supported now.
 	ConsistencyLevel commonpb.ConsistencyLevel `protobuf:"varint,11,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
 	// The collection name
-	CollectionName       string   `protobuf:"bytes,12,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionName string `protobuf:"bytes,12,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The collection level properties
+	Properties []*commonpb.KeyValuePair `protobuf:"bytes,13,rep,name=properties,proto3" json:"properties,omitempty"`
+	// System design related, users should not perceive
+	// Every shards number the collection has used, in order, to route deletes of existing entities
+	ShardsNumHistory     []int32  `protobuf:"varint,14,rep,packed,name=shards_num_history,json=shardsNumHistory,proto3" json:"shards_num_history,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -712,7 +1325,7 @@ func (m *DescribeCollectionResponse) Reset()         { *m = DescribeCollectionRe
 func (m *DescribeCollectionResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeCollectionResponse) ProtoMessage()    {}
 func (*DescribeCollectionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{9}
+	return fileDescriptor_02345ba45cc0e303, []int{18}
 }
 
 func (m *DescribeCollectionResponse) XXX_Unmarshal(b []byte) error {
@@ -817,7 +1430,20 @@ func (m *DescribeCollectionResponse) GetCollectionName() string {
 	return ""
 }
 
-//*
+func (m *DescribeCollectionResponse) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *DescribeCollectionResponse) GetShardsNumHistory() []int32 {
+	if m != nil {
+		return m.ShardsNumHistory
+	}
+	return nil
+}
+
 // Load collection data into query nodes, then you can do vector search on this collection.
 type LoadCollectionRequest struct {
 	// Not useful for now
@@ -837,7 +1463,7 @@ func (m *LoadCollectionRequest) Reset()         { *m = LoadCollectionRequest{} }
 func (m *LoadCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadCollectionRequest) ProtoMessage()    {}
 func (*LoadCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{10}
+	return fileDescriptor_02345ba45cc0e303, []int{19}
 }
 
 func (m *LoadCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -904,7 +1530,7 @@ func (m *ReleaseCollectionRequest) Reset()         { *m = ReleaseCollectionReque
 func (m *ReleaseCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleaseCollectionRequest) ProtoMessage()    {}
 func (*ReleaseCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{11}
+	return fileDescriptor_02345ba45cc0e303, []int{20}
 }
 
 func (m *ReleaseCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -969,7 +1595,7 @@ func (m *GetStatisticsRequest) Reset()         { *m = GetStatisticsRequest{} }
 func (m *GetStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetStatisticsRequest) ProtoMessage()    {}
 func (*GetStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{12}
+	return fileDescriptor_02345ba45cc0e303, []int{21}
 }
 
 func (m *GetStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -1042,7 +1668,7 @@ func (m *GetStatisticsResponse) Reset()         { *m = GetStatisticsResponse{} }
 func (m *GetStatisticsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetStatisticsResponse) ProtoMessage()    {}
 func (*GetStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{13}
+	return fileDescriptor_02345ba45cc0e303, []int{22}
 }
 
 func (m *GetStatisticsResponse) XXX_Unmarshal(b []byte) error {
@@ -1095,7 +1721,7 @@ func (m *GetCollectionStatisticsRequest) Reset()         { *m = GetCollectionSta
 func (m *GetCollectionStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCollectionStatisticsRequest) ProtoMessage()    {}
 func (*GetCollectionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{14}
+	return fileDescriptor_02345ba45cc0e303, []int{23}
 }
 
 func (m *GetCollectionStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -1153,7 +1779,7 @@ func (m *GetCollectionStatisticsResponse) Reset()         { *m = GetCollectionSt
 func (m *GetCollectionStatisticsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCollectionStatisticsResponse) ProtoMessage()    {}
 func (*GetCollectionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{15}
+	return fileDescriptor_02345ba45cc0e303, []int{24}
 }
 
 func (m *GetCollectionStatisticsResponse) XXX_Unmarshal(b []byte) error {
@@ -1210,7 +1836,7 @@ func (m *ShowCollectionsRequest) Reset()         { *m = ShowCollectionsRequest{}
 func (m *ShowCollectionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowCollectionsRequest) ProtoMessage()    {}
 func (*ShowCollectionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{16}
+	return fileDescriptor_02345ba45cc0e303, []int{25}
 }
 
 func (m *ShowCollectionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1292,7 +1918,7 @@ func (m *ShowCollectionsResponse) Reset()         { *m = ShowCollectionsResponse
 func (m *ShowCollectionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowCollectionsResponse) ProtoMessage()    {}
 func (*ShowCollectionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{17}
+	return fileDescriptor_02345ba45cc0e303, []int{26}
 }
 
 func (m *ShowCollectionsResponse) XXX_Unmarshal(b []byte) error {
@@ -1382,7 +2008,7 @@ func (m *CreatePartitionRequest) Reset()         { *m = CreatePartitionRequest{}
 func (m *CreatePartitionRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePartitionRequest) ProtoMessage()    {}
 func (*CreatePartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{18}
+	return fileDescriptor_02345ba45cc0e303, []int{27}
 }
 
 func (m *CreatePartitionRequest) XXX_Unmarshal(b []byte) error {
@@ -1401,106 +2027,173 @@ func (m *CreatePartitionRequest) XXX_DiscardUnknown() {
 	xxx_messageInfo_CreatePartitionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreatePartitionRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreatePartitionRequest proto.InternalMessageInfo
+
+func (m *CreatePartitionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreatePartitionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *CreatePartitionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *CreatePartitionRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+//
+// Drop partition in created collection.
+type DropPartitionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Not useful for now
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name in milvus
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The partition name you want to drop
+	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropPartitionRequest) Reset()         { *m = DropPartitionRequest{} }
+func (m *DropPartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*DropPartitionRequest) ProtoMessage()    {}
+func (*DropPartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{28}
+}
+
+func (m *DropPartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropPartitionRequest.Unmarshal(m, b)
+}
+func (m *DropPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropPartitionRequest.Marshal(b, m, deterministic)
+}
+func (m *DropPartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropPartitionRequest.Merge(m, src)
+}
+func (m *DropPartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_DropPartitionRequest.Size(m)
+}
+func (m *DropPartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropPartitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropPartitionRequest proto.InternalMessageInfo
 
-func (m *CreatePartitionRequest) GetBase() *commonpb.MsgBase {
+func (m *DropPartitionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *CreatePartitionRequest) GetDbName() string {
+func (m *DropPartitionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *CreatePartitionRequest) GetCollectionName() string {
+func (m *DropPartitionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *CreatePartitionRequest) GetPartitionName() string {
+func (m *DropPartitionRequest) GetPartitionName() string {
 	if m != nil {
 		return m.PartitionName
 	}
 	return ""
 }
 
-//
-// Drop partition in created collection.
-type DropPartitionRequest struct {
+// Remove all the entities of a partition, the partition ID and the load state are kept.
+type TruncatePartitionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Not useful for now
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// The collection name in milvus
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The partition name you want to drop
+	// The partition name you want to truncate
 	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DropPartitionRequest) Reset()         { *m = DropPartitionRequest{} }
-func (m *DropPartitionRequest) String() string { return proto.CompactTextString(m) }
-func (*DropPartitionRequest) ProtoMessage()    {}
-func (*DropPartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{19}
+func (m *TruncatePartitionRequest) Reset()         { *m = TruncatePartitionRequest{} }
+func (m *TruncatePartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*TruncatePartitionRequest) ProtoMessage()    {}
+func (*TruncatePartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{29}
 }
 
-func (m *DropPartitionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DropPartitionRequest.Unmarshal(m, b)
+func (m *TruncatePartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TruncatePartitionRequest.Unmarshal(m, b)
 }
-func (m *DropPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DropPartitionRequest.Marshal(b, m, deterministic)
+func (m *TruncatePartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TruncatePartitionRequest.Marshal(b, m, deterministic)
 }
-func (m *DropPartitionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropPartitionRequest.Merge(m, src)
+func (m *TruncatePartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncatePartitionRequest.Merge(m, src)
 }
-func (m *DropPartitionRequest) XXX_Size() int {
-	return xxx_messageInfo_DropPartitionRequest.Size(m)
+func (m *TruncatePartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_TruncatePartitionRequest.Size(m)
 }
-func (m *DropPartitionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropPartitionRequest.DiscardUnknown(m)
+func (m *TruncatePartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncatePartitionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropPartitionRequest proto.InternalMessageInfo
+var xxx_messageInfo_TruncatePartitionRequest proto.InternalMessageInfo
 
-func (m *DropPartitionRequest) GetBase() *commonpb.MsgBase {
+func (m *TruncatePartitionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DropPartitionRequest) GetDbName() string {
+func (m *TruncatePartitionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *DropPartitionRequest) GetCollectionName() string {
+func (m *TruncatePartitionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *DropPartitionRequest) GetPartitionName() string {
+func (m *TruncatePartitionRequest) GetPartitionName() string {
 	if m != nil {
 		return m.PartitionName
 	}
 	return ""
 }
 
-//
 // Check if partition exist in collection or not.
 type HasPartitionRequest struct {
 	// Not useful for now
@@ -1520,7 +2213,7 @@ func (m *HasPartitionRequest) Reset()         { *m = HasPartitionRequest{} }
 func (m *HasPartitionRequest) String() string { return proto.CompactTextString(m) }
 func (*HasPartitionRequest) ProtoMessage()    {}
 func (*HasPartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{20}
+	return fileDescriptor_02345ba45cc0e303, []int{30}
 }
 
 func (m *HasPartitionRequest) XXX_Unmarshal(b []byte) error {
@@ -1592,7 +2285,7 @@ func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
 func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadPartitionsRequest) ProtoMessage()    {}
 func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{21}
+	return fileDescriptor_02345ba45cc0e303, []int{31}
 }
 
 func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1669,7 +2362,7 @@ func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsReque
 func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleasePartitionsRequest) ProtoMessage()    {}
 func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{22}
+	return fileDescriptor_02345ba45cc0e303, []int{32}
 }
 
 func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1738,7 +2431,7 @@ func (m *GetPartitionStatisticsRequest) Reset()         { *m = GetPartitionStati
 func (m *GetPartitionStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatisticsRequest) ProtoMessage()    {}
 func (*GetPartitionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{23}
+	return fileDescriptor_02345ba45cc0e303, []int{33}
 }
 
 func (m *GetPartitionStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -1799,7 +2492,7 @@ func (m *GetPartitionStatisticsResponse) Reset()         { *m = GetPartitionStat
 func (m *GetPartitionStatisticsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatisticsResponse) ProtoMessage()    {}
 func (*GetPartitionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{24}
+	return fileDescriptor_02345ba45cc0e303, []int{34}
 }
 
 func (m *GetPartitionStatisticsResponse) XXX_Unmarshal(b []byte) error {
@@ -1858,7 +2551,7 @@ func (m *ShowPartitionsRequest) Reset()         { *m = ShowPartitionsRequest{} }
 func (m *ShowPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowPartitionsRequest) ProtoMessage()    {}
 func (*ShowPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{25}
+	return fileDescriptor_02345ba45cc0e303, []int{35}
 }
 
 func (m *ShowPartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1946,7 +2639,7 @@ func (m *ShowPartitionsResponse) Reset()         { *m = ShowPartitionsResponse{}
 func (m *ShowPartitionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowPartitionsResponse) ProtoMessage()    {}
 func (*ShowPartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{26}
+	return fileDescriptor_02345ba45cc0e303, []int{36}
 }
 
 func (m *ShowPartitionsResponse) XXX_Unmarshal(b []byte) error {
@@ -2022,7 +2715,7 @@ func (m *DescribeSegmentRequest) Reset()         { *m = DescribeSegmentRequest{}
 func (m *DescribeSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentRequest) ProtoMessage()    {}
 func (*DescribeSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{27}
+	return fileDescriptor_02345ba45cc0e303, []int{37}
 }
 
 func (m *DescribeSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -2079,7 +2772,7 @@ func (m *DescribeSegmentResponse) Reset()         { *m = DescribeSegmentResponse
 func (m *DescribeSegmentResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentResponse) ProtoMessage()    {}
 func (*DescribeSegmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{28}
+	return fileDescriptor_02345ba45cc0e303, []int{38}
 }
 
 func (m *DescribeSegmentResponse) XXX_Unmarshal(b []byte) error {
@@ -2148,7 +2841,7 @@ func (m *ShowSegmentsRequest) Reset()         { *m = ShowSegmentsRequest{} }
 func (m *ShowSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowSegmentsRequest) ProtoMessage()    {}
 func (*ShowSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{29}
+	return fileDescriptor_02345ba45cc0e303, []int{39}
 }
 
 func (m *ShowSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2202,7 +2895,7 @@ func (m *ShowSegmentsResponse) Reset()         { *m = ShowSegmentsResponse{} }
 func (m *ShowSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowSegmentsResponse) ProtoMessage()    {}
 func (*ShowSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{30}
+	return fileDescriptor_02345ba45cc0e303, []int{40}
 }
 
 func (m *ShowSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -2261,7 +2954,7 @@ func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
 func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateIndexRequest) ProtoMessage()    {}
 func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{31}
+	return fileDescriptor_02345ba45cc0e303, []int{41}
 }
 
 func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -2347,7 +3040,7 @@ func (m *DescribeIndexRequest) Reset()         { *m = DescribeIndexRequest{} }
 func (m *DescribeIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeIndexRequest) ProtoMessage()    {}
 func (*DescribeIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{32}
+	return fileDescriptor_02345ba45cc0e303, []int{42}
 }
 
 func (m *DescribeIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -2423,7 +3116,7 @@ func (m *IndexDescription) Reset()         { *m = IndexDescription{} }
 func (m *IndexDescription) String() string { return proto.CompactTextString(m) }
 func (*IndexDescription) ProtoMessage()    {}
 func (*IndexDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{33}
+	return fileDescriptor_02345ba45cc0e303, []int{43}
 }
 
 func (m *IndexDescription) XXX_Unmarshal(b []byte) error {
@@ -2488,7 +3181,7 @@ func (m *DescribeIndexResponse) Reset()         { *m = DescribeIndexResponse{} }
 func (m *DescribeIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeIndexResponse) ProtoMessage()    {}
 func (*DescribeIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{34}
+	return fileDescriptor_02345ba45cc0e303, []int{44}
 }
 
 func (m *DescribeIndexResponse) XXX_Unmarshal(b []byte) error {
@@ -2545,7 +3238,7 @@ func (m *GetIndexBuildProgressRequest) Reset()         { *m = GetIndexBuildProgr
 func (m *GetIndexBuildProgressRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexBuildProgressRequest) ProtoMessage()    {}
 func (*GetIndexBuildProgressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{35}
+	return fileDescriptor_02345ba45cc0e303, []int{45}
 }
 
 func (m *GetIndexBuildProgressRequest) XXX_Unmarshal(b []byte) error {
@@ -2614,7 +3307,7 @@ func (m *GetIndexBuildProgressResponse) Reset()         { *m = GetIndexBuildProg
 func (m *GetIndexBuildProgressResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexBuildProgressResponse) ProtoMessage()    {}
 func (*GetIndexBuildProgressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{36}
+	return fileDescriptor_02345ba45cc0e303, []int{46}
 }
 
 func (m *GetIndexBuildProgressResponse) XXX_Unmarshal(b []byte) error {
@@ -2671,7 +3364,7 @@ func (m *GetIndexStateRequest) Reset()         { *m = GetIndexStateRequest{} }
 func (m *GetIndexStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexStateRequest) ProtoMessage()    {}
 func (*GetIndexStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{37}
+	return fileDescriptor_02345ba45cc0e303, []int{47}
 }
 
 func (m *GetIndexStateRequest) XXX_Unmarshal(b []byte) error {
@@ -2740,7 +3433,7 @@ func (m *GetIndexStateResponse) Reset()         { *m = GetIndexStateResponse{} }
 func (m *GetIndexStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexStateResponse) ProtoMessage()    {}
 func (*GetIndexStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{38}
+	return fileDescriptor_02345ba45cc0e303, []int{48}
 }
 
 func (m *GetIndexStateResponse) XXX_Unmarshal(b []byte) error {
@@ -2797,7 +3490,7 @@ func (m *DropIndexRequest) Reset()         { *m = DropIndexRequest{} }
 func (m *DropIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DropIndexRequest) ProtoMessage()    {}
 func (*DropIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{39}
+	return fileDescriptor_02345ba45cc0e303, []int{49}
 }
 
 func (m *DropIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -2870,7 +3563,7 @@ func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
 func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
 func (*InsertRequest) ProtoMessage()    {}
 func (*InsertRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{40}
+	return fileDescriptor_02345ba45cc0e303, []int{50}
 }
 
 func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
@@ -2959,7 +3652,7 @@ func (m *MutationResult) Reset()         { *m = MutationResult{} }
 func (m *MutationResult) String() string { return proto.CompactTextString(m) }
 func (*MutationResult) ProtoMessage()    {}
 func (*MutationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{41}
+	return fileDescriptor_02345ba45cc0e303, []int{51}
 }
 
 func (m *MutationResult) XXX_Unmarshal(b []byte) error {
@@ -3055,71 +3748,361 @@ type DeleteRequest struct {
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
-func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteRequest) ProtoMessage()    {}
-func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{42}
+func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()    {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{52}
+}
+
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+}
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(m, src)
+}
+func (m *DeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRequest.Size(m)
+}
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+
+func (m *DeleteRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DeleteRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetHashKeys() []uint32 {
+	if m != nil {
+		return m.HashKeys
+	}
+	return nil
+}
+
+type DeleteByExprRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName  string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	// The filter of the entities to delete, any boolean expression supported by query.(Required)
+	Expr                 string   `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteByExprRequest) Reset()         { *m = DeleteByExprRequest{} }
+func (m *DeleteByExprRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteByExprRequest) ProtoMessage()    {}
+func (*DeleteByExprRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{53}
+}
+
+func (m *DeleteByExprRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteByExprRequest.Unmarshal(m, b)
+}
+func (m *DeleteByExprRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteByExprRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteByExprRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteByExprRequest.Merge(m, src)
+}
+func (m *DeleteByExprRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteByExprRequest.Size(m)
+}
+func (m *DeleteByExprRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteByExprRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteByExprRequest proto.InternalMessageInfo
+
+func (m *DeleteByExprRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DeleteByExprRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *DeleteByExprRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *DeleteByExprRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *DeleteByExprRequest) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
+}
+
+type DeleteByExprResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The id of the delete job, used to get the job state
+	JobID                int64    `protobuf:"varint,2,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteByExprResponse) Reset()         { *m = DeleteByExprResponse{} }
+func (m *DeleteByExprResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteByExprResponse) ProtoMessage()    {}
+func (*DeleteByExprResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{54}
+}
+
+func (m *DeleteByExprResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteByExprResponse.Unmarshal(m, b)
+}
+func (m *DeleteByExprResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteByExprResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteByExprResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteByExprResponse.Merge(m, src)
+}
+func (m *DeleteByExprResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteByExprResponse.Size(m)
+}
+func (m *DeleteByExprResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteByExprResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteByExprResponse proto.InternalMessageInfo
+
+func (m *DeleteByExprResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *DeleteByExprResponse) GetJobID() int64 {
+	if m != nil {
+		return m.JobID
+	}
+	return 0
+}
+
+type GetDeleteJobStateRequest struct {
+	Base   *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	JobID  int64             `protobuf:"varint,2,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	DbName string            `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection the job deletes from, the job of other collections is not found.(Required)
+	CollectionName       string   `protobuf:"bytes,4,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeleteJobStateRequest) Reset()         { *m = GetDeleteJobStateRequest{} }
+func (m *GetDeleteJobStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeleteJobStateRequest) ProtoMessage()    {}
+func (*GetDeleteJobStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{55}
+}
+
+func (m *GetDeleteJobStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeleteJobStateRequest.Unmarshal(m, b)
+}
+func (m *GetDeleteJobStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeleteJobStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDeleteJobStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeleteJobStateRequest.Merge(m, src)
+}
+func (m *GetDeleteJobStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeleteJobStateRequest.Size(m)
+}
+func (m *GetDeleteJobStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeleteJobStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeleteJobStateRequest proto.InternalMessageInfo
+
+func (m *GetDeleteJobStateRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetDeleteJobStateRequest) GetJobID() int64 {
+	if m != nil {
+		return m.JobID
+	}
+	return 0
+}
+
+func (m *GetDeleteJobStateRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetDeleteJobStateRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type GetDeleteJobStateResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State  DeleteJobState   `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.milvus.DeleteJobState" json:"state,omitempty"`
+	// The number of the entities deleted so far
+	DeletedRows int64 `protobuf:"varint,3,opt,name=deleted_rows,json=deletedRows,proto3" json:"deleted_rows,omitempty"`
+	// The failed reason if the job failed
+	Reason         string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	CollectionName string `protobuf:"bytes,5,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName  string `protobuf:"bytes,6,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	// The expression submitted, without the row policies applied
+	Expr                 string   `protobuf:"bytes,7,opt,name=expr,proto3" json:"expr,omitempty"`
+	CreateTs             uint64   `protobuf:"varint,8,opt,name=create_ts,json=createTs,proto3" json:"create_ts,omitempty"`
+	UpdateTs             uint64   `protobuf:"varint,9,opt,name=update_ts,json=updateTs,proto3" json:"update_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+func (m *GetDeleteJobStateResponse) Reset()         { *m = GetDeleteJobStateResponse{} }
+func (m *GetDeleteJobStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeleteJobStateResponse) ProtoMessage()    {}
+func (*GetDeleteJobStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{56}
 }
-func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+
+func (m *GetDeleteJobStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeleteJobStateResponse.Unmarshal(m, b)
 }
-func (m *DeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteRequest.Merge(m, src)
+func (m *GetDeleteJobStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeleteJobStateResponse.Marshal(b, m, deterministic)
 }
-func (m *DeleteRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteRequest.Size(m)
+func (m *GetDeleteJobStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeleteJobStateResponse.Merge(m, src)
 }
-func (m *DeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+func (m *GetDeleteJobStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeleteJobStateResponse.Size(m)
+}
+func (m *GetDeleteJobStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeleteJobStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetDeleteJobStateResponse proto.InternalMessageInfo
 
-func (m *DeleteRequest) GetBase() *commonpb.MsgBase {
+func (m *GetDeleteJobStateResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *DeleteRequest) GetDbName() string {
+func (m *GetDeleteJobStateResponse) GetState() DeleteJobState {
 	if m != nil {
-		return m.DbName
+		return m.State
+	}
+	return DeleteJobState_DeleteJobPending
+}
+
+func (m *GetDeleteJobStateResponse) GetDeletedRows() int64 {
+	if m != nil {
+		return m.DeletedRows
+	}
+	return 0
+}
+
+func (m *GetDeleteJobStateResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
 	return ""
 }
 
-func (m *DeleteRequest) GetCollectionName() string {
+func (m *GetDeleteJobStateResponse) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *DeleteRequest) GetPartitionName() string {
+func (m *GetDeleteJobStateResponse) GetPartitionName() string {
 	if m != nil {
 		return m.PartitionName
 	}
 	return ""
 }
 
-func (m *DeleteRequest) GetExpr() string {
+func (m *GetDeleteJobStateResponse) GetExpr() string {
 	if m != nil {
 		return m.Expr
 	}
 	return ""
 }
 
-func (m *DeleteRequest) GetHashKeys() []uint32 {
+func (m *GetDeleteJobStateResponse) GetCreateTs() uint64 {
 	if m != nil {
-		return m.HashKeys
+		return m.CreateTs
 	}
-	return nil
+	return 0
+}
+
+func (m *GetDeleteJobStateResponse) GetUpdateTs() uint64 {
+	if m != nil {
+		return m.UpdateTs
+	}
+	return 0
 }
 
 type SearchRequest struct {
@@ -3136,6 +4119,9 @@ type SearchRequest struct {
 	TravelTimestamp      uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
 	GuaranteeTimestamp   uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
 	Nq                   int64                    `protobuf:"varint,12,opt,name=nq,proto3" json:"nq,omitempty"`
+	Explain              bool                     `protobuf:"varint,13,opt,name=explain,proto3" json:"explain,omitempty"`
+	Priority             int32                    `protobuf:"varint,14,opt,name=priority,proto3" json:"priority,omitempty"`
+	TravelTime           string                   `protobuf:"bytes,15,opt,name=travel_time,json=travelTime,proto3" json:"travel_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -3145,7 +4131,7 @@ func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
 func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()    {}
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{43}
+	return fileDescriptor_02345ba45cc0e303, []int{57}
 }
 
 func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
@@ -3250,6 +4236,27 @@ func (m *SearchRequest) GetNq() int64 {
 	return 0
 }
 
+func (m *SearchRequest) GetExplain() bool {
+	if m != nil {
+		return m.Explain
+	}
+	return false
+}
+
+func (m *SearchRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetTravelTime() string {
+	if m != nil {
+		return m.TravelTime
+	}
+	return ""
+}
+
 type Hits struct {
 	IDs                  []int64   `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
 	RowData              [][]byte  `protobuf:"bytes,2,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
@@ -3263,7 +4270,7 @@ func (m *Hits) Reset()         { *m = Hits{} }
 func (m *Hits) String() string { return proto.CompactTextString(m) }
 func (*Hits) ProtoMessage()    {}
 func (*Hits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{44}
+	return fileDescriptor_02345ba45cc0e303, []int{58}
 }
 
 func (m *Hits) XXX_Unmarshal(b []byte) error {
@@ -3306,19 +4313,22 @@ func (m *Hits) GetScores() []float32 {
 }
 
 type SearchResults struct {
-	Status               *commonpb.Status           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Results              *schemapb.SearchResultData `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
-	CollectionName       string                     `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	Status         *commonpb.Status           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results        *schemapb.SearchResultData `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
+	CollectionName string                     `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Explain        *QueryExplain              `protobuf:"bytes,4,opt,name=explain,proto3" json:"explain,omitempty"`
+	// offset of the first query of the results in the request, used by SearchStream
+	NqOffset             int64    `protobuf:"varint,5,opt,name=nq_offset,json=nqOffset,proto3" json:"nq_offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SearchResults) Reset()         { *m = SearchResults{} }
 func (m *SearchResults) String() string { return proto.CompactTextString(m) }
 func (*SearchResults) ProtoMessage()    {}
 func (*SearchResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{45}
+	return fileDescriptor_02345ba45cc0e303, []int{59}
 }
 
 func (m *SearchResults) XXX_Unmarshal(b []byte) error {
@@ -3360,6 +4370,20 @@ func (m *SearchResults) GetCollectionName() string {
 	return ""
 }
 
+func (m *SearchResults) GetExplain() *QueryExplain {
+	if m != nil {
+		return m.Explain
+	}
+	return nil
+}
+
+func (m *SearchResults) GetNqOffset() int64 {
+	if m != nil {
+		return m.NqOffset
+	}
+	return 0
+}
+
 type FlushRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -3373,7 +4397,7 @@ func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
 func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
 func (*FlushRequest) ProtoMessage()    {}
 func (*FlushRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{46}
+	return fileDescriptor_02345ba45cc0e303, []int{60}
 }
 
 func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
@@ -3428,7 +4452,7 @@ func (m *FlushResponse) Reset()         { *m = FlushResponse{} }
 func (m *FlushResponse) String() string { return proto.CompactTextString(m) }
 func (*FlushResponse) ProtoMessage()    {}
 func (*FlushResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{47}
+	return fileDescriptor_02345ba45cc0e303, []int{61}
 }
 
 func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
@@ -3479,6 +4503,9 @@ type QueryRequest struct {
 	PartitionNames       []string          `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
 	TravelTimestamp      uint64            `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
 	GuaranteeTimestamp   uint64            `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	Explain              bool              `protobuf:"varint,9,opt,name=explain,proto3" json:"explain,omitempty"`
+	Priority             int32             `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	TravelTime           string            `protobuf:"bytes,11,opt,name=travel_time,json=travelTime,proto3" json:"travel_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -3488,7 +4515,7 @@ func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{48}
+	return fileDescriptor_02345ba45cc0e303, []int{62}
 }
 
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
@@ -3565,59 +4592,301 @@ func (m *QueryRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *QueryRequest) GetExplain() bool {
+	if m != nil {
+		return m.Explain
+	}
+	return false
+}
+
+func (m *QueryRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *QueryRequest) GetTravelTime() string {
+	if m != nil {
+		return m.TravelTime
+	}
+	return ""
+}
+
 type QueryResults struct {
 	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	FieldsData           []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
 	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Explain              *QueryExplain         `protobuf:"bytes,4,opt,name=explain,proto3" json:"explain,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *QueryResults) Reset()         { *m = QueryResults{} }
-func (m *QueryResults) String() string { return proto.CompactTextString(m) }
-func (*QueryResults) ProtoMessage()    {}
-func (*QueryResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{49}
+func (m *QueryResults) Reset()         { *m = QueryResults{} }
+func (m *QueryResults) String() string { return proto.CompactTextString(m) }
+func (*QueryResults) ProtoMessage()    {}
+func (*QueryResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{63}
+}
+
+func (m *QueryResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryResults.Unmarshal(m, b)
+}
+func (m *QueryResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryResults.Marshal(b, m, deterministic)
+}
+func (m *QueryResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResults.Merge(m, src)
+}
+func (m *QueryResults) XXX_Size() int {
+	return xxx_messageInfo_QueryResults.Size(m)
+}
+func (m *QueryResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResults proto.InternalMessageInfo
+
+func (m *QueryResults) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *QueryResults) GetFieldsData() []*schemapb.FieldData {
+	if m != nil {
+		return m.FieldsData
+	}
+	return nil
+}
+
+func (m *QueryResults) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *QueryResults) GetExplain() *QueryExplain {
+	if m != nil {
+		return m.Explain
+	}
+	return nil
+}
+
+type SegmentProfile struct {
+	SegmentID            int64    `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	Sealed               bool     `protobuf:"varint,2,opt,name=sealed,proto3" json:"sealed,omitempty"`
+	ScannedRows          int64    `protobuf:"varint,3,opt,name=scanned_rows,json=scannedRows,proto3" json:"scanned_rows,omitempty"`
+	FilteredRows         int64    `protobuf:"varint,4,opt,name=filtered_rows,json=filteredRows,proto3" json:"filtered_rows,omitempty"`
+	FilterUs             int64    `protobuf:"varint,5,opt,name=filter_us,json=filterUs,proto3" json:"filter_us,omitempty"`
+	SearchUs             int64    `protobuf:"varint,6,opt,name=search_us,json=searchUs,proto3" json:"search_us,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentProfile) Reset()         { *m = SegmentProfile{} }
+func (m *SegmentProfile) String() string { return proto.CompactTextString(m) }
+func (*SegmentProfile) ProtoMessage()    {}
+func (*SegmentProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{64}
+}
+
+func (m *SegmentProfile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentProfile.Unmarshal(m, b)
+}
+func (m *SegmentProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentProfile.Marshal(b, m, deterministic)
+}
+func (m *SegmentProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentProfile.Merge(m, src)
+}
+func (m *SegmentProfile) XXX_Size() int {
+	return xxx_messageInfo_SegmentProfile.Size(m)
+}
+func (m *SegmentProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentProfile proto.InternalMessageInfo
+
+func (m *SegmentProfile) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *SegmentProfile) GetSealed() bool {
+	if m != nil {
+		return m.Sealed
+	}
+	return false
+}
+
+func (m *SegmentProfile) GetScannedRows() int64 {
+	if m != nil {
+		return m.ScannedRows
+	}
+	return 0
+}
+
+func (m *SegmentProfile) GetFilteredRows() int64 {
+	if m != nil {
+		return m.FilteredRows
+	}
+	return 0
+}
+
+func (m *SegmentProfile) GetFilterUs() int64 {
+	if m != nil {
+		return m.FilterUs
+	}
+	return 0
+}
+
+func (m *SegmentProfile) GetSearchUs() int64 {
+	if m != nil {
+		return m.SearchUs
+	}
+	return 0
+}
+
+type ShardProfile struct {
+	Channel              string            `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	PartitionIDs         []int64           `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	Segments             []*SegmentProfile `protobuf:"bytes,4,rep,name=segments,proto3" json:"segments,omitempty"`
+	WaitTsafeUs          int64             `protobuf:"varint,5,opt,name=wait_tsafe_us,json=waitTsafeUs,proto3" json:"wait_tsafe_us,omitempty"`
+	ReduceUs             int64             `protobuf:"varint,6,opt,name=reduce_us,json=reduceUs,proto3" json:"reduce_us,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ShardProfile) Reset()         { *m = ShardProfile{} }
+func (m *ShardProfile) String() string { return proto.CompactTextString(m) }
+func (*ShardProfile) ProtoMessage()    {}
+func (*ShardProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{65}
+}
+
+func (m *ShardProfile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardProfile.Unmarshal(m, b)
+}
+func (m *ShardProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardProfile.Marshal(b, m, deterministic)
+}
+func (m *ShardProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardProfile.Merge(m, src)
+}
+func (m *ShardProfile) XXX_Size() int {
+	return xxx_messageInfo_ShardProfile.Size(m)
+}
+func (m *ShardProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardProfile proto.InternalMessageInfo
+
+func (m *ShardProfile) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ShardProfile) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *ShardProfile) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *ShardProfile) GetSegments() []*SegmentProfile {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ShardProfile) GetWaitTsafeUs() int64 {
+	if m != nil {
+		return m.WaitTsafeUs
+	}
+	return 0
+}
+
+func (m *ShardProfile) GetReduceUs() int64 {
+	if m != nil {
+		return m.ReduceUs
+	}
+	return 0
+}
+
+type QueryExplain struct {
+	Plan                 string          `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	Shards               []*ShardProfile `protobuf:"bytes,2,rep,name=shards,proto3" json:"shards,omitempty"`
+	ProxyReduceUs        int64           `protobuf:"varint,3,opt,name=proxy_reduce_us,json=proxyReduceUs,proto3" json:"proxy_reduce_us,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *QueryExplain) Reset()         { *m = QueryExplain{} }
+func (m *QueryExplain) String() string { return proto.CompactTextString(m) }
+func (*QueryExplain) ProtoMessage()    {}
+func (*QueryExplain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{66}
 }
 
-func (m *QueryResults) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QueryResults.Unmarshal(m, b)
+func (m *QueryExplain) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryExplain.Unmarshal(m, b)
 }
-func (m *QueryResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QueryResults.Marshal(b, m, deterministic)
+func (m *QueryExplain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryExplain.Marshal(b, m, deterministic)
 }
-func (m *QueryResults) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryResults.Merge(m, src)
+func (m *QueryExplain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExplain.Merge(m, src)
 }
-func (m *QueryResults) XXX_Size() int {
-	return xxx_messageInfo_QueryResults.Size(m)
+func (m *QueryExplain) XXX_Size() int {
+	return xxx_messageInfo_QueryExplain.Size(m)
 }
-func (m *QueryResults) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryResults.DiscardUnknown(m)
+func (m *QueryExplain) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExplain.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryResults proto.InternalMessageInfo
+var xxx_messageInfo_QueryExplain proto.InternalMessageInfo
 
-func (m *QueryResults) GetStatus() *commonpb.Status {
+func (m *QueryExplain) GetPlan() string {
 	if m != nil {
-		return m.Status
+		return m.Plan
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryResults) GetFieldsData() []*schemapb.FieldData {
+func (m *QueryExplain) GetShards() []*ShardProfile {
 	if m != nil {
-		return m.FieldsData
+		return m.Shards
 	}
 	return nil
 }
 
-func (m *QueryResults) GetCollectionName() string {
+func (m *QueryExplain) GetProxyReduceUs() int64 {
 	if m != nil {
-		return m.CollectionName
+		return m.ProxyReduceUs
 	}
-	return ""
+	return 0
 }
 
 type VectorIDs struct {
@@ -3634,7 +4903,7 @@ func (m *VectorIDs) Reset()         { *m = VectorIDs{} }
 func (m *VectorIDs) String() string { return proto.CompactTextString(m) }
 func (*VectorIDs) ProtoMessage()    {}
 func (*VectorIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{50}
+	return fileDescriptor_02345ba45cc0e303, []int{67}
 }
 
 func (m *VectorIDs) XXX_Unmarshal(b []byte) error {
@@ -3697,7 +4966,7 @@ func (m *VectorsArray) Reset()         { *m = VectorsArray{} }
 func (m *VectorsArray) String() string { return proto.CompactTextString(m) }
 func (*VectorsArray) ProtoMessage()    {}
 func (*VectorsArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{51}
+	return fileDescriptor_02345ba45cc0e303, []int{68}
 }
 
 func (m *VectorsArray) XXX_Unmarshal(b []byte) error {
@@ -3777,7 +5046,7 @@ func (m *CalcDistanceRequest) Reset()         { *m = CalcDistanceRequest{} }
 func (m *CalcDistanceRequest) String() string { return proto.CompactTextString(m) }
 func (*CalcDistanceRequest) ProtoMessage()    {}
 func (*CalcDistanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{52}
+	return fileDescriptor_02345ba45cc0e303, []int{69}
 }
 
 func (m *CalcDistanceRequest) XXX_Unmarshal(b []byte) error {
@@ -3843,7 +5112,7 @@ func (m *CalcDistanceResults) Reset()         { *m = CalcDistanceResults{} }
 func (m *CalcDistanceResults) String() string { return proto.CompactTextString(m) }
 func (*CalcDistanceResults) ProtoMessage()    {}
 func (*CalcDistanceResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{53}
+	return fileDescriptor_02345ba45cc0e303, []int{70}
 }
 
 func (m *CalcDistanceResults) XXX_Unmarshal(b []byte) error {
@@ -3931,7 +5200,7 @@ func (m *PersistentSegmentInfo) Reset()         { *m = PersistentSegmentInfo{} }
 func (m *PersistentSegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*PersistentSegmentInfo) ProtoMessage()    {}
 func (*PersistentSegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{54}
+	return fileDescriptor_02345ba45cc0e303, []int{71}
 }
 
 func (m *PersistentSegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -4000,7 +5269,7 @@ func (m *GetPersistentSegmentInfoRequest) Reset()         { *m = GetPersistentSe
 func (m *GetPersistentSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPersistentSegmentInfoRequest) ProtoMessage()    {}
 func (*GetPersistentSegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{55}
+	return fileDescriptor_02345ba45cc0e303, []int{72}
 }
 
 func (m *GetPersistentSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -4054,7 +5323,7 @@ func (m *GetPersistentSegmentInfoResponse) Reset()         { *m = GetPersistentS
 func (m *GetPersistentSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPersistentSegmentInfoResponse) ProtoMessage()    {}
 func (*GetPersistentSegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{56}
+	return fileDescriptor_02345ba45cc0e303, []int{73}
 }
 
 func (m *GetPersistentSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -4110,7 +5379,7 @@ func (m *QuerySegmentInfo) Reset()         { *m = QuerySegmentInfo{} }
 func (m *QuerySegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*QuerySegmentInfo) ProtoMessage()    {}
 func (*QuerySegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{57}
+	return fileDescriptor_02345ba45cc0e303, []int{74}
 }
 
 func (m *QuerySegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -4214,7 +5483,7 @@ func (m *GetQuerySegmentInfoRequest) Reset()         { *m = GetQuerySegmentInfoR
 func (m *GetQuerySegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetQuerySegmentInfoRequest) ProtoMessage()    {}
 func (*GetQuerySegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{58}
+	return fileDescriptor_02345ba45cc0e303, []int{75}
 }
 
 func (m *GetQuerySegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -4268,7 +5537,7 @@ func (m *GetQuerySegmentInfoResponse) Reset()         { *m = GetQuerySegmentInfo
 func (m *GetQuerySegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetQuerySegmentInfoResponse) ProtoMessage()    {}
 func (*GetQuerySegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{59}
+	return fileDescriptor_02345ba45cc0e303, []int{76}
 }
 
 func (m *GetQuerySegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -4314,7 +5583,7 @@ func (m *DummyRequest) Reset()         { *m = DummyRequest{} }
 func (m *DummyRequest) String() string { return proto.CompactTextString(m) }
 func (*DummyRequest) ProtoMessage()    {}
 func (*DummyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{60}
+	return fileDescriptor_02345ba45cc0e303, []int{77}
 }
 
 func (m *DummyRequest) XXX_Unmarshal(b []byte) error {
@@ -4353,7 +5622,7 @@ func (m *DummyResponse) Reset()         { *m = DummyResponse{} }
 func (m *DummyResponse) String() string { return proto.CompactTextString(m) }
 func (*DummyResponse) ProtoMessage()    {}
 func (*DummyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{61}
+	return fileDescriptor_02345ba45cc0e303, []int{78}
 }
 
 func (m *DummyResponse) XXX_Unmarshal(b []byte) error {
@@ -4391,7 +5660,7 @@ func (m *RegisterLinkRequest) Reset()         { *m = RegisterLinkRequest{} }
 func (m *RegisterLinkRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterLinkRequest) ProtoMessage()    {}
 func (*RegisterLinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{62}
+	return fileDescriptor_02345ba45cc0e303, []int{79}
 }
 
 func (m *RegisterLinkRequest) XXX_Unmarshal(b []byte) error {
@@ -4424,7 +5693,7 @@ func (m *RegisterLinkResponse) Reset()         { *m = RegisterLinkResponse{} }
 func (m *RegisterLinkResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterLinkResponse) ProtoMessage()    {}
 func (*RegisterLinkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{63}
+	return fileDescriptor_02345ba45cc0e303, []int{80}
 }
 
 func (m *RegisterLinkResponse) XXX_Unmarshal(b []byte) error {
@@ -4471,7 +5740,7 @@ func (m *GetMetricsRequest) Reset()         { *m = GetMetricsRequest{} }
 func (m *GetMetricsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMetricsRequest) ProtoMessage()    {}
 func (*GetMetricsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{64}
+	return fileDescriptor_02345ba45cc0e303, []int{81}
 }
 
 func (m *GetMetricsRequest) XXX_Unmarshal(b []byte) error {
@@ -4519,7 +5788,7 @@ func (m *GetMetricsResponse) Reset()         { *m = GetMetricsResponse{} }
 func (m *GetMetricsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMetricsResponse) ProtoMessage()    {}
 func (*GetMetricsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{65}
+	return fileDescriptor_02345ba45cc0e303, []int{82}
 }
 
 func (m *GetMetricsResponse) XXX_Unmarshal(b []byte) error {
@@ -4578,7 +5847,7 @@ func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
 func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceRequest) ProtoMessage()    {}
 func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{66}
+	return fileDescriptor_02345ba45cc0e303, []int{83}
 }
 
 func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -4637,6 +5906,10 @@ func (m *LoadBalanceRequest) GetCollectionName() string {
 type ManualCompactionRequest struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	Timetravel           uint64   `protobuf:"varint,2,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+	PartitionIDs         []int64  `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	SegmentIDs           []int64  `protobuf:"varint,4,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	TargetSize           int64    `protobuf:"varint,5,opt,name=target_size,json=targetSize,proto3" json:"target_size,omitempty"`
+	Priority             int32    `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4646,7 +5919,7 @@ func (m *ManualCompactionRequest) Reset()         { *m = ManualCompactionRequest
 func (m *ManualCompactionRequest) String() string { return proto.CompactTextString(m) }
 func (*ManualCompactionRequest) ProtoMessage()    {}
 func (*ManualCompactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{67}
+	return fileDescriptor_02345ba45cc0e303, []int{84}
 }
 
 func (m *ManualCompactionRequest) XXX_Unmarshal(b []byte) error {
@@ -4681,6 +5954,34 @@ func (m *ManualCompactionRequest) GetTimetravel() uint64 {
 	return 0
 }
 
+func (m *ManualCompactionRequest) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *ManualCompactionRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *ManualCompactionRequest) GetTargetSize() int64 {
+	if m != nil {
+		return m.TargetSize
+	}
+	return 0
+}
+
+func (m *ManualCompactionRequest) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 type ManualCompactionResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	CompactionID         int64            `protobuf:"varint,2,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
@@ -4693,7 +5994,7 @@ func (m *ManualCompactionResponse) Reset()         { *m = ManualCompactionRespon
 func (m *ManualCompactionResponse) String() string { return proto.CompactTextString(m) }
 func (*ManualCompactionResponse) ProtoMessage()    {}
 func (*ManualCompactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{68}
+	return fileDescriptor_02345ba45cc0e303, []int{85}
 }
 
 func (m *ManualCompactionResponse) XXX_Unmarshal(b []byte) error {
@@ -4739,7 +6040,7 @@ func (m *GetCompactionStateRequest) Reset()         { *m = GetCompactionStateReq
 func (m *GetCompactionStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCompactionStateRequest) ProtoMessage()    {}
 func (*GetCompactionStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{69}
+	return fileDescriptor_02345ba45cc0e303, []int{86}
 }
 
 func (m *GetCompactionStateRequest) XXX_Unmarshal(b []byte) error {
@@ -4773,6 +6074,7 @@ type GetCompactionStateResponse struct {
 	ExecutingPlanNo      int64                    `protobuf:"varint,3,opt,name=executingPlanNo,proto3" json:"executingPlanNo,omitempty"`
 	TimeoutPlanNo        int64                    `protobuf:"varint,4,opt,name=timeoutPlanNo,proto3" json:"timeoutPlanNo,omitempty"`
 	CompletedPlanNo      int64                    `protobuf:"varint,5,opt,name=completedPlanNo,proto3" json:"completedPlanNo,omitempty"`
+	CancelledPlanNo      int64                    `protobuf:"varint,6,opt,name=cancelledPlanNo,proto3" json:"cancelledPlanNo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -4782,7 +6084,7 @@ func (m *GetCompactionStateResponse) Reset()         { *m = GetCompactionStateRe
 func (m *GetCompactionStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCompactionStateResponse) ProtoMessage()    {}
 func (*GetCompactionStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{70}
+	return fileDescriptor_02345ba45cc0e303, []int{87}
 }
 
 func (m *GetCompactionStateResponse) XXX_Unmarshal(b []byte) error {
@@ -4838,6 +6140,52 @@ func (m *GetCompactionStateResponse) GetCompletedPlanNo() int64 {
 	return 0
 }
 
+func (m *GetCompactionStateResponse) GetCancelledPlanNo() int64 {
+	if m != nil {
+		return m.CancelledPlanNo
+	}
+	return 0
+}
+
+type CancelCompactionRequest struct {
+	CompactionID         int64    `protobuf:"varint,1,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelCompactionRequest) Reset()         { *m = CancelCompactionRequest{} }
+func (m *CancelCompactionRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelCompactionRequest) ProtoMessage()    {}
+func (*CancelCompactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{88}
+}
+
+func (m *CancelCompactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelCompactionRequest.Unmarshal(m, b)
+}
+func (m *CancelCompactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelCompactionRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelCompactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelCompactionRequest.Merge(m, src)
+}
+func (m *CancelCompactionRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelCompactionRequest.Size(m)
+}
+func (m *CancelCompactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelCompactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelCompactionRequest proto.InternalMessageInfo
+
+func (m *CancelCompactionRequest) GetCompactionID() int64 {
+	if m != nil {
+		return m.CompactionID
+	}
+	return 0
+}
+
 type GetCompactionPlansRequest struct {
 	CompactionID         int64    `protobuf:"varint,1,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -4849,7 +6197,7 @@ func (m *GetCompactionPlansRequest) Reset()         { *m = GetCompactionPlansReq
 func (m *GetCompactionPlansRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCompactionPlansRequest) ProtoMessage()    {}
 func (*GetCompactionPlansRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{71}
+	return fileDescriptor_02345ba45cc0e303, []int{89}
 }
 
 func (m *GetCompactionPlansRequest) XXX_Unmarshal(b []byte) error {
@@ -4890,7 +6238,7 @@ func (m *GetCompactionPlansResponse) Reset()         { *m = GetCompactionPlansRe
 func (m *GetCompactionPlansResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCompactionPlansResponse) ProtoMessage()    {}
 func (*GetCompactionPlansResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{72}
+	return fileDescriptor_02345ba45cc0e303, []int{90}
 }
 
 func (m *GetCompactionPlansResponse) XXX_Unmarshal(b []byte) error {
@@ -4944,7 +6292,7 @@ func (m *CompactionMergeInfo) Reset()         { *m = CompactionMergeInfo{} }
 func (m *CompactionMergeInfo) String() string { return proto.CompactTextString(m) }
 func (*CompactionMergeInfo) ProtoMessage()    {}
 func (*CompactionMergeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{73}
+	return fileDescriptor_02345ba45cc0e303, []int{91}
 }
 
 func (m *CompactionMergeInfo) XXX_Unmarshal(b []byte) error {
@@ -4979,6 +6327,205 @@ func (m *CompactionMergeInfo) GetTarget() int64 {
 	return 0
 }
 
+type GetCompactionPolicyStatsRequest struct {
+	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCompactionPolicyStatsRequest) Reset()         { *m = GetCompactionPolicyStatsRequest{} }
+func (m *GetCompactionPolicyStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionPolicyStatsRequest) ProtoMessage()    {}
+func (*GetCompactionPolicyStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{92}
+}
+
+func (m *GetCompactionPolicyStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionPolicyStatsRequest.Unmarshal(m, b)
+}
+func (m *GetCompactionPolicyStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionPolicyStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionPolicyStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionPolicyStatsRequest.Merge(m, src)
+}
+func (m *GetCompactionPolicyStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionPolicyStatsRequest.Size(m)
+}
+func (m *GetCompactionPolicyStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionPolicyStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionPolicyStatsRequest proto.InternalMessageInfo
+
+func (m *GetCompactionPolicyStatsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type SegmentCompactionStats struct {
+	SegmentID    int64  `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	PartitionID  int64  `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	Channel      string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	NumOfRows    int64  `protobuf:"varint,4,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	DeletedRows  int64  `protobuf:"varint,5,opt,name=deleted_rows,json=deletedRows,proto3" json:"deleted_rows,omitempty"`
+	DeltalogNum  int64  `protobuf:"varint,6,opt,name=deltalog_num,json=deltalogNum,proto3" json:"deltalog_num,omitempty"`
+	DeltalogSize int64  `protobuf:"varint,7,opt,name=deltalog_size,json=deltalogSize,proto3" json:"deltalog_size,omitempty"`
+	BinlogNum    int64  `protobuf:"varint,8,opt,name=binlog_num,json=binlogNum,proto3" json:"binlog_num,omitempty"`
+	// whether the segment is selected by the single compaction rules
+	Selected bool `protobuf:"varint,9,opt,name=selected,proto3" json:"selected,omitempty"`
+	// why the segment is selected or not
+	Reasons              []string `protobuf:"bytes,10,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentCompactionStats) Reset()         { *m = SegmentCompactionStats{} }
+func (m *SegmentCompactionStats) String() string { return proto.CompactTextString(m) }
+func (*SegmentCompactionStats) ProtoMessage()    {}
+func (*SegmentCompactionStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{93}
+}
+
+func (m *SegmentCompactionStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentCompactionStats.Unmarshal(m, b)
+}
+func (m *SegmentCompactionStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentCompactionStats.Marshal(b, m, deterministic)
+}
+func (m *SegmentCompactionStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentCompactionStats.Merge(m, src)
+}
+func (m *SegmentCompactionStats) XXX_Size() int {
+	return xxx_messageInfo_SegmentCompactionStats.Size(m)
+}
+func (m *SegmentCompactionStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentCompactionStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentCompactionStats proto.InternalMessageInfo
+
+func (m *SegmentCompactionStats) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *SegmentCompactionStats) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *SegmentCompactionStats) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *SegmentCompactionStats) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
+func (m *SegmentCompactionStats) GetDeletedRows() int64 {
+	if m != nil {
+		return m.DeletedRows
+	}
+	return 0
+}
+
+func (m *SegmentCompactionStats) GetDeltalogNum() int64 {
+	if m != nil {
+		return m.DeltalogNum
+	}
+	return 0
+}
+
+func (m *SegmentCompactionStats) GetDeltalogSize() int64 {
+	if m != nil {
+		return m.DeltalogSize
+	}
+	return 0
+}
+
+func (m *SegmentCompactionStats) GetBinlogNum() int64 {
+	if m != nil {
+		return m.BinlogNum
+	}
+	return 0
+}
+
+func (m *SegmentCompactionStats) GetSelected() bool {
+	if m != nil {
+		return m.Selected
+	}
+	return false
+}
+
+func (m *SegmentCompactionStats) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
+type GetCompactionPolicyStatsResponse struct {
+	Status               *commonpb.Status          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Segments             []*SegmentCompactionStats `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetCompactionPolicyStatsResponse) Reset()         { *m = GetCompactionPolicyStatsResponse{} }
+func (m *GetCompactionPolicyStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionPolicyStatsResponse) ProtoMessage()    {}
+func (*GetCompactionPolicyStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{94}
+}
+
+func (m *GetCompactionPolicyStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionPolicyStatsResponse.Unmarshal(m, b)
+}
+func (m *GetCompactionPolicyStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionPolicyStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionPolicyStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionPolicyStatsResponse.Merge(m, src)
+}
+func (m *GetCompactionPolicyStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionPolicyStatsResponse.Size(m)
+}
+func (m *GetCompactionPolicyStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionPolicyStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionPolicyStatsResponse proto.InternalMessageInfo
+
+func (m *GetCompactionPolicyStatsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCompactionPolicyStatsResponse) GetSegments() []*SegmentCompactionStats {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
 type GetFlushStateRequest struct {
 	SegmentIDs           []int64  `protobuf:"varint,1,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -4990,7 +6537,7 @@ func (m *GetFlushStateRequest) Reset()         { *m = GetFlushStateRequest{} }
 func (m *GetFlushStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFlushStateRequest) ProtoMessage()    {}
 func (*GetFlushStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{74}
+	return fileDescriptor_02345ba45cc0e303, []int{95}
 }
 
 func (m *GetFlushStateRequest) XXX_Unmarshal(b []byte) error {
@@ -5030,7 +6577,7 @@ func (m *GetFlushStateResponse) Reset()         { *m = GetFlushStateResponse{} }
 func (m *GetFlushStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFlushStateResponse) ProtoMessage()    {}
 func (*GetFlushStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{75}
+	return fileDescriptor_02345ba45cc0e303, []int{96}
 }
 
 func (m *GetFlushStateResponse) XXX_Unmarshal(b []byte) error {
@@ -5081,7 +6628,7 @@ func (m *ImportRequest) Reset()         { *m = ImportRequest{} }
 func (m *ImportRequest) String() string { return proto.CompactTextString(m) }
 func (*ImportRequest) ProtoMessage()    {}
 func (*ImportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{76}
+	return fileDescriptor_02345ba45cc0e303, []int{97}
 }
 
 func (m *ImportRequest) XXX_Unmarshal(b []byte) error {
@@ -5156,7 +6703,7 @@ func (m *ImportResponse) Reset()         { *m = ImportResponse{} }
 func (m *ImportResponse) String() string { return proto.CompactTextString(m) }
 func (*ImportResponse) ProtoMessage()    {}
 func (*ImportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{77}
+	return fileDescriptor_02345ba45cc0e303, []int{98}
 }
 
 func (m *ImportResponse) XXX_Unmarshal(b []byte) error {
@@ -5202,7 +6749,7 @@ func (m *GetImportStateRequest) Reset()         { *m = GetImportStateRequest{} }
 func (m *GetImportStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetImportStateRequest) ProtoMessage()    {}
 func (*GetImportStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{78}
+	return fileDescriptor_02345ba45cc0e303, []int{99}
 }
 
 func (m *GetImportStateRequest) XXX_Unmarshal(b []byte) error {
@@ -5248,7 +6795,7 @@ func (m *GetImportStateResponse) Reset()         { *m = GetImportStateResponse{}
 func (m *GetImportStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetImportStateResponse) ProtoMessage()    {}
 func (*GetImportStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{79}
+	return fileDescriptor_02345ba45cc0e303, []int{100}
 }
 
 func (m *GetImportStateResponse) XXX_Unmarshal(b []byte) error {
@@ -5335,7 +6882,7 @@ func (m *ListImportTasksRequest) Reset()         { *m = ListImportTasksRequest{}
 func (m *ListImportTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*ListImportTasksRequest) ProtoMessage()    {}
 func (*ListImportTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{80}
+	return fileDescriptor_02345ba45cc0e303, []int{101}
 }
 
 func (m *ListImportTasksRequest) XXX_Unmarshal(b []byte) error {
@@ -5368,7 +6915,7 @@ func (m *ListImportTasksResponse) Reset()         { *m = ListImportTasksResponse
 func (m *ListImportTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*ListImportTasksResponse) ProtoMessage()    {}
 func (*ListImportTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{81}
+	return fileDescriptor_02345ba45cc0e303, []int{102}
 }
 
 func (m *ListImportTasksResponse) XXX_Unmarshal(b []byte) error {
@@ -5416,7 +6963,7 @@ func (m *GetReplicasRequest) Reset()         { *m = GetReplicasRequest{} }
 func (m *GetReplicasRequest) String() string { return proto.CompactTextString(m) }
 func (*GetReplicasRequest) ProtoMessage()    {}
 func (*GetReplicasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{82}
+	return fileDescriptor_02345ba45cc0e303, []int{103}
 }
 
 func (m *GetReplicasRequest) XXX_Unmarshal(b []byte) error {
@@ -5470,7 +7017,7 @@ func (m *GetReplicasResponse) Reset()         { *m = GetReplicasResponse{} }
 func (m *GetReplicasResponse) String() string { return proto.CompactTextString(m) }
 func (*GetReplicasResponse) ProtoMessage()    {}
 func (*GetReplicasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{83}
+	return fileDescriptor_02345ba45cc0e303, []int{104}
 }
 
 func (m *GetReplicasResponse) XXX_Unmarshal(b []byte) error {
@@ -5520,7 +7067,7 @@ func (m *ReplicaInfo) Reset()         { *m = ReplicaInfo{} }
 func (m *ReplicaInfo) String() string { return proto.CompactTextString(m) }
 func (*ReplicaInfo) ProtoMessage()    {}
 func (*ReplicaInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{84}
+	return fileDescriptor_02345ba45cc0e303, []int{105}
 }
 
 func (m *ReplicaInfo) XXX_Unmarshal(b []byte) error {
@@ -5592,7 +7139,7 @@ func (m *ShardReplica) Reset()         { *m = ShardReplica{} }
 func (m *ShardReplica) String() string { return proto.CompactTextString(m) }
 func (*ShardReplica) ProtoMessage()    {}
 func (*ShardReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{85}
+	return fileDescriptor_02345ba45cc0e303, []int{106}
 }
 
 func (m *ShardReplica) XXX_Unmarshal(b []byte) error {
@@ -5661,7 +7208,7 @@ func (m *CreateCredentialRequest) Reset()         { *m = CreateCredentialRequest
 func (m *CreateCredentialRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateCredentialRequest) ProtoMessage()    {}
 func (*CreateCredentialRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{86}
+	return fileDescriptor_02345ba45cc0e303, []int{107}
 }
 
 func (m *CreateCredentialRequest) XXX_Unmarshal(b []byte) error {
@@ -5739,7 +7286,7 @@ func (m *UpdateCredentialRequest) Reset()         { *m = UpdateCredentialRequest
 func (m *UpdateCredentialRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateCredentialRequest) ProtoMessage()    {}
 func (*UpdateCredentialRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{87}
+	return fileDescriptor_02345ba45cc0e303, []int{108}
 }
 
 func (m *UpdateCredentialRequest) XXX_Unmarshal(b []byte) error {
@@ -5816,7 +7363,7 @@ func (m *DeleteCredentialRequest) Reset()         { *m = DeleteCredentialRequest
 func (m *DeleteCredentialRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteCredentialRequest) ProtoMessage()    {}
 func (*DeleteCredentialRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{88}
+	return fileDescriptor_02345ba45cc0e303, []int{109}
 }
 
 func (m *DeleteCredentialRequest) XXX_Unmarshal(b []byte) error {
@@ -5865,7 +7412,7 @@ func (m *ListCredUsersResponse) Reset()         { *m = ListCredUsersResponse{} }
 func (m *ListCredUsersResponse) String() string { return proto.CompactTextString(m) }
 func (*ListCredUsersResponse) ProtoMessage()    {}
 func (*ListCredUsersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{89}
+	return fileDescriptor_02345ba45cc0e303, []int{110}
 }
 
 func (m *ListCredUsersResponse) XXX_Unmarshal(b []byte) error {
@@ -5912,7 +7459,7 @@ func (m *ListCredUsersRequest) Reset()         { *m = ListCredUsersRequest{} }
 func (m *ListCredUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListCredUsersRequest) ProtoMessage()    {}
 func (*ListCredUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{90}
+	return fileDescriptor_02345ba45cc0e303, []int{111}
 }
 
 func (m *ListCredUsersRequest) XXX_Unmarshal(b []byte) error {
@@ -5952,7 +7499,7 @@ func (m *RoleEntity) Reset()         { *m = RoleEntity{} }
 func (m *RoleEntity) String() string { return proto.CompactTextString(m) }
 func (*RoleEntity) ProtoMessage()    {}
 func (*RoleEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{91}
+	return fileDescriptor_02345ba45cc0e303, []int{112}
 }
 
 func (m *RoleEntity) XXX_Unmarshal(b []byte) error {
@@ -5991,7 +7538,7 @@ func (m *UserEntity) Reset()         { *m = UserEntity{} }
 func (m *UserEntity) String() string { return proto.CompactTextString(m) }
 func (*UserEntity) ProtoMessage()    {}
 func (*UserEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{92}
+	return fileDescriptor_02345ba45cc0e303, []int{113}
 }
 
 func (m *UserEntity) XXX_Unmarshal(b []byte) error {
@@ -6033,7 +7580,7 @@ func (m *CreateRoleRequest) Reset()         { *m = CreateRoleRequest{} }
 func (m *CreateRoleRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRoleRequest) ProtoMessage()    {}
 func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{93}
+	return fileDescriptor_02345ba45cc0e303, []int{114}
 }
 
 func (m *CreateRoleRequest) XXX_Unmarshal(b []byte) error {
@@ -6082,7 +7629,7 @@ func (m *DropRoleRequest) Reset()         { *m = DropRoleRequest{} }
 func (m *DropRoleRequest) String() string { return proto.CompactTextString(m) }
 func (*DropRoleRequest) ProtoMessage()    {}
 func (*DropRoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{94}
+	return fileDescriptor_02345ba45cc0e303, []int{115}
 }
 
 func (m *DropRoleRequest) XXX_Unmarshal(b []byte) error {
@@ -6135,7 +7682,7 @@ func (m *OperateUserRoleRequest) Reset()         { *m = OperateUserRoleRequest{}
 func (m *OperateUserRoleRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateUserRoleRequest) ProtoMessage()    {}
 func (*OperateUserRoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{95}
+	return fileDescriptor_02345ba45cc0e303, []int{116}
 }
 
 func (m *OperateUserRoleRequest) XXX_Unmarshal(b []byte) error {
@@ -6200,7 +7747,7 @@ func (m *SelectRoleRequest) Reset()         { *m = SelectRoleRequest{} }
 func (m *SelectRoleRequest) String() string { return proto.CompactTextString(m) }
 func (*SelectRoleRequest) ProtoMessage()    {}
 func (*SelectRoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{96}
+	return fileDescriptor_02345ba45cc0e303, []int{117}
 }
 
 func (m *SelectRoleRequest) XXX_Unmarshal(b []byte) error {
@@ -6254,7 +7801,7 @@ func (m *RoleResult) Reset()         { *m = RoleResult{} }
 func (m *RoleResult) String() string { return proto.CompactTextString(m) }
 func (*RoleResult) ProtoMessage()    {}
 func (*RoleResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{97}
+	return fileDescriptor_02345ba45cc0e303, []int{118}
 }
 
 func (m *RoleResult) XXX_Unmarshal(b []byte) error {
@@ -6303,7 +7850,7 @@ func (m *SelectRoleResponse) Reset()         { *m = SelectRoleResponse{} }
 func (m *SelectRoleResponse) String() string { return proto.CompactTextString(m) }
 func (*SelectRoleResponse) ProtoMessage()    {}
 func (*SelectRoleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{98}
+	return fileDescriptor_02345ba45cc0e303, []int{119}
 }
 
 func (m *SelectRoleResponse) XXX_Unmarshal(b []byte) error {
@@ -6354,7 +7901,7 @@ func (m *SelectUserRequest) Reset()         { *m = SelectUserRequest{} }
 func (m *SelectUserRequest) String() string { return proto.CompactTextString(m) }
 func (*SelectUserRequest) ProtoMessage()    {}
 func (*SelectUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{99}
+	return fileDescriptor_02345ba45cc0e303, []int{120}
 }
 
 func (m *SelectUserRequest) XXX_Unmarshal(b []byte) error {
@@ -6408,7 +7955,7 @@ func (m *UserResult) Reset()         { *m = UserResult{} }
 func (m *UserResult) String() string { return proto.CompactTextString(m) }
 func (*UserResult) ProtoMessage()    {}
 func (*UserResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{100}
+	return fileDescriptor_02345ba45cc0e303, []int{121}
 }
 
 func (m *UserResult) XXX_Unmarshal(b []byte) error {
@@ -6457,7 +8004,7 @@ func (m *SelectUserResponse) Reset()         { *m = SelectUserResponse{} }
 func (m *SelectUserResponse) String() string { return proto.CompactTextString(m) }
 func (*SelectUserResponse) ProtoMessage()    {}
 func (*SelectUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{101}
+	return fileDescriptor_02345ba45cc0e303, []int{122}
 }
 
 func (m *SelectUserResponse) XXX_Unmarshal(b []byte) error {
@@ -6503,7 +8050,7 @@ func (m *ObjectEntity) Reset()         { *m = ObjectEntity{} }
 func (m *ObjectEntity) String() string { return proto.CompactTextString(m) }
 func (*ObjectEntity) ProtoMessage()    {}
 func (*ObjectEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{102}
+	return fileDescriptor_02345ba45cc0e303, []int{123}
 }
 
 func (m *ObjectEntity) XXX_Unmarshal(b []byte) error {
@@ -6542,7 +8089,7 @@ func (m *PrivilegeEntity) Reset()         { *m = PrivilegeEntity{} }
 func (m *PrivilegeEntity) String() string { return proto.CompactTextString(m) }
 func (*PrivilegeEntity) ProtoMessage()    {}
 func (*PrivilegeEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{103}
+	return fileDescriptor_02345ba45cc0e303, []int{124}
 }
 
 func (m *PrivilegeEntity) XXX_Unmarshal(b []byte) error {
@@ -6582,7 +8129,7 @@ func (m *GrantorEntity) Reset()         { *m = GrantorEntity{} }
 func (m *GrantorEntity) String() string { return proto.CompactTextString(m) }
 func (*GrantorEntity) ProtoMessage()    {}
 func (*GrantorEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{104}
+	return fileDescriptor_02345ba45cc0e303, []int{125}
 }
 
 func (m *GrantorEntity) XXX_Unmarshal(b []byte) error {
@@ -6628,7 +8175,7 @@ func (m *GrantPrivilegeEntity) Reset()         { *m = GrantPrivilegeEntity{} }
 func (m *GrantPrivilegeEntity) String() string { return proto.CompactTextString(m) }
 func (*GrantPrivilegeEntity) ProtoMessage()    {}
 func (*GrantPrivilegeEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{105}
+	return fileDescriptor_02345ba45cc0e303, []int{126}
 }
 
 func (m *GrantPrivilegeEntity) XXX_Unmarshal(b []byte) error {
@@ -6674,7 +8221,7 @@ func (m *GrantEntity) Reset()         { *m = GrantEntity{} }
 func (m *GrantEntity) String() string { return proto.CompactTextString(m) }
 func (*GrantEntity) ProtoMessage()    {}
 func (*GrantEntity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{106}
+	return fileDescriptor_02345ba45cc0e303, []int{127}
 }
 
 func (m *GrantEntity) XXX_Unmarshal(b []byte) error {
@@ -6733,152 +8280,444 @@ type SelectGrantRequest struct {
 	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *SelectGrantRequest) Reset()         { *m = SelectGrantRequest{} }
-func (m *SelectGrantRequest) String() string { return proto.CompactTextString(m) }
-func (*SelectGrantRequest) ProtoMessage()    {}
-func (*SelectGrantRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{107}
+func (m *SelectGrantRequest) Reset()         { *m = SelectGrantRequest{} }
+func (m *SelectGrantRequest) String() string { return proto.CompactTextString(m) }
+func (*SelectGrantRequest) ProtoMessage()    {}
+func (*SelectGrantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{128}
+}
+
+func (m *SelectGrantRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectGrantRequest.Unmarshal(m, b)
+}
+func (m *SelectGrantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectGrantRequest.Marshal(b, m, deterministic)
+}
+func (m *SelectGrantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectGrantRequest.Merge(m, src)
+}
+func (m *SelectGrantRequest) XXX_Size() int {
+	return xxx_messageInfo_SelectGrantRequest.Size(m)
+}
+func (m *SelectGrantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectGrantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectGrantRequest proto.InternalMessageInfo
+
+func (m *SelectGrantRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SelectGrantRequest) GetEntity() *GrantEntity {
+	if m != nil {
+		return m.Entity
+	}
+	return nil
+}
+
+type SelectGrantResponse struct {
+	// Not useful for now
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// grant info array
+	Entities             []*GrantEntity `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SelectGrantResponse) Reset()         { *m = SelectGrantResponse{} }
+func (m *SelectGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*SelectGrantResponse) ProtoMessage()    {}
+func (*SelectGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{129}
+}
+
+func (m *SelectGrantResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectGrantResponse.Unmarshal(m, b)
+}
+func (m *SelectGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectGrantResponse.Marshal(b, m, deterministic)
+}
+func (m *SelectGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectGrantResponse.Merge(m, src)
+}
+func (m *SelectGrantResponse) XXX_Size() int {
+	return xxx_messageInfo_SelectGrantResponse.Size(m)
+}
+func (m *SelectGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectGrantResponse proto.InternalMessageInfo
+
+func (m *SelectGrantResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *SelectGrantResponse) GetEntities() []*GrantEntity {
+	if m != nil {
+		return m.Entities
+	}
+	return nil
+}
+
+type OperatePrivilegeRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// grant
+	Entity *GrantEntity `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	// operation type
+	Type                 OperatePrivilegeType `protobuf:"varint,3,opt,name=type,proto3,enum=milvus.proto.milvus.OperatePrivilegeType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *OperatePrivilegeRequest) Reset()         { *m = OperatePrivilegeRequest{} }
+func (m *OperatePrivilegeRequest) String() string { return proto.CompactTextString(m) }
+func (*OperatePrivilegeRequest) ProtoMessage()    {}
+func (*OperatePrivilegeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{130}
+}
+
+func (m *OperatePrivilegeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperatePrivilegeRequest.Unmarshal(m, b)
+}
+func (m *OperatePrivilegeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperatePrivilegeRequest.Marshal(b, m, deterministic)
+}
+func (m *OperatePrivilegeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatePrivilegeRequest.Merge(m, src)
+}
+func (m *OperatePrivilegeRequest) XXX_Size() int {
+	return xxx_messageInfo_OperatePrivilegeRequest.Size(m)
+}
+func (m *OperatePrivilegeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatePrivilegeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatePrivilegeRequest proto.InternalMessageInfo
+
+func (m *OperatePrivilegeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *OperatePrivilegeRequest) GetEntity() *GrantEntity {
+	if m != nil {
+		return m.Entity
+	}
+	return nil
+}
+
+func (m *OperatePrivilegeRequest) GetType() OperatePrivilegeType {
+	if m != nil {
+		return m.Type
+	}
+	return OperatePrivilegeType_Grant
+}
+
+// A row policy restricts the rows of a collection visible to a role,
+// searches, queries and deletes of the users with the role only touch the rows satisfying the expression.
+type RowPolicyEntity struct {
+	// role
+	Role *RoleEntity `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// the collection name
+	CollectionName string `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// the policy name, unique in the policies of the role on the collection
+	PolicyName string `protobuf:"bytes,3,opt,name=policy_name,json=policyName,proto3" json:"policy_name,omitempty"`
+	// the boolean expression the visible rows satisfy, like `tenant == "a"`
+	Expr                 string   `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RowPolicyEntity) Reset()         { *m = RowPolicyEntity{} }
+func (m *RowPolicyEntity) String() string { return proto.CompactTextString(m) }
+func (*RowPolicyEntity) ProtoMessage()    {}
+func (*RowPolicyEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{131}
+}
+
+func (m *RowPolicyEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RowPolicyEntity.Unmarshal(m, b)
+}
+func (m *RowPolicyEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RowPolicyEntity.Marshal(b, m, deterministic)
+}
+func (m *RowPolicyEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RowPolicyEntity.Merge(m, src)
+}
+func (m *RowPolicyEntity) XXX_Size() int {
+	return xxx_messageInfo_RowPolicyEntity.Size(m)
+}
+func (m *RowPolicyEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_RowPolicyEntity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RowPolicyEntity proto.InternalMessageInfo
+
+func (m *RowPolicyEntity) GetRole() *RoleEntity {
+	if m != nil {
+		return m.Role
+	}
+	return nil
+}
+
+func (m *RowPolicyEntity) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *RowPolicyEntity) GetPolicyName() string {
+	if m != nil {
+		return m.PolicyName
+	}
+	return ""
+}
+
+func (m *RowPolicyEntity) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
+}
+
+type CreateRowPolicyRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// row policy
+	Entity               *RowPolicyEntity `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CreateRowPolicyRequest) Reset()         { *m = CreateRowPolicyRequest{} }
+func (m *CreateRowPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRowPolicyRequest) ProtoMessage()    {}
+func (*CreateRowPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{132}
+}
+
+func (m *CreateRowPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRowPolicyRequest.Unmarshal(m, b)
+}
+func (m *CreateRowPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRowPolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateRowPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRowPolicyRequest.Merge(m, src)
+}
+func (m *CreateRowPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRowPolicyRequest.Size(m)
+}
+func (m *CreateRowPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRowPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRowPolicyRequest proto.InternalMessageInfo
+
+func (m *CreateRowPolicyRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateRowPolicyRequest) GetEntity() *RowPolicyEntity {
+	if m != nil {
+		return m.Entity
+	}
+	return nil
+}
+
+type DropRowPolicyRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// role
+	Role *RoleEntity `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// the collection name
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// the policy name
+	PolicyName           string   `protobuf:"bytes,4,opt,name=policy_name,json=policyName,proto3" json:"policy_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropRowPolicyRequest) Reset()         { *m = DropRowPolicyRequest{} }
+func (m *DropRowPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*DropRowPolicyRequest) ProtoMessage()    {}
+func (*DropRowPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{133}
 }
 
-func (m *SelectGrantRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SelectGrantRequest.Unmarshal(m, b)
+func (m *DropRowPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropRowPolicyRequest.Unmarshal(m, b)
 }
-func (m *SelectGrantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SelectGrantRequest.Marshal(b, m, deterministic)
+func (m *DropRowPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropRowPolicyRequest.Marshal(b, m, deterministic)
 }
-func (m *SelectGrantRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SelectGrantRequest.Merge(m, src)
+func (m *DropRowPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropRowPolicyRequest.Merge(m, src)
 }
-func (m *SelectGrantRequest) XXX_Size() int {
-	return xxx_messageInfo_SelectGrantRequest.Size(m)
+func (m *DropRowPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_DropRowPolicyRequest.Size(m)
 }
-func (m *SelectGrantRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SelectGrantRequest.DiscardUnknown(m)
+func (m *DropRowPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropRowPolicyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SelectGrantRequest proto.InternalMessageInfo
+var xxx_messageInfo_DropRowPolicyRequest proto.InternalMessageInfo
 
-func (m *SelectGrantRequest) GetBase() *commonpb.MsgBase {
+func (m *DropRowPolicyRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *SelectGrantRequest) GetEntity() *GrantEntity {
+func (m *DropRowPolicyRequest) GetRole() *RoleEntity {
 	if m != nil {
-		return m.Entity
+		return m.Role
 	}
 	return nil
 }
 
-type SelectGrantResponse struct {
+func (m *DropRowPolicyRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *DropRowPolicyRequest) GetPolicyName() string {
+	if m != nil {
+		return m.PolicyName
+	}
+	return ""
+}
+
+type ListRowPoliciesRequest struct {
 	// Not useful for now
-	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// grant info array
-	Entities             []*GrantEntity `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// role, all roles if empty
+	Role *RoleEntity `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// the collection name, all collections if empty
+	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SelectGrantResponse) Reset()         { *m = SelectGrantResponse{} }
-func (m *SelectGrantResponse) String() string { return proto.CompactTextString(m) }
-func (*SelectGrantResponse) ProtoMessage()    {}
-func (*SelectGrantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{108}
+func (m *ListRowPoliciesRequest) Reset()         { *m = ListRowPoliciesRequest{} }
+func (m *ListRowPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRowPoliciesRequest) ProtoMessage()    {}
+func (*ListRowPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{134}
 }
 
-func (m *SelectGrantResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SelectGrantResponse.Unmarshal(m, b)
+func (m *ListRowPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRowPoliciesRequest.Unmarshal(m, b)
 }
-func (m *SelectGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SelectGrantResponse.Marshal(b, m, deterministic)
+func (m *ListRowPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRowPoliciesRequest.Marshal(b, m, deterministic)
 }
-func (m *SelectGrantResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SelectGrantResponse.Merge(m, src)
+func (m *ListRowPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRowPoliciesRequest.Merge(m, src)
 }
-func (m *SelectGrantResponse) XXX_Size() int {
-	return xxx_messageInfo_SelectGrantResponse.Size(m)
+func (m *ListRowPoliciesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRowPoliciesRequest.Size(m)
 }
-func (m *SelectGrantResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SelectGrantResponse.DiscardUnknown(m)
+func (m *ListRowPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRowPoliciesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SelectGrantResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListRowPoliciesRequest proto.InternalMessageInfo
 
-func (m *SelectGrantResponse) GetStatus() *commonpb.Status {
+func (m *ListRowPoliciesRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *SelectGrantResponse) GetEntities() []*GrantEntity {
+func (m *ListRowPoliciesRequest) GetRole() *RoleEntity {
 	if m != nil {
-		return m.Entities
+		return m.Role
 	}
 	return nil
 }
 
-type OperatePrivilegeRequest struct {
+func (m *ListRowPoliciesRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type ListRowPoliciesResponse struct {
 	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// grant
-	Entity *GrantEntity `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
-	// operation type
-	Type                 OperatePrivilegeType `protobuf:"varint,3,opt,name=type,proto3,enum=milvus.proto.milvus.OperatePrivilegeType" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// row policy array
+	Entities             []*RowPolicyEntity `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *OperatePrivilegeRequest) Reset()         { *m = OperatePrivilegeRequest{} }
-func (m *OperatePrivilegeRequest) String() string { return proto.CompactTextString(m) }
-func (*OperatePrivilegeRequest) ProtoMessage()    {}
-func (*OperatePrivilegeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{109}
+func (m *ListRowPoliciesResponse) Reset()         { *m = ListRowPoliciesResponse{} }
+func (m *ListRowPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRowPoliciesResponse) ProtoMessage()    {}
+func (*ListRowPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{135}
 }
 
-func (m *OperatePrivilegeRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OperatePrivilegeRequest.Unmarshal(m, b)
+func (m *ListRowPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRowPoliciesResponse.Unmarshal(m, b)
 }
-func (m *OperatePrivilegeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OperatePrivilegeRequest.Marshal(b, m, deterministic)
+func (m *ListRowPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRowPoliciesResponse.Marshal(b, m, deterministic)
 }
-func (m *OperatePrivilegeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OperatePrivilegeRequest.Merge(m, src)
+func (m *ListRowPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRowPoliciesResponse.Merge(m, src)
 }
-func (m *OperatePrivilegeRequest) XXX_Size() int {
-	return xxx_messageInfo_OperatePrivilegeRequest.Size(m)
+func (m *ListRowPoliciesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRowPoliciesResponse.Size(m)
 }
-func (m *OperatePrivilegeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OperatePrivilegeRequest.DiscardUnknown(m)
+func (m *ListRowPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRowPoliciesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OperatePrivilegeRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListRowPoliciesResponse proto.InternalMessageInfo
 
-func (m *OperatePrivilegeRequest) GetBase() *commonpb.MsgBase {
+func (m *ListRowPoliciesResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *OperatePrivilegeRequest) GetEntity() *GrantEntity {
+func (m *ListRowPoliciesResponse) GetEntities() []*RowPolicyEntity {
 	if m != nil {
-		return m.Entity
+		return m.Entities
 	}
 	return nil
 }
 
-func (m *OperatePrivilegeRequest) GetType() OperatePrivilegeType {
-	if m != nil {
-		return m.Type
-	}
-	return OperatePrivilegeType_Grant
-}
-
 type MilvusExt struct {
 	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -6890,7 +8729,7 @@ func (m *MilvusExt) Reset()         { *m = MilvusExt{} }
 func (m *MilvusExt) String() string { return proto.CompactTextString(m) }
 func (*MilvusExt) ProtoMessage()    {}
 func (*MilvusExt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{110}
+	return fileDescriptor_02345ba45cc0e303, []int{136}
 }
 
 func (m *MilvusExt) XXX_Unmarshal(b []byte) error {
@@ -6929,11 +8768,21 @@ var E_MilvusExtObj = &proto.ExtensionDesc{
 
 func init() {
 	proto.RegisterEnum("milvus.proto.milvus.ShowType", ShowType_name, ShowType_value)
+	proto.RegisterEnum("milvus.proto.milvus.DeleteJobState", DeleteJobState_name, DeleteJobState_value)
 	proto.RegisterEnum("milvus.proto.milvus.OperateUserRoleType", OperateUserRoleType_name, OperateUserRoleType_value)
 	proto.RegisterEnum("milvus.proto.milvus.OperatePrivilegeType", OperatePrivilegeType_name, OperatePrivilegeType_value)
 	proto.RegisterType((*CreateAliasRequest)(nil), "milvus.proto.milvus.CreateAliasRequest")
 	proto.RegisterType((*DropAliasRequest)(nil), "milvus.proto.milvus.DropAliasRequest")
 	proto.RegisterType((*AlterAliasRequest)(nil), "milvus.proto.milvus.AlterAliasRequest")
+	proto.RegisterType((*AlterShardsRequest)(nil), "milvus.proto.milvus.AlterShardsRequest")
+	proto.RegisterType((*AddFieldRequest)(nil), "milvus.proto.milvus.AddFieldRequest")
+	proto.RegisterType((*DropFieldRequest)(nil), "milvus.proto.milvus.DropFieldRequest")
+	proto.RegisterType((*CreateSnapshotRequest)(nil), "milvus.proto.milvus.CreateSnapshotRequest")
+	proto.RegisterType((*DropSnapshotRequest)(nil), "milvus.proto.milvus.DropSnapshotRequest")
+	proto.RegisterType((*ListSnapshotsRequest)(nil), "milvus.proto.milvus.ListSnapshotsRequest")
+	proto.RegisterType((*SnapshotInfo)(nil), "milvus.proto.milvus.SnapshotInfo")
+	proto.RegisterType((*ListSnapshotsResponse)(nil), "milvus.proto.milvus.ListSnapshotsResponse")
+	proto.RegisterType((*TruncateCollectionRequest)(nil), "milvus.proto.milvus.TruncateCollectionRequest")
 	proto.RegisterType((*CreateCollectionRequest)(nil), "milvus.proto.milvus.CreateCollectionRequest")
 	proto.RegisterType((*DropCollectionRequest)(nil), "milvus.proto.milvus.DropCollectionRequest")
 	proto.RegisterType((*HasCollectionRequest)(nil), "milvus.proto.milvus.HasCollectionRequest")
@@ -6951,6 +8800,7 @@ func init() {
 	proto.RegisterType((*ShowCollectionsResponse)(nil), "milvus.proto.milvus.ShowCollectionsResponse")
 	proto.RegisterType((*CreatePartitionRequest)(nil), "milvus.proto.milvus.CreatePartitionRequest")
 	proto.RegisterType((*DropPartitionRequest)(nil), "milvus.proto.milvus.DropPartitionRequest")
+	proto.RegisterType((*TruncatePartitionRequest)(nil), "milvus.proto.milvus.TruncatePartitionRequest")
 	proto.RegisterType((*HasPartitionRequest)(nil), "milvus.proto.milvus.HasPartitionRequest")
 	proto.RegisterType((*LoadPartitionsRequest)(nil), "milvus.proto.milvus.LoadPartitionsRequest")
 	proto.RegisterType((*ReleasePartitionsRequest)(nil), "milvus.proto.milvus.ReleasePartitionsRequest")
@@ -6974,6 +8824,10 @@ func init() {
 	proto.RegisterType((*InsertRequest)(nil), "milvus.proto.milvus.InsertRequest")
 	proto.RegisterType((*MutationResult)(nil), "milvus.proto.milvus.MutationResult")
 	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.milvus.DeleteRequest")
+	proto.RegisterType((*DeleteByExprRequest)(nil), "milvus.proto.milvus.DeleteByExprRequest")
+	proto.RegisterType((*DeleteByExprResponse)(nil), "milvus.proto.milvus.DeleteByExprResponse")
+	proto.RegisterType((*GetDeleteJobStateRequest)(nil), "milvus.proto.milvus.GetDeleteJobStateRequest")
+	proto.RegisterType((*GetDeleteJobStateResponse)(nil), "milvus.proto.milvus.GetDeleteJobStateResponse")
 	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.milvus.SearchRequest")
 	proto.RegisterType((*Hits)(nil), "milvus.proto.milvus.Hits")
 	proto.RegisterType((*SearchResults)(nil), "milvus.proto.milvus.SearchResults")
@@ -6982,6 +8836,9 @@ func init() {
 	proto.RegisterMapType((map[string]*schemapb.LongArray)(nil), "milvus.proto.milvus.FlushResponse.CollSegIDsEntry")
 	proto.RegisterType((*QueryRequest)(nil), "milvus.proto.milvus.QueryRequest")
 	proto.RegisterType((*QueryResults)(nil), "milvus.proto.milvus.QueryResults")
+	proto.RegisterType((*SegmentProfile)(nil), "milvus.proto.milvus.SegmentProfile")
+	proto.RegisterType((*ShardProfile)(nil), "milvus.proto.milvus.ShardProfile")
+	proto.RegisterType((*QueryExplain)(nil), "milvus.proto.milvus.QueryExplain")
 	proto.RegisterType((*VectorIDs)(nil), "milvus.proto.milvus.VectorIDs")
 	proto.RegisterType((*VectorsArray)(nil), "milvus.proto.milvus.VectorsArray")
 	proto.RegisterType((*CalcDistanceRequest)(nil), "milvus.proto.milvus.CalcDistanceRequest")
@@ -7003,9 +8860,13 @@ func init() {
 	proto.RegisterType((*ManualCompactionResponse)(nil), "milvus.proto.milvus.ManualCompactionResponse")
 	proto.RegisterType((*GetCompactionStateRequest)(nil), "milvus.proto.milvus.GetCompactionStateRequest")
 	proto.RegisterType((*GetCompactionStateResponse)(nil), "milvus.proto.milvus.GetCompactionStateResponse")
+	proto.RegisterType((*CancelCompactionRequest)(nil), "milvus.proto.milvus.CancelCompactionRequest")
 	proto.RegisterType((*GetCompactionPlansRequest)(nil), "milvus.proto.milvus.GetCompactionPlansRequest")
 	proto.RegisterType((*GetCompactionPlansResponse)(nil), "milvus.proto.milvus.GetCompactionPlansResponse")
 	proto.RegisterType((*CompactionMergeInfo)(nil), "milvus.proto.milvus.CompactionMergeInfo")
+	proto.RegisterType((*GetCompactionPolicyStatsRequest)(nil), "milvus.proto.milvus.GetCompactionPolicyStatsRequest")
+	proto.RegisterType((*SegmentCompactionStats)(nil), "milvus.proto.milvus.SegmentCompactionStats")
+	proto.RegisterType((*GetCompactionPolicyStatsResponse)(nil), "milvus.proto.milvus.GetCompactionPolicyStatsResponse")
 	proto.RegisterType((*GetFlushStateRequest)(nil), "milvus.proto.milvus.GetFlushStateRequest")
 	proto.RegisterType((*GetFlushStateResponse)(nil), "milvus.proto.milvus.GetFlushStateResponse")
 	proto.RegisterType((*ImportRequest)(nil), "milvus.proto.milvus.ImportRequest")
@@ -7042,6 +8903,11 @@ func init() {
 	proto.RegisterType((*SelectGrantRequest)(nil), "milvus.proto.milvus.SelectGrantRequest")
 	proto.RegisterType((*SelectGrantResponse)(nil), "milvus.proto.milvus.SelectGrantResponse")
 	proto.RegisterType((*OperatePrivilegeRequest)(nil), "milvus.proto.milvus.OperatePrivilegeRequest")
+	proto.RegisterType((*RowPolicyEntity)(nil), "milvus.proto.milvus.RowPolicyEntity")
+	proto.RegisterType((*CreateRowPolicyRequest)(nil), "milvus.proto.milvus.CreateRowPolicyRequest")
+	proto.RegisterType((*DropRowPolicyRequest)(nil), "milvus.proto.milvus.DropRowPolicyRequest")
+	proto.RegisterType((*ListRowPoliciesRequest)(nil), "milvus.proto.milvus.ListRowPoliciesRequest")
+	proto.RegisterType((*ListRowPoliciesResponse)(nil), "milvus.proto.milvus.ListRowPoliciesResponse")
 	proto.RegisterType((*MilvusExt)(nil), "milvus.proto.milvus.MilvusExt")
 	proto.RegisterExtension(E_MilvusExtObj)
 }
@@ -7049,332 +8915,409 @@ func init() {
 func init() { proto.RegisterFile("milvus.proto", fileDescriptor_02345ba45cc0e303) }
 
 var fileDescriptor_02345ba45cc0e303 = []byte{
-	// 5188 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3d, 0x4b, 0x6c, 0x1c, 0x47,
-	0x76, 0xec, 0x19, 0xce, 0xef, 0xcd, 0x87, 0xc3, 0xe2, 0x6f, 0x3c, 0x92, 0x2c, 0xaa, 0x6d, 0xd9,
-	0xb4, 0xb4, 0xa6, 0x6c, 0xca, 0x96, 0xd7, 0xb2, 0x57, 0xb6, 0x24, 0x5a, 0x12, 0x61, 0x7d, 0xe8,
-	0xa6, 0xec, 0x60, 0xe3, 0x18, 0x8d, 0xe6, 0x74, 0x71, 0xd8, 0x56, 0x4f, 0xf7, 0xa8, 0xbb, 0x87,
-	0x14, 0x9d, 0xcb, 0x02, 0x9b, 0xdd, 0x6c, 0x90, 0xcd, 0x2e, 0x36, 0xbf, 0x45, 0x0e, 0xf9, 0x20,
-	0xd8, 0x1c, 0x02, 0x6c, 0x82, 0x38, 0x09, 0x10, 0x60, 0x73, 0xc8, 0x39, 0x46, 0xbe, 0x87, 0x20,
-	0x59, 0x24, 0xc7, 0x45, 0x80, 0x1c, 0x02, 0xe4, 0x90, 0x63, 0x82, 0x04, 0xf5, 0xe9, 0x9e, 0xea,
-	0x9e, 0xea, 0xe1, 0x90, 0xb3, 0x32, 0x29, 0xf3, 0x34, 0xf5, 0xea, 0x55, 0xd5, 0xab, 0x57, 0xef,
-	0xbd, 0x7a, 0x55, 0xef, 0x55, 0x13, 0x2a, 0x1d, 0xcb, 0xde, 0xe9, 0xf9, 0xcb, 0x5d, 0xcf, 0x0d,
-	0x5c, 0x34, 0x23, 0x96, 0x96, 0x59, 0xa1, 0x59, 0x69, 0xb9, 0x9d, 0x8e, 0xeb, 0x30, 0x60, 0xb3,
-	0xe2, 0xb7, 0xb6, 0x71, 0xc7, 0xe0, 0xa5, 0xc5, 0xb6, 0xeb, 0xb6, 0x6d, 0x7c, 0x81, 0x96, 0x36,
-	0x7b, 0x5b, 0x17, 0x4c, 0xec, 0xb7, 0x3c, 0xab, 0x1b, 0xb8, 0x1e, 0xc3, 0x50, 0x7f, 0x47, 0x01,
-	0x74, 0xdd, 0xc3, 0x46, 0x80, 0xaf, 0xda, 0x96, 0xe1, 0x6b, 0xf8, 0x61, 0x0f, 0xfb, 0x01, 0x7a,
-	0x09, 0x26, 0x37, 0x0d, 0x1f, 0x37, 0x94, 0x45, 0x65, 0xa9, 0xbc, 0x72, 0x72, 0x39, 0x36, 0x30,
-	0x1f, 0xf0, 0x8e, 0xdf, 0xbe, 0x66, 0xf8, 0x58, 0xa3, 0x98, 0x68, 0x01, 0x0a, 0xe6, 0xa6, 0xee,
-	0x18, 0x1d, 0xdc, 0xc8, 0x2c, 0x2a, 0x4b, 0x25, 0x2d, 0x6f, 0x6e, 0xde, 0x35, 0x3a, 0x18, 0x3d,
-	0x0f, 0x53, 0x2d, 0xd7, 0xb6, 0x71, 0x2b, 0xb0, 0x5c, 0x87, 0x21, 0x64, 0x29, 0x42, 0xad, 0x0f,
-	0xa6, 0x88, 0xb3, 0x90, 0x33, 0x08, 0x0d, 0x8d, 0x49, 0x5a, 0xcd, 0x0a, 0xaa, 0x0f, 0xf5, 0x55,
-	0xcf, 0xed, 0x3e, 0x2e, 0xea, 0xa2, 0x41, 0xb3, 0xe2, 0xa0, 0xbf, 0xad, 0xc0, 0xf4, 0x55, 0x3b,
-	0xc0, 0xde, 0x31, 0x65, 0xca, 0x0f, 0x33, 0xb0, 0xc0, 0x56, 0xed, 0x7a, 0x84, 0x7e, 0x94, 0x54,
-	0xce, 0x43, 0x9e, 0xc9, 0x1d, 0x25, 0xb3, 0xa2, 0xf1, 0x12, 0x3a, 0x05, 0xe0, 0x6f, 0x1b, 0x9e,
-	0xe9, 0xeb, 0x4e, 0xaf, 0xd3, 0xc8, 0x2d, 0x2a, 0x4b, 0x39, 0xad, 0xc4, 0x20, 0x77, 0x7b, 0x1d,
-	0xa4, 0xc1, 0x74, 0xcb, 0x75, 0x7c, 0xcb, 0x0f, 0xb0, 0xd3, 0xda, 0xd3, 0x6d, 0xbc, 0x83, 0xed,
-	0x46, 0x7e, 0x51, 0x59, 0xaa, 0xad, 0x9c, 0x95, 0xd2, 0x7d, 0xbd, 0x8f, 0x7d, 0x9b, 0x20, 0x6b,
-	0xf5, 0x56, 0x02, 0x72, 0x19, 0x7d, 0x76, 0x65, 0xaa, 0xa8, 0xd4, 0x95, 0xc6, 0xff, 0x85, 0x7f,
-	0x8a, 0xfa, 0xbb, 0x0a, 0xcc, 0x11, 0x21, 0x3a, 0x16, 0xcc, 0x0a, 0x29, 0xcc, 0x88, 0x14, 0xfe,
-	0xa1, 0x02, 0xb3, 0xb7, 0x0c, 0xff, 0x78, 0xac, 0xe6, 0x29, 0x80, 0xc0, 0xea, 0x60, 0xdd, 0x0f,
-	0x8c, 0x4e, 0x97, 0xae, 0xe8, 0xa4, 0x56, 0x22, 0x90, 0x0d, 0x02, 0x50, 0xbf, 0x0a, 0x95, 0x6b,
-	0xae, 0x6b, 0x6b, 0xd8, 0xef, 0xba, 0x8e, 0x8f, 0xd1, 0x45, 0xc8, 0xfb, 0x81, 0x11, 0xf4, 0x7c,
-	0x4e, 0xe4, 0x09, 0x29, 0x91, 0x1b, 0x14, 0x45, 0xe3, 0xa8, 0x44, 0xae, 0x77, 0x0c, 0xbb, 0xc7,
-	0x68, 0x2c, 0x6a, 0xac, 0xa0, 0x7e, 0x08, 0xb5, 0x8d, 0xc0, 0xb3, 0x9c, 0xf6, 0x4f, 0xb1, 0xf3,
-	0x52, 0xd8, 0xf9, 0xbf, 0x2b, 0xf0, 0xd4, 0x2a, 0xb5, 0x7f, 0x9b, 0xc7, 0x44, 0x6d, 0x54, 0xa8,
-	0xf4, 0x21, 0x6b, 0xab, 0x94, 0xd5, 0x59, 0x2d, 0x06, 0x4b, 0x2c, 0x46, 0x2e, 0xb1, 0x18, 0xa1,
-	0x30, 0x65, 0x45, 0x61, 0xfa, 0x5a, 0x0e, 0x9a, 0xb2, 0x89, 0x8e, 0xc3, 0xd2, 0xaf, 0x44, 0x1a,
-	0x9e, 0xa1, 0x8d, 0x12, 0xfa, 0xc9, 0x77, 0x9d, 0xfe, 0x68, 0x1b, 0x14, 0x10, 0x19, 0x82, 0xe4,
-	0x4c, 0xb3, 0x92, 0x99, 0xae, 0xc0, 0xdc, 0x8e, 0xe5, 0x05, 0x3d, 0xc3, 0xd6, 0x5b, 0xdb, 0x86,
-	0xe3, 0x60, 0x9b, 0xf2, 0x8e, 0x98, 0xbe, 0xec, 0x52, 0x49, 0x9b, 0xe1, 0x95, 0xd7, 0x59, 0x1d,
-	0x61, 0xa0, 0x8f, 0x5e, 0x81, 0xf9, 0xee, 0xf6, 0x9e, 0x6f, 0xb5, 0x06, 0x1a, 0xe5, 0x68, 0xa3,
-	0xd9, 0xb0, 0x36, 0xd6, 0xea, 0x3c, 0x4c, 0xb7, 0xa8, 0xf5, 0x34, 0x75, 0xc2, 0x49, 0xc6, 0xda,
-	0x3c, 0x65, 0x6d, 0x9d, 0x57, 0xdc, 0x0f, 0xe1, 0x84, 0xac, 0x10, 0xb9, 0x17, 0xb4, 0x84, 0x06,
-	0x05, 0xda, 0x60, 0x86, 0x57, 0xbe, 0x1f, 0xb4, 0xfa, 0x6d, 0xe2, 0x76, 0xaf, 0x98, 0xb4, 0x7b,
-	0x0d, 0x28, 0x50, 0x3b, 0x8e, 0xfd, 0x46, 0x89, 0x92, 0x19, 0x16, 0xd1, 0x1a, 0x4c, 0xf9, 0x81,
-	0xe1, 0x05, 0x7a, 0xd7, 0xf5, 0x2d, 0xc2, 0x17, 0xbf, 0x01, 0x8b, 0xd9, 0xa5, 0xf2, 0xca, 0xa2,
-	0x74, 0x91, 0xde, 0xc5, 0x7b, 0xab, 0x46, 0x60, 0xac, 0x1b, 0x96, 0xa7, 0xd5, 0x68, 0xc3, 0xf5,
-	0xb0, 0x9d, 0xdc, 0xb8, 0x96, 0xc7, 0x32, 0xae, 0x32, 0xc9, 0xae, 0xc8, 0x24, 0x5b, 0xfd, 0x4b,
-	0x05, 0xe6, 0x6e, 0xbb, 0x86, 0x79, 0x3c, 0xf4, 0xec, 0x2c, 0xd4, 0x3c, 0xdc, 0xb5, 0xad, 0x96,
-	0x41, 0xd6, 0x63, 0x13, 0x7b, 0x54, 0xd3, 0x72, 0x5a, 0x95, 0x43, 0xef, 0x52, 0xe0, 0xe5, 0xc2,
-	0x67, 0x57, 0x26, 0xeb, 0xb9, 0x46, 0x56, 0xfd, 0xbe, 0x02, 0x0d, 0x0d, 0xdb, 0xd8, 0xf0, 0x8f,
-	0x87, 0xa1, 0x60, 0x94, 0xe5, 0x1b, 0x59, 0xf5, 0x3f, 0x15, 0x98, 0xbd, 0x89, 0x03, 0xa2, 0x9c,
-	0x96, 0x1f, 0x58, 0xad, 0x23, 0xf5, 0x4d, 0x9e, 0x87, 0xa9, 0xae, 0xe1, 0x05, 0x56, 0x84, 0x17,
-	0xaa, 0x6a, 0x2d, 0x02, 0x33, 0x7d, 0xbb, 0x00, 0x33, 0xed, 0x9e, 0xe1, 0x19, 0x4e, 0x80, 0xb1,
-	0xa0, 0x40, 0xcc, 0x98, 0xa1, 0xa8, 0x2a, 0xd2, 0x1f, 0x36, 0x5f, 0x68, 0x64, 0xd5, 0x6f, 0x28,
-	0x30, 0x97, 0x98, 0xef, 0x38, 0x56, 0xec, 0x35, 0xc8, 0x91, 0x5f, 0x7e, 0x23, 0x43, 0x95, 0xea,
-	0x4c, 0x9a, 0x52, 0x7d, 0x40, 0x36, 0x0c, 0xaa, 0x55, 0x0c, 0x9f, 0x38, 0x84, 0x4f, 0xdf, 0xc4,
-	0x81, 0x60, 0xdf, 0x8e, 0xc3, 0x0a, 0xf4, 0xf9, 0xf4, 0x5d, 0x05, 0x4e, 0xa7, 0xd2, 0x77, 0x24,
-	0x1c, 0xfb, 0x6f, 0x05, 0xe6, 0x37, 0xb6, 0xdd, 0xdd, 0x3e, 0x49, 0x8f, 0x83, 0x53, 0xf1, 0xdd,
-	0x31, 0x9b, 0xd8, 0x1d, 0xd1, 0xcb, 0x30, 0x19, 0xec, 0x75, 0x31, 0x55, 0xf7, 0xda, 0xca, 0xa9,
-	0x65, 0xc9, 0xf9, 0x69, 0x99, 0x10, 0x79, 0x7f, 0xaf, 0x8b, 0x35, 0x8a, 0x8a, 0x5e, 0x80, 0x7a,
-	0x82, 0xf7, 0xe1, 0x5e, 0x32, 0x15, 0x67, 0xbe, 0x1f, 0xee, 0xbd, 0x93, 0xe2, 0xde, 0xfb, 0x5f,
-	0x19, 0x58, 0x18, 0x98, 0xf6, 0x38, 0x0b, 0x20, 0xa3, 0x27, 0x23, 0xa5, 0x87, 0x98, 0x39, 0x01,
-	0xd5, 0x32, 0xc9, 0xa1, 0x26, 0xbb, 0x94, 0xd5, 0xaa, 0xc2, 0x36, 0x6b, 0xfa, 0xe8, 0x45, 0x40,
-	0x03, 0xbb, 0x1f, 0xd3, 0xdc, 0x49, 0x6d, 0x3a, 0xb9, 0xfd, 0xd1, 0x2d, 0x56, 0xba, 0xff, 0x31,
-	0xb6, 0x4c, 0x6a, 0xb3, 0x92, 0x0d, 0xd0, 0x47, 0x2f, 0xc3, 0xac, 0xe5, 0xdc, 0xc1, 0x1d, 0xd7,
-	0xdb, 0xd3, 0xbb, 0xd8, 0x6b, 0x61, 0x27, 0x30, 0xda, 0xd8, 0x6f, 0xe4, 0x29, 0x45, 0x33, 0x61,
-	0xdd, 0x7a, 0xbf, 0x0a, 0x5d, 0x82, 0x85, 0x87, 0x3d, 0xec, 0xed, 0xe9, 0x3e, 0xf6, 0x76, 0xac,
-	0x16, 0xd6, 0x8d, 0x1d, 0xc3, 0xb2, 0x8d, 0x4d, 0x1b, 0x37, 0x0a, 0x8b, 0xd9, 0xa5, 0xa2, 0x36,
-	0x47, 0xab, 0x37, 0x58, 0xed, 0xd5, 0xb0, 0x52, 0xfd, 0x33, 0x05, 0xe6, 0xd9, 0x61, 0x68, 0x3d,
-	0x34, 0x3b, 0x47, 0xbc, 0xd9, 0xc4, 0xad, 0x22, 0x3f, 0xba, 0x55, 0x63, 0x46, 0x51, 0xfd, 0x54,
-	0x81, 0x59, 0x72, 0x26, 0x79, 0x92, 0x68, 0xfe, 0x13, 0x05, 0x66, 0x6e, 0x19, 0xfe, 0x93, 0x44,
-	0xf2, 0xbf, 0x72, 0x47, 0x24, 0xa2, 0xf9, 0xc9, 0xd8, 0x31, 0x07, 0x3d, 0x96, 0x9c, 0xc4, 0x63,
-	0x51, 0xff, 0xa2, 0xef, 0xa8, 0x3c, 0x59, 0x13, 0x54, 0x7f, 0xa4, 0xc0, 0xa9, 0x9b, 0x38, 0x88,
-	0xa8, 0x3e, 0x1e, 0x1e, 0xcd, 0x88, 0x42, 0xf5, 0x1d, 0xe6, 0x0d, 0x48, 0x89, 0x3f, 0x92, 0xcd,
-	0xf6, 0x97, 0x33, 0x30, 0x47, 0x76, 0x9d, 0xe3, 0x21, 0x04, 0xa3, 0x1c, 0x6b, 0x25, 0x82, 0x92,
-	0x93, 0x6a, 0x42, 0xb8, 0x85, 0xe7, 0x47, 0xde, 0xc2, 0xd5, 0x3f, 0xcd, 0x30, 0xd7, 0x43, 0xe4,
-	0xc6, 0x38, 0xcb, 0x22, 0xa1, 0x35, 0x23, 0xa5, 0x55, 0x85, 0x4a, 0x04, 0x59, 0x5b, 0x0d, 0xb7,
-	0xdf, 0x18, 0xec, 0xb8, 0xee, 0xbe, 0xea, 0xb7, 0x15, 0x98, 0x0f, 0x2f, 0x0d, 0x36, 0x70, 0xbb,
-	0x83, 0x9d, 0xe0, 0xf0, 0x32, 0x94, 0x94, 0x80, 0x8c, 0x44, 0x02, 0x4e, 0x42, 0xc9, 0x67, 0xe3,
-	0x44, 0xf7, 0x01, 0x7d, 0x80, 0xfa, 0x57, 0x0a, 0x2c, 0x0c, 0x90, 0x33, 0xce, 0x22, 0x36, 0xa0,
-	0x60, 0x39, 0x26, 0x7e, 0x14, 0x51, 0x13, 0x16, 0x49, 0xcd, 0x66, 0xcf, 0xb2, 0xcd, 0x88, 0x8c,
-	0xb0, 0x88, 0xce, 0x40, 0x05, 0x3b, 0xc4, 0xc7, 0xd0, 0x29, 0x2e, 0x15, 0xe4, 0xa2, 0x56, 0x66,
-	0xb0, 0x35, 0x02, 0x22, 0x8d, 0xb7, 0x2c, 0x4c, 0x1b, 0xe7, 0x58, 0x63, 0x5e, 0x54, 0x7f, 0x45,
-	0x81, 0x19, 0x22, 0x85, 0x9c, 0x7a, 0xff, 0xf1, 0x72, 0x73, 0x11, 0xca, 0x82, 0x98, 0xf1, 0x89,
-	0x88, 0x20, 0xf5, 0x01, 0xcc, 0xc6, 0xc9, 0x19, 0x87, 0x9b, 0x4f, 0x03, 0x44, 0x6b, 0xc5, 0xb4,
-	0x21, 0xab, 0x09, 0x10, 0xf5, 0x37, 0x32, 0x61, 0x58, 0x81, 0xb2, 0xe9, 0x88, 0x6f, 0x33, 0xe9,
-	0x92, 0x88, 0xf6, 0xbc, 0x44, 0x21, 0xb4, 0x7a, 0x15, 0x2a, 0xf8, 0x51, 0xe0, 0x19, 0x7a, 0xd7,
-	0xf0, 0x8c, 0x0e, 0x53, 0xab, 0x91, 0x4c, 0x6f, 0x99, 0x36, 0x5b, 0xa7, 0xad, 0xc8, 0x20, 0x54,
-	0x44, 0xd8, 0x20, 0x79, 0x36, 0x08, 0x85, 0xf4, 0xcf, 0x69, 0xe5, 0x46, 0x56, 0xfd, 0x47, 0xe2,
-	0xf5, 0x71, 0xb1, 0x3e, 0xee, 0x9c, 0x89, 0xcf, 0x29, 0x27, 0x9d, 0x53, 0xa5, 0x91, 0x55, 0xff,
-	0x40, 0x81, 0x3a, 0x9d, 0xcb, 0x2a, 0x0f, 0x2e, 0x59, 0xae, 0x93, 0x68, 0xac, 0x24, 0x1a, 0x0f,
-	0xd1, 0xc6, 0xd7, 0x21, 0xcf, 0x57, 0x22, 0x3b, 0xea, 0x4a, 0xf0, 0x06, 0xfb, 0xcc, 0x47, 0xfd,
-	0x7d, 0x05, 0xe6, 0x12, 0xbc, 0x1f, 0x47, 0x05, 0xee, 0x03, 0x62, 0x33, 0x34, 0xfb, 0xd3, 0x0e,
-	0x77, 0xee, 0xb3, 0xd2, 0x6d, 0x2a, 0xc9, 0x24, 0x6d, 0xda, 0x4a, 0x40, 0x7c, 0xf5, 0xc7, 0x0a,
-	0x9c, 0xbc, 0x89, 0x03, 0x8a, 0x7a, 0x8d, 0x98, 0xa1, 0x75, 0xcf, 0x6d, 0x7b, 0xd8, 0xf7, 0xbf,
-	0x00, 0x82, 0xf2, 0x9b, 0xcc, 0xe7, 0x93, 0xcd, 0x6d, 0x9c, 0x85, 0x38, 0x03, 0x15, 0x3a, 0x18,
-	0x36, 0x75, 0xcf, 0xdd, 0xf5, 0xb9, 0x40, 0x95, 0x39, 0x4c, 0x73, 0x77, 0xa9, 0x64, 0x04, 0x6e,
-	0x60, 0xd8, 0x0c, 0x81, 0x6f, 0x36, 0x14, 0x42, 0xaa, 0xa9, 0x56, 0x86, 0x84, 0x91, 0xce, 0xf1,
-	0x17, 0x80, 0xd9, 0x3f, 0x60, 0x37, 0x67, 0xe2, 0x9c, 0xc6, 0x61, 0xf2, 0xab, 0xcc, 0x35, 0x65,
-	0xb3, 0xaa, 0xad, 0x9c, 0x96, 0xb6, 0x11, 0x06, 0x63, 0xd8, 0xe8, 0x34, 0x94, 0xb7, 0x0c, 0xcb,
-	0xd6, 0x3d, 0x6c, 0xf8, 0xae, 0xc3, 0x67, 0x0c, 0x04, 0xa4, 0x51, 0x88, 0xfa, 0xb7, 0x0a, 0x8b,
-	0xef, 0x7e, 0x11, 0x8c, 0x61, 0xb5, 0x91, 0x55, 0x7f, 0x98, 0x81, 0xea, 0x9a, 0xe3, 0x63, 0x2f,
-	0x38, 0xfe, 0xe7, 0x18, 0xf4, 0x16, 0x94, 0xe9, 0x0c, 0x7d, 0xdd, 0x34, 0x02, 0x83, 0x6f, 0x7d,
-	0x4f, 0x4b, 0x23, 0x3b, 0x37, 0x08, 0xde, 0xaa, 0x11, 0x18, 0x1a, 0x63, 0x93, 0x4f, 0x7e, 0xa3,
-	0x13, 0x50, 0xda, 0x36, 0xfc, 0x6d, 0xfd, 0x01, 0xde, 0x63, 0xce, 0x65, 0x55, 0x2b, 0x12, 0xc0,
-	0xbb, 0x78, 0xcf, 0x47, 0x4f, 0x41, 0xd1, 0xe9, 0x75, 0x98, 0xca, 0x15, 0x16, 0x95, 0xa5, 0xaa,
-	0x56, 0x70, 0x7a, 0x1d, 0xa2, 0x70, 0x8c, 0x5d, 0xc5, 0x46, 0x56, 0xfd, 0x9b, 0x0c, 0xd4, 0xee,
-	0xf4, 0xc8, 0xf1, 0x89, 0x06, 0xa8, 0x7a, 0x76, 0x70, 0x38, 0xf1, 0x3c, 0x07, 0x59, 0xe6, 0x88,
-	0x90, 0x16, 0x0d, 0xe9, 0x0c, 0xd6, 0x56, 0x7d, 0x8d, 0x20, 0xd1, 0xe0, 0x4c, 0xaf, 0xd5, 0xe2,
-	0x3e, 0x5d, 0x96, 0x52, 0x5d, 0x22, 0x10, 0xe6, 0xd1, 0x9d, 0x80, 0x12, 0xf6, 0xbc, 0xc8, 0xe3,
-	0xa3, 0x73, 0xc2, 0x9e, 0xc7, 0x2a, 0x55, 0xa8, 0x18, 0xad, 0x07, 0x8e, 0xbb, 0x6b, 0x63, 0xb3,
-	0x8d, 0x4d, 0x2a, 0x08, 0x45, 0x2d, 0x06, 0x63, 0xa2, 0x42, 0x24, 0x40, 0x6f, 0x39, 0x01, 0xf5,
-	0x05, 0xb2, 0x44, 0x54, 0x08, 0xe4, 0xba, 0x13, 0x90, 0x6a, 0x13, 0xdb, 0x38, 0xc0, 0xb4, 0xba,
-	0xc0, 0xaa, 0x19, 0x84, 0x57, 0xf7, 0xba, 0x51, 0xeb, 0x22, 0xab, 0x66, 0x10, 0x52, 0x7d, 0x12,
-	0x4a, 0xfd, 0x0b, 0xf4, 0x52, 0xff, 0xbe, 0x93, 0x02, 0xd4, 0x9f, 0x28, 0x50, 0x5d, 0xa5, 0x5d,
-	0x3d, 0x01, 0xd2, 0x87, 0x60, 0x12, 0x3f, 0xea, 0x7a, 0x5c, 0x99, 0xe8, 0xef, 0xa1, 0x02, 0xc5,
-	0xa4, 0xa6, 0xd4, 0xc8, 0xaa, 0xdf, 0x9c, 0x84, 0xea, 0x06, 0x36, 0xbc, 0xd6, 0xf6, 0x13, 0x71,
-	0x99, 0x53, 0x87, 0xac, 0xe9, 0xdb, 0x7c, 0x9e, 0xe4, 0x27, 0x3a, 0x0f, 0xd3, 0x5d, 0xdb, 0x68,
-	0xe1, 0x6d, 0xd7, 0x36, 0xb1, 0xa7, 0xb7, 0x3d, 0xb7, 0xc7, 0x02, 0x90, 0x15, 0xad, 0x2e, 0x54,
-	0xdc, 0x24, 0x70, 0xf4, 0x1a, 0x14, 0x4d, 0xdf, 0xd6, 0xe9, 0x29, 0xb8, 0x40, 0xad, 0xaf, 0x7c,
-	0x7e, 0xab, 0xbe, 0x4d, 0x0f, 0xc1, 0x05, 0x93, 0xfd, 0x40, 0xcf, 0x40, 0xd5, 0xed, 0x05, 0xdd,
-	0x5e, 0xa0, 0x33, 0x95, 0x6d, 0x14, 0x29, 0x79, 0x15, 0x06, 0xa4, 0x1a, 0xed, 0xa3, 0x1b, 0x50,
-	0xf5, 0x29, 0x2b, 0x43, 0x07, 0xb8, 0x34, 0xaa, 0xdb, 0x55, 0x61, 0xed, 0xb8, 0x07, 0xfc, 0x02,
-	0xd4, 0x03, 0xcf, 0xd8, 0xc1, 0xb6, 0x10, 0xe0, 0x01, 0x2a, 0x9f, 0x53, 0x0c, 0xde, 0x8f, 0x8e,
-	0xa6, 0x84, 0x83, 0xca, 0x69, 0xe1, 0x20, 0x54, 0x83, 0x8c, 0xf3, 0x90, 0x46, 0x1a, 0xb3, 0x5a,
-	0xc6, 0x79, 0xc8, 0x04, 0xa1, 0xd6, 0xc8, 0xaa, 0xef, 0xc2, 0xe4, 0x2d, 0x2b, 0xa0, 0x1c, 0x26,
-	0xea, 0xaf, 0xd0, 0x73, 0x08, 0x55, 0xf2, 0xa7, 0xa0, 0xe8, 0xb9, 0xbb, 0xcc, 0xae, 0x11, 0x9f,
-	0xac, 0xa2, 0x15, 0x3c, 0x77, 0x97, 0x1a, 0x2d, 0x9a, 0xac, 0xe2, 0x7a, 0x98, 0x79, 0x98, 0x19,
-	0x8d, 0x97, 0xd4, 0x3f, 0x56, 0xfa, 0x52, 0x45, 0x2c, 0x91, 0x7f, 0x38, 0x53, 0xf4, 0x16, 0x14,
-	0x3c, 0xd6, 0x7e, 0x68, 0xa8, 0x5c, 0x1c, 0x89, 0xda, 0xd5, 0xb0, 0xd5, 0xc8, 0x02, 0x48, 0x4e,
-	0x98, 0x95, 0x1b, 0x76, 0xcf, 0x7f, 0x1c, 0x5a, 0x20, 0x0b, 0x3b, 0x64, 0xe5, 0x61, 0x10, 0xba,
-	0x1a, 0x53, 0x8b, 0x59, 0xf5, 0x7b, 0x19, 0xa8, 0x72, 0x7a, 0xc6, 0x71, 0x35, 0x52, 0x69, 0xda,
-	0x80, 0x32, 0x19, 0x5b, 0xf7, 0x71, 0x3b, 0xbc, 0x5d, 0x29, 0xaf, 0xac, 0x48, 0x5d, 0xed, 0x18,
-	0x19, 0x34, 0x2d, 0x61, 0x83, 0x36, 0x7a, 0xc7, 0x09, 0xbc, 0x3d, 0x0d, 0x5a, 0x11, 0xa0, 0xf9,
-	0x11, 0x4c, 0x25, 0xaa, 0x89, 0x34, 0x3d, 0xc0, 0x7b, 0xfc, 0xd0, 0x42, 0x7e, 0xa2, 0x57, 0xc4,
-	0x84, 0x92, 0xb4, 0x2d, 0xf2, 0xb6, 0xeb, 0xb4, 0xaf, 0x7a, 0x9e, 0xb1, 0xc7, 0x13, 0x4e, 0x2e,
-	0x67, 0xbe, 0xac, 0xa8, 0x7f, 0x9d, 0x81, 0xca, 0x7b, 0x3d, 0xec, 0xed, 0x1d, 0xa5, 0xa5, 0x0a,
-	0x2d, 0xed, 0xa4, 0x60, 0x69, 0x07, 0x8c, 0x43, 0x4e, 0x62, 0x1c, 0x24, 0x26, 0x2e, 0x2f, 0x35,
-	0x71, 0x32, 0xed, 0x2f, 0x1c, 0x48, 0xfb, 0x8b, 0xc3, 0x83, 0xc1, 0xf5, 0x46, 0x56, 0xfd, 0x23,
-	0x25, 0xe2, 0xe5, 0x58, 0xfa, 0x19, 0x73, 0x7a, 0x32, 0x07, 0x76, 0x7a, 0x46, 0xd6, 0xcf, 0x4f,
-	0x15, 0x28, 0x7d, 0x80, 0x5b, 0x81, 0xeb, 0x11, 0x8b, 0x24, 0x69, 0xa6, 0x8c, 0xe0, 0x89, 0x66,
-	0x92, 0x9e, 0xe8, 0x45, 0x28, 0x5a, 0xa6, 0x6e, 0x10, 0x41, 0xa3, 0xe3, 0x0e, 0xf3, 0x77, 0x0a,
-	0x96, 0x49, 0x25, 0x72, 0xf4, 0x7b, 0xf9, 0xef, 0x2b, 0x50, 0x61, 0x34, 0xfb, 0xac, 0xe5, 0x1b,
-	0xc2, 0x70, 0x8a, 0x4c, 0xfa, 0x79, 0x21, 0x9a, 0xe8, 0xad, 0x89, 0xfe, 0xb0, 0x57, 0x01, 0x08,
-	0x93, 0x79, 0x73, 0xa6, 0x3c, 0x8b, 0x52, 0x6a, 0x59, 0x73, 0xca, 0xf0, 0x5b, 0x13, 0x5a, 0x89,
-	0xb4, 0xa2, 0x5d, 0x5c, 0x2b, 0x40, 0x8e, 0xb6, 0x56, 0xff, 0x47, 0x81, 0x99, 0xeb, 0x86, 0xdd,
-	0x5a, 0xb5, 0xfc, 0xc0, 0x70, 0x5a, 0x63, 0x78, 0x38, 0x97, 0xa1, 0xe0, 0x76, 0x75, 0x1b, 0x6f,
-	0x05, 0x9c, 0xa4, 0x33, 0x43, 0x66, 0xc4, 0xd8, 0xa0, 0xe5, 0xdd, 0xee, 0x6d, 0xbc, 0x15, 0xa0,
-	0x37, 0xa1, 0xe8, 0x76, 0x75, 0xcf, 0x6a, 0x6f, 0x07, 0x9c, 0xfb, 0x23, 0x34, 0x2e, 0xb8, 0x5d,
-	0x8d, 0xb4, 0x10, 0x2e, 0x37, 0x26, 0x0f, 0x78, 0xb9, 0x41, 0xce, 0xa8, 0x89, 0xe9, 0x8f, 0xa1,
-	0x03, 0x97, 0xa1, 0x68, 0x39, 0x81, 0x6e, 0x5a, 0x7e, 0xc8, 0x82, 0x53, 0x72, 0x19, 0x72, 0x02,
-	0x3a, 0x03, 0xba, 0xa6, 0x4e, 0x40, 0xc6, 0x46, 0x6f, 0x03, 0x6c, 0xd9, 0xae, 0xc1, 0x5b, 0x33,
-	0x1e, 0x9c, 0x96, 0xab, 0x0f, 0x41, 0x0b, 0xdb, 0x97, 0x68, 0x23, 0xd2, 0x43, 0x7f, 0x49, 0xff,
-	0x5e, 0x81, 0xb9, 0x75, 0xec, 0xb1, 0x1c, 0xa3, 0x80, 0xdf, 0x4c, 0xae, 0x39, 0x5b, 0x6e, 0xfc,
-	0x72, 0x58, 0x49, 0x5c, 0x0e, 0xff, 0x74, 0x2e, 0x44, 0x63, 0xe7, 0x13, 0x16, 0xa2, 0x08, 0xcf,
-	0x27, 0x61, 0x20, 0x86, 0x1d, 0xf4, 0x6a, 0x29, 0xcb, 0xc4, 0xe9, 0x15, 0xcf, 0xbb, 0xea, 0xaf,
-	0xb1, 0x3c, 0x0c, 0xe9, 0xa4, 0x0e, 0x2f, 0xb0, 0xf3, 0xc0, 0x4d, 0x7e, 0x62, 0x03, 0x78, 0x0e,
-	0x12, 0xb6, 0x23, 0xc5, 0x10, 0xfd, 0x96, 0x02, 0x8b, 0xe9, 0x54, 0x8d, 0xb3, 0x57, 0xbf, 0x0d,
-	0x39, 0xcb, 0xd9, 0x72, 0xc3, 0x7b, 0xaf, 0x73, 0x52, 0x5d, 0x90, 0x8f, 0xcb, 0x1a, 0xaa, 0xff,
-	0x90, 0x81, 0xfa, 0x7b, 0x2c, 0xae, 0xff, 0xb9, 0x2f, 0x7f, 0x07, 0x77, 0x74, 0xdf, 0xfa, 0x04,
-	0x87, 0xcb, 0xdf, 0xc1, 0x9d, 0x0d, 0xeb, 0x13, 0x1c, 0x93, 0x8c, 0x5c, 0x5c, 0x32, 0x86, 0x5f,
-	0xf4, 0x8a, 0xf7, 0x9a, 0x85, 0xf8, 0xbd, 0xe6, 0x3c, 0xe4, 0x1d, 0xd7, 0xc4, 0x6b, 0xab, 0xfc,
-	0x4c, 0xc7, 0x4b, 0x7d, 0x51, 0x2b, 0x1d, 0x4c, 0xd4, 0xc8, 0x50, 0xb4, 0x0b, 0x93, 0xa5, 0x08,
-	0x12, 0x1a, 0x59, 0x51, 0xfd, 0x8e, 0x02, 0xcd, 0x9b, 0x38, 0x48, 0x72, 0xf5, 0xe8, 0xe4, 0xef,
-	0xbb, 0x0a, 0x9c, 0x90, 0x12, 0x34, 0x8e, 0xe8, 0xbd, 0x11, 0x17, 0x3d, 0xf9, 0x95, 0xeb, 0xc0,
-	0x90, 0x5c, 0xea, 0x5e, 0x86, 0xca, 0x6a, 0xaf, 0xd3, 0x89, 0xbc, 0xb2, 0x33, 0x50, 0xf1, 0xd8,
-	0x4f, 0x76, 0xce, 0x62, 0x3b, 0x73, 0x99, 0xc3, 0xc8, 0x69, 0x4a, 0x3d, 0x0f, 0x55, 0xde, 0x84,
-	0x53, 0xdd, 0x84, 0xa2, 0xc7, 0x7f, 0x73, 0xfc, 0xa8, 0xac, 0xce, 0xc1, 0x8c, 0x86, 0xdb, 0x44,
-	0xe8, 0xbd, 0xdb, 0x96, 0xf3, 0x80, 0x0f, 0xa3, 0x7e, 0x5d, 0x81, 0xd9, 0x38, 0x9c, 0xf7, 0x75,
-	0x09, 0x0a, 0x86, 0x69, 0x7a, 0xd8, 0xf7, 0x87, 0x2e, 0xcb, 0x55, 0x86, 0xa3, 0x85, 0xc8, 0x02,
-	0xe7, 0x32, 0x23, 0x73, 0x4e, 0xd5, 0x61, 0xfa, 0x26, 0x0e, 0xee, 0xe0, 0xc0, 0x1b, 0x2b, 0xdc,
-	0xde, 0x20, 0x07, 0x1d, 0xda, 0x98, 0x8b, 0x45, 0x58, 0x54, 0xbf, 0xad, 0x00, 0x12, 0x47, 0x18,
-	0x67, 0x99, 0x45, 0x2e, 0x67, 0xe2, 0x5c, 0x66, 0x09, 0x4f, 0x9d, 0xae, 0xeb, 0x60, 0x27, 0x10,
-	0x1d, 0xb1, 0x6a, 0x04, 0xa5, 0xe2, 0xf7, 0x13, 0x05, 0xd0, 0x6d, 0xd7, 0x30, 0xaf, 0x19, 0xf6,
-	0x78, 0x8e, 0xc3, 0x29, 0x00, 0xdf, 0x6b, 0xe9, 0x5c, 0x8f, 0x33, 0xdc, 0x2e, 0x79, 0xad, 0xbb,
-	0x4c, 0x95, 0x4f, 0x43, 0xd9, 0xf4, 0x03, 0x5e, 0x1d, 0x46, 0x7f, 0xc1, 0xf4, 0x03, 0x56, 0x4f,
-	0xf3, 0x8e, 0x7d, 0x6c, 0xd8, 0xd8, 0xd4, 0x85, 0xe0, 0xd9, 0x24, 0x45, 0xab, 0xb3, 0x8a, 0x8d,
-	0x08, 0x2e, 0x51, 0xae, 0x5c, 0x7a, 0x0e, 0xe0, 0x74, 0x23, 0xa7, 0x6e, 0xc1, 0xc2, 0x1d, 0xc3,
-	0xe9, 0x19, 0xf6, 0x75, 0xb7, 0xd3, 0x35, 0x62, 0x39, 0xab, 0x49, 0x8b, 0xa9, 0x48, 0x2c, 0xe6,
-	0xd3, 0x2c, 0x95, 0x8e, 0xb9, 0xeb, 0x74, 0x72, 0x93, 0x9a, 0x00, 0x61, 0xe3, 0x14, 0x1a, 0x8a,
-	0xea, 0x43, 0x63, 0x70, 0x9c, 0x71, 0x96, 0x98, 0x52, 0x17, 0x76, 0x25, 0xda, 0xf3, 0x3e, 0x4c,
-	0x7d, 0x0b, 0x9e, 0xa2, 0xf9, 0x8d, 0x21, 0x28, 0x76, 0x4d, 0x9f, 0xec, 0x40, 0x91, 0x74, 0xf0,
-	0x8b, 0x19, 0x6a, 0x14, 0x07, 0x7a, 0x18, 0x87, 0xf0, 0xcb, 0xf1, 0x4b, 0xf1, 0x67, 0x53, 0xd2,
-	0xaa, 0xe3, 0x23, 0x72, 0xf3, 0xbd, 0x04, 0x53, 0xf8, 0x11, 0x6e, 0xf5, 0x02, 0xcb, 0x69, 0xaf,
-	0xdb, 0x86, 0x73, 0xd7, 0xe5, 0x9b, 0x54, 0x12, 0x8c, 0x9e, 0x85, 0x2a, 0x59, 0x06, 0xb7, 0x17,
-	0x70, 0x3c, 0xb6, 0x5b, 0xc5, 0x81, 0xa4, 0x3f, 0x32, 0x5f, 0x1b, 0x07, 0xd8, 0xe4, 0x78, 0x6c,
-	0xeb, 0x4a, 0x82, 0x07, 0x58, 0x49, 0xc0, 0xfe, 0x41, 0x58, 0xf9, 0xcf, 0x4a, 0x82, 0x95, 0xbc,
-	0x87, 0xa3, 0x62, 0xe5, 0x2d, 0x80, 0x0e, 0xf6, 0xda, 0x78, 0x8d, 0x6e, 0x07, 0xec, 0x5a, 0x60,
-	0x49, 0xba, 0x1d, 0xf4, 0x3b, 0xb8, 0x13, 0x36, 0xd0, 0x84, 0xb6, 0xea, 0x4d, 0x98, 0x91, 0xa0,
-	0x10, 0x4b, 0xe7, 0xbb, 0x3d, 0xaf, 0x85, 0xc3, 0x2b, 0xa6, 0xb0, 0x48, 0x76, 0xc6, 0xc0, 0xf0,
-	0xda, 0x38, 0xe0, 0x42, 0xcb, 0x4b, 0xea, 0x25, 0x1a, 0x50, 0xa2, 0xb7, 0x10, 0x31, 0x49, 0x8d,
-	0xc7, 0xcd, 0x95, 0x81, 0xb8, 0xf9, 0x16, 0x0d, 0xda, 0x88, 0xed, 0xc6, 0xcc, 0x79, 0xd8, 0x22,
-	0x5d, 0x61, 0x93, 0x3f, 0xb3, 0x09, 0x8b, 0xea, 0xff, 0x2a, 0x50, 0x5d, 0xeb, 0x74, 0xdd, 0x7e,
-	0x98, 0x62, 0xe4, 0xe3, 0xe9, 0xe0, 0xed, 0x6e, 0x46, 0x76, 0xbb, 0xfb, 0x0c, 0x54, 0xe3, 0x0f,
-	0x32, 0xd8, 0xed, 0x51, 0xa5, 0x25, 0x3e, 0xc4, 0x38, 0x01, 0x25, 0xcf, 0xdd, 0xd5, 0x89, 0x71,
-	0x35, 0x79, 0x76, 0x45, 0xd1, 0x73, 0x77, 0x89, 0xc9, 0x35, 0xd1, 0x2c, 0xe4, 0xb6, 0x2c, 0x3b,
-	0x4a, 0x0c, 0x62, 0x05, 0xf4, 0x06, 0x39, 0xbc, 0xb1, 0x58, 0x6b, 0x7e, 0xd4, 0x33, 0x54, 0xd8,
-	0x82, 0xd9, 0x30, 0xd4, 0x50, 0xd4, 0x0f, 0xa1, 0x16, 0x4e, 0x7f, 0xcc, 0x87, 0x46, 0x81, 0xe1,
-	0x3f, 0x08, 0x33, 0x20, 0x58, 0x41, 0x3d, 0xcf, 0x22, 0x6f, 0xb4, 0xff, 0xd8, 0xea, 0x23, 0x98,
-	0x24, 0x18, 0x5c, 0xa9, 0xe8, 0x6f, 0xf5, 0xef, 0x32, 0x30, 0x9f, 0xc4, 0x1e, 0x87, 0xa4, 0x4b,
-	0x71, 0x45, 0x92, 0xbf, 0x1b, 0x11, 0x47, 0xe3, 0x4a, 0xc4, 0x97, 0xa2, 0xe5, 0xf6, 0x9c, 0x80,
-	0x5b, 0x22, 0xb2, 0x14, 0xd7, 0x49, 0x19, 0x2d, 0x40, 0xc1, 0x32, 0x75, 0x9b, 0x1c, 0xf8, 0xd8,
-	0x76, 0x95, 0xb7, 0xcc, 0xdb, 0xe4, 0x30, 0xf8, 0x5a, 0xe8, 0x84, 0x8d, 0x9c, 0x36, 0xc1, 0xf0,
-	0x51, 0x0d, 0x32, 0x96, 0xc9, 0x83, 0x23, 0x19, 0xcb, 0x24, 0x52, 0x45, 0x6f, 0x0a, 0x68, 0x8a,
-	0x2f, 0xcf, 0xf9, 0x25, 0xe2, 0x50, 0x25, 0xd0, 0xf7, 0x42, 0x20, 0xf1, 0xd3, 0x28, 0x1a, 0x0f,
-	0xee, 0x52, 0x5f, 0xba, 0xa8, 0x95, 0x09, 0x6c, 0x8d, 0x81, 0xd4, 0x06, 0xcc, 0x13, 0xd2, 0xd8,
-	0x14, 0xef, 0x93, 0x05, 0x09, 0xbd, 0xaf, 0xef, 0x29, 0xb0, 0x30, 0x50, 0x35, 0x0e, 0xaf, 0xaf,
-	0x8a, 0xcb, 0x5f, 0x5e, 0x39, 0x2f, 0xb5, 0x39, 0xf2, 0xc5, 0x0d, 0x65, 0xe5, 0xd7, 0x99, 0xab,
-	0xa4, 0xb1, 0xb4, 0xce, 0xc7, 0x9c, 0x24, 0xb4, 0x04, 0xf5, 0x5d, 0x2b, 0xd8, 0xd6, 0xe9, 0x4b,
-	0x24, 0xea, 0xa7, 0xb0, 0x60, 0x78, 0x51, 0xab, 0x11, 0xf8, 0x06, 0x01, 0x13, 0x5f, 0xc5, 0x57,
-	0xbf, 0xa5, 0xc0, 0x4c, 0x8c, 0xac, 0x71, 0xd8, 0xf4, 0x26, 0x71, 0xe1, 0x58, 0x47, 0x9c, 0x53,
-	0x8b, 0x52, 0x4e, 0xf1, 0xd1, 0xa8, 0x55, 0x8e, 0x5a, 0xa8, 0x3f, 0x56, 0xa0, 0x2c, 0xd4, 0x90,
-	0xb3, 0x21, 0xaf, 0xeb, 0x9f, 0x0d, 0x23, 0xc0, 0x48, 0x6c, 0x78, 0x06, 0xfa, 0xb6, 0x4a, 0x48,
-	0x93, 0x17, 0xf2, 0xf4, 0x4c, 0x1f, 0xdd, 0x82, 0x1a, 0x63, 0x53, 0x44, 0xba, 0xf4, 0xca, 0x26,
-	0xca, 0x40, 0x34, 0x3c, 0x93, 0x53, 0xa9, 0x55, 0x7d, 0xa1, 0xc4, 0xe2, 0xa0, 0xae, 0x89, 0xe9,
-	0x48, 0xb9, 0x81, 0x93, 0x5a, 0x45, 0x6c, 0x4a, 0xbc, 0x5d, 0x1b, 0x1b, 0x26, 0xf6, 0xa2, 0xb9,
-	0x45, 0x65, 0xe2, 0x5e, 0xb2, 0xdf, 0x3a, 0xf1, 0xfe, 0xb9, 0xd5, 0x05, 0x06, 0x22, 0x07, 0x03,
-	0xf4, 0x1c, 0x4c, 0x99, 0x9d, 0xd8, 0x33, 0xb8, 0xd0, 0x1f, 0x36, 0x3b, 0xc2, 0xfb, 0xb7, 0x18,
-	0x41, 0x93, 0x71, 0x82, 0xbe, 0xd1, 0x7f, 0x58, 0xec, 0x61, 0x13, 0x3b, 0x81, 0x65, 0xd8, 0x87,
-	0x97, 0xc9, 0x26, 0x14, 0x7b, 0x3e, 0xf6, 0x84, 0x4d, 0x22, 0x2a, 0x93, 0xba, 0xae, 0xe1, 0xfb,
-	0xbb, 0xae, 0x67, 0x72, 0x2a, 0xa3, 0xf2, 0x90, 0xa4, 0x47, 0xf6, 0x18, 0x55, 0x9e, 0xf4, 0x78,
-	0x09, 0x16, 0x3a, 0xae, 0x69, 0x6d, 0x59, 0xb2, 0x5c, 0x49, 0xd2, 0x6c, 0x2e, 0xac, 0x8e, 0xb5,
-	0x0b, 0x9f, 0x71, 0xcc, 0x88, 0xcf, 0x38, 0x7e, 0x90, 0x81, 0x85, 0xf7, 0xbb, 0xe6, 0xe7, 0xc0,
-	0x87, 0x45, 0x28, 0xbb, 0xb6, 0xb9, 0x1e, 0x67, 0x85, 0x08, 0x22, 0x18, 0x0e, 0xde, 0x8d, 0x30,
-	0xd8, 0x25, 0xbe, 0x08, 0x1a, 0x9a, 0x24, 0x7a, 0x28, 0x7e, 0xe5, 0x87, 0xf1, 0xab, 0xf4, 0xd9,
-	0x95, 0x7c, 0x31, 0x53, 0x9f, 0x6d, 0x64, 0xd4, 0x9f, 0x87, 0x05, 0x16, 0x6e, 0x7e, 0xcc, 0x5c,
-	0x0a, 0xd7, 0x68, 0x4e, 0x5c, 0xa3, 0x8f, 0x61, 0x8e, 0x58, 0x73, 0x32, 0xf4, 0xfb, 0x3e, 0xf6,
-	0xc6, 0x34, 0x52, 0x27, 0xa1, 0x14, 0x8e, 0x16, 0xa6, 0xf7, 0xf6, 0x01, 0xea, 0xcf, 0xc1, 0x6c,
-	0x62, 0xac, 0x43, 0xce, 0x32, 0x9c, 0xc9, 0xbc, 0x38, 0x93, 0x45, 0x00, 0xcd, 0xb5, 0xf1, 0x3b,
-	0x4e, 0x60, 0x05, 0x7b, 0xc4, 0x4b, 0x10, 0xdc, 0x2f, 0xfa, 0x9b, 0x60, 0x90, 0x71, 0x87, 0x60,
-	0xfc, 0xaa, 0x02, 0xd3, 0x4c, 0x73, 0x49, 0x57, 0x87, 0x5f, 0x85, 0xd7, 0x20, 0x8f, 0xe9, 0x28,
-	0xfc, 0x46, 0xe1, 0xb4, 0xdc, 0x54, 0x47, 0xe4, 0x6a, 0x1c, 0x5d, 0xaa, 0x46, 0x01, 0x4c, 0xad,
-	0x7a, 0x6e, 0x77, 0x3c, 0x8a, 0xa8, 0x67, 0x62, 0x63, 0xd1, 0xd7, 0x2c, 0x12, 0xc0, 0xdd, 0x34,
-	0xc1, 0xf8, 0x27, 0x05, 0xe6, 0xef, 0x75, 0xb1, 0x67, 0x04, 0x98, 0x30, 0x6d, 0xbc, 0xd1, 0x87,
-	0xe9, 0x6e, 0x8c, 0xb2, 0x6c, 0x9c, 0x32, 0xf4, 0x66, 0xec, 0xed, 0x99, 0xfc, 0x3c, 0x92, 0xa0,
-	0xb2, 0x9f, 0xc3, 0x1e, 0xce, 0x6b, 0x41, 0x9c, 0xd7, 0x8f, 0x14, 0x98, 0xde, 0xc0, 0x64, 0x1f,
-	0x1b, 0x6f, 0x4a, 0x17, 0x61, 0x92, 0x50, 0x39, 0xea, 0x02, 0x53, 0x64, 0x74, 0x0e, 0xa6, 0x2d,
-	0xa7, 0x65, 0xf7, 0x4c, 0xac, 0x93, 0xf9, 0xeb, 0xc4, 0x8d, 0xe3, 0xce, 0xc3, 0x14, 0xaf, 0x20,
-	0xd3, 0x20, 0x5b, 0xb4, 0x54, 0xc6, 0x1f, 0x31, 0x19, 0x8f, 0x92, 0x7c, 0x18, 0x09, 0xca, 0x41,
-	0x48, 0x78, 0x15, 0x72, 0x64, 0xe8, 0xd0, 0x89, 0x90, 0xb7, 0xea, 0xab, 0x89, 0xc6, 0xb0, 0xd5,
-	0x5f, 0x50, 0x00, 0x89, 0x6c, 0x1b, 0xc7, 0x4a, 0xbc, 0x2e, 0x06, 0xf7, 0xb3, 0x43, 0x49, 0x67,
-	0x33, 0x8d, 0xc2, 0xfa, 0xea, 0xa7, 0xd1, 0xea, 0xd1, 0xe5, 0x1e, 0x67, 0xf5, 0xc8, 0xbc, 0x86,
-	0xae, 0x9e, 0xc0, 0x04, 0x8a, 0x2c, 0xae, 0x1e, 0x95, 0x58, 0xc9, 0xea, 0x11, 0x9a, 0xe9, 0xea,
-	0x71, 0xfb, 0xde, 0x68, 0x64, 0xc8, 0xa2, 0x31, 0x62, 0xc3, 0x45, 0xa3, 0x23, 0x2b, 0x07, 0x19,
-	0xf9, 0x55, 0xc8, 0x91, 0x11, 0xf7, 0xe7, 0x57, 0xb8, 0x68, 0x14, 0x5b, 0x58, 0x34, 0x4e, 0xc0,
-	0xe3, 0x5f, 0xb4, 0xfe, 0x4c, 0xfb, 0x8b, 0xa6, 0x42, 0xe5, 0xde, 0xe6, 0xc7, 0xb8, 0x15, 0x0c,
-	0xb1, 0xbc, 0x67, 0x61, 0x6a, 0xdd, 0xb3, 0x76, 0x2c, 0x1b, 0xb7, 0x87, 0x99, 0xf0, 0x6f, 0x29,
-	0x50, 0xbd, 0xe9, 0x19, 0x4e, 0xe0, 0x86, 0x66, 0xfc, 0x50, 0xfc, 0xbc, 0x06, 0xa5, 0x6e, 0x38,
-	0x1a, 0x97, 0x81, 0x67, 0xe5, 0x51, 0x97, 0x38, 0x4d, 0x5a, 0xbf, 0x99, 0xfa, 0x01, 0xcc, 0x52,
-	0x4a, 0x92, 0x64, 0x5f, 0x81, 0x22, 0x35, 0xe6, 0x16, 0xbf, 0xe8, 0x28, 0xaf, 0xa8, 0xf2, 0x23,
-	0x8d, 0x38, 0x0d, 0x2d, 0x6a, 0xa3, 0xfe, 0x9b, 0x02, 0x65, 0x5a, 0xd7, 0x9f, 0xe0, 0xc1, 0xb5,
-	0xfc, 0x75, 0xc8, 0xbb, 0x94, 0xe5, 0x43, 0x83, 0xb3, 0xe2, 0xaa, 0x68, 0xbc, 0x01, 0xf1, 0x90,
-	0xd9, 0x2f, 0xd1, 0x22, 0x03, 0x03, 0x71, 0x9b, 0x5c, 0x68, 0x33, 0xda, 0xa9, 0x59, 0x1e, 0x6d,
-	0x7e, 0x61, 0x13, 0x7a, 0x56, 0x63, 0x32, 0x49, 0x11, 0x0e, 0xaf, 0xc2, 0x5f, 0x4e, 0xec, 0xb1,
-	0x8b, 0xe9, 0x54, 0xc8, 0x37, 0xd9, 0x98, 0x65, 0x25, 0x67, 0xb5, 0x18, 0x59, 0x63, 0x9e, 0xd5,
-	0x22, 0x11, 0x18, 0x76, 0x56, 0x13, 0x89, 0xeb, 0x0b, 0xc0, 0xbf, 0x28, 0xb0, 0xc0, 0xf7, 0xb4,
-	0x48, 0xb6, 0x8e, 0x80, 0x4d, 0xe8, 0x2b, 0x7c, 0xef, 0xcd, 0xd2, 0xbd, 0xf7, 0x85, 0x61, 0x7b,
-	0x6f, 0x44, 0xe7, 0x3e, 0x9b, 0xef, 0x59, 0x28, 0xdd, 0xa1, 0x0d, 0xdf, 0x79, 0x14, 0xa0, 0x06,
-	0x14, 0x76, 0xb0, 0xe7, 0x5b, 0xae, 0xc3, 0x55, 0x3c, 0x2c, 0x9e, 0x3b, 0x03, 0xc5, 0xf0, 0x35,
-	0x1a, 0x2a, 0x40, 0xf6, 0xaa, 0x6d, 0xd7, 0x27, 0x50, 0x05, 0x8a, 0x6b, 0xfc, 0xc9, 0x55, 0x5d,
-	0x39, 0xf7, 0x36, 0xcc, 0x48, 0xf6, 0x7d, 0x34, 0x0d, 0xd5, 0xab, 0x26, 0xf5, 0x2e, 0xef, 0xbb,
-	0x04, 0x58, 0x9f, 0x40, 0xf3, 0x80, 0x34, 0xdc, 0x71, 0x77, 0x28, 0xe2, 0x0d, 0xcf, 0xed, 0x50,
-	0xb8, 0x72, 0xee, 0x45, 0x98, 0x95, 0x51, 0x8f, 0x4a, 0x90, 0xa3, 0xdc, 0xa8, 0x4f, 0x20, 0x80,
-	0xbc, 0x86, 0x77, 0xdc, 0x07, 0xb8, 0xae, 0xac, 0xfc, 0xf9, 0x39, 0xa8, 0x32, 0xda, 0xf9, 0xdb,
-	0x69, 0xa4, 0x43, 0x3d, 0xf9, 0xf9, 0x28, 0xf4, 0x25, 0xf9, 0x8d, 0xa9, 0xfc, 0x2b, 0x53, 0xcd,
-	0x61, 0xc2, 0xa4, 0x4e, 0xa0, 0x0f, 0xa1, 0x16, 0xff, 0xe0, 0x12, 0x92, 0x87, 0x86, 0xa5, 0x5f,
-	0x65, 0xda, 0xaf, 0x73, 0x1d, 0xaa, 0xb1, 0x6f, 0x25, 0x21, 0xf9, 0x02, 0xcb, 0xbe, 0xa7, 0xd4,
-	0x94, 0x5b, 0x13, 0xf1, 0x7b, 0x46, 0x8c, 0xfa, 0xf8, 0xc7, 0x4b, 0x52, 0xa8, 0x97, 0x7e, 0xe1,
-	0x64, 0x3f, 0xea, 0x0d, 0x98, 0x1e, 0xf8, 0xb6, 0x08, 0x7a, 0x31, 0xe5, 0x42, 0x44, 0xfe, 0x0d,
-	0x92, 0xfd, 0x86, 0xd8, 0x05, 0x34, 0xf8, 0xfd, 0x1f, 0xb4, 0x2c, 0x5f, 0x81, 0xb4, 0x2f, 0x22,
-	0x35, 0x2f, 0x8c, 0x8c, 0x1f, 0x31, 0xee, 0x9b, 0x0a, 0x2c, 0xa4, 0x7c, 0x86, 0x02, 0x5d, 0x4c,
-	0xbb, 0x1d, 0x1b, 0xf2, 0x51, 0x8d, 0xe6, 0x2b, 0x07, 0x6b, 0x14, 0x11, 0xe2, 0xc0, 0x54, 0xe2,
-	0x2b, 0x0c, 0xe8, 0x7c, 0xea, 0xd3, 0xd1, 0xc1, 0x4f, 0x54, 0x34, 0xbf, 0x34, 0x1a, 0x72, 0x34,
-	0xde, 0x47, 0x30, 0x95, 0xf8, 0x04, 0x41, 0xca, 0x78, 0xf2, 0x0f, 0x15, 0xec, 0xb7, 0xa0, 0x5f,
-	0x85, 0x6a, 0xec, 0x5b, 0x01, 0x29, 0x12, 0x2f, 0xfb, 0x9e, 0xc0, 0x7e, 0x5d, 0x7f, 0x04, 0x15,
-	0xf1, 0x49, 0x3f, 0x5a, 0x4a, 0xd3, 0xa5, 0x81, 0x8e, 0x0f, 0xa2, 0x4a, 0xfd, 0xa7, 0xb8, 0x43,
-	0x54, 0x69, 0xe0, 0xf5, 0xf2, 0xe8, 0xaa, 0x24, 0xf4, 0x3f, 0x54, 0x95, 0x0e, 0x3c, 0xc4, 0xd7,
-	0x15, 0x7a, 0x3d, 0x2f, 0x79, 0xea, 0x8d, 0x56, 0xd2, 0x64, 0x33, 0xfd, 0x51, 0x7b, 0xf3, 0xe2,
-	0x81, 0xda, 0x44, 0x5c, 0x7c, 0x00, 0xb5, 0xf8, 0x83, 0xe6, 0x14, 0x2e, 0x4a, 0xdf, 0x80, 0x37,
-	0xcf, 0x8f, 0x84, 0x1b, 0x0d, 0xf6, 0x3e, 0x94, 0x85, 0x2f, 0x42, 0xa2, 0xe7, 0x87, 0xc8, 0xb1,
-	0xf8, 0x79, 0xc4, 0xfd, 0x38, 0xf9, 0x1e, 0x94, 0xa2, 0x0f, 0x39, 0xa2, 0xb3, 0xa9, 0xf2, 0x7b,
-	0x90, 0x2e, 0x37, 0x00, 0xfa, 0x5f, 0x69, 0x44, 0xcf, 0x49, 0xfb, 0x1c, 0xf8, 0x8c, 0xe3, 0x7e,
-	0x9d, 0x46, 0xd3, 0x67, 0x2f, 0x3e, 0x86, 0x4d, 0x5f, 0x7c, 0xb4, 0xb4, 0x5f, 0xb7, 0xdb, 0x50,
-	0x8d, 0x3d, 0x3e, 0x4c, 0x53, 0x61, 0xc9, 0xe3, 0xd0, 0xe6, 0xb9, 0x51, 0x50, 0xa3, 0xf5, 0xdb,
-	0x86, 0x6a, 0xec, 0xe1, 0x57, 0xca, 0x48, 0xb2, 0x07, 0x6f, 0x29, 0x23, 0x49, 0xdf, 0x91, 0xa9,
-	0x13, 0xe8, 0x6b, 0xc2, 0x1b, 0xb3, 0xd8, 0x83, 0x3e, 0xf4, 0xf2, 0xd0, 0x7e, 0x64, 0x0f, 0x1b,
-	0x9b, 0x2b, 0x07, 0x69, 0x12, 0x91, 0xc0, 0xa5, 0x8a, 0xb1, 0x34, 0x5d, 0xaa, 0x0e, 0xb2, 0x52,
-	0x1b, 0x90, 0x67, 0x2f, 0xb8, 0x90, 0x9a, 0xf2, 0x8c, 0x53, 0x78, 0xde, 0xd5, 0x7c, 0x46, 0x8a,
-	0x13, 0x7f, 0xd3, 0xc4, 0x3a, 0x65, 0x37, 0xa5, 0x29, 0x9d, 0xc6, 0x5e, 0xed, 0x8c, 0xda, 0xa9,
-	0x06, 0x79, 0xf6, 0x8c, 0x20, 0xa5, 0xd3, 0xd8, 0x1b, 0x99, 0xe6, 0x70, 0x1c, 0x76, 0xde, 0x9d,
-	0x40, 0xeb, 0x90, 0xa3, 0xe1, 0x67, 0x74, 0x66, 0x58, 0x62, 0xfd, 0xb0, 0x1e, 0x63, 0xb9, 0xf7,
-	0xea, 0x04, 0xba, 0x07, 0x39, 0x1a, 0xc0, 0x4b, 0xe9, 0x51, 0xcc, 0x8e, 0x6f, 0x0e, 0x45, 0x09,
-	0x49, 0x34, 0xa1, 0x22, 0x66, 0xc2, 0xa6, 0x6c, 0x59, 0x92, 0x5c, 0xe1, 0xe6, 0x28, 0x98, 0xe1,
-	0x28, 0x4c, 0x8d, 0xfa, 0xa1, 0xf8, 0x74, 0x35, 0x1a, 0x08, 0xf3, 0xa7, 0xab, 0xd1, 0x60, 0x64,
-	0x5f, 0x9d, 0x40, 0xbf, 0xa4, 0x40, 0x23, 0x2d, 0x3d, 0x13, 0xa5, 0x7a, 0x40, 0xc3, 0x72, 0x4c,
-	0x9b, 0xaf, 0x1e, 0xb0, 0x55, 0x44, 0xcb, 0x27, 0x34, 0xee, 0x37, 0x90, 0x90, 0x79, 0x21, 0xad,
-	0xbf, 0x94, 0x24, 0xc3, 0xe6, 0x4b, 0xa3, 0x37, 0x88, 0xc6, 0xde, 0x84, 0xb2, 0x10, 0x73, 0x4c,
-	0xb1, 0xbc, 0x83, 0xc1, 0xd2, 0x94, 0x55, 0x95, 0x84, 0x2f, 0x99, 0x78, 0xd3, 0x2c, 0xbe, 0x14,
-	0x61, 0x14, 0x93, 0x02, 0x53, 0xc4, 0x3b, 0x96, 0x04, 0xa8, 0x4e, 0x20, 0x0c, 0x15, 0x31, 0xa5,
-	0x2f, 0x45, 0x1a, 0x25, 0xd9, 0x80, 0xcd, 0x17, 0x46, 0xc0, 0x8c, 0x86, 0xd1, 0x01, 0xfa, 0x29,
-	0x75, 0x29, 0x7b, 0xdd, 0x40, 0x56, 0x5f, 0xf3, 0xf9, 0x7d, 0xf1, 0xc4, 0x6d, 0x5f, 0x48, 0x92,
-	0x4b, 0xe1, 0xfe, 0x60, 0x1a, 0xdd, 0x08, 0x67, 0x91, 0xc1, 0xb4, 0xab, 0x94, 0xb3, 0x48, 0x6a,
-	0x86, 0x57, 0xf3, 0xc2, 0xc8, 0xf8, 0xd1, 0x7c, 0x1e, 0x42, 0x3d, 0x99, 0xa6, 0x96, 0x72, 0xc6,
-	0x4d, 0xc9, 0x9a, 0x6b, 0xbe, 0x38, 0x22, 0xb6, 0xb8, 0x1f, 0x9e, 0x18, 0xa4, 0xe9, 0x67, 0xac,
-	0x60, 0x9b, 0x66, 0x48, 0x8d, 0x32, 0x6b, 0x31, 0x19, 0x6b, 0x94, 0x59, 0xc7, 0x52, 0xaf, 0xf8,
-	0xe6, 0x45, 0xb3, 0x0d, 0xd2, 0x36, 0x2f, 0x31, 0xe9, 0x27, 0x65, 0x9f, 0x89, 0x67, 0xc6, 0x30,
-	0xf7, 0x33, 0x9e, 0xc5, 0x80, 0xce, 0x8d, 0x94, 0xea, 0x30, 0xcc, 0xfd, 0x94, 0xa7, 0x45, 0xb0,
-	0xa3, 0x5b, 0x22, 0x49, 0x23, 0xe5, 0x28, 0x25, 0xcf, 0xf2, 0x48, 0x39, 0xba, 0xa5, 0xe4, 0x7d,
-	0x50, 0xc5, 0xaa, 0x27, 0x23, 0xde, 0xc3, 0xef, 0x42, 0x92, 0xa1, 0xce, 0xfd, 0xaf, 0x2b, 0xea,
-	0xc9, 0x50, 0x72, 0xca, 0x00, 0x29, 0x11, 0xe7, 0x11, 0x06, 0x48, 0x46, 0x61, 0x53, 0x06, 0x48,
-	0x09, 0xd6, 0x8e, 0xe0, 0xbb, 0xc6, 0xa2, 0x9f, 0x29, 0x5b, 0xa1, 0x2c, 0x42, 0x9a, 0xb2, 0x15,
-	0x4a, 0x03, 0xb7, 0xcc, 0xa3, 0xef, 0x07, 0x31, 0x53, 0xac, 0xdc, 0x40, 0x94, 0x73, 0x3f, 0xf2,
-	0xef, 0x41, 0x31, 0x8c, 0x42, 0xa2, 0x67, 0x53, 0x5d, 0xc4, 0x03, 0x74, 0xf8, 0x11, 0x4c, 0x25,
-	0x6e, 0xf0, 0x52, 0x44, 0x54, 0x1e, 0x85, 0xdc, 0x7f, 0x3d, 0xa1, 0x1f, 0xaf, 0x4a, 0x61, 0xc2,
-	0x40, 0x1c, 0x30, 0xc5, 0xd4, 0x0f, 0x06, 0xbe, 0xc4, 0x01, 0x08, 0x61, 0x43, 0x07, 0x10, 0x42,
-	0x55, 0x43, 0x07, 0x10, 0x83, 0x34, 0x4c, 0x22, 0x93, 0x17, 0x94, 0x29, 0x12, 0x99, 0x72, 0x5b,
-	0xbc, 0x1f, 0x8b, 0x36, 0xa1, 0x2c, 0x5c, 0x79, 0xa3, 0x61, 0xa4, 0x89, 0x77, 0xf5, 0x29, 0xae,
-	0x82, 0xe4, 0xf6, 0x5c, 0x9d, 0x58, 0xe9, 0x41, 0x65, 0xdd, 0x73, 0x1f, 0x85, 0x1f, 0x9c, 0xfc,
-	0x9c, 0x36, 0xfa, 0xcb, 0x2d, 0xa8, 0x31, 0x04, 0x1d, 0x3f, 0x0a, 0x74, 0x77, 0xf3, 0x63, 0x74,
-	0x72, 0x99, 0xfd, 0x1b, 0x87, 0xe5, 0xf0, 0xdf, 0x38, 0x2c, 0xdf, 0xb0, 0x6c, 0x7c, 0x8f, 0x67,
-	0x41, 0xfe, 0x47, 0x61, 0xc8, 0xab, 0xbc, 0xe8, 0xca, 0x5a, 0xe3, 0xff, 0x49, 0xe2, 0x9d, 0x47,
-	0xc1, 0xbd, 0xcd, 0x8f, 0xaf, 0x19, 0x9f, 0x5d, 0x29, 0x40, 0x6e, 0x65, 0xf9, 0xe5, 0xe5, 0x97,
-	0xa0, 0x66, 0x45, 0xe8, 0x6d, 0xaf, 0xdb, 0xba, 0x56, 0x66, 0x8d, 0xd6, 0x49, 0x3f, 0xeb, 0xca,
-	0xcf, 0x5e, 0x6c, 0x5b, 0xc1, 0x76, 0x6f, 0x93, 0x2c, 0xc1, 0x05, 0x86, 0xf6, 0xa2, 0xe5, 0xf2,
-	0x5f, 0x17, 0x2c, 0x27, 0xc0, 0x9e, 0x63, 0xd8, 0xec, 0x3f, 0x4c, 0x70, 0x68, 0x77, 0xf3, 0xf7,
-	0x14, 0x65, 0x33, 0x4f, 0x41, 0x17, 0xff, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xff, 0xb6, 0x47, 0x70,
-	0xc3, 0x62, 0x00, 0x00,
+	// 6424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x7d, 0x7d, 0x8c, 0x1c, 0xc9,
+	0x55, 0xb8, 0x7b, 0xbe, 0xe7, 0xcd, 0xcc, 0xee, 0x6c, 0xef, 0xd7, 0xdc, 0xf8, 0x7c, 0xb7, 0x6e,
+	0x9f, 0xe3, 0xf5, 0xfa, 0xbc, 0xbe, 0x5b, 0xdf, 0xf9, 0x12, 0xe7, 0x72, 0x17, 0xdb, 0xeb, 0x8f,
+	0xfd, 0xe5, 0x6c, 0xef, 0xf5, 0xda, 0x89, 0x92, 0xfc, 0xa2, 0x51, 0xef, 0x74, 0xed, 0x6e, 0x9f,
+	0x7b, 0xba, 0xc7, 0xdd, 0x3d, 0x5e, 0x6f, 0xf8, 0x07, 0x29, 0x04, 0x05, 0xf1, 0x11, 0x42, 0x02,
+	0x01, 0x89, 0x8f, 0x08, 0x05, 0x21, 0x04, 0x48, 0x1c, 0xfc, 0x11, 0x11, 0x3e, 0xff, 0x44, 0x27,
+	0x02, 0x04, 0x09, 0x01, 0x0a, 0xfc, 0x17, 0x81, 0x40, 0x42, 0x80, 0xc4, 0x9f, 0x20, 0x50, 0x7d,
+	0x74, 0x77, 0x75, 0x4f, 0x75, 0x4f, 0xef, 0xce, 0xf9, 0x76, 0x9d, 0xf9, 0x6b, 0xea, 0xd5, 0xab,
+	0xaa, 0x57, 0xaf, 0x5e, 0xbd, 0x7a, 0xf5, 0xea, 0x55, 0x35, 0xd4, 0x7b, 0x86, 0xf9, 0x68, 0xe0,
+	0x2e, 0xf7, 0x1d, 0xdb, 0xb3, 0xe5, 0x69, 0x3e, 0xb5, 0x4c, 0x13, 0xed, 0x7a, 0xd7, 0xee, 0xf5,
+	0x6c, 0x8b, 0x02, 0xdb, 0x75, 0xb7, 0xbb, 0x83, 0x7a, 0x1a, 0x4b, 0x2d, 0x6c, 0xdb, 0xf6, 0xb6,
+	0x89, 0x2e, 0x90, 0xd4, 0xe6, 0x60, 0xeb, 0x82, 0x8e, 0xdc, 0xae, 0x63, 0xf4, 0x3d, 0xdb, 0xa1,
+	0x18, 0xca, 0x2f, 0x4b, 0x20, 0x5f, 0x73, 0x90, 0xe6, 0xa1, 0x2b, 0xa6, 0xa1, 0xb9, 0x2a, 0x7a,
+	0x38, 0x40, 0xae, 0x27, 0xbf, 0x04, 0x85, 0x4d, 0xcd, 0x45, 0x2d, 0x69, 0x41, 0x5a, 0xac, 0xad,
+	0x3c, 0xbb, 0x1c, 0x69, 0x98, 0x35, 0x78, 0xdb, 0xdd, 0xbe, 0xaa, 0xb9, 0x48, 0x25, 0x98, 0xf2,
+	0x3c, 0x94, 0xf5, 0xcd, 0x8e, 0xa5, 0xf5, 0x50, 0x2b, 0xb7, 0x20, 0x2d, 0x56, 0xd5, 0x92, 0xbe,
+	0x79, 0x47, 0xeb, 0x21, 0xf9, 0x0c, 0x4c, 0x76, 0x6d, 0xd3, 0x44, 0x5d, 0xcf, 0xb0, 0x2d, 0x8a,
+	0x90, 0x27, 0x08, 0x13, 0x21, 0x98, 0x20, 0xce, 0x40, 0x51, 0xc3, 0x34, 0xb4, 0x0a, 0x24, 0x9b,
+	0x26, 0x14, 0x17, 0x9a, 0xab, 0x8e, 0xdd, 0x7f, 0x52, 0xd4, 0x05, 0x8d, 0xe6, 0xf9, 0x46, 0x7f,
+	0x49, 0x82, 0xa9, 0x2b, 0xa6, 0x87, 0x9c, 0x23, 0xca, 0x94, 0x5f, 0x97, 0x40, 0x26, 0xf4, 0x6d,
+	0xec, 0x68, 0x8e, 0x7e, 0xa8, 0x04, 0x9e, 0x00, 0x70, 0x09, 0x11, 0x1d, 0x6b, 0xd0, 0x23, 0x54,
+	0x16, 0xd5, 0x2a, 0x85, 0xdc, 0x19, 0xf4, 0x94, 0x3f, 0x92, 0x60, 0xf2, 0x8a, 0xae, 0xdf, 0x30,
+	0x90, 0xa9, 0x1f, 0x26, 0x99, 0x97, 0xa0, 0xb8, 0x85, 0x69, 0x20, 0x14, 0xd6, 0x56, 0x16, 0xa2,
+	0x8d, 0xb2, 0x49, 0x43, 0xa8, 0xdc, 0x20, 0xff, 0x55, 0x8a, 0xae, 0xfc, 0x9a, 0x44, 0xe5, 0xef,
+	0xd0, 0x3b, 0x70, 0x02, 0x80, 0x50, 0x44, 0x71, 0xa8, 0x34, 0x54, 0x09, 0x04, 0x67, 0x2b, 0xbf,
+	0x2f, 0xc1, 0x2c, 0x9d, 0xc7, 0x1b, 0x96, 0xd6, 0x77, 0x77, 0x6c, 0xef, 0x30, 0x89, 0x3d, 0x05,
+	0x0d, 0x97, 0x91, 0xc1, 0xd3, 0x5b, 0xf7, 0x81, 0x18, 0xe9, 0x72, 0xf9, 0xbd, 0x37, 0x0a, 0xcd,
+	0x72, 0x2b, 0xaf, 0x7c, 0x4b, 0x82, 0x69, 0xcc, 0xe3, 0xa7, 0x8f, 0xf2, 0xaf, 0x4a, 0x30, 0xf3,
+	0x96, 0xe1, 0x7a, 0x3e, 0xe5, 0x87, 0x39, 0x13, 0x29, 0x55, 0xf9, 0x56, 0x5e, 0xf9, 0x4d, 0x09,
+	0xea, 0x3e, 0x45, 0x6b, 0xd6, 0x96, 0x3d, 0xdc, 0x29, 0x69, 0xb8, 0x53, 0xa2, 0x76, 0x72, 0x42,
+	0x16, 0x9d, 0x07, 0x39, 0xa8, 0xcd, 0x33, 0x7a, 0xc8, 0xf5, 0xb4, 0x5e, 0x9f, 0xd0, 0x54, 0x50,
+	0xa7, 0xfc, 0x9c, 0x7b, 0x7e, 0x86, 0xfc, 0x1c, 0x80, 0x8b, 0xb6, 0x7b, 0xc8, 0xf2, 0xd6, 0x56,
+	0xb1, 0x1a, 0xcb, 0x2f, 0xe6, 0x55, 0x0e, 0xa2, 0xfc, 0xa4, 0x04, 0xb3, 0x31, 0x1e, 0xba, 0x7d,
+	0xdb, 0x72, 0x91, 0x7c, 0x11, 0x4a, 0xae, 0xa7, 0x79, 0x03, 0x97, 0xb1, 0xf1, 0xb8, 0x90, 0x8d,
+	0x1b, 0x04, 0x45, 0x65, 0xa8, 0xf2, 0x9b, 0x50, 0xf5, 0x69, 0x70, 0x5b, 0xb9, 0x85, 0xfc, 0x62,
+	0x6d, 0xe5, 0xe4, 0xb2, 0x60, 0xdd, 0x5c, 0xe6, 0x39, 0xa4, 0x86, 0x65, 0x94, 0x9f, 0x97, 0xe0,
+	0x99, 0x7b, 0xce, 0xc0, 0xea, 0x6a, 0x1e, 0xba, 0x16, 0xf4, 0xfc, 0xd0, 0x07, 0xb6, 0xda, 0xca,
+	0x2b, 0xff, 0x9e, 0x83, 0x79, 0x3a, 0xc9, 0x8f, 0x04, 0x61, 0xf2, 0x1c, 0x94, 0xa8, 0xe6, 0x24,
+	0xb3, 0xa4, 0xae, 0xb2, 0x54, 0x6c, 0x4d, 0x28, 0xc6, 0xd6, 0x04, 0x59, 0x85, 0xa9, 0xae, 0x6d,
+	0xb9, 0x86, 0xeb, 0x21, 0xab, 0xbb, 0xd7, 0x31, 0xd1, 0x23, 0x64, 0xb6, 0x4a, 0x0b, 0xd2, 0xe2,
+	0xc4, 0xca, 0x69, 0x21, 0xdd, 0xd7, 0x42, 0xec, 0xb7, 0x30, 0xb2, 0xda, 0xec, 0xc6, 0x20, 0xf2,
+	0x15, 0x80, 0xbe, 0x63, 0xf7, 0x91, 0xe3, 0x19, 0xc8, 0x6d, 0x95, 0x45, 0xe3, 0xce, 0x2a, 0xfb,
+	0x04, 0xda, 0xfb, 0xa4, 0x66, 0x0e, 0xd0, 0xba, 0x66, 0x38, 0x2a, 0x57, 0xe8, 0xb2, 0xfc, 0xde,
+	0x1b, 0x93, 0x15, 0xa9, 0x29, 0xb5, 0xfe, 0xd7, 0xff, 0x49, 0xca, 0xaf, 0x48, 0x30, 0x8b, 0x55,
+	0xd3, 0xd1, 0x10, 0x04, 0x46, 0x61, 0x8e, 0xa7, 0xf0, 0x37, 0x24, 0x98, 0xb9, 0xa5, 0xb9, 0x47,
+	0x43, 0x20, 0x4e, 0x00, 0x60, 0x8d, 0xd0, 0xa1, 0x2a, 0xa1, 0x40, 0x54, 0x42, 0x15, 0x43, 0x36,
+	0x30, 0x40, 0xf9, 0x34, 0xd4, 0xaf, 0xda, 0xb6, 0x39, 0xde, 0x04, 0x9f, 0x81, 0xe2, 0x23, 0x3c,
+	0x7e, 0x84, 0xc6, 0x8a, 0x4a, 0x13, 0xca, 0x67, 0x61, 0x62, 0xc3, 0x73, 0x0c, 0x6b, 0xfb, 0x7d,
+	0xac, 0xbc, 0xea, 0x57, 0xfe, 0x4f, 0x12, 0x3c, 0xb3, 0x4a, 0x2c, 0xe7, 0xcd, 0x23, 0x32, 0xf3,
+	0x14, 0xa8, 0x87, 0x90, 0xb5, 0x55, 0xc2, 0xea, 0xbc, 0x1a, 0x81, 0xc5, 0x06, 0xa3, 0x18, 0x1b,
+	0x0c, 0x5f, 0x98, 0xf2, 0xbc, 0x30, 0xfd, 0x67, 0x11, 0xda, 0xa2, 0x8e, 0x8e, 0xc3, 0xd2, 0x8f,
+	0x05, 0x4a, 0x22, 0x47, 0x0a, 0x9d, 0x16, 0x9a, 0x5e, 0x61, 0x6b, 0xcc, 0xfe, 0x62, 0x85, 0x86,
+	0x7a, 0x9a, 0x17, 0xf4, 0x74, 0x05, 0x66, 0x1f, 0x19, 0x8e, 0x37, 0xd0, 0xcc, 0x4e, 0x77, 0x47,
+	0xb3, 0x2c, 0x64, 0x12, 0xde, 0xd1, 0xd5, 0xa6, 0xaa, 0x4e, 0xb3, 0xcc, 0x6b, 0x34, 0x0f, 0x33,
+	0xd0, 0x95, 0x5f, 0x81, 0xb9, 0xfe, 0xce, 0x9e, 0x6b, 0x74, 0x87, 0x0a, 0x15, 0x49, 0xa1, 0x19,
+	0x3f, 0x37, 0x52, 0xea, 0x1c, 0x4c, 0x75, 0x89, 0x02, 0xd6, 0xb9, 0xa5, 0xaf, 0x44, 0x58, 0xdb,
+	0x64, 0x19, 0xe1, 0xca, 0xb7, 0x02, 0xb3, 0x3e, 0xf2, 0xc0, 0xeb, 0x72, 0x05, 0xca, 0xa4, 0xc0,
+	0x34, 0xcb, 0xbc, 0xef, 0x75, 0xc3, 0x32, 0x51, 0xd5, 0x59, 0x89, 0xab, 0xce, 0x16, 0x94, 0xc9,
+	0x0e, 0x00, 0xb9, 0xad, 0x2a, 0x21, 0xd3, 0x4f, 0xca, 0x6b, 0x30, 0xe9, 0x7a, 0x9a, 0xe3, 0x75,
+	0xfa, 0xb6, 0x6b, 0x60, 0xbe, 0xb8, 0x2d, 0x58, 0xc8, 0x0f, 0x9b, 0xba, 0xa1, 0x16, 0x5c, 0xd5,
+	0x3c, 0x8d, 0x28, 0xc1, 0x09, 0x52, 0x70, 0xdd, 0x2f, 0x27, 0xd6, 0xcf, 0xb5, 0xf1, 0xf4, 0xb3,
+	0x40, 0xb2, 0xeb, 0x42, 0xc9, 0x8e, 0x2a, 0xf2, 0xc6, 0x01, 0x14, 0xb9, 0xfc, 0x22, 0xc8, 0x21,
+	0x0f, 0x3b, 0x3b, 0x86, 0xeb, 0xd9, 0xce, 0x5e, 0x6b, 0x62, 0x21, 0xbf, 0x58, 0x54, 0x9b, 0x01,
+	0x2f, 0x6f, 0x51, 0xb8, 0xf2, 0x07, 0xd8, 0xfe, 0xb0, 0x35, 0xfd, 0x68, 0x4c, 0xec, 0xd3, 0x30,
+	0xe1, 0xa0, 0xbe, 0x69, 0x74, 0x35, 0x4c, 0xfc, 0x26, 0x72, 0xd8, 0x96, 0xaa, 0xc1, 0xa0, 0x77,
+	0x08, 0x90, 0x9a, 0x04, 0xc5, 0x56, 0x5e, 0xf9, 0xba, 0x04, 0x2d, 0x15, 0x99, 0x48, 0x73, 0x8f,
+	0x92, 0xb1, 0x52, 0x6a, 0xe5, 0x95, 0x7f, 0x93, 0x60, 0xe6, 0x26, 0xf2, 0xb0, 0x36, 0x30, 0x5c,
+	0xcf, 0xe8, 0x1e, 0xea, 0x2e, 0xf5, 0x0c, 0x4c, 0xf6, 0x35, 0xc7, 0x33, 0x02, 0x3c, 0x5f, 0x37,
+	0x4c, 0x04, 0x60, 0x3a, 0xc1, 0x2f, 0xc0, 0xf4, 0xf6, 0x40, 0x73, 0x34, 0xcb, 0x43, 0x88, 0x9b,
+	0xb1, 0x54, 0x7b, 0xca, 0x41, 0x56, 0x30, 0x61, 0x69, 0x7f, 0xa1, 0x95, 0x57, 0xbe, 0x28, 0xc1,
+	0x6c, 0xac, 0xbf, 0xe3, 0xa8, 0xcd, 0xd7, 0xa0, 0x88, 0xff, 0x25, 0xd8, 0xb0, 0xa2, 0x29, 0x40,
+	0xf1, 0xb1, 0xef, 0xe2, 0xb9, 0x9b, 0xc8, 0xe3, 0x14, 0xea, 0x51, 0x18, 0x81, 0x90, 0x4f, 0x5f,
+	0x96, 0xe0, 0xf9, 0x44, 0xfa, 0x0e, 0x85, 0x63, 0xff, 0x25, 0xc1, 0xdc, 0xc6, 0x8e, 0xbd, 0x1b,
+	0x92, 0xf4, 0x24, 0x38, 0x15, 0x5d, 0x8e, 0xf3, 0xb1, 0xe5, 0x58, 0x7e, 0x19, 0x0a, 0xde, 0x5e,
+	0x9f, 0xee, 0x37, 0x27, 0x56, 0x4e, 0x88, 0xb7, 0x2c, 0x3b, 0xf6, 0xee, 0xbd, 0xbd, 0x3e, 0x52,
+	0x09, 0xaa, 0x7c, 0x16, 0x9a, 0x31, 0xde, 0xfb, 0x8b, 0xd7, 0x64, 0x94, 0xf9, 0x81, 0x6d, 0x5b,
+	0xe0, 0x17, 0xfb, 0xff, 0xc8, 0xc1, 0xfc, 0x50, 0xb7, 0xc7, 0x19, 0x00, 0x11, 0x3d, 0x39, 0x21,
+	0x3d, 0x58, 0xcd, 0x71, 0xa8, 0x86, 0x8e, 0xfd, 0x6f, 0x78, 0x63, 0xd8, 0x08, 0xa1, 0x6b, 0xba,
+	0x8b, 0xb7, 0x9a, 0x43, 0xcb, 0x2d, 0x9d, 0xb9, 0x05, 0x75, 0x2a, 0xbe, 0xde, 0x92, 0x35, 0x5d,
+	0xb8, 0xe0, 0x52, 0xb6, 0x14, 0xd4, 0x19, 0xc1, 0x8a, 0xeb, 0xca, 0x2f, 0xc3, 0x8c, 0x61, 0xdd,
+	0x46, 0x3d, 0xdb, 0xd9, 0xeb, 0xf4, 0x91, 0xd3, 0x45, 0x96, 0xa7, 0x6d, 0x23, 0xb7, 0x55, 0x22,
+	0x14, 0x4d, 0xfb, 0x79, 0xeb, 0x61, 0x96, 0x7c, 0x09, 0xe6, 0x1f, 0x0e, 0x90, 0xb3, 0xd7, 0x71,
+	0x91, 0xf3, 0xc8, 0xe8, 0xa2, 0x8e, 0xf6, 0x48, 0x33, 0x4c, 0x6d, 0xd3, 0x44, 0x64, 0xeb, 0x51,
+	0x51, 0x67, 0x49, 0xf6, 0x06, 0xcd, 0xbd, 0xe2, 0x67, 0x2a, 0xbf, 0x27, 0xc1, 0x1c, 0xdd, 0xc0,
+	0xad, 0xfb, 0x6a, 0xe7, 0x90, 0x17, 0x9b, 0xa8, 0x56, 0x64, 0xde, 0x8e, 0x46, 0x44, 0x29, 0x2a,
+	0xef, 0x4a, 0x30, 0x83, 0x37, 0x41, 0x4f, 0x13, 0xcd, 0x7f, 0x2c, 0x41, 0xcb, 0xdf, 0xc5, 0x3f,
+	0x45, 0x74, 0x87, 0x7b, 0xfd, 0xdf, 0x91, 0x60, 0xfa, 0x96, 0xe6, 0x3e, 0x4d, 0x3c, 0xff, 0x1e,
+	0xb3, 0xa4, 0x02, 0x9a, 0x9f, 0x8e, 0x25, 0x7f, 0xd8, 0xe4, 0x2a, 0x0a, 0x4c, 0x2e, 0xe5, 0x5b,
+	0xa1, 0xa5, 0xf5, 0x74, 0x75, 0x50, 0xf9, 0xb6, 0x04, 0x27, 0x6e, 0x22, 0x2f, 0xa0, 0xfa, 0x68,
+	0x98, 0x64, 0x19, 0x85, 0xea, 0xa7, 0xa8, 0x39, 0x23, 0x24, 0xfe, 0x50, 0xac, 0x85, 0x1f, 0xcf,
+	0xc1, 0x2c, 0x5e, 0x36, 0x8f, 0x86, 0x10, 0x64, 0x71, 0x04, 0x08, 0x04, 0xa5, 0x28, 0x9c, 0x09,
+	0xbe, 0x0d, 0x52, 0xca, 0x6c, 0x83, 0x28, 0xbf, 0x9b, 0x83, 0xb9, 0x38, 0x37, 0xc6, 0x19, 0x16,
+	0x01, 0xad, 0x39, 0x21, 0xad, 0x0a, 0xd4, 0x03, 0xc8, 0xda, 0xaa, 0x6f, 0x3f, 0x44, 0x60, 0x47,
+	0xd5, 0x7c, 0x50, 0x7e, 0x42, 0x82, 0x39, 0xdf, 0xcd, 0xb2, 0x41, 0x3d, 0xe1, 0x07, 0x97, 0xa1,
+	0xb8, 0x04, 0xe4, 0x04, 0x12, 0xf0, 0x2c, 0x54, 0x03, 0x8f, 0x3b, 0xf3, 0xa0, 0x84, 0x00, 0xe5,
+	0x4f, 0x24, 0x98, 0x1f, 0x22, 0x67, 0x9c, 0x41, 0x6c, 0x41, 0xd9, 0xb0, 0x74, 0xf4, 0x38, 0xa0,
+	0xc6, 0x4f, 0xe2, 0x9c, 0xcd, 0x81, 0x61, 0xea, 0x01, 0x19, 0x7e, 0x52, 0x3e, 0x09, 0x75, 0x64,
+	0x61, 0x23, 0xa9, 0x43, 0x70, 0x89, 0x20, 0x57, 0xd4, 0x1a, 0x85, 0xad, 0x61, 0x10, 0x2e, 0x4c,
+	0x0e, 0xbc, 0xd6, 0x56, 0x89, 0x86, 0xce, 0xab, 0x7e, 0x12, 0x9f, 0x21, 0x4c, 0x63, 0x29, 0x64,
+	0xd4, 0xbb, 0x4f, 0x96, 0x9b, 0x0b, 0x50, 0xe3, 0xc4, 0x8c, 0x75, 0x84, 0x07, 0x29, 0x0f, 0x60,
+	0x26, 0x4a, 0xce, 0x38, 0xdc, 0x8c, 0x1e, 0xa0, 0xe4, 0x86, 0x0e, 0x50, 0x7e, 0x36, 0xe7, 0x1f,
+	0xe1, 0x13, 0x36, 0x1d, 0xdd, 0x43, 0x4a, 0x79, 0x15, 0xea, 0xe8, 0xb1, 0xe7, 0x68, 0x9d, 0xbe,
+	0xe6, 0x68, 0x3d, 0x3a, 0xad, 0x32, 0xa9, 0xde, 0x1a, 0x29, 0xb6, 0x4e, 0x4a, 0xe1, 0x46, 0x88,
+	0x88, 0xd0, 0x46, 0x4a, 0xb4, 0x11, 0x02, 0x09, 0x2d, 0xa8, 0x5a, 0x2b, 0xaf, 0x7c, 0x17, 0x9b,
+	0xad, 0x4c, 0xac, 0x8f, 0x3a, 0x67, 0xa2, 0x7d, 0x2a, 0x0a, 0xfb, 0x54, 0x6f, 0xe5, 0xc9, 0x71,
+	0x34, 0xe9, 0xcb, 0x2a, 0x0b, 0xe4, 0x30, 0x6c, 0x2b, 0x56, 0x58, 0x8a, 0x15, 0x4e, 0x99, 0x8d,
+	0x1f, 0x81, 0x12, 0x1b, 0x89, 0x7c, 0xd6, 0x91, 0x60, 0x05, 0x46, 0x1d, 0x47, 0xff, 0x2a, 0x3e,
+	0x37, 0x89, 0xf2, 0x7e, 0x9c, 0x29, 0x70, 0x0f, 0x64, 0xda, 0x43, 0x3d, 0xec, 0xb6, 0xbf, 0x72,
+	0x9f, 0x16, 0x2e, 0x53, 0x71, 0x26, 0xa9, 0x53, 0x46, 0x0c, 0xe2, 0x2a, 0x7f, 0x2f, 0xc1, 0xb3,
+	0x37, 0x91, 0x47, 0x50, 0xaf, 0x62, 0x35, 0xb4, 0xee, 0xd8, 0xdb, 0x0e, 0x72, 0xdd, 0x1f, 0x00,
+	0x41, 0xf9, 0x39, 0x6a, 0xf3, 0x89, 0xfa, 0x36, 0xce, 0x40, 0x9c, 0x84, 0x3a, 0x69, 0x0c, 0xe9,
+	0x1d, 0xc7, 0xde, 0x75, 0x99, 0x40, 0xd5, 0x18, 0x4c, 0xb5, 0x77, 0x89, 0x64, 0x78, 0xb6, 0xa7,
+	0x99, 0x14, 0x81, 0x2d, 0x36, 0x04, 0x82, 0xb3, 0xc9, 0xac, 0xf4, 0x09, 0xc3, 0x95, 0xa3, 0x1f,
+	0x00, 0x66, 0x7f, 0x93, 0xba, 0xfe, 0xf8, 0x3e, 0x8d, 0xc3, 0xe4, 0x57, 0xa9, 0x69, 0x4a, 0x7b,
+	0x35, 0xb1, 0xf2, 0xbc, 0xb0, 0x0c, 0xd7, 0x18, 0xc5, 0x96, 0x9f, 0x87, 0xda, 0x96, 0x66, 0x98,
+	0x1d, 0x07, 0x69, 0xae, 0x6d, 0xb1, 0x1e, 0x03, 0x06, 0xa9, 0x04, 0xa2, 0x7c, 0x87, 0xc5, 0xb2,
+	0xfc, 0x20, 0x28, 0xc3, 0x46, 0x2b, 0xaf, 0xfc, 0x56, 0x0e, 0x1a, 0x6b, 0x96, 0x8b, 0x1c, 0xef,
+	0xe8, 0xef, 0x63, 0xe4, 0x37, 0xa1, 0x46, 0x7a, 0xe8, 0x76, 0x74, 0xcd, 0xd3, 0xd8, 0xd2, 0xf7,
+	0x5c, 0x72, 0x18, 0x12, 0x3e, 0x9d, 0x51, 0x29, 0x9b, 0x5c, 0xfc, 0x5f, 0x3e, 0x0e, 0xd5, 0x1d,
+	0xcd, 0xdd, 0xe9, 0x3c, 0x40, 0x7b, 0xd4, 0xb8, 0x6c, 0xa8, 0x15, 0x0c, 0xf8, 0x04, 0xda, 0x73,
+	0xe5, 0x67, 0xa0, 0x82, 0xcf, 0x3a, 0xc8, 0x94, 0xc3, 0xa7, 0x4b, 0x0d, 0xb5, 0x6c, 0x0d, 0x7a,
+	0x78, 0xc2, 0x51, 0x76, 0x55, 0x5a, 0x79, 0xe5, 0xcf, 0x73, 0x30, 0x71, 0x7b, 0xe0, 0x69, 0xd4,
+	0x9b, 0xe0, 0x0e, 0x4c, 0xef, 0x60, 0xe2, 0xb9, 0x04, 0x79, 0x6a, 0x88, 0xe0, 0x12, 0x2d, 0x61,
+	0x0f, 0xd6, 0x56, 0x5d, 0x15, 0x23, 0xe1, 0xa1, 0x74, 0x07, 0xdd, 0x2e, 0xb3, 0xe9, 0xf2, 0x84,
+	0xea, 0x2a, 0x86, 0x50, 0x8b, 0xee, 0x38, 0x54, 0x91, 0xe3, 0x04, 0x16, 0x1f, 0xe9, 0x13, 0x72,
+	0x1c, 0x9a, 0xa9, 0x40, 0x5d, 0xeb, 0x3e, 0xb0, 0xec, 0x5d, 0x13, 0xe9, 0xdb, 0x48, 0x27, 0x82,
+	0x50, 0x51, 0x23, 0x30, 0x2a, 0x2a, 0x58, 0x02, 0x3a, 0x5d, 0xcb, 0x23, 0xb6, 0x40, 0x5e, 0xad,
+	0x52, 0xc8, 0x35, 0xcb, 0xc3, 0xd9, 0x3a, 0x32, 0x91, 0x87, 0x48, 0x76, 0x99, 0x66, 0x53, 0x08,
+	0xcb, 0x1e, 0xf4, 0x83, 0xd2, 0x15, 0x9a, 0x4d, 0x21, 0x38, 0xfb, 0x59, 0xa8, 0x86, 0x27, 0x00,
+	0xd5, 0xd0, 0x61, 0x4b, 0x00, 0xca, 0xf7, 0x25, 0x68, 0xac, 0x92, 0xaa, 0x9e, 0x02, 0xe9, 0x93,
+	0xa1, 0x80, 0x1e, 0xf7, 0x1d, 0x36, 0x99, 0xc8, 0xff, 0x54, 0x81, 0x0a, 0xfd, 0x50, 0xdf, 0xc1,
+	0xc1, 0x59, 0xa4, 0x9b, 0x57, 0xf7, 0xae, 0x3f, 0xee, 0x3b, 0x4f, 0x67, 0x67, 0xc3, 0xfe, 0x68,
+	0x30, 0x13, 0xed, 0xce, 0x98, 0xe1, 0x02, 0xef, 0xd8, 0x9b, 0x81, 0x61, 0x45, 0x13, 0xca, 0x6f,
+	0x4b, 0xd0, 0xba, 0x89, 0x3c, 0xda, 0xcc, 0xff, 0xb3, 0x37, 0xc7, 0x5c, 0xe6, 0x84, 0x8d, 0xf0,
+	0xdc, 0xcc, 0x8f, 0xe2, 0x66, 0x21, 0x2d, 0xac, 0xa8, 0xa0, 0x7c, 0x3f, 0x07, 0xcf, 0x08, 0xe8,
+	0x1d, 0x87, 0x31, 0x1f, 0x89, 0x2e, 0x61, 0xa7, 0x84, 0x36, 0x5a, 0xac, 0x41, 0x5a, 0x02, 0x9b,
+	0x18, 0x74, 0x86, 0xea, 0xbc, 0x05, 0x51, 0x63, 0x30, 0x62, 0x62, 0xcc, 0x41, 0x89, 0x2d, 0x72,
+	0xb4, 0x67, 0x2c, 0x25, 0xea, 0x7a, 0x31, 0xa3, 0x20, 0x95, 0xd2, 0x04, 0xa9, 0x1c, 0x9d, 0x35,
+	0xd4, 0x0d, 0xd0, 0xf1, 0x5c, 0xa2, 0x32, 0x0a, 0x6a, 0x85, 0x02, 0xee, 0xb9, 0x38, 0x73, 0xd0,
+	0xd7, 0x59, 0x26, 0xd5, 0x18, 0x15, 0x0a, 0xb8, 0xe7, 0x2a, 0xff, 0x58, 0x80, 0xc6, 0x06, 0xd2,
+	0x9c, 0xee, 0xce, 0x53, 0xe1, 0x16, 0x6d, 0x42, 0x5e, 0x77, 0x4d, 0xc6, 0x40, 0xfc, 0x17, 0x07,
+	0x3f, 0xf4, 0x4d, 0xad, 0x8b, 0x76, 0x6c, 0x53, 0x47, 0x4e, 0x67, 0xdb, 0xb1, 0x07, 0x34, 0xf8,
+	0xa1, 0xae, 0x36, 0xb9, 0x8c, 0x9b, 0x18, 0x2e, 0xbf, 0x06, 0x15, 0xdd, 0x35, 0x3b, 0xc4, 0x9f,
+	0x54, 0x26, 0x42, 0x20, 0xee, 0xdf, 0xaa, 0x6b, 0x12, 0x77, 0x52, 0x59, 0xa7, 0x7f, 0x70, 0xb0,
+	0xa2, 0x3d, 0xf0, 0xfa, 0x03, 0xaf, 0x43, 0x17, 0xbf, 0x56, 0x85, 0x90, 0x57, 0xa7, 0x40, 0xb2,
+	0x36, 0xba, 0xf2, 0x0d, 0x68, 0xb8, 0x84, 0x95, 0xfe, 0x56, 0xb2, 0x9a, 0x75, 0x03, 0x53, 0xa7,
+	0xe5, 0xd8, 0x5e, 0xf2, 0x2c, 0x34, 0x3d, 0x47, 0x7b, 0x84, 0x4c, 0xee, 0xac, 0x17, 0xc8, 0xb8,
+	0x4d, 0x52, 0x78, 0x18, 0x99, 0x91, 0x70, 0x32, 0x5c, 0x4b, 0x3a, 0x19, 0x96, 0x27, 0x20, 0x67,
+	0x3d, 0x24, 0x51, 0x0e, 0x79, 0x35, 0x67, 0x3d, 0xc4, 0xfb, 0x30, 0xf4, 0xb8, 0x6f, 0x6a, 0x86,
+	0xd5, 0x6a, 0x90, 0xa5, 0xcc, 0x4f, 0xca, 0x6d, 0xa8, 0xf4, 0x1d, 0xc3, 0x76, 0x0c, 0x0f, 0x87,
+	0x29, 0x60, 0xdf, 0x73, 0x90, 0xc6, 0x56, 0x1d, 0x47, 0x61, 0x6b, 0x92, 0x5a, 0x75, 0x21, 0x71,
+	0x74, 0x1a, 0x4f, 0xb4, 0xf2, 0xca, 0x27, 0xa0, 0x70, 0xcb, 0xf0, 0xc8, 0xc0, 0xe1, 0xf5, 0x59,
+	0x22, 0x8e, 0x02, 0xfc, 0x17, 0x5b, 0x07, 0x8e, 0xbd, 0x4b, 0x0d, 0x0f, 0xbc, 0x69, 0xaa, 0xab,
+	0x65, 0xc7, 0xde, 0x25, 0x56, 0x05, 0x09, 0xe1, 0xb3, 0x1d, 0x44, 0xb7, 0x80, 0x39, 0x95, 0xa5,
+	0x94, 0x2f, 0xe5, 0x42, 0x61, 0xc5, 0xa6, 0x82, 0x7b, 0xd0, 0x68, 0xcc, 0xb2, 0x43, 0xcb, 0xa7,
+	0x46, 0xff, 0xf0, 0x2d, 0x11, 0xc3, 0xc7, 0x2f, 0x95, 0x5d, 0xae, 0x3f, 0x1a, 0x72, 0x97, 0x86,
+	0x78, 0x8b, 0xa3, 0x3e, 0xdf, 0xc6, 0xe7, 0x72, 0xd7, 0x29, 0x62, 0x38, 0x00, 0xc7, 0xa1, 0x6a,
+	0x3d, 0xec, 0xd8, 0x5b, 0x5b, 0x2e, 0xf2, 0x98, 0x6f, 0xa9, 0x62, 0x3d, 0xbc, 0x4b, 0xd2, 0xd8,
+	0xb9, 0x54, 0xbf, 0x61, 0x0e, 0xdc, 0x27, 0x31, 0x6d, 0x45, 0x47, 0xa6, 0x79, 0xf1, 0x11, 0x2e,
+	0x19, 0xe7, 0xc9, 0x85, 0xbc, 0xf2, 0x95, 0x1c, 0x34, 0x18, 0x3d, 0xe3, 0xa8, 0xe8, 0x44, 0x9a,
+	0x36, 0xa0, 0x86, 0xdb, 0xee, 0xb8, 0x68, 0xdb, 0x77, 0xac, 0xd6, 0x56, 0x56, 0x84, 0xdc, 0x8c,
+	0x90, 0x41, 0x62, 0xb8, 0x36, 0x48, 0xa1, 0xeb, 0x96, 0xe7, 0xec, 0xa9, 0xd0, 0x0d, 0x00, 0xed,
+	0xcf, 0xc1, 0x64, 0x2c, 0x1b, 0xcb, 0xe9, 0x03, 0xb4, 0xc7, 0xfc, 0x15, 0xf8, 0xaf, 0xfc, 0x0a,
+	0x1f, 0x7d, 0x97, 0x64, 0x1d, 0xbf, 0x65, 0x5b, 0xdb, 0x57, 0x1c, 0x47, 0xdb, 0x63, 0xd1, 0x79,
+	0x97, 0x73, 0x1f, 0x96, 0x94, 0x6f, 0xe4, 0xa1, 0x4e, 0x86, 0xf6, 0x30, 0x55, 0xab, 0xbf, 0x5c,
+	0x14, 0xb8, 0xe5, 0x62, 0x48, 0x9b, 0x15, 0x05, 0xda, 0x4c, 0xa0, 0x93, 0x4b, 0x42, 0x9d, 0x2c,
+	0x52, 0x57, 0xe5, 0x7d, 0xa9, 0xab, 0x4a, 0xa2, 0xba, 0xe2, 0xd4, 0x53, 0x35, 0x59, 0x3d, 0x41,
+	0xba, 0x7a, 0xaa, 0x89, 0xd5, 0x53, 0xb3, 0x95, 0x57, 0xfe, 0x55, 0x0a, 0x86, 0x68, 0x2c, 0x85,
+	0x12, 0xd9, 0x46, 0xe5, 0xf6, 0xbd, 0x8d, 0xfa, 0x40, 0x14, 0x8a, 0xf2, 0x67, 0x12, 0x4c, 0x30,
+	0xef, 0xef, 0xba, 0x63, 0x6f, 0x19, 0x26, 0x8a, 0xfa, 0xe0, 0xa5, 0x98, 0x0f, 0x9e, 0xe8, 0x61,
+	0xa4, 0x99, 0x48, 0x67, 0x61, 0xad, 0x2c, 0x85, 0xad, 0x21, 0xb7, 0xab, 0x59, 0x56, 0xcc, 0x1a,
+	0x62, 0x30, 0x62, 0x0d, 0x9d, 0x82, 0xc6, 0x96, 0x61, 0x7a, 0xc8, 0xf1, 0x71, 0xd8, 0x19, 0x90,
+	0x0f, 0x24, 0x48, 0xc7, 0xa1, 0x4a, 0xd3, 0x9d, 0x81, 0xeb, 0x6b, 0x38, 0x0a, 0xb8, 0x4f, 0x32,
+	0xd9, 0x6a, 0x3a, 0x70, 0xd9, 0x26, 0xaa, 0x42, 0x01, 0xf7, 0x5d, 0x1c, 0xfc, 0x5a, 0x27, 0xd7,
+	0x8c, 0xfc, 0x8e, 0xb4, 0xa0, 0xcc, 0x02, 0x26, 0xd9, 0xdc, 0xf5, 0x93, 0xb8, 0x13, 0x96, 0xad,
+	0xa3, 0xc0, 0x54, 0x65, 0xa9, 0x4c, 0x67, 0x35, 0x6f, 0x42, 0x85, 0x71, 0x83, 0x1a, 0x24, 0xb5,
+	0x04, 0xa3, 0x31, 0xca, 0x55, 0x35, 0x28, 0x24, 0x2b, 0xd0, 0xd8, 0xd5, 0x0c, 0xaf, 0xe3, 0xb9,
+	0xda, 0x16, 0x0a, 0x7b, 0x59, 0xc3, 0xc0, 0x7b, 0x18, 0x46, 0x3b, 0xea, 0x20, 0x7d, 0xd0, 0x45,
+	0x5c, 0x47, 0x29, 0xe0, 0xbe, 0xab, 0x7c, 0xd1, 0x17, 0x50, 0x36, 0x9a, 0x78, 0x3e, 0xf7, 0x4d,
+	0xcd, 0x62, 0xbd, 0x24, 0xff, 0xb1, 0xcb, 0x94, 0x46, 0x10, 0xa6, 0xdf, 0x2d, 0xe0, 0xf8, 0xa5,
+	0xb2, 0x02, 0xf2, 0x87, 0x60, 0xb2, 0xef, 0xd8, 0x8f, 0xf7, 0x3a, 0x21, 0x09, 0x74, 0x34, 0x1b,
+	0x04, 0xac, 0xfa, 0x74, 0xbc, 0x2b, 0x41, 0xf5, 0x93, 0xa8, 0xeb, 0xd9, 0x0e, 0xe6, 0x8b, 0x40,
+	0x5e, 0xa5, 0x0c, 0x4e, 0x95, 0x5c, 0xdc, 0xa9, 0x72, 0x11, 0x2a, 0x86, 0xde, 0xd1, 0xb0, 0xe2,
+	0x6c, 0xe5, 0x47, 0x6c, 0xdd, 0xcb, 0x86, 0x4e, 0x34, 0x6c, 0xf6, 0x23, 0xe6, 0xaf, 0x4b, 0x50,
+	0xa7, 0x34, 0xbb, 0xb4, 0xe4, 0x47, 0xb9, 0xe6, 0x24, 0x91, 0x36, 0x67, 0x89, 0xa0, 0xa3, 0xb7,
+	0x8e, 0x85, 0xcd, 0x5e, 0x01, 0xc0, 0xb3, 0x9b, 0x15, 0xcf, 0xa5, 0xdc, 0xd8, 0xa2, 0xc5, 0xc9,
+	0x4c, 0xbf, 0x75, 0x4c, 0xad, 0xe2, 0x52, 0xa4, 0x8a, 0xab, 0x65, 0x28, 0x92, 0xd2, 0xca, 0x7f,
+	0x4b, 0x30, 0x7d, 0x4d, 0x33, 0xbb, 0xab, 0x86, 0xeb, 0x69, 0x56, 0x77, 0x8c, 0x7d, 0xd8, 0x65,
+	0x28, 0xdb, 0xfd, 0x8e, 0x89, 0xb6, 0x3c, 0x46, 0xd2, 0xc9, 0x94, 0x1e, 0x51, 0x36, 0xa8, 0x25,
+	0xbb, 0xff, 0x16, 0xda, 0xf2, 0xe4, 0xd7, 0xa1, 0x62, 0xf7, 0x3b, 0x8e, 0xb1, 0xbd, 0xe3, 0xb5,
+	0xf2, 0x59, 0x0b, 0x97, 0xed, 0xbe, 0x8a, 0x4b, 0x70, 0x7e, 0xfa, 0xc2, 0x3e, 0xfd, 0xf4, 0xca,
+	0x77, 0x87, 0xba, 0x3f, 0x86, 0xf2, 0xbd, 0x0c, 0x15, 0xc3, 0xf2, 0x3a, 0xba, 0xe1, 0xfa, 0x2c,
+	0x38, 0x21, 0x96, 0x21, 0xcb, 0x23, 0x3d, 0x20, 0x63, 0x6a, 0x79, 0xb8, 0x6d, 0xf9, 0xe3, 0x00,
+	0x5b, 0xa6, 0xad, 0xb1, 0xd2, 0x94, 0x07, 0xcf, 0x8b, 0xf5, 0x36, 0x46, 0xf3, 0xcb, 0x57, 0x49,
+	0x21, 0x5c, 0x43, 0x38, 0xa4, 0x7f, 0x29, 0xc1, 0xec, 0x3a, 0x72, 0x68, 0x80, 0xb1, 0xc7, 0x14,
+	0x02, 0xb9, 0xe8, 0x94, 0xae, 0x63, 0xdf, 0x97, 0xb3, 0xbd, 0x88, 0xab, 0x8d, 0x6a, 0x5a, 0xdf,
+	0xd5, 0xe6, 0xc7, 0x14, 0xd0, 0x5d, 0xe7, 0x44, 0xc2, 0x30, 0x31, 0x7a, 0xf9, 0x3d, 0xaf, 0xf2,
+	0x55, 0x1a, 0x13, 0x29, 0xec, 0xd4, 0xc1, 0x05, 0x76, 0x0e, 0x98, 0x09, 0x13, 0x33, 0x68, 0x3e,
+	0x04, 0x31, 0xdd, 0x21, 0x5e, 0x01, 0x95, 0x5f, 0x90, 0x60, 0x21, 0x99, 0xaa, 0x71, 0x6c, 0xcf,
+	0x8f, 0x43, 0xd1, 0xb0, 0xb6, 0x6c, 0x5f, 0x89, 0x2e, 0x09, 0xe7, 0x82, 0xb8, 0x5d, 0x5a, 0x50,
+	0xf9, 0xab, 0x1c, 0x34, 0xdf, 0xa6, 0x31, 0x76, 0x1f, 0xf8, 0xf0, 0xf7, 0x50, 0xaf, 0xe3, 0x1a,
+	0x9f, 0x47, 0xfe, 0xf0, 0xf7, 0x50, 0x6f, 0xc3, 0xf8, 0x3c, 0x8a, 0x48, 0x46, 0x31, 0x2a, 0x19,
+	0xe9, 0x67, 0x96, 0xfc, 0x11, 0x5d, 0x39, 0x7a, 0x44, 0x17, 0x2e, 0xa9, 0x95, 0xc8, 0x92, 0x1a,
+	0x88, 0x5a, 0x75, 0x7f, 0xa2, 0x86, 0x9b, 0x22, 0x55, 0xe8, 0xf4, 0x7e, 0x40, 0x5e, 0xf5, 0x93,
+	0x38, 0xd2, 0xa6, 0x7d, 0x13, 0x79, 0x71, 0xae, 0x1e, 0x9e, 0xfc, 0x7d, 0x59, 0x82, 0xe3, 0x42,
+	0x82, 0xc6, 0x11, 0xbd, 0x8f, 0x46, 0x45, 0xef, 0x74, 0xb2, 0x51, 0x27, 0x90, 0xba, 0x97, 0xa1,
+	0xbe, 0x3a, 0xe8, 0xf5, 0x82, 0x5d, 0xc6, 0x49, 0xa8, 0x3b, 0xf4, 0x2f, 0x75, 0x74, 0xd0, 0x95,
+	0xb9, 0xc6, 0x60, 0xd8, 0x9d, 0xa1, 0x9c, 0x83, 0x06, 0x2b, 0xc2, 0xa8, 0x6e, 0x43, 0xc5, 0x61,
+	0xff, 0x19, 0x7e, 0x90, 0x56, 0x66, 0x61, 0x5a, 0x45, 0xdb, 0x58, 0xe8, 0x9d, 0xb7, 0x0c, 0xeb,
+	0x01, 0x6b, 0x46, 0xf9, 0x82, 0x04, 0x33, 0x51, 0x38, 0xab, 0xeb, 0x12, 0x94, 0x35, 0x5d, 0x77,
+	0x90, 0xeb, 0xa6, 0x0e, 0xcb, 0x15, 0x8a, 0xa3, 0xfa, 0xc8, 0x1c, 0xe7, 0x72, 0x99, 0x39, 0xa7,
+	0x74, 0x60, 0xea, 0x26, 0xf2, 0x6e, 0x23, 0xcf, 0x19, 0x2b, 0x72, 0xac, 0x85, 0x5d, 0x02, 0xa4,
+	0x30, 0x13, 0x0b, 0x3f, 0x89, 0xc3, 0x62, 0x64, 0xbe, 0x85, 0x71, 0x86, 0x99, 0xe7, 0x72, 0x2e,
+	0xca, 0x65, 0x1a, 0x7c, 0xdc, 0xeb, 0xdb, 0x16, 0xb2, 0x3c, 0x7e, 0x07, 0xd0, 0x08, 0xa0, 0x44,
+	0xfc, 0xbe, 0x2f, 0x81, 0x8c, 0xc3, 0x19, 0xaf, 0x6a, 0xe6, 0x78, 0x86, 0x03, 0x3e, 0x04, 0x71,
+	0xba, 0x9d, 0x88, 0x69, 0x5c, 0x75, 0x9d, 0xee, 0x1d, 0x02, 0xc0, 0x5b, 0x28, 0xdd, 0xf5, 0x58,
+	0xb6, 0x6f, 0x1c, 0x83, 0xee, 0x7a, 0x34, 0x9f, 0x5c, 0x3a, 0xa2, 0xbb, 0x81, 0xce, 0xd0, 0x45,
+	0xda, 0x26, 0xcd, 0xd8, 0x08, 0xe0, 0x82, 0xc9, 0x55, 0x4c, 0xf6, 0xfe, 0x4e, 0xb5, 0x8a, 0xca,
+	0x3f, 0x4b, 0x30, 0x7f, 0x5b, 0xb3, 0xf0, 0xfd, 0x28, 0xbb, 0xd7, 0xd7, 0x22, 0x17, 0x48, 0xe2,
+	0x2a, 0x53, 0x12, 0xa8, 0xcc, 0xe7, 0x68, 0x5c, 0x3b, 0xdd, 0xf2, 0x91, 0xde, 0x15, 0x54, 0x0e,
+	0x92, 0xc9, 0xf8, 0x1f, 0x71, 0x47, 0x98, 0xec, 0x32, 0x35, 0x67, 0x1b, 0x79, 0x54, 0xef, 0x52,
+	0xe5, 0x0a, 0x14, 0x44, 0x54, 0x2f, 0xbf, 0x45, 0x2d, 0x45, 0xb7, 0xa8, 0xfe, 0x6d, 0x6d, 0x49,
+	0x71, 0xa1, 0x35, 0xdc, 0xd1, 0x71, 0x84, 0x8c, 0xb0, 0xc7, 0xaf, 0x8a, 0x5f, 0x51, 0x42, 0x98,
+	0xf2, 0x26, 0xf1, 0xad, 0x87, 0x2d, 0x46, 0x0e, 0x03, 0xe2, 0x15, 0x48, 0x82, 0x0a, 0xde, 0xcd,
+	0x41, 0x5b, 0x54, 0xc3, 0x38, 0x84, 0x5f, 0x8e, 0xba, 0xe7, 0x5f, 0x48, 0xb8, 0xd5, 0x15, 0x6d,
+	0x91, 0x16, 0x91, 0x17, 0x61, 0x12, 0x3d, 0x46, 0xdd, 0x81, 0x67, 0x58, 0xdb, 0xeb, 0xa6, 0x66,
+	0xdd, 0xb1, 0xd9, 0x32, 0x19, 0x07, 0xcb, 0x2f, 0x40, 0x03, 0xcb, 0x81, 0x3d, 0xf0, 0x18, 0x1e,
+	0x5d, 0x2f, 0xa3, 0x40, 0x5c, 0x1f, 0xee, 0x2f, 0xf1, 0xee, 0x33, 0x3c, 0x3a, 0xbe, 0x71, 0x30,
+	0xc1, 0xc4, 0x33, 0xd1, 0x34, 0x03, 0xcc, 0x12, 0xc3, 0x8c, 0x82, 0x95, 0x8f, 0xc1, 0xfc, 0x35,
+	0x02, 0x4a, 0x10, 0xe9, 0x11, 0x2c, 0x8f, 0x8f, 0x19, 0xae, 0xd5, 0xdd, 0x4f, 0x05, 0x7f, 0x2b,
+	0x41, 0x5b, 0x54, 0xc3, 0x61, 0x8d, 0xd9, 0x2d, 0x80, 0x1e, 0x72, 0xb6, 0xd1, 0x1a, 0x59, 0xf9,
+	0xa8, 0x47, 0x6f, 0x51, 0xb8, 0xf2, 0x85, 0x15, 0xdc, 0xf6, 0x0b, 0xa8, 0x5c, 0x59, 0xe5, 0x26,
+	0x4c, 0x0b, 0x50, 0xb0, 0x52, 0x77, 0xed, 0x81, 0xd3, 0x45, 0xbe, 0xdf, 0xd9, 0x4f, 0x62, 0x23,
+	0x80, 0xce, 0x53, 0xdf, 0x27, 0x40, 0x53, 0xca, 0x75, 0x76, 0x0b, 0x28, 0xe0, 0x90, 0x6d, 0x1a,
+	0xdd, 0x3d, 0x4c, 0xb5, 0xbb, 0x0f, 0xed, 0xa3, 0xfc, 0x35, 0x8e, 0x3f, 0xa5, 0x8a, 0x22, 0xda,
+	0x77, 0x77, 0x84, 0x35, 0x18, 0xb3, 0xf4, 0x72, 0xc3, 0x96, 0x1e, 0xe7, 0xe7, 0xc8, 0x47, 0xfd,
+	0x1c, 0xcf, 0x41, 0x0d, 0x1b, 0x7a, 0xf6, 0x16, 0xbf, 0x0b, 0xa8, 0x5a, 0x83, 0xde, 0xdd, 0x2d,
+	0x62, 0xed, 0xc5, 0x8f, 0xb0, 0x8a, 0xc3, 0x47, 0x58, 0x14, 0xc5, 0xd3, 0x4c, 0x7b, 0x9b, 0xdc,
+	0xf4, 0x2c, 0x05, 0x28, 0x04, 0x86, 0xef, 0x7a, 0x9e, 0x82, 0x46, 0x80, 0x42, 0xd4, 0x1e, 0x35,
+	0x0d, 0x83, 0x72, 0x44, 0xf1, 0x9d, 0x00, 0xd8, 0x34, 0x2c, 0xbf, 0x16, 0x76, 0x84, 0x4d, 0x21,
+	0xb8, 0x8e, 0x36, 0xf6, 0xaa, 0x60, 0x6e, 0x21, 0x9d, 0x79, 0xf5, 0x82, 0x34, 0x5d, 0x88, 0x35,
+	0xd7, 0xbf, 0x29, 0x5a, 0x55, 0xfd, 0xa4, 0xf2, 0x0d, 0x6a, 0xf8, 0x27, 0x0c, 0xce, 0x38, 0x42,
+	0x7c, 0x93, 0xf3, 0xf2, 0x50, 0x03, 0xec, 0x5c, 0x9a, 0x97, 0x27, 0x36, 0xa4, 0xa1, 0xb7, 0x47,
+	0xb9, 0x44, 0xa2, 0x88, 0x88, 0xff, 0x39, 0xa2, 0x51, 0xa3, 0x2b, 0x89, 0x34, 0x14, 0x2c, 0xb9,
+	0x05, 0xb3, 0xb1, 0x72, 0x63, 0x06, 0xba, 0x6e, 0xe1, 0xaa, 0x02, 0xb7, 0x9d, 0x9f, 0x54, 0xfe,
+	0x47, 0x82, 0xc6, 0x5a, 0xaf, 0x6f, 0x87, 0xb1, 0x29, 0x99, 0x1d, 0x39, 0xc3, 0x87, 0x93, 0x39,
+	0xd1, 0xe1, 0xe4, 0x29, 0x68, 0x44, 0xef, 0x2d, 0xd3, 0x73, 0x83, 0x7a, 0x97, 0xbf, 0xaf, 0x8c,
+	0x1d, 0x5e, 0xf6, 0x6e, 0x07, 0x9b, 0x21, 0x3a, 0x0b, 0xa9, 0xc5, 0x47, 0x41, 0xd8, 0x38, 0xd1,
+	0xf1, 0xc1, 0x32, 0x76, 0x50, 0xf9, 0x3e, 0x69, 0x9a, 0xc0, 0x7e, 0x4f, 0x9b, 0x05, 0xd8, 0x95,
+	0xb2, 0x7a, 0x1b, 0xfc, 0x12, 0x74, 0xad, 0x95, 0x5b, 0x12, 0xbe, 0x8f, 0xef, 0x77, 0x7f, 0xcc,
+	0x03, 0x76, 0x4f, 0x73, 0x1f, 0xf8, 0x61, 0xaf, 0x34, 0xa1, 0x9c, 0xa3, 0xe1, 0x56, 0xa4, 0xfe,
+	0xc8, 0xe8, 0xcb, 0x50, 0xc0, 0x18, 0x6c, 0xb6, 0x93, 0xff, 0xca, 0x5f, 0xe4, 0x60, 0x2e, 0x8e,
+	0x3d, 0x0e, 0x49, 0x97, 0xa2, 0x7a, 0x58, 0x7c, 0xbd, 0x9a, 0x6f, 0x8d, 0xa2, 0xfb, 0x43, 0xd1,
+	0xb5, 0x07, 0x96, 0xc7, 0x56, 0x4c, 0x3c, 0x14, 0xd7, 0x70, 0x1a, 0x1f, 0x3e, 0x18, 0x7a, 0xc7,
+	0xc4, 0xae, 0x11, 0x6a, 0xfd, 0x94, 0x0c, 0x1d, 0x3f, 0x8a, 0x82, 0xf7, 0x79, 0x74, 0xbb, 0x92,
+	0x39, 0x56, 0x96, 0xe2, 0xe3, 0xd3, 0x47, 0x43, 0x67, 0x6a, 0x25, 0x67, 0xe8, 0x58, 0xaa, 0x88,
+	0x4f, 0x8d, 0x5c, 0x4c, 0x63, 0x37, 0xd5, 0xb0, 0x38, 0x34, 0x30, 0xf4, 0x6d, 0x1f, 0x48, 0xf4,
+	0x12, 0x46, 0x63, 0x11, 0x7d, 0x44, 0xa3, 0x54, 0xd4, 0x1a, 0x86, 0xad, 0x51, 0x90, 0xd2, 0x82,
+	0x39, 0x4c, 0x1a, 0xed, 0xe2, 0x3d, 0x3c, 0x20, 0xfe, 0x3e, 0xe5, 0x2b, 0x12, 0xcc, 0x0f, 0x65,
+	0x8d, 0xc3, 0xeb, 0x2b, 0xfc, 0xf0, 0x27, 0xe9, 0x0a, 0xf1, 0xe0, 0xfa, 0xb2, 0xf2, 0x35, 0xba,
+	0xa9, 0x50, 0xe9, 0x5d, 0x9e, 0x27, 0x1c, 0x19, 0xbe, 0x08, 0xcd, 0x5d, 0xc3, 0xdb, 0xe9, 0x10,
+	0x8f, 0x2f, 0xb1, 0xe8, 0xa9, 0x8f, 0xb7, 0xa2, 0x4e, 0x60, 0x38, 0x71, 0x0b, 0x63, 0xab, 0xde,
+	0x55, 0xbe, 0x24, 0xc1, 0x74, 0x84, 0xac, 0x71, 0xd8, 0xf4, 0x3a, 0xde, 0xec, 0xd0, 0x8a, 0x18,
+	0xa7, 0x16, 0x84, 0x9c, 0x62, 0xad, 0x91, 0x45, 0x3d, 0x28, 0x81, 0xc3, 0x60, 0x6b, 0x5c, 0x0e,
+	0x5e, 0x37, 0x59, 0x5e, 0xb8, 0x6e, 0x06, 0x80, 0x4c, 0x6c, 0x38, 0x05, 0xa1, 0xae, 0xe2, 0x2e,
+	0x77, 0x72, 0x36, 0xbf, 0xee, 0xca, 0xb7, 0x60, 0x82, 0xb2, 0x29, 0x20, 0xbd, 0x30, 0xca, 0xa3,
+	0xce, 0xa8, 0x54, 0x1b, 0x2e, 0x97, 0xa2, 0xc1, 0x6f, 0xb6, 0x8e, 0x48, 0x4b, 0xc5, 0x21, 0x9f,
+	0x46, 0x9d, 0x2f, 0x8a, 0x17, 0x44, 0x13, 0x69, 0x3a, 0x72, 0x82, 0xbe, 0x05, 0x69, 0xbc, 0xcb,
+	0xa0, 0xff, 0x3b, 0x78, 0x9f, 0xcc, 0xb4, 0x2e, 0x50, 0x10, 0xde, 0x42, 0x63, 0x0f, 0xbe, 0xde,
+	0x8b, 0xbc, 0x16, 0xe1, 0xef, 0x1c, 0xf5, 0x1e, 0xf7, 0x4c, 0x44, 0x84, 0xa0, 0x42, 0x94, 0xa0,
+	0x2f, 0x86, 0x4f, 0xf8, 0x38, 0x48, 0x47, 0x96, 0x67, 0x68, 0xe6, 0xc1, 0x65, 0xb2, 0x0d, 0x95,
+	0x81, 0x8b, 0x1c, 0x6e, 0x91, 0x08, 0xd2, 0x38, 0xaf, 0xaf, 0xb9, 0xee, 0xae, 0xed, 0xe8, 0x8c,
+	0xca, 0x20, 0x9d, 0x72, 0xd3, 0x85, 0xbe, 0xd9, 0x22, 0xbe, 0xe9, 0x72, 0x09, 0xe6, 0x7b, 0xb6,
+	0x6e, 0x6c, 0x19, 0xa2, 0x0b, 0x32, 0xb8, 0xd8, 0xac, 0x9f, 0x1d, 0x29, 0xe7, 0x5f, 0x3e, 0x9e,
+	0xe6, 0x2f, 0x1f, 0x7f, 0x33, 0x07, 0xf3, 0xf7, 0xfb, 0xfa, 0x07, 0xc0, 0x87, 0x05, 0xa8, 0xd9,
+	0xa6, 0xbe, 0x1e, 0x65, 0x05, 0x0f, 0xc2, 0x18, 0x16, 0xda, 0x0d, 0x30, 0xe8, 0xf1, 0x2d, 0x0f,
+	0x4a, 0xbd, 0x19, 0x74, 0x20, 0x7e, 0x95, 0xd2, 0xf8, 0x55, 0x7d, 0xef, 0x8d, 0x52, 0x25, 0xd7,
+	0x9c, 0x69, 0xe5, 0x94, 0x1f, 0xc2, 0x37, 0x73, 0x4c, 0xf4, 0xc4, 0xb9, 0xe4, 0x8f, 0xd1, 0x2c,
+	0x3f, 0x46, 0xef, 0xd0, 0x87, 0xb9, 0x70, 0xd3, 0xf7, 0x5d, 0xe4, 0x8c, 0xa9, 0xa4, 0x9e, 0x85,
+	0xaa, 0xdf, 0x9a, 0x7f, 0xa7, 0x2b, 0x04, 0x28, 0xff, 0x1f, 0x66, 0x62, 0x6d, 0x1d, 0xb0, 0x97,
+	0x7e, 0x4f, 0xe6, 0xf8, 0x9e, 0x2c, 0x00, 0xa8, 0xb6, 0x89, 0xae, 0x5b, 0x1e, 0x3e, 0xb3, 0x96,
+	0xa1, 0xc0, 0x99, 0x5f, 0xe4, 0x3f, 0xc6, 0xc0, 0xed, 0xa6, 0x60, 0xfc, 0x8c, 0x04, 0x53, 0x74,
+	0xe6, 0xe2, 0xaa, 0x0e, 0x3e, 0x0a, 0xaf, 0x41, 0x09, 0x91, 0x56, 0x5a, 0x39, 0xd1, 0x21, 0x08,
+	0x4b, 0x84, 0xe4, 0xaa, 0x0c, 0x5d, 0x38, 0x8d, 0x3c, 0x98, 0xc4, 0x11, 0xdd, 0xe3, 0x51, 0x44,
+	0x2c, 0x13, 0x13, 0xf1, 0xb6, 0x66, 0x05, 0x03, 0xee, 0x24, 0x09, 0xc6, 0xdf, 0x48, 0x30, 0x77,
+	0xb7, 0x8f, 0x1c, 0xcd, 0x43, 0x98, 0x69, 0xe3, 0xb5, 0x9e, 0x36, 0x77, 0x23, 0x94, 0xe5, 0xa3,
+	0x94, 0xc9, 0xaf, 0x47, 0x5e, 0x4c, 0x10, 0x6f, 0x67, 0x63, 0x54, 0x86, 0x17, 0x17, 0xfd, 0x7e,
+	0xcd, 0xf3, 0xfd, 0xfa, 0xb6, 0x04, 0x53, 0x1b, 0x64, 0x7b, 0x34, 0x5e, 0x97, 0x2e, 0x42, 0x01,
+	0x53, 0x99, 0x75, 0x80, 0x09, 0xb2, 0xbc, 0x04, 0x53, 0x86, 0xd5, 0x35, 0x07, 0x3a, 0x3e, 0x19,
+	0x46, 0x38, 0x28, 0x7a, 0xcb, 0x66, 0xc6, 0xc3, 0x24, 0xcb, 0xc0, 0xdd, 0xc0, 0x4b, 0xb4, 0x50,
+	0xc6, 0x1f, 0x53, 0x19, 0x0f, 0x22, 0xbb, 0x29, 0x09, 0xd2, 0x7e, 0x48, 0x78, 0x15, 0x8a, 0xb8,
+	0x69, 0xdf, 0x88, 0x10, 0x97, 0x0a, 0xa7, 0x89, 0x4a, 0xb1, 0x95, 0x1f, 0x91, 0x40, 0xe6, 0xd9,
+	0x36, 0x5e, 0xe0, 0x28, 0x17, 0x30, 0x96, 0x4f, 0x25, 0x9d, 0xf6, 0x34, 0x08, 0x15, 0x53, 0xde,
+	0x0d, 0x46, 0x8f, 0x0c, 0xf7, 0x38, 0xa3, 0x87, 0xfb, 0x95, 0x3a, 0x7a, 0x1c, 0x13, 0x08, 0x32,
+	0x3f, 0x7a, 0x44, 0x62, 0x05, 0xa3, 0x87, 0x69, 0x26, 0xa3, 0xc7, 0xf4, 0x7b, 0xab, 0x95, 0xc3,
+	0x83, 0x46, 0x89, 0xf5, 0x07, 0x8d, 0xb4, 0x2c, 0xed, 0xa7, 0xe5, 0x57, 0xa1, 0x88, 0x5b, 0x1c,
+	0xcd, 0x2f, 0x7f, 0xd0, 0x08, 0x36, 0x37, 0x68, 0x8c, 0x80, 0x27, 0x3f, 0x68, 0x61, 0x4f, 0xc3,
+	0x41, 0x53, 0xa0, 0x7e, 0x77, 0xf3, 0x1d, 0xd4, 0xf5, 0x52, 0x34, 0xef, 0x69, 0x98, 0x5c, 0x77,
+	0x8c, 0x47, 0x86, 0x89, 0xb6, 0xd3, 0x54, 0xf8, 0x97, 0x24, 0x68, 0xdc, 0x74, 0x34, 0xcb, 0xb3,
+	0x7d, 0x35, 0x7e, 0x20, 0x7e, 0x5e, 0x85, 0x6a, 0xdf, 0x6f, 0x8d, 0xc9, 0xc0, 0x0b, 0xe2, 0xf3,
+	0xc9, 0x28, 0x4d, 0x6a, 0x58, 0x4c, 0xf9, 0x24, 0xcc, 0x10, 0x4a, 0xe2, 0x64, 0xbf, 0x01, 0x15,
+	0xa2, 0xcc, 0x0d, 0xe6, 0x27, 0xab, 0xad, 0x28, 0xe2, 0x2d, 0x0d, 0xdf, 0x0d, 0x35, 0x28, 0xa3,
+	0xfc, 0x83, 0x04, 0x35, 0x92, 0x17, 0x76, 0x70, 0xff, 0xb3, 0xfc, 0x23, 0x50, 0xb2, 0x09, 0xcb,
+	0x53, 0xc3, 0x18, 0xf8, 0x51, 0x51, 0x59, 0x01, 0x6c, 0x21, 0xd3, 0x7f, 0xbc, 0x46, 0x06, 0x0a,
+	0x62, 0x3a, 0xb9, 0xbc, 0x4d, 0x69, 0x67, 0x41, 0x53, 0x59, 0xfa, 0xe7, 0x17, 0x51, 0xbe, 0x16,
+	0xc8, 0x24, 0x41, 0x38, 0xf8, 0x14, 0xfe, 0x70, 0x6c, 0x8d, 0x5d, 0x48, 0xa6, 0x42, 0xbc, 0xc8,
+	0x46, 0x34, 0x2b, 0xde, 0xab, 0x45, 0xc8, 0x1a, 0x73, 0xaf, 0x16, 0x88, 0x40, 0xda, 0x5e, 0x8d,
+	0x27, 0x2e, 0x14, 0x80, 0xbf, 0x93, 0x60, 0x9e, 0xad, 0x69, 0x81, 0x6c, 0x1d, 0x02, 0x9b, 0xe4,
+	0x8f, 0xb1, 0xb5, 0x37, 0x4f, 0xd6, 0xde, 0xb3, 0x69, 0x6b, 0x6f, 0x40, 0xe7, 0x88, 0xc5, 0xf7,
+	0x9b, 0x12, 0x4c, 0xaa, 0xf6, 0x2e, 0x75, 0x35, 0x8e, 0x23, 0xdf, 0x99, 0x1f, 0xb2, 0x7d, 0x1e,
+	0x6a, 0x7d, 0xd2, 0x5a, 0x44, 0x9a, 0x29, 0x28, 0x29, 0xa0, 0x53, 0xf9, 0xc5, 0xe0, 0x09, 0x9f,
+	0x80, 0xd8, 0x83, 0x0f, 0xc0, 0xeb, 0xb1, 0x01, 0x78, 0x21, 0xa1, 0x87, 0x11, 0xae, 0xc4, 0x65,
+	0x35, 0xc2, 0xc5, 0xef, 0xb1, 0xb7, 0x7a, 0xde, 0x07, 0xe2, 0x0e, 0x64, 0xc5, 0x64, 0x0e, 0xaf,
+	0x8c, 0x31, 0xbf, 0x10, 0x67, 0xbe, 0xb0, 0x77, 0x7f, 0x28, 0x51, 0xdf, 0x93, 0xdf, 0x3b, 0x03,
+	0xb9, 0x47, 0xb4, 0x7f, 0x42, 0x45, 0xf2, 0xd3, 0xcc, 0x3f, 0x16, 0x21, 0x7f, 0xbc, 0x38, 0x9a,
+	0xb8, 0x32, 0xc9, 0x26, 0x41, 0xa1, 0x42, 0x39, 0x0d, 0xd5, 0xdb, 0x04, 0xe7, 0xfa, 0x63, 0x0f,
+	0xbb, 0xb3, 0x1f, 0x21, 0xc7, 0x35, 0x6c, 0x3f, 0xe6, 0xd1, 0x4f, 0x2e, 0x9d, 0x84, 0x8a, 0xff,
+	0xf0, 0x87, 0x5c, 0x86, 0xfc, 0x15, 0xd3, 0x6c, 0x1e, 0x93, 0xeb, 0x50, 0x59, 0x63, 0xaf, 0x5b,
+	0x34, 0xa5, 0x25, 0x03, 0x26, 0xa2, 0x17, 0x7a, 0xe4, 0x19, 0x68, 0x06, 0x90, 0x75, 0x64, 0xe9,
+	0x86, 0xb5, 0xdd, 0x3c, 0x16, 0x81, 0xaa, 0x03, 0xcb, 0xc2, 0x50, 0x49, 0x9e, 0x03, 0x39, 0x80,
+	0x5e, 0xf3, 0xcf, 0xfd, 0x9a, 0x39, 0x79, 0x1a, 0x26, 0x03, 0xf8, 0x0d, 0xcd, 0x30, 0x91, 0xde,
+	0xcc, 0x2f, 0x7d, 0x1c, 0xa6, 0x05, 0x86, 0xbd, 0x3c, 0x05, 0x8d, 0x2b, 0x3a, 0xd9, 0x3e, 0xde,
+	0xb3, 0x31, 0xb0, 0x79, 0x0c, 0x57, 0xab, 0xa2, 0x9e, 0xfd, 0x88, 0x20, 0xde, 0x70, 0xec, 0x1e,
+	0x81, 0x4b, 0x4b, 0xe7, 0x61, 0x46, 0xa4, 0x9e, 0xe4, 0x2a, 0x14, 0x89, 0xba, 0x6b, 0x1e, 0x93,
+	0x01, 0x4a, 0x2a, 0x7a, 0x64, 0x3f, 0x40, 0x4d, 0x69, 0xe5, 0x4f, 0x5f, 0x83, 0x06, 0x65, 0x13,
+	0x7b, 0xd2, 0x4b, 0xee, 0x40, 0x33, 0xfe, 0x12, 0xb3, 0xfc, 0xa2, 0xf8, 0x44, 0x4d, 0xfc, 0x60,
+	0x73, 0x3b, 0x6d, 0x80, 0x95, 0x63, 0xf2, 0x67, 0x61, 0x22, 0xfa, 0xf0, 0xb0, 0x2c, 0x8e, 0x92,
+	0x12, 0xbe, 0x4e, 0x3c, 0xaa, 0xf2, 0x0e, 0x34, 0x22, 0x6f, 0x06, 0xcb, 0x62, 0x0d, 0x2e, 0x7a,
+	0x57, 0xb8, 0x2d, 0x36, 0x17, 0xf8, 0x77, 0x7d, 0x29, 0xf5, 0xd1, 0x37, 0x35, 0x13, 0xa8, 0x17,
+	0x3e, 0xbc, 0x39, 0x8a, 0x7a, 0x0d, 0xa6, 0x86, 0x9e, 0xbc, 0x94, 0xcf, 0x27, 0x78, 0x3c, 0xc5,
+	0x4f, 0x63, 0x8e, 0x6a, 0x62, 0x17, 0x64, 0xfa, 0x54, 0x00, 0xff, 0x0e, 0xae, 0xbc, 0x2c, 0x1e,
+	0x81, 0xa4, 0x97, 0x81, 0xdb, 0x17, 0x32, 0xe3, 0x07, 0x8c, 0xfb, 0x51, 0x09, 0xe6, 0x13, 0x5e,
+	0x47, 0x94, 0x2f, 0x26, 0xb9, 0xbf, 0x53, 0xde, 0x7a, 0x6c, 0xbf, 0xb2, 0xbf, 0x42, 0x01, 0x21,
+	0x16, 0x4c, 0xc6, 0x1e, 0x07, 0x94, 0xcf, 0x25, 0x3e, 0x08, 0x34, 0xfc, 0x72, 0x62, 0xfb, 0xc5,
+	0x6c, 0xc8, 0x41, 0x7b, 0xf8, 0x82, 0x48, 0xf4, 0x65, 0xbc, 0x84, 0xf6, 0xc4, 0xef, 0xe7, 0x8d,
+	0x1a, 0xd0, 0x4f, 0x43, 0x23, 0xf2, 0x84, 0x5d, 0x82, 0xc4, 0x8b, 0x9e, 0xb9, 0xcb, 0x20, 0x8e,
+	0x43, 0x2f, 0xcd, 0x25, 0x88, 0x63, 0xd2, 0x8b, 0x74, 0xa3, 0x9a, 0xf8, 0x1c, 0xd4, 0xf9, 0xb7,
+	0xe0, 0xe4, 0xc5, 0xa4, 0xe9, 0x3a, 0x54, 0xf1, 0x7e, 0x66, 0x6b, 0x50, 0xd8, 0x4d, 0x99, 0xad,
+	0x43, 0xcf, 0x5e, 0x65, 0x9f, 0xad, 0x5c, 0xfd, 0xa9, 0xb3, 0x75, 0xdf, 0x4d, 0x7c, 0x41, 0x22,
+	0x47, 0x7c, 0x82, 0x37, 0xc2, 0xe4, 0x95, 0x24, 0xf1, 0x4f, 0x7e, 0x0d, 0xad, 0x7d, 0x71, 0x5f,
+	0x65, 0x02, 0x2e, 0x3e, 0x80, 0x89, 0xe8, 0x4b, 0x58, 0x09, 0x5c, 0x14, 0x3e, 0x1e, 0xd6, 0x3e,
+	0x97, 0x09, 0x37, 0x68, 0xec, 0x3e, 0xd4, 0xb8, 0xcf, 0xf6, 0xc8, 0x67, 0x52, 0xa6, 0x0a, 0xff,
+	0x0d, 0x9b, 0x51, 0x9c, 0x7c, 0x1b, 0xaa, 0xc1, 0xd7, 0x76, 0xe4, 0xd3, 0x89, 0x53, 0x64, 0x3f,
+	0x55, 0x6e, 0x00, 0x84, 0x9f, 0xd2, 0x91, 0x3f, 0x24, 0xac, 0x73, 0xe8, 0x5b, 0x3b, 0xa3, 0x2a,
+	0xbd, 0x0f, 0x35, 0xee, 0xfb, 0x37, 0x09, 0xdd, 0x1f, 0xfe, 0x42, 0xce, 0xa8, 0x6a, 0xbb, 0x20,
+	0x0f, 0x7f, 0xfa, 0x21, 0x41, 0xed, 0x27, 0x7e, 0x23, 0x62, 0x54, 0x23, 0x77, 0xa1, 0xe2, 0x7f,
+	0x11, 0x47, 0x16, 0x9b, 0x6b, 0xb1, 0x0f, 0xe6, 0x64, 0x1c, 0x34, 0x5a, 0x63, 0xf2, 0xa0, 0xed,
+	0xa7, 0xca, 0xcf, 0xc2, 0x44, 0xf4, 0x6b, 0x32, 0x09, 0xb2, 0x2c, 0xfc, 0xe4, 0xcc, 0xa8, 0xca,
+	0x3f, 0x05, 0x75, 0xfe, 0x73, 0x2f, 0x09, 0xda, 0x4c, 0xf0, 0x45, 0x98, 0x51, 0x15, 0xef, 0x40,
+	0x23, 0xf2, 0x25, 0x91, 0x04, 0x25, 0x2f, 0xfa, 0x62, 0x4b, 0x7b, 0x29, 0x0b, 0xea, 0xf0, 0xf4,
+	0xa3, 0x4f, 0x55, 0xa4, 0x4d, 0x3f, 0xfe, 0xb5, 0x95, 0x0c, 0x1d, 0x88, 0xbc, 0x9a, 0x94, 0xb4,
+	0x4a, 0x09, 0x5e, 0xb5, 0x6a, 0x2f, 0x65, 0x41, 0x0d, 0x3a, 0xb0, 0x03, 0x8d, 0xc8, 0x8b, 0x35,
+	0x09, 0x2d, 0x89, 0x5e, 0xea, 0x69, 0x2f, 0x65, 0x41, 0x0d, 0x5a, 0xfa, 0x61, 0xee, 0x71, 0x9c,
+	0xc8, 0x4b, 0x44, 0xf2, 0xcb, 0xa9, 0xf5, 0x88, 0x5e, 0x64, 0x6a, 0xaf, 0xec, 0xa7, 0x48, 0x40,
+	0x02, 0x9b, 0x20, 0x94, 0xa5, 0xc9, 0x13, 0x64, 0x3f, 0x23, 0xb5, 0x01, 0x25, 0xfa, 0xf4, 0x8c,
+	0xac, 0x24, 0xbc, 0x3f, 0xc5, 0xbd, 0x4b, 0xd3, 0x16, 0x5f, 0x65, 0x8b, 0x3e, 0xc6, 0x42, 0x2b,
+	0xa5, 0x9b, 0x9d, 0x84, 0x4a, 0x23, 0xcf, 0x8d, 0x64, 0xad, 0x14, 0x41, 0x9d, 0x7f, 0xf0, 0x22,
+	0x69, 0xb6, 0x0d, 0x3f, 0xf1, 0xd1, 0x3e, 0x9b, 0x01, 0x33, 0xe0, 0xb1, 0x47, 0xa2, 0xc3, 0x63,
+	0x3b, 0xc0, 0xf3, 0x49, 0xc3, 0x25, 0x7c, 0x1b, 0xa3, 0xbd, 0x9c, 0x15, 0x3d, 0x68, 0x55, 0x85,
+	0x12, 0xbd, 0x3b, 0x9e, 0xc0, 0xb1, 0xc8, 0x7b, 0x0b, 0xed, 0x74, 0x1c, 0xea, 0x90, 0x3e, 0x26,
+	0xaf, 0x43, 0x91, 0xc4, 0x87, 0xc9, 0x27, 0xd3, 0xee, 0x3c, 0xa7, 0xd5, 0x18, 0xb9, 0x16, 0x4d,
+	0x34, 0x7e, 0x91, 0x44, 0xd8, 0xc8, 0x29, 0x57, 0x48, 0xd3, 0x0d, 0x36, 0xfe, 0xe2, 0xac, 0x72,
+	0x4c, 0xfe, 0x0c, 0xd4, 0x29, 0xd5, 0x1b, 0x9e, 0x83, 0xb4, 0xde, 0xfb, 0xd7, 0xf9, 0x97, 0x24,
+	0xf9, 0x53, 0x50, 0x23, 0xad, 0xb1, 0xaa, 0xdf, 0x27, 0x92, 0x5f, 0x92, 0x64, 0x1d, 0xea, 0xfc,
+	0x4d, 0xb4, 0x04, 0x41, 0x14, 0xdc, 0xd5, 0x6b, 0x67, 0xc1, 0xf4, 0x59, 0x43, 0x15, 0x5b, 0x18,
+	0xe0, 0x97, 0xac, 0xd8, 0x86, 0x82, 0x07, 0xdb, 0x4b, 0x59, 0x50, 0x83, 0x51, 0xfd, 0x31, 0xfa,
+	0xcc, 0x8b, 0xf8, 0x26, 0x5a, 0xe2, 0xb6, 0x2b, 0xed, 0x8e, 0x57, 0xfb, 0xd5, 0x7d, 0x96, 0x0a,
+	0x68, 0xf9, 0x3c, 0x89, 0x26, 0x1a, 0xba, 0x10, 0x75, 0x21, 0xa9, 0xbe, 0x84, 0x4b, 0x3e, 0xed,
+	0x97, 0xb2, 0x17, 0x08, 0xda, 0xde, 0x84, 0x1a, 0x17, 0xc9, 0x94, 0xb0, 0x16, 0x0e, 0x87, 0x60,
+	0xb5, 0x17, 0x47, 0x23, 0x06, 0x6d, 0xac, 0x43, 0x91, 0xdc, 0xa2, 0x49, 0x10, 0x47, 0xfe, 0x52,
+	0x4e, 0x5b, 0x49, 0x43, 0x09, 0x6a, 0x44, 0x50, 0xe7, 0xaf, 0xd4, 0x24, 0x48, 0xa3, 0xe0, 0x36,
+	0x4e, 0xfb, 0x6c, 0x06, 0xcc, 0xa0, 0x99, 0x0e, 0x40, 0x78, 0xa5, 0x25, 0xc1, 0xfa, 0x1d, 0xba,
+	0x55, 0xd3, 0x3e, 0x33, 0x12, 0x8f, 0xb7, 0x44, 0xb8, 0x4b, 0x2a, 0x09, 0xdc, 0x1f, 0xbe, 0xc6,
+	0x92, 0xc1, 0x01, 0x32, 0x7c, 0xe9, 0x40, 0x5e, 0x4e, 0x76, 0x26, 0x88, 0xee, 0x37, 0xb4, 0x2f,
+	0x64, 0xc6, 0x0f, 0xfa, 0xf3, 0x10, 0x9a, 0xf1, 0x4b, 0x1a, 0x09, 0x8e, 0xb5, 0x84, 0x4b, 0x2b,
+	0xed, 0xf3, 0x19, 0xb1, 0x79, 0x0b, 0xe5, 0xf8, 0x30, 0x4d, 0x9f, 0x32, 0xbc, 0x1d, 0x12, 0xb6,
+	0x9f, 0xa5, 0xd7, 0xfc, 0x0d, 0x81, 0xf6, 0x85, 0xcc, 0xf8, 0x71, 0x5d, 0x22, 0x8c, 0xb8, 0x96,
+	0x5f, 0xc9, 0x50, 0xdf, 0x50, 0xf4, 0x7c, 0xfb, 0xd5, 0x7d, 0x96, 0xe2, 0x44, 0xb6, 0x19, 0xbf,
+	0x3c, 0x91, 0xe4, 0xda, 0x14, 0xdf, 0xb1, 0xc8, 0x62, 0x3b, 0x91, 0x80, 0xcd, 0x24, 0xdb, 0x89,
+	0x8f, 0x9b, 0x6e, 0x9f, 0x4a, 0xc5, 0xe1, 0x77, 0xdf, 0xd1, 0x40, 0x50, 0x79, 0x29, 0x53, 0xb4,
+	0x68, 0xda, 0xee, 0x5b, 0x1c, 0x59, 0x4a, 0x9d, 0x63, 0xb1, 0x38, 0xd7, 0x04, 0x67, 0x95, 0x38,
+	0x50, 0xb6, 0xfd, 0x62, 0x36, 0xe4, 0xc8, 0x90, 0xc4, 0x82, 0x06, 0xd3, 0xbd, 0xcd, 0xf1, 0x68,
+	0xb1, 0xd1, 0x0e, 0xe1, 0x66, 0x3c, 0x1a, 0x2f, 0xa1, 0x81, 0x84, 0xa0, 0xbd, 0x0c, 0x0d, 0xc4,
+	0x03, 0xd9, 0x12, 0x1a, 0x48, 0x88, 0x77, 0xcb, 0xb8, 0xf7, 0x0b, 0x02, 0xc8, 0x52, 0xf6, 0x7e,
+	0xf1, 0x20, 0xb3, 0xf6, 0x52, 0x16, 0xd4, 0x60, 0x30, 0x36, 0x00, 0xc2, 0x38, 0xb0, 0x04, 0x95,
+	0x3e, 0x14, 0x28, 0x96, 0xc1, 0x29, 0xe0, 0x07, 0x72, 0x25, 0x38, 0x05, 0x62, 0x71, 0x5e, 0xa3,
+	0x5d, 0x86, 0x93, 0xb1, 0x33, 0x92, 0x04, 0x11, 0x15, 0x07, 0x72, 0x8d, 0x1e, 0x4f, 0x08, 0x43,
+	0x7e, 0x12, 0x98, 0x30, 0x14, 0x4a, 0xd5, 0x3e, 0x33, 0x12, 0x8f, 0x5f, 0x38, 0xc3, 0xf0, 0x94,
+	0xd4, 0x06, 0xb8, 0x68, 0x9f, 0xf6, 0x99, 0x91, 0x78, 0xfc, 0x9c, 0x8a, 0x1f, 0x01, 0x25, 0x48,
+	0x64, 0xc2, 0x81, 0xfb, 0x28, 0x16, 0x6d, 0x42, 0x8d, 0x8b, 0x1a, 0x90, 0xd3, 0x48, 0xe3, 0xc3,
+	0x1d, 0xda, 0x8b, 0xa3, 0x11, 0x87, 0xbd, 0xe6, 0xc1, 0x09, 0x5f, 0xaa, 0xd7, 0x3c, 0x7e, 0x2a,
+	0x9c, 0xd1, 0x6b, 0x1e, 0x56, 0x7e, 0x36, 0x45, 0x34, 0xf7, 0x57, 0x35, 0x53, 0xa1, 0xdc, 0x51,
+	0x68, 0x8a, 0x0a, 0x1d, 0x3e, 0xef, 0x6d, 0xbf, 0x98, 0x0d, 0xd9, 0xe7, 0xd4, 0xca, 0x00, 0xea,
+	0xeb, 0xf8, 0x99, 0x15, 0xff, 0x00, 0xef, 0x83, 0xb1, 0xff, 0x2e, 0x77, 0x61, 0x82, 0x22, 0x74,
+	0xd0, 0x63, 0xaf, 0x63, 0x6f, 0xbe, 0x23, 0x3f, 0xbb, 0x4c, 0x3f, 0xca, 0xbe, 0xec, 0x7f, 0x94,
+	0x7d, 0xf9, 0x86, 0x61, 0xa2, 0xbb, 0xec, 0xca, 0xcd, 0xbf, 0x94, 0x53, 0x1e, 0x4b, 0x09, 0x4e,
+	0x6a, 0x55, 0xf6, 0x5d, 0xf8, 0xeb, 0x8f, 0xbd, 0xbb, 0x9b, 0xef, 0x5c, 0xd5, 0xde, 0x7b, 0xa3,
+	0x0c, 0xc5, 0x95, 0xe5, 0x97, 0x97, 0x5f, 0x82, 0x09, 0x23, 0x40, 0xdf, 0x76, 0xfa, 0xdd, 0xab,
+	0x35, 0x5a, 0x68, 0x1d, 0xd7, 0xb3, 0x2e, 0x7d, 0xe6, 0xe2, 0xb6, 0xe1, 0xed, 0x0c, 0x36, 0xf1,
+	0x70, 0x5c, 0xa0, 0x68, 0xe7, 0x0d, 0x9b, 0xfd, 0xbb, 0x60, 0x58, 0x1e, 0x72, 0x2c, 0xcd, 0xa4,
+	0xdf, 0x8b, 0x67, 0xd0, 0xfe, 0xe6, 0x37, 0x24, 0x69, 0xb3, 0x44, 0x40, 0x17, 0xff, 0x6f, 0x00,
+	0x9a, 0xc0, 0x67, 0xe2, 0x91, 0x7e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -7399,6 +9342,7 @@ type MilvusServiceClient interface {
 	ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error)
 	CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropPartition(ctx context.Context, in *DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	TruncatePartition(ctx context.Context, in *TruncatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
 	LoadPartitions(ctx context.Context, in *LoadPartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
@@ -7407,6 +9351,15 @@ type MilvusServiceClient interface {
 	CreateAlias(ctx context.Context, in *CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropAlias(ctx context.Context, in *DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AlterAlias(ctx context.Context, in *AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AlterShards(ctx context.Context, in *AlterShardsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// Truncate removes all the entities, the schema, indexes, aliases and load state are kept
+	TruncateCollection(ctx context.Context, in *TruncateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AddField(ctx context.Context, in *AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropField(ctx context.Context, in *DropFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// Snapshots are named read-only views of a collection, searched and queried as `collection@snapshot`
+	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropSnapshot(ctx context.Context, in *DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
 	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error)
 	GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error)
@@ -7414,9 +9367,15 @@ type MilvusServiceClient interface {
 	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MutationResult, error)
+	// DeleteByExpr deletes the entities matching an arbitrary filter in the background, the progress is tracked by GetDeleteJobState
+	DeleteByExpr(ctx context.Context, in *DeleteByExprRequest, opts ...grpc.CallOption) (*DeleteByExprResponse, error)
+	GetDeleteJobState(ctx context.Context, in *GetDeleteJobStateRequest, opts ...grpc.CallOption) (*GetDeleteJobStateResponse, error)
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
 	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error)
+	// the streaming variants send the results in chunks, for the results exceeding the message size limit
+	SearchStream(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (MilvusService_SearchStreamClient, error)
+	QueryStream(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (MilvusService_QueryStreamClient, error)
 	CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error)
 	GetFlushState(ctx context.Context, in *GetFlushStateRequest, opts ...grpc.CallOption) (*GetFlushStateResponse, error)
 	GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error)
@@ -7431,6 +9390,8 @@ type MilvusServiceClient interface {
 	GetCompactionState(ctx context.Context, in *GetCompactionStateRequest, opts ...grpc.CallOption) (*GetCompactionStateResponse, error)
 	ManualCompaction(ctx context.Context, in *ManualCompactionRequest, opts ...grpc.CallOption) (*ManualCompactionResponse, error)
 	GetCompactionStateWithPlans(ctx context.Context, in *GetCompactionPlansRequest, opts ...grpc.CallOption) (*GetCompactionPlansResponse, error)
+	GetCompactionPolicyStats(ctx context.Context, in *GetCompactionPolicyStatsRequest, opts ...grpc.CallOption) (*GetCompactionPolicyStatsResponse, error)
+	CancelCompaction(ctx context.Context, in *CancelCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error)
 	GetImportState(ctx context.Context, in *GetImportStateRequest, opts ...grpc.CallOption) (*GetImportStateResponse, error)
@@ -7448,6 +9409,9 @@ type MilvusServiceClient interface {
 	SelectUser(ctx context.Context, in *SelectUserRequest, opts ...grpc.CallOption) (*SelectUserResponse, error)
 	OperatePrivilege(ctx context.Context, in *OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	SelectGrant(ctx context.Context, in *SelectGrantRequest, opts ...grpc.CallOption) (*SelectGrantResponse, error)
+	CreateRowPolicy(ctx context.Context, in *CreateRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropRowPolicy(ctx context.Context, in *DropRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListRowPolicies(ctx context.Context, in *ListRowPoliciesRequest, opts ...grpc.CallOption) (*ListRowPoliciesResponse, error)
 }
 
 type milvusServiceClient struct {
@@ -7548,6 +9512,15 @@ func (c *milvusServiceClient) DropPartition(ctx context.Context, in *DropPartiti
 	return out, nil
 }
 
+func (c *milvusServiceClient) TruncatePartition(ctx context.Context, in *TruncatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/TruncatePartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
 	out := new(BoolResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/HasPartition", in, out, opts...)
@@ -7620,6 +9593,69 @@ func (c *milvusServiceClient) AlterAlias(ctx context.Context, in *AlterAliasRequ
 	return out, nil
 }
 
+func (c *milvusServiceClient) AlterShards(ctx context.Context, in *AlterShardsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/AlterShards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) TruncateCollection(ctx context.Context, in *TruncateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/TruncateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) AddField(ctx context.Context, in *AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/AddField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropField(ctx context.Context, in *DropFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropSnapshot(ctx context.Context, in *DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateIndex", in, out, opts...)
@@ -7683,6 +9719,24 @@ func (c *milvusServiceClient) Delete(ctx context.Context, in *DeleteRequest, opt
 	return out, nil
 }
 
+func (c *milvusServiceClient) DeleteByExpr(ctx context.Context, in *DeleteByExprRequest, opts ...grpc.CallOption) (*DeleteByExprResponse, error) {
+	out := new(DeleteByExprResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DeleteByExpr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetDeleteJobState(ctx context.Context, in *GetDeleteJobStateRequest, opts ...grpc.CallOption) (*GetDeleteJobStateResponse, error) {
+	out := new(GetDeleteJobStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetDeleteJobState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error) {
 	out := new(SearchResults)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Search", in, out, opts...)
@@ -7710,6 +9764,70 @@ func (c *milvusServiceClient) Query(ctx context.Context, in *QueryRequest, opts
 	return out, nil
 }
 
+func (c *milvusServiceClient) SearchStream(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (MilvusService_SearchStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MilvusService_serviceDesc.Streams[0], "/milvus.proto.milvus.MilvusService/SearchStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &milvusServiceSearchStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MilvusService_SearchStreamClient interface {
+	Recv() (*SearchResults, error)
+	grpc.ClientStream
+}
+
+type milvusServiceSearchStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *milvusServiceSearchStreamClient) Recv() (*SearchResults, error) {
+	m := new(SearchResults)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *milvusServiceClient) QueryStream(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (MilvusService_QueryStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MilvusService_serviceDesc.Streams[1], "/milvus.proto.milvus.MilvusService/QueryStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &milvusServiceQueryStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MilvusService_QueryStreamClient interface {
+	Recv() (*QueryResults, error)
+	grpc.ClientStream
+}
+
+type milvusServiceQueryStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *milvusServiceQueryStreamClient) Recv() (*QueryResults, error) {
+	m := new(QueryResults)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *milvusServiceClient) CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error) {
 	out := new(CalcDistanceResults)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CalcDistance", in, out, opts...)
@@ -7818,6 +9936,24 @@ func (c *milvusServiceClient) GetCompactionStateWithPlans(ctx context.Context, i
 	return out, nil
 }
 
+func (c *milvusServiceClient) GetCompactionPolicyStats(ctx context.Context, in *GetCompactionPolicyStatsRequest, opts ...grpc.CallOption) (*GetCompactionPolicyStatsResponse, error) {
+	out := new(GetCompactionPolicyStatsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetCompactionPolicyStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CancelCompaction(ctx context.Context, in *CancelCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CancelCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error) {
 	out := new(ImportResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Import", in, out, opts...)
@@ -7944,6 +10080,33 @@ func (c *milvusServiceClient) SelectGrant(ctx context.Context, in *SelectGrantRe
 	return out, nil
 }
 
+func (c *milvusServiceClient) CreateRowPolicy(ctx context.Context, in *CreateRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateRowPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropRowPolicy(ctx context.Context, in *DropRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropRowPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListRowPolicies(ctx context.Context, in *ListRowPoliciesRequest, opts ...grpc.CallOption) (*ListRowPoliciesResponse, error) {
+	out := new(ListRowPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListRowPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MilvusServiceServer is the server API for MilvusService service.
 type MilvusServiceServer interface {
 	CreateCollection(context.Context, *CreateCollectionRequest) (*commonpb.Status, error)
@@ -7956,6 +10119,7 @@ type MilvusServiceServer interface {
 	ShowCollections(context.Context, *ShowCollectionsRequest) (*ShowCollectionsResponse, error)
 	CreatePartition(context.Context, *CreatePartitionRequest) (*commonpb.Status, error)
 	DropPartition(context.Context, *DropPartitionRequest) (*commonpb.Status, error)
+	TruncatePartition(context.Context, *TruncatePartitionRequest) (*commonpb.Status, error)
 	HasPartition(context.Context, *HasPartitionRequest) (*BoolResponse, error)
 	LoadPartitions(context.Context, *LoadPartitionsRequest) (*commonpb.Status, error)
 	ReleasePartitions(context.Context, *ReleasePartitionsRequest) (*commonpb.Status, error)
@@ -7964,6 +10128,15 @@ type MilvusServiceServer interface {
 	CreateAlias(context.Context, *CreateAliasRequest) (*commonpb.Status, error)
 	DropAlias(context.Context, *DropAliasRequest) (*commonpb.Status, error)
 	AlterAlias(context.Context, *AlterAliasRequest) (*commonpb.Status, error)
+	AlterShards(context.Context, *AlterShardsRequest) (*commonpb.Status, error)
+	// Truncate removes all the entities, the schema, indexes, aliases and load state are kept
+	TruncateCollection(context.Context, *TruncateCollectionRequest) (*commonpb.Status, error)
+	AddField(context.Context, *AddFieldRequest) (*commonpb.Status, error)
+	DropField(context.Context, *DropFieldRequest) (*commonpb.Status, error)
+	// Snapshots are named read-only views of a collection, searched and queried as `collection@snapshot`
+	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*commonpb.Status, error)
+	DropSnapshot(context.Context, *DropSnapshotRequest) (*commonpb.Status, error)
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
 	CreateIndex(context.Context, *CreateIndexRequest) (*commonpb.Status, error)
 	DescribeIndex(context.Context, *DescribeIndexRequest) (*DescribeIndexResponse, error)
 	GetIndexState(context.Context, *GetIndexStateRequest) (*GetIndexStateResponse, error)
@@ -7971,9 +10144,15 @@ type MilvusServiceServer interface {
 	DropIndex(context.Context, *DropIndexRequest) (*commonpb.Status, error)
 	Insert(context.Context, *InsertRequest) (*MutationResult, error)
 	Delete(context.Context, *DeleteRequest) (*MutationResult, error)
+	// DeleteByExpr deletes the entities matching an arbitrary filter in the background, the progress is tracked by GetDeleteJobState
+	DeleteByExpr(context.Context, *DeleteByExprRequest) (*DeleteByExprResponse, error)
+	GetDeleteJobState(context.Context, *GetDeleteJobStateRequest) (*GetDeleteJobStateResponse, error)
 	Search(context.Context, *SearchRequest) (*SearchResults, error)
 	Flush(context.Context, *FlushRequest) (*FlushResponse, error)
 	Query(context.Context, *QueryRequest) (*QueryResults, error)
+	// the streaming variants send the results in chunks, for the results exceeding the message size limit
+	SearchStream(*SearchRequest, MilvusService_SearchStreamServer) error
+	QueryStream(*QueryRequest, MilvusService_QueryStreamServer) error
 	CalcDistance(context.Context, *CalcDistanceRequest) (*CalcDistanceResults, error)
 	GetFlushState(context.Context, *GetFlushStateRequest) (*GetFlushStateResponse, error)
 	GetPersistentSegmentInfo(context.Context, *GetPersistentSegmentInfoRequest) (*GetPersistentSegmentInfoResponse, error)
@@ -7988,6 +10167,8 @@ type MilvusServiceServer interface {
 	GetCompactionState(context.Context, *GetCompactionStateRequest) (*GetCompactionStateResponse, error)
 	ManualCompaction(context.Context, *ManualCompactionRequest) (*ManualCompactionResponse, error)
 	GetCompactionStateWithPlans(context.Context, *GetCompactionPlansRequest) (*GetCompactionPlansResponse, error)
+	GetCompactionPolicyStats(context.Context, *GetCompactionPolicyStatsRequest) (*GetCompactionPolicyStatsResponse, error)
+	CancelCompaction(context.Context, *CancelCompactionRequest) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(context.Context, *ImportRequest) (*ImportResponse, error)
 	GetImportState(context.Context, *GetImportStateRequest) (*GetImportStateResponse, error)
@@ -8005,6 +10186,9 @@ type MilvusServiceServer interface {
 	SelectUser(context.Context, *SelectUserRequest) (*SelectUserResponse, error)
 	OperatePrivilege(context.Context, *OperatePrivilegeRequest) (*commonpb.Status, error)
 	SelectGrant(context.Context, *SelectGrantRequest) (*SelectGrantResponse, error)
+	CreateRowPolicy(context.Context, *CreateRowPolicyRequest) (*commonpb.Status, error)
+	DropRowPolicy(context.Context, *DropRowPolicyRequest) (*commonpb.Status, error)
+	ListRowPolicies(context.Context, *ListRowPoliciesRequest) (*ListRowPoliciesResponse, error)
 }
 
 // UnimplementedMilvusServiceServer can be embedded to have forward compatible implementations.
@@ -8041,6 +10225,9 @@ func (*UnimplementedMilvusServiceServer) CreatePartition(ctx context.Context, re
 func (*UnimplementedMilvusServiceServer) DropPartition(ctx context.Context, req *DropPartitionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropPartition not implemented")
 }
+func (*UnimplementedMilvusServiceServer) TruncatePartition(ctx context.Context, req *TruncatePartitionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TruncatePartition not implemented")
+}
 func (*UnimplementedMilvusServiceServer) HasPartition(ctx context.Context, req *HasPartitionRequest) (*BoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HasPartition not implemented")
 }
@@ -8065,6 +10252,27 @@ func (*UnimplementedMilvusServiceServer) DropAlias(ctx context.Context, req *Dro
 func (*UnimplementedMilvusServiceServer) AlterAlias(ctx context.Context, req *AlterAliasRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AlterAlias not implemented")
 }
+func (*UnimplementedMilvusServiceServer) AlterShards(ctx context.Context, req *AlterShardsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterShards not implemented")
+}
+func (*UnimplementedMilvusServiceServer) TruncateCollection(ctx context.Context, req *TruncateCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TruncateCollection not implemented")
+}
+func (*UnimplementedMilvusServiceServer) AddField(ctx context.Context, req *AddFieldRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddField not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropField(ctx context.Context, req *DropFieldRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropField not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CreateSnapshot(ctx context.Context, req *CreateSnapshotRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropSnapshot(ctx context.Context, req *DropSnapshotRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropSnapshot not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListSnapshots(ctx context.Context, req *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CreateIndex(ctx context.Context, req *CreateIndexRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateIndex not implemented")
 }
@@ -8086,6 +10294,12 @@ func (*UnimplementedMilvusServiceServer) Insert(ctx context.Context, req *Insert
 func (*UnimplementedMilvusServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*MutationResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedMilvusServiceServer) DeleteByExpr(ctx context.Context, req *DeleteByExprRequest) (*DeleteByExprResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteByExpr not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GetDeleteJobState(ctx context.Context, req *GetDeleteJobStateRequest) (*GetDeleteJobStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeleteJobState not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Search(ctx context.Context, req *SearchRequest) (*SearchResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
@@ -8095,6 +10309,12 @@ func (*UnimplementedMilvusServiceServer) Flush(ctx context.Context, req *FlushRe
 func (*UnimplementedMilvusServiceServer) Query(ctx context.Context, req *QueryRequest) (*QueryResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
+func (*UnimplementedMilvusServiceServer) SearchStream(req *SearchRequest, srv MilvusService_SearchStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SearchStream not implemented")
+}
+func (*UnimplementedMilvusServiceServer) QueryStream(req *QueryRequest, srv MilvusService_QueryStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method QueryStream not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CalcDistance(ctx context.Context, req *CalcDistanceRequest) (*CalcDistanceResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalcDistance not implemented")
 }
@@ -8131,6 +10351,12 @@ func (*UnimplementedMilvusServiceServer) ManualCompaction(ctx context.Context, r
 func (*UnimplementedMilvusServiceServer) GetCompactionStateWithPlans(ctx context.Context, req *GetCompactionPlansRequest) (*GetCompactionPlansResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionStateWithPlans not implemented")
 }
+func (*UnimplementedMilvusServiceServer) GetCompactionPolicyStats(ctx context.Context, req *GetCompactionPolicyStatsRequest) (*GetCompactionPolicyStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionPolicyStats not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CancelCompaction(ctx context.Context, req *CancelCompactionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCompaction not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Import(ctx context.Context, req *ImportRequest) (*ImportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
 }
@@ -8173,6 +10399,15 @@ func (*UnimplementedMilvusServiceServer) OperatePrivilege(ctx context.Context, r
 func (*UnimplementedMilvusServiceServer) SelectGrant(ctx context.Context, req *SelectGrantRequest) (*SelectGrantResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SelectGrant not implemented")
 }
+func (*UnimplementedMilvusServiceServer) CreateRowPolicy(ctx context.Context, req *CreateRowPolicyRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRowPolicy not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropRowPolicy(ctx context.Context, req *DropRowPolicyRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropRowPolicy not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListRowPolicies(ctx context.Context, req *ListRowPoliciesRequest) (*ListRowPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRowPolicies not implemented")
+}
 
 func RegisterMilvusServiceServer(s *grpc.Server, srv MilvusServiceServer) {
 	s.RegisterService(&_MilvusService_serviceDesc, srv)
@@ -8358,6 +10593,24 @@ func _MilvusService_DropPartition_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_TruncatePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TruncatePartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).TruncatePartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/TruncatePartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).TruncatePartition(ctx, req.(*TruncatePartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_HasPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HasPartitionRequest)
 	if err := dec(in); err != nil {
@@ -8502,6 +10755,132 @@ func _MilvusService_AlterAlias_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_AlterShards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterShardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).AlterShards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/AlterShards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).AlterShards(ctx, req.(*AlterShardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_TruncateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TruncateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).TruncateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/TruncateCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).TruncateCollection(ctx, req.(*TruncateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_AddField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).AddField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/AddField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).AddField(ctx, req.(*AddFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropField(ctx, req.(*DropFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateSnapshot(ctx, req.(*CreateSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropSnapshot(ctx, req.(*DropSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateIndexRequest)
 	if err := dec(in); err != nil {
@@ -8628,6 +11007,42 @@ func _MilvusService_Delete_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_DeleteByExpr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteByExprRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DeleteByExpr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DeleteByExpr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DeleteByExpr(ctx, req.(*DeleteByExprRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_GetDeleteJobState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeleteJobStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetDeleteJobState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetDeleteJobState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetDeleteJobState(ctx, req.(*GetDeleteJobStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchRequest)
 	if err := dec(in); err != nil {
@@ -8682,6 +11097,48 @@ func _MilvusService_Query_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_SearchStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MilvusServiceServer).SearchStream(m, &milvusServiceSearchStreamServer{stream})
+}
+
+type MilvusService_SearchStreamServer interface {
+	Send(*SearchResults) error
+	grpc.ServerStream
+}
+
+type milvusServiceSearchStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *milvusServiceSearchStreamServer) Send(m *SearchResults) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MilvusService_QueryStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MilvusServiceServer).QueryStream(m, &milvusServiceQueryStreamServer{stream})
+}
+
+type MilvusService_QueryStreamServer interface {
+	Send(*QueryResults) error
+	grpc.ServerStream
+}
+
+type milvusServiceQueryStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *milvusServiceQueryStreamServer) Send(m *QueryResults) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _MilvusService_CalcDistance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CalcDistanceRequest)
 	if err := dec(in); err != nil {
@@ -8898,6 +11355,42 @@ func _MilvusService_GetCompactionStateWithPlans_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_GetCompactionPolicyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompactionPolicyStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetCompactionPolicyStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetCompactionPolicyStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetCompactionPolicyStats(ctx, req.(*GetCompactionPolicyStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_CancelCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCompactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CancelCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CancelCompaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CancelCompaction(ctx, req.(*CancelCompactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ImportRequest)
 	if err := dec(in); err != nil {
@@ -9150,6 +11643,60 @@ func _MilvusService_SelectGrant_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_CreateRowPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRowPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateRowPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateRowPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateRowPolicy(ctx, req.(*CreateRowPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropRowPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropRowPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropRowPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropRowPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropRowPolicy(ctx, req.(*DropRowPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ListRowPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRowPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ListRowPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListRowPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ListRowPolicies(ctx, req.(*ListRowPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MilvusService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.milvus.MilvusService",
 	HandlerType: (*MilvusServiceServer)(nil),
@@ -9194,6 +11741,10 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DropPartition",
 			Handler:    _MilvusService_DropPartition_Handler,
 		},
+		{
+			MethodName: "TruncatePartition",
+			Handler:    _MilvusService_TruncatePartition_Handler,
+		},
 		{
 			MethodName: "HasPartition",
 			Handler:    _MilvusService_HasPartition_Handler,
@@ -9226,6 +11777,34 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AlterAlias",
 			Handler:    _MilvusService_AlterAlias_Handler,
 		},
+		{
+			MethodName: "AlterShards",
+			Handler:    _MilvusService_AlterShards_Handler,
+		},
+		{
+			MethodName: "TruncateCollection",
+			Handler:    _MilvusService_TruncateCollection_Handler,
+		},
+		{
+			MethodName: "AddField",
+			Handler:    _MilvusService_AddField_Handler,
+		},
+		{
+			MethodName: "DropField",
+			Handler:    _MilvusService_DropField_Handler,
+		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _MilvusService_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "DropSnapshot",
+			Handler:    _MilvusService_DropSnapshot_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _MilvusService_ListSnapshots_Handler,
+		},
 		{
 			MethodName: "CreateIndex",
 			Handler:    _MilvusService_CreateIndex_Handler,
@@ -9254,6 +11833,14 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _MilvusService_Delete_Handler,
 		},
+		{
+			MethodName: "DeleteByExpr",
+			Handler:    _MilvusService_DeleteByExpr_Handler,
+		},
+		{
+			MethodName: "GetDeleteJobState",
+			Handler:    _MilvusService_GetDeleteJobState_Handler,
+		},
 		{
 			MethodName: "Search",
 			Handler:    _MilvusService_Search_Handler,
@@ -9314,6 +11901,14 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCompactionStateWithPlans",
 			Handler:    _MilvusService_GetCompactionStateWithPlans_Handler,
 		},
+		{
+			MethodName: "GetCompactionPolicyStats",
+			Handler:    _MilvusService_GetCompactionPolicyStats_Handler,
+		},
+		{
+			MethodName: "CancelCompaction",
+			Handler:    _MilvusService_CancelCompaction_Handler,
+		},
 		{
 			MethodName: "Import",
 			Handler:    _MilvusService_Import_Handler,
@@ -9370,8 +11965,31 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SelectGrant",
 			Handler:    _MilvusService_SelectGrant_Handler,
 		},
+		{
+			MethodName: "CreateRowPolicy",
+			Handler:    _MilvusService_CreateRowPolicy_Handler,
+		},
+		{
+			MethodName: "DropRowPolicy",
+			Handler:    _MilvusService_DropRowPolicy_Handler,
+		},
+		{
+			MethodName: "ListRowPolicies",
+			Handler:    _MilvusService_ListRowPolicies_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SearchStream",
+			Handler:       _MilvusService_SearchStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "QueryStream",
+			Handler:       _MilvusService_QueryStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "milvus.proto",
 }
 
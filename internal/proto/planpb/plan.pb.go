@@ -169,6 +169,34 @@ func (BinaryExpr_BinaryOp) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{10, 0}
 }
 
+type NullExpr_NullOp int32
+
+const (
+	NullExpr_Invalid   NullExpr_NullOp = 0
+	NullExpr_IsNull    NullExpr_NullOp = 1
+	NullExpr_IsNotNull NullExpr_NullOp = 2
+)
+
+var NullExpr_NullOp_name = map[int32]string{
+	0: "Invalid",
+	1: "IsNull",
+	2: "IsNotNull",
+}
+
+var NullExpr_NullOp_value = map[string]int32{
+	"Invalid":   0,
+	"IsNull":    1,
+	"IsNotNull": 2,
+}
+
+func (x NullExpr_NullOp) String() string {
+	return proto.EnumName(NullExpr_NullOp_name, int32(x))
+}
+
+func (NullExpr_NullOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{11, 0}
+}
+
 type GenericValue struct {
 	// Types that are valid to be assigned to Val:
 	//	*GenericValue_BoolVal
@@ -284,6 +312,8 @@ type QueryInfo struct {
 	MetricType           string   `protobuf:"bytes,3,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
 	SearchParams         string   `protobuf:"bytes,4,opt,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
 	RoundDecimal         int64    `protobuf:"varint,5,opt,name=round_decimal,json=roundDecimal,proto3" json:"round_decimal,omitempty"`
+	GroupByFieldId       int64    `protobuf:"varint,6,opt,name=group_by_field_id,json=groupByFieldId,proto3" json:"group_by_field_id,omitempty"`
+	GroupSize            int64    `protobuf:"varint,7,opt,name=group_size,json=groupSize,proto3" json:"group_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -342,6 +372,20 @@ func (m *QueryInfo) GetRoundDecimal() int64 {
 	return 0
 }
 
+func (m *QueryInfo) GetGroupByFieldId() int64 {
+	if m != nil {
+		return m.GroupByFieldId
+	}
+	return 0
+}
+
+func (m *QueryInfo) GetGroupSize() int64 {
+	if m != nil {
+		return m.GroupSize
+	}
+	return 0
+}
+
 type ColumnInfo struct {
 	FieldId              int64             `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
 	DataType             schemapb.DataType `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
@@ -813,6 +857,53 @@ func (m *BinaryExpr) GetRight() *Expr {
 	return nil
 }
 
+type NullExpr struct {
+	ColumnInfo           *ColumnInfo     `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	Op                   NullExpr_NullOp `protobuf:"varint,2,opt,name=op,proto3,enum=milvus.proto.plan.NullExpr_NullOp" json:"op,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *NullExpr) Reset()         { *m = NullExpr{} }
+func (m *NullExpr) String() string { return proto.CompactTextString(m) }
+func (*NullExpr) ProtoMessage()    {}
+func (*NullExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{11}
+}
+
+func (m *NullExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NullExpr.Unmarshal(m, b)
+}
+func (m *NullExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NullExpr.Marshal(b, m, deterministic)
+}
+func (m *NullExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NullExpr.Merge(m, src)
+}
+func (m *NullExpr) XXX_Size() int {
+	return xxx_messageInfo_NullExpr.Size(m)
+}
+func (m *NullExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_NullExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NullExpr proto.InternalMessageInfo
+
+func (m *NullExpr) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *NullExpr) GetOp() NullExpr_NullOp {
+	if m != nil {
+		return m.Op
+	}
+	return NullExpr_Invalid
+}
+
 type BinaryArithOp struct {
 	ColumnInfo           *ColumnInfo   `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
 	ArithOp              ArithOpType   `protobuf:"varint,2,opt,name=arith_op,json=arithOp,proto3,enum=milvus.proto.plan.ArithOpType" json:"arith_op,omitempty"`
@@ -826,7 +917,7 @@ func (m *BinaryArithOp) Reset()         { *m = BinaryArithOp{} }
 func (m *BinaryArithOp) String() string { return proto.CompactTextString(m) }
 func (*BinaryArithOp) ProtoMessage()    {}
 func (*BinaryArithOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{11}
+	return fileDescriptor_2d655ab2f7683c23, []int{12}
 }
 
 func (m *BinaryArithOp) XXX_Unmarshal(b []byte) error {
@@ -881,7 +972,7 @@ func (m *BinaryArithExpr) Reset()         { *m = BinaryArithExpr{} }
 func (m *BinaryArithExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryArithExpr) ProtoMessage()    {}
 func (*BinaryArithExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{12}
+	return fileDescriptor_2d655ab2f7683c23, []int{13}
 }
 
 func (m *BinaryArithExpr) XXX_Unmarshal(b []byte) error {
@@ -938,7 +1029,7 @@ func (m *BinaryArithOpEvalRangeExpr) Reset()         { *m = BinaryArithOpEvalRan
 func (m *BinaryArithOpEvalRangeExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryArithOpEvalRangeExpr) ProtoMessage()    {}
 func (*BinaryArithOpEvalRangeExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{13}
+	return fileDescriptor_2d655ab2f7683c23, []int{14}
 }
 
 func (m *BinaryArithOpEvalRangeExpr) XXX_Unmarshal(b []byte) error {
@@ -1006,6 +1097,7 @@ type Expr struct {
 	//	*Expr_BinaryArithExpr
 	//	*Expr_ValueExpr
 	//	*Expr_ColumnExpr
+	//	*Expr_NullExpr
 	Expr                 isExpr_Expr `protobuf_oneof:"expr"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
@@ -1016,7 +1108,7 @@ func (m *Expr) Reset()         { *m = Expr{} }
 func (m *Expr) String() string { return proto.CompactTextString(m) }
 func (*Expr) ProtoMessage()    {}
 func (*Expr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{14}
+	return fileDescriptor_2d655ab2f7683c23, []int{15}
 }
 
 func (m *Expr) XXX_Unmarshal(b []byte) error {
@@ -1081,6 +1173,10 @@ type Expr_ColumnExpr struct {
 	ColumnExpr *ColumnExpr `protobuf:"bytes,10,opt,name=column_expr,json=columnExpr,proto3,oneof"`
 }
 
+type Expr_NullExpr struct {
+	NullExpr *NullExpr `protobuf:"bytes,11,opt,name=null_expr,json=nullExpr,proto3,oneof"`
+}
+
 func (*Expr_TermExpr) isExpr_Expr() {}
 
 func (*Expr_UnaryExpr) isExpr_Expr() {}
@@ -1101,6 +1197,8 @@ func (*Expr_ValueExpr) isExpr_Expr() {}
 
 func (*Expr_ColumnExpr) isExpr_Expr() {}
 
+func (*Expr_NullExpr) isExpr_Expr() {}
+
 func (m *Expr) GetExpr() isExpr_Expr {
 	if m != nil {
 		return m.Expr
@@ -1178,6 +1276,13 @@ func (m *Expr) GetColumnExpr() *ColumnExpr {
 	return nil
 }
 
+func (m *Expr) GetNullExpr() *NullExpr {
+	if x, ok := m.GetExpr().(*Expr_NullExpr); ok {
+		return x.NullExpr
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Expr) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1191,6 +1296,7 @@ func (*Expr) XXX_OneofWrappers() []interface{} {
 		(*Expr_BinaryArithExpr)(nil),
 		(*Expr_ValueExpr)(nil),
 		(*Expr_ColumnExpr)(nil),
+		(*Expr_NullExpr)(nil),
 	}
 }
 
@@ -1209,7 +1315,7 @@ func (m *VectorANNS) Reset()         { *m = VectorANNS{} }
 func (m *VectorANNS) String() string { return proto.CompactTextString(m) }
 func (*VectorANNS) ProtoMessage()    {}
 func (*VectorANNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{15}
+	return fileDescriptor_2d655ab2f7683c23, []int{16}
 }
 
 func (m *VectorANNS) XXX_Unmarshal(b []byte) error {
@@ -1269,18 +1375,20 @@ type PlanNode struct {
 	// Types that are valid to be assigned to Node:
 	//	*PlanNode_VectorAnns
 	//	*PlanNode_Predicates
-	Node                 isPlanNode_Node `protobuf_oneof:"node"`
-	OutputFieldIds       []int64         `protobuf:"varint,3,rep,packed,name=output_field_ids,json=outputFieldIds,proto3" json:"output_field_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Node           isPlanNode_Node `protobuf_oneof:"node"`
+	OutputFieldIds []int64         `protobuf:"varint,3,rep,packed,name=output_field_ids,json=outputFieldIds,proto3" json:"output_field_ids,omitempty"`
+	// the retrieve plans only return the limit entities with the smallest primary keys of each segment if positive
+	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PlanNode) Reset()         { *m = PlanNode{} }
 func (m *PlanNode) String() string { return proto.CompactTextString(m) }
 func (*PlanNode) ProtoMessage()    {}
 func (*PlanNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{16}
+	return fileDescriptor_2d655ab2f7683c23, []int{17}
 }
 
 func (m *PlanNode) XXX_Unmarshal(b []byte) error {
@@ -1345,6 +1453,13 @@ func (m *PlanNode) GetOutputFieldIds() []int64 {
 	return nil
 }
 
+func (m *PlanNode) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*PlanNode) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1358,6 +1473,7 @@ func init() {
 	proto.RegisterEnum("milvus.proto.plan.ArithOpType", ArithOpType_name, ArithOpType_value)
 	proto.RegisterEnum("milvus.proto.plan.UnaryExpr_UnaryOp", UnaryExpr_UnaryOp_name, UnaryExpr_UnaryOp_value)
 	proto.RegisterEnum("milvus.proto.plan.BinaryExpr_BinaryOp", BinaryExpr_BinaryOp_name, BinaryExpr_BinaryOp_value)
+	proto.RegisterEnum("milvus.proto.plan.NullExpr_NullOp", NullExpr_NullOp_name, NullExpr_NullOp_value)
 	proto.RegisterType((*GenericValue)(nil), "milvus.proto.plan.GenericValue")
 	proto.RegisterType((*QueryInfo)(nil), "milvus.proto.plan.QueryInfo")
 	proto.RegisterType((*ColumnInfo)(nil), "milvus.proto.plan.ColumnInfo")
@@ -1369,6 +1485,7 @@ func init() {
 	proto.RegisterType((*TermExpr)(nil), "milvus.proto.plan.TermExpr")
 	proto.RegisterType((*UnaryExpr)(nil), "milvus.proto.plan.UnaryExpr")
 	proto.RegisterType((*BinaryExpr)(nil), "milvus.proto.plan.BinaryExpr")
+	proto.RegisterType((*NullExpr)(nil), "milvus.proto.plan.NullExpr")
 	proto.RegisterType((*BinaryArithOp)(nil), "milvus.proto.plan.BinaryArithOp")
 	proto.RegisterType((*BinaryArithExpr)(nil), "milvus.proto.plan.BinaryArithExpr")
 	proto.RegisterType((*BinaryArithOpEvalRangeExpr)(nil), "milvus.proto.plan.BinaryArithOpEvalRangeExpr")
@@ -1380,93 +1497,100 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 1407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x72, 0xdc, 0x44,
-	0x10, 0x5e, 0xad, 0x76, 0xbd, 0x52, 0xef, 0x7a, 0xad, 0xe8, 0x42, 0x7e, 0x48, 0x6c, 0x44, 0x8a,
-	0x98, 0x50, 0xb1, 0x2b, 0x24, 0x24, 0x95, 0x50, 0x01, 0xff, 0x05, 0x7b, 0x8b, 0xc4, 0x36, 0x8a,
-	0xe3, 0x03, 0x17, 0xd5, 0xac, 0x34, 0xf6, 0x4e, 0x45, 0xab, 0x51, 0x46, 0xa3, 0x4d, 0xf6, 0xcc,
-	0x8d, 0x1b, 0x0f, 0xc0, 0x15, 0xee, 0xdc, 0xe0, 0xc2, 0x0b, 0x70, 0xe0, 0xc8, 0x9d, 0xb7, 0xe0,
-	0x44, 0x4d, 0x8f, 0xf6, 0x2f, 0xb5, 0x1b, 0xaf, 0x8b, 0x54, 0x71, 0x1b, 0xf5, 0x74, 0x7f, 0xd3,
-	0xfd, 0x4d, 0x77, 0x4f, 0x0b, 0x20, 0x8d, 0x49, 0xb2, 0x96, 0x0a, 0x2e, 0xb9, 0x7b, 0xa1, 0xcb,
-	0xe2, 0x5e, 0x9e, 0xe9, 0xaf, 0x35, 0xb5, 0x71, 0xb9, 0x91, 0x85, 0x1d, 0xda, 0x25, 0x5a, 0xe4,
-	0xfd, 0x60, 0x40, 0x63, 0x97, 0x26, 0x54, 0xb0, 0xf0, 0x98, 0xc4, 0x39, 0x75, 0xaf, 0x80, 0xd5,
-	0xe6, 0x3c, 0x0e, 0x7a, 0x24, 0xbe, 0x68, 0xac, 0x18, 0xab, 0xd6, 0x5e, 0xc9, 0xaf, 0x29, 0xc9,
-	0x31, 0x89, 0xdd, 0xab, 0x60, 0xb3, 0x44, 0xde, 0xbb, 0x8b, 0xbb, 0xe5, 0x15, 0x63, 0xd5, 0xdc,
-	0x2b, 0xf9, 0x16, 0x8a, 0x8a, 0xed, 0x93, 0x98, 0x13, 0x89, 0xdb, 0xe6, 0x8a, 0xb1, 0x6a, 0xa8,
-	0x6d, 0x14, 0xa9, 0xed, 0x65, 0x80, 0x4c, 0x0a, 0x96, 0x9c, 0xe2, 0x7e, 0x65, 0xc5, 0x58, 0xb5,
-	0xf7, 0x4a, 0xbe, 0xad, 0x65, 0xc7, 0x24, 0xde, 0xaa, 0x82, 0xd9, 0x23, 0xb1, 0xf7, 0xbd, 0x01,
-	0xf6, 0x37, 0x39, 0x15, 0xfd, 0x56, 0x72, 0xc2, 0x5d, 0x17, 0x2a, 0x92, 0xa7, 0x2f, 0xd0, 0x19,
-	0xd3, 0xc7, 0xb5, 0xbb, 0x0c, 0xf5, 0x2e, 0x95, 0x82, 0x85, 0x81, 0xec, 0xa7, 0x14, 0x8f, 0xb2,
-	0x7d, 0xd0, 0xa2, 0xa3, 0x7e, 0x4a, 0xdd, 0x0f, 0x61, 0x31, 0xa3, 0x44, 0x84, 0x9d, 0x20, 0x25,
-	0x82, 0x74, 0x33, 0x7d, 0x9a, 0xdf, 0xd0, 0xc2, 0x43, 0x94, 0x29, 0x25, 0xc1, 0xf3, 0x24, 0x0a,
-	0x22, 0x1a, 0xb2, 0x2e, 0x89, 0x2f, 0x56, 0xf1, 0x88, 0x06, 0x0a, 0x77, 0xb4, 0xcc, 0xfb, 0xc9,
-	0x00, 0xd8, 0xe6, 0x71, 0xde, 0x4d, 0xd0, 0x9b, 0x4b, 0x60, 0x9d, 0x30, 0x1a, 0x47, 0x01, 0x8b,
-	0x0a, 0x8f, 0x6a, 0xf8, 0xdd, 0x8a, 0xdc, 0x87, 0x60, 0x47, 0x44, 0x12, 0xed, 0x92, 0x22, 0xa7,
-	0xf9, 0xe9, 0xd5, 0xb5, 0x09, 0xfe, 0x0b, 0xe6, 0x77, 0x88, 0x24, 0xca, 0x4b, 0xdf, 0x8a, 0x8a,
-	0x95, 0x7b, 0x1d, 0x9a, 0x2c, 0x0b, 0x52, 0xc1, 0xba, 0x44, 0xf4, 0x83, 0x17, 0xb4, 0x8f, 0x31,
-	0x59, 0x7e, 0x83, 0x65, 0x87, 0x5a, 0xf8, 0x35, 0xed, 0xbb, 0x57, 0xc0, 0x66, 0x59, 0x40, 0x72,
-	0xc9, 0x5b, 0x3b, 0x18, 0x91, 0xe5, 0x5b, 0x2c, 0xdb, 0xc4, 0x6f, 0xef, 0xcb, 0x81, 0x9f, 0x8f,
-	0x5f, 0xa7, 0xc2, 0xbd, 0x0d, 0x15, 0x96, 0x9c, 0x70, 0xf4, 0xb1, 0xfe, 0xa6, 0x1f, 0x98, 0x20,
-	0xa3, 0xa0, 0x7c, 0x54, 0xf5, 0xb6, 0xc0, 0xc6, 0x14, 0x40, 0xfb, 0xcf, 0xa0, 0xda, 0x53, 0x1f,
-	0x05, 0xc0, 0xf2, 0x14, 0x80, 0xf1, 0xb4, 0xf1, 0xb5, 0xb6, 0xf7, 0x8b, 0x01, 0xcd, 0xe7, 0x09,
-	0x11, 0x7d, 0x9f, 0x24, 0xa7, 0x1a, 0xe9, 0x0b, 0xa8, 0x87, 0x78, 0x54, 0x30, 0xbf, 0x43, 0x10,
-	0x8e, 0x18, 0xff, 0x18, 0xca, 0x3c, 0x2d, 0xf8, 0xbc, 0x34, 0xc5, 0xec, 0x20, 0x45, 0x2e, 0xcb,
-	0x3c, 0x1d, 0x39, 0x6d, 0x9e, 0xcb, 0xe9, 0x9f, 0xcb, 0xb0, 0xb4, 0xc5, 0xde, 0xad, 0xd7, 0x37,
-	0x60, 0x29, 0xe6, 0xaf, 0xa8, 0x08, 0x58, 0x12, 0xc6, 0x79, 0xc6, 0x7a, 0x3a, 0x25, 0x2c, 0xbf,
-	0x89, 0xe2, 0xd6, 0x40, 0xaa, 0x14, 0xf3, 0x34, 0x9d, 0x50, 0xd4, 0x57, 0xdf, 0x44, 0xf1, 0x48,
-	0x71, 0x03, 0xea, 0x1a, 0x51, 0x87, 0x58, 0x99, 0x2f, 0x44, 0x40, 0x1b, 0x5d, 0xda, 0x1b, 0x50,
-	0xd7, 0x47, 0x69, 0x84, 0xea, 0x9c, 0x08, 0x68, 0x83, 0x6b, 0xef, 0x0f, 0x03, 0xea, 0xdb, 0xbc,
-	0x9b, 0x12, 0xa1, 0x59, 0xda, 0x05, 0x27, 0xa6, 0x27, 0x32, 0x38, 0x37, 0x55, 0x4d, 0x65, 0x36,
-	0x56, 0x56, 0x2d, 0xb8, 0x20, 0xd8, 0x69, 0x67, 0x12, 0xa9, 0x3c, 0x0f, 0xd2, 0x12, 0xda, 0x6d,
-	0xbf, 0x99, 0x2f, 0xe6, 0x1c, 0xf9, 0xe2, 0x7d, 0x67, 0x80, 0x75, 0x44, 0x45, 0xf7, 0x9d, 0xdc,
-	0xf8, 0x7d, 0x58, 0x40, 0x5e, 0xb3, 0x8b, 0xe5, 0x15, 0x73, 0x1e, 0x62, 0x0b, 0x75, 0xd5, 0x82,
-	0x6d, 0xac, 0x19, 0x74, 0xe3, 0x2e, 0xba, 0x6f, 0xa0, 0xfb, 0xd7, 0xa7, 0x40, 0x0c, 0x35, 0xf5,
-	0xea, 0x20, 0xc5, 0xcc, 0xbf, 0x05, 0xd5, 0xb0, 0xc3, 0xe2, 0xa8, 0xe0, 0xec, 0xbd, 0x29, 0x86,
-	0xca, 0xc6, 0xd7, 0x5a, 0xde, 0x32, 0xd4, 0x0a, 0x6b, 0xb7, 0x0e, 0xb5, 0x56, 0xd2, 0x23, 0x31,
-	0x8b, 0x9c, 0x92, 0x5b, 0x03, 0x73, 0x9f, 0x4b, 0xc7, 0xf0, 0xfe, 0x32, 0x00, 0x74, 0x49, 0xa0,
-	0x53, 0xf7, 0xc6, 0x9c, 0xfa, 0x68, 0x0a, 0xf6, 0x48, 0xb5, 0x58, 0x16, 0x6e, 0x7d, 0x02, 0x15,
-	0x75, 0xd1, 0x67, 0x79, 0x85, 0x4a, 0x2a, 0x06, 0xbc, 0xcb, 0xa2, 0x7a, 0x67, 0xc7, 0x80, 0x5a,
-	0xde, 0x3d, 0xb0, 0x06, 0x67, 0x4d, 0x06, 0xd1, 0x04, 0x78, 0xc2, 0x4f, 0x59, 0x48, 0xe2, 0xcd,
-	0x24, 0x72, 0x0c, 0x77, 0x11, 0xec, 0xe2, 0xfb, 0x40, 0x38, 0x65, 0xef, 0x4f, 0x03, 0x16, 0xb5,
-	0xe1, 0xa6, 0x60, 0xb2, 0x73, 0x90, 0xfe, 0xe7, 0x9b, 0x7f, 0x00, 0x16, 0x51, 0x50, 0xc1, 0xb0,
-	0x4f, 0x5d, 0x9b, 0x62, 0x5c, 0x9c, 0x86, 0xc9, 0x57, 0x23, 0xc5, 0xd1, 0x3b, 0xb0, 0xa8, 0xf3,
-	0x9e, 0xa7, 0x54, 0x90, 0x24, 0x9a, 0xb7, 0x73, 0x35, 0xd0, 0xea, 0x40, 0x1b, 0x79, 0x3f, 0x1a,
-	0x83, 0x06, 0x86, 0x87, 0xe0, 0x95, 0x0d, 0xa8, 0x37, 0xce, 0x45, 0x7d, 0x79, 0x1e, 0xea, 0xdd,
-	0xb5, 0xb1, 0x12, 0x3b, 0x2b, 0x54, 0x55, 0x67, 0xbf, 0x97, 0xe1, 0xf2, 0x04, 0xe5, 0x8f, 0x7b,
-	0x24, 0x7e, 0x77, 0xbd, 0xf6, 0xff, 0xe6, 0xbf, 0x68, 0x39, 0x95, 0x73, 0x3d, 0x51, 0xd5, 0x73,
-	0x3d, 0x51, 0xff, 0x54, 0xa1, 0x82, 0x5c, 0x3d, 0x04, 0x5b, 0x52, 0xd1, 0x0d, 0xe8, 0xeb, 0x54,
-	0x14, 0x4c, 0x5d, 0x99, 0x82, 0x31, 0xe8, 0x6a, 0x6a, 0xfe, 0x92, 0x83, 0x0e, 0xf7, 0x08, 0x20,
-	0x57, 0x97, 0xa0, 0x8d, 0xf5, 0x55, 0xbf, 0xff, 0xb6, 0x16, 0xa3, 0xa6, 0xb3, 0x7c, 0xd8, 0x04,
-	0x36, 0xa0, 0xde, 0x66, 0x23, 0x7b, 0x73, 0xe6, 0x35, 0x8d, 0xba, 0xc1, 0x5e, 0xc9, 0x87, 0xf6,
-	0xa8, 0x8d, 0x6c, 0x43, 0x23, 0xd4, 0xaf, 0x87, 0x86, 0xd0, 0x6f, 0xd8, 0xb5, 0xa9, 0x37, 0x3d,
-	0x7c, 0x64, 0xf6, 0x4a, 0x7e, 0x3d, 0x1c, 0x7b, 0x73, 0x9e, 0x82, 0xa3, 0xa3, 0x10, 0x2a, 0x81,
-	0x34, 0x90, 0x26, 0xf3, 0x83, 0x59, 0xb1, 0x0c, 0x53, 0x6d, 0xaf, 0xe4, 0x37, 0xf3, 0xc9, 0x87,
-	0xfe, 0x10, 0x2e, 0x14, 0x51, 0x8d, 0xe1, 0x2d, 0x20, 0x9e, 0x37, 0x33, 0xb6, 0x71, 0xc0, 0xa5,
-	0xf6, 0x1b, 0xa3, 0x83, 0x84, 0xe5, 0x02, 0x71, 0x90, 0x95, 0x01, 0xed, 0x91, 0x78, 0x1c, 0xbf,
-	0x86, 0xf8, 0xb7, 0x66, 0xe2, 0x4f, 0x2b, 0x93, 0xbd, 0x92, 0x7f, 0xb9, 0x3d, 0xbb, 0x88, 0x46,
-	0x71, 0xe8, 0x53, 0xf1, 0x1c, 0xeb, 0x8c, 0x38, 0x86, 0xed, 0x62, 0x14, 0xc7, 0xa8, 0x83, 0x3c,
-	0x02, 0xc0, 0xe4, 0xd3, 0x50, 0xf6, 0xcc, 0x74, 0x19, 0x0e, 0x8d, 0x2a, 0x5d, 0x7a, 0xc3, 0x09,
-	0x72, 0x63, 0x58, 0xd5, 0x68, 0x0f, 0x67, 0x54, 0xf5, 0x20, 0x5d, 0xc2, 0xe1, 0xd7, 0xd6, 0x02,
-	0x54, 0x94, 0xa9, 0xf7, 0xb7, 0x01, 0x70, 0x4c, 0x43, 0xc9, 0xc5, 0xe6, 0xfe, 0xfe, 0xb3, 0x62,
-	0x0a, 0xd6, 0xde, 0xea, 0x5f, 0x14, 0x35, 0x05, 0xeb, 0x80, 0x26, 0xe6, 0xf3, 0xf2, 0xe4, 0x7c,
-	0x7e, 0x1f, 0x20, 0x15, 0x34, 0x62, 0x21, 0x91, 0x34, 0x3b, 0xeb, 0x91, 0x19, 0x53, 0x75, 0x3f,
-	0x07, 0x78, 0xa9, 0x7e, 0x47, 0x74, 0x7b, 0xaa, 0xcc, 0x24, 0x62, 0xf8, 0xcf, 0xe2, 0xdb, 0x2f,
-	0x87, 0xbf, 0x2f, 0x37, 0x60, 0x29, 0x8d, 0x49, 0x48, 0x3b, 0x3c, 0x8e, 0xa8, 0x08, 0x24, 0x39,
-	0xc5, 0x6c, 0xb5, 0xfd, 0xe6, 0x98, 0xf8, 0x88, 0x9c, 0x7a, 0xbf, 0x1a, 0x60, 0x1d, 0xc6, 0x24,
-	0xd9, 0xe7, 0x11, 0x8e, 0x6a, 0x3d, 0x8c, 0x38, 0x20, 0x49, 0x92, 0xbd, 0xa5, 0x25, 0x8e, 0x78,
-	0x51, 0xe4, 0x69, 0x9b, 0xcd, 0x24, 0xc9, 0xdc, 0x07, 0x13, 0xd1, 0xbe, 0xbd, 0xaf, 0x2b, 0xd3,
-	0xb1, 0x78, 0x57, 0xc1, 0xe1, 0xb9, 0x4c, 0x73, 0x19, 0x0c, 0xa8, 0x54, 0x74, 0x99, 0xab, 0xa6,
-	0xdf, 0xd4, 0xf2, 0xaf, 0x34, 0xa3, 0x99, 0xba, 0xa1, 0x84, 0x47, 0xf4, 0xe6, 0x6f, 0x06, 0x2c,
-	0xe8, 0x26, 0x37, 0xf9, 0x14, 0x2f, 0x41, 0x7d, 0x57, 0x50, 0x22, 0xa9, 0x38, 0xea, 0x90, 0xc4,
-	0x31, 0x5c, 0x07, 0x1a, 0x85, 0xe0, 0xf1, 0xcb, 0x9c, 0xc4, 0x4e, 0xd9, 0x6d, 0x80, 0xf5, 0x84,
-	0x66, 0x19, 0xee, 0x9b, 0xf8, 0x56, 0xd3, 0x2c, 0xd3, 0x9b, 0x15, 0xd7, 0x86, 0xaa, 0x5e, 0x56,
-	0x95, 0xde, 0x3e, 0x97, 0xfa, 0x6b, 0x41, 0x01, 0x1f, 0x0a, 0x7a, 0xc2, 0x5e, 0x3f, 0x25, 0x32,
-	0xec, 0x38, 0x35, 0x05, 0x7c, 0xc8, 0x33, 0x39, 0x94, 0x58, 0xca, 0x56, 0x2f, 0x6d, 0xb5, 0xc4,
-	0x42, 0x71, 0xc0, 0x5d, 0x80, 0x72, 0x2b, 0x71, 0xea, 0x4a, 0xb4, 0xcf, 0x65, 0x2b, 0x71, 0x1a,
-	0x37, 0x77, 0xa1, 0x3e, 0xf6, 0x36, 0xa8, 0x00, 0x9e, 0x27, 0x2f, 0x12, 0xfe, 0x2a, 0xd1, 0x03,
-	0xd1, 0x66, 0xa4, 0x86, 0x88, 0x1a, 0x98, 0xcf, 0xf2, 0xb6, 0x53, 0x56, 0x8b, 0xa7, 0x79, 0xec,
-	0x98, 0x6a, 0xb1, 0xc3, 0x7a, 0x4e, 0x05, 0x25, 0x3c, 0x72, 0xaa, 0x5b, 0x77, 0xbe, 0xbd, 0x7d,
-	0xca, 0x64, 0x27, 0x6f, 0xaf, 0x85, 0xbc, 0xbb, 0xae, 0xa9, 0xbe, 0xc5, 0x78, 0xb1, 0x5a, 0x67,
-	0x89, 0xa4, 0x22, 0x21, 0xf1, 0x3a, 0xb2, 0xbf, 0xae, 0xd8, 0x4f, 0xdb, 0xed, 0x05, 0xfc, 0xba,
-	0xf3, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x32, 0x23, 0x5b, 0x87, 0xb6, 0x0f, 0x00, 0x00,
+	// 1512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4b, 0x6f, 0x1b, 0x47,
+	0x12, 0xe6, 0x70, 0xf8, 0x98, 0x29, 0x52, 0xd4, 0x68, 0xb0, 0xc0, 0xca, 0xf6, 0xda, 0xd2, 0xce,
+	0x1a, 0xbb, 0xb2, 0x17, 0x96, 0xd6, 0x8f, 0xb5, 0x61, 0x2f, 0x36, 0x89, 0x1e, 0x8e, 0x44, 0xc4,
+	0xa6, 0x94, 0xb1, 0xac, 0x43, 0x2e, 0x83, 0xe6, 0x4c, 0x8b, 0x6c, 0xb8, 0x39, 0x3d, 0x9e, 0x07,
+	0x6d, 0xfa, 0x9a, 0x5f, 0x90, 0x1f, 0x90, 0x6b, 0x72, 0x0d, 0x72, 0xc8, 0x21, 0x97, 0xfc, 0x81,
+	0x00, 0xc9, 0x21, 0x87, 0xdc, 0xf3, 0x47, 0x82, 0xae, 0x1e, 0xbe, 0x0c, 0x52, 0xa2, 0x10, 0x01,
+	0xb9, 0x55, 0x55, 0x57, 0x7d, 0x5d, 0xaf, 0xae, 0xee, 0x06, 0x88, 0x38, 0x09, 0x37, 0xa3, 0x58,
+	0xa4, 0xc2, 0x5e, 0xe9, 0x31, 0xde, 0xcf, 0x12, 0xc5, 0x6d, 0xca, 0x85, 0xab, 0xf5, 0xc4, 0xef,
+	0xd2, 0x1e, 0x51, 0x22, 0xe7, 0x0b, 0x0d, 0xea, 0xfb, 0x34, 0xa4, 0x31, 0xf3, 0x4f, 0x08, 0xcf,
+	0xa8, 0x7d, 0x0d, 0x8c, 0xb6, 0x10, 0xdc, 0xeb, 0x13, 0xbe, 0xaa, 0xad, 0x6b, 0x1b, 0xc6, 0x41,
+	0xc1, 0xad, 0x4a, 0xc9, 0x09, 0xe1, 0xf6, 0x75, 0x30, 0x59, 0x98, 0x3e, 0x7c, 0x80, 0xab, 0xc5,
+	0x75, 0x6d, 0x43, 0x3f, 0x28, 0xb8, 0x06, 0x8a, 0xf2, 0xe5, 0x53, 0x2e, 0x48, 0x8a, 0xcb, 0xfa,
+	0xba, 0xb6, 0xa1, 0xc9, 0x65, 0x14, 0xc9, 0xe5, 0x35, 0x80, 0x24, 0x8d, 0x59, 0xd8, 0xc1, 0xf5,
+	0xd2, 0xba, 0xb6, 0x61, 0x1e, 0x14, 0x5c, 0x53, 0xc9, 0x4e, 0x08, 0xdf, 0x29, 0x83, 0xde, 0x27,
+	0xdc, 0xf9, 0x45, 0x03, 0xf3, 0xd3, 0x8c, 0xc6, 0x83, 0x66, 0x78, 0x2a, 0x6c, 0x1b, 0x4a, 0xa9,
+	0x88, 0x5e, 0xa1, 0x33, 0xba, 0x8b, 0xb4, 0xbd, 0x06, 0xb5, 0x1e, 0x4d, 0x63, 0xe6, 0x7b, 0xe9,
+	0x20, 0xa2, 0xb8, 0x95, 0xe9, 0x82, 0x12, 0x1d, 0x0f, 0x22, 0x6a, 0xff, 0x03, 0x96, 0x12, 0x4a,
+	0x62, 0xbf, 0xeb, 0x45, 0x24, 0x26, 0xbd, 0x44, 0xed, 0xe6, 0xd6, 0x95, 0xf0, 0x08, 0x65, 0x52,
+	0x29, 0x16, 0x59, 0x18, 0x78, 0x01, 0xf5, 0x59, 0x8f, 0xf0, 0xd5, 0x32, 0x6e, 0x51, 0x47, 0xe1,
+	0x9e, 0x92, 0xd9, 0xb7, 0x60, 0xa5, 0x13, 0x8b, 0x2c, 0xf2, 0xda, 0x03, 0xef, 0x94, 0x51, 0x1e,
+	0x78, 0x2c, 0x58, 0xad, 0xa0, 0x62, 0x03, 0x17, 0x76, 0x06, 0x1f, 0x4b, 0x71, 0x33, 0xb0, 0xaf,
+	0x03, 0x28, 0xd5, 0x84, 0xbd, 0xa3, 0xab, 0x55, 0xd4, 0x31, 0x51, 0xf2, 0x82, 0xbd, 0xa3, 0xce,
+	0x57, 0x1a, 0xc0, 0xae, 0xe0, 0x59, 0x2f, 0xc4, 0xb8, 0xae, 0x80, 0x31, 0xc2, 0x53, 0xb1, 0x55,
+	0x4f, 0x73, 0xa0, 0x27, 0x60, 0x06, 0x24, 0x25, 0x2a, 0x38, 0x99, 0xe6, 0xc6, 0xbd, 0xeb, 0x9b,
+	0x53, 0x95, 0xcc, 0x6b, 0xb8, 0x47, 0x52, 0x22, 0xe3, 0x75, 0x8d, 0x20, 0xa7, 0xec, 0x9b, 0xd0,
+	0x60, 0x89, 0x17, 0xc5, 0xac, 0x47, 0xe2, 0x81, 0xf7, 0x8a, 0x0e, 0x30, 0x3b, 0x86, 0x5b, 0x67,
+	0xc9, 0x91, 0x12, 0x7e, 0x42, 0x07, 0xf6, 0x35, 0x30, 0x59, 0xe2, 0x91, 0x2c, 0x15, 0xcd, 0x3d,
+	0xcc, 0x8d, 0xe1, 0x1a, 0x2c, 0xd9, 0x46, 0xde, 0xf9, 0x70, 0xe8, 0xe7, 0xd3, 0xb7, 0x51, 0x6c,
+	0xdf, 0x85, 0x12, 0x0b, 0x4f, 0x05, 0xfa, 0x58, 0x7b, 0xdf, 0x0f, 0x6c, 0xb5, 0x71, 0x50, 0x2e,
+	0xaa, 0x3a, 0x3b, 0x60, 0x62, 0x33, 0xa1, 0xfd, 0x7f, 0xa1, 0xdc, 0x97, 0x4c, 0x0e, 0xb0, 0x36,
+	0x03, 0x60, 0xb2, 0x01, 0x5d, 0xa5, 0xed, 0x7c, 0xab, 0x41, 0xe3, 0x65, 0x48, 0xe2, 0x81, 0x4b,
+	0xc2, 0x8e, 0x42, 0xfa, 0x00, 0x6a, 0x3e, 0x6e, 0xe5, 0x2d, 0xee, 0x10, 0xf8, 0xe3, 0x8c, 0xdf,
+	0x82, 0xa2, 0x88, 0xf2, 0x7c, 0x5e, 0x99, 0x61, 0x76, 0x18, 0x61, 0x2e, 0x8b, 0x22, 0x1a, 0x3b,
+	0xad, 0x5f, 0xc8, 0xe9, 0xaf, 0x8b, 0xb0, 0xbc, 0xc3, 0x2e, 0xd7, 0xeb, 0x7f, 0xc1, 0x32, 0x17,
+	0x6f, 0x68, 0xec, 0xb1, 0xd0, 0xe7, 0x59, 0xc2, 0xfa, 0xaa, 0x25, 0x0c, 0xb7, 0x81, 0xe2, 0xe6,
+	0x50, 0x2a, 0x15, 0xb3, 0x28, 0x9a, 0x52, 0x54, 0xa5, 0x6f, 0xa0, 0x78, 0xac, 0xf8, 0x11, 0xd4,
+	0x14, 0xa2, 0x0a, 0xb1, 0xb4, 0x58, 0x88, 0x80, 0x36, 0x48, 0x4b, 0x04, 0xb5, 0x95, 0x42, 0x28,
+	0x2f, 0x88, 0x80, 0x36, 0x48, 0x3b, 0x3f, 0x6a, 0x50, 0xdb, 0x15, 0xbd, 0x88, 0xc4, 0x2a, 0x4b,
+	0xfb, 0x60, 0x71, 0x7a, 0x9a, 0x7a, 0x17, 0x4e, 0x55, 0x43, 0x9a, 0x8d, 0x79, 0xbb, 0x09, 0x2b,
+	0x31, 0xeb, 0x74, 0xa7, 0x91, 0x8a, 0x8b, 0x20, 0x2d, 0xa3, 0xdd, 0xee, 0xfb, 0xfd, 0xa2, 0x2f,
+	0xd0, 0x2f, 0xce, 0xe7, 0x1a, 0x18, 0xc7, 0x34, 0xee, 0x5d, 0x4a, 0xc5, 0x1f, 0x41, 0x05, 0xf3,
+	0x9a, 0xac, 0x16, 0xd7, 0xf5, 0x45, 0x12, 0x9b, 0xab, 0xcb, 0x61, 0x6e, 0xe2, 0x99, 0x41, 0x37,
+	0x1e, 0xa0, 0xfb, 0x1a, 0xba, 0x7f, 0x73, 0x06, 0xc4, 0x48, 0x53, 0x51, 0x87, 0x11, 0x76, 0xfe,
+	0x1d, 0x28, 0xfb, 0x5d, 0xc6, 0x83, 0x3c, 0x67, 0x7f, 0x9d, 0x61, 0x28, 0x6d, 0x5c, 0xa5, 0xe5,
+	0xac, 0x41, 0x35, 0xb7, 0xb6, 0x6b, 0x50, 0x6d, 0x86, 0x7d, 0xc2, 0x59, 0x60, 0x15, 0xec, 0x2a,
+	0xe8, 0x2d, 0x91, 0x5a, 0x9a, 0xf3, 0xab, 0x06, 0xa0, 0x8e, 0x04, 0x3a, 0xf5, 0x70, 0xc2, 0xa9,
+	0x7f, 0xce, 0xc0, 0x1e, 0xab, 0xe6, 0x64, 0xee, 0xd6, 0xbf, 0xa1, 0x24, 0x0b, 0x7d, 0x9e, 0x57,
+	0xa8, 0x24, 0x63, 0xc0, 0x5a, 0xae, 0xea, 0x67, 0x6b, 0x2b, 0x2d, 0xe7, 0x21, 0x18, 0x3b, 0x6c,
+	0x56, 0x10, 0x0d, 0x80, 0x67, 0xa2, 0xc3, 0x7c, 0xc2, 0xb7, 0xc3, 0xc0, 0xd2, 0xec, 0x25, 0x30,
+	0x73, 0xfe, 0x30, 0xb6, 0x8a, 0xce, 0x37, 0x1a, 0x18, 0xad, 0x8c, 0xf3, 0x4b, 0x29, 0xfa, 0xbd,
+	0x89, 0xe1, 0xe4, 0xcc, 0x30, 0x1b, 0x6e, 0x84, 0x84, 0x4a, 0x8a, 0xf3, 0x1f, 0xa8, 0x28, 0x6e,
+	0xda, 0x6d, 0x80, 0x4a, 0x33, 0x91, 0x0b, 0xca, 0xe5, 0x66, 0xd2, 0x12, 0x29, 0xb2, 0x45, 0xe7,
+	0x67, 0x0d, 0x96, 0x54, 0xac, 0xdb, 0x31, 0x4b, 0xbb, 0x87, 0xd1, 0x1f, 0xf6, 0xfb, 0x31, 0x18,
+	0x44, 0x42, 0x79, 0x23, 0xef, 0x6f, 0xcc, 0x30, 0xce, 0x77, 0xc3, 0xf3, 0x52, 0x25, 0xf9, 0xd6,
+	0x7b, 0xb0, 0xa4, 0x8e, 0xaa, 0x88, 0x68, 0x4c, 0xc2, 0x60, 0xd1, 0x61, 0x5b, 0x47, 0xab, 0x43,
+	0x65, 0xe4, 0x7c, 0xa9, 0x0d, 0x67, 0x2e, 0x6e, 0x82, 0xc5, 0x18, 0x76, 0x8b, 0x76, 0xa1, 0x6e,
+	0x29, 0x2e, 0xd2, 0x2d, 0xf6, 0xe6, 0xc4, 0x54, 0x38, 0x2f, 0x54, 0x59, 0xa4, 0x1f, 0x8a, 0x70,
+	0x75, 0x2a, 0xe5, 0x4f, 0xfb, 0x84, 0x5f, 0xde, 0xf5, 0xf0, 0x67, 0xe7, 0x3f, 0x9f, 0x92, 0xa5,
+	0x0b, 0xdd, 0xaa, 0xe5, 0x0b, 0xdd, 0xaa, 0xdf, 0x55, 0xa0, 0x84, 0xb9, 0x7a, 0x02, 0x66, 0x4a,
+	0xe3, 0x9e, 0x47, 0xdf, 0x46, 0x71, 0x9e, 0xa9, 0x6b, 0x33, 0x30, 0x86, 0x83, 0x58, 0x3e, 0x3e,
+	0xd3, 0x9c, 0xb6, 0xff, 0x0f, 0x90, 0xc9, 0x22, 0x28, 0x63, 0x55, 0xea, 0xbf, 0x9d, 0x35, 0x15,
+	0xe5, 0xd3, 0x34, 0x1b, 0x32, 0xf2, 0xc6, 0x6b, 0xb3, 0xb1, 0xbd, 0x3e, 0xb7, 0x4c, 0xe3, 0x01,
+	0x76, 0x50, 0x70, 0xa1, 0x3d, 0xe2, 0xec, 0x5d, 0xa8, 0xfb, 0xea, 0xc2, 0x53, 0x10, 0xea, 0xda,
+	0xbd, 0x31, 0xb3, 0xd2, 0xa3, 0x7b, 0xf1, 0xa0, 0xe0, 0xd6, 0xfc, 0x31, 0x6b, 0x3f, 0x07, 0x4b,
+	0x45, 0x11, 0xcb, 0x06, 0x52, 0x40, 0x2a, 0x99, 0x7f, 0x9f, 0x17, 0xcb, 0xa8, 0xd5, 0x0e, 0x0a,
+	0x6e, 0x23, 0x9b, 0x92, 0xd8, 0x47, 0xb0, 0xd2, 0x66, 0xef, 0xe3, 0x55, 0x10, 0xcf, 0x99, 0x1b,
+	0xdb, 0x24, 0xe0, 0x72, 0x7b, 0x5a, 0x64, 0xa7, 0xb0, 0x96, 0x23, 0x0e, 0xbb, 0xd2, 0xa3, 0x7d,
+	0xc2, 0x27, 0xf1, 0xab, 0x88, 0x7f, 0x67, 0x2e, 0xfe, 0xac, 0x63, 0x72, 0x50, 0x70, 0xaf, 0xb6,
+	0xe7, 0x1f, 0xa2, 0x71, 0x1c, 0x6a, 0x57, 0xdc, 0xc7, 0x38, 0x27, 0x8e, 0xd1, 0xb8, 0x18, 0xc7,
+	0x31, 0x12, 0xc9, 0x76, 0xc1, 0xe6, 0x53, 0x50, 0xe6, 0xdc, 0x76, 0x19, 0xbd, 0x73, 0x65, 0xbb,
+	0xf4, 0x87, 0x8c, 0x6c, 0x97, 0xfc, 0x54, 0xa3, 0x3d, 0x9c, 0x73, 0xaa, 0x87, 0xed, 0xe2, 0x8f,
+	0x38, 0xd9, 0xeb, 0x61, 0xc6, 0xb9, 0xb2, 0xaf, 0xcd, 0xed, 0xf5, 0xe1, 0xb5, 0x20, 0x7b, 0x3d,
+	0xcc, 0xe9, 0x9d, 0x0a, 0x94, 0xa4, 0x99, 0xf3, 0x9b, 0x06, 0x70, 0x42, 0xfd, 0x54, 0xc4, 0xdb,
+	0xad, 0xd6, 0x8b, 0xfc, 0xd1, 0xaf, 0x22, 0x5d, 0xd5, 0x86, 0x8f, 0x7e, 0x95, 0x8c, 0xa9, 0xef,
+	0x48, 0x71, 0xfa, 0x3b, 0xf2, 0x08, 0x20, 0x8a, 0x69, 0xc0, 0x7c, 0x92, 0xd2, 0xe4, 0xbc, 0x3b,
+	0x75, 0x42, 0xd5, 0xfe, 0x1f, 0xc0, 0x6b, 0xf9, 0x8f, 0x53, 0xa3, 0xad, 0x34, 0x37, 0x89, 0xa3,
+	0xcf, 0x9e, 0x6b, 0xbe, 0x1e, 0x92, 0xf2, 0x39, 0x1b, 0x71, 0xe2, 0xd3, 0xae, 0xe0, 0x01, 0x8d,
+	0xbd, 0x94, 0x74, 0xb0, 0xd3, 0x4d, 0xb7, 0x31, 0x21, 0x3e, 0x26, 0x1d, 0xe7, 0x27, 0x0d, 0x8c,
+	0x23, 0x4e, 0xc2, 0x96, 0x08, 0xf0, 0x65, 0xda, 0xc7, 0x88, 0x3d, 0x12, 0x86, 0xc9, 0x19, 0xe3,
+	0x74, 0x9c, 0x17, 0x99, 0x78, 0x65, 0xb3, 0x1d, 0x86, 0x89, 0xfd, 0x78, 0x2a, 0xda, 0xb3, 0xef,
+	0x04, 0x69, 0x3a, 0x11, 0xef, 0x06, 0x58, 0x22, 0x4b, 0xa3, 0x2c, 0x1d, 0xfd, 0x14, 0x65, 0xba,
+	0x74, 0xf9, 0x55, 0x54, 0xf2, 0xfc, 0xa7, 0x98, 0xd8, 0x7f, 0x81, 0x32, 0x67, 0x3d, 0x96, 0x62,
+	0x52, 0x74, 0x57, 0x31, 0xb2, 0x6e, 0xa1, 0x08, 0xe8, 0xed, 0xef, 0x35, 0xa8, 0xa8, 0xb1, 0x39,
+	0x7d, 0xb1, 0x2f, 0x43, 0x6d, 0x3f, 0xa6, 0x24, 0xa5, 0xf1, 0x71, 0x97, 0x84, 0x96, 0x66, 0x5b,
+	0x50, 0xcf, 0x05, 0x4f, 0x5f, 0x67, 0x84, 0x5b, 0x45, 0xbb, 0x0e, 0xc6, 0x33, 0x9a, 0x24, 0xb8,
+	0xae, 0xe3, 0x83, 0x85, 0x26, 0x89, 0x5a, 0x2c, 0xd9, 0x26, 0x94, 0x15, 0x59, 0x96, 0x7a, 0x2d,
+	0x91, 0x2a, 0xae, 0x22, 0x81, 0x8f, 0x62, 0x7a, 0xca, 0xde, 0x3e, 0x27, 0xa9, 0xdf, 0xb5, 0xaa,
+	0x12, 0xf8, 0x48, 0x24, 0xe9, 0x48, 0x62, 0x48, 0x5b, 0x45, 0x9a, 0x92, 0xc4, 0xa3, 0x67, 0x81,
+	0x5d, 0x81, 0x62, 0x33, 0xb4, 0x6a, 0x52, 0xd4, 0x12, 0x69, 0x33, 0xb4, 0xea, 0xb7, 0xf7, 0xa1,
+	0x36, 0x71, 0xdb, 0xc8, 0x00, 0x5e, 0x86, 0xaf, 0x42, 0xf1, 0x26, 0x54, 0xaf, 0xc2, 0xed, 0x40,
+	0xbe, 0xa4, 0xaa, 0xa0, 0xbf, 0xc8, 0xda, 0x56, 0x51, 0x12, 0xcf, 0x33, 0x6e, 0xe9, 0x92, 0xd8,
+	0x63, 0x7d, 0xab, 0x84, 0x12, 0x11, 0x58, 0xe5, 0x9d, 0xfb, 0x9f, 0xdd, 0xed, 0xb0, 0xb4, 0x9b,
+	0xb5, 0x37, 0x7d, 0xd1, 0xdb, 0x52, 0x05, 0xb8, 0xc3, 0x44, 0x4e, 0x6d, 0xb1, 0x30, 0xa5, 0x71,
+	0x48, 0xf8, 0x16, 0xd6, 0x64, 0x4b, 0xd6, 0x24, 0x6a, 0xb7, 0x2b, 0xc8, 0xdd, 0xff, 0x7d, 0x00,
+	0xad, 0x0f, 0xc6, 0x2f, 0x05, 0x11, 0x00, 0x00,
 }
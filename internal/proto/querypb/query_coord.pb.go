@@ -1284,22 +1284,23 @@ func (m *WatchDmChannelsRequest) GetSegmentInfos() map[int64]*datapb.SegmentInfo
 }
 
 type SegmentLoadInfo struct {
-	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	PartitionID          int64                 `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	CollectionID         int64                 `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	DbID                 int64                 `protobuf:"varint,4,opt,name=dbID,proto3" json:"dbID,omitempty"`
-	FlushTime            int64                 `protobuf:"varint,5,opt,name=flush_time,json=flushTime,proto3" json:"flush_time,omitempty"`
-	BinlogPaths          []*datapb.FieldBinlog `protobuf:"bytes,6,rep,name=binlog_paths,json=binlogPaths,proto3" json:"binlog_paths,omitempty"`
-	NumOfRows            int64                 `protobuf:"varint,7,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	Statslogs            []*datapb.FieldBinlog `protobuf:"bytes,8,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
-	Deltalogs            []*datapb.FieldBinlog `protobuf:"bytes,9,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	CompactionFrom       []int64               `protobuf:"varint,10,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
-	IndexInfos           []*FieldIndexInfo     `protobuf:"bytes,11,rep,name=index_infos,json=indexInfos,proto3" json:"index_infos,omitempty"`
-	SegmentSize          int64                 `protobuf:"varint,12,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
-	InsertChannel        string                `protobuf:"bytes,13,opt,name=insert_channel,json=insertChannel,proto3" json:"insert_channel,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	SegmentID            int64                      `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	PartitionID          int64                      `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	CollectionID         int64                      `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	DbID                 int64                      `protobuf:"varint,4,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	FlushTime            int64                      `protobuf:"varint,5,opt,name=flush_time,json=flushTime,proto3" json:"flush_time,omitempty"`
+	BinlogPaths          []*datapb.FieldBinlog      `protobuf:"bytes,6,rep,name=binlog_paths,json=binlogPaths,proto3" json:"binlog_paths,omitempty"`
+	NumOfRows            int64                      `protobuf:"varint,7,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	Statslogs            []*datapb.FieldBinlog      `protobuf:"bytes,8,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
+	Deltalogs            []*datapb.FieldBinlog      `protobuf:"bytes,9,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	CompactionFrom       []int64                    `protobuf:"varint,10,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
+	IndexInfos           []*FieldIndexInfo          `protobuf:"bytes,11,rep,name=index_infos,json=indexInfos,proto3" json:"index_infos,omitempty"`
+	SegmentSize          int64                      `protobuf:"varint,12,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
+	InsertChannel        string                     `protobuf:"bytes,13,opt,name=insert_channel,json=insertChannel,proto3" json:"insert_channel,omitempty"`
+	ClusteringKeyRange   *datapb.ClusteringKeyRange `protobuf:"bytes,14,opt,name=clustering_key_range,json=clusteringKeyRange,proto3" json:"clustering_key_range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
 func (m *SegmentLoadInfo) Reset()         { *m = SegmentLoadInfo{} }
@@ -1418,6 +1419,13 @@ func (m *SegmentLoadInfo) GetInsertChannel() string {
 	return ""
 }
 
+func (m *SegmentLoadInfo) GetClusteringKeyRange() *datapb.ClusteringKeyRange {
+	if m != nil {
+		return m.ClusteringKeyRange
+	}
+	return nil
+}
+
 type FieldIndexInfo struct {
 	FieldID              int64                    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	EnableIndex          bool                     `protobuf:"varint,2,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
@@ -2091,6 +2099,117 @@ func (m *LoadBalanceRequest) GetCollectionID() int64 {
 	return 0
 }
 
+type AlterShardsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	ChannelNames         []string          `protobuf:"bytes,3,rep,name=channel_names,json=channelNames,proto3" json:"channel_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AlterShardsRequest) Reset()         { *m = AlterShardsRequest{} }
+func (m *AlterShardsRequest) String() string { return proto.CompactTextString(m) }
+func (*AlterShardsRequest) ProtoMessage()    {}
+func (*AlterShardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{28}
+}
+
+func (m *AlterShardsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlterShardsRequest.Unmarshal(m, b)
+}
+func (m *AlterShardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlterShardsRequest.Marshal(b, m, deterministic)
+}
+func (m *AlterShardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterShardsRequest.Merge(m, src)
+}
+func (m *AlterShardsRequest) XXX_Size() int {
+	return xxx_messageInfo_AlterShardsRequest.Size(m)
+}
+func (m *AlterShardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterShardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterShardsRequest proto.InternalMessageInfo
+
+func (m *AlterShardsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *AlterShardsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *AlterShardsRequest) GetChannelNames() []string {
+	if m != nil {
+		return m.ChannelNames
+	}
+	return nil
+}
+
+type TruncateSegmentsRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// the sealed segments dropped by truncation, which are released from the querynodes
+	SegmentIDs           []int64  `protobuf:"varint,3,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TruncateSegmentsRequest) Reset()         { *m = TruncateSegmentsRequest{} }
+func (m *TruncateSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*TruncateSegmentsRequest) ProtoMessage()    {}
+func (*TruncateSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{29}
+}
+
+func (m *TruncateSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TruncateSegmentsRequest.Unmarshal(m, b)
+}
+func (m *TruncateSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TruncateSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *TruncateSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncateSegmentsRequest.Merge(m, src)
+}
+func (m *TruncateSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_TruncateSegmentsRequest.Size(m)
+}
+func (m *TruncateSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncateSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TruncateSegmentsRequest proto.InternalMessageInfo
+
+func (m *TruncateSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *TruncateSegmentsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *TruncateSegmentsRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
 type DmChannelWatchInfo struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	DmChannel            string   `protobuf:"bytes,2,opt,name=dmChannel,proto3" json:"dmChannel,omitempty"`
@@ -2106,7 +2225,7 @@ func (m *DmChannelWatchInfo) Reset()         { *m = DmChannelWatchInfo{} }
 func (m *DmChannelWatchInfo) String() string { return proto.CompactTextString(m) }
 func (*DmChannelWatchInfo) ProtoMessage()    {}
 func (*DmChannelWatchInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{28}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{30}
 }
 
 func (m *DmChannelWatchInfo) XXX_Unmarshal(b []byte) error {
@@ -2177,7 +2296,7 @@ func (m *QueryChannelInfo) Reset()         { *m = QueryChannelInfo{} }
 func (m *QueryChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*QueryChannelInfo) ProtoMessage()    {}
 func (*QueryChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{29}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{31}
 }
 
 func (m *QueryChannelInfo) XXX_Unmarshal(b []byte) error {
@@ -2246,7 +2365,7 @@ func (m *PartitionStates) Reset()         { *m = PartitionStates{} }
 func (m *PartitionStates) String() string { return proto.CompactTextString(m) }
 func (*PartitionStates) ProtoMessage()    {}
 func (*PartitionStates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{30}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{32}
 }
 
 func (m *PartitionStates) XXX_Unmarshal(b []byte) error {
@@ -2293,28 +2412,30 @@ type SegmentInfo struct {
 	CollectionID int64 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionID  int64 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
 	// deprecated, check node_ids(NodeIds) field
-	NodeID               int64                 `protobuf:"varint,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
-	MemSize              int64                 `protobuf:"varint,5,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
-	NumRows              int64                 `protobuf:"varint,6,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	IndexName            string                `protobuf:"bytes,7,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	IndexID              int64                 `protobuf:"varint,8,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	DmChannel            string                `protobuf:"bytes,9,opt,name=dmChannel,proto3" json:"dmChannel,omitempty"`
-	CompactionFrom       []int64               `protobuf:"varint,10,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
-	CreatedByCompaction  bool                  `protobuf:"varint,11,opt,name=createdByCompaction,proto3" json:"createdByCompaction,omitempty"`
-	SegmentState         commonpb.SegmentState `protobuf:"varint,12,opt,name=segment_state,json=segmentState,proto3,enum=milvus.proto.common.SegmentState" json:"segment_state,omitempty"`
-	IndexInfos           []*FieldIndexInfo     `protobuf:"bytes,13,rep,name=index_infos,json=indexInfos,proto3" json:"index_infos,omitempty"`
-	ReplicaIds           []int64               `protobuf:"varint,14,rep,packed,name=replica_ids,json=replicaIds,proto3" json:"replica_ids,omitempty"`
-	NodeIds              []int64               `protobuf:"varint,15,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	NodeID              int64                 `protobuf:"varint,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	MemSize             int64                 `protobuf:"varint,5,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
+	NumRows             int64                 `protobuf:"varint,6,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	IndexName           string                `protobuf:"bytes,7,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID             int64                 `protobuf:"varint,8,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	DmChannel           string                `protobuf:"bytes,9,opt,name=dmChannel,proto3" json:"dmChannel,omitempty"`
+	CompactionFrom      []int64               `protobuf:"varint,10,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
+	CreatedByCompaction bool                  `protobuf:"varint,11,opt,name=createdByCompaction,proto3" json:"createdByCompaction,omitempty"`
+	SegmentState        commonpb.SegmentState `protobuf:"varint,12,opt,name=segment_state,json=segmentState,proto3,enum=milvus.proto.common.SegmentState" json:"segment_state,omitempty"`
+	IndexInfos          []*FieldIndexInfo     `protobuf:"bytes,13,rep,name=index_infos,json=indexInfos,proto3" json:"index_infos,omitempty"`
+	ReplicaIds          []int64               `protobuf:"varint,14,rep,packed,name=replica_ids,json=replicaIds,proto3" json:"replica_ids,omitempty"`
+	NodeIds             []int64               `protobuf:"varint,15,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	// segments produced by the same clustering compaction, which are handed off together
+	CompactionSiblings   []int64  `protobuf:"varint,16,rep,packed,name=compaction_siblings,json=compactionSiblings,proto3" json:"compaction_siblings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SegmentInfo) Reset()         { *m = SegmentInfo{} }
 func (m *SegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentInfo) ProtoMessage()    {}
 func (*SegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{31}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{33}
 }
 
 func (m *SegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -2440,6 +2561,13 @@ func (m *SegmentInfo) GetNodeIds() []int64 {
 	return nil
 }
 
+func (m *SegmentInfo) GetCompactionSiblings() []int64 {
+	if m != nil {
+		return m.CompactionSiblings
+	}
+	return nil
+}
+
 type CollectionInfo struct {
 	CollectionID         int64                      `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionIDs         []int64                    `protobuf:"varint,2,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
@@ -2459,7 +2587,7 @@ func (m *CollectionInfo) Reset()         { *m = CollectionInfo{} }
 func (m *CollectionInfo) String() string { return proto.CompactTextString(m) }
 func (*CollectionInfo) ProtoMessage()    {}
 func (*CollectionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{32}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{34}
 }
 
 func (m *CollectionInfo) XXX_Unmarshal(b []byte) error {
@@ -2555,7 +2683,7 @@ func (m *UnsubscribeChannels) Reset()         { *m = UnsubscribeChannels{} }
 func (m *UnsubscribeChannels) String() string { return proto.CompactTextString(m) }
 func (*UnsubscribeChannels) ProtoMessage()    {}
 func (*UnsubscribeChannels) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{33}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{35}
 }
 
 func (m *UnsubscribeChannels) XXX_Unmarshal(b []byte) error {
@@ -2602,7 +2730,7 @@ func (m *UnsubscribeChannelInfo) Reset()         { *m = UnsubscribeChannelInfo{}
 func (m *UnsubscribeChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*UnsubscribeChannelInfo) ProtoMessage()    {}
 func (*UnsubscribeChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{34}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{36}
 }
 
 func (m *UnsubscribeChannelInfo) XXX_Unmarshal(b []byte) error {
@@ -2652,7 +2780,7 @@ func (m *SegmentChangeInfo) Reset()         { *m = SegmentChangeInfo{} }
 func (m *SegmentChangeInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentChangeInfo) ProtoMessage()    {}
 func (*SegmentChangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{35}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{37}
 }
 
 func (m *SegmentChangeInfo) XXX_Unmarshal(b []byte) error {
@@ -2713,7 +2841,7 @@ func (m *SealedSegmentsChangeInfo) Reset()         { *m = SealedSegmentsChangeIn
 func (m *SealedSegmentsChangeInfo) String() string { return proto.CompactTextString(m) }
 func (*SealedSegmentsChangeInfo) ProtoMessage()    {}
 func (*SealedSegmentsChangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{36}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{38}
 }
 
 func (m *SealedSegmentsChangeInfo) XXX_Unmarshal(b []byte) error {
@@ -2782,6 +2910,8 @@ func init() {
 	proto.RegisterType((*ReplicaSegmentsInfo)(nil), "milvus.proto.query.ReplicaSegmentsInfo")
 	proto.RegisterType((*HandoffSegmentsRequest)(nil), "milvus.proto.query.HandoffSegmentsRequest")
 	proto.RegisterType((*LoadBalanceRequest)(nil), "milvus.proto.query.LoadBalanceRequest")
+	proto.RegisterType((*AlterShardsRequest)(nil), "milvus.proto.query.AlterShardsRequest")
+	proto.RegisterType((*TruncateSegmentsRequest)(nil), "milvus.proto.query.TruncateSegmentsRequest")
 	proto.RegisterType((*DmChannelWatchInfo)(nil), "milvus.proto.query.DmChannelWatchInfo")
 	proto.RegisterType((*QueryChannelInfo)(nil), "milvus.proto.query.QueryChannelInfo")
 	proto.RegisterType((*PartitionStates)(nil), "milvus.proto.query.PartitionStates")
@@ -2796,192 +2926,200 @@ func init() {
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 2951 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0x49, 0x6f, 0x1c, 0xc7,
-	0xd5, 0xec, 0xd9, 0xe7, 0xcd, 0xd6, 0x2a, 0x4a, 0xd4, 0x78, 0x3e, 0xc9, 0xa6, 0x5b, 0x96, 0xcd,
-	0x8f, 0xb6, 0x29, 0x99, 0xf2, 0x67, 0xd8, 0x5f, 0x6c, 0x20, 0x12, 0x69, 0xd1, 0x8c, 0x24, 0x9a,
-	0xe9, 0x91, 0x9c, 0x40, 0x30, 0x30, 0xe9, 0x99, 0xae, 0x19, 0x36, 0xd4, 0xcb, 0xa8, 0xab, 0x87,
-	0x32, 0x9d, 0x6b, 0x10, 0x20, 0xdb, 0x21, 0xc8, 0x35, 0x08, 0x72, 0x48, 0x0e, 0x01, 0x62, 0xe4,
-	0x92, 0x4b, 0x80, 0x20, 0xc8, 0x2d, 0xd7, 0x00, 0xf9, 0x01, 0x39, 0xe6, 0x90, 0x5c, 0x73, 0x08,
-	0x72, 0x09, 0x6a, 0xeb, 0x6d, 0xba, 0xc5, 0x11, 0x69, 0x79, 0x01, 0x72, 0xeb, 0x7a, 0xf5, 0xaa,
-	0xde, 0xab, 0xb7, 0xbf, 0xaa, 0x86, 0x33, 0x0f, 0x67, 0xd8, 0x3f, 0x1a, 0x8c, 0x3c, 0xcf, 0x37,
-	0x37, 0xa6, 0xbe, 0x17, 0x78, 0x08, 0x39, 0x96, 0x7d, 0x38, 0x23, 0x7c, 0xb4, 0xc1, 0xe6, 0x7b,
-	0xcd, 0x91, 0xe7, 0x38, 0x9e, 0xcb, 0x61, 0xbd, 0x66, 0x1c, 0xa3, 0xd7, 0xb6, 0xdc, 0x00, 0xfb,
-	0xae, 0x61, 0xcb, 0x59, 0x32, 0x3a, 0xc0, 0x8e, 0x21, 0x46, 0xaa, 0x69, 0x04, 0x46, 0x7c, 0x7f,
-	0xed, 0x3b, 0x0a, 0xac, 0xf4, 0x0f, 0xbc, 0x47, 0x5b, 0x9e, 0x6d, 0xe3, 0x51, 0x60, 0x79, 0x2e,
-	0xd1, 0xf1, 0xc3, 0x19, 0x26, 0x01, 0xba, 0x0a, 0xa5, 0xa1, 0x41, 0x70, 0x57, 0x59, 0x55, 0xd6,
-	0x1a, 0x9b, 0x17, 0x36, 0x12, 0x9c, 0x08, 0x16, 0xee, 0x90, 0xc9, 0x0d, 0x83, 0x60, 0x9d, 0x61,
-	0x22, 0x04, 0x25, 0x73, 0xb8, 0xbb, 0xdd, 0x2d, 0xac, 0x2a, 0x6b, 0x45, 0x9d, 0x7d, 0xa3, 0x17,
-	0xa0, 0x35, 0x0a, 0xf7, 0xde, 0xdd, 0x26, 0xdd, 0xe2, 0x6a, 0x71, 0xad, 0xa8, 0x27, 0x81, 0xda,
-	0x5f, 0x15, 0x38, 0x3f, 0xc7, 0x06, 0x99, 0x7a, 0x2e, 0xc1, 0xe8, 0x1a, 0x54, 0x48, 0x60, 0x04,
-	0x33, 0x22, 0x38, 0xf9, 0x9f, 0x4c, 0x4e, 0xfa, 0x0c, 0x45, 0x17, 0xa8, 0xf3, 0x64, 0x0b, 0x19,
-	0x64, 0xd1, 0x6b, 0x70, 0xd6, 0x72, 0xef, 0x60, 0xc7, 0xf3, 0x8f, 0x06, 0x53, 0xec, 0x8f, 0xb0,
-	0x1b, 0x18, 0x13, 0x2c, 0x79, 0x5c, 0x96, 0x73, 0xfb, 0xd1, 0x14, 0x7a, 0x03, 0xce, 0x73, 0x2d,
-	0x11, 0xec, 0x1f, 0x5a, 0x23, 0x3c, 0x30, 0x0e, 0x0d, 0xcb, 0x36, 0x86, 0x36, 0xee, 0x96, 0x56,
-	0x8b, 0x6b, 0x35, 0xfd, 0x1c, 0x9b, 0xee, 0xf3, 0xd9, 0xeb, 0x72, 0x52, 0xfb, 0xa5, 0x02, 0xe7,
-	0xe8, 0x09, 0xf7, 0x0d, 0x3f, 0xb0, 0x9e, 0x82, 0x9c, 0x35, 0x68, 0xc6, 0xcf, 0xd6, 0x2d, 0xb2,
-	0xb9, 0x04, 0x8c, 0xe2, 0x4c, 0x25, 0x79, 0x2a, 0x93, 0x12, 0x3b, 0x66, 0x02, 0xa6, 0xfd, 0x42,
-	0x18, 0x44, 0x9c, 0xcf, 0xd3, 0x28, 0x22, 0x4d, 0xb3, 0x30, 0x4f, 0xf3, 0x04, 0x6a, 0xd0, 0xfe,
-	0xa6, 0xc0, 0xb9, 0xdb, 0x9e, 0x61, 0x46, 0x06, 0xf3, 0xd9, 0x8b, 0xf3, 0x1d, 0xa8, 0x70, 0xef,
-	0xea, 0x96, 0x18, 0xad, 0xcb, 0x49, 0x5a, 0xc2, 0xf3, 0x22, 0x0e, 0xfb, 0x0c, 0xa0, 0x8b, 0x45,
-	0xe8, 0x32, 0xb4, 0x7d, 0x3c, 0xb5, 0xad, 0x91, 0x31, 0x70, 0x67, 0xce, 0x10, 0xfb, 0xdd, 0xf2,
-	0xaa, 0xb2, 0x56, 0xd6, 0x5b, 0x02, 0xba, 0xc7, 0x80, 0xda, 0x4f, 0x15, 0xe8, 0xea, 0xd8, 0xc6,
-	0x06, 0xc1, 0x9f, 0xe7, 0x61, 0x57, 0xa0, 0xe2, 0x7a, 0x26, 0xde, 0xdd, 0x66, 0x87, 0x2d, 0xea,
-	0x62, 0xa4, 0xfd, 0x4b, 0x81, 0xb3, 0x3b, 0x38, 0xa0, 0x5a, 0xb7, 0x48, 0x60, 0x8d, 0x42, 0xb3,
-	0x7e, 0x07, 0x8a, 0x3e, 0x7e, 0x28, 0x38, 0x7b, 0x39, 0xc9, 0x59, 0x18, 0xa4, 0xb2, 0x56, 0xea,
-	0x74, 0x1d, 0x7a, 0x1e, 0x9a, 0xa6, 0x63, 0x0f, 0x46, 0x07, 0x86, 0xeb, 0x62, 0x9b, 0xdb, 0x4d,
-	0x5d, 0x6f, 0x98, 0x8e, 0xbd, 0x25, 0x40, 0xe8, 0x59, 0x00, 0x82, 0x27, 0x0e, 0x76, 0x83, 0x28,
-	0xae, 0xc4, 0x20, 0x68, 0x1d, 0xce, 0x8c, 0x7d, 0xcf, 0x19, 0x90, 0x03, 0xc3, 0x37, 0x07, 0x36,
-	0x36, 0x4c, 0xec, 0x33, 0xee, 0x6b, 0x7a, 0x87, 0x4e, 0xf4, 0x29, 0xfc, 0x36, 0x03, 0xa3, 0x6b,
-	0x50, 0x26, 0x23, 0x6f, 0x8a, 0x99, 0x0e, 0xda, 0x9b, 0x17, 0x37, 0xe6, 0xe3, 0xee, 0xc6, 0xb6,
-	0x11, 0x18, 0x7d, 0x8a, 0xa4, 0x73, 0x5c, 0xed, 0x07, 0x05, 0x6e, 0x84, 0x5f, 0x70, 0x9f, 0x8e,
-	0x19, 0x6a, 0xf9, 0xd3, 0x31, 0xd4, 0x4a, 0x96, 0xa1, 0xfe, 0x31, 0x32, 0xd4, 0x2f, 0xba, 0x40,
-	0x22, 0x63, 0x2e, 0x27, 0x8c, 0xf9, 0x57, 0x0a, 0x3c, 0xb3, 0x83, 0x83, 0x90, 0x7d, 0x6a, 0x9b,
-	0xf8, 0x0b, 0x1a, 0xa8, 0x3f, 0x51, 0xa0, 0x97, 0xc5, 0xeb, 0x69, 0x82, 0xf5, 0x7d, 0x58, 0x09,
-	0x69, 0x0c, 0x4c, 0x4c, 0x46, 0xbe, 0x35, 0x65, 0x6a, 0x64, 0xee, 0xd7, 0xd8, 0xbc, 0x94, 0xe5,
-	0x16, 0x69, 0x0e, 0xce, 0x85, 0x5b, 0x6c, 0xc7, 0x76, 0xd0, 0x7e, 0xa4, 0xc0, 0x39, 0xea, 0xee,
-	0xc2, 0x3f, 0xdd, 0xb1, 0x77, 0x72, 0xb9, 0x26, 0x3d, 0xbf, 0x30, 0xe7, 0xf9, 0x0b, 0xc8, 0x98,
-	0x55, 0x3e, 0x69, 0x7e, 0x4e, 0x23, 0xbb, 0xff, 0x83, 0xb2, 0xe5, 0x8e, 0x3d, 0x29, 0xaa, 0xe7,
-	0xb2, 0x44, 0x15, 0x27, 0xc6, 0xb1, 0x35, 0x97, 0x73, 0x11, 0x85, 0xa2, 0x53, 0x98, 0x5b, 0xfa,
-	0xd8, 0x85, 0x8c, 0x63, 0xff, 0x50, 0x81, 0xf3, 0x73, 0x04, 0x4f, 0x73, 0xee, 0xb7, 0xa1, 0xc2,
-	0x02, 0xac, 0x3c, 0xf8, 0x0b, 0x99, 0x07, 0x8f, 0x91, 0xbb, 0x6d, 0x91, 0x40, 0x17, 0x6b, 0x34,
-	0x0f, 0xd4, 0xf4, 0x1c, 0x0d, 0xfd, 0x22, 0xec, 0x0f, 0x5c, 0xc3, 0xe1, 0x02, 0xa8, 0xeb, 0x0d,
-	0x01, 0xdb, 0x33, 0x1c, 0x8c, 0x9e, 0x81, 0x1a, 0x75, 0xd9, 0x81, 0x65, 0x4a, 0xf5, 0x57, 0x99,
-	0x0b, 0x9b, 0x04, 0x5d, 0x04, 0x60, 0x53, 0x86, 0x69, 0xfa, 0x3c, 0x2b, 0xd4, 0xf5, 0x3a, 0x85,
-	0x5c, 0xa7, 0x00, 0xed, 0xc7, 0x0a, 0x34, 0x69, 0xcc, 0xbe, 0x83, 0x03, 0x83, 0xea, 0x01, 0xbd,
-	0x05, 0x75, 0xdb, 0x33, 0xcc, 0x41, 0x70, 0x34, 0xe5, 0xa4, 0xda, 0x69, 0x59, 0xf3, 0x23, 0xd0,
-	0x45, 0x77, 0x8f, 0xa6, 0x58, 0xaf, 0xd9, 0xe2, 0x6b, 0x11, 0x79, 0xcf, 0xb9, 0x72, 0x31, 0xc3,
-	0x95, 0xbf, 0x5b, 0x86, 0x95, 0x6f, 0x18, 0xc1, 0xe8, 0x60, 0xdb, 0x91, 0xc9, 0xed, 0xe4, 0x46,
-	0x10, 0xc5, 0xb6, 0x42, 0x3c, 0xb6, 0x7d, 0x6a, 0xb1, 0x33, 0xb4, 0xf3, 0x72, 0x96, 0x9d, 0xd3,
-	0x06, 0x63, 0xe3, 0x03, 0xa1, 0xaa, 0x98, 0x9d, 0xc7, 0x72, 0x50, 0xe5, 0x24, 0x39, 0x68, 0x0b,
-	0x5a, 0xf8, 0xa3, 0x91, 0x3d, 0xa3, 0x3a, 0x67, 0xd4, 0xab, 0x8c, 0xfa, 0xb3, 0x19, 0xd4, 0xe3,
-	0x4e, 0xd6, 0x14, 0x8b, 0x76, 0x05, 0x0f, 0x5c, 0xd5, 0x0e, 0x0e, 0x8c, 0x6e, 0x8d, 0xb1, 0xb1,
-	0x9a, 0xa7, 0x6a, 0x69, 0x1f, 0x5c, 0xdd, 0x74, 0x84, 0x2e, 0x40, 0x5d, 0x64, 0xbc, 0xdd, 0xed,
-	0x6e, 0x9d, 0x89, 0x2f, 0x02, 0x20, 0x03, 0x5a, 0x22, 0x02, 0x09, 0x0e, 0x81, 0x71, 0xf8, 0x76,
-	0x16, 0x81, 0x6c, 0x65, 0xc7, 0x39, 0x27, 0xef, 0xba, 0x81, 0x7f, 0xa4, 0x37, 0x49, 0x0c, 0xd4,
-	0x1b, 0xc0, 0x99, 0x39, 0x14, 0xa4, 0x42, 0xf1, 0x01, 0x3e, 0x62, 0x06, 0x52, 0xd4, 0xe9, 0x27,
-	0x7a, 0x1d, 0xca, 0x87, 0x86, 0x3d, 0xc3, 0xcc, 0x00, 0x8e, 0x97, 0x11, 0x47, 0xfe, 0xff, 0xc2,
-	0x9b, 0x8a, 0xf6, 0x87, 0x12, 0x74, 0xc4, 0x14, 0x95, 0x01, 0xf3, 0x8f, 0x0b, 0x50, 0x0f, 0x23,
-	0xab, 0xa0, 0x12, 0x01, 0xd0, 0x2a, 0x34, 0x62, 0xd6, 0x21, 0x4c, 0x2e, 0x0e, 0x5a, 0xc8, 0xee,
-	0x64, 0x9e, 0x2c, 0xc5, 0xf2, 0xe4, 0x45, 0x80, 0xb1, 0x3d, 0x23, 0x07, 0x83, 0xc0, 0x72, 0xb0,
-	0xc8, 0xd3, 0x75, 0x06, 0xb9, 0x6b, 0x39, 0x18, 0x5d, 0x87, 0xe6, 0xd0, 0x72, 0x6d, 0x6f, 0x32,
-	0x98, 0x1a, 0xc1, 0x01, 0xe9, 0x56, 0x72, 0xed, 0xe1, 0xa6, 0x85, 0x6d, 0xf3, 0x06, 0xc3, 0xd5,
-	0x1b, 0x7c, 0xcd, 0x3e, 0x5d, 0x82, 0x9e, 0x85, 0x86, 0x3b, 0x73, 0x06, 0xde, 0x78, 0xe0, 0x7b,
-	0x8f, 0xa8, 0x45, 0x31, 0x12, 0xee, 0xcc, 0x79, 0x7f, 0xac, 0x7b, 0x8f, 0x68, 0x64, 0xab, 0xd3,
-	0x18, 0x47, 0x6c, 0x6f, 0x42, 0xba, 0xb5, 0x85, 0xf6, 0x8f, 0x16, 0xd0, 0xd5, 0x26, 0xb6, 0x03,
-	0x83, 0xad, 0xae, 0x2f, 0xb6, 0x3a, 0x5c, 0x80, 0x5e, 0x84, 0xf6, 0xc8, 0x73, 0xa6, 0x06, 0x93,
-	0xd0, 0x4d, 0xdf, 0x73, 0x98, 0x39, 0x15, 0xf5, 0x14, 0x14, 0x6d, 0x41, 0xc3, 0x72, 0x4d, 0xfc,
-	0x91, 0xb0, 0xb9, 0x06, 0xa3, 0xa3, 0x65, 0xd9, 0x1c, 0x23, 0xb4, 0x4b, 0x71, 0x99, 0xd6, 0xc1,
-	0x92, 0x9f, 0x84, 0x06, 0x5c, 0x69, 0xba, 0xc4, 0xfa, 0x18, 0x77, 0x9b, 0x5c, 0x8b, 0x02, 0xd6,
-	0xb7, 0x3e, 0xc6, 0xb4, 0x06, 0xb4, 0x5c, 0x82, 0xfd, 0x40, 0x56, 0xe4, 0xdd, 0x16, 0x8b, 0xca,
-	0x2d, 0x0e, 0x15, 0x96, 0xac, 0xfd, 0xa6, 0x00, 0xed, 0x24, 0x21, 0xd4, 0x85, 0xea, 0x98, 0x41,
-	0xa4, 0xf5, 0xc8, 0x21, 0x25, 0x8b, 0x5d, 0xda, 0x1c, 0x0f, 0x18, 0x2f, 0xcc, 0x78, 0x6a, 0x7a,
-	0x83, 0xc3, 0xd8, 0x06, 0xd4, 0x08, 0xf8, 0xf1, 0x58, 0x22, 0x28, 0x32, 0x92, 0x75, 0x06, 0x61,
-	0x69, 0xa0, 0x0b, 0x55, 0x7e, 0x0c, 0x69, 0x3a, 0x72, 0x48, 0x67, 0x86, 0x33, 0x8b, 0x51, 0xe5,
-	0xa6, 0x23, 0x87, 0x68, 0x1b, 0x9a, 0x7c, 0xcb, 0xa9, 0xe1, 0x1b, 0x8e, 0x34, 0x9c, 0xe7, 0x33,
-	0x23, 0xeb, 0x2d, 0x7c, 0xf4, 0x01, 0x75, 0x8e, 0x7d, 0xc3, 0xf2, 0x75, 0x2e, 0xe8, 0x7d, 0xb6,
-	0x0a, 0xad, 0x81, 0xca, 0x77, 0x19, 0x5b, 0x36, 0x16, 0x26, 0x58, 0x65, 0xb9, 0xa6, 0xcd, 0xe0,
-	0x37, 0x2d, 0x1b, 0x73, 0x2b, 0x0b, 0x8f, 0xc0, 0x44, 0x5b, 0xe3, 0x46, 0xc6, 0x20, 0x54, 0xb0,
-	0xda, 0x5f, 0x8a, 0xb0, 0x4c, 0x7d, 0x4d, 0xb8, 0xdd, 0x29, 0x02, 0xff, 0x45, 0x00, 0x93, 0x04,
-	0x83, 0x44, 0xf0, 0xaf, 0x9b, 0x24, 0xd8, 0xe3, 0xf1, 0xff, 0x2d, 0x19, 0xb7, 0x8b, 0xf9, 0xa5,
-	0x5c, 0xca, 0xf7, 0xe7, 0x63, 0xf7, 0x89, 0x1a, 0xdd, 0x4b, 0xd0, 0x22, 0xde, 0xcc, 0x1f, 0xe1,
-	0x41, 0xa2, 0xe8, 0x6e, 0x72, 0xe0, 0x5e, 0x76, 0x7a, 0xaa, 0x64, 0x36, 0xdc, 0xb1, 0xf8, 0x5d,
-	0x3d, 0x5d, 0xfc, 0xae, 0xa5, 0xe3, 0xf7, 0x2d, 0xe8, 0x30, 0xf7, 0x1b, 0x4c, 0x3d, 0xc2, 0x7b,
-	0x17, 0xe1, 0xb5, 0x5a, 0x4e, 0xef, 0x7a, 0x87, 0x4c, 0xf6, 0x05, 0xaa, 0xde, 0x66, 0x4b, 0xe5,
-	0x90, 0x68, 0x3f, 0x29, 0xc0, 0x8a, 0xe8, 0x85, 0x4e, 0xaf, 0xd8, 0xbc, 0x8c, 0x2e, 0xa3, 0x66,
-	0xf1, 0x31, 0xdd, 0x45, 0x69, 0x81, 0x2c, 0x5f, 0xce, 0xc8, 0xf2, 0xc9, 0x0a, 0xbb, 0x32, 0x57,
-	0x61, 0x87, 0xfd, 0x72, 0xf5, 0x09, 0xfa, 0xe5, 0xbf, 0x2b, 0xd0, 0xea, 0x63, 0xc3, 0x1f, 0x1d,
-	0x48, 0x61, 0xbc, 0x11, 0xbf, 0x24, 0x78, 0x21, 0x47, 0xd0, 0x89, 0x25, 0x5f, 0x9e, 0xdb, 0x81,
-	0x7f, 0x28, 0xd0, 0xfc, 0x3a, 0x9d, 0x92, 0x87, 0x7d, 0x33, 0x7e, 0xd8, 0x17, 0x73, 0x0e, 0xab,
-	0xe3, 0xc0, 0xb7, 0xf0, 0x21, 0xfe, 0xd2, 0x1d, 0xf7, 0x4f, 0x0a, 0xf4, 0xfa, 0x47, 0xee, 0x48,
-	0xe7, 0x1e, 0x75, 0x7a, 0xb3, 0xbf, 0x04, 0xad, 0xc3, 0x44, 0x1f, 0x50, 0x60, 0xe1, 0xbf, 0x79,
-	0x18, 0x6f, 0x04, 0x74, 0x50, 0xe5, 0xdd, 0x84, 0x38, 0xac, 0x0c, 0x70, 0x2f, 0x65, 0x71, 0x9d,
-	0x62, 0x8e, 0x05, 0x88, 0x8e, 0x9f, 0x04, 0x6a, 0x3e, 0x2c, 0x67, 0xe0, 0xa1, 0xf3, 0x50, 0x15,
-	0x3d, 0x87, 0x48, 0x64, 0xdc, 0x0f, 0x4d, 0xaa, 0x9d, 0xa8, 0x6b, 0xb6, 0xcc, 0xf9, 0x22, 0xc8,
-	0x44, 0xcf, 0x41, 0x23, 0x2c, 0x0e, 0xcd, 0x39, 0xf5, 0x98, 0x44, 0xfb, 0xbd, 0x02, 0x2b, 0xef,
-	0x19, 0xae, 0xe9, 0x8d, 0xc7, 0xa7, 0x97, 0xdc, 0x16, 0x24, 0xea, 0xc6, 0x45, 0x3b, 0xd2, 0xc4,
-	0x22, 0xf4, 0x32, 0x9c, 0xf1, 0x79, 0x04, 0x33, 0x93, 0xa2, 0x2d, 0xea, 0xaa, 0x9c, 0x08, 0x45,
-	0xf6, 0xeb, 0x02, 0x20, 0x1a, 0x75, 0x6f, 0x18, 0xb6, 0xe1, 0x8e, 0xf0, 0xc9, 0x59, 0xbf, 0x0c,
-	0xed, 0x44, 0xae, 0x08, 0x2f, 0xee, 0xe3, 0xc9, 0x82, 0xa0, 0x5b, 0xd0, 0x1e, 0x72, 0x52, 0x03,
-	0x1f, 0x1b, 0xc4, 0x73, 0x59, 0x10, 0x6c, 0x67, 0x37, 0x9f, 0x77, 0x7d, 0x6b, 0x32, 0xc1, 0xfe,
-	0x96, 0xe7, 0x9a, 0x3c, 0x5a, 0xb7, 0x86, 0x92, 0x4d, 0xba, 0x94, 0x2a, 0x27, 0x4a, 0x9c, 0xb2,
-	0xe9, 0x81, 0x30, 0x73, 0x32, 0x51, 0x10, 0x6c, 0xd8, 0x91, 0x20, 0xa2, 0xa8, 0xa9, 0xf2, 0x89,
-	0x7e, 0xfe, 0xdd, 0x43, 0x46, 0x22, 0xd3, 0x7e, 0xab, 0x00, 0x0a, 0xcb, 0x7f, 0xd6, 0x0c, 0x30,
-	0x0b, 0x4b, 0x2f, 0x55, 0x32, 0x82, 0xf7, 0x05, 0xa8, 0x9b, 0x72, 0xa5, 0xf0, 0x88, 0x08, 0x40,
-	0x7d, 0x86, 0x1f, 0x63, 0x40, 0xb3, 0x1e, 0x36, 0x65, 0xb5, 0xcd, 0x81, 0xb7, 0x19, 0x2c, 0x99,
-	0x07, 0x4b, 0xe9, 0x3c, 0x18, 0x6f, 0xad, 0xcb, 0x89, 0xd6, 0x5a, 0xfb, 0xa4, 0x00, 0x2a, 0x8b,
-	0x68, 0x5b, 0x51, 0x7f, 0xb7, 0x10, 0xd3, 0x97, 0xa0, 0x25, 0x9e, 0xb6, 0x12, 0x8c, 0x37, 0x1f,
-	0xc6, 0x36, 0x43, 0x57, 0xe1, 0x2c, 0x47, 0xf2, 0x31, 0x99, 0xd9, 0x51, 0xa1, 0xc9, 0xab, 0x3e,
-	0xf4, 0x90, 0x87, 0x52, 0x3a, 0x25, 0x57, 0xdc, 0x83, 0x95, 0x89, 0xed, 0x0d, 0x0d, 0x7b, 0x90,
-	0x54, 0x0f, 0xd7, 0xe1, 0x02, 0x16, 0x7f, 0x96, 0x2f, 0xef, 0xc7, 0x75, 0x48, 0xd0, 0x0e, 0xed,
-	0xe4, 0xf0, 0x83, 0xb0, 0x10, 0x10, 0xb7, 0xa6, 0x8b, 0xd4, 0x01, 0x4d, 0xba, 0x50, 0x8e, 0xb4,
-	0x9f, 0x29, 0xd0, 0x49, 0xdd, 0x8e, 0xa5, 0x1b, 0x26, 0x65, 0xbe, 0x61, 0x7a, 0x13, 0xca, 0xb4,
-	0x8b, 0xe0, 0xf1, 0xae, 0x9d, 0x5d, 0xcc, 0x27, 0x77, 0xd5, 0xf9, 0x02, 0x74, 0x05, 0x96, 0x33,
-	0xde, 0x51, 0x84, 0x0d, 0xa0, 0xf9, 0x67, 0x14, 0xed, 0x77, 0x25, 0x68, 0xc4, 0xe4, 0x71, 0x4c,
-	0xaf, 0xb7, 0xc8, 0x75, 0x47, 0xea, 0x78, 0xc5, 0xf9, 0xe3, 0xe5, 0x3c, 0x24, 0x50, 0xbb, 0x73,
-	0xb0, 0xc3, 0xab, 0x64, 0x51, 0xb2, 0x3b, 0xd8, 0x61, 0xcd, 0x07, 0x35, 0xc9, 0x99, 0xc3, 0xbb,
-	0x34, 0xee, 0x4e, 0x55, 0x77, 0xe6, 0xb0, 0x1e, 0x2d, 0xd9, 0x20, 0x54, 0x1f, 0xd3, 0x20, 0xd4,
-	0x92, 0x0d, 0x42, 0xc2, 0x8f, 0xea, 0x69, 0x3f, 0x5a, 0xb4, 0xfd, 0xba, 0x0a, 0xcb, 0x23, 0x1f,
-	0x1b, 0x01, 0x36, 0x6f, 0x1c, 0x6d, 0x85, 0x53, 0xdd, 0x06, 0xcb, 0xab, 0x59, 0x53, 0xe8, 0x66,
-	0x74, 0x4d, 0xc0, 0xb5, 0xdc, 0x64, 0x5a, 0xce, 0xee, 0x3f, 0x84, 0x6e, 0xb8, 0x92, 0x65, 0x78,
-	0x66, 0xa3, 0x74, 0xe3, 0xd7, 0x3a, 0x51, 0xe3, 0xf7, 0x1c, 0x34, 0x64, 0xf6, 0xa4, 0xee, 0xde,
-	0xe6, 0x91, 0x4f, 0xc6, 0x02, 0x93, 0x24, 0x82, 0x41, 0x27, 0x19, 0x0c, 0xfe, 0x5c, 0x84, 0x76,
-	0x54, 0xf2, 0x2f, 0x1c, 0x0a, 0x16, 0x79, 0x0f, 0xdc, 0x03, 0x35, 0x4a, 0xa8, 0x4c, 0x4a, 0x8f,
-	0xed, 0x5a, 0xd2, 0x17, 0xd0, 0x9d, 0x69, 0xca, 0xe7, 0x12, 0x57, 0x7c, 0xa5, 0x27, 0xba, 0xe2,
-	0x3b, 0xe5, 0xd3, 0xc9, 0x35, 0x38, 0x17, 0x26, 0xd1, 0xc4, 0xb1, 0x79, 0x45, 0x7d, 0x56, 0x4e,
-	0xee, 0xc7, 0x8f, 0x9f, 0xe3, 0xc6, 0xd5, 0x3c, 0x37, 0x4e, 0xab, 0xb1, 0x36, 0xa7, 0xc6, 0xf9,
-	0x17, 0x9c, 0x7a, 0xd6, 0x0b, 0xce, 0x3d, 0x58, 0xbe, 0xe7, 0x92, 0xd9, 0x90, 0x8c, 0x7c, 0x6b,
-	0x88, 0xc3, 0xd2, 0x72, 0x11, 0xb5, 0xf6, 0xa0, 0x96, 0xaa, 0x4e, 0xc3, 0xb1, 0xf6, 0x7d, 0x05,
-	0x56, 0xe6, 0xf7, 0x65, 0x16, 0x13, 0x05, 0x03, 0x25, 0x11, 0x0c, 0xbe, 0x09, 0xcb, 0xd1, 0xf6,
-	0xc9, 0xba, 0x37, 0xa7, 0xb2, 0xcb, 0x60, 0x5c, 0x47, 0xd1, 0x1e, 0x12, 0xa6, 0xfd, 0x53, 0x09,
-	0x2f, 0xd1, 0x28, 0x6c, 0xc2, 0xae, 0x06, 0x69, 0x82, 0xf2, 0x5c, 0xdb, 0x72, 0xc3, 0x16, 0x55,
-	0x9c, 0x91, 0x03, 0x45, 0x8b, 0xfa, 0x1e, 0x74, 0x04, 0x52, 0x98, 0x67, 0x16, 0xac, 0xac, 0xda,
-	0x7c, 0x5d, 0x98, 0x61, 0x2e, 0x43, 0xdb, 0x1b, 0x8f, 0xe3, 0xf4, 0x78, 0xa0, 0x6c, 0x09, 0xa8,
-	0x20, 0xf8, 0x35, 0x50, 0x25, 0xda, 0x93, 0x66, 0xb6, 0x8e, 0x58, 0x18, 0x56, 0x68, 0xdf, 0x53,
-	0xa0, 0x9b, 0xcc, 0x73, 0xb1, 0xe3, 0x3f, 0x79, 0x9d, 0xf6, 0x95, 0xe4, 0x6b, 0xc7, 0xe5, 0xc7,
-	0xf0, 0x13, 0xd1, 0x11, 0xf7, 0x09, 0xeb, 0x5f, 0x85, 0x7a, 0xd8, 0x3e, 0xa0, 0x06, 0x54, 0xef,
-	0xb9, 0xb7, 0x5c, 0xef, 0x91, 0xab, 0x2e, 0xa1, 0x2a, 0x14, 0xaf, 0xdb, 0xb6, 0xaa, 0xa0, 0x16,
-	0xd4, 0xfb, 0x81, 0x8f, 0x0d, 0xc7, 0x72, 0x27, 0x6a, 0x01, 0xb5, 0x01, 0xde, 0xb3, 0x48, 0xe0,
-	0xf9, 0xd6, 0xc8, 0xb0, 0xd5, 0xe2, 0xfa, 0xc7, 0xd0, 0x4e, 0x7a, 0x3d, 0x6a, 0x42, 0x6d, 0xcf,
-	0x0b, 0xde, 0xfd, 0xc8, 0x22, 0x81, 0xba, 0x44, 0xf1, 0xf7, 0xbc, 0x60, 0xdf, 0xc7, 0x04, 0xbb,
-	0x81, 0xaa, 0x20, 0x80, 0xca, 0xfb, 0xee, 0xb6, 0x45, 0x1e, 0xa8, 0x05, 0xb4, 0x2c, 0x92, 0xb2,
-	0x61, 0xef, 0x0a, 0x57, 0x52, 0x8b, 0x74, 0x79, 0x38, 0x2a, 0x21, 0x15, 0x9a, 0x21, 0xca, 0xce,
-	0xfe, 0x3d, 0xb5, 0x8c, 0xea, 0x50, 0xe6, 0x9f, 0x95, 0x75, 0x13, 0xd4, 0x74, 0x45, 0x49, 0xf7,
-	0xe4, 0x87, 0x08, 0x41, 0xea, 0x12, 0x3d, 0x99, 0x28, 0xe9, 0x55, 0x05, 0x75, 0xa0, 0x11, 0x2b,
-	0x90, 0xd5, 0x02, 0x05, 0xec, 0xf8, 0xd3, 0x91, 0x28, 0x95, 0x39, 0x0b, 0x54, 0xef, 0xdb, 0x54,
-	0x12, 0xa5, 0xf5, 0x1b, 0x50, 0x93, 0xe1, 0x88, 0xa2, 0x0a, 0x11, 0xd1, 0xa1, 0xba, 0x84, 0xce,
-	0x40, 0x2b, 0xf1, 0xee, 0xac, 0x2a, 0x08, 0x41, 0x3b, 0xf9, 0x3f, 0x84, 0x5a, 0xd8, 0xfc, 0x79,
-	0x13, 0x80, 0xd7, 0x6b, 0x9e, 0xe7, 0x9b, 0x68, 0x0a, 0x68, 0x07, 0x07, 0x34, 0x17, 0x79, 0xae,
-	0xcc, 0x23, 0x04, 0x5d, 0xcd, 0x7f, 0x9a, 0x4f, 0xa1, 0x0a, 0x56, 0x7b, 0x79, 0xad, 0x6b, 0x0a,
-	0x5d, 0x5b, 0x42, 0x0e, 0xa3, 0x78, 0xd7, 0x72, 0xf0, 0x5d, 0x6b, 0xf4, 0x20, 0x2c, 0xf4, 0xf2,
-	0x29, 0xa6, 0x50, 0x25, 0xc5, 0x54, 0xd8, 0x17, 0x83, 0x7e, 0xe0, 0x5b, 0xee, 0x44, 0xbe, 0x5e,
-	0x69, 0x4b, 0xe8, 0x61, 0xea, 0x57, 0x04, 0x49, 0x70, 0x73, 0x91, 0xbf, 0x0f, 0x4e, 0x46, 0xd2,
-	0x86, 0x4e, 0xea, 0xbf, 0x25, 0xb4, 0x9e, 0xfd, 0x00, 0x96, 0xf5, 0x8f, 0x55, 0xef, 0xe5, 0x85,
-	0x70, 0x43, 0x6a, 0x16, 0xb4, 0x93, 0xff, 0xe6, 0xa0, 0xff, 0xcd, 0xdb, 0x60, 0xee, 0x09, 0xbe,
-	0xb7, 0xbe, 0x08, 0x6a, 0x48, 0xea, 0x3e, 0xb7, 0xa7, 0xe3, 0x48, 0x65, 0xfe, 0xfe, 0xd0, 0x7b,
-	0xdc, 0xc3, 0xa1, 0xb6, 0x84, 0xbe, 0x05, 0x67, 0xe6, 0x7e, 0x14, 0x40, 0xaf, 0x64, 0xf7, 0xeb,
-	0xd9, 0xff, 0x13, 0x1c, 0x47, 0xe1, 0x7e, 0xda, 0x1b, 0xf2, 0xb9, 0x9f, 0xfb, 0xa9, 0x66, 0x71,
-	0xee, 0x63, 0xdb, 0x3f, 0x8e, 0xfb, 0x27, 0xa6, 0x30, 0x63, 0x6e, 0x93, 0xee, 0x1c, 0x5e, 0xcd,
-	0x22, 0x91, 0xfb, 0xb7, 0x42, 0x6f, 0x63, 0x51, 0xf4, 0xb8, 0x75, 0x25, 0x1f, 0xc4, 0xb3, 0x85,
-	0x96, 0xf9, 0x88, 0x9f, 0x6d, 0x5d, 0xd9, 0xef, 0xeb, 0xda, 0x12, 0xba, 0x9b, 0x88, 0x86, 0xe8,
-	0xc5, 0x3c, 0xe5, 0x24, 0xef, 0x13, 0x8e, 0x93, 0xdb, 0x00, 0x60, 0x07, 0x07, 0x77, 0x70, 0xe0,
-	0x5b, 0x23, 0x92, 0xde, 0x54, 0x0c, 0x22, 0x04, 0xb9, 0xe9, 0x4b, 0xc7, 0xe2, 0x85, 0x6c, 0x0f,
-	0xa1, 0xb1, 0x83, 0x03, 0x71, 0x39, 0x44, 0x50, 0xee, 0x4a, 0x89, 0x21, 0x49, 0xac, 0x1d, 0x8f,
-	0x18, 0x8f, 0x28, 0xa9, 0xf7, 0x79, 0x94, 0x2b, 0xdb, 0xf9, 0xbf, 0x06, 0xb2, 0x23, 0x4a, 0xce,
-	0x83, 0xbf, 0xb6, 0xb4, 0xf9, 0xef, 0x06, 0xd4, 0x59, 0x8a, 0xa0, 0xa9, 0xe7, 0xbf, 0x19, 0xe2,
-	0x29, 0x64, 0x88, 0x0f, 0xa1, 0x93, 0x7a, 0xee, 0xcd, 0xd6, 0x67, 0xf6, 0x9b, 0xf0, 0x71, 0x26,
-	0xff, 0x01, 0xff, 0x9b, 0x21, 0xac, 0x2c, 0x5f, 0xca, 0xf3, 0xa4, 0xd4, 0xad, 0xe2, 0xe7, 0x1f,
-	0xe4, 0x9e, 0x7e, 0x12, 0xf8, 0x10, 0x3a, 0xa9, 0x37, 0x98, 0x6c, 0xc9, 0x67, 0x3f, 0xd4, 0x1c,
-	0xb7, 0xfb, 0x67, 0x18, 0x2d, 0x4d, 0x58, 0xce, 0xb8, 0x59, 0x47, 0x99, 0x11, 0x3e, 0xff, 0x0a,
-	0xfe, 0xf8, 0x03, 0xb5, 0x12, 0xe6, 0x8e, 0xd6, 0xf2, 0x98, 0x4c, 0xff, 0xb1, 0xd9, 0x7b, 0x65,
-	0xb1, 0xdf, 0x3b, 0xc3, 0x03, 0xf5, 0xa1, 0xc2, 0x1f, 0x75, 0xd0, 0xf3, 0xd9, 0x8d, 0x43, 0xec,
-	0xc1, 0xa7, 0x77, 0xdc, 0xb3, 0x10, 0x99, 0xd9, 0x01, 0x61, 0x9b, 0x96, 0x59, 0x24, 0x43, 0x99,
-	0x6f, 0x82, 0xf1, 0x97, 0x98, 0xde, 0xf1, 0x8f, 0x2f, 0x72, 0xd3, 0x6f, 0x03, 0xe2, 0xe5, 0x98,
-	0x3b, 0xb6, 0x26, 0x33, 0xdf, 0xe0, 0x66, 0x9a, 0x17, 0x9f, 0xe6, 0x51, 0x25, 0xc5, 0xd7, 0x9e,
-	0x60, 0x45, 0x28, 0xa6, 0xa7, 0x9d, 0xcf, 0x6e, 0xbc, 0x7e, 0x7f, 0x73, 0x62, 0x05, 0x07, 0xb3,
-	0x21, 0x35, 0x86, 0x2b, 0x1c, 0xf3, 0x55, 0xcb, 0x13, 0x5f, 0x57, 0x24, 0x97, 0x57, 0xd8, 0x4e,
-	0x57, 0x98, 0x20, 0xa7, 0xc3, 0x61, 0x85, 0x0d, 0xaf, 0xfd, 0x27, 0x00, 0x00, 0xff, 0xff, 0x55,
-	0x43, 0xa4, 0x8c, 0xaf, 0x30, 0x00, 0x00,
+	// 3073 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1a, 0x4d, 0x6c, 0x1c, 0x57,
+	0xd9, 0xb3, 0xeb, 0xb5, 0x77, 0xbf, 0xfd, 0xf1, 0xe4, 0x39, 0x71, 0xb6, 0x4b, 0xd2, 0xba, 0x93,
+	0xa6, 0x35, 0x4e, 0xeb, 0xa4, 0x4e, 0xa9, 0x5a, 0x68, 0x25, 0x12, 0xbb, 0x71, 0x4d, 0x12, 0xd7,
+	0xcc, 0x3a, 0x2d, 0x8a, 0x2a, 0x86, 0xd9, 0x99, 0xe7, 0xf5, 0x28, 0xf3, 0xb3, 0x99, 0x37, 0xeb,
+	0xd4, 0xe5, 0x8a, 0x90, 0xf8, 0x13, 0x42, 0x1c, 0xb8, 0x20, 0x4e, 0x70, 0x40, 0xa2, 0xe2, 0xc2,
+	0x91, 0x03, 0x37, 0xae, 0x48, 0x48, 0x5c, 0x39, 0x22, 0x01, 0x57, 0x0e, 0x88, 0x0b, 0x7a, 0x3f,
+	0xf3, 0xbb, 0x6f, 0xb3, 0x6b, 0xbb, 0x29, 0xa9, 0xc4, 0x6d, 0xe6, 0x7b, 0xdf, 0x7b, 0xdf, 0xf7,
+	0xbe, 0xff, 0xef, 0xbd, 0x07, 0x67, 0x1e, 0x0e, 0x71, 0x78, 0x64, 0x58, 0x41, 0x10, 0xda, 0x6b,
+	0x83, 0x30, 0x88, 0x02, 0x84, 0x3c, 0xc7, 0x3d, 0x1c, 0x12, 0xfe, 0xb7, 0xc6, 0xc6, 0x3b, 0x0d,
+	0x2b, 0xf0, 0xbc, 0xc0, 0xe7, 0xb0, 0x4e, 0x23, 0x8b, 0xd1, 0x69, 0x39, 0x7e, 0x84, 0x43, 0xdf,
+	0x74, 0xe3, 0x51, 0x62, 0x1d, 0x60, 0xcf, 0x14, 0x7f, 0xaa, 0x6d, 0x46, 0x66, 0x76, 0x7d, 0xed,
+	0x3b, 0x0a, 0x2c, 0x75, 0x0f, 0x82, 0x47, 0x1b, 0x81, 0xeb, 0x62, 0x2b, 0x72, 0x02, 0x9f, 0xe8,
+	0xf8, 0xe1, 0x10, 0x93, 0x08, 0x5d, 0x83, 0xd9, 0x9e, 0x49, 0x70, 0x5b, 0x59, 0x56, 0x56, 0xea,
+	0xeb, 0x17, 0xd6, 0x72, 0x9c, 0x08, 0x16, 0xee, 0x92, 0xfe, 0x4d, 0x93, 0x60, 0x9d, 0x61, 0x22,
+	0x04, 0xb3, 0x76, 0x6f, 0x7b, 0xb3, 0x5d, 0x5a, 0x56, 0x56, 0xca, 0x3a, 0xfb, 0x46, 0x2f, 0x40,
+	0xd3, 0x4a, 0xd6, 0xde, 0xde, 0x24, 0xed, 0xf2, 0x72, 0x79, 0xa5, 0xac, 0xe7, 0x81, 0xda, 0x5f,
+	0x15, 0x38, 0x3f, 0xc2, 0x06, 0x19, 0x04, 0x3e, 0xc1, 0xe8, 0x3a, 0xcc, 0x91, 0xc8, 0x8c, 0x86,
+	0x44, 0x70, 0xf2, 0x05, 0x29, 0x27, 0x5d, 0x86, 0xa2, 0x0b, 0xd4, 0x51, 0xb2, 0x25, 0x09, 0x59,
+	0xf4, 0x2a, 0x9c, 0x75, 0xfc, 0xbb, 0xd8, 0x0b, 0xc2, 0x23, 0x63, 0x80, 0x43, 0x0b, 0xfb, 0x91,
+	0xd9, 0xc7, 0x31, 0x8f, 0x8b, 0xf1, 0xd8, 0x6e, 0x3a, 0x84, 0x5e, 0x87, 0xf3, 0x5c, 0x4b, 0x04,
+	0x87, 0x87, 0x8e, 0x85, 0x0d, 0xf3, 0xd0, 0x74, 0x5c, 0xb3, 0xe7, 0xe2, 0xf6, 0xec, 0x72, 0x79,
+	0xa5, 0xaa, 0x9f, 0x63, 0xc3, 0x5d, 0x3e, 0x7a, 0x23, 0x1e, 0xd4, 0x7e, 0xa5, 0xc0, 0x39, 0xba,
+	0xc3, 0x5d, 0x33, 0x8c, 0x9c, 0x27, 0x20, 0x67, 0x0d, 0x1a, 0xd9, 0xbd, 0xb5, 0xcb, 0x6c, 0x2c,
+	0x07, 0xa3, 0x38, 0x83, 0x98, 0x3c, 0x95, 0xc9, 0x2c, 0xdb, 0x66, 0x0e, 0xa6, 0xfd, 0x52, 0x18,
+	0x44, 0x96, 0xcf, 0xd3, 0x28, 0xa2, 0x48, 0xb3, 0x34, 0x4a, 0xf3, 0x04, 0x6a, 0xd0, 0xfe, 0xa6,
+	0xc0, 0xb9, 0x3b, 0x81, 0x69, 0xa7, 0x06, 0xf3, 0xd9, 0x8b, 0xf3, 0x6d, 0x98, 0xe3, 0xde, 0xd5,
+	0x9e, 0x65, 0xb4, 0x2e, 0xe7, 0x69, 0xf1, 0xb1, 0xb5, 0x94, 0xc3, 0x2e, 0x03, 0xe8, 0x62, 0x12,
+	0xba, 0x0c, 0xad, 0x10, 0x0f, 0x5c, 0xc7, 0x32, 0x0d, 0x7f, 0xe8, 0xf5, 0x70, 0xd8, 0xae, 0x2c,
+	0x2b, 0x2b, 0x15, 0xbd, 0x29, 0xa0, 0x3b, 0x0c, 0xa8, 0xfd, 0x5c, 0x81, 0xb6, 0x8e, 0x5d, 0x6c,
+	0x12, 0xfc, 0xbf, 0xdc, 0xec, 0x12, 0xcc, 0xf9, 0x81, 0x8d, 0xb7, 0x37, 0xd9, 0x66, 0xcb, 0xba,
+	0xf8, 0xd3, 0xfe, 0xad, 0xc0, 0xd9, 0x2d, 0x1c, 0x51, 0xad, 0x3b, 0x24, 0x72, 0xac, 0xc4, 0xac,
+	0xdf, 0x86, 0x72, 0x88, 0x1f, 0x0a, 0xce, 0xae, 0xe4, 0x39, 0x4b, 0x82, 0x94, 0x6c, 0xa6, 0x4e,
+	0xe7, 0xa1, 0xe7, 0xa1, 0x61, 0x7b, 0xae, 0x61, 0x1d, 0x98, 0xbe, 0x8f, 0x5d, 0x6e, 0x37, 0x35,
+	0xbd, 0x6e, 0x7b, 0xee, 0x86, 0x00, 0xa1, 0x67, 0x01, 0x08, 0xee, 0x7b, 0xd8, 0x8f, 0xd2, 0xb8,
+	0x92, 0x81, 0xa0, 0x55, 0x38, 0xb3, 0x1f, 0x06, 0x9e, 0x41, 0x0e, 0xcc, 0xd0, 0x36, 0x5c, 0x6c,
+	0xda, 0x38, 0x64, 0xdc, 0x57, 0xf5, 0x05, 0x3a, 0xd0, 0xa5, 0xf0, 0x3b, 0x0c, 0x8c, 0xae, 0x43,
+	0x85, 0x58, 0xc1, 0x00, 0x33, 0x1d, 0xb4, 0xd6, 0x2f, 0xae, 0x8d, 0xc6, 0xdd, 0xb5, 0x4d, 0x33,
+	0x32, 0xbb, 0x14, 0x49, 0xe7, 0xb8, 0xda, 0x0f, 0x4a, 0xdc, 0x08, 0x9f, 0x72, 0x9f, 0xce, 0x18,
+	0x6a, 0xe5, 0xd3, 0x31, 0xd4, 0x39, 0x99, 0xa1, 0xfe, 0x21, 0x35, 0xd4, 0xa7, 0x5d, 0x20, 0xa9,
+	0x31, 0x57, 0x72, 0xc6, 0xfc, 0x6b, 0x05, 0x9e, 0xd9, 0xc2, 0x51, 0xc2, 0x3e, 0xb5, 0x4d, 0xfc,
+	0x94, 0x06, 0xea, 0x4f, 0x14, 0xe8, 0xc8, 0x78, 0x3d, 0x4d, 0xb0, 0xbe, 0x0f, 0x4b, 0x09, 0x0d,
+	0xc3, 0xc6, 0xc4, 0x0a, 0x9d, 0x01, 0xfd, 0xe6, 0xee, 0x57, 0x5f, 0xbf, 0x24, 0x73, 0x8b, 0x22,
+	0x07, 0xe7, 0x92, 0x25, 0x36, 0x33, 0x2b, 0x68, 0x3f, 0x52, 0xe0, 0x1c, 0x75, 0x77, 0xe1, 0x9f,
+	0xfe, 0x7e, 0x70, 0x72, 0xb9, 0xe6, 0x3d, 0xbf, 0x34, 0xe2, 0xf9, 0x53, 0xc8, 0x98, 0x55, 0x3e,
+	0x45, 0x7e, 0x4e, 0x23, 0xbb, 0x2f, 0x41, 0xc5, 0xf1, 0xf7, 0x83, 0x58, 0x54, 0xcf, 0xc9, 0x44,
+	0x95, 0x25, 0xc6, 0xb1, 0x35, 0x9f, 0x73, 0x91, 0x86, 0xa2, 0x53, 0x98, 0x5b, 0x71, 0xdb, 0x25,
+	0xc9, 0xb6, 0x7f, 0xa8, 0xc0, 0xf9, 0x11, 0x82, 0xa7, 0xd9, 0xf7, 0x5b, 0x30, 0xc7, 0x02, 0x6c,
+	0xbc, 0xf1, 0x17, 0xa4, 0x1b, 0xcf, 0x90, 0xbb, 0xe3, 0x90, 0x48, 0x17, 0x73, 0xb4, 0x00, 0xd4,
+	0xe2, 0x18, 0x0d, 0xfd, 0x22, 0xec, 0x1b, 0xbe, 0xe9, 0x71, 0x01, 0xd4, 0xf4, 0xba, 0x80, 0xed,
+	0x98, 0x1e, 0x46, 0xcf, 0x40, 0x95, 0xba, 0xac, 0xe1, 0xd8, 0xb1, 0xfa, 0xe7, 0x99, 0x0b, 0xdb,
+	0x04, 0x5d, 0x04, 0x60, 0x43, 0xa6, 0x6d, 0x87, 0x3c, 0x2b, 0xd4, 0xf4, 0x1a, 0x85, 0xdc, 0xa0,
+	0x00, 0xed, 0x27, 0x0a, 0x34, 0x68, 0xcc, 0xbe, 0x8b, 0x23, 0x93, 0xea, 0x01, 0xbd, 0x09, 0x35,
+	0x37, 0x30, 0x6d, 0x23, 0x3a, 0x1a, 0x70, 0x52, 0xad, 0xf5, 0x0b, 0xb2, 0x2d, 0xd0, 0x49, 0x7b,
+	0x47, 0x03, 0xac, 0x57, 0x5d, 0xf1, 0x35, 0x8d, 0xbc, 0x47, 0x5c, 0xb9, 0x2c, 0x71, 0xe5, 0xef,
+	0x56, 0x60, 0xe9, 0x03, 0x33, 0xb2, 0x0e, 0x36, 0xbd, 0x38, 0xb9, 0x9d, 0xdc, 0x08, 0xd2, 0xd8,
+	0x56, 0xca, 0xc6, 0xb6, 0x4f, 0x2d, 0x76, 0x26, 0x76, 0x5e, 0x91, 0xd9, 0x39, 0x6d, 0x30, 0xd6,
+	0xde, 0x17, 0xaa, 0xca, 0xd8, 0x79, 0x26, 0x07, 0xcd, 0x9d, 0x24, 0x07, 0x6d, 0x40, 0x13, 0x7f,
+	0x64, 0xb9, 0x43, 0xaa, 0x73, 0x46, 0x7d, 0x9e, 0x51, 0x7f, 0x56, 0x42, 0x3d, 0xeb, 0x64, 0x0d,
+	0x31, 0x69, 0x5b, 0xf0, 0xc0, 0x55, 0xed, 0xe1, 0xc8, 0x6c, 0x57, 0x19, 0x1b, 0xcb, 0xe3, 0x54,
+	0x1d, 0xdb, 0x07, 0x57, 0x37, 0xfd, 0x43, 0x17, 0xa0, 0x26, 0x32, 0xde, 0xf6, 0x66, 0xbb, 0xc6,
+	0xc4, 0x97, 0x02, 0x90, 0x09, 0x4d, 0x11, 0x81, 0x04, 0x87, 0xc0, 0x38, 0x7c, 0x4b, 0x46, 0x40,
+	0xae, 0xec, 0x2c, 0xe7, 0xe4, 0x1d, 0x3f, 0x0a, 0x8f, 0xf4, 0x06, 0xc9, 0x80, 0x3a, 0x06, 0x9c,
+	0x19, 0x41, 0x41, 0x2a, 0x94, 0x1f, 0xe0, 0x23, 0x66, 0x20, 0x65, 0x9d, 0x7e, 0xa2, 0xd7, 0xa0,
+	0x72, 0x68, 0xba, 0x43, 0xcc, 0x0c, 0x60, 0xb2, 0x8c, 0x38, 0xf2, 0x97, 0x4b, 0x6f, 0x28, 0xda,
+	0xcf, 0x2a, 0xb0, 0x20, 0x86, 0xa8, 0x0c, 0xe8, 0x30, 0xdd, 0x75, 0x12, 0x59, 0x05, 0x95, 0x14,
+	0x80, 0x96, 0xa1, 0x9e, 0xb1, 0x0e, 0x61, 0x72, 0x59, 0xd0, 0x54, 0x76, 0x17, 0xe7, 0xc9, 0xd9,
+	0x4c, 0x9e, 0xbc, 0x08, 0xb0, 0xef, 0x0e, 0xc9, 0x81, 0x11, 0x39, 0x1e, 0x16, 0x79, 0xba, 0xc6,
+	0x20, 0x7b, 0x8e, 0x87, 0xd1, 0x0d, 0x68, 0xf4, 0x1c, 0xdf, 0x0d, 0xfa, 0xc6, 0xc0, 0x8c, 0x0e,
+	0x48, 0x7b, 0x6e, 0xac, 0x3d, 0xdc, 0x72, 0xb0, 0x6b, 0xdf, 0x64, 0xb8, 0x7a, 0x9d, 0xcf, 0xd9,
+	0xa5, 0x53, 0xd0, 0xb3, 0x50, 0xf7, 0x87, 0x9e, 0x11, 0xec, 0x1b, 0x61, 0xf0, 0x88, 0x5a, 0x14,
+	0x23, 0xe1, 0x0f, 0xbd, 0xf7, 0xf6, 0xf5, 0xe0, 0x11, 0x8d, 0x6c, 0x35, 0x1a, 0xe3, 0x88, 0x1b,
+	0xf4, 0x49, 0xbb, 0x3a, 0xd5, 0xfa, 0xe9, 0x04, 0x3a, 0xdb, 0xc6, 0x6e, 0x64, 0xb2, 0xd9, 0xb5,
+	0xe9, 0x66, 0x27, 0x13, 0xd0, 0x8b, 0xd0, 0xb2, 0x02, 0x6f, 0x60, 0x32, 0x09, 0xdd, 0x0a, 0x03,
+	0x8f, 0x99, 0x53, 0x59, 0x2f, 0x40, 0xd1, 0x06, 0xd4, 0x1d, 0xdf, 0xc6, 0x1f, 0x09, 0x9b, 0xab,
+	0x33, 0x3a, 0x9a, 0xcc, 0xe6, 0x18, 0xa1, 0x6d, 0x8a, 0xcb, 0xb4, 0x0e, 0x4e, 0xfc, 0x49, 0x68,
+	0xc0, 0x8d, 0x4d, 0x97, 0x38, 0x1f, 0xe3, 0x76, 0x83, 0x6b, 0x51, 0xc0, 0xba, 0xce, 0xc7, 0x98,
+	0xd6, 0x80, 0x8e, 0x4f, 0x70, 0x18, 0xc5, 0x15, 0x79, 0xbb, 0xc9, 0xa2, 0x72, 0x93, 0x43, 0x85,
+	0x25, 0xa3, 0x0f, 0xe0, 0xac, 0xe5, 0x0e, 0x49, 0x84, 0x43, 0xc7, 0xef, 0x1b, 0x0f, 0xf0, 0x91,
+	0x11, 0x9a, 0x7e, 0x1f, 0xb7, 0x5b, 0x32, 0x9f, 0x67, 0xfb, 0xdf, 0x48, 0xd0, 0x6f, 0xe3, 0x23,
+	0x9d, 0x22, 0xeb, 0xc8, 0x1a, 0x81, 0x69, 0xbf, 0x2d, 0x41, 0x2b, 0xbf, 0x03, 0xd4, 0x86, 0xf9,
+	0x7d, 0x06, 0x89, 0xcd, 0x32, 0xfe, 0xa5, 0xfb, 0xc1, 0x3e, 0xed, 0xba, 0x0d, 0xb6, 0x49, 0x66,
+	0x95, 0x55, 0xbd, 0xce, 0x61, 0x6c, 0x01, 0x6a, 0x5d, 0x5c, 0x6e, 0x2c, 0xc3, 0x94, 0xd9, 0x5e,
+	0x6a, 0x0c, 0xc2, 0xf2, 0x4b, 0x1b, 0xe6, 0xb9, 0x7c, 0x62, 0x9b, 0x8c, 0x7f, 0xe9, 0x48, 0x6f,
+	0xe8, 0x30, 0xaa, 0xdc, 0x26, 0xe3, 0x5f, 0xb4, 0x09, 0x0d, 0xbe, 0xe4, 0xc0, 0x0c, 0x4d, 0x2f,
+	0xb6, 0xc8, 0xe7, 0xa5, 0x21, 0xfb, 0x36, 0x3e, 0x7a, 0x9f, 0x7a, 0xdd, 0xae, 0xe9, 0x84, 0x3a,
+	0xd7, 0xe0, 0x2e, 0x9b, 0x85, 0x56, 0x40, 0xe5, 0xab, 0xec, 0x3b, 0x2e, 0x16, 0xb6, 0x3d, 0xcf,
+	0x92, 0x58, 0x8b, 0xc1, 0x6f, 0x39, 0x2e, 0xe6, 0xe6, 0x9b, 0x6c, 0x81, 0xe9, 0xac, 0xca, 0xad,
+	0x97, 0x41, 0xa8, 0xc6, 0xb4, 0x3f, 0x97, 0x61, 0x91, 0x3a, 0xb1, 0xf0, 0xe7, 0x53, 0x64, 0x94,
+	0x8b, 0x00, 0x36, 0x89, 0x8c, 0x5c, 0x56, 0xa9, 0xd9, 0x24, 0xda, 0x61, 0x00, 0xf4, 0x66, 0x9c,
+	0x10, 0xca, 0xe3, 0x6b, 0xc4, 0x42, 0x50, 0x19, 0x4d, 0x0a, 0x27, 0xea, 0xa0, 0x2f, 0x41, 0x93,
+	0x04, 0xc3, 0xd0, 0xc2, 0x46, 0xae, 0x9a, 0x6f, 0x70, 0xe0, 0x8e, 0x3c, 0xef, 0xcd, 0x49, 0x3b,
+	0xf9, 0x4c, 0x62, 0x98, 0x3f, 0x5d, 0x62, 0xa8, 0x16, 0x13, 0xc3, 0x6d, 0x58, 0x60, 0x7e, 0x6d,
+	0x0c, 0x02, 0xc2, 0x9b, 0xa2, 0x76, 0x4d, 0xe6, 0xa6, 0x49, 0x53, 0x7c, 0x97, 0xf4, 0x77, 0x05,
+	0xaa, 0xde, 0x62, 0x53, 0xe3, 0x5f, 0xa2, 0xfd, 0xb4, 0x04, 0x4b, 0xa2, 0xc9, 0x3a, 0xbd, 0x62,
+	0xc7, 0x95, 0x0a, 0x71, 0x38, 0x2e, 0x3f, 0xa6, 0x6d, 0x99, 0x9d, 0xa2, 0x7c, 0xa8, 0x48, 0xca,
+	0x87, 0x7c, 0xe9, 0x3e, 0x37, 0x52, 0xba, 0x27, 0x8d, 0xf8, 0xfc, 0x31, 0x1a, 0xf1, 0x7f, 0x28,
+	0xd0, 0xec, 0x62, 0x33, 0xb4, 0x0e, 0x62, 0x61, 0xbc, 0x9e, 0x3d, 0x7d, 0x78, 0x61, 0x8c, 0xa0,
+	0x73, 0x53, 0x3e, 0x3f, 0xc7, 0x0e, 0xff, 0x54, 0xa0, 0xf1, 0x75, 0x3a, 0x14, 0x6f, 0xf6, 0x8d,
+	0xec, 0x66, 0x5f, 0x1c, 0xb3, 0x59, 0x1d, 0x47, 0xa1, 0x83, 0x0f, 0xf1, 0xe7, 0x6e, 0xbb, 0x7f,
+	0x54, 0xa0, 0xd3, 0x3d, 0xf2, 0x2d, 0x9d, 0x7b, 0xd4, 0xe9, 0xcd, 0xfe, 0x12, 0x34, 0x0f, 0x73,
+	0x0d, 0x46, 0x89, 0x85, 0xff, 0xc6, 0x61, 0xb6, 0xc3, 0xd0, 0x41, 0x8d, 0x0f, 0x3d, 0xc4, 0x66,
+	0xe3, 0x00, 0xf7, 0x92, 0x8c, 0xeb, 0x02, 0x73, 0x2c, 0x40, 0x2c, 0x84, 0x79, 0xa0, 0x16, 0xc2,
+	0xa2, 0x04, 0x0f, 0x9d, 0x87, 0x79, 0xd1, 0xcc, 0xb4, 0x95, 0x8c, 0x1f, 0xda, 0x54, 0x3b, 0x69,
+	0x3b, 0xee, 0xd8, 0xa3, 0xd5, 0x95, 0x8d, 0x9e, 0x83, 0x7a, 0x52, 0x75, 0xda, 0x23, 0xea, 0xb1,
+	0x89, 0xf6, 0x7b, 0x05, 0x96, 0xde, 0x35, 0x7d, 0x3b, 0xd8, 0xdf, 0x3f, 0xbd, 0xe4, 0x36, 0x20,
+	0x57, 0x90, 0x4e, 0xdb, 0xea, 0xe6, 0x26, 0xa1, 0x2b, 0x70, 0x26, 0xe4, 0x11, 0xcc, 0xce, 0x8b,
+	0xb6, 0xac, 0xab, 0xf1, 0x40, 0x22, 0xb2, 0xdf, 0x94, 0x00, 0xd1, 0xa8, 0x7b, 0xd3, 0x74, 0x4d,
+	0xdf, 0xc2, 0x27, 0x67, 0xfd, 0x32, 0xb4, 0x72, 0xb9, 0x22, 0xb9, 0x11, 0xc8, 0x26, 0x0b, 0x82,
+	0x6e, 0x43, 0xab, 0xc7, 0x49, 0x19, 0x21, 0x36, 0x49, 0xe0, 0xb3, 0x20, 0xd8, 0x92, 0x77, 0xb5,
+	0x7b, 0xa1, 0xd3, 0xef, 0xe3, 0x70, 0x23, 0xf0, 0x6d, 0x1e, 0xad, 0x9b, 0xbd, 0x98, 0x4d, 0x3a,
+	0x95, 0x2a, 0x27, 0x4d, 0x9c, 0x71, 0x37, 0x05, 0x49, 0xe6, 0x64, 0xa2, 0x20, 0xd8, 0x74, 0x53,
+	0x41, 0xa4, 0x51, 0x53, 0xe5, 0x03, 0xdd, 0xf1, 0x87, 0x1a, 0x92, 0x44, 0xa6, 0xfd, 0x58, 0x01,
+	0x74, 0xc3, 0x8d, 0x70, 0xc8, 0xbc, 0xee, 0xc9, 0x1e, 0x25, 0x50, 0x3f, 0xca, 0xba, 0x51, 0xdc,
+	0x6c, 0x37, 0x32, 0x6e, 0x44, 0x28, 0x47, 0xe7, 0xf7, 0xc2, 0xa1, 0x6f, 0x99, 0xd1, 0xa7, 0x90,
+	0xb1, 0xa6, 0x61, 0x6b, 0x42, 0xc0, 0xd2, 0x7e, 0xa7, 0x00, 0x4a, 0x7a, 0x2f, 0xd6, 0x89, 0x31,
+	0x2f, 0x2c, 0x2e, 0xad, 0x48, 0x96, 0xbe, 0x00, 0x35, 0x3b, 0x9e, 0x29, 0xa2, 0x46, 0x0a, 0xa0,
+	0xf2, 0xe0, 0xaa, 0x36, 0x68, 0x65, 0x80, 0xed, 0xb8, 0xd5, 0xe1, 0xc0, 0x3b, 0x0c, 0x96, 0xaf,
+	0x15, 0x66, 0x8b, 0xb5, 0x42, 0xf6, 0x5c, 0xa3, 0x92, 0x3b, 0xd7, 0xd0, 0x3e, 0x29, 0x81, 0xca,
+	0xa2, 0xfe, 0x46, 0xda, 0x5c, 0x4f, 0xc5, 0xf4, 0x25, 0x68, 0x8a, 0x7b, 0xc5, 0x1c, 0xe3, 0x8d,
+	0x87, 0x99, 0xc5, 0xd0, 0x35, 0x38, 0xcb, 0x91, 0x42, 0x4c, 0x86, 0x6e, 0x5a, 0xe5, 0xf3, 0xca,
+	0x18, 0x3d, 0xe4, 0xe9, 0x86, 0x0e, 0xc5, 0x33, 0xee, 0xc1, 0x52, 0xdf, 0x0d, 0x7a, 0xa6, 0x6b,
+	0xe4, 0x4d, 0x98, 0xdb, 0xf9, 0x14, 0x51, 0xe1, 0x2c, 0x9f, 0xde, 0xcd, 0xda, 0x39, 0x41, 0x5b,
+	0xb4, 0x8d, 0xc6, 0x0f, 0x92, 0x62, 0x49, 0x1c, 0x59, 0x4f, 0x53, 0x2b, 0x35, 0xe8, 0xc4, 0xf8,
+	0x4f, 0xfb, 0x85, 0x02, 0x0b, 0x85, 0xa3, 0xc9, 0x62, 0xb7, 0xaa, 0x8c, 0x76, 0xab, 0x6f, 0x40,
+	0x85, 0x50, 0x5c, 0x26, 0xa4, 0x96, 0xbc, 0x93, 0xca, 0xaf, 0xaa, 0xf3, 0x09, 0xe8, 0x2a, 0x2c,
+	0x4a, 0x2e, 0xb1, 0x84, 0x0d, 0xa0, 0xd1, 0x3b, 0x2c, 0xed, 0xef, 0xb3, 0x50, 0xcf, 0xc8, 0x63,
+	0x42, 0xa3, 0x3d, 0x8d, 0xe5, 0x17, 0xb6, 0x57, 0x1e, 0xdd, 0xde, 0x98, 0x5b, 0x1c, 0x6a, 0x77,
+	0x1e, 0xf6, 0x78, 0x27, 0x21, 0xda, 0x1a, 0x0f, 0x7b, 0xac, 0xf3, 0xa3, 0x26, 0x39, 0xf4, 0x78,
+	0x8b, 0xcc, 0x43, 0xce, 0xbc, 0x3f, 0xf4, 0x58, 0x83, 0x9c, 0x6f, 0xa2, 0xe6, 0x1f, 0xd3, 0x44,
+	0x55, 0xf3, 0x4d, 0x54, 0xce, 0x8f, 0x6a, 0x45, 0x3f, 0x9a, 0xb6, 0xf7, 0xbd, 0x06, 0x8b, 0x56,
+	0x88, 0xcd, 0x08, 0xdb, 0x37, 0x8f, 0x36, 0x92, 0xa1, 0x76, 0x9d, 0xd5, 0x1e, 0xb2, 0x21, 0x74,
+	0x2b, 0x3d, 0xa3, 0xe1, 0x5a, 0x6e, 0x30, 0x2d, 0xcb, 0x7b, 0x34, 0xa1, 0x1b, 0xae, 0xe4, 0x06,
+	0xc9, 0xfc, 0x15, 0xbb, 0xee, 0xe6, 0x89, 0xba, 0xee, 0xe7, 0xa0, 0x1e, 0x57, 0x18, 0xd4, 0xdd,
+	0x5b, 0x3c, 0x50, 0x09, 0x10, 0x3d, 0xc9, 0xcc, 0x06, 0x83, 0x85, 0xfc, 0x21, 0xe7, 0x55, 0x58,
+	0x4c, 0x85, 0x61, 0x10, 0xa7, 0xe7, 0x3a, 0x7e, 0x9f, 0xb4, 0x55, 0x86, 0x85, 0xd2, 0xa1, 0xae,
+	0x18, 0xd1, 0xfe, 0x54, 0x86, 0x56, 0xda, 0x47, 0x4d, 0x1d, 0x3b, 0xa6, 0xb9, 0xbd, 0xdd, 0x01,
+	0x35, 0xf9, 0xe7, 0x62, 0x7d, 0x6c, 0x2b, 0x58, 0xbc, 0x2e, 0x58, 0x18, 0xe4, 0x01, 0xf9, 0x03,
+	0xd9, 0xd9, 0x63, 0x1d, 0xc8, 0x9e, 0xf2, 0xa2, 0xeb, 0x3a, 0x9c, 0x4b, 0x2a, 0x93, 0xdc, 0xb6,
+	0x79, 0x9b, 0x72, 0x36, 0x1e, 0xdc, 0xcd, 0x6e, 0x7f, 0x8c, 0xdf, 0xcf, 0x8f, 0xf3, 0xfb, 0xa2,
+	0xde, 0xab, 0x23, 0x7a, 0x1f, 0xbd, 0x6f, 0xab, 0xc9, 0xee, 0xdb, 0xee, 0xc1, 0xe2, 0x3d, 0x9f,
+	0x0c, 0x7b, 0xf4, 0x8e, 0xa5, 0x87, 0x93, 0x7a, 0x7d, 0x1a, 0xb5, 0x76, 0xa0, 0x5a, 0x28, 0xf9,
+	0x93, 0x7f, 0xed, 0xfb, 0x0a, 0x2c, 0x8d, 0xae, 0xcb, 0x2c, 0x26, 0x8d, 0x1e, 0x4a, 0x2e, 0x7a,
+	0x7c, 0x03, 0x16, 0xd3, 0xe5, 0xf3, 0xcd, 0xc4, 0x98, 0x72, 0x59, 0xc2, 0xb8, 0x8e, 0xd2, 0x35,
+	0x62, 0x98, 0xf6, 0x2f, 0x25, 0x39, 0xf2, 0xa4, 0xb0, 0x3e, 0x3b, 0xc8, 0xa5, 0x19, 0x2d, 0xf0,
+	0x5d, 0xc7, 0xc7, 0x46, 0x8e, 0x9d, 0x06, 0x07, 0x8a, 0xbe, 0xff, 0x5d, 0x58, 0x10, 0x48, 0x49,
+	0x62, 0x9a, 0xb2, 0x5c, 0x6d, 0xf1, 0x79, 0x49, 0x4a, 0xba, 0x0c, 0xad, 0x60, 0x7f, 0x3f, 0x4b,
+	0x8f, 0x47, 0xd6, 0xa6, 0x80, 0x0a, 0x82, 0x5f, 0x03, 0x35, 0x46, 0x3b, 0x6e, 0x2a, 0x5c, 0x10,
+	0x13, 0x93, 0xb2, 0xf7, 0x7b, 0x0a, 0xb4, 0xf3, 0x89, 0x31, 0xb3, 0xfd, 0xe3, 0x97, 0x4d, 0x5f,
+	0xc9, 0xdf, 0x4d, 0x5d, 0x7e, 0x0c, 0x3f, 0x29, 0x1d, 0x71, 0x48, 0xb3, 0xfa, 0x55, 0xa8, 0x25,
+	0x3d, 0x19, 0xaa, 0xc3, 0xfc, 0x3d, 0xff, 0xb6, 0x1f, 0x3c, 0xf2, 0xd5, 0x19, 0x34, 0x0f, 0xe5,
+	0x1b, 0xae, 0xab, 0x2a, 0xa8, 0x09, 0xb5, 0x6e, 0x14, 0x62, 0xd3, 0x73, 0xfc, 0xbe, 0x5a, 0x42,
+	0x2d, 0x80, 0x77, 0x1d, 0x12, 0x05, 0xa1, 0x63, 0x99, 0xae, 0x5a, 0x5e, 0xfd, 0x18, 0x5a, 0x79,
+	0xaf, 0x47, 0x0d, 0xa8, 0xee, 0x04, 0xd1, 0x3b, 0x1f, 0x39, 0x24, 0x52, 0x67, 0x28, 0xfe, 0x4e,
+	0x10, 0xed, 0x86, 0x98, 0x60, 0x3f, 0x52, 0x15, 0x04, 0x30, 0xf7, 0x9e, 0xbf, 0xe9, 0x90, 0x07,
+	0x6a, 0x09, 0x2d, 0x8a, 0x2c, 0x6e, 0xba, 0xdb, 0xc2, 0x95, 0xd4, 0x32, 0x9d, 0x9e, 0xfc, 0xcd,
+	0x22, 0x15, 0x1a, 0x09, 0xca, 0xd6, 0xee, 0x3d, 0xb5, 0x82, 0x6a, 0x50, 0xe1, 0x9f, 0x73, 0xab,
+	0x36, 0xa8, 0xc5, 0x32, 0x9d, 0xae, 0xc9, 0x37, 0x91, 0x80, 0xd4, 0x19, 0xba, 0x33, 0xd1, 0x27,
+	0xa9, 0x0a, 0x5a, 0x80, 0x7a, 0xa6, 0xeb, 0x50, 0x4b, 0x14, 0xb0, 0x15, 0x0e, 0x2c, 0x51, 0xb9,
+	0x72, 0x16, 0xa8, 0xde, 0x37, 0xa9, 0x24, 0x66, 0x57, 0x6f, 0x42, 0x35, 0x0e, 0x47, 0x14, 0x55,
+	0x88, 0x88, 0xfe, 0xaa, 0x33, 0xe8, 0x0c, 0x34, 0x73, 0xaf, 0x04, 0x54, 0x05, 0x21, 0x68, 0xe5,
+	0x5f, 0xaf, 0xa8, 0xa5, 0xf5, 0xbf, 0x34, 0x01, 0x78, 0x81, 0x17, 0x04, 0xa1, 0x8d, 0x06, 0x80,
+	0xb6, 0x70, 0x44, 0x93, 0x57, 0xe0, 0xc7, 0x89, 0x87, 0xa0, 0x6b, 0xe3, 0x1f, 0x52, 0x14, 0x50,
+	0x05, 0xab, 0x9d, 0x71, 0xe7, 0x01, 0x05, 0x74, 0x6d, 0x06, 0x79, 0x8c, 0x22, 0x3d, 0x5d, 0xdf,
+	0x73, 0xac, 0x07, 0x49, 0x65, 0x38, 0x9e, 0x62, 0x01, 0x35, 0xa6, 0x58, 0x08, 0xfb, 0xe2, 0xa7,
+	0x1b, 0xd1, 0x03, 0xdd, 0xf8, 0xae, 0x51, 0x9b, 0x41, 0x0f, 0x0b, 0x0f, 0x47, 0x62, 0x82, 0xeb,
+	0xd3, 0xbc, 0x15, 0x39, 0x19, 0x49, 0x17, 0x16, 0x0a, 0xaf, 0xcc, 0xd0, 0xaa, 0xfc, 0xba, 0x52,
+	0xf6, 0x22, 0xae, 0x73, 0x65, 0x2a, 0xdc, 0x84, 0x9a, 0x03, 0xad, 0xfc, 0x4b, 0x2a, 0xf4, 0xc5,
+	0x71, 0x0b, 0x8c, 0x3c, 0x98, 0xe8, 0xac, 0x4e, 0x83, 0x9a, 0x90, 0xba, 0xcf, 0xed, 0x69, 0x12,
+	0x29, 0xe9, 0x63, 0x95, 0xce, 0xe3, 0xae, 0x79, 0xb5, 0x19, 0xf4, 0x2d, 0x38, 0x33, 0xf2, 0xac,
+	0x03, 0xbd, 0x2c, 0x3f, 0x04, 0x91, 0xbf, 0xfe, 0x98, 0x44, 0xe1, 0x7e, 0xd1, 0x1b, 0xc6, 0x73,
+	0x3f, 0xf2, 0x04, 0x6a, 0x7a, 0xee, 0x33, 0xcb, 0x3f, 0x8e, 0xfb, 0x63, 0x53, 0x18, 0x32, 0xb7,
+	0x29, 0xb6, 0x1a, 0xaf, 0xc8, 0x48, 0x8c, 0x7d, 0x5b, 0xd2, 0x59, 0x9b, 0x16, 0x3d, 0x6b, 0x5d,
+	0xf9, 0xe7, 0x0b, 0x72, 0xa1, 0x49, 0x9f, 0x5c, 0x74, 0x56, 0xa7, 0x41, 0x4d, 0x48, 0xed, 0xe5,
+	0xa2, 0x21, 0x7a, 0x71, 0x9c, 0x72, 0xf2, 0x87, 0x34, 0x93, 0xe4, 0xb6, 0x07, 0xf5, 0xcc, 0x51,
+	0x85, 0x7c, 0xd5, 0xd1, 0xb3, 0x8c, 0x49, 0xab, 0x7e, 0x13, 0xd4, 0xe2, 0x71, 0x03, 0xba, 0x22,
+	0x3f, 0xbc, 0x91, 0x1e, 0x4a, 0x4c, 0x5a, 0xdf, 0x00, 0xd8, 0xc2, 0xd1, 0x5d, 0x1c, 0x85, 0x8e,
+	0x35, 0xc2, 0xb4, 0xf8, 0x49, 0x11, 0xe2, 0x45, 0x5f, 0x9a, 0x88, 0x97, 0x08, 0xbb, 0x07, 0xf5,
+	0x2d, 0x1c, 0x89, 0x73, 0x42, 0x82, 0xc6, 0xce, 0x8c, 0x31, 0x62, 0x12, 0x2b, 0x93, 0x11, 0xb3,
+	0x71, 0xb0, 0xf0, 0x06, 0x04, 0x8d, 0xb5, 0x88, 0xd1, 0x97, 0x29, 0x9d, 0x2b, 0x53, 0xe1, 0xc6,
+	0xd4, 0xd6, 0xff, 0x53, 0x87, 0x1a, 0x4b, 0x6c, 0x34, 0x61, 0xfe, 0x3f, 0xaf, 0x3d, 0x81, 0xbc,
+	0xf6, 0x21, 0x2c, 0x14, 0x9e, 0x14, 0xc8, 0xf5, 0x29, 0x7f, 0x77, 0x30, 0xc9, 0xe4, 0xdf, 0xe7,
+	0x2f, 0x66, 0x12, 0x77, 0x7a, 0x69, 0x9c, 0xff, 0x1f, 0xd3, 0x95, 0x9e, 0x7c, 0x68, 0x7e, 0xf2,
+	0xa9, 0xeb, 0x43, 0x58, 0x28, 0x5c, 0xc7, 0xc9, 0x25, 0x2f, 0xbf, 0xb3, 0x9b, 0xb4, 0xfa, 0x67,
+	0x18, 0xe3, 0x6d, 0x58, 0x94, 0x5c, 0xb2, 0x20, 0x69, 0x5e, 0x1a, 0x7f, 0x1b, 0x33, 0x79, 0x43,
+	0xcd, 0x9c, 0xb9, 0xa3, 0x95, 0x71, 0x4c, 0x16, 0x5f, 0x05, 0x77, 0x5e, 0x9e, 0xee, 0x09, 0x71,
+	0xb2, 0xa1, 0x2e, 0xcc, 0xf1, 0xfb, 0x3d, 0xf4, 0xbc, 0x74, 0x0f, 0xd9, 0xbb, 0xbf, 0xce, 0xa4,
+	0x1b, 0x42, 0x32, 0x74, 0x23, 0xc2, 0x16, 0xad, 0xb0, 0x48, 0x86, 0xa4, 0xd7, 0xc3, 0xd9, 0x4b,
+	0xb9, 0xce, 0xe4, 0x7b, 0xb8, 0x78, 0xd1, 0x6f, 0x03, 0xe2, 0x45, 0xa4, 0xbf, 0xef, 0xf4, 0x87,
+	0xa1, 0xc9, 0xcd, 0x74, 0x5c, 0x7c, 0x1a, 0x45, 0x8d, 0x29, 0xbe, 0x7a, 0x8c, 0x19, 0x89, 0x98,
+	0x9e, 0x74, 0x3e, 0xbb, 0xf9, 0xda, 0xfd, 0xf5, 0xbe, 0x13, 0x1d, 0x0c, 0x7b, 0xd4, 0x18, 0xae,
+	0x72, 0xcc, 0x57, 0x9c, 0x40, 0x7c, 0x5d, 0x8d, 0xb9, 0xbc, 0xca, 0x56, 0xba, 0xca, 0x04, 0x39,
+	0xe8, 0xf5, 0xe6, 0xd8, 0xef, 0xf5, 0xff, 0x0e, 0x00, 0x32, 0xfa, 0x52, 0x77, 0x13, 0x33, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3008,6 +3146,8 @@ type QueryCoordClient interface {
 	GetPartitionStates(ctx context.Context, in *GetPartitionStatesRequest, opts ...grpc.CallOption) (*GetPartitionStatesResponse, error)
 	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
 	LoadBalance(ctx context.Context, in *LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AlterShards(ctx context.Context, in *AlterShardsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	TruncateSegments(ctx context.Context, in *TruncateSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+23+--+Multiple+memory+replication+design
@@ -3131,6 +3271,24 @@ func (c *queryCoordClient) LoadBalance(ctx context.Context, in *LoadBalanceReque
 	return out, nil
 }
 
+func (c *queryCoordClient) AlterShards(ctx context.Context, in *AlterShardsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/AlterShards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) TruncateSegments(ctx context.Context, in *TruncateSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/TruncateSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	out := new(milvuspb.GetMetricsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetMetrics", in, out, opts...)
@@ -3172,6 +3330,8 @@ type QueryCoordServer interface {
 	GetPartitionStates(context.Context, *GetPartitionStatesRequest) (*GetPartitionStatesResponse, error)
 	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
 	LoadBalance(context.Context, *LoadBalanceRequest) (*commonpb.Status, error)
+	AlterShards(context.Context, *AlterShardsRequest) (*commonpb.Status, error)
+	TruncateSegments(context.Context, *TruncateSegmentsRequest) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+23+--+Multiple+memory+replication+design
@@ -3219,6 +3379,12 @@ func (*UnimplementedQueryCoordServer) GetSegmentInfo(ctx context.Context, req *G
 func (*UnimplementedQueryCoordServer) LoadBalance(ctx context.Context, req *LoadBalanceRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoadBalance not implemented")
 }
+func (*UnimplementedQueryCoordServer) AlterShards(ctx context.Context, req *AlterShardsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterShards not implemented")
+}
+func (*UnimplementedQueryCoordServer) TruncateSegments(ctx context.Context, req *TruncateSegmentsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TruncateSegments not implemented")
+}
 func (*UnimplementedQueryCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
@@ -3449,6 +3615,42 @@ func _QueryCoord_LoadBalance_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryCoord_AlterShards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterShardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).AlterShards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/AlterShards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).AlterShards(ctx, req.(*AlterShardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_TruncateSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TruncateSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).TruncateSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/TruncateSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).TruncateSegments(ctx, req.(*TruncateSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.GetMetricsRequest)
 	if err := dec(in); err != nil {
@@ -3555,6 +3757,14 @@ var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LoadBalance",
 			Handler:    _QueryCoord_LoadBalance_Handler,
 		},
+		{
+			MethodName: "AlterShards",
+			Handler:    _QueryCoord_AlterShards_Handler,
+		},
+		{
+			MethodName: "TruncateSegments",
+			Handler:    _QueryCoord_TruncateSegments_Handler,
+		},
 		{
 			MethodName: "GetMetrics",
 			Handler:    _QueryCoord_GetMetrics_Handler,
@@ -676,109 +676,115 @@ func init() {
 func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
 
 var fileDescriptor_4513485a144f6b06 = []byte{
-	// 1621 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5d, 0x73, 0xd3, 0x46,
-	0x17, 0xc6, 0x36, 0xf9, 0x3a, 0x76, 0xe2, 0xb0, 0x43, 0xc0, 0xaf, 0xe1, 0x6d, 0x8d, 0xa1, 0xe0,
-	0xf0, 0xe1, 0x30, 0x61, 0x86, 0x52, 0xee, 0x48, 0x4c, 0x83, 0xa7, 0x64, 0x08, 0x32, 0x74, 0xe8,
-	0x07, 0xe3, 0x6e, 0xac, 0x83, 0xa3, 0x89, 0xac, 0x35, 0xda, 0x75, 0x3e, 0x2e, 0x3b, 0xd3, 0xfb,
-	0xfe, 0xa7, 0xf6, 0xa7, 0xf4, 0x77, 0x74, 0xa6, 0xb3, 0x5a, 0x49, 0x96, 0x64, 0xad, 0xa2, 0x00,
-	0x77, 0xda, 0xdd, 0x67, 0x9f, 0xe7, 0xec, 0x39, 0xbb, 0x67, 0xcf, 0x0a, 0x56, 0x5d, 0xc6, 0x44,
-	0x7f, 0xc0, 0x98, 0x6b, 0xb6, 0xc7, 0x2e, 0x13, 0x8c, 0x5c, 0x19, 0x59, 0xf6, 0xd1, 0x84, 0xab,
-	0x56, 0x5b, 0x0e, 0x7b, 0xa3, 0xf5, 0xca, 0x80, 0x8d, 0x46, 0xcc, 0x51, 0xfd, 0xf5, 0x4a, 0x14,
-	0x55, 0x5f, 0xb1, 0x1c, 0x81, 0xae, 0x43, 0x6d, 0xbf, 0x5d, 0x1e, 0xbb, 0xec, 0xe4, 0xd4, 0x6f,
-	0xac, 0x9a, 0x54, 0xd0, 0xa8, 0x44, 0xbd, 0x8a, 0x62, 0x60, 0xf6, 0x47, 0x28, 0xa8, 0xdf, 0x71,
-	0xc9, 0x72, 0x4c, 0x3c, 0x89, 0x62, 0x9a, 0x7d, 0x58, 0x7b, 0x66, 0xdb, 0x6c, 0xf0, 0xc6, 0x1a,
-	0x21, 0x17, 0x74, 0x34, 0x36, 0xf0, 0xe3, 0x04, 0xb9, 0x20, 0x0f, 0xe1, 0xe2, 0x3e, 0xe5, 0x58,
-	0x2b, 0x34, 0x0a, 0xad, 0xf2, 0xe6, 0xf5, 0x76, 0xcc, 0x5c, 0xdf, 0xc6, 0x5d, 0x3e, 0xdc, 0xa2,
-	0x1c, 0x0d, 0x0f, 0x49, 0x2e, 0xc3, 0xdc, 0x80, 0x4d, 0x1c, 0x51, 0x2b, 0x35, 0x0a, 0xad, 0x65,
-	0x43, 0x35, 0x9a, 0xbf, 0x17, 0xe0, 0x4a, 0x52, 0x81, 0x8f, 0x99, 0xc3, 0x91, 0x3c, 0x82, 0x79,
-	0x2e, 0xa8, 0x98, 0x70, 0x5f, 0xe4, 0x5a, 0xaa, 0x48, 0xcf, 0x83, 0x18, 0x3e, 0x94, 0x5c, 0x87,
-	0x25, 0x11, 0x30, 0xd5, 0x8a, 0x8d, 0x42, 0xeb, 0xa2, 0x31, 0xed, 0xd0, 0xd8, 0xf0, 0x0e, 0x56,
-	0x3c, 0x13, 0xba, 0x9d, 0x2f, 0xb0, 0xba, 0x62, 0x94, 0xd9, 0x86, 0x6a, 0xc8, 0xfc, 0x39, 0xab,
-	0x5a, 0x81, 0x62, 0xb7, 0xe3, 0x51, 0x97, 0x8c, 0x62, 0xb7, 0xa3, 0x59, 0xc7, 0x5f, 0x45, 0xa8,
-	0x74, 0x47, 0x63, 0xe6, 0x0a, 0x03, 0xf9, 0xc4, 0x16, 0x9f, 0xa6, 0x75, 0x15, 0x16, 0x04, 0xe5,
-	0x87, 0x7d, 0xcb, 0xf4, 0x05, 0xe7, 0x65, 0xb3, 0x6b, 0x92, 0xaf, 0xa1, 0x2c, 0xf7, 0x90, 0xc3,
-	0x4c, 0x94, 0x83, 0x25, 0x6f, 0x10, 0x82, 0xae, 0xae, 0x49, 0x1e, 0xc3, 0x9c, 0xe4, 0xc0, 0xda,
-	0xc5, 0x46, 0xa1, 0xb5, 0xb2, 0xd9, 0x48, 0x55, 0x53, 0x06, 0x4a, 0x4d, 0x34, 0x14, 0x9c, 0xd4,
-	0x61, 0x91, 0xe3, 0x70, 0x84, 0x8e, 0xe0, 0xb5, 0xb9, 0x46, 0xa9, 0x55, 0x32, 0xc2, 0x36, 0xf9,
-	0x1f, 0x2c, 0xd2, 0x89, 0x60, 0x7d, 0xcb, 0xe4, 0xb5, 0x79, 0x6f, 0x6c, 0x41, 0xb6, 0xbb, 0x26,
-	0x27, 0xd7, 0x60, 0xc9, 0x65, 0xc7, 0x7d, 0xe5, 0x88, 0x05, 0xcf, 0x9a, 0x45, 0x97, 0x1d, 0x6f,
-	0xcb, 0x36, 0xf9, 0x16, 0xe6, 0x2c, 0xe7, 0x03, 0xe3, 0xb5, 0xc5, 0x46, 0xa9, 0x55, 0xde, 0xbc,
-	0x91, 0x6a, 0xcb, 0x0f, 0x78, 0xfa, 0x23, 0xb5, 0x27, 0xb8, 0x47, 0x2d, 0xd7, 0x50, 0xf8, 0xe6,
-	0x9f, 0x05, 0xb8, 0xda, 0x41, 0x3e, 0x70, 0xad, 0x7d, 0xec, 0xf9, 0x56, 0x7c, 0xfa, 0xb6, 0x68,
-	0x42, 0x65, 0xc0, 0x6c, 0x1b, 0x07, 0xc2, 0x62, 0x4e, 0x18, 0xc2, 0x58, 0x1f, 0xf9, 0x0a, 0xc0,
-	0x5f, 0x6e, 0xb7, 0xc3, 0x6b, 0x25, 0x6f, 0x91, 0x91, 0x9e, 0xe6, 0x04, 0xaa, 0xbe, 0x21, 0x92,
-	0xb8, 0xeb, 0x7c, 0x60, 0x33, 0xb4, 0x85, 0x14, 0xda, 0x06, 0x94, 0xc7, 0xd4, 0x15, 0x56, 0x4c,
-	0x39, 0xda, 0x25, 0xcf, 0x4a, 0x28, 0xe3, 0x87, 0x73, 0xda, 0xd1, 0xfc, 0xa7, 0x08, 0x15, 0x5f,
-	0x57, 0x6a, 0x72, 0xd2, 0x81, 0x25, 0xb9, 0xa6, 0xbe, 0xf4, 0x93, 0xef, 0x82, 0x3b, 0xed, 0xf4,
-	0x34, 0xd5, 0x4e, 0x18, 0x6c, 0x2c, 0xee, 0x07, 0xa6, 0x77, 0xa0, 0xac, 0xd2, 0x8c, 0x0a, 0x4f,
-	0xd1, 0x0b, 0xcf, 0xcd, 0x38, 0x8f, 0x4c, 0x4c, 0xed, 0x50, 0xdb, 0xc4, 0x13, 0x8f, 0x03, 0xac,
-	0xe0, 0x93, 0x13, 0x84, 0x4b, 0x78, 0x22, 0x5c, 0xda, 0x8f, 0x72, 0x95, 0x3c, 0xae, 0xef, 0xce,
-	0xb0, 0xc9, 0x23, 0x68, 0x3f, 0x97, 0xb3, 0x43, 0x6e, 0xfe, 0xdc, 0x11, 0xee, 0xa9, 0x51, 0xc5,
-	0x78, 0x6f, 0xfd, 0x37, 0xb8, 0x9c, 0x06, 0x24, 0xab, 0x50, 0x3a, 0xc4, 0x53, 0xdf, 0xed, 0xf2,
-	0x93, 0x6c, 0xc2, 0xdc, 0x91, 0xdc, 0x4a, 0x9e, 0x9f, 0x67, 0xf6, 0x86, 0xb7, 0xa0, 0xe9, 0x4a,
-	0x14, 0xf4, 0x69, 0xf1, 0x49, 0xa1, 0xf9, 0x77, 0x11, 0x6a, 0xb3, 0xdb, 0xed, 0x73, 0x72, 0x45,
-	0x9e, 0x2d, 0x37, 0x84, 0x65, 0x3f, 0xd0, 0x31, 0xd7, 0x6d, 0xe9, 0x5c, 0xa7, 0xb3, 0x30, 0xe6,
-	0x53, 0xe5, 0xc3, 0x0a, 0x8f, 0x74, 0xd5, 0x11, 0x2e, 0xcd, 0x40, 0x52, 0xbc, 0xf7, 0x34, 0xee,
-	0xbd, 0x5b, 0x79, 0x42, 0x18, 0xf5, 0xa2, 0x09, 0x97, 0x77, 0x50, 0x6c, 0xbb, 0x68, 0xa2, 0x23,
-	0x2c, 0x6a, 0x7f, 0xfa, 0x81, 0xad, 0xc3, 0xe2, 0x84, 0xcb, 0x4b, 0x74, 0xa4, 0x8c, 0x59, 0x32,
-	0xc2, 0x76, 0xf3, 0x8f, 0x02, 0xac, 0x25, 0x64, 0x3e, 0x27, 0x50, 0x19, 0x52, 0x72, 0x6c, 0x4c,
-	0x39, 0x3f, 0x66, 0xae, 0x4a, 0xb4, 0x4b, 0x46, 0xd8, 0xde, 0xfc, 0xf7, 0x26, 0x2c, 0x19, 0x8c,
-	0x89, 0x6d, 0xe9, 0x12, 0x32, 0x06, 0x22, 0x6d, 0x62, 0xa3, 0x31, 0x73, 0xd0, 0x51, 0x89, 0x95,
-	0x93, 0x87, 0x71, 0x03, 0xc2, 0xc2, 0x60, 0x16, 0xea, 0xbb, 0xaa, 0x7e, 0x5b, 0x33, 0x23, 0x01,
-	0x6f, 0x5e, 0x20, 0x23, 0x4f, 0x51, 0xde, 0xd7, 0x6f, 0xac, 0xc1, 0xe1, 0xf6, 0x01, 0x75, 0x1c,
-	0xb4, 0xb3, 0x14, 0x13, 0xd0, 0x40, 0x31, 0x71, 0xe8, 0xfd, 0x46, 0x4f, 0xb8, 0x96, 0x33, 0x0c,
-	0x3c, 0xdb, 0xbc, 0x40, 0x3e, 0x7a, 0xb1, 0x95, 0xea, 0x16, 0x17, 0xd6, 0x80, 0x07, 0x82, 0x9b,
-	0x7a, 0xc1, 0x19, 0xf0, 0x39, 0x25, 0xfb, 0xb0, 0xba, 0xed, 0x22, 0x15, 0xb8, 0x1d, 0x1e, 0x1a,
-	0x72, 0x3f, 0x75, 0x6a, 0x12, 0x16, 0x08, 0x65, 0x6d, 0x80, 0xe6, 0x05, 0xf2, 0x0b, 0xac, 0x74,
-	0x5c, 0x36, 0x8e, 0xd0, 0xdf, 0x4d, 0xa5, 0x8f, 0x83, 0x72, 0x92, 0xf7, 0x61, 0xf9, 0x05, 0xe5,
-	0x11, 0xee, 0xf5, 0x54, 0xee, 0x18, 0x26, 0xa0, 0xbe, 0x91, 0x0a, 0xdd, 0x62, 0xcc, 0x8e, 0xb8,
-	0xe7, 0x18, 0x48, 0x90, 0x10, 0x22, 0x2a, 0xed, 0xf4, 0x15, 0xcc, 0x00, 0x03, 0xa9, 0x8d, 0xdc,
-	0xf8, 0x50, 0xf8, 0x2d, 0x94, 0x95, 0xc3, 0x9f, 0xd9, 0x16, 0xe5, 0xe4, 0x4e, 0x46, 0x48, 0x3c,
-	0x44, 0x4e, 0x87, 0xbd, 0x86, 0x25, 0xe9, 0x68, 0x45, 0xfa, 0x8d, 0x36, 0x10, 0xe7, 0xa1, 0xec,
-	0x01, 0x3c, 0xb3, 0x05, 0xba, 0x8a, 0xf3, 0x76, 0x2a, 0xe7, 0x14, 0x90, 0x93, 0xd4, 0x81, 0x6a,
-	0xef, 0x40, 0x16, 0x38, 0x81, 0x6b, 0x38, 0xb9, 0x97, 0xbe, 0xa1, 0xe3, 0xa8, 0x80, 0xfe, 0x7e,
-	0x3e, 0x70, 0xe8, 0xee, 0xf7, 0x50, 0x55, 0xce, 0xdc, 0x0b, 0x8a, 0x06, 0x8d, 0x5e, 0x02, 0x95,
-	0x73, 0x39, 0x3f, 0xc1, 0xb2, 0x74, 0xeb, 0x94, 0x7c, 0x5d, 0xeb, 0xfa, 0xf3, 0x52, 0xbf, 0x87,
-	0xca, 0x0b, 0xca, 0xa7, 0xcc, 0x2d, 0xdd, 0x09, 0x98, 0x21, 0xce, 0x75, 0x00, 0x0e, 0x61, 0x45,
-	0x7a, 0x2d, 0x9c, 0xcc, 0x35, 0xc7, 0x37, 0x0e, 0x0a, 0x24, 0xee, 0xe5, 0xc2, 0x86, 0x62, 0x0e,
-	0x54, 0x13, 0xd7, 0xaf, 0x26, 0x0a, 0x09, 0x54, 0x76, 0xd4, 0x67, 0xc0, 0xa1, 0x1e, 0x42, 0x45,
-	0xda, 0x12, 0x5c, 0xf5, 0x1a, 0xdf, 0x45, 0x21, 0x81, 0xd2, 0x7a, 0x0e, 0x64, 0x24, 0x89, 0xac,
-	0x26, 0xab, 0x0a, 0xb2, 0x91, 0xbf, 0xfe, 0x50, 0x8a, 0x0f, 0xcf, 0x5b, 0xb0, 0x44, 0x93, 0x88,
-	0x57, 0x8f, 0x65, 0x26, 0x11, 0x0f, 0x91, 0x73, 0xcb, 0x1d, 0xc0, 0x72, 0x20, 0xaa, 0x88, 0xd7,
-	0x33, 0xfd, 0x1e, 0xa3, 0xbe, 0x9b, 0x07, 0x1a, 0x2e, 0xc0, 0x4f, 0x57, 0x4a, 0x45, 0x9f, 0xae,
-	0xce, 0x69, 0xfc, 0x0e, 0xaa, 0x52, 0xdb, 0xbb, 0xe6, 0x35, 0xc6, 0xc7, 0x30, 0x1a, 0xe3, 0xbd,
-	0x82, 0x3c, 0x8e, 0xe4, 0xb1, 0xdb, 0x7c, 0x25, 0xfe, 0xdc, 0x27, 0x0f, 0x74, 0x31, 0x4c, 0xfd,
-	0xf1, 0x50, 0x6f, 0xe7, 0x85, 0x87, 0x92, 0xbf, 0xc2, 0x82, 0xff, 0x08, 0x4f, 0x26, 0xe2, 0xc4,
-	0xe4, 0xf0, 0xfd, 0x5f, 0xbf, 0x73, 0x26, 0x2e, 0x64, 0xa7, 0xb0, 0xf6, 0x76, 0x6c, 0xca, 0x22,
-	0x40, 0x95, 0x1a, 0x41, 0xb1, 0x93, 0x74, 0xe1, 0xb4, 0xa0, 0x8a, 0xe3, 0x76, 0xf9, 0xf0, 0xac,
-	0xe8, 0xd8, 0x70, 0xd5, 0x40, 0x1b, 0x29, 0xc7, 0xce, 0xeb, 0x97, 0xbb, 0xc8, 0x39, 0x1d, 0x62,
-	0x4f, 0xb8, 0x48, 0x47, 0xc9, 0x22, 0x48, 0xfd, 0xf0, 0xd1, 0x80, 0x73, 0xee, 0x05, 0x17, 0xfe,
-	0xdf, 0x75, 0x8e, 0xa8, 0x6d, 0x99, 0xb1, 0xca, 0x66, 0x17, 0x05, 0xdd, 0xa6, 0x83, 0x03, 0x4c,
-	0xd7, 0x8c, 0x4f, 0x09, 0xc1, 0x39, 0x35, 0x07, 0xb0, 0xe6, 0x9f, 0xd4, 0xef, 0xed, 0x09, 0x3f,
-	0x90, 0x35, 0xa7, 0x8d, 0x02, 0xcd, 0x64, 0xa6, 0x33, 0xa9, 0xa0, 0xed, 0x54, 0x64, 0x0e, 0x37,
-	0xf6, 0x01, 0x76, 0x50, 0xec, 0xa2, 0x70, 0xad, 0x81, 0xee, 0x4e, 0x9e, 0x02, 0x34, 0x5b, 0x21,
-	0x05, 0x17, 0x6e, 0x85, 0x1e, 0xcc, 0xab, 0xbf, 0x1b, 0xa4, 0x99, 0x3a, 0x29, 0xf8, 0x37, 0x93,
-	0x55, 0x8b, 0x86, 0xff, 0x6f, 0x22, 0x77, 0x8d, 0x3c, 0x4c, 0xd3, 0xbf, 0x26, 0x9a, 0xbb, 0x26,
-	0x0e, 0xca, 0xbe, 0x6b, 0x92, 0xd8, 0xe8, 0x5d, 0xf3, 0xd2, 0xe2, 0xfe, 0xe0, 0x1b, 0xca, 0x0f,
-	0x75, 0x15, 0x46, 0x02, 0x95, 0x7d, 0xd7, 0xcc, 0x80, 0x23, 0x1e, 0xab, 0x18, 0x28, 0x07, 0x7c,
-	0xbf, 0x69, 0x1f, 0x7e, 0xd1, 0xdf, 0x5a, 0x67, 0xc5, 0xf9, 0x5d, 0x58, 0xbd, 0x87, 0x0f, 0xb5,
-	0x64, 0x9a, 0x9c, 0x1e, 0xc6, 0x10, 0x22, 0xdf, 0x94, 0x39, 0x98, 0xfd, 0xb3, 0xfe, 0xa5, 0x99,
-	0xfb, 0xf2, 0x36, 0x94, 0x1b, 0x39, 0xc2, 0xac, 0xbb, 0xb8, 0xe3, 0xb0, 0xfc, 0x19, 0x5e, 0x86,
-	0x41, 0xce, 0x7b, 0xcb, 0xd1, 0xe5, 0x9a, 0x0c, 0x1f, 0xc3, 0x64, 0x5f, 0x4f, 0x09, 0x68, 0x64,
-	0x0f, 0x2d, 0xc7, 0x1e, 0xc9, 0xc9, 0x75, 0x4c, 0x83, 0x9a, 0xf6, 0x64, 0xaf, 0x3f, 0xc8, 0x89,
-	0x8e, 0xec, 0x21, 0x50, 0xe1, 0x36, 0x98, 0x8d, 0x9a, 0x63, 0x3d, 0x05, 0xe4, 0x74, 0xd7, 0x2b,
-	0x58, 0x94, 0x77, 0xa8, 0x47, 0x79, 0x4b, 0x7b, 0xc5, 0x9e, 0x83, 0xf0, 0x3d, 0x54, 0x5f, 0x8d,
-	0xd1, 0xa5, 0x02, 0xa5, 0xbf, 0x3c, 0xde, 0xf4, 0x93, 0x95, 0x40, 0xe5, 0x7e, 0xf3, 0x41, 0x0f,
-	0x65, 0xa6, 0xce, 0x70, 0xc2, 0x14, 0x90, 0x9d, 0xdb, 0xa2, 0xb8, 0xc8, 0x93, 0xd8, 0x17, 0x90,
-	0x86, 0x65, 0x0a, 0x78, 0x96, 0xe7, 0x10, 0x50, 0xb8, 0xe8, 0x9b, 0xdb, 0x5f, 0xfa, 0x9e, 0x6b,
-	0x1d, 0x59, 0x36, 0x0e, 0x51, 0x73, 0x02, 0x92, 0xb0, 0x9c, 0x2e, 0xda, 0x87, 0xb2, 0x12, 0xde,
-	0x71, 0xa9, 0x23, 0x48, 0x96, 0x69, 0x1e, 0x22, 0xa0, 0x6d, 0x9d, 0x0d, 0x0c, 0x17, 0x31, 0x00,
-	0x90, 0xc7, 0x62, 0x8f, 0xd9, 0xd6, 0xe0, 0x34, 0x59, 0x39, 0x87, 0xa9, 0x61, 0x0a, 0xd1, 0x54,
-	0xce, 0xa9, 0xc8, 0x40, 0x64, 0xeb, 0xc9, 0xcf, 0x8f, 0x87, 0x96, 0x38, 0x98, 0xec, 0xcb, 0x25,
-	0x6e, 0xa8, 0x89, 0x0f, 0x2c, 0xe6, 0x7f, 0x6d, 0x04, 0x93, 0x37, 0x3c, 0xae, 0x8d, 0xf0, 0x00,
-	0x8d, 0xf7, 0xf7, 0xe7, 0xbd, 0xae, 0x47, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x0a, 0x94, 0xcd,
-	0x4d, 0x6f, 0x1a, 0x00, 0x00,
+	// 1716 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5b, 0x73, 0x13, 0x37,
+	0x14, 0xc6, 0x36, 0xb9, 0x1d, 0x3b, 0x71, 0xa2, 0x21, 0xe0, 0x1a, 0xda, 0x1a, 0x97, 0x8b, 0x03,
+	0xc4, 0x61, 0xc2, 0x0c, 0xa5, 0xbc, 0x25, 0x31, 0x04, 0x4f, 0xc9, 0x10, 0xd6, 0x49, 0x87, 0x5e,
+	0x18, 0x57, 0xd9, 0x15, 0xce, 0x4e, 0xd6, 0x2b, 0xb3, 0x92, 0x73, 0x79, 0xec, 0x4c, 0xdf, 0xfb,
+	0x9f, 0xda, 0x9f, 0xd2, 0xb7, 0xfe, 0x8a, 0x8e, 0x56, 0x7b, 0xf7, 0x6a, 0xb3, 0x06, 0xde, 0x56,
+	0xda, 0x4f, 0xdf, 0x77, 0x74, 0xce, 0x91, 0x8e, 0x24, 0x58, 0x76, 0x28, 0xe5, 0x7d, 0x9d, 0x52,
+	0xc7, 0x68, 0x8f, 0x1c, 0xca, 0x29, 0xba, 0x3e, 0x34, 0xad, 0xd3, 0x31, 0x93, 0xad, 0xb6, 0xf8,
+	0xed, 0xfe, 0xad, 0x57, 0x74, 0x3a, 0x1c, 0x52, 0x5b, 0xf6, 0xd7, 0x2b, 0x51, 0x54, 0x7d, 0xc9,
+	0xb4, 0x39, 0x71, 0x6c, 0x6c, 0x79, 0xed, 0xf2, 0xc8, 0xa1, 0xe7, 0x17, 0x5e, 0x63, 0xd9, 0xc0,
+	0x1c, 0x47, 0x25, 0xea, 0x55, 0xc2, 0x75, 0xa3, 0x3f, 0x24, 0x1c, 0x7b, 0x1d, 0x2b, 0xa6, 0x6d,
+	0x90, 0xf3, 0x28, 0xa6, 0xd9, 0x87, 0xd5, 0x2d, 0xcb, 0xa2, 0xfa, 0x81, 0x39, 0x24, 0x8c, 0xe3,
+	0xe1, 0x48, 0x23, 0x1f, 0xc7, 0x84, 0x71, 0xf4, 0x18, 0xae, 0x1e, 0x61, 0x46, 0x6a, 0x85, 0x46,
+	0xa1, 0x55, 0xde, 0xbc, 0xd5, 0x8e, 0x99, 0xeb, 0xd9, 0xb8, 0xc7, 0x06, 0xdb, 0x98, 0x11, 0xcd,
+	0x45, 0xa2, 0x6b, 0x30, 0xa3, 0xd3, 0xb1, 0xcd, 0x6b, 0xa5, 0x46, 0xa1, 0xb5, 0xa8, 0xc9, 0x46,
+	0xf3, 0x8f, 0x02, 0x5c, 0x4f, 0x2a, 0xb0, 0x11, 0xb5, 0x19, 0x41, 0x4f, 0x60, 0x96, 0x71, 0xcc,
+	0xc7, 0xcc, 0x13, 0xb9, 0x99, 0x2a, 0xd2, 0x73, 0x21, 0x9a, 0x07, 0x45, 0xb7, 0x60, 0x81, 0xfb,
+	0x4c, 0xb5, 0x62, 0xa3, 0xd0, 0xba, 0xaa, 0x85, 0x1d, 0x0a, 0x1b, 0xde, 0xc1, 0x92, 0x6b, 0x42,
+	0xb7, 0xf3, 0x05, 0x66, 0x57, 0x8c, 0x32, 0x5b, 0x50, 0x0d, 0x98, 0x3f, 0x67, 0x56, 0x4b, 0x50,
+	0xec, 0x76, 0x5c, 0xea, 0x92, 0x56, 0xec, 0x76, 0x14, 0xf3, 0xf8, 0xbb, 0x08, 0x95, 0xee, 0x70,
+	0x44, 0x1d, 0xae, 0x11, 0x36, 0xb6, 0xf8, 0xa7, 0x69, 0xdd, 0x80, 0x39, 0x8e, 0xd9, 0x49, 0xdf,
+	0x34, 0x3c, 0xc1, 0x59, 0xd1, 0xec, 0x1a, 0xe8, 0x5b, 0x28, 0x8b, 0x1c, 0xb2, 0xa9, 0x41, 0xc4,
+	0xcf, 0x92, 0xfb, 0x13, 0xfc, 0xae, 0xae, 0x81, 0x9e, 0xc2, 0x8c, 0xe0, 0x20, 0xb5, 0xab, 0x8d,
+	0x42, 0x6b, 0x69, 0xb3, 0x91, 0xaa, 0x26, 0x0d, 0x14, 0x9a, 0x44, 0x93, 0x70, 0x54, 0x87, 0x79,
+	0x46, 0x06, 0x43, 0x62, 0x73, 0x56, 0x9b, 0x69, 0x94, 0x5a, 0x25, 0x2d, 0x68, 0xa3, 0xaf, 0x60,
+	0x1e, 0x8f, 0x39, 0xed, 0x9b, 0x06, 0xab, 0xcd, 0xba, 0xff, 0xe6, 0x44, 0xbb, 0x6b, 0x30, 0x74,
+	0x13, 0x16, 0x1c, 0x7a, 0xd6, 0x97, 0x8e, 0x98, 0x73, 0xad, 0x99, 0x77, 0xe8, 0xd9, 0x8e, 0x68,
+	0xa3, 0xef, 0x61, 0xc6, 0xb4, 0x3f, 0x50, 0x56, 0x9b, 0x6f, 0x94, 0x5a, 0xe5, 0xcd, 0xdb, 0xa9,
+	0xb6, 0xfc, 0x48, 0x2e, 0x7e, 0xc2, 0xd6, 0x98, 0xec, 0x63, 0xd3, 0xd1, 0x24, 0xbe, 0xf9, 0x57,
+	0x01, 0x6e, 0x74, 0x08, 0xd3, 0x1d, 0xf3, 0x88, 0xf4, 0x3c, 0x2b, 0x3e, 0x3d, 0x2d, 0x9a, 0x50,
+	0xd1, 0xa9, 0x65, 0x11, 0x9d, 0x9b, 0xd4, 0x0e, 0x42, 0x18, 0xeb, 0x43, 0xdf, 0x00, 0x78, 0xd3,
+	0xed, 0x76, 0x58, 0xad, 0xe4, 0x4e, 0x32, 0xd2, 0xd3, 0x1c, 0x43, 0xd5, 0x33, 0x44, 0x10, 0x77,
+	0xed, 0x0f, 0x74, 0x82, 0xb6, 0x90, 0x42, 0xdb, 0x80, 0xf2, 0x08, 0x3b, 0xdc, 0x8c, 0x29, 0x47,
+	0xbb, 0xc4, 0x5a, 0x09, 0x64, 0xbc, 0x70, 0x86, 0x1d, 0xcd, 0x7f, 0x8b, 0x50, 0xf1, 0x74, 0x85,
+	0x26, 0x43, 0x1d, 0x58, 0x10, 0x73, 0xea, 0x0b, 0x3f, 0x79, 0x2e, 0xb8, 0xdf, 0x4e, 0xdf, 0xa6,
+	0xda, 0x09, 0x83, 0xb5, 0xf9, 0x23, 0xdf, 0xf4, 0x0e, 0x94, 0xe5, 0x36, 0x23, 0xc3, 0x53, 0x74,
+	0xc3, 0xf3, 0x5d, 0x9c, 0x47, 0x6c, 0x4c, 0xed, 0x40, 0xdb, 0x20, 0xe7, 0x2e, 0x07, 0x98, 0xfe,
+	0x27, 0x43, 0x04, 0x56, 0xc8, 0x39, 0x77, 0x70, 0x3f, 0xca, 0x55, 0x72, 0xb9, 0x7e, 0xb8, 0xc4,
+	0x26, 0x97, 0xa0, 0xfd, 0x42, 0x8c, 0x0e, 0xb8, 0xd9, 0x0b, 0x9b, 0x3b, 0x17, 0x5a, 0x95, 0xc4,
+	0x7b, 0xeb, 0xbf, 0xc3, 0xb5, 0x34, 0x20, 0x5a, 0x86, 0xd2, 0x09, 0xb9, 0xf0, 0xdc, 0x2e, 0x3e,
+	0xd1, 0x26, 0xcc, 0x9c, 0x8a, 0x54, 0xaa, 0x15, 0xd3, 0x72, 0xc3, 0x9d, 0x50, 0x38, 0x13, 0x09,
+	0x7d, 0x5e, 0x7c, 0x56, 0x68, 0xfe, 0x53, 0x84, 0xda, 0x64, 0xba, 0x7d, 0xce, 0x5e, 0x91, 0x27,
+	0xe5, 0x06, 0xb0, 0xe8, 0x05, 0x3a, 0xe6, 0xba, 0x6d, 0x95, 0xeb, 0x54, 0x16, 0xc6, 0x7c, 0x2a,
+	0x7d, 0x58, 0x61, 0x91, 0xae, 0x3a, 0x81, 0x95, 0x09, 0x48, 0x8a, 0xf7, 0x9e, 0xc7, 0xbd, 0x77,
+	0x27, 0x4f, 0x08, 0xa3, 0x5e, 0x34, 0xe0, 0xda, 0x2e, 0xe1, 0x3b, 0x0e, 0x31, 0x88, 0xcd, 0x4d,
+	0x6c, 0x7d, 0xfa, 0x82, 0xad, 0xc3, 0xfc, 0x98, 0x89, 0x22, 0x3a, 0x94, 0xc6, 0x2c, 0x68, 0x41,
+	0xbb, 0xf9, 0x67, 0x01, 0x56, 0x13, 0x32, 0x9f, 0x13, 0xa8, 0x0c, 0x29, 0xf1, 0x6f, 0x84, 0x19,
+	0x3b, 0xa3, 0x8e, 0xdc, 0x68, 0x17, 0xb4, 0xa0, 0xbd, 0xf9, 0xdf, 0x1a, 0x2c, 0x68, 0x94, 0xf2,
+	0x1d, 0xe1, 0x12, 0x34, 0x02, 0x24, 0x6c, 0xa2, 0xc3, 0x11, 0xb5, 0x89, 0x2d, 0x37, 0x56, 0x86,
+	0x1e, 0xc7, 0x0d, 0x08, 0x0e, 0x06, 0x93, 0x50, 0xcf, 0x55, 0xf5, 0x7b, 0x8a, 0x11, 0x09, 0x78,
+	0xf3, 0x0a, 0x1a, 0xba, 0x8a, 0xa2, 0x5e, 0x1f, 0x98, 0xfa, 0xc9, 0xce, 0x31, 0xb6, 0x6d, 0x62,
+	0x65, 0x29, 0x26, 0xa0, 0xbe, 0x62, 0x62, 0xd1, 0x7b, 0x8d, 0x1e, 0x77, 0x4c, 0x7b, 0xe0, 0x7b,
+	0xb6, 0x79, 0x05, 0x7d, 0x74, 0x63, 0x2b, 0xd4, 0x4d, 0xc6, 0x4d, 0x9d, 0xf9, 0x82, 0x9b, 0x6a,
+	0xc1, 0x09, 0xf0, 0x94, 0x92, 0x7d, 0x58, 0xde, 0x71, 0x08, 0xe6, 0x64, 0x27, 0x58, 0x34, 0xe8,
+	0x51, 0xea, 0xd0, 0x24, 0xcc, 0x17, 0xca, 0x4a, 0x80, 0xe6, 0x15, 0xf4, 0x2b, 0x2c, 0x75, 0x1c,
+	0x3a, 0x8a, 0xd0, 0x3f, 0x48, 0xa5, 0x8f, 0x83, 0x72, 0x92, 0xf7, 0x61, 0xf1, 0x15, 0x66, 0x11,
+	0xee, 0xb5, 0x54, 0xee, 0x18, 0xc6, 0xa7, 0xbe, 0x9d, 0x0a, 0xdd, 0xa6, 0xd4, 0x8a, 0xb8, 0xe7,
+	0x0c, 0x90, 0xbf, 0x21, 0x44, 0x54, 0xda, 0xe9, 0x33, 0x98, 0x00, 0xfa, 0x52, 0x1b, 0xb9, 0xf1,
+	0x81, 0xf0, 0x21, 0x94, 0xa5, 0xc3, 0xb7, 0x2c, 0x13, 0x33, 0x74, 0x3f, 0x23, 0x24, 0x2e, 0x22,
+	0xa7, 0xc3, 0xde, 0xc2, 0x82, 0x70, 0xb4, 0x24, 0xbd, 0xab, 0x0c, 0xc4, 0x34, 0x94, 0x3d, 0x80,
+	0x2d, 0x8b, 0x13, 0x47, 0x72, 0xde, 0x4b, 0xe5, 0x0c, 0x01, 0x39, 0x49, 0x0f, 0xa1, 0xec, 0x8e,
+	0xe9, 0x1d, 0x63, 0xc7, 0x50, 0x4d, 0x3f, 0x82, 0xc8, 0x49, 0xab, 0x03, 0x3a, 0x70, 0xc6, 0xb6,
+	0x8e, 0xf9, 0xe5, 0xe1, 0x9c, 0x04, 0xe6, 0x14, 0x79, 0x03, 0xf3, 0x5b, 0x86, 0xf1, 0xd2, 0x24,
+	0x96, 0x81, 0xee, 0xa4, 0x1b, 0xee, 0xfd, 0x9e, 0x2e, 0x68, 0x92, 0x51, 0x1d, 0xb4, 0x69, 0x28,
+	0x6d, 0xa8, 0xf6, 0x8e, 0xe9, 0x59, 0x38, 0x37, 0x86, 0x1e, 0xa6, 0x6f, 0x18, 0x71, 0x94, 0x4f,
+	0xff, 0x28, 0x1f, 0x38, 0x48, 0xe7, 0xf7, 0x50, 0x95, 0xc9, 0xba, 0xef, 0x1f, 0xca, 0x14, 0x7a,
+	0x09, 0x54, 0xce, 0xe9, 0xfc, 0x0c, 0x8b, 0xc2, 0x03, 0x21, 0xf9, 0x9a, 0xd2, 0x4b, 0xd3, 0x52,
+	0x63, 0x58, 0xf1, 0x33, 0x21, 0xa4, 0x5f, 0xcf, 0xcc, 0x98, 0x69, 0x25, 0xde, 0x43, 0xe5, 0x15,
+	0x66, 0x21, 0x7b, 0x4b, 0xb5, 0x89, 0x4d, 0x10, 0xe7, 0xda, 0xc3, 0x4e, 0x60, 0x49, 0x04, 0x26,
+	0x18, 0xcc, 0x14, 0x3b, 0x70, 0x1c, 0xe4, 0x4b, 0x3c, 0xcc, 0x85, 0x0d, 0xc4, 0x6c, 0xa8, 0x26,
+	0x4e, 0x50, 0x8a, 0x40, 0x27, 0x50, 0xd9, 0x89, 0x35, 0x01, 0x0e, 0xf4, 0x08, 0x54, 0x84, 0x2d,
+	0x3d, 0xff, 0x12, 0xd5, 0x52, 0x9a, 0x9b, 0xb8, 0xe1, 0xd4, 0xd7, 0x72, 0x20, 0x23, 0x75, 0x60,
+	0x39, 0x61, 0x03, 0x43, 0x1b, 0xf9, 0x8f, 0x90, 0x52, 0xf1, 0xf1, 0xb4, 0x67, 0xce, 0x68, 0x1d,
+	0x70, 0x8f, 0xd4, 0x99, 0x75, 0xc0, 0x45, 0xe4, 0x4c, 0xb9, 0x63, 0x58, 0xf4, 0x45, 0x25, 0xf1,
+	0x5a, 0xa6, 0xdf, 0x63, 0xd4, 0x0f, 0xf2, 0x40, 0x83, 0x09, 0x78, 0x9b, 0x97, 0x54, 0x51, 0x6f,
+	0x5e, 0x53, 0x1a, 0xbf, 0x4b, 0xe4, 0x6d, 0xa9, 0xe7, 0xde, 0xaa, 0xd3, 0x8d, 0x8f, 0x61, 0x14,
+	0xc6, 0xbb, 0x77, 0xaa, 0x38, 0x92, 0xc5, 0x0e, 0x64, 0x4b, 0xf1, 0x17, 0x1b, 0xb4, 0xae, 0x8a,
+	0x61, 0xea, 0xdb, 0x51, 0xbd, 0x9d, 0x17, 0x1e, 0x48, 0xfe, 0x06, 0x73, 0xde, 0x3b, 0x0a, 0xba,
+	0x97, 0x39, 0x38, 0x78, 0xc2, 0xa9, 0xdf, 0xbf, 0x14, 0x17, 0xb0, 0x63, 0x58, 0x3d, 0x1c, 0x19,
+	0xa2, 0xaa, 0xc9, 0xd3, 0xa2, 0x7f, 0x5e, 0x45, 0x6b, 0x8a, 0x23, 0x66, 0x02, 0xb7, 0xc7, 0x06,
+	0x97, 0x45, 0xc7, 0x82, 0x1b, 0x1a, 0xb1, 0x08, 0x66, 0xa4, 0xf3, 0xf6, 0xf5, 0x1e, 0x61, 0x0c,
+	0x0f, 0x48, 0x8f, 0x3b, 0x04, 0x0f, 0x93, 0xe7, 0x58, 0xf9, 0x66, 0xa7, 0x00, 0xe7, 0xcc, 0x05,
+	0x07, 0xbe, 0xee, 0xda, 0xa7, 0xd8, 0x32, 0x8d, 0x58, 0xa9, 0xde, 0x23, 0x1c, 0xef, 0x60, 0xfd,
+	0x98, 0xa4, 0x6b, 0xc6, 0x87, 0x04, 0xe0, 0xdc, 0xa7, 0x88, 0x55, 0x6f, 0xa5, 0xbe, 0xb4, 0xc6,
+	0xec, 0x58, 0x5c, 0x1b, 0x2c, 0xc2, 0x89, 0x91, 0xdc, 0xe9, 0xc4, 0x7b, 0x51, 0x3b, 0x15, 0x99,
+	0xc3, 0x8d, 0x7d, 0x80, 0x5d, 0xc2, 0xf7, 0x08, 0x77, 0x4c, 0x5d, 0x75, 0xac, 0x0a, 0x01, 0x8a,
+	0x54, 0x48, 0xc1, 0x05, 0xa9, 0xd0, 0x83, 0x59, 0xf9, 0x40, 0x85, 0x9a, 0xa9, 0x83, 0xfc, 0xe7,
+	0xb5, 0xac, 0xeb, 0x84, 0x8f, 0x89, 0xd6, 0x1a, 0xb1, 0x98, 0xc2, 0x87, 0x2f, 0x45, 0xad, 0x89,
+	0x83, 0xb2, 0x6b, 0x4d, 0x12, 0x1b, 0xad, 0x35, 0xaf, 0x4d, 0xe6, 0xfd, 0x3c, 0xc0, 0xec, 0x44,
+	0x75, 0x88, 0x49, 0xa0, 0xb2, 0x6b, 0xcd, 0x04, 0x38, 0xe2, 0xb1, 0x8a, 0x46, 0xc4, 0x0f, 0xcf,
+	0x6f, 0xca, 0xbb, 0x7b, 0xf4, 0x65, 0xf2, 0xb2, 0x38, 0xbf, 0x0b, 0x2e, 0x60, 0xc1, 0x5d, 0x1b,
+	0xdd, 0x55, 0x2d, 0xc6, 0x00, 0x22, 0x9e, 0x05, 0x72, 0x30, 0x7b, 0x6b, 0xfd, 0x4b, 0x33, 0xf7,
+	0x45, 0x35, 0x14, 0x89, 0x1c, 0x61, 0x56, 0x15, 0xee, 0x38, 0x2c, 0xff, 0x0e, 0x2f, 0xc2, 0x20,
+	0xc6, 0x1d, 0x32, 0xe2, 0x30, 0xc5, 0x0e, 0x1f, 0xc3, 0x64, 0x97, 0xa7, 0x04, 0x34, 0x92, 0x43,
+	0x8b, 0xb1, 0x77, 0x0e, 0xf4, 0x48, 0x15, 0xd4, 0xb4, 0x57, 0x97, 0xfa, 0x7a, 0x4e, 0x74, 0x24,
+	0x87, 0x40, 0x86, 0x5b, 0xa3, 0x16, 0x51, 0x2c, 0xeb, 0x10, 0x90, 0xff, 0xc6, 0x21, 0x6a, 0xa8,
+	0x4b, 0x79, 0x47, 0x59, 0x62, 0xa7, 0x20, 0x7c, 0x0f, 0xd5, 0x37, 0x23, 0xe2, 0x60, 0x4e, 0x84,
+	0xbf, 0x5c, 0xde, 0xf4, 0x95, 0x95, 0x40, 0xe5, 0xbe, 0xb6, 0x43, 0x8f, 0x88, 0x9d, 0x3a, 0xc3,
+	0x09, 0x21, 0x20, 0x7b, 0x6f, 0x8b, 0xe2, 0x22, 0xaf, 0x1a, 0x9e, 0x80, 0x30, 0x2c, 0x53, 0xc0,
+	0xb5, 0x3c, 0x87, 0x80, 0xc4, 0x45, 0x9f, 0x4d, 0xbc, 0xa9, 0xef, 0x3b, 0xe6, 0xa9, 0x69, 0x91,
+	0x01, 0x51, 0xac, 0x80, 0x24, 0x2c, 0xa7, 0x8b, 0x8e, 0xa0, 0x2c, 0x85, 0x77, 0x1d, 0x6c, 0x73,
+	0x94, 0x65, 0x9a, 0x8b, 0xf0, 0x69, 0x5b, 0x97, 0x03, 0x83, 0x49, 0xe8, 0x00, 0x62, 0x59, 0xec,
+	0x53, 0xcb, 0xd4, 0x2f, 0x50, 0x4b, 0xb1, 0x35, 0x84, 0x10, 0xc5, 0xc9, 0x39, 0x15, 0x39, 0x79,
+	0xf3, 0xd3, 0xe8, 0x99, 0xa7, 0xf4, 0x30, 0x33, 0xeb, 0xcf, 0xe2, 0x62, 0xf9, 0x6e, 0x7e, 0x21,
+	0xf9, 0x5a, 0x46, 0xfe, 0x4f, 0x47, 0xed, 0x95, 0x17, 0x7f, 0x98, 0x49, 0xb2, 0xca, 0x4b, 0x04,
+	0x75, 0x79, 0x79, 0x89, 0x81, 0x7d, 0x4f, 0x6d, 0x3f, 0xfb, 0xe5, 0xe9, 0xc0, 0xe4, 0xc7, 0xe3,
+	0x23, 0x61, 0xc9, 0x86, 0x84, 0xaf, 0x9b, 0xd4, 0xfb, 0xda, 0xf0, 0xdd, 0xbc, 0xe1, 0xd2, 0x6d,
+	0x04, 0x5b, 0xcd, 0xe8, 0xe8, 0x68, 0xd6, 0xed, 0x7a, 0xf2, 0xff, 0x00, 0x0d, 0x40, 0x2c, 0x8a,
+	0x5c, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -827,7 +833,11 @@ type RootCoordClient interface {
 	CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	AlterShards(ctx context.Context, in *milvuspb.AlterShardsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	TruncateCollection(ctx context.Context, in *milvuspb.TruncateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AddField(ctx context.Context, in *milvuspb.AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropField(ctx context.Context, in *milvuspb.DropFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//
 	// @brief This method is used to list all collections.
 	//
 	// @return StringListResponse, collection name list
@@ -842,7 +852,8 @@ type RootCoordClient interface {
 	//
 	// @return Status
 	DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
+	TruncatePartition(ctx context.Context, in *milvuspb.TruncatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//
 	// @brief This method is used to test partition existence.
 	//
 	// @return BoolResponse
@@ -890,6 +901,9 @@ type RootCoordClient interface {
 	OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest, opts ...grpc.CallOption) (*milvuspb.SelectGrantResponse, error)
 	ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest, opts ...grpc.CallOption) (*internalpb.ListPolicyResponse, error)
+	CreateRowPolicy(ctx context.Context, in *milvuspb.CreateRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropRowPolicy(ctx context.Context, in *milvuspb.DropRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListRowPolicies(ctx context.Context, in *milvuspb.ListRowPoliciesRequest, opts ...grpc.CallOption) (*milvuspb.ListRowPoliciesResponse, error)
 }
 
 type rootCoordClient struct {
@@ -990,6 +1004,42 @@ func (c *rootCoordClient) AlterAlias(ctx context.Context, in *milvuspb.AlterAlia
 	return out, nil
 }
 
+func (c *rootCoordClient) AlterShards(ctx context.Context, in *milvuspb.AlterShardsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AlterShards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) TruncateCollection(ctx context.Context, in *milvuspb.TruncateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/TruncateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) AddField(ctx context.Context, in *milvuspb.AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AddField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropField(ctx context.Context, in *milvuspb.DropFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error) {
 	out := new(milvuspb.ShowCollectionsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ShowCollections", in, out, opts...)
@@ -1017,6 +1067,15 @@ func (c *rootCoordClient) DropPartition(ctx context.Context, in *milvuspb.DropPa
 	return out, nil
 }
 
+func (c *rootCoordClient) TruncatePartition(ctx context.Context, in *milvuspb.TruncatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/TruncatePartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) HasPartition(ctx context.Context, in *milvuspb.HasPartitionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error) {
 	out := new(milvuspb.BoolResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/HasPartition", in, out, opts...)
@@ -1314,6 +1373,33 @@ func (c *rootCoordClient) ListPolicy(ctx context.Context, in *internalpb.ListPol
 	return out, nil
 }
 
+func (c *rootCoordClient) CreateRowPolicy(ctx context.Context, in *milvuspb.CreateRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateRowPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropRowPolicy(ctx context.Context, in *milvuspb.DropRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropRowPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListRowPolicies(ctx context.Context, in *milvuspb.ListRowPoliciesRequest, opts ...grpc.CallOption) (*milvuspb.ListRowPoliciesResponse, error) {
+	out := new(milvuspb.ListRowPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListRowPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RootCoordServer is the server API for RootCoord service.
 type RootCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -1350,7 +1436,11 @@ type RootCoordServer interface {
 	CreateAlias(context.Context, *milvuspb.CreateAliasRequest) (*commonpb.Status, error)
 	DropAlias(context.Context, *milvuspb.DropAliasRequest) (*commonpb.Status, error)
 	AlterAlias(context.Context, *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
-	//*
+	AlterShards(context.Context, *milvuspb.AlterShardsRequest) (*commonpb.Status, error)
+	TruncateCollection(context.Context, *milvuspb.TruncateCollectionRequest) (*commonpb.Status, error)
+	AddField(context.Context, *milvuspb.AddFieldRequest) (*commonpb.Status, error)
+	DropField(context.Context, *milvuspb.DropFieldRequest) (*commonpb.Status, error)
+	//
 	// @brief This method is used to list all collections.
 	//
 	// @return StringListResponse, collection name list
@@ -1365,7 +1455,8 @@ type RootCoordServer interface {
 	//
 	// @return Status
 	DropPartition(context.Context, *milvuspb.DropPartitionRequest) (*commonpb.Status, error)
-	//*
+	TruncatePartition(context.Context, *milvuspb.TruncatePartitionRequest) (*commonpb.Status, error)
+	//
 	// @brief This method is used to test partition existence.
 	//
 	// @return BoolResponse
@@ -1413,6 +1504,9 @@ type RootCoordServer interface {
 	OperatePrivilege(context.Context, *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)
 	SelectGrant(context.Context, *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
 	ListPolicy(context.Context, *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error)
+	CreateRowPolicy(context.Context, *milvuspb.CreateRowPolicyRequest) (*commonpb.Status, error)
+	DropRowPolicy(context.Context, *milvuspb.DropRowPolicyRequest) (*commonpb.Status, error)
+	ListRowPolicies(context.Context, *milvuspb.ListRowPoliciesRequest) (*milvuspb.ListRowPoliciesResponse, error)
 }
 
 // UnimplementedRootCoordServer can be embedded to have forward compatible implementations.
@@ -1449,6 +1543,18 @@ func (*UnimplementedRootCoordServer) DropAlias(ctx context.Context, req *milvusp
 func (*UnimplementedRootCoordServer) AlterAlias(ctx context.Context, req *milvuspb.AlterAliasRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AlterAlias not implemented")
 }
+func (*UnimplementedRootCoordServer) AlterShards(ctx context.Context, req *milvuspb.AlterShardsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterShards not implemented")
+}
+func (*UnimplementedRootCoordServer) TruncateCollection(ctx context.Context, req *milvuspb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TruncateCollection not implemented")
+}
+func (*UnimplementedRootCoordServer) AddField(ctx context.Context, req *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddField not implemented")
+}
+func (*UnimplementedRootCoordServer) DropField(ctx context.Context, req *milvuspb.DropFieldRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropField not implemented")
+}
 func (*UnimplementedRootCoordServer) ShowCollections(ctx context.Context, req *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowCollections not implemented")
 }
@@ -1458,6 +1564,9 @@ func (*UnimplementedRootCoordServer) CreatePartition(ctx context.Context, req *m
 func (*UnimplementedRootCoordServer) DropPartition(ctx context.Context, req *milvuspb.DropPartitionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropPartition not implemented")
 }
+func (*UnimplementedRootCoordServer) TruncatePartition(ctx context.Context, req *milvuspb.TruncatePartitionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TruncatePartition not implemented")
+}
 func (*UnimplementedRootCoordServer) HasPartition(ctx context.Context, req *milvuspb.HasPartitionRequest) (*milvuspb.BoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HasPartition not implemented")
 }
@@ -1557,6 +1666,15 @@ func (*UnimplementedRootCoordServer) SelectGrant(ctx context.Context, req *milvu
 func (*UnimplementedRootCoordServer) ListPolicy(ctx context.Context, req *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPolicy not implemented")
 }
+func (*UnimplementedRootCoordServer) CreateRowPolicy(ctx context.Context, req *milvuspb.CreateRowPolicyRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRowPolicy not implemented")
+}
+func (*UnimplementedRootCoordServer) DropRowPolicy(ctx context.Context, req *milvuspb.DropRowPolicyRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropRowPolicy not implemented")
+}
+func (*UnimplementedRootCoordServer) ListRowPolicies(ctx context.Context, req *milvuspb.ListRowPoliciesRequest) (*milvuspb.ListRowPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRowPolicies not implemented")
+}
 
 func RegisterRootCoordServer(s *grpc.Server, srv RootCoordServer) {
 	s.RegisterService(&_RootCoord_serviceDesc, srv)
@@ -1742,6 +1860,78 @@ func _RootCoord_AlterAlias_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_AlterShards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.AlterShardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AlterShards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AlterShards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AlterShards(ctx, req.(*milvuspb.AlterShardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_TruncateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.TruncateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).TruncateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/TruncateCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).TruncateCollection(ctx, req.(*milvuspb.TruncateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_AddField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.AddFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AddField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AddField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AddField(ctx, req.(*milvuspb.AddFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropField(ctx, req.(*milvuspb.DropFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_ShowCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.ShowCollectionsRequest)
 	if err := dec(in); err != nil {
@@ -1796,6 +1986,24 @@ func _RootCoord_DropPartition_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_TruncatePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.TruncatePartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).TruncatePartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/TruncatePartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).TruncatePartition(ctx, req.(*milvuspb.TruncatePartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_HasPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.HasPartitionRequest)
 	if err := dec(in); err != nil {
@@ -2390,6 +2598,60 @@ func _RootCoord_ListPolicy_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_CreateRowPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateRowPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateRowPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateRowPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateRowPolicy(ctx, req.(*milvuspb.CreateRowPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropRowPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropRowPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropRowPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropRowPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropRowPolicy(ctx, req.(*milvuspb.DropRowPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListRowPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListRowPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListRowPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListRowPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListRowPolicies(ctx, req.(*milvuspb.ListRowPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RootCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.rootcoord.RootCoord",
 	HandlerType: (*RootCoordServer)(nil),
@@ -2434,6 +2696,22 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AlterAlias",
 			Handler:    _RootCoord_AlterAlias_Handler,
 		},
+		{
+			MethodName: "AlterShards",
+			Handler:    _RootCoord_AlterShards_Handler,
+		},
+		{
+			MethodName: "TruncateCollection",
+			Handler:    _RootCoord_TruncateCollection_Handler,
+		},
+		{
+			MethodName: "AddField",
+			Handler:    _RootCoord_AddField_Handler,
+		},
+		{
+			MethodName: "DropField",
+			Handler:    _RootCoord_DropField_Handler,
+		},
 		{
 			MethodName: "ShowCollections",
 			Handler:    _RootCoord_ShowCollections_Handler,
@@ -2446,6 +2724,10 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DropPartition",
 			Handler:    _RootCoord_DropPartition_Handler,
 		},
+		{
+			MethodName: "TruncatePartition",
+			Handler:    _RootCoord_TruncatePartition_Handler,
+		},
 		{
 			MethodName: "HasPartition",
 			Handler:    _RootCoord_HasPartition_Handler,
@@ -2578,6 +2860,18 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListPolicy",
 			Handler:    _RootCoord_ListPolicy_Handler,
 		},
+		{
+			MethodName: "CreateRowPolicy",
+			Handler:    _RootCoord_CreateRowPolicy_Handler,
+		},
+		{
+			MethodName: "DropRowPolicy",
+			Handler:    _RootCoord_DropRowPolicy_Handler,
+		},
+		{
+			MethodName: "ListRowPolicies",
+			Handler:    _RootCoord_ListRowPolicies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "root_coord.proto",
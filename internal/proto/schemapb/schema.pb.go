@@ -26,18 +26,21 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type DataType int32
 
 const (
-	DataType_None         DataType = 0
-	DataType_Bool         DataType = 1
-	DataType_Int8         DataType = 2
-	DataType_Int16        DataType = 3
-	DataType_Int32        DataType = 4
-	DataType_Int64        DataType = 5
-	DataType_Float        DataType = 10
-	DataType_Double       DataType = 11
-	DataType_String       DataType = 20
-	DataType_VarChar      DataType = 21
-	DataType_BinaryVector DataType = 100
-	DataType_FloatVector  DataType = 101
+	DataType_None              DataType = 0
+	DataType_Bool              DataType = 1
+	DataType_Int8              DataType = 2
+	DataType_Int16             DataType = 3
+	DataType_Int32             DataType = 4
+	DataType_Int64             DataType = 5
+	DataType_Float             DataType = 10
+	DataType_Double            DataType = 11
+	DataType_String            DataType = 20
+	DataType_VarChar           DataType = 21
+	DataType_BinaryVector      DataType = 100
+	DataType_FloatVector       DataType = 101
+	DataType_SparseFloatVector DataType = 102
+	DataType_Float16Vector     DataType = 103
+	DataType_BFloat16Vector    DataType = 104
 )
 
 var DataType_name = map[int32]string{
@@ -53,21 +56,27 @@ var DataType_name = map[int32]string{
 	21:  "VarChar",
 	100: "BinaryVector",
 	101: "FloatVector",
+	102: "SparseFloatVector",
+	103: "Float16Vector",
+	104: "BFloat16Vector",
 }
 
 var DataType_value = map[string]int32{
-	"None":         0,
-	"Bool":         1,
-	"Int8":         2,
-	"Int16":        3,
-	"Int32":        4,
-	"Int64":        5,
-	"Float":        10,
-	"Double":       11,
-	"String":       20,
-	"VarChar":      21,
-	"BinaryVector": 100,
-	"FloatVector":  101,
+	"None":              0,
+	"Bool":              1,
+	"Int8":              2,
+	"Int16":             3,
+	"Int32":             4,
+	"Int64":             5,
+	"Float":             10,
+	"Double":            11,
+	"String":            20,
+	"VarChar":           21,
+	"BinaryVector":      100,
+	"FloatVector":       101,
+	"SparseFloatVector": 102,
+	"Float16Vector":     103,
+	"BFloat16Vector":    104,
 }
 
 func (x DataType) String() string {
@@ -89,6 +98,9 @@ type FieldSchema struct {
 	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
 	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
 	AutoID               bool                     `protobuf:"varint,8,opt,name=autoID,proto3" json:"autoID,omitempty"`
+	IsClusteringKey      bool                     `protobuf:"varint,9,opt,name=is_clustering_key,json=isClusteringKey,proto3" json:"is_clustering_key,omitempty"`
+	Nullable             bool                     `protobuf:"varint,10,opt,name=nullable,proto3" json:"nullable,omitempty"`
+	DefaultValue         *ValueField              `protobuf:"bytes,11,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -175,13 +187,175 @@ func (m *FieldSchema) GetAutoID() bool {
 	return false
 }
 
-//*
+func (m *FieldSchema) GetIsClusteringKey() bool {
+	if m != nil {
+		return m.IsClusteringKey
+	}
+	return false
+}
+
+func (m *FieldSchema) GetNullable() bool {
+	if m != nil {
+		return m.Nullable
+	}
+	return false
+}
+
+func (m *FieldSchema) GetDefaultValue() *ValueField {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return nil
+}
+
+// @brief Scalar value of a field, used as default value
+type ValueField struct {
+	// Types that are valid to be assigned to Data:
+	//	*ValueField_BoolData
+	//	*ValueField_IntData
+	//	*ValueField_LongData
+	//	*ValueField_FloatData
+	//	*ValueField_DoubleData
+	//	*ValueField_StringData
+	Data                 isValueField_Data `protobuf_oneof:"data"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ValueField) Reset()         { *m = ValueField{} }
+func (m *ValueField) String() string { return proto.CompactTextString(m) }
+func (*ValueField) ProtoMessage()    {}
+func (*ValueField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{1}
+}
+
+func (m *ValueField) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValueField.Unmarshal(m, b)
+}
+func (m *ValueField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValueField.Marshal(b, m, deterministic)
+}
+func (m *ValueField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueField.Merge(m, src)
+}
+func (m *ValueField) XXX_Size() int {
+	return xxx_messageInfo_ValueField.Size(m)
+}
+func (m *ValueField) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValueField proto.InternalMessageInfo
+
+type isValueField_Data interface {
+	isValueField_Data()
+}
+
+type ValueField_BoolData struct {
+	BoolData bool `protobuf:"varint,1,opt,name=bool_data,json=boolData,proto3,oneof"`
+}
+
+type ValueField_IntData struct {
+	IntData int32 `protobuf:"varint,2,opt,name=int_data,json=intData,proto3,oneof"`
+}
+
+type ValueField_LongData struct {
+	LongData int64 `protobuf:"varint,3,opt,name=long_data,json=longData,proto3,oneof"`
+}
+
+type ValueField_FloatData struct {
+	FloatData float32 `protobuf:"fixed32,4,opt,name=float_data,json=floatData,proto3,oneof"`
+}
+
+type ValueField_DoubleData struct {
+	DoubleData float64 `protobuf:"fixed64,5,opt,name=double_data,json=doubleData,proto3,oneof"`
+}
+
+type ValueField_StringData struct {
+	StringData string `protobuf:"bytes,6,opt,name=string_data,json=stringData,proto3,oneof"`
+}
+
+func (*ValueField_BoolData) isValueField_Data() {}
+
+func (*ValueField_IntData) isValueField_Data() {}
+
+func (*ValueField_LongData) isValueField_Data() {}
+
+func (*ValueField_FloatData) isValueField_Data() {}
+
+func (*ValueField_DoubleData) isValueField_Data() {}
+
+func (*ValueField_StringData) isValueField_Data() {}
+
+func (m *ValueField) GetData() isValueField_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ValueField) GetBoolData() bool {
+	if x, ok := m.GetData().(*ValueField_BoolData); ok {
+		return x.BoolData
+	}
+	return false
+}
+
+func (m *ValueField) GetIntData() int32 {
+	if x, ok := m.GetData().(*ValueField_IntData); ok {
+		return x.IntData
+	}
+	return 0
+}
+
+func (m *ValueField) GetLongData() int64 {
+	if x, ok := m.GetData().(*ValueField_LongData); ok {
+		return x.LongData
+	}
+	return 0
+}
+
+func (m *ValueField) GetFloatData() float32 {
+	if x, ok := m.GetData().(*ValueField_FloatData); ok {
+		return x.FloatData
+	}
+	return 0
+}
+
+func (m *ValueField) GetDoubleData() float64 {
+	if x, ok := m.GetData().(*ValueField_DoubleData); ok {
+		return x.DoubleData
+	}
+	return 0
+}
+
+func (m *ValueField) GetStringData() string {
+	if x, ok := m.GetData().(*ValueField_StringData); ok {
+		return x.StringData
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ValueField) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ValueField_BoolData)(nil),
+		(*ValueField_IntData)(nil),
+		(*ValueField_LongData)(nil),
+		(*ValueField_FloatData)(nil),
+		(*ValueField_DoubleData)(nil),
+		(*ValueField_StringData)(nil),
+	}
+}
+
 // @brief Collection schema
 type CollectionSchema struct {
 	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Description          string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	AutoID               bool           `protobuf:"varint,3,opt,name=autoID,proto3" json:"autoID,omitempty"`
 	Fields               []*FieldSchema `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	Version              int32          `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -191,7 +365,7 @@ func (m *CollectionSchema) Reset()         { *m = CollectionSchema{} }
 func (m *CollectionSchema) String() string { return proto.CompactTextString(m) }
 func (*CollectionSchema) ProtoMessage()    {}
 func (*CollectionSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{1}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{2}
 }
 
 func (m *CollectionSchema) XXX_Unmarshal(b []byte) error {
@@ -240,6 +414,13 @@ func (m *CollectionSchema) GetFields() []*FieldSchema {
 	return nil
 }
 
+func (m *CollectionSchema) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type BoolArray struct {
 	Data                 []bool   `protobuf:"varint,1,rep,packed,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -251,7 +432,7 @@ func (m *BoolArray) Reset()         { *m = BoolArray{} }
 func (m *BoolArray) String() string { return proto.CompactTextString(m) }
 func (*BoolArray) ProtoMessage()    {}
 func (*BoolArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{2}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{3}
 }
 
 func (m *BoolArray) XXX_Unmarshal(b []byte) error {
@@ -290,7 +471,7 @@ func (m *IntArray) Reset()         { *m = IntArray{} }
 func (m *IntArray) String() string { return proto.CompactTextString(m) }
 func (*IntArray) ProtoMessage()    {}
 func (*IntArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{3}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{4}
 }
 
 func (m *IntArray) XXX_Unmarshal(b []byte) error {
@@ -329,7 +510,7 @@ func (m *LongArray) Reset()         { *m = LongArray{} }
 func (m *LongArray) String() string { return proto.CompactTextString(m) }
 func (*LongArray) ProtoMessage()    {}
 func (*LongArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{4}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{5}
 }
 
 func (m *LongArray) XXX_Unmarshal(b []byte) error {
@@ -368,7 +549,7 @@ func (m *FloatArray) Reset()         { *m = FloatArray{} }
 func (m *FloatArray) String() string { return proto.CompactTextString(m) }
 func (*FloatArray) ProtoMessage()    {}
 func (*FloatArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{5}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{6}
 }
 
 func (m *FloatArray) XXX_Unmarshal(b []byte) error {
@@ -407,7 +588,7 @@ func (m *DoubleArray) Reset()         { *m = DoubleArray{} }
 func (m *DoubleArray) String() string { return proto.CompactTextString(m) }
 func (*DoubleArray) ProtoMessage()    {}
 func (*DoubleArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{6}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{7}
 }
 
 func (m *DoubleArray) XXX_Unmarshal(b []byte) error {
@@ -447,7 +628,7 @@ func (m *BytesArray) Reset()         { *m = BytesArray{} }
 func (m *BytesArray) String() string { return proto.CompactTextString(m) }
 func (*BytesArray) ProtoMessage()    {}
 func (*BytesArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{7}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{8}
 }
 
 func (m *BytesArray) XXX_Unmarshal(b []byte) error {
@@ -486,7 +667,7 @@ func (m *StringArray) Reset()         { *m = StringArray{} }
 func (m *StringArray) String() string { return proto.CompactTextString(m) }
 func (*StringArray) ProtoMessage()    {}
 func (*StringArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{8}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{9}
 }
 
 func (m *StringArray) XXX_Unmarshal(b []byte) error {
@@ -533,7 +714,7 @@ func (m *ScalarField) Reset()         { *m = ScalarField{} }
 func (m *ScalarField) String() string { return proto.CompactTextString(m) }
 func (*ScalarField) ProtoMessage()    {}
 func (*ScalarField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{9}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{10}
 }
 
 func (m *ScalarField) XXX_Unmarshal(b []byte) error {
@@ -669,11 +850,64 @@ func (*ScalarField) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// Each row of a sparse float vector is encoded as a sequence of little-endian
+// (uint32 index, float32 value) pairs sorted by index. dim is the maximum
+// dimension among all rows, i.e. the largest index plus one.
+type SparseFloatArray struct {
+	Contents             [][]byte `protobuf:"bytes,1,rep,name=contents,proto3" json:"contents,omitempty"`
+	Dim                  int64    `protobuf:"varint,2,opt,name=dim,proto3" json:"dim,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SparseFloatArray) Reset()         { *m = SparseFloatArray{} }
+func (m *SparseFloatArray) String() string { return proto.CompactTextString(m) }
+func (*SparseFloatArray) ProtoMessage()    {}
+func (*SparseFloatArray) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{11}
+}
+
+func (m *SparseFloatArray) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SparseFloatArray.Unmarshal(m, b)
+}
+func (m *SparseFloatArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SparseFloatArray.Marshal(b, m, deterministic)
+}
+func (m *SparseFloatArray) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SparseFloatArray.Merge(m, src)
+}
+func (m *SparseFloatArray) XXX_Size() int {
+	return xxx_messageInfo_SparseFloatArray.Size(m)
+}
+func (m *SparseFloatArray) XXX_DiscardUnknown() {
+	xxx_messageInfo_SparseFloatArray.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SparseFloatArray proto.InternalMessageInfo
+
+func (m *SparseFloatArray) GetContents() [][]byte {
+	if m != nil {
+		return m.Contents
+	}
+	return nil
+}
+
+func (m *SparseFloatArray) GetDim() int64 {
+	if m != nil {
+		return m.Dim
+	}
+	return 0
+}
+
 type VectorField struct {
 	Dim int64 `protobuf:"varint,1,opt,name=dim,proto3" json:"dim,omitempty"`
 	// Types that are valid to be assigned to Data:
 	//	*VectorField_FloatVector
 	//	*VectorField_BinaryVector
+	//	*VectorField_SparseFloatVector
+	//	*VectorField_Float16Vector
+	//	*VectorField_Bfloat16Vector
 	Data                 isVectorField_Data `protobuf_oneof:"data"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
@@ -684,7 +918,7 @@ func (m *VectorField) Reset()         { *m = VectorField{} }
 func (m *VectorField) String() string { return proto.CompactTextString(m) }
 func (*VectorField) ProtoMessage()    {}
 func (*VectorField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{10}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{12}
 }
 
 func (m *VectorField) XXX_Unmarshal(b []byte) error {
@@ -724,10 +958,28 @@ type VectorField_BinaryVector struct {
 	BinaryVector []byte `protobuf:"bytes,3,opt,name=binary_vector,json=binaryVector,proto3,oneof"`
 }
 
+type VectorField_SparseFloatVector struct {
+	SparseFloatVector *SparseFloatArray `protobuf:"bytes,4,opt,name=sparse_float_vector,json=sparseFloatVector,proto3,oneof"`
+}
+
+type VectorField_Float16Vector struct {
+	Float16Vector []byte `protobuf:"bytes,5,opt,name=float16_vector,json=float16Vector,proto3,oneof"`
+}
+
+type VectorField_Bfloat16Vector struct {
+	Bfloat16Vector []byte `protobuf:"bytes,6,opt,name=bfloat16_vector,json=bfloat16Vector,proto3,oneof"`
+}
+
 func (*VectorField_FloatVector) isVectorField_Data() {}
 
 func (*VectorField_BinaryVector) isVectorField_Data() {}
 
+func (*VectorField_SparseFloatVector) isVectorField_Data() {}
+
+func (*VectorField_Float16Vector) isVectorField_Data() {}
+
+func (*VectorField_Bfloat16Vector) isVectorField_Data() {}
+
 func (m *VectorField) GetData() isVectorField_Data {
 	if m != nil {
 		return m.Data
@@ -749,11 +1001,35 @@ func (m *VectorField) GetBinaryVector() []byte {
 	return nil
 }
 
+func (m *VectorField) GetSparseFloatVector() *SparseFloatArray {
+	if x, ok := m.GetData().(*VectorField_SparseFloatVector); ok {
+		return x.SparseFloatVector
+	}
+	return nil
+}
+
+func (m *VectorField) GetFloat16Vector() []byte {
+	if x, ok := m.GetData().(*VectorField_Float16Vector); ok {
+		return x.Float16Vector
+	}
+	return nil
+}
+
+func (m *VectorField) GetBfloat16Vector() []byte {
+	if x, ok := m.GetData().(*VectorField_Bfloat16Vector); ok {
+		return x.Bfloat16Vector
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*VectorField) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*VectorField_FloatVector)(nil),
 		(*VectorField_BinaryVector)(nil),
+		(*VectorField_SparseFloatVector)(nil),
+		(*VectorField_Float16Vector)(nil),
+		(*VectorField_Bfloat16Vector)(nil),
 	}
 }
 
@@ -765,6 +1041,7 @@ type FieldData struct {
 	//	*FieldData_Vectors
 	Field                isFieldData_Field `protobuf_oneof:"field"`
 	FieldId              int64             `protobuf:"varint,5,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	ValidData            []bool            `protobuf:"varint,6,rep,packed,name=valid_data,json=validData,proto3" json:"valid_data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -774,7 +1051,7 @@ func (m *FieldData) Reset()         { *m = FieldData{} }
 func (m *FieldData) String() string { return proto.CompactTextString(m) }
 func (*FieldData) ProtoMessage()    {}
 func (*FieldData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{11}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{13}
 }
 
 func (m *FieldData) XXX_Unmarshal(b []byte) error {
@@ -853,6 +1130,13 @@ func (m *FieldData) GetFieldId() int64 {
 	return 0
 }
 
+func (m *FieldData) GetValidData() []bool {
+	if m != nil {
+		return m.ValidData
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*FieldData) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -875,7 +1159,7 @@ func (m *IDs) Reset()         { *m = IDs{} }
 func (m *IDs) String() string { return proto.CompactTextString(m) }
 func (*IDs) ProtoMessage()    {}
 func (*IDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{12}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{14}
 }
 
 func (m *IDs) XXX_Unmarshal(b []byte) error {
@@ -948,6 +1232,7 @@ type SearchResultData struct {
 	Scores               []float32    `protobuf:"fixed32,4,rep,packed,name=scores,proto3" json:"scores,omitempty"`
 	Ids                  *IDs         `protobuf:"bytes,5,opt,name=ids,proto3" json:"ids,omitempty"`
 	Topks                []int64      `protobuf:"varint,6,rep,packed,name=topks,proto3" json:"topks,omitempty"`
+	GroupByFieldValue    *FieldData   `protobuf:"bytes,7,opt,name=group_by_field_value,json=groupByFieldValue,proto3" json:"group_by_field_value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -957,7 +1242,7 @@ func (m *SearchResultData) Reset()         { *m = SearchResultData{} }
 func (m *SearchResultData) String() string { return proto.CompactTextString(m) }
 func (*SearchResultData) ProtoMessage()    {}
 func (*SearchResultData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{13}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{15}
 }
 
 func (m *SearchResultData) XXX_Unmarshal(b []byte) error {
@@ -1020,9 +1305,17 @@ func (m *SearchResultData) GetTopks() []int64 {
 	return nil
 }
 
+func (m *SearchResultData) GetGroupByFieldValue() *FieldData {
+	if m != nil {
+		return m.GroupByFieldValue
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.schema.DataType", DataType_name, DataType_value)
 	proto.RegisterType((*FieldSchema)(nil), "milvus.proto.schema.FieldSchema")
+	proto.RegisterType((*ValueField)(nil), "milvus.proto.schema.ValueField")
 	proto.RegisterType((*CollectionSchema)(nil), "milvus.proto.schema.CollectionSchema")
 	proto.RegisterType((*BoolArray)(nil), "milvus.proto.schema.BoolArray")
 	proto.RegisterType((*IntArray)(nil), "milvus.proto.schema.IntArray")
@@ -1032,6 +1325,7 @@ func init() {
 	proto.RegisterType((*BytesArray)(nil), "milvus.proto.schema.BytesArray")
 	proto.RegisterType((*StringArray)(nil), "milvus.proto.schema.StringArray")
 	proto.RegisterType((*ScalarField)(nil), "milvus.proto.schema.ScalarField")
+	proto.RegisterType((*SparseFloatArray)(nil), "milvus.proto.schema.SparseFloatArray")
 	proto.RegisterType((*VectorField)(nil), "milvus.proto.schema.VectorField")
 	proto.RegisterType((*FieldData)(nil), "milvus.proto.schema.FieldData")
 	proto.RegisterType((*IDs)(nil), "milvus.proto.schema.IDs")
@@ -1041,68 +1335,85 @@ func init() {
 func init() { proto.RegisterFile("schema.proto", fileDescriptor_1c5fb4d8cc22d66a) }
 
 var fileDescriptor_1c5fb4d8cc22d66a = []byte{
-	// 995 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x5d, 0x6f, 0xe3, 0x44,
-	0x17, 0xce, 0xc4, 0xf9, 0xb0, 0x8f, 0xf3, 0xf6, 0xb5, 0x66, 0x17, 0x64, 0x90, 0x76, 0x9b, 0x8d,
-	0x40, 0x8a, 0x56, 0xa2, 0xd5, 0xb6, 0x68, 0x59, 0x56, 0xac, 0x80, 0x34, 0xaa, 0x12, 0x15, 0xad,
-	0x82, 0x8b, 0x8a, 0xc4, 0x4d, 0x34, 0x89, 0x67, 0xdb, 0x51, 0x6d, 0x8f, 0x99, 0x99, 0xac, 0xc8,
-	0x0f, 0xe0, 0x1f, 0x70, 0x85, 0xb8, 0xe0, 0x8f, 0x71, 0x81, 0xf8, 0x1d, 0x48, 0x68, 0x3e, 0x92,
-	0x18, 0xe2, 0xad, 0x7a, 0x77, 0x66, 0x7c, 0x9e, 0x67, 0xce, 0x79, 0xce, 0x87, 0xa1, 0x27, 0x97,
-	0x37, 0x34, 0x27, 0x47, 0xa5, 0xe0, 0x8a, 0xe3, 0x07, 0x39, 0xcb, 0xde, 0xae, 0xa4, 0x3d, 0x1d,
-	0xd9, 0x4f, 0x1f, 0xf6, 0x96, 0x3c, 0xcf, 0x79, 0x61, 0x2f, 0x07, 0x7f, 0x36, 0x21, 0x3c, 0x67,
-	0x34, 0x4b, 0x2f, 0xcd, 0x57, 0x1c, 0x43, 0xf7, 0x8d, 0x3e, 0x4e, 0xc7, 0x31, 0xea, 0xa3, 0xa1,
-	0x97, 0x6c, 0x8e, 0x18, 0x43, 0xab, 0x20, 0x39, 0x8d, 0x9b, 0x7d, 0x34, 0x0c, 0x12, 0x63, 0xe3,
-	0x8f, 0xe0, 0x80, 0xc9, 0x79, 0x29, 0x58, 0x4e, 0xc4, 0x7a, 0x7e, 0x4b, 0xd7, 0xb1, 0xd7, 0x47,
-	0x43, 0x3f, 0xe9, 0x31, 0x39, 0xb3, 0x97, 0x17, 0x74, 0x8d, 0xfb, 0x10, 0xa6, 0x54, 0x2e, 0x05,
-	0x2b, 0x15, 0xe3, 0x45, 0xdc, 0x32, 0x04, 0xd5, 0x2b, 0xfc, 0x12, 0x82, 0x94, 0x28, 0x32, 0x57,
-	0xeb, 0x92, 0xc6, 0xed, 0x3e, 0x1a, 0x1e, 0x9c, 0x3c, 0x3a, 0xaa, 0x09, 0xfe, 0x68, 0x4c, 0x14,
-	0xf9, 0x6e, 0x5d, 0xd2, 0xc4, 0x4f, 0x9d, 0x85, 0x47, 0x10, 0x6a, 0xd8, 0xbc, 0x24, 0x82, 0xe4,
-	0x32, 0xee, 0xf4, 0xbd, 0x61, 0x78, 0xf2, 0xe4, 0xdf, 0x68, 0x97, 0xf2, 0x05, 0x5d, 0x5f, 0x91,
-	0x6c, 0x45, 0x67, 0x84, 0x89, 0x04, 0x34, 0x6a, 0x66, 0x40, 0x78, 0x0c, 0x3d, 0x56, 0xa4, 0xf4,
-	0xa7, 0x0d, 0x49, 0xf7, 0xbe, 0x24, 0xa1, 0x81, 0x39, 0x96, 0xf7, 0xa1, 0x43, 0x56, 0x8a, 0x4f,
-	0xc7, 0xb1, 0x6f, 0x54, 0x70, 0xa7, 0xc1, 0xaf, 0x08, 0xa2, 0x33, 0x9e, 0x65, 0x74, 0xa9, 0x93,
-	0x75, 0x42, 0x6f, 0xe4, 0x44, 0x15, 0x39, 0xff, 0x23, 0x54, 0x73, 0x5f, 0xa8, 0xdd, 0x13, 0x5e,
-	0xf5, 0x09, 0xfc, 0x02, 0x3a, 0xa6, 0x4e, 0x32, 0x6e, 0x99, 0xd0, 0xfb, 0xb5, 0xea, 0x55, 0x0a,
-	0x9d, 0x38, 0xff, 0xc1, 0x21, 0x04, 0x23, 0xce, 0xb3, 0xaf, 0x85, 0x20, 0x6b, 0x1d, 0x94, 0xd6,
-	0x35, 0x46, 0x7d, 0x6f, 0xe8, 0x27, 0xc6, 0x1e, 0x3c, 0x06, 0x7f, 0x5a, 0xa8, 0xfd, 0xef, 0x6d,
-	0xf7, 0xfd, 0x10, 0x82, 0x6f, 0x78, 0x71, 0xbd, 0xef, 0xe0, 0x39, 0x87, 0x3e, 0xc0, 0x79, 0xc6,
-	0x49, 0x0d, 0x45, 0xd3, 0x79, 0x3c, 0x81, 0x70, 0xcc, 0x57, 0x8b, 0x8c, 0xee, 0xbb, 0xa0, 0x1d,
-	0xc9, 0x68, 0xad, 0xa8, 0xdc, 0xf7, 0xe8, 0xed, 0x48, 0x2e, 0x95, 0x60, 0x75, 0x91, 0x04, 0xce,
-	0xe5, 0x0f, 0x0f, 0xc2, 0xcb, 0x25, 0xc9, 0x88, 0x30, 0x4a, 0xe0, 0x57, 0x10, 0x2c, 0x38, 0xcf,
-	0xe6, 0xce, 0x11, 0x0d, 0xc3, 0x93, 0xc7, 0xb5, 0xc2, 0x6d, 0x15, 0x9a, 0x34, 0x12, 0x5f, 0x43,
-	0x74, 0x1f, 0xe2, 0x97, 0xe0, 0xb3, 0x42, 0x59, 0x74, 0xd3, 0xa0, 0xeb, 0x9b, 0x76, 0x23, 0xdf,
-	0xa4, 0x91, 0x74, 0x59, 0xa1, 0x0c, 0xf6, 0x15, 0x04, 0x19, 0x2f, 0xae, 0x2d, 0xd8, 0xbb, 0xe3,
-	0xe9, 0xad, 0xb6, 0xfa, 0x69, 0x0d, 0x31, 0xf0, 0xaf, 0x00, 0xde, 0x68, 0x4d, 0x2d, 0xbe, 0x65,
-	0xf0, 0x87, 0xf5, 0x35, 0xdf, 0x4a, 0x3f, 0x69, 0x24, 0x81, 0x01, 0x19, 0x86, 0x33, 0x08, 0x53,
-	0xa3, 0xb9, 0xa5, 0x68, 0x1b, 0x8a, 0xfa, 0xb6, 0xa9, 0xd4, 0x66, 0xd2, 0x48, 0xc0, 0xc2, 0x36,
-	0x24, 0xd2, 0x68, 0x6e, 0x49, 0x3a, 0x77, 0x90, 0x54, 0x6a, 0xa3, 0x49, 0x2c, 0x6c, 0x93, 0xcb,
-	0x42, 0x97, 0xd6, 0x72, 0x74, 0xef, 0xc8, 0x65, 0xd7, 0x01, 0x3a, 0x17, 0x03, 0xd2, 0x0c, 0xa3,
-	0x8e, 0xad, 0xf5, 0xe0, 0x17, 0x04, 0xe1, 0x15, 0x5d, 0x2a, 0xee, 0xea, 0x1b, 0x81, 0x97, 0xb2,
-	0xdc, 0x2d, 0x32, 0x6d, 0xea, 0x41, 0xb7, 0xba, 0xbd, 0x35, 0x6e, 0xae, 0x6c, 0xf7, 0x50, 0x2e,
-	0x34, 0x30, 0x4b, 0x8e, 0x3f, 0x86, 0xff, 0x2d, 0x58, 0xa1, 0x57, 0x9e, 0xa3, 0xd1, 0x05, 0xec,
-	0x4d, 0x1a, 0x49, 0xcf, 0x5e, 0x5b, 0xb7, 0x6d, 0x58, 0x7f, 0x23, 0x08, 0x4c, 0x40, 0x26, 0xdd,
-	0x67, 0xd0, 0x32, 0x6b, 0x0e, 0xdd, 0x67, 0xcd, 0x19, 0x57, 0xfc, 0x08, 0xc0, 0x4c, 0xeb, 0xbc,
-	0xb2, 0x80, 0x03, 0x73, 0xf3, 0x5a, 0xaf, 0x8d, 0x2f, 0xa0, 0x2b, 0x4d, 0x57, 0x4b, 0xd7, 0x49,
-	0xef, 0xa8, 0xc0, 0xae, 0xf3, 0x75, 0x27, 0x3a, 0x88, 0x46, 0xdb, 0x2c, 0xa4, 0xeb, 0xa3, 0x7a,
-	0x74, 0x45, 0x57, 0x8d, 0x76, 0x10, 0xfc, 0x01, 0xf8, 0x36, 0x34, 0x96, 0x9a, 0x1e, 0xda, 0xfe,
-	0x30, 0xd2, 0x51, 0x17, 0xda, 0xc6, 0x1c, 0xfc, 0x8c, 0xc0, 0x9b, 0x8e, 0x25, 0xfe, 0x0c, 0x3a,
-	0x7a, 0x5e, 0x58, 0x7a, 0xe7, 0xac, 0x55, 0x1b, 0xbe, 0xcd, 0x0a, 0x35, 0x4d, 0xf1, 0xe7, 0xd0,
-	0x91, 0x4a, 0x68, 0x60, 0xf3, 0xde, 0x1d, 0xd6, 0x96, 0x4a, 0x4c, 0xd3, 0x11, 0x80, 0xcf, 0xd2,
-	0xb9, 0x8d, 0xe3, 0x2f, 0x04, 0xd1, 0x25, 0x25, 0x62, 0x79, 0x93, 0x50, 0xb9, 0xca, 0xec, 0x1c,
-	0x1c, 0x42, 0x58, 0xac, 0xf2, 0xf9, 0x8f, 0x2b, 0x2a, 0x18, 0x95, 0xae, 0x57, 0xa0, 0x58, 0xe5,
-	0xdf, 0xda, 0x1b, 0xfc, 0x00, 0xda, 0x8a, 0x97, 0xf3, 0x5b, 0xf3, 0xb6, 0x97, 0xb4, 0x14, 0x2f,
-	0x2f, 0xf0, 0x97, 0x10, 0xda, 0xfd, 0xb9, 0x19, 0x60, 0xef, 0x9d, 0xf9, 0x6c, 0x2b, 0x9f, 0xd8,
-	0x22, 0x9a, 0x96, 0xd5, 0x8b, 0x5c, 0x2e, 0xb9, 0xa0, 0x76, 0x61, 0x37, 0x13, 0x77, 0xc2, 0x4f,
-	0xc1, 0x63, 0xa9, 0x74, 0xe3, 0x18, 0xd7, 0xaf, 0x93, 0xb1, 0x4c, 0xb4, 0x13, 0x7e, 0x68, 0x22,
-	0xbb, 0xb5, 0xff, 0x3c, 0x2f, 0xb1, 0x87, 0xa7, 0xbf, 0x21, 0xf0, 0x37, 0xfd, 0x83, 0x7d, 0x68,
-	0xbd, 0xe6, 0x05, 0x8d, 0x1a, 0xda, 0xd2, 0x5b, 0x2c, 0x42, 0xda, 0x9a, 0x16, 0xea, 0x45, 0xd4,
-	0xc4, 0x01, 0xb4, 0xa7, 0x85, 0x7a, 0xf6, 0x3c, 0xf2, 0x9c, 0x79, 0x7a, 0x12, 0xb5, 0x9c, 0xf9,
-	0xfc, 0xd3, 0xa8, 0xad, 0x4d, 0x33, 0x05, 0x11, 0x60, 0x80, 0x8e, 0xdd, 0x03, 0x51, 0xa8, 0x6d,
-	0x2b, 0x76, 0xf4, 0x10, 0x87, 0xd0, 0xbd, 0x22, 0xe2, 0xec, 0x86, 0x88, 0xe8, 0x3d, 0x1c, 0x41,
-	0x6f, 0x54, 0x99, 0x80, 0x28, 0xc5, 0xff, 0x87, 0xf0, 0x7c, 0x37, 0x39, 0x11, 0x1d, 0x7d, 0x0f,
-	0x07, 0x8c, 0x6f, 0xf2, 0xba, 0x16, 0xe5, 0x72, 0x14, 0xda, 0x3f, 0xd2, 0x4c, 0xe7, 0x38, 0x43,
-	0x3f, 0x9c, 0x5e, 0x33, 0x75, 0xb3, 0x5a, 0xe8, 0xdf, 0xed, 0xb1, 0x75, 0xfb, 0x84, 0x71, 0x67,
-	0x1d, 0xb3, 0x42, 0x51, 0x51, 0x90, 0xec, 0xd8, 0x28, 0x72, 0x6c, 0x15, 0x29, 0x17, 0xbf, 0x23,
-	0xb4, 0xe8, 0x98, 0xab, 0xd3, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xcc, 0x21, 0x94, 0x03,
-	0x09, 0x00, 0x00,
+	// 1272 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x5f, 0x6e, 0xdb, 0xc6,
+	0x13, 0x16, 0x45, 0x51, 0x22, 0x87, 0xb2, 0x43, 0x6f, 0x92, 0x1f, 0xf8, 0x4b, 0xe1, 0x58, 0x31,
+	0x1a, 0x54, 0x0d, 0x50, 0x1b, 0x71, 0x8a, 0x34, 0x0d, 0x1a, 0x34, 0x95, 0x85, 0xc0, 0x42, 0x8a,
+	0xd4, 0xa5, 0x8b, 0x04, 0xe8, 0x0b, 0xb1, 0x12, 0xd7, 0xf2, 0xc2, 0x14, 0x97, 0xe5, 0x2e, 0x8d,
+	0xea, 0x00, 0xbd, 0x45, 0x1f, 0x7a, 0x88, 0x5c, 0xa0, 0x97, 0xe8, 0x43, 0x81, 0x9e, 0xa1, 0x57,
+	0x28, 0xf6, 0x8f, 0x24, 0x4a, 0xb2, 0x0c, 0xbf, 0xed, 0xce, 0x7e, 0x33, 0xdc, 0xf9, 0xe6, 0x9b,
+	0x59, 0x42, 0x9b, 0x8f, 0x2e, 0xc8, 0x04, 0x1f, 0xe4, 0x05, 0x13, 0x0c, 0xdd, 0x9d, 0xd0, 0xf4,
+	0xaa, 0xe4, 0x7a, 0x77, 0xa0, 0x8f, 0x1e, 0xb4, 0x47, 0x6c, 0x32, 0x61, 0x99, 0x36, 0xee, 0xff,
+	0x6b, 0x83, 0xff, 0x86, 0x92, 0x34, 0x39, 0x53, 0xa7, 0x28, 0x84, 0xd6, 0xb9, 0xdc, 0x0e, 0xfa,
+	0xa1, 0xd5, 0xb1, 0xba, 0x76, 0x34, 0xdb, 0x22, 0x04, 0x8d, 0x0c, 0x4f, 0x48, 0x58, 0xef, 0x58,
+	0x5d, 0x2f, 0x52, 0x6b, 0xf4, 0x29, 0x6c, 0x53, 0x1e, 0xe7, 0x05, 0x9d, 0xe0, 0x62, 0x1a, 0x5f,
+	0x92, 0x69, 0x68, 0x77, 0xac, 0xae, 0x1b, 0xb5, 0x29, 0x3f, 0xd5, 0xc6, 0xb7, 0x64, 0x8a, 0x3a,
+	0xe0, 0x27, 0x84, 0x8f, 0x0a, 0x9a, 0x0b, 0xca, 0xb2, 0xb0, 0xa1, 0x02, 0x54, 0x4d, 0xe8, 0x25,
+	0x78, 0x09, 0x16, 0x38, 0x16, 0xd3, 0x9c, 0x84, 0x4e, 0xc7, 0xea, 0x6e, 0x1f, 0xed, 0x1e, 0x5c,
+	0x73, 0xf9, 0x83, 0x3e, 0x16, 0xf8, 0xa7, 0x69, 0x4e, 0x22, 0x37, 0x31, 0x2b, 0xd4, 0x03, 0x5f,
+	0xba, 0xc5, 0x39, 0x2e, 0xf0, 0x84, 0x87, 0xcd, 0x8e, 0xdd, 0xf5, 0x8f, 0x1e, 0x2d, 0x7b, 0x9b,
+	0x94, 0xdf, 0x92, 0xe9, 0x7b, 0x9c, 0x96, 0xe4, 0x14, 0xd3, 0x22, 0x02, 0xe9, 0x75, 0xaa, 0x9c,
+	0x50, 0x1f, 0xda, 0x34, 0x4b, 0xc8, 0xaf, 0xb3, 0x20, 0xad, 0xdb, 0x06, 0xf1, 0x95, 0x9b, 0x89,
+	0xf2, 0x3f, 0x68, 0xe2, 0x52, 0xb0, 0x41, 0x3f, 0x74, 0x15, 0x0b, 0x66, 0x87, 0x9e, 0xc0, 0x0e,
+	0xe5, 0xf1, 0x28, 0x2d, 0xb9, 0x20, 0x05, 0xcd, 0xc6, 0x8a, 0x28, 0x4f, 0x41, 0xee, 0x50, 0x7e,
+	0x3c, 0xb7, 0x4b, 0xae, 0x1e, 0x80, 0x9b, 0x95, 0x69, 0x8a, 0x87, 0x29, 0x09, 0x41, 0x41, 0xe6,
+	0x7b, 0xd4, 0x87, 0xad, 0x84, 0x9c, 0xe3, 0x32, 0x15, 0xf1, 0x95, 0xbc, 0x41, 0xe8, 0x77, 0xac,
+	0xae, 0x7f, 0xb4, 0x77, 0x2d, 0x53, 0xea, 0x8e, 0xaa, 0xb2, 0x51, 0xdb, 0x78, 0x29, 0xd3, 0xfe,
+	0x5f, 0x16, 0xc0, 0xe2, 0x10, 0xed, 0x82, 0x37, 0x64, 0x2c, 0x8d, 0x25, 0x9f, 0xaa, 0xe4, 0xee,
+	0x49, 0x2d, 0x72, 0xa5, 0x49, 0x72, 0x8d, 0x3e, 0x01, 0x97, 0x66, 0x42, 0x9f, 0xca, 0xca, 0x3b,
+	0x27, 0xb5, 0xa8, 0x45, 0x33, 0xa1, 0x0e, 0x77, 0xc1, 0x4b, 0x59, 0x36, 0xd6, 0xa7, 0xb2, 0xf2,
+	0xb6, 0xf4, 0x95, 0x26, 0x75, 0xbc, 0x07, 0x70, 0x9e, 0x32, 0x6c, 0xbc, 0x65, 0xd9, 0xeb, 0x27,
+	0xb5, 0xc8, 0x53, 0x36, 0x05, 0x78, 0x04, 0x7e, 0xc2, 0xca, 0x61, 0x4a, 0x34, 0x42, 0x16, 0xde,
+	0x3a, 0xa9, 0x45, 0xa0, 0x8d, 0x33, 0x08, 0x17, 0x8a, 0x34, 0x05, 0x69, 0x4a, 0xed, 0x48, 0x88,
+	0x36, 0x4a, 0x48, 0xaf, 0x09, 0x0d, 0x79, 0xb6, 0xff, 0xd1, 0x82, 0xe0, 0x98, 0xa5, 0x29, 0x19,
+	0x49, 0x4d, 0x19, 0x3d, 0xcf, 0x54, 0x6b, 0x55, 0x54, 0xbb, 0xa2, 0xc7, 0xfa, 0xba, 0x1e, 0x17,
+	0x95, 0xb4, 0x97, 0x2a, 0xf9, 0x02, 0x9a, 0xaa, 0x1d, 0x78, 0xd8, 0x50, 0x0a, 0xe9, 0x5c, 0x4b,
+	0x7d, 0xa5, 0x9f, 0x22, 0x83, 0x97, 0x7d, 0x75, 0x45, 0x0a, 0x2e, 0xbf, 0x27, 0xd3, 0x74, 0xa2,
+	0xd9, 0x76, 0x7f, 0x0f, 0xbc, 0x1e, 0x63, 0xe9, 0x77, 0x45, 0x81, 0xa7, 0x08, 0xe9, 0x5c, 0x42,
+	0xab, 0x63, 0x77, 0xdd, 0x48, 0xe7, 0xf5, 0x10, 0xdc, 0x41, 0x26, 0xd6, 0xcf, 0x1d, 0x73, 0xbe,
+	0x07, 0xde, 0xf7, 0x2c, 0x1b, 0xaf, 0x03, 0x6c, 0x03, 0xe8, 0x00, 0xbc, 0x91, 0x9c, 0xaf, 0x23,
+	0xea, 0x06, 0xf1, 0x08, 0xfc, 0xbe, 0xe2, 0x7c, 0x1d, 0x62, 0x2d, 0x82, 0xf4, 0xa6, 0x82, 0xf0,
+	0x75, 0x44, 0x7b, 0x11, 0xe4, 0x4c, 0x55, 0x65, 0x1d, 0xe2, 0x19, 0xc8, 0x3f, 0x36, 0xf8, 0x67,
+	0x23, 0x9c, 0xe2, 0x42, 0x8b, 0xef, 0xd5, 0xaa, 0xf8, 0xfc, 0xa3, 0x87, 0xd7, 0x52, 0x3a, 0x67,
+	0x68, 0x49, 0x9c, 0x2f, 0x57, 0xc4, 0xe9, 0x6f, 0x98, 0x1a, 0x33, 0xfa, 0xaa, 0xda, 0x7d, 0xb5,
+	0xaa, 0xdd, 0x4d, 0x9f, 0x9e, 0x73, 0xbb, 0xa4, 0xed, 0xd7, 0x6b, 0xda, 0xde, 0xd4, 0x88, 0x0b,
+	0xea, 0x97, 0xc5, 0x7f, 0xbc, 0x2e, 0xfe, 0x4d, 0x82, 0xaa, 0xd4, 0x66, 0xa5, 0x3d, 0x8e, 0xd7,
+	0xdb, 0x63, 0x53, 0x90, 0x4a, 0x6d, 0x96, 0x1b, 0x48, 0xe6, 0x32, 0x94, 0xa5, 0xd5, 0x31, 0x5a,
+	0x37, 0xe4, 0xb2, 0x50, 0x80, 0xcc, 0x45, 0x39, 0x2d, 0xb5, 0xe0, 0x6b, 0x08, 0xce, 0x72, 0x5c,
+	0x70, 0x52, 0xd1, 0xdb, 0x03, 0x70, 0x47, 0x2c, 0x13, 0x24, 0x13, 0xdc, 0xc8, 0x65, 0xbe, 0x47,
+	0x01, 0xd8, 0x09, 0x9d, 0xa8, 0xda, 0xd9, 0x91, 0x5c, 0xee, 0xff, 0x59, 0x07, 0xff, 0x3d, 0x19,
+	0x09, 0x66, 0x14, 0x62, 0x10, 0xd6, 0x1c, 0x21, 0x67, 0xb5, 0x66, 0xfe, 0x4a, 0xc1, 0xc2, 0xfa,
+	0x0d, 0xf7, 0x5d, 0xe2, 0xde, 0x57, 0x6e, 0x3a, 0x38, 0x7a, 0x0c, 0x5b, 0x43, 0x9a, 0xc9, 0x57,
+	0xcb, 0x84, 0x91, 0x12, 0x68, 0x9f, 0xd4, 0xa2, 0xb6, 0x36, 0x1b, 0xd8, 0x07, 0xb8, 0xcb, 0x55,
+	0x42, 0xf1, 0xd2, 0x37, 0x75, 0xbd, 0x1f, 0x5f, 0xcf, 0xf3, 0x0a, 0x01, 0x27, 0xb5, 0x68, 0x87,
+	0x2f, 0x6c, 0x26, 0xf0, 0x67, 0xb0, 0xad, 0x22, 0x3e, 0x7d, 0x3e, 0x8b, 0xe9, 0x98, 0x0b, 0x6c,
+	0x19, 0xbb, 0x01, 0x7e, 0x0e, 0x77, 0x86, 0x2b, 0xc8, 0xa6, 0x41, 0x6e, 0x0f, 0x97, 0xa0, 0xf3,
+	0x2a, 0xfc, 0x5e, 0x07, 0x4f, 0xb1, 0xa7, 0xaa, 0xfb, 0x14, 0x1a, 0xea, 0x59, 0xb5, 0x6e, 0xf3,
+	0xac, 0x2a, 0x28, 0xda, 0x05, 0x50, 0x63, 0x2b, 0xae, 0x3c, 0xf8, 0x9e, 0xb2, 0xbc, 0x93, 0xf3,
+	0xf3, 0x1b, 0x68, 0x71, 0xd5, 0xc4, 0x3c, 0xb4, 0x6f, 0x12, 0xdc, 0xa2, 0xd1, 0x65, 0xe3, 0x19,
+	0x17, 0xe9, 0xad, 0xf3, 0xe0, 0x61, 0xe3, 0x06, 0xef, 0x8a, 0x08, 0xa4, 0xb7, 0x71, 0x41, 0xff,
+	0x07, 0x57, 0x5f, 0x8d, 0x26, 0xa1, 0x53, 0xfd, 0x41, 0x91, 0x2f, 0x19, 0x5c, 0xe1, 0x94, 0x26,
+	0xb3, 0x56, 0x90, 0x13, 0xd4, 0x53, 0x16, 0xa5, 0xd1, 0x16, 0x38, 0x0a, 0xb9, 0xff, 0x9b, 0x05,
+	0xf6, 0xa0, 0xcf, 0xd1, 0x57, 0xd0, 0x94, 0xd3, 0x83, 0x26, 0xa1, 0x75, 0xcb, 0xf6, 0x77, 0x68,
+	0x26, 0x06, 0x09, 0xfa, 0x1a, 0x9a, 0x5c, 0x14, 0xd2, 0xb1, 0x7e, 0xeb, 0x7e, 0x73, 0xb8, 0x28,
+	0x06, 0x49, 0x0f, 0xc0, 0xa5, 0x49, 0xac, 0xef, 0xf1, 0xb1, 0x0e, 0xc1, 0x19, 0xc1, 0xc5, 0xe8,
+	0x22, 0x22, 0xbc, 0x4c, 0x85, 0x79, 0x33, 0xfd, 0xac, 0x9c, 0xc4, 0xbf, 0x94, 0xa4, 0xa0, 0x84,
+	0x1b, 0xdd, 0x43, 0x56, 0x4e, 0x7e, 0xd4, 0x16, 0x74, 0x17, 0x1c, 0xc1, 0xf2, 0xf8, 0xd2, 0x34,
+	0x4d, 0x43, 0xb0, 0xfc, 0x2d, 0xfa, 0x16, 0x7c, 0xfd, 0xce, 0xcc, 0xc6, 0x99, 0xbd, 0x31, 0x9f,
+	0xb9, 0x30, 0x22, 0x5d, 0x63, 0xd5, 0xc0, 0xf2, 0xc1, 0xe3, 0x23, 0x56, 0x10, 0xfd, 0xb0, 0xd5,
+	0x23, 0xb3, 0x43, 0x4f, 0xc0, 0xa6, 0x09, 0x37, 0xc3, 0x29, 0xbc, 0x7e, 0xb8, 0xf6, 0x79, 0x24,
+	0x41, 0xe8, 0x9e, 0xba, 0xd9, 0xa5, 0xfe, 0x05, 0xb3, 0x23, 0xbd, 0x41, 0x3f, 0xc0, 0xbd, 0x71,
+	0xc1, 0xca, 0x3c, 0x1e, 0x4e, 0x75, 0xde, 0xe6, 0xdf, 0xa5, 0xd5, 0xb1, 0x6e, 0x71, 0xc7, 0x1d,
+	0xe5, 0xdb, 0x9b, 0x2a, 0x8b, 0xfa, 0x6b, 0x79, 0xf2, 0xb7, 0x05, 0xee, 0x4c, 0xaf, 0xc8, 0x85,
+	0xc6, 0x3b, 0x96, 0x91, 0xa0, 0x26, 0x57, 0xf2, 0x91, 0x08, 0x2c, 0xb9, 0x1a, 0x64, 0xe2, 0x45,
+	0x50, 0x47, 0x1e, 0x38, 0x83, 0x4c, 0x3c, 0x7d, 0x1e, 0xd8, 0x66, 0xf9, 0xec, 0x28, 0x68, 0x98,
+	0xe5, 0xf3, 0x2f, 0x03, 0x47, 0x2e, 0x55, 0x53, 0x06, 0x80, 0x00, 0x9a, 0x7a, 0xcc, 0x06, 0xbe,
+	0x5c, 0xeb, 0xea, 0x05, 0xf7, 0x90, 0x0f, 0xad, 0xf7, 0xb8, 0x38, 0xbe, 0xc0, 0x45, 0x70, 0x1f,
+	0x05, 0xd0, 0xee, 0x55, 0xc6, 0x43, 0x90, 0xa0, 0x3b, 0xe0, 0x57, 0xda, 0x3a, 0x20, 0xe8, 0x3e,
+	0xec, 0x9c, 0xad, 0x76, 0x7b, 0x70, 0x8e, 0x76, 0x60, 0xeb, 0x4d, 0xb5, 0x59, 0x83, 0x31, 0x42,
+	0xb0, 0xdd, 0x5b, 0xb6, 0x5d, 0xf4, 0x3e, 0xc0, 0x36, 0x65, 0x33, 0x4e, 0xc6, 0x45, 0x3e, 0xea,
+	0xf9, 0xfa, 0x47, 0xe2, 0x54, 0xf2, 0x73, 0x6a, 0xfd, 0xfc, 0x6c, 0x4c, 0xc5, 0x45, 0x39, 0x94,
+	0x3f, 0xa3, 0x87, 0x1a, 0xf6, 0x05, 0x65, 0x66, 0x75, 0x48, 0x33, 0x41, 0x8a, 0x0c, 0xa7, 0x87,
+	0x8a, 0xcd, 0x43, 0xcd, 0x66, 0x3e, 0xfc, 0xc3, 0xb2, 0x86, 0x4d, 0x65, 0x7a, 0xf6, 0xdf, 0x00,
+	0x48, 0x6a, 0x1d, 0xb7, 0x21, 0x0c, 0x00, 0x00,
 }
@@ -0,0 +1,639 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	lumberjack "gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/authutil"
+)
+
+// operation classes of the audited requests
+const (
+	auditOperationDDL  = "DDL"
+	auditOperationDML  = "DML"
+	auditOperationDQL  = "DQL"
+	auditOperationRBAC = "RBAC"
+)
+
+// sinks of the audit records
+const (
+	auditSinkFile      = "file"
+	auditSinkMsgStream = "msgstream"
+)
+
+// auditOperations maps the methods of MilvusService to their operation classes,
+// methods not listed here, like the states polling and metrics, are not audited.
+var auditOperations = map[string]string{
+	"CreateCollection":        auditOperationDDL,
+	"DropCollection":          auditOperationDDL,
+	"HasCollection":           auditOperationDDL,
+	"LoadCollection":          auditOperationDDL,
+	"ReleaseCollection":       auditOperationDDL,
+	"DescribeCollection":      auditOperationDDL,
+	"GetCollectionStatistics": auditOperationDDL,
+	"ShowCollections":         auditOperationDDL,
+	"CreatePartition":         auditOperationDDL,
+	"DropPartition":           auditOperationDDL,
+	"TruncateCollection":      auditOperationDDL,
+	"TruncatePartition":       auditOperationDDL,
+	"AddField":                auditOperationDDL,
+	"DropField":               auditOperationDDL,
+	"CreateSnapshot":          auditOperationDDL,
+	"DropSnapshot":            auditOperationDDL,
+	"ListSnapshots":           auditOperationDDL,
+	"HasPartition":            auditOperationDDL,
+	"LoadPartitions":          auditOperationDDL,
+	"ReleasePartitions":       auditOperationDDL,
+	"GetPartitionStatistics":  auditOperationDDL,
+	"ShowPartitions":          auditOperationDDL,
+	"CreateAlias":             auditOperationDDL,
+	"DropAlias":               auditOperationDDL,
+	"AlterAlias":              auditOperationDDL,
+	"AlterShards":             auditOperationDDL,
+	"CreateIndex":             auditOperationDDL,
+	"DescribeIndex":           auditOperationDDL,
+	"DropIndex":               auditOperationDDL,
+	"Flush":                   auditOperationDDL,
+	"LoadBalance":             auditOperationDDL,
+	"ManualCompaction":        auditOperationDDL,
+	"CancelCompaction":        auditOperationDDL,
+
+	"Insert":       auditOperationDML,
+	"Delete":       auditOperationDML,
+	"DeleteByExpr": auditOperationDML,
+	"Import":       auditOperationDML,
+
+	"Search":       auditOperationDQL,
+	"SearchStream": auditOperationDQL,
+	"Query":        auditOperationDQL,
+	"QueryStream":  auditOperationDQL,
+	"CalcDistance": auditOperationDQL,
+
+	"CreateCredential": auditOperationRBAC,
+	"UpdateCredential": auditOperationRBAC,
+	"DeleteCredential": auditOperationRBAC,
+	"ListCredUsers":    auditOperationRBAC,
+	"CreateRole":       auditOperationRBAC,
+	"DropRole":         auditOperationRBAC,
+	"OperateUserRole":  auditOperationRBAC,
+	"SelectRole":       auditOperationRBAC,
+	"SelectUser":       auditOperationRBAC,
+	"OperatePrivilege": auditOperationRBAC,
+	"SelectGrant":      auditOperationRBAC,
+	"CreateRowPolicy":  auditOperationRBAC,
+	"DropRowPolicy":    auditOperationRBAC,
+	"ListRowPolicies":  auditOperationRBAC,
+}
+
+// auditRecord is a record of an audited request.
+// Records are chained by HMACs, so that a removed or modified record breaks the chain.
+type auditRecord struct {
+	Time       time.Time              `json:"time"`
+	NodeID     int64                  `json:"node_id"`
+	User       string                 `json:"user"`
+	Address    string                 `json:"address"`
+	Method     string                 `json:"method"`
+	Operation  string                 `json:"operation"`
+	Database   string                 `json:"database,omitempty"`
+	Collection string                 `json:"collection,omitempty"`
+	ErrorCode  string                 `json:"error_code"`
+	Reason     string                 `json:"reason,omitempty"`
+	LatencyMs  float64                `json:"latency_ms"`
+	Payload    map[string]interface{} `json:"payload,omitempty"`
+	Dropped    int64                  `json:"dropped,omitempty"` // number of records dropped right before this one
+	PrevHash   string                 `json:"prev_hash"`
+	Hash       string                 `json:"hash"`
+}
+
+// seal sets the hashes of the record, the hash is the HMAC-SHA256 of the json of the record without the hash itself,
+// so that the records can't be forged without the key
+func (r *auditRecord) seal(key []byte, prevHash string) ([]byte, error) {
+	r.PrevHash = prevHash
+	r.Hash = ""
+	content, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(content)
+	r.Hash = hex.EncodeToString(mac.Sum(nil))
+	return json.Marshal(r)
+}
+
+// auditWriter writes sealed audit records to the sink
+type auditWriter interface {
+	Write(record []byte) error
+	Close() error
+}
+
+// fileAuditWriter writes audit records as json lines to a rotating log file
+type fileAuditWriter struct {
+	logger *lumberjack.Logger
+}
+
+func newFileAuditWriter() *fileAuditWriter {
+	return &fileAuditWriter{
+		logger: &lumberjack.Logger{
+			Filename:   Params.ProxyCfg.AuditFilePath,
+			MaxSize:    Params.ProxyCfg.AuditFileMaxSize,
+			MaxAge:     Params.ProxyCfg.AuditFileMaxAge,
+			MaxBackups: Params.ProxyCfg.AuditFileMaxBackups,
+			LocalTime:  true,
+		},
+	}
+}
+
+func (w *fileAuditWriter) Write(record []byte) error {
+	_, err := w.logger.Write(append(record, '\n'))
+	return err
+}
+
+func (w *fileAuditWriter) Close() error {
+	return w.logger.Close()
+}
+
+// auditMsg carries the json of an audit record, it is only produced for the consumers outside milvus
+type auditMsg struct {
+	msgstream.BaseMsg
+	record []byte
+}
+
+var _ msgstream.TsMsg = &auditMsg{}
+
+func (m *auditMsg) ID() UniqueID {
+	return 0
+}
+
+func (m *auditMsg) Type() commonpb.MsgType {
+	return commonpb.MsgType_Undefined
+}
+
+func (m *auditMsg) SourceID() int64 {
+	return Params.ProxyCfg.GetNodeID()
+}
+
+func (m *auditMsg) Marshal(input msgstream.TsMsg) (msgstream.MarshalType, error) {
+	return input.(*auditMsg).record, nil
+}
+
+func (m *auditMsg) Unmarshal(input msgstream.MarshalType) (msgstream.TsMsg, error) {
+	return nil, errors.New("audit messages are not supposed to be consumed by milvus")
+}
+
+// msgStreamAuditWriter produces audit records to the audit topic
+type msgStreamAuditWriter struct {
+	stream msgstream.MsgStream
+}
+
+func newMsgStreamAuditWriter(ctx context.Context, factory msgstream.Factory) (*msgStreamAuditWriter, error) {
+	stream, err := factory.NewMsgStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stream.AsProducer([]string{Params.CommonCfg.ClusterPrefix + "-" + Params.ProxyCfg.AuditTopic})
+	stream.SetRepackFunc(msgstream.DefaultRepackFunc)
+	return &msgStreamAuditWriter{stream: stream}, nil
+}
+
+func (w *msgStreamAuditWriter) Write(record []byte) error {
+	msg := &auditMsg{
+		BaseMsg: msgstream.BaseMsg{HashValues: []uint32{0}},
+		record:  record,
+	}
+	return w.stream.Produce(&msgstream.MsgPack{Msgs: []msgstream.TsMsg{msg}})
+}
+
+func (w *msgStreamAuditWriter) Close() error {
+	w.stream.Close()
+	return nil
+}
+
+// auditConfig is the config of auditor
+type auditConfig struct {
+	operations     []string
+	includePayload bool
+	key            []byte
+	// chainFile keeps the hash of the last record written, the chain isn't persisted if it's empty
+	chainFile  string
+	bufferSize int
+}
+
+// auditor seals the audit records in order and writes them to the sink in background
+type auditor struct {
+	writer         auditWriter
+	operations     map[string]struct{}
+	includePayload bool
+	key            []byte
+	chainFile      string
+
+	records  chan *auditRecord
+	dropped  int64
+	prevHash string
+	closeMtx sync.RWMutex
+	closed   bool
+	wg       sync.WaitGroup
+}
+
+func newAuditor(writer auditWriter, cfg auditConfig) (*auditor, error) {
+	prevHash, err := loadAuditChain(cfg.chainFile)
+	if err != nil {
+		return nil, err
+	}
+	a := &auditor{
+		writer:         writer,
+		operations:     make(map[string]struct{}, len(cfg.operations)),
+		includePayload: cfg.includePayload,
+		key:            cfg.key,
+		chainFile:      cfg.chainFile,
+		records:        make(chan *auditRecord, cfg.bufferSize),
+		prevHash:       prevHash,
+	}
+	for _, op := range cfg.operations {
+		switch op {
+		case auditOperationDDL, auditOperationDML, auditOperationDQL, auditOperationRBAC:
+			a.operations[op] = struct{}{}
+		default:
+			log.Warn("unknown audit operation class is ignored", zap.String("operation", op))
+		}
+	}
+	a.wg.Add(1)
+	go a.writeLoop()
+	return a, nil
+}
+
+// loadAuditChain returns the hash of the last record written before the restart
+func loadAuditChain(chainFile string) (string, error) {
+	if chainFile == "" {
+		return "", nil
+	}
+	content, err := ioutil.ReadFile(chainFile)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to load the audit chain: %w", err)
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+// saveAuditChain keeps the hash of the last record written, the file is replaced atomically
+func saveAuditChain(chainFile string, hash string) error {
+	if err := os.MkdirAll(filepath.Dir(chainFile), os.ModePerm); err != nil {
+		return err
+	}
+	tmp := chainFile + ".tmp"
+	if err := ioutil.WriteFile(tmp, []byte(hash), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, chainFile)
+}
+
+// operationOf returns the operation class of the method, and whether the method should be audited
+func (a *auditor) operationOf(method string) (string, bool) {
+	op, ok := auditOperations[method]
+	if !ok {
+		return "", false
+	}
+	_, ok = a.operations[op]
+	return op, ok
+}
+
+// record queues the record without blocking the request, the record is dropped if the buffer is full
+// because the sink falls behind, and the number of dropped records is kept in the next record written.
+func (a *auditor) record(record *auditRecord) {
+	a.closeMtx.RLock()
+	defer a.closeMtx.RUnlock()
+	if a.closed {
+		return
+	}
+	select {
+	case a.records <- record:
+	default:
+		atomic.AddInt64(&a.dropped, 1)
+		log.RatedWarn(10, "audit record dropped since the sink falls behind", zap.String("method", record.Method),
+			zap.String("user", record.User))
+	}
+}
+
+func (a *auditor) writeLoop() {
+	defer a.wg.Done()
+	for record := range a.records {
+		record.Dropped = atomic.SwapInt64(&a.dropped, 0)
+		a.write(record)
+		// the chain is saved when the buffer is drained, so it's not saved for every record under load
+		if len(a.records) == 0 {
+			a.saveChain()
+		}
+	}
+	// the records dropped at last are kept in an empty record
+	if dropped := atomic.SwapInt64(&a.dropped, 0); dropped > 0 {
+		a.write(&auditRecord{Time: time.Now(), NodeID: Params.ProxyCfg.GetNodeID(), Dropped: dropped})
+		a.saveChain()
+	}
+}
+
+func (a *auditor) write(record *auditRecord) {
+	content, err := record.seal(a.key, a.prevHash)
+	if err != nil {
+		log.Warn("failed to seal audit record", zap.String("method", record.Method), zap.Error(err))
+		return
+	}
+	a.prevHash = record.Hash
+	if err := a.writer.Write(content); err != nil {
+		log.Warn("failed to write audit record", zap.String("method", record.Method),
+			zap.String("user", record.User), zap.Error(err))
+	}
+}
+
+func (a *auditor) saveChain() {
+	if a.chainFile == "" {
+		return
+	}
+	if err := saveAuditChain(a.chainFile, a.prevHash); err != nil {
+		log.Warn("failed to save audit chain", zap.String("file", a.chainFile), zap.Error(err))
+	}
+}
+
+// Close writes the queued records and closes the sink
+func (a *auditor) Close() error {
+	a.closeMtx.Lock()
+	if a.closed {
+		a.closeMtx.Unlock()
+		return nil
+	}
+	a.closed = true
+	close(a.records)
+	a.closeMtx.Unlock()
+
+	a.wg.Wait()
+	return a.writer.Close()
+}
+
+var globalAuditor atomic.Value
+
+func getGlobalAuditor() *auditor {
+	a, _ := globalAuditor.Load().(*auditor)
+	return a
+}
+
+// initAuditor sets up the global auditor if the audit log is enabled
+func initAuditor(ctx context.Context, factory msgstream.Factory) (*auditor, error) {
+	if !Params.ProxyCfg.AuditEnabled {
+		return nil, nil
+	}
+	if Params.ProxyCfg.AuditHMACKey == "" {
+		return nil, errors.New("proxy.audit.hmacKey should be set if the audit log is enabled")
+	}
+	var writer auditWriter
+	switch Params.ProxyCfg.AuditSink {
+	case auditSinkFile:
+		writer = newFileAuditWriter()
+	case auditSinkMsgStream:
+		w, err := newMsgStreamAuditWriter(ctx, factory)
+		if err != nil {
+			return nil, err
+		}
+		writer = w
+	default:
+		return nil, fmt.Errorf("unknown audit sink %s, should be %s or %s", Params.ProxyCfg.AuditSink, auditSinkFile, auditSinkMsgStream)
+	}
+	a, err := newAuditor(writer, auditConfig{
+		operations:     Params.ProxyCfg.AuditOperations,
+		includePayload: Params.ProxyCfg.AuditIncludePayload,
+		key:            []byte(Params.ProxyCfg.AuditHMACKey),
+		chainFile:      Params.ProxyCfg.AuditChainFile,
+		bufferSize:     Params.ProxyCfg.AuditBufferSize,
+	})
+	if err != nil {
+		writer.Close()
+		return nil, err
+	}
+	globalAuditor.Store(a)
+	return a, nil
+}
+
+// auditIdentityCtxKey is the context key of auditIdentity
+type auditIdentityCtxKey struct{}
+
+// auditIdentity is filled by the authentication placed after the audit interceptors,
+// so that the records have the identity accepted by the authenticators.
+type auditIdentity struct {
+	info *authutil.AuthInfo
+}
+
+// setAuditAuthInfo keeps the identity accepted by the authentication for the audit record of the request
+func setAuditAuthInfo(ctx context.Context, info *authutil.AuthInfo) {
+	if identity, ok := ctx.Value(auditIdentityCtxKey{}).(*auditIdentity); ok {
+		identity.info = info
+	}
+}
+
+// getAuditUser returns the user of the request, the user is empty if the authorization is disabled
+func getAuditUser(ctx context.Context) string {
+	if identity, ok := ctx.Value(auditIdentityCtxKey{}).(*auditIdentity); ok && identity.info != nil {
+		return identity.info.User
+	}
+	// the requests rejected by the authentication are recorded with the user claimed
+	user, _ := GetCurUserFromContext(ctx)
+	return user
+}
+
+// AuditInterceptor returns a unary server interceptor that records the audited requests.
+// It should be placed before the authentication, so that the rejected requests are recorded as well.
+func AuditInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		a := getGlobalAuditor()
+		if a == nil {
+			return handler(ctx, req)
+		}
+		method := path.Base(info.FullMethod)
+		op, ok := a.operationOf(method)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		ctx = context.WithValue(ctx, auditIdentityCtxKey{}, &auditIdentity{})
+		resp, err := handler(ctx, req)
+		a.record(newAuditRecord(ctx, method, op, req, resp, err, time.Since(start), a.includePayload))
+		return resp, err
+	}
+}
+
+// AuditStreamInterceptor returns a stream server interceptor that records the audited streaming requests,
+// the status of the last message sent is recorded as the result.
+func AuditStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		a := getGlobalAuditor()
+		if a == nil {
+			return handler(srv, ss)
+		}
+		method := path.Base(info.FullMethod)
+		op, ok := a.operationOf(method)
+		if !ok {
+			return handler(srv, ss)
+		}
+
+		start := time.Now()
+		stream := &auditServerStream{
+			ServerStream: ss,
+			ctx:          context.WithValue(ss.Context(), auditIdentityCtxKey{}, &auditIdentity{}),
+		}
+		err := handler(srv, stream)
+		a.record(newAuditRecord(stream.Context(), method, op, stream.req, stream.resp, err, time.Since(start), a.includePayload))
+		return err
+	}
+}
+
+// auditServerStream keeps the request received and the last response sent of the stream
+type auditServerStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	req  interface{}
+	resp interface{}
+}
+
+func (s *auditServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *auditServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.req == nil {
+		s.req = m
+	}
+	return err
+}
+
+func (s *auditServerStream) SendMsg(m interface{}) error {
+	s.resp = m
+	return s.ServerStream.SendMsg(m)
+}
+
+func newAuditRecord(ctx context.Context, method string, op string, req interface{}, resp interface{},
+	err error, latency time.Duration, includePayload bool) *auditRecord {
+	record := &auditRecord{
+		Time:      time.Now(),
+		NodeID:    Params.ProxyCfg.GetNodeID(),
+		Method:    method,
+		Operation: op,
+		LatencyMs: float64(latency.Microseconds()) / 1000,
+	}
+	record.User = getAuditUser(ctx)
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		record.Address = p.Addr.String()
+	}
+	if r, ok := req.(interface{ GetDbName() string }); ok {
+		record.Database = r.GetDbName()
+	}
+	if r, ok := req.(interface{ GetCollectionName() string }); ok {
+		record.Collection = r.GetCollectionName()
+	}
+
+	switch {
+	case err != nil:
+		record.ErrorCode = commonpb.ErrorCode_UnexpectedError.String()
+		record.Reason = err.Error()
+	case getResponseStatus(resp) != nil:
+		status := getResponseStatus(resp)
+		record.ErrorCode = status.GetErrorCode().String()
+		record.Reason = status.GetReason()
+	default:
+		record.ErrorCode = commonpb.ErrorCode_Success.String()
+	}
+
+	if includePayload {
+		record.Payload = getPayloadSummary(req)
+	}
+	return record
+}
+
+// getResponseStatus returns the status of the response of MilvusService
+func getResponseStatus(resp interface{}) *commonpb.Status {
+	switch r := resp.(type) {
+	case *commonpb.Status:
+		return r
+	case interface{ GetStatus() *commonpb.Status }:
+		return r.GetStatus()
+	default:
+		return nil
+	}
+}
+
+// getPayloadSummary returns the summary of the request payload,
+// the data and the credentials are never included.
+func getPayloadSummary(req interface{}) map[string]interface{} {
+	summary := make(map[string]interface{})
+	if m, ok := req.(proto.Message); ok {
+		summary["size"] = proto.Size(m)
+	}
+	if r, ok := req.(interface{ GetPartitionName() string }); ok && r.GetPartitionName() != "" {
+		summary["partition_name"] = r.GetPartitionName()
+	}
+	if r, ok := req.(interface{ GetPartitionNames() []string }); ok && len(r.GetPartitionNames()) > 0 {
+		summary["partition_names"] = r.GetPartitionNames()
+	}
+	if r, ok := req.(interface{ GetFieldName() string }); ok && r.GetFieldName() != "" {
+		summary["field_name"] = r.GetFieldName()
+	}
+	if r, ok := req.(interface{ GetIndexName() string }); ok && r.GetIndexName() != "" {
+		summary["index_name"] = r.GetIndexName()
+	}
+	if r, ok := req.(interface{ GetNumRows() uint32 }); ok {
+		summary["num_rows"] = r.GetNumRows()
+	}
+	if r, ok := req.(interface{ GetExpr() string }); ok && r.GetExpr() != "" {
+		summary["expr"] = r.GetExpr()
+	}
+	if r, ok := req.(interface{ GetDsl() string }); ok && r.GetDsl() != "" {
+		summary["dsl"] = r.GetDsl()
+	}
+	if r, ok := req.(interface{ GetOutputFields() []string }); ok && len(r.GetOutputFields()) > 0 {
+		summary["output_fields"] = r.GetOutputFields()
+	}
+	if r, ok := req.(interface{ GetUsername() string }); ok && r.GetUsername() != "" {
+		summary["username"] = r.GetUsername()
+	}
+	if r, ok := req.(interface{ GetRoleName() string }); ok && r.GetRoleName() != "" {
+		summary["role_name"] = r.GetRoleName()
+	}
+	return summary
+}
@@ -0,0 +1,355 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/authutil"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+type mockAuditWriter struct {
+	mu      sync.Mutex
+	records [][]byte
+	closed  bool
+}
+
+func (w *mockAuditWriter) Write(record []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.records = append(w.records, record)
+	return nil
+}
+
+func (w *mockAuditWriter) Close() error {
+	w.closed = true
+	return nil
+}
+
+var testAuditKey = []byte("audit-key")
+
+func newTestAuditor(t *testing.T, writer auditWriter, operations []string, includePayload bool) *auditor {
+	a, err := newAuditor(writer, auditConfig{
+		operations:     operations,
+		includePayload: includePayload,
+		key:            testAuditKey,
+		bufferSize:     16,
+	})
+	assert.NoError(t, err)
+	return a
+}
+
+func Test_auditRecord_seal(t *testing.T) {
+	first := &auditRecord{Method: "CreateCollection", Operation: auditOperationDDL}
+	content, err := first.seal(testAuditKey, "")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, first.Hash)
+
+	second := &auditRecord{Method: "Insert", Operation: auditOperationDML}
+	_, err = second.seal(testAuditKey, first.Hash)
+	assert.NoError(t, err)
+	assert.Equal(t, first.Hash, second.PrevHash)
+
+	// a modified record doesn't match its hash any more
+	decoded := &auditRecord{}
+	assert.NoError(t, json.Unmarshal(content, decoded))
+	hash := decoded.Hash
+	_, err = decoded.seal(testAuditKey, decoded.PrevHash)
+	assert.NoError(t, err)
+	assert.Equal(t, hash, decoded.Hash)
+	decoded.User = "mallory"
+	_, err = decoded.seal(testAuditKey, decoded.PrevHash)
+	assert.NoError(t, err)
+	assert.NotEqual(t, hash, decoded.Hash)
+
+	// the hash can't be forged without the key
+	forged := &auditRecord{Method: "CreateCollection", Operation: auditOperationDDL}
+	_, err = forged.seal([]byte("another-key"), "")
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.Hash, forged.Hash)
+}
+
+func Test_auditor(t *testing.T) {
+	writer := &mockAuditWriter{}
+	a := newTestAuditor(t, writer, []string{auditOperationDDL, auditOperationRBAC, "unknown"}, false)
+
+	op, ok := a.operationOf("CreateCollection")
+	assert.True(t, ok)
+	assert.Equal(t, auditOperationDDL, op)
+	_, ok = a.operationOf("Search")
+	assert.False(t, ok)
+	_, ok = a.operationOf("GetComponentStates")
+	assert.False(t, ok)
+
+	a.record(&auditRecord{Method: "CreateCollection"})
+	a.record(&auditRecord{Method: "CreateRole"})
+	assert.NoError(t, a.Close())
+	assert.True(t, writer.closed)
+	// records after closed are dropped
+	a.record(&auditRecord{Method: "DropRole"})
+
+	assert.Equal(t, 2, len(writer.records))
+	prevHash := ""
+	for _, content := range writer.records {
+		record := &auditRecord{}
+		assert.NoError(t, json.Unmarshal(content, record))
+		assert.Equal(t, prevHash, record.PrevHash)
+		prevHash = record.Hash
+	}
+}
+
+func Test_auditorChain(t *testing.T) {
+	chainFile := t.TempDir() + "/audit.chain"
+	cfg := auditConfig{
+		operations: []string{auditOperationDDL},
+		key:        testAuditKey,
+		chainFile:  chainFile,
+		bufferSize: 16,
+	}
+
+	writer := &mockAuditWriter{}
+	a, err := newAuditor(writer, cfg)
+	assert.NoError(t, err)
+	a.record(&auditRecord{Method: "CreateCollection"})
+	assert.NoError(t, a.Close())
+	first := &auditRecord{}
+	assert.NoError(t, json.Unmarshal(writer.records[0], first))
+
+	// the chain continues after restarts
+	writer = &mockAuditWriter{}
+	a, err = newAuditor(writer, cfg)
+	assert.NoError(t, err)
+	a.record(&auditRecord{Method: "DropCollection"})
+	assert.NoError(t, a.Close())
+	second := &auditRecord{}
+	assert.NoError(t, json.Unmarshal(writer.records[0], second))
+	assert.Equal(t, first.Hash, second.PrevHash)
+}
+
+type blockingAuditWriter struct {
+	mockAuditWriter
+	unblock chan struct{}
+}
+
+func (w *blockingAuditWriter) Write(record []byte) error {
+	<-w.unblock
+	return w.mockAuditWriter.Write(record)
+}
+
+func Test_auditorDropRecords(t *testing.T) {
+	writer := &blockingAuditWriter{unblock: make(chan struct{})}
+	a, err := newAuditor(writer, auditConfig{
+		operations: []string{auditOperationDDL},
+		key:        testAuditKey,
+		bufferSize: 1,
+	})
+	assert.NoError(t, err)
+
+	// the requests are not blocked by the stalled sink
+	for i := 0; i < 10; i++ {
+		a.record(&auditRecord{Method: "CreateCollection"})
+	}
+	close(writer.unblock)
+	assert.NoError(t, a.Close())
+
+	var written, dropped int64
+	for _, content := range writer.records {
+		record := &auditRecord{}
+		assert.NoError(t, json.Unmarshal(content, record))
+		if record.Method != "" {
+			written++
+		}
+		dropped += record.Dropped
+	}
+	assert.Equal(t, int64(10), written+dropped)
+	assert.True(t, dropped > 0)
+}
+
+func TestAuditInterceptor(t *testing.T) {
+	writer := &mockAuditWriter{}
+	a := newTestAuditor(t, writer, []string{auditOperationDDL, auditOperationDML}, true)
+	globalAuditor.Store(a)
+	defer globalAuditor.Store((*auditor)(nil))
+
+	interceptor := AuditInterceptor()
+	token := crypto.Base64Encode("alice" + util.CredentialSeperator + "secret")
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderAuthorize, token))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 12345}})
+
+	req := &milvuspb.DropCollectionRequest{DbName: "db", CollectionName: "coll"}
+	resp, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/DropCollection"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &commonpb.Status{ErrorCode: commonpb.ErrorCode_CollectionNotExists, Reason: "not found"}, nil
+		})
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	_, err = interceptor(ctx, &milvuspb.InsertRequest{CollectionName: "coll", NumRows: 10},
+		&grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Insert"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, errors.New("mock error")
+		})
+	assert.Error(t, err)
+
+	// not audited
+	_, err = interceptor(ctx, &milvuspb.SearchRequest{}, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/Search"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &milvuspb.SearchResults{}, nil
+		})
+	assert.NoError(t, err)
+
+	assert.NoError(t, a.Close())
+	assert.Equal(t, 2, len(writer.records))
+
+	drop := &auditRecord{}
+	assert.NoError(t, json.Unmarshal(writer.records[0], drop))
+	assert.Equal(t, "alice", drop.User)
+	assert.Equal(t, "127.0.0.1:12345", drop.Address)
+	assert.Equal(t, "DropCollection", drop.Method)
+	assert.Equal(t, auditOperationDDL, drop.Operation)
+	assert.Equal(t, "db", drop.Database)
+	assert.Equal(t, "coll", drop.Collection)
+	assert.Equal(t, commonpb.ErrorCode_CollectionNotExists.String(), drop.ErrorCode)
+	assert.Equal(t, "not found", drop.Reason)
+
+	insert := &auditRecord{}
+	assert.NoError(t, json.Unmarshal(writer.records[1], insert))
+	assert.Equal(t, auditOperationDML, insert.Operation)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError.String(), insert.ErrorCode)
+	assert.Equal(t, "mock error", insert.Reason)
+	assert.Equal(t, float64(10), insert.Payload["num_rows"])
+	assert.Equal(t, drop.Hash, insert.PrevHash)
+}
+
+func TestAuditInterceptorAuthenticatedUser(t *testing.T) {
+	writer := &mockAuditWriter{}
+	a := newTestAuditor(t, writer, []string{auditOperationDDL}, false)
+	globalAuditor.Store(a)
+	defer globalAuditor.Store((*auditor)(nil))
+
+	// the identity accepted by the authentication after the audit interceptor is recorded
+	interceptor := AuditInterceptor()
+	_, err := interceptor(context.Background(), &milvuspb.DropCollectionRequest{CollectionName: "coll"},
+		&grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/DropCollection"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			setAuditAuthInfo(ctx, &authutil.AuthInfo{User: "bob", Method: "jwt"})
+			return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+		})
+	assert.NoError(t, err)
+
+	assert.NoError(t, a.Close())
+	assert.Equal(t, 1, len(writer.records))
+	record := &auditRecord{}
+	assert.NoError(t, json.Unmarshal(writer.records[0], record))
+	assert.Equal(t, "bob", record.User)
+}
+
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	req  proto.Message
+	sent []interface{}
+}
+
+func (s *mockServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockServerStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), s.req)
+	return nil
+}
+
+func (s *mockServerStream) SendMsg(m interface{}) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestAuditStreamInterceptor(t *testing.T) {
+	writer := &mockAuditWriter{}
+	a := newTestAuditor(t, writer, []string{auditOperationDQL}, true)
+	globalAuditor.Store(a)
+	defer globalAuditor.Store((*auditor)(nil))
+
+	interceptor := AuditStreamInterceptor()
+	token := crypto.Base64Encode("alice" + util.CredentialSeperator + "secret")
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderAuthorize, token))
+
+	stream := &mockServerStream{
+		ctx: ctx,
+		req: &milvuspb.QueryRequest{DbName: "db", CollectionName: "coll", Expr: "pk > 0"},
+	}
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/QueryStream", IsServerStream: true},
+		func(srv interface{}, ss grpc.ServerStream) error {
+			req := &milvuspb.QueryRequest{}
+			if err := ss.RecvMsg(req); err != nil {
+				return err
+			}
+			if err := ss.SendMsg(&milvuspb.QueryResults{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}); err != nil {
+				return err
+			}
+			return ss.SendMsg(&milvuspb.QueryResults{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_EmptyCollection, Reason: "empty"}})
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(stream.sent))
+
+	assert.NoError(t, a.Close())
+	assert.Equal(t, 1, len(writer.records))
+
+	record := &auditRecord{}
+	assert.NoError(t, json.Unmarshal(writer.records[0], record))
+	assert.Equal(t, "alice", record.User)
+	assert.Equal(t, "QueryStream", record.Method)
+	assert.Equal(t, auditOperationDQL, record.Operation)
+	assert.Equal(t, "coll", record.Collection)
+	// the status of the last message
+	assert.Equal(t, commonpb.ErrorCode_EmptyCollection.String(), record.ErrorCode)
+	assert.Equal(t, "pk > 0", record.Payload["expr"])
+}
+
+func Test_getPayloadSummary(t *testing.T) {
+	summary := getPayloadSummary(&milvuspb.QueryRequest{
+		CollectionName: "coll",
+		Expr:           "pk in [1]",
+		OutputFields:   []string{"pk"},
+		PartitionNames: []string{"p1"},
+	})
+	assert.Equal(t, "pk in [1]", summary["expr"])
+	assert.Equal(t, []string{"pk"}, summary["output_fields"])
+	assert.Equal(t, []string{"p1"}, summary["partition_names"])
+	assert.Contains(t, summary, "size")
+
+	summary = getPayloadSummary(&milvuspb.CreateCredentialRequest{Username: "alice", Password: "secret"})
+	assert.Equal(t, "alice", summary["username"])
+	for _, v := range summary {
+		assert.NotEqual(t, "secret", v)
+	}
+}
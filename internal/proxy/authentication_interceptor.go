@@ -9,37 +9,12 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/authutil"
 	"github.com/milvus-io/milvus/internal/util/crypto"
 
 	"google.golang.org/grpc/metadata"
 )
 
-// validAuth validates the authentication
-func validAuth(ctx context.Context, authorization []string) bool {
-	if len(authorization) < 1 {
-		//log.Warn("key not found in header", zap.String("key", headerAuthorize))
-		return false
-	}
-	// token format: base64<username:password>
-	//token := strings.TrimPrefix(authorization[0], "Bearer ")
-	token := authorization[0]
-	rawToken, err := crypto.Base64Decode(token)
-	if err != nil {
-		return false
-	}
-	secrets := strings.SplitN(rawToken, util.CredentialSeperator, 2)
-	username := secrets[0]
-	password := secrets[1]
-
-	credInfo, err := globalMetaCache.GetCredentialInfo(ctx, username)
-	if err != nil {
-		log.Error("found no credential", zap.String("username", username), zap.Error(err))
-		return false
-	}
-
-	return crypto.PasswordVerify(password, credInfo)
-}
-
 func validSourceID(ctx context.Context, authorization []string) bool {
 	if len(authorization) < 1 {
 		//log.Warn("key not found in header", zap.String("key", util.HeaderSourceID))
@@ -68,11 +43,22 @@ func AuthenticationInterceptor(ctx context.Context) (context.Context, error) {
 	// check:
 	//	1. if rpc call from a member (like index/query/data component)
 	// 	2. if rpc call from sdk
+	//	3. if rpc call with the credentials of the other authenticators, like api keys or JWT
 	if Params.CommonCfg.AuthorizationEnabled {
-		if !validSourceID(ctx, md[strings.ToLower(util.HeaderSourceID)]) &&
-			!validAuth(ctx, md[strings.ToLower(util.HeaderAuthorize)]) {
+		if validSourceID(ctx, md[strings.ToLower(util.HeaderSourceID)]) {
+			return ctx, nil
+		}
+		authorization := md[strings.ToLower(util.HeaderAuthorize)]
+		if len(authorization) < 1 {
+			return nil, ErrUnauthenticated()
+		}
+		info, err := getAuthenticatorChain().authenticate(ctx, authorization[0])
+		if err != nil {
+			log.Debug("authentication failed", zap.Error(err))
 			return nil, ErrUnauthenticated()
 		}
+		setAuditAuthInfo(ctx, info)
+		return authutil.ContextWithAuthInfo(ctx, info), nil
 	}
 	return ctx, nil
 }
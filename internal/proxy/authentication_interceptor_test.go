@@ -12,25 +12,6 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-// validAuth validates the authentication
-func TestValidAuth(t *testing.T) {
-	ctx := context.Background()
-	// no metadata
-	res := validAuth(ctx, nil)
-	assert.False(t, res)
-	// illegal metadata
-	res = validAuth(ctx, []string{"xxx"})
-	assert.False(t, res)
-	// normal metadata
-	rootCoord := &MockRootCoordClientInterface{}
-	queryCoord := &MockQueryCoordClientInterface{}
-	mgr := newShardClientMgr()
-	err := InitMetaCache(ctx, rootCoord, queryCoord, mgr)
-	assert.Nil(t, err)
-	res = validAuth(ctx, []string{crypto.Base64Encode("mockUser:mockPass")})
-	assert.True(t, res)
-}
-
 func TestValidSourceID(t *testing.T) {
 	ctx := context.Background()
 	// no metadata
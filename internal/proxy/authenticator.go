@@ -0,0 +1,263 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/authutil"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+)
+
+const (
+	authenticatorPassword = "password"
+	authenticatorAPIKey   = "apikey"
+	authenticatorJWT      = "jwt"
+
+	authSchemeBasic  = "basic"
+	authSchemeBearer = "bearer"
+	// authSchemeLegacy is the token of the sdks sending base64<username:password> without a scheme
+	authSchemeLegacy = ""
+)
+
+// authenticator verifies a kind of credentials.
+// It returns nil AuthInfo and nil error if the credential isn't recognized, so that the next authenticator is tried,
+// and an error if the credential is recognized but invalid.
+type authenticator interface {
+	name() string
+	authenticate(ctx context.Context, scheme string, credential string) (*authutil.AuthInfo, error)
+}
+
+type authenticatorBuilder func() (authenticator, error)
+
+var authenticatorBuilders = map[string]authenticatorBuilder{
+	authenticatorPassword: func() (authenticator, error) {
+		return &passwordAuthenticator{}, nil
+	},
+	authenticatorAPIKey: func() (authenticator, error) {
+		return newAPIKeyAuthenticator(Params.CommonCfg.APIKeyFile)
+	},
+	authenticatorJWT: func() (authenticator, error) {
+		return newJWTAuthenticator(jwtAuthenticatorConfig{
+			jwksURL:         Params.CommonCfg.JWTJwksURL,
+			jwksFile:        Params.CommonCfg.JWTJwksFile,
+			refreshInterval: Params.CommonCfg.JWTJwksRefreshInterval,
+			issuer:          Params.CommonCfg.JWTIssuer,
+			audience:        Params.CommonCfg.JWTAudience,
+			userClaim:       Params.CommonCfg.JWTUserClaim,
+			rolesClaim:      Params.CommonCfg.JWTRolesClaim,
+		})
+	},
+}
+
+// authenticatorChain tries the authenticators in order, the first one recognizing the credential decides
+type authenticatorChain struct {
+	authenticators []authenticator
+}
+
+func newAuthenticatorChain(names []string) (*authenticatorChain, error) {
+	chain := &authenticatorChain{}
+	for _, name := range names {
+		builder, ok := authenticatorBuilders[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown authenticator %s", name)
+		}
+		a, err := builder()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create authenticator %s: %w", name, err)
+		}
+		chain.authenticators = append(chain.authenticators, a)
+	}
+	if len(chain.authenticators) == 0 {
+		return nil, errors.New("no authenticator configured")
+	}
+	return chain, nil
+}
+
+func (c *authenticatorChain) authenticate(ctx context.Context, authorization string) (*authutil.AuthInfo, error) {
+	scheme, credential := parseAuthorization(authorization)
+	if credential == "" {
+		return nil, errors.New("empty credential")
+	}
+	for _, a := range c.authenticators {
+		info, err := a.authenticate(ctx, scheme, credential)
+		if err != nil {
+			log.Debug("authentication failed", zap.String("authenticator", a.name()), zap.Error(err))
+			return nil, err
+		}
+		if info != nil {
+			info.Method = a.name()
+			return info, nil
+		}
+	}
+	return nil, errors.New("credential not recognized by any authenticator")
+}
+
+// parseAuthorization splits the authorization like "Bearer xxx" into the lowercase scheme and the credential
+func parseAuthorization(authorization string) (string, string) {
+	authorization = strings.TrimSpace(authorization)
+	if i := strings.IndexByte(authorization, ' '); i > 0 {
+		scheme := strings.ToLower(authorization[:i])
+		if scheme == authSchemeBasic || scheme == authSchemeBearer {
+			return scheme, strings.TrimSpace(authorization[i+1:])
+		}
+	}
+	return authSchemeLegacy, authorization
+}
+
+var globalAuthenticatorChain atomic.Value
+
+// initAuthenticators creates the authenticator chain configured, used by the grpc and the http servers
+func initAuthenticators() error {
+	chain, err := newAuthenticatorChain(Params.CommonCfg.Authenticators)
+	if err != nil {
+		return err
+	}
+	globalAuthenticatorChain.Store(chain)
+	return nil
+}
+
+// getAuthenticatorChain returns the configured chain, or the password authenticator only if not initialized
+func getAuthenticatorChain() *authenticatorChain {
+	if chain, ok := globalAuthenticatorChain.Load().(*authenticatorChain); ok && chain != nil {
+		return chain
+	}
+	return &authenticatorChain{authenticators: []authenticator{&passwordAuthenticator{}}}
+}
+
+// AuthenticateHTTPRequest verifies the Authorization header of http requests with the authenticator chain,
+// it returns nil AuthInfo if the authorization is disabled.
+func AuthenticateHTTPRequest(ctx context.Context, authorization string) (*authutil.AuthInfo, error) {
+	if !Params.CommonCfg.AuthorizationEnabled {
+		return nil, nil
+	}
+	if globalMetaCache == nil {
+		return nil, ErrProxyNotReady()
+	}
+	return getAuthenticatorChain().authenticate(ctx, authorization)
+}
+
+// passwordAuthenticator verifies base64<username:password> with the credentials stored in milvus
+type passwordAuthenticator struct{}
+
+func (a *passwordAuthenticator) name() string {
+	return authenticatorPassword
+}
+
+func (a *passwordAuthenticator) authenticate(ctx context.Context, scheme string, credential string) (*authutil.AuthInfo, error) {
+	if scheme != authSchemeBasic && scheme != authSchemeLegacy {
+		return nil, nil
+	}
+	rawToken, err := crypto.Base64Decode(credential)
+	if err != nil {
+		if scheme == authSchemeLegacy {
+			// may be a credential of other authenticators
+			return nil, nil
+		}
+		return nil, fmt.Errorf("invalid basic credential: %w", err)
+	}
+	secrets := strings.SplitN(rawToken, util.CredentialSeperator, 2)
+	if len(secrets) < 2 {
+		if scheme == authSchemeLegacy {
+			return nil, nil
+		}
+		return nil, errors.New("invalid basic credential")
+	}
+	username, password := secrets[0], secrets[1]
+	credInfo, err := globalMetaCache.GetCredentialInfo(ctx, username)
+	if err != nil {
+		log.Debug("found no credential", zap.String("username", username), zap.Error(err))
+		if scheme == authSchemeLegacy {
+			// may be a credential of other authenticators containing the separator
+			return nil, nil
+		}
+		return nil, errors.New("invalid username or password")
+	}
+	if !crypto.PasswordVerify(password, credInfo) {
+		if scheme == authSchemeLegacy {
+			return nil, nil
+		}
+		return nil, errors.New("invalid username or password")
+	}
+	return &authutil.AuthInfo{User: username}, nil
+}
+
+type apiKeyEntry struct {
+	User    string   `json:"user"`
+	KeyHash string   `json:"keyHash"`
+	Roles   []string `json:"roles"`
+}
+
+// apiKeyAuthenticator verifies the static api keys, only the sha256 hashes of the keys are configured
+type apiKeyAuthenticator struct {
+	keys map[string]apiKeyEntry
+}
+
+func newAPIKeyAuthenticator(file string) (*apiKeyAuthenticator, error) {
+	if file == "" {
+		return nil, errors.New("api key file not configured")
+	}
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var entries []apiKeyEntry
+	if err := json.Unmarshal(content, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse api key file %s: %w", file, err)
+	}
+	a := &apiKeyAuthenticator{keys: make(map[string]apiKeyEntry, len(entries))}
+	for _, entry := range entries {
+		if entry.User == "" || entry.KeyHash == "" {
+			return nil, fmt.Errorf("invalid api key entry of user %q, user and keyHash are required", entry.User)
+		}
+		if entry.User == util.UserRoot {
+			return nil, fmt.Errorf("invalid api key entry, api keys of user %s are not allowed", util.UserRoot)
+		}
+		a.keys[strings.ToLower(entry.KeyHash)] = entry
+	}
+	return a, nil
+}
+
+func (a *apiKeyAuthenticator) name() string {
+	return authenticatorAPIKey
+}
+
+func (a *apiKeyAuthenticator) authenticate(ctx context.Context, scheme string, credential string) (*authutil.AuthInfo, error) {
+	if scheme != authSchemeBearer && scheme != authSchemeLegacy {
+		return nil, nil
+	}
+	sum := sha256.Sum256([]byte(credential))
+	hash := hex.EncodeToString(sum[:])
+	entry, ok := a.keys[hash]
+	if !ok {
+		// may be a credential of other authenticators
+		return nil, nil
+	}
+	return &authutil.AuthInfo{User: entry.User, Roles: append([]string(nil), entry.Roles...)}, nil
+}
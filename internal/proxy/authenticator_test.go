@@ -0,0 +1,310 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/form3tech-oss/jwt-go"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/authutil"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func writeAPIKeyFile(t *testing.T, dir string, entries ...apiKeyEntry) string {
+	content, err := json.Marshal(entries)
+	require.NoError(t, err)
+	file := filepath.Join(dir, "api_keys.json")
+	require.NoError(t, ioutil.WriteFile(file, content, 0600))
+	return file
+}
+
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func writeJWKSFile(t *testing.T, dir string, kid string, key *rsa.PublicKey) string {
+	jwks := map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": kid,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	}
+	content, err := json.Marshal(jwks)
+	require.NoError(t, err)
+	file := filepath.Join(dir, "jwks.json")
+	require.NoError(t, ioutil.WriteFile(file, content, 0600))
+	return file
+}
+
+func signJWT(t *testing.T, key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func Test_parseAuthorization(t *testing.T) {
+	scheme, credential := parseAuthorization("Bearer abc")
+	assert.Equal(t, authSchemeBearer, scheme)
+	assert.Equal(t, "abc", credential)
+
+	scheme, credential = parseAuthorization("basic  abc ")
+	assert.Equal(t, authSchemeBasic, scheme)
+	assert.Equal(t, "abc", credential)
+
+	scheme, credential = parseAuthorization("abc")
+	assert.Equal(t, authSchemeLegacy, scheme)
+	assert.Equal(t, "abc", credential)
+}
+
+func Test_apiKeyAuthenticator(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	_, err := newAPIKeyAuthenticator("")
+	assert.Error(t, err)
+	_, err = newAPIKeyAuthenticator(writeAPIKeyFile(t, dir, apiKeyEntry{User: "ingest"}))
+	assert.Error(t, err)
+	_, err = newAPIKeyAuthenticator(writeAPIKeyFile(t, dir, apiKeyEntry{User: util.UserRoot, KeyHash: hashAPIKey("secret-key")}))
+	assert.Error(t, err)
+
+	a, err := newAPIKeyAuthenticator(writeAPIKeyFile(t, dir,
+		apiKeyEntry{User: "ingest", KeyHash: hashAPIKey("secret-key"), Roles: []string{"writer"}}))
+	require.NoError(t, err)
+
+	info, err := a.authenticate(ctx, authSchemeBearer, "secret-key")
+	assert.NoError(t, err)
+	assert.Equal(t, "ingest", info.User)
+	assert.Equal(t, []string{"writer"}, info.Roles)
+
+	// unknown keys and other schemes are left to the other authenticators
+	info, err = a.authenticate(ctx, authSchemeBearer, "unknown-key")
+	assert.NoError(t, err)
+	assert.Nil(t, info)
+	info, err = a.authenticate(ctx, authSchemeBasic, "secret-key")
+	assert.NoError(t, err)
+	assert.Nil(t, info)
+}
+
+func Test_jwtAuthenticator(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	_, err = newJWTAuthenticator(jwtAuthenticatorConfig{})
+	assert.Error(t, err)
+
+	a, err := newJWTAuthenticator(jwtAuthenticatorConfig{
+		jwksFile:   writeJWKSFile(t, dir, "key1", &key.PublicKey),
+		issuer:     "https://issuer",
+		audience:   "milvus",
+		userClaim:  "sub",
+		rolesClaim: "roles",
+	})
+	require.NoError(t, err)
+
+	claims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"sub":   "alice",
+			"iss":   "https://issuer",
+			"aud":   "milvus",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"roles": []string{"reader", "writer"},
+		}
+	}
+
+	info, err := a.authenticate(ctx, authSchemeBearer, signJWT(t, key, "key1", claims()))
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", info.User)
+	assert.Equal(t, []string{"reader", "writer"}, info.Roles)
+
+	// roles in a string
+	c := claims()
+	c["roles"] = "reader, writer"
+	info, err = a.authenticate(ctx, authSchemeBearer, signJWT(t, key, "key1", c))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"reader", "writer"}, info.Roles)
+
+	// not a jwt
+	info, err = a.authenticate(ctx, authSchemeBearer, "secret-key")
+	assert.NoError(t, err)
+	assert.Nil(t, info)
+
+	// expired
+	c = claims()
+	c["exp"] = time.Now().Add(-time.Hour).Unix()
+	_, err = a.authenticate(ctx, authSchemeBearer, signJWT(t, key, "key1", c))
+	assert.Error(t, err)
+
+	// never expiring
+	c = claims()
+	delete(c, "exp")
+	_, err = a.authenticate(ctx, authSchemeBearer, signJWT(t, key, "key1", c))
+	assert.Error(t, err)
+
+	// wrong issuer and audience
+	c = claims()
+	c["iss"] = "https://other"
+	_, err = a.authenticate(ctx, authSchemeBearer, signJWT(t, key, "key1", c))
+	assert.Error(t, err)
+	c = claims()
+	c["aud"] = "other"
+	_, err = a.authenticate(ctx, authSchemeBearer, signJWT(t, key, "key1", c))
+	assert.Error(t, err)
+
+	// no user
+	c = claims()
+	delete(c, "sub")
+	_, err = a.authenticate(ctx, authSchemeBearer, signJWT(t, key, "key1", c))
+	assert.Error(t, err)
+
+	// root is not accepted
+	c = claims()
+	c["sub"] = util.UserRoot
+	_, err = a.authenticate(ctx, authSchemeBearer, signJWT(t, key, "key1", c))
+	assert.Error(t, err)
+
+	// signed by unknown keys
+	_, err = a.authenticate(ctx, authSchemeBearer, signJWT(t, otherKey, "key1", claims()))
+	assert.Error(t, err)
+	_, err = a.authenticate(ctx, authSchemeBearer, signJWT(t, otherKey, "key2", claims()))
+	assert.Error(t, err)
+
+	// hmac is not allowed
+	hmacToken := jwt.NewWithClaims(jwt.SigningMethodHS256, claims())
+	hmacToken.Header["kid"] = "key1"
+	signed, err := hmacToken.SignedString([]byte("secret"))
+	require.NoError(t, err)
+	_, err = a.authenticate(ctx, authSchemeBearer, signed)
+	assert.Error(t, err)
+
+	// the rotated keys are loaded
+	writeJWKSFile(t, dir, "key2", &otherKey.PublicKey)
+	a.lastRefresh = time.Now().Add(-time.Minute)
+	info, err = a.authenticate(ctx, authSchemeBearer, signJWT(t, otherKey, "key2", claims()))
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", info.User)
+}
+
+func TestAuthenticationInterceptor_Authenticators(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	Params.CommonCfg.AuthorizationEnabled = true
+	defer func() {
+		Params.CommonCfg.AuthorizationEnabled = false
+		globalAuthenticatorChain.Store((*authenticatorChain)(nil))
+	}()
+	rootCoord := &MockRootCoordClientInterface{}
+	queryCoord := &MockQueryCoordClientInterface{}
+	err := InitMetaCache(ctx, rootCoord, queryCoord, newShardClientMgr())
+	require.NoError(t, err)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	Params.CommonCfg.Authenticators = []string{authenticatorPassword, authenticatorAPIKey, authenticatorJWT}
+	legacyKey := crypto.Base64Encode("svc:token")
+	Params.CommonCfg.APIKeyFile = writeAPIKeyFile(t, dir,
+		apiKeyEntry{User: "ingest", KeyHash: hashAPIKey("secret-key"), Roles: []string{"writer"}},
+		apiKeyEntry{User: "svc", KeyHash: hashAPIKey(legacyKey), Roles: []string{"reader"}})
+	Params.CommonCfg.JWTJwksFile = writeJWKSFile(t, dir, "key1", &key.PublicKey)
+	Params.CommonCfg.JWTUserClaim = "sub"
+	Params.CommonCfg.JWTRolesClaim = "roles"
+	defer func() {
+		Params.CommonCfg.Authenticators = []string{authenticatorPassword}
+		Params.CommonCfg.APIKeyFile = ""
+		Params.CommonCfg.JWTJwksFile = ""
+	}()
+	require.NoError(t, initAuthenticators())
+
+	authenticate := func(authorization string) (*authutil.AuthInfo, error) {
+		newCtx, err := AuthenticationInterceptor(metadata.NewIncomingContext(ctx, metadata.Pairs(util.HeaderAuthorize, authorization)))
+		if err != nil {
+			return nil, err
+		}
+		info, ok := authutil.AuthInfoFromContext(newCtx)
+		assert.True(t, ok)
+		user, err := GetCurUserFromContext(newCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, info.User, user)
+		return info, nil
+	}
+
+	info, err := authenticate(crypto.Base64Encode("mockUser:mockPass"))
+	assert.NoError(t, err)
+	assert.Equal(t, authenticatorPassword, info.Method)
+	info, err = authenticate("Basic " + crypto.Base64Encode("mockUser:mockPass"))
+	assert.NoError(t, err)
+	assert.Equal(t, "mockUser", info.User)
+
+	info, err = authenticate("Bearer secret-key")
+	assert.NoError(t, err)
+	assert.Equal(t, authenticatorAPIKey, info.Method)
+	assert.Equal(t, "ingest", info.User)
+
+	token := signJWT(t, key, "key1", jwt.MapClaims{"sub": "alice", "roles": []string{"reader"}, "exp": time.Now().Add(time.Hour).Unix()})
+	info, err = authenticate("Bearer " + token)
+	assert.NoError(t, err)
+	assert.Equal(t, authenticatorJWT, info.Method)
+	assert.Equal(t, "alice", info.User)
+
+	// the legacy tokens not matching a credential are left to the other authenticators
+	info, err = authenticate(legacyKey)
+	assert.NoError(t, err)
+	assert.Equal(t, authenticatorAPIKey, info.Method)
+	assert.Equal(t, "svc", info.User)
+	_, err = authenticate(crypto.Base64Encode("mockUser:wrongPass"))
+	assert.Error(t, err)
+
+	_, err = authenticate("Bearer unknown-key")
+	assert.Error(t, err)
+	_, err = authenticate("Bearer " + token + "x")
+	assert.Error(t, err)
+
+	// the http requests use the same chain
+	info, err = AuthenticateHTTPRequest(ctx, "Bearer secret-key")
+	assert.NoError(t, err)
+	assert.Equal(t, "ingest", info.User)
+	_, err = AuthenticateHTTPRequest(ctx, "Bearer unknown-key")
+	assert.Error(t, err)
+
+	// unknown authenticator
+	Params.CommonCfg.Authenticators = []string{"unknown"}
+	assert.Error(t, initAuthenticators())
+}
@@ -38,6 +38,7 @@ import (
 type channelsMgr interface {
 	getChannels(collectionID UniqueID) ([]pChan, error)
 	getVChannels(collectionID UniqueID) ([]vChan, error)
+	getShardsNumHistory(collectionID UniqueID) ([]int32, error)
 	getOrCreateDmlStream(collectionID UniqueID) (msgstream.MsgStream, error)
 	removeDMLStream(collectionID UniqueID) error
 	removeAllDMLStream() error
@@ -47,6 +48,8 @@ type channelInfos struct {
 	// It seems that there is no need to maintain relationships between vchans & pchans.
 	vchans []vChan
 	pchans []pChan
+	// every shards number the collection has used, the vchans are only ever appended to
+	shardsNumHistory []int32
 }
 
 type streamInfos struct {
@@ -106,7 +109,12 @@ func getDmlChannelsFunc(ctx context.Context, rc types.RootCoord) getChannelsFunc
 			return channelInfos{}, errors.New(resp.GetStatus().GetReason())
 		}
 
-		return newChannels(resp.GetVirtualChannelNames(), resp.GetPhysicalChannelNames())
+		channels, err := newChannels(resp.GetVirtualChannelNames(), resp.GetPhysicalChannelNames())
+		if err != nil {
+			return channelInfos{}, err
+		}
+		channels.shardsNumHistory = resp.GetShardsNumHistory()
+		return channels, nil
 	}
 }
 
@@ -176,6 +184,18 @@ func (mgr *singleTypeChannelsMgr) getVChannels(collectionID UniqueID) ([]vChan,
 	return channelInfos.vchans, nil
 }
 
+// getShardsNumHistory returns the shards numbers the collection has used.
+func (mgr *singleTypeChannelsMgr) getShardsNumHistory(collectionID UniqueID) ([]int32, error) {
+	channelInfos, err := mgr.getAllChannels(collectionID)
+	if err != nil {
+		channelInfos, err = mgr.getChannelsFunc(collectionID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return channelInfos.shardsNumHistory, nil
+}
+
 func (mgr *singleTypeChannelsMgr) streamExistPrivate(collectionID UniqueID) bool {
 	streamInfos, ok := mgr.infos[collectionID]
 	return ok && streamInfos.stream != nil
@@ -331,6 +351,10 @@ func (mgr *channelsMgrImpl) getVChannels(collectionID UniqueID) ([]vChan, error)
 	return mgr.dmlChannelsMgr.getVChannels(collectionID)
 }
 
+func (mgr *channelsMgrImpl) getShardsNumHistory(collectionID UniqueID) ([]int32, error) {
+	return mgr.dmlChannelsMgr.getShardsNumHistory(collectionID)
+}
+
 func (mgr *channelsMgrImpl) getOrCreateDmlStream(collectionID UniqueID) (msgstream.MsgStream, error) {
 	return mgr.dmlChannelsMgr.getOrCreateStream(collectionID)
 }
@@ -205,6 +205,42 @@ func Test_singleTypeChannelsMgr_getVChannels(t *testing.T) {
 	})
 }
 
+func Test_singleTypeChannelsMgr_getShardsNumHistory(t *testing.T) {
+	t.Run("cached", func(t *testing.T) {
+		m := &singleTypeChannelsMgr{
+			infos: map[UniqueID]streamInfos{
+				100: {channelInfos: channelInfos{vchans: []string{"111", "222"}, pchans: []string{"111", "111"}, shardsNumHistory: []int32{1, 2}}},
+			},
+		}
+		got, err := m.getShardsNumHistory(100)
+		assert.NoError(t, err)
+		assert.Equal(t, []int32{1, 2}, got)
+	})
+
+	t.Run("not cached", func(t *testing.T) {
+		m := &singleTypeChannelsMgr{
+			infos: map[UniqueID]streamInfos{},
+			getChannelsFunc: func(collectionID UniqueID) (channelInfos, error) {
+				return channelInfos{vchans: []string{"111", "222"}, pchans: []string{"111", "111"}, shardsNumHistory: []int32{1, 2}}, nil
+			},
+		}
+		got, err := m.getShardsNumHistory(100)
+		assert.NoError(t, err)
+		assert.Equal(t, []int32{1, 2}, got)
+	})
+
+	t.Run("error case", func(t *testing.T) {
+		m := &singleTypeChannelsMgr{
+			infos: map[UniqueID]streamInfos{},
+			getChannelsFunc: func(collectionID UniqueID) (channelInfos, error) {
+				return channelInfos{}, errors.New("mock")
+			},
+		}
+		_, err := m.getShardsNumHistory(100)
+		assert.Error(t, err)
+	})
+}
+
 func Test_createStream(t *testing.T) {
 	t.Run("failed to create msgstream", func(t *testing.T) {
 		factory := newMockMsgStreamFactory()
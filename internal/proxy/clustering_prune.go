@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+// clusteringKeyRangesTTL is how long the clustering key ranges of a collection are cached before refreshed,
+// segments flushed or compacted in the meantime are just not pruned.
+const clusteringKeyRangesTTL = 10 * time.Second
+
+type collectionKeyRanges struct {
+	ranges    map[UniqueID]*datapb.ClusteringKeyRange // segment id -> key range, nil if the segment isn't clustered
+	refreshed time.Time
+}
+
+// clusteringKeyRanges caches the clustering key ranges of the flushed segments,
+// the range of a segment never changes once it's flushed.
+type clusteringKeyRanges struct {
+	mu          sync.Mutex
+	collections map[UniqueID]*collectionKeyRanges
+}
+
+var globalClusteringKeyRanges = &clusteringKeyRanges{collections: make(map[UniqueID]*collectionKeyRanges)}
+
+// get returns the clustering key ranges of the flushed segments of the collection, refreshed from data coord if expired.
+func (c *clusteringKeyRanges) get(ctx context.Context, dc types.DataCoord, collectionID UniqueID) (map[UniqueID]*datapb.ClusteringKeyRange, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cached, ok := c.collections[collectionID]
+	if ok && time.Since(cached.refreshed) < clusteringKeyRangesTTL {
+		return cached.ranges, nil
+	}
+
+	flushed, err := dc.GetFlushedSegments(ctx, &datapb.GetFlushedSegmentsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_SegmentInfo,
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		CollectionID: collectionID,
+		PartitionID:  -1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if flushed.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(flushed.GetStatus().GetReason())
+	}
+
+	ranges := make(map[UniqueID]*datapb.ClusteringKeyRange, len(flushed.GetSegments()))
+	var unknown []UniqueID
+	for _, segmentID := range flushed.GetSegments() {
+		if keyRange, ok := cached.getRange(segmentID); ok {
+			ranges[segmentID] = keyRange
+		} else {
+			unknown = append(unknown, segmentID)
+		}
+	}
+	if len(unknown) > 0 {
+		infos, err := dc.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_SegmentInfo,
+				SourceID: Params.ProxyCfg.GetNodeID(),
+			},
+			SegmentIDs: unknown,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if infos.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return nil, errors.New(infos.GetStatus().GetReason())
+		}
+		for _, info := range infos.GetInfos() {
+			ranges[info.GetID()] = info.GetClusteringKeyRange()
+		}
+	}
+
+	c.collections[collectionID] = &collectionKeyRanges{ranges: ranges, refreshed: time.Now()}
+	return ranges, nil
+}
+
+func (c *collectionKeyRanges) getRange(segmentID UniqueID) (*datapb.ClusteringKeyRange, bool) {
+	if c == nil {
+		return nil, false
+	}
+	keyRange, ok := c.ranges[segmentID]
+	return keyRange, ok
+}
+
+// pruneByClusteringKey returns the flushed segments whose clustering key range can't match the filter of the plan.
+// The pruning is best effort, nothing is pruned if the ranges are unavailable.
+func pruneByClusteringKey(ctx context.Context, dc types.DataCoord, collectionID UniqueID, schema *schemapb.CollectionSchema, plan *planpb.PlanNode) []UniqueID {
+	if dc == nil || !hasClusteringKey(schema) {
+		return nil
+	}
+	ranges, err := globalClusteringKeyRanges.get(ctx, dc, collectionID)
+	if err != nil {
+		log.Warn("failed to get the clustering key ranges, skip pruning", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return nil
+	}
+	var pruned []UniqueID
+	for segmentID, keyRange := range ranges {
+		if keyRange != nil && planparserv2.CanPruneByRange(plan, keyRange.GetFieldID(), keyRange.GetMin(), keyRange.GetMax()) {
+			pruned = append(pruned, segmentID)
+		}
+	}
+	return pruned
+}
+
+func hasClusteringKey(schema *schemapb.CollectionSchema) bool {
+	for _, field := range schema.GetFields() {
+		if field.GetIsClusteringKey() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+type clusteringDataCoordMock struct {
+	*DataCoordMock
+	segments []*datapb.SegmentInfo
+	requests int
+}
+
+func (m *clusteringDataCoordMock) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
+	m.requests++
+	var ids []int64
+	for _, segment := range m.segments {
+		if segment.GetCollectionID() == req.GetCollectionID() {
+			ids = append(ids, segment.GetID())
+		}
+	}
+	return &datapb.GetFlushedSegmentsResponse{Status: &commonpb.Status{}, Segments: ids}, nil
+}
+
+func (m *clusteringDataCoordMock) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+	var infos []*datapb.SegmentInfo
+	for _, segment := range m.segments {
+		for _, id := range req.GetSegmentIDs() {
+			if segment.GetID() == id {
+				infos = append(infos, segment)
+			}
+		}
+	}
+	return &datapb.GetSegmentInfoResponse{Status: &commonpb.Status{}, Infos: infos}, nil
+}
+
+func TestPruneByClusteringKey(t *testing.T) {
+	Params.InitOnce()
+	ctx := context.Background()
+	int64Value := func(v int64) *planpb.GenericValue {
+		return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: v}}
+	}
+	dc := &clusteringDataCoordMock{
+		DataCoordMock: NewDataCoordMock(),
+		segments: []*datapb.SegmentInfo{
+			{ID: 1, CollectionID: 1000},
+			{ID: 2, CollectionID: 1000, ClusteringKeyRange: &datapb.ClusteringKeyRange{FieldID: 101, Min: int64Value(0), Max: int64Value(9)}},
+			{ID: 3, CollectionID: 1000, ClusteringKeyRange: &datapb.ClusteringKeyRange{FieldID: 101, Min: int64Value(10), Max: int64Value(19)}},
+		},
+	}
+	schema := &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "key", DataType: schemapb.DataType_Int64},
+		},
+	}
+	plan, err := planparserv2.CreateRetrievePlan(schema, "key >= 10")
+	assert.NoError(t, err)
+
+	// nothing is pruned without clustering key
+	assert.Empty(t, pruneByClusteringKey(ctx, dc, 1000, schema, plan))
+	assert.Equal(t, 0, dc.requests)
+
+	schema.Fields[1].IsClusteringKey = true
+	assert.Empty(t, pruneByClusteringKey(ctx, nil, 1000, schema, plan))
+	assert.ElementsMatch(t, []int64{2}, pruneByClusteringKey(ctx, dc, 1000, schema, plan))
+
+	// the cached ranges are used before expired
+	dc.segments = append(dc.segments, &datapb.SegmentInfo{ID: 4, CollectionID: 1000,
+		ClusteringKeyRange: &datapb.ClusteringKeyRange{FieldID: 101, Min: int64Value(-10), Max: int64Value(-1)}})
+	assert.ElementsMatch(t, []int64{2}, pruneByClusteringKey(ctx, dc, 1000, schema, plan))
+	assert.Equal(t, 1, dc.requests)
+
+	globalClusteringKeyRanges.collections[1000].refreshed = globalClusteringKeyRanges.collections[1000].refreshed.Add(-clusteringKeyRangesTTL)
+	assert.ElementsMatch(t, []int64{2, 4}, pruneByClusteringKey(ctx, dc, 1000, schema, plan))
+	assert.Equal(t, 2, dc.requests)
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
@@ -198,6 +199,14 @@ func (coord *DataCoordMock) GetCompactionStateWithPlans(ctx context.Context, req
 	return &milvuspb.GetCompactionPlansResponse{}, nil
 }
 
+func (coord *DataCoordMock) GetCompactionPolicyStats(ctx context.Context, req *milvuspb.GetCompactionPolicyStatsRequest) (*milvuspb.GetCompactionPolicyStatsResponse, error) {
+	return &milvuspb.GetCompactionPolicyStatsResponse{}, nil
+}
+
+func (coord *DataCoordMock) CancelCompaction(ctx context.Context, req *milvuspb.CancelCompactionRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
 func (coord *DataCoordMock) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error) {
 	return &datapb.WatchChannelsResponse{}, nil
 }
@@ -239,6 +248,29 @@ func (coord *DataCoordMock) ReleaseSegmentLock(ctx context.Context, req *datapb.
 	}, nil
 }
 
+func (coord *DataCoordMock) TruncateSegments(ctx context.Context, req *datapb.TruncateSegmentsRequest) (*datapb.TruncateSegmentsResponse, error) {
+	return &datapb.TruncateSegmentsResponse{}, nil
+}
+
+func (coord *DataCoordMock) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) (*datapb.CreateSnapshotResponse, error) {
+	return &datapb.CreateSnapshotResponse{}, nil
+}
+
+func (coord *DataCoordMock) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *DataCoordMock) ListSnapshots(ctx context.Context, req *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error) {
+	return &datapb.ListSnapshotsResponse{}, nil
+}
+
+func (coord *DataCoordMock) CheckPrimaryKeys(ctx context.Context, req *datapb.CheckPrimaryKeysRequest) (*datapb.CheckPrimaryKeysResponse, error) {
+	return &datapb.CheckPrimaryKeysResponse{
+		Status:       &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		ExistingKeys: &schemapb.IDs{},
+	}, nil
+}
+
 func NewDataCoordMock() *DataCoordMock {
 	return &DataCoordMock{
 		nodeID:            typeutil.UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt()),
@@ -0,0 +1,408 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	deleteJobPrefix = "proxy/delete-job"
+	// the interval to take over the jobs of the crashed proxies
+	deleteJobCheckInterval = time.Minute
+	// the finished jobs are removed after the retention
+	deleteJobRetention = 24 * time.Hour
+)
+
+// errDeleteJobClaimed means the job has been taken over by another proxy
+var errDeleteJobClaimed = errors.New("delete job is claimed by another proxy")
+
+// deleteJobKV is the meta storage of the delete jobs
+type deleteJobKV interface {
+	kv.BaseKV
+	CompareValueAndSwap(key, value, target string, opts ...clientv3.OpOption) (bool, error)
+}
+
+// deleteJob is the persisted state of a DeleteByExpr job
+type deleteJob struct {
+	JobID          UniqueID `json:"job_id"`
+	NodeID         int64    `json:"node_id"` // the proxy running the job
+	DbName         string   `json:"db_name"`
+	CollectionName string   `json:"collection_name"`
+	PartitionName  string   `json:"partition_name"`
+	// the expression of the request
+	Expr string `json:"expr"`
+	// the row policies of the user submitting the job, the entities hidden by them aren't deleted
+	RowPolicyExpr string                  `json:"row_policy_expr"`
+	State         milvuspb.DeleteJobState `json:"state"`
+	DeletedRows   int64                   `json:"deleted_rows"`
+	Cursor        string                  `json:"cursor"` // the last deleted primary key in the expressions, the job resumes after it
+	Reason        string                  `json:"reason"`
+	CreateTs      Timestamp               `json:"create_ts"`
+	UpdateTs      Timestamp               `json:"update_ts"`
+
+	// the value last persisted, to detect the updates of other proxies
+	value string
+}
+
+func deleteJobKey(jobID UniqueID) string {
+	return path.Join(deleteJobPrefix, strconv.FormatInt(jobID, 10))
+}
+
+// filter returns the expression of the entities to delete
+func (job *deleteJob) filter() string {
+	return mergeRowPolicyExpr(job.Expr, job.RowPolicyExpr)
+}
+
+func (job *deleteJob) finished() bool {
+	return job.State == milvuspb.DeleteJobState_DeleteJobCompleted || job.State == milvuspb.DeleteJobState_DeleteJobFailed
+}
+
+func unmarshalDeleteJob(value string) (*deleteJob, error) {
+	job := &deleteJob{}
+	if err := json.Unmarshal([]byte(value), job); err != nil {
+		return nil, err
+	}
+	job.value = value
+	return job, nil
+}
+
+// deleteJobManager runs the DeleteByExpr jobs in the background.
+// The primary keys matching the filter are paged through in order and deleted page by page,
+// the progress and the cursor are persisted after each page. The jobs of the crashed proxies are taken over
+// by the others, which continue after the persisted cursor.
+type deleteJobManager struct {
+	ctx    context.Context
+	kv     deleteJobKV
+	nodeID int64
+
+	allocID     func() (UniqueID, error)
+	liveProxies func() (map[int64]struct{}, error)
+	// queryPKs returns the smallest limit primary keys greater than the cursor of the job in order,
+	// of the entities matching the filter of the job. All the keys are candidates if the cursor is empty.
+	queryPKs func(ctx context.Context, job *deleteJob, limit int64) ([]interface{}, error)
+	// deletePKs deletes the entities with the primary keys
+	deletePKs func(ctx context.Context, job *deleteJob, pks []interface{}) error
+
+	mu      sync.Mutex
+	running map[UniqueID]struct{}
+	wg      sync.WaitGroup
+}
+
+func newDeleteJobManager(ctx context.Context, kv deleteJobKV, nodeID int64) *deleteJobManager {
+	return &deleteJobManager{
+		ctx:     ctx,
+		kv:      kv,
+		nodeID:  nodeID,
+		running: make(map[UniqueID]struct{}),
+	}
+}
+
+// submit persists a new job and runs it
+func (m *deleteJobManager) submit(job *deleteJob) (UniqueID, error) {
+	jobID, err := m.allocID()
+	if err != nil {
+		return 0, err
+	}
+	job.JobID = jobID
+	job.NodeID = m.nodeID
+	job.State = milvuspb.DeleteJobState_DeleteJobPending
+	job.CreateTs = tsoutil.GetCurrentTime()
+	job.UpdateTs = job.CreateTs
+	value, err := json.Marshal(job)
+	if err != nil {
+		return 0, err
+	}
+	if err := m.kv.Save(deleteJobKey(jobID), string(value)); err != nil {
+		return 0, err
+	}
+	job.value = string(value)
+	m.run(job)
+	return jobID, nil
+}
+
+func (m *deleteJobManager) get(jobID UniqueID) (*deleteJob, error) {
+	value, err := m.kv.Load(deleteJobKey(jobID))
+	if err != nil {
+		return nil, fmt.Errorf("delete job %d not found: %w", jobID, err)
+	}
+	return unmarshalDeleteJob(value)
+}
+
+// update persists the mutated job if it isn't changed by others since last persisted
+func (m *deleteJobManager) update(job *deleteJob, mutate func(job *deleteJob)) error {
+	updated := *job
+	mutate(&updated)
+	updated.UpdateTs = tsoutil.GetCurrentTime()
+	value, err := json.Marshal(&updated)
+	if err != nil {
+		return err
+	}
+	swapped, err := m.kv.CompareValueAndSwap(deleteJobKey(job.JobID), job.value, string(value))
+	if err != nil {
+		return err
+	}
+	if !swapped {
+		return errDeleteJobClaimed
+	}
+	updated.value = string(value)
+	*job = updated
+	return nil
+}
+
+func (m *deleteJobManager) run(job *deleteJob) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.running[job.JobID]; ok {
+		return
+	}
+	m.running[job.JobID] = struct{}{}
+	m.wg.Add(1)
+	go func() {
+		defer func() {
+			m.mu.Lock()
+			delete(m.running, job.JobID)
+			m.mu.Unlock()
+			m.wg.Done()
+		}()
+		m.execute(job)
+	}()
+}
+
+func (m *deleteJobManager) execute(job *deleteJob) {
+	log := log.With(zap.Int64("jobID", job.JobID), zap.String("collection", job.CollectionName),
+		zap.String("expr", job.Expr))
+	if err := m.update(job, func(job *deleteJob) {
+		job.State = milvuspb.DeleteJobState_DeleteJobRunning
+	}); err != nil {
+		log.Warn("failed to start delete job", zap.Error(err))
+		return
+	}
+	log.Info("delete job started", zap.Int64("deletedRows", job.DeletedRows), zap.String("cursor", job.Cursor))
+
+	claimed := false
+	var err error
+	for {
+		var pks []interface{}
+		pks, err = m.queryPKs(m.ctx, job, Params.ProxyCfg.DeleteJobBatchRows)
+		if err != nil || len(pks) == 0 {
+			break
+		}
+		if err = m.deletePKs(m.ctx, job, pks); err != nil {
+			break
+		}
+		if err = m.update(job, func(job *deleteJob) {
+			job.DeletedRows += int64(len(pks))
+			job.Cursor = formatPK(pks[len(pks)-1])
+		}); err != nil {
+			claimed = errors.Is(err, errDeleteJobClaimed)
+			break
+		}
+	}
+	if claimed {
+		log.Warn("delete job is claimed by another proxy, stop running it")
+		return
+	}
+	if m.ctx.Err() != nil {
+		// the proxy is stopping, the job is resumed by another one
+		log.Info("delete job is interrupted", zap.Int64("deletedRows", job.DeletedRows))
+		return
+	}
+
+	if updateErr := m.update(job, func(job *deleteJob) {
+		if err != nil {
+			job.State = milvuspb.DeleteJobState_DeleteJobFailed
+			job.Reason = err.Error()
+		} else {
+			job.State = milvuspb.DeleteJobState_DeleteJobCompleted
+		}
+	}); updateErr != nil {
+		log.Warn("failed to update the state of delete job", zap.Error(updateErr))
+		return
+	}
+	log.Info("delete job finished", zap.String("state", job.State.String()), zap.Int64("deletedRows", job.DeletedRows),
+		zap.Error(err))
+}
+
+// recover takes over the unfinished jobs whose proxies are gone, and removes the expired finished jobs
+func (m *deleteJobManager) recover() error {
+	_, values, err := m.kv.LoadWithPrefix(deleteJobPrefix)
+	if err != nil {
+		return err
+	}
+	live, err := m.liveProxies()
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		job, err := unmarshalDeleteJob(value)
+		if err != nil {
+			log.Warn("failed to unmarshal delete job", zap.Error(err))
+			continue
+		}
+		if job.finished() {
+			updateTime, _ := tsoutil.ParseTS(job.UpdateTs)
+			if time.Since(updateTime) > deleteJobRetention {
+				if err := m.kv.Remove(deleteJobKey(job.JobID)); err != nil {
+					log.Warn("failed to remove expired delete job", zap.Int64("jobID", job.JobID), zap.Error(err))
+				}
+			}
+			continue
+		}
+		if _, ok := live[job.NodeID]; ok {
+			continue
+		}
+		previous := job.NodeID
+		if err := m.update(job, func(job *deleteJob) {
+			job.NodeID = m.nodeID
+		}); err != nil {
+			log.Warn("failed to take over delete job", zap.Int64("jobID", job.JobID), zap.Error(err))
+			continue
+		}
+		log.Info("take over delete job", zap.Int64("jobID", job.JobID), zap.Int64("previousProxy", previous))
+		m.run(job)
+	}
+	return nil
+}
+
+func (m *deleteJobManager) start() {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(deleteJobCheckInterval)
+		defer ticker.Stop()
+		for {
+			if err := m.recover(); err != nil {
+				log.Warn("failed to recover delete jobs", zap.Error(err))
+			}
+			select {
+			case <-m.ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// close waits for the running jobs to be interrupted, the context should be canceled first
+func (m *deleteJobManager) close() {
+	m.wg.Wait()
+}
+
+// initDeleteJobManager creates the manager of the delete jobs running by the query and delete of the proxy
+func (node *Proxy) initDeleteJobManager() {
+	m := newDeleteJobManager(node.ctx, etcdkv.NewEtcdKV(node.etcdCli, Params.EtcdCfg.MetaRootPath), node.session.ServerID)
+	m.allocID = node.idAllocator.AllocOne
+	m.liveProxies = func() (map[int64]struct{}, error) {
+		sessions, _, err := node.session.GetSessions(typeutil.ProxyRole)
+		if err != nil {
+			return nil, err
+		}
+		live := make(map[int64]struct{}, len(sessions))
+		for _, session := range sessions {
+			live[session.ServerID] = struct{}{}
+		}
+		return live, nil
+	}
+	m.queryPKs = node.queryDeleteJobPKs
+	m.deletePKs = node.deleteJobPKs
+	node.deleteJobs = m
+}
+
+// getPrimaryFieldSchema returns the schema of the primary field of the collection
+func getPrimaryFieldSchema(ctx context.Context, collectionName string) (*schemapb.FieldSchema, error) {
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	return typeutil.GetPrimaryFieldSchema(schema)
+}
+
+// sameCollection checks whether the names refer to the same collection, either of them may be an alias
+func sameCollection(ctx context.Context, name1, name2 string) bool {
+	if name1 == name2 {
+		return true
+	}
+	collectionID1, err := globalMetaCache.GetCollectionID(ctx, name1)
+	if err != nil {
+		return false
+	}
+	collectionID2, err := globalMetaCache.GetCollectionID(ctx, name2)
+	return err == nil && collectionID1 == collectionID2
+}
+
+// queryDeleteJobPKs returns a page of the primary keys matching the filter of the job in order,
+// the limit is pushed down to the segments so that each page only retrieves the entities of the page
+func (node *Proxy) queryDeleteJobPKs(ctx context.Context, job *deleteJob, limit int64) ([]interface{}, error) {
+	pkSchema, err := getPrimaryFieldSchema(ctx, job.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+	request := &milvuspb.QueryRequest{
+		DbName:         job.DbName,
+		CollectionName: job.CollectionName,
+		Expr:           job.filter(),
+	}
+	if job.Cursor != "" {
+		request.Expr = fmt.Sprintf("(%s) && (%s > %s)", request.Expr, pkSchema.GetName(), job.Cursor)
+	}
+	if job.PartitionName != "" {
+		request.PartitionNames = []string{job.PartitionName}
+	}
+	// the row policies of the user submitting the job are in the filter already
+	return node.queryPKs(ctx, request, pkSchema, true, limit)
+}
+
+// deleteJobPKs deletes a batch of the entities of the job
+func (node *Proxy) deleteJobPKs(ctx context.Context, job *deleteJob, pks []interface{}) error {
+	pkSchema, err := getPrimaryFieldSchema(ctx, job.CollectionName)
+	if err != nil {
+		return err
+	}
+	result, err := node.Delete(ctx, &milvuspb.DeleteRequest{
+		DbName:         job.DbName,
+		CollectionName: job.CollectionName,
+		PartitionName:  job.PartitionName,
+		Expr:           getPKTermExpr(pkSchema.GetName(), pks),
+	})
+	if err != nil {
+		return err
+	}
+	if result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(result.GetStatus().GetReason())
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+type mockDeleteJobKV struct {
+	*memkv.MemoryKV
+	mu sync.Mutex
+}
+
+func (kv *mockDeleteJobKV) CompareValueAndSwap(key, value, target string, opts ...clientv3.OpOption) (bool, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	current, err := kv.Load(key)
+	if err != nil || current != value {
+		return false, nil
+	}
+	return true, kv.Save(key, target)
+}
+
+func newMockDeleteJobManager(ctx context.Context, pks []interface{}) (*deleteJobManager, *[][]interface{}) {
+	var mu sync.Mutex
+	var deleted [][]interface{}
+	m := newDeleteJobManager(ctx, &mockDeleteJobKV{MemoryKV: memkv.NewMemoryKV()}, 1)
+	nextID := UniqueID(100)
+	m.allocID = func() (UniqueID, error) {
+		nextID++
+		return nextID, nil
+	}
+	m.liveProxies = func() (map[int64]struct{}, error) {
+		return map[int64]struct{}{1: {}, 2: {}}, nil
+	}
+	// the pks are sorted
+	m.queryPKs = func(ctx context.Context, job *deleteJob, limit int64) ([]interface{}, error) {
+		start := 0
+		if job.Cursor != "" {
+			for start < len(pks) && formatPK(pks[start]) != job.Cursor {
+				start++
+			}
+			start++
+		}
+		end := start + int(limit)
+		if end > len(pks) {
+			end = len(pks)
+		}
+		if start >= end {
+			return nil, nil
+		}
+		return pks[start:end], nil
+	}
+	m.deletePKs = func(ctx context.Context, job *deleteJob, pks []interface{}) error {
+		mu.Lock()
+		defer mu.Unlock()
+		deleted = append(deleted, pks)
+		return nil
+	}
+	return m, &deleted
+}
+
+func waitDeleteJobFinished(t *testing.T, m *deleteJobManager, jobID UniqueID) *deleteJob {
+	var job *deleteJob
+	assert.Eventually(t, func() bool {
+		var err error
+		job, err = m.get(jobID)
+		assert.NoError(t, err)
+		return job.finished()
+	}, 5*time.Second, 10*time.Millisecond)
+	return job
+}
+
+func TestDeleteJobManager_Submit(t *testing.T) {
+	Params.Init()
+	oldBatchRows := Params.ProxyCfg.DeleteJobBatchRows
+	Params.ProxyCfg.DeleteJobBatchRows = 2
+	defer func() {
+		Params.ProxyCfg.DeleteJobBatchRows = oldBatchRows
+	}()
+
+	m, deleted := newMockDeleteJobManager(context.Background(), []interface{}{int64(1), int64(2), int64(3), int64(4), int64(5)})
+	var filters []string
+	query := m.queryPKs
+	m.queryPKs = func(ctx context.Context, job *deleteJob, limit int64) ([]interface{}, error) {
+		filters = append(filters, job.filter())
+		return query(ctx, job, limit)
+	}
+	jobID, err := m.submit(&deleteJob{CollectionName: "coll", Expr: "age > 10", RowPolicyExpr: "owner == \"u1\""})
+	assert.NoError(t, err)
+
+	job := waitDeleteJobFinished(t, m, jobID)
+	m.close()
+	assert.Equal(t, milvuspb.DeleteJobState_DeleteJobCompleted, job.State)
+	assert.Equal(t, int64(5), job.DeletedRows)
+	assert.Equal(t, "5", job.Cursor)
+	assert.Equal(t, int64(1), job.NodeID)
+	// the expression submitted is kept apart from the row policies
+	assert.Equal(t, "age > 10", job.Expr)
+	assert.Equal(t, "(owner == \"u1\") && (age > 10)", filters[0])
+	// the last page is empty
+	assert.Equal(t, 4, len(filters))
+	assert.Equal(t, [][]interface{}{{int64(1), int64(2)}, {int64(3), int64(4)}, {int64(5)}}, *deleted)
+
+	_, err = m.get(jobID + 1)
+	assert.Error(t, err)
+
+	t.Run("delete failed", func(t *testing.T) {
+		m, _ := newMockDeleteJobManager(context.Background(), []interface{}{"a", "b", "c"})
+		m.deletePKs = func(ctx context.Context, job *deleteJob, pks []interface{}) error {
+			if pks[0] == "c" {
+				return errors.New("mock")
+			}
+			return nil
+		}
+		jobID, err := m.submit(&deleteJob{CollectionName: "coll", Expr: "name like \"x%\""})
+		assert.NoError(t, err)
+
+		job := waitDeleteJobFinished(t, m, jobID)
+		m.close()
+		assert.Equal(t, milvuspb.DeleteJobState_DeleteJobFailed, job.State)
+		assert.Equal(t, int64(2), job.DeletedRows)
+		assert.Equal(t, "\"b\"", job.Cursor)
+		assert.Equal(t, "mock", job.Reason)
+	})
+
+	t.Run("alloc id failed", func(t *testing.T) {
+		m, _ := newMockDeleteJobManager(context.Background(), nil)
+		m.allocID = func() (UniqueID, error) {
+			return 0, errors.New("mock")
+		}
+		_, err := m.submit(&deleteJob{CollectionName: "coll", Expr: "age > 10"})
+		assert.Error(t, err)
+	})
+}
+
+func TestDeleteJobManager_Claimed(t *testing.T) {
+	Params.Init()
+	m, _ := newMockDeleteJobManager(context.Background(), []interface{}{int64(1), int64(2)})
+	m.deletePKs = func(ctx context.Context, job *deleteJob, pks []interface{}) error {
+		// another proxy takes over the job
+		stolen, err := m.get(job.JobID)
+		assert.NoError(t, err)
+		stolen.NodeID = 2
+		value, err := json.Marshal(stolen)
+		assert.NoError(t, err)
+		return m.kv.Save(deleteJobKey(job.JobID), string(value))
+	}
+	jobID, err := m.submit(&deleteJob{CollectionName: "coll", Expr: "age > 10"})
+	assert.NoError(t, err)
+	m.close()
+
+	// the job is left to the new owner
+	job, err := m.get(jobID)
+	assert.NoError(t, err)
+	assert.Equal(t, milvuspb.DeleteJobState_DeleteJobRunning, job.State)
+	assert.Equal(t, int64(2), job.NodeID)
+	assert.Equal(t, int64(0), job.DeletedRows)
+}
+
+func TestDeleteJobManager_Recover(t *testing.T) {
+	Params.Init()
+	m, deleted := newMockDeleteJobManager(context.Background(), []interface{}{int64(1), int64(2), int64(3)})
+
+	saveJob := func(job *deleteJob) {
+		value, err := json.Marshal(job)
+		assert.NoError(t, err)
+		assert.NoError(t, m.kv.Save(deleteJobKey(job.JobID), string(value)))
+	}
+	now := tsoutil.GetCurrentTime()
+	// the proxy running the job crashed after deleting 2 entities, the job continues after them
+	saveJob(&deleteJob{JobID: 1, NodeID: 10, CollectionName: "coll", Expr: "age > 10",
+		State: milvuspb.DeleteJobState_DeleteJobRunning, DeletedRows: 2, Cursor: "2", CreateTs: now, UpdateTs: now})
+	// running on a live proxy
+	saveJob(&deleteJob{JobID: 2, NodeID: 2, CollectionName: "coll", Expr: "age > 10",
+		State: milvuspb.DeleteJobState_DeleteJobRunning, CreateTs: now, UpdateTs: now})
+	expired := tsoutil.ComposeTSByTime(time.Now().Add(-2*deleteJobRetention), 0)
+	saveJob(&deleteJob{JobID: 3, NodeID: 10, CollectionName: "coll", Expr: "age > 10",
+		State: milvuspb.DeleteJobState_DeleteJobCompleted, CreateTs: expired, UpdateTs: expired})
+	saveJob(&deleteJob{JobID: 4, NodeID: 10, CollectionName: "coll", Expr: "age > 10",
+		State: milvuspb.DeleteJobState_DeleteJobFailed, CreateTs: now, UpdateTs: now})
+
+	err := m.recover()
+	assert.NoError(t, err)
+	job := waitDeleteJobFinished(t, m, 1)
+	m.close()
+	assert.Equal(t, milvuspb.DeleteJobState_DeleteJobCompleted, job.State)
+	assert.Equal(t, int64(1), job.NodeID)
+	assert.Equal(t, int64(3), job.DeletedRows)
+	assert.Equal(t, [][]interface{}{{int64(3)}}, *deleted)
+
+	job, err = m.get(2)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), job.NodeID)
+	assert.Equal(t, milvuspb.DeleteJobState_DeleteJobRunning, job.State)
+
+	_, err = m.get(3)
+	assert.Error(t, err)
+	_, err = m.get(4)
+	assert.NoError(t, err)
+
+	m.liveProxies = func() (map[int64]struct{}, error) {
+		return nil, errors.New("mock")
+	}
+	assert.Error(t, m.recover())
+}
+
+func TestDeleteJobManager_Interrupted(t *testing.T) {
+	Params.Init()
+	ctx, cancel := context.WithCancel(context.Background())
+	m, _ := newMockDeleteJobManager(ctx, []interface{}{int64(1)})
+	m.queryPKs = func(ctx context.Context, job *deleteJob, limit int64) ([]interface{}, error) {
+		cancel()
+		return nil, ctx.Err()
+	}
+	m.start()
+	jobID, err := m.submit(&deleteJob{CollectionName: "coll", Expr: "age > 10"})
+	assert.NoError(t, err)
+	m.close()
+
+	// the job is resumed later
+	job, err := m.get(jobID)
+	assert.NoError(t, err)
+	assert.Equal(t, milvuspb.DeleteJobState_DeleteJobRunning, job.State)
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/milvus-io/milvus/internal/util/errorutil"
 
@@ -34,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -117,6 +119,10 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 			globalMetaCache.RemoveCollectionsByID(ctx, collectionID)
 		}
 	}
+	// the shards of the collection changed, the dml stream is recreated with the new channels on next use
+	if request.GetBase().GetMsgType() == commonpb.MsgType_AlterShards && node.chMgr != nil {
+		_ = node.chMgr.removeDMLStream(collectionID)
+	}
 	logutil.Logger(ctx).Info("complete to invalidate collection meta cache",
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -1205,6 +1211,101 @@ func (node *Proxy) DropPartition(ctx context.Context, request *milvuspb.DropPart
 	return dpt.result, nil
 }
 
+// TruncatePartition removes all the entities of a partition, the partition itself is kept.
+func (node *Proxy) TruncatePartition(ctx context.Context, request *milvuspb.TruncatePartitionRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-TruncatePartition")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+	method := "TruncatePartition"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	tpt := &truncatePartitionTask{
+		ctx:                      ctx,
+		Condition:                NewTaskCondition(ctx),
+		TruncatePartitionRequest: request,
+		rootCoord:                node.rootCoord,
+		result:                   nil,
+	}
+
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName))
+
+	if err := node.sched.ddQueue.Enqueue(tpt); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.String("partition", request.PartitionName))
+
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", tpt.ID()),
+		zap.Uint64("BeginTS", tpt.BeginTs()),
+		zap.Uint64("EndTS", tpt.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName))
+
+	if err := tpt.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", tpt.ID()),
+			zap.Uint64("BeginTS", tpt.BeginTs()),
+			zap.Uint64("EndTS", tpt.EndTs()),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.String("partition", request.PartitionName))
+
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", tpt.ID()),
+		zap.Uint64("BeginTS", tpt.BeginTs()),
+		zap.Uint64("EndTS", tpt.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName))
+
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyDDLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return tpt.result, nil
+}
+
 // HasPartition check if partition exist.
 func (node *Proxy) HasPartition(ctx context.Context, request *milvuspb.HasPartitionRequest) (*milvuspb.BoolResponse, error) {
 	if !node.checkHealthy() {
@@ -2351,6 +2452,16 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 		}
 	}
 
+	// must be done before the insert task is enqueued, which allocates the timestamps of the upserting deletes
+	upsertIDs, err := node.checkUniquePK(ctx, request)
+	if err != nil {
+		log.Debug("Failed to check the uniqueness of primary keys: "+err.Error(), zap.String("traceID", traceID))
+		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+			metrics.FailLabel).Inc()
+		return constructFailedResponse(err), nil
+	}
+	it.upsertIDs = upsertIDs
+
 	log.Debug("Enqueue insert request in Proxy",
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -2365,7 +2476,9 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 		log.Debug("Failed to enqueue insert task: " + err.Error())
 		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 			metrics.AbandonLabel).Inc()
-		return constructFailedResponse(err), nil
+		resp := constructFailedResponse(err)
+		resp.Status = enqueueFailedStatus(err)
+		return resp, nil
 	}
 
 	log.Debug("Detail of insert request in Proxy",
@@ -2432,6 +2545,28 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 
 	metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.TotalLabel).Inc()
+
+	// the entities hidden by the row policies of the user are not deleted
+	if apply, err := node.applyRowPolicyToDelete(ctx, request); err != nil {
+		log.Debug("Failed to apply row policies to delete request: "+err.Error(), zap.String("traceID", traceID))
+		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+			metrics.FailLabel).Inc()
+		return &milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	} else if !apply {
+		metrics.ProxyDMLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
+			metrics.SuccessLabel).Inc()
+		return &milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+		}, nil
+	}
+
 	dt := &deleteTask{
 		ctx:        ctx,
 		Condition:  NewTaskCondition(ctx),
@@ -2469,10 +2604,7 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 			metrics.FailLabel).Inc()
 
 		return &milvuspb.MutationResult{
-			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
-				Reason:    err.Error(),
-			},
+			Status: enqueueFailedStatus(err),
 		}, nil
 	}
 
@@ -2506,14 +2638,129 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 	return dt.result, nil
 }
 
+// DeleteByExpr submits a job deleting the entities matching the expression in the background.
+// Any expression supported by query is allowed, the collection should be loaded to evaluate it.
+func (node *Proxy) DeleteByExpr(ctx context.Context, request *milvuspb.DeleteByExprRequest) (*milvuspb.DeleteByExprResponse, error) {
+	log.Info("received delete by expr request", zap.String("collection", request.GetCollectionName()),
+		zap.String("partition", request.GetPartitionName()), zap.String("expr", request.GetExpr()))
+	if !node.checkHealthy() {
+		return &milvuspb.DeleteByExprResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+
+	failResponse := func(err error) *milvuspb.DeleteByExprResponse {
+		log.Warn("failed to submit delete job", zap.String("collection", request.GetCollectionName()), zap.Error(err))
+		return &milvuspb.DeleteByExprResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}
+	}
+	if strings.TrimSpace(request.GetExpr()) == "" {
+		return failResponse(errors.New("delete expression is empty")), nil
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, request.GetCollectionName())
+	if err != nil {
+		return failResponse(err), nil
+	}
+	if _, err := planparserv2.CreateRetrievePlan(schema, request.GetExpr()); err != nil {
+		return failResponse(fmt.Errorf("failed to create query plan: %v", err)), nil
+	}
+	if request.GetPartitionName() != "" {
+		if _, err := globalMetaCache.GetPartitionID(ctx, request.GetCollectionName(), request.GetPartitionName()); err != nil {
+			return failResponse(err), nil
+		}
+	}
+	// the job runs without the user, the entities hidden by the row policies are excluded now
+	policyExpr, err := getRowPolicyExpr(ctx, request.GetCollectionName())
+	if err != nil {
+		return failResponse(err), nil
+	}
+
+	jobID, err := node.deleteJobs.submit(&deleteJob{
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetCollectionName(),
+		PartitionName:  request.GetPartitionName(),
+		Expr:           request.GetExpr(),
+		RowPolicyExpr:  policyExpr,
+	})
+	if err != nil {
+		return failResponse(err), nil
+	}
+	log.Info("delete job submitted", zap.Int64("jobID", jobID), zap.String("collection", request.GetCollectionName()))
+	return &milvuspb.DeleteByExprResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		JobID: jobID,
+	}, nil
+}
+
+// GetDeleteJobState returns the state and the progress of the job submitted by DeleteByExpr
+func (node *Proxy) GetDeleteJobState(ctx context.Context, request *milvuspb.GetDeleteJobStateRequest) (*milvuspb.GetDeleteJobStateResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.GetDeleteJobStateResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+
+	job, err := node.deleteJobs.get(request.GetJobID())
+	if err == nil && !sameCollection(ctx, job.CollectionName, request.GetCollectionName()) {
+		// the privilege is checked against the collection of the request, so the jobs of others are hidden
+		err = fmt.Errorf("delete job %d not found in collection %s", request.GetJobID(), request.GetCollectionName())
+	}
+	if err != nil {
+		log.Warn("failed to get delete job", zap.Int64("jobID", request.GetJobID()), zap.Error(err))
+		return &milvuspb.GetDeleteJobStateResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return &milvuspb.GetDeleteJobStateResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		State:          job.State,
+		DeletedRows:    job.DeletedRows,
+		Reason:         job.Reason,
+		CollectionName: job.CollectionName,
+		PartitionName:  job.PartitionName,
+		Expr:           job.Expr,
+		CreateTs:       job.CreateTs,
+		UpdateTs:       job.UpdateTs,
+	}, nil
+}
+
 // Search search the most similar records of requests.
 func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	return node.search(ctx, request, nil)
+}
+
+// SearchStream searches the most similar records of requests, and sends the results in chunks of queries in order.
+// The last chunk carries the status and the explain only.
+func (node *Proxy) SearchStream(ctx context.Context, request *milvuspb.SearchRequest, send func(*milvuspb.SearchResults) error) error {
+	result, err := node.search(ctx, request, send)
+	if err != nil {
+		return err
+	}
+	return send(result)
+}
+
+// search returns the results at once if send is nil, otherwise the results are sent in chunks by send
+func (node *Proxy) search(ctx context.Context, request *milvuspb.SearchRequest, send func(*milvuspb.SearchResults) error) (*milvuspb.SearchResults, error) {
 	if !node.checkHealthy() {
 		return &milvuspb.SearchResults{
 			Status: unhealthyStatus(),
 		}, nil
 	}
 	method := "Search"
+	if send != nil {
+		method = "SearchStream"
+	}
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.TotalLabel).Inc()
@@ -2532,10 +2779,13 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 			},
 			ReqID: Params.ProxyCfg.GetNodeID(),
 		},
-		request:  request,
-		qc:       node.queryCoord,
-		tr:       timerecord.NewTimeRecorder("search"),
-		shardMgr: node.shardMgr,
+		request:    request,
+		qc:         node.queryCoord,
+		dc:         node.dataCoord,
+		tr:         timerecord.NewTimeRecorder("search"),
+		shardMgr:   node.shardMgr,
+		sendStream: send,
+		streams:    node.resultStreams,
 	}
 
 	travelTs := request.TravelTimestamp
@@ -2575,13 +2825,13 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 			metrics.AbandonLabel).Inc()
 
 		return &milvuspb.SearchResults{
-			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
-				Reason:    err.Error(),
-			},
+			Status: enqueueFailedStatus(err),
 		}, nil
 	}
 	tr.Record("search request enqueue")
+	if send != nil {
+		defer node.resultStreams.remove(qt.ID())
+	}
 
 	log.Debug(
 		rpcEnqueued(method),
@@ -2755,6 +3005,21 @@ func (node *Proxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*
 
 // Query get the records by primary keys.
 func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+	return node.query(ctx, request, nil)
+}
+
+// QueryStream gets the records by primary keys, and sends the results in chunks as the shards complete.
+// The last chunk carries the status and the explain only.
+func (node *Proxy) QueryStream(ctx context.Context, request *milvuspb.QueryRequest, send func(*milvuspb.QueryResults) error) error {
+	result, err := node.query(ctx, request, send)
+	if err != nil {
+		return err
+	}
+	return send(result)
+}
+
+// query returns the results at once if send is nil, otherwise the results are sent in chunks by send
+func (node *Proxy) query(ctx context.Context, request *milvuspb.QueryRequest, send func(*milvuspb.QueryResults) error) (*milvuspb.QueryResults, error) {
 	if !node.checkHealthy() {
 		return &milvuspb.QueryResults{
 			Status: unhealthyStatus(),
@@ -2778,11 +3043,17 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 		},
 		request:          request,
 		qc:               node.queryCoord,
+		dc:               node.dataCoord,
 		queryShardPolicy: mergeRoundRobinPolicy,
 		shardMgr:         node.shardMgr,
+		sendStream:       send,
+		streams:          node.resultStreams,
 	}
 
 	method := "Query"
+	if send != nil {
+		method = "QueryStream"
+	}
 
 	metrics.ProxyDQLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method,
 		metrics.TotalLabel).Inc()
@@ -2813,13 +3084,13 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 			metrics.FailLabel).Inc()
 
 		return &milvuspb.QueryResults{
-			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
-				Reason:    err.Error(),
-			},
+			Status: enqueueFailedStatus(err),
 		}, nil
 	}
 	tr.Record("query request enqueue")
+	if send != nil {
+		defer node.resultStreams.remove(qt.ID())
+	}
 
 	log.Debug(
 		rpcEnqueued(method),
@@ -2872,6 +3143,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	ret := &milvuspb.QueryResults{
 		Status:     qt.result.Status,
 		FieldsData: qt.result.FieldsData,
+		Explain:    qt.result.Explain,
 	}
 	sentSize := proto.Size(qt.result)
 	metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10)).Add(float64(sentSize))
@@ -3155,63 +3427,435 @@ func (node *Proxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasR
 	return aat.result, nil
 }
 
-// CalcDistance calculates the distances between vectors.
-func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
+// AlterShards increases the shards number of a collection, entities inserted afterwards are
+// distributed over both the old and the new shards.
+func (node *Proxy) AlterShards(ctx context.Context, request *milvuspb.AlterShardsRequest) (*commonpb.Status, error) {
 	if !node.checkHealthy() {
-		return &milvuspb.CalcDistanceResults{
-			Status: unhealthyStatus(),
-		}, nil
+		return unhealthyStatus(), nil
 	}
 
-	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CalcDistance")
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-AlterShards")
 	defer sp.Finish()
 	traceID, _, _ := trace.InfoFromSpan(sp)
 
-	query := func(ids *milvuspb.VectorIDs) (*milvuspb.QueryResults, error) {
-		outputFields := []string{ids.FieldName}
-
-		queryRequest := &milvuspb.QueryRequest{
-			DbName:         "",
-			CollectionName: ids.CollectionName,
-			PartitionNames: ids.PartitionNames,
-			OutputFields:   outputFields,
-		}
+	ast := &AlterShardsTask{
+		ctx:                ctx,
+		Condition:          NewTaskCondition(ctx),
+		AlterShardsRequest: request,
+		rootCoord:          node.rootCoord,
+	}
 
-		qt := &queryTask{
-			ctx:       ctx,
-			Condition: NewTaskCondition(ctx),
-			RetrieveRequest: &internalpb.RetrieveRequest{
-				Base: &commonpb.MsgBase{
-					MsgType:  commonpb.MsgType_Retrieve,
-					SourceID: Params.ProxyCfg.GetNodeID(),
-				},
-				ReqID: Params.ProxyCfg.GetNodeID(),
-			},
-			request: queryRequest,
-			qc:      node.queryCoord,
-			ids:     ids.IdArray,
+	method := "AlterShards"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
 
-			queryShardPolicy: mergeRoundRobinPolicy,
-			shardMgr:         node.shardMgr,
-		}
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Int32("shardsNum", request.ShardsNum))
 
-		items := []zapcore.Field{
-			zap.String("collection", queryRequest.CollectionName),
-			zap.Any("partitions", queryRequest.PartitionNames),
-			zap.Any("OutputFields", queryRequest.OutputFields),
-		}
+	if err := node.sched.ddQueue.Enqueue(ast); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.Int32("shardsNum", request.ShardsNum))
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
 
-		err := node.sched.dqQueue.Enqueue(qt)
-		if err != nil {
-			log.Error("CalcDistance queryTask failed to enqueue", append(items, zap.Error(err))...)
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
 
-			return &milvuspb.QueryResults{
-				Status: &commonpb.Status{
-					ErrorCode: commonpb.ErrorCode_UnexpectedError,
-					Reason:    err.Error(),
-				},
-			}, err
-		}
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", ast.ID()),
+		zap.Uint64("BeginTs", ast.BeginTs()),
+		zap.Uint64("EndTs", ast.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Int32("shardsNum", request.ShardsNum))
+
+	if err := ast.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", ast.ID()),
+			zap.Uint64("BeginTs", ast.BeginTs()),
+			zap.Uint64("EndTs", ast.EndTs()),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.Int32("shardsNum", request.ShardsNum))
+
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", ast.ID()),
+		zap.Uint64("BeginTs", ast.BeginTs()),
+		zap.Uint64("EndTs", ast.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Int32("shardsNum", request.ShardsNum))
+
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyDDLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return ast.result, nil
+}
+
+// TruncateCollection removes all the entities of a collection, the schema, indexes and load state are kept.
+func (node *Proxy) TruncateCollection(ctx context.Context, request *milvuspb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-TruncateCollection")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+	method := "TruncateCollection"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	tct := &truncateCollectionTask{
+		ctx:                       ctx,
+		Condition:                 NewTaskCondition(ctx),
+		TruncateCollectionRequest: request,
+		rootCoord:                 node.rootCoord,
+		result:                    nil,
+	}
+
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName))
+
+	if err := node.sched.ddQueue.Enqueue(tct); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName))
+
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", tct.ID()),
+		zap.Uint64("BeginTS", tct.BeginTs()),
+		zap.Uint64("EndTS", tct.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName))
+
+	if err := tct.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", tct.ID()),
+			zap.Uint64("BeginTS", tct.BeginTs()),
+			zap.Uint64("EndTS", tct.EndTs()),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName))
+
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", tct.ID()),
+		zap.Uint64("BeginTS", tct.BeginTs()),
+		zap.Uint64("EndTS", tct.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName))
+
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyDDLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return tct.result, nil
+}
+
+// AddField adds a nullable or default valued scalar field to a collection.
+func (node *Proxy) AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-AddField")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+	method := "AddField"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	aft := &addFieldTask{
+		ctx:             ctx,
+		Condition:       NewTaskCondition(ctx),
+		AddFieldRequest: request,
+		rootCoord:       node.rootCoord,
+		result:          nil,
+	}
+
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.GetField().GetName()))
+
+	if err := node.sched.ddQueue.Enqueue(aft); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.String("field", request.GetField().GetName()))
+
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", aft.ID()),
+		zap.Uint64("BeginTS", aft.BeginTs()),
+		zap.Uint64("EndTS", aft.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.GetField().GetName()))
+
+	if err := aft.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", aft.ID()),
+			zap.Uint64("BeginTS", aft.BeginTs()),
+			zap.Uint64("EndTS", aft.EndTs()),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.String("field", request.GetField().GetName()))
+
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", aft.ID()),
+		zap.Uint64("BeginTS", aft.BeginTs()),
+		zap.Uint64("EndTS", aft.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.GetField().GetName()))
+
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyDDLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return aft.result, nil
+}
+
+// DropField drops a scalar field without index from a collection.
+func (node *Proxy) DropField(ctx context.Context, request *milvuspb.DropFieldRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DropField")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+	method := "DropField"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	dft := &dropFieldTask{
+		ctx:              ctx,
+		Condition:        NewTaskCondition(ctx),
+		DropFieldRequest: request,
+		rootCoord:        node.rootCoord,
+		result:           nil,
+	}
+
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.FieldName))
+
+	if err := node.sched.ddQueue.Enqueue(dft); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.String("field", request.FieldName))
+
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", dft.ID()),
+		zap.Uint64("BeginTS", dft.BeginTs()),
+		zap.Uint64("EndTS", dft.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.FieldName))
+
+	if err := dft.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", dft.ID()),
+			zap.Uint64("BeginTS", dft.BeginTs()),
+			zap.Uint64("EndTS", dft.EndTs()),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.String("field", request.FieldName))
+
+		metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", dft.ID()),
+		zap.Uint64("BeginTS", dft.BeginTs()),
+		zap.Uint64("EndTS", dft.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.FieldName))
+
+	metrics.ProxyDDLFunctionCall.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyDDLReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return dft.result, nil
+}
+
+// CalcDistance calculates the distances between vectors.
+func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.CalcDistanceResults{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CalcDistance")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+
+	query := func(ids *milvuspb.VectorIDs) (*milvuspb.QueryResults, error) {
+		outputFields := []string{ids.FieldName}
+
+		queryRequest := &milvuspb.QueryRequest{
+			DbName:         "",
+			CollectionName: ids.CollectionName,
+			PartitionNames: ids.PartitionNames,
+			OutputFields:   outputFields,
+		}
+
+		qt := &queryTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			RetrieveRequest: &internalpb.RetrieveRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:  commonpb.MsgType_Retrieve,
+					SourceID: Params.ProxyCfg.GetNodeID(),
+				},
+				ReqID: Params.ProxyCfg.GetNodeID(),
+			},
+			request: queryRequest,
+			qc:      node.queryCoord,
+			ids:     ids.IdArray,
+
+			queryShardPolicy: mergeRoundRobinPolicy,
+			shardMgr:         node.shardMgr,
+		}
+
+		items := []zapcore.Field{
+			zap.String("collection", queryRequest.CollectionName),
+			zap.Any("partitions", queryRequest.PartitionNames),
+			zap.Any("OutputFields", queryRequest.OutputFields),
+		}
+
+		err := node.sched.dqQueue.Enqueue(qt)
+		if err != nil {
+			log.Error("CalcDistance queryTask failed to enqueue", append(items, zap.Error(err))...)
+
+			return &milvuspb.QueryResults{
+				Status: enqueueFailedStatus(err),
+			}, err
+		}
 
 		log.Debug("CalcDistance queryTask enqueued", items...)
 
@@ -3655,7 +4299,8 @@ func (node *Proxy) GetCompactionState(ctx context.Context, req *milvuspb.GetComp
 
 // ManualCompaction invokes compaction on specified collection
 func (node *Proxy) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
-	log.Info("received ManualCompaction request", zap.Int64("collectionID", req.GetCollectionID()))
+	log.Info("received ManualCompaction request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("partitionIDs", req.GetPartitionIDs()), zap.Int64s("segmentIDs", req.GetSegmentIDs()))
 	resp := &milvuspb.ManualCompactionResponse{}
 	if !node.checkHealthy() {
 		resp.Status = unhealthyStatus()
@@ -3681,6 +4326,153 @@ func (node *Proxy) GetCompactionStateWithPlans(ctx context.Context, req *milvusp
 	return resp, err
 }
 
+// GetCompactionPolicyStats returns the segments of a collection and why they are selected by the compaction policy or not
+func (node *Proxy) GetCompactionPolicyStats(ctx context.Context, req *milvuspb.GetCompactionPolicyStatsRequest) (*milvuspb.GetCompactionPolicyStatsResponse, error) {
+	log.Info("received GetCompactionPolicyStats request", zap.Int64("collectionID", req.GetCollectionID()))
+	resp := &milvuspb.GetCompactionPolicyStatsResponse{}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	resp, err := node.dataCoord.GetCompactionPolicyStats(ctx, req)
+	log.Info("received GetCompactionPolicyStats response", zap.Int64("collectionID", req.GetCollectionID()), zap.Int("segments", len(resp.GetSegments())), zap.Error(err))
+	return resp, err
+}
+
+// CancelCompaction cancels the pending and executing plans of a manual compaction
+func (node *Proxy) CancelCompaction(ctx context.Context, req *milvuspb.CancelCompactionRequest) (*commonpb.Status, error) {
+	log.Info("received CancelCompaction request", zap.Int64("compactionID", req.GetCompactionID()))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	resp, err := node.dataCoord.CancelCompaction(ctx, req)
+	log.Info("received CancelCompaction response", zap.Int64("compactionID", req.GetCompactionID()), zap.Any("resp", resp), zap.Error(err))
+	return resp, err
+}
+
+// CreateSnapshot pins the current state of a collection as a named snapshot
+func (node *Proxy) CreateSnapshot(ctx context.Context, req *milvuspb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	log.Info("received CreateSnapshot request", zap.String("collection", req.GetCollectionName()), zap.String("snapshot", req.GetSnapshotName()))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+	if err := validateName(req.GetSnapshotName(), "snapshot name"); err != nil {
+		status.ErrorCode = commonpb.ErrorCode_IllegalArgument
+		status.Reason = err.Error()
+		return status, nil
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	if err != nil {
+		log.Error("failed to get collection id", zap.String("collection name", req.GetCollectionName()), zap.Error(err))
+		status.Reason = err.Error()
+		return status, nil
+	}
+	resp, err := node.dataCoord.CreateSnapshot(ctx, &datapb.CreateSnapshotRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_CreateSnapshot,
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		CollectionID: collectionID,
+		Name:         req.GetSnapshotName(),
+	})
+	if err != nil {
+		log.Error("failed to create snapshot", zap.String("collection", req.GetCollectionName()), zap.Error(err))
+		status.Reason = err.Error()
+		return status, nil
+	}
+	log.Info("received CreateSnapshot response", zap.String("collection", req.GetCollectionName()),
+		zap.String("snapshot", req.GetSnapshotName()), zap.Any("status", resp.GetStatus()))
+	return resp.GetStatus(), nil
+}
+
+// DropSnapshot drops a snapshot of a collection, its data is released to compaction and garbage collection
+func (node *Proxy) DropSnapshot(ctx context.Context, req *milvuspb.DropSnapshotRequest) (*commonpb.Status, error) {
+	log.Info("received DropSnapshot request", zap.String("collection", req.GetCollectionName()), zap.String("snapshot", req.GetSnapshotName()))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	// DataCoord drops all the snapshots of the collection by an empty name
+	if err := validateName(req.GetSnapshotName(), "snapshot name"); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	if err != nil {
+		log.Error("failed to get collection id", zap.String("collection name", req.GetCollectionName()), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	resp, err := node.dataCoord.DropSnapshot(ctx, &datapb.DropSnapshotRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_DropSnapshot,
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		CollectionID: collectionID,
+		Name:         req.GetSnapshotName(),
+	})
+	log.Info("received DropSnapshot response", zap.String("collection", req.GetCollectionName()),
+		zap.String("snapshot", req.GetSnapshotName()), zap.Any("status", resp), zap.Error(err))
+	return resp, err
+}
+
+// ListSnapshots lists the snapshots of a collection
+func (node *Proxy) ListSnapshots(ctx context.Context, req *milvuspb.ListSnapshotsRequest) (*milvuspb.ListSnapshotsResponse, error) {
+	log.Info("received ListSnapshots request", zap.String("collection", req.GetCollectionName()))
+	resp := &milvuspb.ListSnapshotsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	if err != nil {
+		log.Error("failed to get collection id", zap.String("collection name", req.GetCollectionName()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	listResp, err := node.dataCoord.ListSnapshots(ctx, &datapb.ListSnapshotsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_ListSnapshots,
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		log.Error("failed to list snapshots", zap.String("collection", req.GetCollectionName()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	if listResp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		resp.Status = listResp.GetStatus()
+		return resp, nil
+	}
+	for _, snapshot := range listResp.GetSnapshots() {
+		resp.Snapshots = append(resp.Snapshots, &milvuspb.SnapshotInfo{
+			SnapshotName:      snapshot.GetName(),
+			CollectionName:    req.GetCollectionName(),
+			SnapshotTimestamp: snapshot.GetSnapshotTs(),
+			SegmentIDs:        snapshot.GetSegmentIDs(),
+		})
+	}
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
 // GetFlushState gets the flush state of multiple segments
 func (node *Proxy) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
 	log.Info("received get flush state request", zap.Any("request", req))
@@ -4040,19 +4832,56 @@ func (node *Proxy) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUser
 	}, nil
 }
 
-// SendSearchResult needs to be removed TODO
+// SendSearchResult receives the partial search result pushed by the shard leader for the streaming search of req.ReqID
 func (node *Proxy) SendSearchResult(ctx context.Context, req *internalpb.SearchResults) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	t, ok := node.resultStreams.get(req.GetReqID())
+	st, isSearch := t.(*searchTask)
+	if !ok || !isSearch {
+		log.Warn("no streaming search of the pushed result", zap.Int64("msgID", req.GetReqID()),
+			zap.Int64("sourceID", req.GetBase().GetSourceID()))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("no streaming search of msgID %d", req.GetReqID()),
+		}, nil
+	}
+	if err := st.pushSearchResult(req); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
 	return &commonpb.Status{
-		ErrorCode: commonpb.ErrorCode_UnexpectedError,
-		Reason:    "Not implemented",
+		ErrorCode: commonpb.ErrorCode_Success,
 	}, nil
 }
 
-// SendRetrieveResult needs to be removed TODO
+// SendRetrieveResult receives the partial query result pushed by the shard leader for the streaming query of req.ReqID,
+// it returns after the result is sent to the client, so the shard leader is held back if the client falls behind.
 func (node *Proxy) SendRetrieveResult(ctx context.Context, req *internalpb.RetrieveResults) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	t, ok := node.resultStreams.get(req.GetReqID())
+	qt, isQuery := t.(*queryTask)
+	if !ok || !isQuery {
+		log.Warn("no streaming query of the pushed result", zap.Int64("msgID", req.GetReqID()),
+			zap.Int64("sourceID", req.GetBase().GetSourceID()))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("no streaming query of msgID %d", req.GetReqID()),
+		}, nil
+	}
+	if err := qt.streamRetrieveResult(ctx, req); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
 	return &commonpb.Status{
-		ErrorCode: commonpb.ErrorCode_UnexpectedError,
-		Reason:    "Not implemented",
+		ErrorCode: commonpb.ErrorCode_Success,
 	}, nil
 }
 
@@ -4311,6 +5140,95 @@ func (node *Proxy) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantReq
 	return result, nil
 }
 
+func (node *Proxy) validRowPolicyParams(role *milvuspb.RoleEntity, collectionName string, policyName string) error {
+	if role == nil {
+		return fmt.Errorf("the role entity in the request is nil")
+	}
+	if err := ValidateRoleName(role.Name); err != nil {
+		return err
+	}
+	if err := validateCollectionNameOrAlias(collectionName, "CollectionName"); err != nil {
+		return err
+	}
+	return ValidateRowPolicyName(policyName)
+}
+
+// CreateRowPolicy creates a policy restricting the rows of a collection visible to a role
+func (node *Proxy) CreateRowPolicy(ctx context.Context, req *milvuspb.CreateRowPolicyRequest) (*commonpb.Status, error) {
+	logger.Debug("CreateRowPolicy", zap.Any("req", req))
+	if code, ok := node.checkHealthyAndReturnCode(); !ok {
+		return errorutil.UnhealthyStatus(code), errorutil.UnhealthyError()
+	}
+	if req.Entity == nil {
+		err := fmt.Errorf("the row policy entity in the request is nil")
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, err
+	}
+	err := node.validRowPolicyParams(req.Entity.Role, req.Entity.CollectionName, req.Entity.PolicyName)
+	if err == nil && strings.TrimSpace(req.Entity.Expr) == "" {
+		err = fmt.Errorf("the expression of the row policy is empty")
+	}
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, err
+	}
+	result, err := node.rootCoord.CreateRowPolicy(ctx, req)
+	if err != nil {
+		logger.Error("fail to create row policy", zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, err
+	}
+	return result, nil
+}
+
+// DropRowPolicy drops a row policy of a role on a collection
+func (node *Proxy) DropRowPolicy(ctx context.Context, req *milvuspb.DropRowPolicyRequest) (*commonpb.Status, error) {
+	logger.Debug("DropRowPolicy", zap.Any("req", req))
+	if code, ok := node.checkHealthyAndReturnCode(); !ok {
+		return errorutil.UnhealthyStatus(code), errorutil.UnhealthyError()
+	}
+	if err := node.validRowPolicyParams(req.Role, req.CollectionName, req.PolicyName); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, err
+	}
+	result, err := node.rootCoord.DropRowPolicy(ctx, req)
+	if err != nil {
+		logger.Error("fail to drop row policy", zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, err
+	}
+	return result, nil
+}
+
+// ListRowPolicies lists the row policies, filtered by the role and the collection if they are given
+func (node *Proxy) ListRowPolicies(ctx context.Context, req *milvuspb.ListRowPoliciesRequest) (*milvuspb.ListRowPoliciesResponse, error) {
+	logger.Debug("ListRowPolicies", zap.Any("req", req))
+	if code, ok := node.checkHealthyAndReturnCode(); !ok {
+		return &milvuspb.ListRowPoliciesResponse{Status: errorutil.UnhealthyStatus(code)}, errorutil.UnhealthyError()
+	}
+	result, err := node.rootCoord.ListRowPolicies(ctx, req)
+	if err != nil {
+		logger.Error("fail to list row policies", zap.Error(err))
+		return &milvuspb.ListRowPoliciesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, err
+	}
+	return result, nil
+}
+
 func (node *Proxy) RefreshPolicyInfoCache(ctx context.Context, req *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
 	logger.Debug("RefreshPrivilegeInfoCache", zap.Any("req", req))
 	if code, ok := node.checkHealthyAndReturnCode(); !ok {
@@ -0,0 +1,287 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/form3tech-oss/jwt-go"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/authutil"
+)
+
+// jwksMinRefreshInterval limits the refreshes of the JWKS caused by tokens signed by unknown keys
+const jwksMinRefreshInterval = 10 * time.Second
+
+var jwtValidMethods = []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}
+
+type jwtAuthenticatorConfig struct {
+	jwksURL         string
+	jwksFile        string
+	refreshInterval time.Duration
+	issuer          string
+	audience        string
+	userClaim       string
+	rolesClaim      string
+}
+
+// jwtAuthenticator verifies the JWT bearer tokens signed by the keys in the JWKS,
+// the claims of the tokens are mapped to the milvus user and roles.
+type jwtAuthenticator struct {
+	cfg    jwtAuthenticatorConfig
+	parser *jwt.Parser
+	client *http.Client
+
+	mu          sync.RWMutex
+	keys        map[string]interface{}
+	lastRefresh time.Time
+}
+
+func newJWTAuthenticator(cfg jwtAuthenticatorConfig) (*jwtAuthenticator, error) {
+	if cfg.jwksURL == "" && cfg.jwksFile == "" {
+		return nil, errors.New("neither jwks url nor jwks file is configured")
+	}
+	if cfg.userClaim == "" {
+		cfg.userClaim = "sub"
+	}
+	a := &jwtAuthenticator{
+		cfg:    cfg,
+		parser: &jwt.Parser{ValidMethods: jwtValidMethods},
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+	if err := a.refresh(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (a *jwtAuthenticator) name() string {
+	return authenticatorJWT
+}
+
+func (a *jwtAuthenticator) authenticate(ctx context.Context, scheme string, credential string) (*authutil.AuthInfo, error) {
+	if scheme != authSchemeBearer && scheme != authSchemeLegacy {
+		return nil, nil
+	}
+	if strings.Count(credential, ".") != 2 {
+		// not a JWT
+		return nil, nil
+	}
+	token, err := a.parser.Parse(credential, a.keyFunc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid jwt: %w", err)
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return nil, errors.New("invalid jwt")
+	}
+	// the expiration is checked by the parser only if it's present, tokens never expiring are not accepted
+	if _, ok := claims["exp"]; !ok {
+		return nil, errors.New("jwt without expiration time")
+	}
+	if a.cfg.issuer != "" && !claims.VerifyIssuer(a.cfg.issuer, true) {
+		return nil, errors.New("invalid jwt issuer")
+	}
+	if a.cfg.audience != "" && !claims.VerifyAudience(a.cfg.audience, true) {
+		return nil, errors.New("invalid jwt audience")
+	}
+	user, ok := claims[a.cfg.userClaim].(string)
+	if !ok || user == "" {
+		return nil, fmt.Errorf("jwt claim %s of the user not found", a.cfg.userClaim)
+	}
+	if user == util.UserRoot {
+		return nil, fmt.Errorf("jwt of user %s is not accepted", util.UserRoot)
+	}
+	return &authutil.AuthInfo{User: user, Roles: getClaimStrings(claims, a.cfg.rolesClaim)}, nil
+}
+
+// getClaimStrings returns the strings of the claim, which is an array or a string separated by spaces or commas
+func getClaimStrings(claims jwt.MapClaims, name string) []string {
+	if name == "" {
+		return nil
+	}
+	var ret []string
+	switch v := claims[name].(type) {
+	case string:
+		ret = strings.FieldsFunc(v, func(r rune) bool {
+			return r == ' ' || r == ','
+		})
+	case []interface{}:
+		for _, e := range v {
+			if s, ok := e.(string); ok && s != "" {
+				ret = append(ret, s)
+			}
+		}
+	}
+	return ret
+}
+
+func (a *jwtAuthenticator) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	a.mu.RLock()
+	key, ok := a.keys[kid]
+	expired := a.cfg.refreshInterval > 0 && time.Since(a.lastRefresh) > a.cfg.refreshInterval
+	canRefresh := time.Since(a.lastRefresh) > jwksMinRefreshInterval
+	a.mu.RUnlock()
+
+	if expired || (!ok && canRefresh) {
+		// keep using the cached keys if the refresh fails
+		if err := a.refresh(); err != nil {
+			log.Warn("failed to refresh jwks", zap.Error(err))
+		}
+		a.mu.RLock()
+		key, ok = a.keys[kid]
+		a.mu.RUnlock()
+	}
+	if !ok {
+		return nil, fmt.Errorf("signing key %q not found in jwks", kid)
+	}
+	return key, nil
+}
+
+func (a *jwtAuthenticator) refresh() error {
+	content, err := a.loadJWKS()
+	a.mu.Lock()
+	// record the attempt even if it fails, to limit the refreshes
+	a.lastRefresh = time.Now()
+	a.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	keys, err := parseJWKS(content)
+	if err != nil {
+		return err
+	}
+	a.mu.Lock()
+	a.keys = keys
+	a.mu.Unlock()
+	return nil
+}
+
+func (a *jwtAuthenticator) loadJWKS() ([]byte, error) {
+	if a.cfg.jwksFile != "" {
+		return ioutil.ReadFile(a.cfg.jwksFile)
+	}
+	resp, err := a.client.Get(a.cfg.jwksURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get jwks from %s, status: %s", a.cfg.jwksURL, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// parseJWKS returns the public keys of the JWKS by their kid, the keys not for signatures are skipped
+func parseJWKS(content []byte) (map[string]interface{}, error) {
+	jwks := struct {
+		Keys []jsonWebKey `json:"keys"`
+	}{}
+	if err := json.Unmarshal(content, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to parse jwks: %w", err)
+	}
+	keys := make(map[string]interface{}, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			log.Warn("skip invalid json web key", zap.String("kid", jwk.Kid), zap.Error(err))
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+func (jwk *jsonWebKey) publicKey() (interface{}, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeBase64URLInt(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBase64URLInt(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", jwk.Crv)
+		}
+		x, err := decodeBase64URLInt(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBase64URLInt(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", jwk.Kty)
+	}
+}
+
+func decodeBase64URLInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
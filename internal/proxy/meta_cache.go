@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -73,6 +74,10 @@ type Cache interface {
 	GetUserRole(username string) []string
 	RefreshPolicyInfo(op typeutil.CacheOp) error
 	InitPolicyInfo(info []string, userRoles []string)
+
+	// GetRowPolicies returns the expressions of the row policies of the roles on the collection
+	GetRowPolicies(roles []string, collectionName string) []string
+	InitRowPolicies(policies []*milvuspb.RowPolicyEntity)
 }
 
 type collectionInfo struct {
@@ -84,6 +89,7 @@ type collectionInfo struct {
 	createdTimestamp    uint64
 	createdUtcTimestamp uint64
 	isLoaded            bool
+	properties          []*commonpb.KeyValuePair
 }
 
 // CloneShardLeaders returns a copy of shard leaders
@@ -113,9 +119,10 @@ type MetaCache struct {
 	queryCoord types.QueryCoord
 
 	collInfo       map[string]*collectionInfo
-	credMap        map[string]*internalpb.CredentialInfo // cache for credential, lazy load
-	privilegeInfos map[string]struct{}                   // privileges cache
-	userToRoles    map[string]map[string]struct{}        // user to role cache
+	credMap        map[string]*internalpb.CredentialInfo   // cache for credential, lazy load
+	privilegeInfos map[string]struct{}                     // privileges cache
+	userToRoles    map[string]map[string]struct{}          // user to role cache
+	rowPolicies    map[string]map[string]map[string]string // role to collection to policy name to row policy expression
 	mu             sync.RWMutex
 	credMut        sync.RWMutex
 	privilegeMut   sync.RWMutex
@@ -140,6 +147,7 @@ func InitMetaCache(ctx context.Context, rootCoord types.RootCoord, queryCoord ty
 		return err
 	}
 	globalMetaCache.InitPolicyInfo(resp.PolicyInfos, resp.UserRoles)
+	globalMetaCache.InitRowPolicies(resp.RowPolicies)
 	log.Debug("success to init meta cache", zap.Strings("policy_infos", resp.PolicyInfos))
 	return nil
 }
@@ -154,6 +162,7 @@ func NewMetaCache(rootCoord types.RootCoord, queryCoord types.QueryCoord, shardM
 		shardMgr:       shardMgr,
 		privilegeInfos: map[string]struct{}{},
 		userToRoles:    map[string]map[string]struct{}{},
+		rowPolicies:    map[string]map[string]map[string]string{},
 	}, nil
 }
 
@@ -282,6 +291,7 @@ func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse,
 	m.collInfo[collectionName].collID = coll.CollectionID
 	m.collInfo[collectionName].createdTimestamp = coll.CreatedTimestamp
 	m.collInfo[collectionName].createdUtcTimestamp = coll.CreatedUtcTimestamp
+	m.collInfo[collectionName].properties = coll.Properties
 }
 
 func (m *MetaCache) GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
@@ -414,6 +424,7 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 			Description: coll.Schema.Description,
 			AutoID:      coll.Schema.AutoID,
 			Fields:      make([]*schemapb.FieldSchema, 0),
+			Version:     coll.Schema.Version,
 		},
 		CollectionID:         coll.CollectionID,
 		VirtualChannelNames:  coll.VirtualChannelNames,
@@ -725,8 +736,59 @@ func (m *MetaCache) RefreshPolicyInfo(op typeutil.CacheOp) error {
 		if m.userToRoles[user] != nil {
 			delete(m.userToRoles[user], role)
 		}
+	case typeutil.CacheCreateRowPolicy:
+		entity, err := funcutil.DecodeRowPolicyCache(op.OpKey)
+		if err != nil {
+			return fmt.Errorf("invalid opKey, fail to decode, op_type: %d, op_key: %s", int(op.OpType), op.OpKey)
+		}
+		m.addRowPolicy(entity)
+	case typeutil.CacheDropRowPolicy:
+		entity, err := funcutil.DecodeRowPolicyCache(op.OpKey)
+		if err != nil {
+			return fmt.Errorf("invalid opKey, fail to decode, op_type: %d, op_key: %s", int(op.OpType), op.OpKey)
+		}
+		if collPolicies := m.rowPolicies[entity.Role.Name][entity.CollectionName]; collPolicies != nil {
+			delete(collPolicies, entity.PolicyName)
+		}
 	default:
 		return fmt.Errorf("invalid opType, op_type: %d, op_key: %s", int(op.OpType), op.OpKey)
 	}
 	return nil
 }
+
+func (m *MetaCache) InitRowPolicies(policies []*milvuspb.RowPolicyEntity) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.rowPolicies = map[string]map[string]map[string]string{}
+	for _, entity := range policies {
+		m.addRowPolicy(entity)
+	}
+}
+
+// addRowPolicy adds the row policy, m.mu must be held
+func (m *MetaCache) addRowPolicy(entity *milvuspb.RowPolicyEntity) {
+	role := entity.GetRole().GetName()
+	if m.rowPolicies[role] == nil {
+		m.rowPolicies[role] = make(map[string]map[string]string)
+	}
+	if m.rowPolicies[role][entity.CollectionName] == nil {
+		m.rowPolicies[role][entity.CollectionName] = make(map[string]string)
+	}
+	m.rowPolicies[role][entity.CollectionName][entity.PolicyName] = entity.Expr
+}
+
+func (m *MetaCache) GetRowPolicies(roles []string, collectionName string) []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var exprs []string
+	for _, role := range roles {
+		for _, expr := range m.rowPolicies[role][collectionName] {
+			exprs = append(exprs, expr)
+		}
+	}
+	// map iteration is random, keep the combined expression stable
+	sort.Strings(exprs)
+	return exprs
+}
@@ -529,6 +529,51 @@ func TestMetaCache_PolicyInfo(t *testing.T) {
 		err = globalMetaCache.RefreshPolicyInfo(typeutil.CacheOp{OpType: 100, OpKey: "policyX"})
 		assert.NotNil(t, err)
 	})
+
+	t.Run("RowPolicies", func(t *testing.T) {
+		newEntity := func(role, collection, policy, expr string) *milvuspb.RowPolicyEntity {
+			return &milvuspb.RowPolicyEntity{
+				Role:           &milvuspb.RoleEntity{Name: role},
+				CollectionName: collection,
+				PolicyName:     policy,
+				Expr:           expr,
+			}
+		}
+		client.listPolicy = func(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+			return &internalpb.ListPolicyResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+				RowPolicies: []*milvuspb.RowPolicyEntity{
+					newEntity("role1", "collection1", "p1", "a > 1"),
+					newEntity("role2", "collection1", "p2", "b > 1"),
+					newEntity("role2", "collection2", "p3", "c > 1"),
+				},
+			}, nil
+		}
+		err := InitMetaCache(context.Background(), client, qc, mgr)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a > 1", "b > 1"}, globalMetaCache.GetRowPolicies([]string{"role1", "role2"}, "collection1"))
+		assert.Equal(t, []string{"c > 1"}, globalMetaCache.GetRowPolicies([]string{"role1", "role2"}, "collection2"))
+		assert.Empty(t, globalMetaCache.GetRowPolicies([]string{"role3"}, "collection1"))
+
+		opKey, err := funcutil.EncodeRowPolicyCache(newEntity("role1", "collection2", "p4", "d > 1"))
+		assert.Nil(t, err)
+		err = globalMetaCache.RefreshPolicyInfo(typeutil.CacheOp{OpType: typeutil.CacheCreateRowPolicy, OpKey: opKey})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"c > 1", "d > 1"}, globalMetaCache.GetRowPolicies([]string{"role1", "role2"}, "collection2"))
+
+		opKey, err = funcutil.EncodeRowPolicyCache(newEntity("role2", "collection1", "p2", ""))
+		assert.Nil(t, err)
+		err = globalMetaCache.RefreshPolicyInfo(typeutil.CacheOp{OpType: typeutil.CacheDropRowPolicy, OpKey: opKey})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a > 1"}, globalMetaCache.GetRowPolicies([]string{"role1", "role2"}, "collection1"))
+
+		err = globalMetaCache.RefreshPolicyInfo(typeutil.CacheOp{OpType: typeutil.CacheCreateRowPolicy, OpKey: "invalid"})
+		assert.NotNil(t, err)
+		err = globalMetaCache.RefreshPolicyInfo(typeutil.CacheOp{OpType: typeutil.CacheDropRowPolicy, OpKey: "invalid"})
+		assert.NotNil(t, err)
+	})
 }
 
 func TestMetaCache_LoadCache(t *testing.T) {
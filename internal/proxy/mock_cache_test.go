@@ -11,13 +11,15 @@ type getCollectionIDFunc func(ctx context.Context, collectionName string) (typeu
 type getCollectionSchemaFunc func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error)
 type getCollectionInfoFunc func(ctx context.Context, collectionName string) (*collectionInfo, error)
 type getUserRoleFunc func(username string) []string
+type getRowPoliciesFunc func(roles []string, collectionName string) []string
 
 type mockCache struct {
 	Cache
-	getIDFunc       getCollectionIDFunc
-	getSchemaFunc   getCollectionSchemaFunc
-	getInfoFunc     getCollectionInfoFunc
-	getUserRoleFunc getUserRoleFunc
+	getIDFunc          getCollectionIDFunc
+	getSchemaFunc      getCollectionSchemaFunc
+	getInfoFunc        getCollectionInfoFunc
+	getUserRoleFunc    getUserRoleFunc
+	getRowPoliciesFunc getRowPoliciesFunc
 }
 
 func (m *mockCache) GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
@@ -51,6 +53,13 @@ func (m *mockCache) GetUserRole(username string) []string {
 	return []string{}
 }
 
+func (m *mockCache) GetRowPolicies(roles []string, collectionName string) []string {
+	if m.getRowPoliciesFunc != nil {
+		return m.getRowPoliciesFunc(roles, collectionName)
+	}
+	return nil
+}
+
 func (m *mockCache) setGetIDFunc(f getCollectionIDFunc) {
 	m.getIDFunc = f
 }
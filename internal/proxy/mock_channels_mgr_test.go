@@ -16,6 +16,10 @@ func (m *mockChannelsMgr) getVChannels(collectionID UniqueID) ([]vChan, error) {
 	return nil, nil
 }
 
+func (m *mockChannelsMgr) getShardsNumHistory(collectionID UniqueID) ([]int32, error) {
+	return nil, nil
+}
+
 func (m *mockChannelsMgr) removeDMLStream(collectionID UniqueID) error {
 	if m.removeDMLStreamFuncType != nil {
 		return m.removeDMLStreamFuncType(collectionID)
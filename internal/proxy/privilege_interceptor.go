@@ -14,6 +14,7 @@ import (
 	"github.com/casbin/casbin/v2/model"
 	jsonadapter "github.com/casbin/json-adapter/v2"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/authutil"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -68,6 +69,27 @@ func UnaryServerInterceptor(privilegeFunc PrivilegeFunc) grpc.UnaryServerInterce
 	}
 }
 
+// StreamServerInterceptor checks the privilege of the requests received by the streaming RPCs
+func StreamServerInterceptor(privilegeFunc PrivilegeFunc) grpc.StreamServerInterceptor {
+	initPolicyModel()
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &privilegeServerStream{ServerStream: ss, privilegeFunc: privilegeFunc})
+	}
+}
+
+type privilegeServerStream struct {
+	grpc.ServerStream
+	privilegeFunc PrivilegeFunc
+}
+
+func (s *privilegeServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	_, err := s.privilegeFunc(s.Context(), m)
+	return err
+}
+
 func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context, error) {
 	if !Params.CommonCfg.AuthorizationEnabled {
 		return ctx, nil
@@ -83,15 +105,25 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 		log.Error("GetCurUserFromContext fail", zap.Error(err))
 		return ctx, err
 	}
-	roleNames, err := GetRole(username)
-	if err != nil {
-		log.Error("GetRole fail", zap.String("username", username), zap.Error(err))
-		return ctx, err
+	var roleNames []string
+	if info, ok := authutil.AuthInfoFromContext(ctx); ok && info.Method != "" && info.Method != authenticatorPassword {
+		// the credentials like the api keys and the JWTs are scoped to the roles they list
+		roleNames = append(roleNames, info.Roles...)
+	} else {
+		roleNames, err = GetRole(username)
+		if err != nil {
+			log.Error("GetRole fail", zap.String("username", username), zap.Error(err))
+			return ctx, err
+		}
 	}
 	roleNames = append(roleNames, util.RolePublic)
 	objectType := privilegeExt.ObjectType.String()
 	objectNameIndex := privilegeExt.ObjectNameIndex
 	objectName := funcutil.GetObjectName(req, objectNameIndex)
+	if objectType == commonpb.ObjectType_Collection.String() {
+		// the snapshots of a collection share its privileges
+		objectName, _ = splitSnapshotName(objectName)
+	}
 	if isCurUserObject(objectType, username, objectName) {
 		return ctx, nil
 	}
@@ -2,14 +2,17 @@ package proxy
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/util/authutil"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 )
 
 func TestUnaryServerInterceptor(t *testing.T) {
@@ -17,6 +20,27 @@ func TestUnaryServerInterceptor(t *testing.T) {
 	assert.NotNil(t, interceptor)
 }
 
+func TestStreamServerInterceptor(t *testing.T) {
+	var checked []interface{}
+	interceptor := StreamServerInterceptor(func(ctx context.Context, req interface{}) (context.Context, error) {
+		checked = append(checked, req)
+		if req.(*milvuspb.QueryRequest).GetCollectionName() == "denied" {
+			return ctx, errors.New("permission denied")
+		}
+		return ctx, nil
+	})
+
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		return ss.RecvMsg(&milvuspb.QueryRequest{})
+	}
+	info := &grpc.StreamServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/QueryStream", IsServerStream: true}
+	err := interceptor(nil, &mockServerStream{ctx: context.Background(), req: &milvuspb.QueryRequest{CollectionName: "coll"}}, info, handler)
+	assert.NoError(t, err)
+	err = interceptor(nil, &mockServerStream{ctx: context.Background(), req: &milvuspb.QueryRequest{CollectionName: "denied"}}, info, handler)
+	assert.Error(t, err)
+	assert.Equal(t, 2, len(checked))
+}
+
 func TestPrivilegeInterceptor(t *testing.T) {
 	ctx := context.Background()
 	t.Run("Authorization Disabled", func(t *testing.T) {
@@ -89,6 +113,19 @@ func TestPrivilegeInterceptor(t *testing.T) {
 		})
 		assert.NotNil(t, err)
 
+		// the api keys are scoped to their roles instead of the roles of the user
+		_, err = PrivilegeInterceptor(authutil.ContextWithAuthInfo(context.Background(),
+			&authutil.AuthInfo{User: "alice", Method: authenticatorAPIKey}), &milvuspb.LoadCollectionRequest{
+			DbName:         "db_test",
+			CollectionName: "col1",
+		})
+		assert.NotNil(t, err)
+		_, err = PrivilegeInterceptor(authutil.ContextWithAuthInfo(context.Background(),
+			&authutil.AuthInfo{User: "bob", Roles: []string{"role1"}, Method: authenticatorAPIKey}), &milvuspb.LoadCollectionRequest{
+			DbName:         "db_test",
+			CollectionName: "col1",
+		})
+		assert.Nil(t, err)
 	})
 
 }
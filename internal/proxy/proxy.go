@@ -94,7 +94,13 @@ type Proxy struct {
 
 	factory dependency.Factory
 
+	auditor *auditor
+
 	searchResultCh chan *internalpb.SearchResults
+	// the streaming search and query receiving the results pushed by the shard leaders
+	resultStreams *resultStreams
+	// the DeleteByExpr jobs running in the background
+	deleteJobs *deleteJobManager
 
 	// Add callback functions at different stages
 	startCallbacks []func()
@@ -112,6 +118,7 @@ func NewProxy(ctx context.Context, factory dependency.Factory) (*Proxy, error) {
 		factory:        factory,
 		searchResultCh: make(chan *internalpb.SearchResults, n),
 		shardMgr:       newShardClientMgr(),
+		resultStreams:  newResultStreams(),
 	}
 	node.UpdateStateCode(internalpb.StateCode_Abnormal)
 	logutil.Logger(ctx).Debug("create a new Proxy instance", zap.Any("state", node.stateCode.Load()))
@@ -227,6 +234,19 @@ func (node *Proxy) Init() error {
 	}
 	log.Debug("init meta cache done", zap.String("role", typeutil.ProxyRole))
 
+	node.auditor, err = initAuditor(node.ctx, node.factory)
+	if err != nil {
+		log.Warn("failed to init auditor", zap.Error(err), zap.String("role", typeutil.ProxyRole))
+		return err
+	}
+
+	if err := initAuthenticators(); err != nil {
+		log.Warn("failed to init authenticators", zap.Error(err), zap.String("role", typeutil.ProxyRole))
+		return err
+	}
+
+	node.initDeleteJobManager()
+
 	return nil
 }
 
@@ -342,6 +362,9 @@ func (node *Proxy) Start() error {
 	log.Debug("update state code", zap.String("role", typeutil.ProxyRole), zap.String("State", internalpb.StateCode_Healthy.String()))
 	node.UpdateStateCode(internalpb.StateCode_Healthy)
 
+	// the unfinished delete jobs are resumed after the proxy is able to serve the queries
+	node.deleteJobs.start()
+
 	return nil
 }
 
@@ -374,6 +397,18 @@ func (node *Proxy) Stop() error {
 
 	node.wg.Wait()
 
+	if node.deleteJobs != nil {
+		node.deleteJobs.close()
+		log.Info("close delete job manager", zap.String("role", typeutil.ProxyRole))
+	}
+
+	if node.auditor != nil {
+		if err := node.auditor.Close(); err != nil {
+			log.Warn("failed to close auditor", zap.Error(err), zap.String("role", typeutil.ProxyRole))
+		}
+		log.Info("close auditor", zap.String("role", typeutil.ProxyRole))
+	}
+
 	for _, cb := range node.closeCallbacks {
 		cb()
 	}
@@ -335,6 +335,14 @@ func (s *proxyTestServer) GetStatisticsChannel(ctx context.Context, request *int
 	return s.Proxy.GetStatisticsChannel(ctx)
 }
 
+func (s *proxyTestServer) SearchStream(request *milvuspb.SearchRequest, stream milvuspb.MilvusService_SearchStreamServer) error {
+	return s.Proxy.SearchStream(stream.Context(), request, stream.Send)
+}
+
+func (s *proxyTestServer) QueryStream(request *milvuspb.QueryRequest, stream milvuspb.MilvusService_QueryStreamServer) error {
+	return s.Proxy.QueryStream(stream.Context(), request, stream.Send)
+}
+
 func (s *proxyTestServer) startGrpc(ctx context.Context, wg *sync.WaitGroup) {
 	defer wg.Done()
 
@@ -2561,6 +2569,70 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("AlterShards fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.AlterShards(ctx, &milvuspb.AlterShardsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("TruncateCollection fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.TruncateCollection(ctx, &milvuspb.TruncateCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("TruncatePartition fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.TruncatePartition(ctx, &milvuspb.TruncatePartitionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("AddField fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.AddField(ctx, &milvuspb.AddFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("DropField fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.DropField(ctx, &milvuspb.DropFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("CreateSnapshot fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.CreateSnapshot(ctx, &milvuspb.CreateSnapshotRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("DropSnapshot fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.DropSnapshot(ctx, &milvuspb.DropSnapshotRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("ListSnapshots fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.ListSnapshots(ctx, &milvuspb.ListSnapshotsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("GetPersistentSegmentInfo fail, unhealthy", func(t *testing.T) {
 		defer wg.Done()
@@ -2852,6 +2924,46 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("AlterShards fail, dd queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.AlterShards(ctx, &milvuspb.AlterShardsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("TruncateCollection fail, dd queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.TruncateCollection(ctx, &milvuspb.TruncateCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("TruncatePartition fail, dd queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.TruncatePartition(ctx, &milvuspb.TruncatePartitionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("AddField fail, dd queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.AddField(ctx, &milvuspb.AddFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("DropField fail, dd queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.DropField(ctx, &milvuspb.DropFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	proxy.sched.ddQueue.setMaxTaskNum(ddParallel)
 
 	dmParallelism := proxy.sched.dmQueue.getMaxTaskNum()
@@ -3128,6 +3240,46 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("AlterShards fail, timeout", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.AlterShards(shortCtx, &milvuspb.AlterShardsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("TruncateCollection fail, timeout", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.TruncateCollection(shortCtx, &milvuspb.TruncateCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("TruncatePartition fail, timeout", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.TruncatePartition(shortCtx, &milvuspb.TruncatePartitionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("AddField fail, timeout", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.AddField(shortCtx, &milvuspb.AddFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("DropField fail, timeout", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.DropField(shortCtx, &milvuspb.DropFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("CreateCredential fail, timeout", func(t *testing.T) {
 		defer wg.Done()
@@ -3647,6 +3799,44 @@ func Test_GetCompactionStateWithPlans(t *testing.T) {
 	})
 }
 
+func Test_GetCompactionPolicyStats(t *testing.T) {
+	t.Run("test get compaction policy stats", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+		resp, err := proxy.GetCompactionPolicyStats(context.TODO(), nil)
+		assert.EqualValues(t, &milvuspb.GetCompactionPolicyStatsResponse{}, resp)
+		assert.Nil(t, err)
+	})
+	t.Run("test get compaction policy stats with unhealthy proxy", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(internalpb.StateCode_Abnormal)
+		resp, err := proxy.GetCompactionPolicyStats(context.TODO(), nil)
+		assert.EqualValues(t, unhealthyStatus(), resp.Status)
+		assert.Nil(t, err)
+	})
+}
+
+func Test_CancelCompaction(t *testing.T) {
+	t.Run("test cancel compaction", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+		resp, err := proxy.CancelCompaction(context.TODO(), nil)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Nil(t, err)
+	})
+	t.Run("test cancel compaction with unhealthy proxy", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(internalpb.StateCode_Abnormal)
+		resp, err := proxy.CancelCompaction(context.TODO(), nil)
+		assert.EqualValues(t, unhealthyStatus(), resp)
+		assert.Nil(t, err)
+	})
+}
+
 func Test_GetFlushState(t *testing.T) {
 	t.Run("normal test", func(t *testing.T) {
 		datacoord := &DataCoordMock{}
@@ -308,6 +308,30 @@ func (coord *QueryCoordMock) LoadBalance(ctx context.Context, req *querypb.LoadB
 	panic("implement me")
 }
 
+func (coord *QueryCoordMock) AlterShards(ctx context.Context, req *querypb.AlterShardsRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "unhealthy",
+		}, nil
+	}
+
+	panic("implement me")
+}
+
+func (coord *QueryCoordMock) TruncateSegments(ctx context.Context, req *querypb.TruncateSegmentsRequest) (*commonpb.Status, error) {
+	if !coord.healthy() {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "unhealthy",
+		}, nil
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 func (coord *QueryCoordMock) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	if !coord.healthy() {
 		return &milvuspb.GetMetricsResponse{
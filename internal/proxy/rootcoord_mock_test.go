@@ -54,6 +54,7 @@ type collectionMeta struct {
 	id                   typeutil.UniqueID
 	schema               *schemapb.CollectionSchema
 	shardsNum            int32
+	shardsNumHistory     []int32
 	virtualChannelNames  []string
 	physicalChannelNames []string
 	createdTimestamp     uint64
@@ -204,6 +205,120 @@ func (coord *RootCoordMock) AlterAlias(ctx context.Context, req *milvuspb.AlterA
 	}, nil
 }
 
+func (coord *RootCoordMock) AlterShards(ctx context.Context, req *milvuspb.AlterShardsRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.collMtx.Lock()
+	defer coord.collMtx.Unlock()
+
+	collID, exist := coord.collName2ID[req.CollectionName]
+	if !exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CollectionNotExists,
+			Reason:    milvuserrors.MsgCollectionNotExist(req.CollectionName),
+		}, nil
+	}
+	meta := coord.collID2Meta[collID]
+	if req.ShardsNum <= int32(len(meta.virtualChannelNames)) {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("shards number can only be increased, current = %d", len(meta.virtualChannelNames)),
+		}, nil
+	}
+	if len(meta.shardsNumHistory) == 0 {
+		meta.shardsNumHistory = []int32{int32(len(meta.virtualChannelNames))}
+	}
+	for i := len(meta.virtualChannelNames); i < int(req.ShardsNum); i++ {
+		meta.virtualChannelNames = append(meta.virtualChannelNames, funcutil.GenRandomStr())
+		meta.physicalChannelNames = append(meta.physicalChannelNames, funcutil.GenRandomStr())
+	}
+	meta.shardsNum = req.ShardsNum
+	meta.shardsNumHistory = append(meta.shardsNumHistory, req.ShardsNum)
+	coord.collID2Meta[collID] = meta
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) TruncateCollection(ctx context.Context, req *milvuspb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.collMtx.RLock()
+	defer coord.collMtx.RUnlock()
+
+	if _, exist := coord.collName2ID[req.CollectionName]; !exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CollectionNotExists,
+			Reason:    milvuserrors.MsgCollectionNotExist(req.CollectionName),
+		}, nil
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) AddField(ctx context.Context, req *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.collMtx.RLock()
+	defer coord.collMtx.RUnlock()
+
+	if _, exist := coord.collName2ID[req.CollectionName]; !exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CollectionNotExists,
+			Reason:    milvuserrors.MsgCollectionNotExist(req.CollectionName),
+		}, nil
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) DropField(ctx context.Context, req *milvuspb.DropFieldRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.collMtx.RLock()
+	defer coord.collMtx.RUnlock()
+
+	if _, exist := coord.collName2ID[req.CollectionName]; !exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CollectionNotExists,
+			Reason:    milvuserrors.MsgCollectionNotExist(req.CollectionName),
+		}, nil
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 func (coord *RootCoordMock) updateState(state internalpb.StateCode) {
 	coord.state.Store(state)
 }
@@ -488,6 +603,7 @@ func (coord *RootCoordMock) DescribeCollection(ctx context.Context, req *milvusp
 		Schema:               meta.schema,
 		CollectionID:         collID,
 		ShardsNum:            meta.shardsNum,
+		ShardsNumHistory:     meta.shardsNumHistory,
 		VirtualChannelNames:  meta.virtualChannelNames,
 		PhysicalChannelNames: meta.physicalChannelNames,
 		CreatedTimestamp:     meta.createdUtcTimestamp,
@@ -621,6 +737,41 @@ func (coord *RootCoordMock) DropPartition(ctx context.Context, req *milvuspb.Dro
 	}, nil
 }
 
+func (coord *RootCoordMock) TruncatePartition(ctx context.Context, req *milvuspb.TruncatePartitionRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.collMtx.RLock()
+	defer coord.collMtx.RUnlock()
+
+	collID, exist := coord.collName2ID[req.CollectionName]
+	if !exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CollectionNotExists,
+			Reason:    milvuserrors.MsgCollectionNotExist(req.CollectionName),
+		}, nil
+	}
+
+	coord.partitionMtx.RLock()
+	defer coord.partitionMtx.RUnlock()
+
+	if _, partitionExist := coord.collID2Partitions[collID].partitionName2ID[req.PartitionName]; !partitionExist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    milvuserrors.MsgPartitionNotExist(req.PartitionName),
+		}, nil
+	}
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 func (coord *RootCoordMock) HasPartition(ctx context.Context, req *milvuspb.HasPartitionRequest) (*milvuspb.BoolResponse, error) {
 	code := coord.state.Load().(internalpb.StateCode)
 	if code != internalpb.StateCode_Healthy {
@@ -1113,6 +1264,18 @@ func (coord *RootCoordMock) ListPolicy(ctx context.Context, in *internalpb.ListP
 	return &internalpb.ListPolicyResponse{}, nil
 }
 
+func (coord *RootCoordMock) CreateRowPolicy(ctx context.Context, req *milvuspb.CreateRowPolicyRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) DropRowPolicy(ctx context.Context, req *milvuspb.DropRowPolicyRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) ListRowPolicies(ctx context.Context, req *milvuspb.ListRowPoliciesRequest) (*milvuspb.ListRowPoliciesResponse, error) {
+	return &milvuspb.ListRowPoliciesResponse{}, nil
+}
+
 type DescribeCollectionFunc func(ctx context.Context, request *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error)
 type ShowPartitionsFunc func(ctx context.Context, request *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error)
 type DescribeIndexFunc func(ctx context.Context, request *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error)
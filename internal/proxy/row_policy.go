@@ -0,0 +1,123 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/authutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// getRowPolicyExpr returns the expression of the row policies applied to the current user on the collection,
+// an empty string means all the entities are visible.
+// The policies of all the roles of the user are ORed, users with the admin role aren't restricted.
+func getRowPolicyExpr(ctx context.Context, collectionName string) (string, error) {
+	if !Params.CommonCfg.AuthorizationEnabled {
+		return "", nil
+	}
+	username, err := GetCurUserFromContext(ctx)
+	if err != nil {
+		// the internal requests without users
+		return "", nil
+	}
+	if username == util.UserRoot {
+		return "", nil
+	}
+	roles, err := GetRole(username)
+	if err != nil {
+		return "", err
+	}
+	if info, ok := authutil.AuthInfoFromContext(ctx); ok {
+		roles = append(roles, info.Roles...)
+	}
+	for _, role := range roles {
+		if role == util.RoleAdmin {
+			return "", nil
+		}
+	}
+	roles = append(roles, util.RolePublic)
+
+	// the policies are bound to the collection name, not to the aliases
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	if err != nil {
+		return "", err
+	}
+	exprs := globalMetaCache.GetRowPolicies(roles, schema.GetName())
+	if len(exprs) == 0 {
+		return "", nil
+	}
+	log.Debug("apply row policies", zap.String("username", username),
+		zap.String("collection", schema.GetName()), zap.Strings("exprs", exprs))
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return "(" + strings.Join(exprs, ") || (") + ")", nil
+}
+
+// mergeRowPolicyExpr restricts the expression of the user with the row policies
+func mergeRowPolicyExpr(userExpr string, policyExpr string) string {
+	if policyExpr == "" {
+		return userExpr
+	}
+	if strings.TrimSpace(userExpr) == "" {
+		return policyExpr
+	}
+	return fmt.Sprintf("(%s) && (%s)", policyExpr, userExpr)
+}
+
+// applyRowPolicyToDelete restricts the primary keys of the delete request to the entities visible to the user.
+// It returns false if none of the entities is visible, and the request should be skipped.
+// The collection must be loaded to check the visibility if any row policy is applied.
+func (node *Proxy) applyRowPolicyToDelete(ctx context.Context, request *milvuspb.DeleteRequest) (bool, error) {
+	policyExpr, err := getRowPolicyExpr(ctx, request.GetCollectionName())
+	if err != nil || policyExpr == "" {
+		return true, err
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, request.GetCollectionName())
+	if err != nil {
+		return false, err
+	}
+	pkSchema, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return false, err
+	}
+	// only the expressions of primary keys are supported by deletes
+	if _, _, err := getPrimaryKeysFromExpr(schema, request.GetExpr()); err != nil {
+		return false, fmt.Errorf("failed to get primary keys from expr: %w", err)
+	}
+	visible, err := node.queryPKs(ctx, &milvuspb.QueryRequest{
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetCollectionName(),
+		Expr:           request.GetExpr(),
+	}, pkSchema, false, 0)
+	if err != nil {
+		return false, fmt.Errorf("failed to apply the row policies, the collection should be loaded: %w", err)
+	}
+	if len(visible) == 0 {
+		return false, nil
+	}
+	request.Expr = getPKTermExpr(pkSchema.GetName(), visible)
+	return true, nil
+}
@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/authutil"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+)
+
+func Test_mergeRowPolicyExpr(t *testing.T) {
+	assert.Equal(t, "a > 1", mergeRowPolicyExpr("a > 1", ""))
+	assert.Equal(t, "b < 2", mergeRowPolicyExpr(" ", "b < 2"))
+	assert.Equal(t, "(b < 2) && (a > 1 || a < 0)", mergeRowPolicyExpr("a > 1 || a < 0", "b < 2"))
+}
+
+func Test_getRowPolicyExpr(t *testing.T) {
+	Params.CommonCfg.AuthorizationEnabled = true
+	defer func() {
+		Params.CommonCfg.AuthorizationEnabled = false
+	}()
+	oldCache := globalMetaCache
+	defer func() {
+		globalMetaCache = oldCache
+	}()
+
+	cache := newMockCache()
+	cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		// the alias is resolved to the collection
+		return &schemapb.CollectionSchema{Name: "coll"}, nil
+	})
+	cache.getUserRoleFunc = func(username string) []string {
+		switch username {
+		case "alice":
+			return []string{"reader"}
+		case "bob":
+			return []string{"reader", util.RoleAdmin}
+		}
+		return nil
+	}
+	policies := map[string][]string{
+		"reader":        {"region == \"eu\""},
+		"auditor":       {"level < 3"},
+		util.RolePublic: {"deleted == false"},
+	}
+	cache.getRowPoliciesFunc = func(roles []string, collectionName string) []string {
+		if collectionName != "coll" {
+			return nil
+		}
+		var exprs []string
+		for _, role := range roles {
+			exprs = append(exprs, policies[role]...)
+		}
+		return exprs
+	}
+	globalMetaCache = cache
+
+	userCtx := func(username string) context.Context {
+		return metadata.NewIncomingContext(context.Background(),
+			metadata.Pairs(util.HeaderAuthorize, crypto.Base64Encode(username+util.CredentialSeperator+"pwd")))
+	}
+
+	expr, err := getRowPolicyExpr(userCtx("alice"), "alias")
+	assert.NoError(t, err)
+	assert.Equal(t, `(region == "eu") || (deleted == false)`, expr)
+
+	// the roles granted by the credential
+	ctx := authutil.ContextWithAuthInfo(context.Background(), &authutil.AuthInfo{User: "carol", Roles: []string{"auditor"}})
+	expr, err = getRowPolicyExpr(ctx, "alias")
+	assert.NoError(t, err)
+	assert.Equal(t, `(level < 3) || (deleted == false)`, expr)
+
+	// admin and root aren't restricted
+	expr, err = getRowPolicyExpr(userCtx("bob"), "alias")
+	assert.NoError(t, err)
+	assert.Equal(t, "", expr)
+	expr, err = getRowPolicyExpr(userCtx(util.UserRoot), "alias")
+	assert.NoError(t, err)
+	assert.Equal(t, "", expr)
+
+	// internal requests
+	expr, err = getRowPolicyExpr(context.Background(), "alias")
+	assert.NoError(t, err)
+	assert.Equal(t, "", expr)
+
+	Params.CommonCfg.AuthorizationEnabled = false
+	expr, err = getRowPolicyExpr(userCtx("alice"), "alias")
+	assert.NoError(t, err)
+	assert.Equal(t, "", expr)
+}
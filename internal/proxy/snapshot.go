@@ -0,0 +1,70 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+// snapshotSeparator separates the collection name and the snapshot name, search and query against
+// `collection@snapshot` read the collection as it was when the snapshot was created.
+const snapshotSeparator = "@"
+
+// splitSnapshotName splits `collection@snapshot` into the collection name and the snapshot name,
+// the snapshot name is empty if name doesn't refer to a snapshot.
+func splitSnapshotName(name string) (string, string) {
+	idx := strings.LastIndex(name, snapshotSeparator)
+	if idx < 0 {
+		return name, ""
+	}
+	return name[:idx], name[idx+len(snapshotSeparator):]
+}
+
+// getSnapshot returns the snapshot of the collection by name.
+func getSnapshot(ctx context.Context, dc types.DataCoord, collectionName string, collectionID UniqueID, snapshotName string) (*datapb.SnapshotInfo, error) {
+	if dc == nil {
+		return nil, errors.New("snapshot is not supported by the request")
+	}
+	if err := validateName(snapshotName, "snapshot name"); err != nil {
+		return nil, err
+	}
+	resp, err := dc.ListSnapshots(ctx, &datapb.ListSnapshotsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_ListSnapshots,
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		CollectionID: collectionID,
+		Name:         snapshotName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+	if len(resp.GetSnapshots()) == 0 {
+		return nil, fmt.Errorf("snapshot %s does not exist in collection %s", snapshotName, collectionName)
+	}
+	return resp.GetSnapshots()[0], nil
+}
@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+type snapshotDataCoordMock struct {
+	*DataCoordMock
+	snapshots []*datapb.SnapshotInfo
+}
+
+func (m *snapshotDataCoordMock) ListSnapshots(ctx context.Context, req *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error) {
+	ret := make([]*datapb.SnapshotInfo, 0)
+	for _, snapshot := range m.snapshots {
+		if snapshot.GetCollectionID() == req.GetCollectionID() && snapshot.GetName() == req.GetName() {
+			ret = append(ret, snapshot)
+		}
+	}
+	return &datapb.ListSnapshotsResponse{Snapshots: ret}, nil
+}
+
+func TestSplitSnapshotName(t *testing.T) {
+	collection, snapshot := splitSnapshotName("coll")
+	assert.Equal(t, "coll", collection)
+	assert.Equal(t, "", snapshot)
+
+	collection, snapshot = splitSnapshotName("coll@snap")
+	assert.Equal(t, "coll", collection)
+	assert.Equal(t, "snap", snapshot)
+
+	collection, snapshot = splitSnapshotName("coll@")
+	assert.Equal(t, "coll", collection)
+	assert.Equal(t, "", snapshot)
+}
+
+func TestGetSnapshot(t *testing.T) {
+	Params.InitOnce()
+	ctx := context.Background()
+	dc := &snapshotDataCoordMock{
+		DataCoordMock: NewDataCoordMock(),
+		snapshots: []*datapb.SnapshotInfo{
+			{SnapshotID: 1, Name: "snap", CollectionID: 100, SnapshotTs: 1000, SegmentIDs: []int64{1, 2}},
+		},
+	}
+
+	_, err := getSnapshot(ctx, nil, "coll", 100, "snap")
+	assert.Error(t, err)
+
+	_, err = getSnapshot(ctx, dc, "coll", 100, "#snap")
+	assert.Error(t, err)
+
+	_, err = getSnapshot(ctx, dc, "coll", 100, "other")
+	assert.Error(t, err)
+
+	_, err = getSnapshot(ctx, dc, "coll", 101, "snap")
+	assert.Error(t, err)
+
+	snapshot, err := getSnapshot(ctx, dc, "coll", 100, "snap")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1000), snapshot.GetSnapshotTs())
+	assert.ElementsMatch(t, []int64{1, 2}, snapshot.GetSegmentIDs())
+}
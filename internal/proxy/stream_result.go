@@ -0,0 +1,68 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"sync"
+)
+
+// resultStreams routes the partial results pushed by the shard leaders to the streaming tasks by the request ID
+type resultStreams struct {
+	mu    sync.RWMutex
+	tasks map[UniqueID]task
+}
+
+func newResultStreams() *resultStreams {
+	return &resultStreams{
+		tasks: make(map[UniqueID]task),
+	}
+}
+
+func (s *resultStreams) register(t task) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tasks[t.ID()] = t
+}
+
+func (s *resultStreams) remove(reqID UniqueID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.tasks, reqID)
+}
+
+func (s *resultStreams) get(reqID UniqueID) (task, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	t, ok := s.tasks[reqID]
+	return t, ok
+}
+
+// splitRows returns the ranges of at most chunkRows rows, [start, end) of each
+func splitRows(rows int64, chunkRows int64) [][2]int64 {
+	if chunkRows <= 0 {
+		chunkRows = rows
+	}
+	var ranges [][2]int64
+	for start := int64(0); start < rows; start += chunkRows {
+		end := start + chunkRows
+		if end > rows {
+			end = rows
+		}
+		ranges = append(ranges, [2]int64{start, end})
+	}
+	return ranges
+}
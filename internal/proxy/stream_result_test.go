@@ -0,0 +1,175 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func Test_splitRows(t *testing.T) {
+	assert.Equal(t, [][2]int64{{0, 2}, {2, 4}, {4, 5}}, splitRows(5, 2))
+	assert.Equal(t, [][2]int64{{0, 5}}, splitRows(5, 10))
+	assert.Equal(t, [][2]int64{{0, 5}}, splitRows(5, 0))
+	assert.Nil(t, splitRows(0, 2))
+}
+
+func newStreamRetrieveResult(pks ...int64) *internalpb.RetrieveResults {
+	return &internalpb.RetrieveResults{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}},
+		},
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type: schemapb.DataType_Int64,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newStreamQueryTask(msgID UniqueID, chunks *[]*milvuspb.QueryResults) *queryTask {
+	return &queryTask{
+		RetrieveRequest: &internalpb.RetrieveRequest{
+			Base:           &commonpb.MsgBase{MsgID: msgID},
+			OutputFieldsId: []int64{100},
+		},
+		collectionName: "coll",
+		sendStream: func(result *milvuspb.QueryResults) error {
+			*chunks = append(*chunks, result)
+			return nil
+		},
+	}
+}
+
+func mockStreamSchemaCache() Cache {
+	cache := newMockCache()
+	cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		return &schemapb.CollectionSchema{
+			Name: "coll",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			},
+		}, nil
+	})
+	return cache
+}
+
+func TestQueryTask_streamRetrieveResult(t *testing.T) {
+	Params.Init()
+	oldChunkRows := Params.ProxyCfg.StreamResultChunkRows
+	Params.ProxyCfg.StreamResultChunkRows = 2
+	defer func() {
+		Params.ProxyCfg.StreamResultChunkRows = oldChunkRows
+	}()
+	oldCache := globalMetaCache
+	defer func() {
+		globalMetaCache = oldCache
+	}()
+	globalMetaCache = mockStreamSchemaCache()
+
+	ctx := context.Background()
+	var chunks []*milvuspb.QueryResults
+	qt := newStreamQueryTask(1, &chunks)
+
+	assert.False(t, qt.hasStreamed())
+	err := qt.streamRetrieveResult(ctx, newStreamRetrieveResult(1, 2, 3))
+	assert.NoError(t, err)
+	err = qt.streamRetrieveResult(ctx, newStreamRetrieveResult(4, 5))
+	assert.NoError(t, err)
+	err = qt.streamRetrieveResult(ctx, newStreamRetrieveResult(6))
+	assert.NoError(t, err)
+	assert.True(t, qt.hasStreamed())
+
+	assert.Equal(t, 4, len(chunks))
+	expected := [][]int64{{1, 2}, {3}, {4, 5}, {6}}
+	for i, chunk := range chunks {
+		assert.Equal(t, commonpb.ErrorCode_Success, chunk.GetStatus().GetErrorCode())
+		assert.Equal(t, "coll", chunk.GetCollectionName())
+		assert.Equal(t, "pk", chunk.GetFieldsData()[0].GetFieldName())
+		assert.Equal(t, expected[i], chunk.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	}
+
+	qt.sendStream = func(result *milvuspb.QueryResults) error {
+		return errors.New("mock")
+	}
+	err = qt.streamRetrieveResult(ctx, newStreamRetrieveResult(5))
+	assert.Error(t, err)
+}
+
+func TestProxy_SendResults(t *testing.T) {
+	Params.Init()
+	oldCache := globalMetaCache
+	defer func() {
+		globalMetaCache = oldCache
+	}()
+	globalMetaCache = mockStreamSchemaCache()
+
+	ctx := context.Background()
+	node := &Proxy{resultStreams: newResultStreams()}
+	node.UpdateStateCode(internalpb.StateCode_Healthy)
+
+	var chunks []*milvuspb.QueryResults
+	qt := newStreamQueryTask(1, &chunks)
+	st := &searchTask{
+		SearchRequest: &internalpb.SearchRequest{
+			Base: &commonpb.MsgBase{MsgID: 2},
+		},
+	}
+	node.resultStreams.register(qt)
+	node.resultStreams.register(st)
+
+	retrieveResult := newStreamRetrieveResult(1, 2)
+	retrieveResult.ReqID = qt.ID()
+	status, err := node.SendRetrieveResult(ctx, retrieveResult)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.Equal(t, 1, len(chunks))
+
+	status, err = node.SendSearchResult(ctx, &internalpb.SearchResults{ReqID: st.ID()})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.Equal(t, 1, len(st.pushedResult))
+
+	// the request isn't streaming search or query
+	status, err = node.SendSearchResult(ctx, &internalpb.SearchResults{ReqID: qt.ID()})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+
+	node.resultStreams.remove(qt.ID())
+	status, err = node.SendRetrieveResult(ctx, retrieveResult)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+
+	node.UpdateStateCode(internalpb.StateCode_Abnormal)
+	status, err = node.SendSearchResult(ctx, &internalpb.SearchResults{ReqID: st.ID()})
+	assert.NoError(t, err)
+	assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+}
@@ -62,6 +62,8 @@ const (
 	MetricTypeKey                   = "metric_type"
 	SearchParamsKey                 = "params"
 	RoundDecimalKey                 = "round_decimal"
+	GroupByFieldKey                 = "group_by_field"
+	GroupSizeKey                    = "group_size"
 	HasCollectionTaskName           = "HasCollectionTask"
 	DescribeCollectionTaskName      = "DescribeCollectionTask"
 	GetCollectionStatisticsTaskName = "GetCollectionStatisticsTask"
@@ -85,6 +87,11 @@ const (
 	CreateAliasTaskName             = "CreateAliasTask"
 	DropAliasTaskName               = "DropAliasTask"
 	AlterAliasTaskName              = "AlterAliasTask"
+	AlterShardsTaskName             = "AlterShardsTask"
+	TruncateCollectionTaskName      = "TruncateCollectionTask"
+	TruncatePartitionTaskName       = "TruncatePartitionTask"
+	AddFieldTaskName                = "AddFieldTask"
+	DropFieldTaskName               = "DropFieldTask"
 
 	// minFloat32 minimum float.
 	minFloat32 = -1 * float32(math.MaxFloat32)
@@ -202,18 +209,38 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	// validate clustering key
+	if err := validateClusteringKey(cct.schema); err != nil {
+		return err
+	}
+
 	// validate field type definition
 	if err := validateFieldType(cct.schema); err != nil {
 		return err
 	}
 
+	// validate nullable fields and fields with default value
+	if err := validateNullableFields(cct.schema); err != nil {
+		return err
+	}
+
+	// validate the uniqueness policy of primary keys
+	if _, _, err := getUniquePKPolicy(cct.GetProperties()); err != nil {
+		return err
+	}
+
+	// validate the time travel retention of the collection
+	if _, err := common.GetTimeTravelRetention(cct.GetProperties(), Params.CommonCfg.RetentionDuration); err != nil {
+		return err
+	}
+
 	for _, field := range cct.schema.Fields {
 		// validate field name
 		if err := validateFieldName(field.Name); err != nil {
 			return err
 		}
-		// validate vector field type parameters
-		if field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector {
+		// validate vector field type parameters, sparse float vector has no fixed dimension
+		if typeutil.IsVectorType(field.DataType) && field.DataType != schemapb.DataType_SparseFloatVector {
 			err = validateDimension(field)
 			if err != nil {
 				return err
@@ -349,7 +376,7 @@ func translateOutputFields(outputFields []string, schema *schemapb.CollectionSch
 		if field.IsPrimaryKey {
 			primaryFieldName = field.Name
 		}
-		if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
+		if typeutil.IsVectorType(field.DataType) {
 			vectorFieldNameMap[field.Name] = true
 		} else {
 			scalarFieldNameMap[field.Name] = true
@@ -540,6 +567,7 @@ func (dct *describeCollectionTask) Execute(ctx context.Context) error {
 		dct.result.Schema.Name = result.Schema.Name
 		dct.result.Schema.Description = result.Schema.Description
 		dct.result.Schema.AutoID = result.Schema.AutoID
+		dct.result.Schema.Version = result.Schema.Version
 		dct.result.CollectionID = result.CollectionID
 		dct.result.VirtualChannelNames = result.VirtualChannelNames
 		dct.result.PhysicalChannelNames = result.PhysicalChannelNames
@@ -548,17 +576,21 @@ func (dct *describeCollectionTask) Execute(ctx context.Context) error {
 		dct.result.ShardsNum = result.ShardsNum
 		dct.result.ConsistencyLevel = result.ConsistencyLevel
 		dct.result.Aliases = result.Aliases
+		dct.result.Properties = result.Properties
 		for _, field := range result.Schema.Fields {
 			if field.FieldID >= common.StartOfUserFieldID {
 				dct.result.Schema.Fields = append(dct.result.Schema.Fields, &schemapb.FieldSchema{
-					FieldID:      field.FieldID,
-					Name:         field.Name,
-					IsPrimaryKey: field.IsPrimaryKey,
-					AutoID:       field.AutoID,
-					Description:  field.Description,
-					DataType:     field.DataType,
-					TypeParams:   field.TypeParams,
-					IndexParams:  field.IndexParams,
+					FieldID:         field.FieldID,
+					Name:            field.Name,
+					IsPrimaryKey:    field.IsPrimaryKey,
+					AutoID:          field.AutoID,
+					Description:     field.Description,
+					DataType:        field.DataType,
+					TypeParams:      field.TypeParams,
+					IndexParams:     field.IndexParams,
+					IsClusteringKey: field.IsClusteringKey,
+					Nullable:        field.Nullable,
+					DefaultValue:    field.DefaultValue,
 				})
 			}
 		}
@@ -1219,6 +1251,8 @@ func fillDimension(field *schemapb.FieldSchema, indexParams map[string]string) e
 	vecDataTypes := []schemapb.DataType{
 		schemapb.DataType_FloatVector,
 		schemapb.DataType_BinaryVector,
+		schemapb.DataType_Float16Vector,
+		schemapb.DataType_BFloat16Vector,
 	}
 	if !funcutil.SliceContain(vecDataTypes, field.GetDataType()) {
 		return nil
@@ -1245,14 +1279,24 @@ func checkTrain(field *schemapb.FieldSchema, indexParams map[string]string) erro
 	indexType := indexParams["index_type"]
 
 	// skip params check of non-vector field.
-	vecDataTypes := []schemapb.DataType{
-		schemapb.DataType_FloatVector,
-		schemapb.DataType_BinaryVector,
-	}
-	if !funcutil.SliceContain(vecDataTypes, field.GetDataType()) {
+	if !typeutil.IsVectorType(field.GetDataType()) {
 		return indexparamcheck.CheckIndexValid(field.GetDataType(), indexType, indexParams)
 	}
 
+	if field.GetDataType() == schemapb.DataType_SparseFloatVector {
+		if !indexparamcheck.IsSparseIndexType(indexType) {
+			return fmt.Errorf("index type %s is not supported for sparse float vector field", indexType)
+		}
+	} else if indexparamcheck.IsSparseIndexType(indexType) {
+		return fmt.Errorf("index type %s is only supported for sparse float vector field", indexType)
+	}
+
+	if field.GetDataType() == schemapb.DataType_Float16Vector || field.GetDataType() == schemapb.DataType_BFloat16Vector {
+		if !indexparamcheck.IsHalfFloatIndexType(indexType) {
+			return fmt.Errorf("index type %s is not supported for %s field", indexType, field.GetDataType().String())
+		}
+	}
+
 	adapter, err := indexparamcheck.GetConfAdapterMgrInstance().GetAdapter(indexType)
 	if err != nil {
 		log.Warn("Failed to get conf adapter", zap.String("index_type", indexType))
@@ -2502,12 +2546,9 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("proxy execute delete %d", dt.ID()))
 
 	collID := dt.DeleteRequest.CollectionID
-	stream, err := dt.chMgr.getOrCreateDmlStream(collID)
-	if err != nil {
-		return err
-	}
 
 	// hash primary keys to channels
+	// channels are fetched before the stream, so the stream always covers them even if the shards were altered meanwhile
 	channelNames, err := dt.chMgr.getVChannels(collID)
 	if err != nil {
 		log.Error("get vChannels failed", zap.Int64("collectionID", collID), zap.Error(err))
@@ -2515,7 +2556,20 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 		dt.result.Status.Reason = err.Error()
 		return err
 	}
+	shardsNumHistory, err := dt.chMgr.getShardsNumHistory(collID)
+	if err != nil {
+		log.Error("get shards number history failed", zap.Int64("collectionID", collID), zap.Error(err))
+		dt.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		dt.result.Status.Reason = err.Error()
+		return err
+	}
+	stream, err := dt.chMgr.getOrCreateDmlStream(collID)
+	if err != nil {
+		return err
+	}
 	dt.HashValues = typeutil.HashPK2Channels(dt.result.IDs, channelNames)
+	// entities inserted before the shards were altered live in the channel chosen by the shards number of that time
+	hashKeys := typeutil.HashPK2ChannelsWithHistory(dt.result.IDs, channelNames, shardsNumHistory)
 
 	log.Info("send delete request to virtual channels",
 		zap.String("collection", dt.GetCollectionName()),
@@ -2524,54 +2578,11 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 		zap.Int64("task_id", dt.ID()))
 
 	tr.Record("get vchannels")
-	// repack delete msg by dmChannel
-	result := make(map[uint32]msgstream.TsMsg)
-	collectionName := dt.CollectionName
-	collectionID := dt.CollectionID
-	partitionID := dt.PartitionID
-	partitionName := dt.PartitionName
-	proxyID := dt.Base.SourceID
-	for index, key := range dt.HashValues {
-		ts := dt.Timestamps[index]
-		_, ok := result[key]
-		if !ok {
-			sliceRequest := internalpb.DeleteRequest{
-				Base: &commonpb.MsgBase{
-					MsgType:   commonpb.MsgType_Delete,
-					MsgID:     dt.Base.MsgID,
-					Timestamp: ts,
-					SourceID:  proxyID,
-				},
-				CollectionID:   collectionID,
-				PartitionID:    partitionID,
-				CollectionName: collectionName,
-				PartitionName:  partitionName,
-				PrimaryKeys:    &schemapb.IDs{},
-			}
-			deleteMsg := &msgstream.DeleteMsg{
-				BaseMsg: msgstream.BaseMsg{
-					Ctx: ctx,
-				},
-				DeleteRequest: sliceRequest,
-			}
-			result[key] = deleteMsg
-		}
-		curMsg := result[key].(*msgstream.DeleteMsg)
-		curMsg.HashValues = append(curMsg.HashValues, dt.HashValues[index])
-		curMsg.Timestamps = append(curMsg.Timestamps, dt.Timestamps[index])
-		typeutil.AppendIDs(curMsg.PrimaryKeys, dt.PrimaryKeys, index)
-		curMsg.NumRows++
-	}
-
 	// send delete request to log broker
 	msgPack := &msgstream.MsgPack{
 		BeginTs: dt.BeginTs(),
 		EndTs:   dt.EndTs(),
-	}
-	for _, msg := range result {
-		if msg != nil {
-			msgPack.Msgs = append(msgPack.Msgs, msg)
-		}
+		Msgs:    packDeleteMsgs(ctx, &dt.DeleteRequest, dt.PrimaryKeys, dt.Timestamps, hashKeys),
 	}
 
 	tr.Record("pack messages")
@@ -2587,6 +2598,47 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 	return nil
 }
 
+// packDeleteMsgs repacks the deletes of the primary keys by dml channel, hashKeys are the channels of each key.
+// The other fields of the messages are copied from req.
+func packDeleteMsgs(ctx context.Context, req *internalpb.DeleteRequest, pks *schemapb.IDs, tss []Timestamp, hashKeys [][]uint32) []msgstream.TsMsg {
+	result := make(map[uint32]*msgstream.DeleteMsg)
+	var msgs []msgstream.TsMsg
+	for index, keys := range hashKeys {
+		ts := tss[index]
+		for _, key := range keys {
+			curMsg, ok := result[key]
+			if !ok {
+				sliceRequest := internalpb.DeleteRequest{
+					Base: &commonpb.MsgBase{
+						MsgType:   commonpb.MsgType_Delete,
+						MsgID:     req.GetBase().GetMsgID(),
+						Timestamp: ts,
+						SourceID:  req.GetBase().GetSourceID(),
+					},
+					CollectionID:   req.GetCollectionID(),
+					PartitionID:    req.GetPartitionID(),
+					CollectionName: req.GetCollectionName(),
+					PartitionName:  req.GetPartitionName(),
+					PrimaryKeys:    &schemapb.IDs{},
+				}
+				curMsg = &msgstream.DeleteMsg{
+					BaseMsg: msgstream.BaseMsg{
+						Ctx: ctx,
+					},
+					DeleteRequest: sliceRequest,
+				}
+				result[key] = curMsg
+				msgs = append(msgs, curMsg)
+			}
+			curMsg.HashValues = append(curMsg.HashValues, key)
+			curMsg.Timestamps = append(curMsg.Timestamps, ts)
+			typeutil.AppendIDs(curMsg.PrimaryKeys, pks, index)
+			curMsg.NumRows++
+		}
+	}
+	return msgs
+}
+
 func (dt *deleteTask) PostExecute(ctx context.Context) error {
 	return nil
 }
@@ -2819,3 +2871,374 @@ func (a *AlterAliasTask) Execute(ctx context.Context) error {
 func (a *AlterAliasTask) PostExecute(ctx context.Context) error {
 	return nil
 }
+
+// AlterShardsTask is the task to increase the shards number of a collection
+type AlterShardsTask struct {
+	Condition
+	*milvuspb.AlterShardsRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (a *AlterShardsTask) TraceCtx() context.Context {
+	return a.ctx
+}
+
+func (a *AlterShardsTask) ID() UniqueID {
+	return a.Base.MsgID
+}
+
+func (a *AlterShardsTask) SetID(uid UniqueID) {
+	a.Base.MsgID = uid
+}
+
+func (a *AlterShardsTask) Name() string {
+	return AlterShardsTaskName
+}
+
+func (a *AlterShardsTask) Type() commonpb.MsgType {
+	return a.Base.MsgType
+}
+
+func (a *AlterShardsTask) BeginTs() Timestamp {
+	return a.Base.Timestamp
+}
+
+func (a *AlterShardsTask) EndTs() Timestamp {
+	return a.Base.Timestamp
+}
+
+func (a *AlterShardsTask) SetTs(ts Timestamp) {
+	a.Base.Timestamp = ts
+}
+
+func (a *AlterShardsTask) OnEnqueue() error {
+	a.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (a *AlterShardsTask) PreExecute(ctx context.Context) error {
+	a.Base.MsgType = commonpb.MsgType_AlterShards
+	a.Base.SourceID = Params.ProxyCfg.GetNodeID()
+
+	if err := validateCollectionName(a.CollectionName); err != nil {
+		return err
+	}
+
+	if a.ShardsNum <= 0 {
+		return fmt.Errorf("shards number should be positive, got %d", a.ShardsNum)
+	}
+	if a.ShardsNum > Params.ProxyCfg.MaxShardNum {
+		return fmt.Errorf("maximum shards's number should be limited to %d", Params.ProxyCfg.MaxShardNum)
+	}
+
+	return nil
+}
+
+func (a *AlterShardsTask) Execute(ctx context.Context) error {
+	var err error
+	a.result, err = a.rootCoord.AlterShards(ctx, a.AlterShardsRequest)
+	return err
+}
+
+func (a *AlterShardsTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// truncateCollectionTask removes all the entities of a collection, the schema, indexes and load state are kept
+type truncateCollectionTask struct {
+	Condition
+	*milvuspb.TruncateCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (tct *truncateCollectionTask) TraceCtx() context.Context {
+	return tct.ctx
+}
+
+func (tct *truncateCollectionTask) ID() UniqueID {
+	return tct.Base.MsgID
+}
+
+func (tct *truncateCollectionTask) SetID(uid UniqueID) {
+	tct.Base.MsgID = uid
+}
+
+func (tct *truncateCollectionTask) Name() string {
+	return TruncateCollectionTaskName
+}
+
+func (tct *truncateCollectionTask) Type() commonpb.MsgType {
+	return tct.Base.MsgType
+}
+
+func (tct *truncateCollectionTask) BeginTs() Timestamp {
+	return tct.Base.Timestamp
+}
+
+func (tct *truncateCollectionTask) EndTs() Timestamp {
+	return tct.Base.Timestamp
+}
+
+func (tct *truncateCollectionTask) SetTs(ts Timestamp) {
+	tct.Base.Timestamp = ts
+}
+
+func (tct *truncateCollectionTask) OnEnqueue() error {
+	tct.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (tct *truncateCollectionTask) PreExecute(ctx context.Context) error {
+	tct.Base.MsgType = commonpb.MsgType_TruncateCollection
+	tct.Base.SourceID = Params.ProxyCfg.GetNodeID()
+
+	return validateCollectionName(tct.CollectionName)
+}
+
+func (tct *truncateCollectionTask) Execute(ctx context.Context) (err error) {
+	tct.result, err = tct.rootCoord.TruncateCollection(ctx, tct.TruncateCollectionRequest)
+	if err != nil {
+		return err
+	}
+	if tct.result == nil {
+		return errors.New("truncate collection resp is nil")
+	}
+	if tct.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(tct.result.Reason)
+	}
+	return nil
+}
+
+func (tct *truncateCollectionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// truncatePartitionTask removes all the entities of a partition, the partition itself is kept
+type truncatePartitionTask struct {
+	Condition
+	*milvuspb.TruncatePartitionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (tpt *truncatePartitionTask) TraceCtx() context.Context {
+	return tpt.ctx
+}
+
+func (tpt *truncatePartitionTask) ID() UniqueID {
+	return tpt.Base.MsgID
+}
+
+func (tpt *truncatePartitionTask) SetID(uid UniqueID) {
+	tpt.Base.MsgID = uid
+}
+
+func (tpt *truncatePartitionTask) Name() string {
+	return TruncatePartitionTaskName
+}
+
+func (tpt *truncatePartitionTask) Type() commonpb.MsgType {
+	return tpt.Base.MsgType
+}
+
+func (tpt *truncatePartitionTask) BeginTs() Timestamp {
+	return tpt.Base.Timestamp
+}
+
+func (tpt *truncatePartitionTask) EndTs() Timestamp {
+	return tpt.Base.Timestamp
+}
+
+func (tpt *truncatePartitionTask) SetTs(ts Timestamp) {
+	tpt.Base.Timestamp = ts
+}
+
+func (tpt *truncatePartitionTask) OnEnqueue() error {
+	tpt.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (tpt *truncatePartitionTask) PreExecute(ctx context.Context) error {
+	tpt.Base.MsgType = commonpb.MsgType_TruncatePartition
+	tpt.Base.SourceID = Params.ProxyCfg.GetNodeID()
+
+	if err := validateCollectionName(tpt.CollectionName); err != nil {
+		return err
+	}
+
+	return validatePartitionTag(tpt.PartitionName, true)
+}
+
+func (tpt *truncatePartitionTask) Execute(ctx context.Context) (err error) {
+	tpt.result, err = tpt.rootCoord.TruncatePartition(ctx, tpt.TruncatePartitionRequest)
+	if err != nil {
+		return err
+	}
+	if tpt.result == nil {
+		return errors.New("truncate partition resp is nil")
+	}
+	if tpt.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(tpt.result.Reason)
+	}
+	return nil
+}
+
+func (tpt *truncatePartitionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// addFieldTask adds a field to a collection, the rows inserted before get null or the default value of it
+type addFieldTask struct {
+	Condition
+	*milvuspb.AddFieldRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (aft *addFieldTask) TraceCtx() context.Context {
+	return aft.ctx
+}
+
+func (aft *addFieldTask) ID() UniqueID {
+	return aft.Base.MsgID
+}
+
+func (aft *addFieldTask) SetID(uid UniqueID) {
+	aft.Base.MsgID = uid
+}
+
+func (aft *addFieldTask) Name() string {
+	return AddFieldTaskName
+}
+
+func (aft *addFieldTask) Type() commonpb.MsgType {
+	return aft.Base.MsgType
+}
+
+func (aft *addFieldTask) BeginTs() Timestamp {
+	return aft.Base.Timestamp
+}
+
+func (aft *addFieldTask) EndTs() Timestamp {
+	return aft.Base.Timestamp
+}
+
+func (aft *addFieldTask) SetTs(ts Timestamp) {
+	aft.Base.Timestamp = ts
+}
+
+func (aft *addFieldTask) OnEnqueue() error {
+	aft.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (aft *addFieldTask) PreExecute(ctx context.Context) error {
+	aft.Base.MsgType = commonpb.MsgType_AddField
+	aft.Base.SourceID = Params.ProxyCfg.GetNodeID()
+
+	if err := validateCollectionName(aft.CollectionName); err != nil {
+		return err
+	}
+	if aft.Field == nil {
+		return errors.New("field schema is empty")
+	}
+	return validateFieldName(aft.Field.Name)
+}
+
+func (aft *addFieldTask) Execute(ctx context.Context) (err error) {
+	aft.result, err = aft.rootCoord.AddField(ctx, aft.AddFieldRequest)
+	if err != nil {
+		return err
+	}
+	if aft.result == nil {
+		return errors.New("add field resp is nil")
+	}
+	if aft.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(aft.result.Reason)
+	}
+	return nil
+}
+
+func (aft *addFieldTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// dropFieldTask drops a field from a collection, the data of it is ignored afterwards
+type dropFieldTask struct {
+	Condition
+	*milvuspb.DropFieldRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (dft *dropFieldTask) TraceCtx() context.Context {
+	return dft.ctx
+}
+
+func (dft *dropFieldTask) ID() UniqueID {
+	return dft.Base.MsgID
+}
+
+func (dft *dropFieldTask) SetID(uid UniqueID) {
+	dft.Base.MsgID = uid
+}
+
+func (dft *dropFieldTask) Name() string {
+	return DropFieldTaskName
+}
+
+func (dft *dropFieldTask) Type() commonpb.MsgType {
+	return dft.Base.MsgType
+}
+
+func (dft *dropFieldTask) BeginTs() Timestamp {
+	return dft.Base.Timestamp
+}
+
+func (dft *dropFieldTask) EndTs() Timestamp {
+	return dft.Base.Timestamp
+}
+
+func (dft *dropFieldTask) SetTs(ts Timestamp) {
+	dft.Base.Timestamp = ts
+}
+
+func (dft *dropFieldTask) OnEnqueue() error {
+	dft.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (dft *dropFieldTask) PreExecute(ctx context.Context) error {
+	dft.Base.MsgType = commonpb.MsgType_DropField
+	dft.Base.SourceID = Params.ProxyCfg.GetNodeID()
+
+	if err := validateCollectionName(dft.CollectionName); err != nil {
+		return err
+	}
+	return validateFieldName(dft.FieldName)
+}
+
+func (dft *dropFieldTask) Execute(ctx context.Context) (err error) {
+	dft.result, err = dft.rootCoord.DropField(ctx, dft.DropFieldRequest)
+	if err != nil {
+		return err
+	}
+	if dft.result == nil {
+		return errors.New("drop field resp is nil")
+	}
+	if dft.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(dft.result.Reason)
+	}
+	return nil
+}
+
+func (dft *dropFieldTask) PostExecute(ctx context.Context) error {
+	return nil
+}
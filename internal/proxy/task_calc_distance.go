@@ -67,6 +67,62 @@ func (t *calcDistanceTask) arrangeVectorsByIntID(inputIds []int64, sequence map[
 		}, nil
 	}
 
+	if retrievedVectors.GetFloat16Vector() != nil || retrievedVectors.GetBfloat16Vector() != nil {
+		halfArr := retrievedVectors.GetFloat16Vector()
+		if halfArr == nil {
+			halfArr = retrievedVectors.GetBfloat16Vector()
+		}
+		numBytes := retrievedVectors.GetDim() * 2
+
+		result := make([]byte, 0, int64(len(inputIds))*numBytes)
+		for _, id := range inputIds {
+			index, ok := sequence[id]
+			if !ok {
+				log.Error("id not found in CalcDistance", zap.Int64("id", id))
+				return nil, errors.New("failed to fetch vectors by id: " + fmt.Sprintln(id))
+			}
+			result = append(result, halfArr[int64(index)*numBytes:int64(index+1)*numBytes]...)
+		}
+
+		if retrievedVectors.GetFloat16Vector() != nil {
+			return &schemapb.VectorField{
+				Dim: retrievedVectors.GetDim(),
+				Data: &schemapb.VectorField_Float16Vector{
+					Float16Vector: result,
+				},
+			}, nil
+		}
+		return &schemapb.VectorField{
+			Dim: retrievedVectors.GetDim(),
+			Data: &schemapb.VectorField_Bfloat16Vector{
+				Bfloat16Vector: result,
+			},
+		}, nil
+	}
+
+	if retrievedVectors.GetSparseFloatVector() != nil {
+		contents := retrievedVectors.GetSparseFloatVector().GetContents()
+		result := make([][]byte, 0, len(inputIds))
+		for _, id := range inputIds {
+			index, ok := sequence[id]
+			if !ok {
+				log.Error("id not found in CalcDistance", zap.Int64("id", id))
+				return nil, errors.New("failed to fetch vectors by id: " + fmt.Sprintln(id))
+			}
+			result = append(result, contents[index])
+		}
+
+		return &schemapb.VectorField{
+			Dim: retrievedVectors.GetDim(),
+			Data: &schemapb.VectorField_SparseFloatVector{
+				SparseFloatVector: &schemapb.SparseFloatArray{
+					Contents: result,
+					Dim:      retrievedVectors.GetSparseFloatVector().GetDim(),
+				},
+			},
+		}, nil
+	}
+
 	return nil, errors.New("unsupported vector type")
 }
 
@@ -117,6 +173,62 @@ func (t *calcDistanceTask) arrangeVectorsByStrID(inputIds []string, sequence map
 		}, nil
 	}
 
+	if retrievedVectors.GetFloat16Vector() != nil || retrievedVectors.GetBfloat16Vector() != nil {
+		halfArr := retrievedVectors.GetFloat16Vector()
+		if halfArr == nil {
+			halfArr = retrievedVectors.GetBfloat16Vector()
+		}
+		numBytes := retrievedVectors.GetDim() * 2
+
+		result := make([]byte, 0, int64(len(inputIds))*numBytes)
+		for _, id := range inputIds {
+			index, ok := sequence[id]
+			if !ok {
+				log.Error("id not found in CalcDistance", zap.String("id", id))
+				return nil, errors.New("failed to fetch vectors by id: " + fmt.Sprintln(id))
+			}
+			result = append(result, halfArr[int64(index)*numBytes:int64(index+1)*numBytes]...)
+		}
+
+		if retrievedVectors.GetFloat16Vector() != nil {
+			return &schemapb.VectorField{
+				Dim: retrievedVectors.GetDim(),
+				Data: &schemapb.VectorField_Float16Vector{
+					Float16Vector: result,
+				},
+			}, nil
+		}
+		return &schemapb.VectorField{
+			Dim: retrievedVectors.GetDim(),
+			Data: &schemapb.VectorField_Bfloat16Vector{
+				Bfloat16Vector: result,
+			},
+		}, nil
+	}
+
+	if retrievedVectors.GetSparseFloatVector() != nil {
+		contents := retrievedVectors.GetSparseFloatVector().GetContents()
+		result := make([][]byte, 0, len(inputIds))
+		for _, id := range inputIds {
+			index, ok := sequence[id]
+			if !ok {
+				log.Error("id not found in CalcDistance", zap.String("id", id))
+				return nil, errors.New("failed to fetch vectors by id: " + fmt.Sprintln(id))
+			}
+			result = append(result, contents[index])
+		}
+
+		return &schemapb.VectorField{
+			Dim: retrievedVectors.GetDim(),
+			Data: &schemapb.VectorField_SparseFloatVector{
+				SparseFloatVector: &schemapb.SparseFloatArray{
+					Contents: result,
+					Dim:      retrievedVectors.GetSparseFloatVector().GetDim(),
+				},
+			},
+		}, nil
+	}
+
 	return nil, errors.New("unsupported vector type")
 }
 
@@ -300,6 +412,39 @@ func (t *calcDistanceTask) Execute(ctx context.Context, request *milvuspb.CalcDi
 		}, nil
 	}
 
+	// sparse float vectors have no fixed dimension, the dimension check is skipped
+	if vectorsLeft.GetSparseFloatVector() != nil && vectorsRight.GetSparseFloatVector() != nil {
+		distances, err := distance.CalcSparseFloatDistance(vectorsLeft.GetSparseFloatVector().GetContents(), vectorsRight.GetSparseFloatVector().GetContents(), metric)
+		if err != nil {
+			log.Debug("Failed to CalcSparseFloatDistance",
+				zap.Error(err),
+				zap.Int("leftLen", len(vectorsLeft.GetSparseFloatVector().GetContents())),
+				zap.Int("rightLen", len(vectorsRight.GetSparseFloatVector().GetContents())),
+				zap.String("traceID", t.traceID),
+				zap.String("role", typeutil.ProxyRole))
+
+			return &milvuspb.CalcDistanceResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+			}, nil
+		}
+
+		log.Debug("CalcSparseFloatDistance done",
+			zap.String("traceID", t.traceID),
+			zap.String("role", typeutil.ProxyRole))
+
+		return &milvuspb.CalcDistanceResults{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success, Reason: ""},
+			Array: &milvuspb.CalcDistanceResults_FloatDist{
+				FloatDist: &schemapb.FloatArray{
+					Data: distances,
+				},
+			},
+		}, nil
+	}
+
 	if vectorsLeft.GetDim() != vectorsRight.GetDim() {
 		msg := "Vectors dimension is not equal"
 		log.Debug(msg,
@@ -349,6 +494,44 @@ func (t *calcDistanceTask) Execute(ctx context.Context, request *milvuspb.CalcDi
 		}, nil
 	}
 
+	if (vectorsLeft.GetFloat16Vector() != nil && vectorsRight.GetFloat16Vector() != nil) ||
+		(vectorsLeft.GetBfloat16Vector() != nil && vectorsRight.GetBfloat16Vector() != nil) {
+		var distances []float32
+		if vectorsLeft.GetFloat16Vector() != nil {
+			distances, err = distance.CalcFloat16Distance(vectorsLeft.GetDim(), vectorsLeft.GetFloat16Vector(), vectorsRight.GetFloat16Vector(), metric)
+		} else {
+			distances, err = distance.CalcBFloat16Distance(vectorsLeft.GetDim(), vectorsLeft.GetBfloat16Vector(), vectorsRight.GetBfloat16Vector(), metric)
+		}
+		if err != nil {
+			log.Debug("Failed to calculate half float distance",
+				zap.Error(err),
+				zap.Int64("leftDim", vectorsLeft.GetDim()),
+				zap.Int64("rightDim", vectorsRight.GetDim()),
+				zap.String("traceID", t.traceID),
+				zap.String("role", typeutil.ProxyRole))
+
+			return &milvuspb.CalcDistanceResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+			}, nil
+		}
+
+		log.Debug("Calculate half float distance done",
+			zap.String("traceID", t.traceID),
+			zap.String("role", typeutil.ProxyRole))
+
+		return &milvuspb.CalcDistanceResults{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success, Reason: ""},
+			Array: &milvuspb.CalcDistanceResults_FloatDist{
+				FloatDist: &schemapb.FloatArray{
+					Data: distances,
+				},
+			},
+		}, nil
+	}
+
 	if vectorsLeft.GetBinaryVector() != nil && vectorsRight.GetBinaryVector() != nil {
 		hamming, err := distance.CalcHammingDistance(vectorsLeft.GetDim(), vectorsLeft.GetBinaryVector(), vectorsRight.GetBinaryVector())
 		if err != nil {
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
@@ -34,6 +35,8 @@ type insertTask struct {
 	vChannels     []vChan
 	pChannels     []pChan
 	schema        *schemapb.CollectionSchema
+	// upsertIDs are the primary keys whose existing entities are replaced by the insert
+	upsertIDs *schemapb.IDs
 }
 
 // TraceCtx returns insertTask context
@@ -70,6 +73,16 @@ func (it *insertTask) EndTs() Timestamp {
 	return it.EndTimestamp
 }
 
+// rangeTs returns true if the task deletes the upserted entities at the begin timestamp,
+// which is before the inserted rows at the end timestamp
+func (it *insertTask) rangeTs() bool {
+	return typeutil.GetSizeOfIDs(it.upsertIDs) > 0
+}
+
+func (it *insertTask) SetEndTs(ts Timestamp) {
+	it.EndTimestamp = ts
+}
+
 func (it *insertTask) getPChanStats() (map[pChan]pChanStatistics, error) {
 	ret := make(map[pChan]pChanStatistics)
 
@@ -117,6 +130,54 @@ func (it *insertTask) checkLengthOfFieldsData() error {
 	return nil
 }
 
+// fillNullableFieldsData fills the missing columns of nullable fields and fields with default value,
+// and replaces the null rows of fields with default value by the default value.
+func (it *insertTask) fillNullableFieldsData() error {
+	numRows := int(it.NRows())
+	columns := make(map[string]*schemapb.FieldData, len(it.GetFieldsData()))
+	for _, column := range it.GetFieldsData() {
+		columns[column.FieldName] = column
+	}
+
+	for _, field := range it.schema.GetFields() {
+		column, ok := columns[field.Name]
+		if !ok {
+			if field.AutoID || (!field.GetNullable() && field.GetDefaultValue() == nil) {
+				continue
+			}
+			column, err := genNullableFieldData(field, numRows)
+			if err != nil {
+				return err
+			}
+			it.FieldsData = append(it.FieldsData, column)
+			continue
+		}
+
+		validData := column.GetValidData()
+		if len(validData) == 0 {
+			continue
+		}
+		if len(validData) != numRows {
+			return fmt.Errorf("the length of valid data %d of field %s mismatch the number of rows %d", len(validData), field.Name, numRows)
+		}
+		if field.GetDefaultValue() != nil {
+			if err := fillDefaultValue(column, field); err != nil {
+				return err
+			}
+			continue
+		}
+		if !field.GetNullable() {
+			for _, valid := range validData {
+				if !valid {
+					return fmt.Errorf("field %s is not nullable but has null values", field.Name)
+				}
+			}
+			column.ValidData = nil
+		}
+	}
+	return nil
+}
+
 func (it *insertTask) checkPrimaryFieldData() error {
 	rowNums := uint32(it.NRows())
 	// TODO(dragondriver): in fact, NumRows is not trustable, we should check all input fields
@@ -194,8 +255,25 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		log.Error("get collection schema from global meta cache failed", zap.String("collection name", collectionName), zap.Error(err))
 		return err
 	}
+	if !isSchemaMatched(it.GetFieldsData(), collSchema) {
+		// fields may be added or dropped, validate the insert data with the latest schema version
+		globalMetaCache.RemoveCollection(ctx, collectionName)
+		collSchema, err = globalMetaCache.GetCollectionSchema(ctx, collectionName)
+		if err != nil {
+			log.Error("get collection schema from global meta cache failed", zap.String("collection name", collectionName), zap.Error(err))
+			return err
+		}
+		log.Debug("refresh the collection schema for insert", zap.String("collection name", collectionName),
+			zap.Int32("schema version", collSchema.GetVersion()))
+	}
 	it.schema = collSchema
 
+	// fill the columns of nullable fields and fields with default value
+	if err := it.fillNullableFieldsData(); err != nil {
+		log.Error("fill nullable fields data failed", zap.String("collection name", collectionName), zap.Error(err))
+		return err
+	}
+
 	rowNums := uint32(it.NRows())
 	// set insertTask.rowIDs
 	var rowIDBegin UniqueID
@@ -213,7 +291,7 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 	rowNum := it.NRows()
 	it.Timestamps = make([]uint64, rowNum)
 	for index := range it.Timestamps {
-		it.Timestamps[index] = it.BeginTimestamp
+		it.Timestamps[index] = it.EndTimestamp
 	}
 
 	// set result.SuccIndex
@@ -244,6 +322,12 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	// check that sparse float vector rows are well formed
+	if err = checkSparseFloatVectorFieldData(it.GetFieldsData()); err != nil {
+		log.Error("invalid sparse float vector data", zap.Int64("msgID", it.Base.MsgID), zap.String("collection name", collectionName), zap.Error(err))
+		return err
+	}
+
 	log.Debug("Proxy Insert PreExecute done", zap.Int64("msgID", it.Base.MsgID), zap.String("collection name", collectionName))
 
 	return nil
@@ -311,7 +395,7 @@ func (it *insertTask) assignSegmentID(channelNames []string) (*msgstream.MsgPack
 			Base: &commonpb.MsgBase{
 				MsgType:   commonpb.MsgType_Insert,
 				MsgID:     msgID,
-				Timestamp: it.BeginTimestamp, // entity's timestamp was set to equal it.BeginTimestamp in preExecute()
+				Timestamp: it.EndTimestamp, // entity's timestamp was set to equal it.EndTimestamp in preExecute()
 				SourceID:  it.Base.SourceID,
 			},
 			CollectionID:   it.CollectionID,
@@ -430,12 +514,7 @@ func (it *insertTask) Execute(ctx context.Context) error {
 	it.PartitionID = partitionID
 	tr.Record("get collection id & partition id from cache")
 
-	stream, err := it.chMgr.getOrCreateDmlStream(collID)
-	if err != nil {
-		return err
-	}
-	tr.Record("get used message stream")
-
+	// channels are fetched before the stream, so the stream always covers them even if the shards were altered meanwhile
 	channelNames, err := it.chMgr.getVChannels(collID)
 	if err != nil {
 		log.Error("get vChannels failed", zap.Int64("msgID", it.Base.MsgID), zap.Int64("collectionID", collID), zap.Error(err))
@@ -444,6 +523,12 @@ func (it *insertTask) Execute(ctx context.Context) error {
 		return err
 	}
 
+	stream, err := it.chMgr.getOrCreateDmlStream(collID)
+	if err != nil {
+		return err
+	}
+	tr.Record("get used message stream")
+
 	log.Info("send insert request to virtual channels",
 		zap.String("collection", it.GetCollectionName()),
 		zap.String("partition", it.GetPartitionName()),
@@ -471,11 +556,53 @@ func (it *insertTask) Execute(ctx context.Context) error {
 	sendMsgDur := tr.Record("send insert request to dml channel")
 	metrics.ProxySendMutationReqLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.InsertLabel).Observe(float64(sendMsgDur.Milliseconds()))
 
+	// the old entities are deleted after the new rows are sent, so a failure leaves duplicates instead of losing data
+	if it.rangeTs() {
+		if err := it.deleteUpserted(ctx, stream, channelNames); err != nil {
+			log.Error("delete upserted entities failed", zap.Int64("msgID", it.Base.MsgID), zap.Int64("collectionID", collID), zap.Error(err))
+			err = fmt.Errorf("rows are inserted but the existing entities with the same primary keys are not deleted: %w", err)
+			it.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			it.result.Status.Reason = err.Error()
+			return err
+		}
+		tr.Record("send delete request of upserted entities to dml channel")
+	}
+
 	log.Debug("Proxy Insert Execute done", zap.Int64("msgID", it.Base.MsgID), zap.String("collection name", collectionName))
 
 	return nil
 }
 
+// deleteUpserted deletes the existing entities of upsertIDs from all partitions at the begin timestamp,
+// the inserted rows have the later end timestamp so they are kept.
+func (it *insertTask) deleteUpserted(ctx context.Context, stream msgstream.MsgStream, channelNames []string) error {
+	shardsNumHistory, err := it.chMgr.getShardsNumHistory(it.CollectionID)
+	if err != nil {
+		return err
+	}
+	// entities inserted before the shards were altered live in the channel chosen by the shards number of that time
+	hashKeys := typeutil.HashPK2ChannelsWithHistory(it.upsertIDs, channelNames, shardsNumHistory)
+	tss := make([]Timestamp, typeutil.GetSizeOfIDs(it.upsertIDs))
+	for i := range tss {
+		tss[i] = it.BeginTimestamp
+	}
+	req := &internalpb.DeleteRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_Delete,
+			MsgID:    it.Base.MsgID,
+			SourceID: it.Base.SourceID,
+		},
+		CollectionID:   it.CollectionID,
+		PartitionID:    common.InvalidPartitionID,
+		CollectionName: it.CollectionName,
+	}
+	return stream.Produce(&msgstream.MsgPack{
+		BeginTs: it.BeginTs(),
+		EndTs:   it.BeginTs(),
+		Msgs:    packDeleteMsgs(ctx, req, it.upsertIDs, tss, hashKeys),
+	})
+}
+
 func (it *insertTask) PostExecute(ctx context.Context) error {
 	return nil
 }
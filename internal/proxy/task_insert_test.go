@@ -346,3 +346,61 @@ func TestInsertTask_CheckAligned(t *testing.T) {
 	err = case2.CheckAligned()
 	assert.NoError(t, err)
 }
+
+func TestInsertTask_fillNullableFieldsData(t *testing.T) {
+	numRows := 3
+	nullableField := &schemapb.FieldSchema{Name: "nullable", FieldID: 101, DataType: schemapb.DataType_Int64, Nullable: true}
+	defaultField := &schemapb.FieldSchema{
+		Name:         "default",
+		FieldID:      102,
+		DataType:     schemapb.DataType_Int64,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 7}},
+	}
+	normalField := &schemapb.FieldSchema{Name: "normal", FieldID: 103, DataType: schemapb.DataType_Int64}
+	newColumn := func(name string, validData []bool) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			FieldName: name,
+			Type:      schemapb.DataType_Int64,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2, 3}}},
+			}},
+			ValidData: validData,
+		}
+	}
+	newTask := func(columns ...*schemapb.FieldData) *insertTask {
+		return &insertTask{
+			schema: &schemapb.CollectionSchema{
+				Fields: []*schemapb.FieldSchema{nullableField, defaultField, normalField},
+			},
+			BaseInsertTask: BaseInsertTask{
+				InsertRequest: internalpb.InsertRequest{
+					FieldsData: columns,
+					NumRows:    uint64(numRows),
+					Version:    internalpb.InsertDataVersion_ColumnBased,
+				},
+			},
+		}
+	}
+
+	// missing columns are generated
+	task := newTask(newColumn("normal", nil))
+	assert.NoError(t, task.fillNullableFieldsData())
+	assert.Equal(t, 3, len(task.FieldsData))
+	assert.Equal(t, []bool{false, false, false}, task.FieldsData[1].GetValidData())
+	assert.Equal(t, []int64{7, 7, 7}, task.FieldsData[2].GetScalars().GetLongData().GetData())
+
+	// null rows are replaced by the default value
+	task = newTask(newColumn("normal", nil), newColumn("nullable", []bool{true, false, true}), newColumn("default", []bool{false, true, true}))
+	assert.NoError(t, task.fillNullableFieldsData())
+	assert.Equal(t, []bool{true, false, true}, task.FieldsData[1].GetValidData())
+	assert.Equal(t, []int64{7, 2, 3}, task.FieldsData[2].GetScalars().GetLongData().GetData())
+	assert.Empty(t, task.FieldsData[2].GetValidData())
+
+	// length of valid data mismatch
+	task = newTask(newColumn("normal", nil), newColumn("nullable", []bool{true}))
+	assert.Error(t, task.fillNullableFieldsData())
+
+	// null rows of a non-nullable field
+	task = newTask(newColumn("normal", []bool{true, false, true}))
+	assert.Error(t, task.fillNullableFieldsData())
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 
@@ -22,6 +24,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
@@ -41,6 +44,7 @@ type queryTask struct {
 	result         *milvuspb.QueryResults
 	request        *milvuspb.QueryRequest
 	qc             types.QueryCoord
+	dc             types.DataCoord // resolves the snapshot of `collection@snapshot`
 	ids            *schemapb.IDs
 	collectionName string
 
@@ -49,6 +53,19 @@ type queryTask struct {
 
 	queryShardPolicy pickShardPolicy
 	shardMgr         *shardClientMgr
+	skipRowPolicy    bool // internal queries covering all entities, like the uniqueness check of primary keys
+
+	explainPlan string // readable plan returned to user if explain is required
+
+	// sends the chunks of the streaming query, nil if the results are returned at once
+	sendStream func(*milvuspb.QueryResults) error
+	// routes the results pushed by the shard leaders to the streaming query
+	streams  *resultStreams
+	streamMu sync.Mutex
+	// whether any chunk is sent, the query can't be retried then.
+	// The entities are not deduplicated, since the primary keys never span the channels
+	// and the shard leaders push the merged results of the channels.
+	streamed bool
 }
 
 // translateOutputFields translates output fields name to output fields id.
@@ -56,7 +73,7 @@ func translateToOutputFieldIDs(outputFields []string, schema *schemapb.Collectio
 	outputFieldIDs := make([]UniqueID, 0, len(outputFields))
 	if len(outputFields) == 0 {
 		for _, field := range schema.Fields {
-			if field.FieldID >= 100 && field.DataType != schemapb.DataType_FloatVector && field.DataType != schemapb.DataType_BinaryVector {
+			if field.FieldID >= 100 && !typeutil.IsVectorType(field.DataType) {
 				outputFieldIDs = append(outputFieldIDs, field.FieldID)
 			}
 		}
@@ -86,6 +103,11 @@ func translateToOutputFieldIDs(outputFields []string, schema *schemapb.Collectio
 	return outputFieldIDs, nil
 }
 
+// getPriority returns the priority of the request, the higher the earlier it's scheduled
+func (t *queryTask) getPriority() int32 {
+	return t.request.GetPriority()
+}
+
 func (t *queryTask) PreExecute(ctx context.Context) error {
 	if t.queryShardPolicy == nil {
 		t.queryShardPolicy = mergeRoundRobinPolicy
@@ -94,7 +116,7 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	t.Base.MsgType = commonpb.MsgType_Retrieve
 	t.Base.SourceID = Params.ProxyCfg.GetNodeID()
 
-	collectionName := t.request.CollectionName
+	collectionName, snapshotName := splitSnapshotName(t.request.CollectionName)
 	t.collectionName = collectionName
 	if err := validateCollectionName(collectionName); err != nil {
 		log.Warn("Invalid collection name.", zap.String("collectionName", collectionName),
@@ -117,6 +139,13 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		zap.Int64("collectionID", t.CollectionID), zap.String("collection name", collectionName),
 		zap.Int64("msgID", t.ID()), zap.Any("requestType", "query"))
 
+	var snapshot *datapb.SnapshotInfo
+	if snapshotName != "" {
+		if snapshot, err = getSnapshot(ctx, t.dc, collectionName, collID, snapshotName); err != nil {
+			return err
+		}
+	}
+
 	for _, tag := range t.request.PartitionNames {
 		if err := validatePartitionTag(tag, false); err != nil {
 			log.Warn("invalid partition name", zap.String("partition name", tag),
@@ -161,7 +190,15 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf("query expression is empty")
 	}
 
-	plan, err := planparserv2.CreateRetrievePlan(schema, t.request.Expr)
+	expr := t.request.Expr
+	if !t.skipRowPolicy {
+		policyExpr, err := getRowPolicyExpr(ctx, collectionName)
+		if err != nil {
+			return err
+		}
+		expr = mergeRowPolicyExpr(expr, policyExpr)
+	}
+	plan, err := planparserv2.CreateRetrievePlan(schema, expr)
 	if err != nil {
 		return err
 	}
@@ -178,6 +215,10 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	}
 	t.RetrieveRequest.OutputFieldsId = outputFieldIDs
 	plan.OutputFieldIds = outputFieldIDs
+	// the segments only retrieve the entities with the smallest primary keys within the limit,
+	// which are merged again by the query nodes and the proxy
+	plan.Limit = t.RetrieveRequest.GetLimit()
+	t.RetrieveRequest.PrunedSegmentIDs = pruneByClusteringKey(ctx, t.dc, collID, schema, plan)
 	log.Debug("translate output fields to field ids", zap.Any("OutputFieldsID", t.OutputFieldsId),
 		zap.Int64("msgID", t.ID()), zap.Any("requestType", "query"))
 
@@ -185,17 +226,50 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-
-	if t.request.TravelTimestamp == 0 {
-		t.TravelTimestamp = t.BeginTs()
-	} else {
-		t.TravelTimestamp = t.request.TravelTimestamp
+	t.RetrieveRequest.Explain = t.request.GetExplain()
+	t.RetrieveRequest.Priority = t.request.GetPriority()
+	// empty if the authorization is disabled
+	t.RetrieveRequest.Username, _ = GetCurUserFromContext(ctx)
+	if t.sendStream != nil && t.streams != nil {
+		// the shard leaders push the results of the channels as they complete
+		t.RetrieveRequest.StreamResults = true
+		t.streams.register(t)
+	}
+	if t.request.GetExplain() {
+		// the row policies are hidden from the user
+		userPlan := plan
+		if expr != t.request.Expr {
+			if userPlan, err = planparserv2.CreateRetrievePlan(schema, t.request.Expr); err != nil {
+				return err
+			}
+			userPlan.OutputFieldIds = plan.OutputFieldIds
+		}
+		t.explainPlan = planparserv2.ShowPlan(userPlan)
 	}
 
-	err = validateTravelTimestamp(t.TravelTimestamp, t.BeginTs())
+	t.TravelTimestamp, err = parseTravelTimestamp(t.request.GetTravelTime(), t.request.TravelTimestamp)
 	if err != nil {
 		return err
 	}
+	if snapshot != nil {
+		// the data of the snapshot is pinned, it's never out of the retention
+		if t.TravelTimestamp != 0 {
+			return fmt.Errorf("travel timestamp can't be specified when querying snapshot %s", snapshotName)
+		}
+		t.TravelTimestamp = snapshot.GetSnapshotTs()
+	} else {
+		if t.TravelTimestamp == 0 {
+			t.TravelTimestamp = t.BeginTs()
+		}
+		retention, err := getTimeTravelRetention(ctx, collectionName)
+		if err != nil {
+			return err
+		}
+		err = validateTravelTimestamp(t.TravelTimestamp, t.BeginTs(), retention)
+		if err != nil {
+			return err
+		}
+	}
 
 	guaranteeTs := t.request.GetGuaranteeTimestamp()
 	t.GuaranteeTimestamp = parseGuaranteeTs(guaranteeTs, t.BeginTs())
@@ -232,6 +306,10 @@ func (t *queryTask) Execute(ctx context.Context) error {
 	}
 
 	err := executeQuery(WithCache)
+	if t.hasStreamed() && err != nil {
+		// the entities sent would be sent again by the retry
+		return fmt.Errorf("fail to query on all shard leaders after some results are sent, err=%s", err.Error())
+	}
 	if errors.Is(err, errInvalidShardLeaders) || funcutil.IsGrpcErr(err) || errors.Is(err, grpcclient.ErrConnect) {
 		log.Warn("invalid shard leaders cache, updating shardleader caches and retry search",
 			zap.Int64("msgID", t.ID()), zap.Error(err))
@@ -267,14 +345,35 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		}
 	}
 
+	if t.sendStream != nil {
+		// the results have been sent as the shards complete
+		t.result = &milvuspb.QueryResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			CollectionName: t.collectionName,
+		}
+		t.explain(0)
+		log.Info("Query PostExecute done", zap.Int64("msgID", t.ID()), zap.String("requestType", "query"), zap.Bool("stream", true))
+		return nil
+	}
+
 	metrics.ProxyDecodeResultLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.QueryLabel).Observe(0.0)
 	tr.Record("reduceResultStart")
+	if limit := t.RetrieveRequest.GetLimit(); limit > 0 {
+		t.toReduceResults, err = limitRetrieveResults(t.toReduceResults, limit)
+		if err != nil {
+			return err
+		}
+	}
 	t.result, err = mergeRetrieveResults(t.toReduceResults)
 	if err != nil {
 		return err
 	}
-	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.QueryLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
+	reduceDur := tr.RecordSpan()
+	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.QueryLabel).Observe(float64(reduceDur.Milliseconds()))
 	t.result.CollectionName = t.collectionName
+	t.explain(reduceDur)
 
 	if len(t.result.FieldsData) > 0 {
 		t.result.Status = &commonpb.Status{
@@ -289,23 +388,99 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		return nil
 	}
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.request.CollectionName)
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.collectionName)
 	if err != nil {
 		return err
 	}
-	for i := 0; i < len(t.result.FieldsData); i++ {
+	t.fillOutputFields(t.result.FieldsData, schema)
+	log.Info("Query PostExecute done", zap.Int64("msgID", t.ID()), zap.String("requestType", "query"))
+	return nil
+}
+
+// fillOutputFields fills the names and the types of the output fields
+func (t *queryTask) fillOutputFields(fieldsData []*schemapb.FieldData, schema *schemapb.CollectionSchema) {
+	for i := 0; i < len(fieldsData); i++ {
 		for _, field := range schema.Fields {
 			if field.FieldID == t.OutputFieldsId[i] {
-				t.result.FieldsData[i].FieldName = field.Name
-				t.result.FieldsData[i].FieldId = field.FieldID
-				t.result.FieldsData[i].Type = field.DataType
+				fieldsData[i].FieldName = field.Name
+				fieldsData[i].FieldId = field.FieldID
+				fieldsData[i].Type = field.DataType
 			}
 		}
 	}
-	log.Info("Query PostExecute done", zap.Int64("msgID", t.ID()), zap.String("requestType", "query"))
+}
+
+// hasStreamed returns whether any chunk of the streaming query is sent
+func (t *queryTask) hasStreamed() bool {
+	t.streamMu.Lock()
+	defer t.streamMu.Unlock()
+	return t.streamed
+}
+
+// streamRetrieveResult sends the entities of the result in chunks. It blocks until the chunks are sent,
+// which holds back the shard leaders pushing the results if the client falls behind.
+func (t *queryTask) streamRetrieveResult(ctx context.Context, result *internalpb.RetrieveResults) error {
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.collectionName)
+	if err != nil {
+		return err
+	}
+
+	t.streamMu.Lock()
+	defer t.streamMu.Unlock()
+
+	var chunk *milvuspb.QueryResults
+	var rows int64
+	send := func() error {
+		t.fillOutputFields(chunk.FieldsData, schema)
+		err := t.sendStream(chunk)
+		t.streamed = true
+		chunk, rows = nil, 0
+		if err != nil {
+			return fmt.Errorf("failed to send the query results: %w", err)
+		}
+		return nil
+	}
+	numPks := typeutil.GetSizeOfIDs(result.GetIds())
+	for i := 0; i < numPks; i++ {
+		if chunk == nil {
+			chunk = &milvuspb.QueryResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+				FieldsData:     make([]*schemapb.FieldData, len(result.GetFieldsData())),
+				CollectionName: t.collectionName,
+			}
+		}
+		typeutil.AppendFieldData(chunk.FieldsData, result.GetFieldsData(), int64(i))
+		rows++
+		if rows >= Params.ProxyCfg.StreamResultChunkRows {
+			if err := send(); err != nil {
+				return err
+			}
+		}
+	}
+	if chunk != nil {
+		return send()
+	}
 	return nil
 }
 
+// explain attaches the plan and the profiles of all shards to the result if it's required
+func (t *queryTask) explain(reduceDur time.Duration) {
+	if !t.request.GetExplain() {
+		return
+	}
+	shards := make([]*milvuspb.ShardProfile, 0, len(t.toReduceResults))
+	for _, res := range t.toReduceResults {
+		shards = append(shards, res.GetProfiles()...)
+	}
+	t.result.Explain = &milvuspb.QueryExplain{
+		Plan:          t.explainPlan,
+		Shards:        shards,
+		ProxyReduceUs: reduceDur.Microseconds(),
+	}
+}
+
 func (t *queryTask) queryShard(ctx context.Context, nodeID int64, qn types.QueryNode, channelIDs []string) error {
 	req := &querypb.QueryRequest{
 		Req:         t.RetrieveRequest,
@@ -330,6 +505,17 @@ func (t *queryTask) queryShard(ctx context.Context, nodeID int64, qn types.Query
 	}
 
 	log.Debug("get query result", zap.Int64("msgID", t.ID()), zap.Int64("nodeID", nodeID), zap.Strings("channelIDs", channelIDs))
+	if t.sendStream != nil {
+		if err := t.streamRetrieveResult(ctx, result); err != nil {
+			return err
+		}
+		// only the profiles are kept for the explain
+		result = &internalpb.RetrieveResults{
+			Base:     result.GetBase(),
+			Status:   result.GetStatus(),
+			Profiles: result.GetProfiles(),
+		}
+	}
 	t.resultBuf <- result
 	return nil
 }
@@ -347,6 +533,32 @@ func IDs2Expr(fieldName string, ids *schemapb.IDs) string {
 	return fieldName + " in [ " + idsStr + " ]"
 }
 
+// limitRetrieveResults keeps the entities with the smallest primary keys among the results of the shards
+func limitRetrieveResults(retrieveResults []*internalpb.RetrieveResults, limit int64) ([]*internalpb.RetrieveResults, error) {
+	ret := &internalpb.RetrieveResults{
+		Ids: &schemapb.IDs{},
+	}
+	for _, rr := range retrieveResults {
+		ret.Profiles = append(ret.Profiles, rr.GetProfiles()...)
+		numPks := typeutil.GetSizeOfIDs(rr.GetIds())
+		if numPks == 0 {
+			continue
+		}
+		if ret.FieldsData == nil {
+			ret.FieldsData = make([]*schemapb.FieldData, len(rr.GetFieldsData()))
+		}
+		if len(ret.FieldsData) != len(rr.GetFieldsData()) {
+			return nil, fmt.Errorf("mismatch FieldData in proxy RetrieveResults, expect %d get %d", len(ret.FieldsData), len(rr.FieldsData))
+		}
+		for i := 0; i < numPks; i++ {
+			typeutil.AppendPKs(ret.Ids, typeutil.GetPK(rr.GetIds(), int64(i)))
+			typeutil.AppendFieldData(ret.FieldsData, rr.GetFieldsData(), int64(i))
+		}
+	}
+	ret.Ids, ret.FieldsData = typeutil.LimitByPK(ret.Ids, ret.FieldsData, limit)
+	return []*internalpb.RetrieveResults{ret}, nil
+}
+
 func mergeRetrieveResults(retrieveResults []*internalpb.RetrieveResults) (*milvuspb.QueryResults, error) {
 	var ret *milvuspb.QueryResults
 	var skipDupCnt int64
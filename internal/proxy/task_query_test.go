@@ -196,3 +196,45 @@ func TestQueryTask_all(t *testing.T) {
 
 	assert.NoError(t, task.PostExecute(ctx))
 }
+
+func Test_limitRetrieveResults(t *testing.T) {
+	newResult := func(pks []int64, values []int64, profile string) *internalpb.RetrieveResults {
+		return &internalpb.RetrieveResults{
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}},
+			},
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_Int64,
+					FieldName: testInt64Field,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: values}},
+						},
+					},
+				},
+			},
+			Profiles: []*milvuspb.ShardProfile{{Channel: profile}},
+		}
+	}
+
+	results, err := limitRetrieveResults([]*internalpb.RetrieveResults{
+		newResult([]int64{4, 1}, []int64{40, 10}, "ch1"),
+		{Profiles: []*milvuspb.ShardProfile{{Channel: "ch2"}}},
+		newResult([]int64{3, 2}, []int64{30, 20}, "ch3"),
+	}, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(results))
+	assert.Equal(t, []int64{1, 2, 3}, results[0].GetIds().GetIntId().GetData())
+	assert.Equal(t, []int64{10, 20, 30}, results[0].GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, 3, len(results[0].GetProfiles()))
+
+	queryResults, err := mergeRetrieveResults(results)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{10, 20, 30}, queryResults.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+
+	mismatched := newResult([]int64{5}, []int64{50}, "ch4")
+	mismatched.FieldsData = append(mismatched.FieldsData, mismatched.FieldsData[0])
+	_, err = limitRetrieveResults([]*internalpb.RetrieveResults{newResult([]int64{1}, []int64{10}, "ch1"), mismatched}, 3)
+	assert.Error(t, err)
+}
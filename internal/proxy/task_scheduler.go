@@ -21,15 +21,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/opentracing/opentracing-go"
 	oplog "github.com/opentracing/opentracing-go/log"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type taskQueue interface {
@@ -47,6 +52,49 @@ type taskQueue interface {
 	getMaxTaskNum() int64
 }
 
+// prioritizedTask is the task ordered by its priority in the prioritized queues, the higher the earlier
+type prioritizedTask interface {
+	task
+	getPriority() int32
+}
+
+func getTaskPriority(t task) int32 {
+	if pt, ok := t.(prioritizedTask); ok {
+		return pt.getPriority()
+	}
+	return 0
+}
+
+// serverBusyError is returned if the task queue is full, the request could be retried after the backoff
+type serverBusyError struct {
+	retryAfter time.Duration
+}
+
+func newServerBusyError() *serverBusyError {
+	return &serverBusyError{retryAfter: Params.ProxyCfg.ServerBusyRetryAfter}
+}
+
+func (e *serverBusyError) Error() string {
+	return fmt.Sprintf("server is busy as the task queue is full, please retry after %v", e.retryAfter)
+}
+
+// enqueueFailedStatus returns the status of the request failing to be enqueued,
+// ServerBusy with the suggested backoff if the task queue is full
+func enqueueFailedStatus(err error) *commonpb.Status {
+	var busyErr *serverBusyError
+	if errors.As(err, &busyErr) {
+		return &commonpb.Status{
+			ErrorCode:    commonpb.ErrorCode_ServerBusy,
+			Reason:       err.Error(),
+			RetryAfterMs: busyErr.retryAfter.Milliseconds(),
+		}
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		Reason:    err.Error(),
+	}
+}
+
 // make sure baseTaskQueue implements taskQueue.
 var _ taskQueue = (*baseTaskQueue)(nil)
 
@@ -63,6 +111,9 @@ type baseTaskQueue struct {
 
 	utBufChan chan int // to block scheduler
 
+	// whether the unissued tasks are ordered by priority, FIFO in the same priority
+	prioritized bool
+
 	tsoAllocatorIns tsoAllocator
 	idAllocatorIns  idAllocatorInterface
 }
@@ -86,13 +137,30 @@ func (queue *baseTaskQueue) addUnissuedTask(t task) error {
 	defer queue.utLock.Unlock()
 
 	if queue.utFull() {
-		return errors.New("task queue is full")
+		metrics.ProxyRejectedTaskCount.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), t.Name()).Inc()
+		return newServerBusyError()
+	}
+	if queue.prioritized {
+		queue.insertByPriority(t)
+	} else {
+		queue.unissuedTasks.PushBack(t)
 	}
-	queue.unissuedTasks.PushBack(t)
 	queue.utBufChan <- 1
 	return nil
 }
 
+// insertByPriority inserts the task after the tasks of the same or higher priorities, utLock must be held
+func (queue *baseTaskQueue) insertByPriority(t task) {
+	priority := getTaskPriority(t)
+	for e := queue.unissuedTasks.Back(); e != nil; e = e.Prev() {
+		if getTaskPriority(e.Value.(task)) >= priority {
+			queue.unissuedTasks.InsertAfter(t, e)
+			return
+		}
+	}
+	queue.unissuedTasks.PushFront(t)
+}
+
 func (queue *baseTaskQueue) FrontUnissuedTask() task {
 	queue.utLock.RLock()
 	defer queue.utLock.RUnlock()
@@ -164,6 +232,12 @@ func (queue *baseTaskQueue) getTaskByReqID(reqID UniqueID) task {
 	return nil
 }
 
+// rangeTsTask is implemented by the tasks which may need different begin and end timestamps
+type rangeTsTask interface {
+	rangeTs() bool
+	SetEndTs(ts Timestamp)
+}
+
 func (queue *baseTaskQueue) Enqueue(t task) error {
 	err := t.OnEnqueue()
 	if err != nil {
@@ -175,6 +249,14 @@ func (queue *baseTaskQueue) Enqueue(t task) error {
 		return err
 	}
 	t.SetTs(ts)
+	// tasks spanning two timestamps get a later end timestamp
+	if rt, ok := t.(rangeTsTask); ok && rt.rangeTs() {
+		endTs, err := queue.tsoAllocatorIns.AllocOne()
+		if err != nil {
+			return err
+		}
+		rt.SetEndTs(endTs)
+	}
 
 	reqID, err := queue.idAllocatorIns.AllocOne()
 	if err != nil {
@@ -363,9 +445,11 @@ func newDmTaskQueue(tsoAllocatorIns tsoAllocator, idAllocatorIns idAllocatorInte
 }
 
 func newDqTaskQueue(tsoAllocatorIns tsoAllocator, idAllocatorIns idAllocatorInterface) *dqTaskQueue {
-	return &dqTaskQueue{
+	queue := &dqTaskQueue{
 		baseTaskQueue: newBaseTaskQueue(tsoAllocatorIns, idAllocatorIns),
 	}
+	queue.prioritized = true
+	return queue
 }
 
 // taskScheduler schedules the gRPC tasks.
@@ -374,6 +458,9 @@ type taskScheduler struct {
 	dmQueue *dmTaskQueue
 	dqQueue *dqTaskQueue
 
+	// limits the concurrency of the dq tasks, nil if unlimited
+	readSlots chan struct{}
+
 	wg     sync.WaitGroup
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -398,6 +485,9 @@ func newTaskScheduler(ctx context.Context,
 	s.ddQueue = newDdTaskQueue(tsoAllocatorIns, idAllocatorIns)
 	s.dmQueue = newDmTaskQueue(tsoAllocatorIns, idAllocatorIns)
 	s.dqQueue = newDqTaskQueue(tsoAllocatorIns, idAllocatorIns)
+	if Params.ProxyCfg.MaxReadConcurrency > 0 {
+		s.readSlots = make(chan struct{}, Params.ProxyCfg.MaxReadConcurrency)
+	}
 
 	for _, opt := range opts {
 		opt(s)
@@ -440,6 +530,12 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 	defer span.Finish()
 	traceID, _, _ := trace.InfoFromSpan(span)
 
+	ctx, otelSpan := trace.StartOtelSpan(ctx, "Proxy-"+t.Name(), attribute.Int64("taskID", t.ID()))
+	var err error
+	defer func() {
+		trace.EndOtelSpan(otelSpan, err)
+	}()
+
 	span.LogFields(oplog.Int64("scheduler process AddActiveTask", t.ID()))
 	q.AddActiveTask(t)
 
@@ -447,13 +543,20 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 		span.LogFields(oplog.Int64("scheduler process PopActiveTask", t.ID()))
 		q.PopActiveTask(t.ID())
 	}()
-	span.LogFields(oplog.Int64("scheduler process PreExecute", t.ID()))
-
-	err := t.PreExecute(ctx)
-
 	defer func() {
 		t.Notify(err)
 	}()
+
+	// the client has given up, don't waste the resources
+	if err = t.TraceCtx().Err(); err != nil {
+		metrics.ProxyDroppedTaskCount.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), t.Name()).Inc()
+		log.Warn("Drop the task as its context is done before execution", zap.String("task", t.Name()),
+			zap.Int64("taskID", t.ID()), zap.Error(err), zap.String("traceID", traceID))
+		return
+	}
+
+	span.LogFields(oplog.Int64("scheduler process PreExecute", t.ID()))
+	err = runTaskStep(ctx, "PreExecute", t.PreExecute)
 	if err != nil {
 		trace.LogError(span, err)
 		log.Error("Failed to pre-execute task: "+err.Error(),
@@ -462,7 +565,7 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 	}
 
 	span.LogFields(oplog.Int64("scheduler process Execute", t.ID()))
-	err = t.Execute(ctx)
+	err = runTaskStep(ctx, "Execute", t.Execute)
 	if err != nil {
 		trace.LogError(span, err)
 		log.Error("Failed to execute task: "+err.Error(),
@@ -471,7 +574,7 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 	}
 
 	span.LogFields(oplog.Int64("scheduler process PostExecute", t.ID()))
-	err = t.PostExecute(ctx)
+	err = runTaskStep(ctx, "PostExecute", t.PostExecute)
 
 	if err != nil {
 		trace.LogError(span, err)
@@ -481,6 +584,14 @@ func (sched *taskScheduler) processTask(t task, q taskQueue) {
 	}
 }
 
+// runTaskStep runs a step of the task in an OpenTelemetry span
+func runTaskStep(ctx context.Context, name string, step func(context.Context) error) error {
+	ctx, span := trace.StartOtelSpan(ctx, name)
+	err := step(ctx)
+	trace.EndOtelSpan(span, err)
+	return err
+}
+
 // definitionLoop schedules the ddl tasks.
 func (sched *taskScheduler) definitionLoop() {
 	defer sched.wg.Done()
@@ -521,8 +632,15 @@ func (sched *taskScheduler) queryLoop() {
 			return
 		case <-sched.dqQueue.utChan():
 			if !sched.dqQueue.utEmpty() {
+				// the tasks keep queued by priority until a slot is free
+				if !sched.acquireReadSlot() {
+					return
+				}
 				t := sched.scheduleDqTask()
-				go sched.processTask(t, sched.dqQueue)
+				go func() {
+					defer sched.releaseReadSlot()
+					sched.processTask(t, sched.dqQueue)
+				}()
 			} else {
 				log.Debug("query queue is empty ...")
 			}
@@ -530,6 +648,25 @@ func (sched *taskScheduler) queryLoop() {
 	}
 }
 
+// acquireReadSlot waits for a free slot of the dq tasks, it returns false if the scheduler is closed
+func (sched *taskScheduler) acquireReadSlot() bool {
+	if sched.readSlots == nil {
+		return true
+	}
+	select {
+	case sched.readSlots <- struct{}{}:
+		return true
+	case <-sched.ctx.Done():
+		return false
+	}
+}
+
+func (sched *taskScheduler) releaseReadSlot() {
+	if sched.readSlots != nil {
+		<-sched.readSlots
+	}
+}
+
 func (sched *taskScheduler) Start() error {
 	sched.wg.Add(1)
 	go sched.definitionLoop()
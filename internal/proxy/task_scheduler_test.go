@@ -18,11 +18,15 @@ package proxy
 
 import (
 	"context"
+	"errors"
 	"math/rand"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 )
 
 func TestBaseTaskQueue(t *testing.T) {
@@ -383,6 +387,88 @@ func TestDqTaskQueue(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+type mockPrioritizedDqlTask struct {
+	*mockTask
+	priority int32
+}
+
+func (m *mockPrioritizedDqlTask) getPriority() int32 {
+	return m.priority
+}
+
+func newMockPrioritizedDqlTask(priority int32) *mockPrioritizedDqlTask {
+	return &mockPrioritizedDqlTask{
+		mockTask: newDefaultMockDqlTask().mockTask,
+		priority: priority,
+	}
+}
+
+func TestDqTaskQueue_Priority(t *testing.T) {
+	Params.Init()
+
+	queue := newDqTaskQueue(newMockTsoAllocator(), newMockIDAllocatorInterface())
+
+	tasks := []*mockPrioritizedDqlTask{
+		newMockPrioritizedDqlTask(0),
+		newMockPrioritizedDqlTask(1),
+		newMockPrioritizedDqlTask(0),
+		newMockPrioritizedDqlTask(2),
+		newMockPrioritizedDqlTask(1),
+	}
+	for _, st := range tasks {
+		err := queue.Enqueue(st)
+		assert.NoError(t, err)
+	}
+
+	// higher priorities first, FIFO in the same priority
+	expected := []*mockPrioritizedDqlTask{tasks[3], tasks[1], tasks[4], tasks[0], tasks[2]}
+	for _, st := range expected {
+		assert.Equal(t, st.ID(), queue.PopUnissuedTask().ID())
+	}
+	assert.True(t, queue.utEmpty())
+}
+
+func TestTaskQueue_ServerBusy(t *testing.T) {
+	Params.Init()
+
+	queue := newDqTaskQueue(newMockTsoAllocator(), newMockIDAllocatorInterface())
+	queue.setMaxTaskNum(1)
+	err := queue.Enqueue(newDefaultMockDqlTask())
+	assert.NoError(t, err)
+
+	err = queue.Enqueue(newDefaultMockDqlTask())
+	assert.Error(t, err)
+	status := enqueueFailedStatus(err)
+	assert.Equal(t, commonpb.ErrorCode_ServerBusy, status.GetErrorCode())
+	assert.Equal(t, Params.ProxyCfg.ServerBusyRetryAfter.Milliseconds(), status.GetRetryAfterMs())
+
+	status = enqueueFailedStatus(errors.New("mock"))
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	assert.Equal(t, int64(0), status.GetRetryAfterMs())
+}
+
+func TestTaskScheduler_DropExpiredTask(t *testing.T) {
+	Params.Init()
+
+	ctx := context.Background()
+	sched, err := newTaskScheduler(ctx, newMockIDAllocatorInterface(), newMockTsoAllocator(), newSimpleMockMsgStreamFactory())
+	assert.NoError(t, err)
+
+	taskCtx, cancel := context.WithTimeout(ctx, time.Millisecond)
+	defer cancel()
+	<-taskCtx.Done()
+
+	st := newMockDqlTask(taskCtx)
+	err = sched.dqQueue.Enqueue(st)
+	assert.NoError(t, err)
+	sched.processTask(sched.scheduleDqTask(), sched.dqQueue)
+
+	// WaitToFinish returns once the context is done, check the notified error instead
+	err = <-st.done
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, sched.dqQueue.getTaskByReqID(st.ID()))
+}
+
 func TestTaskScheduler(t *testing.T) {
 	Params.Init()
 
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 
@@ -25,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/planpb"
@@ -40,6 +43,7 @@ type searchTask struct {
 	result         *milvuspb.SearchResults
 	request        *milvuspb.SearchRequest
 	qc             types.QueryCoord
+	dc             types.DataCoord // resolves the snapshot of `collection@snapshot`
 	tr             *timerecord.TimeRecorder
 	collectionName string
 	schema         *schemapb.CollectionSchema
@@ -49,6 +53,26 @@ type searchTask struct {
 
 	searchShardPolicy pickShardPolicy
 	shardMgr          *shardClientMgr
+
+	groupBy *groupByInfo
+
+	explainPlan string // readable plan returned to user if explain is required
+
+	// sends the chunks of the streaming search, nil if the results are returned at once
+	sendStream func(*milvuspb.SearchResults) error
+	// routes the results pushed by the shard leaders to the streaming search
+	streams  *resultStreams
+	pushedMu sync.Mutex
+	// the results pushed by the shard leaders without the hits, which are merged into pushedData as they arrive
+	pushedResult []*internalpb.SearchResults
+	pushedData   *schemapb.SearchResultData
+}
+
+// groupByInfo describes how search hits are grouped by the value of a scalar output field
+type groupByInfo struct {
+	fieldOffset int   // offset of the group-by field in SearchResultData.FieldsData
+	groupSize   int64 // max number of hits returned for each distinct group value
+	groupLimit  int64 // max number of distinct group values returned for each query
 }
 
 func getPartitionIDs(ctx context.Context, collectionName string, partitionNames []string) (partitionIDs []UniqueID, err error) {
@@ -129,13 +153,47 @@ func parseQueryInfo(searchParamsPair []*commonpb.KeyValuePair) (*planpb.QueryInf
 	}, nil
 }
 
+// parseGroupByInfo returns the id of the field to group search results by and the group size,
+// zero field id is returned if group by is not requested
+func parseGroupByInfo(schema *schemapb.CollectionSchema, searchParamsPair []*commonpb.KeyValuePair) (int64, int64, error) {
+	groupByFieldName, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldKey, searchParamsPair)
+	if err != nil || groupByFieldName == "" {
+		return 0, 0, nil
+	}
+
+	var groupByField *schemapb.FieldSchema
+	for _, field := range schema.GetFields() {
+		if field.GetName() == groupByFieldName {
+			groupByField = field
+			break
+		}
+	}
+	if groupByField == nil {
+		return 0, 0, fmt.Errorf("group by field %s not exist", groupByFieldName)
+	}
+	dataType := groupByField.GetDataType()
+	if !typeutil.IsIntegerType(dataType) && !typeutil.IsBoolType(dataType) && !typeutil.IsStringType(dataType) {
+		return 0, 0, fmt.Errorf("group by field %s of type %s is not supported", groupByFieldName, dataType.String())
+	}
+
+	groupSize := int64(1)
+	groupSizeStr, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupSizeKey, searchParamsPair)
+	if err == nil {
+		groupSize, err = strconv.ParseInt(groupSizeStr, 10, 64)
+		if err != nil || groupSize <= 0 {
+			return 0, 0, errors.New(GroupSizeKey + " " + groupSizeStr + " is not invalid")
+		}
+	}
+	return groupByField.GetFieldID(), groupSize, nil
+}
+
 func getOutputFieldIDs(schema *schemapb.CollectionSchema, outputFields []string) (outputFieldIDs []UniqueID, err error) {
 	outputFieldIDs = make([]UniqueID, 0, len(outputFields))
 	for _, name := range outputFields {
 		hitField := false
 		for _, field := range schema.GetFields() {
 			if field.Name == name {
-				if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
+				if typeutil.IsVectorType(field.DataType) {
 					return nil, errors.New("search doesn't support vector field as output_fields")
 				}
 				outputFieldIDs = append(outputFieldIDs, field.GetFieldID())
@@ -169,6 +227,11 @@ func getNq(req *milvuspb.SearchRequest) (int64, error) {
 	return req.GetNq(), nil
 }
 
+// getPriority returns the priority of the request, the higher the earlier it's scheduled
+func (t *searchTask) getPriority() int32 {
+	return t.request.GetPriority()
+}
+
 func (t *searchTask) PreExecute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(t.TraceCtx(), "Proxy-Search-PreExecute")
 	defer sp.Finish()
@@ -180,12 +243,18 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	t.Base.MsgType = commonpb.MsgType_Search
 	t.Base.SourceID = Params.ProxyCfg.GetNodeID()
 
-	collectionName := t.request.CollectionName
+	collectionName, snapshotName := splitSnapshotName(t.request.CollectionName)
 	t.collectionName = collectionName
 	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
+	var snapshot *datapb.SnapshotInfo
+	if snapshotName != "" {
+		if snapshot, err = getSnapshot(ctx, t.dc, collectionName, collID, snapshotName); err != nil {
+			return err
+		}
+	}
 
 	t.SearchRequest.DbID = 0 // todo
 	t.SearchRequest.CollectionID = collID
@@ -224,7 +293,31 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 			return err
 		}
 
-		plan, err := planparserv2.CreateSearchPlan(t.schema, t.request.Dsl, annsField, queryInfo)
+		groupByFieldID, groupSize, err := parseGroupByInfo(t.schema, t.request.GetSearchParams())
+		if err != nil {
+			return err
+		}
+		if groupByFieldID > 0 {
+			if err := validateTopK(queryInfo.GetTopk()); err != nil {
+				return err
+			}
+			// search topk * group_size candidates so that enough groups are left after grouping
+			groupLimit := queryInfo.GetTopk()
+			queryInfo.Topk = groupLimit * groupSize
+			queryInfo.GroupByFieldId = groupByFieldID
+			queryInfo.GroupSize = groupSize
+			if err := validateTopK(queryInfo.GetTopk()); err != nil {
+				return fmt.Errorf("invalid limit %d with %s %d: %w", groupLimit, GroupSizeKey, groupSize, err)
+			}
+			t.groupBy = &groupByInfo{groupSize: groupSize, groupLimit: groupLimit}
+		}
+
+		policyExpr, err := getRowPolicyExpr(ctx, collectionName)
+		if err != nil {
+			return err
+		}
+		dsl := mergeRowPolicyExpr(t.request.Dsl, policyExpr)
+		plan, err := planparserv2.CreateSearchPlan(t.schema, dsl, annsField, queryInfo)
 		if err != nil {
 			log.Debug("failed to create query plan", zap.Error(err), zap.Int64("msgID", t.ID()),
 				zap.String("dsl", t.request.Dsl), // may be very large if large term passed.
@@ -239,8 +332,24 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		if t.groupBy != nil {
+			// group by field is retrieved as an extra output field if not requested
+			t.groupBy.fieldOffset = len(outputFieldIDs)
+			for offset, fieldID := range outputFieldIDs {
+				if fieldID == groupByFieldID {
+					t.groupBy.fieldOffset = offset
+					break
+				}
+			}
+			if t.groupBy.fieldOffset == len(outputFieldIDs) {
+				outputFieldIDs = append(outputFieldIDs, groupByFieldID)
+			}
+			t.SearchRequest.GroupByFieldId = groupByFieldID
+			t.SearchRequest.GroupSize = groupSize
+		}
 		t.SearchRequest.OutputFieldsId = outputFieldIDs
 		plan.OutputFieldIds = outputFieldIDs
+		t.SearchRequest.PrunedSegmentIDs = pruneByClusteringKey(ctx, t.dc, collID, t.schema, plan)
 
 		t.SearchRequest.MetricType = queryInfo.GetMetricType()
 		t.SearchRequest.DslType = commonpb.DslType_BoolExprV1
@@ -256,16 +365,51 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		log.Debug("Proxy::searchTask::PreExecute", zap.Int64("msgID", t.ID()),
 			zap.Int64s("plan.OutputFieldIds", plan.GetOutputFieldIds()),
 			zap.String("plan", plan.String())) // may be very large if large term passed.
+		if t.request.GetExplain() {
+			// the row policies are hidden from the user
+			userPlan := plan
+			if dsl != t.request.Dsl {
+				if userPlan, err = planparserv2.CreateSearchPlan(t.schema, t.request.Dsl, annsField, queryInfo); err != nil {
+					return err
+				}
+				userPlan.OutputFieldIds = plan.OutputFieldIds
+			}
+			t.explainPlan = planparserv2.ShowPlan(userPlan)
+		}
 	}
-
-	travelTimestamp := t.request.TravelTimestamp
-	if travelTimestamp == 0 {
-		travelTimestamp = typeutil.MaxTimestamp
+	t.SearchRequest.Explain = t.request.GetExplain()
+	t.SearchRequest.Priority = t.request.GetPriority()
+	// empty if the authorization is disabled
+	t.SearchRequest.Username, _ = GetCurUserFromContext(ctx)
+	if t.sendStream != nil && t.streams != nil {
+		// the shard leaders push the results of the channels as they complete
+		t.SearchRequest.StreamResults = true
+		t.streams.register(t)
 	}
-	err = validateTravelTimestamp(travelTimestamp, t.BeginTs())
+
+	travelTimestamp, err := parseTravelTimestamp(t.request.GetTravelTime(), t.request.TravelTimestamp)
 	if err != nil {
 		return err
 	}
+	if snapshot != nil {
+		// the data of the snapshot is pinned, it's never out of the retention
+		if travelTimestamp != 0 {
+			return fmt.Errorf("travel timestamp can't be specified when searching snapshot %s", snapshotName)
+		}
+		travelTimestamp = snapshot.GetSnapshotTs()
+	} else {
+		if travelTimestamp == 0 {
+			travelTimestamp = typeutil.MaxTimestamp
+		}
+		retention, err := getTimeTravelRetention(ctx, collectionName)
+		if err != nil {
+			return err
+		}
+		err = validateTravelTimestamp(travelTimestamp, t.BeginTs(), retention)
+		if err != nil {
+			return err
+		}
+	}
 	t.SearchRequest.TravelTimestamp = travelTimestamp
 
 	guaranteeTs := t.request.GetGuaranteeTimestamp()
@@ -303,6 +447,10 @@ func (t *searchTask) Execute(ctx context.Context) error {
 		}
 		t.resultBuf = make(chan *internalpb.SearchResults, len(shard2Leaders))
 		t.toReduceResults = make([]*internalpb.SearchResults, 0, len(shard2Leaders))
+		t.pushedMu.Lock()
+		t.pushedResult = nil
+		t.pushedData = nil
+		t.pushedMu.Unlock()
 		if err := t.searchShardPolicy(ctx, t.shardMgr, t.searchShard, shard2Leaders); err != nil {
 			log.Warn("failed to do search", zap.Error(err), zap.String("Shards", fmt.Sprintf("%v", shard2Leaders)))
 			return err
@@ -350,6 +498,12 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	t.pushedMu.Lock()
+	t.toReduceResults = append(t.toReduceResults, t.pushedResult...)
+	if t.pushedData != nil {
+		validSearchResults = append(validSearchResults, t.pushedData)
+	}
+	t.pushedMu.Unlock()
 	metrics.ProxyDecodeResultLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10),
 		metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
@@ -372,6 +526,7 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 				Topks:      make([]int64, t.toReduceResults[0].NumQueries),
 			}
 		}
+		t.explain(0)
 		return nil
 	}
 
@@ -380,15 +535,32 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	t.result, err = reduceSearchResultData(validSearchResults, t.toReduceResults[0].NumQueries, t.toReduceResults[0].TopK, t.toReduceResults[0].MetricType, primaryFieldSchema.DataType)
+	if t.sendStream != nil {
+		return t.streamSearchResults(ctx, validSearchResults, primaryFieldSchema.DataType)
+	}
+	t.result, err = reduceSearchResultData(validSearchResults, t.toReduceResults[0].NumQueries, t.toReduceResults[0].TopK, t.toReduceResults[0].MetricType, primaryFieldSchema.DataType, t.groupBy)
 	if err != nil {
 		return err
 	}
+	if t.groupBy != nil {
+		t.fillGroupByFieldValue()
+	}
 
-	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
+	reduceDur := tr.RecordSpan()
+	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.SearchLabel).Observe(float64(reduceDur.Milliseconds()))
 	t.result.CollectionName = t.collectionName
+	t.explain(reduceDur)
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.request.CollectionName)
+	if err := t.fillOutputFields(ctx); err != nil {
+		return err
+	}
+	log.Info("Search post execute done", zap.Int64("msgID", t.ID()))
+	return nil
+}
+
+// fillOutputFields fills the names and the types of the output fields of the result
+func (t *searchTask) fillOutputFields(ctx context.Context) error {
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.collectionName)
 	if err != nil {
 		return err
 	}
@@ -403,10 +575,123 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 			}
 		}
 	}
-	log.Info("Search post execute done", zap.Int64("msgID", t.ID()))
 	return nil
 }
 
+// streamSearchResults reduces and sends the results in chunks of queries in order, so that the whole result
+// is never buffered, the result left is the status and the explain sent as the last chunk.
+func (t *searchTask) streamSearchResults(ctx context.Context, data []*schemapb.SearchResultData, pkType schemapb.DataType) error {
+	nq := t.toReduceResults[0].GetNumQueries()
+	topk := t.toReduceResults[0].GetTopK()
+	chunkNq := int64(1)
+	if topk > 0 && Params.ProxyCfg.StreamResultChunkRows > topk {
+		chunkNq = Params.ProxyCfg.StreamResultChunkRows / topk
+	}
+
+	var reduceDur time.Duration
+	for _, chunk := range splitRows(nq, chunkNq) {
+		tr := timerecord.NewTimeRecorder("reduceSearchResultChunk")
+		var err error
+		t.result, err = reduceSearchResultDataOfQueries(data, nq, chunk[0], chunk[1], topk, t.toReduceResults[0].GetMetricType(), pkType, t.groupBy)
+		if err != nil {
+			return err
+		}
+		if t.groupBy != nil {
+			t.fillGroupByFieldValue()
+		}
+		reduceDur += tr.ElapseSpan()
+		t.result.CollectionName = t.collectionName
+		t.result.NqOffset = chunk[0]
+		if err := t.fillOutputFields(ctx); err != nil {
+			return err
+		}
+		if err := t.sendStream(t.result); err != nil {
+			return fmt.Errorf("failed to send the search results: %w", err)
+		}
+	}
+	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(Params.ProxyCfg.GetNodeID(), 10), metrics.SearchLabel).Observe(float64(reduceDur.Milliseconds()))
+
+	t.result = &milvuspb.SearchResults{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		CollectionName: t.collectionName,
+		NqOffset:       nq,
+	}
+	t.explain(reduceDur)
+	log.Info("Search post execute done", zap.Int64("msgID", t.ID()), zap.Bool("stream", true))
+	return nil
+}
+
+// pushSearchResult merges the partial result pushed by the shard leader into the results pushed before,
+// so that the hits of the shards are reduced as they complete instead of being buffered to the end
+func (t *searchTask) pushSearchResult(result *internalpb.SearchResults) error {
+	data, err := decodeSearchResults([]*internalpb.SearchResults{result})
+	if err != nil {
+		return err
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(t.schema)
+	if err != nil && len(data) > 0 {
+		return err
+	}
+
+	t.pushedMu.Lock()
+	defer t.pushedMu.Unlock()
+	for _, d := range data {
+		merged, err := mergePushedSearchResultData(t.pushedData, d, result.GetNumQueries(), result.GetTopK(), pkField.GetDataType(), t.groupBy)
+		if err != nil {
+			return err
+		}
+		t.pushedData = merged
+	}
+	// only the profiles and the sizes are kept for the explain and the reduce
+	t.pushedResult = append(t.pushedResult, &internalpb.SearchResults{
+		Base:       result.GetBase(),
+		Status:     result.GetStatus(),
+		MetricType: result.GetMetricType(),
+		NumQueries: result.GetNumQueries(),
+		TopK:       result.GetTopK(),
+		Profiles:   result.GetProfiles(),
+	})
+	return nil
+}
+
+// explain attaches the plan and the profiles of all shards to the result if it's required
+func (t *searchTask) explain(reduceDur time.Duration) {
+	if !t.request.GetExplain() {
+		return
+	}
+	shards := make([]*milvuspb.ShardProfile, 0, len(t.toReduceResults))
+	for _, res := range t.toReduceResults {
+		shards = append(shards, res.GetProfiles()...)
+	}
+	t.result.Explain = &milvuspb.QueryExplain{
+		Plan:          t.explainPlan,
+		Shards:        shards,
+		ProxyReduceUs: reduceDur.Microseconds(),
+	}
+}
+
+// fillGroupByFieldValue moves the group by field out of the output fields if it's not requested by user
+func (t *searchTask) fillGroupByFieldValue() {
+	fieldsData := t.result.GetResults().GetFieldsData()
+	if t.groupBy.fieldOffset >= len(fieldsData) {
+		return
+	}
+	groupByFieldValue := proto.Clone(fieldsData[t.groupBy.fieldOffset]).(*schemapb.FieldData)
+	for _, field := range t.schema.GetFields() {
+		if field.GetFieldID() == t.SearchRequest.GetGroupByFieldId() {
+			groupByFieldValue.FieldName = field.GetName()
+			groupByFieldValue.FieldId = field.GetFieldID()
+			groupByFieldValue.Type = field.GetDataType()
+		}
+	}
+	t.result.Results.GroupByFieldValue = groupByFieldValue
+	if t.groupBy.fieldOffset >= len(t.request.GetOutputFields()) {
+		t.result.Results.FieldsData = fieldsData[:t.groupBy.fieldOffset]
+	}
+}
+
 func (t *searchTask) searchShard(ctx context.Context, nodeID int64, qn types.QueryNode, channelIDs []string) error {
 	req := &querypb.SearchRequest{
 		Req:         t.SearchRequest,
@@ -544,7 +829,14 @@ func selectSearchResultData(dataArray []*schemapb.SearchResultData, resultOffset
 	return sel
 }
 
-func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType) (*milvuspb.SearchResults, error) {
+// reduceSearchResultData merges search results by score and removes duplicated primary keys,
+// if groupBy is not nil, at most groupBy.groupLimit groups and groupBy.groupSize hits of each group are returned
+func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType, groupBy *groupByInfo) (*milvuspb.SearchResults, error) {
+	return reduceSearchResultDataOfQueries(searchResultData, nq, 0, nq, topk, metricType, pkType, groupBy)
+}
+
+// reduceSearchResultDataOfQueries is like reduceSearchResultData, but only the queries in [nqStart, nqEnd) are reduced
+func reduceSearchResultDataOfQueries(searchResultData []*schemapb.SearchResultData, nq int64, nqStart int64, nqEnd int64, topk int64, metricType string, pkType schemapb.DataType, groupBy *groupByInfo) (*milvuspb.SearchResults, error) {
 
 	tr := timerecord.NewTimeRecorder("reduceSearchResultData")
 	defer func() {
@@ -558,31 +850,12 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 		Status: &commonpb.Status{
 			ErrorCode: 0,
 		},
-		Results: &schemapb.SearchResultData{
-			NumQueries: nq,
-			TopK:       topk,
-			FieldsData: make([]*schemapb.FieldData, len(searchResultData[0].FieldsData)),
-			Scores:     make([]float32, 0),
-			Ids:        &schemapb.IDs{},
-			Topks:      make([]int64, 0),
-		},
 	}
-	switch pkType {
-	case schemapb.DataType_Int64:
-		ret.GetResults().Ids.IdField = &schemapb.IDs_IntId{
-			IntId: &schemapb.LongArray{
-				Data: make([]int64, 0),
-			},
-		}
-	case schemapb.DataType_VarChar:
-		ret.GetResults().Ids.IdField = &schemapb.IDs_StrId{
-			StrId: &schemapb.StringArray{
-				Data: make([]string, 0),
-			},
-		}
-	default:
-		return nil, errors.New("unsupported pk type")
+	results, err := newSearchResultData(nqEnd-nqStart, topk, len(searchResultData[0].FieldsData), pkType)
+	if err != nil {
+		return nil, err
 	}
+	ret.Results = results
 
 	for i, sData := range searchResultData {
 		log.Debug("reduceSearchResultData",
@@ -598,6 +871,51 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 		//printSearchResultData(sData, strconv.FormatInt(int64(i), 10))
 	}
 
+	if err := mergeSearchResultData(searchResultData, ret.Results, nq, nqStart, nqEnd, topk, groupBy); err != nil {
+		return ret, err
+	}
+
+	if !distance.PositivelyRelated(metricType) {
+		for k := range ret.Results.Scores {
+			ret.Results.Scores[k] *= -1
+		}
+	}
+	// printSearchResultData(ret.Results, "proxy reduce result")
+	return ret, nil
+}
+
+// newSearchResultData returns an empty search result to merge the hits into
+func newSearchResultData(nq int64, topk int64, numFields int, pkType schemapb.DataType) (*schemapb.SearchResultData, error) {
+	data := &schemapb.SearchResultData{
+		NumQueries: nq,
+		TopK:       topk,
+		FieldsData: make([]*schemapb.FieldData, numFields),
+		Scores:     make([]float32, 0),
+		Ids:        &schemapb.IDs{},
+		Topks:      make([]int64, 0),
+	}
+	switch pkType {
+	case schemapb.DataType_Int64:
+		data.Ids.IdField = &schemapb.IDs_IntId{
+			IntId: &schemapb.LongArray{
+				Data: make([]int64, 0),
+			},
+		}
+	case schemapb.DataType_VarChar:
+		data.Ids.IdField = &schemapb.IDs_StrId{
+			StrId: &schemapb.StringArray{
+				Data: make([]string, 0),
+			},
+		}
+	default:
+		return nil, errors.New("unsupported pk type")
+	}
+	return data, nil
+}
+
+// mergeSearchResultData merges the hits of the queries in [nqStart, nqEnd) into ret by score,
+// the duplicated primary keys are removed and the hits are grouped if groupBy is not nil
+func mergeSearchResultData(searchResultData []*schemapb.SearchResultData, ret *schemapb.SearchResultData, nq int64, nqStart int64, nqEnd int64, topk int64, groupBy *groupByInfo) error {
 	resultOffsets := make([][]int64, len(searchResultData))
 	for i := 0; i < len(searchResultData); i++ {
 		resultOffsets[i] = make([]int64, len(searchResultData[i].Topks))
@@ -607,11 +925,13 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 	}
 
 	var skipDupCnt int64
+	var skipGroupCnt int64
 	var realTopK int64 = -1
-	for i := int64(0); i < nq; i++ {
+	for i := nqStart; i < nqEnd; i++ {
 		offsets := make([]int64, len(searchResultData))
 
 		var idSet = make(map[interface{}]struct{})
+		var groupCount = make(map[interface{}]int64)
 		var j int64
 		for j = 0; j < topk; {
 			sel := selectSearchResultData(searchResultData, resultOffsets, offsets, i)
@@ -623,11 +943,31 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 			id := typeutil.GetPK(searchResultData[sel].GetIds(), idx)
 			score := searchResultData[sel].Scores[idx]
 
+			if groupBy != nil {
+				if groupBy.fieldOffset >= len(searchResultData[sel].GetFieldsData()) {
+					return fmt.Errorf("group by field not found in search result, offset = %d", groupBy.fieldOffset)
+				}
+				groupValue := typeutil.GetScalarFieldValue(searchResultData[sel].GetFieldsData()[groupBy.fieldOffset], idx)
+				if groupValue == nil {
+					return fmt.Errorf("invalid group by value at offset %d", idx)
+				}
+				cnt, exist := groupCount[groupValue]
+				if (exist && cnt >= groupBy.groupSize) || (!exist && int64(len(groupCount)) >= groupBy.groupLimit) {
+					// skip entity whose group is full or exceeds the group limit
+					skipGroupCnt++
+					offsets[sel]++
+					continue
+				}
+				if _, ok := idSet[id]; !ok {
+					groupCount[groupValue] = cnt + 1
+				}
+			}
+
 			// remove duplicates
 			if _, ok := idSet[id]; !ok {
-				typeutil.AppendFieldData(ret.Results.FieldsData, searchResultData[sel].FieldsData, idx)
-				typeutil.AppendPKs(ret.Results.Ids, id)
-				ret.Results.Scores = append(ret.Results.Scores, score)
+				typeutil.AppendFieldData(ret.FieldsData, searchResultData[sel].FieldsData, idx)
+				typeutil.AppendPKs(ret.Ids, id)
+				ret.Scores = append(ret.Scores, score)
 				idSet[id] = struct{}{}
 				j++
 			} else {
@@ -641,17 +981,35 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 			// return nil, errors.New("the length (topk) between all result of query is different")
 		}
 		realTopK = j
-		ret.Results.Topks = append(ret.Results.Topks, realTopK)
+		ret.Topks = append(ret.Topks, realTopK)
 	}
 	log.Debug("skip duplicated search result", zap.Int64("count", skipDupCnt))
-	ret.Results.TopK = realTopK
+	if groupBy != nil {
+		log.Debug("skip search result of full group", zap.Int64("count", skipGroupCnt))
+	}
+	ret.TopK = realTopK
+	return nil
+}
 
-	if !distance.PositivelyRelated(metricType) {
-		for k := range ret.Results.Scores {
-			ret.Results.Scores[k] *= -1
-		}
+// mergePushedSearchResultData merges the result pushed by a shard leader into the results pushed before,
+// so that only the top k hits of the queries are kept instead of the results of all the shards.
+// The scores are not converted, the merged result is reduced with the others as a partial result.
+func mergePushedSearchResultData(merged *schemapb.SearchResultData, data *schemapb.SearchResultData, nq int64, topk int64, pkType schemapb.DataType, groupBy *groupByInfo) (*schemapb.SearchResultData, error) {
+	if err := checkSearchResultData(data, nq, topk); err != nil {
+		return nil, err
 	}
-	// printSearchResultData(ret.Results, "proxy reduce result")
+	if merged == nil {
+		return data, nil
+	}
+	ret, err := newSearchResultData(nq, topk, len(data.FieldsData), pkType)
+	if err != nil {
+		return nil, err
+	}
+	if err := mergeSearchResultData([]*schemapb.SearchResultData{merged, data}, ret, nq, 0, nq, topk, groupBy); err != nil {
+		return nil, err
+	}
+	// the hits of the queries are limited by topk as the results of the shards
+	ret.TopK = topk
 	return ret, nil
 }
 
@@ -1418,13 +1418,75 @@ func Test_reduceSearchResultData_int(t *testing.T) {
 		},
 	}
 
-	reduced, err := reduceSearchResultData(results, int64(nq), int64(topk), distance.L2, schemapb.DataType_Int64)
+	reduced, err := reduceSearchResultData(results, int64(nq), int64(topk), distance.L2, schemapb.DataType_Int64, nil)
 	assert.NoError(t, err)
 	assert.ElementsMatch(t, []int64{3, 4, 7, 8, 11, 12}, reduced.GetResults().GetIds().GetIntId().GetData())
 	// hard to compare floating point value.
 	// TODO: compare scores.
 }
 
+func Test_reduceSearchResultDataOfQueries(t *testing.T) {
+	topk := 2
+	nq := 3
+	results := []*schemapb.SearchResultData{
+		{
+			NumQueries: int64(nq),
+			TopK:       int64(topk),
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: []int64{11, 9, 7, 5, 3, 1},
+					},
+				},
+			},
+			Scores: []float32{1.1, 0.9, 0.7, 0.5, 0.3, 0.1},
+			Topks:  []int64{2, 2, 2},
+		},
+		{
+			NumQueries: int64(nq),
+			TopK:       int64(topk),
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: []int64{12, 10, 8, 6, 4, 2},
+					},
+				},
+			},
+			Scores: []float32{1.2, 1.0, 0.8, 0.6, 0.4, 0.2},
+			Topks:  []int64{2, 2, 2},
+		},
+	}
+
+	reduced, err := reduceSearchResultData(results, int64(nq), int64(topk), distance.IP, schemapb.DataType_Int64, nil)
+	assert.NoError(t, err)
+
+	// the chunks of queries are the same as the whole result
+	var ids []int64
+	var topks []int64
+	for _, chunk := range splitRows(int64(nq), 2) {
+		res, err := reduceSearchResultDataOfQueries(results, int64(nq), chunk[0], chunk[1], int64(topk), distance.IP, schemapb.DataType_Int64, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, chunk[1]-chunk[0], res.GetResults().GetNumQueries())
+		ids = append(ids, res.GetResults().GetIds().GetIntId().GetData()...)
+		topks = append(topks, res.GetResults().GetTopks()...)
+	}
+	assert.Equal(t, reduced.GetResults().GetIds().GetIntId().GetData(), ids)
+	assert.Equal(t, reduced.GetResults().GetTopks(), topks)
+	assert.Equal(t, []int64{12, 11, 8, 7, 4, 3}, ids)
+
+	// merging the pushed results as they arrive is the same as reducing them at once
+	var pushed *schemapb.SearchResultData
+	for _, res := range results {
+		pushed, err = mergePushedSearchResultData(pushed, res, int64(nq), int64(topk), schemapb.DataType_Int64, nil)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int64(topk), pushed.GetTopK())
+	merged, err := reduceSearchResultData([]*schemapb.SearchResultData{pushed}, int64(nq), int64(topk), distance.IP, schemapb.DataType_Int64, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, reduced.GetResults().GetIds().GetIntId().GetData(), merged.GetResults().GetIds().GetIntId().GetData())
+	assert.Equal(t, reduced.GetResults().GetScores(), merged.GetResults().GetScores())
+}
+
 func Test_reduceSearchResultData_str(t *testing.T) {
 	topk := 2
 	nq := 3
@@ -1457,13 +1519,124 @@ func Test_reduceSearchResultData_str(t *testing.T) {
 		},
 	}
 
-	reduced, err := reduceSearchResultData(results, int64(nq), int64(topk), distance.L2, schemapb.DataType_VarChar)
+	reduced, err := reduceSearchResultData(results, int64(nq), int64(topk), distance.L2, schemapb.DataType_VarChar, nil)
 	assert.NoError(t, err)
 	assert.ElementsMatch(t, []string{"3", "4", "7", "8", "11", "12"}, reduced.GetResults().GetIds().GetStrId().GetData())
 	// hard to compare floating point value.
 	// TODO: compare scores.
 }
 
+func Test_reduceSearchResultData_groupBy(t *testing.T) {
+	topk := 4
+	nq := 1
+	genDocIDs := func(data []int64) []*schemapb.FieldData {
+		return []*schemapb.FieldData{
+			{
+				Type:    schemapb.DataType_Int64,
+				FieldId: 101,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{
+							LongData: &schemapb.LongArray{Data: data},
+						},
+					},
+				},
+			},
+		}
+	}
+	results := []*schemapb.SearchResultData{
+		{
+			NumQueries: int64(nq),
+			TopK:       int64(topk),
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: []int64{1, 2, 3, 4},
+					},
+				},
+			},
+			FieldsData: genDocIDs([]int64{10, 10, 20, 30}),
+			Scores:     []float32{1.0, 0.8, 0.6, 0.4},
+			Topks:      []int64{4},
+		},
+		{
+			NumQueries: int64(nq),
+			TopK:       int64(topk),
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: []int64{5, 6, 7, 8},
+					},
+				},
+			},
+			FieldsData: genDocIDs([]int64{10, 20, 20, 40}),
+			Scores:     []float32{0.9, 0.7, 0.5, 0.3},
+			Topks:      []int64{4},
+		},
+	}
+
+	t.Run("best hit per group", func(t *testing.T) {
+		groupBy := &groupByInfo{fieldOffset: 0, groupSize: 1, groupLimit: 2}
+		reduced, err := reduceSearchResultData(results, int64(nq), int64(topk), distance.IP, schemapb.DataType_Int64, groupBy)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 6}, reduced.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{10, 20}, reduced.GetResults().GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	})
+
+	t.Run("multiple hits per group", func(t *testing.T) {
+		groupBy := &groupByInfo{fieldOffset: 0, groupSize: 2, groupLimit: 2}
+		reduced, err := reduceSearchResultData(results, int64(nq), int64(topk), distance.IP, schemapb.DataType_Int64, groupBy)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 5, 6, 3}, reduced.GetResults().GetIds().GetIntId().GetData())
+	})
+
+	t.Run("group by field not found", func(t *testing.T) {
+		groupBy := &groupByInfo{fieldOffset: 1, groupSize: 1, groupLimit: 2}
+		_, err := reduceSearchResultData(results, int64(nq), int64(topk), distance.IP, schemapb.DataType_Int64, groupBy)
+		assert.Error(t, err)
+	})
+}
+
+func Test_parseGroupByInfo(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "doc_id", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "score", DataType: schemapb.DataType_Float},
+		},
+	}
+
+	fieldID, groupSize, err := parseGroupByInfo(schema, []*commonpb.KeyValuePair{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), fieldID)
+	assert.Equal(t, int64(0), groupSize)
+
+	fieldID, groupSize, err = parseGroupByInfo(schema, []*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: "doc_id"}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), fieldID)
+	assert.Equal(t, int64(1), groupSize)
+
+	fieldID, groupSize, err = parseGroupByInfo(schema, []*commonpb.KeyValuePair{
+		{Key: GroupByFieldKey, Value: "doc_id"},
+		{Key: GroupSizeKey, Value: "3"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), fieldID)
+	assert.Equal(t, int64(3), groupSize)
+
+	_, _, err = parseGroupByInfo(schema, []*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: "not_exist"}})
+	assert.Error(t, err)
+
+	_, _, err = parseGroupByInfo(schema, []*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: "score"}})
+	assert.Error(t, err)
+
+	_, _, err = parseGroupByInfo(schema, []*commonpb.KeyValuePair{
+		{Key: GroupByFieldKey, Value: "doc_id"},
+		{Key: GroupSizeKey, Value: "0"},
+	})
+	assert.Error(t, err)
+}
+
 func Test_checkIfLoaded(t *testing.T) {
 	t.Run("failed to get collection info", func(t *testing.T) {
 		cache := newMockCache()
@@ -122,7 +122,11 @@ func (g *getStatisticsTask) PreExecute(ctx context.Context) error {
 		g.TravelTimestamp = g.BeginTs()
 	}
 
-	err = validateTravelTimestamp(g.TravelTimestamp, g.BeginTs())
+	retention, err := getTimeTravelRetention(ctx, g.collectionName)
+	if err != nil {
+		return err
+	}
+	err = validateTravelTimestamp(g.TravelTimestamp, g.BeginTs(), retention)
 	if err != nil {
 		return err
 	}
@@ -1194,6 +1194,230 @@ func TestDropPartitionTask(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestTruncateCollectionTask(t *testing.T) {
+	Params.InitOnce()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	prefix := "TestTruncateCollectionTask"
+	collectionName := prefix + funcutil.GenRandomStr()
+
+	task := &truncateCollectionTask{
+		Condition: NewTaskCondition(ctx),
+		TruncateCollectionRequest: &milvuspb.TruncateCollectionRequest{
+			CollectionName: collectionName,
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+		result:    nil,
+	}
+	assert.NoError(t, task.OnEnqueue())
+	assert.NotNil(t, task.TraceCtx())
+	task.SetID(100)
+	task.SetTs(100)
+	assert.NoError(t, task.PreExecute(ctx))
+
+	assert.Equal(t, commonpb.MsgType_TruncateCollection, task.Type())
+	assert.Equal(t, TruncateCollectionTaskName, task.Name())
+	assert.Equal(t, UniqueID(100), task.ID())
+	assert.Equal(t, Timestamp(100), task.BeginTs())
+	assert.Equal(t, Timestamp(100), task.EndTs())
+	assert.Equal(t, Params.ProxyCfg.GetNodeID(), task.GetBase().GetSourceID())
+
+	// collection not exists
+	assert.Error(t, task.Execute(ctx))
+
+	schema := constructCollectionSchema(testInt64Field, testFloatVecField, testVecDim, collectionName)
+	marshaledSchema, err := proto.Marshal(schema)
+	assert.NoError(t, err)
+	rc.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		CollectionName: collectionName,
+		Schema:         marshaledSchema,
+		ShardsNum:      2,
+	})
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, task.PostExecute(ctx))
+
+	task.CollectionName = "#0xc0de"
+	assert.Error(t, task.PreExecute(ctx))
+}
+
+func TestTruncatePartitionTask(t *testing.T) {
+	Params.InitOnce()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	prefix := "TestTruncatePartitionTask"
+	collectionName := prefix + funcutil.GenRandomStr()
+	partitionName := prefix + funcutil.GenRandomStr()
+
+	task := &truncatePartitionTask{
+		Condition: NewTaskCondition(ctx),
+		TruncatePartitionRequest: &milvuspb.TruncatePartitionRequest{
+			CollectionName: collectionName,
+			PartitionName:  partitionName,
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+		result:    nil,
+	}
+	assert.NoError(t, task.OnEnqueue())
+	assert.NotNil(t, task.TraceCtx())
+	task.SetID(100)
+	task.SetTs(100)
+	assert.NoError(t, task.PreExecute(ctx))
+
+	assert.Equal(t, commonpb.MsgType_TruncatePartition, task.Type())
+	assert.Equal(t, TruncatePartitionTaskName, task.Name())
+	assert.Equal(t, UniqueID(100), task.ID())
+	assert.Equal(t, Timestamp(100), task.BeginTs())
+	assert.Equal(t, Timestamp(100), task.EndTs())
+	assert.Equal(t, Params.ProxyCfg.GetNodeID(), task.GetBase().GetSourceID())
+
+	// collection not exists
+	assert.Error(t, task.Execute(ctx))
+
+	schema := constructCollectionSchema(testInt64Field, testFloatVecField, testVecDim, collectionName)
+	marshaledSchema, err := proto.Marshal(schema)
+	assert.NoError(t, err)
+	rc.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		CollectionName: collectionName,
+		Schema:         marshaledSchema,
+		ShardsNum:      2,
+	})
+	// partition not exists
+	assert.Error(t, task.Execute(ctx))
+
+	rc.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+		CollectionName: collectionName,
+		PartitionName:  partitionName,
+	})
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, task.PostExecute(ctx))
+
+	task.CollectionName = "#0xc0de"
+	assert.Error(t, task.PreExecute(ctx))
+
+	task.CollectionName = collectionName
+	task.PartitionName = "#0xc0de"
+	assert.Error(t, task.PreExecute(ctx))
+}
+
+func TestAddFieldTask(t *testing.T) {
+	Params.InitOnce()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	prefix := "TestAddFieldTask"
+	collectionName := prefix + funcutil.GenRandomStr()
+
+	task := &addFieldTask{
+		Condition: NewTaskCondition(ctx),
+		AddFieldRequest: &milvuspb.AddFieldRequest{
+			CollectionName: collectionName,
+			Field: &schemapb.FieldSchema{
+				Name:     "added",
+				DataType: schemapb.DataType_Int64,
+				Nullable: true,
+			},
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+		result:    nil,
+	}
+	assert.NoError(t, task.OnEnqueue())
+	assert.NotNil(t, task.TraceCtx())
+	task.SetID(100)
+	task.SetTs(100)
+	assert.NoError(t, task.PreExecute(ctx))
+
+	assert.Equal(t, commonpb.MsgType_AddField, task.Type())
+	assert.Equal(t, AddFieldTaskName, task.Name())
+	assert.Equal(t, UniqueID(100), task.ID())
+	assert.Equal(t, Timestamp(100), task.BeginTs())
+	assert.Equal(t, Timestamp(100), task.EndTs())
+	assert.Equal(t, Params.ProxyCfg.GetNodeID(), task.GetBase().GetSourceID())
+
+	// collection not exists
+	assert.Error(t, task.Execute(ctx))
+
+	schema := constructCollectionSchema(testInt64Field, testFloatVecField, testVecDim, collectionName)
+	marshaledSchema, err := proto.Marshal(schema)
+	assert.NoError(t, err)
+	rc.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		CollectionName: collectionName,
+		Schema:         marshaledSchema,
+		ShardsNum:      2,
+	})
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, task.PostExecute(ctx))
+
+	task.Field.Name = "#0xc0de"
+	assert.Error(t, task.PreExecute(ctx))
+
+	task.Field = nil
+	assert.Error(t, task.PreExecute(ctx))
+
+	task.CollectionName = "#0xc0de"
+	assert.Error(t, task.PreExecute(ctx))
+}
+
+func TestDropFieldTask(t *testing.T) {
+	Params.InitOnce()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	prefix := "TestDropFieldTask"
+	collectionName := prefix + funcutil.GenRandomStr()
+
+	task := &dropFieldTask{
+		Condition: NewTaskCondition(ctx),
+		DropFieldRequest: &milvuspb.DropFieldRequest{
+			CollectionName: collectionName,
+			FieldName:      "dropped",
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+		result:    nil,
+	}
+	assert.NoError(t, task.OnEnqueue())
+	assert.NotNil(t, task.TraceCtx())
+	task.SetID(100)
+	task.SetTs(100)
+	assert.NoError(t, task.PreExecute(ctx))
+
+	assert.Equal(t, commonpb.MsgType_DropField, task.Type())
+	assert.Equal(t, DropFieldTaskName, task.Name())
+	assert.Equal(t, UniqueID(100), task.ID())
+	assert.Equal(t, Timestamp(100), task.BeginTs())
+	assert.Equal(t, Timestamp(100), task.EndTs())
+	assert.Equal(t, Params.ProxyCfg.GetNodeID(), task.GetBase().GetSourceID())
+
+	// collection not exists
+	assert.Error(t, task.Execute(ctx))
+
+	schema := constructCollectionSchema(testInt64Field, testFloatVecField, testVecDim, collectionName)
+	marshaledSchema, err := proto.Marshal(schema)
+	assert.NoError(t, err)
+	rc.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		CollectionName: collectionName,
+		Schema:         marshaledSchema,
+		ShardsNum:      2,
+	})
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, task.PostExecute(ctx))
+
+	task.FieldName = "#0xc0de"
+	assert.Error(t, task.PreExecute(ctx))
+
+	task.CollectionName = "#0xc0de"
+	assert.Error(t, task.PreExecute(ctx))
+}
+
 func TestHasPartitionTask(t *testing.T) {
 	Params.InitOnce()
 	rc := NewRootCoordMock()
@@ -1930,6 +2154,53 @@ func TestAlterAlias_all(t *testing.T) {
 	assert.NoError(t, task.PostExecute(ctx))
 }
 
+func TestAlterShards_all(t *testing.T) {
+	Params.InitOnce()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	prefix := "TestAlterShards_all"
+	collectionName := prefix + funcutil.GenRandomStr()
+	task := &AlterShardsTask{
+		Condition: NewTaskCondition(ctx),
+		AlterShardsRequest: &milvuspb.AlterShardsRequest{
+			Base:           nil,
+			CollectionName: collectionName,
+			ShardsNum:      4,
+		},
+		ctx: ctx,
+		result: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		rootCoord: rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+
+	assert.NotNil(t, task.TraceCtx())
+
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	task.SetID(id)
+	assert.Equal(t, id, task.ID())
+
+	task.Base.MsgType = commonpb.MsgType_AlterShards
+	assert.Equal(t, commonpb.MsgType_AlterShards, task.Type())
+	ts := Timestamp(time.Now().UnixNano())
+	task.SetTs(ts)
+	assert.Equal(t, ts, task.BeginTs())
+	assert.Equal(t, ts, task.EndTs())
+
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, task.PostExecute(ctx))
+
+	task.ShardsNum = 0
+	assert.Error(t, task.PreExecute(ctx))
+	task.ShardsNum = Params.ProxyCfg.MaxShardNum + 1
+	assert.Error(t, task.PreExecute(ctx))
+}
+
 func Test_createIndexTask_getIndexedField(t *testing.T) {
 	collectionName := "test"
 	fieldName := "test"
@@ -0,0 +1,253 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+)
+
+// keys of collection properties about the uniqueness of primary keys
+const (
+	enforceUniquePKKey        = common.EnforceUniquePKKey
+	uniquePKConflictPolicyKey = common.UniquePKConflictPolicyKey
+)
+
+// policies applied to inserted rows whose primary keys already exist
+const (
+	uniquePKConflictReject = "reject"
+	uniquePKConflictUpsert = common.UniquePKConflictUpsert
+)
+
+// maxReportedPKs limits the number of primary keys listed in error messages
+const maxReportedPKs = 10
+
+// getUniquePKPolicy returns whether the collection enforces unique primary keys and the policy applied to conflicts.
+// The policy defaults to proxy.uniquePKConflictPolicy.
+func getUniquePKPolicy(properties []*commonpb.KeyValuePair) (bool, string, error) {
+	enforce := false
+	policy := Params.ProxyCfg.UniquePKConflictPolicy
+	for _, kv := range properties {
+		switch kv.GetKey() {
+		case enforceUniquePKKey:
+			v, err := strconv.ParseBool(kv.GetValue())
+			if err != nil {
+				return false, "", fmt.Errorf("invalid value of %s: %s", enforceUniquePKKey, kv.GetValue())
+			}
+			enforce = v
+		case uniquePKConflictPolicyKey:
+			policy = kv.GetValue()
+		}
+	}
+	if enforce && policy != uniquePKConflictReject && policy != uniquePKConflictUpsert {
+		return false, "", fmt.Errorf("invalid value of %s: %s, should be %s or %s",
+			uniquePKConflictPolicyKey, policy, uniquePKConflictReject, uniquePKConflictUpsert)
+	}
+	return enforce, policy, nil
+}
+
+// getDuplicatedPKs returns the primary keys appearing more than once in ids
+func getDuplicatedPKs(ids *schemapb.IDs) []interface{} {
+	var duplicated []interface{}
+	seen := make(map[interface{}]int)
+	for i := 0; i < typeutil.GetSizeOfIDs(ids); i++ {
+		pk := typeutil.GetPK(ids, int64(i))
+		seen[pk]++
+		if seen[pk] == 2 {
+			duplicated = append(duplicated, pk)
+		}
+	}
+	return duplicated
+}
+
+// getPKTermExpr returns the expression matching the entities with the given primary keys
+func getPKTermExpr(pkName string, pks []interface{}) string {
+	values := make([]string, 0, len(pks))
+	for _, pk := range pks {
+		values = append(values, formatPK(pk))
+	}
+	return fmt.Sprintf("%s in [%s]", pkName, strings.Join(values, ","))
+}
+
+// formatPK formats the primary key as a literal of the expressions
+func formatPK(pk interface{}) string {
+	switch pk := pk.(type) {
+	case int64:
+		return strconv.FormatInt(pk, 10)
+	case string:
+		return strconv.Quote(pk)
+	}
+	return fmt.Sprint(pk)
+}
+
+// formatPKs formats at most maxReportedPKs primary keys for error messages
+func formatPKs(pks []interface{}) string {
+	if len(pks) > maxReportedPKs {
+		return fmt.Sprintf("%v and %d more", pks[:maxReportedPKs], len(pks)-maxReportedPKs)
+	}
+	return fmt.Sprintf("%v", pks)
+}
+
+// checkUniquePK checks the primary keys of the insert request if the collection enforces unique primary keys.
+// The keys are checked against the bloom filters and the primary key ranges of the segments kept by the datanodes,
+// so the collection doesn't have to be loaded. Rows conflicting with existing entities are rejected, or the keys
+// to upsert are returned, whose existing entities are deleted by the insert task according to the conflict policy.
+// Inserts not consumed by the datanodes yet, including the concurrent ones, are not detected.
+func (node *Proxy) checkUniquePK(ctx context.Context, request *milvuspb.InsertRequest) (*schemapb.IDs, error) {
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, request.GetCollectionName())
+	if err != nil {
+		return nil, err
+	}
+	enforce, policy, err := getUniquePKPolicy(collInfo.properties)
+	if err != nil || !enforce {
+		return nil, err
+	}
+
+	pkSchema, err := typeutil.GetPrimaryFieldSchema(collInfo.schema)
+	if err != nil {
+		return nil, err
+	}
+	// primary keys allocated by milvus are always unique
+	if pkSchema.GetAutoID() {
+		return nil, nil
+	}
+	pkData, err := typeutil.GetPrimaryFieldData(request.GetFieldsData(), pkSchema)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := parsePrimaryFieldData2IDs(pkData)
+	if err != nil {
+		return nil, err
+	}
+	if duplicated := getDuplicatedPKs(ids); len(duplicated) > 0 {
+		return nil, fmt.Errorf("duplicated primary keys in the insert request: %s", formatPKs(duplicated))
+	}
+
+	resp, err := node.dataCoord.CheckPrimaryKeys(ctx, &datapb.CheckPrimaryKeysRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_CheckPrimaryKeys,
+			SourceID: Params.ProxyCfg.GetNodeID(),
+		},
+		CollectionID: collInfo.collID,
+		PrimaryKeys:  ids,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check the uniqueness of primary keys: %w", err)
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, fmt.Errorf("failed to check the uniqueness of primary keys: %s", resp.GetStatus().GetReason())
+	}
+	candidates := resp.GetExistingKeys()
+	if typeutil.GetSizeOfIDs(candidates) == 0 {
+		return nil, nil
+	}
+
+	log.Debug("conflicting primary keys may exist in insert request",
+		zap.String("collection", request.GetCollectionName()),
+		zap.String("policy", policy),
+		zap.Int("candidates", typeutil.GetSizeOfIDs(candidates)))
+	// deleting the keys which don't exist is harmless, so the false positives of the bloom filters are upserted as well
+	if policy == uniquePKConflictUpsert {
+		return candidates, nil
+	}
+
+	pks := make([]interface{}, 0, typeutil.GetSizeOfIDs(candidates))
+	for i := 0; i < typeutil.GetSizeOfIDs(candidates); i++ {
+		pks = append(pks, typeutil.GetPK(candidates, int64(i)))
+	}
+	// the false positives are filtered out by querying the collection if it's loaded,
+	// the uniqueness covers the entities hidden by the row policies too
+	existing, err := node.queryPKs(ctx, &milvuspb.QueryRequest{
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetCollectionName(),
+		Expr:           getPKTermExpr(pkSchema.GetName(), pks),
+	}, pkSchema, true, 0)
+	if err != nil {
+		log.Debug("failed to confirm conflicting primary keys, reject them", zap.String("collection", request.GetCollectionName()), zap.Error(err))
+		return nil, fmt.Errorf("primary keys may already exist in collection %s: %s", request.GetCollectionName(), formatPKs(pks))
+	}
+	if len(existing) > 0 {
+		return nil, fmt.Errorf("primary keys already exist in collection %s: %s", request.GetCollectionName(), formatPKs(existing))
+	}
+	return nil, nil
+}
+
+// queryPKs returns the primary keys of the entities matching the query request with strong consistency,
+// only the smallest limit primary keys are returned in order if the limit is positive
+func (node *Proxy) queryPKs(ctx context.Context, request *milvuspb.QueryRequest, pkSchema *schemapb.FieldSchema, skipRowPolicy bool, limit int64) ([]interface{}, error) {
+	request.OutputFields = []string{pkSchema.GetName()}
+	request.GuaranteeTimestamp = strongTS
+	qt := &queryTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		RetrieveRequest: &internalpb.RetrieveRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_Retrieve,
+				SourceID: Params.ProxyCfg.GetNodeID(),
+			},
+			ReqID: Params.ProxyCfg.GetNodeID(),
+			Limit: limit,
+		},
+		request:          request,
+		qc:               node.queryCoord,
+		queryShardPolicy: mergeRoundRobinPolicy,
+		shardMgr:         node.shardMgr,
+		skipRowPolicy:    skipRowPolicy,
+	}
+	if err := node.sched.dqQueue.Enqueue(qt); err != nil {
+		return nil, err
+	}
+	if err := qt.WaitToFinish(); err != nil {
+		return nil, err
+	}
+	switch qt.result.GetStatus().GetErrorCode() {
+	case commonpb.ErrorCode_Success:
+	case commonpb.ErrorCode_EmptyCollection:
+		// no entity matches
+		return nil, nil
+	default:
+		return nil, errors.New(qt.result.GetStatus().GetReason())
+	}
+
+	var existing []interface{}
+	for _, fieldData := range qt.result.GetFieldsData() {
+		if fieldData.GetFieldName() != pkSchema.GetName() && fieldData.GetFieldId() != pkSchema.GetFieldID() {
+			continue
+		}
+		ids, err := parsePrimaryFieldData2IDs(fieldData)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < typeutil.GetSizeOfIDs(ids); i++ {
+			existing = append(existing, typeutil.GetPK(ids, int64(i)))
+		}
+	}
+	return existing, nil
+}
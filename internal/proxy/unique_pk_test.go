@@ -0,0 +1,242 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_getUniquePKPolicy(t *testing.T) {
+	defaultPolicy := Params.ProxyCfg.UniquePKConflictPolicy
+	defer func() { Params.ProxyCfg.UniquePKConflictPolicy = defaultPolicy }()
+	Params.ProxyCfg.UniquePKConflictPolicy = uniquePKConflictReject
+
+	enforce, _, err := getUniquePKPolicy(nil)
+	assert.NoError(t, err)
+	assert.False(t, enforce)
+
+	enforce, policy, err := getUniquePKPolicy([]*commonpb.KeyValuePair{
+		{Key: enforceUniquePKKey, Value: "true"},
+	})
+	assert.NoError(t, err)
+	assert.True(t, enforce)
+	assert.Equal(t, uniquePKConflictReject, policy)
+
+	enforce, policy, err = getUniquePKPolicy([]*commonpb.KeyValuePair{
+		{Key: enforceUniquePKKey, Value: "true"},
+		{Key: uniquePKConflictPolicyKey, Value: uniquePKConflictUpsert},
+	})
+	assert.NoError(t, err)
+	assert.True(t, enforce)
+	assert.Equal(t, uniquePKConflictUpsert, policy)
+
+	_, _, err = getUniquePKPolicy([]*commonpb.KeyValuePair{
+		{Key: enforceUniquePKKey, Value: "yes please"},
+	})
+	assert.Error(t, err)
+
+	_, _, err = getUniquePKPolicy([]*commonpb.KeyValuePair{
+		{Key: enforceUniquePKKey, Value: "true"},
+		{Key: uniquePKConflictPolicyKey, Value: "ignore"},
+	})
+	assert.Error(t, err)
+
+	// the policy is not checked when the uniqueness is not enforced
+	enforce, _, err = getUniquePKPolicy([]*commonpb.KeyValuePair{
+		{Key: uniquePKConflictPolicyKey, Value: "ignore"},
+	})
+	assert.NoError(t, err)
+	assert.False(t, enforce)
+}
+
+func Test_getDuplicatedPKs(t *testing.T) {
+	intIDs := &schemapb.IDs{
+		IdField: &schemapb.IDs_IntId{
+			IntId: &schemapb.LongArray{Data: []int64{1, 2, 1, 3, 1, 2}},
+		},
+	}
+	assert.ElementsMatch(t, []interface{}{int64(1), int64(2)}, getDuplicatedPKs(intIDs))
+
+	strIDs := &schemapb.IDs{
+		IdField: &schemapb.IDs_StrId{
+			StrId: &schemapb.StringArray{Data: []string{"a", "b", "c"}},
+		},
+	}
+	assert.Empty(t, getDuplicatedPKs(strIDs))
+}
+
+func Test_getPKTermExpr(t *testing.T) {
+	assert.Equal(t, "pk in [1,2]", getPKTermExpr("pk", []interface{}{int64(1), int64(2)}))
+	assert.Equal(t, `pk in ["a","b\"c"]`, getPKTermExpr("pk", []interface{}{"a", `b"c`}))
+}
+
+func Test_formatPKs(t *testing.T) {
+	assert.Equal(t, "[1 2]", formatPKs([]interface{}{int64(1), int64(2)}))
+
+	pks := make([]interface{}, 0, maxReportedPKs+2)
+	for i := 0; i < maxReportedPKs+2; i++ {
+		pks = append(pks, int64(i))
+	}
+	assert.Equal(t, "[0 1 2 3 4 5 6 7 8 9] and 2 more", formatPKs(pks))
+}
+
+type checkPKsDataCoordMock struct {
+	*DataCoordMock
+	existing []int64
+	err      error
+}
+
+func (coord *checkPKsDataCoordMock) CheckPrimaryKeys(ctx context.Context, req *datapb.CheckPrimaryKeysRequest) (*datapb.CheckPrimaryKeysResponse, error) {
+	if coord.err != nil {
+		return nil, coord.err
+	}
+	existing := make(map[int64]bool)
+	for _, pk := range coord.existing {
+		existing[pk] = true
+	}
+	var keys []int64
+	for _, pk := range req.GetPrimaryKeys().GetIntId().GetData() {
+		if existing[pk] {
+			keys = append(keys, pk)
+		}
+	}
+	return &datapb.CheckPrimaryKeysResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		ExistingKeys: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: keys}},
+		},
+	}, nil
+}
+
+func Test_checkUniquePK(t *testing.T) {
+	ctx := context.Background()
+	collectionName := "test_unique_pk"
+	pkSchema := &schemapb.FieldSchema{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64}
+	schema := &schemapb.CollectionSchema{Name: collectionName, Fields: []*schemapb.FieldSchema{pkSchema}}
+	properties := []*commonpb.KeyValuePair{
+		{Key: enforceUniquePKKey, Value: "true"},
+		{Key: uniquePKConflictPolicyKey, Value: uniquePKConflictUpsert},
+	}
+
+	cache := newMockCache()
+	cache.setGetInfoFunc(func(ctx context.Context, collectionName string) (*collectionInfo, error) {
+		return &collectionInfo{collID: 1, schema: schema, properties: properties}, nil
+	})
+	oldCache := globalMetaCache
+	defer func() { globalMetaCache = oldCache }()
+	globalMetaCache = cache
+
+	newRequest := func(pks ...int64) *milvuspb.InsertRequest {
+		return &milvuspb.InsertRequest{
+			CollectionName: collectionName,
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_Int64,
+					FieldName: pkSchema.GetName(),
+					FieldId:   pkSchema.GetFieldID(),
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+						},
+					},
+				},
+			},
+			NumRows: uint32(len(pks)),
+		}
+	}
+
+	dc := &checkPKsDataCoordMock{DataCoordMock: NewDataCoordMock(), existing: []int64{2, 3}}
+	node := &Proxy{dataCoord: dc}
+
+	t.Run("upsert existing keys", func(t *testing.T) {
+		ids, err := node.checkUniquePK(ctx, newRequest(1, 2, 3, 4))
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []int64{2, 3}, ids.GetIntId().GetData())
+	})
+
+	t.Run("no existing keys", func(t *testing.T) {
+		ids, err := node.checkUniquePK(ctx, newRequest(1, 4))
+		assert.NoError(t, err)
+		assert.Nil(t, ids)
+	})
+
+	t.Run("duplicated keys in request", func(t *testing.T) {
+		_, err := node.checkUniquePK(ctx, newRequest(1, 1))
+		assert.Error(t, err)
+	})
+
+	t.Run("check primary keys failed", func(t *testing.T) {
+		failed := &Proxy{dataCoord: &checkPKsDataCoordMock{DataCoordMock: NewDataCoordMock(), err: errors.New("mock")}}
+		_, err := failed.checkUniquePK(ctx, newRequest(1, 2))
+		assert.Error(t, err)
+	})
+
+	t.Run("not enforced", func(t *testing.T) {
+		properties = nil
+		defer func() {
+			properties = []*commonpb.KeyValuePair{
+				{Key: enforceUniquePKKey, Value: "true"},
+				{Key: uniquePKConflictPolicyKey, Value: uniquePKConflictUpsert},
+			}
+		}()
+		ids, err := node.checkUniquePK(ctx, newRequest(2, 2))
+		assert.NoError(t, err)
+		assert.Nil(t, ids)
+	})
+
+	t.Run("auto id", func(t *testing.T) {
+		pkSchema.AutoID = true
+		defer func() { pkSchema.AutoID = false }()
+		ids, err := node.checkUniquePK(ctx, newRequest(2, 2))
+		assert.NoError(t, err)
+		assert.Nil(t, ids)
+	})
+}
+
+func Test_insertTaskRangeTs(t *testing.T) {
+	queue := newBaseTaskQueue(newMockTsoAllocator(), newMockIDAllocatorInterface())
+
+	it := &insertTask{
+		Condition: NewTaskCondition(context.Background()),
+		BaseInsertTask: BaseInsertTask{
+			InsertRequest: internalpb.InsertRequest{Base: &commonpb.MsgBase{}},
+		},
+	}
+	assert.NoError(t, queue.Enqueue(it))
+	assert.Equal(t, it.BeginTs(), it.EndTs())
+
+	upsert := &insertTask{
+		Condition: NewTaskCondition(context.Background()),
+		BaseInsertTask: BaseInsertTask{
+			InsertRequest: internalpb.InsertRequest{Base: &commonpb.MsgBase{}},
+		},
+		upsertIDs: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1}}},
+		},
+	}
+	assert.NoError(t, queue.Enqueue(upsert))
+	assert.LessOrEqual(t, upsert.BeginTs(), upsert.EndTs())
+}
@@ -24,10 +24,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/authutil"
 	"github.com/milvus-io/milvus/internal/util/crypto"
 	"google.golang.org/grpc/metadata"
 
@@ -230,7 +232,7 @@ func validateMaxLengthPerRow(collectionName string, field *schemapb.FieldSchema)
 }
 
 func validateVectorFieldMetricType(field *schemapb.FieldSchema) error {
-	if (field.DataType != schemapb.DataType_FloatVector) && (field.DataType != schemapb.DataType_BinaryVector) {
+	if !typeutil.IsVectorType(field.DataType) {
 		return nil
 	}
 	for _, params := range field.IndexParams {
@@ -312,6 +314,46 @@ func validatePrimaryKey(coll *schemapb.CollectionSchema) error {
 	return nil
 }
 
+// validateClusteringKey checks at most one arithmetic or string field is specified as clustering key
+func validateClusteringKey(coll *schemapb.CollectionSchema) error {
+	idx := -1
+	for i, field := range coll.Fields {
+		if field.GetIsClusteringKey() {
+			if idx != -1 {
+				return fmt.Errorf("there are more than one clustering key, field name = %s, %s", coll.Fields[idx].Name, field.Name)
+			}
+			if !typeutil.IsArithmetic(field.DataType) && !typeutil.IsStringType(field.DataType) {
+				return fmt.Errorf("the data type of clustering key should be arithmetic or string, field name = %s", field.Name)
+			}
+			idx = i
+		}
+	}
+	return nil
+}
+
+// validateNullableFields checks the definition of nullable fields and fields with default value,
+// primary key and vector fields cannot be nullable or have default value, clustering key cannot be nullable.
+func validateNullableFields(coll *schemapb.CollectionSchema) error {
+	for _, field := range coll.Fields {
+		if !field.GetNullable() && field.GetDefaultValue() == nil {
+			continue
+		}
+		if field.GetIsPrimaryKey() {
+			return fmt.Errorf("primary key field cannot be nullable or have default value, field name = %s", field.Name)
+		}
+		if !typeutil.IsNullableType(field.DataType) {
+			return fmt.Errorf("field of type %s cannot be nullable or have default value, field name = %s", field.DataType.String(), field.Name)
+		}
+		if field.GetNullable() && field.GetIsClusteringKey() {
+			return fmt.Errorf("clustering key field cannot be nullable, field name = %s", field.Name)
+		}
+		if err := typeutil.ValidateDefaultValue(field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // RepeatedKeyValToMap transfer the kv pairs to map.
 func RepeatedKeyValToMap(kvPairs []*commonpb.KeyValuePair) (map[string]string, error) {
 	resMap := make(map[string]string)
@@ -334,18 +376,30 @@ func isVector(dataType schemapb.DataType) (bool, error) {
 		schemapb.DataType_Float, schemapb.DataType_Double:
 		return false, nil
 
-	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
+	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector, schemapb.DataType_SparseFloatVector,
+		schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
 		return true, nil
 	}
 
 	return false, fmt.Errorf("invalid data type: %d", dataType)
 }
 
+// isDenseFloatVectorType returns true for the float vector types with a fixed dimension.
+func isDenseFloatVectorType(dataType schemapb.DataType) bool {
+	return dataType == schemapb.DataType_FloatVector ||
+		dataType == schemapb.DataType_Float16Vector ||
+		dataType == schemapb.DataType_BFloat16Vector
+}
+
 func validateMetricType(dataType schemapb.DataType, metricTypeStrRaw string) error {
 	metricTypeStr := strings.ToUpper(metricTypeStrRaw)
 	switch metricTypeStr {
-	case "L2", "IP":
-		if dataType == schemapb.DataType_FloatVector {
+	case "L2":
+		if isDenseFloatVectorType(dataType) {
+			return nil
+		}
+	case "IP":
+		if isDenseFloatVectorType(dataType) || dataType == schemapb.DataType_SparseFloatVector {
 			return nil
 		}
 	case "JACCARD", "HAMMING", "TANIMOTO", "SUBSTRUCTURE", "SUBPERSTURCTURE":
@@ -406,13 +460,16 @@ func validateSchema(coll *schemapb.CollectionSchema) error {
 			if err2 != nil {
 				return err2
 			}
-			dimStr, ok := typeKv["dim"]
-			if !ok {
-				return fmt.Errorf("dim not found in type_params for vector field %s(%d)", field.Name, field.FieldID)
-			}
-			dim, err := strconv.Atoi(dimStr)
-			if err != nil || dim < 0 {
-				return fmt.Errorf("invalid dim; %s", dimStr)
+			// sparse float vector has no fixed dimension
+			if field.DataType != schemapb.DataType_SparseFloatVector {
+				dimStr, ok := typeKv["dim"]
+				if !ok {
+					return fmt.Errorf("dim not found in type_params for vector field %s(%d)", field.Name, field.FieldID)
+				}
+				dim, err := strconv.Atoi(dimStr)
+				if err != nil || dim < 0 {
+					return fmt.Errorf("invalid dim; %s", dimStr)
+				}
 			}
 
 			metricTypeStr, ok := indexKv["metric_type"]
@@ -450,7 +507,7 @@ func validateMultipleVectorFields(schema *schemapb.CollectionSchema) error {
 	for i := range schema.Fields {
 		name := schema.Fields[i].Name
 		dType := schema.Fields[i].DataType
-		isVec := dType == schemapb.DataType_BinaryVector || dType == schemapb.DataType_FloatVector
+		isVec := typeutil.IsVectorType(dType)
 		if isVec && vecExist && !enableMultipleVectorFields {
 			return fmt.Errorf(
 				"multiple vector fields is not supported, fields name: %s, %s",
@@ -466,6 +523,25 @@ func validateMultipleVectorFields(schema *schemapb.CollectionSchema) error {
 	return nil
 }
 
+// checkSparseFloatVectorFieldData validates the rows of sparse float vector fields and fills their dimension.
+func checkSparseFloatVectorFieldData(fieldsData []*schemapb.FieldData) error {
+	for _, fieldData := range fieldsData {
+		if fieldData.GetType() != schemapb.DataType_SparseFloatVector {
+			continue
+		}
+		sparseArray := fieldData.GetVectors().GetSparseFloatVector()
+		if sparseArray == nil {
+			return fmt.Errorf("sparse float vector field %s has no data", fieldData.GetFieldName())
+		}
+		if err := typeutil.ValidateSparseFloatRows(sparseArray.GetContents()...); err != nil {
+			return fmt.Errorf("invalid sparse float vector field %s: %w", fieldData.GetFieldName(), err)
+		}
+		sparseArray.Dim = typeutil.SparseFloatRowsDim(sparseArray.GetContents()...)
+		fieldData.GetVectors().Dim = sparseArray.Dim
+	}
+	return nil
+}
+
 // parsePrimaryFieldData2IDs get IDs to fill grpc result, for example insert request, delete request etc.
 func parsePrimaryFieldData2IDs(fieldData *schemapb.FieldData) (*schemapb.IDs, error) {
 	primaryData := &schemapb.IDs{}
@@ -540,6 +616,141 @@ func fillFieldIDBySchema(columns []*schemapb.FieldData, schema *schemapb.Collect
 	return nil
 }
 
+// genNullableFieldData generates the column of numRows rows for a nullable field or a field with default value,
+// the rows hold the default value, or are null if the field has no default value.
+func genNullableFieldData(field *schemapb.FieldSchema, numRows int) (*schemapb.FieldData, error) {
+	value := field.GetDefaultValue()
+	scalars := &schemapb.ScalarField{}
+	switch field.DataType {
+	case schemapb.DataType_Bool:
+		data := make([]bool, numRows)
+		for i := range data {
+			data[i] = value.GetBoolData()
+		}
+		scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		data := make([]int32, numRows)
+		for i := range data {
+			data[i] = value.GetIntData()
+		}
+		scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}
+	case schemapb.DataType_Int64:
+		data := make([]int64, numRows)
+		for i := range data {
+			data[i] = value.GetLongData()
+		}
+		scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}
+	case schemapb.DataType_Float:
+		data := make([]float32, numRows)
+		for i := range data {
+			data[i] = value.GetFloatData()
+		}
+		scalars.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}
+	case schemapb.DataType_Double:
+		data := make([]float64, numRows)
+		for i := range data {
+			data[i] = value.GetDoubleData()
+		}
+		scalars.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		data := make([]string, numRows)
+		for i := range data {
+			data[i] = value.GetStringData()
+		}
+		scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}
+	default:
+		return nil, fmt.Errorf("field of type %s cannot be nullable or have default value, field name = %s", field.DataType.String(), field.Name)
+	}
+
+	fieldData := &schemapb.FieldData{
+		Type:      field.DataType,
+		FieldName: field.Name,
+		FieldId:   field.FieldID,
+		Field:     &schemapb.FieldData_Scalars{Scalars: scalars},
+	}
+	if value == nil {
+		fieldData.ValidData = make([]bool, numRows)
+	}
+	return fieldData, nil
+}
+
+// fillDefaultValue replaces the null rows of the column with the default value of the field
+func fillDefaultValue(column *schemapb.FieldData, field *schemapb.FieldSchema) error {
+	validData := column.GetValidData()
+	value := field.GetDefaultValue()
+	scalars := column.GetScalars()
+	switch field.DataType {
+	case schemapb.DataType_Bool:
+		data := scalars.GetBoolData().GetData()
+		for i := 0; i < len(data) && i < len(validData); i++ {
+			if !validData[i] {
+				data[i] = value.GetBoolData()
+			}
+		}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		data := scalars.GetIntData().GetData()
+		for i := 0; i < len(data) && i < len(validData); i++ {
+			if !validData[i] {
+				data[i] = value.GetIntData()
+			}
+		}
+	case schemapb.DataType_Int64:
+		data := scalars.GetLongData().GetData()
+		for i := 0; i < len(data) && i < len(validData); i++ {
+			if !validData[i] {
+				data[i] = value.GetLongData()
+			}
+		}
+	case schemapb.DataType_Float:
+		data := scalars.GetFloatData().GetData()
+		for i := 0; i < len(data) && i < len(validData); i++ {
+			if !validData[i] {
+				data[i] = value.GetFloatData()
+			}
+		}
+	case schemapb.DataType_Double:
+		data := scalars.GetDoubleData().GetData()
+		for i := 0; i < len(data) && i < len(validData); i++ {
+			if !validData[i] {
+				data[i] = value.GetDoubleData()
+			}
+		}
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		data := scalars.GetStringData().GetData()
+		for i := 0; i < len(data) && i < len(validData); i++ {
+			if !validData[i] {
+				data[i] = value.GetStringData()
+			}
+		}
+	default:
+		return fmt.Errorf("field of type %s cannot have default value, field name = %s", field.DataType.String(), field.Name)
+	}
+	column.ValidData = nil
+	return nil
+}
+
+// isSchemaMatched checks whether the columns can be inserted with the schema, the cached schema may be
+// outdated after fields are added or dropped
+func isSchemaMatched(columns []*schemapb.FieldData, schema *schemapb.CollectionSchema) bool {
+	names := make(map[string]struct{}, len(columns))
+	for _, fieldData := range columns {
+		names[fieldData.FieldName] = struct{}{}
+	}
+	fieldNames := make(map[string]struct{}, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		fieldNames[field.Name] = struct{}{}
+		if _, ok := names[field.Name]; !ok && !field.AutoID && !field.GetNullable() && field.GetDefaultValue() == nil {
+			return false
+		}
+	}
+	for name := range names {
+		if _, ok := fieldNames[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
 func ValidateUsername(username string) error {
 	username = strings.TrimSpace(username)
 
@@ -580,15 +791,39 @@ func ValidatePassword(password string) error {
 	return nil
 }
 
-func validateTravelTimestamp(travelTs, tMax typeutil.Timestamp) error {
+// validateTravelTimestamp checks that travelTs lies in the time travel retention window ending at tMax, retention is in seconds.
+func validateTravelTimestamp(travelTs, tMax typeutil.Timestamp, retention int64) error {
 	durationSeconds := tsoutil.CalculateDuration(tMax, travelTs) / 1000
-	if durationSeconds > Params.CommonCfg.RetentionDuration {
-		duration := time.Second * time.Duration(durationSeconds)
-		return fmt.Errorf("only support to travel back to %s so far", duration.String())
+	if durationSeconds > retention {
+		travelTime, _ := tsoutil.ParseTS(travelTs)
+		duration := time.Second * time.Duration(retention)
+		return fmt.Errorf("travel time %s is older than the retained window, only support to travel back to %s so far",
+			travelTime.Format(time.RFC3339Nano), duration.String())
 	}
 	return nil
 }
 
+// parseTravelTimestamp returns the travel timestamp of the request, the wall-clock travelTime overrides travelTs if it's set.
+func parseTravelTimestamp(travelTime string, travelTs typeutil.Timestamp) (typeutil.Timestamp, error) {
+	if travelTime == "" {
+		return travelTs, nil
+	}
+	ts, err := tsoutil.ParseWallClockTime(travelTime)
+	if err != nil {
+		return 0, fmt.Errorf("invalid travel time: %w", err)
+	}
+	return ts, nil
+}
+
+// getTimeTravelRetention returns the time travel retention of the collection in seconds.
+func getTimeTravelRetention(ctx context.Context, collectionName string) (int64, error) {
+	info, err := globalMetaCache.GetCollectionInfo(ctx, collectionName)
+	if err != nil {
+		return 0, err
+	}
+	return common.GetTimeTravelRetention(info.properties, Params.CommonCfg.RetentionDuration)
+}
+
 func ReplaceID2Name(oldStr string, id int64, name string) string {
 	return strings.ReplaceAll(oldStr, strconv.FormatInt(id, 10), name)
 }
@@ -668,7 +903,14 @@ func ValidatePrivilege(entity string) error {
 	return validateName(entity, "Privilege")
 }
 
+func ValidateRowPolicyName(entity string) error {
+	return validateName(entity, "RowPolicyName")
+}
+
 func GetCurUserFromContext(ctx context.Context) (string, error) {
+	if info, ok := authutil.AuthInfoFromContext(ctx); ok {
+		return info.User, nil
+	}
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return "", fmt.Errorf("fail to get md from the context")
@@ -22,11 +22,14 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/crypto"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/metadata"
 )
@@ -273,6 +276,43 @@ func TestValidatePrimaryKey(t *testing.T) {
 	}))
 }
 
+func TestValidateClusteringKey(t *testing.T) {
+	boolField := &schemapb.FieldSchema{
+		Name:     "boolField",
+		DataType: schemapb.DataType_Bool,
+	}
+	int64Field := &schemapb.FieldSchema{
+		Name:     "int64Field",
+		DataType: schemapb.DataType_Int64,
+	}
+	varCharField := &schemapb.FieldSchema{
+		Name:     "varCharField",
+		DataType: schemapb.DataType_VarChar,
+	}
+
+	// clustering key is optional
+	assert.NoError(t, validateClusteringKey(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{boolField, int64Field, varCharField},
+	}))
+
+	int64Field.IsClusteringKey = true
+	assert.NoError(t, validateClusteringKey(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{boolField, int64Field, varCharField},
+	}))
+
+	// more than one clustering key
+	varCharField.IsClusteringKey = true
+	assert.Error(t, validateClusteringKey(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{boolField, int64Field, varCharField},
+	}))
+
+	// bool field could not be clustering key
+	boolField.IsClusteringKey = true
+	assert.Error(t, validateClusteringKey(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{boolField},
+	}))
+}
+
 func TestValidateFieldType(t *testing.T) {
 	type testCase struct {
 		dt       schemapb.DataType
@@ -557,6 +597,38 @@ func TestValidateMultipleVectorFields(t *testing.T) {
 	}
 }
 
+func TestCheckSparseFloatVectorFieldData(t *testing.T) {
+	newSparseFieldData := func(rows ...[]byte) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:      schemapb.DataType_SparseFloatVector,
+			FieldName: "sparse",
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Data: &schemapb.VectorField_SparseFloatVector{
+						SparseFloatVector: &schemapb.SparseFloatArray{
+							Contents: rows,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	fieldData := newSparseFieldData(
+		typeutil.CreateSparseFloatRow([]uint32{1, 10}, []float32{0.1, 0.2}),
+		typeutil.CreateSparseFloatRow([]uint32{99}, []float32{0.3}),
+	)
+	assert.NoError(t, checkSparseFloatVectorFieldData([]*schemapb.FieldData{fieldData}))
+	assert.Equal(t, int64(100), fieldData.GetVectors().GetDim())
+	assert.Equal(t, int64(100), fieldData.GetVectors().GetSparseFloatVector().GetDim())
+
+	invalid := newSparseFieldData(typeutil.CreateSparseFloatRow([]uint32{1, 1}, []float32{0.1, 0.2}))
+	assert.Error(t, checkSparseFloatVectorFieldData([]*schemapb.FieldData{invalid}))
+
+	noData := &schemapb.FieldData{Type: schemapb.DataType_SparseFloatVector}
+	assert.Error(t, checkSparseFloatVectorFieldData([]*schemapb.FieldData{noData}))
+}
+
 func TestFillFieldIDBySchema(t *testing.T) {
 	schema := &schemapb.CollectionSchema{}
 	columns := []*schemapb.FieldData{
@@ -582,6 +654,123 @@ func TestFillFieldIDBySchema(t *testing.T) {
 	assert.Equal(t, int64(1), columns[0].FieldId)
 }
 
+func TestIsSchemaMatched(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, AutoID: true},
+			{Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	assert.True(t, isSchemaMatched([]*schemapb.FieldData{{FieldName: "vec"}}, schema))
+	assert.True(t, isSchemaMatched([]*schemapb.FieldData{{FieldName: "pk"}, {FieldName: "vec"}}, schema))
+
+	// field added
+	assert.False(t, isSchemaMatched([]*schemapb.FieldData{{FieldName: "vec"}, {FieldName: "added"}}, schema))
+
+	// field dropped
+	assert.False(t, isSchemaMatched([]*schemapb.FieldData{}, schema))
+
+	// nullable field could be missing
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{Name: "nullable", DataType: schemapb.DataType_Int64, Nullable: true})
+	assert.True(t, isSchemaMatched([]*schemapb.FieldData{{FieldName: "vec"}}, schema))
+}
+
+func TestValidateNullableFields(t *testing.T) {
+	pkField := &schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true}
+	vecField := &schemapb.FieldSchema{Name: "vec", DataType: schemapb.DataType_FloatVector}
+	int64Field := &schemapb.FieldSchema{Name: "int64", DataType: schemapb.DataType_Int64, Nullable: true}
+	schema := &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{pkField, vecField, int64Field}}
+	assert.NoError(t, validateNullableFields(schema))
+
+	int64Field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 1}}
+	assert.NoError(t, validateNullableFields(schema))
+
+	// default value mismatch the field type
+	int64Field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "a"}}
+	assert.Error(t, validateNullableFields(schema))
+	int64Field.DefaultValue = nil
+
+	// clustering key could not be nullable
+	int64Field.IsClusteringKey = true
+	assert.Error(t, validateNullableFields(schema))
+	int64Field.IsClusteringKey = false
+
+	// vector field could not be nullable
+	vecField.Nullable = true
+	assert.Error(t, validateNullableFields(schema))
+	vecField.Nullable = false
+
+	// primary key could not be nullable
+	pkField.Nullable = true
+	assert.Error(t, validateNullableFields(schema))
+}
+
+func TestGenNullableFieldData(t *testing.T) {
+	field := &schemapb.FieldSchema{Name: "int64", FieldID: 100, DataType: schemapb.DataType_Int64, Nullable: true}
+	fieldData, err := genNullableFieldData(field, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), fieldData.FieldId)
+	assert.Equal(t, []int64{0, 0, 0}, fieldData.GetScalars().GetLongData().GetData())
+	assert.Equal(t, []bool{false, false, false}, fieldData.GetValidData())
+
+	field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 7}}
+	fieldData, err = genNullableFieldData(field, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{7, 7}, fieldData.GetScalars().GetLongData().GetData())
+	assert.Empty(t, fieldData.GetValidData())
+
+	field.DataType = schemapb.DataType_FloatVector
+	_, err = genNullableFieldData(field, 2)
+	assert.Error(t, err)
+}
+
+func TestFillDefaultValue(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		Name:         "varchar",
+		DataType:     schemapb.DataType_VarChar,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "default"}},
+	}
+	column := &schemapb.FieldData{
+		FieldName: "varchar",
+		Type:      schemapb.DataType_VarChar,
+		Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "", "c"}}},
+		}},
+		ValidData: []bool{true, false, true},
+	}
+	assert.NoError(t, fillDefaultValue(column, field))
+	assert.Equal(t, []string{"a", "default", "c"}, column.GetScalars().GetStringData().GetData())
+	assert.Empty(t, column.GetValidData())
+}
+
+func TestValidateTravelTimestamp(t *testing.T) {
+	now := time.Now()
+	tMax := tsoutil.ComposeTSByTime(now, 0)
+
+	assert.NoError(t, validateTravelTimestamp(tMax, tMax, 0))
+	assert.NoError(t, validateTravelTimestamp(typeutil.MaxTimestamp, tMax, 0))
+	assert.NoError(t, validateTravelTimestamp(tsoutil.ComposeTSByTime(now.Add(-time.Hour), 0), tMax, 3600))
+	assert.Error(t, validateTravelTimestamp(tsoutil.ComposeTSByTime(now.Add(-2*time.Hour), 0), tMax, 3600))
+}
+
+func TestParseTravelTimestamp(t *testing.T) {
+	ts, err := parseTravelTimestamp("", 100)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), ts)
+
+	now := time.UnixMilli(time.Now().UnixMilli())
+	ts, err = parseTravelTimestamp(now.Format(time.RFC3339Nano), 100)
+	assert.NoError(t, err)
+	assert.Equal(t, tsoutil.ComposeTSByTime(now, 0), ts)
+
+	ts, err = parseTravelTimestamp(strconv.FormatInt(now.UnixMilli(), 10), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, tsoutil.ComposeTSByTime(now, 0), ts)
+
+	_, err = parseTravelTimestamp("yesterday", 0)
+	assert.Error(t, err)
+}
+
 func TestValidateUsername(t *testing.T) {
 	// only spaces
 	res := ValidateUsername(" ")
@@ -433,6 +433,8 @@ func (broker *globalMetaBroker) generateSegmentLoadInfo(ctx context.Context,
 		Statslogs:     segmentBinlog.Statslogs,
 		Deltalogs:     segmentBinlog.Deltalogs,
 		InsertChannel: segmentBinlog.InsertChannel,
+
+		ClusteringKeyRange: segmentBinlog.ClusteringKeyRange,
 	}
 	if setIndex {
 		// if index not exist, load binlog to query node
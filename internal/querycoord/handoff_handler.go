@@ -293,7 +293,14 @@ func (handler *HandoffHandler) process(segmentID int64) error {
 			log.Info("HandoffHandler: collection/partition has not been loaded, task canceled", zap.Int64("segmentID", task.segmentInfo.SegmentID))
 			return ErrHandoffRequestInvalid
 		}
-		handler.triggerHandoff(task)
+		tasks, ready := handler.getSiblingTasks(task)
+		if !ready {
+			// the compacted segments could be released only if all the segments compacted to are loaded
+			log.Info("HandoffHandler: wait for compaction siblings to be ready", zap.Int64("segmentID", task.segmentInfo.SegmentID),
+				zap.Int64s("siblings", task.segmentInfo.GetCompactionSiblings()))
+			return nil
+		}
+		handler.triggerHandoff(tasks...)
 	}
 	// handoffTaskTriggered state don't need to be handled in the loop, it will handled by the go routine in triggerHandoff
 
@@ -344,19 +351,50 @@ func (handler *HandoffHandler) getOverrideSegments(parentTask *HandOffTask) []in
 	return toRelease
 }
 
-func (handler *HandoffHandler) triggerHandoff(task *HandOffTask) {
-	log.Info("HandoffHandler: trigger handoff", zap.Any("segmentInfo", task.segmentInfo))
+// getSiblingTasks returns the tasks of the segments produced by the same compaction, which are handed off together,
+// ready is false if any of them is not ready yet.
+func (handler *HandoffHandler) getSiblingTasks(task *HandOffTask) ([]*HandOffTask, bool) {
+	siblings := task.segmentInfo.GetCompactionSiblings()
+	if len(siblings) <= 1 {
+		return []*HandOffTask{task}, true
+	}
+	tasks := make([]*HandOffTask, 0, len(siblings))
+	for _, segmentID := range siblings {
+		sibling, ok := handler.tasks[segmentID]
+		if !ok || sibling.state != handoffTaskReady {
+			return nil, false
+		}
+		tasks = append(tasks, sibling)
+	}
+	return tasks, true
+}
+
+func (handler *HandoffHandler) triggerHandoff(tasks ...*HandOffTask) {
 	baseTask := newBaseTask(handler.ctx, querypb.TriggerCondition_Handoff)
 
-	// if recursive compaction happened, previous segment also need to be released
-	toRelease := handler.getOverrideSegments(task)
-	toRelease = append(toRelease, task.segmentInfo.GetCompactionFrom()...)
+	var segmentIDs []UniqueID
+	var segmentInfos []*querypb.SegmentInfo
+	var toRelease []UniqueID
+	released := make(map[UniqueID]struct{})
+	for _, task := range tasks {
+		log.Info("HandoffHandler: trigger handoff", zap.Any("segmentInfo", task.segmentInfo))
+		segmentIDs = append(segmentIDs, task.segmentInfo.SegmentID)
+		segmentInfos = append(segmentInfos, task.segmentInfo)
+
+		// if recursive compaction happened, previous segment also need to be released
+		for _, segmentID := range append(handler.getOverrideSegments(task), task.segmentInfo.GetCompactionFrom()...) {
+			if _, ok := released[segmentID]; !ok {
+				released[segmentID] = struct{}{}
+				toRelease = append(toRelease, segmentID)
+			}
+		}
+	}
 
 	handoffReq := &querypb.HandoffSegmentsRequest{
 		Base: &commonpb.MsgBase{
 			MsgType: commonpb.MsgType_HandoffSegments,
 		},
-		SegmentInfos:     []*querypb.SegmentInfo{task.segmentInfo},
+		SegmentInfos:     segmentInfos,
 		ReleasedSegments: toRelease,
 	}
 	handoffTask := &handoffTask{
@@ -370,13 +408,15 @@ func (handler *HandoffHandler) triggerHandoff(task *HandOffTask) {
 	if err != nil {
 		// we just wait for next cycle for reschedule
 		log.Error("HandoffHandler: handoffTask enqueue failed",
-			zap.Int64("segmentID", task.segmentInfo.SegmentID),
+			zap.Int64s("segmentIDs", segmentIDs),
 			zap.Error(err))
 		return
 	}
-	log.Info("HandoffHandler: handoff task triggered successfully", zap.Int64("segmentID", task.segmentInfo.SegmentID))
-	handler.tasks[task.segmentInfo.SegmentID] = &HandOffTask{
-		task.segmentInfo, handoffTaskTriggered, task.locked,
+	log.Info("HandoffHandler: handoff task triggered successfully", zap.Int64s("segmentIDs", segmentIDs))
+	for _, task := range tasks {
+		handler.tasks[task.segmentInfo.SegmentID] = &HandOffTask{
+			task.segmentInfo, handoffTaskTriggered, task.locked,
+		}
 	}
 	go func() {
 		err := handoffTask.waitToFinish()
@@ -384,18 +424,22 @@ func (handler *HandoffHandler) triggerHandoff(task *HandOffTask) {
 		defer handler.taskMutex.Unlock()
 		if err != nil {
 			log.Warn("HandoffHandler: handoff task failed to execute",
-				zap.Int64("segmentID", task.segmentInfo.SegmentID),
+				zap.Int64s("segmentIDs", segmentIDs),
 				zap.Error(err))
 			// wait for reschedule
-			handler.tasks[task.segmentInfo.SegmentID] = &HandOffTask{
-				task.segmentInfo, handoffTaskReady, task.locked,
+			for _, task := range tasks {
+				handler.tasks[task.segmentInfo.SegmentID] = &HandOffTask{
+					task.segmentInfo, handoffTaskReady, task.locked,
+				}
 			}
 			return
 		}
 		// wait for cleanup
-		log.Info("HandoffHandler: handoffTask completed", zap.Int64("segmentID", task.segmentInfo.SegmentID))
-		handler.tasks[task.segmentInfo.SegmentID] = &HandOffTask{
-			task.segmentInfo, handoffTaskDone, task.locked,
+		log.Info("HandoffHandler: handoffTask completed", zap.Int64s("segmentIDs", segmentIDs))
+		for _, task := range tasks {
+			handler.tasks[task.segmentInfo.SegmentID] = &HandOffTask{
+				task.segmentInfo, handoffTaskDone, task.locked,
+			}
 		}
 	}()
 }
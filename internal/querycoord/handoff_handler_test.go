@@ -328,6 +328,78 @@ func TestHandoff(t *testing.T) {
 		assert.Equal(t, 0, dataCoord.segmentRefCount[newSegment.SegmentID])
 	})
 
+	t.Run("Test_SiblingsHandoffTogether", func(t *testing.T) {
+		meta.addCollection(defaultCollectionID, querypb.LoadType_LoadCollection, genDefaultCollectionSchema(false))
+		defer meta.releaseCollection(defaultCollectionID)
+
+		handoffHandler, err := newHandoffHandler(ctx, kv, meta, nil, taskScheduler, broker)
+		assert.Nil(t, err)
+
+		siblings := []UniqueID{defaultSegmentID + 2, defaultSegmentID + 3}
+		var keys []string
+		for _, segmentID := range siblings {
+			sibling := &querypb.SegmentInfo{
+				SegmentID:          segmentID,
+				CollectionID:       defaultCollectionID,
+				PartitionID:        defaultPartitionID,
+				SegmentState:       commonpb.SegmentState_Sealed,
+				CompactionFrom:     []UniqueID{defaultSegmentID},
+				CompactionSiblings: siblings,
+			}
+			key := fmt.Sprintf("%s/%d/%d/%d", util.HandoffSegmentPrefix, defaultCollectionID, defaultPartitionID, segmentID)
+			value, err := proto.Marshal(sibling)
+			assert.NoError(t, err)
+			err = kv.Save(key, string(value))
+			assert.NoError(t, err)
+			keys = append(keys, key)
+			handoffHandler.enqueue(sibling)
+		}
+
+		// Init -> Ready
+		err = handoffHandler.process(siblings[0])
+		assert.NoError(t, err)
+		// wait for the sibling to be ready
+		err = handoffHandler.process(siblings[0])
+		assert.NoError(t, err)
+		assert.Equal(t, handoffTaskReady, handoffHandler.tasks[siblings[0]].state)
+
+		// Init -> Ready
+		err = handoffHandler.process(siblings[1])
+		assert.NoError(t, err)
+		// Ready -> Triggered, together with the sibling
+		err = handoffHandler.process(siblings[1])
+		assert.NoError(t, err)
+		assert.Equal(t, handoffTaskTriggered, handoffHandler.tasks[siblings[0]].state)
+		assert.Equal(t, handoffTaskTriggered, handoffHandler.tasks[siblings[1]].state)
+
+		for _, segmentID := range siblings {
+			for {
+				task, ok := handoffHandler.tasks[segmentID]
+				if !ok {
+					break
+				}
+
+				// Mock the task has succeeded
+				if task.state == handoffTaskTriggered {
+					task.state = handoffTaskDone
+					continue
+				}
+
+				err := handoffHandler.process(segmentID)
+				assert.NoError(t, err)
+			}
+		}
+
+		for _, key := range keys {
+			for {
+				_, err := kv.Load(key)
+				if err != nil {
+					break
+				}
+			}
+		}
+	})
+
 	t.Run("Test_Handoff", func(t *testing.T) {
 		meta.addCollection(defaultCollectionID, querypb.LoadType_LoadCollection, genDefaultCollectionSchema(false))
 
@@ -913,6 +913,124 @@ func (qc *QueryCoord) LoadBalance(ctx context.Context, req *querypb.LoadBalanceR
 	return status, nil
 }
 
+// AlterShards makes the loaded replicas of the collection watch the newly added dml channels
+func (qc *QueryCoord) AlterShards(ctx context.Context, req *querypb.AlterShardsRequest) (*commonpb.Status, error) {
+	log.Info("alterShardsRequest received",
+		zap.String("role", typeutil.QueryCoordRole),
+		zap.Int64("collectionID", req.CollectionID),
+		zap.Strings("channelNames", req.ChannelNames),
+		zap.Int64("msgID", req.GetBase().GetMsgID()))
+
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("QueryCoord is not healthy")
+		status.Reason = err.Error()
+		log.Warn("alterShards failed", zap.String("role", typeutil.QueryCoordRole), zap.Int64("msgID", req.GetBase().GetMsgID()), zap.Error(err))
+		return status, nil
+	}
+
+	// the new channels will be watched when the collection is loaded
+	if !qc.meta.hasCollection(req.CollectionID) {
+		log.Info("alterShardsRequest completed, collection not loaded",
+			zap.String("role", typeutil.QueryCoordRole),
+			zap.Int64("collectionID", req.CollectionID),
+			zap.Int64("msgID", req.GetBase().GetMsgID()))
+		return status, nil
+	}
+
+	baseTask := newBaseTask(qc.loopCtx, querypb.TriggerCondition_GrpcRequest)
+	alterShardsTask := &alterShardsTask{
+		baseTask:           baseTask,
+		AlterShardsRequest: req,
+		broker:             qc.broker,
+		cluster:            qc.cluster,
+		meta:               qc.meta,
+	}
+	err := qc.scheduler.Enqueue(alterShardsTask)
+	if err != nil {
+		log.Warn("alterShardsRequest failed to add execute task to scheduler",
+			zap.String("role", typeutil.QueryCoordRole),
+			zap.Int64("msgID", req.GetBase().GetMsgID()),
+			zap.Error(err))
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		status.Reason = err.Error()
+		return status, nil
+	}
+
+	err = alterShardsTask.waitToFinish()
+	if err != nil {
+		log.Warn("alterShardsRequest failed", zap.String("role", typeutil.QueryCoordRole), zap.Int64("msgID", req.GetBase().GetMsgID()), zap.Error(err))
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		status.Reason = err.Error()
+		return status, nil
+	}
+
+	log.Info("alterShardsRequest completed",
+		zap.String("role", typeutil.QueryCoordRole),
+		zap.Int64("collectionID", req.CollectionID),
+		zap.Strings("channelNames", req.ChannelNames),
+		zap.Int64("msgID", req.GetBase().GetMsgID()))
+
+	return status, nil
+}
+
+// TruncateSegments removes the segments dropped by truncation from the meta, the shard leaders release them from the query nodes
+func (qc *QueryCoord) TruncateSegments(ctx context.Context, req *querypb.TruncateSegmentsRequest) (*commonpb.Status, error) {
+	log.Info("truncateSegmentsRequest received",
+		zap.String("role", typeutil.QueryCoordRole),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("segmentIDs", req.GetSegmentIDs()),
+		zap.Int64("msgID", req.GetBase().GetMsgID()))
+
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("QueryCoord is not healthy")
+		status.Reason = err.Error()
+		log.Warn("truncateSegments failed", zap.String("role", typeutil.QueryCoordRole), zap.Int64("msgID", req.GetBase().GetMsgID()), zap.Error(err))
+		return status, nil
+	}
+
+	if !qc.meta.hasCollection(req.GetCollectionID()) {
+		log.Info("truncateSegmentsRequest completed, collection not loaded",
+			zap.String("role", typeutil.QueryCoordRole),
+			zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Int64("msgID", req.GetBase().GetMsgID()))
+		return status, nil
+	}
+
+	// the growing segments are removed by the query nodes when consuming the truncate msg
+	var removes []*querypb.SegmentInfo
+	for _, segmentID := range req.GetSegmentIDs() {
+		info, err := qc.meta.getSegmentInfoByID(segmentID)
+		if err != nil || info.GetCollectionID() != req.GetCollectionID() {
+			continue
+		}
+		removes = append(removes, info)
+	}
+	if len(removes) > 0 {
+		err := qc.meta.saveGlobalSealedSegInfos(nil, col2SegmentInfos{req.GetCollectionID(): removes})
+		if err != nil {
+			log.Warn("truncateSegments failed to remove sealed segments", zap.String("role", typeutil.QueryCoordRole), zap.Int64("msgID", req.GetBase().GetMsgID()), zap.Error(err))
+			status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			status.Reason = err.Error()
+			return status, nil
+		}
+	}
+
+	log.Info("truncateSegmentsRequest completed",
+		zap.String("role", typeutil.QueryCoordRole),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int("removed", len(removes)),
+		zap.Int64("msgID", req.GetBase().GetMsgID()))
+	return status, nil
+}
+
 // GetMetrics returns all the queryCoord's metrics
 func (qc *QueryCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	log.Debug("getMetricsRequest received",
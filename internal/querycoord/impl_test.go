@@ -640,6 +640,17 @@ func TestGrpcTaskBeforeHealthy(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("Test TruncateSegments", func(t *testing.T) {
+		status, err := unHealthyCoord.TruncateSegments(ctx, &querypb.TruncateSegmentsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_TruncateCollection,
+			},
+			CollectionID: defaultCollectionID,
+		})
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+		assert.Nil(t, err)
+	})
+
 	t.Run("Test ShowPartitions", func(t *testing.T) {
 		res, err := unHealthyCoord.ShowPartitions(ctx, &querypb.ShowPartitionsRequest{
 			Base: &commonpb.MsgBase{
@@ -1239,6 +1250,55 @@ func Test_LoadAndReleaseCollection(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_LoadAndTruncateSegments(t *testing.T) {
+	refreshParams()
+	ctx := context.Background()
+	queryCoord, err := startQueryCoord(ctx)
+	assert.Nil(t, err)
+
+	node, err := startQueryNodeServer(ctx)
+	assert.Nil(t, err)
+
+	waitQueryNodeOnline(queryCoord.cluster, node.queryNodeID)
+	loadCollectionReq := &querypb.LoadCollectionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_LoadCollection,
+		},
+		CollectionID:  defaultCollectionID,
+		Schema:        genDefaultCollectionSchema(false),
+		ReplicaNumber: 1,
+	}
+	status, err := queryCoord.LoadCollection(ctx, loadCollectionReq)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	assert.Nil(t, err)
+	waitLoadCollectionDone(ctx, queryCoord, defaultCollectionID)
+
+	var segmentIDs []UniqueID
+	for _, info := range queryCoord.meta.showSegmentInfos(defaultCollectionID, nil) {
+		segmentIDs = append(segmentIDs, info.GetSegmentID())
+	}
+	assert.NotEmpty(t, segmentIDs)
+
+	status, err = queryCoord.TruncateSegments(ctx, &querypb.TruncateSegmentsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_TruncateCollection,
+		},
+		CollectionID: defaultCollectionID,
+		SegmentIDs:   segmentIDs,
+	})
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	assert.Nil(t, err)
+
+	// the segments are removed while the collection is kept loaded
+	assert.Empty(t, queryCoord.meta.showSegmentInfos(defaultCollectionID, nil))
+	assert.True(t, queryCoord.meta.hasCollection(defaultCollectionID))
+
+	node.stop()
+	queryCoord.Stop()
+	err = removeAllSession()
+	assert.Nil(t, err)
+}
+
 func Test_LoadAndReleasePartitions(t *testing.T) {
 	refreshParams()
 	ctx := context.Background()
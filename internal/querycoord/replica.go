@@ -206,13 +206,23 @@ func (rep *ReplicaInfos) UpdateShardLeader(replicaID UniqueID, dmChannel string,
 		return fmt.Errorf("replica %v not found", replicaID)
 	}
 
+	found := false
 	for _, shard := range replica.ShardReplicas {
 		if shard.DmChannelName == dmChannel {
 			shard.LeaderID = leaderID
 			shard.LeaderAddr = leaderAddr
+			found = true
 			break
 		}
 	}
+	// the shard may be newly added by AlterShards
+	if !found {
+		replica.ShardReplicas = append(replica.ShardReplicas, &milvuspb.ShardReplica{
+			LeaderID:      leaderID,
+			LeaderAddr:    leaderAddr,
+			DmChannelName: dmChannel,
+		})
+	}
 
 	err := saveReplica(meta, replica)
 	if err != nil {
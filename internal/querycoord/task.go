@@ -2441,6 +2441,230 @@ func (lbt *loadBalanceTask) globalPostExecute(ctx context.Context) error {
 	return nil
 }
 
+// alterShardsTask makes the replicas of a loaded collection watch the dml channels
+// newly added to the collection by rootCoord
+type alterShardsTask struct {
+	*baseTask
+	*querypb.AlterShardsRequest
+	broker  *globalMetaBroker
+	cluster Cluster
+	meta    Meta
+}
+
+func (ast *alterShardsTask) msgBase() *commonpb.MsgBase {
+	return ast.Base
+}
+
+func (ast *alterShardsTask) marshal() ([]byte, error) {
+	return proto.Marshal(ast.AlterShardsRequest)
+}
+
+func (ast *alterShardsTask) msgType() commonpb.MsgType {
+	return ast.Base.MsgType
+}
+
+func (ast *alterShardsTask) timestamp() Timestamp {
+	return ast.Base.Timestamp
+}
+
+func (ast *alterShardsTask) preExecute(context.Context) error {
+	ast.setResultInfo(nil)
+	log.Info("start do alterShardsTask",
+		zap.Int64("collectionID", ast.CollectionID),
+		zap.Strings("channelNames", ast.ChannelNames),
+		zap.Int64("taskID", ast.getTaskID()))
+	if len(ast.ChannelNames) == 0 {
+		err := errors.New("alterShardsTask: empty channel list to watch")
+		log.Error(err.Error())
+		ast.setResultInfo(err)
+		return err
+	}
+	return nil
+}
+
+func (ast *alterShardsTask) execute(ctx context.Context) error {
+	defer ast.reduceRetryCount()
+	collectionID := ast.CollectionID
+
+	collectionInfo, err := ast.meta.getCollectionInfoByID(collectionID)
+	if err != nil {
+		log.Error("alterShardsTask: get collectionInfo from meta failed", zap.Int64("taskID", ast.getTaskID()), zap.Int64("collectionID", collectionID), zap.Error(err))
+		ast.setResultInfo(err)
+		return err
+	}
+	schema := collectionInfo.Schema
+
+	var partitionIDs []UniqueID
+	if collectionInfo.LoadType == querypb.LoadType_LoadCollection {
+		partitionIDs, err = ast.broker.showPartitionIDs(ctx, collectionID)
+		if err != nil {
+			log.Error("alterShardsTask: show collection's partitionIDs failed", zap.Int64("taskID", ast.getTaskID()), zap.Int64("collectionID", collectionID), zap.Error(err))
+			ast.setResultInfo(err)
+			return err
+		}
+	} else {
+		partitionIDs = collectionInfo.PartitionIDs
+	}
+
+	newChannels := make(map[string]struct{}, len(ast.ChannelNames))
+	for _, channel := range ast.ChannelNames {
+		newChannels[channel] = struct{}{}
+	}
+
+	deltaChannelInfos, err := ast.meta.getDeltaChannelsByCollectionID(collectionID)
+	if err != nil {
+		log.Error("alterShardsTask: get delta channels from meta failed", zap.Int64("taskID", ast.getTaskID()), zap.Int64("collectionID", collectionID), zap.Error(err))
+		ast.setResultInfo(err)
+		return err
+	}
+	var dmChannelInfos []*datapb.VchannelInfo
+	for _, partitionID := range partitionIDs {
+		vChannelInfos, _, err := ast.broker.getRecoveryInfo(ctx, collectionID, partitionID)
+		if err != nil {
+			log.Error("alterShardsTask: getRecoveryInfo failed", zap.Int64("taskID", ast.getTaskID()), zap.Int64("collectionID", collectionID), zap.Int64("partitionID", partitionID), zap.Error(err))
+			ast.setResultInfo(err)
+			return err
+		}
+		for _, info := range vChannelInfos {
+			if _, ok := newChannels[info.ChannelName]; !ok {
+				continue
+			}
+			deltaChannel, err := generateWatchDeltaChannelInfo(info)
+			if err != nil {
+				log.Error("alterShardsTask: generateWatchDeltaChannelInfo failed", zap.Int64("taskID", ast.getTaskID()), zap.Int64("collectionID", collectionID), zap.String("channelName", info.ChannelName), zap.Error(err))
+				ast.setResultInfo(err)
+				return err
+			}
+			deltaChannelInfos = append(deltaChannelInfos, deltaChannel)
+			dmChannelInfos = append(dmChannelInfos, info)
+		}
+	}
+
+	// setDeltaChannel overwrites the whole list, so keep the delta channels already watched
+	err = ast.meta.setDeltaChannel(collectionID, mergeWatchDeltaChannelInfo(deltaChannelInfos))
+	if err != nil {
+		log.Error("alterShardsTask: set delta channel info meta failed", zap.Int64("taskID", ast.getTaskID()), zap.Int64("collectionID", collectionID), zap.Error(err))
+		ast.setResultInfo(err)
+		return err
+	}
+
+	replicas, err := ast.meta.getReplicasByCollectionID(collectionID)
+	if err != nil {
+		log.Error("alterShardsTask: get replicas failed", zap.Int64("taskID", ast.getTaskID()), zap.Int64("collectionID", collectionID), zap.Error(err))
+		ast.setResultInfo(err)
+		return err
+	}
+
+	mergedDmChannel := mergeDmChannelInfo(dmChannelInfos)
+	var internalTasks []task
+	for _, replica := range replicas {
+		// the request may be retried, the channels served by the replica already are skipped
+		watched := make(map[string]struct{}, len(replica.GetShardReplicas()))
+		for _, shard := range replica.GetShardReplicas() {
+			watched[shard.GetDmChannelName()] = struct{}{}
+		}
+		watchDmChannelReqs := make([]*querypb.WatchDmChannelsRequest, 0, len(mergedDmChannel))
+		for _, vChannelInfo := range mergedDmChannel {
+			if _, ok := watched[vChannelInfo.GetChannelName()]; ok {
+				continue
+			}
+			msgBase := proto.Clone(ast.Base).(*commonpb.MsgBase)
+			msgBase.MsgType = commonpb.MsgType_WatchDmChannels
+			watchRequest := &querypb.WatchDmChannelsRequest{
+				Base:         msgBase,
+				CollectionID: collectionID,
+				Infos:        []*datapb.VchannelInfo{vChannelInfo},
+				Schema:       schema,
+				LoadMeta: &querypb.LoadMetaInfo{
+					LoadType:     collectionInfo.LoadType,
+					CollectionID: collectionID,
+					PartitionIDs: partitionIDs,
+				},
+				ReplicaID: replica.ReplicaID,
+			}
+			if collectionInfo.LoadType == querypb.LoadType_LoadPartition {
+				watchRequest.PartitionIDs = partitionIDs
+			}
+
+			fullWatchRequest, err := generateFullWatchDmChannelsRequest(ast.broker, watchRequest)
+			if err != nil {
+				ast.setResultInfo(err)
+				return err
+			}
+			watchDmChannelReqs = append(watchDmChannelReqs, fullWatchRequest)
+		}
+		if len(watchDmChannelReqs) == 0 {
+			continue
+		}
+
+		tasks, err := assignInternalTask(ctx, ast, ast.meta, ast.cluster, nil, watchDmChannelReqs, false, nil, replica.GetNodeIds(), replica.GetReplicaID(), ast.broker)
+		if err != nil {
+			log.Error("alterShardsTask: assign child task failed", zap.Int64("taskID", ast.getTaskID()), zap.Int64("replicaID", replica.GetReplicaID()), zap.Error(err))
+			ast.setResultInfo(err)
+			return err
+		}
+		internalTasks = append(internalTasks, tasks...)
+	}
+
+	for _, internalTask := range internalTasks {
+		ast.addChildTask(internalTask)
+		log.Info("alterShardsTask: add a childTask",
+			zap.Int64("taskID", ast.getTaskID()),
+			zap.Any("task", internalTask))
+	}
+	log.Info("alterShardsTask Execute done",
+		zap.Int64("collectionID", collectionID),
+		zap.Int("childTaskNum", len(internalTasks)),
+		zap.Int64("taskID", ast.getTaskID()))
+	return nil
+}
+
+func (ast *alterShardsTask) postExecute(context.Context) error {
+	if ast.getResultInfo().ErrorCode != commonpb.ErrorCode_Success {
+		ast.clearChildTasks()
+	}
+
+	log.Info("alterShardsTask postExecute done",
+		zap.Int64("collectionID", ast.CollectionID),
+		zap.Int64("taskID", ast.getTaskID()),
+		zap.Int("childTaskNum", len(ast.childTasks)))
+	return nil
+}
+
+func (ast *alterShardsTask) globalPostExecute(ctx context.Context) error {
+	// Register the new shard leaders on the replicas
+	for _, childTask := range ast.getChildTask() {
+		task, ok := childTask.(*watchDmChannelTask)
+		if !ok {
+			continue
+		}
+		leaderID := task.NodeID
+		dmChannel := task.Infos[0].ChannelName
+
+		nodeInfo, err := ast.cluster.GetNodeInfoByID(leaderID)
+		if err != nil {
+			log.Error("alterShardsTask: failed to get node info to update shard leader info",
+				zap.Int64("triggerTaskID", ast.getTaskID()),
+				zap.Int64("nodeID", leaderID),
+				zap.String("dmChannel", dmChannel),
+				zap.Error(err))
+			return err
+		}
+
+		err = ast.meta.updateShardLeader(task.ReplicaID, dmChannel, leaderID, nodeInfo.(*queryNode).address)
+		if err != nil {
+			log.Error("alterShardsTask: failed to update shard leader info of replica",
+				zap.Int64("triggerTaskID", ast.getTaskID()),
+				zap.Int64("replicaID", task.ReplicaID),
+				zap.String("dmChannel", dmChannel),
+				zap.Error(err))
+			return err
+		}
+	}
+
+	return nil
+}
+
 func assignInternalTask(ctx context.Context,
 	parentTask task, meta Meta, cluster Cluster,
 	loadSegmentRequests []*querypb.LoadSegmentsRequest,
@@ -429,6 +429,20 @@ func (scheduler *TaskScheduler) unmarshalTask(taskID UniqueID, t string) (task,
 			meta:               scheduler.meta,
 		}
 		newTask = loadBalanceTask
+	case commonpb.MsgType_AlterShards:
+		alterShardsReq := querypb.AlterShardsRequest{}
+		err = proto.Unmarshal([]byte(t), &alterShardsReq)
+		if err != nil {
+			return nil, err
+		}
+		alterShardsTask := &alterShardsTask{
+			baseTask:           baseTask,
+			AlterShardsRequest: &alterShardsReq,
+			broker:             scheduler.broker,
+			cluster:            scheduler.cluster,
+			meta:               scheduler.meta,
+		}
+		newTask = alterShardsTask
 	case commonpb.MsgType_HandoffSegments:
 		handoffReq := querypb.HandoffSegmentsRequest{}
 		err = proto.Unmarshal([]byte(t), &handoffReq)
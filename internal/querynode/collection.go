@@ -49,6 +49,8 @@ type Collection struct {
 	id            UniqueID
 	partitionIDs  []UniqueID
 	schema        *schemapb.CollectionSchema
+	// retiredPtrs are replaced by newer schema versions, plans created with them may be in use
+	retiredPtrs []C.CCollection
 
 	channelMu      sync.RWMutex
 	vChannels      []Channel
@@ -307,6 +309,35 @@ func (c *Collection) getFieldType(fieldID FieldID) (schemapb.DataType, error) {
 	return field.GetDataType(), nil
 }
 
+// updateSchema replaces the schema of collection if the schema version is newer, returns whether the schema
+// is replaced. The growing segments returned by growingSegments get the added fields with their default values,
+// the sealed segments keep their schema and serve the defaults when loading.
+func (c *Collection) updateSchema(schema *schemapb.CollectionSchema, growingSegments func() []*Segment) (bool, error) {
+	c.Lock()
+	defer c.Unlock()
+	if c.schema.GetVersion() >= schema.GetVersion() {
+		return false, nil
+	}
+
+	schemaBlob := proto.MarshalTextString(schema)
+	cSchemaBlob := C.CString(schemaBlob)
+	defer C.free(unsafe.Pointer(cSchemaBlob))
+
+	c.retiredPtrs = append(c.retiredPtrs, c.collectionPtr)
+	c.collectionPtr = C.NewCollection(cSchemaBlob)
+	c.schema = schema
+
+	log.Info("update collection schema", zap.Int64("collectionID", c.id), zap.Int32("version", schema.GetVersion()))
+
+	// growing segments are added and inserted into under the read lock, so none of them is missed
+	for _, segment := range growingSegments() {
+		if err := segment.addFields(c); err != nil {
+			return true, fmt.Errorf("failed to add fields to growing segment %d: %w", segment.ID(), err)
+		}
+	}
+	return true, nil
+}
+
 // newCollection returns a new Collection
 func newCollection(collectionID UniqueID, schema *schemapb.CollectionSchema) *Collection {
 	/*
@@ -340,8 +371,12 @@ func deleteCollection(collection *Collection) {
 	*/
 	cPtr := collection.collectionPtr
 	C.DeleteCollection(cPtr)
+	for _, retired := range collection.retiredPtrs {
+		C.DeleteCollection(retired)
+	}
 
 	collection.collectionPtr = nil
+	collection.retiredPtrs = nil
 
 	log.Info("delete collection", zap.Int64("collectionID", collection.ID()))
 
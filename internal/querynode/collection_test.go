@@ -17,11 +17,15 @@
 package querynode
 
 import (
+	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/util/concurrency"
 )
 
 func TestCollection_newCollection(t *testing.T) {
@@ -52,6 +56,66 @@ func TestCollection_schema(t *testing.T) {
 	deleteCollection(collection)
 }
 
+func TestCollection_updateSchema(t *testing.T) {
+	collectionID := UniqueID(0)
+	schema := genTestCollectionSchema()
+
+	collection := newCollection(collectionID, schema)
+	defer deleteCollection(collection)
+
+	newSchema := genTestCollectionSchema()
+	newSchema.Version = schema.GetVersion() + 1
+	newSchema.Fields = newSchema.Fields[:len(newSchema.Fields)-1]
+	noSegments := func() []*Segment { return nil }
+	updated, err := collection.updateSchema(newSchema, noSegments)
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	assert.Equal(t, len(newSchema.Fields), len(collection.Schema().Fields))
+
+	updated, err = collection.updateSchema(schema, noSegments)
+	assert.NoError(t, err)
+	assert.False(t, updated)
+	assert.Equal(t, newSchema.GetVersion(), collection.Schema().GetVersion())
+
+	t.Run("add fields to growing segments", func(t *testing.T) {
+		pool, err := concurrency.NewPool(runtime.GOMAXPROCS(0))
+		require.NoError(t, err)
+
+		// the double field is added after the segment is created
+		oldSchema := genTestCollectionSchema()
+		oldSchema.Fields = append(oldSchema.Fields[:5], oldSchema.Fields[6:]...)
+		collection := newCollection(collectionID, oldSchema)
+		defer deleteCollection(collection)
+		segment, err := newSegment(collection, defaultSegmentID, defaultPartitionID, collectionID, "", segmentTypeGrowing, pool)
+		require.NoError(t, err)
+		defer deleteSegment(segment)
+
+		insert := func(schema *schemapb.CollectionSchema) {
+			insertMsg, err := genSimpleInsertMsg(schema, defaultMsgLength)
+			require.NoError(t, err)
+			offset, err := segment.segmentPreInsert(defaultMsgLength)
+			require.NoError(t, err)
+			err = segment.segmentInsert(offset, insertMsg.RowIDs, insertMsg.Timestamps, &segcorepb.InsertRecord{
+				FieldsData: insertMsg.FieldsData,
+				NumRows:    int64(insertMsg.NumRows),
+			})
+			require.NoError(t, err)
+		}
+		insert(oldSchema)
+
+		newSchema := genTestCollectionSchema()
+		newSchema.Version = oldSchema.GetVersion() + 1
+		updated, err := collection.updateSchema(newSchema, func() []*Segment { return []*Segment{segment} })
+		assert.NoError(t, err)
+		assert.True(t, updated)
+		assert.Equal(t, newSchema, segment.schema)
+		assert.Equal(t, int64(defaultMsgLength), segment.getRowCount())
+
+		insert(newSchema)
+		assert.Equal(t, int64(2*defaultMsgLength), segment.getRowCount())
+	})
+}
+
 func TestCollection_vChannel(t *testing.T) {
 	collectionID := UniqueID(0)
 	schema := genTestCollectionSchema()
@@ -114,6 +114,40 @@ func (fdmNode *filterDmNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			if resMsg != nil {
 				iMsg.deleteMessages = append(iMsg.deleteMessages, resMsg)
 			}
+		case commonpb.MsgType_TruncateCollection, commonpb.MsgType_TruncatePartition:
+			tMsg := msg.(*msgstream.TruncateMsg)
+			if tMsg.GetCollectionID() != fdmNode.collectionID {
+				continue
+			}
+			log.Info("truncate msg received in filterDmNode",
+				zap.Int64("collectionID", tMsg.GetCollectionID()),
+				zap.Int64("partitionID", tMsg.GetPartitionID()),
+				zap.Int64s("segmentIDs", tMsg.GetSegmentIDs()),
+				zap.String("channel", fdmNode.channel))
+			// the inserts before the truncate msg are dropped with the segments
+			truncated := make(map[UniqueID]struct{}, len(tMsg.GetSegmentIDs()))
+			for _, segmentID := range tMsg.GetSegmentIDs() {
+				truncated[segmentID] = struct{}{}
+			}
+			insertMessages := iMsg.insertMessages[:0]
+			for _, insertMsg := range iMsg.insertMessages {
+				if _, ok := truncated[insertMsg.GetSegmentID()]; !ok {
+					insertMessages = append(insertMessages, insertMsg)
+				}
+			}
+			iMsg.insertMessages = insertMessages
+			iMsg.truncatedSegments = append(iMsg.truncatedSegments, tMsg.GetSegmentIDs()...)
+		case commonpb.MsgType_AddField, commonpb.MsgType_DropField:
+			asMsg := msg.(*msgstream.AlterSchemaMsg)
+			if asMsg.GetCollectionID() != fdmNode.collectionID {
+				continue
+			}
+			log.Info("alter schema msg received in filterDmNode",
+				zap.Int64("collectionID", asMsg.GetCollectionID()),
+				zap.String("fieldName", asMsg.GetFieldName()),
+				zap.Int32("schemaVersion", asMsg.GetSchema().GetVersion()),
+				zap.String("channel", fdmNode.channel))
+			iMsg.schema = asMsg.GetSchema()
 		default:
 			log.Warn("invalid message type in filterDmNode",
 				zap.String("message type", msg.Type().String()),
@@ -275,4 +275,47 @@ func TestFlowGraphFilterDmNode_Operate(t *testing.T) {
 		res := fg.Operate([]flowgraph.Msg{msg})
 		assert.NotNil(t, res)
 	})
+
+	t.Run("truncate msg", func(t *testing.T) {
+		iMsg, err := genSimpleInsertMsg(schema, defaultMsgLength)
+		assert.NoError(t, err)
+		tMsg := &msgstream.TruncateMsg{
+			BaseMsg: genMsgStreamBaseMsg(),
+			TruncateRequest: internalpb.TruncateRequest{
+				Base:         genCommonMsgBase(commonpb.MsgType_TruncateCollection),
+				CollectionID: defaultCollectionID,
+				SegmentIDs:   []UniqueID{defaultSegmentID},
+			},
+		}
+		msg := flowgraph.GenerateMsgStreamMsg([]msgstream.TsMsg{iMsg, tMsg}, 0, 1000, nil, nil)
+
+		fg, err := getFilterDMNode()
+		assert.NoError(t, err)
+		res := fg.Operate([]flowgraph.Msg{msg})
+		assert.Len(t, res, 1)
+		resMsg := res[0].(*insertMsg)
+		assert.Empty(t, resMsg.insertMessages)
+		assert.Equal(t, []UniqueID{defaultSegmentID}, resMsg.truncatedSegments)
+	})
+
+	t.Run("alter schema msg", func(t *testing.T) {
+		newSchema := genTestCollectionSchema()
+		newSchema.Version = schema.GetVersion() + 1
+		aMsg := &msgstream.AlterSchemaMsg{
+			BaseMsg: genMsgStreamBaseMsg(),
+			AlterSchemaRequest: internalpb.AlterSchemaRequest{
+				Base:         genCommonMsgBase(commonpb.MsgType_AddField),
+				CollectionID: defaultCollectionID,
+				Schema:       newSchema,
+			},
+		}
+		msg := flowgraph.GenerateMsgStreamMsg([]msgstream.TsMsg{aMsg}, 0, 1000, nil, nil)
+
+		fg, err := getFilterDMNode()
+		assert.NoError(t, err)
+		res := fg.Operate([]flowgraph.Msg{msg})
+		assert.Len(t, res, 1)
+		resMsg := res[0].(*insertMsg)
+		assert.Equal(t, newSchema.GetVersion(), resMsg.schema.GetVersion())
+	})
 }
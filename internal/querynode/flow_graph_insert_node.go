@@ -110,8 +110,24 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		// QueryNode should add collection before start flow graph
 		panic(fmt.Errorf("%s getCollectionByID failed, collectionID = %d, channel: %s", iNode.Name(), iNode.collectionID, iNode.channel))
 	}
+	// the existing growing segments get the added fields, and the ones created afterwards use the new schema
+	if iMsg.schema != nil {
+		if err := iNode.metaReplica.updateCollectionSchema(iNode.collectionID, iMsg.schema); err != nil {
+			panic(fmt.Errorf("%s updateCollectionSchema failed, collectionID = %d, channel: %s, err: %w", iNode.Name(), iNode.collectionID, iNode.channel, err))
+		}
+	}
 	collection.RLock()
 	defer collection.RUnlock()
+
+	// the truncated growing segments are removed before the inserts and deletes of this pack
+	for _, segmentID := range iMsg.truncatedSegments {
+		log.Info("insertNode remove truncated segment",
+			zap.Int64("segmentID", segmentID),
+			zap.Int64("collection", iNode.collectionID),
+			zap.String("channel", iNode.channel))
+		iNode.metaReplica.removeSegment(segmentID, segmentTypeGrowing)
+	}
+
 	// 1. hash insertMessages to insertData
 	// sort timestamps ensures that the data in iData.insertRecords is sorted in ascending order of timestamp
 	// avoiding re-sorting in segCore, which will need data copying
@@ -146,7 +162,14 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			}
 		}
 
-		insertRecord, err := storage.TransferInsertMsgToInsertRecord(collection.schema, insertMsg)
+		// the growing segment keeps the schema when it was created
+		segment, err := iNode.metaReplica.getSegmentByID(insertMsg.SegmentID, segmentTypeGrowing)
+		if err != nil {
+			err = fmt.Errorf("insertNode getSegmentByID failed, err = %s", err)
+			log.Error(err.Error(), zap.Int64("collection", iNode.collectionID), zap.String("channel", iNode.channel))
+			panic(err)
+		}
+		insertRecord, err := storage.TransferInsertMsgToInsertRecord(segment.schema, insertMsg)
 		if err != nil {
 			// occurs only when schema doesn't have dim param, this should not happen
 			err = fmt.Errorf("failed to transfer msgStream.insertMsg to storage.InsertRecord, err = %s", err)
@@ -463,6 +486,17 @@ func getPKsFromRowBasedInsertMsg(msg *msgstream.InsertMsg, schema *schemapb.Coll
 					break
 				}
 			}
+		case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+			for _, t := range field.TypeParams {
+				if t.Key == "dim" {
+					dim, err := strconv.Atoi(t.Value)
+					if err != nil {
+						return nil, fmt.Errorf("strconv wrong on get dim, err = %s", err)
+					}
+					offset += dim * 2
+					break
+				}
+			}
 		}
 	}
 
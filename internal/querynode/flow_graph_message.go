@@ -18,6 +18,7 @@ package querynode
 
 import (
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 )
 
@@ -32,6 +33,10 @@ type insertMsg struct {
 	insertMessages []*msgstream.InsertMsg
 	deleteMessages []*msgstream.DeleteMsg
 	timeRange      TimeRange
+	// truncatedSegments are the growing segments removed by truncation
+	truncatedSegments []UniqueID
+	// schema is the new collection schema after fields are added or dropped
+	schema *schemapb.CollectionSchema
 }
 
 // deleteMsg is an implementation of interface Msg
@@ -581,6 +581,9 @@ func (node *QueryNode) Search(ctx context.Context, req *queryPb.SearchRequest) (
 		},
 	}
 	toReduceResults := make([]*internalpb.SearchResults, 0)
+	// the results of the channels are pushed to the proxy directly for the streaming search
+	streamResults := req.GetReq().GetStreamResults() && !req.GetFromShardLeader()
+	pushed := 0
 	runningGp, runningCtx := errgroup.WithContext(ctx)
 	mu := &sync.Mutex{}
 	for _, ch := range req.GetDmlChannels() {
@@ -594,6 +597,9 @@ func (node *QueryNode) Search(ctx context.Context, req *queryPb.SearchRequest) (
 		}
 		runningGp.Go(func() error {
 			ret, err := node.searchWithDmlChannel(runningCtx, req, ch)
+			// the result is buffered as usual if it fails to be pushed
+			isPushed := streamResults && err == nil && ret.GetStatus().GetErrorCode() == commonpb.ErrorCode_Success &&
+				node.resultPusher.pushSearchResult(runningCtx, req.GetReq(), ret) == nil
 			mu.Lock()
 			defer mu.Unlock()
 			if err != nil {
@@ -606,6 +612,10 @@ func (node *QueryNode) Search(ctx context.Context, req *queryPb.SearchRequest) (
 				failRet.Status.ErrorCode = ret.Status.ErrorCode
 				return fmt.Errorf("%s", ret.Status.Reason)
 			}
+			if isPushed {
+				pushed++
+				return nil
+			}
 			toReduceResults = append(toReduceResults, ret)
 			return nil
 		})
@@ -613,7 +623,24 @@ func (node *QueryNode) Search(ctx context.Context, req *queryPb.SearchRequest) (
 	if err := runningGp.Wait(); err != nil {
 		return failRet, nil
 	}
-	ret, err := reduceSearchResults(toReduceResults, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType())
+	if pushed > 0 && len(toReduceResults) == 0 {
+		// all the results have been pushed, the proxy reduces them
+		return &internalpb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			MetricType: req.GetReq().GetMetricType(),
+			NumQueries: req.GetReq().GetNq(),
+			TopK:       req.GetReq().GetTopk(),
+		}, nil
+	}
+	groupBy, err := newGroupByInfo(req.Req.GetGroupByFieldId(), req.Req.GetGroupSize(), req.Req.GetOutputFieldsId())
+	if err != nil {
+		failRet.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		failRet.Status.Reason = err.Error()
+		return failRet, nil
+	}
+	ret, err := reduceSearchResults(toReduceResults, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType(), groupBy)
 	if err != nil {
 		failRet.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
 		failRet.Status.Reason = err.Error()
@@ -756,7 +783,12 @@ func (node *QueryNode) searchWithDmlChannel(ctx context.Context, req *queryPb.Se
 		msgID, req.GetFromShardLeader(), dmlChannel, req.GetSegmentIDs()))
 
 	results = append(results, streamingResult)
-	ret, err2 := reduceSearchResults(results, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType())
+	groupBy, err2 := newGroupByInfo(req.Req.GetGroupByFieldId(), req.Req.GetGroupSize(), req.Req.GetOutputFieldsId())
+	if err2 != nil {
+		failRet.Status.Reason = err2.Error()
+		return failRet, nil
+	}
+	ret, err2 := reduceSearchResults(results, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType(), groupBy)
 	if err2 != nil {
 		failRet.Status.Reason = err2.Error()
 		return failRet, nil
@@ -904,6 +936,7 @@ func (node *QueryNode) queryWithDmlChannel(ctx context.Context, req *queryPb.Que
 		failRet.Status.Reason = err2.Error()
 		return failRet, nil
 	}
+	limitRetrieveResult(ret, req.GetReq().GetLimit())
 
 	tr.Elapse(fmt.Sprintf("do query done, msgID = %d, fromSharedLeader = %t, vChannel = %s, segmentIDs = %v",
 		msgID, req.GetFromShardLeader(), dmlChannel, req.GetSegmentIDs()))
@@ -930,6 +963,8 @@ func (node *QueryNode) Query(ctx context.Context, req *querypb.QueryRequest) (*i
 	}
 
 	toMergeResults := make([]*internalpb.RetrieveResults, 0)
+	// the results of the channels are pushed to the proxy directly for the streaming query
+	streamResults := req.GetReq().GetStreamResults() && !req.GetFromShardLeader()
 	runningGp, runningCtx := errgroup.WithContext(ctx)
 	mu := &sync.Mutex{}
 
@@ -944,6 +979,21 @@ func (node *QueryNode) Query(ctx context.Context, req *querypb.QueryRequest) (*i
 		}
 		runningGp.Go(func() error {
 			ret, err := node.queryWithDmlChannel(runningCtx, req, ch)
+			if streamResults && err == nil && ret.GetStatus().GetErrorCode() == commonpb.ErrorCode_Success {
+				started, pushErr := node.resultPusher.pushRetrieveResult(runningCtx, req.GetReq(), ret, Params.QueryNodeCfg.StreamResultChunkRows)
+				switch {
+				case pushErr == nil:
+					// only the profiles are kept for the explain
+					ret = &internalpb.RetrieveResults{
+						Status:   ret.GetStatus(),
+						Profiles: ret.GetProfiles(),
+					}
+				case started:
+					// the chunks pushed can't be taken back, so the result can't be buffered instead
+					err = fmt.Errorf("failed to push the query results of channel %s: %w", ch, pushErr)
+				}
+				// otherwise the result is buffered as usual
+			}
 			mu.Lock()
 			defer mu.Unlock()
 			if err != nil {
@@ -969,6 +1019,7 @@ func (node *QueryNode) Query(ctx context.Context, req *querypb.QueryRequest) (*i
 		failRet.Status.Reason = err.Error()
 		return failRet, nil
 	}
+	limitRetrieveResult(ret, req.GetReq().GetLimit())
 	return ret, nil
 }
 
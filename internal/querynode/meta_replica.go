@@ -39,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/concurrency"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // ReplicaInterface specifies all the methods that the Collection object needs to implement in QueryNode.
@@ -50,6 +51,8 @@ type ReplicaInterface interface {
 	getCollectionIDs() []UniqueID
 	// addCollection creates a new collection and add it to collectionReplica
 	addCollection(collectionID UniqueID, schema *schemapb.CollectionSchema) *Collection
+	// updateCollectionSchema replaces the schema of collection if it's newer and adds the new fields to the growing segments
+	updateCollectionSchema(collectionID UniqueID, schema *schemapb.CollectionSchema) error
 	// removeCollection removes the collection from collectionReplica
 	removeCollection(collectionID UniqueID) error
 	// getCollectionByID gets the collection which id is collectionID
@@ -175,6 +178,13 @@ func (replica *metaReplica) addCollection(collectionID UniqueID, schema *schemap
 	defer replica.mu.Unlock()
 
 	if col, ok := replica.collections[collectionID]; ok {
+		// fields may be added or dropped since the collection was added
+		_, err := col.updateSchema(schema, func() []*Segment {
+			return replica.getGrowingSegmentsPrivate(collectionID)
+		})
+		if err != nil {
+			log.Warn("failed to update collection schema", zap.Int64("collectionID", collectionID), zap.Error(err))
+		}
 		return col
 	}
 
@@ -184,6 +194,32 @@ func (replica *metaReplica) addCollection(collectionID UniqueID, schema *schemap
 	return newC
 }
 
+// updateCollectionSchema replaces the schema of collection if it's newer and adds the new fields to the growing segments
+func (replica *metaReplica) updateCollectionSchema(collectionID UniqueID, schema *schemapb.CollectionSchema) error {
+	col, err := replica.getCollectionByID(collectionID)
+	if err != nil {
+		return err
+	}
+	// the growing segments are listed under the write lock of collection, after the replica lock
+	_, err = col.updateSchema(schema, func() []*Segment {
+		replica.mu.RLock()
+		defer replica.mu.RUnlock()
+		return replica.getGrowingSegmentsPrivate(collectionID)
+	})
+	return err
+}
+
+// getGrowingSegmentsPrivate returns the growing segments of collection
+func (replica *metaReplica) getGrowingSegmentsPrivate(collectionID UniqueID) []*Segment {
+	var segments []*Segment
+	for _, segment := range replica.growingSegments {
+		if segment.collectionID == collectionID {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
 // removeCollection removes the collection from collectionReplica
 func (replica *metaReplica) removeCollection(collectionID UniqueID) error {
 	replica.mu.Lock()
@@ -289,7 +325,7 @@ func (replica *metaReplica) getVecFieldIDsByCollectionIDPrivate(collectionID Uni
 
 	vecFields := make([]FieldID, 0)
 	for _, field := range fields {
-		if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
+		if typeutil.IsVectorType(field.DataType) {
 			vecFields = append(vecFields, field.FieldID)
 		}
 	}
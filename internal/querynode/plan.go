@@ -29,7 +29,10 @@ import (
 	"fmt"
 	"unsafe"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 )
 
@@ -96,6 +99,7 @@ type searchRequest struct {
 	timestamp         Timestamp
 	msgID             UniqueID
 	searchFieldID     UniqueID
+	exprPlan          *planpb.PlanNode // nil if the plan is not an expression plan
 }
 
 func newSearchRequest(collection *Collection, req *querypb.SearchRequest, placeholderGrp []byte) (*searchRequest, error) {
@@ -144,6 +148,9 @@ func newSearchRequest(collection *Collection, req *querypb.SearchRequest, placeh
 		msgID:             req.GetReq().GetBase().GetMsgID(),
 		searchFieldID:     int64(fieldID),
 	}
+	if req.Req.GetDslType() == commonpb.DslType_BoolExprV1 {
+		ret.exprPlan = unmarshalExprPlan(req.Req.SerializedExprPlan)
+	}
 
 	return ret, nil
 }
@@ -182,6 +189,7 @@ type RetrievePlan struct {
 	cRetrievePlan C.CRetrievePlan
 	Timestamp     Timestamp
 	msgID         UniqueID // only used to debug.
+	exprPlan      *planpb.PlanNode
 }
 
 func createRetrievePlanByExpr(col *Collection, expr []byte, timestamp Timestamp, msgID UniqueID) (*RetrievePlan, error) {
@@ -197,10 +205,21 @@ func createRetrievePlanByExpr(col *Collection, expr []byte, timestamp Timestamp,
 		cRetrievePlan: cPlan,
 		Timestamp:     timestamp,
 		msgID:         msgID,
+		exprPlan:      unmarshalExprPlan(expr),
 	}
 	return newPlan, nil
 }
 
+// unmarshalExprPlan decodes the serialized expression plan, which is only used to prune segments,
+// so nil is returned if it fails.
+func unmarshalExprPlan(expr []byte) *planpb.PlanNode {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(expr, plan); err != nil {
+		return nil
+	}
+	return plan
+}
+
 func (plan *RetrievePlan) delete() {
 	C.DeleteRetrievePlan(plan.cRetrievePlan)
 }
@@ -120,6 +120,9 @@ type QueryNode struct {
 
 	// cgoPool is the worker pool to control concurrency of cgo call
 	cgoPool *concurrency.Pool
+
+	// pushes the partial results to the proxies of the streaming requests
+	resultPusher *resultPusher
 }
 
 // NewQueryNode will return a QueryNode with abnormal state.
@@ -133,6 +136,10 @@ func NewQueryNode(ctx context.Context, factory dependency.Factory) *QueryNode {
 
 	node.tSafeReplica = newTSafeReplica()
 	node.scheduler = newTaskScheduler(ctx1, node.tSafeReplica)
+	node.resultPusher = newResultPusher(func() (map[string]*sessionutil.Session, error) {
+		sessions, _, err := node.session.GetSessions(typeutil.ProxyRole)
+		return sessions, err
+	})
 	node.UpdateStateCode(internalpb.StateCode_Abnormal)
 
 	return node
@@ -319,6 +326,10 @@ func (node *QueryNode) Stop() error {
 		node.queryShardService.close()
 	}
 
+	if node.resultPusher != nil {
+		node.resultPusher.close()
+	}
+
 	node.session.Revoke(time.Second)
 	node.wg.Wait()
 	return nil
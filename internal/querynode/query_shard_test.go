@@ -159,7 +159,7 @@ func TestReduceSearchResultData(t *testing.T) {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := reduceSearchResultData(dataArray, nq, topk)
+		res, err := reduceSearchResultData(dataArray, nq, topk, nil)
 		assert.Nil(t, err)
 		assert.Equal(t, ids, res.Ids.GetIntId().Data)
 		assert.Equal(t, scores, res.Scores)
@@ -176,10 +176,43 @@ func TestReduceSearchResultData(t *testing.T) {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := reduceSearchResultData(dataArray, nq, topk)
+		res, err := reduceSearchResultData(dataArray, nq, topk, nil)
 		assert.Nil(t, err)
 		assert.ElementsMatch(t, []int64{1, 5, 2, 3}, res.Ids.GetIntId().Data)
 	})
+	t.Run("group by", func(t *testing.T) {
+		ids1 := []int64{1, 2, 3, 4}
+		scores1 := []float32{-1.0, -2.0, -3.0, -4.0}
+		topks1 := []int64{int64(len(ids1))}
+		ids2 := []int64{5, 6, 7, 8}
+		scores2 := []float32{-1.5, -2.5, -3.5, -4.5}
+		topks2 := []int64{int64(len(ids2))}
+		data1 := genSearchResultData(nq, topk, ids1, scores1, topks1)
+		data1.FieldsData = []*schemapb.FieldData{genFieldData("doc_id", 101, schemapb.DataType_Int64, []int64{10, 10, 20, 30}, 1)}
+		data2 := genSearchResultData(nq, topk, ids2, scores2, topks2)
+		data2.FieldsData = []*schemapb.FieldData{genFieldData("doc_id", 101, schemapb.DataType_Int64, []int64{10, 20, 20, 40}, 1)}
+		dataArray := []*schemapb.SearchResultData{data1, data2}
+
+		groupBy, err := newGroupByInfo(101, 1, []int64{101})
+		assert.NoError(t, err)
+		res, err := reduceSearchResultData(dataArray, nq, topk, groupBy)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 6, 4, 8}, res.Ids.GetIntId().Data)
+		assert.Equal(t, []int64{10, 20, 30, 40}, res.FieldsData[0].GetScalars().GetLongData().GetData())
+
+		groupBy, err = newGroupByInfo(101, 2, []int64{101})
+		assert.NoError(t, err)
+		res, err = reduceSearchResultData(dataArray, nq, topk, groupBy)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 5, 6, 3}, res.Ids.GetIntId().Data)
+
+		_, err = newGroupByInfo(102, 1, []int64{101})
+		assert.Error(t, err)
+
+		groupBy, err = newGroupByInfo(0, 1, []int64{101})
+		assert.NoError(t, err)
+		assert.Nil(t, groupBy)
+	})
 }
 
 func TestMergeInternalRetrieveResults(t *testing.T) {
@@ -232,3 +265,29 @@ func TestMergeInternalRetrieveResults(t *testing.T) {
 	_, err = mergeInternalRetrieveResults(nil)
 	assert.NoError(t, err)
 }
+
+func TestLimitRetrieveResult(t *testing.T) {
+	result := &internalpb.RetrieveResults{
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: []int64{3, 1, 2},
+				},
+			},
+		},
+		FieldsData: []*schemapb.FieldData{
+			genFieldData("Int64Field", common.StartOfUserFieldID+1, schemapb.DataType_Int64, []int64{33, 11, 22}, 1),
+		},
+	}
+
+	limitRetrieveResult(result, 0)
+	assert.Equal(t, []int64{3, 1, 2}, result.GetIds().GetIntId().GetData())
+
+	limitRetrieveResult(result, 2)
+	assert.Equal(t, []int64{1, 2}, result.GetIds().GetIntId().GetData())
+	assert.Equal(t, []int64{11, 22}, result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+
+	empty := &internalpb.RetrieveResults{Ids: &schemapb.IDs{}, FieldsData: []*schemapb.FieldData{}}
+	limitRetrieveResult(empty, 2)
+	assert.Equal(t, []*schemapb.FieldData{}, empty.GetFieldsData())
+}
@@ -25,6 +25,8 @@ package querynode
 import "C"
 import (
 	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 )
 
 type sliceInfo struct {
@@ -134,6 +136,19 @@ func deleteSearchResultDataBlobs(cSearchResultDataBlobs searchResultDataBlobs) {
 	C.DeleteSearchResultDataBlobs(cSearchResultDataBlobs)
 }
 
+// getSearchProfile returns how the search plan is executed on the segment
+func getSearchProfile(result *SearchResult, segID UniqueID, segType segmentType) *milvuspb.SegmentProfile {
+	profile := C.GetSearchProfile(result.cSearchResult)
+	return &milvuspb.SegmentProfile{
+		SegmentID:    segID,
+		Sealed:       segType == segmentTypeSealed,
+		ScannedRows:  int64(profile.scanned_rows),
+		FilteredRows: int64(profile.filtered_rows),
+		FilterUs:     int64(profile.filter_cost_us),
+		SearchUs:     int64(profile.search_cost_us),
+	}
+}
+
 func deleteSearchResults(results []*SearchResult) {
 	if len(results) == 0 {
 		return
@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -73,7 +74,40 @@ func reduceStatisticResponse(results []*internalpb.GetStatisticsResponse) (*inte
 	return ret, nil
 }
 
-func reduceSearchResults(results []*internalpb.SearchResults, nq int64, topk int64, metricType string) (*internalpb.SearchResults, error) {
+// groupByInfo describes how search hits are grouped by the value of a scalar output field
+type groupByInfo struct {
+	fieldOffset int   // offset of the group-by field in SearchResultData.FieldsData
+	groupSize   int64 // max number of hits kept for each distinct group value
+}
+
+// newGroupByInfo returns nil if group by is not requested
+func newGroupByInfo(groupByFieldID int64, groupSize int64, outputFieldIDs []int64) (*groupByInfo, error) {
+	if groupByFieldID <= 0 {
+		return nil, nil
+	}
+	if groupSize <= 0 {
+		groupSize = 1
+	}
+	for offset, fieldID := range outputFieldIDs {
+		if fieldID == groupByFieldID {
+			return &groupByInfo{fieldOffset: offset, groupSize: groupSize}, nil
+		}
+	}
+	return nil, fmt.Errorf("group by field %d not found in output fields", groupByFieldID)
+}
+
+func (g *groupByInfo) getGroupValue(data *schemapb.SearchResultData, idx int64) (interface{}, error) {
+	if g.fieldOffset >= len(data.GetFieldsData()) {
+		return nil, fmt.Errorf("group by field not found in search result, offset = %d", g.fieldOffset)
+	}
+	value := typeutil.GetScalarFieldValue(data.GetFieldsData()[g.fieldOffset], idx)
+	if value == nil {
+		return nil, fmt.Errorf("invalid group by value at offset %d", idx)
+	}
+	return value, nil
+}
+
+func reduceSearchResults(results []*internalpb.SearchResults, nq int64, topk int64, metricType string, groupBy *groupByInfo) (*internalpb.SearchResults, error) {
 	searchResultData, err := decodeSearchResults(results)
 	if err != nil {
 		log.Warn("shard leader decode search results errors", zap.Error(err))
@@ -88,7 +122,7 @@ func reduceSearchResults(results []*internalpb.SearchResults, nq int64, topk int
 			zap.Int64("topk", sData.TopK))
 	}
 
-	reducedResultData, err := reduceSearchResultData(searchResultData, nq, topk)
+	reducedResultData, err := reduceSearchResultData(searchResultData, nq, topk, groupBy)
 	if err != nil {
 		log.Warn("shard leader reduce errors", zap.Error(err))
 		return nil, err
@@ -98,6 +132,9 @@ func reduceSearchResults(results []*internalpb.SearchResults, nq int64, topk int
 		log.Warn("shard leader encode search result errors", zap.Error(err))
 		return nil, err
 	}
+	for _, r := range results {
+		searchResults.Profiles = append(searchResults.Profiles, r.GetProfiles()...)
+	}
 	//if searchResults.SlicedBlob == nil {
 	//	log.Debug("shard leader send nil results to proxy",
 	//		zap.String("shard", q.channel))
@@ -110,7 +147,9 @@ func reduceSearchResults(results []*internalpb.SearchResults, nq int64, topk int
 	return searchResults, nil
 }
 
-func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq int64, topk int64) (*schemapb.SearchResultData, error) {
+// reduceSearchResultData merges search results by score and removes duplicated primary keys,
+// at most groupBy.groupSize hits are kept for each group value if groupBy is not nil
+func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq int64, topk int64, groupBy *groupByInfo) (*schemapb.SearchResultData, error) {
 	if len(searchResultData) == 0 {
 		return &schemapb.SearchResultData{
 			NumQueries: nq,
@@ -139,10 +178,12 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 	}
 
 	var skipDupCnt int64
+	var skipGroupCnt int64
 	for i := int64(0); i < nq; i++ {
 		offsets := make([]int64, len(searchResultData))
 
 		var idSet = make(map[interface{}]struct{})
+		var groupCount = make(map[interface{}]int64)
 		var j int64
 		for j = 0; j < topk; {
 			sel := selectSearchResultData(searchResultData, resultOffsets, offsets, i)
@@ -154,6 +195,22 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 			id := typeutil.GetPK(searchResultData[sel].GetIds(), idx)
 			score := searchResultData[sel].Scores[idx]
 
+			if groupBy != nil {
+				groupValue, err := groupBy.getGroupValue(searchResultData[sel], idx)
+				if err != nil {
+					return nil, err
+				}
+				if groupCount[groupValue] >= groupBy.groupSize {
+					// skip entity whose group is full
+					skipGroupCnt++
+					offsets[sel]++
+					continue
+				}
+				if _, ok := idSet[id]; !ok {
+					groupCount[groupValue]++
+				}
+			}
+
 			// remove duplicates
 			if _, ok := idSet[id]; !ok {
 				typeutil.AppendFieldData(ret.FieldsData, searchResultData[sel].FieldsData, idx)
@@ -175,9 +232,28 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 		ret.Topks = append(ret.Topks, j)
 	}
 	log.Debug("skip duplicated search result", zap.Int64("count", skipDupCnt))
+	if groupBy != nil {
+		log.Debug("skip search result of full group", zap.Int64("count", skipGroupCnt))
+	}
 	return ret, nil
 }
 
+// groupSearchResultDataBlob keeps at most groupBy.groupSize hits of each group value in the marshaled search result data
+func groupSearchResultDataBlob(blob []byte, nq int64, topk int64, groupBy *groupByInfo) ([]byte, error) {
+	var data schemapb.SearchResultData
+	if err := proto.Unmarshal(blob, &data); err != nil {
+		return nil, err
+	}
+	if typeutil.GetSizeOfIDs(data.GetIds()) == 0 {
+		return blob, nil
+	}
+	groupedData, err := reduceSearchResultData([]*schemapb.SearchResultData{&data}, nq, topk, groupBy)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(groupedData)
+}
+
 func selectSearchResultData(dataArray []*schemapb.SearchResultData, resultOffsets [][]int64, offsets []int64, qi int64) int {
 	sel := -1
 	maxDistance := -1 * float32(math.MaxFloat32)
@@ -239,8 +315,11 @@ func mergeInternalRetrieveResults(retrieveResults []*internalpb.RetrieveResults)
 	var skipDupCnt int64
 	var idSet = make(map[interface{}]struct{})
 
+	var profiles []*milvuspb.ShardProfile
+
 	// merge results and remove duplicates
 	for _, rr := range retrieveResults {
+		profiles = append(profiles, rr.GetProfiles()...)
 		// skip if fields data is empty
 		if len(rr.FieldsData) == 0 {
 			continue
@@ -279,10 +358,20 @@ func mergeInternalRetrieveResults(retrieveResults []*internalpb.RetrieveResults)
 			FieldsData: []*schemapb.FieldData{},
 		}
 	}
+	ret.Profiles = profiles
 
 	return ret, nil
 }
 
+// limitRetrieveResult keeps the entities with the smallest primary keys if the limit of the request is positive,
+// the limit is applied by each segment already, so only the limited results of the segments are merged
+func limitRetrieveResult(result *internalpb.RetrieveResults, limit int64) {
+	if limit <= 0 || typeutil.GetSizeOfIDs(result.GetIds()) == 0 {
+		return
+	}
+	result.Ids, result.FieldsData = typeutil.LimitByPK(result.GetIds(), result.GetFieldsData(), limit)
+}
+
 func mergeSegcoreRetrieveResults(retrieveResults []*segcorepb.RetrieveResults) (*segcorepb.RetrieveResults, error) {
 	var ret *segcorepb.RetrieveResults
 	var skipDupCnt int64
@@ -0,0 +1,180 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+
+	grpcproxyclient "github.com/milvus-io/milvus/internal/distributed/proxy/client"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// resultPusher pushes the partial results of the channels to the proxies of the streaming requests,
+// so that the shard leader doesn't buffer the results until all the channels complete
+type resultPusher struct {
+	getSessions func() (map[string]*sessionutil.Session, error)
+	newClient   func(ctx context.Context, addr string) (types.Proxy, error)
+
+	mu      sync.Mutex
+	clients map[int64]types.Proxy
+}
+
+func newResultPusher(getSessions func() (map[string]*sessionutil.Session, error)) *resultPusher {
+	return &resultPusher{
+		getSessions: getSessions,
+		newClient: func(ctx context.Context, addr string) (types.Proxy, error) {
+			cli, err := grpcproxyclient.NewClient(ctx, addr)
+			if err != nil {
+				return nil, err
+			}
+			if err := cli.Init(); err != nil {
+				return nil, err
+			}
+			return cli, nil
+		},
+		clients: make(map[int64]types.Proxy),
+	}
+}
+
+// getClient returns the client of the proxy, which is looked up in the sessions if not connected yet
+func (p *resultPusher) getClient(ctx context.Context, nodeID int64) (types.Proxy, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if cli, ok := p.clients[nodeID]; ok {
+		return cli, nil
+	}
+
+	sessions, err := p.getSessions()
+	if err != nil {
+		return nil, err
+	}
+	for _, session := range sessions {
+		if session.ServerID != nodeID {
+			continue
+		}
+		cli, err := p.newClient(ctx, session.Address)
+		if err != nil {
+			return nil, err
+		}
+		p.clients[nodeID] = cli
+		return cli, nil
+	}
+	return nil, fmt.Errorf("proxy %d not found", nodeID)
+}
+
+// removeClient drops the client of the proxy failing to receive the results, it's reconnected next time
+func (p *resultPusher) removeClient(nodeID int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if cli, ok := p.clients[nodeID]; ok {
+		cli.Stop()
+		delete(p.clients, nodeID)
+	}
+}
+
+// pushSearchResult pushes the result to the proxy sending req, the result should be buffered as usual if it fails
+func (p *resultPusher) pushSearchResult(ctx context.Context, req *internalpb.SearchRequest, result *internalpb.SearchResults) error {
+	nodeID := req.GetBase().GetSourceID()
+	cli, err := p.getClient(ctx, nodeID)
+	if err != nil {
+		return err
+	}
+	result.ReqID = req.GetBase().GetMsgID()
+	status, err := cli.SendSearchResult(ctx, result)
+	return p.checkPushed(nodeID, result.ReqID, status, err)
+}
+
+// pushRetrieveResult pushes the result to the proxy sending req in chunks of at most chunkRows rows,
+// so that the proxy never holds the whole result of a channel. The result should be buffered as usual
+// if it fails before any chunk is pushed, started is true if some chunks are pushed already.
+func (p *resultPusher) pushRetrieveResult(ctx context.Context, req *internalpb.RetrieveRequest, result *internalpb.RetrieveResults, chunkRows int64) (started bool, err error) {
+	nodeID := req.GetBase().GetSourceID()
+	cli, err := p.getClient(ctx, nodeID)
+	if err != nil {
+		return false, err
+	}
+	for _, chunk := range splitRetrieveResult(result, chunkRows) {
+		chunk.ReqID = req.GetBase().GetMsgID()
+		status, err := cli.SendRetrieveResult(ctx, chunk)
+		if err := p.checkPushed(nodeID, chunk.ReqID, status, err); err != nil {
+			return started, err
+		}
+		started = true
+	}
+	return started, nil
+}
+
+// splitRetrieveResult splits the entities of the result into chunks of at most chunkRows rows,
+// the result is returned as it is if it's small enough
+func splitRetrieveResult(result *internalpb.RetrieveResults, chunkRows int64) []*internalpb.RetrieveResults {
+	rows := int64(typeutil.GetSizeOfIDs(result.GetIds()))
+	if chunkRows <= 0 || rows <= chunkRows {
+		return []*internalpb.RetrieveResults{result}
+	}
+	var chunks []*internalpb.RetrieveResults
+	for start := int64(0); start < rows; start += chunkRows {
+		end := start + chunkRows
+		if end > rows {
+			end = rows
+		}
+		chunk := &internalpb.RetrieveResults{
+			Base:       result.GetBase(),
+			Status:     result.GetStatus(),
+			Ids:        &schemapb.IDs{},
+			FieldsData: make([]*schemapb.FieldData, len(result.GetFieldsData())),
+		}
+		for i := start; i < end; i++ {
+			typeutil.AppendIDs(chunk.Ids, result.GetIds(), int(i))
+			typeutil.AppendFieldData(chunk.FieldsData, result.GetFieldsData(), i)
+		}
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
+func (p *resultPusher) checkPushed(nodeID int64, reqID int64, status *commonpb.Status, err error) error {
+	if err != nil {
+		log.Warn("failed to push the result to proxy", zap.Int64("proxyID", nodeID), zap.Int64("msgID", reqID), zap.Error(err))
+		p.removeClient(nodeID)
+		return err
+	}
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		log.Warn("proxy failed to receive the pushed result", zap.Int64("proxyID", nodeID), zap.Int64("msgID", reqID),
+			zap.String("reason", status.GetReason()))
+		return fmt.Errorf("proxy %d failed to receive the result, reason = %s", nodeID, status.GetReason())
+	}
+	return nil
+}
+
+func (p *resultPusher) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for nodeID, cli := range p.clients {
+		cli.Stop()
+		delete(p.clients, nodeID)
+	}
+}
@@ -0,0 +1,156 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+)
+
+type mockPushProxy struct {
+	types.Proxy
+	searchResults   []*internalpb.SearchResults
+	retrieveResults []*internalpb.RetrieveResults
+	status          *commonpb.Status
+	err             error
+	stopped         bool
+}
+
+func (m *mockPushProxy) SendSearchResult(ctx context.Context, req *internalpb.SearchResults) (*commonpb.Status, error) {
+	m.searchResults = append(m.searchResults, req)
+	return m.status, m.err
+}
+
+func (m *mockPushProxy) SendRetrieveResult(ctx context.Context, req *internalpb.RetrieveResults) (*commonpb.Status, error) {
+	m.retrieveResults = append(m.retrieveResults, req)
+	return m.status, m.err
+}
+
+func (m *mockPushProxy) Stop() error {
+	m.stopped = true
+	return nil
+}
+
+func TestResultPusher(t *testing.T) {
+	ctx := context.Background()
+	var connected []string
+	var proxies []*mockPushProxy
+	pusher := newResultPusher(func() (map[string]*sessionutil.Session, error) {
+		return map[string]*sessionutil.Session{
+			"proxy-1": {ServerID: 1, Address: "localhost:19530"},
+		}, nil
+	})
+	pusher.newClient = func(ctx context.Context, addr string) (types.Proxy, error) {
+		connected = append(connected, addr)
+		p := &mockPushProxy{status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+		proxies = append(proxies, p)
+		return p, nil
+	}
+
+	searchReq := &internalpb.SearchRequest{Base: &commonpb.MsgBase{SourceID: 1, MsgID: 10}}
+	err := pusher.pushSearchResult(ctx, searchReq, &internalpb.SearchResults{})
+	assert.NoError(t, err)
+	retrieveReq := &internalpb.RetrieveRequest{Base: &commonpb.MsgBase{SourceID: 1, MsgID: 11}}
+	_, err = pusher.pushRetrieveResult(ctx, retrieveReq, &internalpb.RetrieveResults{}, 10)
+	assert.NoError(t, err)
+
+	// the client is reused
+	assert.Equal(t, []string{"localhost:19530"}, connected)
+	assert.Equal(t, int64(10), proxies[0].searchResults[0].GetReqID())
+	assert.Equal(t, int64(11), proxies[0].retrieveResults[0].GetReqID())
+
+	// unknown proxy
+	err = pusher.pushSearchResult(ctx, &internalpb.SearchRequest{Base: &commonpb.MsgBase{SourceID: 2}}, &internalpb.SearchResults{})
+	assert.Error(t, err)
+
+	// the proxy fails to receive the result
+	proxies[0].status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "no streaming query"}
+	started, err := pusher.pushRetrieveResult(ctx, retrieveReq, &internalpb.RetrieveResults{}, 10)
+	assert.Error(t, err)
+	assert.False(t, started)
+	assert.False(t, proxies[0].stopped)
+
+	// the client is reconnected after rpc failure
+	proxies[0].err = errors.New("mock")
+	_, err = pusher.pushRetrieveResult(ctx, retrieveReq, &internalpb.RetrieveResults{}, 10)
+	assert.Error(t, err)
+	assert.True(t, proxies[0].stopped)
+	_, err = pusher.pushRetrieveResult(ctx, retrieveReq, &internalpb.RetrieveResults{}, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(connected))
+
+	pusher.close()
+	assert.True(t, proxies[1].stopped)
+}
+
+func newPushedRetrieveResult(pks ...int64) *internalpb.RetrieveResults {
+	return &internalpb.RetrieveResults{
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}},
+		},
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:    schemapb.DataType_Int64,
+				FieldId: 100,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestResultPusher_pushRetrieveResultInChunks(t *testing.T) {
+	ctx := context.Background()
+	proxy := &mockPushProxy{status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}
+	pusher := newResultPusher(func() (map[string]*sessionutil.Session, error) {
+		return map[string]*sessionutil.Session{
+			"proxy-1": {ServerID: 1, Address: "localhost:19530"},
+		}, nil
+	})
+	pusher.newClient = func(ctx context.Context, addr string) (types.Proxy, error) {
+		return proxy, nil
+	}
+	req := &internalpb.RetrieveRequest{Base: &commonpb.MsgBase{SourceID: 1, MsgID: 11}}
+
+	started, err := pusher.pushRetrieveResult(ctx, req, newPushedRetrieveResult(1, 2, 3, 4, 5), 2)
+	assert.NoError(t, err)
+	assert.True(t, started)
+	assert.Equal(t, 3, len(proxy.retrieveResults))
+	var pks []int64
+	for _, chunk := range proxy.retrieveResults {
+		assert.Equal(t, int64(11), chunk.GetReqID())
+		assert.True(t, len(chunk.GetIds().GetIntId().GetData()) <= 2)
+		assert.Equal(t, chunk.GetIds().GetIntId().GetData(), chunk.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		pks = append(pks, chunk.GetIds().GetIntId().GetData()...)
+	}
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, pks)
+
+	// small results are pushed as they are
+	assert.Equal(t, 1, len(splitRetrieveResult(newPushedRetrieveResult(1, 2), 2)))
+}
@@ -17,58 +17,72 @@
 package querynode
 
 import (
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
 )
 
-// retrieveOnSegments performs retrieve on listed segments
+// retrieveOnSegments performs retrieve on listed segments, and profiles how each segment is retrieved
 // all segment ids are validated before calling this function
-func retrieveOnSegments(replica ReplicaInterface, segType segmentType, collID UniqueID, plan *RetrievePlan, segIDs []UniqueID, vcm storage.ChunkManager) ([]*segcorepb.RetrieveResults, error) {
+func retrieveOnSegments(replica ReplicaInterface, segType segmentType, collID UniqueID, plan *RetrievePlan, segIDs []UniqueID, vcm storage.ChunkManager) ([]*segcorepb.RetrieveResults, []*milvuspb.SegmentProfile, error) {
 	var retrieveResults []*segcorepb.RetrieveResults
+	var profiles []*milvuspb.SegmentProfile
 
 	for _, segID := range segIDs {
 		seg, err := replica.getSegmentByID(segID, segType)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
+		tr := timerecord.NewTimeRecorder("retrieveOnSegments")
 		result, err := seg.retrieve(plan)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
+		profiles = append(profiles, &milvuspb.SegmentProfile{
+			SegmentID:    segID,
+			Sealed:       segType == segmentTypeSealed,
+			ScannedRows:  seg.getRowCount(),
+			FilteredRows: int64(len(result.GetOffset())),
+			FilterUs:     tr.ElapseSpan().Microseconds(),
+		})
 		if err := seg.fillIndexedFieldsData(collID, vcm, result); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		retrieveResults = append(retrieveResults, result)
 	}
-	return retrieveResults, nil
+	return retrieveResults, profiles, nil
 }
 
 // retrieveHistorical will retrieve all the target segments in historical
-func retrieveHistorical(replica ReplicaInterface, plan *RetrievePlan, collID UniqueID, partIDs []UniqueID, segIDs []UniqueID, vcm storage.ChunkManager) ([]*segcorepb.RetrieveResults, []UniqueID, []UniqueID, error) {
+func retrieveHistorical(replica ReplicaInterface, plan *RetrievePlan, collID UniqueID, partIDs []UniqueID, segIDs []UniqueID, vcm storage.ChunkManager) ([]*segcorepb.RetrieveResults, []*milvuspb.SegmentProfile, []UniqueID, []UniqueID, error) {
 	var err error
 	var retrieveResults []*segcorepb.RetrieveResults
+	var profiles []*milvuspb.SegmentProfile
 	var retrieveSegmentIDs []UniqueID
 	var retrievePartIDs []UniqueID
 	retrievePartIDs, retrieveSegmentIDs, err = validateOnHistoricalReplica(replica, collID, partIDs, segIDs)
 	if err != nil {
-		return retrieveResults, retrieveSegmentIDs, retrievePartIDs, err
+		return retrieveResults, profiles, retrieveSegmentIDs, retrievePartIDs, err
 	}
 
-	retrieveResults, err = retrieveOnSegments(replica, segmentTypeSealed, collID, plan, retrieveSegmentIDs, vcm)
-	return retrieveResults, retrievePartIDs, retrieveSegmentIDs, err
+	retrieveSegmentIDs = pruneByClusteringKey(replica, plan.exprPlan, retrieveSegmentIDs)
+	retrieveResults, profiles, err = retrieveOnSegments(replica, segmentTypeSealed, collID, plan, retrieveSegmentIDs, vcm)
+	return retrieveResults, profiles, retrievePartIDs, retrieveSegmentIDs, err
 }
 
 // retrieveStreaming will retrieve all the target segments in streaming
-func retrieveStreaming(replica ReplicaInterface, plan *RetrievePlan, collID UniqueID, partIDs []UniqueID, vChannel Channel, vcm storage.ChunkManager) ([]*segcorepb.RetrieveResults, []UniqueID, []UniqueID, error) {
+func retrieveStreaming(replica ReplicaInterface, plan *RetrievePlan, collID UniqueID, partIDs []UniqueID, vChannel Channel, vcm storage.ChunkManager) ([]*segcorepb.RetrieveResults, []*milvuspb.SegmentProfile, []UniqueID, []UniqueID, error) {
 	var err error
 	var retrieveResults []*segcorepb.RetrieveResults
+	var profiles []*milvuspb.SegmentProfile
 	var retrievePartIDs []UniqueID
 	var retrieveSegmentIDs []UniqueID
 
 	retrievePartIDs, retrieveSegmentIDs, err = validateOnStreamReplica(replica, collID, partIDs, vChannel)
 	if err != nil {
-		return retrieveResults, retrieveSegmentIDs, retrievePartIDs, err
+		return retrieveResults, profiles, retrieveSegmentIDs, retrievePartIDs, err
 	}
-	retrieveResults, err = retrieveOnSegments(replica, segmentTypeGrowing, collID, plan, retrieveSegmentIDs, vcm)
-	return retrieveResults, retrievePartIDs, retrieveSegmentIDs, err
+	retrieveResults, profiles, err = retrieveOnSegments(replica, segmentTypeGrowing, collID, plan, retrieveSegmentIDs, vcm)
+	return retrieveResults, profiles, retrievePartIDs, retrieveSegmentIDs, err
 }
@@ -49,7 +49,7 @@ func TestStreaming_retrieve(t *testing.T) {
 	assert.NoError(t, err)
 
 	t.Run("test retrieve", func(t *testing.T) {
-		res, _, ids, err := retrieveStreaming(streaming, plan,
+		res, _, _, ids, err := retrieveStreaming(streaming, plan,
 			defaultCollectionID,
 			[]UniqueID{defaultPartitionID},
 			defaultDMLChannel,
@@ -61,7 +61,7 @@ func TestStreaming_retrieve(t *testing.T) {
 
 	t.Run("test empty partition", func(t *testing.T) {
 
-		res, _, ids, err := retrieveStreaming(streaming, plan,
+		res, _, _, ids, err := retrieveStreaming(streaming, plan,
 			defaultCollectionID,
 			nil,
 			defaultDMLChannel,
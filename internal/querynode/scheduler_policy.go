@@ -2,10 +2,52 @@ package querynode
 
 import (
 	"container/list"
+	"fmt"
+	"sort"
+	"strconv"
 )
 
 type scheduleReadTaskPolicy func(sqTasks *list.List, targetUsage int32, maxNum int32) ([]readTask, int32)
 
+// readTaskGroupFunc returns the group of the read task, the tasks are scheduled and measured by groups
+type readTaskGroupFunc func(t readTask) string
+
+const (
+	scheduleReadPolicyFIFO             = "fifo"
+	scheduleReadPolicyFairByCollection = "fair_by_collection"
+	scheduleReadPolicyFairByUser       = "fair_by_user"
+	scheduleReadPolicyPriority         = "priority"
+)
+
+func groupByCollection(t readTask) string {
+	return strconv.FormatInt(t.GetCollectionID(), 10)
+}
+
+func groupByUser(t readTask) string {
+	return t.GetUsername()
+}
+
+func groupByPriority(t readTask) string {
+	return strconv.FormatInt(int64(t.GetPriority()), 10)
+}
+
+// newScheduleReadPolicy returns the schedule policy of the name and how the tasks are grouped by the policy.
+// The weights are used by the fair policies only.
+func newScheduleReadPolicy(name string, weights map[string]int32) (scheduleReadTaskPolicy, readTaskGroupFunc, error) {
+	switch name {
+	case scheduleReadPolicyFIFO, "":
+		return defaultScheduleReadPolicy, groupByCollection, nil
+	case scheduleReadPolicyFairByCollection:
+		return newFairScheduleReadPolicy(groupByCollection, weights), groupByCollection, nil
+	case scheduleReadPolicyFairByUser:
+		return newFairScheduleReadPolicy(groupByUser, weights), groupByUser, nil
+	case scheduleReadPolicyPriority:
+		return priorityScheduleReadPolicy, groupByPriority, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown schedule read policy %s", name)
+	}
+}
+
 func defaultScheduleReadPolicy(sqTasks *list.List, targetUsage int32, maxNum int32) ([]readTask, int32) {
 	var ret []readTask
 	usage := int32(0)
@@ -24,3 +66,118 @@ func defaultScheduleReadPolicy(sqTasks *list.List, targetUsage int32, maxNum int
 	}
 	return ret, usage
 }
+
+// priorityScheduleReadPolicy schedules the tasks of higher priorities first, and FIFO for the tasks of the same priority.
+// Like the FIFO policy, it stops at the first task exceeding the cpu usage, so that large tasks aren't starved by small ones.
+func priorityScheduleReadPolicy(sqTasks *list.List, targetUsage int32, maxNum int32) ([]readTask, int32) {
+	elements := make([]*list.Element, 0, sqTasks.Len())
+	for e := sqTasks.Front(); e != nil; e = e.Next() {
+		elements = append(elements, e)
+	}
+	sort.SliceStable(elements, func(i, j int) bool {
+		return elements[i].Value.(readTask).GetPriority() > elements[j].Value.(readTask).GetPriority()
+	})
+
+	var ret []readTask
+	usage := int32(0)
+	for _, e := range elements {
+		if maxNum <= 0 {
+			break
+		}
+		t := e.Value.(readTask)
+		tUsage := t.CPUUsage()
+		if usage+tUsage > targetUsage {
+			break
+		}
+		usage += tUsage
+		sqTasks.Remove(e)
+		ret = append(ret, t)
+		maxNum--
+	}
+	return ret, usage
+}
+
+// fairScheduler is the state of the weighted fair queuing among the groups of tasks.
+// Each group is charged the cpu usage of its scheduled tasks divided by its weight,
+// and the group charged least is served next, FIFO in the group.
+type fairScheduler struct {
+	groupOf readTaskGroupFunc
+	weights map[string]int32
+
+	// charged usage of the groups with ready tasks
+	charged map[string]float64
+	// charged usage of the group served last, the groups becoming active start from it,
+	// so that the idle groups can't save up the credit to starve the others later
+	virtualTime float64
+}
+
+// newFairScheduleReadPolicy returns the weighted fair queuing policy, which must be called by one goroutine only.
+func newFairScheduleReadPolicy(groupOf readTaskGroupFunc, weights map[string]int32) scheduleReadTaskPolicy {
+	s := &fairScheduler{
+		groupOf: groupOf,
+		weights: weights,
+		charged: make(map[string]float64),
+	}
+	return s.schedule
+}
+
+func (s *fairScheduler) weight(group string) float64 {
+	if w, ok := s.weights[group]; ok && w > 0 {
+		return float64(w)
+	}
+	return 1
+}
+
+func (s *fairScheduler) schedule(sqTasks *list.List, targetUsage int32, maxNum int32) ([]readTask, int32) {
+	queues := make(map[string][]*list.Element)
+	for e := sqTasks.Front(); e != nil; e = e.Next() {
+		group := s.groupOf(e.Value.(readTask))
+		queues[group] = append(queues[group], e)
+	}
+	for group := range s.charged {
+		if _, ok := queues[group]; !ok {
+			delete(s.charged, group)
+		}
+	}
+	for group := range queues {
+		if _, ok := s.charged[group]; !ok {
+			s.charged[group] = s.virtualTime
+		}
+	}
+
+	var ret []readTask
+	usage := int32(0)
+	for maxNum > 0 && len(queues) > 0 {
+		// the group charged least, ties are broken by the group name to be deterministic
+		group := ""
+		first := true
+		for g := range queues {
+			if first || s.charged[g] < s.charged[group] || (s.charged[g] == s.charged[group] && g < group) {
+				group, first = g, false
+			}
+		}
+		e := queues[group][0]
+		t := e.Value.(readTask)
+		tUsage := t.CPUUsage()
+		if usage+tUsage > targetUsage {
+			// wait for the cpu for the group, instead of starving its large tasks
+			break
+		}
+		usage += tUsage
+		sqTasks.Remove(e)
+		ret = append(ret, t)
+		maxNum--
+
+		s.virtualTime = s.charged[group]
+		// tasks of no estimated usage are still charged, to rotate among the groups
+		cost := float64(tUsage)
+		if cost < 1 {
+			cost = 1
+		}
+		s.charged[group] += cost / s.weight(group)
+		if queues[group] = queues[group][1:]; len(queues[group]) == 0 {
+			delete(queues, group)
+		}
+	}
+	return ret, usage
+}
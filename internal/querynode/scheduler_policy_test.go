@@ -58,3 +58,105 @@ func TestScheduler_defaultScheduleReadPolicy(t *testing.T) {
 	assert.Equal(t, actual, cur)
 	assert.Equal(t, 4, len(tasks))
 }
+
+func TestScheduler_newScheduleReadPolicy(t *testing.T) {
+	for _, name := range []string{"", scheduleReadPolicyFIFO, scheduleReadPolicyFairByCollection, scheduleReadPolicyFairByUser, scheduleReadPolicyPriority} {
+		policy, group, err := newScheduleReadPolicy(name, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, policy)
+		assert.NotNil(t, group)
+	}
+	_, _, err := newScheduleReadPolicy("unknown", nil)
+	assert.Error(t, err)
+}
+
+func TestScheduler_priorityScheduleReadPolicy(t *testing.T) {
+	readyReadTasks := list.New()
+	for i, priority := range []int32{0, 2, 1, 2, 0} {
+		readyReadTasks.PushBack(&mockReadTask{
+			cpuUsage:     10,
+			collectionID: UniqueID(i),
+			priority:     priority,
+		})
+	}
+
+	tasks, cur := priorityScheduleReadPolicy(readyReadTasks, 30, math.MaxInt32)
+	assert.Equal(t, int32(30), cur)
+	assert.Equal(t, 3, len(tasks))
+	// higher priorities first, fifo in the same priority
+	assert.Equal(t, UniqueID(1), tasks[0].GetCollectionID())
+	assert.Equal(t, UniqueID(3), tasks[1].GetCollectionID())
+	assert.Equal(t, UniqueID(2), tasks[2].GetCollectionID())
+
+	tasks, cur = priorityScheduleReadPolicy(readyReadTasks, 100, 1)
+	assert.Equal(t, int32(10), cur)
+	assert.Equal(t, 1, len(tasks))
+	assert.Equal(t, UniqueID(0), tasks[0].GetCollectionID())
+	assert.Equal(t, 1, readyReadTasks.Len())
+}
+
+func TestScheduler_fairScheduleReadPolicy(t *testing.T) {
+	t.Run("by collection", func(t *testing.T) {
+		schedule := newFairScheduleReadPolicy(groupByCollection, nil)
+		readyReadTasks := list.New()
+		// a burst of large tasks of collection 1 ahead of the small ones of collection 2
+		for i := 0; i < 5; i++ {
+			readyReadTasks.PushBack(&mockReadTask{cpuUsage: 40, collectionID: 1})
+		}
+		for i := 0; i < 5; i++ {
+			readyReadTasks.PushBack(&mockReadTask{cpuUsage: 10, collectionID: 2})
+		}
+
+		tasks, cur := schedule(readyReadTasks, 100, math.MaxInt32)
+		assert.Equal(t, int32(80), cur)
+		collections := make([]UniqueID, 0, len(tasks))
+		for _, task := range tasks {
+			collections = append(collections, task.GetCollectionID())
+		}
+		assert.Equal(t, []UniqueID{1, 2, 2, 2, 2}, collections)
+
+		// the large task of collection 1 waits for the cpu instead of being starved by the small ones
+		tasks, _ = schedule(readyReadTasks, 100, 1)
+		assert.Equal(t, 1, len(tasks))
+		assert.Equal(t, UniqueID(1), tasks[0].GetCollectionID())
+	})
+
+	t.Run("by user with weights", func(t *testing.T) {
+		schedule := newFairScheduleReadPolicy(groupByUser, map[string]int32{"alice": 3})
+		readyReadTasks := list.New()
+		for i := 0; i < 8; i++ {
+			readyReadTasks.PushBack(&mockReadTask{cpuUsage: 10, username: "bob"})
+			readyReadTasks.PushBack(&mockReadTask{cpuUsage: 10, username: "alice"})
+		}
+		tasks, cur := schedule(readyReadTasks, 80, math.MaxInt32)
+		assert.Equal(t, int32(80), cur)
+		count := make(map[string]int)
+		for _, task := range tasks {
+			count[task.GetUsername()]++
+		}
+		assert.Equal(t, 6, count["alice"])
+		assert.Equal(t, 2, count["bob"])
+	})
+
+	t.Run("idle groups", func(t *testing.T) {
+		schedule := newFairScheduleReadPolicy(groupByCollection, nil)
+		readyReadTasks := list.New()
+		for i := 0; i < 10; i++ {
+			readyReadTasks.PushBack(&mockReadTask{cpuUsage: 10, collectionID: 1})
+		}
+		tasks, _ := schedule(readyReadTasks, 50, math.MaxInt32)
+		assert.Equal(t, 5, len(tasks))
+
+		// collection 2 becoming active doesn't get the credit of the idle time
+		for i := 0; i < 10; i++ {
+			readyReadTasks.PushBack(&mockReadTask{cpuUsage: 10, collectionID: 2})
+		}
+		tasks, _ = schedule(readyReadTasks, 40, math.MaxInt32)
+		count := make(map[UniqueID]int)
+		for _, task := range tasks {
+			count[task.GetCollectionID()]++
+		}
+		assert.Equal(t, 2, count[1])
+		assert.Equal(t, 2, count[2])
+	})
+}
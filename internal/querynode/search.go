@@ -81,6 +81,7 @@ func searchHistorical(replica ReplicaInterface, searchReq *searchRequest, collID
 	if err != nil {
 		return searchResults, searchSegmentIDs, searchPartIDs, err
 	}
+	searchSegmentIDs = pruneByClusteringKey(replica, searchReq.exprPlan, searchSegmentIDs)
 	searchResults, err = searchOnSegments(replica, segmentTypeSealed, searchReq, searchSegmentIDs)
 	return searchResults, searchPartIDs, searchSegmentIDs, err
 }
@@ -82,6 +82,9 @@ type Segment struct {
 	lastMemSize  int64
 	lastRowCount int64
 
+	// schema is the collection schema when the segment is created, which is kept after fields are added or dropped
+	schema *schemapb.CollectionSchema
+
 	rmMutex          sync.RWMutex // guards recentlyModified
 	recentlyModified bool
 
@@ -95,6 +98,8 @@ type Segment struct {
 
 	pkFilter *bloom.BloomFilter //  bloom filter of pk inside a segment
 
+	clusteringKeyRange *datapb.ClusteringKeyRange // min/max of the clustering key, nil if not clustered
+
 	pool *concurrency.Pool
 }
 
@@ -135,6 +140,14 @@ func (s *Segment) getType() segmentType {
 	return s.segmentType
 }
 
+func (s *Segment) setClusteringKeyRange(keyRange *datapb.ClusteringKeyRange) {
+	s.clusteringKeyRange = keyRange
+}
+
+func (s *Segment) getClusteringKeyRange() *datapb.ClusteringKeyRange {
+	return s.clusteringKeyRange
+}
+
 func (s *Segment) setIndexedFieldInfo(fieldID UniqueID, info *IndexedFieldInfo) {
 	s.indexedFieldMutex.Lock()
 	defer s.indexedFieldMutex.Unlock()
@@ -205,6 +218,7 @@ func newSegment(collection *Collection, segmentID UniqueID, partitionID UniqueID
 		partitionID:       partitionID,
 		collectionID:      collectionID,
 		vChannelID:        vChannelID,
+		schema:            collection.Schema(),
 		indexedFieldInfos: make(map[UniqueID]*IndexedFieldInfo),
 
 		pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
@@ -408,6 +422,26 @@ func fillBinVecFieldData(vcm storage.ChunkManager, dataPath string, fieldData *s
 	return nil
 }
 
+func fillHalfFloatVecFieldData(vcm storage.ChunkManager, dataPath string, fieldData *schemapb.FieldData, i int, offset int64, endian binary.ByteOrder) error {
+	dim := fieldData.GetVectors().GetDim()
+	rowBytes := dim * 2
+	content, err := vcm.ReadAt(dataPath, offset*rowBytes, rowBytes)
+	if err != nil {
+		return err
+	}
+	var data []byte
+	switch x := fieldData.GetVectors().GetData().(type) {
+	case *schemapb.VectorField_Float16Vector:
+		data = x.Float16Vector
+	case *schemapb.VectorField_Bfloat16Vector:
+		data = x.Bfloat16Vector
+	default:
+		return fmt.Errorf("invalid half float vector data type: %T", x)
+	}
+	copy(data[i*int(rowBytes):(i+1)*int(rowBytes)], content)
+	return nil
+}
+
 func fillFloatVecFieldData(vcm storage.ChunkManager, dataPath string, fieldData *schemapb.FieldData, i int, offset int64, endian binary.ByteOrder) error {
 	dim := fieldData.GetVectors().GetDim()
 	rowBytes := dim * 4
@@ -534,6 +568,8 @@ func fillFieldData(vcm storage.ChunkManager, dataPath string, fieldData *schemap
 		return fillBinVecFieldData(vcm, dataPath, fieldData, i, offset, endian)
 	case schemapb.DataType_FloatVector:
 		return fillFloatVecFieldData(vcm, dataPath, fieldData, i, offset, endian)
+	case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		return fillHalfFloatVecFieldData(vcm, dataPath, fieldData, i, offset, endian)
 	case schemapb.DataType_Bool:
 		return fillBoolFieldData(vcm, dataPath, fieldData, i, offset, endian)
 	case schemapb.DataType_String, schemapb.DataType_VarChar:
@@ -682,6 +718,69 @@ func (s *Segment) segmentInsert(offset int64, entityIDs []UniqueID, timestamps [
 	return nil
 }
 
+// addFields adds the fields of the collection schema missing from the growing segment, the existing rows hold the
+// default values of the fields, or null if the fields are nullable without default values.
+// The caller must hold the write lock of collection, so no rows are inserted meanwhile.
+func (s *Segment) addFields(collection *Collection) error {
+	/*
+		CStatus
+		AddGrowingFields(CSegmentInterface c_segment,
+		                 CCollection collection,
+		                 const uint8_t* data_info,
+		                 const uint64_t data_info_len);
+	*/
+	if s.getType() != segmentTypeGrowing {
+		return fmt.Errorf("unexpected segmentType when addFields, segmentType = %s", s.getType().String())
+	}
+
+	if s.segmentPtr == nil {
+		return errors.New("null seg core pointer")
+	}
+
+	added := &schemapb.CollectionSchema{}
+	for _, field := range collection.schema.GetFields() {
+		if field.GetFieldID() >= common.StartOfUserFieldID && !hasField(s.schema, field.GetFieldID()) {
+			added.Fields = append(added.Fields, field)
+		}
+	}
+	if len(added.GetFields()) == 0 {
+		s.schema = collection.schema
+		return nil
+	}
+
+	defaultData := &storage.InsertData{Data: make(map[FieldID]storage.FieldData)}
+	if err := storage.FillDefaultFieldData(added, defaultData, uint64(s.getRowCount())); err != nil {
+		return err
+	}
+	defaultRecord, err := storage.TransferInsertDataToInsertRecord(defaultData)
+	if err != nil {
+		return err
+	}
+	defaultRecordBlob, err := proto.Marshal(defaultRecord)
+	if err != nil {
+		return fmt.Errorf("failed to marshal default record: %s", err)
+	}
+	if len(defaultRecordBlob) == 0 {
+		s.schema = collection.schema
+		return nil
+	}
+
+	var status C.CStatus
+	s.pool.Submit(func() (interface{}, error) {
+		status = C.AddGrowingFields(s.segmentPtr,
+			collection.collectionPtr,
+			(*C.uint8_t)(unsafe.Pointer(&defaultRecordBlob[0])),
+			(C.uint64_t)(len(defaultRecordBlob)))
+		return nil, nil
+	}).Await()
+
+	if err := HandleCStatus(&status, "AddGrowingFields failed"); err != nil {
+		return err
+	}
+	s.schema = collection.schema
+	return nil
+}
+
 func (s *Segment) segmentDelete(offset int64, entityIDs []primaryKey, timestamps []Timestamp) error {
 	/*
 		CStatus
@@ -154,6 +154,7 @@ func (loader *segmentLoader) LoadSegment(req *querypb.LoadSegmentsRequest, segme
 			segmentGC()
 			return err
 		}
+		segment.setClusteringKeyRange(info.GetClusteringKeyRange())
 
 		newSegments[segmentID] = segment
 	}
@@ -232,6 +233,16 @@ func (loader *segmentLoader) loadFiles(segment *Segment,
 	// for now, there will be multiple copies in the process of data loading into segCore
 	defer debug.FreeOSMemory()
 
+	// the binlogs of the dropped fields are ignored
+	binlogPaths := make([]*datapb.FieldBinlog, 0, len(loadInfo.BinlogPaths))
+	for _, fieldBinlog := range loadInfo.BinlogPaths {
+		if segment.schema != nil && fieldBinlog.FieldID >= common.StartOfUserFieldID && !hasField(segment.schema, fieldBinlog.FieldID) {
+			log.Info("skip the binlogs of dropped field", zap.Int64("segmentID", segmentID), zap.Int64("fieldID", fieldBinlog.FieldID))
+			continue
+		}
+		binlogPaths = append(binlogPaths, fieldBinlog)
+	}
+
 	if segment.getType() == segmentTypeSealed {
 		fieldID2IndexInfo := make(map[int64]*querypb.FieldIndexInfo)
 		for _, indexInfo := range loadInfo.IndexInfos {
@@ -242,9 +253,9 @@ func (loader *segmentLoader) loadFiles(segment *Segment,
 		}
 
 		indexedFieldInfos := make(map[int64]*IndexedFieldInfo)
-		fieldBinlogs := make([]*datapb.FieldBinlog, 0, len(loadInfo.BinlogPaths))
+		fieldBinlogs := make([]*datapb.FieldBinlog, 0, len(binlogPaths))
 
-		for _, fieldBinlog := range loadInfo.BinlogPaths {
+		for _, fieldBinlog := range binlogPaths {
 			fieldID := fieldBinlog.FieldID
 			if indexInfo, ok := fieldID2IndexInfo[fieldID]; ok {
 				fieldInfo := &IndexedFieldInfo{
@@ -263,8 +274,11 @@ func (loader *segmentLoader) loadFiles(segment *Segment,
 		if err := loader.loadSealedSegmentFields(segment, fieldBinlogs, loadInfo); err != nil {
 			return err
 		}
+		if err := loader.loadDefaultFieldData(segment, binlogPaths, loadInfo.GetNumOfRows()); err != nil {
+			return err
+		}
 	} else {
-		if err := loader.loadGrowingSegmentFields(segment, loadInfo.BinlogPaths); err != nil {
+		if err := loader.loadGrowingSegmentFields(segment, binlogPaths); err != nil {
 			return err
 		}
 	}
@@ -350,6 +364,11 @@ func (loader *segmentLoader) loadGrowingSegmentFields(segment *Segment, fieldBin
 			return errors.New("cannot get row ids from insert data")
 		}
 
+		// the fields added after the binlogs were written get the default values
+		if err := storage.FillDefaultFieldData(segment.schema, insertData, uint64(tsData.RowNum())); err != nil {
+			return err
+		}
+
 		return loader.loadGrowingSegments(segment, rowIDData.(*storage.Int64FieldData).Data, utss, insertData)
 
 	default:
@@ -409,10 +428,64 @@ func (loader *segmentLoader) loadSealedFieldAsync(segment *Segment, field *datap
 			return nil, err
 		}
 
+		// the field added after some binlogs were written has fewer rows, fill the default values before them
+		fieldSchema := fieldsSchema(segment.schema, func(f *schemapb.FieldSchema) bool { return f.GetFieldID() == field.FieldID })
+		if err := storage.FillDefaultFieldData(fieldSchema, &insertData, uint64(loadInfo.GetNumOfRows())); err != nil {
+			return nil, err
+		}
+
 		return nil, loader.loadSealedSegments(segment, &insertData)
 	})
 }
 
+// loadDefaultFieldData loads the default values of the fields added after the sealed segment was written
+func (loader *segmentLoader) loadDefaultFieldData(segment *Segment, fieldBinlogs []*datapb.FieldBinlog, numRows int64) error {
+	loaded := make(map[int64]struct{}, len(fieldBinlogs))
+	for _, fieldBinlog := range fieldBinlogs {
+		loaded[fieldBinlog.FieldID] = struct{}{}
+	}
+	schema := fieldsSchema(segment.schema, func(f *schemapb.FieldSchema) bool {
+		_, ok := loaded[f.GetFieldID()]
+		return !ok
+	})
+
+	insertData := &storage.InsertData{
+		Data: make(map[int64]storage.FieldData),
+	}
+	if err := storage.FillDefaultFieldData(schema, insertData, uint64(numRows)); err != nil {
+		return err
+	}
+	if len(insertData.Data) == 0 {
+		return nil
+	}
+	log.Info("load default values of the added fields",
+		zap.Int64("collection", segment.collectionID),
+		zap.Int64("segment", segment.segmentID),
+		zap.Int("len(field)", len(insertData.Data)))
+	return loader.loadSealedSegments(segment, insertData)
+}
+
+// fieldsSchema returns a schema with the fields accepted by the filter
+func fieldsSchema(schema *schemapb.CollectionSchema, filter func(*schemapb.FieldSchema) bool) *schemapb.CollectionSchema {
+	ret := &schemapb.CollectionSchema{}
+	for _, field := range schema.GetFields() {
+		if filter(field) {
+			ret.Fields = append(ret.Fields, field)
+		}
+	}
+	return ret
+}
+
+// hasField returns whether the field is in the schema
+func hasField(schema *schemapb.CollectionSchema, fieldID int64) bool {
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() == fieldID {
+			return true
+		}
+	}
+	return false
+}
+
 // Load binlogs concurrently into memory from KV storage asyncly
 func (loader *segmentLoader) loadFieldBinlogsAsync(field *datapb.FieldBinlog) []*concurrency.Future {
 	futures := make([]*concurrency.Future, 0, len(field.Binlogs))
@@ -550,6 +550,30 @@ func (sc *ShardCluster) segmentAllocations(partitionIDs []int64) (map[int64][]in
 	return sc.currentVersion.GetAllocation(partitionIDs), sc.currentVersion.versionID
 }
 
+// excludeSegments removes the excluded segments from the allocations, the nodes left nothing to serve are removed too.
+func excludeSegments(segAllocs map[int64][]int64, excluded []int64) map[int64][]int64 {
+	if len(excluded) == 0 {
+		return segAllocs
+	}
+	excludedSet := make(map[int64]struct{}, len(excluded))
+	for _, segmentID := range excluded {
+		excludedSet[segmentID] = struct{}{}
+	}
+	result := make(map[int64][]int64, len(segAllocs))
+	for nodeID, segmentIDs := range segAllocs {
+		left := make([]int64, 0, len(segmentIDs))
+		for _, segmentID := range segmentIDs {
+			if _, ok := excludedSet[segmentID]; !ok {
+				left = append(left, segmentID)
+			}
+		}
+		if len(left) > 0 {
+			result[nodeID] = left
+		}
+	}
+	return result
+}
+
 // finishUsage decreases the inUse count of provided segments
 func (sc *ShardCluster) finishUsage(versionID int64) {
 	defer func() {
@@ -842,6 +866,7 @@ func (sc *ShardCluster) Search(ctx context.Context, req *querypb.SearchRequest,
 	// get node allocation and maintains the inUse reference count
 	segAllocs, versionID := sc.segmentAllocations(req.GetReq().GetPartitionIDs())
 	defer sc.finishUsage(versionID)
+	segAllocs = excludeSegments(segAllocs, req.GetReq().GetPrunedSegmentIDs())
 
 	log.Debug("cluster segment distribution", zap.Int("len", len(segAllocs)))
 	for nodeID, segmentIDs := range segAllocs {
@@ -928,6 +953,7 @@ func (sc *ShardCluster) Query(ctx context.Context, req *querypb.QueryRequest, wi
 	// get node allocation and maintains the inUse reference count
 	segAllocs, versionID := sc.segmentAllocations(req.GetReq().GetPartitionIDs())
 	defer sc.finishUsage(versionID)
+	segAllocs = excludeSegments(segAllocs, req.GetReq().GetPrunedSegmentIDs())
 
 	// concurrent visiting nodes
 	var wg sync.WaitGroup
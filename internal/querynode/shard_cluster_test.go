@@ -2346,3 +2346,12 @@ func TestShardCluster_HandoffSegments(t *testing.T) {
 
 	})
 }
+
+func TestShardCluster_excludeSegments(t *testing.T) {
+	segAllocs := map[int64][]int64{
+		1: {1, 2},
+		2: {3},
+	}
+	assert.Equal(t, segAllocs, excludeSegments(segAllocs, nil))
+	assert.Equal(t, map[int64][]int64{1: {2}}, excludeSegments(segAllocs, []int64{1, 3, 4}))
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
@@ -78,7 +79,7 @@ func (q *queryTask) queryOnStreaming() error {
 	}
 	defer plan.delete()
 
-	sResults, _, _, sErr := retrieveStreaming(q.QS.metaReplica, plan, q.CollectionID, q.iReq.GetPartitionIDs(), q.QS.channel, q.QS.vectorChunkManager)
+	sResults, profiles, partIDs, _, sErr := retrieveStreaming(q.QS.metaReplica, plan, q.CollectionID, q.iReq.GetPartitionIDs(), q.QS.channel, q.QS.vectorChunkManager)
 	if sErr != nil {
 		return sErr
 	}
@@ -94,7 +95,9 @@ func (q *queryTask) queryOnStreaming() error {
 		Ids:        mergedResult.Ids,
 		FieldsData: mergedResult.FieldsData,
 	}
+	limitRetrieveResult(q.Ret, q.iReq.GetLimit())
 	q.reduceDur = q.tr.RecordSpan()
+	q.explain(partIDs, profiles)
 	return nil
 }
 
@@ -125,10 +128,11 @@ func (q *queryTask) queryOnHistorical() error {
 		return err
 	}
 	defer plan.delete()
-	retrieveResults, _, _, err := retrieveHistorical(q.QS.metaReplica, plan, q.CollectionID, nil, q.req.SegmentIDs, q.QS.vectorChunkManager)
+	retrieveResults, profiles, partIDs, _, err := retrieveHistorical(q.QS.metaReplica, plan, q.CollectionID, nil, q.req.SegmentIDs, q.QS.vectorChunkManager)
 	if err != nil {
 		return err
 	}
+	q.tr.RecordSpan()
 	mergedResult, err := mergeSegcoreRetrieveResults(retrieveResults)
 	if err != nil {
 		return err
@@ -138,9 +142,20 @@ func (q *queryTask) queryOnHistorical() error {
 		Ids:        mergedResult.Ids,
 		FieldsData: mergedResult.FieldsData,
 	}
+	limitRetrieveResult(q.Ret, q.iReq.GetLimit())
+	q.reduceDur = q.tr.RecordSpan()
+	q.explain(partIDs, profiles)
 	return nil
 }
 
+// explain attaches the profile of the query to the result if it's required.
+func (q *queryTask) explain(partIDs []UniqueID, segments []*milvuspb.SegmentProfile) {
+	if !q.iReq.GetExplain() {
+		return
+	}
+	q.Ret.Profiles = []*milvuspb.ShardProfile{q.newShardProfile(partIDs, segments)}
+}
+
 func (q *queryTask) Execute(ctx context.Context) error {
 	if q.DataScope == querypb.DataScope_Streaming {
 		return q.queryOnStreaming()
@@ -178,6 +193,8 @@ func newQueryTask(ctx context.Context, src *querypb.QueryRequest) *queryTask {
 			TravelTimestamp:    src.Req.GetTravelTimestamp(),
 			GuaranteeTimestamp: src.Req.GetGuaranteeTimestamp(),
 			TimeoutTimestamp:   src.Req.GetTimeoutTimestamp(),
+			Username:           src.Req.GetUsername(),
+			Priority:           src.Req.GetPriority(),
 			tr:                 timerecord.NewTimeRecorder("queryTask"),
 			DataScope:          src.GetScope(),
 		},
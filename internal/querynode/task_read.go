@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
@@ -35,6 +36,8 @@ type readTask interface {
 	Ctx() context.Context
 
 	GetCollectionID() UniqueID
+	GetUsername() string
+	GetPriority() int32
 
 	Ready() (bool, error)
 	Merge(readTask)
@@ -61,6 +64,8 @@ type baseReadTask struct {
 	TravelTimestamp    uint64
 	GuaranteeTimestamp uint64
 	TimeoutTimestamp   uint64
+	Username           string
+	Priority           int32
 	step               TaskStep
 	queueDur           time.Duration
 	reduceDur          time.Duration
@@ -110,11 +115,33 @@ func (b *baseReadTask) Notify(err error) {
 	b.baseTask.Notify(err)
 }
 
+// newShardProfile describes how the task is executed on the query shard.
+func (b *baseReadTask) newShardProfile(partIDs []UniqueID, segments []*milvuspb.SegmentProfile) *milvuspb.ShardProfile {
+	return &milvuspb.ShardProfile{
+		Channel:      b.QS.channel,
+		NodeID:       Params.QueryNodeCfg.GetNodeID(),
+		PartitionIDs: partIDs,
+		Segments:     segments,
+		WaitTsafeUs:  b.queueDur.Microseconds(),
+		ReduceUs:     b.reduceDur.Microseconds(),
+	}
+}
+
 // GetCollectionID return CollectionID.
 func (b *baseReadTask) GetCollectionID() UniqueID {
 	return b.CollectionID
 }
 
+// GetUsername return the user sending the request, empty if the authorization is disabled.
+func (b *baseReadTask) GetUsername() string {
+	return b.Username
+}
+
+// GetPriority return the priority of the request.
+func (b *baseReadTask) GetPriority() int32 {
+	return b.Priority
+}
+
 func (b *baseReadTask) CanMergeWith(t readTask) bool {
 	return false
 }
@@ -23,11 +23,15 @@ import (
 	"runtime"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/trace"
 )
 
 const (
@@ -51,6 +55,12 @@ type taskScheduler struct {
 	tSafeReplica TSafeReplicaInterface
 
 	schedule scheduleReadTaskPolicy
+	// group of the read tasks by the schedule policy, only the tasks of the same group are merged
+	group readTaskGroupFunc
+	// when the tasks in readyReadTasks became ready
+	readyTime map[readTask]time.Time
+	// groups with the ready tasks reported to metrics
+	readyGroups map[string]struct{}
 	// for search and query end
 
 	cpuUsage        int32 // 1200 means 1200% 12 cores
@@ -83,7 +93,14 @@ func newTaskScheduler(ctx context.Context, tSafeReplica TSafeReplicaInterface) *
 		notifyChan:          make(chan struct{}, 1),
 		tSafeReplica:        tSafeReplica,
 		maxCPUUsage:         int32(getNumCPU() * 100),
-		schedule:            defaultScheduleReadPolicy,
+		readyTime:           make(map[readTask]time.Time),
+		readyGroups:         make(map[string]struct{}),
+	}
+	var err error
+	s.schedule, s.group, err = newScheduleReadPolicy(Params.QueryNodeCfg.ScheduleReadPolicy, Params.QueryNodeCfg.ScheduleReadWeights)
+	if err != nil {
+		log.Warn("invalid schedule read policy, use fifo instead", zap.Error(err))
+		s.schedule, s.group = defaultScheduleReadPolicy, groupByCollection
 	}
 	s.queue = newQueryNodeTaskQueue(s)
 	return s
@@ -255,9 +272,38 @@ func (s *taskScheduler) popAndAddToExecute() {
 
 	tasks, deltaUsage := s.schedule(s.readyReadTasks, targetUsage, remain)
 	atomic.AddInt32(&s.cpuUsage, deltaUsage)
+	nodeID := fmt.Sprint(Params.QueryNodeCfg.GetNodeID())
 	for _, t := range tasks {
+		if readyTime, ok := s.readyTime[t]; ok {
+			metrics.QueryNodeReadTaskGroupWaitLatency.WithLabelValues(nodeID, s.group(t)).Observe(float64(time.Since(readyTime).Milliseconds()))
+			delete(s.readyTime, t)
+		}
 		s.executeReadTaskChan <- t
 	}
+	if len(tasks) > 0 {
+		s.updateReadyGroupMetrics()
+	}
+}
+
+// updateReadyGroupMetrics reports the number of ready tasks of each group, the groups without ready tasks are removed
+func (s *taskScheduler) updateReadyGroupMetrics() {
+	nodeID := fmt.Sprint(Params.QueryNodeCfg.GetNodeID())
+	groupLen := make(map[string]int)
+	for e := s.readyReadTasks.Front(); e != nil; e = e.Next() {
+		if t, ok := e.Value.(readTask); ok {
+			groupLen[s.group(t)]++
+		}
+	}
+	for group := range s.readyGroups {
+		if _, ok := groupLen[group]; !ok {
+			metrics.QueryNodeReadTaskGroupReadyLen.DeleteLabelValues(nodeID, group)
+			delete(s.readyGroups, group)
+		}
+	}
+	for group, n := range groupLen {
+		metrics.QueryNodeReadTaskGroupReadyLen.WithLabelValues(nodeID, group).Set(float64(n))
+		s.readyGroups[group] = struct{}{}
+	}
 }
 
 func (s *taskScheduler) executeReadTasks() {
@@ -313,9 +359,13 @@ func (s *taskScheduler) executeReadTasks() {
 }
 
 func (s *taskScheduler) processReadTask(t readTask) {
-	err := t.PreExecute(t.Ctx())
+	ctx, span := trace.StartOtelSpan(t.Ctx(), "QueryNode-ReadTask", attribute.Int64("collectionID", t.GetCollectionID()))
+	// Execute and PostExecute are bound to the scheduler instead of the request, only the span is passed on
+	execCtx := oteltrace.ContextWithSpan(s.ctx, span)
+	err := t.PreExecute(ctx)
 
 	defer func() {
+		trace.EndOtelSpan(span, err)
 		t.Notify(err)
 	}()
 	if err != nil {
@@ -323,12 +373,12 @@ func (s *taskScheduler) processReadTask(t readTask) {
 		return
 	}
 
-	err = t.Execute(s.ctx)
+	err = t.Execute(execCtx)
 	if err != nil {
 		log.Warn(err.Error())
 		return
 	}
-	err = t.PostExecute(s.ctx)
+	err = t.PostExecute(execCtx)
 }
 
 func (s *taskScheduler) Close() {
@@ -354,6 +404,7 @@ func (s *taskScheduler) tryMergeReadTasks() {
 		if ready {
 			if !Params.QueryNodeCfg.GroupEnabled {
 				s.readyReadTasks.PushBack(t)
+				s.readyTime[t] = time.Now()
 			} else {
 				merged := false
 				for m := s.readyReadTasks.Back(); m != nil; m = m.Prev() {
@@ -361,7 +412,7 @@ func (s *taskScheduler) tryMergeReadTasks() {
 					if !ok {
 						continue
 					}
-					if mTask.CanMergeWith(t) {
+					if s.group(mTask) == s.group(t) && mTask.CanMergeWith(t) {
 						mTask.Merge(t)
 						merged = true
 						break
@@ -369,6 +420,7 @@ func (s *taskScheduler) tryMergeReadTasks() {
 				}
 				if !merged {
 					s.readyReadTasks.PushBack(t)
+					s.readyTime[t] = time.Now()
 				}
 			}
 			s.unsolvedReadTasks.Remove(e)
@@ -376,4 +428,5 @@ func (s *taskScheduler) tryMergeReadTasks() {
 	}
 	metrics.QueryNodeReadTaskUnsolveLen.WithLabelValues(fmt.Sprint(Params.QueryNodeCfg.GetNodeID())).Set(float64(s.unsolvedReadTasks.Len()))
 	metrics.QueryNodeReadTaskReadyLen.WithLabelValues(fmt.Sprint(Params.QueryNodeCfg.GetNodeID())).Set(float64(s.readyReadTasks.Len()))
+	s.updateReadyGroupMetrics()
 }
@@ -62,6 +62,8 @@ type mockReadTask struct {
 	cpuUsage     int32
 	maxCPU       int32
 	collectionID UniqueID
+	username     string
+	priority     int32
 	ready        bool
 	canMerge     bool
 	timeout      bool
@@ -73,6 +75,14 @@ func (m *mockReadTask) GetCollectionID() UniqueID {
 	return m.collectionID
 }
 
+func (m *mockReadTask) GetUsername() string {
+	return m.username
+}
+
+func (m *mockReadTask) GetPriority() int32 {
+	return m.priority
+}
+
 func (m *mockReadTask) Ready() (bool, error) {
 	return m.ready, m.readyError
 }
@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
@@ -56,6 +57,7 @@ type searchTask struct {
 	cpuOnce          sync.Once
 	plan             *planpb.PlanNode
 	qInfo            *planpb.QueryInfo
+	groupBy          *groupByInfo
 }
 
 func (s *searchTask) PreExecute(ctx context.Context) error {
@@ -79,6 +81,11 @@ func (s *searchTask) init() error {
 			s.qInfo = s.plan.GetVectorAnns().GetQueryInfo()
 		}
 	}
+	groupBy, err := newGroupByInfo(s.iReq.GetGroupByFieldId(), s.iReq.GetGroupSize(), s.iReq.GetOutputFieldsId())
+	if err != nil {
+		return err
+	}
+	s.groupBy = groupBy
 	return nil
 }
 
@@ -114,14 +121,18 @@ func (s *searchTask) searchOnStreaming() error {
 	defer searchReq.delete()
 
 	// TODO add context
-	partResults, _, _, sErr := searchStreaming(s.QS.metaReplica, searchReq, s.CollectionID, s.iReq.GetPartitionIDs(), s.req.GetDmlChannels()[0])
+	partResults, partIDs, segIDs, sErr := searchStreaming(s.QS.metaReplica, searchReq, s.CollectionID, s.iReq.GetPartitionIDs(), s.req.GetDmlChannels()[0])
 	if sErr != nil {
 		log.Debug("failed to search streaming data", zap.Int64("msgID", s.ID()),
 			zap.Int64("collectionID", s.CollectionID), zap.Error(sErr))
 		return sErr
 	}
 	defer deleteSearchResults(partResults)
-	return s.reduceResults(searchReq, partResults)
+	if err := s.reduceResults(searchReq, partResults); err != nil {
+		return err
+	}
+	s.explain(segmentTypeGrowing, partIDs, segIDs, partResults)
+	return nil
 }
 
 func (s *searchTask) searchOnHistorical() error {
@@ -151,12 +162,31 @@ func (s *searchTask) searchOnHistorical() error {
 	}
 	defer searchReq.delete()
 
-	partResults, _, _, err := searchHistorical(s.QS.metaReplica, searchReq, s.CollectionID, nil, segmentIDs)
+	partResults, partIDs, segIDs, err := searchHistorical(s.QS.metaReplica, searchReq, s.CollectionID, nil, segmentIDs)
 	if err != nil {
 		return err
 	}
 	defer deleteSearchResults(partResults)
-	return s.reduceResults(searchReq, partResults)
+	if err := s.reduceResults(searchReq, partResults); err != nil {
+		return err
+	}
+	s.explain(segmentTypeSealed, partIDs, segIDs, partResults)
+	return nil
+}
+
+// explain attaches the profile of the search to the result if it's required.
+func (s *searchTask) explain(segType segmentType, partIDs []UniqueID, segIDs []UniqueID, results []*SearchResult) {
+	if !s.iReq.GetExplain() {
+		return
+	}
+	segments := make([]*milvuspb.SegmentProfile, 0, len(results))
+	for i, result := range results {
+		// result is nil if the segment is released during search
+		if result != nil {
+			segments = append(segments, getSearchProfile(result, segIDs[i], segType))
+		}
+	}
+	s.Ret.Profiles = []*milvuspb.ShardProfile{s.newShardProfile(partIDs, segments)}
 }
 
 func (s *searchTask) Execute(ctx context.Context) error {
@@ -241,6 +271,13 @@ func (s *searchTask) reduceResults(searchReq *searchRequest, results []*SearchRe
 			}
 			bs := make([]byte, len(blob))
 			copy(bs, blob)
+			if s.groupBy != nil {
+				bs, err = groupSearchResultDataBlob(bs, s.OrigNQs[i], s.OrigTopKs[i], s.groupBy)
+				if err != nil {
+					log.Debug("group search results error", zap.Int64("msgID", s.ID()), zap.Error(err))
+					return err
+				}
+			}
 			if i == 0 {
 				t = s
 			} else {
@@ -288,6 +325,11 @@ func (s *searchTask) CanMergeWith(t readTask) bool {
 		return false
 	}
 
+	// profile of explained search could not be split from a merged task
+	if s.iReq.GetExplain() || s2.iReq.GetExplain() {
+		return false
+	}
+
 	if s.CollectionID != s2.CollectionID {
 		return false
 	}
@@ -407,6 +449,8 @@ func newSearchTask(ctx context.Context, src *querypb.SearchRequest) (*searchTask
 			TravelTimestamp:    src.Req.GetTravelTimestamp(),
 			GuaranteeTimestamp: src.Req.GetGuaranteeTimestamp(),
 			TimeoutTimestamp:   src.Req.GetTimeoutTimestamp(),
+			Username:           src.Req.GetUsername(),
+			Priority:           src.Req.GetPriority(),
 			tr:                 timerecord.NewTimeRecorder("searchTask"),
 			DataScope:          src.GetScope(),
 		},
@@ -23,6 +23,8 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 )
 
 // TODO: merge validate?
@@ -131,3 +133,25 @@ func validateOnStreamReplica(replica ReplicaInterface, collectionID UniqueID, pa
 		zap.Error(err))
 	return searchPartIDs, segmentIDs, nil
 }
+
+// pruneByClusteringKey filters out the sealed segments whose clustering key range doesn't match the plan.
+func pruneByClusteringKey(replica ReplicaInterface, plan *planpb.PlanNode, segmentIDs []UniqueID) []UniqueID {
+	if plan == nil {
+		return segmentIDs
+	}
+	prunedIDs := make([]UniqueID, 0, len(segmentIDs))
+	for _, segmentID := range segmentIDs {
+		segment, err := replica.getSegmentByID(segmentID, segmentTypeSealed)
+		if err == nil {
+			keyRange := segment.getClusteringKeyRange()
+			if keyRange != nil && planparserv2.CanPruneByRange(plan, keyRange.GetFieldID(), keyRange.GetMin(), keyRange.GetMax()) {
+				continue
+			}
+		}
+		prunedIDs = append(prunedIDs, segmentID)
+	}
+	if len(prunedIDs) < len(segmentIDs) {
+		log.Debug("prune segments by clustering key", zap.Int("before", len(segmentIDs)), zap.Int("after", len(prunedIDs)))
+	}
+	return prunedIDs
+}
@@ -21,6 +21,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
 func TestQueryShardHistorical_validateSegmentIDs(t *testing.T) {
@@ -116,3 +120,38 @@ func TestQueryShardHistorical_validateSegmentIDs(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestPruneByClusteringKey(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	his, err := genSimpleReplicaWithSealSegment(ctx)
+	assert.NoError(t, err)
+	seg, err := his.getSegmentByID(defaultSegmentID, segmentTypeSealed)
+	assert.NoError(t, err)
+
+	plan := &planpb.PlanNode{
+		Node: &planpb.PlanNode_Predicates{
+			Predicates: &planpb.Expr{
+				Expr: &planpb.Expr_UnaryRangeExpr{
+					UnaryRangeExpr: &planpb.UnaryRangeExpr{
+						ColumnInfo: &planpb.ColumnInfo{FieldId: simpleInt64Field.id, DataType: schemapb.DataType_Int64},
+						Op:         planpb.OpType_GreaterThan,
+						Value:      &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: 100}},
+					},
+				},
+			},
+		},
+	}
+
+	// not clustered
+	assert.Equal(t, []UniqueID{defaultSegmentID}, pruneByClusteringKey(his, plan, []UniqueID{defaultSegmentID}))
+
+	seg.setClusteringKeyRange(&datapb.ClusteringKeyRange{
+		FieldID: simpleInt64Field.id,
+		Min:     &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: 0}},
+		Max:     &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: 10}},
+	})
+	assert.Empty(t, pruneByClusteringKey(his, plan, []UniqueID{defaultSegmentID}))
+	assert.Equal(t, []UniqueID{defaultSegmentID}, pruneByClusteringKey(his, nil, []UniqueID{defaultSegmentID}))
+}
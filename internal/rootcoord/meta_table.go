@@ -404,6 +404,63 @@ func (mt *MetaTable) AddPartition(collID typeutil.UniqueID, partitionName string
 	return nil
 }
 
+// AlterShards appends the newly created channels to the collection and records the new shards number
+func (mt *MetaTable) AlterShards(collID typeutil.UniqueID, vchanNames []string, pchanNames []string, startPositions []*commonpb.KeyDataPair, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+	coll, ok := mt.collID2Meta[collID]
+	if !ok {
+		return fmt.Errorf("can't find collection. id = %d", collID)
+	}
+	if len(vchanNames) == 0 || len(vchanNames) != len(pchanNames) {
+		return fmt.Errorf("invalid channels, vchannels = %v, pchannels = %v", vchanNames, pchanNames)
+	}
+
+	newColl := coll.Clone()
+	newColl.VirtualChannelNames = append(append([]string{}, coll.VirtualChannelNames...), vchanNames...)
+	newColl.PhysicalChannelNames = append(append([]string{}, coll.PhysicalChannelNames...), pchanNames...)
+	newColl.StartPositions = append(append([]*commonpb.KeyDataPair{}, coll.StartPositions...), startPositions...)
+	// collections created before shards could be altered have no history, the first entry is the original shards number
+	history := coll.ShardsNumHistory
+	if len(history) == 0 {
+		history = []int32{int32(len(coll.VirtualChannelNames))}
+	}
+	newColl.ShardsNum = int32(len(newColl.VirtualChannelNames))
+	newColl.ShardsNumHistory = append(append([]int32{}, history...), newColl.ShardsNum)
+
+	if err := mt.catalog.AlterCollection(mt.ctx, newColl, ts); err != nil {
+		return err
+	}
+
+	mt.collID2Meta[collID] = *newColl
+	return nil
+}
+
+// AlterSchema replaces the fields of the collection, schemaVersion must follow the current version of the collection
+func (mt *MetaTable) AlterSchema(collID typeutil.UniqueID, fields []*model.Field, schemaVersion int32, maxFieldID int64, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+	coll, ok := mt.collID2Meta[collID]
+	if !ok {
+		return fmt.Errorf("can't find collection. id = %d", collID)
+	}
+	if schemaVersion != coll.SchemaVersion+1 {
+		return fmt.Errorf("schema version of collection %d is %d, can't be altered to %d", collID, coll.SchemaVersion, schemaVersion)
+	}
+
+	newColl := coll.Clone()
+	newColl.Fields = fields
+	newColl.SchemaVersion = schemaVersion
+	newColl.MaxFieldID = maxFieldID
+
+	if err := mt.catalog.AlterCollection(mt.ctx, newColl, ts); err != nil {
+		return err
+	}
+
+	mt.collID2Meta[collID] = *newColl
+	return nil
+}
+
 // GetPartitionNameByID return partition name by partition id
 func (mt *MetaTable) GetPartitionNameByID(collID, partitionID typeutil.UniqueID, ts typeutil.Timestamp) (string, error) {
 	if ts == 0 {
@@ -1073,10 +1130,11 @@ func (mt *MetaTable) AddIndex(colName string, fieldName string, idxInfo *model.I
 		return false, err
 	}
 
-	//TODO:: check index params for scalar field
-	// set default index type for scalar index
+	// set default index type for scalar index unless a scalar index type is specified
 	if !typeutil.IsVectorType(fieldSchema.DataType) {
-		if fieldSchema.DataType == schemapb.DataType_VarChar {
+		if indexType := GetScalarIndexType(idxInfo.IndexParams); indexType != "" {
+			idxInfo.IndexParams = []*commonpb.KeyValuePair{{Key: "index_type", Value: indexType}}
+		} else if fieldSchema.DataType == schemapb.DataType_VarChar {
 			idxInfo.IndexParams = []*commonpb.KeyValuePair{{Key: "index_type", Value: DefaultStringIndexType}}
 		} else {
 			idxInfo.IndexParams = []*commonpb.KeyValuePair{{Key: "index_type", Value: DefaultIndexType}}
@@ -1437,3 +1495,33 @@ func (mt *MetaTable) ListPolicy(tenant string) ([]string, error) {
 func (mt *MetaTable) ListUserRole(tenant string) ([]string, error) {
 	return mt.catalog.ListUserRole(mt.ctx, tenant)
 }
+
+// CreateRowPolicy saves the row policy, the policy of the same name on the role and the collection is replaced
+func (mt *MetaTable) CreateRowPolicy(tenant string, entity *milvuspb.RowPolicyEntity) error {
+	if entity.Role == nil || funcutil.IsEmptyString(entity.Role.Name) {
+		return fmt.Errorf("the role entity in the row policy entity is invalid")
+	}
+	if funcutil.IsEmptyString(entity.CollectionName) {
+		return fmt.Errorf("the collection name in the row policy entity is empty")
+	}
+	if funcutil.IsEmptyString(entity.PolicyName) {
+		return fmt.Errorf("the policy name in the row policy entity is empty")
+	}
+	if funcutil.IsEmptyString(entity.Expr) {
+		return fmt.Errorf("the expression in the row policy entity is empty")
+	}
+	return mt.catalog.SaveRowPolicy(mt.ctx, tenant, entity)
+}
+
+// DropRowPolicy drops the row policy of the role on the collection
+func (mt *MetaTable) DropRowPolicy(tenant string, roleName string, collectionName string, policyName string) error {
+	if funcutil.IsEmptyString(roleName) || funcutil.IsEmptyString(collectionName) || funcutil.IsEmptyString(policyName) {
+		return fmt.Errorf("the role name, the collection name and the policy name of the row policy should not be empty")
+	}
+	return mt.catalog.DropRowPolicy(mt.ctx, tenant, roleName, collectionName, policyName)
+}
+
+// ListRowPolicies lists the row policies, filtered by the role name and the collection name if they are not empty
+func (mt *MetaTable) ListRowPolicies(tenant string, roleName string, collectionName string) ([]*milvuspb.RowPolicyEntity, error) {
+	return mt.catalog.ListRowPolicies(mt.ctx, tenant, roleName, collectionName)
+}
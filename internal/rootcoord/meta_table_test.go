@@ -1525,6 +1525,77 @@ func TestRbacListUserRole(t *testing.T) {
 	assert.Equal(t, 4, len(userRoles))
 }
 
+func TestRbacRowPolicy(t *testing.T) {
+	mt, _, mockTxnKV, closeCli := generateMetaTable(t)
+	defer closeCli()
+	var err error
+
+	entity := &milvuspb.RowPolicyEntity{}
+	err = mt.CreateRowPolicy(util.DefaultTenant, entity)
+	assert.NotNil(t, err)
+	entity.Role = &milvuspb.RoleEntity{Name: "role1"}
+	err = mt.CreateRowPolicy(util.DefaultTenant, entity)
+	assert.NotNil(t, err)
+	entity.CollectionName = "col1"
+	err = mt.CreateRowPolicy(util.DefaultTenant, entity)
+	assert.NotNil(t, err)
+	entity.PolicyName = "policy1"
+	err = mt.CreateRowPolicy(util.DefaultTenant, entity)
+	assert.NotNil(t, err)
+	entity.Expr = "region == \"eu\""
+
+	var savedKey string
+	mockTxnKV.save = func(key, value string) error {
+		savedKey = key
+		return nil
+	}
+	err = mt.CreateRowPolicy(util.DefaultTenant, entity)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasSuffix(savedKey, "/role1/col1/policy1"))
+
+	mockTxnKV.save = func(key, value string) error {
+		return fmt.Errorf("save error")
+	}
+	err = mt.CreateRowPolicy(util.DefaultTenant, entity)
+	assert.NotNil(t, err)
+
+	err = mt.DropRowPolicy(util.DefaultTenant, "role1", "col1", "")
+	assert.NotNil(t, err)
+	mockTxnKV.load = func(key string) (string, error) {
+		return "", fmt.Errorf("load error")
+	}
+	err = mt.DropRowPolicy(util.DefaultTenant, "role1", "col1", "policy1")
+	assert.NotNil(t, err)
+	mockTxnKV.load = func(key string) (string, error) {
+		return "value", nil
+	}
+	mockTxnKV.remove = func(key string) error {
+		return nil
+	}
+	err = mt.DropRowPolicy(util.DefaultTenant, "role1", "col1", "policy1")
+	assert.Nil(t, err)
+
+	entityByte, _ := proto.Marshal(entity)
+	entity2 := proto.Clone(entity).(*milvuspb.RowPolicyEntity)
+	entity2.CollectionName = "col2"
+	entityByte2, _ := proto.Marshal(entity2)
+	mockTxnKV.loadWithPrefix = func(key string) ([]string, []string, error) {
+		return []string{key + "col1/policy1", key + "col2/policy1", key + "col3/policy1"}, []string{string(entityByte), string(entityByte2), "unmarshal error"}, nil
+	}
+	entities, err := mt.ListRowPolicies(util.DefaultTenant, "role1", "")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(entities))
+	entities, err = mt.ListRowPolicies(util.DefaultTenant, "", "col2")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(entities))
+
+	mockTxnKV.loadWithPrefix = func(key string) ([]string, []string, error) {
+		return nil, nil, fmt.Errorf("load with prefix error")
+	}
+	_, err = mt.ListRowPolicies(util.DefaultTenant, "role1", "col1")
+	assert.NotNil(t, err)
+}
+
 func TestMetaWithTimestamp(t *testing.T) {
 	const (
 		collID1   = typeutil.UniqueID(1)
@@ -2283,6 +2354,115 @@ func (mc *MockedCatalog) DropIndex(ctx context.Context, collectionInfo *model.Co
 	return err.(error)
 }
 
+func (mc *MockedCatalog) AlterCollection(ctx context.Context, collectionInfo *model.Collection, ts typeutil.Timestamp) error {
+	args := mc.Called()
+	err := args.Get(0)
+	if err == nil {
+		return nil
+	}
+	return err.(error)
+}
+
+func TestMetaTable_AlterShards(t *testing.T) {
+	collID := UniqueID(1)
+	mt := &MetaTable{
+		ctx: context.TODO(),
+		collID2Meta: map[typeutil.UniqueID]model.Collection{
+			collID: {
+				CollectionID:         collID,
+				ShardsNum:            2,
+				VirtualChannelNames:  []string{"dml_0_1v0", "dml_1_1v1"},
+				PhysicalChannelNames: []string{"dml_0", "dml_1"},
+			},
+		},
+	}
+
+	t.Run("collection not found", func(t *testing.T) {
+		err := mt.AlterShards(collID+1, []string{"dml_2_1v2"}, []string{"dml_2"}, nil, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid channels", func(t *testing.T) {
+		err := mt.AlterShards(collID, []string{"dml_2_1v2"}, nil, nil, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("catalog failed", func(t *testing.T) {
+		mc := &MockedCatalog{}
+		mc.On("AlterCollection").Return(errors.New("alter collection fail"))
+		mt.catalog = mc
+		err := mt.AlterShards(collID, []string{"dml_2_1v2"}, []string{"dml_2"}, nil, 0)
+		assert.Error(t, err)
+		assert.Equal(t, int32(2), mt.collID2Meta[collID].ShardsNum)
+	})
+
+	t.Run("normal", func(t *testing.T) {
+		mc := &MockedCatalog{}
+		mc.On("AlterCollection").Return(nil)
+		mt.catalog = mc
+		err := mt.AlterShards(collID, []string{"dml_2_1v2"}, []string{"dml_2"}, nil, 0)
+		assert.NoError(t, err)
+		coll := mt.collID2Meta[collID]
+		assert.Equal(t, int32(3), coll.ShardsNum)
+		assert.Equal(t, []int32{2, 3}, coll.ShardsNumHistory)
+		assert.Equal(t, []string{"dml_0_1v0", "dml_1_1v1", "dml_2_1v2"}, coll.VirtualChannelNames)
+		assert.Equal(t, []string{"dml_0", "dml_1", "dml_2"}, coll.PhysicalChannelNames)
+
+		err = mt.AlterShards(collID, []string{"dml_3_1v3"}, []string{"dml_3"}, nil, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, []int32{2, 3, 4}, mt.collID2Meta[collID].ShardsNumHistory)
+	})
+}
+
+func TestMetaTable_AlterSchema(t *testing.T) {
+	collID := UniqueID(1)
+	fields := []*model.Field{
+		{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+	}
+	mt := &MetaTable{
+		ctx: context.TODO(),
+		collID2Meta: map[typeutil.UniqueID]model.Collection{
+			collID: {
+				CollectionID: collID,
+				Fields:       fields,
+			},
+		},
+	}
+	newFields := append(append([]*model.Field{}, fields...), &model.Field{FieldID: 101, Name: "added", DataType: schemapb.DataType_Int32, Nullable: true})
+
+	t.Run("collection not found", func(t *testing.T) {
+		err := mt.AlterSchema(collID+1, newFields, 1, 101, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("version mismatch", func(t *testing.T) {
+		err := mt.AlterSchema(collID, newFields, 2, 101, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("catalog failed", func(t *testing.T) {
+		mc := &MockedCatalog{}
+		mc.On("AlterCollection").Return(errors.New("alter collection fail"))
+		mt.catalog = mc
+		err := mt.AlterSchema(collID, newFields, 1, 101, 0)
+		assert.Error(t, err)
+		assert.Equal(t, int32(0), mt.collID2Meta[collID].SchemaVersion)
+		assert.Equal(t, 1, len(mt.collID2Meta[collID].Fields))
+	})
+
+	t.Run("normal", func(t *testing.T) {
+		mc := &MockedCatalog{}
+		mc.On("AlterCollection").Return(nil)
+		mt.catalog = mc
+		err := mt.AlterSchema(collID, newFields, 1, 101, 0)
+		assert.NoError(t, err)
+		coll := mt.collID2Meta[collID]
+		assert.Equal(t, int32(1), coll.SchemaVersion)
+		assert.Equal(t, int64(101), coll.MaxFieldID)
+		assert.Equal(t, newFields, coll.Fields)
+	})
+}
+
 func TestMetaTable_ReloadFromKV(t *testing.T) {
 	mc := &MockedCatalog{}
 
@@ -44,6 +44,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/metrics"
 	ms "github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
@@ -52,6 +53,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util"
@@ -131,10 +133,22 @@ type Core struct {
 	//setMsgStreams, send drop partition into dd channel
 	SendDdDropPartitionReq func(ctx context.Context, req *internalpb.DropPartitionRequest, channelNames []string) error
 
+	//setMsgStreams, send truncate collection or partition into dd channel
+	SendDdTruncateReq func(ctx context.Context, req *internalpb.TruncateRequest, channelNames []string) error
+
+	//setMsgStreams, send alter schema into dd channel
+	SendDdAlterSchemaReq func(ctx context.Context, req *internalpb.AlterSchemaRequest, channelNames []string) error
+
 	//get segment info from data service
 	CallGetFlushedSegmentsService func(ctx context.Context, collID, partID typeutil.UniqueID) ([]typeutil.UniqueID, error)
 	CallGetRecoveryInfoService    func(ctx context.Context, collID, partID UniqueID) ([]*datapb.SegmentBinlogs, error)
 
+	// mark the segments of collection or partition dropped in data service, partID 0 means all the partitions
+	CallTruncateSegmentsService func(ctx context.Context, ts typeutil.Timestamp, collID, partID UniqueID, finished bool) (*datapb.TruncateSegmentsResponse, error)
+
+	// drop all the snapshots of collection in data service, so that their segments are released
+	CallDropSnapshotsService func(ctx context.Context, collID UniqueID) error
+
 	//call index builder's client to build index, return build id or get index state.
 	CallBuildIndexService     func(ctx context.Context, segID UniqueID, binlog []string, field *model.Field, idxInfo *model.Index, numRows int64) (typeutil.UniqueID, error)
 	CallDropIndexService      func(ctx context.Context, indexID typeutil.UniqueID) error
@@ -144,8 +158,10 @@ type Core struct {
 	NewProxyClient func(sess *sessionutil.Session) (types.Proxy, error)
 
 	//query service interface, notify query service to release collection
-	CallReleaseCollectionService func(ctx context.Context, ts typeutil.Timestamp, dbID, collectionID typeutil.UniqueID) error
-	CallReleasePartitionService  func(ctx context.Context, ts typeutil.Timestamp, dbID, collectionID typeutil.UniqueID, partitionIDs []typeutil.UniqueID) error
+	CallReleaseCollectionService     func(ctx context.Context, ts typeutil.Timestamp, dbID, collectionID typeutil.UniqueID) error
+	CallReleasePartitionService      func(ctx context.Context, ts typeutil.Timestamp, dbID, collectionID typeutil.UniqueID, partitionIDs []typeutil.UniqueID) error
+	CallAlterShardsService           func(ctx context.Context, ts typeutil.Timestamp, collectionID typeutil.UniqueID, channelNames []string) error
+	CallTruncateQuerySegmentsService func(ctx context.Context, ts typeutil.Timestamp, collectionID typeutil.UniqueID, segmentIDs []typeutil.UniqueID) error
 
 	// Communicates with queryCoord service for segments info.
 	CallGetSegmentInfoService func(ctx context.Context, collectionID int64, segIDs []int64) (*querypb.GetSegmentInfoResponse, error)
@@ -273,12 +289,24 @@ func (c *Core) checkInit() error {
 	if c.SendDdDropPartitionReq == nil {
 		return fmt.Errorf("sendDdDropPartitionReq is nil")
 	}
+	if c.SendDdTruncateReq == nil {
+		return fmt.Errorf("sendDdTruncateReq is nil")
+	}
+	if c.SendDdAlterSchemaReq == nil {
+		return fmt.Errorf("sendDdAlterSchemaReq is nil")
+	}
 	if c.CallGetFlushedSegmentsService == nil {
 		return fmt.Errorf("callGetFlushedSegmentsService is nil")
 	}
 	if c.CallGetRecoveryInfoService == nil {
 		return fmt.Errorf("CallGetRecoveryInfoService is nil")
 	}
+	if c.CallTruncateSegmentsService == nil {
+		return fmt.Errorf("callTruncateSegmentsService is nil")
+	}
+	if c.CallDropSnapshotsService == nil {
+		return fmt.Errorf("callDropSnapshotsService is nil")
+	}
 	if c.CallBuildIndexService == nil {
 		return fmt.Errorf("callBuildIndexService is nil")
 	}
@@ -300,6 +328,12 @@ func (c *Core) checkInit() error {
 	if c.CallReleasePartitionService == nil {
 		return fmt.Errorf("callReleasePartitionService is nil")
 	}
+	if c.CallAlterShardsService == nil {
+		return fmt.Errorf("callAlterShardsService is nil")
+	}
+	if c.CallTruncateQuerySegmentsService == nil {
+		return fmt.Errorf("callTruncateQuerySegmentsService is nil")
+	}
 	if c.CallImportService == nil {
 		return fmt.Errorf("callImportService is nil")
 	}
@@ -649,6 +683,38 @@ func (c *Core) setMsgStreams() error {
 		return c.chanTimeTick.broadcastDmlChannels(channelNames, &msgPack)
 	}
 
+	c.SendDdTruncateReq = func(ctx context.Context, req *internalpb.TruncateRequest, channelNames []string) error {
+		msgPack := ms.MsgPack{}
+		baseMsg := ms.BaseMsg{
+			Ctx:            ctx,
+			BeginTimestamp: req.Base.Timestamp,
+			EndTimestamp:   req.Base.Timestamp,
+			HashValues:     []uint32{0},
+		}
+		msg := &ms.TruncateMsg{
+			BaseMsg:         baseMsg,
+			TruncateRequest: *req,
+		}
+		msgPack.Msgs = append(msgPack.Msgs, msg)
+		return c.chanTimeTick.broadcastDmlChannels(channelNames, &msgPack)
+	}
+
+	c.SendDdAlterSchemaReq = func(ctx context.Context, req *internalpb.AlterSchemaRequest, channelNames []string) error {
+		msgPack := ms.MsgPack{}
+		baseMsg := ms.BaseMsg{
+			Ctx:            ctx,
+			BeginTimestamp: req.Base.Timestamp,
+			EndTimestamp:   req.Base.Timestamp,
+			HashValues:     []uint32{0},
+		}
+		msg := &ms.AlterSchemaMsg{
+			BaseMsg:            baseMsg,
+			AlterSchemaRequest: *req,
+		}
+		msgPack.Msgs = append(msgPack.Msgs, msg)
+		return c.chanTimeTick.broadcastDmlChannels(channelNames, &msgPack)
+	}
+
 	return nil
 }
 
@@ -725,6 +791,58 @@ func (c *Core) SetDataCoord(ctx context.Context, s types.DataCoord) error {
 		return resp.Binlogs, nil
 	}
 
+	c.CallTruncateSegmentsService = func(ctx context.Context, ts typeutil.Timestamp, collID, partID UniqueID, finished bool) (retResp *datapb.TruncateSegmentsResponse, retErr error) {
+		defer func() {
+			if err := recover(); err != nil {
+				retErr = fmt.Errorf("truncate segments from data coord panic, msg = %v", err)
+			}
+		}()
+		<-initCh
+		req := &datapb.TruncateSegmentsRequest{
+			Base: &commonpb.MsgBase{
+				Timestamp: ts,
+				SourceID:  c.session.ServerID,
+			},
+			CollectionID: collID,
+			PartitionID:  partID,
+			TruncateTs:   ts,
+			Finished:     finished,
+		}
+		rsp, err := s.TruncateSegments(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if rsp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return nil, fmt.Errorf("truncate segments from data coord failed, reason = %s", rsp.Status.Reason)
+		}
+		return rsp, nil
+	}
+
+	c.CallDropSnapshotsService = func(ctx context.Context, collID UniqueID) (retErr error) {
+		defer func() {
+			if err := recover(); err != nil {
+				retErr = fmt.Errorf("drop snapshots from data coord panic, msg = %v", err)
+			}
+		}()
+		<-initCh
+		// an empty name drops all the snapshots of collection
+		req := &datapb.DropSnapshotRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_DropSnapshot,
+				SourceID: c.session.ServerID,
+			},
+			CollectionID: collID,
+		}
+		rsp, err := s.DropSnapshot(ctx, req)
+		if err != nil {
+			return err
+		}
+		if rsp.ErrorCode != commonpb.ErrorCode_Success {
+			return fmt.Errorf("drop snapshots from data coord failed, reason = %s", rsp.Reason)
+		}
+		return nil
+	}
+
 	c.CallWatchChannels = func(ctx context.Context, collectionID int64, channelNames []string) (retErr error) {
 		defer func() {
 			if err := recover(); err != nil {
@@ -1009,6 +1127,55 @@ func (c *Core) SetQueryCoord(s types.QueryCoord) error {
 		}
 		return nil
 	}
+	c.CallAlterShardsService = func(ctx context.Context, ts typeutil.Timestamp, collectionID typeutil.UniqueID, channelNames []string) (retErr error) {
+		defer func() {
+			if err := recover(); err != nil {
+				retErr = fmt.Errorf("alter shards from query service panic, msg = %v", err)
+			}
+		}()
+		<-initCh
+		req := &querypb.AlterShardsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_AlterShards,
+				Timestamp: ts,
+				SourceID:  c.session.ServerID,
+			},
+			CollectionID: collectionID,
+			ChannelNames: channelNames,
+		}
+		rsp, err := s.AlterShards(ctx, req)
+		if err != nil {
+			return err
+		}
+		if rsp.ErrorCode != commonpb.ErrorCode_Success {
+			return fmt.Errorf("alterShards from query service failed, error = %s", rsp.Reason)
+		}
+		return nil
+	}
+	c.CallTruncateQuerySegmentsService = func(ctx context.Context, ts typeutil.Timestamp, collectionID typeutil.UniqueID, segmentIDs []typeutil.UniqueID) (retErr error) {
+		defer func() {
+			if err := recover(); err != nil {
+				retErr = fmt.Errorf("truncate segments from query service panic, msg = %v", err)
+			}
+		}()
+		<-initCh
+		req := &querypb.TruncateSegmentsRequest{
+			Base: &commonpb.MsgBase{
+				Timestamp: ts,
+				SourceID:  c.session.ServerID,
+			},
+			CollectionID: collectionID,
+			SegmentIDs:   segmentIDs,
+		}
+		rsp, err := s.TruncateSegments(ctx, req)
+		if err != nil {
+			return err
+		}
+		if rsp.ErrorCode != commonpb.ErrorCode_Success {
+			return fmt.Errorf("truncateSegments from query service failed, error = %s", rsp.Reason)
+		}
+		return nil
+	}
 	c.CallGetSegmentInfoService = func(ctx context.Context, collectionID int64, segIDs []int64) (retResp *querypb.GetSegmentInfoResponse, retErr error) {
 		defer func() {
 			if err := recover(); err != nil {
@@ -1826,6 +1993,45 @@ func (c *Core) DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequ
 	return succStatus(), nil
 }
 
+// TruncatePartition marks all the segments of the partition dropped, keeping the schema, indexes and load state
+func (c *Core) TruncatePartition(ctx context.Context, in *milvuspb.TruncatePartitionRequest) (*commonpb.Status, error) {
+	metrics.RootCoordDDLReqCounter.WithLabelValues("TruncatePartition", metrics.TotalLabel).Inc()
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+	tr := timerecord.NewTimeRecorder("TruncatePartition")
+	log.Debug("TruncatePartition", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection name", in.CollectionName), zap.String("partition name", in.PartitionName),
+		zap.Int64("msgID", in.Base.MsgID))
+	t := &TruncateReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: &internalpb.TruncateRequest{
+			Base:           in.Base,
+			DbName:         in.DbName,
+			CollectionName: in.CollectionName,
+			PartitionName:  in.PartitionName,
+		},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Error("TruncatePartition failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("collection name", in.CollectionName), zap.String("partition name", in.PartitionName),
+			zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("TruncatePartition", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "TruncatePartition failed: "+err.Error()), nil
+	}
+	log.Debug("TruncatePartition success", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection name", in.CollectionName), zap.String("partition name", in.PartitionName),
+		zap.Int64("msgID", in.Base.MsgID))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("TruncatePartition", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("TruncatePartition").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return succStatus(), nil
+}
+
 // HasPartition check partition existence
 func (c *Core) HasPartition(ctx context.Context, in *milvuspb.HasPartitionRequest) (*milvuspb.BoolResponse, error) {
 	metrics.RootCoordDDLReqCounter.WithLabelValues("HasPartition", metrics.TotalLabel).Inc()
@@ -2499,6 +2705,152 @@ func (c *Core) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest) (
 	return succStatus(), nil
 }
 
+// AlterShards increases the shards number of the collection
+func (c *Core) AlterShards(ctx context.Context, in *milvuspb.AlterShardsRequest) (*commonpb.Status, error) {
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AlterShards", metrics.TotalLabel).Inc()
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+	tr := timerecord.NewTimeRecorder("AlterShards")
+	log.Debug("AlterShards", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection name", in.CollectionName), zap.Int32("shards num", in.ShardsNum),
+		zap.Int64("msgID", in.Base.MsgID))
+	t := &AlterShardsReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Error("AlterShards failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("collection name", in.CollectionName), zap.Int32("shards num", in.ShardsNum),
+			zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AlterShards", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "AlterShards failed: "+err.Error()), nil
+	}
+	log.Debug("AlterShards success", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection name", in.CollectionName), zap.Int32("shards num", in.ShardsNum),
+		zap.Int64("msgID", in.Base.MsgID))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AlterShards", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("AlterShards").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return succStatus(), nil
+}
+
+// TruncateCollection marks all the segments of the collection dropped, keeping the schema, indexes and load state
+func (c *Core) TruncateCollection(ctx context.Context, in *milvuspb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	metrics.RootCoordDDLReqCounter.WithLabelValues("TruncateCollection", metrics.TotalLabel).Inc()
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+	tr := timerecord.NewTimeRecorder("TruncateCollection")
+	log.Debug("TruncateCollection", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection name", in.CollectionName),
+		zap.Int64("msgID", in.Base.MsgID))
+	t := &TruncateReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: &internalpb.TruncateRequest{
+			Base:           in.Base,
+			DbName:         in.DbName,
+			CollectionName: in.CollectionName,
+		},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Error("TruncateCollection failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("collection name", in.CollectionName),
+			zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("TruncateCollection", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "TruncateCollection failed: "+err.Error()), nil
+	}
+	log.Debug("TruncateCollection success", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection name", in.CollectionName),
+		zap.Int64("msgID", in.Base.MsgID))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("TruncateCollection", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("TruncateCollection").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return succStatus(), nil
+}
+
+// AddField adds a nullable or default valued scalar field to the collection
+func (c *Core) AddField(ctx context.Context, in *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AddField", metrics.TotalLabel).Inc()
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+	tr := timerecord.NewTimeRecorder("AddField")
+	log.Debug("AddField", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection name", in.CollectionName),
+		zap.String("field name", in.GetField().GetName()),
+		zap.Int64("msgID", in.Base.MsgID))
+	t := &AddFieldReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Error("AddField failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("collection name", in.CollectionName),
+			zap.String("field name", in.GetField().GetName()),
+			zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AddField", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "AddField failed: "+err.Error()), nil
+	}
+	log.Debug("AddField success", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection name", in.CollectionName),
+		zap.String("field name", in.GetField().GetName()),
+		zap.Int64("msgID", in.Base.MsgID))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AddField", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("AddField").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return succStatus(), nil
+}
+
+// DropField drops a scalar field without index from the collection
+func (c *Core) DropField(ctx context.Context, in *milvuspb.DropFieldRequest) (*commonpb.Status, error) {
+	metrics.RootCoordDDLReqCounter.WithLabelValues("DropField", metrics.TotalLabel).Inc()
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+	tr := timerecord.NewTimeRecorder("DropField")
+	log.Debug("DropField", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection name", in.CollectionName),
+		zap.String("field name", in.FieldName),
+		zap.Int64("msgID", in.Base.MsgID))
+	t := &DropFieldReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Error("DropField failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("collection name", in.CollectionName),
+			zap.String("field name", in.FieldName),
+			zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("DropField", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "DropField failed: "+err.Error()), nil
+	}
+	log.Debug("DropField success", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection name", in.CollectionName),
+		zap.String("field name", in.FieldName),
+		zap.Int64("msgID", in.Base.MsgID))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("DropField", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("DropField").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return succStatus(), nil
+}
+
 // Import imports large files (json, numpy, etc.) on MinIO/S3 storage into Milvus storage.
 func (c *Core) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
 	if code, ok := c.checkHealthy(); !ok {
@@ -3143,6 +3495,17 @@ func (c *Core) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest) (*com
 		logger.Error(errMsg, zap.String("role_name", in.RoleName), zap.Error(err))
 		return failStatus(commonpb.ErrorCode_DropRoleFailure, errMsg), errors.New(errMsg)
 	}
+	rowPolicies, err := c.MetaTable.ListRowPolicies(util.DefaultTenant, in.RoleName, "")
+	if err != nil {
+		errMsg := "fail to list the row policies of the role"
+		logger.Error(errMsg, zap.String("role_name", in.RoleName), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_DropRoleFailure, errMsg), err
+	}
+	if len(rowPolicies) != 0 {
+		errMsg := "fail to drop the role that it has row policies. Use DropRowPolicy API to drop row policies"
+		logger.Error(errMsg, zap.String("role_name", in.RoleName), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_DropRoleFailure, errMsg), errors.New(errMsg)
+	}
 	roleResults, err := c.MetaTable.SelectRole(util.DefaultTenant, &milvuspb.RoleEntity{Name: in.RoleName}, true)
 	if err != nil {
 		errMsg := "fail to select a role by role name"
@@ -3501,6 +3864,12 @@ func (c *Core) ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest)
 			Status: failStatus(commonpb.ErrorCode_ListPolicyFailure, "fail to list user-role"),
 		}, err
 	}
+	rowPolicies, err := c.MetaTable.ListRowPolicies(util.DefaultTenant, "", "")
+	if err != nil {
+		return &internalpb.ListPolicyResponse{
+			Status: failStatus(commonpb.ErrorCode_ListPolicyFailure, "fail to list row policies"),
+		}, err
+	}
 
 	logger.Debug(method + " success")
 	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
@@ -3509,5 +3878,151 @@ func (c *Core) ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest)
 		Status:      succStatus(),
 		PolicyInfos: policies,
 		UserRoles:   userRoles,
+		RowPolicies: rowPolicies,
+	}, nil
+}
+
+// CreateRowPolicy creates a row policy restricting the rows of the collection visible to the role
+// - check the node health
+// - check if the role is valid
+// - check if the collection exists, and resolve the alias to the collection name
+// - check if the expression is valid on the schema of the collection
+// - save the row policy by the metatable api
+// - update the policy cache
+func (c *Core) CreateRowPolicy(ctx context.Context, in *milvuspb.CreateRowPolicyRequest) (*commonpb.Status, error) {
+	method := "CreateRowPolicy"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	logger.Debug(method, zap.Any("in", in))
+
+	if code, ok := c.checkHealthy(); !ok {
+		return errorutil.UnhealthyStatus(code), errorutil.UnhealthyError()
+	}
+	entity := in.GetEntity()
+	if entity == nil {
+		errMsg := "the row policy entity in the request is nil"
+		return failStatus(commonpb.ErrorCode_OperateRowPolicyFailure, errMsg), errors.New(errMsg)
+	}
+	if err := c.isValidRole(entity.Role); err != nil {
+		return failStatus(commonpb.ErrorCode_OperateRowPolicyFailure, err.Error()), err
+	}
+	collMeta, err := c.MetaTable.GetCollectionByName(entity.CollectionName, 0)
+	if err != nil {
+		return failStatus(commonpb.ErrorCode_OperateRowPolicyFailure, err.Error()), err
+	}
+	schema := &schemapb.CollectionSchema{
+		Name:        collMeta.Name,
+		Description: collMeta.Description,
+		AutoID:      collMeta.AutoID,
+		Fields:      model.MarshalFieldModels(collMeta.Fields),
+	}
+	if _, err := planparserv2.CreateRetrievePlan(schema, entity.Expr); err != nil {
+		errMsg := fmt.Sprintf("invalid row policy expression: %s", err.Error())
+		return failStatus(commonpb.ErrorCode_OperateRowPolicyFailure, errMsg), errors.New(errMsg)
+	}
+	entity.CollectionName = collMeta.Name
+	if err := c.MetaTable.CreateRowPolicy(util.DefaultTenant, entity); err != nil {
+		errMsg := "fail to create the row policy"
+		logger.Error(errMsg, zap.Error(err))
+		return failStatus(commonpb.ErrorCode_OperateRowPolicyFailure, errMsg), err
+	}
+
+	if err := c.refreshRowPolicyCache(ctx, typeutil.CacheCreateRowPolicy, entity); err != nil {
+		return failStatus(commonpb.ErrorCode_OperateRowPolicyFailure, err.Error()), err
+	}
+
+	logger.Debug(method + " success")
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return succStatus(), nil
+}
+
+// DropRowPolicy drops the row policy of the role on the collection
+// - check the node health
+// - resolve the alias to the collection name
+// - drop the row policy by the metatable api
+// - update the policy cache
+func (c *Core) DropRowPolicy(ctx context.Context, in *milvuspb.DropRowPolicyRequest) (*commonpb.Status, error) {
+	method := "DropRowPolicy"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	logger.Debug(method, zap.Any("in", in))
+
+	if code, ok := c.checkHealthy(); !ok {
+		return errorutil.UnhealthyStatus(code), errorutil.UnhealthyError()
+	}
+	collectionName := c.resolveRowPolicyCollection(in.GetCollectionName())
+	if err := c.MetaTable.DropRowPolicy(util.DefaultTenant, in.GetRole().GetName(), collectionName, in.GetPolicyName()); err != nil {
+		errMsg := "fail to drop the row policy"
+		logger.Error(errMsg, zap.Error(err))
+		return failStatus(commonpb.ErrorCode_OperateRowPolicyFailure, errMsg), err
+	}
+
+	if err := c.refreshRowPolicyCache(ctx, typeutil.CacheDropRowPolicy, &milvuspb.RowPolicyEntity{
+		Role:           in.GetRole(),
+		CollectionName: collectionName,
+		PolicyName:     in.GetPolicyName(),
+	}); err != nil {
+		return failStatus(commonpb.ErrorCode_OperateRowPolicyFailure, err.Error()), err
+	}
+
+	logger.Debug(method + " success")
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return succStatus(), nil
+}
+
+// resolveRowPolicyCollection returns the name of the collection if the name is an alias,
+// the name is returned as it is if the collection doesn't exist, so that the policies of dropped collections can be dropped.
+func (c *Core) resolveRowPolicyCollection(name string) string {
+	if name == "" {
+		return name
+	}
+	collMeta, err := c.MetaTable.GetCollectionByName(name, 0)
+	if err != nil {
+		return name
+	}
+	return collMeta.Name
+}
+
+func (c *Core) refreshRowPolicyCache(ctx context.Context, opType typeutil.CacheOpType, entity *milvuspb.RowPolicyEntity) error {
+	opKey, err := funcutil.EncodeRowPolicyCache(entity)
+	if err != nil {
+		return err
+	}
+	return c.proxyClientManager.RefreshPolicyInfoCache(ctx, &proxypb.RefreshPolicyInfoCacheRequest{
+		OpType: int32(opType),
+		OpKey:  opKey,
+	})
+}
+
+// ListRowPolicies lists the row policies, filtered by the role and the collection if they are given
+func (c *Core) ListRowPolicies(ctx context.Context, in *milvuspb.ListRowPoliciesRequest) (*milvuspb.ListRowPoliciesResponse, error) {
+	method := "ListRowPolicies"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	logger.Debug(method, zap.Any("in", in))
+
+	if code, ok := c.checkHealthy(); !ok {
+		return &milvuspb.ListRowPoliciesResponse{
+			Status: errorutil.UnhealthyStatus(code),
+		}, errorutil.UnhealthyError()
+	}
+
+	entities, err := c.MetaTable.ListRowPolicies(util.DefaultTenant, in.GetRole().GetName(), c.resolveRowPolicyCollection(in.GetCollectionName()))
+	if err != nil {
+		errMsg := "fail to list the row policies"
+		logger.Error(errMsg, zap.Error(err))
+		return &milvuspb.ListRowPoliciesResponse{
+			Status: failStatus(commonpb.ErrorCode_ListRowPoliciesFailure, errMsg),
+		}, err
+	}
+
+	logger.Debug(method + " success")
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return &milvuspb.ListRowPoliciesResponse{
+		Status:   succStatus(),
+		Entities: entities,
 	}, nil
 }
@@ -314,6 +314,27 @@ func (d *dataMock) ReleaseSegmentLock(context.Context, *datapb.ReleaseSegmentLoc
 	}, nil
 }
 
+func (d *dataMock) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (d *dataMock) TruncateSegments(ctx context.Context, req *datapb.TruncateSegmentsRequest) (*datapb.TruncateSegmentsResponse, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	rsp := &datapb.TruncateSegmentsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+	}
+	rsp.SegmentIDs = append(rsp.SegmentIDs, d.segs...)
+	return rsp, nil
+}
+
 type queryMock struct {
 	types.QueryCoord
 	collID []typeutil.UniqueID
@@ -328,6 +349,20 @@ func (q *queryMock) Start() error {
 	return nil
 }
 
+func (q *queryMock) AlterShards(ctx context.Context, req *querypb.AlterShardsRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (q *queryMock) TruncateSegments(ctx context.Context, req *querypb.TruncateSegmentsRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 func (q *queryMock) ReleaseCollection(ctx context.Context, req *querypb.ReleaseCollectionRequest) (*commonpb.Status, error) {
 	q.mutex.Lock()
 	defer q.mutex.Unlock()
@@ -2467,6 +2502,214 @@ func TestRootCoord_Base(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("truncate", func(t *testing.T) {
+		defer wg.Done()
+		oldMeta, err := core.MetaTable.GetCollectionByName(collName2, 0)
+		assert.NoError(t, err)
+
+		partReq := &milvuspb.TruncatePartitionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_TruncatePartition,
+				MsgID:     3014,
+				Timestamp: 3014,
+				SourceID:  3014,
+			},
+			CollectionName: collName2,
+			PartitionName:  Params.CommonCfg.DefaultPartitionName,
+		}
+		rsp, err := core.TruncatePartition(ctx, partReq)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		partReq.PartitionName = "not-exist"
+		rsp, err = core.TruncatePartition(ctx, partReq)
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		collReq := &milvuspb.TruncateCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_TruncateCollection,
+				MsgID:     3014,
+				Timestamp: 3014,
+				SourceID:  3014,
+			},
+			CollectionName: collName2,
+		}
+		rsp, err = core.TruncateCollection(ctx, collReq)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		// the schema and partitions are kept
+		newMeta, err := core.MetaTable.GetCollectionByName(collName2, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, oldMeta.CollectionID, newMeta.CollectionID)
+		assert.Equal(t, len(oldMeta.Fields), len(newMeta.Fields))
+		assert.Equal(t, len(oldMeta.Partitions), len(newMeta.Partitions))
+
+		// alias is not allowed
+		collReq.CollectionName = aliasName
+		rsp, err = core.TruncateCollection(ctx, collReq)
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("add and drop field", func(t *testing.T) {
+		defer wg.Done()
+		oldMeta, err := core.MetaTable.GetCollectionByName(collName2, 0)
+		assert.NoError(t, err)
+
+		addReq := &milvuspb.AddFieldRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_AddField,
+				MsgID:     3014,
+				Timestamp: 3014,
+				SourceID:  3014,
+			},
+			CollectionName: collName2,
+			Field: &schemapb.FieldSchema{
+				Name:     "added",
+				DataType: schemapb.DataType_Int64,
+				DefaultValue: &schemapb.ValueField{
+					Data: &schemapb.ValueField_LongData{LongData: 10},
+				},
+			},
+		}
+		rsp, err := core.AddField(ctx, addReq)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		newMeta, err := core.MetaTable.GetCollectionByName(collName2, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, oldMeta.SchemaVersion+1, newMeta.SchemaVersion)
+		assert.Equal(t, len(oldMeta.Fields)+1, len(newMeta.Fields))
+		added := newMeta.Fields[len(newMeta.Fields)-1]
+		assert.Equal(t, "added", added.Name)
+		assert.GreaterOrEqual(t, added.FieldID, int64(common.StartOfUserFieldID))
+		assert.Equal(t, int64(10), added.DefaultValue.GetLongData())
+
+		descRsp, err := core.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DescribeCollection},
+			CollectionName: collName2,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, descRsp.Status.ErrorCode)
+		assert.Equal(t, newMeta.SchemaVersion, descRsp.Schema.Version)
+
+		// duplicated field name
+		rsp, err = core.AddField(ctx, addReq)
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		// neither nullable nor default valued
+		addReq.Field = &schemapb.FieldSchema{Name: "added2", DataType: schemapb.DataType_Int64}
+		rsp, err = core.AddField(ctx, addReq)
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		// default value doesn't match the type
+		addReq.Field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "a"}}
+		rsp, err = core.AddField(ctx, addReq)
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		// vector field can't be added
+		addReq.Field = &schemapb.FieldSchema{Name: "added2", DataType: schemapb.DataType_FloatVector, Nullable: true}
+		rsp, err = core.AddField(ctx, addReq)
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		dropReq := &milvuspb.DropFieldRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_DropField,
+				MsgID:     3014,
+				Timestamp: 3014,
+				SourceID:  3014,
+			},
+			CollectionName: collName2,
+			FieldName:      "added",
+		}
+		rsp, err = core.DropField(ctx, dropReq)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		droppedMeta, err := core.MetaTable.GetCollectionByName(collName2, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, newMeta.SchemaVersion+1, droppedMeta.SchemaVersion)
+		assert.Equal(t, len(oldMeta.Fields), len(droppedMeta.Fields))
+		assert.Equal(t, added.FieldID, droppedMeta.MaxFieldID)
+
+		// field doesn't exist
+		rsp, err = core.DropField(ctx, dropReq)
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		// system field can't be dropped
+		dropReq.FieldName = RowIDFieldName
+		rsp, err = core.DropField(ctx, dropReq)
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		// alias is not allowed
+		addReq.CollectionName = aliasName
+		rsp, err = core.AddField(ctx, addReq)
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("alter shards", func(t *testing.T) {
+		defer wg.Done()
+		oldMeta, err := core.MetaTable.GetCollectionByName(collName2, 0)
+		assert.NoError(t, err)
+		oldShardsNum := int32(len(oldMeta.VirtualChannelNames))
+
+		req := &milvuspb.AlterShardsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_AlterShards,
+				MsgID:     3015,
+				Timestamp: 3015,
+				SourceID:  3015,
+			},
+			CollectionName: collName2,
+			ShardsNum:      oldShardsNum + 2,
+		}
+		rsp, err := core.AlterShards(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		newMeta, err := core.MetaTable.GetCollectionByName(collName2, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, oldShardsNum+2, newMeta.ShardsNum)
+		assert.Equal(t, []int32{oldShardsNum, oldShardsNum + 2}, newMeta.ShardsNumHistory)
+		assert.Equal(t, int(oldShardsNum+2), len(newMeta.VirtualChannelNames))
+		assert.Equal(t, int(oldShardsNum+2), len(newMeta.PhysicalChannelNames))
+		assert.Equal(t, oldMeta.VirtualChannelNames, newMeta.VirtualChannelNames[:oldShardsNum])
+
+		// retrying with the same shards number resumes serving the new shards, the meta is unchanged
+		rsp, err = core.AlterShards(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+		resumedMeta, err := core.MetaTable.GetCollectionByName(collName2, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, newMeta.VirtualChannelNames, resumedMeta.VirtualChannelNames)
+		assert.Equal(t, newMeta.ShardsNumHistory, resumedMeta.ShardsNumHistory)
+
+		// shards number can only be increased
+		req.ShardsNum = oldShardsNum + 1
+		rsp, err = core.AlterShards(ctx, req)
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+
+		// alias is not allowed
+		req.CollectionName = aliasName
+		req.ShardsNum = oldShardsNum + 4
+		rsp, err = core.AlterShards(ctx, req)
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("drop collection with alias", func(t *testing.T) {
 		defer wg.Done()
@@ -3017,6 +3260,18 @@ func TestCheckInit(t *testing.T) {
 	err = c.checkInit()
 	assert.Error(t, err)
 
+	c.SendDdTruncateReq = func(context.Context, *internalpb.TruncateRequest, []string) error {
+		return nil
+	}
+	err = c.checkInit()
+	assert.Error(t, err)
+
+	c.SendDdAlterSchemaReq = func(context.Context, *internalpb.AlterSchemaRequest, []string) error {
+		return nil
+	}
+	err = c.checkInit()
+	assert.Error(t, err)
+
 	c.CallGetFlushedSegmentsService = func(ctx context.Context, collID, partID typeutil.UniqueID) ([]typeutil.UniqueID, error) {
 		return nil, nil
 	}
@@ -3031,6 +3286,18 @@ func TestCheckInit(t *testing.T) {
 	err = c.checkInit()
 	assert.Error(t, err)
 
+	c.CallTruncateSegmentsService = func(ctx context.Context, ts typeutil.Timestamp, collID, partID UniqueID, finished bool) (*datapb.TruncateSegmentsResponse, error) {
+		return nil, nil
+	}
+	err = c.checkInit()
+	assert.Error(t, err)
+
+	c.CallDropSnapshotsService = func(ctx context.Context, collID UniqueID) error {
+		return nil
+	}
+	err = c.checkInit()
+	assert.Error(t, err)
+
 	c.CallBuildIndexService = func(ctx context.Context, segID UniqueID, binlog []string, field *model.Field, idxInfo *model.Index, numRows int64) (typeutil.UniqueID, error) {
 		return 0, nil
 	}
@@ -3067,6 +3334,18 @@ func TestCheckInit(t *testing.T) {
 	err = c.checkInit()
 	assert.Error(t, err)
 
+	c.CallAlterShardsService = func(ctx context.Context, ts typeutil.Timestamp, collectionID typeutil.UniqueID, channelNames []string) error {
+		return nil
+	}
+	err = c.checkInit()
+	assert.Error(t, err)
+
+	c.CallTruncateQuerySegmentsService = func(ctx context.Context, ts typeutil.Timestamp, collectionID typeutil.UniqueID, segmentIDs []typeutil.UniqueID) error {
+		return nil
+	}
+	err = c.checkInit()
+	assert.Error(t, err)
+
 	c.CallWatchChannels = func(ctx context.Context, collectionID int64, channelNames []string) error {
 		return nil
 	}
@@ -18,8 +18,10 @@ package rootcoord
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/common"
@@ -29,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
@@ -224,6 +227,7 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 		PhysicalChannelNames: chanNames,
 		ShardsNum:            t.Req.ShardsNum,
 		ConsistencyLevel:     t.Req.ConsistencyLevel,
+		Properties:           t.Req.Properties,
 		FieldIDToIndexID:     make([]common.Int64Tuple, 0, 16),
 		CreateTime:           ts,
 		Partitions: []*model.Partition{
@@ -345,6 +349,12 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 		}
 	}
 
+	// drop all snapshots, otherwise their segments are pinned forever
+	if err = t.core.CallDropSnapshotsService(t.core.ctx, collMeta.CollectionID); err != nil {
+		log.Error("DropCollection CallDropSnapshotsService fail", zap.String("collName", t.Req.CollectionName), zap.Error(err))
+		return err
+	}
+
 	// Allocate a new ts to make sure the channel timetick is consistent.
 	ts, err = t.core.TSOAllocator(1)
 	if err != nil {
@@ -480,6 +490,7 @@ func (t *DescribeCollectionReqTask) Execute(ctx context.Context) error {
 		Description: collInfo.Description,
 		AutoID:      collInfo.AutoID,
 		Fields:      model.MarshalFieldModels(collInfo.Fields),
+		Version:     collInfo.SchemaVersion,
 	}
 	t.Rsp.CollectionID = collInfo.CollectionID
 	t.Rsp.VirtualChannelNames = collInfo.VirtualChannelNames
@@ -488,7 +499,9 @@ func (t *DescribeCollectionReqTask) Execute(ctx context.Context) error {
 		collInfo.ShardsNum = int32(len(collInfo.VirtualChannelNames))
 	}
 	t.Rsp.ShardsNum = collInfo.ShardsNum
+	t.Rsp.ShardsNumHistory = collInfo.ShardsNumHistory
 	t.Rsp.ConsistencyLevel = collInfo.ConsistencyLevel
+	t.Rsp.Properties = collInfo.Properties
 
 	t.Rsp.CreatedTimestamp = collInfo.CreateTime
 	createdPhysicalTime, _ := tsoutil.ParseHybridTs(collInfo.CreateTime)
@@ -1212,3 +1225,519 @@ func (t *AlterAliasReqTask) Execute(ctx context.Context) error {
 
 	return t.core.ExpireMetaCache(ctx, []string{t.Req.Alias}, InvalidCollectionID, ts)
 }
+
+// AlterShardsReqTask alter shards request task
+type AlterShardsReqTask struct {
+	baseReqTask
+	Req *milvuspb.AlterShardsRequest
+}
+
+// Type return msg type
+func (t *AlterShardsReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *AlterShardsReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_AlterShards {
+		return fmt.Errorf("alter shards, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.core.MetaTable.IsAlias(t.Req.CollectionName) {
+		return fmt.Errorf("cannot alter shards of the collection via alias = %s", t.Req.CollectionName)
+	}
+
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	if err != nil {
+		return err
+	}
+	collID := collMeta.CollectionID
+	oldShardsNum := int32(len(collMeta.VirtualChannelNames))
+	if t.Req.ShardsNum == oldShardsNum && isLastAlteredShardsNum(collMeta.ShardsNumHistory, oldShardsNum) {
+		// the new shards were persisted by the last request, but it failed to get them served,
+		// the request is retried against the persisted channels
+		lastShardsNum := collMeta.ShardsNumHistory[len(collMeta.ShardsNumHistory)-2]
+		vchanNames := collMeta.VirtualChannelNames[lastShardsNum:]
+		log.Info("AlterShardsReqTask resume", zap.String("CollectionName", t.Req.CollectionName),
+			zap.Int64("CollectionID", collID),
+			zap.Strings("vchannels", vchanNames))
+		ts, err := t.core.TSOAllocator(1)
+		if err != nil {
+			return fmt.Errorf("tso alloc fail, error = %w", err)
+		}
+		return t.serveShards(ctx, ts, collID, vchanNames)
+	}
+	if t.Req.ShardsNum <= oldShardsNum {
+		return fmt.Errorf("shards number can only be increased, current = %d, request = %d", oldShardsNum, t.Req.ShardsNum)
+	}
+
+	log.Debug("AlterShardsReqTask Execute", zap.String("CollectionName", t.Req.CollectionName),
+		zap.Int64("CollectionID", collID),
+		zap.Int32("OldShardsNum", oldShardsNum),
+		zap.Int32("ShardsNum", t.Req.ShardsNum))
+
+	// new shards are appended after the old ones, the index in the name keeps growing
+	newNum := t.Req.ShardsNum - oldShardsNum
+	vchanNames := make([]string, newNum)
+	chanNames := make([]string, newNum)
+	deltaChanNames := make([]string, newNum)
+	for i := int32(0); i < newNum; i++ {
+		vchanNames[i] = fmt.Sprintf("%s_%dv%d", t.core.chanTimeTick.getDmlChannelName(), collID, oldShardsNum+i)
+		chanNames[i] = funcutil.ToPhysicalChannel(vchanNames[i])
+
+		deltaChanNames[i], err = funcutil.ConvertChannelName(chanNames[i], Params.CommonCfg.RootCoordDml, Params.CommonCfg.RootCoordDelta)
+		if err != nil {
+			return fmt.Errorf("dmlChanName %s can not be converted to deltaChanName, error = %w", chanNames[i], err)
+		}
+	}
+
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("tso alloc fail, error = %w", err)
+	}
+	reason := fmt.Sprintf("alter shards of collection %d", collID)
+
+	schema := &schemapb.CollectionSchema{
+		Name:        collMeta.Name,
+		Description: collMeta.Description,
+		AutoID:      collMeta.AutoID,
+		Fields:      model.MarshalFieldModels(collMeta.Fields),
+	}
+	schemaBytes, err := proto.Marshal(schema)
+	if err != nil {
+		return fmt.Errorf("marshal schema error = %w", err)
+	}
+	// datanode and querynode don't process the create collection msg, it's sent to mark the start positions of the new channels
+	ddCollReq := internalpb.CreateCollectionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_CreateCollection,
+			MsgID:     t.Req.GetBase().GetMsgID(),
+			Timestamp: ts,
+			SourceID:  t.core.session.ServerID,
+		},
+		DbName:               t.Req.DbName,
+		CollectionName:       t.Req.CollectionName,
+		CollectionID:         collID,
+		Schema:               schemaBytes,
+		VirtualChannelNames:  vchanNames,
+		PhysicalChannelNames: chanNames,
+	}
+
+	alterShardsFn := func() error {
+		// lock for ddl operation
+		t.core.ddlLock.Lock()
+		defer t.core.ddlLock.Unlock()
+
+		t.core.chanTimeTick.addDdlTimeTick(ts, reason)
+		// clear ddl timetick in all conditions
+		defer t.core.chanTimeTick.removeDdlTimeTick(ts, reason)
+
+		t.core.chanTimeTick.addDmlChannels(chanNames...)
+		t.core.chanTimeTick.addDeltaChannels(deltaChanNames...)
+
+		ids, err := t.core.SendDdCreateCollectionReq(ctx, &ddCollReq, chanNames)
+		if err != nil {
+			t.core.chanTimeTick.removeDmlChannels(chanNames...)
+			t.core.chanTimeTick.removeDeltaChannels(deltaChanNames...)
+			return fmt.Errorf("send dd create collection req failed, error = %w", err)
+		}
+
+		// physical channels shared with the old shards keep their original start positions
+		existed := make(map[string]struct{})
+		for _, pos := range collMeta.StartPositions {
+			existed[pos.GetKey()] = struct{}{}
+		}
+		var startPositions []*commonpb.KeyDataPair
+		for _, pchan := range chanNames {
+			if _, ok := existed[pchan]; ok {
+				continue
+			}
+			existed[pchan] = struct{}{}
+			startPositions = append(startPositions, &commonpb.KeyDataPair{
+				Key:  pchan,
+				Data: ids[pchan],
+			})
+		}
+
+		if err = t.core.MetaTable.AlterShards(collID, vchanNames, chanNames, startPositions, ts); err != nil {
+			t.core.chanTimeTick.removeDmlChannels(chanNames...)
+			t.core.chanTimeTick.removeDeltaChannels(deltaChanNames...)
+			return fmt.Errorf("meta table alter shards failed, error = %w", err)
+		}
+
+		// use addDdlTimeTick and removeDdlTimeTick to mark DDL operation in process
+		t.core.chanTimeTick.removeDdlTimeTick(ts, reason)
+		errTimeTick := t.core.SendTimeTick(ts, reason)
+		if errTimeTick != nil {
+			log.Warn("Failed to send timetick", zap.Error(errTimeTick))
+		}
+		return nil
+	}
+
+	if err = alterShardsFn(); err != nil {
+		return err
+	}
+
+	if err = t.serveShards(ctx, ts, collID, vchanNames); err != nil {
+		return fmt.Errorf("new shards are persisted but not served, retry with the same shards number to resume, error = %w", err)
+	}
+	return nil
+}
+
+// isLastAlteredShardsNum returns true if the shards number is the result of the last shards alteration
+func isLastAlteredShardsNum(history []int32, shardsNum int32) bool {
+	return len(history) >= 2 && history[len(history)-1] == shardsNum
+}
+
+// serveShards gets the new persisted shards consumed, every step is idempotent so it could be retried
+func (t *AlterShardsReqTask) serveShards(ctx context.Context, ts typeutil.Timestamp, collID typeutil.UniqueID, vchanNames []string) error {
+	// datanodes start consuming the new channels, the channels watched already are skipped
+	if err := t.core.CallWatchChannels(ctx, collID, vchanNames); err != nil {
+		return err
+	}
+
+	// querynodes serving the loaded collection start consuming the new channels, the channels watched already are skipped
+	if err := t.core.CallAlterShardsService(ctx, ts, collID, vchanNames); err != nil {
+		return err
+	}
+
+	// proxies recreate their dml streams at last, so new inserts are hashed over all the shards
+	// only after the new channels are consumed
+	return t.core.proxyClientManager.InvalidateCollectionMetaCache(ctx, &proxypb.InvalidateCollMetaCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_AlterShards,
+			Timestamp: ts,
+			SourceID:  t.core.session.ServerID,
+		},
+		DbName:         t.Req.DbName,
+		CollectionName: t.Req.CollectionName,
+		CollectionID:   collID,
+	})
+}
+
+// TruncateReqTask truncate collection or partition request task
+type TruncateReqTask struct {
+	baseReqTask
+	Req *internalpb.TruncateRequest
+}
+
+// Type return msg type
+func (t *TruncateReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *TruncateReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_TruncateCollection && t.Type() != commonpb.MsgType_TruncatePartition {
+		return fmt.Errorf("truncate, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.core.MetaTable.IsAlias(t.Req.CollectionName) {
+		return fmt.Errorf("cannot truncate the collection via alias = %s", t.Req.CollectionName)
+	}
+	collInfo, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	if err != nil {
+		return err
+	}
+	collID := collInfo.CollectionID
+	var partID typeutil.UniqueID
+	if t.Type() == commonpb.MsgType_TruncatePartition {
+		partID, err = t.core.MetaTable.GetPartitionByName(collID, t.Req.PartitionName, 0)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the segments allocated until the truncate timestamp may still receive inserts,
+	// wait for the allocations expired and retry with a later timestamp.
+	// DataCoord fixes the segments to truncate by the first call and seals them, so the retries wait for
+	// the same segments, which receive no more allocations, while the new ones for the later inserts are kept
+	var segmentIDs []typeutil.UniqueID
+	for {
+		truncateTs, err := t.core.TSOAllocator(1)
+		if err != nil {
+			return fmt.Errorf("TSO alloc fail, error = %w", err)
+		}
+		rsp, err := t.core.CallTruncateSegmentsService(ctx, truncateTs, collID, partID, false)
+		if err != nil {
+			return err
+		}
+		if rsp.GetAllocatedUntil() == 0 {
+			segmentIDs = rsp.GetSegmentIDs()
+			break
+		}
+		allocatedUntil, _ := tsoutil.ParseTS(rsp.GetAllocatedUntil())
+		log.Debug("TruncateReqTask wait for the segment allocations expired", zap.Int64("CollectionID", collID),
+			zap.Int64("PartitionID", partID), zap.Time("AllocatedUntil", allocatedUntil))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Until(allocatedUntil) + time.Millisecond):
+		}
+	}
+	log.Debug("TruncateReqTask Execute", zap.String("CollectionName", t.Req.CollectionName),
+		zap.Int64("CollectionID", collID), zap.Int64("PartitionID", partID),
+		zap.Int64s("SegmentIDs", segmentIDs))
+
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	reason := fmt.Sprintf("truncate collection %d", collID)
+	ddReq := internalpb.TruncateRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   t.Type(),
+			MsgID:     t.Req.GetBase().GetMsgID(),
+			Timestamp: ts,
+			SourceID:  t.core.session.ServerID,
+		},
+		DbName:         t.Req.DbName,
+		CollectionName: t.Req.CollectionName,
+		PartitionName:  t.Req.PartitionName,
+		CollectionID:   collID,
+		PartitionID:    partID,
+		SegmentIDs:     segmentIDs,
+	}
+
+	// use lambda function here to guarantee all resources to be released
+	truncateFn := func() error {
+		// lock for ddl operation
+		t.core.ddlLock.Lock()
+		defer t.core.ddlLock.Unlock()
+
+		t.core.chanTimeTick.addDdlTimeTick(ts, reason)
+		// clear ddl timetick in all conditions
+		defer t.core.chanTimeTick.removeDdlTimeTick(ts, reason)
+
+		// datanodes and querynodes reset the growing segments when consuming the msg
+		if err = t.core.SendDdTruncateReq(ctx, &ddReq, collInfo.PhysicalChannelNames); err != nil {
+			return err
+		}
+
+		// use addDdlTimeTick and removeDdlTimeTick to mark DDL operation in process
+		t.core.chanTimeTick.removeDdlTimeTick(ts, reason)
+		errTimeTick := t.core.SendTimeTick(ts, reason)
+		if errTimeTick != nil {
+			log.Warn("Failed to send timetick", zap.Error(errTimeTick))
+		}
+		return nil
+	}
+
+	if err = truncateFn(); err != nil {
+		return err
+	}
+
+	// querynodes release the sealed segments truncated
+	if err = t.core.CallTruncateQuerySegmentsService(ctx, ts, collID, segmentIDs); err != nil {
+		return err
+	}
+	// the truncation is resumed with the same segments by the next truncate if it fails before
+	_, err = t.core.CallTruncateSegmentsService(ctx, ts, collID, partID, true)
+	return err
+}
+
+// AddFieldReqTask add field request task
+type AddFieldReqTask struct {
+	baseReqTask
+	Req *milvuspb.AddFieldRequest
+}
+
+// Type return msg type
+func (t *AddFieldReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *AddFieldReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_AddField {
+		return fmt.Errorf("add field, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.core.MetaTable.IsAlias(t.Req.CollectionName) {
+		return fmt.Errorf("cannot add field to the collection via alias = %s", t.Req.CollectionName)
+	}
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	if err != nil {
+		return err
+	}
+	if err = checkAddedField(collMeta, t.Req.Field); err != nil {
+		return err
+	}
+
+	// field ids of the dropped fields are never reused, the binlogs of them may still exist
+	maxFieldID := collMeta.MaxFieldID
+	for _, field := range collMeta.Fields {
+		if field.FieldID > maxFieldID {
+			maxFieldID = field.FieldID
+		}
+	}
+	newField := model.UnmarshalFieldModel(t.Req.Field)
+	newField.FieldID = maxFieldID + 1
+
+	fields := make([]*model.Field, 0, len(collMeta.Fields)+1)
+	fields = append(fields, collMeta.Fields...)
+	fields = append(fields, newField)
+
+	log.Debug("AddFieldReqTask Execute", zap.String("CollectionName", t.Req.CollectionName),
+		zap.Int64("CollectionID", collMeta.CollectionID), zap.String("FieldName", newField.Name),
+		zap.Int64("FieldID", newField.FieldID), zap.Int32("SchemaVersion", collMeta.SchemaVersion+1))
+	return t.core.alterSchema(ctx, t.Req.GetBase(), t.Req.DbName, collMeta, newField.Name, fields, newField.FieldID)
+}
+
+// DropFieldReqTask drop field request task
+type DropFieldReqTask struct {
+	baseReqTask
+	Req *milvuspb.DropFieldRequest
+}
+
+// Type return msg type
+func (t *DropFieldReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *DropFieldReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_DropField {
+		return fmt.Errorf("drop field, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.core.MetaTable.IsAlias(t.Req.CollectionName) {
+		return fmt.Errorf("cannot drop field of the collection via alias = %s", t.Req.CollectionName)
+	}
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	if err != nil {
+		return err
+	}
+
+	var dropped *model.Field
+	fields := make([]*model.Field, 0, len(collMeta.Fields))
+	for _, field := range collMeta.Fields {
+		if field.Name == t.Req.FieldName {
+			dropped = field
+			continue
+		}
+		fields = append(fields, field)
+	}
+	if dropped == nil {
+		return fmt.Errorf("field %s does not exist in collection %s", t.Req.FieldName, t.Req.CollectionName)
+	}
+	if dropped.FieldID < common.StartOfUserFieldID {
+		return fmt.Errorf("cannot drop system field %s", dropped.Name)
+	}
+	if dropped.IsPrimaryKey {
+		return fmt.Errorf("cannot drop primary key field %s", dropped.Name)
+	}
+	if dropped.IsClusteringKey {
+		return fmt.Errorf("cannot drop clustering key field %s", dropped.Name)
+	}
+	if typeutil.IsVectorType(dropped.DataType) {
+		return fmt.Errorf("cannot drop vector field %s", dropped.Name)
+	}
+	for _, tuple := range collMeta.FieldIDToIndexID {
+		if tuple.Key == dropped.FieldID {
+			return fmt.Errorf("field %s has index %d, drop the index first", dropped.Name, tuple.Value)
+		}
+	}
+
+	maxFieldID := collMeta.MaxFieldID
+	if dropped.FieldID > maxFieldID {
+		maxFieldID = dropped.FieldID
+	}
+
+	log.Debug("DropFieldReqTask Execute", zap.String("CollectionName", t.Req.CollectionName),
+		zap.Int64("CollectionID", collMeta.CollectionID), zap.String("FieldName", dropped.Name),
+		zap.Int64("FieldID", dropped.FieldID), zap.Int32("SchemaVersion", collMeta.SchemaVersion+1))
+	return t.core.alterSchema(ctx, t.Req.GetBase(), t.Req.DbName, collMeta, dropped.Name, fields, maxFieldID)
+}
+
+// alterSchema broadcasts the new schema to the dml channels of the collection and bumps the schema version,
+// datanodes and querynodes switch to the new schema when consuming the msg
+func (c *Core) alterSchema(ctx context.Context, base *commonpb.MsgBase, dbName string, collMeta *model.Collection,
+	fieldName string, fields []*model.Field, maxFieldID int64) error {
+	ts, err := c.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	collID := collMeta.CollectionID
+	schemaVersion := collMeta.SchemaVersion + 1
+	reason := fmt.Sprintf("alter schema of collection %d", collID)
+
+	ddReq := internalpb.AlterSchemaRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   base.GetMsgType(),
+			MsgID:     base.GetMsgID(),
+			Timestamp: ts,
+			SourceID:  c.session.ServerID,
+		},
+		DbName:         dbName,
+		CollectionName: collMeta.Name,
+		CollectionID:   collID,
+		FieldName:      fieldName,
+		Schema: &schemapb.CollectionSchema{
+			Name:        collMeta.Name,
+			Description: collMeta.Description,
+			AutoID:      collMeta.AutoID,
+			Fields:      model.MarshalFieldModels(fields),
+			Version:     schemaVersion,
+		},
+	}
+
+	// use lambda function here to guarantee all resources to be released
+	alterSchemaFn := func() error {
+		// lock for ddl operation
+		c.ddlLock.Lock()
+		defer c.ddlLock.Unlock()
+
+		c.chanTimeTick.addDdlTimeTick(ts, reason)
+		// clear ddl timetick in all conditions
+		defer c.chanTimeTick.removeDdlTimeTick(ts, reason)
+
+		if err := c.SendDdAlterSchemaReq(ctx, &ddReq, collMeta.PhysicalChannelNames); err != nil {
+			return err
+		}
+
+		if err := c.MetaTable.AlterSchema(collID, fields, schemaVersion, maxFieldID, ts); err != nil {
+			return fmt.Errorf("meta table alter schema failed, error = %w", err)
+		}
+
+		// use addDdlTimeTick and removeDdlTimeTick to mark DDL operation in process
+		c.chanTimeTick.removeDdlTimeTick(ts, reason)
+		errTimeTick := c.SendTimeTick(ts, reason)
+		if errTimeTick != nil {
+			log.Warn("Failed to send timetick", zap.Error(errTimeTick))
+		}
+		return nil
+	}
+
+	if err = alterSchemaFn(); err != nil {
+		return err
+	}
+
+	// proxies fetch the new schema when the collection is accessed next time
+	return c.ExpireMetaCache(ctx, nil, collID, ts)
+}
+
+// checkAddedField checks the field can be added to the collection, the rows inserted before
+// get no value of the field, so it must be nullable or have a default value
+func checkAddedField(collMeta *model.Collection, field *schemapb.FieldSchema) error {
+	if field == nil {
+		return errors.New("field schema is empty")
+	}
+	if field.Name == "" {
+		return errors.New("field name is empty")
+	}
+	for _, f := range collMeta.Fields {
+		if f.Name == field.Name {
+			return fmt.Errorf("field %s already exists in collection %s", field.Name, collMeta.Name)
+		}
+	}
+	if field.IsPrimaryKey || field.AutoID {
+		return fmt.Errorf("cannot add primary key or auto id field %s", field.Name)
+	}
+	if field.IsClusteringKey {
+		return fmt.Errorf("cannot add clustering key field %s", field.Name)
+	}
+	if !typeutil.IsNullableType(field.DataType) {
+		return fmt.Errorf("cannot add field %s of type %s", field.Name, field.DataType.String())
+	}
+	if !field.Nullable && field.DefaultValue == nil {
+		return fmt.Errorf("added field %s must be nullable or have a default value", field.Name)
+	}
+	return typeutil.ValidateDefaultValue(field)
+}
@@ -30,6 +30,8 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -56,6 +58,26 @@ func EqualKeyPairArray(p1 []*commonpb.KeyValuePair, p2 []*commonpb.KeyValuePair)
 	return true
 }
 
+// GetScalarIndexType returns the scalar index type specified in the index params, empty if there is none.
+func GetScalarIndexType(params []*commonpb.KeyValuePair) string {
+	for _, kv := range params {
+		indexType := kv.GetValue()
+		if kv.GetKey() == "params" {
+			m, err := funcutil.ParseIndexParamsMap(kv.GetValue())
+			if err != nil {
+				continue
+			}
+			indexType = m["index_type"]
+		} else if kv.GetKey() != "index_type" {
+			continue
+		}
+		if indexparamcheck.IsScalarIndexType(indexType) {
+			return indexType
+		}
+	}
+	return ""
+}
+
 // GetFieldSchemaByID return field schema by id
 func GetFieldSchemaByID(coll *model.Collection, fieldID typeutil.UniqueID) (*model.Field, error) {
 	for _, f := range coll.Fields {
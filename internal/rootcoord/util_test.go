@@ -95,6 +95,14 @@ func Test_GetFieldSchemaByIndexID(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func Test_GetScalarIndexType(t *testing.T) {
+	assert.Equal(t, "", GetScalarIndexType(nil))
+	assert.Equal(t, "", GetScalarIndexType([]*commonpb.KeyValuePair{{Key: "index_type", Value: "IVF_FLAT"}}))
+	assert.Equal(t, "BITMAP", GetScalarIndexType([]*commonpb.KeyValuePair{{Key: "index_type", Value: "BITMAP"}}))
+	assert.Equal(t, "INVERTED", GetScalarIndexType([]*commonpb.KeyValuePair{{Key: "params", Value: `{"index_type": "INVERTED"}`}}))
+	assert.Equal(t, "", GetScalarIndexType([]*commonpb.KeyValuePair{{Key: "params", Value: "invalid"}}))
+}
+
 func Test_EncodeMsgPositions(t *testing.T) {
 	mp := &msgstream.MsgPosition{
 		ChannelName: "test",
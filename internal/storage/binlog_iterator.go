@@ -90,6 +90,11 @@ func (itr *InsertBinlogIterator) Next() (interface{}, error) {
 
 	m := make(map[FieldID]interface{})
 	for fieldID, fieldData := range itr.data.Data {
+		// the value of null is nil
+		if !IsRowValid(fieldData, itr.pos) {
+			m[fieldID] = nil
+			continue
+		}
 		m[fieldID] = fieldData.GetRow(itr.pos)
 	}
 	pk, err := GenPrimaryKeyByRawData(itr.data.Data[itr.PKfieldID].GetRow(itr.pos), itr.PkType)
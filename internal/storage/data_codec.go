@@ -112,37 +112,47 @@ type FieldData interface {
 	GetRow(i int) interface{}
 }
 
+// ValidData of scalar field data holds the validity of each row of a nullable field,
+// it is empty if all rows are valid. The rows of null keep a placeholder value in Data.
 type BoolFieldData struct {
-	NumRows []int64
-	Data    []bool
+	NumRows   []int64
+	Data      []bool
+	ValidData []bool
 }
 type Int8FieldData struct {
-	NumRows []int64
-	Data    []int8
+	NumRows   []int64
+	Data      []int8
+	ValidData []bool
 }
 type Int16FieldData struct {
-	NumRows []int64
-	Data    []int16
+	NumRows   []int64
+	Data      []int16
+	ValidData []bool
 }
 type Int32FieldData struct {
-	NumRows []int64
-	Data    []int32
+	NumRows   []int64
+	Data      []int32
+	ValidData []bool
 }
 type Int64FieldData struct {
-	NumRows []int64
-	Data    []int64
+	NumRows   []int64
+	Data      []int64
+	ValidData []bool
 }
 type FloatFieldData struct {
-	NumRows []int64
-	Data    []float32
+	NumRows   []int64
+	Data      []float32
+	ValidData []bool
 }
 type DoubleFieldData struct {
-	NumRows []int64
-	Data    []float64
+	NumRows   []int64
+	Data      []float64
+	ValidData []bool
 }
 type StringFieldData struct {
-	NumRows []int64
-	Data    []string
+	NumRows   []int64
+	Data      []string
+	ValidData []bool
 }
 type BinaryVectorFieldData struct {
 	NumRows []int64
@@ -155,6 +165,28 @@ type FloatVectorFieldData struct {
 	Dim     int
 }
 
+// Float16VectorFieldData stores float16 vectors, 2 bytes per dimension
+type Float16VectorFieldData struct {
+	NumRows []int64
+	Data    []byte
+	Dim     int
+}
+
+// BFloat16VectorFieldData stores bfloat16 vectors, 2 bytes per dimension
+type BFloat16VectorFieldData struct {
+	NumRows []int64
+	Data    []byte
+	Dim     int
+}
+
+// SparseFloatVectorFieldData stores sparse float vector rows, each row is encoded as
+// (uint32 index, float32 value) pairs, Dim is the maximum dimension among all rows
+type SparseFloatVectorFieldData struct {
+	NumRows  []int64
+	Contents [][]byte
+	Dim      int64
+}
+
 // RowNum implements FieldData.RowNum
 func (data *BoolFieldData) RowNum() int         { return len(data.Data) }
 func (data *Int8FieldData) RowNum() int         { return len(data.Data) }
@@ -166,6 +198,15 @@ func (data *DoubleFieldData) RowNum() int       { return len(data.Data) }
 func (data *StringFieldData) RowNum() int       { return len(data.Data) }
 func (data *BinaryVectorFieldData) RowNum() int { return len(data.Data) * 8 / data.Dim }
 func (data *FloatVectorFieldData) RowNum() int  { return len(data.Data) / data.Dim }
+func (data *Float16VectorFieldData) RowNum() int {
+	return len(data.Data) / 2 / data.Dim
+}
+func (data *BFloat16VectorFieldData) RowNum() int {
+	return len(data.Data) / 2 / data.Dim
+}
+func (data *SparseFloatVectorFieldData) RowNum() int {
+	return len(data.Contents)
+}
 
 // GetRow implements FieldData.GetRow
 func (data *BoolFieldData) GetRow(i int) interface{}   { return data.Data[i] }
@@ -182,6 +223,87 @@ func (data *BinaryVectorFieldData) GetRow(i int) interface{} {
 func (data *FloatVectorFieldData) GetRow(i int) interface{} {
 	return data.Data[i*data.Dim : (i+1)*data.Dim]
 }
+func (data *Float16VectorFieldData) GetRow(i int) interface{} {
+	return data.Data[i*data.Dim*2 : (i+1)*data.Dim*2]
+}
+func (data *BFloat16VectorFieldData) GetRow(i int) interface{} {
+	return data.Data[i*data.Dim*2 : (i+1)*data.Dim*2]
+}
+func (data *SparseFloatVectorFieldData) GetRow(i int) interface{} {
+	return data.Contents[i]
+}
+
+// AppendRow appends a sparse float vector row and updates the dimension
+func (data *SparseFloatVectorFieldData) AppendRow(row []byte) {
+	data.Contents = append(data.Contents, row)
+	if rowDim := typeutil.SparseFloatRowDim(row); rowDim > data.Dim {
+		data.Dim = rowDim
+	}
+}
+
+// validDataOf returns the valid data of scalar field data, nil for the field data of vectors
+func validDataOf(data FieldData) *[]bool {
+	switch data := data.(type) {
+	case *BoolFieldData:
+		return &data.ValidData
+	case *Int8FieldData:
+		return &data.ValidData
+	case *Int16FieldData:
+		return &data.ValidData
+	case *Int32FieldData:
+		return &data.ValidData
+	case *Int64FieldData:
+		return &data.ValidData
+	case *FloatFieldData:
+		return &data.ValidData
+	case *DoubleFieldData:
+		return &data.ValidData
+	case *StringFieldData:
+		return &data.ValidData
+	default:
+		return nil
+	}
+}
+
+// GetValidData returns the validity of rows of field data, nil means all rows are valid
+func GetValidData(data FieldData) []bool {
+	if validData := validDataOf(data); validData != nil {
+		return *validData
+	}
+	return nil
+}
+
+// IsRowValid tells whether the i-th row of field data is not null
+func IsRowValid(data FieldData, i int) bool {
+	validData := GetValidData(data)
+	return len(validData) == 0 || validData[i]
+}
+
+// SetValidData replaces the validity of rows of scalar field data, it does nothing for the field data of vectors
+func SetValidData(data FieldData, validData []bool) {
+	if ref := validDataOf(data); ref != nil {
+		*ref = validData
+	}
+}
+
+// appendValidData appends the validity of the last numRows rows of field data, an empty validData
+// means the rows are all valid. The rows before are marked as valid if their validity is missing.
+func appendValidData(data FieldData, validData []bool, numRows int) {
+	ref := validDataOf(data)
+	if ref == nil || (len(validData) == 0 && len(*ref) == 0) {
+		return
+	}
+	for before := data.RowNum() - numRows; len(*ref) < before; {
+		*ref = append(*ref, true)
+	}
+	if len(validData) == 0 {
+		for i := 0; i < numRows; i++ {
+			*ref = append(*ref, true)
+		}
+		return
+	}
+	*ref = append(*ref, validData...)
+}
 
 // why not binary.Size(data) directly? binary.Size(data) return -1
 // binary.Size returns how many bytes Write would generate to encode the value v, which
@@ -190,39 +312,39 @@ func (data *FloatVectorFieldData) GetRow(i int) interface{} {
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *BoolFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *Int8FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *Int16FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *Int32FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *Int64FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *FloatFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *DoubleFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *StringFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *BinaryVectorFieldData) GetMemorySize() int {
@@ -233,6 +355,22 @@ func (data *FloatVectorFieldData) GetMemorySize() int {
 	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.Dim)
 }
 
+func (data *Float16VectorFieldData) GetMemorySize() int {
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.Dim)
+}
+
+func (data *BFloat16VectorFieldData) GetMemorySize() int {
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.Dim)
+}
+
+func (data *SparseFloatVectorFieldData) GetMemorySize() int {
+	size := binary.Size(data.NumRows) + binary.Size(data.Dim)
+	for _, row := range data.Contents {
+		size += len(row)
+	}
+	return size
+}
+
 // system filed id:
 // 0: unique row id
 // 1: timestamp
@@ -387,12 +525,46 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 				return nil, nil, err
 			}
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*FloatVectorFieldData).GetMemorySize()))
+		case schemapb.DataType_Float16Vector:
+			err = eventWriter.AddFloat16VectorToPayload(singleData.(*Float16VectorFieldData).Data, singleData.(*Float16VectorFieldData).Dim)
+			if err != nil {
+				eventWriter.Close()
+				writer.Close()
+				return nil, nil, err
+			}
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Float16VectorFieldData).GetMemorySize()))
+		case schemapb.DataType_BFloat16Vector:
+			err = eventWriter.AddBFloat16VectorToPayload(singleData.(*BFloat16VectorFieldData).Data, singleData.(*BFloat16VectorFieldData).Dim)
+			if err != nil {
+				eventWriter.Close()
+				writer.Close()
+				return nil, nil, err
+			}
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*BFloat16VectorFieldData).GetMemorySize()))
+		case schemapb.DataType_SparseFloatVector:
+			for _, row := range singleData.(*SparseFloatVectorFieldData).Contents {
+				err = eventWriter.AddOneSparseFloatVectorToPayload(row)
+				if err != nil {
+					eventWriter.Close()
+					writer.Close()
+					return nil, nil, err
+				}
+			}
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*SparseFloatVectorFieldData).GetMemorySize()))
 		default:
 			return nil, nil, fmt.Errorf("undefined data type %d", field.DataType)
 		}
 		if err != nil {
 			return nil, nil, err
 		}
+		if validData := GetValidData(singleData); len(validData) > 0 {
+			err = eventWriter.AddValidDataToPayload(validData)
+			if err != nil {
+				eventWriter.Close()
+				writer.Close()
+				return nil, nil, err
+			}
+		}
 		writer.SetEventTimeStamp(typeutil.Timestamp(startTs), typeutil.Timestamp(endTs))
 
 		err = writer.Finish()
@@ -486,6 +658,7 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 			if eventReader == nil {
 				break
 			}
+			eventOffset := totalLength
 			switch dataType {
 			case schemapb.DataType_Bool:
 				singleData, err := eventReader.GetBoolFromPayload()
@@ -713,11 +886,99 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 				floatVectorFieldData.Dim = dim
 				insertData.Data[fieldID] = floatVectorFieldData
 
+			case schemapb.DataType_Float16Vector:
+				var singleData []byte
+				singleData, dim, err = eventReader.GetFloat16VectorFromPayload()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+
+				if insertData.Data[fieldID] == nil {
+					insertData.Data[fieldID] = &Float16VectorFieldData{
+						NumRows: make([]int64, 0),
+						Data:    make([]byte, 0, rowNum*dim*2),
+					}
+				}
+				float16VectorFieldData := insertData.Data[fieldID].(*Float16VectorFieldData)
+
+				float16VectorFieldData.Data = append(float16VectorFieldData.Data, singleData...)
+				length, err := eventReader.GetPayloadLengthFromReader()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+				totalLength += length
+				float16VectorFieldData.NumRows = append(float16VectorFieldData.NumRows, int64(length))
+				float16VectorFieldData.Dim = dim
+				insertData.Data[fieldID] = float16VectorFieldData
+
+			case schemapb.DataType_BFloat16Vector:
+				var singleData []byte
+				singleData, dim, err = eventReader.GetBFloat16VectorFromPayload()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+
+				if insertData.Data[fieldID] == nil {
+					insertData.Data[fieldID] = &BFloat16VectorFieldData{
+						NumRows: make([]int64, 0),
+						Data:    make([]byte, 0, rowNum*dim*2),
+					}
+				}
+				bfloat16VectorFieldData := insertData.Data[fieldID].(*BFloat16VectorFieldData)
+
+				bfloat16VectorFieldData.Data = append(bfloat16VectorFieldData.Data, singleData...)
+				length, err := eventReader.GetPayloadLengthFromReader()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+				totalLength += length
+				bfloat16VectorFieldData.NumRows = append(bfloat16VectorFieldData.NumRows, int64(length))
+				bfloat16VectorFieldData.Dim = dim
+				insertData.Data[fieldID] = bfloat16VectorFieldData
+
+			case schemapb.DataType_SparseFloatVector:
+				sparsePayload, _, err := eventReader.GetSparseFloatVectorFromPayload()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+
+				if insertData.Data[fieldID] == nil {
+					insertData.Data[fieldID] = &SparseFloatVectorFieldData{
+						NumRows:  make([]int64, 0),
+						Contents: make([][]byte, 0, rowNum),
+					}
+				}
+				sparseFieldData := insertData.Data[fieldID].(*SparseFloatVectorFieldData)
+
+				for _, row := range sparsePayload {
+					sparseFieldData.AppendRow(row)
+				}
+				totalLength += len(sparsePayload)
+				sparseFieldData.NumRows = append(sparseFieldData.NumRows, int64(len(sparsePayload)))
+				insertData.Data[fieldID] = sparseFieldData
+
 			default:
 				eventReader.Close()
 				binlogReader.Close()
 				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, fmt.Errorf("undefined data type %d", dataType)
 			}
+			validData, err := eventReader.GetValidDataFromPayload()
+			if err != nil {
+				eventReader.Close()
+				binlogReader.Close()
+				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+			}
+			appendValidData(insertData.Data[fieldID], validData, totalLength-eventOffset)
 			eventReader.Close()
 		}
 
@@ -260,16 +260,16 @@ func TestInsertCodec(t *testing.T) {
 
 	insertDataEmpty := &InsertData{
 		Data: map[int64]FieldData{
-			RowIDField:        &Int64FieldData{[]int64{}, []int64{}},
-			TimestampField:    &Int64FieldData{[]int64{}, []int64{}},
-			BoolField:         &BoolFieldData{[]int64{}, []bool{}},
-			Int8Field:         &Int8FieldData{[]int64{}, []int8{}},
-			Int16Field:        &Int16FieldData{[]int64{}, []int16{}},
-			Int32Field:        &Int32FieldData{[]int64{}, []int32{}},
-			Int64Field:        &Int64FieldData{[]int64{}, []int64{}},
-			FloatField:        &FloatFieldData{[]int64{}, []float32{}},
-			DoubleField:       &DoubleFieldData{[]int64{}, []float64{}},
-			StringField:       &StringFieldData{[]int64{}, []string{}},
+			RowIDField:        &Int64FieldData{[]int64{}, []int64{}, nil},
+			TimestampField:    &Int64FieldData{[]int64{}, []int64{}, nil},
+			BoolField:         &BoolFieldData{[]int64{}, []bool{}, nil},
+			Int8Field:         &Int8FieldData{[]int64{}, []int8{}, nil},
+			Int16Field:        &Int16FieldData{[]int64{}, []int16{}, nil},
+			Int32Field:        &Int32FieldData{[]int64{}, []int32{}, nil},
+			Int64Field:        &Int64FieldData{[]int64{}, []int64{}, nil},
+			FloatField:        &FloatFieldData{[]int64{}, []float32{}, nil},
+			DoubleField:       &DoubleFieldData{[]int64{}, []float64{}, nil},
+			StringField:       &StringFieldData{[]int64{}, []string{}, nil},
 			BinaryVectorField: &BinaryVectorFieldData{[]int64{}, []byte{}, 8},
 			FloatVectorField:  &FloatVectorFieldData{[]int64{}, []float32{}, 4},
 		},
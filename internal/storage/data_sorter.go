@@ -94,10 +94,28 @@ func (ds *DataSorter) Swap(i, j int) {
 			for idx := 0; idx < dim; idx++ {
 				data[i*dim+idx], data[j*dim+idx] = data[j*dim+idx], data[i*dim+idx]
 			}
+		case schemapb.DataType_Float16Vector:
+			data := singleData.(*Float16VectorFieldData).Data
+			steps := singleData.(*Float16VectorFieldData).Dim * 2
+			for idx := 0; idx < steps; idx++ {
+				data[i*steps+idx], data[j*steps+idx] = data[j*steps+idx], data[i*steps+idx]
+			}
+		case schemapb.DataType_BFloat16Vector:
+			data := singleData.(*BFloat16VectorFieldData).Data
+			steps := singleData.(*BFloat16VectorFieldData).Dim * 2
+			for idx := 0; idx < steps; idx++ {
+				data[i*steps+idx], data[j*steps+idx] = data[j*steps+idx], data[i*steps+idx]
+			}
+		case schemapb.DataType_SparseFloatVector:
+			data := singleData.(*SparseFloatVectorFieldData).Contents
+			data[i], data[j] = data[j], data[i]
 		default:
 			errMsg := "undefined data type " + string(field.DataType)
 			panic(errMsg)
 		}
+		if validData := GetValidData(singleData); len(validData) > 0 {
+			validData[i], validData[j] = validData[j], validData[i]
+		}
 	}
 }
 
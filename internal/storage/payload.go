@@ -45,6 +45,10 @@ type PayloadWriterInterface interface {
 	AddOneStringToPayload(msgs string) error
 	AddBinaryVectorToPayload(binVec []byte, dim int) error
 	AddFloatVectorToPayload(binVec []float32, dim int) error
+	AddFloat16VectorToPayload(vec []byte, dim int) error
+	AddBFloat16VectorToPayload(vec []byte, dim int) error
+	AddOneSparseFloatVectorToPayload(row []byte) error
+	AddValidDataToPayload(valid []bool) error
 	FinishPayloadWriter() error
 	GetPayloadBufferFromWriter() ([]byte, error)
 	GetPayloadLengthFromWriter() (int, error)
@@ -66,6 +70,10 @@ type PayloadReaderInterface interface {
 	GetStringFromPayload() ([]string, error)
 	GetBinaryVectorFromPayload() ([]byte, int, error)
 	GetFloatVectorFromPayload() ([]float32, int, error)
+	GetFloat16VectorFromPayload() ([]byte, int, error)
+	GetBFloat16VectorFromPayload() ([]byte, int, error)
+	GetSparseFloatVectorFromPayload() ([][]byte, int, error)
+	GetValidDataFromPayload() ([]bool, error)
 	GetPayloadLengthFromReader() (int, error)
 	ReleasePayloadReader()
 	Close()
@@ -139,6 +147,12 @@ func (w *PayloadWriter) AddDataToPayload(msgs interface{}, dim ...int) error {
 				return errors.New("incorrect data type")
 			}
 			return w.AddOneStringToPayload(val)
+		case schemapb.DataType_SparseFloatVector:
+			val, ok := msgs.([]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddOneSparseFloatVectorToPayload(val)
 		default:
 			return errors.New("incorrect datatype")
 		}
@@ -156,6 +170,18 @@ func (w *PayloadWriter) AddDataToPayload(msgs interface{}, dim ...int) error {
 				return errors.New("incorrect data type")
 			}
 			return w.AddFloatVectorToPayload(val, dim[0])
+		case schemapb.DataType_Float16Vector:
+			val, ok := msgs.([]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddFloat16VectorToPayload(val, dim[0])
+		case schemapb.DataType_BFloat16Vector:
+			val, ok := msgs.([]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddBFloat16VectorToPayload(val, dim[0])
 		default:
 			return errors.New("incorrect datatype")
 		}
@@ -318,6 +344,66 @@ func (w *PayloadWriter) AddFloatVectorToPayload(floatVec []float32, dim int) err
 	return HandleCStatus(&status, "AddFloatVectorToPayload failed")
 }
 
+// AddFloat16VectorToPayload adds float16 vectors, 2 bytes per dimension, into payload
+func (w *PayloadWriter) AddFloat16VectorToPayload(vec []byte, dim int) error {
+	if w.colType != schemapb.DataType_Float16Vector {
+		return errors.New("incorrect data type")
+	}
+	return w.addHalfFloatVectorToPayload(vec, dim)
+}
+
+// AddBFloat16VectorToPayload adds bfloat16 vectors, 2 bytes per dimension, into payload
+func (w *PayloadWriter) AddBFloat16VectorToPayload(vec []byte, dim int) error {
+	if w.colType != schemapb.DataType_BFloat16Vector {
+		return errors.New("incorrect data type")
+	}
+	return w.addHalfFloatVectorToPayload(vec, dim)
+}
+
+func (w *PayloadWriter) addHalfFloatVectorToPayload(vec []byte, dim int) error {
+	length := len(vec)
+	if length <= 0 {
+		return errors.New("can't add empty vector into payload")
+	}
+	if dim <= 0 {
+		return errors.New("dimension should be greater than 0")
+	}
+
+	cVec := (*C.uint8_t)(&vec[0])
+	cDim := C.int(dim)
+	cLength := C.int(length / (dim * 2))
+
+	status := C.AddFloat16VectorToPayload(w.payloadWriterPtr, cVec, cDim, cLength)
+	return HandleCStatus(&status, "AddFloat16VectorToPayload failed")
+}
+
+// AddOneSparseFloatVectorToPayload adds one sparse float vector row into payload, the row may be empty
+func (w *PayloadWriter) AddOneSparseFloatVectorToPayload(row []byte) error {
+	var cRow *C.uint8_t
+	if len(row) > 0 {
+		cRow = (*C.uint8_t)(&row[0])
+	}
+	cLength := C.int(len(row))
+
+	status := C.AddOneBinaryToPayload(w.payloadWriterPtr, cRow, cLength)
+	return HandleCStatus(&status, "AddOneSparseFloatVectorToPayload failed")
+}
+
+// AddValidDataToPayload adds the validity of rows of a nullable field into payload,
+// the valid data is stored in a separate column alongside the field data
+func (w *PayloadWriter) AddValidDataToPayload(valid []bool) error {
+	length := len(valid)
+	if length <= 0 {
+		return errors.New("can't add empty valid data into payload")
+	}
+
+	cValid := (*C.bool)(unsafe.Pointer(&valid[0]))
+	cLength := C.int(length)
+
+	status := C.AddValidDataToPayload(w.payloadWriterPtr, cValid, cLength)
+	return HandleCStatus(&status, "AddValidDataToPayload failed")
+}
+
 func (w *PayloadWriter) FinishPayloadWriter() error {
 	status := C.FinishPayloadWriter(w.payloadWriterPtr)
 	return HandleCStatus(&status, "FinishPayloadWriter failed")
@@ -10,6 +10,7 @@ import (
 	"github.com/apache/arrow/go/v8/parquet/file"
 
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // PayloadReader reads data from payload
@@ -62,6 +63,12 @@ func (r *PayloadReader) GetDataFromPayload() (interface{}, int, error) {
 		return r.GetBinaryVectorFromPayload()
 	case schemapb.DataType_FloatVector:
 		return r.GetFloatVectorFromPayload()
+	case schemapb.DataType_Float16Vector:
+		return r.GetFloat16VectorFromPayload()
+	case schemapb.DataType_BFloat16Vector:
+		return r.GetBFloat16VectorFromPayload()
+	case schemapb.DataType_SparseFloatVector:
+		return r.GetSparseFloatVectorFromPayload()
 	case schemapb.DataType_String, schemapb.DataType_VarChar:
 		val, err := r.GetStringFromPayload()
 		return val, 0, err
@@ -332,6 +339,91 @@ func (r *PayloadReader) GetFloatVectorFromPayload() ([]float32, int, error) {
 	return ret, dim, nil
 }
 
+// GetFloat16VectorFromPayload returns vector, dimension, error
+func (r *PayloadReader) GetFloat16VectorFromPayload() ([]byte, int, error) {
+	if r.colType != schemapb.DataType_Float16Vector {
+		return nil, -1, fmt.Errorf("failed to get float16 vector from datatype %v", r.colType.String())
+	}
+	return r.getHalfFloatVectorFromPayload()
+}
+
+// GetBFloat16VectorFromPayload returns vector, dimension, error
+func (r *PayloadReader) GetBFloat16VectorFromPayload() ([]byte, int, error) {
+	if r.colType != schemapb.DataType_BFloat16Vector {
+		return nil, -1, fmt.Errorf("failed to get bfloat16 vector from datatype %v", r.colType.String())
+	}
+	return r.getHalfFloatVectorFromPayload()
+}
+
+func (r *PayloadReader) getHalfFloatVectorFromPayload() ([]byte, int, error) {
+	reader, ok := r.reader.RowGroup(0).Column(0).(*file.FixedLenByteArrayColumnChunkReader)
+	if !ok {
+		return nil, -1, fmt.Errorf("expect type *file.FixedLenByteArrayColumnChunkReader, but got %T", r.reader.RowGroup(0).Column(0))
+	}
+
+	width := r.reader.RowGroup(0).Column(0).Descriptor().TypeLength()
+	values := make([]parquet.FixedLenByteArray, r.numRows)
+	total, valuesRead, err := reader.ReadBatch(r.numRows, values, nil, nil)
+	if err != nil {
+		return nil, -1, err
+	}
+	if total != r.numRows || int64(valuesRead) != r.numRows {
+		return nil, -1, fmt.Errorf("expect %d rows, but got total = %d and valuesRead = %d", r.numRows, total, valuesRead)
+	}
+	ret := make([]byte, int64(width)*r.numRows)
+	for i := 0; i < int(r.numRows); i++ {
+		copy(ret[i*width:(i+1)*width], values[i])
+	}
+	return ret, width / 2, nil
+}
+
+// GetSparseFloatVectorFromPayload returns sparse float vector rows, dimension, error
+func (r *PayloadReader) GetSparseFloatVectorFromPayload() ([][]byte, int, error) {
+	if r.colType != schemapb.DataType_SparseFloatVector {
+		return nil, -1, fmt.Errorf("failed to get sparse float vector from datatype %v", r.colType.String())
+	}
+	reader, ok := r.reader.RowGroup(0).Column(0).(*file.ByteArrayColumnChunkReader)
+	if !ok {
+		return nil, -1, fmt.Errorf("expect type *file.ByteArrayColumnChunkReader, but got %T", r.reader.RowGroup(0).Column(0))
+	}
+
+	values := make([]parquet.ByteArray, r.numRows)
+	total, valuesRead, err := reader.ReadBatch(r.numRows, values, nil, nil)
+	if err != nil {
+		return nil, -1, err
+	}
+	if total != r.numRows || int64(valuesRead) != r.numRows {
+		return nil, -1, fmt.Errorf("expect %d rows, but got total = %d and valuesRead = %d", r.numRows, total, valuesRead)
+	}
+	ret := make([][]byte, r.numRows)
+	for i := 0; i < int(r.numRows); i++ {
+		ret[i] = make([]byte, len(values[i]))
+		copy(ret[i], values[i])
+	}
+	return ret, int(typeutil.SparseFloatRowsDim(ret...)), nil
+}
+
+// GetValidDataFromPayload returns the validity of rows, nil if the payload has no valid data which means all rows are valid
+func (r *PayloadReader) GetValidDataFromPayload() ([]bool, error) {
+	if r.reader.MetaData().Schema.NumColumns() < 2 {
+		return nil, nil
+	}
+	reader, ok := r.reader.RowGroup(0).Column(1).(*file.BooleanColumnChunkReader)
+	if !ok {
+		return nil, fmt.Errorf("expect type *file.BooleanColumnChunkReader, but got %T", r.reader.RowGroup(0).Column(1))
+	}
+
+	values := make([]bool, r.numRows)
+	total, valuesRead, err := reader.ReadBatch(r.numRows, values, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if total != r.numRows || int64(valuesRead) != r.numRows {
+		return nil, fmt.Errorf("expect %d rows, but got total = %d and valuesRead = %d", r.numRows, total, valuesRead)
+	}
+	return values, nil
+}
+
 func (r *PayloadReader) GetPayloadLengthFromReader() (int, error) {
 	return int(r.numRows), nil
 }
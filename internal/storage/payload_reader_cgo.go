@@ -15,6 +15,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // PayloadReaderCgo reads data from payload
@@ -68,6 +69,12 @@ func (r *PayloadReaderCgo) GetDataFromPayload() (interface{}, int, error) {
 		return r.GetBinaryVectorFromPayload()
 	case schemapb.DataType_FloatVector:
 		return r.GetFloatVectorFromPayload()
+	case schemapb.DataType_Float16Vector:
+		return r.GetFloat16VectorFromPayload()
+	case schemapb.DataType_BFloat16Vector:
+		return r.GetBFloat16VectorFromPayload()
+	case schemapb.DataType_SparseFloatVector:
+		return r.GetSparseFloatVectorFromPayload()
 	case schemapb.DataType_String:
 		val, err := r.GetStringFromPayload()
 		return val, 0, err
@@ -290,6 +297,76 @@ func (r *PayloadReaderCgo) GetFloatVectorFromPayload() ([]float32, int, error) {
 	return slice, int(cDim), nil
 }
 
+// GetFloat16VectorFromPayload returns vector, dimension, error
+func (r *PayloadReaderCgo) GetFloat16VectorFromPayload() ([]byte, int, error) {
+	if r.colType != schemapb.DataType_Float16Vector {
+		return nil, 0, errors.New("incorrect data type")
+	}
+	return r.getHalfFloatVectorFromPayload()
+}
+
+// GetBFloat16VectorFromPayload returns vector, dimension, error
+func (r *PayloadReaderCgo) GetBFloat16VectorFromPayload() ([]byte, int, error) {
+	if r.colType != schemapb.DataType_BFloat16Vector {
+		return nil, 0, errors.New("incorrect data type")
+	}
+	return r.getHalfFloatVectorFromPayload()
+}
+
+func (r *PayloadReaderCgo) getHalfFloatVectorFromPayload() ([]byte, int, error) {
+	var cMsg *C.uint8_t
+	var cDim C.int
+	var cLen C.int
+
+	status := C.GetFloat16VectorFromPayload(r.payloadReaderPtr, &cMsg, &cDim, &cLen)
+	if err := HandleCStatus(&status, "GetFloat16VectorFromPayload failed"); err != nil {
+		return nil, 0, err
+	}
+	length := cDim * 2 * cLen
+
+	slice := (*[1 << 28]byte)(unsafe.Pointer(cMsg))[:length:length]
+	return slice, int(cDim), nil
+}
+
+// GetValidDataFromPayload returns the validity of rows, nil if the payload has no valid data
+func (r *PayloadReaderCgo) GetValidDataFromPayload() ([]bool, error) {
+	var cMsg *C.bool
+	var cSize C.int
+
+	status := C.GetValidDataFromPayload(r.payloadReaderPtr, &cMsg, &cSize)
+	if err := HandleCStatus(&status, "GetValidDataFromPayload failed"); err != nil {
+		return nil, err
+	}
+	if cSize == 0 {
+		return nil, nil
+	}
+
+	slice := (*[1 << 28]bool)(unsafe.Pointer(cMsg))[:cSize:cSize]
+	return slice, nil
+}
+
+// GetSparseFloatVectorFromPayload returns sparse float vector rows, dimension, error
+func (r *PayloadReaderCgo) GetSparseFloatVectorFromPayload() ([][]byte, int, error) {
+	if r.colType != schemapb.DataType_SparseFloatVector {
+		return nil, 0, errors.New("incorrect data type")
+	}
+	length, err := r.GetPayloadLengthFromReader()
+	if err != nil {
+		return nil, 0, err
+	}
+	ret := make([][]byte, length)
+	for i := 0; i < length; i++ {
+		var cMsg *C.uint8_t
+		var cLen C.int
+		status := C.GetOneBinaryFromPayload(r.payloadReaderPtr, C.int(i), &cMsg, &cLen)
+		if err := HandleCStatus(&status, "GetOneBinaryFromPayload failed"); err != nil {
+			return nil, 0, err
+		}
+		ret[i] = C.GoBytes(unsafe.Pointer(cMsg), cLen)
+	}
+	return ret, int(typeutil.SparseFloatRowsDim(ret...)), nil
+}
+
 func (r *PayloadReaderCgo) GetPayloadLengthFromReader() (int, error) {
 	length := C.GetPayloadLengthFromReader(r.payloadReaderPtr)
 	return int(length), nil
@@ -64,6 +64,46 @@ func TestPayload_ReaderAndWriter(t *testing.T) {
 
 	})
 
+	t.Run("TestValidData", func(t *testing.T) {
+		w, err := NewPayloadWriter(schemapb.DataType_Int64)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		err = w.AddInt64ToPayload([]int64{1, 0, 3})
+		assert.Nil(t, err)
+		err = w.AddValidDataToPayload([]bool{true, false, true})
+		assert.Nil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.Nil(t, err)
+		defer w.ReleasePayloadWriter()
+
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+
+		r, err := NewPayloadReader(schemapb.DataType_Int64, buffer)
+		require.Nil(t, err)
+		defer r.ReleasePayloadReader()
+		int64s, err := r.GetInt64FromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{1, 0, 3}, int64s)
+		valid, err := r.GetValidDataFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, []bool{true, false, true}, valid)
+	})
+
+	t.Run("TestValidData length mismatch", func(t *testing.T) {
+		w, err := NewPayloadWriter(schemapb.DataType_Int64)
+		require.Nil(t, err)
+		defer w.ReleasePayloadWriter()
+
+		err = w.AddInt64ToPayload([]int64{1, 0, 3})
+		assert.Nil(t, err)
+		err = w.AddValidDataToPayload([]bool{true, false})
+		assert.Nil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.NotNil(t, err)
+	})
+
 	t.Run("TestInt8", func(t *testing.T) {
 		w, err := NewPayloadWriter(schemapb.DataType_Int8)
 		require.Nil(t, err)
@@ -422,6 +462,72 @@ func TestPayload_ReaderAndWriter(t *testing.T) {
 		defer r.ReleasePayloadReader()
 	})
 
+	t.Run("TestFloat16Vector", func(t *testing.T) {
+		w, err := NewPayloadWriter(schemapb.DataType_Float16Vector)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		err = w.AddFloat16VectorToPayload([]byte{1, 2, 3, 4}, 2)
+		assert.Nil(t, err)
+		err = w.AddDataToPayload([]byte{5, 6, 7, 8}, 2)
+		assert.Nil(t, err)
+		err = w.AddBFloat16VectorToPayload([]byte{5, 6, 7, 8}, 2)
+		assert.NotNil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.Nil(t, err)
+
+		length, err := w.GetPayloadLengthFromWriter()
+		assert.Nil(t, err)
+		assert.Equal(t, 2, length)
+		defer w.ReleasePayloadWriter()
+
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+
+		r, err := NewPayloadReader(schemapb.DataType_Float16Vector, buffer)
+		require.Nil(t, err)
+		length, err = r.GetPayloadLengthFromReader()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 2)
+
+		vecs, dim, err := r.GetFloat16VectorFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, 2, dim)
+		assert.Equal(t, []byte{1, 2, 3, 4, 5, 6, 7, 8}, vecs)
+
+		ivecs, dim, err := r.GetDataFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, 2, dim)
+		assert.Equal(t, []byte{1, 2, 3, 4, 5, 6, 7, 8}, ivecs.([]byte))
+
+		_, _, err = r.GetBFloat16VectorFromPayload()
+		assert.NotNil(t, err)
+		defer r.ReleasePayloadReader()
+	})
+
+	t.Run("TestBFloat16Vector", func(t *testing.T) {
+		w, err := NewPayloadWriter(schemapb.DataType_BFloat16Vector)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		err = w.AddBFloat16VectorToPayload([]byte{1, 2, 3, 4, 5, 6}, 1)
+		assert.Nil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.Nil(t, err)
+		defer w.ReleasePayloadWriter()
+
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+
+		r, err := NewPayloadReader(schemapb.DataType_BFloat16Vector, buffer)
+		require.Nil(t, err)
+		vecs, dim, err := r.GetBFloat16VectorFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, 1, dim)
+		assert.Equal(t, []byte{1, 2, 3, 4, 5, 6}, vecs)
+		defer r.ReleasePayloadReader()
+	})
+
 	t.Run("TestAddDataToPayload", func(t *testing.T) {
 		w, err := NewPayloadWriter(schemapb.DataType_Bool)
 		w.colType = 999
@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // PrintBinlogFiles call printBinlogFile in turn for the file list specified by parameter fileList.
@@ -319,6 +320,41 @@ func printPayloadValues(colType schemapb.DataType, reader PayloadReaderInterface
 			}
 			fmt.Println()
 		}
+	case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		var val []byte
+		var dim int
+		var err error
+		var toFloat32 func([]byte) []float32
+		if colType == schemapb.DataType_Float16Vector {
+			val, dim, err = reader.GetFloat16VectorFromPayload()
+			toFloat32 = typeutil.Float16BytesToFloat32Array
+		} else {
+			val, dim, err = reader.GetBFloat16VectorFromPayload()
+			toFloat32 = typeutil.BFloat16BytesToFloat32Array
+		}
+		if err != nil {
+			return err
+		}
+		length := len(val) / (dim * 2)
+		for i := 0; i < length; i++ {
+			fmt.Printf("\t\t%d :", i)
+			for _, f := range toFloat32(val[i*dim*2 : (i+1)*dim*2]) {
+				fmt.Printf(" %f", f)
+			}
+			fmt.Println()
+		}
+	case schemapb.DataType_SparseFloatVector:
+		val, _, err := reader.GetSparseFloatVectorFromPayload()
+		if err != nil {
+			return err
+		}
+		for i, row := range val {
+			fmt.Printf("\t\t%d :", i)
+			for j := 0; j < typeutil.SparseFloatRowElementCount(row); j++ {
+				fmt.Printf(" %d:%f", typeutil.SparseFloatRowIndexAt(row, j), typeutil.SparseFloatRowValueAt(row, j))
+			}
+			fmt.Println()
+		}
 	default:
 		return errors.New("undefined data type")
 	}
@@ -407,6 +407,15 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 	}
 
 	for _, field := range collSchema.Fields {
+		if _, ok := srcFields[field.FieldID]; !ok && isFilledByDefault(field) {
+			fieldData, err := GenDefaultFieldData(field, msg.NRows())
+			if err != nil {
+				return nil, err
+			}
+			idata.Data[field.FieldID] = fieldData
+			continue
+		}
+
 		switch field.DataType {
 		case schemapb.DataType_FloatVector:
 			dim, err := GetDimFromParams(field.TypeParams)
@@ -444,6 +453,56 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 
 			idata.Data[field.FieldID] = fieldData
 
+		case schemapb.DataType_Float16Vector:
+			dim, err := GetDimFromParams(field.TypeParams)
+			if err != nil {
+				log.Error("failed to get dim", zap.Error(err))
+				return nil, err
+			}
+
+			srcData := srcFields[field.FieldID].GetVectors().GetFloat16Vector()
+
+			fieldData := &Float16VectorFieldData{
+				NumRows: []int64{int64(msg.NRows())},
+				Data:    make([]byte, 0, len(srcData)),
+				Dim:     dim,
+			}
+			fieldData.Data = append(fieldData.Data, srcData...)
+
+			idata.Data[field.FieldID] = fieldData
+
+		case schemapb.DataType_BFloat16Vector:
+			dim, err := GetDimFromParams(field.TypeParams)
+			if err != nil {
+				log.Error("failed to get dim", zap.Error(err))
+				return nil, err
+			}
+
+			srcData := srcFields[field.FieldID].GetVectors().GetBfloat16Vector()
+
+			fieldData := &BFloat16VectorFieldData{
+				NumRows: []int64{int64(msg.NRows())},
+				Data:    make([]byte, 0, len(srcData)),
+				Dim:     dim,
+			}
+			fieldData.Data = append(fieldData.Data, srcData...)
+
+			idata.Data[field.FieldID] = fieldData
+
+		case schemapb.DataType_SparseFloatVector:
+			srcData := srcFields[field.FieldID].GetVectors().GetSparseFloatVector()
+
+			fieldData := &SparseFloatVectorFieldData{
+				NumRows:  []int64{int64(msg.NRows())},
+				Contents: make([][]byte, 0, len(srcData.GetContents())),
+				Dim:      srcData.GetDim(),
+			}
+			for _, row := range srcData.GetContents() {
+				fieldData.AppendRow(row)
+			}
+
+			idata.Data[field.FieldID] = fieldData
+
 		case schemapb.DataType_Bool:
 			srcData := srcFields[field.FieldID].GetScalars().GetBoolData().GetData()
 
@@ -551,11 +610,117 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 			fieldData.Data = append(fieldData.Data, srcData...)
 			idata.Data[field.FieldID] = fieldData
 		}
+
+		if validData := srcFields[field.FieldID].GetValidData(); len(validData) > 0 {
+			appendValidData(idata.Data[field.FieldID], validData, len(validData))
+		}
 	}
 
 	return idata, nil
 }
 
+// isFilledByDefault tells whether a user field missing in the insert data is filled with its default value,
+// which happens when the data was written with another version of the schema.
+func isFilledByDefault(field *schemapb.FieldSchema) bool {
+	return field.GetFieldID() >= common.StartOfUserFieldID && !typeutil.IsVectorType(field.GetDataType())
+}
+
+// GenDefaultFieldData returns the data of numRows rows holding the default value of a scalar field,
+// the rows are null if the field is nullable and has no default value, otherwise the zero value of
+// the data type is used if the field has no default value.
+func GenDefaultFieldData(field *schemapb.FieldSchema, numRows uint64) (FieldData, error) {
+	value := field.GetDefaultValue()
+	nums := []int64{int64(numRows)}
+	var fieldData FieldData
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		data := make([]bool, numRows)
+		for i := range data {
+			data[i] = value.GetBoolData()
+		}
+		fieldData = &BoolFieldData{NumRows: nums, Data: data}
+	case schemapb.DataType_Int8:
+		data := make([]int8, numRows)
+		for i := range data {
+			data[i] = int8(value.GetIntData())
+		}
+		fieldData = &Int8FieldData{NumRows: nums, Data: data}
+	case schemapb.DataType_Int16:
+		data := make([]int16, numRows)
+		for i := range data {
+			data[i] = int16(value.GetIntData())
+		}
+		fieldData = &Int16FieldData{NumRows: nums, Data: data}
+	case schemapb.DataType_Int32:
+		data := make([]int32, numRows)
+		for i := range data {
+			data[i] = value.GetIntData()
+		}
+		fieldData = &Int32FieldData{NumRows: nums, Data: data}
+	case schemapb.DataType_Int64:
+		data := make([]int64, numRows)
+		for i := range data {
+			data[i] = value.GetLongData()
+		}
+		fieldData = &Int64FieldData{NumRows: nums, Data: data}
+	case schemapb.DataType_Float:
+		data := make([]float32, numRows)
+		for i := range data {
+			data[i] = value.GetFloatData()
+		}
+		fieldData = &FloatFieldData{NumRows: nums, Data: data}
+	case schemapb.DataType_Double:
+		data := make([]float64, numRows)
+		for i := range data {
+			data[i] = value.GetDoubleData()
+		}
+		fieldData = &DoubleFieldData{NumRows: nums, Data: data}
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		data := make([]string, numRows)
+		for i := range data {
+			data[i] = value.GetStringData()
+		}
+		fieldData = &StringFieldData{NumRows: nums, Data: data}
+	default:
+		return nil, fmt.Errorf("no default value for field %s of type %s", field.GetName(), field.GetDataType().String())
+	}
+	if field.GetNullable() && value == nil {
+		*validDataOf(fieldData) = make([]bool, numRows)
+	}
+	return fieldData, nil
+}
+
+// FillDefaultFieldData adds the default value of the scalar fields in schema but not in insert data,
+// numRows is the rows number of the insert data. A field added after some rows were written has fewer
+// rows, the default values are filled before its rows.
+func FillDefaultFieldData(schema *schemapb.CollectionSchema, data *InsertData, numRows uint64) error {
+	for _, field := range schema.GetFields() {
+		if !isFilledByDefault(field) {
+			continue
+		}
+		fieldID := field.GetFieldID()
+		missing := numRows
+		existed, ok := data.Data[fieldID]
+		if ok {
+			if uint64(existed.RowNum()) >= numRows {
+				continue
+			}
+			missing = numRows - uint64(existed.RowNum())
+		}
+		fieldData, err := GenDefaultFieldData(field, missing)
+		if err != nil {
+			return err
+		}
+		if ok {
+			filled := &InsertData{Data: map[FieldID]FieldData{fieldID: fieldData}}
+			MergeFieldData(filled, fieldID, existed)
+			fieldData = filled.Data[fieldID]
+		}
+		data.Data[fieldID] = fieldData
+	}
+	return nil
+}
+
 func InsertMsgToInsertData(msg *msgstream.InsertMsg, schema *schemapb.CollectionSchema) (idata *InsertData, err error) {
 	if msg.IsRowBased() {
 		return RowBasedInsertMsgToInsertData(msg, schema)
@@ -574,6 +739,7 @@ func mergeBoolField(data *InsertData, fid FieldID, field *BoolFieldData) {
 	fieldData := data.Data[fid].(*BoolFieldData)
 	fieldData.Data = append(fieldData.Data, field.Data...)
 	fieldData.NumRows[0] += int64(field.RowNum())
+	appendValidData(fieldData, field.ValidData, field.RowNum())
 }
 
 func mergeInt8Field(data *InsertData, fid FieldID, field *Int8FieldData) {
@@ -587,6 +753,7 @@ func mergeInt8Field(data *InsertData, fid FieldID, field *Int8FieldData) {
 	fieldData := data.Data[fid].(*Int8FieldData)
 	fieldData.Data = append(fieldData.Data, field.Data...)
 	fieldData.NumRows[0] += int64(field.RowNum())
+	appendValidData(fieldData, field.ValidData, field.RowNum())
 }
 
 func mergeInt16Field(data *InsertData, fid FieldID, field *Int16FieldData) {
@@ -600,6 +767,7 @@ func mergeInt16Field(data *InsertData, fid FieldID, field *Int16FieldData) {
 	fieldData := data.Data[fid].(*Int16FieldData)
 	fieldData.Data = append(fieldData.Data, field.Data...)
 	fieldData.NumRows[0] += int64(field.RowNum())
+	appendValidData(fieldData, field.ValidData, field.RowNum())
 }
 
 func mergeInt32Field(data *InsertData, fid FieldID, field *Int32FieldData) {
@@ -613,6 +781,7 @@ func mergeInt32Field(data *InsertData, fid FieldID, field *Int32FieldData) {
 	fieldData := data.Data[fid].(*Int32FieldData)
 	fieldData.Data = append(fieldData.Data, field.Data...)
 	fieldData.NumRows[0] += int64(field.RowNum())
+	appendValidData(fieldData, field.ValidData, field.RowNum())
 }
 
 func mergeInt64Field(data *InsertData, fid FieldID, field *Int64FieldData) {
@@ -626,6 +795,7 @@ func mergeInt64Field(data *InsertData, fid FieldID, field *Int64FieldData) {
 	fieldData := data.Data[fid].(*Int64FieldData)
 	fieldData.Data = append(fieldData.Data, field.Data...)
 	fieldData.NumRows[0] += int64(field.RowNum())
+	appendValidData(fieldData, field.ValidData, field.RowNum())
 }
 
 func mergeFloatField(data *InsertData, fid FieldID, field *FloatFieldData) {
@@ -639,6 +809,7 @@ func mergeFloatField(data *InsertData, fid FieldID, field *FloatFieldData) {
 	fieldData := data.Data[fid].(*FloatFieldData)
 	fieldData.Data = append(fieldData.Data, field.Data...)
 	fieldData.NumRows[0] += int64(field.RowNum())
+	appendValidData(fieldData, field.ValidData, field.RowNum())
 }
 
 func mergeDoubleField(data *InsertData, fid FieldID, field *DoubleFieldData) {
@@ -652,6 +823,7 @@ func mergeDoubleField(data *InsertData, fid FieldID, field *DoubleFieldData) {
 	fieldData := data.Data[fid].(*DoubleFieldData)
 	fieldData.Data = append(fieldData.Data, field.Data...)
 	fieldData.NumRows[0] += int64(field.RowNum())
+	appendValidData(fieldData, field.ValidData, field.RowNum())
 }
 
 func mergeStringField(data *InsertData, fid FieldID, field *StringFieldData) {
@@ -665,6 +837,7 @@ func mergeStringField(data *InsertData, fid FieldID, field *StringFieldData) {
 	fieldData := data.Data[fid].(*StringFieldData)
 	fieldData.Data = append(fieldData.Data, field.Data...)
 	fieldData.NumRows[0] += int64(field.RowNum())
+	appendValidData(fieldData, field.ValidData, field.RowNum())
 }
 
 func mergeBinaryVectorField(data *InsertData, fid FieldID, field *BinaryVectorFieldData) {
@@ -695,6 +868,50 @@ func mergeFloatVectorField(data *InsertData, fid FieldID, field *FloatVectorFiel
 	fieldData.NumRows[0] += int64(field.RowNum())
 }
 
+func mergeFloat16VectorField(data *InsertData, fid FieldID, field *Float16VectorFieldData) {
+	if _, ok := data.Data[fid]; !ok {
+		fieldData := &Float16VectorFieldData{
+			NumRows: []int64{0},
+			Data:    nil,
+			Dim:     field.Dim,
+		}
+		data.Data[fid] = fieldData
+	}
+	fieldData := data.Data[fid].(*Float16VectorFieldData)
+	fieldData.Data = append(fieldData.Data, field.Data...)
+	fieldData.NumRows[0] += int64(field.RowNum())
+}
+
+func mergeBFloat16VectorField(data *InsertData, fid FieldID, field *BFloat16VectorFieldData) {
+	if _, ok := data.Data[fid]; !ok {
+		fieldData := &BFloat16VectorFieldData{
+			NumRows: []int64{0},
+			Data:    nil,
+			Dim:     field.Dim,
+		}
+		data.Data[fid] = fieldData
+	}
+	fieldData := data.Data[fid].(*BFloat16VectorFieldData)
+	fieldData.Data = append(fieldData.Data, field.Data...)
+	fieldData.NumRows[0] += int64(field.RowNum())
+}
+
+func mergeSparseFloatVectorField(data *InsertData, fid FieldID, field *SparseFloatVectorFieldData) {
+	if _, ok := data.Data[fid]; !ok {
+		fieldData := &SparseFloatVectorFieldData{
+			NumRows:  []int64{0},
+			Contents: nil,
+		}
+		data.Data[fid] = fieldData
+	}
+	fieldData := data.Data[fid].(*SparseFloatVectorFieldData)
+	fieldData.Contents = append(fieldData.Contents, field.Contents...)
+	if field.Dim > fieldData.Dim {
+		fieldData.Dim = field.Dim
+	}
+	fieldData.NumRows[0] += int64(field.RowNum())
+}
+
 // MergeFieldData merge field into data.
 func MergeFieldData(data *InsertData, fid FieldID, field FieldData) {
 	if field == nil {
@@ -721,6 +938,12 @@ func MergeFieldData(data *InsertData, fid FieldID, field FieldData) {
 		mergeBinaryVectorField(data, fid, field)
 	case *FloatVectorFieldData:
 		mergeFloatVectorField(data, fid, field)
+	case *Float16VectorFieldData:
+		mergeFloat16VectorField(data, fid, field)
+	case *BFloat16VectorFieldData:
+		mergeBFloat16VectorField(data, fid, field)
+	case *SparseFloatVectorFieldData:
+		mergeSparseFloatVectorField(data, fid, field)
 	}
 }
 
@@ -790,6 +1013,11 @@ func stringFieldDataToPbBytes(field *StringFieldData) ([]byte, error) {
 	return proto.Marshal(arr)
 }
 
+func sparseFloatVectorFieldDataToPbBytes(field *SparseFloatVectorFieldData) ([]byte, error) {
+	arr := &schemapb.SparseFloatArray{Contents: field.Contents, Dim: field.Dim}
+	return proto.Marshal(arr)
+}
+
 func binaryWrite(endian binary.ByteOrder, data interface{}) ([]byte, error) {
 	buf := new(bytes.Buffer)
 	err := binary.Write(buf, endian, data)
@@ -801,9 +1029,10 @@ func binaryWrite(endian binary.ByteOrder, data interface{}) ([]byte, error) {
 
 // FieldDataToBytes encode field data to byte slice.
 // For some fixed-length data, such as int32, int64, float vector, use binary.Write directly.
-// For binary vector, return it directly.
+// For binary vector, float16 vector and bfloat16 vector, return it directly.
 // For bool data, first transfer to schemapb.BoolArray and then marshal it. (TODO: handle bool like other scalar data.)
 // For variable-length data, such as string, first transfer to schemapb.StringArray and then marshal it.
+// For sparse float vector, first transfer to schemapb.SparseFloatArray and then marshal it.
 // TODO: find a proper way to store variable-length data. Or we should unify to use protobuf?
 func FieldDataToBytes(endian binary.ByteOrder, fieldData FieldData) ([]byte, error) {
 	switch field := fieldData.(type) {
@@ -816,6 +1045,12 @@ func FieldDataToBytes(endian binary.ByteOrder, fieldData FieldData) ([]byte, err
 		return field.Data, nil
 	case *FloatVectorFieldData:
 		return binaryWrite(endian, field.Data)
+	case *Float16VectorFieldData:
+		return field.Data, nil
+	case *BFloat16VectorFieldData:
+		return field.Data, nil
+	case *SparseFloatVectorFieldData:
+		return sparseFloatVectorFieldDataToPbBytes(field)
 	case *Int8FieldData:
 		return binaryWrite(endian, field.Data)
 	case *Int16FieldData:
@@ -986,10 +1221,53 @@ func TransferInsertDataToInsertRecord(insertData *InsertData) (*segcorepb.Insert
 					},
 				},
 			}
+		case *Float16VectorFieldData:
+			fieldData = &schemapb.FieldData{
+				Type:    schemapb.DataType_Float16Vector,
+				FieldId: fieldID,
+				Field: &schemapb.FieldData_Vectors{
+					Vectors: &schemapb.VectorField{
+						Data: &schemapb.VectorField_Float16Vector{
+							Float16Vector: rawData.Data,
+						},
+						Dim: int64(rawData.Dim),
+					},
+				},
+			}
+		case *BFloat16VectorFieldData:
+			fieldData = &schemapb.FieldData{
+				Type:    schemapb.DataType_BFloat16Vector,
+				FieldId: fieldID,
+				Field: &schemapb.FieldData_Vectors{
+					Vectors: &schemapb.VectorField{
+						Data: &schemapb.VectorField_Bfloat16Vector{
+							Bfloat16Vector: rawData.Data,
+						},
+						Dim: int64(rawData.Dim),
+					},
+				},
+			}
+		case *SparseFloatVectorFieldData:
+			fieldData = &schemapb.FieldData{
+				Type:    schemapb.DataType_SparseFloatVector,
+				FieldId: fieldID,
+				Field: &schemapb.FieldData_Vectors{
+					Vectors: &schemapb.VectorField{
+						Data: &schemapb.VectorField_SparseFloatVector{
+							SparseFloatVector: &schemapb.SparseFloatArray{
+								Contents: rawData.Contents,
+								Dim:      rawData.Dim,
+							},
+						},
+						Dim: rawData.Dim,
+					},
+				},
+			}
 		default:
 			return insertRecord, fmt.Errorf("unsupported data type when transter storage.InsertData to internalpb.InsertRecord")
 		}
 
+		fieldData.ValidData = GetValidData(rawData)
 		insertRecord.FieldsData = append(insertRecord.FieldsData, fieldData)
 		insertRecord.NumRows = int64(rawData.RowNum())
 	}
@@ -1013,5 +1291,29 @@ func TransferInsertMsgToInsertRecord(schema *schemapb.CollectionSchema, msg *msg
 
 	insertRecord.FieldsData = append(insertRecord.FieldsData, msg.FieldsData...)
 
+	// the fields unknown to the producer of msg are filled with their default values
+	srcFields := make(map[FieldID]struct{}, len(msg.FieldsData))
+	for _, field := range msg.FieldsData {
+		srcFields[field.GetFieldId()] = struct{}{}
+	}
+	defaultData := &InsertData{Data: make(map[FieldID]FieldData)}
+	for _, field := range schema.GetFields() {
+		if _, ok := srcFields[field.GetFieldID()]; ok || !isFilledByDefault(field) {
+			continue
+		}
+		fieldData, err := GenDefaultFieldData(field, msg.NRows())
+		if err != nil {
+			return nil, err
+		}
+		defaultData.Data[field.GetFieldID()] = fieldData
+	}
+	if len(defaultData.Data) > 0 {
+		defaultRecord, err := TransferInsertDataToInsertRecord(defaultData)
+		if err != nil {
+			return nil, err
+		}
+		insertRecord.FieldsData = append(insertRecord.FieldsData, defaultRecord.FieldsData...)
+	}
+
 	return insertRecord, nil
 }
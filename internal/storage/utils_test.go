@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 
 	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -833,6 +834,107 @@ func TestInsertMsgToInsertData2(t *testing.T) {
 	}
 }
 
+func TestGenDefaultFieldData(t *testing.T) {
+	cases := []struct {
+		field    *schemapb.FieldSchema
+		expected interface{}
+	}{
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_Bool, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_BoolData{BoolData: true}}}, true},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_Int8, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 8}}}, int8(8)},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_Int16, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 16}}}, int16(16)},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_Int32, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 32}}}, int32(32)},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_Int64, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 64}}}, int64(64)},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_Float, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_FloatData{FloatData: 1.5}}}, float32(1.5)},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_Double, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_DoubleData{DoubleData: 2.5}}}, 2.5},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_VarChar, DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "a"}}}, "a"},
+		{&schemapb.FieldSchema{DataType: schemapb.DataType_VarChar, Nullable: true}, ""},
+	}
+	for _, c := range cases {
+		fieldData, err := GenDefaultFieldData(c.field, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, fieldData.RowNum())
+		for i := 0; i < fieldData.RowNum(); i++ {
+			assert.Equal(t, c.expected, fieldData.GetRow(i))
+		}
+	}
+
+	_, err := GenDefaultFieldData(&schemapb.FieldSchema{DataType: schemapb.DataType_FloatVector}, 3)
+	assert.Error(t, err)
+}
+
+func TestInsertMsgToInsertData_AddedField(t *testing.T) {
+	numRows, fVecDim, bVecDim := 2, 2, 8
+	schema, _, _ := genAllFieldsSchema(fVecDim, bVecDim)
+	msg, _, _ := genColumnBasedInsertMsg(schema, numRows, fVecDim, bVecDim)
+
+	addedFieldID := int64(common.StartOfUserFieldID + len(schema.Fields))
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID:      addedFieldID,
+		Name:         "added",
+		DataType:     schemapb.DataType_Int64,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 10}},
+	})
+
+	idata, err := InsertMsgToInsertData(msg, schema)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{10, 10}, idata.Data[addedFieldID].(*Int64FieldData).Data)
+
+	insertRecord, err := TransferInsertMsgToInsertRecord(schema, msg)
+	assert.NoError(t, err)
+	assert.Equal(t, len(msg.FieldsData)+1, len(insertRecord.FieldsData))
+	added := insertRecord.FieldsData[len(insertRecord.FieldsData)-1]
+	assert.Equal(t, addedFieldID, added.GetFieldId())
+	assert.Equal(t, []int64{10, 10}, added.GetScalars().GetLongData().GetData())
+}
+
+func TestFillDefaultFieldData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, DataType: schemapb.DataType_FloatVector},
+			{
+				FieldID:      101,
+				DataType:     schemapb.DataType_Int64,
+				DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 10}},
+			},
+			{FieldID: 102, DataType: schemapb.DataType_VarChar, Nullable: true},
+		},
+	}
+	data := &InsertData{
+		Data: map[FieldID]FieldData{
+			common.RowIDField: &Int64FieldData{NumRows: []int64{3}, Data: []int64{1, 2, 3}},
+			// the field is added after the first row was written
+			101: &Int64FieldData{NumRows: []int64{2}, Data: []int64{5, 6}},
+		},
+	}
+	err := FillDefaultFieldData(schema, data, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(data.Data))
+	assert.Equal(t, []int64{10, 5, 6}, data.Data[101].(*Int64FieldData).Data)
+	assert.Equal(t, []string{"", "", ""}, data.Data[102].(*StringFieldData).Data)
+	assert.Empty(t, GetValidData(data.Data[101]))
+	assert.Equal(t, []bool{false, false, false}, GetValidData(data.Data[102]))
+}
+
+func TestMergeFieldData_validData(t *testing.T) {
+	data := &InsertData{Data: make(map[FieldID]FieldData)}
+	MergeFieldData(data, 100, &Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}})
+	assert.Empty(t, GetValidData(data.Data[100]))
+
+	MergeFieldData(data, 100, &Int64FieldData{NumRows: []int64{2}, Data: []int64{0, 4}, ValidData: []bool{false, true}})
+	assert.Equal(t, []bool{true, true, false, true}, GetValidData(data.Data[100]))
+	assert.False(t, IsRowValid(data.Data[100], 2))
+
+	MergeFieldData(data, 100, &Int64FieldData{NumRows: []int64{1}, Data: []int64{5}})
+	assert.Equal(t, []int64{1, 2, 0, 4, 5}, data.Data[100].(*Int64FieldData).Data)
+	assert.Equal(t, []bool{true, true, false, true, true}, GetValidData(data.Data[100]))
+	assert.True(t, IsRowValid(data.Data[100], 4))
+
+	// vector field data has no valid data
+	assert.Nil(t, GetValidData(&FloatVectorFieldData{Data: []float32{1, 2}, Dim: 2}))
+	assert.True(t, IsRowValid(&FloatVectorFieldData{Data: []float32{1, 2}, Dim: 2}, 0))
+}
+
 func TestMergeInsertData(t *testing.T) {
 	d1 := &InsertData{
 		Data: map[int64]FieldData{
@@ -1089,6 +1191,28 @@ func Test_stringFieldDataToBytes(t *testing.T) {
 	assert.ElementsMatch(t, field.Data, arr.Data)
 }
 
+func Test_sparseFloatVectorFieldDataToBytes(t *testing.T) {
+	field := &SparseFloatVectorFieldData{}
+	field.AppendRow(typeutil.CreateSparseFloatRow([]uint32{1, 10}, []float32{0.1, 0.2}))
+	field.AppendRow(typeutil.CreateSparseFloatRow([]uint32{5}, []float32{0.3}))
+	assert.Equal(t, int64(11), field.Dim)
+	assert.Equal(t, 2, field.RowNum())
+
+	bs, err := FieldDataToBytes(common.Endian, field)
+	assert.NoError(t, err)
+	var arr schemapb.SparseFloatArray
+	err = proto.Unmarshal(bs, &arr)
+	assert.NoError(t, err)
+	assert.Equal(t, field.Contents, arr.Contents)
+	assert.Equal(t, field.Dim, arr.Dim)
+
+	merged := &InsertData{Data: make(map[FieldID]FieldData)}
+	MergeFieldData(merged, 100, field)
+	MergeFieldData(merged, 100, field)
+	assert.Equal(t, 4, merged.Data[100].RowNum())
+	assert.Equal(t, int64(11), merged.Data[100].(*SparseFloatVectorFieldData).Dim)
+}
+
 func binaryRead(endian binary.ByteOrder, bs []byte, receiver interface{}) error {
 	reader := bytes.NewReader(bs)
 	return binary.Read(reader, endian, receiver)
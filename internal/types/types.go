@@ -68,6 +68,8 @@ type DataNode interface {
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 	// Compaction will add a compaction task according to the request plan
 	Compaction(ctx context.Context, req *datapb.CompactionPlan) (*commonpb.Status, error)
+	// StopCompaction stops the executing compaction tasks of the given plans
+	StopCompaction(ctx context.Context, req *datapb.StopCompactionRequest) (*commonpb.Status, error)
 
 	// Import data files(json, numpy, etc.) on MinIO/S3 storage, read and parse them into sealed segments
 	//
@@ -84,6 +86,9 @@ type DataNode interface {
 
 	// AddSegment puts the given segment to current DataNode's flow graph.
 	AddSegment(ctx context.Context, req *datapb.AddSegmentRequest) (*commonpb.Status, error)
+
+	// CheckPrimaryKeys returns the primary keys which may exist in the segments of the collection served by the DataNode.
+	CheckPrimaryKeys(ctx context.Context, req *datapb.CheckPrimaryKeysRequest) (*datapb.CheckPrimaryKeysResponse, error)
 }
 
 // DataNodeComponent is used by grpc server of DataNode
@@ -253,6 +258,10 @@ type DataCoord interface {
 	GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
 	// GetCompactionStateWithPlans get the state of requested plan id
 	GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
+	// GetCompactionPolicyStats lists the flushed segments of a collection and why they are selected by compaction policy or not
+	GetCompactionPolicyStats(ctx context.Context, req *milvuspb.GetCompactionPolicyStatsRequest) (*milvuspb.GetCompactionPolicyStatsResponse, error)
+	// CancelCompaction cancels the pending and executing plans of a manual compaction
+	CancelCompaction(ctx context.Context, req *milvuspb.CancelCompactionRequest) (*commonpb.Status, error)
 
 	// WatchChannels notifies DataCoord to watch vchannels of a collection
 	WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error)
@@ -291,6 +300,26 @@ type DataCoord interface {
 	// AddSegment looks for the right DataNode given channel name, and triggers AddSegment call on that DataNode to
 	// add the segment into this DataNode.
 	AddSegment(ctx context.Context, req *datapb.AddSegmentRequest) (*commonpb.Status, error)
+
+	// TruncateSegments marks all the segments of a collection or partition dropped.
+	//
+	// The `AllocatedUntil` in response is nonzero if nothing is truncated since the segments may still
+	// receive the inserts no later than `truncate_ts`, the caller should retry with a later timestamp.
+	TruncateSegments(ctx context.Context, req *datapb.TruncateSegmentsRequest) (*datapb.TruncateSegmentsResponse, error)
+
+	// CreateSnapshot pins the segments holding the data of a collection by now as a named snapshot,
+	// compaction and garbage collection leave the pinned segments alone until the snapshot is dropped.
+	CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) (*datapb.CreateSnapshotResponse, error)
+
+	// DropSnapshot drops a snapshot and unpins its segments.
+	DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest) (*commonpb.Status, error)
+
+	// ListSnapshots returns the snapshots of a collection.
+	ListSnapshots(ctx context.Context, req *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error)
+
+	// CheckPrimaryKeys returns the primary keys which may exist in the collection,
+	// checked by the DataNodes against the bloom filters of the segments of the channels they serve.
+	CheckPrimaryKeys(ctx context.Context, req *datapb.CheckPrimaryKeysRequest) (*datapb.CheckPrimaryKeysResponse, error)
 }
 
 // DataCoordComponent defines the interface of DataCoord component.
@@ -456,6 +485,18 @@ type RootCoord interface {
 	// Default partition cannot be dropped
 	DropPartition(ctx context.Context, req *milvuspb.DropPartitionRequest) (*commonpb.Status, error)
 
+	// TruncatePartition notifies RootCoord to remove all the entities of a partition
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(not used), collection name and partition name
+	//
+	// The `ErrorCode` of `Status` is `Success` if truncate partition successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	//
+	// RootCoord asks DataCoord to mark all the segments of the partition dropped, the partition itself is kept
+	TruncatePartition(ctx context.Context, req *milvuspb.TruncatePartitionRequest) (*commonpb.Status, error)
+
 	// HasPartition notifies RootCoord to check if a partition with specified name exists in the collection
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -543,6 +584,52 @@ type RootCoord interface {
 	// error is always nil
 	AlterAlias(ctx context.Context, req *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
 
+	// AlterShards notifies RootCoord to increase the shards number of the collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including collection name and new shards number
+	//
+	// The `ErrorCode` of `Status` is `Success` if alter shards successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AlterShards(ctx context.Context, req *milvuspb.AlterShardsRequest) (*commonpb.Status, error)
+
+	// TruncateCollection notifies RootCoord to remove all the entities of a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(not used) and collection name
+	//
+	// The `ErrorCode` of `Status` is `Success` if truncate collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	//
+	// The schema, indexes, aliases and load state of the collection are kept
+	TruncateCollection(ctx context.Context, req *milvuspb.TruncateCollectionRequest) (*commonpb.Status, error)
+
+	// AddField notifies RootCoord to add a field to a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(not used), collection name and field schema
+	//
+	// The `ErrorCode` of `Status` is `Success` if add field successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	//
+	// The field must be a nullable or default valued scalar field, the schema version of the collection is increased
+	AddField(ctx context.Context, req *milvuspb.AddFieldRequest) (*commonpb.Status, error)
+
+	// DropField notifies RootCoord to drop a field from a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(not used), collection name and field name
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop field successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	//
+	// Primary key, vector, system fields and fields with index can't be dropped
+	DropField(ctx context.Context, req *milvuspb.DropFieldRequest) (*commonpb.Status, error)
+
 	// AllocTimestamp notifies RootCoord to alloc timestamps
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -695,6 +782,9 @@ type RootCoord interface {
 	OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)
 	SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
 	ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error)
+	CreateRowPolicy(ctx context.Context, req *milvuspb.CreateRowPolicyRequest) (*commonpb.Status, error)
+	DropRowPolicy(ctx context.Context, req *milvuspb.DropRowPolicyRequest) (*commonpb.Status, error)
+	ListRowPolicies(ctx context.Context, req *milvuspb.ListRowPoliciesRequest) (*milvuspb.ListRowPoliciesResponse, error)
 }
 
 // RootCoordComponent is used by grpc server of RootCoord
@@ -918,6 +1008,16 @@ type ProxyComponent interface {
 	// error is always nil
 	DropPartition(ctx context.Context, request *milvuspb.DropPartitionRequest) (*commonpb.Status, error)
 
+	// TruncatePartition notifies Proxy to remove all the entities of a partition
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name, partition name
+	//
+	// The `ErrorCode` of `Status` is `Success` if truncate partition successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	TruncatePartition(ctx context.Context, request *milvuspb.TruncatePartitionRequest) (*commonpb.Status, error)
+
 	// HasPartition notifies Proxy to check a partition's existence
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -1046,6 +1146,24 @@ type ProxyComponent interface {
 	// error is always nil
 	Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error)
 
+	// DeleteByExpr notifies Proxy to delete the entities matching any filter expression in the background
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name, partition name(optional), filter expression
+	//
+	// The `Status` in response struct `DeleteByExprResponse` indicates if the job is submitted successfully or fail cause;
+	// the `JobID` is used to get the progress of the job by GetDeleteJobState.
+	// error is always nil
+	DeleteByExpr(ctx context.Context, request *milvuspb.DeleteByExprRequest) (*milvuspb.DeleteByExprResponse, error)
+
+	// GetDeleteJobState notifies Proxy to return the state and the number of deleted entities of a delete job
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the job id
+	//
+	// error is always nil
+	GetDeleteJobState(ctx context.Context, request *milvuspb.GetDeleteJobStateRequest) (*milvuspb.GetDeleteJobStateResponse, error)
+
 	// Search notifies Proxy to do search
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -1075,6 +1193,22 @@ type ProxyComponent interface {
 	// error is always nil
 	Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error)
 
+	// SearchStream notifies Proxy to search vectors and send the results in chunks of queries
+	//
+	// ctx is the context to control request deadline and cancellation
+	// send is called for each chunk in the order of the queries, the last chunk carries the status only
+	//
+	// The error is returned only if the chunks fail to be sent
+	SearchStream(ctx context.Context, request *milvuspb.SearchRequest, send func(*milvuspb.SearchResults) error) error
+
+	// QueryStream notifies Proxy to query entities and send the results in chunks as the shards complete
+	//
+	// ctx is the context to control request deadline and cancellation
+	// send is called for each chunk, the last chunk carries the status only
+	//
+	// The error is returned only if the chunks fail to be sent
+	QueryStream(ctx context.Context, request *milvuspb.QueryRequest, send func(*milvuspb.QueryResults) error) error
+
 	// CalcDistance notifies Proxy to calculate distance between specified vectors
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -1161,9 +1295,84 @@ type ProxyComponent interface {
 	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
 	// error is always nil
 	AlterAlias(ctx context.Context, request *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
+
+	// AlterShards notifies Proxy to increase the shards number of a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name, shards number
+	//
+	// The `ErrorCode` of `Status` is `Success` if alter shards successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AlterShards(ctx context.Context, request *milvuspb.AlterShardsRequest) (*commonpb.Status, error)
+
+	// TruncateCollection notifies Proxy to remove all the entities of a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name
+	//
+	// The `ErrorCode` of `Status` is `Success` if truncate collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	TruncateCollection(ctx context.Context, request *milvuspb.TruncateCollectionRequest) (*commonpb.Status, error)
+
+	// AddField notifies Proxy to add a field to a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name and field schema
+	//
+	// The `ErrorCode` of `Status` is `Success` if add field successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error)
+
+	// DropField notifies Proxy to drop a field from a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name and field name
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop field successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DropField(ctx context.Context, request *milvuspb.DropFieldRequest) (*commonpb.Status, error)
+
+	// CreateSnapshot notifies Proxy to pin the current state of a collection as a named snapshot
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name and snapshot name
+	//
+	// The `ErrorCode` of `Status` is `Success` if create snapshot successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateSnapshot(ctx context.Context, request *milvuspb.CreateSnapshotRequest) (*commonpb.Status, error)
+
+	// DropSnapshot notifies Proxy to drop a snapshot of a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name and snapshot name
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop snapshot successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DropSnapshot(ctx context.Context, request *milvuspb.DropSnapshotRequest) (*commonpb.Status, error)
+
+	// ListSnapshots notifies Proxy to list the snapshots of a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved) and collection name
+	//
+	// The `Status` in response struct `ListSnapshotsResponse` indicates if this operation is processed successfully or fail cause;
+	// the `Snapshots` in `ListSnapshotsResponse` are the snapshots of the collection sorted by name.
+	// error is always nil
+	ListSnapshots(ctx context.Context, request *milvuspb.ListSnapshotsRequest) (*milvuspb.ListSnapshotsResponse, error)
+
 	GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
 	ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
 	GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
+	// GetCompactionPolicyStats gets the compaction policy statistics of segments of a collection
+	GetCompactionPolicyStats(ctx context.Context, req *milvuspb.GetCompactionPolicyStatsRequest) (*milvuspb.GetCompactionPolicyStatsResponse, error)
+	// CancelCompaction cancels a compaction
+	CancelCompaction(ctx context.Context, req *milvuspb.CancelCompactionRequest) (*commonpb.Status, error)
 	// GetFlushState gets the flush state of multiple segments
 	GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)
 
@@ -1215,6 +1424,9 @@ type ProxyComponent interface {
 	SelectUser(ctx context.Context, req *milvuspb.SelectUserRequest) (*milvuspb.SelectUserResponse, error)
 	OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)
 	SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
+	CreateRowPolicy(ctx context.Context, req *milvuspb.CreateRowPolicyRequest) (*commonpb.Status, error)
+	DropRowPolicy(ctx context.Context, req *milvuspb.DropRowPolicyRequest) (*commonpb.Status, error)
+	ListRowPolicies(ctx context.Context, req *milvuspb.ListRowPoliciesRequest) (*milvuspb.ListRowPoliciesResponse, error)
 }
 
 // QueryNode is the interface `querynode` package implements
@@ -1273,6 +1485,8 @@ type QueryCoord interface {
 	GetPartitionStates(ctx context.Context, req *querypb.GetPartitionStatesRequest) (*querypb.GetPartitionStatesResponse, error)
 	GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
 	LoadBalance(ctx context.Context, req *querypb.LoadBalanceRequest) (*commonpb.Status, error)
+	AlterShards(ctx context.Context, req *querypb.AlterShardsRequest) (*commonpb.Status, error)
+	TruncateSegments(ctx context.Context, req *querypb.TruncateSegmentsRequest) (*commonpb.Status, error)
 
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 
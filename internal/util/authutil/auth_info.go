@@ -0,0 +1,53 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authutil
+
+import "context"
+
+// AuthInfoKey is the key of the AuthInfo in the contexts only supporting string keys, like gin.Context
+const AuthInfoKey = "milvus-auth-info"
+
+type authInfoCtxKey struct{}
+
+// AuthInfo is the identity of an authenticated request
+type AuthInfo struct {
+	User string
+	// Roles are granted by the credential, the requests authenticated by the api keys or the JWTs are scoped to them
+	// instead of the roles granted to the user in milvus
+	Roles []string
+	// Method is the name of the authenticator accepting the credential
+	Method string
+}
+
+// ContextWithAuthInfo returns a copy of ctx carrying info
+func ContextWithAuthInfo(ctx context.Context, info *AuthInfo) context.Context {
+	return context.WithValue(ctx, authInfoCtxKey{}, info)
+}
+
+// AuthInfoFromContext returns the AuthInfo carried by ctx
+func AuthInfoFromContext(ctx context.Context) (*AuthInfo, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	if info, ok := ctx.Value(authInfoCtxKey{}).(*AuthInfo); ok && info != nil {
+		return info, true
+	}
+	if info, ok := ctx.Value(AuthInfoKey).(*AuthInfo); ok && info != nil {
+		return info, true
+	}
+	return nil, false
+}
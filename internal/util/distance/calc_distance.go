@@ -20,6 +20,8 @@ import (
 	"errors"
 	"strings"
 	"sync"
+
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 const (
@@ -148,6 +150,69 @@ func CalcFloatDistance(dim int64, left, right []float32, metric string) ([]float
 	return distArray, nil
 }
 
+// ValidateHalfFloatArrayLength is used validate float16 or bfloat16 vector length, 2 bytes per dimension
+func ValidateHalfFloatArrayLength(dim int64, length int) error {
+	if dim <= 0 {
+		return errors.New("invalid dimension")
+	}
+	if length%2 != 0 || int64(length/2)%dim != 0 {
+		return errors.New("vector length not matching dimension")
+	}
+	return nil
+}
+
+// CalcFloat16Distance calculate float16 vector distance by given metric,
+// the vectors are converted to float32 and the distance is calculated by CalcFloatDistance
+func CalcFloat16Distance(dim int64, left, right []byte, metric string) ([]float32, error) {
+	for _, vectors := range [][]byte{left, right} {
+		if err := ValidateHalfFloatArrayLength(dim, len(vectors)); err != nil {
+			return nil, err
+		}
+	}
+	return CalcFloatDistance(dim, typeutil.Float16BytesToFloat32Array(left), typeutil.Float16BytesToFloat32Array(right), metric)
+}
+
+// CalcBFloat16Distance calculate bfloat16 vector distance by given metric,
+// the vectors are converted to float32 and the distance is calculated by CalcFloatDistance
+func CalcBFloat16Distance(dim int64, left, right []byte, metric string) ([]float32, error) {
+	for _, vectors := range [][]byte{left, right} {
+		if err := ValidateHalfFloatArrayLength(dim, len(vectors)); err != nil {
+			return nil, err
+		}
+	}
+	return CalcFloatDistance(dim, typeutil.BFloat16BytesToFloat32Array(left), typeutil.BFloat16BytesToFloat32Array(right), metric)
+}
+
+// CalcSparseFloatDistance calculate the distance of sparse float vectors by given metric,
+// each vector is a sparse float row, only IP is supported for sparse float vector
+func CalcSparseFloatDistance(left, right [][]byte, metric string) ([]float32, error) {
+	metricUpper := strings.ToUpper(metric)
+	if metricUpper != IP {
+		err := errors.New("invalid metric type, only IP is supported for sparse float vector")
+		return nil, err
+	}
+
+	if len(left) == 0 || len(right) == 0 {
+		err := errors.New("sparse float vectors are empty")
+		return nil, err
+	}
+
+	for _, rows := range [][][]byte{left, right} {
+		if err := typeutil.ValidateSparseFloatRows(rows...); err != nil {
+			return nil, err
+		}
+	}
+
+	distArray := make([]float32, len(left)*len(right))
+	for i := range left {
+		for j := range right {
+			distArray[i*len(right)+j] = typeutil.SparseFloatRowInnerProduct(left[i], right[j])
+		}
+	}
+
+	return distArray, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 // SingleBitLen returns the bit length of @dim
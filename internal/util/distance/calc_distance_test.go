@@ -22,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -187,6 +188,61 @@ func CreateBinaryArray(n, dim int64) []byte {
 	return array
 }
 
+func Test_CalcSparseFloatDistance(t *testing.T) {
+	left := [][]byte{
+		typeutil.CreateSparseFloatRow([]uint32{1, 3}, []float32{1.0, 2.0}),
+		typeutil.CreateSparseFloatRow([]uint32{2}, []float32{3.0}),
+	}
+	right := [][]byte{
+		typeutil.CreateSparseFloatRow([]uint32{1, 2, 3}, []float32{4.0, 5.0, 6.0}),
+		typeutil.CreateSparseFloatRow([]uint32{100}, []float32{7.0}),
+		typeutil.CreateSparseFloatRow(nil, nil),
+	}
+
+	distances, err := CalcSparseFloatDistance(left, right, "ip")
+	assert.Nil(t, err)
+	assert.Equal(t, []float32{16.0, 0, 0, 15.0, 0, 0}, distances)
+
+	_, err = CalcSparseFloatDistance(left, right, L2)
+	assert.NotNil(t, err)
+
+	_, err = CalcSparseFloatDistance(nil, right, IP)
+	assert.NotNil(t, err)
+
+	invalid := [][]byte{typeutil.CreateSparseFloatRow([]uint32{1, 1}, []float32{1.0, 2.0})}
+	_, err = CalcSparseFloatDistance(left, invalid, IP)
+	assert.NotNil(t, err)
+}
+
+func Test_CalcHalfFloatDistance(t *testing.T) {
+	dim := int64(2)
+	left := []float32{1, 2, 3, 4}
+	right := []float32{0.5, -1}
+
+	expectL2, err := CalcFloatDistance(dim, left, right, L2)
+	assert.Nil(t, err)
+	expectIP, err := CalcFloatDistance(dim, left, right, IP)
+	assert.Nil(t, err)
+
+	// all values are exactly representable in float16 and bfloat16
+	distances, err := CalcFloat16Distance(dim, typeutil.Float32ArrayToFloat16Bytes(left), typeutil.Float32ArrayToFloat16Bytes(right), L2)
+	assert.Nil(t, err)
+	assert.Equal(t, expectL2, distances)
+
+	distances, err = CalcBFloat16Distance(dim, typeutil.Float32ArrayToBFloat16Bytes(left), typeutil.Float32ArrayToBFloat16Bytes(right), IP)
+	assert.Nil(t, err)
+	assert.Equal(t, expectIP, distances)
+
+	_, err = CalcFloat16Distance(dim, []byte{1, 2, 3}, typeutil.Float32ArrayToFloat16Bytes(right), L2)
+	assert.NotNil(t, err)
+
+	_, err = CalcBFloat16Distance(0, typeutil.Float32ArrayToBFloat16Bytes(left), typeutil.Float32ArrayToBFloat16Bytes(right), L2)
+	assert.NotNil(t, err)
+
+	_, err = CalcFloat16Distance(dim, typeutil.Float32ArrayToFloat16Bytes(left), typeutil.Float32ArrayToFloat16Bytes(right), HAMMING)
+	assert.NotNil(t, err)
+}
+
 func Test_SingleBitLen(t *testing.T) {
 	n := SingleBitLen(125)
 	assert.Equal(t, n, int64(128))
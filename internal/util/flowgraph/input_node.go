@@ -22,6 +22,8 @@ import (
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/opentracing/opentracing-go"
 	oplog "github.com/opentracing/opentracing-go/log"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -73,10 +75,17 @@ func (inNode *InputNode) Operate(in []Msg) []Msg {
 		return nil
 	}
 	var spans []opentracing.Span
+	var otelSpans []oteltrace.Span
 	for _, msg := range msgPack.Msgs {
 		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 		sp.LogFields(oplog.String("input_node name", inNode.Name()))
 		spans = append(spans, sp)
+		if ctx != nil {
+			var otelSpan oteltrace.Span
+			ctx, otelSpan = trace.StartOtelSpan(ctx, "flowgraph input", attribute.String("node", inNode.Name()),
+				attribute.String("msgType", msg.Type().String()))
+			otelSpans = append(otelSpans, otelSpan)
+		}
 		msg.SetTraceCtx(ctx)
 	}
 
@@ -91,6 +100,9 @@ func (inNode *InputNode) Operate(in []Msg) []Msg {
 	for _, span := range spans {
 		span.Finish()
 	}
+	for _, span := range otelSpans {
+		span.End()
+	}
 
 	return []Msg{msgStreamMsg}
 }
@@ -42,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	grpcStatus "google.golang.org/grpc/status"
 )
@@ -188,7 +189,7 @@ func CheckCtxValid(ctx context.Context) bool {
 func GetVecFieldIDs(schema *schemapb.CollectionSchema) []int64 {
 	var vecFieldIDs []int64
 	for _, field := range schema.Fields {
-		if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
+		if typeutil.IsVectorType(field.DataType) {
 			vecFieldIDs = append(vecFieldIDs, field.FieldID)
 		}
 	}
@@ -294,6 +295,17 @@ func getNumRowsOfBinaryVectorField(bDatas []byte, dim int64) (uint64, error) {
 	return uint64((8 * int64(l)) / dim), nil
 }
 
+func getNumRowsOfHalfFloatVectorField(bDatas []byte, dim int64) (uint64, error) {
+	if dim <= 0 {
+		return 0, fmt.Errorf("dim(%d) should be greater than 0", dim)
+	}
+	l := len(bDatas)
+	if int64(l)%(2*dim) != 0 {
+		return 0, fmt.Errorf("the length(%d) of half float data should divide twice the dim(%d)", l, dim)
+	}
+	return uint64(int64(l) / (2 * dim)), nil
+}
+
 // GetNumRowOfFieldData return num rows of the field data
 func GetNumRowOfFieldData(fieldData *schemapb.FieldData) (uint64, error) {
 	var fieldNumRows uint64
@@ -332,6 +344,20 @@ func GetNumRowOfFieldData(fieldData *schemapb.FieldData) (uint64, error) {
 			if err != nil {
 				return 0, err
 			}
+		case *schemapb.VectorField_Float16Vector:
+			dim := vectorField.GetDim()
+			fieldNumRows, err = getNumRowsOfHalfFloatVectorField(vectorField.GetFloat16Vector(), dim)
+			if err != nil {
+				return 0, err
+			}
+		case *schemapb.VectorField_Bfloat16Vector:
+			dim := vectorField.GetDim()
+			fieldNumRows, err = getNumRowsOfHalfFloatVectorField(vectorField.GetBfloat16Vector(), dim)
+			if err != nil {
+				return 0, err
+			}
+		case *schemapb.VectorField_SparseFloatVector:
+			fieldNumRows = uint64(len(vectorField.GetSparseFloatVector().GetContents()))
 		default:
 			return 0, fmt.Errorf("%s is not supported now", vectorFieldType)
 		}
@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/stretchr/testify/assert"
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
@@ -405,6 +406,45 @@ func TestGetNumRowsOfBinaryVectorField(t *testing.T) {
 	}
 }
 
+func TestGetNumRowsOfHalfFloatVectorField(t *testing.T) {
+	cases := []struct {
+		bDatas   []byte
+		dim      int64
+		want     uint64
+		errIsNil bool
+	}{
+		{[]byte{}, -1, 0, false},    // dim <= 0
+		{[]byte{}, 0, 0, false},     // dim <= 0
+		{[]byte{1, 2}, 2, 0, false}, // length % (2*dim) != 0
+		{[]byte{}, 128, 0, true},
+		{[]byte{1, 2}, 1, 1, true},
+		{[]byte{1, 2, 3, 4}, 1, 2, true},
+		{[]byte{1, 2, 3, 4}, 2, 1, true},
+	}
+
+	for _, test := range cases {
+		got, err := getNumRowsOfHalfFloatVectorField(test.bDatas, test.dim)
+		if test.errIsNil {
+			assert.Equal(t, nil, err)
+			assert.Equal(t, test.want, got)
+		} else {
+			assert.NotEqual(t, nil, err)
+		}
+	}
+
+	fieldData := &schemapb.FieldData{
+		Field: &schemapb.FieldData_Vectors{
+			Vectors: &schemapb.VectorField{
+				Dim:  2,
+				Data: &schemapb.VectorField_Bfloat16Vector{Bfloat16Vector: make([]byte, 12)},
+			},
+		},
+	}
+	rows, err := GetNumRowOfFieldData(fieldData)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), rows)
+}
+
 func Test_ReadBinary(t *testing.T) {
 	// TODO: test big endian.
 	// low byte in high address, high byte in low address.
@@ -1,6 +1,7 @@
 package funcutil
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/golang/protobuf/descriptor"
@@ -98,3 +99,24 @@ func PolicyForPrivilege(roleName string, objectType string, objectName string, p
 func PolicyForResource(objectType string, objectName string) string {
 	return fmt.Sprintf("%s-%s", objectType, objectName)
 }
+
+// EncodeRowPolicyCache encodes the row policy as the key of the policy cache operations
+func EncodeRowPolicyCache(entity *milvuspb.RowPolicyEntity) (string, error) {
+	b, err := json.Marshal(entity)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// DecodeRowPolicyCache decodes the row policy from the key of the policy cache operations
+func DecodeRowPolicyCache(cache string) (*milvuspb.RowPolicyEntity, error) {
+	entity := &milvuspb.RowPolicyEntity{}
+	if err := json.Unmarshal([]byte(cache), entity); err != nil {
+		return nil, fmt.Errorf("invalid row policy cache: [%s], err: %w", cache, err)
+	}
+	if entity.GetRole().GetName() == "" || entity.GetCollectionName() == "" || entity.GetPolicyName() == "" {
+		return nil, fmt.Errorf("invalid row policy cache: [%s]", cache)
+	}
+	return entity, nil
+}
@@ -87,3 +87,25 @@ func Test_PolicyForResource(t *testing.T) {
 		`COLLECTION-col1`,
 		PolicyForResource("COLLECTION", "col1"))
 }
+
+func Test_RowPolicyCache(t *testing.T) {
+	entity := &milvuspb.RowPolicyEntity{
+		Role:           &milvuspb.RoleEntity{Name: "tenant_a"},
+		CollectionName: "col1",
+		PolicyName:     "p1",
+		Expr:           `tenant == "a" && x/y in ["1"]`,
+	}
+	cache, err := EncodeRowPolicyCache(entity)
+	assert.NoError(t, err)
+	decoded, err := DecodeRowPolicyCache(cache)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.Role.Name, decoded.Role.Name)
+	assert.Equal(t, entity.CollectionName, decoded.CollectionName)
+	assert.Equal(t, entity.PolicyName, decoded.PolicyName)
+	assert.Equal(t, entity.Expr, decoded.Expr)
+
+	_, err = DecodeRowPolicyCache("invalid")
+	assert.Error(t, err)
+	_, err = DecodeRowPolicyCache(`{"collection_name":"col1"}`)
+	assert.Error(t, err)
+}
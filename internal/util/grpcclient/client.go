@@ -31,6 +31,8 @@ import (
 	grpcopentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tlsutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -70,6 +72,8 @@ type ClientBase struct {
 	InitialBackoff    float32
 	MaxBackoff        float32
 	BackoffMultiplier float32
+
+	InternalTLS paramtable.InternalTLSConfig
 }
 
 // SetRole sets role of client
@@ -141,6 +145,13 @@ func (c *ClientBase) connect(ctx context.Context) error {
 		return err
 	}
 
+	// the credentials are created for every connection, so that the rotated CA is taken
+	creds, err := tlsutil.InternalClientCredentials(c.InternalTLS)
+	if err != nil {
+		log.Error("failed to load internal tls credentials", zap.Error(err))
+		return err
+	}
+
 	opts := trace.GetInterceptorOpts()
 	dialContext, cancel := context.WithTimeout(ctx, c.DialTimeout)
 
@@ -160,7 +171,7 @@ func (c *ClientBase) connect(ctx context.Context) error {
 	conn, err := grpc.DialContext(
 		dialContext,
 		addr,
-		grpc.WithInsecure(),
+		grpc.WithTransportCredentials(creds),
 		grpc.WithBlock(),
 		grpc.WithDefaultCallOptions(
 			grpc.MaxCallRecvMsgSize(c.ClientMaxRecvSize),
@@ -168,6 +179,8 @@ func (c *ClientBase) connect(ctx context.Context) error {
 		),
 		grpc.WithUnaryInterceptor(grpcopentracing.UnaryClientInterceptor(opts...)),
 		grpc.WithStreamInterceptor(grpcopentracing.StreamClientInterceptor(opts...)),
+		grpc.WithChainUnaryInterceptor(trace.OtelUnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(trace.OtelStreamClientInterceptor()),
 		grpc.WithDefaultServiceConfig(retryPolicy),
 		grpc.WithKeepaliveParams(keepalive.ClientParameters{
 			Time:                c.KeepAliveTime,
@@ -447,6 +447,27 @@ func (p *ImportWrapper) appendFunc(schema *schemapb.FieldSchema) func(src storag
 			arr.NumRows[0]++
 			return nil
 		}
+	case schemapb.DataType_Float16Vector:
+		return func(src storage.FieldData, n int, target storage.FieldData) error {
+			arr := target.(*storage.Float16VectorFieldData)
+			arr.Data = append(arr.Data, src.GetRow(n).([]byte)...)
+			arr.NumRows[0]++
+			return nil
+		}
+	case schemapb.DataType_BFloat16Vector:
+		return func(src storage.FieldData, n int, target storage.FieldData) error {
+			arr := target.(*storage.BFloat16VectorFieldData)
+			arr.Data = append(arr.Data, src.GetRow(n).([]byte)...)
+			arr.NumRows[0]++
+			return nil
+		}
+	case schemapb.DataType_SparseFloatVector:
+		return func(src storage.FieldData, n int, target storage.FieldData) error {
+			arr := target.(*storage.SparseFloatVectorFieldData)
+			arr.AppendRow(src.GetRow(n).([]byte))
+			arr.NumRows[0]++
+			return nil
+		}
 	case schemapb.DataType_String, schemapb.DataType_VarChar:
 		return func(src storage.FieldData, n int, target storage.FieldData) error {
 			arr := target.(*storage.StringFieldData)
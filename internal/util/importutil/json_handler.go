@@ -3,6 +3,7 @@ package importutil
 import (
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 
 	"go.uber.org/zap"
@@ -41,6 +42,74 @@ func getFieldDimension(schema *schemapb.FieldSchema) (int, error) {
 	return 0, errors.New("vector dimension is not defined")
 }
 
+// method to parse a sparse float vector row, two formats are accepted:
+// 1. a dict of index to value, for example: {"1": 0.5, "100": 0.3}
+// 2. a dict of indices and values lists, for example: {"indices": [1, 100], "values": [0.5, 0.3]}
+func parseSparseFloatRow(obj interface{}) ([]byte, error) {
+	dict, ok := obj.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%v is not a dict for sparse float vector", obj)
+	}
+
+	toIndex := func(v float64) (uint32, error) {
+		if v < 0 || v > math.MaxUint32 || v != math.Trunc(v) {
+			return 0, fmt.Errorf("illegal index %v for sparse float vector", v)
+		}
+		return uint32(v), nil
+	}
+
+	indices := make([]uint32, 0, len(dict))
+	values := make([]float32, 0, len(dict))
+	rawIndices, hasIndices := dict["indices"]
+	rawValues, hasValues := dict["values"]
+	if hasIndices && hasValues && len(dict) == 2 {
+		indexArr, ok1 := rawIndices.([]interface{})
+		valueArr, ok2 := rawValues.([]interface{})
+		if !ok1 || !ok2 {
+			return nil, errors.New("indices and values of sparse float vector should be arrays")
+		}
+		if len(indexArr) != len(valueArr) {
+			return nil, fmt.Errorf("length of indices %d doesn't equal to length of values %d for sparse float vector", len(indexArr), len(valueArr))
+		}
+		for i := range indexArr {
+			idx, ok1 := indexArr[i].(float64)
+			val, ok2 := valueArr[i].(float64)
+			if !ok1 || !ok2 {
+				return nil, fmt.Errorf("illegal element (%v, %v) for sparse float vector", indexArr[i], valueArr[i])
+			}
+			index, err := toIndex(idx)
+			if err != nil {
+				return nil, err
+			}
+			indices = append(indices, index)
+			values = append(values, float32(val))
+		}
+	} else {
+		for k, v := range dict {
+			idx, err := strconv.ParseFloat(k, 64)
+			if err != nil {
+				return nil, fmt.Errorf("illegal index %s for sparse float vector", k)
+			}
+			index, err := toIndex(idx)
+			if err != nil {
+				return nil, err
+			}
+			val, ok := v.(float64)
+			if !ok {
+				return nil, fmt.Errorf("illegal value %v for sparse float vector", v)
+			}
+			indices = append(indices, index)
+			values = append(values, float32(val))
+		}
+	}
+
+	row := typeutil.CreateSparseFloatRow(indices, values)
+	if err := typeutil.ValidateSparseFloatRow(row); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
 // field value validator
 type Validator struct {
 	validateFunc func(obj interface{}) error                          // validate data type function
@@ -228,6 +297,69 @@ func initValidators(collectionSchema *schemapb.CollectionSchema, validators map[
 				field.(*storage.FloatVectorFieldData).NumRows[0]++
 				return nil
 			}
+		case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+			dim, err := getFieldDimension(schema)
+			if err != nil {
+				return err
+			}
+			validators[schema.GetFieldID()].dimension = dim
+
+			dataType := schema.GetDataType()
+			validators[schema.GetFieldID()].validateFunc = func(obj interface{}) error {
+				switch vt := obj.(type) {
+				case []interface{}:
+					if len(vt) != dim {
+						msg := "array size " + strconv.Itoa(len(vt)) + " doesn't equal to vector dimension " + strconv.Itoa(dim) + " of field " + schema.GetName()
+						return errors.New(msg)
+					}
+					for i := 0; i < len(vt); i++ {
+						if e := numericValidator(vt[i]); e != nil {
+							msg := e.Error() + " for " + dataType.String() + " field " + schema.GetName()
+							return errors.New(msg)
+						}
+					}
+					return nil
+				default:
+					s := fmt.Sprintf("%v", obj)
+					msg := s + " is not an array for " + dataType.String() + " field " + schema.GetName()
+					return errors.New(msg)
+				}
+			}
+
+			validators[schema.GetFieldID()].convertFunc = func(obj interface{}, field storage.FieldData) error {
+				arr := obj.([]interface{})
+				values := make([]float32, 0, len(arr))
+				for i := 0; i < len(arr); i++ {
+					values = append(values, float32(arr[i].(float64)))
+				}
+				if dataType == schemapb.DataType_Float16Vector {
+					vec := field.(*storage.Float16VectorFieldData)
+					vec.Data = append(vec.Data, typeutil.Float32ArrayToFloat16Bytes(values)...)
+					vec.NumRows[0]++
+				} else {
+					vec := field.(*storage.BFloat16VectorFieldData)
+					vec.Data = append(vec.Data, typeutil.Float32ArrayToBFloat16Bytes(values)...)
+					vec.NumRows[0]++
+				}
+				return nil
+			}
+		case schemapb.DataType_SparseFloatVector:
+			validators[schema.GetFieldID()].validateFunc = func(obj interface{}) error {
+				if _, err := parseSparseFloatRow(obj); err != nil {
+					return fmt.Errorf("%s of field %s", err.Error(), schema.GetName())
+				}
+				return nil
+			}
+
+			validators[schema.GetFieldID()].convertFunc = func(obj interface{}, field storage.FieldData) error {
+				row, err := parseSparseFloatRow(obj)
+				if err != nil {
+					return err
+				}
+				field.(*storage.SparseFloatVectorFieldData).AppendRow(row)
+				field.(*storage.SparseFloatVectorFieldData).NumRows[0]++
+				return nil
+			}
 		case schemapb.DataType_String, schemapb.DataType_VarChar:
 			validators[schema.GetFieldID()].isString = true
 			validators[schema.GetFieldID()].validateFunc = func(obj interface{}) error {
@@ -468,6 +600,25 @@ func initSegmentData(collectionSchema *schemapb.CollectionSchema) map[storage.Fi
 				NumRows: []int64{0},
 				Dim:     dim,
 			}
+		case schemapb.DataType_Float16Vector:
+			dim, _ := getFieldDimension(schema)
+			segmentData[schema.GetFieldID()] = &storage.Float16VectorFieldData{
+				Data:    make([]byte, 0),
+				NumRows: []int64{0},
+				Dim:     dim,
+			}
+		case schemapb.DataType_BFloat16Vector:
+			dim, _ := getFieldDimension(schema)
+			segmentData[schema.GetFieldID()] = &storage.BFloat16VectorFieldData{
+				Data:    make([]byte, 0),
+				NumRows: []int64{0},
+				Dim:     dim,
+			}
+		case schemapb.DataType_SparseFloatVector:
+			segmentData[schema.GetFieldID()] = &storage.SparseFloatVectorFieldData{
+				Contents: make([][]byte, 0),
+				NumRows:  []int64{0},
+			}
 		case schemapb.DataType_String, schemapb.DataType_VarChar:
 			segmentData[schema.GetFieldID()] = &storage.StringFieldData{
 				Data:    make([]string, 0),
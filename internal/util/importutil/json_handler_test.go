@@ -13,6 +13,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type mockIDAllocator struct {
@@ -69,6 +70,46 @@ func Test_GetFieldDimension(t *testing.T) {
 	assert.Equal(t, 0, dim)
 }
 
+func Test_ParseSparseFloatRow(t *testing.T) {
+	expected := typeutil.CreateSparseFloatRow([]uint32{1, 100}, []float32{0.5, 0.25})
+
+	row, err := parseSparseFloatRow(map[string]interface{}{"100": 0.25, "1": 0.5})
+	assert.Nil(t, err)
+	assert.Equal(t, expected, row)
+
+	row, err = parseSparseFloatRow(map[string]interface{}{
+		"indices": []interface{}{float64(100), float64(1)},
+		"values":  []interface{}{0.25, 0.5},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, expected, row)
+
+	row, err = parseSparseFloatRow(map[string]interface{}{})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(row))
+
+	_, err = parseSparseFloatRow([]interface{}{0.5})
+	assert.NotNil(t, err)
+	_, err = parseSparseFloatRow(map[string]interface{}{"abc": 0.5})
+	assert.NotNil(t, err)
+	_, err = parseSparseFloatRow(map[string]interface{}{"-1": 0.5})
+	assert.NotNil(t, err)
+	_, err = parseSparseFloatRow(map[string]interface{}{"1.5": 0.5})
+	assert.NotNil(t, err)
+	_, err = parseSparseFloatRow(map[string]interface{}{"1": "abc"})
+	assert.NotNil(t, err)
+	_, err = parseSparseFloatRow(map[string]interface{}{
+		"indices": []interface{}{float64(1)},
+		"values":  []interface{}{0.25, 0.5},
+	})
+	assert.NotNil(t, err)
+	_, err = parseSparseFloatRow(map[string]interface{}{
+		"indices": []interface{}{float64(1), float64(1)},
+		"values":  []interface{}{0.25, 0.5},
+	})
+	assert.NotNil(t, err)
+}
+
 func Test_InitValidators(t *testing.T) {
 	validators := make(map[storage.FieldID]*Validator)
 	err := initValidators(nil, validators)
@@ -306,6 +306,37 @@ func (n *NumpyAdapter) ReadInt64(size int) ([]int64, error) {
 	return data, nil
 }
 
+// ReadFloat16 returns the raw bits of float16 values
+func (n *NumpyAdapter) ReadFloat16(size int) ([]uint16, error) {
+	if n.npyReader == nil {
+		return nil, errors.New("reader is not initialized")
+	}
+
+	// incorrect type
+	switch n.npyReader.Header.Descr.Type {
+	case "f2", "<f2", "|f2", ">f2", "float16":
+	default:
+		return nil, errors.New("numpy data is not float16 type")
+	}
+
+	// avoid read overflow
+	readSize := n.checkSize(size)
+	if readSize <= 0 {
+		return nil, errors.New("nothing to read")
+	}
+
+	data := make([]uint16, readSize)
+	err := binary.Read(n.reader, n.order, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	// update read position after successfully read
+	n.readPosition += readSize
+
+	return data, nil
+}
+
 func (n *NumpyAdapter) ReadFloat32(size int) ([]float32, error) {
 	if n.npyReader == nil {
 		return nil, errors.New("reader is not initialized")
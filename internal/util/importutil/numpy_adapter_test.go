@@ -1,6 +1,7 @@
 package importutil
 
 import (
+	"bytes"
 	"encoding/binary"
 	"io"
 	"os"
@@ -452,4 +453,29 @@ func Test_Read(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Nil(t, res)
 	}
+
+	{
+		// npyio can't write float16, patch the header of an uint16 file
+		data := []uint16{0x3c00, 0xc000, 0x3800}
+		buf, err := CreateNumpyData(data)
+		assert.Nil(t, err)
+		buf = bytes.Replace(buf, []byte("'<u2'"), []byte("'<f2'"), 1)
+
+		adapter, err := NewNumpyAdapter(bytes.NewReader(buf))
+		assert.Nil(t, err)
+		assert.Equal(t, "<f2", adapter.GetType())
+
+		res, err := adapter.ReadFloat16(len(data))
+		assert.Nil(t, err)
+		assert.Equal(t, data, res)
+
+		res, err = adapter.ReadFloat16(len(data))
+		assert.NotNil(t, err)
+		assert.Nil(t, res)
+
+		// incorrect type read
+		resf, err := adapter.ReadFloat32(len(data))
+		assert.NotNil(t, err)
+		assert.Nil(t, resf)
+	}
 }
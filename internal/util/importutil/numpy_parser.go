@@ -6,9 +6,11 @@ import (
 	"io"
 	"strconv"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type ColumnDesc struct {
@@ -49,7 +51,8 @@ func (p *NumpyParser) logError(msg string) error {
 	return errors.New(msg)
 }
 
-// data type converted from numpy header description, for vector field, the type is int8(binary vector) or float32(float vector)
+// data type converted from numpy header description, for vector field, the type is int8(binary vector), float32(float vector)
+// or float16(float16 vector)
 func convertNumpyType(str string) (schemapb.DataType, error) {
 	switch str {
 	case "b1", "<b1", "|b1", "bool":
@@ -64,6 +67,8 @@ func convertNumpyType(str string) (schemapb.DataType, error) {
 		return schemapb.DataType_Int32, nil
 	case "i8", "<i8", "|i8", ">i8", "int64":
 		return schemapb.DataType_Int64, nil
+	case "f2", "<f2", "|f2", ">f2", "float16": // float16 vector data type is float16
+		return schemapb.DataType_Float16Vector, nil
 	case "f4", "<f4", "|f4", ">f4", "float32":
 		return schemapb.DataType_Float, nil
 	case "f8", "<f8", "|f8", ">f8", "float64":
@@ -120,6 +125,29 @@ func (p *NumpyParser) validate(adapter *NumpyAdapter, fieldName string) error {
 			return err
 		}
 
+		if shape[1] != p.columnDesc.dimension {
+			return errors.New("illegal row width " + strconv.Itoa(shape[1]) + " for field " + schema.GetName() + " dimension " + strconv.Itoa(p.columnDesc.dimension))
+		}
+	} else if schemapb.DataType_Float16Vector == schema.DataType || schemapb.DataType_BFloat16Vector == schema.DataType {
+		// float16 vector accepts float16 numpy file, both of them accept float32 and float64 numpy file
+		if elementType != schemapb.DataType_Float && elementType != schemapb.DataType_Double &&
+			!(elementType == schemapb.DataType_Float16Vector && schema.DataType == schemapb.DataType_Float16Vector) {
+			return errors.New("illegal data type " + adapter.GetType() + " for field " + schema.GetName())
+		}
+
+		// vector field, the shape should be 2
+		if len(shape) != 2 {
+			return errors.New("illegal numpy shape " + strconv.Itoa(len(shape)) + " for field " + schema.GetName())
+		}
+
+		// shape[0] is row count, shape[1] is element count per row
+		p.columnDesc.elementCount = shape[0] * shape[1]
+
+		p.columnDesc.dimension, err = getFieldDimension(schema)
+		if err != nil {
+			return err
+		}
+
 		if shape[1] != p.columnDesc.dimension {
 			return errors.New("illegal row width " + strconv.Itoa(shape[1]) + " for field " + schema.GetName() + " dimension " + strconv.Itoa(p.columnDesc.dimension))
 		}
@@ -278,6 +306,25 @@ func (p *NumpyParser) consume(adapter *NumpyAdapter) error {
 			Data:    data,
 			Dim:     p.columnDesc.dimension,
 		}
+	case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		data, err := p.readHalfFloatVectors(adapter)
+		if err != nil {
+			return err
+		}
+
+		if p.columnDesc.dt == schemapb.DataType_Float16Vector {
+			p.columnData = &storage.Float16VectorFieldData{
+				NumRows: []int64{int64(p.columnDesc.elementCount)},
+				Data:    data,
+				Dim:     p.columnDesc.dimension,
+			}
+		} else {
+			p.columnData = &storage.BFloat16VectorFieldData{
+				NumRows: []int64{int64(p.columnDesc.elementCount)},
+				Data:    data,
+				Dim:     p.columnDesc.dimension,
+			}
+		}
 	default:
 		return errors.New("unsupported data type: " + strconv.Itoa(int(p.columnDesc.dt)))
 	}
@@ -285,6 +332,51 @@ func (p *NumpyParser) consume(adapter *NumpyAdapter) error {
 	return nil
 }
 
+// readHalfFloatVectors reads float16 vector or bfloat16 vector data, 2 bytes per element
+// float16 numpy file is copied directly, float32 and float64 numpy file is converted
+func (p *NumpyParser) readHalfFloatVectors(adapter *NumpyAdapter) ([]byte, error) {
+	elementType, err := convertNumpyType(adapter.GetType())
+	if err != nil {
+		return nil, err
+	}
+
+	if elementType == schemapb.DataType_Float16Vector {
+		bits, err := adapter.ReadFloat16(p.columnDesc.elementCount)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]byte, len(bits)*2)
+		for i, b := range bits {
+			common.Endian.PutUint16(data[i*2:], b)
+		}
+		return data, nil
+	}
+
+	var data []float32
+	if elementType == schemapb.DataType_Float {
+		data, err = adapter.ReadFloat32(p.columnDesc.elementCount)
+		if err != nil {
+			return nil, err
+		}
+	} else if elementType == schemapb.DataType_Double {
+		data64, err := adapter.ReadFloat64(p.columnDesc.elementCount)
+		if err != nil {
+			return nil, err
+		}
+		data = make([]float32, 0, len(data64))
+		for _, f64 := range data64 {
+			data = append(data, float32(f64))
+		}
+	} else {
+		return nil, errors.New("illegal data type " + adapter.GetType() + " for field " + p.columnDesc.name)
+	}
+
+	if p.columnDesc.dt == schemapb.DataType_Float16Vector {
+		return typeutil.Float32ArrayToFloat16Bytes(data), nil
+	}
+	return typeutil.Float32ArrayToBFloat16Bytes(data), nil
+}
+
 func (p *NumpyParser) Parse(reader io.Reader, fieldName string, onlyValidate bool) error {
 	adapter, err := NewNumpyAdapter(reader)
 	if err != nil {
@@ -1,6 +1,7 @@
 package importutil
 
 import (
+	"bytes"
 	"context"
 	"os"
 	"testing"
@@ -8,9 +9,12 @@ import (
 	"github.com/sbinet/npyio/npy"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func Test_NewNumpyParser(t *testing.T) {
@@ -36,6 +40,7 @@ func Test_ConvertNumpyType(t *testing.T) {
 	checkFunc([]string{"i8", "<i8", "|i8", ">i8", "int64"}, schemapb.DataType_Int64)
 	checkFunc([]string{"f4", "<f4", "|f4", ">f4", "float32"}, schemapb.DataType_Float)
 	checkFunc([]string{"f8", "<f8", "|f8", ">f8", "float64"}, schemapb.DataType_Double)
+	checkFunc([]string{"f2", "<f2", "|f2", ">f2", "float16"}, schemapb.DataType_Float16Vector)
 
 	dt, err := convertNumpyType("dummy")
 	assert.NotNil(t, err)
@@ -524,3 +529,78 @@ func Test_Parse_perf(t *testing.T) {
 
 	tr.Record("parse large numpy files: " + filePath)
 }
+
+func Test_ParseHalfFloatVector(t *testing.T) {
+	ctx := context.Background()
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:  100,
+				Name:     "fp16",
+				DataType: schemapb.DataType_Float16Vector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "2"},
+				},
+			},
+			{
+				FieldID:  101,
+				Name:     "bf16",
+				DataType: schemapb.DataType_BFloat16Vector,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "dim", Value: "2"},
+				},
+			},
+		},
+	}
+	values := []float32{1, -2, 0.5, 4}
+	fp16 := typeutil.Float32ArrayToFloat16Bytes(values)
+	bf16 := typeutil.Float32ArrayToBFloat16Bytes(values)
+
+	parse := func(data []byte, fieldName string, callback func(field storage.FieldData) error) error {
+		parser := NewNumpyParser(ctx, schema, callback)
+		return parser.Parse(bytes.NewReader(data), fieldName, false)
+	}
+
+	// float16 numpy file, npyio can't write float16 so the header of an uint16 file is patched
+	bits := [][2]uint16{}
+	for i := 0; i < len(values); i += 2 {
+		bits = append(bits, [2]uint16{common.Endian.Uint16(fp16[i*2:]), common.Endian.Uint16(fp16[i*2+2:])})
+	}
+	data, err := CreateNumpyData(bits)
+	assert.Nil(t, err)
+	data = bytes.Replace(data, []byte("'<u2'"), []byte("'<f2'"), 1)
+
+	err = parse(data, "fp16", func(field storage.FieldData) error {
+		vec, ok := field.(*storage.Float16VectorFieldData)
+		assert.True(t, ok)
+		assert.Equal(t, 2, vec.Dim)
+		assert.Equal(t, 2, vec.RowNum())
+		assert.Equal(t, fp16, vec.Data)
+		return nil
+	})
+	assert.Nil(t, err)
+
+	// float16 numpy file is not accepted by bfloat16 field
+	err = parse(data, "bf16", nil)
+	assert.NotNil(t, err)
+
+	// float32 numpy file is converted
+	data, err = CreateNumpyData([][2]float32{{values[0], values[1]}, {values[2], values[3]}})
+	assert.Nil(t, err)
+	err = parse(data, "fp16", func(field storage.FieldData) error {
+		assert.Equal(t, fp16, field.(*storage.Float16VectorFieldData).Data)
+		return nil
+	})
+	assert.Nil(t, err)
+	err = parse(data, "bf16", func(field storage.FieldData) error {
+		assert.Equal(t, bf16, field.(*storage.BFloat16VectorFieldData).Data)
+		return nil
+	})
+	assert.Nil(t, err)
+
+	// dimension mismatch
+	data, err = CreateNumpyData([][3]float32{{1, 2, 3}})
+	assert.Nil(t, err)
+	err = parse(data, "fp16", nil)
+	assert.NotNil(t, err)
+}
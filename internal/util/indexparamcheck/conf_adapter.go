@@ -109,6 +109,8 @@ const (
 	IndexMode = "index_mode"
 	CPUMode   = "CPU"
 	GPUMode   = "GPU"
+
+	DropRatioBuild = "drop_ratio_build"
 )
 
 // METRICS is a set of all metrics types supported for float vector.
@@ -117,6 +119,7 @@ var METRICS = []string{L2, IP} // const
 // BinIDMapMetrics is a set of all metric types supported for binary vector.
 var BinIDMapMetrics = []string{HAMMING, JACCARD, TANIMOTO, SUBSTRUCTURE, SUPERSTRUCTURE}   // const
 var BinIvfMetrics = []string{HAMMING, JACCARD, TANIMOTO}                                   // const
+var SparseMetrics = []string{IP}                                                           // const
 var supportDimPerSubQuantizer = []int{32, 28, 24, 20, 16, 12, 10, 8, 6, 4, 3, 2, 1}        // const
 var supportSubQuantizer = []int{96, 64, 56, 48, 40, 32, 28, 24, 20, 16, 12, 8, 4, 3, 2, 1} // const
 
@@ -507,3 +510,24 @@ func (adapter *NGTONNGConfAdapter) CheckTrain(params map[string]string) bool {
 func newNGTONNGConfAdapter() *NGTONNGConfAdapter {
 	return &NGTONNGConfAdapter{}
 }
+
+// SparseInvertedIndexConfAdapter checks if a sparse inverted index can be built.
+type SparseInvertedIndexConfAdapter struct {
+}
+
+// CheckTrain checks if a sparse inverted index can be built with the specific index parameters.
+// Sparse float vector has no fixed dimension, so `dim` is not checked.
+func (adapter *SparseInvertedIndexConfAdapter) CheckTrain(params map[string]string) bool {
+	if dropRatio, ok := params[DropRatioBuild]; ok {
+		value, err := strconv.ParseFloat(dropRatio, 64)
+		if err != nil || value < 0 || value >= 1 {
+			return false
+		}
+	}
+
+	return CheckStrByValues(params, Metric, SparseMetrics)
+}
+
+func newSparseInvertedIndexConfAdapter() *SparseInvertedIndexConfAdapter {
+	return &SparseInvertedIndexConfAdapter{}
+}
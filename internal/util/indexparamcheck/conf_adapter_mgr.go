@@ -60,6 +60,8 @@ func (mgr *ConfAdapterMgrImpl) registerConfAdapter() {
 	mgr.adapters[IndexRHNSWSQ] = newRHNSWSQConfAdapter()
 	mgr.adapters[IndexNGTPANNG] = newNGTPANNGConfAdapter()
 	mgr.adapters[IndexNGTONNG] = newNGTONNGConfAdapter()
+	mgr.adapters[IndexSparseInverted] = newSparseInvertedIndexConfAdapter()
+	mgr.adapters[IndexSparseWand] = newSparseInvertedIndexConfAdapter()
 }
 
 func newConfAdapterMgrImpl() *ConfAdapterMgrImpl {
@@ -15,6 +15,8 @@ import (
 	"fmt"
 	"strconv"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 // TODO: add more test cases which `ConfAdapter.CheckTrain` return false,
@@ -622,3 +624,53 @@ func TestNGTONNGConfAdapter_CheckTrain(t *testing.T) {
 		}
 	}
 }
+
+func TestSparseInvertedIndexConfAdapter_CheckTrain(t *testing.T) {
+	validParams := map[string]string{
+		Metric: IP,
+	}
+
+	validDropRatioParams := copyParams(validParams)
+	validDropRatioParams[DropRatioBuild] = "0.2"
+
+	invalidDropRatioParams := copyParams(validParams)
+	invalidDropRatioParams[DropRatioBuild] = "1.0"
+
+	invalidDropRatioStrParams := copyParams(validParams)
+	invalidDropRatioStrParams[DropRatioBuild] = "abc"
+
+	invalidMetricParams := copyParams(validParams)
+	invalidMetricParams[Metric] = L2
+
+	cases := []struct {
+		params map[string]string
+		want   bool
+	}{
+		{validParams, true},
+		{validDropRatioParams, true},
+		{invalidDropRatioParams, false},
+		{invalidDropRatioStrParams, false},
+		{invalidMetricParams, false},
+		{map[string]string{}, false},
+	}
+
+	adapter := newSparseInvertedIndexConfAdapter()
+	for _, test := range cases {
+		if got := adapter.CheckTrain(test.params); got != test.want {
+			t.Errorf("SparseInvertedIndexConfAdapter.CheckTrain(%v) = %v", test.params, test.want)
+		}
+	}
+
+	assert.True(t, IsSparseIndexType(IndexSparseInverted))
+	assert.True(t, IsSparseIndexType(IndexSparseWand))
+	assert.False(t, IsSparseIndexType(IndexHNSW))
+}
+
+func TestIsHalfFloatIndexType(t *testing.T) {
+	for _, indexType := range []IndexType{IndexFaissIDMap, IndexFaissIvfFlat, IndexFaissIvfPQ, IndexFaissIvfSQ8, IndexHNSW} {
+		assert.True(t, IsHalfFloatIndexType(indexType))
+	}
+	for _, indexType := range []IndexType{IndexFaissBinIDMap, IndexANNOY, IndexSparseInverted} {
+		assert.False(t, IsHalfFloatIndexType(indexType))
+	}
+}
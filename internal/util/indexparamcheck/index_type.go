@@ -31,4 +31,31 @@ const (
 	IndexANNOY           IndexType = "ANNOY"
 	IndexNGTPANNG        IndexType = "NGT_PANNG"
 	IndexNGTONNG         IndexType = "NGT_ONNG"
+
+	IndexSparseInverted IndexType = "SPARSE_INVERTED_INDEX"
+	IndexSparseWand     IndexType = "SPARSE_WAND"
+
+	IndexSTLSORT  IndexType = "STL_SORT"
+	IndexINVERTED IndexType = "INVERTED"
+	IndexBitmap   IndexType = "BITMAP"
 )
+
+// IsSparseIndexType returns true if the index type can only be built on sparse float vector.
+func IsSparseIndexType(indexType IndexType) bool {
+	return indexType == IndexSparseInverted || indexType == IndexSparseWand
+}
+
+// IsHalfFloatIndexType returns true if the index type can be built on float16 or bfloat16 vector.
+func IsHalfFloatIndexType(indexType IndexType) bool {
+	switch indexType {
+	case IndexFaissIDMap, IndexFaissIvfFlat, IndexFaissIvfPQ, IndexFaissIvfSQ8, IndexHNSW:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsScalarIndexType returns true if the index type can only be built on scalar field.
+func IsScalarIndexType(indexType IndexType) bool {
+	return indexType == IndexSTLSORT || indexType == IndexINVERTED || indexType == IndexBitmap
+}
@@ -1,8 +1,27 @@
 package indexparamcheck
 
-import "github.com/milvus-io/milvus/internal/proto/schemapb"
+import (
+	"fmt"
 
-// TODO: check index parameters according to the index type & data type.
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// CheckIndexValid checks whether the scalar index type can be built on the data type.
+// Other index types are accepted as before, and the default scalar index is built for them.
 func CheckIndexValid(dType schemapb.DataType, indexType IndexType, indexParams map[string]string) error {
+	var supported bool
+	switch indexType {
+	case IndexSTLSORT:
+		// sorting strings is not supported by the sort index, use INVERTED instead.
+		supported = typeutil.IsArithmetic(dType)
+	case IndexINVERTED, IndexBitmap:
+		supported = typeutil.IsBoolType(dType) || typeutil.IsArithmetic(dType) || typeutil.IsStringType(dType)
+	default:
+		return nil
+	}
+	if !supported {
+		return fmt.Errorf("index type %s is not supported for %s field", indexType, dType.String())
+	}
 	return nil
 }
@@ -9,4 +9,32 @@ import (
 
 func TestCheckIndexValid(t *testing.T) {
 	assert.NoError(t, CheckIndexValid(schemapb.DataType_Int64, "inverted_index", nil))
+
+	t.Run("STL_SORT", func(t *testing.T) {
+		assert.NoError(t, CheckIndexValid(schemapb.DataType_Int8, IndexSTLSORT, nil))
+		assert.NoError(t, CheckIndexValid(schemapb.DataType_Double, IndexSTLSORT, nil))
+		assert.Error(t, CheckIndexValid(schemapb.DataType_VarChar, IndexSTLSORT, nil))
+		assert.Error(t, CheckIndexValid(schemapb.DataType_Bool, IndexSTLSORT, nil))
+	})
+
+	t.Run("INVERTED", func(t *testing.T) {
+		assert.NoError(t, CheckIndexValid(schemapb.DataType_VarChar, IndexINVERTED, nil))
+		assert.NoError(t, CheckIndexValid(schemapb.DataType_Int32, IndexINVERTED, nil))
+		assert.NoError(t, CheckIndexValid(schemapb.DataType_Bool, IndexINVERTED, nil))
+		assert.Error(t, CheckIndexValid(schemapb.DataType_FloatVector, IndexINVERTED, nil))
+	})
+
+	t.Run("BITMAP", func(t *testing.T) {
+		assert.NoError(t, CheckIndexValid(schemapb.DataType_Bool, IndexBitmap, nil))
+		assert.NoError(t, CheckIndexValid(schemapb.DataType_String, IndexBitmap, nil))
+		assert.NoError(t, CheckIndexValid(schemapb.DataType_Int16, IndexBitmap, nil))
+		assert.Error(t, CheckIndexValid(schemapb.DataType_BinaryVector, IndexBitmap, nil))
+	})
+}
+
+func TestIsScalarIndexType(t *testing.T) {
+	assert.True(t, IsScalarIndexType(IndexSTLSORT))
+	assert.True(t, IsScalarIndexType(IndexINVERTED))
+	assert.True(t, IsScalarIndexType(IndexBitmap))
+	assert.False(t, IsScalarIndexType(IndexHNSW))
 }
@@ -108,6 +108,14 @@ func (m *DataCoordClient) GetCompactionStateWithPlans(ctx context.Context, req *
 	return &milvuspb.GetCompactionPlansResponse{}, m.Err
 }
 
+func (m *DataCoordClient) GetCompactionPolicyStats(ctx context.Context, req *milvuspb.GetCompactionPolicyStatsRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPolicyStatsResponse, error) {
+	return &milvuspb.GetCompactionPolicyStatsResponse{}, m.Err
+}
+
+func (m *DataCoordClient) CancelCompaction(ctx context.Context, req *milvuspb.CancelCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *DataCoordClient) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest, opts ...grpc.CallOption) (*datapb.WatchChannelsResponse, error) {
 	return &datapb.WatchChannelsResponse{}, m.Err
 }
@@ -110,6 +110,14 @@ func (m *GrpcDataCoordClient) GetCompactionStateWithPlans(ctx context.Context, r
 	return &milvuspb.GetCompactionPlansResponse{}, m.Err
 }
 
+func (m *GrpcDataCoordClient) GetCompactionPolicyStats(ctx context.Context, req *milvuspb.GetCompactionPolicyStatsRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPolicyStatsResponse, error) {
+	return &milvuspb.GetCompactionPolicyStatsResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) CancelCompaction(ctx context.Context, req *milvuspb.CancelCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcDataCoordClient) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest, opts ...grpc.CallOption) (*datapb.WatchChannelsResponse, error) {
 	return &datapb.WatchChannelsResponse{}, m.Err
 }
@@ -144,3 +152,23 @@ func (m *GrpcDataCoordClient) ReleaseSegmentLock(ctx context.Context, req *datap
 func (m *GrpcDataCoordClient) AddSegment(ctx context.Context, in *datapb.AddSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
+
+func (m *GrpcDataCoordClient) TruncateSegments(ctx context.Context, in *datapb.TruncateSegmentsRequest, opts ...grpc.CallOption) (*datapb.TruncateSegmentsResponse, error) {
+	return &datapb.TruncateSegmentsResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) CreateSnapshot(ctx context.Context, in *datapb.CreateSnapshotRequest, opts ...grpc.CallOption) (*datapb.CreateSnapshotResponse, error) {
+	return &datapb.CreateSnapshotResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) DropSnapshot(ctx context.Context, in *datapb.DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) ListSnapshots(ctx context.Context, in *datapb.ListSnapshotsRequest, opts ...grpc.CallOption) (*datapb.ListSnapshotsResponse, error) {
+	return &datapb.ListSnapshotsResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) CheckPrimaryKeys(ctx context.Context, in *datapb.CheckPrimaryKeysRequest, opts ...grpc.CallOption) (*datapb.CheckPrimaryKeysResponse, error) {
+	return &datapb.CheckPrimaryKeysResponse{}, m.Err
+}
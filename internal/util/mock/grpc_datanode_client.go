@@ -57,6 +57,10 @@ func (m *GrpcDataNodeClient) Compaction(ctx context.Context, req *datapb.Compact
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *GrpcDataNodeClient) StopCompaction(ctx context.Context, req *datapb.StopCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcDataNodeClient) Import(ctx context.Context, req *datapb.ImportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
@@ -68,3 +72,7 @@ func (m *GrpcDataNodeClient) ResendSegmentStats(ctx context.Context, req *datapb
 func (m *GrpcDataNodeClient) AddSegment(ctx context.Context, in *datapb.AddSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
+
+func (m *GrpcDataNodeClient) CheckPrimaryKeys(ctx context.Context, in *datapb.CheckPrimaryKeysRequest, opts ...grpc.CallOption) (*datapb.CheckPrimaryKeysResponse, error) {
+	return &datapb.CheckPrimaryKeysResponse{}, m.Err
+}
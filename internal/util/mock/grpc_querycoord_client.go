@@ -82,6 +82,14 @@ func (m *GrpcQueryCoordClient) LoadBalance(ctx context.Context, in *querypb.Load
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *GrpcQueryCoordClient) AlterShards(ctx context.Context, in *querypb.AlterShardsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) TruncateSegments(ctx context.Context, in *querypb.TruncateSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcQueryCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	return &milvuspb.GetMetricsResponse{}, m.Err
 }
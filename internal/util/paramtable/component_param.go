@@ -12,6 +12,7 @@
 package paramtable
 
 import (
+	"fmt"
 	"math"
 	"path"
 	"strconv"
@@ -23,6 +24,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/trace"
 )
 
 const (
@@ -49,6 +51,7 @@ type ComponentParam struct {
 	DataNodeCfg   dataNodeConfig
 	IndexCoordCfg indexCoordConfig
 	IndexNodeCfg  indexNodeConfig
+	CDCCfg        cdcConfig
 }
 
 // InitOnce initialize once
@@ -72,6 +75,7 @@ func (p *ComponentParam) Init() {
 	p.DataNodeCfg.init(&p.BaseTable)
 	p.IndexCoordCfg.init(&p.BaseTable)
 	p.IndexNodeCfg.init(&p.BaseTable)
+	p.CDCCfg.init(&p.BaseTable)
 }
 
 // SetLogConfig set log config with given role
@@ -130,6 +134,23 @@ type commonConfig struct {
 	SimdType    string
 
 	AuthorizationEnabled bool
+
+	// authenticators tried in order on the credentials of requests
+	Authenticators         []string
+	APIKeyFile             string
+	JWTJwksURL             string
+	JWTJwksFile            string
+	JWTJwksRefreshInterval time.Duration
+	JWTIssuer              string
+	JWTAudience            string
+	JWTUserClaim           string
+	JWTRolesClaim          string
+
+	TraceExporter       string
+	TraceSampleFraction float64
+	TraceOtlpEndpoint   string
+	TraceOtlpInsecure   bool
+	TraceFilePath       string
 }
 
 func (p *commonConfig) init(base *BaseTable) {
@@ -167,6 +188,9 @@ func (p *commonConfig) init(base *BaseTable) {
 	p.initStorageType()
 
 	p.initEnableAuthorization()
+	p.initAuthenticators()
+
+	p.initTraceConfig()
 }
 
 func (p *commonConfig) initClusterPrefix() {
@@ -370,6 +394,42 @@ func (p *commonConfig) initEnableAuthorization() {
 	p.AuthorizationEnabled = p.Base.ParseBool("common.security.authorizationEnabled", false)
 }
 
+func (p *commonConfig) initAuthenticators() {
+	p.Authenticators = nil
+	for _, name := range strings.Split(p.Base.LoadWithDefault("common.security.authenticators", "password"), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			p.Authenticators = append(p.Authenticators, strings.ToLower(name))
+		}
+	}
+	p.APIKeyFile = p.Base.LoadWithDefault("common.security.apiKey.file", "")
+	p.JWTJwksURL = p.Base.LoadWithDefault("common.security.jwt.jwksUrl", "")
+	p.JWTJwksFile = p.Base.LoadWithDefault("common.security.jwt.jwksFile", "")
+	p.JWTJwksRefreshInterval = time.Duration(p.Base.ParseInt64WithDefault("common.security.jwt.jwksRefreshInterval", 3600)) * time.Second
+	p.JWTIssuer = p.Base.LoadWithDefault("common.security.jwt.issuer", "")
+	p.JWTAudience = p.Base.LoadWithDefault("common.security.jwt.audience", "")
+	p.JWTUserClaim = p.Base.LoadWithDefault("common.security.jwt.userClaim", "sub")
+	p.JWTRolesClaim = p.Base.LoadWithDefault("common.security.jwt.rolesClaim", "roles")
+}
+
+// OtelConfig returns the config of OpenTelemetry tracing
+func (p *commonConfig) OtelConfig() trace.OtelConfig {
+	return trace.OtelConfig{
+		Exporter:       p.TraceExporter,
+		SampleFraction: p.TraceSampleFraction,
+		OtlpEndpoint:   p.TraceOtlpEndpoint,
+		OtlpInsecure:   p.TraceOtlpInsecure,
+		FilePath:       p.TraceFilePath,
+	}
+}
+
+func (p *commonConfig) initTraceConfig() {
+	p.TraceExporter = p.Base.LoadWithDefault("common.trace.exporter", "none")
+	p.TraceSampleFraction = p.Base.ParseFloatWithDefault("common.trace.sampleFraction", 1)
+	p.TraceOtlpEndpoint = p.Base.LoadWithDefault("common.trace.otlp.endpoint", "localhost:4317")
+	p.TraceOtlpInsecure = p.Base.ParseBool("common.trace.otlp.insecure", true)
+	p.TraceFilePath = p.Base.LoadWithDefault("common.trace.file.path", "/tmp/milvus/trace.json")
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // --- rootcoord ---
 type rootCoordConfig struct {
@@ -435,11 +495,37 @@ type proxyConfig struct {
 	MaxUserNum               int
 	MaxRoleNum               int
 
+	// the default policy applied to conflicting primary keys of collections enforcing unique primary keys
+	UniquePKConflictPolicy string
+
+	// audit log of the requests passing the authentication
+	AuditEnabled        bool
+	AuditSink           string
+	AuditOperations     []string
+	AuditIncludePayload bool
+	AuditHMACKey        string
+	AuditChainFile      string
+	AuditBufferSize     int
+	AuditFilePath       string
+	AuditFileMaxSize    int
+	AuditFileMaxAge     int
+	AuditFileMaxBackups int
+	AuditTopic          string
+
 	// required from QueryCoord
 	SearchResultChannelNames   []string
 	RetrieveResultChannelNames []string
 
 	MaxTaskNum int64
+	// MaxReadConcurrency limits the search and query tasks executed concurrently, 0 means unlimited.
+	// The queued tasks are executed by their priorities when it's limited.
+	MaxReadConcurrency int64
+	// ServerBusyRetryAfter is the backoff suggested to the clients rejected by the full task queues
+	ServerBusyRetryAfter time.Duration
+	// StreamResultChunkRows is the max number of rows sent in a chunk by the streaming search and query
+	StreamResultChunkRows int64
+	// DeleteJobBatchRows is the max number of entities deleted in a batch by the DeleteByExpr jobs
+	DeleteJobBatchRows int64
 
 	CreatedTime time.Time
 	UpdatedTime time.Time
@@ -460,9 +546,15 @@ func (p *proxyConfig) init(base *BaseTable) {
 	p.initMaxDimension()
 
 	p.initMaxTaskNum()
+	p.initMaxReadConcurrency()
+	p.initServerBusyRetryAfter()
+	p.initStreamResultChunkRows()
+	p.initDeleteJobBatchRows()
 	p.initGinLogging()
 	p.initMaxUserNum()
 	p.initMaxRoleNum()
+	p.initUniquePKConflictPolicy()
+	p.initAuditConfig()
 }
 
 // InitAlias initialize Alias member.
@@ -546,6 +638,31 @@ func (p *proxyConfig) initMaxTaskNum() {
 	p.MaxTaskNum = p.Base.ParseInt64WithDefault("proxy.maxTaskNum", 1024)
 }
 
+func (p *proxyConfig) initMaxReadConcurrency() {
+	p.MaxReadConcurrency = p.Base.ParseInt64WithDefault("proxy.maxReadConcurrency", 0)
+	if p.MaxReadConcurrency < 0 {
+		p.MaxReadConcurrency = 0
+	}
+}
+
+func (p *proxyConfig) initServerBusyRetryAfter() {
+	p.ServerBusyRetryAfter = time.Duration(p.Base.ParseInt64WithDefault("proxy.serverBusyRetryAfter", 100)) * time.Millisecond
+}
+
+func (p *proxyConfig) initStreamResultChunkRows() {
+	p.StreamResultChunkRows = p.Base.ParseInt64WithDefault("proxy.streamResultChunkRows", 10000)
+	if p.StreamResultChunkRows <= 0 {
+		p.StreamResultChunkRows = 10000
+	}
+}
+
+func (p *proxyConfig) initDeleteJobBatchRows() {
+	p.DeleteJobBatchRows = p.Base.ParseInt64WithDefault("proxy.deleteJobBatchRows", 10000)
+	if p.DeleteJobBatchRows <= 0 {
+		p.DeleteJobBatchRows = 10000
+	}
+}
+
 func (p *proxyConfig) initGinLogging() {
 	// Gin logging is on by default.
 	p.GinLogging = p.Base.ParseBool("proxy.ginLogging", true)
@@ -581,6 +698,30 @@ func (p *proxyConfig) initMaxRoleNum() {
 	p.MaxRoleNum = int(maxRoleNum)
 }
 
+func (p *proxyConfig) initUniquePKConflictPolicy() {
+	p.UniquePKConflictPolicy = p.Base.LoadWithDefault("proxy.uniquePKConflictPolicy", "reject")
+}
+
+func (p *proxyConfig) initAuditConfig() {
+	p.AuditEnabled = p.Base.ParseBool("proxy.audit.enabled", false)
+	p.AuditSink = p.Base.LoadWithDefault("proxy.audit.sink", "file")
+	p.AuditOperations = nil
+	for _, op := range strings.Split(p.Base.LoadWithDefault("proxy.audit.operations", "DDL,DML,DQL,RBAC"), ",") {
+		if op = strings.TrimSpace(op); op != "" {
+			p.AuditOperations = append(p.AuditOperations, strings.ToUpper(op))
+		}
+	}
+	p.AuditIncludePayload = p.Base.ParseBool("proxy.audit.includePayload", false)
+	p.AuditHMACKey = p.Base.LoadWithDefault("proxy.audit.hmacKey", "")
+	p.AuditChainFile = p.Base.LoadWithDefault("proxy.audit.chainFile", "/tmp/milvus/audit/audit.chain")
+	p.AuditBufferSize = p.Base.ParseIntWithDefault("proxy.audit.bufferSize", 1024)
+	p.AuditFilePath = p.Base.LoadWithDefault("proxy.audit.file.path", "/tmp/milvus/audit/audit.log")
+	p.AuditFileMaxSize = p.Base.ParseIntWithDefault("proxy.audit.file.maxSize", 300)
+	p.AuditFileMaxAge = p.Base.ParseIntWithDefault("proxy.audit.file.maxAge", 30)
+	p.AuditFileMaxBackups = p.Base.ParseIntWithDefault("proxy.audit.file.maxBackups", 20)
+	p.AuditTopic = p.Base.LoadWithDefault("proxy.audit.topic", "audit")
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // --- querycoord ---
 type queryCoordConfig struct {
@@ -733,6 +874,14 @@ type queryNodeConfig struct {
 	MaxGroupNQ           int64
 	TopKMergeRatio       float64
 	CPURatio             float64
+
+	// ScheduleReadPolicy decides the order the ready read tasks are executed in
+	ScheduleReadPolicy string
+	// ScheduleReadWeights are the weights of the groups of the fair policies, 1 by default
+	ScheduleReadWeights map[string]int32
+
+	// StreamResultChunkRows is the max number of rows pushed in a chunk to the proxies of the streaming queries
+	StreamResultChunkRows int64
 }
 
 func (p *queryNodeConfig) init(base *BaseTable) {
@@ -759,6 +908,8 @@ func (p *queryNodeConfig) init(base *BaseTable) {
 	p.initMaxGroupNQ()
 	p.initTopKMergeRatio()
 	p.initCPURatio()
+	p.initScheduleReadPolicy()
+	p.initStreamResultChunkRows()
 }
 
 // InitAlias initializes an alias for the QueryNode role.
@@ -870,6 +1021,32 @@ func (p *queryNodeConfig) initMaxReadConcurrency() {
 	}
 }
 
+func (p *queryNodeConfig) initStreamResultChunkRows() {
+	p.StreamResultChunkRows = p.Base.ParseInt64WithDefault("queryNode.streamResultChunkRows", 10000)
+	if p.StreamResultChunkRows <= 0 {
+		p.StreamResultChunkRows = 10000
+	}
+}
+
+func (p *queryNodeConfig) initScheduleReadPolicy() {
+	p.ScheduleReadPolicy = p.Base.LoadWithDefault("queryNode.scheduler.readPolicy.name", "fifo")
+	p.ScheduleReadWeights = make(map[string]int32)
+	for _, kv := range strings.Split(p.Base.LoadWithDefault("queryNode.scheduler.readPolicy.weights", ""), ",") {
+		if kv = strings.TrimSpace(kv); kv == "" {
+			continue
+		}
+		i := strings.LastIndex(kv, ":")
+		if i <= 0 {
+			panic(fmt.Errorf("invalid weight of read policy group: %s", kv))
+		}
+		weight, err := strconv.ParseInt(strings.TrimSpace(kv[i+1:]), 10, 32)
+		if err != nil || weight <= 0 {
+			panic(fmt.Errorf("invalid weight of read policy group: %s", kv))
+		}
+		p.ScheduleReadWeights[strings.TrimSpace(kv[:i])] = int32(weight)
+	}
+}
+
 func (p *queryNodeConfig) initMaxGroupNQ() {
 	p.MaxGroupNQ = p.Base.ParseInt64WithDefault("queryNode.grouping.maxNQ", 1000)
 }
@@ -925,6 +1102,8 @@ type dataCoordConfig struct {
 	SingleCompactionDeltaLogMaxSize   int64
 	SingleCompactionExpiredLogMaxSize int64
 	SingleCompactionBinlogMaxNum      int64
+	SingleCompactionDeltalogMaxNum    int64
+	SingleCompactionSegmentMaxAge     time.Duration
 	GlobalCompactionInterval          time.Duration
 
 	// Garbage Collection
@@ -954,6 +1133,8 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 	p.initSingleCompactionDeltaLogMaxSize()
 	p.initSingleCompactionExpiredLogMaxSize()
 	p.initSingleCompactionBinlogMaxNum()
+	p.initSingleCompactionDeltalogMaxNum()
+	p.initSingleCompactionSegmentMaxAge()
 	p.initGlobalCompactionInterval()
 
 	p.initEnableGarbageCollection()
@@ -1029,6 +1210,16 @@ func (p *dataCoordConfig) initSingleCompactionBinlogMaxNum() {
 	p.SingleCompactionBinlogMaxNum = p.Base.ParseInt64WithDefault("dataCoord.compaction.single.binlog.maxnum", 1000)
 }
 
+// if deltalog number > SingleCompactionDeltalogMaxNum, trigger single compaction to merge the deltalogs
+func (p *dataCoordConfig) initSingleCompactionDeltalogMaxNum() {
+	p.SingleCompactionDeltalogMaxNum = p.Base.ParseInt64WithDefault("dataCoord.compaction.single.deltalog.maxnum", 200)
+}
+
+// if segment with deletions is older than SingleCompactionSegmentMaxAge, trigger single compaction, 0 means disabled
+func (p *dataCoordConfig) initSingleCompactionSegmentMaxAge() {
+	p.SingleCompactionSegmentMaxAge = time.Duration(p.Base.ParseInt64WithDefault("dataCoord.compaction.single.segment.maxAge", 0)) * time.Second
+}
+
 // interval we check and trigger global compaction
 func (p *dataCoordConfig) initGlobalCompactionInterval() {
 	p.GlobalCompactionInterval = time.Duration(p.Base.ParseInt64WithDefault("dataCoord.compaction.global.interval", int64(60*time.Second)))
@@ -1278,3 +1469,39 @@ func (p *indexNodeConfig) GetNodeID() UniqueID {
 	}
 	return 0
 }
+
+///////////////////////////////////////////////////////////////////////////////
+// --- cdc ---
+type cdcConfig struct {
+	Base *BaseTable
+
+	Name          string
+	SourceAddress string
+	TargetAddress string
+	Collections   []string
+
+	RetryAttempts    uint
+	RestartInterval  time.Duration
+	MetaSyncInterval time.Duration
+}
+
+func (p *cdcConfig) init(base *BaseTable) {
+	p.Base = base
+
+	p.Name = p.Base.LoadWithDefault("cdc.name", "cdc")
+	p.SourceAddress = p.Base.LoadWithDefault("cdc.source.address", "localhost:19530")
+	p.TargetAddress = p.Base.LoadWithDefault("cdc.target.address", "")
+	p.initCollections()
+	p.RetryAttempts = uint(p.Base.ParseIntWithDefault("cdc.retryAttempts", 10))
+	p.RestartInterval = time.Duration(p.Base.ParseInt64WithDefault("cdc.restartInterval", 10)) * time.Second
+	p.MetaSyncInterval = time.Duration(p.Base.ParseInt64WithDefault("cdc.metaSyncInterval", 60)) * time.Second
+}
+
+func (p *cdcConfig) initCollections() {
+	p.Collections = nil
+	for _, name := range strings.Split(p.Base.LoadWithDefault("cdc.collections", ""), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			p.Collections = append(p.Collections, name)
+		}
+	}
+}
@@ -347,4 +347,18 @@ func TestComponentParam(t *testing.T) {
 
 		t.Logf("IndexStorageRootPath: %v", Params.IndexStorageRootPath)
 	})
+
+	t.Run("test cdcConfig", func(t *testing.T) {
+		Params := CParams.CDCCfg
+
+		assert.Equal(t, "cdc", Params.Name)
+		assert.Empty(t, Params.Collections)
+		assert.Equal(t, uint(10), Params.RetryAttempts)
+		assert.Equal(t, 10*time.Second, Params.RestartInterval)
+		assert.Equal(t, time.Minute, Params.MetaSyncInterval)
+
+		Params.Base.Save("cdc.collections", "c1, c2,")
+		Params.initCollections()
+		assert.Equal(t, []string{"c1", "c2"}, Params.Collections)
+	})
 }
@@ -68,6 +68,22 @@ type grpcConfig struct {
 	ServerPemPath string
 	ServerKeyPath string
 	CaPemPath     string
+
+	InternalTLS InternalTLSConfig
+}
+
+// InternalTLSConfig is the config of mutual tls between coordinators and nodes
+type InternalTLSConfig struct {
+	Enabled       bool
+	ServerPemPath string
+	ServerKeyPath string
+	ClientPemPath string
+	ClientKeyPath string
+	CaPemPath     string
+	// ServerName is verified against the certificates of servers
+	ServerName string
+	// ReloadInterval is the interval to check the changes of the certificate files
+	ReloadInterval time.Duration
 }
 
 func (p *grpcConfig) init(domain string) {
@@ -78,6 +94,7 @@ func (p *grpcConfig) init(domain string) {
 	p.LoadFromArgs()
 	p.initPort()
 	p.initTLSPath()
+	p.initInternalTLS()
 }
 
 // LoadFromEnv is used to initialize configuration items from env.
@@ -102,6 +119,19 @@ func (p *grpcConfig) initTLSPath() {
 	p.CaPemPath = p.Get("tls.caPemPath")
 }
 
+func (p *grpcConfig) initInternalTLS() {
+	p.InternalTLS = InternalTLSConfig{
+		Enabled:        p.ParseBool("internalTls.enabled", false),
+		ServerPemPath:  p.Get("internalTls.serverPemPath"),
+		ServerKeyPath:  p.Get("internalTls.serverKeyPath"),
+		ClientPemPath:  p.Get("internalTls.clientPemPath"),
+		ClientKeyPath:  p.Get("internalTls.clientKeyPath"),
+		CaPemPath:      p.Get("internalTls.caPemPath"),
+		ServerName:     p.LoadWithDefault("internalTls.serverName", "localhost"),
+		ReloadInterval: time.Duration(p.ParseIntWithDefault("internalTls.reloadInterval", 10)) * time.Second,
+	}
+}
+
 // GetAddress return grpc address
 func (p *grpcConfig) GetAddress() string {
 	return p.IP + ":" + strconv.Itoa(p.Port)
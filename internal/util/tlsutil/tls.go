@@ -0,0 +1,252 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// CertReloader holds a key pair and a CA pool loaded from files,
+// and reloads them when the files change, so that certificates are rotated without restarts.
+type CertReloader struct {
+	certPath string
+	keyPath  string
+	caPath   string
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	caPool   *x509.CertPool
+	modTimes map[string]time.Time
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+}
+
+// NewCertReloader loads the key pair and the CA, then checks the changes of the files every interval
+func NewCertReloader(certPath, keyPath, caPath string, interval time.Duration) (*CertReloader, error) {
+	r := &CertReloader{
+		certPath: certPath,
+		keyPath:  keyPath,
+		caPath:   caPath,
+		closeCh:  make(chan struct{}),
+	}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	if interval > 0 {
+		go r.watch(interval)
+	}
+	return r, nil
+}
+
+func (r *CertReloader) watch(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.closeCh:
+			return
+		case <-ticker.C:
+			modTimes, changed := r.changed()
+			if !changed {
+				continue
+			}
+			// keep the loaded certificates if the new ones are broken, the files may be written partially,
+			// they are loaded again on the next change
+			if err := r.reload(); err != nil {
+				log.Warn("failed to reload tls certificates", zap.String("cert", r.certPath), zap.Error(err))
+				r.mu.Lock()
+				r.modTimes = modTimes
+				r.mu.Unlock()
+				continue
+			}
+			log.Info("tls certificates reloaded", zap.String("cert", r.certPath), zap.String("ca", r.caPath))
+		}
+	}
+}
+
+func (r *CertReloader) changed() (map[string]time.Time, bool) {
+	modTimes, err := getModTimes(r.certPath, r.keyPath, r.caPath)
+	if err != nil {
+		return nil, false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for path, modTime := range modTimes {
+		if !modTime.Equal(r.modTimes[path]) {
+			return modTimes, true
+		}
+	}
+	return modTimes, false
+}
+
+func (r *CertReloader) reload() error {
+	modTimes, err := getModTimes(r.certPath, r.keyPath, r.caPath)
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(r.certPath, r.keyPath)
+	if err != nil {
+		return fmt.Errorf("failed to load x509 key pair: %w", err)
+	}
+	caPem, err := ioutil.ReadFile(r.caPath)
+	if err != nil {
+		return fmt.Errorf("failed to read ca pem: %w", err)
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caPem) {
+		return fmt.Errorf("failed to append ca pem %s", r.caPath)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = &cert
+	r.caPool = caPool
+	r.modTimes = modTimes
+	return nil
+}
+
+func getModTimes(paths ...string) (map[string]time.Time, error) {
+	modTimes := make(map[string]time.Time, len(paths))
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		modTimes[path] = info.ModTime()
+	}
+	return modTimes, nil
+}
+
+// Certificate returns the key pair loaded last
+func (r *CertReloader) Certificate() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert
+}
+
+// CAPool returns the CA pool loaded last
+func (r *CertReloader) CAPool() *x509.CertPool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.caPool
+}
+
+// ServerTLSConfig returns the tls config of servers requiring and verifying client certificates,
+// every handshake uses the certificates loaded last.
+func (r *CertReloader) ServerTLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.Certificate()},
+				ClientCAs:    r.CAPool(),
+				ClientAuth:   tls.RequireAndVerifyClientCert,
+				NextProtos:   []string{"h2"},
+			}, nil
+		},
+	}
+}
+
+// ClientTLSConfig returns the tls config of clients presenting their certificates and verifying the servers.
+// The client certificate is refreshed on every handshake, while the CA is taken when the config is created,
+// so the config should be created for every dial.
+func (r *CertReloader) ClientTLSConfig(serverName string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+		RootCAs:    r.CAPool(),
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return r.Certificate(), nil
+		},
+	}
+}
+
+// Close stops watching the files
+func (r *CertReloader) Close() {
+	r.closeOnce.Do(func() {
+		close(r.closeCh)
+	})
+}
+
+var reloadersMtx sync.Mutex
+var reloaders = make(map[string]*CertReloader)
+
+// getCertReloader returns the reloader of the files shared in the process
+func getCertReloader(certPath, keyPath, caPath string, interval time.Duration) (*CertReloader, error) {
+	reloadersMtx.Lock()
+	defer reloadersMtx.Unlock()
+	key := strings.Join([]string{certPath, keyPath, caPath}, ",")
+	if r, ok := reloaders[key]; ok {
+		return r, nil
+	}
+	r, err := NewCertReloader(certPath, keyPath, caPath, interval)
+	if err != nil {
+		return nil, err
+	}
+	reloaders[key] = r
+	return r, nil
+}
+
+// ServerCredentials returns the credentials of grpc servers with mutual tls
+func ServerCredentials(certPath, keyPath, caPath string, interval time.Duration) (credentials.TransportCredentials, error) {
+	r, err := getCertReloader(certPath, keyPath, caPath, interval)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(r.ServerTLSConfig()), nil
+}
+
+// ClientCredentials returns the credentials of grpc clients with mutual tls
+func ClientCredentials(certPath, keyPath, caPath, serverName string, interval time.Duration) (credentials.TransportCredentials, error) {
+	r, err := getCertReloader(certPath, keyPath, caPath, interval)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(r.ClientTLSConfig(serverName)), nil
+}
+
+// InternalServerCredentials returns the credentials of the internal grpc servers, insecure if the internal tls is disabled
+func InternalServerCredentials(cfg paramtable.InternalTLSConfig) (credentials.TransportCredentials, error) {
+	if !cfg.Enabled {
+		return insecure.NewCredentials(), nil
+	}
+	return ServerCredentials(cfg.ServerPemPath, cfg.ServerKeyPath, cfg.CaPemPath, cfg.ReloadInterval)
+}
+
+// InternalClientCredentials returns the credentials of the internal grpc clients, insecure if the internal tls is disabled
+func InternalClientCredentials(cfg paramtable.InternalTLSConfig) (credentials.TransportCredentials, error) {
+	if !cfg.Enabled {
+		return insecure.NewCredentials(), nil
+	}
+	return ClientCredentials(cfg.ClientPemPath, cfg.ClientKeyPath, cfg.CaPemPath, cfg.ServerName, cfg.ReloadInterval)
+}
@@ -0,0 +1,187 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsutil
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue writes a key pair signed by the ca to dir, and returns the paths of the cert and the key
+func (ca *testCA) issue(t *testing.T, dir string, name string, serial int64) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPath := filepath.Join(dir, name+".pem")
+	keyPath := filepath.Join(dir, name+".key")
+	require.NoError(t, ioutil.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, ioutil.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return certPath, keyPath
+}
+
+func TestCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	caPath := filepath.Join(dir, "ca.pem")
+	require.NoError(t, ioutil.WriteFile(caPath, ca.pem, 0600))
+	certPath, keyPath := ca.issue(t, dir, "server", 2)
+
+	r, err := NewCertReloader(certPath, keyPath, caPath, 10*time.Millisecond)
+	require.NoError(t, err)
+	defer r.Close()
+	first := r.Certificate()
+	assert.NotNil(t, first)
+	assert.NotNil(t, r.CAPool())
+
+	// broken files are not loaded
+	time.Sleep(20 * time.Millisecond)
+	require.NoError(t, ioutil.WriteFile(certPath, []byte("broken"), 0600))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, first, r.Certificate())
+
+	// rotated files are reloaded
+	ca.issue(t, dir, "server", 3)
+	assert.Eventually(t, func() bool {
+		return r.Certificate() != first
+	}, time.Second, 10*time.Millisecond)
+	leaf, err := x509.ParseCertificate(r.Certificate().Certificate[0])
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), leaf.SerialNumber.Int64())
+
+	_, err = NewCertReloader(filepath.Join(dir, "not_exist.pem"), keyPath, caPath, 0)
+	assert.Error(t, err)
+}
+
+func TestInternalCredentials(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	caPath := filepath.Join(dir, "ca.pem")
+	require.NoError(t, ioutil.WriteFile(caPath, ca.pem, 0600))
+	serverCert, serverKey := ca.issue(t, dir, "server", 2)
+	clientCert, clientKey := ca.issue(t, dir, "client", 3)
+	cfg := paramtable.InternalTLSConfig{
+		Enabled:       true,
+		ServerPemPath: serverCert,
+		ServerKeyPath: serverKey,
+		ClientPemPath: clientCert,
+		ClientKeyPath: clientKey,
+		CaPemPath:     caPath,
+		ServerName:    "localhost",
+	}
+
+	serverCreds, err := InternalServerCredentials(cfg)
+	require.NoError(t, err)
+	server := grpc.NewServer(grpc.Creds(serverCreds))
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	clientCreds, err := InternalClientCredentials(cfg)
+	require.NoError(t, err)
+	conn, err := grpc.DialContext(ctx, lis.Addr().String(), grpc.WithTransportCredentials(clientCreds))
+	require.NoError(t, err)
+	defer conn.Close()
+	resp, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.GetStatus())
+
+	// plaintext clients are rejected
+	plainConn, err := grpc.DialContext(ctx, lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer plainConn.Close()
+	_, err = grpc_health_v1.NewHealthClient(plainConn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	assert.Error(t, err)
+
+	// clients without certificates are rejected
+	r, err := NewCertReloader(clientCert, clientKey, caPath, 0)
+	require.NoError(t, err)
+	tlsConf := r.ClientTLSConfig("localhost")
+	tlsConf.GetClientCertificate = nil
+	anonymousConn, err := grpc.DialContext(ctx, lis.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(tlsConf)))
+	require.NoError(t, err)
+	defer anonymousConn.Close()
+	_, err = grpc_health_v1.NewHealthClient(anonymousConn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	assert.Error(t, err)
+
+	// insecure credentials if disabled
+	creds, err := InternalClientCredentials(paramtable.InternalTLSConfig{})
+	assert.NoError(t, err)
+	assert.Equal(t, "insecure", creds.Info().SecurityProtocol)
+}
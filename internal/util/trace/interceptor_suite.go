@@ -21,6 +21,7 @@ import (
 
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/opentracing/opentracing-go"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 )
 
@@ -49,3 +50,35 @@ func GetInterceptorOpts() []grpc_opentracing.Option {
 	}
 	return opts
 }
+
+// OtelUnaryServerInterceptor returns the gRPC unary server interceptor of OpenTelemetry
+func OtelUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	interceptor := otelgrpc.UnaryServerInterceptor()
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !filterFunc(ctx, info.FullMethod) {
+			return handler(ctx, req)
+		}
+		return interceptor(ctx, req, info, handler)
+	}
+}
+
+// OtelStreamServerInterceptor returns the gRPC stream server interceptor of OpenTelemetry
+func OtelStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return otelgrpc.StreamServerInterceptor()
+}
+
+// OtelUnaryClientInterceptor returns the gRPC unary client interceptor of OpenTelemetry
+func OtelUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	interceptor := otelgrpc.UnaryClientInterceptor()
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !filterFunc(ctx, method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		return interceptor(ctx, method, req, reply, cc, invoker, opts...)
+	}
+}
+
+// OtelStreamClientInterceptor returns the gRPC stream client interceptor of OpenTelemetry
+func OtelStreamClientInterceptor() grpc.StreamClientInterceptor {
+	return otelgrpc.StreamClientInterceptor()
+}
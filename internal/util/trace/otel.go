@@ -0,0 +1,239 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// exporters of OpenTelemetry spans
+const (
+	OtelExporterNone   = "none"
+	OtelExporterOtlp   = "otlp"
+	OtelExporterStdout = "stdout"
+	OtelExporterFile   = "file"
+)
+
+const otelTracerName = "github.com/milvus-io/milvus"
+
+// OtelConfig is the config of OpenTelemetry tracing
+type OtelConfig struct {
+	Exporter       string
+	SampleFraction float64
+	OtlpEndpoint   string
+	OtlpInsecure   bool
+	FilePath       string
+}
+
+var otelProviderMtx sync.Mutex
+
+// otelProvider is the tracer provider shared by the components in the process, and the number of its users
+var otelProvider *sdktrace.TracerProvider
+var otelProviderRefs int
+
+// otelProviderCloser releases a reference of the shared tracer provider when closed,
+// the provider flushes and stops its exporter when the last reference is released
+type otelProviderCloser struct {
+	once sync.Once
+}
+
+// Close implements io.Closer
+func (c *otelProviderCloser) Close() error {
+	var err error
+	c.once.Do(func() {
+		otelProviderMtx.Lock()
+		defer otelProviderMtx.Unlock()
+		otelProviderRefs--
+		if otelProviderRefs > 0 {
+			return
+		}
+		err = otelProvider.Shutdown(context.Background())
+		otelProvider = nil
+	})
+	return err
+}
+
+// InitOtelTracing sets up the global OpenTelemetry tracer provider and propagator, spans are dropped
+// if the exporter is none. Components running in the same process share the provider set up first,
+// each of them gets its own closer and the provider is shut down when all of them are closed.
+func InitOtelTracing(serviceName string, cfg OtelConfig) (io.Closer, error) {
+	otelProviderMtx.Lock()
+	defer otelProviderMtx.Unlock()
+
+	// the trace context is propagated even if spans are dropped, so that traces pass through this component
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if otelProvider != nil {
+		otelProviderRefs++
+		return &otelProviderCloser{}, nil
+	}
+
+	exporter, err := newOtelExporter(cfg)
+	if err != nil || exporter == nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleFraction))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.ServiceNameKey.String(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	otelProvider = provider
+	otelProviderRefs = 1
+	return &otelProviderCloser{}, nil
+}
+
+func newOtelExporter(cfg OtelConfig) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case "", OtelExporterNone:
+		return nil, nil
+	case OtelExporterOtlp:
+		opts := []otlpgrpc.Option{otlpgrpc.WithEndpoint(cfg.OtlpEndpoint)}
+		if cfg.OtlpInsecure {
+			opts = append(opts, otlpgrpc.WithInsecure())
+		}
+		return otlp.NewExporter(context.Background(), otlpgrpc.NewDriver(opts...))
+	case OtelExporterStdout:
+		return newJSONSpanExporter(os.Stdout), nil
+	case OtelExporterFile:
+		if err := os.MkdirAll(filepath.Dir(cfg.FilePath), os.ModePerm); err != nil {
+			return nil, err
+		}
+		f, err := os.OpenFile(cfg.FilePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, err
+		}
+		return newJSONSpanExporter(f), nil
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %s", cfg.Exporter)
+	}
+}
+
+// jsonSpan is the json format of the spans written by jsonSpanExporter
+type jsonSpan struct {
+	TraceID       string                 `json:"trace_id"`
+	SpanID        string                 `json:"span_id"`
+	ParentSpanID  string                 `json:"parent_span_id,omitempty"`
+	Name          string                 `json:"name"`
+	Kind          string                 `json:"kind"`
+	StartTime     time.Time              `json:"start_time"`
+	EndTime       time.Time              `json:"end_time"`
+	Attributes    map[string]interface{} `json:"attributes,omitempty"`
+	StatusCode    string                 `json:"status_code"`
+	StatusMessage string                 `json:"status_message,omitempty"`
+}
+
+// jsonSpanExporter writes spans as json lines, it is meant for local testing
+type jsonSpanExporter struct {
+	mu      sync.Mutex
+	w       io.Writer
+	encoder *json.Encoder
+}
+
+func newJSONSpanExporter(w io.Writer) *jsonSpanExporter {
+	return &jsonSpanExporter{
+		w:       w,
+		encoder: json.NewEncoder(w),
+	}
+}
+
+// ExportSpans implements sdktrace.SpanExporter
+func (e *jsonSpanExporter) ExportSpans(ctx context.Context, spans []*sdktrace.SpanSnapshot) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, span := range spans {
+		js := &jsonSpan{
+			TraceID:       span.SpanContext.TraceID().String(),
+			SpanID:        span.SpanContext.SpanID().String(),
+			Name:          span.Name,
+			Kind:          span.SpanKind.String(),
+			StartTime:     span.StartTime,
+			EndTime:       span.EndTime,
+			StatusCode:    span.StatusCode.String(),
+			StatusMessage: span.StatusMessage,
+		}
+		if span.Parent.IsValid() {
+			js.ParentSpanID = span.Parent.SpanID().String()
+		}
+		if len(span.Attributes) > 0 {
+			js.Attributes = make(map[string]interface{}, len(span.Attributes))
+			for _, kv := range span.Attributes {
+				js.Attributes[string(kv.Key)] = kv.Value.AsInterface()
+			}
+		}
+		if err := e.encoder.Encode(js); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Shutdown implements sdktrace.SpanExporter
+func (e *jsonSpanExporter) Shutdown(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if f, ok := e.w.(*os.File); ok && f != os.Stdout {
+		return f.Close()
+	}
+	return nil
+}
+
+// StartOtelSpan starts an OpenTelemetry span as the child of the span in ctx
+func StartOtelSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, oteltrace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return otel.Tracer(otelTracerName).Start(ctx, name, oteltrace.WithAttributes(attrs...))
+}
+
+// EndOtelSpan records err on span if it is not nil, then ends the span
+func EndOtelSpan(span oteltrace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// InjectOtelContextToMsgProperties injects the OpenTelemetry span context of ctx to message properties.
+func InjectOtelContextToMsgProperties(ctx context.Context, properties map[string]string) {
+	otel.GetTextMapPropagator().Inject(ctx, PropertiesReaderWriter{properties})
+}
+
+// ExtractOtelContextFromMsgProperties returns ctx with the OpenTelemetry span context carried by message properties,
+// and whether a valid span context is found.
+func ExtractOtelContextFromMsgProperties(ctx context.Context, properties map[string]string) (context.Context, bool) {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, PropertiesReaderWriter{properties})
+	return ctx, oteltrace.SpanContextFromContext(ctx).IsValid()
+}
@@ -0,0 +1,120 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func TestNewOtelExporter(t *testing.T) {
+	exporter, err := newOtelExporter(OtelConfig{Exporter: OtelExporterNone})
+	assert.NoError(t, err)
+	assert.Nil(t, exporter)
+
+	exporter, err = newOtelExporter(OtelConfig{Exporter: OtelExporterFile, FilePath: t.TempDir() + "/trace.json"})
+	assert.NoError(t, err)
+	assert.NotNil(t, exporter)
+	assert.NoError(t, exporter.Shutdown(context.Background()))
+
+	_, err = newOtelExporter(OtelConfig{Exporter: "zipkin"})
+	assert.Error(t, err)
+}
+
+func TestJSONSpanExporter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSyncer(newJSONSpanExporter(buf)),
+		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+	)
+	tracer := provider.Tracer("test")
+
+	ctx, parent := tracer.Start(context.Background(), "parent")
+	_, child := tracer.Start(ctx, "child", oteltrace.WithAttributes(attribute.Int64("segmentID", 1)))
+	EndOtelSpan(child, errors.New("mock error"))
+	EndOtelSpan(parent, nil)
+	assert.NoError(t, provider.Shutdown(context.Background()))
+
+	decoder := json.NewDecoder(buf)
+	var childSpan, parentSpan jsonSpan
+	assert.NoError(t, decoder.Decode(&childSpan))
+	assert.NoError(t, decoder.Decode(&parentSpan))
+
+	assert.Equal(t, "child", childSpan.Name)
+	assert.Equal(t, parentSpan.SpanID, childSpan.ParentSpanID)
+	assert.Equal(t, parentSpan.TraceID, childSpan.TraceID)
+	assert.Equal(t, float64(1), childSpan.Attributes["segmentID"])
+	assert.Equal(t, "Error", childSpan.StatusCode)
+	assert.Equal(t, "mock error", childSpan.StatusMessage)
+	assert.Empty(t, parentSpan.ParentSpanID)
+}
+
+func TestInitOtelTracingRefCount(t *testing.T) {
+	cfg := OtelConfig{Exporter: OtelExporterFile, FilePath: t.TempDir() + "/trace.json", SampleFraction: 1}
+	closer1, err := InitOtelTracing("test1", cfg)
+	assert.NoError(t, err)
+	closer2, err := InitOtelTracing("test2", cfg)
+	assert.NoError(t, err)
+	provider := otelProvider
+	assert.NotNil(t, provider)
+	assert.Equal(t, 2, otelProviderRefs)
+
+	// closing a closer twice releases one reference only
+	assert.NoError(t, closer1.Close())
+	assert.NoError(t, closer1.Close())
+	assert.Equal(t, 1, otelProviderRefs)
+	assert.Equal(t, provider, otelProvider)
+
+	assert.NoError(t, closer2.Close())
+	assert.Nil(t, otelProvider)
+
+	// the provider is set up again after all components are closed
+	closer3, err := InitOtelTracing("test3", cfg)
+	assert.NoError(t, err)
+	assert.NotNil(t, otelProvider)
+	assert.NotEqual(t, provider, otelProvider)
+	assert.NoError(t, closer3.Close())
+}
+
+func TestOtelMsgProperties(t *testing.T) {
+	_, err := InitOtelTracing("test", OtelConfig{Exporter: OtelExporterNone})
+	assert.NoError(t, err)
+
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSampler(sdktrace.AlwaysSample()))
+	ctx, span := provider.Tracer("test").Start(context.Background(), "producer")
+	defer span.End()
+
+	properties := make(map[string]string)
+	InjectOtelContextToMsgProperties(ctx, properties)
+	assert.NotEmpty(t, properties)
+
+	extracted, ok := ExtractOtelContextFromMsgProperties(context.Background(), properties)
+	assert.True(t, ok)
+	assert.Equal(t, span.SpanContext().TraceID(), oteltrace.SpanContextFromContext(extracted).TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), oteltrace.SpanContextFromContext(extracted).SpanID())
+
+	_, ok = ExtractOtelContextFromMsgProperties(context.Background(), map[string]string{})
+	assert.False(t, ok)
+}
@@ -204,6 +204,20 @@ func (ppRW PropertiesReaderWriter) Set(key, val string) {
 	ppRW.PpMap[key] = val
 }
 
+// Get returns the value of key in PpMap.
+func (ppRW PropertiesReaderWriter) Get(key string) string {
+	return ppRW.PpMap[strings.ToLower(key)]
+}
+
+// Keys returns the keys of PpMap.
+func (ppRW PropertiesReaderWriter) Keys() []string {
+	keys := make([]string, 0, len(ppRW.PpMap))
+	for k := range ppRW.PpMap {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
 // ForeachKey iterates each key value of PpMap.
 func (ppRW PropertiesReaderWriter) ForeachKey(handler func(key, val string) error) error {
 	for k, val := range ppRW.PpMap {
@@ -17,7 +17,9 @@
 package tsoutil
 
 import (
+	"fmt"
 	"path"
+	"strconv"
 	"time"
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
@@ -82,6 +84,21 @@ func AddPhysicalDurationOnTs(ts uint64, duration time.Duration) uint64 {
 	return ComposeTS(physical+msecs, logical)
 }
 
+// ParseWallClockTime converts a wall-clock time in RFC3339 format or in epoch milliseconds to a hybrid timestamp.
+func ParseWallClockTime(value string) (uint64, error) {
+	if msecs, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if msecs <= 0 {
+			return 0, fmt.Errorf("invalid epoch milliseconds %d", msecs)
+		}
+		return ComposeTS(msecs, 0), nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %s, should be in RFC3339 format or epoch milliseconds", value)
+	}
+	return ComposeTSByTime(t, 0), nil
+}
+
 // NewTSOKVBase returns a etcdkv.EtcdKV object
 func NewTSOKVBase(client *clientv3.Client, tsoRoot, subPath string) *etcdkv.EtcdKV {
 	return etcdkv.NewEtcdKV(client, path.Join(tsoRoot, subPath))
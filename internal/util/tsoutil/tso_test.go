@@ -17,6 +17,7 @@
 package tsoutil
 
 import (
+	"strconv"
 	"testing"
 	"time"
 
@@ -78,3 +79,25 @@ func TestAddPhysicalDurationOnTs(t *testing.T) {
 	//diff := CalculateDuration(ts2, ts1)
 	assert.Equal(t, ts3, ts2)
 }
+
+func TestParseWallClockTime(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+
+	ts, err := ParseWallClockTime(strconv.FormatInt(now.UnixMilli(), 10))
+	assert.NoError(t, err)
+	assert.Equal(t, ComposeTSByTime(now, 0), ts)
+
+	ts, err = ParseWallClockTime(now.Format(time.RFC3339Nano))
+	assert.NoError(t, err)
+	assert.Equal(t, ComposeTSByTime(now, 0), ts)
+
+	ts, err = ParseWallClockTime("2022-06-01T08:00:00+08:00")
+	assert.NoError(t, err)
+	physical, _ := ParseTS(ts)
+	assert.Equal(t, time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC).UnixMilli(), physical.UnixMilli())
+
+	_, err = ParseWallClockTime("-1")
+	assert.Error(t, err)
+	_, err = ParseWallClockTime("2022-06-01 08:00:00")
+	assert.Error(t, err)
+}
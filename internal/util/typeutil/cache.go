@@ -7,6 +7,8 @@ const (
 	CacheRemoveUserFromRole
 	CacheGrantPrivilege
 	CacheRevokePrivilege
+	CacheCreateRowPolicy
+	CacheDropRowPolicy
 )
 
 type CacheOp struct {
@@ -0,0 +1,143 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"math"
+
+	"github.com/milvus-io/milvus/internal/common"
+)
+
+// Float16 and BFloat16 vectors are stored as raw bytes, 2 bytes per dimension in common.Endian.
+
+// Float32ToFloat16Bits converts a float32 to IEEE 754 half precision bits, rounding to nearest even.
+func Float32ToFloat16Bits(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int32(bits>>23) & 0xff
+	mantissa := bits & 0x7fffff
+
+	switch {
+	case exp == 0xff:
+		// Inf or NaN, keep NaN quiet
+		if mantissa != 0 {
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	case exp-127+15 >= 0x1f:
+		// overflow to Inf
+		return sign | 0x7c00
+	case exp-127+15 <= 0:
+		// subnormal half or zero
+		shift := uint32(14 - (exp - 127 + 15))
+		if shift > 24 {
+			return sign
+		}
+		mantissa |= 0x800000
+		half := mantissa >> shift
+		rem := mantissa & (1<<shift - 1)
+		mid := uint32(1) << (shift - 1)
+		if rem > mid || (rem == mid && half&1 == 1) {
+			half++
+		}
+		return sign | uint16(half)
+	}
+
+	half := uint32(exp-127+15)<<10 | mantissa>>13
+	rem := mantissa & 0x1fff
+	if rem > 0x1000 || (rem == 0x1000 && half&1 == 1) {
+		// a carry into the exponent is the correct rounding, up to Inf
+		half++
+	}
+	return sign | uint16(half)
+}
+
+// Float16BitsToFloat32 converts IEEE 754 half precision bits to a float32.
+func Float16BitsToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mantissa := uint32(h & 0x3ff)
+
+	switch {
+	case exp == 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mantissa<<13)
+	case exp == 0:
+		if mantissa == 0 {
+			return math.Float32frombits(sign)
+		}
+		// normalize the subnormal half
+		exp = 127 - 15 + 1
+		for mantissa&0x400 == 0 {
+			mantissa <<= 1
+			exp--
+		}
+		mantissa &= 0x3ff
+		return math.Float32frombits(sign | exp<<23 | mantissa<<13)
+	}
+	return math.Float32frombits(sign | (exp+127-15)<<23 | mantissa<<13)
+}
+
+// Float32ToBFloat16Bits converts a float32 to bfloat16 bits, rounding to nearest even.
+func Float32ToBFloat16Bits(f float32) uint16 {
+	bits := math.Float32bits(f)
+	if math.IsNaN(float64(f)) {
+		return uint16(bits>>16) | 0x40
+	}
+	bits += 0x7fff + (bits>>16)&1
+	return uint16(bits >> 16)
+}
+
+// BFloat16BitsToFloat32 converts bfloat16 bits to a float32.
+func BFloat16BitsToFloat32(b uint16) float32 {
+	return math.Float32frombits(uint32(b) << 16)
+}
+
+// Float32ArrayToFloat16Bytes encodes a float32 slice as a float16 vector.
+func Float32ArrayToFloat16Bytes(fa []float32) []byte {
+	data := make([]byte, 2*len(fa))
+	for i, f := range fa {
+		common.Endian.PutUint16(data[i*2:], Float32ToFloat16Bits(f))
+	}
+	return data
+}
+
+// Float16BytesToFloat32Array decodes a float16 vector into a float32 slice.
+func Float16BytesToFloat32Array(data []byte) []float32 {
+	fa := make([]float32, len(data)/2)
+	for i := range fa {
+		fa[i] = Float16BitsToFloat32(common.Endian.Uint16(data[i*2:]))
+	}
+	return fa
+}
+
+// Float32ArrayToBFloat16Bytes encodes a float32 slice as a bfloat16 vector.
+func Float32ArrayToBFloat16Bytes(fa []float32) []byte {
+	data := make([]byte, 2*len(fa))
+	for i, f := range fa {
+		common.Endian.PutUint16(data[i*2:], Float32ToBFloat16Bits(f))
+	}
+	return data
+}
+
+// BFloat16BytesToFloat32Array decodes a bfloat16 vector into a float32 slice.
+func BFloat16BytesToFloat32Array(data []byte) []float32 {
+	fa := make([]float32, len(data)/2)
+	for i := range fa {
+		fa[i] = BFloat16BitsToFloat32(common.Endian.Uint16(data[i*2:]))
+	}
+	return fa
+}
@@ -0,0 +1,100 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"math"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFloat16Conversion(t *testing.T) {
+	cases := []struct {
+		f    float32
+		bits uint16
+	}{
+		{0, 0x0000},
+		{1, 0x3c00},
+		{-2, 0xc000},
+		{0.5, 0x3800},
+		{65504, 0x7bff},
+		{float32(math.Inf(1)), 0x7c00},
+		{float32(math.Inf(-1)), 0xfc00},
+		{5.960464477539063e-08, 0x0001}, // smallest subnormal
+		{6.103515625e-05, 0x0400},       // smallest normal
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.bits, Float32ToFloat16Bits(c.f), "%v", c.f)
+		assert.Equal(t, c.f, Float16BitsToFloat32(c.bits), "%x", c.bits)
+	}
+
+	// overflow and rounding
+	assert.Equal(t, uint16(0x7c00), Float32ToFloat16Bits(1e6))
+	assert.Equal(t, uint16(0x0000), Float32ToFloat16Bits(1e-10))
+	assert.Equal(t, uint16(0x3c00), Float32ToFloat16Bits(1+1.0/4096))
+	assert.Equal(t, uint16(0x3c01), Float32ToFloat16Bits(1+1.0/1024))
+	assert.True(t, math.IsNaN(float64(Float16BitsToFloat32(Float32ToFloat16Bits(float32(math.NaN()))))))
+
+	fa := []float32{1, -0.25, 3.5, 1024}
+	assert.Equal(t, fa, Float16BytesToFloat32Array(Float32ArrayToFloat16Bytes(fa)))
+}
+
+func TestBFloat16Conversion(t *testing.T) {
+	assert.Equal(t, uint16(0x3f80), Float32ToBFloat16Bits(1))
+	assert.Equal(t, uint16(0xc000), Float32ToBFloat16Bits(-2))
+	assert.Equal(t, float32(1), BFloat16BitsToFloat32(0x3f80))
+	assert.Equal(t, uint16(0x7f80), Float32ToBFloat16Bits(float32(math.Inf(1))))
+	assert.True(t, math.IsNaN(float64(BFloat16BitsToFloat32(Float32ToBFloat16Bits(float32(math.NaN()))))))
+	// 1 + 2^-8 is exactly between two bfloat16 values, rounds to even
+	assert.Equal(t, uint16(0x3f80), Float32ToBFloat16Bits(1+1.0/256))
+
+	fa := []float32{1, -0.25, 3.5, 1e20}
+	out := BFloat16BytesToFloat32Array(Float32ArrayToBFloat16Bytes(fa))
+	for i := range fa {
+		assert.InEpsilon(t, fa[i], out[i], 1e-2)
+	}
+}
+
+func TestAppendFieldData_Float16Vector(t *testing.T) {
+	dim := int64(2)
+	data := Float32ArrayToFloat16Bytes([]float32{1, 2, 3, 4})
+	src := []*schemapb.FieldData{
+		{
+			Type:      schemapb.DataType_Float16Vector,
+			FieldName: "fp16",
+			FieldId:   100,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim:  dim,
+					Data: &schemapb.VectorField_Float16Vector{Float16Vector: data},
+				},
+			},
+		},
+	}
+
+	dst := make([]*schemapb.FieldData, 1)
+	AppendFieldData(dst, src, 1)
+	assert.Equal(t, data[4:], dst[0].GetVectors().GetFloat16Vector())
+	AppendFieldData(dst, src, 0)
+	assert.Equal(t, append(append([]byte{}, data[4:]...), data[:4]...), dst[0].GetVectors().GetFloat16Vector())
+
+	size, err := EstimateEntitySize(src, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, size)
+}
@@ -94,3 +94,52 @@ func HashPK2Channels(primaryKeys *schemapb.IDs, shardNames []string) []uint32 {
 
 	return hashValues
 }
+
+// HashPK2ChannelsWithHistory hash primary keys to all the channels they may have been inserted into.
+// Shards are only ever appended to a collection, so a primary key inserted while the collection had
+// n shards was hashed into shardNames[hash % n]. The result holds the distinct channel indexes of each key.
+func HashPK2ChannelsWithHistory(primaryKeys *schemapb.IDs, shardNames []string, shardsNumHistory []int32) [][]uint32 {
+	numShards := []uint32{uint32(len(shardNames))}
+	for _, num := range shardsNumHistory {
+		if num > 0 && int(num) < len(shardNames) {
+			numShards = append(numShards, uint32(num))
+		}
+	}
+
+	var hashes []uint32
+	switch primaryKeys.IdField.(type) {
+	case *schemapb.IDs_IntId:
+		pks := primaryKeys.GetIntId().Data
+		for _, pk := range pks {
+			value, _ := Hash32Int64(pk)
+			hashes = append(hashes, value)
+		}
+	case *schemapb.IDs_StrId:
+		pks := primaryKeys.GetStrId().Data
+		for _, pk := range pks {
+			hashes = append(hashes, HashString2Uint32(pk))
+		}
+	default:
+		//TODO::
+	}
+
+	hashValues := make([][]uint32, 0, len(hashes))
+	for _, hash := range hashes {
+		keys := make([]uint32, 0, len(numShards))
+		for _, num := range numShards {
+			key := hash % num
+			duplicated := false
+			for _, k := range keys {
+				if k == key {
+					duplicated = true
+					break
+				}
+			}
+			if !duplicated {
+				keys = append(keys, key)
+			}
+		}
+		hashValues = append(hashValues, keys)
+	}
+	return hashValues
+}
@@ -94,3 +94,47 @@ func TestHashPK2Channels(t *testing.T) {
 	assert.Equal(t, 5, len(ret))
 	assert.Equal(t, ret[1], ret[2])
 }
+
+func TestHashPK2ChannelsWithHistory(t *testing.T) {
+	channels := []string{"test1", "test2", "test3", "test4", "test5"}
+	int64IDs := &schemapb.IDs{
+		IdField: &schemapb.IDs_IntId{
+			IntId: &schemapb.LongArray{
+				Data: []int64{100, 102, 102, 103, 104},
+			},
+		},
+	}
+	current := HashPK2Channels(int64IDs, channels)
+	old := HashPK2Channels(int64IDs, channels[:2])
+	ret := HashPK2ChannelsWithHistory(int64IDs, channels, []int32{2, 5})
+	assert.Equal(t, 5, len(ret))
+	for i, keys := range ret {
+		// the key of the current shards comes first, followed by the old one if it differs
+		assert.Equal(t, current[i], keys[0])
+		assert.Contains(t, keys, old[i])
+		assert.LessOrEqual(t, len(keys), 2)
+	}
+	assert.Equal(t, ret[1], ret[2])
+
+	// without history only the current shards are used
+	ret = HashPK2ChannelsWithHistory(int64IDs, channels, nil)
+	for i, keys := range ret {
+		assert.Equal(t, []uint32{current[i]}, keys)
+	}
+
+	stringIDs := &schemapb.IDs{
+		IdField: &schemapb.IDs_StrId{
+			StrId: &schemapb.StringArray{
+				Data: []string{"ab", "bc", "bc", "abd", "milvus"},
+			},
+		},
+	}
+	current = HashPK2Channels(stringIDs, channels)
+	old = HashPK2Channels(stringIDs, channels[:3])
+	ret = HashPK2ChannelsWithHistory(stringIDs, channels, []int32{3, 5})
+	assert.Equal(t, 5, len(ret))
+	for i, keys := range ret {
+		assert.Equal(t, current[i], keys[0])
+		assert.Contains(t, keys, old[i])
+	}
+}
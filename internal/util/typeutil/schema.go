@@ -19,6 +19,7 @@ package typeutil
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -59,6 +60,10 @@ func GetAvgLengthOfVarLengthField(fieldSchema *schemapb.FieldSchema) (int, error
 	return maxLength, nil
 }
 
+// SparseFloatVectorEstimatedElements is the estimated number of non-zero elements
+// of a sparse float vector row, used when estimating the size of a record.
+const SparseFloatVectorEstimatedElements = 64
+
 // EstimateSizePerRecord returns the estimate size of a record in a collection
 func EstimateSizePerRecord(schema *schemapb.CollectionSchema) (int, error) {
 	res := 0
@@ -100,6 +105,20 @@ func EstimateSizePerRecord(schema *schemapb.CollectionSchema) (int, error) {
 					break
 				}
 			}
+		case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+			for _, kv := range fs.TypeParams {
+				if kv.Key == "dim" {
+					v, err := strconv.Atoi(kv.Value)
+					if err != nil {
+						return -1, err
+					}
+					res += v * 2
+					break
+				}
+			}
+		case schemapb.DataType_SparseFloatVector:
+			// sparse rows are variable-length, use a rough estimate of non-zero elements per row
+			res += SparseFloatVectorEstimatedElements * SparseFloatRowElementSize
 		}
 	}
 	return res, nil
@@ -127,6 +146,14 @@ func EstimateEntitySize(fieldsData []*schemapb.FieldData, rowOffset int) (int, e
 			res += int(fs.GetVectors().GetDim())
 		case schemapb.DataType_FloatVector:
 			res += int(fs.GetVectors().GetDim() * 4)
+		case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+			res += int(fs.GetVectors().GetDim() * 2)
+		case schemapb.DataType_SparseFloatVector:
+			contents := fs.GetVectors().GetSparseFloatVector().GetContents()
+			if rowOffset >= len(contents) {
+				return 0, fmt.Errorf("offset out range of field datas")
+			}
+			res += len(contents[rowOffset])
 		}
 	}
 	return res, nil
@@ -215,7 +242,8 @@ func (helper *SchemaHelper) GetVectorDimFromID(fieldID int64) (int, error) {
 // IsVectorType returns true if input is a vector type, otherwise false
 func IsVectorType(dataType schemapb.DataType) bool {
 	switch dataType {
-	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
+	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector, schemapb.DataType_SparseFloatVector,
+		schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
 		return true
 	default:
 		return false
@@ -248,6 +276,41 @@ func IsArithmetic(dataType schemapb.DataType) bool {
 	return IsIntegerType(dataType) || IsFloatingType(dataType)
 }
 
+// IsNullableType returns true if fields of the data type can be nullable or have a default value, otherwise false
+func IsNullableType(dataType schemapb.DataType) bool {
+	return IsBoolType(dataType) || IsArithmetic(dataType) || IsStringType(dataType)
+}
+
+// ValidateDefaultValue checks whether the default value of field matches the data type of the field
+func ValidateDefaultValue(field *schemapb.FieldSchema) error {
+	if field.GetDefaultValue() == nil {
+		return nil
+	}
+	if !IsNullableType(field.GetDataType()) {
+		return fmt.Errorf("field %s of type %s cannot have default value", field.GetName(), field.GetDataType().String())
+	}
+
+	var ok bool
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		_, ok = field.GetDefaultValue().GetData().(*schemapb.ValueField_BoolData)
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		_, ok = field.GetDefaultValue().GetData().(*schemapb.ValueField_IntData)
+	case schemapb.DataType_Int64:
+		_, ok = field.GetDefaultValue().GetData().(*schemapb.ValueField_LongData)
+	case schemapb.DataType_Float:
+		_, ok = field.GetDefaultValue().GetData().(*schemapb.ValueField_FloatData)
+	case schemapb.DataType_Double:
+		_, ok = field.GetDefaultValue().GetData().(*schemapb.ValueField_DoubleData)
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		_, ok = field.GetDefaultValue().GetData().(*schemapb.ValueField_StringData)
+	}
+	if !ok {
+		return fmt.Errorf("default value of field %s doesn't match the type %s", field.GetName(), field.GetDataType().String())
+	}
+	return nil
+}
+
 // IsBoolType returns true if input is a bool type, otherwise false
 func IsBoolType(dataType schemapb.DataType) bool {
 	switch dataType {
@@ -387,10 +450,48 @@ func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx i
 				} else {
 					dstVector.GetFloatVector().Data = append(dstVector.GetFloatVector().Data, srcVector.FloatVector.Data[idx*dim:(idx+1)*dim]...)
 				}
+			case *schemapb.VectorField_Float16Vector:
+				if dstVector.GetFloat16Vector() == nil {
+					srcToCopy := srcVector.Float16Vector[idx*(dim*2) : (idx+1)*(dim*2)]
+					dstVector.Data = &schemapb.VectorField_Float16Vector{
+						Float16Vector: make([]byte, len(srcToCopy)),
+					}
+					copy(dstVector.Data.(*schemapb.VectorField_Float16Vector).Float16Vector, srcToCopy)
+				} else {
+					dstFloat16Vector := dstVector.Data.(*schemapb.VectorField_Float16Vector)
+					dstFloat16Vector.Float16Vector = append(dstFloat16Vector.Float16Vector, srcVector.Float16Vector[idx*(dim*2):(idx+1)*(dim*2)]...)
+				}
+			case *schemapb.VectorField_Bfloat16Vector:
+				if dstVector.GetBfloat16Vector() == nil {
+					srcToCopy := srcVector.Bfloat16Vector[idx*(dim*2) : (idx+1)*(dim*2)]
+					dstVector.Data = &schemapb.VectorField_Bfloat16Vector{
+						Bfloat16Vector: make([]byte, len(srcToCopy)),
+					}
+					copy(dstVector.Data.(*schemapb.VectorField_Bfloat16Vector).Bfloat16Vector, srcToCopy)
+				} else {
+					dstBFloat16Vector := dstVector.Data.(*schemapb.VectorField_Bfloat16Vector)
+					dstBFloat16Vector.Bfloat16Vector = append(dstBFloat16Vector.Bfloat16Vector, srcVector.Bfloat16Vector[idx*(dim*2):(idx+1)*(dim*2)]...)
+				}
+			case *schemapb.VectorField_SparseFloatVector:
+				srcRow := srcVector.SparseFloatVector.Contents[idx]
+				if dstVector.GetSparseFloatVector() == nil {
+					dstVector.Data = &schemapb.VectorField_SparseFloatVector{
+						SparseFloatVector: &schemapb.SparseFloatArray{},
+					}
+				}
+				dstSparse := dstVector.GetSparseFloatVector()
+				dstSparse.Contents = append(dstSparse.Contents, append([]byte(nil), srcRow...))
+				if rowDim := SparseFloatRowDim(srcRow); rowDim > dstSparse.Dim {
+					dstSparse.Dim = rowDim
+				}
+				dstVector.Dim = dstSparse.Dim
 			default:
 				log.Error("Not supported field type", zap.String("field type", fieldData.Type.String()))
 			}
 		}
+		if validData := fieldData.GetValidData(); len(validData) > 0 && dst[i] != nil {
+			dst[i].ValidData = append(dst[i].ValidData, validData[idx])
+		}
 	}
 }
 
@@ -517,13 +618,87 @@ func MergeFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData) {
 				} else {
 					dstVector.GetFloatVector().Data = append(dstVector.GetFloatVector().Data, srcVector.FloatVector.Data...)
 				}
+			case *schemapb.VectorField_Float16Vector:
+				if dstVector.GetFloat16Vector() == nil {
+					dstVector.Data = &schemapb.VectorField_Float16Vector{
+						Float16Vector: srcVector.Float16Vector,
+					}
+				} else {
+					dstFloat16Vector := dstVector.Data.(*schemapb.VectorField_Float16Vector)
+					dstFloat16Vector.Float16Vector = append(dstFloat16Vector.Float16Vector, srcVector.Float16Vector...)
+				}
+			case *schemapb.VectorField_Bfloat16Vector:
+				if dstVector.GetBfloat16Vector() == nil {
+					dstVector.Data = &schemapb.VectorField_Bfloat16Vector{
+						Bfloat16Vector: srcVector.Bfloat16Vector,
+					}
+				} else {
+					dstBFloat16Vector := dstVector.Data.(*schemapb.VectorField_Bfloat16Vector)
+					dstBFloat16Vector.Bfloat16Vector = append(dstBFloat16Vector.Bfloat16Vector, srcVector.Bfloat16Vector...)
+				}
+			case *schemapb.VectorField_SparseFloatVector:
+				if dstVector.GetSparseFloatVector() == nil {
+					dstVector.Data = &schemapb.VectorField_SparseFloatVector{
+						SparseFloatVector: &schemapb.SparseFloatArray{},
+					}
+				}
+				dstSparse := dstVector.GetSparseFloatVector()
+				dstSparse.Contents = append(dstSparse.Contents, srcVector.SparseFloatVector.Contents...)
+				if srcVector.SparseFloatVector.Dim > dstSparse.Dim {
+					dstSparse.Dim = srcVector.SparseFloatVector.Dim
+				}
+				dstVector.Dim = dstSparse.Dim
 			default:
 				log.Error("Not supported field type", zap.String("field type", srcFieldData.Type.String()))
 			}
 		}
+		if validData := srcFieldData.GetValidData(); len(validData) > 0 {
+			if dstFieldData, ok := fieldID2Data[srcFieldData.FieldId]; ok {
+				dstFieldData.ValidData = append(dstFieldData.ValidData, validData...)
+			}
+		}
 	}
 }
 
+// GetScalarFieldValue returns the value of scalar field data at the specified index,
+// nil is returned if the field is not a scalar field, idx is out of range or the value is null
+func GetScalarFieldValue(fieldData *schemapb.FieldData, idx int64) interface{} {
+	if idx < 0 {
+		return nil
+	}
+	if validData := fieldData.GetValidData(); idx < int64(len(validData)) && !validData[idx] {
+		return nil
+	}
+	scalars := fieldData.GetScalars()
+	switch scalars.GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		if data := scalars.GetBoolData().GetData(); idx < int64(len(data)) {
+			return data[idx]
+		}
+	case *schemapb.ScalarField_IntData:
+		if data := scalars.GetIntData().GetData(); idx < int64(len(data)) {
+			return data[idx]
+		}
+	case *schemapb.ScalarField_LongData:
+		if data := scalars.GetLongData().GetData(); idx < int64(len(data)) {
+			return data[idx]
+		}
+	case *schemapb.ScalarField_FloatData:
+		if data := scalars.GetFloatData().GetData(); idx < int64(len(data)) {
+			return data[idx]
+		}
+	case *schemapb.ScalarField_DoubleData:
+		if data := scalars.GetDoubleData().GetData(); idx < int64(len(data)) {
+			return data[idx]
+		}
+	case *schemapb.ScalarField_StringData:
+		if data := scalars.GetStringData().GetData(); idx < int64(len(data)) {
+			return data[idx]
+		}
+	}
+	return nil
+}
+
 // GetPrimaryFieldSchema get primary field schema from collection schema
 func GetPrimaryFieldSchema(schema *schemapb.CollectionSchema) (*schemapb.FieldSchema, error) {
 	for _, fieldSchema := range schema.Fields {
@@ -645,3 +820,30 @@ func AppendPKs(pks *schemapb.IDs, pk interface{}) {
 		log.Warn("got unexpected data type of pk when append pks", zap.Any("pk", pk))
 	}
 }
+
+// LimitByPK sorts the entities by the primary keys and keeps the first limit ones
+func LimitByPK(ids *schemapb.IDs, fieldsData []*schemapb.FieldData, limit int64) (*schemapb.IDs, []*schemapb.FieldData) {
+	offsets := make([]int64, GetSizeOfIDs(ids))
+	for i := range offsets {
+		offsets[i] = int64(i)
+	}
+	switch ids.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		data := ids.GetIntId().GetData()
+		sort.Slice(offsets, func(i, j int) bool { return data[offsets[i]] < data[offsets[j]] })
+	case *schemapb.IDs_StrId:
+		data := ids.GetStrId().GetData()
+		sort.Slice(offsets, func(i, j int) bool { return data[offsets[i]] < data[offsets[j]] })
+	}
+	if int64(len(offsets)) > limit {
+		offsets = offsets[:limit]
+	}
+
+	retIDs := &schemapb.IDs{}
+	retFieldsData := make([]*schemapb.FieldData, len(fieldsData))
+	for _, offset := range offsets {
+		AppendPKs(retIDs, GetPK(ids, offset))
+		AppendFieldData(retFieldsData, fieldsData, offset)
+	}
+	return retIDs, retFieldsData
+}
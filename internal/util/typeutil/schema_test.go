@@ -612,3 +612,73 @@ func TestAppendPKs(t *testing.T) {
 	AppendPKs(strPks, "2")
 	assert.ElementsMatch(t, []string{"1", "2"}, strPks.GetStrId().GetData())
 }
+
+func TestLimitByPK(t *testing.T) {
+	ids := &schemapb.IDs{
+		IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{5, 1, 3, 2}}},
+	}
+	fieldsData := []*schemapb.FieldData{
+		{
+			Type:    schemapb.DataType_VarChar,
+			FieldId: 101,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{Data: []string{"e", "a", "c", "b"}},
+					},
+				},
+			},
+		},
+	}
+	retIDs, retFieldsData := LimitByPK(ids, fieldsData, 3)
+	assert.Equal(t, []int64{1, 2, 3}, retIDs.GetIntId().GetData())
+	assert.Equal(t, []string{"a", "b", "c"}, retFieldsData[0].GetScalars().GetStringData().GetData())
+	assert.Equal(t, int64(101), retFieldsData[0].GetFieldId())
+
+	strIDs := &schemapb.IDs{
+		IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"b", "c", "a"}}},
+	}
+	retIDs, _ = LimitByPK(strIDs, nil, 5)
+	assert.Equal(t, []string{"a", "b", "c"}, retIDs.GetStrId().GetData())
+
+	retIDs, retFieldsData = LimitByPK(&schemapb.IDs{}, fieldsData, 3)
+	assert.Equal(t, 0, GetSizeOfIDs(retIDs))
+	assert.Nil(t, retFieldsData[0])
+}
+
+func TestGetScalarFieldValue(t *testing.T) {
+	longField := &schemapb.FieldData{
+		Type: schemapb.DataType_Int64,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{
+					LongData: &schemapb.LongArray{Data: []int64{1, 2, 3}},
+				},
+			},
+		},
+	}
+	assert.Equal(t, int64(2), GetScalarFieldValue(longField, 1))
+	assert.Nil(t, GetScalarFieldValue(longField, 3))
+	assert.Nil(t, GetScalarFieldValue(longField, -1))
+
+	stringField := &schemapb.FieldData{
+		Type: schemapb.DataType_VarChar,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{
+					StringData: &schemapb.StringArray{Data: []string{"a", "b"}},
+				},
+			},
+		},
+	}
+	assert.Equal(t, "b", GetScalarFieldValue(stringField, 1))
+
+	vectorField := &schemapb.FieldData{
+		Type: schemapb.DataType_FloatVector,
+		Field: &schemapb.FieldData_Vectors{
+			Vectors: &schemapb.VectorField{Dim: 1},
+		},
+	}
+	assert.Nil(t, GetScalarFieldValue(vectorField, 0))
+	assert.Nil(t, GetScalarFieldValue(nil, 0))
+}
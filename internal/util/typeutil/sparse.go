@@ -0,0 +1,132 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// SparseFloatRowElementSize is the size in bytes of one (index, value) pair
+// of a sparse float vector row.
+const SparseFloatRowElementSize = 8
+
+// CreateSparseFloatRow encodes the given indices and values into a sparse float vector row.
+// The pairs are sorted by index, indices and values must have the same length.
+func CreateSparseFloatRow(indices []uint32, values []float32) []byte {
+	order := make([]int, len(indices))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return indices[order[i]] < indices[order[j]]
+	})
+
+	row := make([]byte, len(indices)*SparseFloatRowElementSize)
+	for i, o := range order {
+		binary.LittleEndian.PutUint32(row[i*SparseFloatRowElementSize:], indices[o])
+		binary.LittleEndian.PutUint32(row[i*SparseFloatRowElementSize+4:], math.Float32bits(values[o]))
+	}
+	return row
+}
+
+// SparseFloatRowElementCount returns the number of non-zero elements of a sparse float vector row.
+func SparseFloatRowElementCount(row []byte) int {
+	return len(row) / SparseFloatRowElementSize
+}
+
+// SparseFloatRowIndexAt returns the index of the i-th element of a sparse float vector row.
+func SparseFloatRowIndexAt(row []byte, i int) uint32 {
+	return binary.LittleEndian.Uint32(row[i*SparseFloatRowElementSize:])
+}
+
+// SparseFloatRowValueAt returns the value of the i-th element of a sparse float vector row.
+func SparseFloatRowValueAt(row []byte, i int) float32 {
+	return math.Float32frombits(binary.LittleEndian.Uint32(row[i*SparseFloatRowElementSize+4:]))
+}
+
+// SparseFloatRowDim returns the dimension of a sparse float vector row, which is the largest index plus one.
+func SparseFloatRowDim(row []byte) int64 {
+	cnt := SparseFloatRowElementCount(row)
+	if cnt == 0 {
+		return 0
+	}
+	return int64(SparseFloatRowIndexAt(row, cnt-1)) + 1
+}
+
+// ValidateSparseFloatRow checks that a sparse float vector row is well formed:
+// indices are strictly increasing and all values are finite.
+func ValidateSparseFloatRow(row []byte) error {
+	if len(row)%SparseFloatRowElementSize != 0 {
+		return fmt.Errorf("invalid sparse float vector row length: %d, should be a multiple of %d", len(row), SparseFloatRowElementSize)
+	}
+	cnt := SparseFloatRowElementCount(row)
+	for i := 0; i < cnt; i++ {
+		if i > 0 && SparseFloatRowIndexAt(row, i) <= SparseFloatRowIndexAt(row, i-1) {
+			return errors.New("sparse float vector row indices should be strictly increasing")
+		}
+		value := SparseFloatRowValueAt(row, i)
+		if math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) {
+			return fmt.Errorf("invalid value in sparse float vector row: %v", value)
+		}
+	}
+	return nil
+}
+
+// ValidateSparseFloatRows checks all rows of a sparse float vector field.
+func ValidateSparseFloatRows(rows ...[]byte) error {
+	for i, row := range rows {
+		if err := ValidateSparseFloatRow(row); err != nil {
+			return fmt.Errorf("row %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// SparseFloatRowsDim returns the maximum dimension of the given sparse float vector rows.
+func SparseFloatRowsDim(rows ...[]byte) int64 {
+	var dim int64
+	for _, row := range rows {
+		if rowDim := SparseFloatRowDim(row); rowDim > dim {
+			dim = rowDim
+		}
+	}
+	return dim
+}
+
+// SparseFloatRowInnerProduct returns the inner product of two sparse float vector rows.
+func SparseFloatRowInnerProduct(left, right []byte) float32 {
+	var sum float32
+	lcnt, rcnt := SparseFloatRowElementCount(left), SparseFloatRowElementCount(right)
+	for i, j := 0, 0; i < lcnt && j < rcnt; {
+		li, ri := SparseFloatRowIndexAt(left, i), SparseFloatRowIndexAt(right, j)
+		switch {
+		case li == ri:
+			sum += SparseFloatRowValueAt(left, i) * SparseFloatRowValueAt(right, j)
+			i++
+			j++
+		case li < ri:
+			i++
+		default:
+			j++
+		}
+	}
+	return sum
+}
@@ -0,0 +1,109 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"math"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparseFloatRow(t *testing.T) {
+	row := CreateSparseFloatRow([]uint32{30, 1, 10}, []float32{3.0, 0.1, 1.0})
+	assert.Equal(t, 3, SparseFloatRowElementCount(row))
+	assert.Equal(t, uint32(1), SparseFloatRowIndexAt(row, 0))
+	assert.Equal(t, uint32(10), SparseFloatRowIndexAt(row, 1))
+	assert.Equal(t, uint32(30), SparseFloatRowIndexAt(row, 2))
+	assert.Equal(t, float32(0.1), SparseFloatRowValueAt(row, 0))
+	assert.Equal(t, float32(1.0), SparseFloatRowValueAt(row, 1))
+	assert.Equal(t, float32(3.0), SparseFloatRowValueAt(row, 2))
+	assert.Equal(t, int64(31), SparseFloatRowDim(row))
+	assert.NoError(t, ValidateSparseFloatRow(row))
+
+	empty := CreateSparseFloatRow(nil, nil)
+	assert.Equal(t, 0, SparseFloatRowElementCount(empty))
+	assert.Equal(t, int64(0), SparseFloatRowDim(empty))
+	assert.NoError(t, ValidateSparseFloatRow(empty))
+
+	assert.Equal(t, int64(31), SparseFloatRowsDim(empty, row))
+	assert.NoError(t, ValidateSparseFloatRows(empty, row))
+}
+
+func TestValidateSparseFloatRow(t *testing.T) {
+	t.Run("invalid length", func(t *testing.T) {
+		assert.Error(t, ValidateSparseFloatRow([]byte{1, 2, 3}))
+	})
+
+	t.Run("duplicated index", func(t *testing.T) {
+		row := CreateSparseFloatRow([]uint32{1, 1}, []float32{1.0, 2.0})
+		assert.Error(t, ValidateSparseFloatRow(row))
+		assert.Error(t, ValidateSparseFloatRows(row))
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		row := CreateSparseFloatRow([]uint32{1, 2}, []float32{1.0, float32(math.NaN())})
+		assert.Error(t, ValidateSparseFloatRow(row))
+		row = CreateSparseFloatRow([]uint32{1}, []float32{float32(math.Inf(1))})
+		assert.Error(t, ValidateSparseFloatRow(row))
+	})
+}
+
+func TestSparseFloatRowInnerProduct(t *testing.T) {
+	left := CreateSparseFloatRow([]uint32{1, 3, 5}, []float32{1.0, 2.0, 3.0})
+	right := CreateSparseFloatRow([]uint32{0, 3, 5, 7}, []float32{4.0, 5.0, 6.0, 7.0})
+	assert.Equal(t, float32(28.0), SparseFloatRowInnerProduct(left, right))
+	assert.Equal(t, float32(0), SparseFloatRowInnerProduct(left, nil))
+}
+
+func TestAppendFieldData_SparseFloatVector(t *testing.T) {
+	rows := [][]byte{
+		CreateSparseFloatRow([]uint32{1, 2}, []float32{1.0, 2.0}),
+		CreateSparseFloatRow([]uint32{100}, []float32{3.0}),
+	}
+	src := []*schemapb.FieldData{
+		{
+			Type:      schemapb.DataType_SparseFloatVector,
+			FieldName: "sparse",
+			FieldId:   100,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim: 101,
+					Data: &schemapb.VectorField_SparseFloatVector{
+						SparseFloatVector: &schemapb.SparseFloatArray{
+							Contents: rows,
+							Dim:      101,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	dst := make([]*schemapb.FieldData, 1)
+	AppendFieldData(dst, src, 0)
+	assert.Equal(t, [][]byte{rows[0]}, dst[0].GetVectors().GetSparseFloatVector().GetContents())
+	assert.Equal(t, int64(3), dst[0].GetVectors().GetSparseFloatVector().GetDim())
+	AppendFieldData(dst, src, 1)
+	assert.Equal(t, rows, dst[0].GetVectors().GetSparseFloatVector().GetContents())
+	assert.Equal(t, int64(101), dst[0].GetVectors().GetDim())
+
+	size, err := EstimateEntitySize(src, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, SparseFloatRowElementSize, size)
+}
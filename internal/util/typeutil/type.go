@@ -46,6 +46,8 @@ const (
 	DataCoordRole = "datacoord"
 	// DataNodeRole is a constant represent DataNode
 	DataNodeRole = "datanode"
+	// CDCRole is a constant represent CDC
+	CDCRole = "cdc"
 )
 
 func ServerTypeMap() map[string]interface{} {
@@ -60,6 +62,7 @@ func ServerTypeMap() map[string]interface{} {
 		IndexNodeRole:  nil,
 		DataCoordRole:  nil,
 		DataNodeRole:   nil,
+		CDCRole:        nil,
 	}
 }
 